@@ -2,7 +2,7 @@
 package btcbridge
 
 import (
-	_ "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -2201,6 +2201,8 @@ var (
 	fd_WithdrawRequest_amount   protoreflect.FieldDescriptor
 	fd_WithdrawRequest_sequence protoreflect.FieldDescriptor
 	fd_WithdrawRequest_txid     protoreflect.FieldDescriptor
+	fd_WithdrawRequest_group    protoreflect.FieldDescriptor
+	fd_WithdrawRequest_sender   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2210,6 +2212,8 @@ func init() {
 	fd_WithdrawRequest_amount = md_WithdrawRequest.Fields().ByName("amount")
 	fd_WithdrawRequest_sequence = md_WithdrawRequest.Fields().ByName("sequence")
 	fd_WithdrawRequest_txid = md_WithdrawRequest.Fields().ByName("txid")
+	fd_WithdrawRequest_group = md_WithdrawRequest.Fields().ByName("group")
+	fd_WithdrawRequest_sender = md_WithdrawRequest.Fields().ByName("sender")
 }
 
 var _ protoreflect.Message = (*fastReflection_WithdrawRequest)(nil)
@@ -2301,6 +2305,18 @@ func (x *fastReflection_WithdrawRequest) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Group != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Group)
+		if !f(fd_WithdrawRequest_group, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_WithdrawRequest_sender, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2324,6 +2340,10 @@ func (x *fastReflection_WithdrawRequest) Has(fd protoreflect.FieldDescriptor) bo
 		return x.Sequence != uint64(0)
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		return x.Txid != ""
+	case "bitway.btcbridge.WithdrawRequest.group":
+		return x.Group != uint64(0)
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2348,6 +2368,10 @@ func (x *fastReflection_WithdrawRequest) Clear(fd protoreflect.FieldDescriptor)
 		x.Sequence = uint64(0)
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		x.Txid = ""
+	case "bitway.btcbridge.WithdrawRequest.group":
+		x.Group = uint64(0)
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		x.Sender = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2376,6 +2400,12 @@ func (x *fastReflection_WithdrawRequest) Get(descriptor protoreflect.FieldDescri
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		value := x.Txid
 		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.WithdrawRequest.group":
+		value := x.Group
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2404,6 +2434,10 @@ func (x *fastReflection_WithdrawRequest) Set(fd protoreflect.FieldDescriptor, va
 		x.Sequence = value.Uint()
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		x.Txid = value.Interface().(string)
+	case "bitway.btcbridge.WithdrawRequest.group":
+		x.Group = value.Uint()
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2432,6 +2466,10 @@ func (x *fastReflection_WithdrawRequest) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field sequence of message bitway.btcbridge.WithdrawRequest is not mutable"))
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		panic(fmt.Errorf("field txid of message bitway.btcbridge.WithdrawRequest is not mutable"))
+	case "bitway.btcbridge.WithdrawRequest.group":
+		panic(fmt.Errorf("field group of message bitway.btcbridge.WithdrawRequest is not mutable"))
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.WithdrawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2453,6 +2491,10 @@ func (x *fastReflection_WithdrawRequest) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "bitway.btcbridge.WithdrawRequest.txid":
 		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.WithdrawRequest.group":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.WithdrawRequest.sender":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawRequest"))
@@ -2537,6 +2579,13 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Group != 0 {
+			n += 1 + runtime.Sov(uint64(x.Group))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2566,6 +2615,18 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Group != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Group))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.Txid) > 0 {
 			i -= len(x.Txid)
 			copy(dAtA[i:], x.Txid)
@@ -2756,6 +2817,57 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Txid = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				}
+				x.Group = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Group |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7363,30 +7475,83 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_FeeSponsorshipUsage_3_list)(nil)
+
+type _FeeSponsorshipUsage_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeSponsorshipUsage_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSponsorshipUsage_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSponsorshipUsage_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSponsorshipUsage_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSponsorshipUsage_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipUsage_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSponsorshipUsage_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipUsage_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Edict        protoreflect.MessageDescriptor
-	fd_Edict_id     protoreflect.FieldDescriptor
-	fd_Edict_amount protoreflect.FieldDescriptor
-	fd_Edict_output protoreflect.FieldDescriptor
+	md_FeeSponsorshipUsage                  protoreflect.MessageDescriptor
+	fd_FeeSponsorshipUsage_policy_id        protoreflect.FieldDescriptor
+	fd_FeeSponsorshipUsage_epoch_start_time protoreflect.FieldDescriptor
+	fd_FeeSponsorshipUsage_spent            protoreflect.FieldDescriptor
+	fd_FeeSponsorshipUsage_tx_count         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_Edict = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("Edict")
-	fd_Edict_id = md_Edict.Fields().ByName("id")
-	fd_Edict_amount = md_Edict.Fields().ByName("amount")
-	fd_Edict_output = md_Edict.Fields().ByName("output")
+	md_FeeSponsorshipUsage = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("FeeSponsorshipUsage")
+	fd_FeeSponsorshipUsage_policy_id = md_FeeSponsorshipUsage.Fields().ByName("policy_id")
+	fd_FeeSponsorshipUsage_epoch_start_time = md_FeeSponsorshipUsage.Fields().ByName("epoch_start_time")
+	fd_FeeSponsorshipUsage_spent = md_FeeSponsorshipUsage.Fields().ByName("spent")
+	fd_FeeSponsorshipUsage_tx_count = md_FeeSponsorshipUsage.Fields().ByName("tx_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_Edict)(nil)
+var _ protoreflect.Message = (*fastReflection_FeeSponsorshipUsage)(nil)
 
-type fastReflection_Edict Edict
+type fastReflection_FeeSponsorshipUsage FeeSponsorshipUsage
 
-func (x *Edict) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Edict)(x)
+func (x *FeeSponsorshipUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipUsage)(x)
 }
 
-func (x *Edict) slowProtoReflect() protoreflect.Message {
+func (x *FeeSponsorshipUsage) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7398,43 +7563,43 @@ func (x *Edict) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Edict_messageType fastReflection_Edict_messageType
-var _ protoreflect.MessageType = fastReflection_Edict_messageType{}
+var _fastReflection_FeeSponsorshipUsage_messageType fastReflection_FeeSponsorshipUsage_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSponsorshipUsage_messageType{}
 
-type fastReflection_Edict_messageType struct{}
+type fastReflection_FeeSponsorshipUsage_messageType struct{}
 
-func (x fastReflection_Edict_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Edict)(nil)
+func (x fastReflection_FeeSponsorshipUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipUsage)(nil)
 }
-func (x fastReflection_Edict_messageType) New() protoreflect.Message {
-	return new(fastReflection_Edict)
+func (x fastReflection_FeeSponsorshipUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipUsage)
 }
-func (x fastReflection_Edict_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Edict
+func (x fastReflection_FeeSponsorshipUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipUsage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Edict) Descriptor() protoreflect.MessageDescriptor {
-	return md_Edict
+func (x *fastReflection_FeeSponsorshipUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipUsage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Edict) Type() protoreflect.MessageType {
-	return _fastReflection_Edict_messageType
+func (x *fastReflection_FeeSponsorshipUsage) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSponsorshipUsage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Edict) New() protoreflect.Message {
-	return new(fastReflection_Edict)
+func (x *fastReflection_FeeSponsorshipUsage) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipUsage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
-	return (*Edict)(x)
+func (x *fastReflection_FeeSponsorshipUsage) Interface() protoreflect.ProtoMessage {
+	return (*FeeSponsorshipUsage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7442,22 +7607,28 @@ func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != nil {
-		value := protoreflect.ValueOfMessage(x.Id.ProtoReflect())
-		if !f(fd_Edict_id, value) {
+func (x *fastReflection_FeeSponsorshipUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PolicyId != "" {
+		value := protoreflect.ValueOfString(x.PolicyId)
+		if !f(fd_FeeSponsorshipUsage_policy_id, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_Edict_amount, value) {
+	if x.EpochStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.EpochStartTime.ProtoReflect())
+		if !f(fd_FeeSponsorshipUsage_epoch_start_time, value) {
 			return
 		}
 	}
-	if x.Output != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Output)
-		if !f(fd_Edict_output, value) {
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipUsage_3_list{list: &x.Spent})
+		if !f(fd_FeeSponsorshipUsage_spent, value) {
+			return
+		}
+	}
+	if x.TxCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxCount)
+		if !f(fd_FeeSponsorshipUsage_tx_count, value) {
 			return
 		}
 	}
@@ -7474,19 +7645,21 @@ func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeSponsorshipUsage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		return x.Id != nil
-	case "bitway.btcbridge.Edict.amount":
-		return x.Amount != ""
-	case "bitway.btcbridge.Edict.output":
-		return x.Output != uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
+		return x.PolicyId != ""
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		return x.EpochStartTime != nil
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		return len(x.Spent) != 0
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		return x.TxCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7496,19 +7669,21 @@ func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeSponsorshipUsage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		x.Id = nil
-	case "bitway.btcbridge.Edict.amount":
-		x.Amount = ""
-	case "bitway.btcbridge.Edict.output":
-		x.Output = uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
+		x.PolicyId = ""
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		x.EpochStartTime = nil
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		x.Spent = nil
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		x.TxCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7518,22 +7693,28 @@ func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		value := x.Id
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.Edict.amount":
-		value := x.Amount
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
+		value := x.PolicyId
 		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.Edict.output":
-		value := x.Output
-		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		value := x.EpochStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipUsage_3_list{})
+		}
+		listValue := &_FeeSponsorshipUsage_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		value := x.TxCount
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7547,19 +7728,23 @@ func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeSponsorshipUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		x.Id = value.Message().Interface().(*RuneId)
-	case "bitway.btcbridge.Edict.amount":
-		x.Amount = value.Interface().(string)
-	case "bitway.btcbridge.Edict.output":
-		x.Output = uint32(value.Uint())
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
+		x.PolicyId = value.Interface().(string)
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		x.EpochStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipUsage_3_list)
+		x.Spent = *clv.list
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		x.TxCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7573,52 +7758,61 @@ func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		if x.Id == nil {
-			x.Id = new(RuneId)
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		if x.EpochStartTime == nil {
+			x.EpochStartTime = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Id.ProtoReflect())
-	case "bitway.btcbridge.Edict.amount":
-		panic(fmt.Errorf("field amount of message bitway.btcbridge.Edict is not mutable"))
-	case "bitway.btcbridge.Edict.output":
-		panic(fmt.Errorf("field output of message bitway.btcbridge.Edict is not mutable"))
+		return protoreflect.ValueOfMessage(x.EpochStartTime.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta1.Coin{}
+		}
+		value := &_FeeSponsorshipUsage_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
+		panic(fmt.Errorf("field policy_id of message bitway.btcbridge.FeeSponsorshipUsage is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		panic(fmt.Errorf("field tx_count of message bitway.btcbridge.FeeSponsorshipUsage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Edict) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Edict.id":
-		m := new(RuneId)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.Edict.amount":
+	case "bitway.btcbridge.FeeSponsorshipUsage.policy_id":
 		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.Edict.output":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipUsage.spent":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipUsage_3_list{list: &list})
+	case "bitway.btcbridge.FeeSponsorshipUsage.tx_count":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeSponsorshipUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.Edict", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.FeeSponsorshipUsage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7626,7 +7820,7 @@ func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeSponsorshipUsage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7637,7 +7831,7 @@ func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeSponsorshipUsage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7649,7 +7843,7 @@ func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Edict) IsValid() bool {
+func (x *fastReflection_FeeSponsorshipUsage) IsValid() bool {
 	return x != nil
 }
 
@@ -7659,9 +7853,9 @@ func (x *fastReflection_Edict) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeSponsorshipUsage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*FeeSponsorshipUsage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7673,16 +7867,22 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != nil {
-			l = options.Size(x.Id)
+		l = len(x.PolicyId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
-		if l > 0 {
+		if x.EpochStartTime != nil {
+			l = options.Size(x.EpochStartTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Output != 0 {
-			n += 1 + runtime.Sov(uint64(x.Output))
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TxCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7694,7 +7894,7 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*FeeSponsorshipUsage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7713,20 +7913,29 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Output != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Output))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if x.TxCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxCount))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x20
 		}
-		if x.Id != nil {
-			encoded, err := options.Marshal(x.Id)
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.EpochStartTime != nil {
+			encoded, err := options.Marshal(x.EpochStartTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7737,6 +7946,13 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PolicyId) > 0 {
+			i -= len(x.PolicyId)
+			copy(dAtA[i:], x.PolicyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolicyId)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -7750,7 +7966,7 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*FeeSponsorshipUsage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7782,15 +7998,47 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipUsage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolicyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7817,18 +8065,18 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Id == nil {
-					x.Id = &RuneId{}
+				if x.EpochStartTime == nil {
+					x.EpochStartTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Id); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochStartTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7838,29 +8086,31 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Spent = append(x.Spent, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 				}
-				x.Output = 0
+				x.TxCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7870,7 +8120,7 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Output |= uint32(b&0x7F) << shift
+					x.TxCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -7910,28 +8160,85 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_FeeSponsorshipAccountUsage_4_list)(nil)
+
+type _FeeSponsorshipAccountUsage_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipAccountUsage_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BtcConsolidation                  protoreflect.MessageDescriptor
-	fd_BtcConsolidation_target_threshold protoreflect.FieldDescriptor
-	fd_BtcConsolidation_max_num          protoreflect.FieldDescriptor
+	md_FeeSponsorshipAccountUsage                  protoreflect.MessageDescriptor
+	fd_FeeSponsorshipAccountUsage_policy_id        protoreflect.FieldDescriptor
+	fd_FeeSponsorshipAccountUsage_address          protoreflect.FieldDescriptor
+	fd_FeeSponsorshipAccountUsage_epoch_start_time protoreflect.FieldDescriptor
+	fd_FeeSponsorshipAccountUsage_spent            protoreflect.FieldDescriptor
+	fd_FeeSponsorshipAccountUsage_tx_count         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_BtcConsolidation = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("BtcConsolidation")
-	fd_BtcConsolidation_target_threshold = md_BtcConsolidation.Fields().ByName("target_threshold")
-	fd_BtcConsolidation_max_num = md_BtcConsolidation.Fields().ByName("max_num")
+	md_FeeSponsorshipAccountUsage = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("FeeSponsorshipAccountUsage")
+	fd_FeeSponsorshipAccountUsage_policy_id = md_FeeSponsorshipAccountUsage.Fields().ByName("policy_id")
+	fd_FeeSponsorshipAccountUsage_address = md_FeeSponsorshipAccountUsage.Fields().ByName("address")
+	fd_FeeSponsorshipAccountUsage_epoch_start_time = md_FeeSponsorshipAccountUsage.Fields().ByName("epoch_start_time")
+	fd_FeeSponsorshipAccountUsage_spent = md_FeeSponsorshipAccountUsage.Fields().ByName("spent")
+	fd_FeeSponsorshipAccountUsage_tx_count = md_FeeSponsorshipAccountUsage.Fields().ByName("tx_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_BtcConsolidation)(nil)
+var _ protoreflect.Message = (*fastReflection_FeeSponsorshipAccountUsage)(nil)
 
-type fastReflection_BtcConsolidation BtcConsolidation
+type fastReflection_FeeSponsorshipAccountUsage FeeSponsorshipAccountUsage
 
-func (x *BtcConsolidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BtcConsolidation)(x)
+func (x *FeeSponsorshipAccountUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipAccountUsage)(x)
 }
 
-func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
+func (x *FeeSponsorshipAccountUsage) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7943,43 +8250,43 @@ func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BtcConsolidation_messageType fastReflection_BtcConsolidation_messageType
-var _ protoreflect.MessageType = fastReflection_BtcConsolidation_messageType{}
+var _fastReflection_FeeSponsorshipAccountUsage_messageType fastReflection_FeeSponsorshipAccountUsage_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSponsorshipAccountUsage_messageType{}
 
-type fastReflection_BtcConsolidation_messageType struct{}
+type fastReflection_FeeSponsorshipAccountUsage_messageType struct{}
 
-func (x fastReflection_BtcConsolidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BtcConsolidation)(nil)
+func (x fastReflection_FeeSponsorshipAccountUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipAccountUsage)(nil)
 }
-func (x fastReflection_BtcConsolidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_BtcConsolidation)
+func (x fastReflection_FeeSponsorshipAccountUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipAccountUsage)
 }
-func (x fastReflection_BtcConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BtcConsolidation
+func (x fastReflection_FeeSponsorshipAccountUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipAccountUsage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BtcConsolidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_BtcConsolidation
+func (x *fastReflection_FeeSponsorshipAccountUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipAccountUsage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BtcConsolidation) Type() protoreflect.MessageType {
-	return _fastReflection_BtcConsolidation_messageType
+func (x *fastReflection_FeeSponsorshipAccountUsage) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSponsorshipAccountUsage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BtcConsolidation) New() protoreflect.Message {
-	return new(fastReflection_BtcConsolidation)
+func (x *fastReflection_FeeSponsorshipAccountUsage) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipAccountUsage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage {
-	return (*BtcConsolidation)(x)
+func (x *fastReflection_FeeSponsorshipAccountUsage) Interface() protoreflect.ProtoMessage {
+	return (*FeeSponsorshipAccountUsage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7987,16 +8294,34 @@ func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TargetThreshold != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TargetThreshold)
-		if !f(fd_BtcConsolidation_target_threshold, value) {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PolicyId != "" {
+		value := protoreflect.ValueOfString(x.PolicyId)
+		if !f(fd_FeeSponsorshipAccountUsage_policy_id, value) {
 			return
 		}
 	}
-	if x.MaxNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxNum)
-		if !f(fd_BtcConsolidation_max_num, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_FeeSponsorshipAccountUsage_address, value) {
+			return
+		}
+	}
+	if x.EpochStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.EpochStartTime.ProtoReflect())
+		if !f(fd_FeeSponsorshipAccountUsage_epoch_start_time, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipAccountUsage_4_list{list: &x.Spent})
+		if !f(fd_FeeSponsorshipAccountUsage_spent, value) {
+			return
+		}
+	}
+	if x.TxCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TxCount)
+		if !f(fd_FeeSponsorshipAccountUsage_tx_count, value) {
 			return
 		}
 	}
@@ -8013,17 +8338,23 @@ func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		return x.TargetThreshold != int64(0)
-	case "bitway.btcbridge.BtcConsolidation.max_num":
-		return x.MaxNum != uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		return x.PolicyId != ""
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		return x.Address != ""
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		return x.EpochStartTime != nil
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		return len(x.Spent) != 0
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
+		return x.TxCount != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8033,17 +8364,23 @@ func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		x.TargetThreshold = int64(0)
-	case "bitway.btcbridge.BtcConsolidation.max_num":
-		x.MaxNum = uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		x.PolicyId = ""
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		x.Address = ""
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		x.EpochStartTime = nil
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		x.Spent = nil
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
+		x.TxCount = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8053,19 +8390,31 @@ func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		value := x.TargetThreshold
-		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.BtcConsolidation.max_num":
-		value := x.MaxNum
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		value := x.PolicyId
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		value := x.EpochStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipAccountUsage_4_list{})
+		}
+		listValue := &_FeeSponsorshipAccountUsage_4_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
+		value := x.TxCount
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8079,17 +8428,25 @@ func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		x.TargetThreshold = value.Int()
-	case "bitway.btcbridge.BtcConsolidation.max_num":
-		x.MaxNum = uint32(value.Uint())
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		x.PolicyId = value.Interface().(string)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		x.Address = value.Interface().(string)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		x.EpochStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipAccountUsage_4_list)
+		x.Spent = *clv.list
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
+		x.TxCount = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8103,44 +8460,65 @@ func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipAccountUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		panic(fmt.Errorf("field target_threshold of message bitway.btcbridge.BtcConsolidation is not mutable"))
-	case "bitway.btcbridge.BtcConsolidation.max_num":
-		panic(fmt.Errorf("field max_num of message bitway.btcbridge.BtcConsolidation is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		if x.EpochStartTime == nil {
+			x.EpochStartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EpochStartTime.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta1.Coin{}
+		}
+		value := &_FeeSponsorshipAccountUsage_4_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		panic(fmt.Errorf("field policy_id of message bitway.btcbridge.FeeSponsorshipAccountUsage is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		panic(fmt.Errorf("field address of message bitway.btcbridge.FeeSponsorshipAccountUsage is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
+		panic(fmt.Errorf("field tx_count of message bitway.btcbridge.FeeSponsorshipAccountUsage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BtcConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeSponsorshipAccountUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.BtcConsolidation.target_threshold":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.BtcConsolidation.max_num":
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.policy_id":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.address":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.spent":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipAccountUsage_4_list{list: &list})
+	case "bitway.btcbridge.FeeSponsorshipAccountUsage.tx_count":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipAccountUsage"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipAccountUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeSponsorshipAccountUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.BtcConsolidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.FeeSponsorshipAccountUsage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8148,7 +8526,7 @@ func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeSponsorshipAccountUsage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8159,7 +8537,7 @@ func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeSponsorshipAccountUsage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8171,7 +8549,7 @@ func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BtcConsolidation) IsValid() bool {
+func (x *fastReflection_FeeSponsorshipAccountUsage) IsValid() bool {
 	return x != nil
 }
 
@@ -8181,9 +8559,9 @@ func (x *fastReflection_BtcConsolidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeSponsorshipAccountUsage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*FeeSponsorshipAccountUsage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8195,11 +8573,26 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TargetThreshold != 0 {
-			n += 1 + runtime.Sov(uint64(x.TargetThreshold))
+		l = len(x.PolicyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochStartTime != nil {
+			l = options.Size(x.EpochStartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TxCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8211,7 +8604,7 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*FeeSponsorshipAccountUsage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8230,28 +8623,67 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.TargetThreshold != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetThreshold))
+		if x.TxCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxCount))
 			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+			dAtA[i] = 0x28
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.EpochStartTime != nil {
+			encoded, err := options.Marshal(x.EpochStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PolicyId) > 0 {
+			i -= len(x.PolicyId)
+			copy(dAtA[i:], x.PolicyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolicyId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*FeeSponsorshipAccountUsage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8283,17 +8715,17 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipAccountUsage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipAccountUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 				}
-				x.TargetThreshold = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8303,16 +8735,131 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TargetThreshold |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolicyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EpochStartTime == nil {
+					x.EpochStartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochStartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 				}
-				x.MaxNum = 0
+				x.TxCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8322,7 +8869,7 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxNum |= uint32(b&0x7F) << shift
+					x.TxCount |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8363,29 +8910,33 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RunesConsolidation                  protoreflect.MessageDescriptor
-	fd_RunesConsolidation_rune_id          protoreflect.FieldDescriptor
-	fd_RunesConsolidation_target_threshold protoreflect.FieldDescriptor
-	fd_RunesConsolidation_max_num          protoreflect.FieldDescriptor
+	md_RuneEtching              protoreflect.MessageDescriptor
+	fd_RuneEtching_id           protoreflect.FieldDescriptor
+	fd_RuneEtching_name         protoreflect.FieldDescriptor
+	fd_RuneEtching_spacers      protoreflect.FieldDescriptor
+	fd_RuneEtching_symbol       protoreflect.FieldDescriptor
+	fd_RuneEtching_divisibility protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_RunesConsolidation = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RunesConsolidation")
-	fd_RunesConsolidation_rune_id = md_RunesConsolidation.Fields().ByName("rune_id")
-	fd_RunesConsolidation_target_threshold = md_RunesConsolidation.Fields().ByName("target_threshold")
-	fd_RunesConsolidation_max_num = md_RunesConsolidation.Fields().ByName("max_num")
+	md_RuneEtching = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RuneEtching")
+	fd_RuneEtching_id = md_RuneEtching.Fields().ByName("id")
+	fd_RuneEtching_name = md_RuneEtching.Fields().ByName("name")
+	fd_RuneEtching_spacers = md_RuneEtching.Fields().ByName("spacers")
+	fd_RuneEtching_symbol = md_RuneEtching.Fields().ByName("symbol")
+	fd_RuneEtching_divisibility = md_RuneEtching.Fields().ByName("divisibility")
 }
 
-var _ protoreflect.Message = (*fastReflection_RunesConsolidation)(nil)
+var _ protoreflect.Message = (*fastReflection_RuneEtching)(nil)
 
-type fastReflection_RunesConsolidation RunesConsolidation
+type fastReflection_RuneEtching RuneEtching
 
-func (x *RunesConsolidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RunesConsolidation)(x)
+func (x *RuneEtching) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneEtching)(x)
 }
 
-func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
+func (x *RuneEtching) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8397,43 +8948,43 @@ func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RunesConsolidation_messageType fastReflection_RunesConsolidation_messageType
-var _ protoreflect.MessageType = fastReflection_RunesConsolidation_messageType{}
+var _fastReflection_RuneEtching_messageType fastReflection_RuneEtching_messageType
+var _ protoreflect.MessageType = fastReflection_RuneEtching_messageType{}
 
-type fastReflection_RunesConsolidation_messageType struct{}
+type fastReflection_RuneEtching_messageType struct{}
 
-func (x fastReflection_RunesConsolidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RunesConsolidation)(nil)
+func (x fastReflection_RuneEtching_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneEtching)(nil)
 }
-func (x fastReflection_RunesConsolidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_RunesConsolidation)
+func (x fastReflection_RuneEtching_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneEtching)
 }
-func (x fastReflection_RunesConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RunesConsolidation
+func (x fastReflection_RuneEtching_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneEtching
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RunesConsolidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_RunesConsolidation
+func (x *fastReflection_RuneEtching) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneEtching
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RunesConsolidation) Type() protoreflect.MessageType {
-	return _fastReflection_RunesConsolidation_messageType
+func (x *fastReflection_RuneEtching) Type() protoreflect.MessageType {
+	return _fastReflection_RuneEtching_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RunesConsolidation) New() protoreflect.Message {
-	return new(fastReflection_RunesConsolidation)
+func (x *fastReflection_RuneEtching) New() protoreflect.Message {
+	return new(fastReflection_RuneEtching)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessage {
-	return (*RunesConsolidation)(x)
+func (x *fastReflection_RuneEtching) Interface() protoreflect.ProtoMessage {
+	return (*RuneEtching)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8441,22 +8992,34 @@ func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RuneId != "" {
-		value := protoreflect.ValueOfString(x.RuneId)
-		if !f(fd_RunesConsolidation_rune_id, value) {
+func (x *fastReflection_RuneEtching) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_RuneEtching_id, value) {
 			return
 		}
 	}
-	if x.TargetThreshold != "" {
-		value := protoreflect.ValueOfString(x.TargetThreshold)
-		if !f(fd_RunesConsolidation_target_threshold, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_RuneEtching_name, value) {
 			return
 		}
 	}
-	if x.MaxNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxNum)
-		if !f(fd_RunesConsolidation_max_num, value) {
+	if x.Spacers != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Spacers)
+		if !f(fd_RuneEtching_spacers, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_RuneEtching_symbol, value) {
+			return
+		}
+	}
+	if x.Divisibility != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Divisibility)
+		if !f(fd_RuneEtching_divisibility, value) {
 			return
 		}
 	}
@@ -8473,19 +9036,23 @@ func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RuneEtching) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
-		return x.RuneId != ""
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
-		return x.TargetThreshold != ""
-	case "bitway.btcbridge.RunesConsolidation.max_num":
-		return x.MaxNum != uint32(0)
+	case "bitway.btcbridge.RuneEtching.id":
+		return x.Id != ""
+	case "bitway.btcbridge.RuneEtching.name":
+		return x.Name != ""
+	case "bitway.btcbridge.RuneEtching.spacers":
+		return x.Spacers != uint32(0)
+	case "bitway.btcbridge.RuneEtching.symbol":
+		return x.Symbol != ""
+	case "bitway.btcbridge.RuneEtching.divisibility":
+		return x.Divisibility != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8495,19 +9062,23 @@ func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RuneEtching) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
-		x.RuneId = ""
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
-		x.TargetThreshold = ""
-	case "bitway.btcbridge.RunesConsolidation.max_num":
-		x.MaxNum = uint32(0)
+	case "bitway.btcbridge.RuneEtching.id":
+		x.Id = ""
+	case "bitway.btcbridge.RuneEtching.name":
+		x.Name = ""
+	case "bitway.btcbridge.RuneEtching.spacers":
+		x.Spacers = uint32(0)
+	case "bitway.btcbridge.RuneEtching.symbol":
+		x.Symbol = ""
+	case "bitway.btcbridge.RuneEtching.divisibility":
+		x.Divisibility = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8517,22 +9088,28 @@ func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtching) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
-		value := x.RuneId
+	case "bitway.btcbridge.RuneEtching.id":
+		value := x.Id
 		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
-		value := x.TargetThreshold
+	case "bitway.btcbridge.RuneEtching.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.RunesConsolidation.max_num":
-		value := x.MaxNum
+	case "bitway.btcbridge.RuneEtching.spacers":
+		value := x.Spacers
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.RuneEtching.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RuneEtching.divisibility":
+		value := x.Divisibility
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8546,19 +9123,23 @@ func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RuneEtching) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
-		x.RuneId = value.Interface().(string)
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
-		x.TargetThreshold = value.Interface().(string)
-	case "bitway.btcbridge.RunesConsolidation.max_num":
-		x.MaxNum = uint32(value.Uint())
+	case "bitway.btcbridge.RuneEtching.id":
+		x.Id = value.Interface().(string)
+	case "bitway.btcbridge.RuneEtching.name":
+		x.Name = value.Interface().(string)
+	case "bitway.btcbridge.RuneEtching.spacers":
+		x.Spacers = uint32(value.Uint())
+	case "bitway.btcbridge.RuneEtching.symbol":
+		x.Symbol = value.Interface().(string)
+	case "bitway.btcbridge.RuneEtching.divisibility":
+		x.Divisibility = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8572,48 +9153,56 @@ func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtching) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
-		panic(fmt.Errorf("field rune_id of message bitway.btcbridge.RunesConsolidation is not mutable"))
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
-		panic(fmt.Errorf("field target_threshold of message bitway.btcbridge.RunesConsolidation is not mutable"))
-	case "bitway.btcbridge.RunesConsolidation.max_num":
-		panic(fmt.Errorf("field max_num of message bitway.btcbridge.RunesConsolidation is not mutable"))
+	case "bitway.btcbridge.RuneEtching.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.RuneEtching is not mutable"))
+	case "bitway.btcbridge.RuneEtching.name":
+		panic(fmt.Errorf("field name of message bitway.btcbridge.RuneEtching is not mutable"))
+	case "bitway.btcbridge.RuneEtching.spacers":
+		panic(fmt.Errorf("field spacers of message bitway.btcbridge.RuneEtching is not mutable"))
+	case "bitway.btcbridge.RuneEtching.symbol":
+		panic(fmt.Errorf("field symbol of message bitway.btcbridge.RuneEtching is not mutable"))
+	case "bitway.btcbridge.RuneEtching.divisibility":
+		panic(fmt.Errorf("field divisibility of message bitway.btcbridge.RuneEtching is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RunesConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtching) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RunesConsolidation.rune_id":
+	case "bitway.btcbridge.RuneEtching.id":
 		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+	case "bitway.btcbridge.RuneEtching.name":
 		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.RunesConsolidation.max_num":
+	case "bitway.btcbridge.RuneEtching.spacers":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.RuneEtching.symbol":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RuneEtching.divisibility":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtching"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtching does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RuneEtching) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RunesConsolidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RuneEtching", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8621,7 +9210,7 @@ func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RuneEtching) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8632,7 +9221,7 @@ func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RuneEtching) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8644,7 +9233,7 @@ func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RunesConsolidation) IsValid() bool {
+func (x *fastReflection_RuneEtching) IsValid() bool {
 	return x != nil
 }
 
@@ -8654,9 +9243,9 @@ func (x *fastReflection_RunesConsolidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RuneEtching) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*RuneEtching)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8668,16 +9257,23 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RuneId)
+		l = len(x.Id)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TargetThreshold)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		if x.Spacers != 0 {
+			n += 1 + runtime.Sov(uint64(x.Spacers))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Divisibility != 0 {
+			n += 1 + runtime.Sov(uint64(x.Divisibility))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8689,7 +9285,7 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*RuneEtching)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8708,22 +9304,34 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
+		if x.Divisibility != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Divisibility))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Spacers != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Spacers))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.TargetThreshold) > 0 {
-			i -= len(x.TargetThreshold)
-			copy(dAtA[i:], x.TargetThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetThreshold)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.RuneId) > 0 {
-			i -= len(x.RuneId)
-			copy(dAtA[i:], x.RuneId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneId)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8738,7 +9346,7 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*RuneEtching)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8770,15 +9378,15 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneEtching: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneEtching: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8806,11 +9414,11 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RuneId = string(dAtA[iNdEx:postIndex])
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8838,13 +9446,13 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TargetThreshold = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spacers", wireType)
 				}
-				x.MaxNum = 0
+				x.Spacers = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8854,7 +9462,58 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxNum |= uint32(b&0x7F) << shift
+					x.Spacers |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
+				}
+				x.Divisibility = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Divisibility |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8895,29 +9554,27 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_DKGParticipant                  protoreflect.MessageDescriptor
-	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
-	fd_DKGParticipant_operator_address protoreflect.FieldDescriptor
-	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
+	md_RuneEtchingSubmission         protoreflect.MessageDescriptor
+	fd_RuneEtchingSubmission_relayer protoreflect.FieldDescriptor
+	fd_RuneEtchingSubmission_etching protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_DKGParticipant = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGParticipant")
-	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
-	fd_DKGParticipant_operator_address = md_DKGParticipant.Fields().ByName("operator_address")
-	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
+	md_RuneEtchingSubmission = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RuneEtchingSubmission")
+	fd_RuneEtchingSubmission_relayer = md_RuneEtchingSubmission.Fields().ByName("relayer")
+	fd_RuneEtchingSubmission_etching = md_RuneEtchingSubmission.Fields().ByName("etching")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
+var _ protoreflect.Message = (*fastReflection_RuneEtchingSubmission)(nil)
 
-type fastReflection_DKGParticipant DKGParticipant
+type fastReflection_RuneEtchingSubmission RuneEtchingSubmission
 
-func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(x)
+func (x *RuneEtchingSubmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneEtchingSubmission)(x)
 }
 
-func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
+func (x *RuneEtchingSubmission) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8929,43 +9586,43 @@ func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
-var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
+var _fastReflection_RuneEtchingSubmission_messageType fastReflection_RuneEtchingSubmission_messageType
+var _ protoreflect.MessageType = fastReflection_RuneEtchingSubmission_messageType{}
 
-type fastReflection_DKGParticipant_messageType struct{}
+type fastReflection_RuneEtchingSubmission_messageType struct{}
 
-func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(nil)
+func (x fastReflection_RuneEtchingSubmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneEtchingSubmission)(nil)
 }
-func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
+func (x fastReflection_RuneEtchingSubmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneEtchingSubmission)
 }
-func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
+func (x fastReflection_RuneEtchingSubmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneEtchingSubmission
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
+func (x *fastReflection_RuneEtchingSubmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneEtchingSubmission
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
-	return _fastReflection_DKGParticipant_messageType
+func (x *fastReflection_RuneEtchingSubmission) Type() protoreflect.MessageType {
+	return _fastReflection_RuneEtchingSubmission_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
+func (x *fastReflection_RuneEtchingSubmission) New() protoreflect.Message {
+	return new(fastReflection_RuneEtchingSubmission)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
-	return (*DKGParticipant)(x)
+func (x *fastReflection_RuneEtchingSubmission) Interface() protoreflect.ProtoMessage {
+	return (*RuneEtchingSubmission)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8973,22 +9630,16 @@ func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Moniker != "" {
-		value := protoreflect.ValueOfString(x.Moniker)
-		if !f(fd_DKGParticipant_moniker, value) {
-			return
-		}
-	}
-	if x.OperatorAddress != "" {
-		value := protoreflect.ValueOfString(x.OperatorAddress)
-		if !f(fd_DKGParticipant_operator_address, value) {
+func (x *fastReflection_RuneEtchingSubmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_RuneEtchingSubmission_relayer, value) {
 			return
 		}
 	}
-	if x.ConsensusPubkey != "" {
-		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_DKGParticipant_consensus_pubkey, value) {
+	if x.Etching != nil {
+		value := protoreflect.ValueOfMessage(x.Etching.ProtoReflect())
+		if !f(fd_RuneEtchingSubmission_etching, value) {
 			return
 		}
 	}
@@ -9005,19 +9656,17 @@ func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RuneEtchingSubmission) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		return x.Moniker != ""
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		return x.OperatorAddress != ""
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
-		return x.ConsensusPubkey != ""
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
+		return x.Relayer != ""
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		return x.Etching != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9027,19 +9676,17 @@ func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RuneEtchingSubmission) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		x.Moniker = ""
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		x.OperatorAddress = ""
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = ""
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
+		x.Relayer = ""
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		x.Etching = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9049,22 +9696,19 @@ func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtchingSubmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		value := x.Moniker
-		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		value := x.OperatorAddress
-		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
-		value := x.ConsensusPubkey
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
+		value := x.Relayer
 		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		value := x.Etching
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9078,19 +9722,17 @@ func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RuneEtchingSubmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		x.Moniker = value.Interface().(string)
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		x.OperatorAddress = value.Interface().(string)
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
+		x.Relayer = value.Interface().(string)
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		x.Etching = value.Message().Interface().(*RuneEtching)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9104,48 +9746,48 @@ func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtchingSubmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		panic(fmt.Errorf("field moniker of message bitway.btcbridge.DKGParticipant is not mutable"))
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		panic(fmt.Errorf("field operator_address of message bitway.btcbridge.DKGParticipant is not mutable"))
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.DKGParticipant is not mutable"))
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		if x.Etching == nil {
+			x.Etching = new(RuneEtching)
+		}
+		return protoreflect.ValueOfMessage(x.Etching.ProtoReflect())
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
+		panic(fmt.Errorf("field relayer of message bitway.btcbridge.RuneEtchingSubmission is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneEtchingSubmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGParticipant.moniker":
-		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.DKGParticipant.operator_address":
-		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
+	case "bitway.btcbridge.RuneEtchingSubmission.relayer":
 		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RuneEtchingSubmission.etching":
+		m := new(RuneEtching)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RuneEtchingSubmission"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RuneEtchingSubmission does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RuneEtchingSubmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGParticipant", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RuneEtchingSubmission", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9153,7 +9795,7 @@ func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RuneEtchingSubmission) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9164,7 +9806,7 @@ func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RuneEtchingSubmission) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9176,7 +9818,7 @@ func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGParticipant) IsValid() bool {
+func (x *fastReflection_RuneEtchingSubmission) IsValid() bool {
 	return x != nil
 }
 
@@ -9186,9 +9828,9 @@ func (x *fastReflection_DKGParticipant) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RuneEtchingSubmission) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneEtchingSubmission)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9200,16 +9842,12 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Moniker)
+		l = len(x.Relayer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.OperatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConsensusPubkey)
-		if l > 0 {
+		if x.Etching != nil {
+			l = options.Size(x.Etching)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9222,7 +9860,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneEtchingSubmission)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9241,24 +9879,24 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ConsensusPubkey) > 0 {
-			i -= len(x.ConsensusPubkey)
-			copy(dAtA[i:], x.ConsensusPubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.OperatorAddress) > 0 {
-			i -= len(x.OperatorAddress)
-			copy(dAtA[i:], x.OperatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+		if x.Etching != nil {
+			encoded, err := options.Marshal(x.Etching)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Moniker) > 0 {
-			i -= len(x.Moniker)
-			copy(dAtA[i:], x.Moniker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9273,7 +9911,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneEtchingSubmission)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9305,15 +9943,15 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneEtchingSubmission: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneEtchingSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9341,13 +9979,13 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Moniker = string(dAtA[iNdEx:postIndex])
+				x.Relayer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Etching", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9357,55 +9995,27 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Etching == nil {
+					x.Etching = &RuneEtching{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Etching); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9442,137 +10052,30 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DKGRequest_2_list)(nil)
-
-type _DKGRequest_2_list struct {
-	list *[]*DKGParticipant
-}
-
-func (x *_DKGRequest_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGRequest_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGRequest_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGRequest_2_list) AppendMutable() protoreflect.Value {
-	v := new(DKGParticipant)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGRequest_2_list) NewElement() protoreflect.Value {
-	v := new(DKGParticipant)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_DKGRequest_4_list)(nil)
-
-type _DKGRequest_4_list struct {
-	list *[]AssetType
-}
-
-func (x *_DKGRequest_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_DKGRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (AssetType)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (AssetType)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGRequest_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DKGRequest at list field VaultTypes as it is not of Message kind"))
-}
-
-func (x *_DKGRequest_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGRequest_4_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_DKGRequest_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DKGRequest                 protoreflect.MessageDescriptor
-	fd_DKGRequest_id              protoreflect.FieldDescriptor
-	fd_DKGRequest_participants    protoreflect.FieldDescriptor
-	fd_DKGRequest_threshold       protoreflect.FieldDescriptor
-	fd_DKGRequest_vault_types     protoreflect.FieldDescriptor
-	fd_DKGRequest_enable_transfer protoreflect.FieldDescriptor
-	fd_DKGRequest_target_utxo_num protoreflect.FieldDescriptor
-	fd_DKGRequest_expiration      protoreflect.FieldDescriptor
-	fd_DKGRequest_status          protoreflect.FieldDescriptor
+	md_Edict        protoreflect.MessageDescriptor
+	fd_Edict_id     protoreflect.FieldDescriptor
+	fd_Edict_amount protoreflect.FieldDescriptor
+	fd_Edict_output protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_DKGRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGRequest")
-	fd_DKGRequest_id = md_DKGRequest.Fields().ByName("id")
-	fd_DKGRequest_participants = md_DKGRequest.Fields().ByName("participants")
-	fd_DKGRequest_threshold = md_DKGRequest.Fields().ByName("threshold")
-	fd_DKGRequest_vault_types = md_DKGRequest.Fields().ByName("vault_types")
-	fd_DKGRequest_enable_transfer = md_DKGRequest.Fields().ByName("enable_transfer")
-	fd_DKGRequest_target_utxo_num = md_DKGRequest.Fields().ByName("target_utxo_num")
-	fd_DKGRequest_expiration = md_DKGRequest.Fields().ByName("expiration")
-	fd_DKGRequest_status = md_DKGRequest.Fields().ByName("status")
+	md_Edict = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("Edict")
+	fd_Edict_id = md_Edict.Fields().ByName("id")
+	fd_Edict_amount = md_Edict.Fields().ByName("amount")
+	fd_Edict_output = md_Edict.Fields().ByName("output")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_Edict)(nil)
 
-type fastReflection_DKGRequest DKGRequest
+type fastReflection_Edict Edict
 
-func (x *DKGRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGRequest)(x)
+func (x *Edict) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Edict)(x)
 }
 
-func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
+func (x *Edict) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9584,43 +10087,43 @@ func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGRequest_messageType fastReflection_DKGRequest_messageType
-var _ protoreflect.MessageType = fastReflection_DKGRequest_messageType{}
+var _fastReflection_Edict_messageType fastReflection_Edict_messageType
+var _ protoreflect.MessageType = fastReflection_Edict_messageType{}
 
-type fastReflection_DKGRequest_messageType struct{}
+type fastReflection_Edict_messageType struct{}
 
-func (x fastReflection_DKGRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGRequest)(nil)
+func (x fastReflection_Edict_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Edict)(nil)
 }
-func (x fastReflection_DKGRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGRequest)
+func (x fastReflection_Edict_messageType) New() protoreflect.Message {
+	return new(fastReflection_Edict)
 }
-func (x fastReflection_DKGRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGRequest
+func (x fastReflection_Edict_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Edict
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGRequest
+func (x *fastReflection_Edict) Descriptor() protoreflect.MessageDescriptor {
+	return md_Edict
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGRequest) Type() protoreflect.MessageType {
-	return _fastReflection_DKGRequest_messageType
+func (x *fastReflection_Edict) Type() protoreflect.MessageType {
+	return _fastReflection_Edict_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGRequest) New() protoreflect.Message {
-	return new(fastReflection_DKGRequest)
+func (x *fastReflection_Edict) New() protoreflect.Message {
+	return new(fastReflection_Edict)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
-	return (*DKGRequest)(x)
+func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
+	return (*Edict)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9628,52 +10131,22 @@ func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_DKGRequest_id, value) {
-			return
-		}
-	}
-	if len(x.Participants) != 0 {
-		value := protoreflect.ValueOfList(&_DKGRequest_2_list{list: &x.Participants})
-		if !f(fd_DKGRequest_participants, value) {
-			return
-		}
-	}
-	if x.Threshold != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Threshold)
-		if !f(fd_DKGRequest_threshold, value) {
-			return
-		}
-	}
-	if len(x.VaultTypes) != 0 {
-		value := protoreflect.ValueOfList(&_DKGRequest_4_list{list: &x.VaultTypes})
-		if !f(fd_DKGRequest_vault_types, value) {
-			return
-		}
-	}
-	if x.EnableTransfer != false {
-		value := protoreflect.ValueOfBool(x.EnableTransfer)
-		if !f(fd_DKGRequest_enable_transfer, value) {
-			return
-		}
-	}
-	if x.TargetUtxoNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.TargetUtxoNum)
-		if !f(fd_DKGRequest_target_utxo_num, value) {
+func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != nil {
+		value := protoreflect.ValueOfMessage(x.Id.ProtoReflect())
+		if !f(fd_Edict_id, value) {
 			return
 		}
 	}
-	if x.Expiration != nil {
-		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-		if !f(fd_DKGRequest_expiration, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Edict_amount, value) {
 			return
 		}
 	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_DKGRequest_status, value) {
+	if x.Output != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Output)
+		if !f(fd_Edict_output, value) {
 			return
 		}
 	}
@@ -9690,29 +10163,19 @@ func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGRequest.id":
-		return x.Id != uint64(0)
-	case "bitway.btcbridge.DKGRequest.participants":
-		return len(x.Participants) != 0
-	case "bitway.btcbridge.DKGRequest.threshold":
-		return x.Threshold != uint32(0)
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		return len(x.VaultTypes) != 0
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		return x.EnableTransfer != false
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		return x.TargetUtxoNum != uint32(0)
-	case "bitway.btcbridge.DKGRequest.expiration":
-		return x.Expiration != nil
-	case "bitway.btcbridge.DKGRequest.status":
-		return x.Status != 0
+	case "bitway.btcbridge.Edict.id":
+		return x.Id != nil
+	case "bitway.btcbridge.Edict.amount":
+		return x.Amount != ""
+	case "bitway.btcbridge.Edict.output":
+		return x.Output != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9722,29 +10185,19 @@ func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGRequest.id":
-		x.Id = uint64(0)
-	case "bitway.btcbridge.DKGRequest.participants":
-		x.Participants = nil
-	case "bitway.btcbridge.DKGRequest.threshold":
-		x.Threshold = uint32(0)
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		x.VaultTypes = nil
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		x.EnableTransfer = false
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		x.TargetUtxoNum = uint32(0)
-	case "bitway.btcbridge.DKGRequest.expiration":
-		x.Expiration = nil
-	case "bitway.btcbridge.DKGRequest.status":
-		x.Status = 0
+	case "bitway.btcbridge.Edict.id":
+		x.Id = nil
+	case "bitway.btcbridge.Edict.amount":
+		x.Amount = ""
+	case "bitway.btcbridge.Edict.output":
+		x.Output = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9754,43 +10207,22 @@ func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.DKGRequest.id":
+	case "bitway.btcbridge.Edict.id":
 		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.DKGRequest.participants":
-		if len(x.Participants) == 0 {
-			return protoreflect.ValueOfList(&_DKGRequest_2_list{})
-		}
-		listValue := &_DKGRequest_2_list{list: &x.Participants}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.DKGRequest.threshold":
-		value := x.Threshold
-		return protoreflect.ValueOfUint32(value)
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		if len(x.VaultTypes) == 0 {
-			return protoreflect.ValueOfList(&_DKGRequest_4_list{})
-		}
-		listValue := &_DKGRequest_4_list{list: &x.VaultTypes}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		value := x.EnableTransfer
-		return protoreflect.ValueOfBool(value)
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		value := x.TargetUtxoNum
-		return protoreflect.ValueOfUint32(value)
-	case "bitway.btcbridge.DKGRequest.expiration":
-		value := x.Expiration
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.DKGRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.Edict.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.Edict.output":
+		value := x.Output
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9804,33 +10236,19 @@ func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGRequest.id":
-		x.Id = value.Uint()
-	case "bitway.btcbridge.DKGRequest.participants":
-		lv := value.List()
-		clv := lv.(*_DKGRequest_2_list)
-		x.Participants = *clv.list
-	case "bitway.btcbridge.DKGRequest.threshold":
-		x.Threshold = uint32(value.Uint())
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		lv := value.List()
-		clv := lv.(*_DKGRequest_4_list)
-		x.VaultTypes = *clv.list
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		x.EnableTransfer = value.Bool()
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		x.TargetUtxoNum = uint32(value.Uint())
-	case "bitway.btcbridge.DKGRequest.expiration":
-		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
-	case "bitway.btcbridge.DKGRequest.status":
-		x.Status = (DKGRequestStatus)(value.Enum())
+	case "bitway.btcbridge.Edict.id":
+		x.Id = value.Message().Interface().(*RuneId)
+	case "bitway.btcbridge.Edict.amount":
+		x.Amount = value.Interface().(string)
+	case "bitway.btcbridge.Edict.output":
+		x.Output = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9844,82 +10262,52 @@ func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGRequest.participants":
-		if x.Participants == nil {
-			x.Participants = []*DKGParticipant{}
-		}
-		value := &_DKGRequest_2_list{list: &x.Participants}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		if x.VaultTypes == nil {
-			x.VaultTypes = []AssetType{}
-		}
-		value := &_DKGRequest_4_list{list: &x.VaultTypes}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.DKGRequest.expiration":
-		if x.Expiration == nil {
-			x.Expiration = new(timestamppb.Timestamp)
+	case "bitway.btcbridge.Edict.id":
+		if x.Id == nil {
+			x.Id = new(RuneId)
 		}
-		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-	case "bitway.btcbridge.DKGRequest.id":
-		panic(fmt.Errorf("field id of message bitway.btcbridge.DKGRequest is not mutable"))
-	case "bitway.btcbridge.DKGRequest.threshold":
-		panic(fmt.Errorf("field threshold of message bitway.btcbridge.DKGRequest is not mutable"))
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		panic(fmt.Errorf("field enable_transfer of message bitway.btcbridge.DKGRequest is not mutable"))
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		panic(fmt.Errorf("field target_utxo_num of message bitway.btcbridge.DKGRequest is not mutable"))
-	case "bitway.btcbridge.DKGRequest.status":
-		panic(fmt.Errorf("field status of message bitway.btcbridge.DKGRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Id.ProtoReflect())
+	case "bitway.btcbridge.Edict.amount":
+		panic(fmt.Errorf("field amount of message bitway.btcbridge.Edict is not mutable"))
+	case "bitway.btcbridge.Edict.output":
+		panic(fmt.Errorf("field output of message bitway.btcbridge.Edict is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.DKGRequest.participants":
-		list := []*DKGParticipant{}
-		return protoreflect.ValueOfList(&_DKGRequest_2_list{list: &list})
-	case "bitway.btcbridge.DKGRequest.threshold":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "bitway.btcbridge.DKGRequest.vault_types":
-		list := []AssetType{}
-		return protoreflect.ValueOfList(&_DKGRequest_4_list{list: &list})
-	case "bitway.btcbridge.DKGRequest.enable_transfer":
-		return protoreflect.ValueOfBool(false)
-	case "bitway.btcbridge.DKGRequest.target_utxo_num":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "bitway.btcbridge.DKGRequest.expiration":
-		m := new(timestamppb.Timestamp)
+	case "bitway.btcbridge.Edict.id":
+		m := new(RuneId)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.DKGRequest.status":
-		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.Edict.amount":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.Edict.output":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.Edict", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9927,7 +10315,7 @@ func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9938,7 +10326,7 @@ func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9950,7 +10338,7 @@ func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGRequest) IsValid() bool {
+func (x *fastReflection_Edict) IsValid() bool {
 	return x != nil
 }
 
@@ -9960,9 +10348,9 @@ func (x *fastReflection_DKGRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9974,37 +10362,16 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		if len(x.Participants) > 0 {
-			for _, e := range x.Participants {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Threshold != 0 {
-			n += 1 + runtime.Sov(uint64(x.Threshold))
-		}
-		if len(x.VaultTypes) > 0 {
-			l = 0
-			for _, e := range x.VaultTypes {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
-		}
-		if x.EnableTransfer {
-			n += 2
-		}
-		if x.TargetUtxoNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.TargetUtxoNum))
+		if x.Id != nil {
+			l = options.Size(x.Id)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Expiration != nil {
-			l = options.Size(x.Expiration)
+		l = len(x.Amount)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.Output != 0 {
+			n += 1 + runtime.Sov(uint64(x.Output))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10016,7 +10383,7 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10035,13 +10402,20 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+		if x.Output != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Output))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x18
 		}
-		if x.Expiration != nil {
-			encoded, err := options.Marshal(x.Expiration)
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != nil {
+			encoded, err := options.Marshal(x.Id)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10052,69 +10426,7 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x3a
-		}
-		if x.TargetUtxoNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetUtxoNum))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.EnableTransfer {
-			i--
-			if x.EnableTransfer {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.VaultTypes) > 0 {
-			var pksize2 int
-			for _, num := range x.VaultTypes {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num1 := range x.VaultTypes {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Threshold != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Participants) > 0 {
-			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Participants[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10127,7 +10439,7 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10159,34 +10471,15 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10213,16 +10506,18 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Participants = append(x.Participants, &DKGParticipant{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+				if x.Id == nil {
+					x.Id = &RuneId{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Id); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.Threshold = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10232,124 +10527,29 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Threshold |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
-				if wireType == 0 {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.VaultTypes = append(x.VaultTypes, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					if elementCount != 0 && len(x.VaultTypes) == 0 {
-						x.VaultTypes = make([]AssetType, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v AssetType
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= AssetType(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.VaultTypes = append(x.VaultTypes, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EnableTransfer = bool(v != 0)
-			case 6:
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
-				}
-				x.TargetUtxoNum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TargetUtxoNum |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 				}
-				var msglen int
+				x.Output = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10359,61 +10559,25 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Output |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Expiration == nil {
-					x.Expiration = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= DKGRequestStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -10435,80 +10599,28 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DKGCompletionRequest_3_list)(nil)
-
-type _DKGCompletionRequest_3_list struct {
-	list *[]string
-}
-
-func (x *_DKGCompletionRequest_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGCompletionRequest_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_DKGCompletionRequest_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGCompletionRequest_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGCompletionRequest_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field Vaults as it is not of Message kind"))
-}
-
-func (x *_DKGCompletionRequest_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGCompletionRequest_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_DKGCompletionRequest_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DKGCompletionRequest                  protoreflect.MessageDescriptor
-	fd_DKGCompletionRequest_id               protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_sender           protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_vaults           protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_consensus_pubkey protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_signature        protoreflect.FieldDescriptor
+	md_BtcConsolidation                  protoreflect.MessageDescriptor
+	fd_BtcConsolidation_target_threshold protoreflect.FieldDescriptor
+	fd_BtcConsolidation_max_num          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_DKGCompletionRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGCompletionRequest")
-	fd_DKGCompletionRequest_id = md_DKGCompletionRequest.Fields().ByName("id")
-	fd_DKGCompletionRequest_sender = md_DKGCompletionRequest.Fields().ByName("sender")
-	fd_DKGCompletionRequest_vaults = md_DKGCompletionRequest.Fields().ByName("vaults")
-	fd_DKGCompletionRequest_consensus_pubkey = md_DKGCompletionRequest.Fields().ByName("consensus_pubkey")
-	fd_DKGCompletionRequest_signature = md_DKGCompletionRequest.Fields().ByName("signature")
+	md_BtcConsolidation = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("BtcConsolidation")
+	fd_BtcConsolidation_target_threshold = md_BtcConsolidation.Fields().ByName("target_threshold")
+	fd_BtcConsolidation_max_num = md_BtcConsolidation.Fields().ByName("max_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGCompletionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_BtcConsolidation)(nil)
 
-type fastReflection_DKGCompletionRequest DKGCompletionRequest
+type fastReflection_BtcConsolidation BtcConsolidation
 
-func (x *DKGCompletionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGCompletionRequest)(x)
+func (x *BtcConsolidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BtcConsolidation)(x)
 }
 
-func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
+func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10520,43 +10632,43 @@ func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGCompletionRequest_messageType fastReflection_DKGCompletionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_DKGCompletionRequest_messageType{}
+var _fastReflection_BtcConsolidation_messageType fastReflection_BtcConsolidation_messageType
+var _ protoreflect.MessageType = fastReflection_BtcConsolidation_messageType{}
 
-type fastReflection_DKGCompletionRequest_messageType struct{}
+type fastReflection_BtcConsolidation_messageType struct{}
 
-func (x fastReflection_DKGCompletionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGCompletionRequest)(nil)
+func (x fastReflection_BtcConsolidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BtcConsolidation)(nil)
 }
-func (x fastReflection_DKGCompletionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGCompletionRequest)
+func (x fastReflection_BtcConsolidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_BtcConsolidation)
 }
-func (x fastReflection_DKGCompletionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGCompletionRequest
+func (x fastReflection_BtcConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BtcConsolidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGCompletionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGCompletionRequest
+func (x *fastReflection_BtcConsolidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_BtcConsolidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGCompletionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_DKGCompletionRequest_messageType
+func (x *fastReflection_BtcConsolidation) Type() protoreflect.MessageType {
+	return _fastReflection_BtcConsolidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGCompletionRequest) New() protoreflect.Message {
-	return new(fastReflection_DKGCompletionRequest)
+func (x *fastReflection_BtcConsolidation) New() protoreflect.Message {
+	return new(fastReflection_BtcConsolidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMessage {
-	return (*DKGCompletionRequest)(x)
+func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage {
+	return (*BtcConsolidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10564,34 +10676,16 @@ func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_DKGCompletionRequest_id, value) {
-			return
-		}
-	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_DKGCompletionRequest_sender, value) {
-			return
-		}
-	}
-	if len(x.Vaults) != 0 {
-		value := protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &x.Vaults})
-		if !f(fd_DKGCompletionRequest_vaults, value) {
-			return
-		}
-	}
-	if x.ConsensusPubkey != "" {
-		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_DKGCompletionRequest_consensus_pubkey, value) {
+func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetThreshold != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetThreshold)
+		if !f(fd_BtcConsolidation_target_threshold, value) {
 			return
 		}
 	}
-	if x.Signature != "" {
-		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_DKGCompletionRequest_signature, value) {
+	if x.MaxNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNum)
+		if !f(fd_BtcConsolidation_max_num, value) {
 			return
 		}
 	}
@@ -10608,23 +10702,17 @@ func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		return x.Id != uint64(0)
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		return x.Sender != ""
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		return len(x.Vaults) != 0
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		return x.ConsensusPubkey != ""
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		return x.Signature != ""
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		return x.TargetThreshold != int64(0)
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		return x.MaxNum != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10634,23 +10722,17 @@ func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		x.Id = uint64(0)
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		x.Sender = ""
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		x.Vaults = nil
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		x.ConsensusPubkey = ""
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		x.Signature = ""
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		x.TargetThreshold = int64(0)
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		x.MaxNum = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10660,31 +10742,19 @@ func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		value := x.Sender
-		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		if len(x.Vaults) == 0 {
-			return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{})
-		}
-		listValue := &_DKGCompletionRequest_3_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		value := x.ConsensusPubkey
-		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		value := x.Signature
-		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		value := x.TargetThreshold
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		value := x.MaxNum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10698,25 +10768,17 @@ func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		x.Id = value.Uint()
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		x.Sender = value.Interface().(string)
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		lv := value.List()
-		clv := lv.(*_DKGCompletionRequest_3_list)
-		x.Vaults = *clv.list
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		x.ConsensusPubkey = value.Interface().(string)
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		x.Signature = value.Interface().(string)
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		x.TargetThreshold = value.Int()
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		x.MaxNum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10730,61 +10792,44 @@ func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		if x.Vaults == nil {
-			x.Vaults = []string{}
-		}
-		value := &_DKGCompletionRequest_3_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		panic(fmt.Errorf("field id of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		panic(fmt.Errorf("field sender of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		panic(fmt.Errorf("field signature of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		panic(fmt.Errorf("field target_threshold of message bitway.btcbridge.BtcConsolidation is not mutable"))
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		panic(fmt.Errorf("field max_num of message bitway.btcbridge.BtcConsolidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGCompletionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.DKGCompletionRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.DKGCompletionRequest.sender":
-		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.DKGCompletionRequest.vaults":
-		list := []string{}
-		return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &list})
-	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
-		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.DKGCompletionRequest.signature":
-		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.BtcConsolidation.target_threshold":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.BtcConsolidation.max_num":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGCompletionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.BtcConsolidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10792,7 +10837,7 @@ func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10803,7 +10848,7 @@ func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10815,7 +10860,7 @@ func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
+func (x *fastReflection_BtcConsolidation) IsValid() bool {
 	return x != nil
 }
 
@@ -10825,9 +10870,9 @@ func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10839,26 +10884,11 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Sender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Vaults) > 0 {
-			for _, s := range x.Vaults {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.ConsensusPubkey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TargetThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetThreshold))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10870,7 +10900,7 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10889,38 +10919,13 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.ConsensusPubkey) > 0 {
-			i -= len(x.ConsensusPubkey)
-			copy(dAtA[i:], x.ConsensusPubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Vaults) > 0 {
-			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Vaults[iNdEx])
-				copy(dAtA[i:], x.Vaults[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vaults[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		if x.MaxNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.TargetThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetThreshold))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -10935,7 +10940,7 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10967,17 +10972,17 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
 				}
-				x.Id = 0
+				x.TargetThreshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10987,80 +10992,16 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.TargetThreshold |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Vaults = append(x.Vaults, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
 				}
-				var stringLen uint64
+				x.MaxNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11070,70 +11011,25 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MaxNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -11155,80 +11051,30 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_RefreshingRequest_3_list)(nil)
-
-type _RefreshingRequest_3_list struct {
-	list *[]string
-}
-
-func (x *_RefreshingRequest_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_RefreshingRequest_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_RefreshingRequest_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_RefreshingRequest_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_RefreshingRequest_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingRequest at list field RemovedParticipants as it is not of Message kind"))
-}
-
-func (x *_RefreshingRequest_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_RefreshingRequest_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_RefreshingRequest_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_RefreshingRequest                      protoreflect.MessageDescriptor
-	fd_RefreshingRequest_id                   protoreflect.FieldDescriptor
-	fd_RefreshingRequest_dkg_id               protoreflect.FieldDescriptor
-	fd_RefreshingRequest_removed_participants protoreflect.FieldDescriptor
-	fd_RefreshingRequest_expiration_time      protoreflect.FieldDescriptor
-	fd_RefreshingRequest_status               protoreflect.FieldDescriptor
+	md_RunesConsolidation                  protoreflect.MessageDescriptor
+	fd_RunesConsolidation_rune_id          protoreflect.FieldDescriptor
+	fd_RunesConsolidation_target_threshold protoreflect.FieldDescriptor
+	fd_RunesConsolidation_max_num          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_RefreshingRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RefreshingRequest")
-	fd_RefreshingRequest_id = md_RefreshingRequest.Fields().ByName("id")
-	fd_RefreshingRequest_dkg_id = md_RefreshingRequest.Fields().ByName("dkg_id")
-	fd_RefreshingRequest_removed_participants = md_RefreshingRequest.Fields().ByName("removed_participants")
-	fd_RefreshingRequest_expiration_time = md_RefreshingRequest.Fields().ByName("expiration_time")
-	fd_RefreshingRequest_status = md_RefreshingRequest.Fields().ByName("status")
+	md_RunesConsolidation = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RunesConsolidation")
+	fd_RunesConsolidation_rune_id = md_RunesConsolidation.Fields().ByName("rune_id")
+	fd_RunesConsolidation_target_threshold = md_RunesConsolidation.Fields().ByName("target_threshold")
+	fd_RunesConsolidation_max_num = md_RunesConsolidation.Fields().ByName("max_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_RefreshingRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_RunesConsolidation)(nil)
 
-type fastReflection_RefreshingRequest RefreshingRequest
+type fastReflection_RunesConsolidation RunesConsolidation
 
-func (x *RefreshingRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RefreshingRequest)(x)
+func (x *RunesConsolidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RunesConsolidation)(x)
 }
 
-func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
+func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11240,43 +11086,43 @@ func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RefreshingRequest_messageType fastReflection_RefreshingRequest_messageType
-var _ protoreflect.MessageType = fastReflection_RefreshingRequest_messageType{}
+var _fastReflection_RunesConsolidation_messageType fastReflection_RunesConsolidation_messageType
+var _ protoreflect.MessageType = fastReflection_RunesConsolidation_messageType{}
 
-type fastReflection_RefreshingRequest_messageType struct{}
+type fastReflection_RunesConsolidation_messageType struct{}
 
-func (x fastReflection_RefreshingRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RefreshingRequest)(nil)
+func (x fastReflection_RunesConsolidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RunesConsolidation)(nil)
 }
-func (x fastReflection_RefreshingRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_RefreshingRequest)
+func (x fastReflection_RunesConsolidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_RunesConsolidation)
 }
-func (x fastReflection_RefreshingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingRequest
+func (x fastReflection_RunesConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesConsolidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RefreshingRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingRequest
+func (x *fastReflection_RunesConsolidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesConsolidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RefreshingRequest) Type() protoreflect.MessageType {
-	return _fastReflection_RefreshingRequest_messageType
+func (x *fastReflection_RunesConsolidation) Type() protoreflect.MessageType {
+	return _fastReflection_RunesConsolidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RefreshingRequest) New() protoreflect.Message {
-	return new(fastReflection_RefreshingRequest)
+func (x *fastReflection_RunesConsolidation) New() protoreflect.Message {
+	return new(fastReflection_RunesConsolidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage {
-	return (*RefreshingRequest)(x)
+func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessage {
+	return (*RunesConsolidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11284,34 +11130,22 @@ func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_RefreshingRequest_id, value) {
-			return
-		}
-	}
-	if x.DkgId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DkgId)
-		if !f(fd_RefreshingRequest_dkg_id, value) {
-			return
-		}
-	}
-	if len(x.RemovedParticipants) != 0 {
-		value := protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &x.RemovedParticipants})
-		if !f(fd_RefreshingRequest_removed_participants, value) {
+func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RuneId != "" {
+		value := protoreflect.ValueOfString(x.RuneId)
+		if !f(fd_RunesConsolidation_rune_id, value) {
 			return
 		}
 	}
-	if x.ExpirationTime != nil {
-		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
-		if !f(fd_RefreshingRequest_expiration_time, value) {
+	if x.TargetThreshold != "" {
+		value := protoreflect.ValueOfString(x.TargetThreshold)
+		if !f(fd_RunesConsolidation_target_threshold, value) {
 			return
 		}
 	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_RefreshingRequest_status, value) {
+	if x.MaxNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNum)
+		if !f(fd_RunesConsolidation_max_num, value) {
 			return
 		}
 	}
@@ -11328,23 +11162,19 @@ func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.id":
-		return x.Id != uint64(0)
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		return x.DkgId != uint64(0)
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		return len(x.RemovedParticipants) != 0
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		return x.ExpirationTime != nil
-	case "bitway.btcbridge.RefreshingRequest.status":
-		return x.Status != 0
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		return x.RuneId != ""
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		return x.TargetThreshold != ""
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		return x.MaxNum != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11354,23 +11184,19 @@ func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.id":
-		x.Id = uint64(0)
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		x.DkgId = uint64(0)
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		x.RemovedParticipants = nil
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		x.ExpirationTime = nil
-	case "bitway.btcbridge.RefreshingRequest.status":
-		x.Status = 0
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		x.RuneId = ""
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		x.TargetThreshold = ""
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		x.MaxNum = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11380,31 +11206,22 @@ func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		value := x.DkgId
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		if len(x.RemovedParticipants) == 0 {
-			return protoreflect.ValueOfList(&_RefreshingRequest_3_list{})
-		}
-		listValue := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		value := x.ExpirationTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.RefreshingRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		value := x.RuneId
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		value := x.TargetThreshold
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		value := x.MaxNum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11418,25 +11235,19 @@ func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.id":
-		x.Id = value.Uint()
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		x.DkgId = value.Uint()
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		lv := value.List()
-		clv := lv.(*_RefreshingRequest_3_list)
-		x.RemovedParticipants = *clv.list
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "bitway.btcbridge.RefreshingRequest.status":
-		x.Status = (RefreshingStatus)(value.Enum())
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		x.RuneId = value.Interface().(string)
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		x.TargetThreshold = value.Interface().(string)
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		x.MaxNum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11450,65 +11261,48 @@ func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RunesConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		if x.RemovedParticipants == nil {
-			x.RemovedParticipants = []string{}
-		}
-		value := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		if x.ExpirationTime == nil {
-			x.ExpirationTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
-	case "bitway.btcbridge.RefreshingRequest.id":
-		panic(fmt.Errorf("field id of message bitway.btcbridge.RefreshingRequest is not mutable"))
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		panic(fmt.Errorf("field dkg_id of message bitway.btcbridge.RefreshingRequest is not mutable"))
-	case "bitway.btcbridge.RefreshingRequest.status":
-		panic(fmt.Errorf("field status of message bitway.btcbridge.RefreshingRequest is not mutable"))
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		panic(fmt.Errorf("field rune_id of message bitway.btcbridge.RunesConsolidation is not mutable"))
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		panic(fmt.Errorf("field target_threshold of message bitway.btcbridge.RunesConsolidation is not mutable"))
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		panic(fmt.Errorf("field max_num of message bitway.btcbridge.RunesConsolidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RefreshingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RunesConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.RefreshingRequest.dkg_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.RefreshingRequest.removed_participants":
-		list := []string{}
-		return protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &list})
-	case "bitway.btcbridge.RefreshingRequest.expiration_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.RefreshingRequest.status":
-		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.RunesConsolidation.rune_id":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesConsolidation.target_threshold":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesConsolidation.max_num":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesConsolidation"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RefreshingRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RunesConsolidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11516,7 +11310,7 @@ func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11527,7 +11321,7 @@ func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11539,7 +11333,7 @@ func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RefreshingRequest) IsValid() bool {
+func (x *fastReflection_RunesConsolidation) IsValid() bool {
 	return x != nil
 }
 
@@ -11549,9 +11343,9 @@ func (x *fastReflection_RefreshingRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*RunesConsolidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11563,24 +11357,16 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		if x.DkgId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DkgId))
-		}
-		if len(x.RemovedParticipants) > 0 {
-			for _, s := range x.RemovedParticipants {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RuneId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExpirationTime != nil {
-			l = options.Size(x.ExpirationTime)
+		l = len(x.TargetThreshold)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.MaxNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11592,7 +11378,7 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*RunesConsolidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11611,43 +11397,24 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.ExpirationTime != nil {
-			encoded, err := options.Marshal(x.ExpirationTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.MaxNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.RemovedParticipants) > 0 {
-			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RemovedParticipants[iNdEx])
-				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
+			dAtA[i] = 0x18
 		}
-		if x.DkgId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+		if len(x.TargetThreshold) > 0 {
+			i -= len(x.TargetThreshold)
+			copy(dAtA[i:], x.TargetThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetThreshold)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.RuneId) > 0 {
+			i -= len(x.RuneId)
+			copy(dAtA[i:], x.RuneId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneId)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11660,7 +11427,7 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*RunesConsolidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11692,53 +11459,15 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
-				}
-				x.DkgId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DkgId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11766,13 +11495,13 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+				x.RuneId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11782,33 +11511,29 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpirationTime == nil {
-					x.ExpirationTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.TargetThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
 				}
-				x.Status = 0
+				x.MaxNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11818,7 +11543,7 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= RefreshingStatus(b&0x7F) << shift
+					x.MaxNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -11859,31 +11584,29 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RefreshingCompletion                  protoreflect.MessageDescriptor
-	fd_RefreshingCompletion_id               protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_sender           protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_consensus_pubkey protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_signature        protoreflect.FieldDescriptor
+	md_DKGParticipant                  protoreflect.MessageDescriptor
+	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
+	fd_DKGParticipant_operator_address protoreflect.FieldDescriptor
+	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_btcbridge_proto_init()
-	md_RefreshingCompletion = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RefreshingCompletion")
-	fd_RefreshingCompletion_id = md_RefreshingCompletion.Fields().ByName("id")
-	fd_RefreshingCompletion_sender = md_RefreshingCompletion.Fields().ByName("sender")
-	fd_RefreshingCompletion_consensus_pubkey = md_RefreshingCompletion.Fields().ByName("consensus_pubkey")
-	fd_RefreshingCompletion_signature = md_RefreshingCompletion.Fields().ByName("signature")
+	md_DKGParticipant = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGParticipant")
+	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
+	fd_DKGParticipant_operator_address = md_DKGParticipant.Fields().ByName("operator_address")
+	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
 }
 
-var _ protoreflect.Message = (*fastReflection_RefreshingCompletion)(nil)
+var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
 
-type fastReflection_RefreshingCompletion RefreshingCompletion
+type fastReflection_DKGParticipant DKGParticipant
 
-func (x *RefreshingCompletion) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RefreshingCompletion)(x)
+func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(x)
 }
 
-func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
+func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11895,43 +11618,43 @@ func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RefreshingCompletion_messageType fastReflection_RefreshingCompletion_messageType
-var _ protoreflect.MessageType = fastReflection_RefreshingCompletion_messageType{}
+var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
+var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
 
-type fastReflection_RefreshingCompletion_messageType struct{}
+type fastReflection_DKGParticipant_messageType struct{}
 
-func (x fastReflection_RefreshingCompletion_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RefreshingCompletion)(nil)
+func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(nil)
 }
-func (x fastReflection_RefreshingCompletion_messageType) New() protoreflect.Message {
-	return new(fastReflection_RefreshingCompletion)
+func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
 }
-func (x fastReflection_RefreshingCompletion_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingCompletion
+func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RefreshingCompletion) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingCompletion
+func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RefreshingCompletion) Type() protoreflect.MessageType {
-	return _fastReflection_RefreshingCompletion_messageType
+func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
+	return _fastReflection_DKGParticipant_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RefreshingCompletion) New() protoreflect.Message {
-	return new(fastReflection_RefreshingCompletion)
+func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMessage {
-	return (*RefreshingCompletion)(x)
+func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
+	return (*DKGParticipant)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11939,28 +11662,22 @@ func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_RefreshingCompletion_id, value) {
+func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_DKGParticipant_moniker, value) {
 			return
 		}
 	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_RefreshingCompletion_sender, value) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_DKGParticipant_operator_address, value) {
 			return
 		}
 	}
 	if x.ConsensusPubkey != "" {
 		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_RefreshingCompletion_consensus_pubkey, value) {
-			return
-		}
-	}
-	if x.Signature != "" {
-		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_RefreshingCompletion_signature, value) {
+		if !f(fd_DKGParticipant_consensus_pubkey, value) {
 			return
 		}
 	}
@@ -11977,21 +11694,19 @@ func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		return x.Id != uint64(0)
-	case "bitway.btcbridge.RefreshingCompletion.sender":
-		return x.Sender != ""
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+	case "bitway.btcbridge.DKGParticipant.moniker":
+		return x.Moniker != ""
+	case "bitway.btcbridge.DKGParticipant.operator_address":
+		return x.OperatorAddress != ""
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
 		return x.ConsensusPubkey != ""
-	case "bitway.btcbridge.RefreshingCompletion.signature":
-		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12001,21 +11716,19 @@ func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		x.Id = uint64(0)
-	case "bitway.btcbridge.RefreshingCompletion.sender":
-		x.Sender = ""
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+	case "bitway.btcbridge.DKGParticipant.moniker":
+		x.Moniker = ""
+	case "bitway.btcbridge.DKGParticipant.operator_address":
+		x.OperatorAddress = ""
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
 		x.ConsensusPubkey = ""
-	case "bitway.btcbridge.RefreshingCompletion.signature":
-		x.Signature = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12025,25 +11738,22 @@ func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.RefreshingCompletion.sender":
-		value := x.Sender
+	case "bitway.btcbridge.DKGParticipant.moniker":
+		value := x.Moniker
 		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
-		value := x.ConsensusPubkey
+	case "bitway.btcbridge.DKGParticipant.operator_address":
+		value := x.OperatorAddress
 		return protoreflect.ValueOfString(value)
-	case "bitway.btcbridge.RefreshingCompletion.signature":
-		value := x.Signature
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
+		value := x.ConsensusPubkey
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12057,21 +11767,19 @@ func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		x.Id = value.Uint()
-	case "bitway.btcbridge.RefreshingCompletion.sender":
-		x.Sender = value.Interface().(string)
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+	case "bitway.btcbridge.DKGParticipant.moniker":
+		x.Moniker = value.Interface().(string)
+	case "bitway.btcbridge.DKGParticipant.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
 		x.ConsensusPubkey = value.Interface().(string)
-	case "bitway.btcbridge.RefreshingCompletion.signature":
-		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12085,52 +11793,48 @@ func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		panic(fmt.Errorf("field id of message bitway.btcbridge.RefreshingCompletion is not mutable"))
-	case "bitway.btcbridge.RefreshingCompletion.sender":
-		panic(fmt.Errorf("field sender of message bitway.btcbridge.RefreshingCompletion is not mutable"))
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.RefreshingCompletion is not mutable"))
-	case "bitway.btcbridge.RefreshingCompletion.signature":
-		panic(fmt.Errorf("field signature of message bitway.btcbridge.RefreshingCompletion is not mutable"))
+	case "bitway.btcbridge.DKGParticipant.moniker":
+		panic(fmt.Errorf("field moniker of message bitway.btcbridge.DKGParticipant is not mutable"))
+	case "bitway.btcbridge.DKGParticipant.operator_address":
+		panic(fmt.Errorf("field operator_address of message bitway.btcbridge.DKGParticipant is not mutable"))
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.DKGParticipant is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RefreshingCompletion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RefreshingCompletion.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.RefreshingCompletion.sender":
+	case "bitway.btcbridge.DKGParticipant.moniker":
 		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+	case "bitway.btcbridge.DKGParticipant.operator_address":
 		return protoreflect.ValueOfString("")
-	case "bitway.btcbridge.RefreshingCompletion.signature":
+	case "bitway.btcbridge.DKGParticipant.consensus_pubkey":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGParticipant"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RefreshingCompletion", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGParticipant", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12138,7 +11842,7 @@ func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12149,7 +11853,7 @@ func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12161,7 +11865,7 @@ func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RefreshingCompletion) IsValid() bool {
+func (x *fastReflection_DKGParticipant) IsValid() bool {
 	return x != nil
 }
 
@@ -12171,9 +11875,9 @@ func (x *fastReflection_RefreshingCompletion) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*DKGParticipant)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12185,18 +11889,15 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Sender)
+		l = len(x.Moniker)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ConsensusPubkey)
+		l = len(x.OperatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signature)
+		l = len(x.ConsensusPubkey)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -12210,7 +11911,7 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*DKGParticipant)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12229,13 +11930,6 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x22
-		}
 		if len(x.ConsensusPubkey) > 0 {
 			i -= len(x.ConsensusPubkey)
 			copy(dAtA[i:], x.ConsensusPubkey)
@@ -12243,17 +11937,19 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12266,7 +11962,7 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*DKGParticipant)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12298,34 +11994,15 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12353,11 +12030,11 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
+				x.Moniker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12385,11 +12062,11 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12417,7 +12094,7 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signature = string(dAtA[iNdEx:postIndex])
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -12454,1447 +12131,11971 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: bitway/btcbridge/btcbridge.proto
+var _ protoreflect.List = (*_DKGRequest_2_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _DKGRequest_2_list struct {
+	list *[]*DKGParticipant
+}
 
-// Bitcoin Signing Status
-type SigningStatus int32
+func (x *_DKGRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-const (
-	// SIGNING_STATUS_UNSPECIFIED - Default value, should not be used
-	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
-	// SIGNING_STATUS_PENDING - The signing request is pending
-	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
-	// SIGNING_STATUS_BROADCASTED - The signing request is broadcasted
-	SigningStatus_SIGNING_STATUS_BROADCASTED SigningStatus = 2
-	// SIGNING_STATUS_CONFIRMED - The signing request is confirmed
-	SigningStatus_SIGNING_STATUS_CONFIRMED SigningStatus = 3
-	// SIGNING_STATUS_FAILED - The signing request failed to be signed or broadcast due to unexpected exceptions
-	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 4
-)
+func (x *_DKGRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-// Enum value maps for SigningStatus.
-var (
-	SigningStatus_name = map[int32]string{
-		0: "SIGNING_STATUS_UNSPECIFIED",
-		1: "SIGNING_STATUS_PENDING",
-		2: "SIGNING_STATUS_BROADCASTED",
-		3: "SIGNING_STATUS_CONFIRMED",
-		4: "SIGNING_STATUS_FAILED",
+func (x *_DKGRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGRequest_2_list) AppendMutable() protoreflect.Value {
+	v := new(DKGParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	SigningStatus_value = map[string]int32{
-		"SIGNING_STATUS_UNSPECIFIED": 0,
-		"SIGNING_STATUS_PENDING":     1,
-		"SIGNING_STATUS_BROADCASTED": 2,
-		"SIGNING_STATUS_CONFIRMED":   3,
-		"SIGNING_STATUS_FAILED":      4,
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGRequest_2_list) NewElement() protoreflect.Value {
+	v := new(DKGParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGRequest_4_list)(nil)
+
+type _DKGRequest_4_list struct {
+	list *[]AssetType
+}
+
+func (x *_DKGRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x SigningStatus) Enum() *SigningStatus {
-	p := new(SigningStatus)
-	*p = x
-	return p
+func (x *_DKGRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
 }
 
-func (x SigningStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_DKGRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AssetType)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
 }
 
-func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_btcbridge_btcbridge_proto_enumTypes[0].Descriptor()
+func (x *_DKGRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AssetType)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (SigningStatus) Type() protoreflect.EnumType {
-	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[0]
+func (x *_DKGRequest_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGRequest at list field VaultTypes as it is not of Message kind"))
 }
 
-func (x SigningStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_DKGRequest_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use SigningStatus.Descriptor instead.
-func (SigningStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+func (x *_DKGRequest_4_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
 }
 
-type DKGRequestStatus int32
+func (x *_DKGRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
 
-const (
-	// DKG_REQUEST_STATUS_UNSPECIFIED defines the unknown DKG request status
-	DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED DKGRequestStatus = 0
-	// DKG_REQUEST_STATUS_PENDING defines the status of the DKG request which is pending
-	DKGRequestStatus_DKG_REQUEST_STATUS_PENDING DKGRequestStatus = 1
-	// DKG_REQUEST_STATUS_COMPLETED defines the status of the DKG request which is completed
-	DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED DKGRequestStatus = 2
-	// DKG_REQUEST_STATUS_FAILED defines the status of the DKG request which failed
-	DKGRequestStatus_DKG_REQUEST_STATUS_FAILED DKGRequestStatus = 3
-	// DKG_REQUEST_STATUS_TIMEDOUT defines the status of the DKG request which timed out
-	DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT DKGRequestStatus = 4
+var (
+	md_DKGRequest                 protoreflect.MessageDescriptor
+	fd_DKGRequest_id              protoreflect.FieldDescriptor
+	fd_DKGRequest_participants    protoreflect.FieldDescriptor
+	fd_DKGRequest_threshold       protoreflect.FieldDescriptor
+	fd_DKGRequest_vault_types     protoreflect.FieldDescriptor
+	fd_DKGRequest_enable_transfer protoreflect.FieldDescriptor
+	fd_DKGRequest_target_utxo_num protoreflect.FieldDescriptor
+	fd_DKGRequest_expiration      protoreflect.FieldDescriptor
+	fd_DKGRequest_status          protoreflect.FieldDescriptor
+	fd_DKGRequest_recovery_params protoreflect.FieldDescriptor
 )
 
-// Enum value maps for DKGRequestStatus.
-var (
-	DKGRequestStatus_name = map[int32]string{
-		0: "DKG_REQUEST_STATUS_UNSPECIFIED",
-		1: "DKG_REQUEST_STATUS_PENDING",
-		2: "DKG_REQUEST_STATUS_COMPLETED",
-		3: "DKG_REQUEST_STATUS_FAILED",
-		4: "DKG_REQUEST_STATUS_TIMEDOUT",
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_DKGRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGRequest")
+	fd_DKGRequest_id = md_DKGRequest.Fields().ByName("id")
+	fd_DKGRequest_participants = md_DKGRequest.Fields().ByName("participants")
+	fd_DKGRequest_threshold = md_DKGRequest.Fields().ByName("threshold")
+	fd_DKGRequest_vault_types = md_DKGRequest.Fields().ByName("vault_types")
+	fd_DKGRequest_enable_transfer = md_DKGRequest.Fields().ByName("enable_transfer")
+	fd_DKGRequest_target_utxo_num = md_DKGRequest.Fields().ByName("target_utxo_num")
+	fd_DKGRequest_expiration = md_DKGRequest.Fields().ByName("expiration")
+	fd_DKGRequest_status = md_DKGRequest.Fields().ByName("status")
+	fd_DKGRequest_recovery_params = md_DKGRequest.Fields().ByName("recovery_params")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGRequest)(nil)
+
+type fastReflection_DKGRequest DKGRequest
+
+func (x *DKGRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGRequest)(x)
+}
+
+func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	DKGRequestStatus_value = map[string]int32{
-		"DKG_REQUEST_STATUS_UNSPECIFIED": 0,
-		"DKG_REQUEST_STATUS_PENDING":     1,
-		"DKG_REQUEST_STATUS_COMPLETED":   2,
-		"DKG_REQUEST_STATUS_FAILED":      3,
-		"DKG_REQUEST_STATUS_TIMEDOUT":    4,
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGRequest_messageType fastReflection_DKGRequest_messageType
+var _ protoreflect.MessageType = fastReflection_DKGRequest_messageType{}
+
+type fastReflection_DKGRequest_messageType struct{}
+
+func (x fastReflection_DKGRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGRequest)(nil)
+}
+func (x fastReflection_DKGRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGRequest)
+}
+func (x fastReflection_DKGRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGRequest) Type() protoreflect.MessageType {
+	return _fastReflection_DKGRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGRequest) New() protoreflect.Message {
+	return new(fastReflection_DKGRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
+	return (*DKGRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DKGRequest_id, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRequest_2_list{list: &x.Participants})
+		if !f(fd_DKGRequest_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_DKGRequest_threshold, value) {
+			return
+		}
+	}
+	if len(x.VaultTypes) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRequest_4_list{list: &x.VaultTypes})
+		if !f(fd_DKGRequest_vault_types, value) {
+			return
+		}
+	}
+	if x.EnableTransfer != false {
+		value := protoreflect.ValueOfBool(x.EnableTransfer)
+		if !f(fd_DKGRequest_enable_transfer, value) {
+			return
+		}
+	}
+	if x.TargetUtxoNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TargetUtxoNum)
+		if !f(fd_DKGRequest_target_utxo_num, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_DKGRequest_expiration, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_DKGRequest_status, value) {
+			return
+		}
+	}
+	if x.RecoveryParams != nil {
+		value := protoreflect.ValueOfMessage(x.RecoveryParams.ProtoReflect())
+		if !f(fd_DKGRequest_recovery_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGRequest.id":
+		return x.Id != uint64(0)
+	case "bitway.btcbridge.DKGRequest.participants":
+		return len(x.Participants) != 0
+	case "bitway.btcbridge.DKGRequest.threshold":
+		return x.Threshold != uint32(0)
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		return len(x.VaultTypes) != 0
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		return x.EnableTransfer != false
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		return x.TargetUtxoNum != uint32(0)
+	case "bitway.btcbridge.DKGRequest.expiration":
+		return x.Expiration != nil
+	case "bitway.btcbridge.DKGRequest.status":
+		return x.Status != 0
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		return x.RecoveryParams != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGRequest.id":
+		x.Id = uint64(0)
+	case "bitway.btcbridge.DKGRequest.participants":
+		x.Participants = nil
+	case "bitway.btcbridge.DKGRequest.threshold":
+		x.Threshold = uint32(0)
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		x.VaultTypes = nil
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		x.EnableTransfer = false
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		x.TargetUtxoNum = uint32(0)
+	case "bitway.btcbridge.DKGRequest.expiration":
+		x.Expiration = nil
+	case "bitway.btcbridge.DKGRequest.status":
+		x.Status = 0
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		x.RecoveryParams = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DKGRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.DKGRequest.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_DKGRequest_2_list{})
+		}
+		listValue := &_DKGRequest_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.DKGRequest.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		if len(x.VaultTypes) == 0 {
+			return protoreflect.ValueOfList(&_DKGRequest_4_list{})
+		}
+		listValue := &_DKGRequest_4_list{list: &x.VaultTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		value := x.EnableTransfer
+		return protoreflect.ValueOfBool(value)
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		value := x.TargetUtxoNum
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.DKGRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.DKGRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		value := x.RecoveryParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGRequest.id":
+		x.Id = value.Uint()
+	case "bitway.btcbridge.DKGRequest.participants":
+		lv := value.List()
+		clv := lv.(*_DKGRequest_2_list)
+		x.Participants = *clv.list
+	case "bitway.btcbridge.DKGRequest.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		lv := value.List()
+		clv := lv.(*_DKGRequest_4_list)
+		x.VaultTypes = *clv.list
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		x.EnableTransfer = value.Bool()
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		x.TargetUtxoNum = uint32(value.Uint())
+	case "bitway.btcbridge.DKGRequest.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.DKGRequest.status":
+		x.Status = (DKGRequestStatus)(value.Enum())
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		x.RecoveryParams = value.Message().Interface().(*VaultRecoveryParams)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGRequest.participants":
+		if x.Participants == nil {
+			x.Participants = []*DKGParticipant{}
+		}
+		value := &_DKGRequest_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		if x.VaultTypes == nil {
+			x.VaultTypes = []AssetType{}
+		}
+		value := &_DKGRequest_4_list{list: &x.VaultTypes}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DKGRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		if x.RecoveryParams == nil {
+			x.RecoveryParams = new(VaultRecoveryParams)
+		}
+		return protoreflect.ValueOfMessage(x.RecoveryParams.ProtoReflect())
+	case "bitway.btcbridge.DKGRequest.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.DKGRequest is not mutable"))
+	case "bitway.btcbridge.DKGRequest.threshold":
+		panic(fmt.Errorf("field threshold of message bitway.btcbridge.DKGRequest is not mutable"))
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		panic(fmt.Errorf("field enable_transfer of message bitway.btcbridge.DKGRequest is not mutable"))
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		panic(fmt.Errorf("field target_utxo_num of message bitway.btcbridge.DKGRequest is not mutable"))
+	case "bitway.btcbridge.DKGRequest.status":
+		panic(fmt.Errorf("field status of message bitway.btcbridge.DKGRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.DKGRequest.participants":
+		list := []*DKGParticipant{}
+		return protoreflect.ValueOfList(&_DKGRequest_2_list{list: &list})
+	case "bitway.btcbridge.DKGRequest.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.DKGRequest.vault_types":
+		list := []AssetType{}
+		return protoreflect.ValueOfList(&_DKGRequest_4_list{list: &list})
+	case "bitway.btcbridge.DKGRequest.enable_transfer":
+		return protoreflect.ValueOfBool(false)
+	case "bitway.btcbridge.DKGRequest.target_utxo_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.DKGRequest.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.DKGRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.DKGRequest.recovery_params":
+		m := new(VaultRecoveryParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if len(x.VaultTypes) > 0 {
+			l = 0
+			for _, e := range x.VaultTypes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.EnableTransfer {
+			n += 2
+		}
+		if x.TargetUtxoNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetUtxoNum))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.RecoveryParams != nil {
+			l = options.Size(x.RecoveryParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RecoveryParams != nil {
+			encoded, err := options.Marshal(x.RecoveryParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TargetUtxoNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetUtxoNum))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EnableTransfer {
+			i--
+			if x.EnableTransfer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.VaultTypes) > 0 {
+			var pksize2 int
+			for _, num := range x.VaultTypes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.VaultTypes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &DKGParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType == 0 {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.VaultTypes = append(x.VaultTypes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.VaultTypes) == 0 {
+						x.VaultTypes = make([]AssetType, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v AssetType
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= AssetType(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.VaultTypes = append(x.VaultTypes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableTransfer = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+				}
+				x.TargetUtxoNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetUtxoNum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= DKGRequestStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecoveryParams == nil {
+					x.RecoveryParams = &VaultRecoveryParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecoveryParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_3_list)(nil)
+
+type _DKGCompletionRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field Vaults as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_6_list)(nil)
+
+type _DKGCompletionRequest_6_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field InternalKeys as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_7_list)(nil)
+
+type _DKGCompletionRequest_7_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DKGCompletionRequest                  protoreflect.MessageDescriptor
+	fd_DKGCompletionRequest_id               protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_sender           protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_vaults           protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_consensus_pubkey protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_signature        protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_internal_keys    protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_pub_keys         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_DKGCompletionRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DKGCompletionRequest")
+	fd_DKGCompletionRequest_id = md_DKGCompletionRequest.Fields().ByName("id")
+	fd_DKGCompletionRequest_sender = md_DKGCompletionRequest.Fields().ByName("sender")
+	fd_DKGCompletionRequest_vaults = md_DKGCompletionRequest.Fields().ByName("vaults")
+	fd_DKGCompletionRequest_consensus_pubkey = md_DKGCompletionRequest.Fields().ByName("consensus_pubkey")
+	fd_DKGCompletionRequest_signature = md_DKGCompletionRequest.Fields().ByName("signature")
+	fd_DKGCompletionRequest_internal_keys = md_DKGCompletionRequest.Fields().ByName("internal_keys")
+	fd_DKGCompletionRequest_pub_keys = md_DKGCompletionRequest.Fields().ByName("pub_keys")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGCompletionRequest)(nil)
+
+type fastReflection_DKGCompletionRequest DKGCompletionRequest
+
+func (x *DKGCompletionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGCompletionRequest)(x)
+}
+
+func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGCompletionRequest_messageType fastReflection_DKGCompletionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_DKGCompletionRequest_messageType{}
+
+type fastReflection_DKGCompletionRequest_messageType struct{}
+
+func (x fastReflection_DKGCompletionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGCompletionRequest)(nil)
+}
+func (x fastReflection_DKGCompletionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGCompletionRequest)
+}
+func (x fastReflection_DKGCompletionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGCompletionRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGCompletionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGCompletionRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGCompletionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_DKGCompletionRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGCompletionRequest) New() protoreflect.Message {
+	return new(fastReflection_DKGCompletionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMessage {
+	return (*DKGCompletionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DKGCompletionRequest_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_DKGCompletionRequest_sender, value) {
+			return
+		}
+	}
+	if len(x.Vaults) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &x.Vaults})
+		if !f(fd_DKGCompletionRequest_vaults, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_DKGCompletionRequest_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_DKGCompletionRequest_signature, value) {
+			return
+		}
+	}
+	if len(x.InternalKeys) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{list: &x.InternalKeys})
+		if !f(fd_DKGCompletionRequest_internal_keys, value) {
+			return
+		}
+	}
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{list: &x.PubKeys})
+		if !f(fd_DKGCompletionRequest_pub_keys, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		return x.Id != uint64(0)
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		return x.Sender != ""
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		return len(x.Vaults) != 0
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		return x.Signature != ""
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		return len(x.InternalKeys) != 0
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		return len(x.PubKeys) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		x.Id = uint64(0)
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		x.Sender = ""
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		x.Vaults = nil
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		x.Signature = ""
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		x.InternalKeys = nil
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		x.PubKeys = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		if len(x.Vaults) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{})
+		}
+		listValue := &_DKGCompletionRequest_3_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		if len(x.InternalKeys) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{})
+		}
+		listValue := &_DKGCompletionRequest_6_list{list: &x.InternalKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{})
+		}
+		listValue := &_DKGCompletionRequest_7_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		x.Id = value.Uint()
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_3_list)
+		x.Vaults = *clv.list
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		x.Signature = value.Interface().(string)
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_6_list)
+		x.InternalKeys = *clv.list
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_7_list)
+		x.PubKeys = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		if x.Vaults == nil {
+			x.Vaults = []string{}
+		}
+		value := &_DKGCompletionRequest_3_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		if x.InternalKeys == nil {
+			x.InternalKeys = []string{}
+		}
+		value := &_DKGCompletionRequest_6_list{list: &x.InternalKeys}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_DKGCompletionRequest_7_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		panic(fmt.Errorf("field signature of message bitway.btcbridge.DKGCompletionRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGCompletionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DKGCompletionRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.DKGCompletionRequest.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DKGCompletionRequest.vaults":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &list})
+	case "bitway.btcbridge.DKGCompletionRequest.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DKGCompletionRequest.signature":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DKGCompletionRequest.internal_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{list: &list})
+	case "bitway.btcbridge.DKGCompletionRequest.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DKGCompletionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Vaults) > 0 {
+			for _, s := range x.Vaults {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InternalKeys) > 0 {
+			for _, s := range x.InternalKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.InternalKeys) > 0 {
+			for iNdEx := len(x.InternalKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.InternalKeys[iNdEx])
+				copy(dAtA[i:], x.InternalKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InternalKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Vaults) > 0 {
+			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Vaults[iNdEx])
+				copy(dAtA[i:], x.Vaults[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vaults[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vaults = append(x.Vaults, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InternalKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InternalKeys = append(x.InternalKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RefreshingRequest_3_list)(nil)
+
+type _RefreshingRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_RefreshingRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RefreshingRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RefreshingRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RefreshingRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RefreshingRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingRequest at list field RemovedParticipants as it is not of Message kind"))
+}
+
+func (x *_RefreshingRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RefreshingRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RefreshingRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RefreshingRequest                      protoreflect.MessageDescriptor
+	fd_RefreshingRequest_id                   protoreflect.FieldDescriptor
+	fd_RefreshingRequest_dkg_id               protoreflect.FieldDescriptor
+	fd_RefreshingRequest_removed_participants protoreflect.FieldDescriptor
+	fd_RefreshingRequest_expiration_time      protoreflect.FieldDescriptor
+	fd_RefreshingRequest_status               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_RefreshingRequest = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RefreshingRequest")
+	fd_RefreshingRequest_id = md_RefreshingRequest.Fields().ByName("id")
+	fd_RefreshingRequest_dkg_id = md_RefreshingRequest.Fields().ByName("dkg_id")
+	fd_RefreshingRequest_removed_participants = md_RefreshingRequest.Fields().ByName("removed_participants")
+	fd_RefreshingRequest_expiration_time = md_RefreshingRequest.Fields().ByName("expiration_time")
+	fd_RefreshingRequest_status = md_RefreshingRequest.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_RefreshingRequest)(nil)
+
+type fastReflection_RefreshingRequest RefreshingRequest
+
+func (x *RefreshingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RefreshingRequest)(x)
+}
+
+func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RefreshingRequest_messageType fastReflection_RefreshingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RefreshingRequest_messageType{}
+
+type fastReflection_RefreshingRequest_messageType struct{}
+
+func (x fastReflection_RefreshingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RefreshingRequest)(nil)
+}
+func (x fastReflection_RefreshingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RefreshingRequest)
+}
+func (x fastReflection_RefreshingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RefreshingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RefreshingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RefreshingRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RefreshingRequest) New() protoreflect.Message {
+	return new(fastReflection_RefreshingRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage {
+	return (*RefreshingRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RefreshingRequest_id, value) {
+			return
+		}
+	}
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_RefreshingRequest_dkg_id, value) {
+			return
+		}
+	}
+	if len(x.RemovedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &x.RemovedParticipants})
+		if !f(fd_RefreshingRequest_removed_participants, value) {
+			return
+		}
+	}
+	if x.ExpirationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+		if !f(fd_RefreshingRequest_expiration_time, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_RefreshingRequest_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.id":
+		return x.Id != uint64(0)
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		return len(x.RemovedParticipants) != 0
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		return x.ExpirationTime != nil
+	case "bitway.btcbridge.RefreshingRequest.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.id":
+		x.Id = uint64(0)
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		x.RemovedParticipants = nil
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		x.ExpirationTime = nil
+	case "bitway.btcbridge.RefreshingRequest.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		if len(x.RemovedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_RefreshingRequest_3_list{})
+		}
+		listValue := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		value := x.ExpirationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.RefreshingRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.id":
+		x.Id = value.Uint()
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		lv := value.List()
+		clv := lv.(*_RefreshingRequest_3_list)
+		x.RemovedParticipants = *clv.list
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.RefreshingRequest.status":
+		x.Status = (RefreshingStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		if x.RemovedParticipants == nil {
+			x.RemovedParticipants = []string{}
+		}
+		value := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		if x.ExpirationTime == nil {
+			x.ExpirationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+	case "bitway.btcbridge.RefreshingRequest.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.RefreshingRequest is not mutable"))
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.btcbridge.RefreshingRequest is not mutable"))
+	case "bitway.btcbridge.RefreshingRequest.status":
+		panic(fmt.Errorf("field status of message bitway.btcbridge.RefreshingRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RefreshingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.RefreshingRequest.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.RefreshingRequest.removed_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &list})
+	case "bitway.btcbridge.RefreshingRequest.expiration_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.RefreshingRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RefreshingRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RefreshingRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for _, s := range x.RemovedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpirationTime != nil {
+			l = options.Size(x.ExpirationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpirationTime != nil {
+			encoded, err := options.Marshal(x.ExpirationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedParticipants[iNdEx])
+				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpirationTime == nil {
+					x.ExpirationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RefreshingStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RefreshingCompletion                  protoreflect.MessageDescriptor
+	fd_RefreshingCompletion_id               protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_sender           protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_consensus_pubkey protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_signature        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_RefreshingCompletion = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RefreshingCompletion")
+	fd_RefreshingCompletion_id = md_RefreshingCompletion.Fields().ByName("id")
+	fd_RefreshingCompletion_sender = md_RefreshingCompletion.Fields().ByName("sender")
+	fd_RefreshingCompletion_consensus_pubkey = md_RefreshingCompletion.Fields().ByName("consensus_pubkey")
+	fd_RefreshingCompletion_signature = md_RefreshingCompletion.Fields().ByName("signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_RefreshingCompletion)(nil)
+
+type fastReflection_RefreshingCompletion RefreshingCompletion
+
+func (x *RefreshingCompletion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RefreshingCompletion)(x)
+}
+
+func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RefreshingCompletion_messageType fastReflection_RefreshingCompletion_messageType
+var _ protoreflect.MessageType = fastReflection_RefreshingCompletion_messageType{}
+
+type fastReflection_RefreshingCompletion_messageType struct{}
+
+func (x fastReflection_RefreshingCompletion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RefreshingCompletion)(nil)
+}
+func (x fastReflection_RefreshingCompletion_messageType) New() protoreflect.Message {
+	return new(fastReflection_RefreshingCompletion)
+}
+func (x fastReflection_RefreshingCompletion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingCompletion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RefreshingCompletion) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingCompletion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RefreshingCompletion) Type() protoreflect.MessageType {
+	return _fastReflection_RefreshingCompletion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RefreshingCompletion) New() protoreflect.Message {
+	return new(fastReflection_RefreshingCompletion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMessage {
+	return (*RefreshingCompletion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RefreshingCompletion_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_RefreshingCompletion_sender, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_RefreshingCompletion_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_RefreshingCompletion_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		return x.Id != uint64(0)
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		return x.Sender != ""
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		return x.Signature != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		x.Id = uint64(0)
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		x.Sender = ""
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		x.Signature = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		x.Id = value.Uint()
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		x.Signature = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.RefreshingCompletion is not mutable"))
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.RefreshingCompletion is not mutable"))
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.btcbridge.RefreshingCompletion is not mutable"))
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		panic(fmt.Errorf("field signature of message bitway.btcbridge.RefreshingCompletion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RefreshingCompletion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RefreshingCompletion.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.RefreshingCompletion.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RefreshingCompletion.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RefreshingCompletion.signature":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RefreshingCompletion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RefreshingCompletion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Relayer_5_list)(nil)
+
+type _Relayer_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Relayer_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Relayer_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Relayer_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Relayer_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Relayer_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Relayer_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Relayer_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Relayer_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Relayer                  protoreflect.MessageDescriptor
+	fd_Relayer_address          protoreflect.FieldDescriptor
+	fd_Relayer_bond             protoreflect.FieldDescriptor
+	fd_Relayer_status           protoreflect.FieldDescriptor
+	fd_Relayer_relayed_deposits protoreflect.FieldDescriptor
+	fd_Relayer_total_rewards    protoreflect.FieldDescriptor
+	fd_Relayer_slash_count      protoreflect.FieldDescriptor
+	fd_Relayer_unbonding_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_Relayer = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("Relayer")
+	fd_Relayer_address = md_Relayer.Fields().ByName("address")
+	fd_Relayer_bond = md_Relayer.Fields().ByName("bond")
+	fd_Relayer_status = md_Relayer.Fields().ByName("status")
+	fd_Relayer_relayed_deposits = md_Relayer.Fields().ByName("relayed_deposits")
+	fd_Relayer_total_rewards = md_Relayer.Fields().ByName("total_rewards")
+	fd_Relayer_slash_count = md_Relayer.Fields().ByName("slash_count")
+	fd_Relayer_unbonding_time = md_Relayer.Fields().ByName("unbonding_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_Relayer)(nil)
+
+type fastReflection_Relayer Relayer
+
+func (x *Relayer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Relayer)(x)
+}
+
+func (x *Relayer) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Relayer_messageType fastReflection_Relayer_messageType
+var _ protoreflect.MessageType = fastReflection_Relayer_messageType{}
+
+type fastReflection_Relayer_messageType struct{}
+
+func (x fastReflection_Relayer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Relayer)(nil)
+}
+func (x fastReflection_Relayer_messageType) New() protoreflect.Message {
+	return new(fastReflection_Relayer)
+}
+func (x fastReflection_Relayer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Relayer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Relayer) Descriptor() protoreflect.MessageDescriptor {
+	return md_Relayer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Relayer) Type() protoreflect.MessageType {
+	return _fastReflection_Relayer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Relayer) New() protoreflect.Message {
+	return new(fastReflection_Relayer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Relayer) Interface() protoreflect.ProtoMessage {
+	return (*Relayer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Relayer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Relayer_address, value) {
+			return
+		}
+	}
+	if x.Bond != nil {
+		value := protoreflect.ValueOfMessage(x.Bond.ProtoReflect())
+		if !f(fd_Relayer_bond, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Relayer_status, value) {
+			return
+		}
+	}
+	if x.RelayedDeposits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RelayedDeposits)
+		if !f(fd_Relayer_relayed_deposits, value) {
+			return
+		}
+	}
+	if len(x.TotalRewards) != 0 {
+		value := protoreflect.ValueOfList(&_Relayer_5_list{list: &x.TotalRewards})
+		if !f(fd_Relayer_total_rewards, value) {
+			return
+		}
+	}
+	if x.SlashCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashCount)
+		if !f(fd_Relayer_slash_count, value) {
+			return
+		}
+	}
+	if x.UnbondingTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+		if !f(fd_Relayer_unbonding_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Relayer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Relayer.address":
+		return x.Address != ""
+	case "bitway.btcbridge.Relayer.bond":
+		return x.Bond != nil
+	case "bitway.btcbridge.Relayer.status":
+		return x.Status != 0
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		return x.RelayedDeposits != uint64(0)
+	case "bitway.btcbridge.Relayer.total_rewards":
+		return len(x.TotalRewards) != 0
+	case "bitway.btcbridge.Relayer.slash_count":
+		return x.SlashCount != uint64(0)
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		return x.UnbondingTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Relayer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Relayer.address":
+		x.Address = ""
+	case "bitway.btcbridge.Relayer.bond":
+		x.Bond = nil
+	case "bitway.btcbridge.Relayer.status":
+		x.Status = 0
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		x.RelayedDeposits = uint64(0)
+	case "bitway.btcbridge.Relayer.total_rewards":
+		x.TotalRewards = nil
+	case "bitway.btcbridge.Relayer.slash_count":
+		x.SlashCount = uint64(0)
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		x.UnbondingTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Relayer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.Relayer.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.Relayer.bond":
+		value := x.Bond
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Relayer.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		value := x.RelayedDeposits
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.Relayer.total_rewards":
+		if len(x.TotalRewards) == 0 {
+			return protoreflect.ValueOfList(&_Relayer_5_list{})
+		}
+		listValue := &_Relayer_5_list{list: &x.TotalRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.Relayer.slash_count":
+		value := x.SlashCount
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		value := x.UnbondingTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Relayer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Relayer.address":
+		x.Address = value.Interface().(string)
+	case "bitway.btcbridge.Relayer.bond":
+		x.Bond = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.Relayer.status":
+		x.Status = (RelayerStatus)(value.Enum())
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		x.RelayedDeposits = value.Uint()
+	case "bitway.btcbridge.Relayer.total_rewards":
+		lv := value.List()
+		clv := lv.(*_Relayer_5_list)
+		x.TotalRewards = *clv.list
+	case "bitway.btcbridge.Relayer.slash_count":
+		x.SlashCount = value.Uint()
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		x.UnbondingTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Relayer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Relayer.bond":
+		if x.Bond == nil {
+			x.Bond = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Bond.ProtoReflect())
+	case "bitway.btcbridge.Relayer.total_rewards":
+		if x.TotalRewards == nil {
+			x.TotalRewards = []*v1beta1.Coin{}
+		}
+		value := &_Relayer_5_list{list: &x.TotalRewards}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		if x.UnbondingTime == nil {
+			x.UnbondingTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+	case "bitway.btcbridge.Relayer.address":
+		panic(fmt.Errorf("field address of message bitway.btcbridge.Relayer is not mutable"))
+	case "bitway.btcbridge.Relayer.status":
+		panic(fmt.Errorf("field status of message bitway.btcbridge.Relayer is not mutable"))
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		panic(fmt.Errorf("field relayed_deposits of message bitway.btcbridge.Relayer is not mutable"))
+	case "bitway.btcbridge.Relayer.slash_count":
+		panic(fmt.Errorf("field slash_count of message bitway.btcbridge.Relayer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Relayer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Relayer.address":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.Relayer.bond":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Relayer.status":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.Relayer.relayed_deposits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.Relayer.total_rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Relayer_5_list{list: &list})
+	case "bitway.btcbridge.Relayer.slash_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.Relayer.unbonding_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Relayer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Relayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Relayer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.Relayer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Relayer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Relayer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Relayer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Relayer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Relayer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bond != nil {
+			l = options.Size(x.Bond)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.RelayedDeposits != 0 {
+			n += 1 + runtime.Sov(uint64(x.RelayedDeposits))
+		}
+		if len(x.TotalRewards) > 0 {
+			for _, e := range x.TotalRewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SlashCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashCount))
+		}
+		if x.UnbondingTime != nil {
+			l = options.Size(x.UnbondingTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Relayer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnbondingTime != nil {
+			encoded, err := options.Marshal(x.UnbondingTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.SlashCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TotalRewards) > 0 {
+			for iNdEx := len(x.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.RelayedDeposits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RelayedDeposits))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Bond != nil {
+			encoded, err := options.Marshal(x.Bond)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Relayer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Relayer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Relayer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Bond == nil {
+					x.Bond = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bond); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RelayerStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayedDeposits", wireType)
+				}
+				x.RelayedDeposits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RelayedDeposits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalRewards = append(x.TotalRewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalRewards[len(x.TotalRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+				}
+				x.SlashCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnbondingTime == nil {
+					x.UnbondingTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RunesAttestation         protoreflect.MessageDescriptor
+	fd_RunesAttestation_txid    protoreflect.FieldDescriptor
+	fd_RunesAttestation_relayer protoreflect.FieldDescriptor
+	fd_RunesAttestation_height  protoreflect.FieldDescriptor
+	fd_RunesAttestation_slashed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_RunesAttestation = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("RunesAttestation")
+	fd_RunesAttestation_txid = md_RunesAttestation.Fields().ByName("txid")
+	fd_RunesAttestation_relayer = md_RunesAttestation.Fields().ByName("relayer")
+	fd_RunesAttestation_height = md_RunesAttestation.Fields().ByName("height")
+	fd_RunesAttestation_slashed = md_RunesAttestation.Fields().ByName("slashed")
+}
+
+var _ protoreflect.Message = (*fastReflection_RunesAttestation)(nil)
+
+type fastReflection_RunesAttestation RunesAttestation
+
+func (x *RunesAttestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RunesAttestation)(x)
+}
+
+func (x *RunesAttestation) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RunesAttestation_messageType fastReflection_RunesAttestation_messageType
+var _ protoreflect.MessageType = fastReflection_RunesAttestation_messageType{}
+
+type fastReflection_RunesAttestation_messageType struct{}
+
+func (x fastReflection_RunesAttestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RunesAttestation)(nil)
+}
+func (x fastReflection_RunesAttestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_RunesAttestation)
+}
+func (x fastReflection_RunesAttestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesAttestation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RunesAttestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesAttestation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RunesAttestation) Type() protoreflect.MessageType {
+	return _fastReflection_RunesAttestation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RunesAttestation) New() protoreflect.Message {
+	return new(fastReflection_RunesAttestation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RunesAttestation) Interface() protoreflect.ProtoMessage {
+	return (*RunesAttestation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RunesAttestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_RunesAttestation_txid, value) {
+			return
+		}
+	}
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_RunesAttestation_relayer, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_RunesAttestation_height, value) {
+			return
+		}
+	}
+	if x.Slashed != false {
+		value := protoreflect.ValueOfBool(x.Slashed)
+		if !f(fd_RunesAttestation_slashed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RunesAttestation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		return x.Txid != ""
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		return x.Relayer != ""
+	case "bitway.btcbridge.RunesAttestation.height":
+		return x.Height != int64(0)
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		return x.Slashed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesAttestation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		x.Txid = ""
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		x.Relayer = ""
+	case "bitway.btcbridge.RunesAttestation.height":
+		x.Height = int64(0)
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		x.Slashed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RunesAttestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesAttestation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		value := x.Slashed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesAttestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		x.Txid = value.Interface().(string)
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		x.Relayer = value.Interface().(string)
+	case "bitway.btcbridge.RunesAttestation.height":
+		x.Height = value.Int()
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		x.Slashed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesAttestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		panic(fmt.Errorf("field txid of message bitway.btcbridge.RunesAttestation is not mutable"))
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		panic(fmt.Errorf("field relayer of message bitway.btcbridge.RunesAttestation is not mutable"))
+	case "bitway.btcbridge.RunesAttestation.height":
+		panic(fmt.Errorf("field height of message bitway.btcbridge.RunesAttestation is not mutable"))
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		panic(fmt.Errorf("field slashed of message bitway.btcbridge.RunesAttestation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RunesAttestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesAttestation.txid":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesAttestation.relayer":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesAttestation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.RunesAttestation.slashed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesAttestation"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RunesAttestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RunesAttestation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RunesAttestation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesAttestation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RunesAttestation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RunesAttestation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RunesAttestation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Slashed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RunesAttestation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Slashed {
+			i--
+			if x.Slashed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RunesAttestation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesAttestation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Slashed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Pause            protoreflect.MessageDescriptor
+	fd_Pause_id         protoreflect.FieldDescriptor
+	fd_Pause_target     protoreflect.FieldDescriptor
+	fd_Pause_asset_type protoreflect.FieldDescriptor
+	fd_Pause_initiator  protoreflect.FieldDescriptor
+	fd_Pause_reason     protoreflect.FieldDescriptor
+	fd_Pause_start_time protoreflect.FieldDescriptor
+	fd_Pause_end_time   protoreflect.FieldDescriptor
+	fd_Pause_extensions protoreflect.FieldDescriptor
+	fd_Pause_status     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_Pause = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("Pause")
+	fd_Pause_id = md_Pause.Fields().ByName("id")
+	fd_Pause_target = md_Pause.Fields().ByName("target")
+	fd_Pause_asset_type = md_Pause.Fields().ByName("asset_type")
+	fd_Pause_initiator = md_Pause.Fields().ByName("initiator")
+	fd_Pause_reason = md_Pause.Fields().ByName("reason")
+	fd_Pause_start_time = md_Pause.Fields().ByName("start_time")
+	fd_Pause_end_time = md_Pause.Fields().ByName("end_time")
+	fd_Pause_extensions = md_Pause.Fields().ByName("extensions")
+	fd_Pause_status = md_Pause.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_Pause)(nil)
+
+type fastReflection_Pause Pause
+
+func (x *Pause) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Pause)(x)
+}
+
+func (x *Pause) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Pause_messageType fastReflection_Pause_messageType
+var _ protoreflect.MessageType = fastReflection_Pause_messageType{}
+
+type fastReflection_Pause_messageType struct{}
+
+func (x fastReflection_Pause_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Pause)(nil)
+}
+func (x fastReflection_Pause_messageType) New() protoreflect.Message {
+	return new(fastReflection_Pause)
+}
+func (x fastReflection_Pause_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pause
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Pause) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pause
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Pause) Type() protoreflect.MessageType {
+	return _fastReflection_Pause_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Pause) New() protoreflect.Message {
+	return new(fastReflection_Pause)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Pause) Interface() protoreflect.ProtoMessage {
+	return (*Pause)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Pause) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Pause_id, value) {
+			return
+		}
+	}
+	if x.Target != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Target))
+		if !f(fd_Pause_target, value) {
+			return
+		}
+	}
+	if x.AssetType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AssetType))
+		if !f(fd_Pause_asset_type, value) {
+			return
+		}
+	}
+	if x.Initiator != "" {
+		value := protoreflect.ValueOfString(x.Initiator)
+		if !f(fd_Pause_initiator, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_Pause_reason, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_Pause_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_Pause_end_time, value) {
+			return
+		}
+	}
+	if x.Extensions != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Extensions)
+		if !f(fd_Pause_extensions, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Pause_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Pause) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Pause.id":
+		return x.Id != uint64(0)
+	case "bitway.btcbridge.Pause.target":
+		return x.Target != 0
+	case "bitway.btcbridge.Pause.asset_type":
+		return x.AssetType != 0
+	case "bitway.btcbridge.Pause.initiator":
+		return x.Initiator != ""
+	case "bitway.btcbridge.Pause.reason":
+		return x.Reason != ""
+	case "bitway.btcbridge.Pause.start_time":
+		return x.StartTime != nil
+	case "bitway.btcbridge.Pause.end_time":
+		return x.EndTime != nil
+	case "bitway.btcbridge.Pause.extensions":
+		return x.Extensions != uint32(0)
+	case "bitway.btcbridge.Pause.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pause) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Pause.id":
+		x.Id = uint64(0)
+	case "bitway.btcbridge.Pause.target":
+		x.Target = 0
+	case "bitway.btcbridge.Pause.asset_type":
+		x.AssetType = 0
+	case "bitway.btcbridge.Pause.initiator":
+		x.Initiator = ""
+	case "bitway.btcbridge.Pause.reason":
+		x.Reason = ""
+	case "bitway.btcbridge.Pause.start_time":
+		x.StartTime = nil
+	case "bitway.btcbridge.Pause.end_time":
+		x.EndTime = nil
+	case "bitway.btcbridge.Pause.extensions":
+		x.Extensions = uint32(0)
+	case "bitway.btcbridge.Pause.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Pause) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.Pause.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.Pause.target":
+		value := x.Target
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.Pause.asset_type":
+		value := x.AssetType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.Pause.initiator":
+		value := x.Initiator
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.Pause.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.Pause.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Pause.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Pause.extensions":
+		value := x.Extensions
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.Pause.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pause) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Pause.id":
+		x.Id = value.Uint()
+	case "bitway.btcbridge.Pause.target":
+		x.Target = (PauseTarget)(value.Enum())
+	case "bitway.btcbridge.Pause.asset_type":
+		x.AssetType = (AssetType)(value.Enum())
+	case "bitway.btcbridge.Pause.initiator":
+		x.Initiator = value.Interface().(string)
+	case "bitway.btcbridge.Pause.reason":
+		x.Reason = value.Interface().(string)
+	case "bitway.btcbridge.Pause.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.Pause.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.btcbridge.Pause.extensions":
+		x.Extensions = uint32(value.Uint())
+	case "bitway.btcbridge.Pause.status":
+		x.Status = (PauseStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pause) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Pause.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "bitway.btcbridge.Pause.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "bitway.btcbridge.Pause.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.target":
+		panic(fmt.Errorf("field target of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.asset_type":
+		panic(fmt.Errorf("field asset_type of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.initiator":
+		panic(fmt.Errorf("field initiator of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.reason":
+		panic(fmt.Errorf("field reason of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.extensions":
+		panic(fmt.Errorf("field extensions of message bitway.btcbridge.Pause is not mutable"))
+	case "bitway.btcbridge.Pause.status":
+		panic(fmt.Errorf("field status of message bitway.btcbridge.Pause is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Pause) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.Pause.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.Pause.target":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.Pause.asset_type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.Pause.initiator":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.Pause.reason":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.Pause.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Pause.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Pause.extensions":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.Pause.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Pause"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.Pause does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Pause) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.Pause", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Pause) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pause) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Pause) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Pause) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Pause)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Target != 0 {
+			n += 1 + runtime.Sov(uint64(x.Target))
+		}
+		if x.AssetType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AssetType))
+		}
+		l = len(x.Initiator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Extensions != 0 {
+			n += 1 + runtime.Sov(uint64(x.Extensions))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Pause)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Extensions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Extensions))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Initiator) > 0 {
+			i -= len(x.Initiator)
+			copy(dAtA[i:], x.Initiator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Initiator)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.AssetType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssetType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Target != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Target))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Pause)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pause: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				x.Target = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Target |= PauseTarget(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+				}
+				x.AssetType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AssetType |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Initiator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+				}
+				x.Extensions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Extensions |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PauseStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScreenedAddress         protoreflect.MessageDescriptor
+	fd_ScreenedAddress_address protoreflect.FieldDescriptor
+	fd_ScreenedAddress_source  protoreflect.FieldDescriptor
+	fd_ScreenedAddress_reason  protoreflect.FieldDescriptor
+	fd_ScreenedAddress_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_ScreenedAddress = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("ScreenedAddress")
+	fd_ScreenedAddress_address = md_ScreenedAddress.Fields().ByName("address")
+	fd_ScreenedAddress_source = md_ScreenedAddress.Fields().ByName("source")
+	fd_ScreenedAddress_reason = md_ScreenedAddress.Fields().ByName("reason")
+	fd_ScreenedAddress_height = md_ScreenedAddress.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScreenedAddress)(nil)
+
+type fastReflection_ScreenedAddress ScreenedAddress
+
+func (x *ScreenedAddress) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScreenedAddress)(x)
+}
+
+func (x *ScreenedAddress) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScreenedAddress_messageType fastReflection_ScreenedAddress_messageType
+var _ protoreflect.MessageType = fastReflection_ScreenedAddress_messageType{}
+
+type fastReflection_ScreenedAddress_messageType struct{}
+
+func (x fastReflection_ScreenedAddress_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScreenedAddress)(nil)
+}
+func (x fastReflection_ScreenedAddress_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScreenedAddress)
+}
+func (x fastReflection_ScreenedAddress_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScreenedAddress
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScreenedAddress) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScreenedAddress
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScreenedAddress) Type() protoreflect.MessageType {
+	return _fastReflection_ScreenedAddress_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScreenedAddress) New() protoreflect.Message {
+	return new(fastReflection_ScreenedAddress)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScreenedAddress) Interface() protoreflect.ProtoMessage {
+	return (*ScreenedAddress)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScreenedAddress) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ScreenedAddress_address, value) {
+			return
+		}
+	}
+	if x.Source != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Source))
+		if !f(fd_ScreenedAddress_source, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ScreenedAddress_reason, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScreenedAddress_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScreenedAddress) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		return x.Address != ""
+	case "bitway.btcbridge.ScreenedAddress.source":
+		return x.Source != 0
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		return x.Reason != ""
+	case "bitway.btcbridge.ScreenedAddress.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreenedAddress) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		x.Address = ""
+	case "bitway.btcbridge.ScreenedAddress.source":
+		x.Source = 0
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		x.Reason = ""
+	case "bitway.btcbridge.ScreenedAddress.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScreenedAddress) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.ScreenedAddress.source":
+		value := x.Source
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.ScreenedAddress.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreenedAddress) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		x.Address = value.Interface().(string)
+	case "bitway.btcbridge.ScreenedAddress.source":
+		x.Source = (ScreeningSource)(value.Enum())
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		x.Reason = value.Interface().(string)
+	case "bitway.btcbridge.ScreenedAddress.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreenedAddress) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		panic(fmt.Errorf("field address of message bitway.btcbridge.ScreenedAddress is not mutable"))
+	case "bitway.btcbridge.ScreenedAddress.source":
+		panic(fmt.Errorf("field source of message bitway.btcbridge.ScreenedAddress is not mutable"))
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		panic(fmt.Errorf("field reason of message bitway.btcbridge.ScreenedAddress is not mutable"))
+	case "bitway.btcbridge.ScreenedAddress.height":
+		panic(fmt.Errorf("field height of message bitway.btcbridge.ScreenedAddress is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScreenedAddress) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.ScreenedAddress.address":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.ScreenedAddress.source":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.ScreenedAddress.reason":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.ScreenedAddress.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ScreenedAddress"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.ScreenedAddress does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScreenedAddress) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.ScreenedAddress", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScreenedAddress) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreenedAddress) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScreenedAddress) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScreenedAddress) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScreenedAddress)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Source != 0 {
+			n += 1 + runtime.Sov(uint64(x.Source))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScreenedAddress)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Source != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Source))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScreenedAddress)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScreenedAddress: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScreenedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				x.Source = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Source |= ScreeningSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuarantinedDeposit           protoreflect.MessageDescriptor
+	fd_QuarantinedDeposit_txid      protoreflect.FieldDescriptor
+	fd_QuarantinedDeposit_sender    protoreflect.FieldDescriptor
+	fd_QuarantinedDeposit_recipient protoreflect.FieldDescriptor
+	fd_QuarantinedDeposit_amount    protoreflect.FieldDescriptor
+	fd_QuarantinedDeposit_height    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_QuarantinedDeposit = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("QuarantinedDeposit")
+	fd_QuarantinedDeposit_txid = md_QuarantinedDeposit.Fields().ByName("txid")
+	fd_QuarantinedDeposit_sender = md_QuarantinedDeposit.Fields().ByName("sender")
+	fd_QuarantinedDeposit_recipient = md_QuarantinedDeposit.Fields().ByName("recipient")
+	fd_QuarantinedDeposit_amount = md_QuarantinedDeposit.Fields().ByName("amount")
+	fd_QuarantinedDeposit_height = md_QuarantinedDeposit.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuarantinedDeposit)(nil)
+
+type fastReflection_QuarantinedDeposit QuarantinedDeposit
+
+func (x *QuarantinedDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuarantinedDeposit)(x)
+}
+
+func (x *QuarantinedDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuarantinedDeposit_messageType fastReflection_QuarantinedDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_QuarantinedDeposit_messageType{}
+
+type fastReflection_QuarantinedDeposit_messageType struct{}
+
+func (x fastReflection_QuarantinedDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuarantinedDeposit)(nil)
+}
+func (x fastReflection_QuarantinedDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuarantinedDeposit)
+}
+func (x fastReflection_QuarantinedDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuarantinedDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuarantinedDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuarantinedDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuarantinedDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_QuarantinedDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuarantinedDeposit) New() protoreflect.Message {
+	return new(fastReflection_QuarantinedDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuarantinedDeposit) Interface() protoreflect.ProtoMessage {
+	return (*QuarantinedDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuarantinedDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_QuarantinedDeposit_txid, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_QuarantinedDeposit_sender, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_QuarantinedDeposit_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QuarantinedDeposit_amount, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_QuarantinedDeposit_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuarantinedDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		return x.Txid != ""
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		return x.Sender != ""
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		return x.Recipient != ""
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		return x.Amount != nil
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		x.Txid = ""
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		x.Sender = ""
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		x.Recipient = ""
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		x.Amount = nil
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuarantinedDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		x.Txid = value.Interface().(string)
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		x.Recipient = value.Interface().(string)
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		panic(fmt.Errorf("field txid of message bitway.btcbridge.QuarantinedDeposit is not mutable"))
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.QuarantinedDeposit is not mutable"))
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		panic(fmt.Errorf("field recipient of message bitway.btcbridge.QuarantinedDeposit is not mutable"))
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		panic(fmt.Errorf("field height of message bitway.btcbridge.QuarantinedDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuarantinedDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedDeposit.txid":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.QuarantinedDeposit.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.QuarantinedDeposit.recipient":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.QuarantinedDeposit.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedDeposit.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedDeposit"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuarantinedDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.QuarantinedDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuarantinedDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuarantinedDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuarantinedDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuarantinedDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuarantinedDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuarantinedDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuarantinedWithdrawal          protoreflect.MessageDescriptor
+	fd_QuarantinedWithdrawal_sequence protoreflect.FieldDescriptor
+	fd_QuarantinedWithdrawal_address  protoreflect.FieldDescriptor
+	fd_QuarantinedWithdrawal_amount   protoreflect.FieldDescriptor
+	fd_QuarantinedWithdrawal_sender   protoreflect.FieldDescriptor
+	fd_QuarantinedWithdrawal_group    protoreflect.FieldDescriptor
+	fd_QuarantinedWithdrawal_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_QuarantinedWithdrawal = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("QuarantinedWithdrawal")
+	fd_QuarantinedWithdrawal_sequence = md_QuarantinedWithdrawal.Fields().ByName("sequence")
+	fd_QuarantinedWithdrawal_address = md_QuarantinedWithdrawal.Fields().ByName("address")
+	fd_QuarantinedWithdrawal_amount = md_QuarantinedWithdrawal.Fields().ByName("amount")
+	fd_QuarantinedWithdrawal_sender = md_QuarantinedWithdrawal.Fields().ByName("sender")
+	fd_QuarantinedWithdrawal_group = md_QuarantinedWithdrawal.Fields().ByName("group")
+	fd_QuarantinedWithdrawal_height = md_QuarantinedWithdrawal.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuarantinedWithdrawal)(nil)
+
+type fastReflection_QuarantinedWithdrawal QuarantinedWithdrawal
+
+func (x *QuarantinedWithdrawal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuarantinedWithdrawal)(x)
+}
+
+func (x *QuarantinedWithdrawal) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuarantinedWithdrawal_messageType fastReflection_QuarantinedWithdrawal_messageType
+var _ protoreflect.MessageType = fastReflection_QuarantinedWithdrawal_messageType{}
+
+type fastReflection_QuarantinedWithdrawal_messageType struct{}
+
+func (x fastReflection_QuarantinedWithdrawal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuarantinedWithdrawal)(nil)
+}
+func (x fastReflection_QuarantinedWithdrawal_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuarantinedWithdrawal)
+}
+func (x fastReflection_QuarantinedWithdrawal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuarantinedWithdrawal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuarantinedWithdrawal) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuarantinedWithdrawal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuarantinedWithdrawal) Type() protoreflect.MessageType {
+	return _fastReflection_QuarantinedWithdrawal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuarantinedWithdrawal) New() protoreflect.Message {
+	return new(fastReflection_QuarantinedWithdrawal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuarantinedWithdrawal) Interface() protoreflect.ProtoMessage {
+	return (*QuarantinedWithdrawal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuarantinedWithdrawal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_QuarantinedWithdrawal_sequence, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QuarantinedWithdrawal_address, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QuarantinedWithdrawal_amount, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_QuarantinedWithdrawal_sender, value) {
+			return
+		}
+	}
+	if x.Group != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Group)
+		if !f(fd_QuarantinedWithdrawal_group, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_QuarantinedWithdrawal_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuarantinedWithdrawal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		return x.Sequence != uint64(0)
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		return x.Address != ""
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		return x.Amount != nil
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		return x.Sender != ""
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		return x.Group != uint64(0)
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedWithdrawal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		x.Sequence = uint64(0)
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		x.Address = ""
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		x.Amount = nil
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		x.Sender = ""
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		x.Group = uint64(0)
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuarantinedWithdrawal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		value := x.Group
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedWithdrawal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		x.Sequence = value.Uint()
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		x.Address = value.Interface().(string)
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		x.Group = value.Uint()
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedWithdrawal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		panic(fmt.Errorf("field sequence of message bitway.btcbridge.QuarantinedWithdrawal is not mutable"))
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		panic(fmt.Errorf("field address of message bitway.btcbridge.QuarantinedWithdrawal is not mutable"))
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.QuarantinedWithdrawal is not mutable"))
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		panic(fmt.Errorf("field group of message bitway.btcbridge.QuarantinedWithdrawal is not mutable"))
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		panic(fmt.Errorf("field height of message bitway.btcbridge.QuarantinedWithdrawal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuarantinedWithdrawal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.QuarantinedWithdrawal.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.QuarantinedWithdrawal.address":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.QuarantinedWithdrawal.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.QuarantinedWithdrawal.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.QuarantinedWithdrawal.group":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.QuarantinedWithdrawal.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.QuarantinedWithdrawal"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.QuarantinedWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuarantinedWithdrawal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.QuarantinedWithdrawal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuarantinedWithdrawal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuarantinedWithdrawal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuarantinedWithdrawal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuarantinedWithdrawal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuarantinedWithdrawal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Group != 0 {
+			n += 1 + runtime.Sov(uint64(x.Group))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuarantinedWithdrawal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Group != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Group))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuarantinedWithdrawal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuarantinedWithdrawal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuarantinedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				}
+				x.Group = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Group |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DepositRecord                  protoreflect.MessageDescriptor
+	fd_DepositRecord_txid             protoreflect.FieldDescriptor
+	fd_DepositRecord_vout             protoreflect.FieldDescriptor
+	fd_DepositRecord_sender           protoreflect.FieldDescriptor
+	fd_DepositRecord_recipient        protoreflect.FieldDescriptor
+	fd_DepositRecord_asset            protoreflect.FieldDescriptor
+	fd_DepositRecord_amount           protoreflect.FieldDescriptor
+	fd_DepositRecord_fee              protoreflect.FieldDescriptor
+	fd_DepositRecord_btc_block_height protoreflect.FieldDescriptor
+	fd_DepositRecord_height           protoreflect.FieldDescriptor
+	fd_DepositRecord_quarantined      protoreflect.FieldDescriptor
+	fd_DepositRecord_ibc_transfer     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_DepositRecord = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DepositRecord")
+	fd_DepositRecord_txid = md_DepositRecord.Fields().ByName("txid")
+	fd_DepositRecord_vout = md_DepositRecord.Fields().ByName("vout")
+	fd_DepositRecord_sender = md_DepositRecord.Fields().ByName("sender")
+	fd_DepositRecord_recipient = md_DepositRecord.Fields().ByName("recipient")
+	fd_DepositRecord_asset = md_DepositRecord.Fields().ByName("asset")
+	fd_DepositRecord_amount = md_DepositRecord.Fields().ByName("amount")
+	fd_DepositRecord_fee = md_DepositRecord.Fields().ByName("fee")
+	fd_DepositRecord_btc_block_height = md_DepositRecord.Fields().ByName("btc_block_height")
+	fd_DepositRecord_height = md_DepositRecord.Fields().ByName("height")
+	fd_DepositRecord_quarantined = md_DepositRecord.Fields().ByName("quarantined")
+	fd_DepositRecord_ibc_transfer = md_DepositRecord.Fields().ByName("ibc_transfer")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositRecord)(nil)
+
+type fastReflection_DepositRecord DepositRecord
+
+func (x *DepositRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositRecord)(x)
+}
+
+func (x *DepositRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositRecord_messageType fastReflection_DepositRecord_messageType
+var _ protoreflect.MessageType = fastReflection_DepositRecord_messageType{}
+
+type fastReflection_DepositRecord_messageType struct{}
+
+func (x fastReflection_DepositRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositRecord)(nil)
+}
+func (x fastReflection_DepositRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositRecord)
+}
+func (x fastReflection_DepositRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositRecord) Type() protoreflect.MessageType {
+	return _fastReflection_DepositRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositRecord) New() protoreflect.Message {
+	return new(fastReflection_DepositRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositRecord) Interface() protoreflect.ProtoMessage {
+	return (*DepositRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_DepositRecord_txid, value) {
+			return
+		}
+	}
+	if x.Vout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Vout)
+		if !f(fd_DepositRecord_vout, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_DepositRecord_sender, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_DepositRecord_recipient, value) {
+			return
+		}
+	}
+	if x.Asset != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Asset))
+		if !f(fd_DepositRecord_asset, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_DepositRecord_amount, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_DepositRecord_fee, value) {
+			return
+		}
+	}
+	if x.BtcBlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BtcBlockHeight)
+		if !f(fd_DepositRecord_btc_block_height, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DepositRecord_height, value) {
+			return
+		}
+	}
+	if x.Quarantined != false {
+		value := protoreflect.ValueOfBool(x.Quarantined)
+		if !f(fd_DepositRecord_quarantined, value) {
+			return
+		}
+	}
+	if x.IbcTransfer != nil {
+		value := protoreflect.ValueOfMessage(x.IbcTransfer.ProtoReflect())
+		if !f(fd_DepositRecord_ibc_transfer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositRecord.txid":
+		return x.Txid != ""
+	case "bitway.btcbridge.DepositRecord.vout":
+		return x.Vout != uint64(0)
+	case "bitway.btcbridge.DepositRecord.sender":
+		return x.Sender != ""
+	case "bitway.btcbridge.DepositRecord.recipient":
+		return x.Recipient != ""
+	case "bitway.btcbridge.DepositRecord.asset":
+		return x.Asset != 0
+	case "bitway.btcbridge.DepositRecord.amount":
+		return x.Amount != nil
+	case "bitway.btcbridge.DepositRecord.fee":
+		return x.Fee != nil
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		return x.BtcBlockHeight != uint64(0)
+	case "bitway.btcbridge.DepositRecord.height":
+		return x.Height != int64(0)
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		return x.Quarantined != false
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		return x.IbcTransfer != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositRecord.txid":
+		x.Txid = ""
+	case "bitway.btcbridge.DepositRecord.vout":
+		x.Vout = uint64(0)
+	case "bitway.btcbridge.DepositRecord.sender":
+		x.Sender = ""
+	case "bitway.btcbridge.DepositRecord.recipient":
+		x.Recipient = ""
+	case "bitway.btcbridge.DepositRecord.asset":
+		x.Asset = 0
+	case "bitway.btcbridge.DepositRecord.amount":
+		x.Amount = nil
+	case "bitway.btcbridge.DepositRecord.fee":
+		x.Fee = nil
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		x.BtcBlockHeight = uint64(0)
+	case "bitway.btcbridge.DepositRecord.height":
+		x.Height = int64(0)
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		x.Quarantined = false
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		x.IbcTransfer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DepositRecord.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DepositRecord.vout":
+		value := x.Vout
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.DepositRecord.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DepositRecord.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DepositRecord.asset":
+		value := x.Asset
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.btcbridge.DepositRecord.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		value := x.BtcBlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.DepositRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		value := x.Quarantined
+		return protoreflect.ValueOfBool(value)
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		value := x.IbcTransfer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositRecord.txid":
+		x.Txid = value.Interface().(string)
+	case "bitway.btcbridge.DepositRecord.vout":
+		x.Vout = value.Uint()
+	case "bitway.btcbridge.DepositRecord.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.btcbridge.DepositRecord.recipient":
+		x.Recipient = value.Interface().(string)
+	case "bitway.btcbridge.DepositRecord.asset":
+		x.Asset = (AssetType)(value.Enum())
+	case "bitway.btcbridge.DepositRecord.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.DepositRecord.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		x.BtcBlockHeight = value.Uint()
+	case "bitway.btcbridge.DepositRecord.height":
+		x.Height = value.Int()
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		x.Quarantined = value.Bool()
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		x.IbcTransfer = value.Message().Interface().(*DepositIBCTransfer)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositRecord.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		if x.IbcTransfer == nil {
+			x.IbcTransfer = new(DepositIBCTransfer)
+		}
+		return protoreflect.ValueOfMessage(x.IbcTransfer.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.txid":
+		panic(fmt.Errorf("field txid of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.vout":
+		panic(fmt.Errorf("field vout of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.sender":
+		panic(fmt.Errorf("field sender of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.recipient":
+		panic(fmt.Errorf("field recipient of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.asset":
+		panic(fmt.Errorf("field asset of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		panic(fmt.Errorf("field btc_block_height of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.height":
+		panic(fmt.Errorf("field height of message bitway.btcbridge.DepositRecord is not mutable"))
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		panic(fmt.Errorf("field quarantined of message bitway.btcbridge.DepositRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositRecord.txid":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DepositRecord.vout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.DepositRecord.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DepositRecord.recipient":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DepositRecord.asset":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.btcbridge.DepositRecord.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.DepositRecord.btc_block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.DepositRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.DepositRecord.quarantined":
+		return protoreflect.ValueOfBool(false)
+	case "bitway.btcbridge.DepositRecord.ibc_transfer":
+		m := new(DepositIBCTransfer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositRecord"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DepositRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Vout != 0 {
+			n += 1 + runtime.Sov(uint64(x.Vout))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Asset != 0 {
+			n += 1 + runtime.Sov(uint64(x.Asset))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BtcBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcBlockHeight))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Quarantined {
+			n += 2
+		}
+		if x.IbcTransfer != nil {
+			l = options.Size(x.IbcTransfer)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IbcTransfer != nil {
+			encoded, err := options.Marshal(x.IbcTransfer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Quarantined {
+			i--
+			if x.Quarantined {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.BtcBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcBlockHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Asset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Asset))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Vout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Vout))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+				}
+				x.Vout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Vout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				x.Asset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Asset |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHeight", wireType)
+				}
+				x.BtcBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Quarantined = bool(v != 0)
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcTransfer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IbcTransfer == nil {
+					x.IbcTransfer = &DepositIBCTransfer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcTransfer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DepositIBCTransfer            protoreflect.MessageDescriptor
+	fd_DepositIBCTransfer_channel_id protoreflect.FieldDescriptor
+	fd_DepositIBCTransfer_recipient  protoreflect.FieldDescriptor
+	fd_DepositIBCTransfer_sequence   protoreflect.FieldDescriptor
+	fd_DepositIBCTransfer_error      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_btcbridge_proto_init()
+	md_DepositIBCTransfer = File_bitway_btcbridge_btcbridge_proto.Messages().ByName("DepositIBCTransfer")
+	fd_DepositIBCTransfer_channel_id = md_DepositIBCTransfer.Fields().ByName("channel_id")
+	fd_DepositIBCTransfer_recipient = md_DepositIBCTransfer.Fields().ByName("recipient")
+	fd_DepositIBCTransfer_sequence = md_DepositIBCTransfer.Fields().ByName("sequence")
+	fd_DepositIBCTransfer_error = md_DepositIBCTransfer.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositIBCTransfer)(nil)
+
+type fastReflection_DepositIBCTransfer DepositIBCTransfer
+
+func (x *DepositIBCTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositIBCTransfer)(x)
+}
+
+func (x *DepositIBCTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositIBCTransfer_messageType fastReflection_DepositIBCTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_DepositIBCTransfer_messageType{}
+
+type fastReflection_DepositIBCTransfer_messageType struct{}
+
+func (x fastReflection_DepositIBCTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositIBCTransfer)(nil)
+}
+func (x fastReflection_DepositIBCTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositIBCTransfer)
+}
+func (x fastReflection_DepositIBCTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositIBCTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositIBCTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositIBCTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositIBCTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_DepositIBCTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositIBCTransfer) New() protoreflect.Message {
+	return new(fastReflection_DepositIBCTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositIBCTransfer) Interface() protoreflect.ProtoMessage {
+	return (*DepositIBCTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositIBCTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_DepositIBCTransfer_channel_id, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_DepositIBCTransfer_recipient, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_DepositIBCTransfer_sequence, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_DepositIBCTransfer_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositIBCTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		return x.ChannelId != ""
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		return x.Recipient != ""
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		return x.Sequence != uint64(0)
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositIBCTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		x.ChannelId = ""
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		x.Recipient = ""
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		x.Sequence = uint64(0)
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositIBCTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositIBCTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		x.Recipient = value.Interface().(string)
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		x.Sequence = value.Uint()
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositIBCTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		panic(fmt.Errorf("field channel_id of message bitway.btcbridge.DepositIBCTransfer is not mutable"))
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		panic(fmt.Errorf("field recipient of message bitway.btcbridge.DepositIBCTransfer is not mutable"))
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		panic(fmt.Errorf("field sequence of message bitway.btcbridge.DepositIBCTransfer is not mutable"))
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		panic(fmt.Errorf("field error of message bitway.btcbridge.DepositIBCTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositIBCTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositIBCTransfer.channel_id":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DepositIBCTransfer.recipient":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.DepositIBCTransfer.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.DepositIBCTransfer.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositIBCTransfer"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositIBCTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DepositIBCTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositIBCTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositIBCTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositIBCTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositIBCTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositIBCTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositIBCTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositIBCTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositIBCTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositIBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: bitway/btcbridge/btcbridge.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Bitcoin Signing Status
+type SigningStatus int32
+
+const (
+	// SIGNING_STATUS_UNSPECIFIED - Default value, should not be used
+	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
+	// SIGNING_STATUS_PENDING - The signing request is pending
+	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
+	// SIGNING_STATUS_BROADCASTED - The signing request is broadcasted
+	SigningStatus_SIGNING_STATUS_BROADCASTED SigningStatus = 2
+	// SIGNING_STATUS_CONFIRMED - The signing request is confirmed
+	SigningStatus_SIGNING_STATUS_CONFIRMED SigningStatus = 3
+	// SIGNING_STATUS_FAILED - The signing request failed to be signed or broadcast due to unexpected exceptions
+	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 4
+)
+
+// Enum value maps for SigningStatus.
+var (
+	SigningStatus_name = map[int32]string{
+		0: "SIGNING_STATUS_UNSPECIFIED",
+		1: "SIGNING_STATUS_PENDING",
+		2: "SIGNING_STATUS_BROADCASTED",
+		3: "SIGNING_STATUS_CONFIRMED",
+		4: "SIGNING_STATUS_FAILED",
+	}
+	SigningStatus_value = map[string]int32{
+		"SIGNING_STATUS_UNSPECIFIED": 0,
+		"SIGNING_STATUS_PENDING":     1,
+		"SIGNING_STATUS_BROADCASTED": 2,
+		"SIGNING_STATUS_CONFIRMED":   3,
+		"SIGNING_STATUS_FAILED":      4,
+	}
+)
+
+func (x SigningStatus) Enum() *SigningStatus {
+	p := new(SigningStatus)
+	*p = x
+	return p
+}
+
+func (x SigningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[0].Descriptor()
+}
+
+func (SigningStatus) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[0]
+}
+
+func (x SigningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningStatus.Descriptor instead.
+func (SigningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+}
+
+type DKGRequestStatus int32
+
+const (
+	// DKG_REQUEST_STATUS_UNSPECIFIED defines the unknown DKG request status
+	DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED DKGRequestStatus = 0
+	// DKG_REQUEST_STATUS_PENDING defines the status of the DKG request which is pending
+	DKGRequestStatus_DKG_REQUEST_STATUS_PENDING DKGRequestStatus = 1
+	// DKG_REQUEST_STATUS_COMPLETED defines the status of the DKG request which is completed
+	DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED DKGRequestStatus = 2
+	// DKG_REQUEST_STATUS_FAILED defines the status of the DKG request which failed
+	DKGRequestStatus_DKG_REQUEST_STATUS_FAILED DKGRequestStatus = 3
+	// DKG_REQUEST_STATUS_TIMEDOUT defines the status of the DKG request which timed out
+	DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT DKGRequestStatus = 4
+)
+
+// Enum value maps for DKGRequestStatus.
+var (
+	DKGRequestStatus_name = map[int32]string{
+		0: "DKG_REQUEST_STATUS_UNSPECIFIED",
+		1: "DKG_REQUEST_STATUS_PENDING",
+		2: "DKG_REQUEST_STATUS_COMPLETED",
+		3: "DKG_REQUEST_STATUS_FAILED",
+		4: "DKG_REQUEST_STATUS_TIMEDOUT",
+	}
+	DKGRequestStatus_value = map[string]int32{
+		"DKG_REQUEST_STATUS_UNSPECIFIED": 0,
+		"DKG_REQUEST_STATUS_PENDING":     1,
+		"DKG_REQUEST_STATUS_COMPLETED":   2,
+		"DKG_REQUEST_STATUS_FAILED":      3,
+		"DKG_REQUEST_STATUS_TIMEDOUT":    4,
+	}
+)
+
+func (x DKGRequestStatus) Enum() *DKGRequestStatus {
+	p := new(DKGRequestStatus)
+	*p = x
+	return p
+}
+
+func (x DKGRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DKGRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[1].Descriptor()
+}
+
+func (DKGRequestStatus) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[1]
+}
+
+func (x DKGRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DKGRequestStatus.Descriptor instead.
+func (DKGRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+}
+
+// Refreshing Status
+type RefreshingStatus int32
+
+const (
+	// REFRESHING_STATUS_UNSPECIFIED defines the unknown refreshing status
+	RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED RefreshingStatus = 0
+	// REFRESHING_STATUS_PENDING defines the status of the refreshing request which is pending
+	RefreshingStatus_REFRESHING_STATUS_PENDING RefreshingStatus = 1
+	// REFRESHING_STATUS_COMPLETED defines the status of the refreshing request which is completed
+	RefreshingStatus_REFRESHING_STATUS_COMPLETED RefreshingStatus = 2
+	// REFRESHING_STATUS_TIMEDOUT defines the status of the refreshing request which timed out
+	RefreshingStatus_REFRESHING_STATUS_TIMEDOUT RefreshingStatus = 3
+)
+
+// Enum value maps for RefreshingStatus.
+var (
+	RefreshingStatus_name = map[int32]string{
+		0: "REFRESHING_STATUS_UNSPECIFIED",
+		1: "REFRESHING_STATUS_PENDING",
+		2: "REFRESHING_STATUS_COMPLETED",
+		3: "REFRESHING_STATUS_TIMEDOUT",
+	}
+	RefreshingStatus_value = map[string]int32{
+		"REFRESHING_STATUS_UNSPECIFIED": 0,
+		"REFRESHING_STATUS_PENDING":     1,
+		"REFRESHING_STATUS_COMPLETED":   2,
+		"REFRESHING_STATUS_TIMEDOUT":    3,
+	}
+)
+
+func (x RefreshingStatus) Enum() *RefreshingStatus {
+	p := new(RefreshingStatus)
+	*p = x
+	return p
+}
+
+func (x RefreshingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[2].Descriptor()
+}
+
+func (RefreshingStatus) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[2]
+}
+
+func (x RefreshingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshingStatus.Descriptor instead.
+func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+}
+
+// Relayer Status
+type RelayerStatus int32
+
+const (
+	// RELAYER_STATUS_UNSPECIFIED defines the unknown relayer status
+	RelayerStatus_RELAYER_STATUS_UNSPECIFIED RelayerStatus = 0
+	// RELAYER_STATUS_BONDED defines the status of the relayer which is bonded
+	RelayerStatus_RELAYER_STATUS_BONDED RelayerStatus = 1
+	// RELAYER_STATUS_UNBONDING defines the status of the relayer which is unbonding
+	RelayerStatus_RELAYER_STATUS_UNBONDING RelayerStatus = 2
+)
+
+// Enum value maps for RelayerStatus.
+var (
+	RelayerStatus_name = map[int32]string{
+		0: "RELAYER_STATUS_UNSPECIFIED",
+		1: "RELAYER_STATUS_BONDED",
+		2: "RELAYER_STATUS_UNBONDING",
+	}
+	RelayerStatus_value = map[string]int32{
+		"RELAYER_STATUS_UNSPECIFIED": 0,
+		"RELAYER_STATUS_BONDED":      1,
+		"RELAYER_STATUS_UNBONDING":   2,
+	}
+)
+
+func (x RelayerStatus) Enum() *RelayerStatus {
+	p := new(RelayerStatus)
+	*p = x
+	return p
+}
+
+func (x RelayerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[3].Descriptor()
+}
+
+func (RelayerStatus) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[3]
+}
+
+func (x RelayerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelayerStatus.Descriptor instead.
+func (RelayerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+}
+
+// Relayer Misbehaviour
+// The validity of the runes attestation depends on the runes protocol state which is not available on chain,
+// so the misbehaviour is adjudicated by governance against the recorded attestation
+type RelayerMisbehaviour int32
+
+const (
+	// RELAYER_MISBEHAVIOUR_UNSPECIFIED defines the unknown misbehaviour
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_UNSPECIFIED RelayerMisbehaviour = 0
+	// RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION defines the misbehaviour of attesting an invalid runes deposit
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION RelayerMisbehaviour = 1
+	// RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION defines the misbehaviour of attesting a duplicate runes deposit
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION RelayerMisbehaviour = 2
+)
+
+// Enum value maps for RelayerMisbehaviour.
+var (
+	RelayerMisbehaviour_name = map[int32]string{
+		0: "RELAYER_MISBEHAVIOUR_UNSPECIFIED",
+		1: "RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION",
+		2: "RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION",
+	}
+	RelayerMisbehaviour_value = map[string]int32{
+		"RELAYER_MISBEHAVIOUR_UNSPECIFIED":                 0,
+		"RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION":   1,
+		"RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION": 2,
+	}
+)
+
+func (x RelayerMisbehaviour) Enum() *RelayerMisbehaviour {
+	p := new(RelayerMisbehaviour)
+	*p = x
+	return p
+}
+
+func (x RelayerMisbehaviour) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayerMisbehaviour) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[4].Descriptor()
+}
+
+func (RelayerMisbehaviour) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[4]
+}
+
+func (x RelayerMisbehaviour) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelayerMisbehaviour.Descriptor instead.
+func (RelayerMisbehaviour) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+}
+
+// Pause Target
+type PauseTarget int32
+
+const (
+	// PAUSE_TARGET_UNSPECIFIED defines the unknown pause target
+	PauseTarget_PAUSE_TARGET_UNSPECIFIED PauseTarget = 0
+	// PAUSE_TARGET_DEPOSIT defines the pause target for deposits
+	PauseTarget_PAUSE_TARGET_DEPOSIT PauseTarget = 1
+	// PAUSE_TARGET_WITHDRAW defines the pause target for withdrawals
+	PauseTarget_PAUSE_TARGET_WITHDRAW PauseTarget = 2
+	// PAUSE_TARGET_IBC_PEGOUT defines the pause target for the automatic withdrawals via IBC
+	PauseTarget_PAUSE_TARGET_IBC_PEGOUT PauseTarget = 3
+	// PAUSE_TARGET_VAULT_TRANSFER defines the pause target for vault transfers
+	PauseTarget_PAUSE_TARGET_VAULT_TRANSFER PauseTarget = 4
+	// PAUSE_TARGET_ASSET defines the pause target for the specific asset type
+	PauseTarget_PAUSE_TARGET_ASSET PauseTarget = 5
+	// PAUSE_TARGET_LENDING_APPLY defines the pause target for lending applications
+	PauseTarget_PAUSE_TARGET_LENDING_APPLY PauseTarget = 6
+	// PAUSE_TARGET_LIQUIDATION defines the pause target for liquidations
+	PauseTarget_PAUSE_TARGET_LIQUIDATION PauseTarget = 7
+)
+
+// Enum value maps for PauseTarget.
+var (
+	PauseTarget_name = map[int32]string{
+		0: "PAUSE_TARGET_UNSPECIFIED",
+		1: "PAUSE_TARGET_DEPOSIT",
+		2: "PAUSE_TARGET_WITHDRAW",
+		3: "PAUSE_TARGET_IBC_PEGOUT",
+		4: "PAUSE_TARGET_VAULT_TRANSFER",
+		5: "PAUSE_TARGET_ASSET",
+		6: "PAUSE_TARGET_LENDING_APPLY",
+		7: "PAUSE_TARGET_LIQUIDATION",
+	}
+	PauseTarget_value = map[string]int32{
+		"PAUSE_TARGET_UNSPECIFIED":    0,
+		"PAUSE_TARGET_DEPOSIT":        1,
+		"PAUSE_TARGET_WITHDRAW":       2,
+		"PAUSE_TARGET_IBC_PEGOUT":     3,
+		"PAUSE_TARGET_VAULT_TRANSFER": 4,
+		"PAUSE_TARGET_ASSET":          5,
+		"PAUSE_TARGET_LENDING_APPLY":  6,
+		"PAUSE_TARGET_LIQUIDATION":    7,
+	}
+)
+
+func (x PauseTarget) Enum() *PauseTarget {
+	p := new(PauseTarget)
+	*p = x
+	return p
+}
+
+func (x PauseTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[5].Descriptor()
+}
+
+func (PauseTarget) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[5]
+}
+
+func (x PauseTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseTarget.Descriptor instead.
+func (PauseTarget) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+}
+
+// Pause Status
+type PauseStatus int32
+
+const (
+	// PAUSE_STATUS_UNSPECIFIED defines the unknown pause status
+	PauseStatus_PAUSE_STATUS_UNSPECIFIED PauseStatus = 0
+	// PAUSE_STATUS_ACTIVE defines the status of the pause which is in effect
+	PauseStatus_PAUSE_STATUS_ACTIVE PauseStatus = 1
+	// PAUSE_STATUS_EXPIRED defines the status of the pause which expired
+	PauseStatus_PAUSE_STATUS_EXPIRED PauseStatus = 2
+	// PAUSE_STATUS_LIFTED defines the status of the pause which is lifted manually
+	PauseStatus_PAUSE_STATUS_LIFTED PauseStatus = 3
+)
+
+// Enum value maps for PauseStatus.
+var (
+	PauseStatus_name = map[int32]string{
+		0: "PAUSE_STATUS_UNSPECIFIED",
+		1: "PAUSE_STATUS_ACTIVE",
+		2: "PAUSE_STATUS_EXPIRED",
+		3: "PAUSE_STATUS_LIFTED",
+	}
+	PauseStatus_value = map[string]int32{
+		"PAUSE_STATUS_UNSPECIFIED": 0,
+		"PAUSE_STATUS_ACTIVE":      1,
+		"PAUSE_STATUS_EXPIRED":     2,
+		"PAUSE_STATUS_LIFTED":      3,
+	}
+)
+
+func (x PauseStatus) Enum() *PauseStatus {
+	p := new(PauseStatus)
+	*p = x
+	return p
+}
+
+func (x PauseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[6].Descriptor()
+}
+
+func (PauseStatus) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[6]
+}
+
+func (x PauseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseStatus.Descriptor instead.
+func (PauseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+}
+
+// Screening Source
+type ScreeningSource int32
+
+const (
+	// SCREENING_SOURCE_UNSPECIFIED defines the unknown screening source
+	ScreeningSource_SCREENING_SOURCE_UNSPECIFIED ScreeningSource = 0
+	// SCREENING_SOURCE_GOVERNANCE defines the address denylisted by governance
+	ScreeningSource_SCREENING_SOURCE_GOVERNANCE ScreeningSource = 1
+	// SCREENING_SOURCE_SCREENER defines the address flagged by the off-chain screeners of validators
+	ScreeningSource_SCREENING_SOURCE_SCREENER ScreeningSource = 2
+)
+
+// Enum value maps for ScreeningSource.
+var (
+	ScreeningSource_name = map[int32]string{
+		0: "SCREENING_SOURCE_UNSPECIFIED",
+		1: "SCREENING_SOURCE_GOVERNANCE",
+		2: "SCREENING_SOURCE_SCREENER",
+	}
+	ScreeningSource_value = map[string]int32{
+		"SCREENING_SOURCE_UNSPECIFIED": 0,
+		"SCREENING_SOURCE_GOVERNANCE":  1,
+		"SCREENING_SOURCE_SCREENER":    2,
+	}
+)
+
+func (x ScreeningSource) Enum() *ScreeningSource {
+	p := new(ScreeningSource)
+	*p = x
+	return p
+}
+
+func (x ScreeningSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreeningSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_btcbridge_proto_enumTypes[7].Descriptor()
+}
+
+func (ScreeningSource) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[7]
+}
+
+func (x ScreeningSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreeningSource.Descriptor instead.
+func (ScreeningSource) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{7}
+}
+
+// Fee rate
+type FeeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fee rate
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// block height at which the fee rate is submitted
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *FeeRate) Reset() {
+	*x = FeeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRate) ProtoMessage() {}
+
+// Deprecated: Use FeeRate.ProtoReflect.Descriptor instead.
+func (*FeeRate) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeRate) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FeeRate) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Bitcoin Signing Request
+type SigningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=bitway.btcbridge.AssetType" json:"type,omitempty"`
+	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Psbt         string                 `protobuf:"bytes,5,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Status       SigningStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=bitway.btcbridge.SigningStatus" json:"status,omitempty"`
+}
+
+func (x *SigningRequest) Reset() {
+	*x = SigningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningRequest) ProtoMessage() {}
+
+// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
+func (*SigningRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SigningRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetType_() AssetType {
+	if x != nil {
+		return x.Type_
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *SigningRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetStatus() SigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+}
+
+// Compact Signing Request
+type CompactSigningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=bitway.btcbridge.AssetType" json:"type,omitempty"`
+	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Signers      []string               `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
+	SigHashes    []string               `protobuf:"bytes,6,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Status       SigningStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.btcbridge.SigningStatus" json:"status,omitempty"`
+}
+
+func (x *CompactSigningRequest) Reset() {
+	*x = CompactSigningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactSigningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactSigningRequest) ProtoMessage() {}
+
+// Deprecated: Use CompactSigningRequest.ProtoReflect.Descriptor instead.
+func (*CompactSigningRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompactSigningRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CompactSigningRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CompactSigningRequest) GetType_() AssetType {
+	if x != nil {
+		return x.Type_
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *CompactSigningRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *CompactSigningRequest) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *CompactSigningRequest) GetSigHashes() []string {
+	if x != nil {
+		return x.SigHashes
+	}
+	return nil
+}
+
+func (x *CompactSigningRequest) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *CompactSigningRequest) GetStatus() SigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+}
+
+// Withdrawal Request
+type WithdrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Txid     string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	// sequence group shared by the linked withdrawal requests, i.e. the sequence of the first request in the group
+	// 0 if not grouped; the grouped requests are always handled in the same batch
+	Group uint64 `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	// account which initiated the grouped withdrawal requests
+	// empty if not grouped, in which case the withdrawal address is the initiator
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WithdrawRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetGroup() uint64 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// Withdrawal request via IBC
+type IBCWithdrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IBCWithdrawRequest) Reset() {
+	*x = IBCWithdrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCWithdrawRequest) ProtoMessage() {}
+
+// Deprecated: Use IBCWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*IBCWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IBCWithdrawRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IBCWithdrawRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IBCWithdrawRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IBCWithdrawRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Rate limit for BTC withdrawal
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GlobalRateLimit  *GlobalRateLimit  `protobuf:"bytes,1,opt,name=global_rate_limit,json=globalRateLimit,proto3" json:"global_rate_limit,omitempty"`
+	AddressRateLimit *AddressRateLimit `protobuf:"bytes,2,opt,name=address_rate_limit,json=addressRateLimit,proto3" json:"address_rate_limit,omitempty"`
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateLimit) GetGlobalRateLimit() *GlobalRateLimit {
+	if x != nil {
+		return x.GlobalRateLimit
+	}
+	return nil
+}
+
+func (x *RateLimit) GetAddressRateLimit() *AddressRateLimit {
+	if x != nil {
+		return x.AddressRateLimit
+	}
+	return nil
+}
+
+// Global rate limit for BTC withdrawal
+type GlobalRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Starting time for the current epoch
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time for the current epoch
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum withdrawable amount for the current epoch; 0 means no limit
+	Quota int64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Used quota currently
+	Used int64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *GlobalRateLimit) Reset() {
+	*x = GlobalRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GlobalRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalRateLimit) ProtoMessage() {}
+
+// Deprecated: Use GlobalRateLimit.ProtoReflect.Descriptor instead.
+func (*GlobalRateLimit) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GlobalRateLimit) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GlobalRateLimit) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GlobalRateLimit) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *GlobalRateLimit) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+// Per address rate limit for BTC withdrawal
+type AddressRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Starting time for the current epoch
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time for the current epoch
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum withdrawable amount for the current epoch; 0 means no limit
+	Quota int64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *AddressRateLimit) Reset() {
+	*x = AddressRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRateLimit) ProtoMessage() {}
+
+// Deprecated: Use AddressRateLimit.ProtoReflect.Descriptor instead.
+func (*AddressRateLimit) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddressRateLimit) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AddressRateLimit) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AddressRateLimit) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+// Per address rate limit details
+type AddressRateLimitDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Used quota currently
+	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *AddressRateLimitDetails) Reset() {
+	*x = AddressRateLimitDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressRateLimitDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRateLimitDetails) ProtoMessage() {}
+
+// Deprecated: Use AddressRateLimitDetails.ProtoReflect.Descriptor instead.
+func (*AddressRateLimitDetails) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddressRateLimitDetails) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressRateLimitDetails) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+// Bitcoin UTXO
+type UTXO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid         string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout         uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount       uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height       uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	PubKeyScript []byte `protobuf:"bytes,6,opt,name=pub_key_script,json=pubKeyScript,proto3" json:"pub_key_script,omitempty"`
+	IsLocked     bool   `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	// rune balances associated with the UTXO
+	Runes []*RuneBalance `protobuf:"bytes,8,rep,name=runes,proto3" json:"runes,omitempty"`
+}
+
+func (x *UTXO) Reset() {
+	*x = UTXO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTXO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXO) ProtoMessage() {}
+
+// Deprecated: Use UTXO.ProtoReflect.Descriptor instead.
+func (*UTXO) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UTXO) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *UTXO) GetVout() uint64 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *UTXO) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UTXO) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UTXO) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *UTXO) GetPubKeyScript() []byte {
+	if x != nil {
+		return x.PubKeyScript
+	}
+	return nil
+}
+
+func (x *UTXO) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *UTXO) GetRunes() []*RuneBalance {
+	if x != nil {
+		return x.Runes
+	}
+	return nil
+}
+
+// Rune Balance
+type RuneBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// serialized rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rune amount
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RuneBalance) Reset() {
+	*x = RuneBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneBalance) ProtoMessage() {}
+
+// Deprecated: Use RuneBalance.ProtoReflect.Descriptor instead.
+func (*RuneBalance) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RuneBalance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuneBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Rune ID
+type RuneId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block height
+	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	// tx index
+	Tx uint32 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *RuneId) Reset() {
+	*x = RuneId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneId) ProtoMessage() {}
+
+// Deprecated: Use RuneId.ProtoReflect.Descriptor instead.
+func (*RuneId) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RuneId) GetBlock() uint64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *RuneId) GetTx() uint32 {
+	if x != nil {
+		return x.Tx
+	}
+	return 0
+}
+
+// FeeSponsorshipUsage defines the usage of the fee sponsorship policy in the current epoch
+type FeeSponsorshipUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// policy id
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// start time of the current epoch
+	EpochStartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=epoch_start_time,json=epochStartTime,proto3" json:"epoch_start_time,omitempty"`
+	// total fee sponsored in the current epoch
+	Spent []*v1beta1.Coin `protobuf:"bytes,3,rep,name=spent,proto3" json:"spent,omitempty"`
+	// number of the sponsored txs in the current epoch
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (x *FeeSponsorshipUsage) Reset() {
+	*x = FeeSponsorshipUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSponsorshipUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSponsorshipUsage) ProtoMessage() {}
+
+// Deprecated: Use FeeSponsorshipUsage.ProtoReflect.Descriptor instead.
+func (*FeeSponsorshipUsage) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FeeSponsorshipUsage) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *FeeSponsorshipUsage) GetEpochStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EpochStartTime
+	}
+	return nil
+}
+
+func (x *FeeSponsorshipUsage) GetSpent() []*v1beta1.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *FeeSponsorshipUsage) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+// FeeSponsorshipAccountUsage defines the usage of the fee sponsorship policy by the account
+type FeeSponsorshipAccountUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// policy id
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// account address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// start time of the epoch in which the usage is recorded
+	EpochStartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=epoch_start_time,json=epochStartTime,proto3" json:"epoch_start_time,omitempty"`
+	// total fee sponsored for the account in the epoch
+	Spent []*v1beta1.Coin `protobuf:"bytes,4,rep,name=spent,proto3" json:"spent,omitempty"`
+	// number of the sponsored txs for the account in the epoch
+	TxCount uint32 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (x *FeeSponsorshipAccountUsage) Reset() {
+	*x = FeeSponsorshipAccountUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x DKGRequestStatus) Enum() *DKGRequestStatus {
-	p := new(DKGRequestStatus)
-	*p = x
-	return p
+func (x *FeeSponsorshipAccountUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x DKGRequestStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*FeeSponsorshipAccountUsage) ProtoMessage() {}
+
+// Deprecated: Use FeeSponsorshipAccountUsage.ProtoReflect.Descriptor instead.
+func (*FeeSponsorshipAccountUsage) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{13}
 }
 
-func (DKGRequestStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_btcbridge_btcbridge_proto_enumTypes[1].Descriptor()
+func (x *FeeSponsorshipAccountUsage) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
 }
 
-func (DKGRequestStatus) Type() protoreflect.EnumType {
-	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[1]
+func (x *FeeSponsorshipAccountUsage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x DKGRequestStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *FeeSponsorshipAccountUsage) GetEpochStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EpochStartTime
+	}
+	return nil
 }
 
-// Deprecated: Use DKGRequestStatus.Descriptor instead.
-func (DKGRequestStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+func (x *FeeSponsorshipAccountUsage) GetSpent() []*v1beta1.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
 }
 
-// Refreshing Status
-type RefreshingStatus int32
+func (x *FeeSponsorshipAccountUsage) GetTxCount() uint32 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
 
-const (
-	// REFRESHING_STATUS_UNSPECIFIED defines the unknown refreshing status
-	RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED RefreshingStatus = 0
-	// REFRESHING_STATUS_PENDING defines the status of the refreshing request which is pending
-	RefreshingStatus_REFRESHING_STATUS_PENDING RefreshingStatus = 1
-	// REFRESHING_STATUS_COMPLETED defines the status of the refreshing request which is completed
-	RefreshingStatus_REFRESHING_STATUS_COMPLETED RefreshingStatus = 2
-	// REFRESHING_STATUS_TIMEDOUT defines the status of the refreshing request which timed out
-	RefreshingStatus_REFRESHING_STATUS_TIMEDOUT RefreshingStatus = 3
-)
+// RuneEtching defines the etching data of the rune used to register the denom metadata
+type RuneEtching struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// Enum value maps for RefreshingStatus.
-var (
-	RefreshingStatus_name = map[int32]string{
-		0: "REFRESHING_STATUS_UNSPECIFIED",
-		1: "REFRESHING_STATUS_PENDING",
-		2: "REFRESHING_STATUS_COMPLETED",
-		3: "REFRESHING_STATUS_TIMEDOUT",
-	}
-	RefreshingStatus_value = map[string]int32{
-		"REFRESHING_STATUS_UNSPECIFIED": 0,
-		"REFRESHING_STATUS_PENDING":     1,
-		"REFRESHING_STATUS_COMPLETED":   2,
-		"REFRESHING_STATUS_TIMEDOUT":    3,
+	// rune id, e.g. 840000:3
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rune name without spacers, e.g. DOGGOTOTHEMOON
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// bit field of the spacers between the rune name characters
+	Spacers uint32 `protobuf:"varint,3,opt,name=spacers,proto3" json:"spacers,omitempty"`
+	// single non-whitespace currency symbol; defaulted to ¤ if empty
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// divisibility of the rune
+	Divisibility uint32 `protobuf:"varint,5,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
+}
+
+func (x *RuneEtching) Reset() {
+	*x = RuneEtching{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x RefreshingStatus) Enum() *RefreshingStatus {
-	p := new(RefreshingStatus)
-	*p = x
-	return p
+func (x *RuneEtching) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x RefreshingStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*RuneEtching) ProtoMessage() {}
+
+// Deprecated: Use RuneEtching.ProtoReflect.Descriptor instead.
+func (*RuneEtching) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{14}
 }
 
-func (RefreshingStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_btcbridge_btcbridge_proto_enumTypes[2].Descriptor()
+func (x *RuneEtching) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (RefreshingStatus) Type() protoreflect.EnumType {
-	return &file_bitway_btcbridge_btcbridge_proto_enumTypes[2]
+func (x *RuneEtching) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x RefreshingStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *RuneEtching) GetSpacers() uint32 {
+	if x != nil {
+		return x.Spacers
+	}
+	return 0
 }
 
-// Deprecated: Use RefreshingStatus.Descriptor instead.
-func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+func (x *RuneEtching) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
 }
 
-// Fee rate
-type FeeRate struct {
+func (x *RuneEtching) GetDivisibility() uint32 {
+	if x != nil {
+		return x.Divisibility
+	}
+	return 0
+}
+
+// RuneEtchingSubmission defines the rune etching submitted by the relayer which is pending the relayer quorum
+type RuneEtchingSubmission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// fee rate
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	// block height at which the fee rate is submitted
-	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// relayer address
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// rune etching data
+	Etching *RuneEtching `protobuf:"bytes,2,opt,name=etching,proto3" json:"etching,omitempty"`
 }
 
-func (x *FeeRate) Reset() {
-	*x = FeeRate{}
+func (x *RuneEtchingSubmission) Reset() {
+	*x = RuneEtchingSubmission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[0]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRate) String() string {
+func (x *RuneEtchingSubmission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRate) ProtoMessage() {}
+func (*RuneEtchingSubmission) ProtoMessage() {}
 
-// Deprecated: Use FeeRate.ProtoReflect.Descriptor instead.
-func (*FeeRate) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RuneEtchingSubmission.ProtoReflect.Descriptor instead.
+func (*RuneEtchingSubmission) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FeeRate) GetValue() int64 {
+func (x *RuneEtchingSubmission) GetRelayer() string {
 	if x != nil {
-		return x.Value
+		return x.Relayer
 	}
-	return 0
+	return ""
 }
 
-func (x *FeeRate) GetHeight() int64 {
+func (x *RuneEtchingSubmission) GetEtching() *RuneEtching {
 	if x != nil {
-		return x.Height
+		return x.Etching
 	}
-	return 0
+	return nil
 }
 
-// Bitcoin Signing Request
-type SigningRequest struct {
+// Rune Edict
+type Edict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=bitway.btcbridge.AssetType" json:"type,omitempty"`
-	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
-	Psbt         string                 `protobuf:"bytes,5,opt,name=psbt,proto3" json:"psbt,omitempty"`
-	CreationTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	Status       SigningStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=bitway.btcbridge.SigningStatus" json:"status,omitempty"`
+	Id     *RuneId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Output uint32  `protobuf:"varint,3,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *SigningRequest) Reset() {
-	*x = SigningRequest{}
+func (x *Edict) Reset() {
+	*x = Edict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[1]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SigningRequest) String() string {
+func (x *Edict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningRequest) ProtoMessage() {}
+func (*Edict) ProtoMessage() {}
 
-// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
-func (*SigningRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Edict.ProtoReflect.Descriptor instead.
+func (*Edict) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SigningRequest) GetAddress() string {
+func (x *Edict) GetId() *RuneId {
 	if x != nil {
-		return x.Address
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *SigningRequest) GetSequence() uint64 {
+func (x *Edict) GetAmount() string {
 	if x != nil {
-		return x.Sequence
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *SigningRequest) GetType_() AssetType {
+func (x *Edict) GetOutput() uint32 {
 	if x != nil {
-		return x.Type_
+		return x.Output
 	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *SigningRequest) GetTxid() string {
-	if x != nil {
-		return x.Txid
-	}
-	return ""
+// BTC UTXO Consolidation
+type BtcConsolidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximum threshold of the btc value
+	TargetThreshold int64 `protobuf:"varint,1,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
+	// maximum number of the utxos to be consolidated; 0 means all
+	MaxNum uint32 `protobuf:"varint,2,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
 }
 
-func (x *SigningRequest) GetPsbt() string {
-	if x != nil {
-		return x.Psbt
+func (x *BtcConsolidation) Reset() {
+	*x = BtcConsolidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+func (x *BtcConsolidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BtcConsolidation) ProtoMessage() {}
+
+// Deprecated: Use BtcConsolidation.ProtoReflect.Descriptor instead.
+func (*BtcConsolidation) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BtcConsolidation) GetTargetThreshold() int64 {
 	if x != nil {
-		return x.CreationTime
+		return x.TargetThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *SigningRequest) GetStatus() SigningStatus {
+func (x *BtcConsolidation) GetMaxNum() uint32 {
 	if x != nil {
-		return x.Status
+		return x.MaxNum
 	}
-	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+	return 0
 }
 
-// Compact Signing Request
-type CompactSigningRequest struct {
+// Runes UTXO Consolidation
+type RunesConsolidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=bitway.btcbridge.AssetType" json:"type,omitempty"`
-	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
-	Signers      []string               `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
-	SigHashes    []string               `protobuf:"bytes,6,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
-	CreationTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	Status       SigningStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.btcbridge.SigningStatus" json:"status,omitempty"`
+	// rune id
+	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
+	// maximum threshold of the corresponding rune balance
+	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
+	// maximum number of the utxos to be consolidated; 0 means all
+	MaxNum uint32 `protobuf:"varint,3,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
 }
 
-func (x *CompactSigningRequest) Reset() {
-	*x = CompactSigningRequest{}
+func (x *RunesConsolidation) Reset() {
+	*x = RunesConsolidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[2]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompactSigningRequest) String() string {
+func (x *RunesConsolidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompactSigningRequest) ProtoMessage() {}
+func (*RunesConsolidation) ProtoMessage() {}
 
-// Deprecated: Use CompactSigningRequest.ProtoReflect.Descriptor instead.
-func (*CompactSigningRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RunesConsolidation.ProtoReflect.Descriptor instead.
+func (*RunesConsolidation) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CompactSigningRequest) GetAddress() string {
+func (x *RunesConsolidation) GetRuneId() string {
 	if x != nil {
-		return x.Address
+		return x.RuneId
 	}
 	return ""
 }
 
-func (x *CompactSigningRequest) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
-	}
-	return 0
-}
-
-func (x *CompactSigningRequest) GetType_() AssetType {
-	if x != nil {
-		return x.Type_
-	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
-}
-
-func (x *CompactSigningRequest) GetTxid() string {
+func (x *RunesConsolidation) GetTargetThreshold() string {
 	if x != nil {
-		return x.Txid
+		return x.TargetThreshold
 	}
 	return ""
 }
 
-func (x *CompactSigningRequest) GetSigners() []string {
-	if x != nil {
-		return x.Signers
-	}
-	return nil
-}
-
-func (x *CompactSigningRequest) GetSigHashes() []string {
-	if x != nil {
-		return x.SigHashes
-	}
-	return nil
-}
-
-func (x *CompactSigningRequest) GetCreationTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreationTime
-	}
-	return nil
-}
-
-func (x *CompactSigningRequest) GetStatus() SigningStatus {
+func (x *RunesConsolidation) GetMaxNum() uint32 {
 	if x != nil {
-		return x.Status
+		return x.MaxNum
 	}
-	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+	return 0
 }
 
-// Withdrawal Request
-type WithdrawRequest struct {
+// DKG Participant
+type DKGParticipant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Txid     string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	// the optional moniker
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// the optional operator address
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *DKGParticipant) Reset() {
+	*x = DKGParticipant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[3]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *DKGParticipant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*DKGParticipant) ProtoMessage() {}
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
+func (*DKGParticipant) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *WithdrawRequest) GetAddress() string {
+func (x *DKGParticipant) GetMoniker() string {
 	if x != nil {
-		return x.Address
+		return x.Moniker
 	}
 	return ""
 }
 
-func (x *WithdrawRequest) GetAmount() string {
+func (x *DKGParticipant) GetOperatorAddress() string {
 	if x != nil {
-		return x.Amount
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *WithdrawRequest) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
-	}
-	return 0
-}
-
-func (x *WithdrawRequest) GetTxid() string {
+func (x *DKGParticipant) GetConsensusPubkey() string {
 	if x != nil {
-		return x.Txid
+		return x.ConsensusPubkey
 	}
 	return ""
 }
 
-// Withdrawal request via IBC
-type IBCWithdrawRequest struct {
+// DKG Request
+type DKGRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the unique request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// participant set
+	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold required to perform DKG
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// asset types of vaults to be generated
+	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=bitway.btcbridge.AssetType" json:"vault_types,omitempty"`
+	// indicates if transferring assets to the newly generated vaults when the DKG request is completed
+	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
+	// target number of the UTXOs to be transferred each time
+	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
+	// expiration time
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// status
+	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.btcbridge.DKGRequestStatus" json:"status,omitempty"`
+	// recovery params committed by the vaults to be generated; empty if the recovery is disabled
+	RecoveryParams *VaultRecoveryParams `protobuf:"bytes,9,opt,name=recovery_params,json=recoveryParams,proto3" json:"recovery_params,omitempty"`
 }
 
-func (x *IBCWithdrawRequest) Reset() {
-	*x = IBCWithdrawRequest{}
+func (x *DKGRequest) Reset() {
+	*x = DKGRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[4]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IBCWithdrawRequest) String() string {
+func (x *DKGRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IBCWithdrawRequest) ProtoMessage() {}
+func (*DKGRequest) ProtoMessage() {}
 
-// Deprecated: Use IBCWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*IBCWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
+func (*DKGRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *IBCWithdrawRequest) GetChannelId() string {
+func (x *DKGRequest) GetId() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *IBCWithdrawRequest) GetSequence() uint64 {
+func (x *DKGRequest) GetParticipants() []*DKGParticipant {
 	if x != nil {
-		return x.Sequence
+		return x.Participants
 	}
-	return 0
+	return nil
 }
 
-func (x *IBCWithdrawRequest) GetAddress() string {
+func (x *DKGRequest) GetThreshold() uint32 {
 	if x != nil {
-		return x.Address
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (x *IBCWithdrawRequest) GetAmount() string {
+func (x *DKGRequest) GetVaultTypes() []AssetType {
 	if x != nil {
-		return x.Amount
+		return x.VaultTypes
 	}
-	return ""
-}
-
-// Rate limit for BTC withdrawal
-type RateLimit struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GlobalRateLimit  *GlobalRateLimit  `protobuf:"bytes,1,opt,name=global_rate_limit,json=globalRateLimit,proto3" json:"global_rate_limit,omitempty"`
-	AddressRateLimit *AddressRateLimit `protobuf:"bytes,2,opt,name=address_rate_limit,json=addressRateLimit,proto3" json:"address_rate_limit,omitempty"`
+	return nil
 }
 
-func (x *RateLimit) Reset() {
-	*x = RateLimit{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DKGRequest) GetEnableTransfer() bool {
+	if x != nil {
+		return x.EnableTransfer
 	}
+	return false
 }
 
-func (x *RateLimit) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DKGRequest) GetTargetUtxoNum() uint32 {
+	if x != nil {
+		return x.TargetUtxoNum
+	}
+	return 0
 }
 
-func (*RateLimit) ProtoMessage() {}
-
-// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
-func (*RateLimit) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+func (x *DKGRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
 }
 
-func (x *RateLimit) GetGlobalRateLimit() *GlobalRateLimit {
+func (x *DKGRequest) GetStatus() DKGRequestStatus {
 	if x != nil {
-		return x.GlobalRateLimit
+		return x.Status
 	}
-	return nil
+	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
 }
 
-func (x *RateLimit) GetAddressRateLimit() *AddressRateLimit {
+func (x *DKGRequest) GetRecoveryParams() *VaultRecoveryParams {
 	if x != nil {
-		return x.AddressRateLimit
+		return x.RecoveryParams
 	}
 	return nil
 }
 
-// Global rate limit for BTC withdrawal
-type GlobalRateLimit struct {
+// DKG Completion Request
+type DKGCompletionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Starting time for the current epoch
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// End time for the current epoch
-	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Maximum withdrawable amount for the current epoch; 0 means no limit
-	Quota int64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
-	// Used quota currently
-	Used int64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new vaults generated by DKG
+	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// x-only internal keys of the new vaults; required if the recovery is enabled
+	InternalKeys []string `protobuf:"bytes,6,rep,name=internal_keys,json=internalKeys,proto3" json:"internal_keys,omitempty"`
+	// hex encoded compressed pub keys of the new vaults; required for the segwit v0 vaults
+	PubKeys []string `protobuf:"bytes,7,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
 }
 
-func (x *GlobalRateLimit) Reset() {
-	*x = GlobalRateLimit{}
+func (x *DKGCompletionRequest) Reset() {
+	*x = DKGCompletionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[6]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GlobalRateLimit) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DKGCompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGCompletionRequest) ProtoMessage() {}
+
+// Deprecated: Use DKGCompletionRequest.ProtoReflect.Descriptor instead.
+func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DKGCompletionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DKGCompletionRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
 }
 
-func (*GlobalRateLimit) ProtoMessage() {}
-
-// Deprecated: Use GlobalRateLimit.ProtoReflect.Descriptor instead.
-func (*GlobalRateLimit) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+func (x *DKGCompletionRequest) GetVaults() []string {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
 }
 
-func (x *GlobalRateLimit) GetStartTime() *timestamppb.Timestamp {
+func (x *DKGCompletionRequest) GetConsensusPubkey() string {
 	if x != nil {
-		return x.StartTime
+		return x.ConsensusPubkey
 	}
-	return nil
+	return ""
 }
 
-func (x *GlobalRateLimit) GetEndTime() *timestamppb.Timestamp {
+func (x *DKGCompletionRequest) GetSignature() string {
 	if x != nil {
-		return x.EndTime
+		return x.Signature
 	}
-	return nil
+	return ""
 }
 
-func (x *GlobalRateLimit) GetQuota() int64 {
+func (x *DKGCompletionRequest) GetInternalKeys() []string {
 	if x != nil {
-		return x.Quota
+		return x.InternalKeys
 	}
-	return 0
+	return nil
 }
 
-func (x *GlobalRateLimit) GetUsed() int64 {
+func (x *DKGCompletionRequest) GetPubKeys() []string {
 	if x != nil {
-		return x.Used
+		return x.PubKeys
 	}
-	return 0
+	return nil
 }
 
-// Per address rate limit for BTC withdrawal
-type AddressRateLimit struct {
+// Refreshing Request
+type RefreshingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Starting time for the current epoch
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// End time for the current epoch
-	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Maximum withdrawable amount for the current epoch; 0 means no limit
-	Quota int64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// request id of the DKG corresponding to the key shares to be refreshed
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// removed participant set
+	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
+	// expiration time
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// status
+	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.btcbridge.RefreshingStatus" json:"status,omitempty"`
 }
 
-func (x *AddressRateLimit) Reset() {
-	*x = AddressRateLimit{}
+func (x *RefreshingRequest) Reset() {
+	*x = RefreshingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[7]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddressRateLimit) String() string {
+func (x *RefreshingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressRateLimit) ProtoMessage() {}
+func (*RefreshingRequest) ProtoMessage() {}
 
-// Deprecated: Use AddressRateLimit.ProtoReflect.Descriptor instead.
-func (*AddressRateLimit) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RefreshingRequest.ProtoReflect.Descriptor instead.
+func (*RefreshingRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddressRateLimit) GetStartTime() *timestamppb.Timestamp {
+func (x *RefreshingRequest) GetId() uint64 {
 	if x != nil {
-		return x.StartTime
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshingRequest) GetDkgId() uint64 {
+	if x != nil {
+		return x.DkgId
+	}
+	return 0
+}
+
+func (x *RefreshingRequest) GetRemovedParticipants() []string {
+	if x != nil {
+		return x.RemovedParticipants
 	}
 	return nil
 }
 
-func (x *AddressRateLimit) GetEndTime() *timestamppb.Timestamp {
+func (x *RefreshingRequest) GetExpirationTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndTime
+		return x.ExpirationTime
 	}
 	return nil
 }
 
-func (x *AddressRateLimit) GetQuota() int64 {
+func (x *RefreshingRequest) GetStatus() RefreshingStatus {
 	if x != nil {
-		return x.Quota
+		return x.Status
 	}
-	return 0
+	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
 }
 
-// Per address rate limit details
-type AddressRateLimitDetails struct {
+// Refreshing Completion
+type RefreshingCompletion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Used quota currently
-	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *AddressRateLimitDetails) Reset() {
-	*x = AddressRateLimitDetails{}
+func (x *RefreshingCompletion) Reset() {
+	*x = RefreshingCompletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[8]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddressRateLimitDetails) String() string {
+func (x *RefreshingCompletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressRateLimitDetails) ProtoMessage() {}
+func (*RefreshingCompletion) ProtoMessage() {}
 
-// Deprecated: Use AddressRateLimitDetails.ProtoReflect.Descriptor instead.
-func (*AddressRateLimitDetails) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RefreshingCompletion.ProtoReflect.Descriptor instead.
+func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AddressRateLimitDetails) GetAddress() string {
+func (x *RefreshingCompletion) GetId() uint64 {
 	if x != nil {
-		return x.Address
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshingCompletion) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *AddressRateLimitDetails) GetUsed() int64 {
+func (x *RefreshingCompletion) GetConsensusPubkey() string {
 	if x != nil {
-		return x.Used
+		return x.ConsensusPubkey
 	}
-	return 0
+	return ""
 }
 
-// Bitcoin UTXO
-type UTXO struct {
+func (x *RefreshingCompletion) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// Relayer
+type Relayer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txid         string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	Vout         uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Amount       uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Height       uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	PubKeyScript []byte `protobuf:"bytes,6,opt,name=pub_key_script,json=pubKeyScript,proto3" json:"pub_key_script,omitempty"`
-	IsLocked     bool   `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
-	// rune balances associated with the UTXO
-	Runes []*RuneBalance `protobuf:"bytes,8,rep,name=runes,proto3" json:"runes,omitempty"`
-}
-
-func (x *UTXO) Reset() {
-	*x = UTXO{}
+	// relayer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// bonded stake
+	Bond *v1beta1.Coin `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+	// status
+	Status RelayerStatus `protobuf:"varint,3,opt,name=status,proto3,enum=bitway.btcbridge.RelayerStatus" json:"status,omitempty"`
+	// number of the deposit transactions relayed
+	RelayedDeposits uint64 `protobuf:"varint,4,opt,name=relayed_deposits,json=relayedDeposits,proto3" json:"relayed_deposits,omitempty"`
+	// total rewards earned
+	TotalRewards []*v1beta1.Coin `protobuf:"bytes,5,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	// number of times slashed
+	SlashCount uint64 `protobuf:"varint,6,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// time at which the bond is released; only set when unbonding
+	UnbondingTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+}
+
+func (x *Relayer) Reset() {
+	*x = Relayer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[9]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UTXO) String() string {
+func (x *Relayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UTXO) ProtoMessage() {}
+func (*Relayer) ProtoMessage() {}
 
-// Deprecated: Use UTXO.ProtoReflect.Descriptor instead.
-func (*UTXO) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Relayer.ProtoReflect.Descriptor instead.
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UTXO) GetTxid() string {
+func (x *Relayer) GetAddress() string {
 	if x != nil {
-		return x.Txid
+		return x.Address
 	}
 	return ""
 }
 
-func (x *UTXO) GetVout() uint64 {
-	if x != nil {
-		return x.Vout
-	}
-	return 0
-}
-
-func (x *UTXO) GetAddress() string {
+func (x *Relayer) GetBond() *v1beta1.Coin {
 	if x != nil {
-		return x.Address
+		return x.Bond
 	}
-	return ""
+	return nil
 }
 
-func (x *UTXO) GetAmount() uint64 {
+func (x *Relayer) GetStatus() RelayerStatus {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
-	return 0
+	return RelayerStatus_RELAYER_STATUS_UNSPECIFIED
 }
 
-func (x *UTXO) GetHeight() uint64 {
+func (x *Relayer) GetRelayedDeposits() uint64 {
 	if x != nil {
-		return x.Height
+		return x.RelayedDeposits
 	}
 	return 0
 }
 
-func (x *UTXO) GetPubKeyScript() []byte {
+func (x *Relayer) GetTotalRewards() []*v1beta1.Coin {
 	if x != nil {
-		return x.PubKeyScript
+		return x.TotalRewards
 	}
 	return nil
 }
 
-func (x *UTXO) GetIsLocked() bool {
+func (x *Relayer) GetSlashCount() uint64 {
 	if x != nil {
-		return x.IsLocked
+		return x.SlashCount
 	}
-	return false
+	return 0
 }
 
-func (x *UTXO) GetRunes() []*RuneBalance {
+func (x *Relayer) GetUnbondingTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Runes
+		return x.UnbondingTime
 	}
 	return nil
 }
-
-// Rune Balance
-type RuneBalance struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// serialized rune id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// rune amount
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *RuneBalance) Reset() {
-	*x = RuneBalance{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RuneBalance) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RuneBalance) ProtoMessage() {}
-
-// Deprecated: Use RuneBalance.ProtoReflect.Descriptor instead.
-func (*RuneBalance) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *RuneBalance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RuneBalance) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-// Rune ID
-type RuneId struct {
+
+// Runes deposit attestation made by the relayer
+type RunesAttestation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// block height
-	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
-	// tx index
-	Tx uint32 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// relayer address
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// block height at which the attestation is made
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// indicates if the relayer has been slashed for the attestation
+	Slashed bool `protobuf:"varint,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
 }
 
-func (x *RuneId) Reset() {
-	*x = RuneId{}
+func (x *RunesAttestation) Reset() {
+	*x = RunesAttestation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[11]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuneId) String() string {
+func (x *RunesAttestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuneId) ProtoMessage() {}
+func (*RunesAttestation) ProtoMessage() {}
 
-// Deprecated: Use RuneId.ProtoReflect.Descriptor instead.
-func (*RuneId) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RunesAttestation.ProtoReflect.Descriptor instead.
+func (*RunesAttestation) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RuneId) GetBlock() uint64 {
+func (x *RunesAttestation) GetTxid() string {
 	if x != nil {
-		return x.Block
+		return x.Txid
 	}
-	return 0
+	return ""
 }
 
-func (x *RuneId) GetTx() uint32 {
+func (x *RunesAttestation) GetRelayer() string {
 	if x != nil {
-		return x.Tx
+		return x.Relayer
+	}
+	return ""
+}
+
+func (x *RunesAttestation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-// Rune Edict
-type Edict struct {
+func (x *RunesAttestation) GetSlashed() bool {
+	if x != nil {
+		return x.Slashed
+	}
+	return false
+}
+
+// Pause
+type Pause struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     *RuneId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Output uint32  `protobuf:"varint,3,opt,name=output,proto3" json:"output,omitempty"`
+	// pause id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pause target
+	Target PauseTarget `protobuf:"varint,2,opt,name=target,proto3,enum=bitway.btcbridge.PauseTarget" json:"target,omitempty"`
+	// asset type; only set for PAUSE_TARGET_ASSET
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// address which initiated the pause
+	Initiator string `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// reason
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// start time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// time at which the pause expires
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// number of times extended by governance
+	Extensions uint32 `protobuf:"varint,8,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	// status
+	Status PauseStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bitway.btcbridge.PauseStatus" json:"status,omitempty"`
 }
 
-func (x *Edict) Reset() {
-	*x = Edict{}
+func (x *Pause) Reset() {
+	*x = Pause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[12]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Edict) String() string {
+func (x *Pause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Edict) ProtoMessage() {}
+func (*Pause) ProtoMessage() {}
 
-// Deprecated: Use Edict.ProtoReflect.Descriptor instead.
-func (*Edict) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Pause.ProtoReflect.Descriptor instead.
+func (*Pause) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Edict) GetId() *RuneId {
+func (x *Pause) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *Edict) GetAmount() string {
+func (x *Pause) GetTarget() PauseTarget {
 	if x != nil {
-		return x.Amount
+		return x.Target
 	}
-	return ""
+	return PauseTarget_PAUSE_TARGET_UNSPECIFIED
 }
 
-func (x *Edict) GetOutput() uint32 {
+func (x *Pause) GetAssetType() AssetType {
 	if x != nil {
-		return x.Output
+		return x.AssetType
 	}
-	return 0
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-// BTC UTXO Consolidation
-type BtcConsolidation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// maximum threshold of the btc value
-	TargetThreshold int64 `protobuf:"varint,1,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
-	// maximum number of the utxos to be consolidated; 0 means all
-	MaxNum uint32 `protobuf:"varint,2,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+func (x *Pause) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
 }
 
-func (x *BtcConsolidation) Reset() {
-	*x = BtcConsolidation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Pause) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
+	return ""
 }
 
-func (x *BtcConsolidation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pause) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (*BtcConsolidation) ProtoMessage() {}
-
-// Deprecated: Use BtcConsolidation.ProtoReflect.Descriptor instead.
-func (*BtcConsolidation) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{13}
+func (x *Pause) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *BtcConsolidation) GetTargetThreshold() int64 {
+func (x *Pause) GetExtensions() uint32 {
 	if x != nil {
-		return x.TargetThreshold
+		return x.Extensions
 	}
 	return 0
 }
 
-func (x *BtcConsolidation) GetMaxNum() uint32 {
+func (x *Pause) GetStatus() PauseStatus {
 	if x != nil {
-		return x.MaxNum
+		return x.Status
 	}
-	return 0
+	return PauseStatus_PAUSE_STATUS_UNSPECIFIED
 }
 
-// Runes UTXO Consolidation
-type RunesConsolidation struct {
+// Screened address
+type ScreenedAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rune id
-	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
-	// maximum threshold of the corresponding rune balance
-	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
-	// maximum number of the utxos to be consolidated; 0 means all
-	MaxNum uint32 `protobuf:"varint,3,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+	// address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// screening source
+	Source ScreeningSource `protobuf:"varint,2,opt,name=source,proto3,enum=bitway.btcbridge.ScreeningSource" json:"source,omitempty"`
+	// reason
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block height at which the address is screened
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *RunesConsolidation) Reset() {
-	*x = RunesConsolidation{}
+func (x *ScreenedAddress) Reset() {
+	*x = ScreenedAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[14]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunesConsolidation) String() string {
+func (x *ScreenedAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunesConsolidation) ProtoMessage() {}
+func (*ScreenedAddress) ProtoMessage() {}
 
-// Deprecated: Use RunesConsolidation.ProtoReflect.Descriptor instead.
-func (*RunesConsolidation) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ScreenedAddress.ProtoReflect.Descriptor instead.
+func (*ScreenedAddress) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RunesConsolidation) GetRuneId() string {
+func (x *ScreenedAddress) GetAddress() string {
 	if x != nil {
-		return x.RuneId
+		return x.Address
 	}
 	return ""
 }
 
-func (x *RunesConsolidation) GetTargetThreshold() string {
+func (x *ScreenedAddress) GetSource() ScreeningSource {
 	if x != nil {
-		return x.TargetThreshold
+		return x.Source
+	}
+	return ScreeningSource_SCREENING_SOURCE_UNSPECIFIED
+}
+
+func (x *ScreenedAddress) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *RunesConsolidation) GetMaxNum() uint32 {
+func (x *ScreenedAddress) GetHeight() int64 {
 	if x != nil {
-		return x.MaxNum
+		return x.Height
 	}
 	return 0
 }
 
-// DKG Participant
-type DKGParticipant struct {
+// Deposit credited to the quarantine account due to screening
+type QuarantinedDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the optional moniker
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// the optional operator address
-	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// deposit sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// intended recipient address
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// quarantined amount
+	Amount *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// block height at which the deposit is quarantined
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *DKGParticipant) Reset() {
-	*x = DKGParticipant{}
+func (x *QuarantinedDeposit) Reset() {
+	*x = QuarantinedDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[15]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGParticipant) String() string {
+func (x *QuarantinedDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGParticipant) ProtoMessage() {}
+func (*QuarantinedDeposit) ProtoMessage() {}
 
-// Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
-func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QuarantinedDeposit.ProtoReflect.Descriptor instead.
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DKGParticipant) GetMoniker() string {
+func (x *QuarantinedDeposit) GetTxid() string {
 	if x != nil {
-		return x.Moniker
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *DKGParticipant) GetOperatorAddress() string {
+func (x *QuarantinedDeposit) GetSender() string {
 	if x != nil {
-		return x.OperatorAddress
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *DKGParticipant) GetConsensusPubkey() string {
+func (x *QuarantinedDeposit) GetRecipient() string {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Recipient
 	}
 	return ""
 }
 
-// DKG Request
-type DKGRequest struct {
+func (x *QuarantinedDeposit) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *QuarantinedDeposit) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Quarantined Withdrawal
+type QuarantinedWithdrawal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the unique request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// participant set
-	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	// threshold required to perform DKG
-	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// asset types of vaults to be generated
-	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=bitway.btcbridge.AssetType" json:"vault_types,omitempty"`
-	// indicates if transferring assets to the newly generated vaults when the DKG request is completed
-	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
-	// target number of the UTXOs to be transferred each time
-	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
-	// expiration time
-	Expiration *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// status
-	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.btcbridge.DKGRequestStatus" json:"status,omitempty"`
-}
-
-func (x *DKGRequest) Reset() {
-	*x = DKGRequest{}
+	// withdrawal request sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// withdrawal address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// quarantined amount
+	Amount *v1beta1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// account which initiated the grouped withdrawal request; empty if not grouped
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// sequence group of the withdrawal request; 0 if not grouped
+	Group uint64 `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	// block height at which the withdrawal is quarantined
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QuarantinedWithdrawal) Reset() {
+	*x = QuarantinedWithdrawal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[16]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGRequest) String() string {
+func (x *QuarantinedWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGRequest) ProtoMessage() {}
+func (*QuarantinedWithdrawal) ProtoMessage() {}
 
-// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
-func (*DKGRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QuarantinedWithdrawal.ProtoReflect.Descriptor instead.
+func (*QuarantinedWithdrawal) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DKGRequest) GetId() uint64 {
+func (x *QuarantinedWithdrawal) GetSequence() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetParticipants() []*DKGParticipant {
-	if x != nil {
-		return x.Participants
-	}
-	return nil
-}
-
-func (x *DKGRequest) GetThreshold() uint32 {
+func (x *QuarantinedWithdrawal) GetAddress() string {
 	if x != nil {
-		return x.Threshold
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *DKGRequest) GetVaultTypes() []AssetType {
+func (x *QuarantinedWithdrawal) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.VaultTypes
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *DKGRequest) GetEnableTransfer() bool {
+func (x *QuarantinedWithdrawal) GetSender() string {
 	if x != nil {
-		return x.EnableTransfer
+		return x.Sender
 	}
-	return false
+	return ""
 }
 
-func (x *DKGRequest) GetTargetUtxoNum() uint32 {
+func (x *QuarantinedWithdrawal) GetGroup() uint64 {
 	if x != nil {
-		return x.TargetUtxoNum
+		return x.Group
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetExpiration() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Expiration
-	}
-	return nil
-}
-
-func (x *DKGRequest) GetStatus() DKGRequestStatus {
+func (x *QuarantinedWithdrawal) GetHeight() int64 {
 	if x != nil {
-		return x.Status
+		return x.Height
 	}
-	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
+	return 0
 }
 
-// DKG Completion Request
-type DKGCompletionRequest struct {
+// Deposit Record
+type DepositRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// new vaults generated by DKG
-	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
-}
-
-func (x *DKGCompletionRequest) Reset() {
-	*x = DKGCompletionRequest{}
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// output index of the deposit to the vault
+	Vout uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	// deposit sender address; empty if not resolved
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient address
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// asset type
+	Asset AssetType `protobuf:"varint,5,opt,name=asset,proto3,enum=bitway.btcbridge.AssetType" json:"asset,omitempty"`
+	// amount credited to the recipient
+	Amount *v1beta1.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// protocol deposit fee deducted
+	Fee *v1beta1.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	// bitcoin block height of the deposit tx
+	BtcBlockHeight uint64 `protobuf:"varint,8,opt,name=btc_block_height,json=btcBlockHeight,proto3" json:"btc_block_height,omitempty"`
+	// block height at which the deposit is minted
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// indicates if the deposit is credited to the quarantine account
+	Quarantined bool `protobuf:"varint,10,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	// outcome of the IBC auto transfer if any
+	IbcTransfer *DepositIBCTransfer `protobuf:"bytes,11,opt,name=ibc_transfer,json=ibcTransfer,proto3" json:"ibc_transfer,omitempty"`
+}
+
+func (x *DepositRecord) Reset() {
+	*x = DepositRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[17]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
-
-func (x *DKGCompletionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DKGCompletionRequest) ProtoMessage() {}
-
-// Deprecated: Use DKGCompletionRequest.ProtoReflect.Descriptor instead.
-func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{17}
+
+func (x *DepositRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DKGCompletionRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (*DepositRecord) ProtoMessage() {}
+
+// Deprecated: Use DepositRecord.ProtoReflect.Descriptor instead.
+func (*DepositRecord) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DKGCompletionRequest) GetSender() string {
+func (x *DepositRecord) GetTxid() string {
 	if x != nil {
-		return x.Sender
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *DKGCompletionRequest) GetVaults() []string {
+func (x *DepositRecord) GetVout() uint64 {
 	if x != nil {
-		return x.Vaults
+		return x.Vout
 	}
-	return nil
+	return 0
 }
 
-func (x *DKGCompletionRequest) GetConsensusPubkey() string {
+func (x *DepositRecord) GetSender() string {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *DKGCompletionRequest) GetSignature() string {
+func (x *DepositRecord) GetRecipient() string {
 	if x != nil {
-		return x.Signature
+		return x.Recipient
 	}
 	return ""
 }
 
-// Refreshing Request
-type RefreshingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// request id of the DKG corresponding to the key shares to be refreshed
-	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
-	// removed participant set
-	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
-	// expiration time
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	// status
-	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.btcbridge.RefreshingStatus" json:"status,omitempty"`
-}
-
-func (x *RefreshingRequest) Reset() {
-	*x = RefreshingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DepositRecord) GetAsset() AssetType {
+	if x != nil {
+		return x.Asset
 	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (x *RefreshingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RefreshingRequest) ProtoMessage() {}
-
-// Deprecated: Use RefreshingRequest.ProtoReflect.Descriptor instead.
-func (*RefreshingRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{18}
+func (x *DepositRecord) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
 }
 
-func (x *RefreshingRequest) GetId() uint64 {
+func (x *DepositRecord) GetFee() *v1beta1.Coin {
 	if x != nil {
-		return x.Id
+		return x.Fee
 	}
-	return 0
+	return nil
 }
 
-func (x *RefreshingRequest) GetDkgId() uint64 {
+func (x *DepositRecord) GetBtcBlockHeight() uint64 {
 	if x != nil {
-		return x.DkgId
+		return x.BtcBlockHeight
 	}
 	return 0
 }
 
-func (x *RefreshingRequest) GetRemovedParticipants() []string {
+func (x *DepositRecord) GetHeight() int64 {
 	if x != nil {
-		return x.RemovedParticipants
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *RefreshingRequest) GetExpirationTime() *timestamppb.Timestamp {
+func (x *DepositRecord) GetQuarantined() bool {
 	if x != nil {
-		return x.ExpirationTime
+		return x.Quarantined
 	}
-	return nil
+	return false
 }
 
-func (x *RefreshingRequest) GetStatus() RefreshingStatus {
+func (x *DepositRecord) GetIbcTransfer() *DepositIBCTransfer {
 	if x != nil {
-		return x.Status
+		return x.IbcTransfer
 	}
-	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+	return nil
 }
 
-// Refreshing Completion
-type RefreshingCompletion struct {
+// Deposit IBC Transfer
+type DepositIBCTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// channel id
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// recipient address on the counterparty chain
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// packet sequence; 0 if the transfer failed
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// error message if the transfer failed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *RefreshingCompletion) Reset() {
-	*x = RefreshingCompletion{}
+func (x *DepositIBCTransfer) Reset() {
+	*x = DepositIBCTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[19]
+		mi := &file_bitway_btcbridge_btcbridge_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshingCompletion) String() string {
+func (x *DepositIBCTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshingCompletion) ProtoMessage() {}
+func (*DepositIBCTransfer) ProtoMessage() {}
 
-// Deprecated: Use RefreshingCompletion.ProtoReflect.Descriptor instead.
-func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DepositIBCTransfer.ProtoReflect.Descriptor instead.
+func (*DepositIBCTransfer) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_btcbridge_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RefreshingCompletion) GetId() uint64 {
+func (x *DepositIBCTransfer) GetChannelId() string {
 	if x != nil {
-		return x.Id
+		return x.ChannelId
 	}
-	return 0
+	return ""
 }
 
-func (x *RefreshingCompletion) GetSender() string {
+func (x *DepositIBCTransfer) GetRecipient() string {
 	if x != nil {
-		return x.Sender
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *RefreshingCompletion) GetConsensusPubkey() string {
+func (x *DepositIBCTransfer) GetSequence() uint64 {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (x *RefreshingCompletion) GetSignature() string {
+func (x *DepositIBCTransfer) GetError() string {
 	if x != nil {
-		return x.Signature
+		return x.Error
 	}
 	return ""
 }
@@ -13959,214 +24160,452 @@ var file_bitway_btcbridge_btcbridge_proto_rawDesc = []byte{
 	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
 	0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e,
 	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x73, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x49, 0x42, 0x43, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x56, 0x0a,
-	0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x49, 0x42, 0x43, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x56, 0x0a, 0x12,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x17, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x04, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76,
+	0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x33, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x06,
+	0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x78, 0x22, 0x80, 0x02, 0x0a,
+	0x13, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49,
+	0x64, 0x12, 0x4e, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x61, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa1, 0x02, 0x0a, 0x1a, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x6a, 0x0a,
+	0x15, 0x52, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x07, 0x65, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x65, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x61, 0x0a, 0x05, 0x45, 0x64, 0x69,
+	0x63, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x10,
+	0x42, 0x74, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x4e, 0x75, 0x6d, 0x22, 0x71, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75,
+	0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e,
+	0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x44, 0x4b, 0x47, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f,
+	0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e,
+	0x69, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xe1, 0x03, 0x0a, 0x0a, 0x44,
+	0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3c, 0x0a,
+	0x0b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x44, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
-	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0e,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xdf,
+	0x01, 0x0a, 0x14, 0x44, 0x4b, 0x47, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73,
+	0x22, 0xf8, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x31, 0x0a,
+	0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x62,
+	0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64,
+	0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0x9d, 0x03, 0x0a, 0x05, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x17, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x04, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x76, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24,
-	0x0a, 0x0e, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a,
-	0x06, 0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a,
-	0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x78, 0x22, 0x61, 0x0a,
-	0x05, 0x45, 0x64, 0x69, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x22, 0x56, 0x0a, 0x10, 0x42, 0x74, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x22, 0x71, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x65,
-	0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
-	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x22, 0x80, 0x01, 0x0a, 0x0e,
-	0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x91,
-	0x03, 0x0a, 0x0a, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a,
-	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d,
-	0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x44, 0x4b, 0x47, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49,
-	0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x87, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2a, 0xa4, 0x01, 0x0a, 0x0d, 0x53, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
-	0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53,
-	0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e, 0x49,
-	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
-	0x41, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x49, 0x47, 0x4e, 0x49,
-	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
-	0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04,
-	0x2a, 0xb8, 0x01, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51,
-	0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4b, 0x47,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x4b, 0x47,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44,
-	0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4b,
-	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0x95, 0x01, 0x0a, 0x10,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xb9, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x74, 0x63,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x0b, 0x69, 0x62, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x83,
+	0x01, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x2a, 0xa4, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e,
 	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e,
-	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55,
-	0x54, 0x10, 0x03, 0x42, 0xba, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0e, 0x42, 0x74,
-	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10, 0x42, 0x69, 0x74,
-	0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1c,
-	0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x42,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xb8, 0x01, 0x0a, 0x10,
+	0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x0a, 0x1e, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x44, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x52,
+	0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x2a, 0x68,
+	0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42,
+	0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xa5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72,
+	0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42,
+	0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x55, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x32, 0x0a, 0x2e, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x55, 0x52, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x45, 0x53, 0x5f, 0x41, 0x54, 0x54,
+	0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x34, 0x0a, 0x30, 0x52, 0x45,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f,
+	0x55, 0x52, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e,
+	0x45, 0x53, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02,
+	0x2a, 0xf4, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x44,
+	0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41,
+	0x57, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x52,
+	0x47, 0x45, 0x54, 0x5f, 0x49, 0x42, 0x43, 0x5f, 0x50, 0x45, 0x47, 0x4f, 0x55, 0x54, 0x10, 0x03,
+	0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10,
+	0x04, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4c, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x2a, 0x77, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x49, 0x46, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0x73, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49,
+	0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x4f, 0x56, 0x45, 0x52, 0x4e,
+	0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x45, 0x45,
+	0x4e, 0x45, 0x52, 0x10, 0x02, 0x42, 0xba, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0e,
+	0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10, 0x42,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2,
+	0x02, 0x1c, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x11, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14181,61 +24620,102 @@ func file_bitway_btcbridge_btcbridge_proto_rawDescGZIP() []byte {
 	return file_bitway_btcbridge_btcbridge_proto_rawDescData
 }
 
-var file_bitway_btcbridge_btcbridge_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_bitway_btcbridge_btcbridge_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_bitway_btcbridge_btcbridge_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_bitway_btcbridge_btcbridge_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_bitway_btcbridge_btcbridge_proto_goTypes = []interface{}{
-	(SigningStatus)(0),              // 0: bitway.btcbridge.SigningStatus
-	(DKGRequestStatus)(0),           // 1: bitway.btcbridge.DKGRequestStatus
-	(RefreshingStatus)(0),           // 2: bitway.btcbridge.RefreshingStatus
-	(*FeeRate)(nil),                 // 3: bitway.btcbridge.FeeRate
-	(*SigningRequest)(nil),          // 4: bitway.btcbridge.SigningRequest
-	(*CompactSigningRequest)(nil),   // 5: bitway.btcbridge.CompactSigningRequest
-	(*WithdrawRequest)(nil),         // 6: bitway.btcbridge.WithdrawRequest
-	(*IBCWithdrawRequest)(nil),      // 7: bitway.btcbridge.IBCWithdrawRequest
-	(*RateLimit)(nil),               // 8: bitway.btcbridge.RateLimit
-	(*GlobalRateLimit)(nil),         // 9: bitway.btcbridge.GlobalRateLimit
-	(*AddressRateLimit)(nil),        // 10: bitway.btcbridge.AddressRateLimit
-	(*AddressRateLimitDetails)(nil), // 11: bitway.btcbridge.AddressRateLimitDetails
-	(*UTXO)(nil),                    // 12: bitway.btcbridge.UTXO
-	(*RuneBalance)(nil),             // 13: bitway.btcbridge.RuneBalance
-	(*RuneId)(nil),                  // 14: bitway.btcbridge.RuneId
-	(*Edict)(nil),                   // 15: bitway.btcbridge.Edict
-	(*BtcConsolidation)(nil),        // 16: bitway.btcbridge.BtcConsolidation
-	(*RunesConsolidation)(nil),      // 17: bitway.btcbridge.RunesConsolidation
-	(*DKGParticipant)(nil),          // 18: bitway.btcbridge.DKGParticipant
-	(*DKGRequest)(nil),              // 19: bitway.btcbridge.DKGRequest
-	(*DKGCompletionRequest)(nil),    // 20: bitway.btcbridge.DKGCompletionRequest
-	(*RefreshingRequest)(nil),       // 21: bitway.btcbridge.RefreshingRequest
-	(*RefreshingCompletion)(nil),    // 22: bitway.btcbridge.RefreshingCompletion
-	(AssetType)(0),                  // 23: bitway.btcbridge.AssetType
-	(*timestamppb.Timestamp)(nil),   // 24: google.protobuf.Timestamp
+	(SigningStatus)(0),                 // 0: bitway.btcbridge.SigningStatus
+	(DKGRequestStatus)(0),              // 1: bitway.btcbridge.DKGRequestStatus
+	(RefreshingStatus)(0),              // 2: bitway.btcbridge.RefreshingStatus
+	(RelayerStatus)(0),                 // 3: bitway.btcbridge.RelayerStatus
+	(RelayerMisbehaviour)(0),           // 4: bitway.btcbridge.RelayerMisbehaviour
+	(PauseTarget)(0),                   // 5: bitway.btcbridge.PauseTarget
+	(PauseStatus)(0),                   // 6: bitway.btcbridge.PauseStatus
+	(ScreeningSource)(0),               // 7: bitway.btcbridge.ScreeningSource
+	(*FeeRate)(nil),                    // 8: bitway.btcbridge.FeeRate
+	(*SigningRequest)(nil),             // 9: bitway.btcbridge.SigningRequest
+	(*CompactSigningRequest)(nil),      // 10: bitway.btcbridge.CompactSigningRequest
+	(*WithdrawRequest)(nil),            // 11: bitway.btcbridge.WithdrawRequest
+	(*IBCWithdrawRequest)(nil),         // 12: bitway.btcbridge.IBCWithdrawRequest
+	(*RateLimit)(nil),                  // 13: bitway.btcbridge.RateLimit
+	(*GlobalRateLimit)(nil),            // 14: bitway.btcbridge.GlobalRateLimit
+	(*AddressRateLimit)(nil),           // 15: bitway.btcbridge.AddressRateLimit
+	(*AddressRateLimitDetails)(nil),    // 16: bitway.btcbridge.AddressRateLimitDetails
+	(*UTXO)(nil),                       // 17: bitway.btcbridge.UTXO
+	(*RuneBalance)(nil),                // 18: bitway.btcbridge.RuneBalance
+	(*RuneId)(nil),                     // 19: bitway.btcbridge.RuneId
+	(*FeeSponsorshipUsage)(nil),        // 20: bitway.btcbridge.FeeSponsorshipUsage
+	(*FeeSponsorshipAccountUsage)(nil), // 21: bitway.btcbridge.FeeSponsorshipAccountUsage
+	(*RuneEtching)(nil),                // 22: bitway.btcbridge.RuneEtching
+	(*RuneEtchingSubmission)(nil),      // 23: bitway.btcbridge.RuneEtchingSubmission
+	(*Edict)(nil),                      // 24: bitway.btcbridge.Edict
+	(*BtcConsolidation)(nil),           // 25: bitway.btcbridge.BtcConsolidation
+	(*RunesConsolidation)(nil),         // 26: bitway.btcbridge.RunesConsolidation
+	(*DKGParticipant)(nil),             // 27: bitway.btcbridge.DKGParticipant
+	(*DKGRequest)(nil),                 // 28: bitway.btcbridge.DKGRequest
+	(*DKGCompletionRequest)(nil),       // 29: bitway.btcbridge.DKGCompletionRequest
+	(*RefreshingRequest)(nil),          // 30: bitway.btcbridge.RefreshingRequest
+	(*RefreshingCompletion)(nil),       // 31: bitway.btcbridge.RefreshingCompletion
+	(*Relayer)(nil),                    // 32: bitway.btcbridge.Relayer
+	(*RunesAttestation)(nil),           // 33: bitway.btcbridge.RunesAttestation
+	(*Pause)(nil),                      // 34: bitway.btcbridge.Pause
+	(*ScreenedAddress)(nil),            // 35: bitway.btcbridge.ScreenedAddress
+	(*QuarantinedDeposit)(nil),         // 36: bitway.btcbridge.QuarantinedDeposit
+	(*QuarantinedWithdrawal)(nil),      // 37: bitway.btcbridge.QuarantinedWithdrawal
+	(*DepositRecord)(nil),              // 38: bitway.btcbridge.DepositRecord
+	(*DepositIBCTransfer)(nil),         // 39: bitway.btcbridge.DepositIBCTransfer
+	(AssetType)(0),                     // 40: bitway.btcbridge.AssetType
+	(*timestamppb.Timestamp)(nil),      // 41: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),               // 42: cosmos.base.v1beta1.Coin
+	(*VaultRecoveryParams)(nil),        // 43: bitway.btcbridge.VaultRecoveryParams
 }
 var file_bitway_btcbridge_btcbridge_proto_depIdxs = []int32{
-	23, // 0: bitway.btcbridge.SigningRequest.type:type_name -> bitway.btcbridge.AssetType
-	24, // 1: bitway.btcbridge.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
+	40, // 0: bitway.btcbridge.SigningRequest.type:type_name -> bitway.btcbridge.AssetType
+	41, // 1: bitway.btcbridge.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
 	0,  // 2: bitway.btcbridge.SigningRequest.status:type_name -> bitway.btcbridge.SigningStatus
-	23, // 3: bitway.btcbridge.CompactSigningRequest.type:type_name -> bitway.btcbridge.AssetType
-	24, // 4: bitway.btcbridge.CompactSigningRequest.creation_time:type_name -> google.protobuf.Timestamp
+	40, // 3: bitway.btcbridge.CompactSigningRequest.type:type_name -> bitway.btcbridge.AssetType
+	41, // 4: bitway.btcbridge.CompactSigningRequest.creation_time:type_name -> google.protobuf.Timestamp
 	0,  // 5: bitway.btcbridge.CompactSigningRequest.status:type_name -> bitway.btcbridge.SigningStatus
-	9,  // 6: bitway.btcbridge.RateLimit.global_rate_limit:type_name -> bitway.btcbridge.GlobalRateLimit
-	10, // 7: bitway.btcbridge.RateLimit.address_rate_limit:type_name -> bitway.btcbridge.AddressRateLimit
-	24, // 8: bitway.btcbridge.GlobalRateLimit.start_time:type_name -> google.protobuf.Timestamp
-	24, // 9: bitway.btcbridge.GlobalRateLimit.end_time:type_name -> google.protobuf.Timestamp
-	24, // 10: bitway.btcbridge.AddressRateLimit.start_time:type_name -> google.protobuf.Timestamp
-	24, // 11: bitway.btcbridge.AddressRateLimit.end_time:type_name -> google.protobuf.Timestamp
-	13, // 12: bitway.btcbridge.UTXO.runes:type_name -> bitway.btcbridge.RuneBalance
-	14, // 13: bitway.btcbridge.Edict.id:type_name -> bitway.btcbridge.RuneId
-	18, // 14: bitway.btcbridge.DKGRequest.participants:type_name -> bitway.btcbridge.DKGParticipant
-	23, // 15: bitway.btcbridge.DKGRequest.vault_types:type_name -> bitway.btcbridge.AssetType
-	24, // 16: bitway.btcbridge.DKGRequest.expiration:type_name -> google.protobuf.Timestamp
-	1,  // 17: bitway.btcbridge.DKGRequest.status:type_name -> bitway.btcbridge.DKGRequestStatus
-	24, // 18: bitway.btcbridge.RefreshingRequest.expiration_time:type_name -> google.protobuf.Timestamp
-	2,  // 19: bitway.btcbridge.RefreshingRequest.status:type_name -> bitway.btcbridge.RefreshingStatus
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	14, // 6: bitway.btcbridge.RateLimit.global_rate_limit:type_name -> bitway.btcbridge.GlobalRateLimit
+	15, // 7: bitway.btcbridge.RateLimit.address_rate_limit:type_name -> bitway.btcbridge.AddressRateLimit
+	41, // 8: bitway.btcbridge.GlobalRateLimit.start_time:type_name -> google.protobuf.Timestamp
+	41, // 9: bitway.btcbridge.GlobalRateLimit.end_time:type_name -> google.protobuf.Timestamp
+	41, // 10: bitway.btcbridge.AddressRateLimit.start_time:type_name -> google.protobuf.Timestamp
+	41, // 11: bitway.btcbridge.AddressRateLimit.end_time:type_name -> google.protobuf.Timestamp
+	18, // 12: bitway.btcbridge.UTXO.runes:type_name -> bitway.btcbridge.RuneBalance
+	41, // 13: bitway.btcbridge.FeeSponsorshipUsage.epoch_start_time:type_name -> google.protobuf.Timestamp
+	42, // 14: bitway.btcbridge.FeeSponsorshipUsage.spent:type_name -> cosmos.base.v1beta1.Coin
+	41, // 15: bitway.btcbridge.FeeSponsorshipAccountUsage.epoch_start_time:type_name -> google.protobuf.Timestamp
+	42, // 16: bitway.btcbridge.FeeSponsorshipAccountUsage.spent:type_name -> cosmos.base.v1beta1.Coin
+	22, // 17: bitway.btcbridge.RuneEtchingSubmission.etching:type_name -> bitway.btcbridge.RuneEtching
+	19, // 18: bitway.btcbridge.Edict.id:type_name -> bitway.btcbridge.RuneId
+	27, // 19: bitway.btcbridge.DKGRequest.participants:type_name -> bitway.btcbridge.DKGParticipant
+	40, // 20: bitway.btcbridge.DKGRequest.vault_types:type_name -> bitway.btcbridge.AssetType
+	41, // 21: bitway.btcbridge.DKGRequest.expiration:type_name -> google.protobuf.Timestamp
+	1,  // 22: bitway.btcbridge.DKGRequest.status:type_name -> bitway.btcbridge.DKGRequestStatus
+	43, // 23: bitway.btcbridge.DKGRequest.recovery_params:type_name -> bitway.btcbridge.VaultRecoveryParams
+	41, // 24: bitway.btcbridge.RefreshingRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	2,  // 25: bitway.btcbridge.RefreshingRequest.status:type_name -> bitway.btcbridge.RefreshingStatus
+	42, // 26: bitway.btcbridge.Relayer.bond:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 27: bitway.btcbridge.Relayer.status:type_name -> bitway.btcbridge.RelayerStatus
+	42, // 28: bitway.btcbridge.Relayer.total_rewards:type_name -> cosmos.base.v1beta1.Coin
+	41, // 29: bitway.btcbridge.Relayer.unbonding_time:type_name -> google.protobuf.Timestamp
+	5,  // 30: bitway.btcbridge.Pause.target:type_name -> bitway.btcbridge.PauseTarget
+	40, // 31: bitway.btcbridge.Pause.asset_type:type_name -> bitway.btcbridge.AssetType
+	41, // 32: bitway.btcbridge.Pause.start_time:type_name -> google.protobuf.Timestamp
+	41, // 33: bitway.btcbridge.Pause.end_time:type_name -> google.protobuf.Timestamp
+	6,  // 34: bitway.btcbridge.Pause.status:type_name -> bitway.btcbridge.PauseStatus
+	7,  // 35: bitway.btcbridge.ScreenedAddress.source:type_name -> bitway.btcbridge.ScreeningSource
+	42, // 36: bitway.btcbridge.QuarantinedDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	42, // 37: bitway.btcbridge.QuarantinedWithdrawal.amount:type_name -> cosmos.base.v1beta1.Coin
+	40, // 38: bitway.btcbridge.DepositRecord.asset:type_name -> bitway.btcbridge.AssetType
+	42, // 39: bitway.btcbridge.DepositRecord.amount:type_name -> cosmos.base.v1beta1.Coin
+	42, // 40: bitway.btcbridge.DepositRecord.fee:type_name -> cosmos.base.v1beta1.Coin
+	39, // 41: bitway.btcbridge.DepositRecord.ibc_transfer:type_name -> bitway.btcbridge.DepositIBCTransfer
+	42, // [42:42] is the sub-list for method output_type
+	42, // [42:42] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_bitway_btcbridge_btcbridge_proto_init() }
@@ -14390,7 +24870,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Edict); i {
+			switch v := v.(*FeeSponsorshipUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14402,7 +24882,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BtcConsolidation); i {
+			switch v := v.(*FeeSponsorshipAccountUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14414,7 +24894,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunesConsolidation); i {
+			switch v := v.(*RuneEtching); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14426,7 +24906,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGParticipant); i {
+			switch v := v.(*RuneEtchingSubmission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14438,7 +24918,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGRequest); i {
+			switch v := v.(*Edict); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14450,7 +24930,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGCompletionRequest); i {
+			switch v := v.(*BtcConsolidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14462,7 +24942,7 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshingRequest); i {
+			switch v := v.(*RunesConsolidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14474,6 +24954,54 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_btcbridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGParticipant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGCompletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshingCompletion); i {
 			case 0:
 				return &v.state
@@ -14485,14 +25013,110 @@ func file_bitway_btcbridge_btcbridge_proto_init() {
 				return nil
 			}
 		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunesAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantinedDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantinedWithdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_btcbridge_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositIBCTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bitway_btcbridge_btcbridge_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   20,
+			NumEnums:      8,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
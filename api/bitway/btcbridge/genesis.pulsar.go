@@ -365,737 +365,2263 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                               protoreflect.MessageDescriptor
-	fd_GenesisState_params                        protoreflect.FieldDescriptor
-	fd_GenesisState_utxos                         protoreflect.FieldDescriptor
-	fd_GenesisState_dkg_requests                  protoreflect.FieldDescriptor
-	fd_GenesisState_dkg_completions               protoreflect.FieldDescriptor
-	fd_GenesisState_signing_requests              protoreflect.FieldDescriptor
-	fd_GenesisState_withdraw_requests             protoreflect.FieldDescriptor
-	fd_GenesisState_pending_btc_withdraw_requests protoreflect.FieldDescriptor
-	fd_GenesisState_minted_tx_hashes              protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
 
-func init() {
-	file_bitway_btcbridge_genesis_proto_init()
-	md_GenesisState = File_bitway_btcbridge_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_utxos = md_GenesisState.Fields().ByName("utxos")
-	fd_GenesisState_dkg_requests = md_GenesisState.Fields().ByName("dkg_requests")
-	fd_GenesisState_dkg_completions = md_GenesisState.Fields().ByName("dkg_completions")
-	fd_GenesisState_signing_requests = md_GenesisState.Fields().ByName("signing_requests")
-	fd_GenesisState_withdraw_requests = md_GenesisState.Fields().ByName("withdraw_requests")
-	fd_GenesisState_pending_btc_withdraw_requests = md_GenesisState.Fields().ByName("pending_btc_withdraw_requests")
-	fd_GenesisState_minted_tx_hashes = md_GenesisState.Fields().ByName("minted_tx_hashes")
+type _GenesisState_9_list struct {
+	list *[]*Relayer
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Relayer)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_bitway_btcbridge_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Relayer)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(Relayer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(Relayer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*RunesAttestation
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RunesAttestation)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RunesAttestation)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Utxos) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Utxos})
-		if !f(fd_GenesisState_utxos, value) {
-			return
-		}
-	}
-	if len(x.DkgRequests) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DkgRequests})
-		if !f(fd_GenesisState_dkg_requests, value) {
-			return
-		}
-	}
-	if len(x.DkgCompletions) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DkgCompletions})
-		if !f(fd_GenesisState_dkg_completions, value) {
-			return
-		}
-	}
-	if len(x.SigningRequests) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SigningRequests})
-		if !f(fd_GenesisState_signing_requests, value) {
-			return
-		}
-	}
-	if len(x.WithdrawRequests) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.WithdrawRequests})
-		if !f(fd_GenesisState_withdraw_requests, value) {
-			return
-		}
-	}
-	if len(x.PendingBtcWithdrawRequests) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests})
-		if !f(fd_GenesisState_pending_btc_withdraw_requests, value) {
-			return
-		}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(RunesAttestation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.MintedTxHashes) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.MintedTxHashes})
-		if !f(fd_GenesisState_minted_tx_hashes, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(RunesAttestation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*Pause
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		return x.Params != nil
-	case "bitway.btcbridge.GenesisState.utxos":
-		return len(x.Utxos) != 0
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		return len(x.DkgRequests) != 0
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		return len(x.DkgCompletions) != 0
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		return len(x.SigningRequests) != 0
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		return len(x.WithdrawRequests) != 0
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		return len(x.PendingBtcWithdrawRequests) != 0
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		return len(x.MintedTxHashes) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pause)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pause)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(Pause)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		x.Params = nil
-	case "bitway.btcbridge.GenesisState.utxos":
-		x.Utxos = nil
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		x.DkgRequests = nil
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		x.DkgCompletions = nil
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		x.SigningRequests = nil
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		x.WithdrawRequests = nil
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		x.PendingBtcWithdrawRequests = nil
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		x.MintedTxHashes = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(Pause)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ScreenedAddress
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.GenesisState.utxos":
-		if len(x.Utxos) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Utxos}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		if len(x.DkgRequests) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.DkgRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		if len(x.DkgCompletions) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.DkgCompletions}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		if len(x.SigningRequests) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.SigningRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		if len(x.WithdrawRequests) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
-		}
-		listValue := &_GenesisState_6_list{list: &x.WithdrawRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		if len(x.PendingBtcWithdrawRequests) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_7_list{})
-		}
-		listValue := &_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		if len(x.MintedTxHashes) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_8_list{})
-		}
-		listValue := &_GenesisState_8_list{list: &x.MintedTxHashes}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "bitway.btcbridge.GenesisState.utxos":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Utxos = *clv.list
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.DkgRequests = *clv.list
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.DkgCompletions = *clv.list
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.SigningRequests = *clv.list
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.WithdrawRequests = *clv.list
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		lv := value.List()
-		clv := lv.(*_GenesisState_7_list)
-		x.PendingBtcWithdrawRequests = *clv.list
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		lv := value.List()
-		clv := lv.(*_GenesisState_8_list)
-		x.MintedTxHashes = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScreenedAddress)
+	(*x.list)[i] = concreteValue
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "bitway.btcbridge.GenesisState.utxos":
-		if x.Utxos == nil {
-			x.Utxos = []*UTXO{}
-		}
-		value := &_GenesisState_2_list{list: &x.Utxos}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		if x.DkgRequests == nil {
-			x.DkgRequests = []*DKGRequest{}
-		}
-		value := &_GenesisState_3_list{list: &x.DkgRequests}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		if x.DkgCompletions == nil {
-			x.DkgCompletions = []*DKGCompletionRequest{}
-		}
-		value := &_GenesisState_4_list{list: &x.DkgCompletions}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		if x.SigningRequests == nil {
-			x.SigningRequests = []*SigningRequest{}
-		}
-		value := &_GenesisState_5_list{list: &x.SigningRequests}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		if x.WithdrawRequests == nil {
-			x.WithdrawRequests = []*WithdrawRequest{}
-		}
-		value := &_GenesisState_6_list{list: &x.WithdrawRequests}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		if x.PendingBtcWithdrawRequests == nil {
-			x.PendingBtcWithdrawRequests = []*WithdrawRequest{}
-		}
-		value := &_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests}
-		return protoreflect.ValueOfList(value)
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		if x.MintedTxHashes == nil {
-			x.MintedTxHashes = []string{}
-		}
-		value := &_GenesisState_8_list{list: &x.MintedTxHashes}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScreenedAddress)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "bitway.btcbridge.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.GenesisState.utxos":
-		list := []*UTXO{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "bitway.btcbridge.GenesisState.dkg_requests":
-		list := []*DKGRequest{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "bitway.btcbridge.GenesisState.dkg_completions":
-		list := []*DKGCompletionRequest{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "bitway.btcbridge.GenesisState.signing_requests":
-		list := []*SigningRequest{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "bitway.btcbridge.GenesisState.withdraw_requests":
-		list := []*WithdrawRequest{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
-	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
-		list := []*WithdrawRequest{}
-		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
-	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
-		}
-		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ScreenedAddress)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.GenesisState", d.FullName()))
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ScreenedAddress)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*QuarantinedDeposit
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Utxos) > 0 {
-			for _, e := range x.Utxos {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DkgRequests) > 0 {
-			for _, e := range x.DkgRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DkgCompletions) > 0 {
-			for _, e := range x.DkgCompletions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.SigningRequests) > 0 {
-			for _, e := range x.SigningRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.WithdrawRequests) > 0 {
-			for _, e := range x.WithdrawRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PendingBtcWithdrawRequests) > 0 {
-			for _, e := range x.PendingBtcWithdrawRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.MintedTxHashes) > 0 {
-			for _, s := range x.MintedTxHashes {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.MintedTxHashes) > 0 {
-			for iNdEx := len(x.MintedTxHashes) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.MintedTxHashes[iNdEx])
-				copy(dAtA[i:], x.MintedTxHashes[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintedTxHashes[iNdEx])))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.PendingBtcWithdrawRequests) > 0 {
-			for iNdEx := len(x.PendingBtcWithdrawRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PendingBtcWithdrawRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if len(x.WithdrawRequests) > 0 {
-			for iNdEx := len(x.WithdrawRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.WithdrawRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(QuarantinedDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(QuarantinedDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*DepositRecord
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(DepositRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(DepositRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*RuneEtching
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneEtching)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneEtching)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(RuneEtching)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(RuneEtching)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*FeeSponsorshipUsage
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSponsorshipUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(FeeSponsorshipUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*FeeSponsorshipAccountUsage
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipAccountUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipAccountUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSponsorshipAccountUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(FeeSponsorshipAccountUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*QuarantinedWithdrawal
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedWithdrawal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedWithdrawal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(QuarantinedWithdrawal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(QuarantinedWithdrawal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*RuneEtchingSubmission
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneEtchingSubmission)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneEtchingSubmission)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(RuneEtchingSubmission)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(RuneEtchingSubmission)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                                protoreflect.MessageDescriptor
+	fd_GenesisState_params                         protoreflect.FieldDescriptor
+	fd_GenesisState_utxos                          protoreflect.FieldDescriptor
+	fd_GenesisState_dkg_requests                   protoreflect.FieldDescriptor
+	fd_GenesisState_dkg_completions                protoreflect.FieldDescriptor
+	fd_GenesisState_signing_requests               protoreflect.FieldDescriptor
+	fd_GenesisState_withdraw_requests              protoreflect.FieldDescriptor
+	fd_GenesisState_pending_btc_withdraw_requests  protoreflect.FieldDescriptor
+	fd_GenesisState_minted_tx_hashes               protoreflect.FieldDescriptor
+	fd_GenesisState_relayers                       protoreflect.FieldDescriptor
+	fd_GenesisState_runes_attestations             protoreflect.FieldDescriptor
+	fd_GenesisState_pauses                         protoreflect.FieldDescriptor
+	fd_GenesisState_screened_addresses             protoreflect.FieldDescriptor
+	fd_GenesisState_quarantined_deposits           protoreflect.FieldDescriptor
+	fd_GenesisState_deposit_records                protoreflect.FieldDescriptor
+	fd_GenesisState_rune_etchings                  protoreflect.FieldDescriptor
+	fd_GenesisState_fee_sponsorship_usages         protoreflect.FieldDescriptor
+	fd_GenesisState_fee_sponsorship_account_usages protoreflect.FieldDescriptor
+	fd_GenesisState_quarantined_withdrawals        protoreflect.FieldDescriptor
+	fd_GenesisState_rune_etching_submissions       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_genesis_proto_init()
+	md_GenesisState = File_bitway_btcbridge_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_utxos = md_GenesisState.Fields().ByName("utxos")
+	fd_GenesisState_dkg_requests = md_GenesisState.Fields().ByName("dkg_requests")
+	fd_GenesisState_dkg_completions = md_GenesisState.Fields().ByName("dkg_completions")
+	fd_GenesisState_signing_requests = md_GenesisState.Fields().ByName("signing_requests")
+	fd_GenesisState_withdraw_requests = md_GenesisState.Fields().ByName("withdraw_requests")
+	fd_GenesisState_pending_btc_withdraw_requests = md_GenesisState.Fields().ByName("pending_btc_withdraw_requests")
+	fd_GenesisState_minted_tx_hashes = md_GenesisState.Fields().ByName("minted_tx_hashes")
+	fd_GenesisState_relayers = md_GenesisState.Fields().ByName("relayers")
+	fd_GenesisState_runes_attestations = md_GenesisState.Fields().ByName("runes_attestations")
+	fd_GenesisState_pauses = md_GenesisState.Fields().ByName("pauses")
+	fd_GenesisState_screened_addresses = md_GenesisState.Fields().ByName("screened_addresses")
+	fd_GenesisState_quarantined_deposits = md_GenesisState.Fields().ByName("quarantined_deposits")
+	fd_GenesisState_deposit_records = md_GenesisState.Fields().ByName("deposit_records")
+	fd_GenesisState_rune_etchings = md_GenesisState.Fields().ByName("rune_etchings")
+	fd_GenesisState_fee_sponsorship_usages = md_GenesisState.Fields().ByName("fee_sponsorship_usages")
+	fd_GenesisState_fee_sponsorship_account_usages = md_GenesisState.Fields().ByName("fee_sponsorship_account_usages")
+	fd_GenesisState_quarantined_withdrawals = md_GenesisState.Fields().ByName("quarantined_withdrawals")
+	fd_GenesisState_rune_etching_submissions = md_GenesisState.Fields().ByName("rune_etching_submissions")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Utxos) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Utxos})
+		if !f(fd_GenesisState_utxos, value) {
+			return
+		}
+	}
+	if len(x.DkgRequests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DkgRequests})
+		if !f(fd_GenesisState_dkg_requests, value) {
+			return
+		}
+	}
+	if len(x.DkgCompletions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DkgCompletions})
+		if !f(fd_GenesisState_dkg_completions, value) {
+			return
+		}
+	}
+	if len(x.SigningRequests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SigningRequests})
+		if !f(fd_GenesisState_signing_requests, value) {
+			return
+		}
+	}
+	if len(x.WithdrawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.WithdrawRequests})
+		if !f(fd_GenesisState_withdraw_requests, value) {
+			return
+		}
+	}
+	if len(x.PendingBtcWithdrawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests})
+		if !f(fd_GenesisState_pending_btc_withdraw_requests, value) {
+			return
+		}
+	}
+	if len(x.MintedTxHashes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.MintedTxHashes})
+		if !f(fd_GenesisState_minted_tx_hashes, value) {
+			return
+		}
+	}
+	if len(x.Relayers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Relayers})
+		if !f(fd_GenesisState_relayers, value) {
+			return
+		}
+	}
+	if len(x.RunesAttestations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.RunesAttestations})
+		if !f(fd_GenesisState_runes_attestations, value) {
+			return
+		}
+	}
+	if len(x.Pauses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.Pauses})
+		if !f(fd_GenesisState_pauses, value) {
+			return
+		}
+	}
+	if len(x.ScreenedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ScreenedAddresses})
+		if !f(fd_GenesisState_screened_addresses, value) {
+			return
+		}
+	}
+	if len(x.QuarantinedDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.QuarantinedDeposits})
+		if !f(fd_GenesisState_quarantined_deposits, value) {
+			return
+		}
+	}
+	if len(x.DepositRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.DepositRecords})
+		if !f(fd_GenesisState_deposit_records, value) {
+			return
+		}
+	}
+	if len(x.RuneEtchings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.RuneEtchings})
+		if !f(fd_GenesisState_rune_etchings, value) {
+			return
+		}
+	}
+	if len(x.FeeSponsorshipUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.FeeSponsorshipUsages})
+		if !f(fd_GenesisState_fee_sponsorship_usages, value) {
+			return
+		}
+	}
+	if len(x.FeeSponsorshipAccountUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.FeeSponsorshipAccountUsages})
+		if !f(fd_GenesisState_fee_sponsorship_account_usages, value) {
+			return
+		}
+	}
+	if len(x.QuarantinedWithdrawals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.QuarantinedWithdrawals})
+		if !f(fd_GenesisState_quarantined_withdrawals, value) {
+			return
+		}
+	}
+	if len(x.RuneEtchingSubmissions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.RuneEtchingSubmissions})
+		if !f(fd_GenesisState_rune_etching_submissions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		return x.Params != nil
+	case "bitway.btcbridge.GenesisState.utxos":
+		return len(x.Utxos) != 0
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		return len(x.DkgRequests) != 0
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		return len(x.DkgCompletions) != 0
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		return len(x.SigningRequests) != 0
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		return len(x.WithdrawRequests) != 0
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		return len(x.PendingBtcWithdrawRequests) != 0
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		return len(x.MintedTxHashes) != 0
+	case "bitway.btcbridge.GenesisState.relayers":
+		return len(x.Relayers) != 0
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		return len(x.RunesAttestations) != 0
+	case "bitway.btcbridge.GenesisState.pauses":
+		return len(x.Pauses) != 0
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		return len(x.ScreenedAddresses) != 0
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		return len(x.QuarantinedDeposits) != 0
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		return len(x.DepositRecords) != 0
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		return len(x.RuneEtchings) != 0
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		return len(x.FeeSponsorshipUsages) != 0
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		return len(x.FeeSponsorshipAccountUsages) != 0
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		return len(x.QuarantinedWithdrawals) != 0
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		return len(x.RuneEtchingSubmissions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		x.Params = nil
+	case "bitway.btcbridge.GenesisState.utxos":
+		x.Utxos = nil
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		x.DkgRequests = nil
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		x.DkgCompletions = nil
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		x.SigningRequests = nil
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		x.WithdrawRequests = nil
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		x.PendingBtcWithdrawRequests = nil
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		x.MintedTxHashes = nil
+	case "bitway.btcbridge.GenesisState.relayers":
+		x.Relayers = nil
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		x.RunesAttestations = nil
+	case "bitway.btcbridge.GenesisState.pauses":
+		x.Pauses = nil
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		x.ScreenedAddresses = nil
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		x.QuarantinedDeposits = nil
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		x.DepositRecords = nil
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		x.RuneEtchings = nil
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		x.FeeSponsorshipUsages = nil
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		x.FeeSponsorshipAccountUsages = nil
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		x.QuarantinedWithdrawals = nil
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		x.RuneEtchingSubmissions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.GenesisState.utxos":
+		if len(x.Utxos) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Utxos}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		if len(x.DkgRequests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.DkgRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		if len(x.DkgCompletions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DkgCompletions}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		if len(x.SigningRequests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.SigningRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		if len(x.WithdrawRequests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.WithdrawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		if len(x.PendingBtcWithdrawRequests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		if len(x.MintedTxHashes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.MintedTxHashes}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.relayers":
+		if len(x.Relayers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Relayers}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		if len(x.RunesAttestations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.RunesAttestations}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.pauses":
+		if len(x.Pauses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.Pauses}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		if len(x.ScreenedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ScreenedAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		if len(x.QuarantinedDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.QuarantinedDeposits}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		if len(x.DepositRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.DepositRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		if len(x.RuneEtchings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.RuneEtchings}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		if len(x.FeeSponsorshipUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.FeeSponsorshipUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		if len(x.FeeSponsorshipAccountUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.FeeSponsorshipAccountUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		if len(x.QuarantinedWithdrawals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.QuarantinedWithdrawals}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		if len(x.RuneEtchingSubmissions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.RuneEtchingSubmissions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "bitway.btcbridge.GenesisState.utxos":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Utxos = *clv.list
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.DkgRequests = *clv.list
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DkgCompletions = *clv.list
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.SigningRequests = *clv.list
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.WithdrawRequests = *clv.list
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PendingBtcWithdrawRequests = *clv.list
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.MintedTxHashes = *clv.list
+	case "bitway.btcbridge.GenesisState.relayers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Relayers = *clv.list
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.RunesAttestations = *clv.list
+	case "bitway.btcbridge.GenesisState.pauses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.Pauses = *clv.list
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ScreenedAddresses = *clv.list
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.QuarantinedDeposits = *clv.list
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.DepositRecords = *clv.list
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.RuneEtchings = *clv.list
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.FeeSponsorshipUsages = *clv.list
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.FeeSponsorshipAccountUsages = *clv.list
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.QuarantinedWithdrawals = *clv.list
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.RuneEtchingSubmissions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "bitway.btcbridge.GenesisState.utxos":
+		if x.Utxos == nil {
+			x.Utxos = []*UTXO{}
+		}
+		value := &_GenesisState_2_list{list: &x.Utxos}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		if x.DkgRequests == nil {
+			x.DkgRequests = []*DKGRequest{}
+		}
+		value := &_GenesisState_3_list{list: &x.DkgRequests}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		if x.DkgCompletions == nil {
+			x.DkgCompletions = []*DKGCompletionRequest{}
+		}
+		value := &_GenesisState_4_list{list: &x.DkgCompletions}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		if x.SigningRequests == nil {
+			x.SigningRequests = []*SigningRequest{}
+		}
+		value := &_GenesisState_5_list{list: &x.SigningRequests}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		if x.WithdrawRequests == nil {
+			x.WithdrawRequests = []*WithdrawRequest{}
+		}
+		value := &_GenesisState_6_list{list: &x.WithdrawRequests}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		if x.PendingBtcWithdrawRequests == nil {
+			x.PendingBtcWithdrawRequests = []*WithdrawRequest{}
+		}
+		value := &_GenesisState_7_list{list: &x.PendingBtcWithdrawRequests}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		if x.MintedTxHashes == nil {
+			x.MintedTxHashes = []string{}
+		}
+		value := &_GenesisState_8_list{list: &x.MintedTxHashes}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.relayers":
+		if x.Relayers == nil {
+			x.Relayers = []*Relayer{}
+		}
+		value := &_GenesisState_9_list{list: &x.Relayers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		if x.RunesAttestations == nil {
+			x.RunesAttestations = []*RunesAttestation{}
+		}
+		value := &_GenesisState_10_list{list: &x.RunesAttestations}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.pauses":
+		if x.Pauses == nil {
+			x.Pauses = []*Pause{}
+		}
+		value := &_GenesisState_11_list{list: &x.Pauses}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		if x.ScreenedAddresses == nil {
+			x.ScreenedAddresses = []*ScreenedAddress{}
+		}
+		value := &_GenesisState_12_list{list: &x.ScreenedAddresses}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		if x.QuarantinedDeposits == nil {
+			x.QuarantinedDeposits = []*QuarantinedDeposit{}
+		}
+		value := &_GenesisState_13_list{list: &x.QuarantinedDeposits}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		if x.DepositRecords == nil {
+			x.DepositRecords = []*DepositRecord{}
+		}
+		value := &_GenesisState_14_list{list: &x.DepositRecords}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		if x.RuneEtchings == nil {
+			x.RuneEtchings = []*RuneEtching{}
+		}
+		value := &_GenesisState_15_list{list: &x.RuneEtchings}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		if x.FeeSponsorshipUsages == nil {
+			x.FeeSponsorshipUsages = []*FeeSponsorshipUsage{}
+		}
+		value := &_GenesisState_16_list{list: &x.FeeSponsorshipUsages}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		if x.FeeSponsorshipAccountUsages == nil {
+			x.FeeSponsorshipAccountUsages = []*FeeSponsorshipAccountUsage{}
+		}
+		value := &_GenesisState_17_list{list: &x.FeeSponsorshipAccountUsages}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		if x.QuarantinedWithdrawals == nil {
+			x.QuarantinedWithdrawals = []*QuarantinedWithdrawal{}
+		}
+		value := &_GenesisState_18_list{list: &x.QuarantinedWithdrawals}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		if x.RuneEtchingSubmissions == nil {
+			x.RuneEtchingSubmissions = []*RuneEtchingSubmission{}
+		}
+		value := &_GenesisState_19_list{list: &x.RuneEtchingSubmissions}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.GenesisState.utxos":
+		list := []*UTXO{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "bitway.btcbridge.GenesisState.dkg_requests":
+		list := []*DKGRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "bitway.btcbridge.GenesisState.dkg_completions":
+		list := []*DKGCompletionRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "bitway.btcbridge.GenesisState.signing_requests":
+		list := []*SigningRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "bitway.btcbridge.GenesisState.withdraw_requests":
+		list := []*WithdrawRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "bitway.btcbridge.GenesisState.pending_btc_withdraw_requests":
+		list := []*WithdrawRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "bitway.btcbridge.GenesisState.minted_tx_hashes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "bitway.btcbridge.GenesisState.relayers":
+		list := []*Relayer{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "bitway.btcbridge.GenesisState.runes_attestations":
+		list := []*RunesAttestation{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "bitway.btcbridge.GenesisState.pauses":
+		list := []*Pause{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "bitway.btcbridge.GenesisState.screened_addresses":
+		list := []*ScreenedAddress{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "bitway.btcbridge.GenesisState.quarantined_deposits":
+		list := []*QuarantinedDeposit{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "bitway.btcbridge.GenesisState.deposit_records":
+		list := []*DepositRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "bitway.btcbridge.GenesisState.rune_etchings":
+		list := []*RuneEtching{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_usages":
+		list := []*FeeSponsorshipUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "bitway.btcbridge.GenesisState.fee_sponsorship_account_usages":
+		list := []*FeeSponsorshipAccountUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "bitway.btcbridge.GenesisState.quarantined_withdrawals":
+		list := []*QuarantinedWithdrawal{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "bitway.btcbridge.GenesisState.rune_etching_submissions":
+		list := []*RuneEtchingSubmission{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GenesisState"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Utxos) > 0 {
+			for _, e := range x.Utxos {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DkgRequests) > 0 {
+			for _, e := range x.DkgRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DkgCompletions) > 0 {
+			for _, e := range x.DkgCompletions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SigningRequests) > 0 {
+			for _, e := range x.SigningRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WithdrawRequests) > 0 {
+			for _, e := range x.WithdrawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingBtcWithdrawRequests) > 0 {
+			for _, e := range x.PendingBtcWithdrawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintedTxHashes) > 0 {
+			for _, s := range x.MintedTxHashes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Relayers) > 0 {
+			for _, e := range x.Relayers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RunesAttestations) > 0 {
+			for _, e := range x.RunesAttestations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Pauses) > 0 {
+			for _, e := range x.Pauses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ScreenedAddresses) > 0 {
+			for _, e := range x.ScreenedAddresses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QuarantinedDeposits) > 0 {
+			for _, e := range x.QuarantinedDeposits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DepositRecords) > 0 {
+			for _, e := range x.DepositRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RuneEtchings) > 0 {
+			for _, e := range x.RuneEtchings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSponsorshipUsages) > 0 {
+			for _, e := range x.FeeSponsorshipUsages {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSponsorshipAccountUsages) > 0 {
+			for _, e := range x.FeeSponsorshipAccountUsages {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QuarantinedWithdrawals) > 0 {
+			for _, e := range x.QuarantinedWithdrawals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RuneEtchingSubmissions) > 0 {
+			for _, e := range x.RuneEtchingSubmissions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RuneEtchingSubmissions) > 0 {
+			for iNdEx := len(x.RuneEtchingSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RuneEtchingSubmissions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.QuarantinedWithdrawals) > 0 {
+			for iNdEx := len(x.QuarantinedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QuarantinedWithdrawals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.FeeSponsorshipAccountUsages) > 0 {
+			for iNdEx := len(x.FeeSponsorshipAccountUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSponsorshipAccountUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.FeeSponsorshipUsages) > 0 {
+			for iNdEx := len(x.FeeSponsorshipUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSponsorshipUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.RuneEtchings) > 0 {
+			for iNdEx := len(x.RuneEtchings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RuneEtchings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.DepositRecords) > 0 {
+			for iNdEx := len(x.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.QuarantinedDeposits) > 0 {
+			for iNdEx := len(x.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QuarantinedDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ScreenedAddresses) > 0 {
+			for iNdEx := len(x.ScreenedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScreenedAddresses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Pauses) > 0 {
+			for iNdEx := len(x.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pauses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.RunesAttestations) > 0 {
+			for iNdEx := len(x.RunesAttestations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RunesAttestations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Relayers) > 0 {
+			for iNdEx := len(x.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Relayers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.MintedTxHashes) > 0 {
+			for iNdEx := len(x.MintedTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MintedTxHashes[iNdEx])
+				copy(dAtA[i:], x.MintedTxHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintedTxHashes[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.PendingBtcWithdrawRequests) > 0 {
+			for iNdEx := len(x.PendingBtcWithdrawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingBtcWithdrawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.WithdrawRequests) > 0 {
+			for iNdEx := len(x.WithdrawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WithdrawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SigningRequests) > 0 {
+			for iNdEx := len(x.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DkgCompletions) > 0 {
+			for iNdEx := len(x.DkgCompletions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DkgCompletions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.DkgRequests) > 0 {
+			for iNdEx := len(x.DkgRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DkgRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Utxos) > 0 {
+			for iNdEx := len(x.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Utxos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Utxos = append(x.Utxos, &UTXO{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Utxos[len(x.Utxos)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DkgRequests = append(x.DkgRequests, &DKGRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgRequests[len(x.DkgRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgCompletions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DkgCompletions = append(x.DkgCompletions, &DKGCompletionRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgCompletions[len(x.DkgCompletions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningRequests = append(x.SigningRequests, &SigningRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningRequests[len(x.SigningRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawRequests = append(x.WithdrawRequests, &WithdrawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawRequests[len(x.WithdrawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingBtcWithdrawRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingBtcWithdrawRequests = append(x.PendingBtcWithdrawRequests, &WithdrawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingBtcWithdrawRequests[len(x.PendingBtcWithdrawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintedTxHashes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintedTxHashes = append(x.MintedTxHashes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayers = append(x.Relayers, &Relayer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Relayers[len(x.Relayers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RunesAttestations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if len(x.SigningRequests) > 0 {
-			for iNdEx := len(x.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SigningRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.DkgCompletions) > 0 {
-			for iNdEx := len(x.DkgCompletions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DkgCompletions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.RunesAttestations = append(x.RunesAttestations, &RunesAttestation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RunesAttestations[len(x.RunesAttestations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.DkgRequests) > 0 {
-			for iNdEx := len(x.DkgRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DkgRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Utxos) > 0 {
-			for iNdEx := len(x.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Utxos[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Pauses = append(x.Pauses, &Pause{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pauses[len(x.Pauses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddresses", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1122,16 +2648,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.ScreenedAddresses = append(x.ScreenedAddresses, &ScreenedAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScreenedAddresses[len(x.ScreenedAddresses)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1158,14 +2682,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Utxos = append(x.Utxos, &UTXO{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Utxos[len(x.Utxos)-1]); err != nil {
+				x.QuarantinedDeposits = append(x.QuarantinedDeposits, &QuarantinedDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QuarantinedDeposits[len(x.QuarantinedDeposits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgRequests", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositRecords", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1192,14 +2716,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DkgRequests = append(x.DkgRequests, &DKGRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgRequests[len(x.DkgRequests)-1]); err != nil {
+				x.DepositRecords = append(x.DepositRecords, &DepositRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositRecords[len(x.DepositRecords)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgCompletions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneEtchings", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1226,14 +2750,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DkgCompletions = append(x.DkgCompletions, &DKGCompletionRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgCompletions[len(x.DkgCompletions)-1]); err != nil {
+				x.RuneEtchings = append(x.RuneEtchings, &RuneEtching{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RuneEtchings[len(x.RuneEtchings)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipUsages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1260,14 +2784,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SigningRequests = append(x.SigningRequests, &SigningRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningRequests[len(x.SigningRequests)-1]); err != nil {
+				x.FeeSponsorshipUsages = append(x.FeeSponsorshipUsages, &FeeSponsorshipUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSponsorshipUsages[len(x.FeeSponsorshipUsages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawRequests", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipAccountUsages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1294,14 +2818,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WithdrawRequests = append(x.WithdrawRequests, &WithdrawRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawRequests[len(x.WithdrawRequests)-1]); err != nil {
+				x.FeeSponsorshipAccountUsages = append(x.FeeSponsorshipAccountUsages, &FeeSponsorshipAccountUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSponsorshipAccountUsages[len(x.FeeSponsorshipAccountUsages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingBtcWithdrawRequests", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuarantinedWithdrawals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1328,16 +2852,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PendingBtcWithdrawRequests = append(x.PendingBtcWithdrawRequests, &WithdrawRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingBtcWithdrawRequests[len(x.PendingBtcWithdrawRequests)-1]); err != nil {
+				x.QuarantinedWithdrawals = append(x.QuarantinedWithdrawals, &QuarantinedWithdrawal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QuarantinedWithdrawals[len(x.QuarantinedWithdrawals)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintedTxHashes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneEtchingSubmissions", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1347,23 +2871,25 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MintedTxHashes = append(x.MintedTxHashes, string(dAtA[iNdEx:postIndex]))
+				x.RuneEtchingSubmissions = append(x.RuneEtchingSubmissions, &RuneEtchingSubmission{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RuneEtchingSubmissions[len(x.RuneEtchingSubmissions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1419,14 +2945,25 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params                     *Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Utxos                      []*UTXO                 `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	DkgRequests                []*DKGRequest           `protobuf:"bytes,3,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
-	DkgCompletions             []*DKGCompletionRequest `protobuf:"bytes,4,rep,name=dkg_completions,json=dkgCompletions,proto3" json:"dkg_completions,omitempty"`
-	SigningRequests            []*SigningRequest       `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
-	WithdrawRequests           []*WithdrawRequest      `protobuf:"bytes,6,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests,omitempty"`
-	PendingBtcWithdrawRequests []*WithdrawRequest      `protobuf:"bytes,7,rep,name=pending_btc_withdraw_requests,json=pendingBtcWithdrawRequests,proto3" json:"pending_btc_withdraw_requests,omitempty"`
-	MintedTxHashes             []string                `protobuf:"bytes,8,rep,name=minted_tx_hashes,json=mintedTxHashes,proto3" json:"minted_tx_hashes,omitempty"`
+	Params                      *Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Utxos                       []*UTXO                       `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	DkgRequests                 []*DKGRequest                 `protobuf:"bytes,3,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
+	DkgCompletions              []*DKGCompletionRequest       `protobuf:"bytes,4,rep,name=dkg_completions,json=dkgCompletions,proto3" json:"dkg_completions,omitempty"`
+	SigningRequests             []*SigningRequest             `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	WithdrawRequests            []*WithdrawRequest            `protobuf:"bytes,6,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests,omitempty"`
+	PendingBtcWithdrawRequests  []*WithdrawRequest            `protobuf:"bytes,7,rep,name=pending_btc_withdraw_requests,json=pendingBtcWithdrawRequests,proto3" json:"pending_btc_withdraw_requests,omitempty"`
+	MintedTxHashes              []string                      `protobuf:"bytes,8,rep,name=minted_tx_hashes,json=mintedTxHashes,proto3" json:"minted_tx_hashes,omitempty"`
+	Relayers                    []*Relayer                    `protobuf:"bytes,9,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	RunesAttestations           []*RunesAttestation           `protobuf:"bytes,10,rep,name=runes_attestations,json=runesAttestations,proto3" json:"runes_attestations,omitempty"`
+	Pauses                      []*Pause                      `protobuf:"bytes,11,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	ScreenedAddresses           []*ScreenedAddress            `protobuf:"bytes,12,rep,name=screened_addresses,json=screenedAddresses,proto3" json:"screened_addresses,omitempty"`
+	QuarantinedDeposits         []*QuarantinedDeposit         `protobuf:"bytes,13,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	DepositRecords              []*DepositRecord              `protobuf:"bytes,14,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records,omitempty"`
+	RuneEtchings                []*RuneEtching                `protobuf:"bytes,15,rep,name=rune_etchings,json=runeEtchings,proto3" json:"rune_etchings,omitempty"`
+	FeeSponsorshipUsages        []*FeeSponsorshipUsage        `protobuf:"bytes,16,rep,name=fee_sponsorship_usages,json=feeSponsorshipUsages,proto3" json:"fee_sponsorship_usages,omitempty"`
+	FeeSponsorshipAccountUsages []*FeeSponsorshipAccountUsage `protobuf:"bytes,17,rep,name=fee_sponsorship_account_usages,json=feeSponsorshipAccountUsages,proto3" json:"fee_sponsorship_account_usages,omitempty"`
+	QuarantinedWithdrawals      []*QuarantinedWithdrawal      `protobuf:"bytes,18,rep,name=quarantined_withdrawals,json=quarantinedWithdrawals,proto3" json:"quarantined_withdrawals,omitempty"`
+	RuneEtchingSubmissions      []*RuneEtchingSubmission      `protobuf:"bytes,19,rep,name=rune_etching_submissions,json=runeEtchingSubmissions,proto3" json:"rune_etching_submissions,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1505,6 +3042,83 @@ func (x *GenesisState) GetMintedTxHashes() []string {
 	return nil
 }
 
+func (x *GenesisState) GetRelayers() []*Relayer {
+	if x != nil {
+		return x.Relayers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRunesAttestations() []*RunesAttestation {
+	if x != nil {
+		return x.RunesAttestations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPauses() []*Pause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScreenedAddresses() []*ScreenedAddress {
+	if x != nil {
+		return x.ScreenedAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQuarantinedDeposits() []*QuarantinedDeposit {
+	if x != nil {
+		return x.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDepositRecords() []*DepositRecord {
+	if x != nil {
+		return x.DepositRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRuneEtchings() []*RuneEtching {
+	if x != nil {
+		return x.RuneEtchings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeSponsorshipUsages() []*FeeSponsorshipUsage {
+	if x != nil {
+		return x.FeeSponsorshipUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeSponsorshipAccountUsages() []*FeeSponsorshipAccountUsage {
+	if x != nil {
+		return x.FeeSponsorshipAccountUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQuarantinedWithdrawals() []*QuarantinedWithdrawal {
+	if x != nil {
+		return x.QuarantinedWithdrawals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRuneEtchingSubmissions() []*RuneEtchingSubmission {
+	if x != nil {
+		return x.RuneEtchingSubmissions
+	}
+	return nil
+}
+
 var File_bitway_btcbridge_genesis_proto protoreflect.FileDescriptor
 
 var file_bitway_btcbridge_genesis_proto_rawDesc = []byte{
@@ -1516,7 +3130,7 @@ var file_bitway_btcbridge_genesis_proto_rawDesc = []byte{
 	0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f,
 	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x04, 0x0a, 0x0c, 0x47, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x0b, 0x0a, 0x0c, 0x47, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74,
 	0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x61,
@@ -1551,20 +3165,76 @@ var file_bitway_btcbridge_genesis_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x42, 0x74, 0x63, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f,
 	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42,
-	0xb8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62,
-	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x42, 0x42,
-	0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74,
-	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1c, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a,
-	0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x08, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x11, 0x73, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x14,
+	0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x51, 0x75,
+	0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x13, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x65, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x45, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x5b, 0x0a, 0x16, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x14, 0x66, 0x65, 0x65, 0x53,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x71, 0x0a, 0x1e, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x53,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x1b, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x17, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x16, 0x71,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x61, 0x0a, 0x18, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x65, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x45,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x16, 0x72, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xb8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10,
+	0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0xe2, 0x02, 0x1c, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x11, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1581,27 +3251,49 @@ func file_bitway_btcbridge_genesis_proto_rawDescGZIP() []byte {
 
 var file_bitway_btcbridge_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_bitway_btcbridge_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),         // 0: bitway.btcbridge.GenesisState
-	(*Params)(nil),               // 1: bitway.btcbridge.Params
-	(*UTXO)(nil),                 // 2: bitway.btcbridge.UTXO
-	(*DKGRequest)(nil),           // 3: bitway.btcbridge.DKGRequest
-	(*DKGCompletionRequest)(nil), // 4: bitway.btcbridge.DKGCompletionRequest
-	(*SigningRequest)(nil),       // 5: bitway.btcbridge.SigningRequest
-	(*WithdrawRequest)(nil),      // 6: bitway.btcbridge.WithdrawRequest
+	(*GenesisState)(nil),               // 0: bitway.btcbridge.GenesisState
+	(*Params)(nil),                     // 1: bitway.btcbridge.Params
+	(*UTXO)(nil),                       // 2: bitway.btcbridge.UTXO
+	(*DKGRequest)(nil),                 // 3: bitway.btcbridge.DKGRequest
+	(*DKGCompletionRequest)(nil),       // 4: bitway.btcbridge.DKGCompletionRequest
+	(*SigningRequest)(nil),             // 5: bitway.btcbridge.SigningRequest
+	(*WithdrawRequest)(nil),            // 6: bitway.btcbridge.WithdrawRequest
+	(*Relayer)(nil),                    // 7: bitway.btcbridge.Relayer
+	(*RunesAttestation)(nil),           // 8: bitway.btcbridge.RunesAttestation
+	(*Pause)(nil),                      // 9: bitway.btcbridge.Pause
+	(*ScreenedAddress)(nil),            // 10: bitway.btcbridge.ScreenedAddress
+	(*QuarantinedDeposit)(nil),         // 11: bitway.btcbridge.QuarantinedDeposit
+	(*DepositRecord)(nil),              // 12: bitway.btcbridge.DepositRecord
+	(*RuneEtching)(nil),                // 13: bitway.btcbridge.RuneEtching
+	(*FeeSponsorshipUsage)(nil),        // 14: bitway.btcbridge.FeeSponsorshipUsage
+	(*FeeSponsorshipAccountUsage)(nil), // 15: bitway.btcbridge.FeeSponsorshipAccountUsage
+	(*QuarantinedWithdrawal)(nil),      // 16: bitway.btcbridge.QuarantinedWithdrawal
+	(*RuneEtchingSubmission)(nil),      // 17: bitway.btcbridge.RuneEtchingSubmission
 }
 var file_bitway_btcbridge_genesis_proto_depIdxs = []int32{
-	1, // 0: bitway.btcbridge.GenesisState.params:type_name -> bitway.btcbridge.Params
-	2, // 1: bitway.btcbridge.GenesisState.utxos:type_name -> bitway.btcbridge.UTXO
-	3, // 2: bitway.btcbridge.GenesisState.dkg_requests:type_name -> bitway.btcbridge.DKGRequest
-	4, // 3: bitway.btcbridge.GenesisState.dkg_completions:type_name -> bitway.btcbridge.DKGCompletionRequest
-	5, // 4: bitway.btcbridge.GenesisState.signing_requests:type_name -> bitway.btcbridge.SigningRequest
-	6, // 5: bitway.btcbridge.GenesisState.withdraw_requests:type_name -> bitway.btcbridge.WithdrawRequest
-	6, // 6: bitway.btcbridge.GenesisState.pending_btc_withdraw_requests:type_name -> bitway.btcbridge.WithdrawRequest
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: bitway.btcbridge.GenesisState.params:type_name -> bitway.btcbridge.Params
+	2,  // 1: bitway.btcbridge.GenesisState.utxos:type_name -> bitway.btcbridge.UTXO
+	3,  // 2: bitway.btcbridge.GenesisState.dkg_requests:type_name -> bitway.btcbridge.DKGRequest
+	4,  // 3: bitway.btcbridge.GenesisState.dkg_completions:type_name -> bitway.btcbridge.DKGCompletionRequest
+	5,  // 4: bitway.btcbridge.GenesisState.signing_requests:type_name -> bitway.btcbridge.SigningRequest
+	6,  // 5: bitway.btcbridge.GenesisState.withdraw_requests:type_name -> bitway.btcbridge.WithdrawRequest
+	6,  // 6: bitway.btcbridge.GenesisState.pending_btc_withdraw_requests:type_name -> bitway.btcbridge.WithdrawRequest
+	7,  // 7: bitway.btcbridge.GenesisState.relayers:type_name -> bitway.btcbridge.Relayer
+	8,  // 8: bitway.btcbridge.GenesisState.runes_attestations:type_name -> bitway.btcbridge.RunesAttestation
+	9,  // 9: bitway.btcbridge.GenesisState.pauses:type_name -> bitway.btcbridge.Pause
+	10, // 10: bitway.btcbridge.GenesisState.screened_addresses:type_name -> bitway.btcbridge.ScreenedAddress
+	11, // 11: bitway.btcbridge.GenesisState.quarantined_deposits:type_name -> bitway.btcbridge.QuarantinedDeposit
+	12, // 12: bitway.btcbridge.GenesisState.deposit_records:type_name -> bitway.btcbridge.DepositRecord
+	13, // 13: bitway.btcbridge.GenesisState.rune_etchings:type_name -> bitway.btcbridge.RuneEtching
+	14, // 14: bitway.btcbridge.GenesisState.fee_sponsorship_usages:type_name -> bitway.btcbridge.FeeSponsorshipUsage
+	15, // 15: bitway.btcbridge.GenesisState.fee_sponsorship_account_usages:type_name -> bitway.btcbridge.FeeSponsorshipAccountUsage
+	16, // 16: bitway.btcbridge.GenesisState.quarantined_withdrawals:type_name -> bitway.btcbridge.QuarantinedWithdrawal
+	17, // 17: bitway.btcbridge.GenesisState.rune_etching_submissions:type_name -> bitway.btcbridge.RuneEtchingSubmission
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_bitway_btcbridge_genesis_proto_init() }
@@ -15,52 +15,6 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_Params_7_list)(nil)
-
-type _Params_7_list struct {
-	list *[]string
-}
-
-func (x *_Params_7_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_7_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_7_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_7_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field TrustedNonBtcRelayers as it is not of Message kind"))
-}
-
-func (x *_Params_7_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_7_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Params_7_list) IsValid() bool {
-	return x.list != nil
-}
-
 var _ protoreflect.List = (*_Params_8_list)(nil)
 
 type _Params_8_list struct {
@@ -160,13 +114,11 @@ func (x *_Params_10_list) IsValid() bool {
 
 var (
 	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_deposit_confirmation_depth  protoreflect.FieldDescriptor
 	fd_Params_withdraw_confirmation_depth protoreflect.FieldDescriptor
 	fd_Params_max_acceptable_block_depth  protoreflect.FieldDescriptor
 	fd_Params_btc_voucher_denom           protoreflect.FieldDescriptor
 	fd_Params_deposit_enabled             protoreflect.FieldDescriptor
 	fd_Params_withdraw_enabled            protoreflect.FieldDescriptor
-	fd_Params_trusted_non_btc_relayers    protoreflect.FieldDescriptor
 	fd_Params_trusted_fee_providers       protoreflect.FieldDescriptor
 	fd_Params_fee_rate_validity_period    protoreflect.FieldDescriptor
 	fd_Params_vaults                      protoreflect.FieldDescriptor
@@ -177,18 +129,20 @@ var (
 	fd_Params_rate_limit_params           protoreflect.FieldDescriptor
 	fd_Params_ibc_params                  protoreflect.FieldDescriptor
 	fd_Params_fee_sponsorship_params      protoreflect.FieldDescriptor
+	fd_Params_relayer_params              protoreflect.FieldDescriptor
+	fd_Params_guardian_params             protoreflect.FieldDescriptor
+	fd_Params_deposit_confirmation_params protoreflect.FieldDescriptor
+	fd_Params_vault_recovery_params       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
 	md_Params = File_bitway_btcbridge_params_proto.Messages().ByName("Params")
-	fd_Params_deposit_confirmation_depth = md_Params.Fields().ByName("deposit_confirmation_depth")
 	fd_Params_withdraw_confirmation_depth = md_Params.Fields().ByName("withdraw_confirmation_depth")
 	fd_Params_max_acceptable_block_depth = md_Params.Fields().ByName("max_acceptable_block_depth")
 	fd_Params_btc_voucher_denom = md_Params.Fields().ByName("btc_voucher_denom")
 	fd_Params_deposit_enabled = md_Params.Fields().ByName("deposit_enabled")
 	fd_Params_withdraw_enabled = md_Params.Fields().ByName("withdraw_enabled")
-	fd_Params_trusted_non_btc_relayers = md_Params.Fields().ByName("trusted_non_btc_relayers")
 	fd_Params_trusted_fee_providers = md_Params.Fields().ByName("trusted_fee_providers")
 	fd_Params_fee_rate_validity_period = md_Params.Fields().ByName("fee_rate_validity_period")
 	fd_Params_vaults = md_Params.Fields().ByName("vaults")
@@ -199,6 +153,10 @@ func init() {
 	fd_Params_rate_limit_params = md_Params.Fields().ByName("rate_limit_params")
 	fd_Params_ibc_params = md_Params.Fields().ByName("ibc_params")
 	fd_Params_fee_sponsorship_params = md_Params.Fields().ByName("fee_sponsorship_params")
+	fd_Params_relayer_params = md_Params.Fields().ByName("relayer_params")
+	fd_Params_guardian_params = md_Params.Fields().ByName("guardian_params")
+	fd_Params_deposit_confirmation_params = md_Params.Fields().ByName("deposit_confirmation_params")
+	fd_Params_vault_recovery_params = md_Params.Fields().ByName("vault_recovery_params")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -266,12 +224,6 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DepositConfirmationDepth != int32(0) {
-		value := protoreflect.ValueOfInt32(x.DepositConfirmationDepth)
-		if !f(fd_Params_deposit_confirmation_depth, value) {
-			return
-		}
-	}
 	if x.WithdrawConfirmationDepth != int32(0) {
 		value := protoreflect.ValueOfInt32(x.WithdrawConfirmationDepth)
 		if !f(fd_Params_withdraw_confirmation_depth, value) {
@@ -302,12 +254,6 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-	if len(x.TrustedNonBtcRelayers) != 0 {
-		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.TrustedNonBtcRelayers})
-		if !f(fd_Params_trusted_non_btc_relayers, value) {
-			return
-		}
-	}
 	if len(x.TrustedFeeProviders) != 0 {
 		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.TrustedFeeProviders})
 		if !f(fd_Params_trusted_fee_providers, value) {
@@ -368,6 +314,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.RelayerParams != nil {
+		value := protoreflect.ValueOfMessage(x.RelayerParams.ProtoReflect())
+		if !f(fd_Params_relayer_params, value) {
+			return
+		}
+	}
+	if x.GuardianParams != nil {
+		value := protoreflect.ValueOfMessage(x.GuardianParams.ProtoReflect())
+		if !f(fd_Params_guardian_params, value) {
+			return
+		}
+	}
+	if x.DepositConfirmationParams != nil {
+		value := protoreflect.ValueOfMessage(x.DepositConfirmationParams.ProtoReflect())
+		if !f(fd_Params_deposit_confirmation_params, value) {
+			return
+		}
+	}
+	if x.VaultRecoveryParams != nil {
+		value := protoreflect.ValueOfMessage(x.VaultRecoveryParams.ProtoReflect())
+		if !f(fd_Params_vault_recovery_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -383,8 +353,6 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		return x.DepositConfirmationDepth != int32(0)
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		return x.WithdrawConfirmationDepth != int32(0)
 	case "bitway.btcbridge.Params.max_acceptable_block_depth":
@@ -395,8 +363,6 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DepositEnabled != false
 	case "bitway.btcbridge.Params.withdraw_enabled":
 		return x.WithdrawEnabled != false
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		return len(x.TrustedNonBtcRelayers) != 0
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		return len(x.TrustedFeeProviders) != 0
 	case "bitway.btcbridge.Params.fee_rate_validity_period":
@@ -417,6 +383,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.IbcParams != nil
 	case "bitway.btcbridge.Params.fee_sponsorship_params":
 		return x.FeeSponsorshipParams != nil
+	case "bitway.btcbridge.Params.relayer_params":
+		return x.RelayerParams != nil
+	case "bitway.btcbridge.Params.guardian_params":
+		return x.GuardianParams != nil
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		return x.DepositConfirmationParams != nil
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		return x.VaultRecoveryParams != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Params"))
@@ -433,8 +407,6 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		x.DepositConfirmationDepth = int32(0)
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		x.WithdrawConfirmationDepth = int32(0)
 	case "bitway.btcbridge.Params.max_acceptable_block_depth":
@@ -445,8 +417,6 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DepositEnabled = false
 	case "bitway.btcbridge.Params.withdraw_enabled":
 		x.WithdrawEnabled = false
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		x.TrustedNonBtcRelayers = nil
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		x.TrustedFeeProviders = nil
 	case "bitway.btcbridge.Params.fee_rate_validity_period":
@@ -467,6 +437,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.IbcParams = nil
 	case "bitway.btcbridge.Params.fee_sponsorship_params":
 		x.FeeSponsorshipParams = nil
+	case "bitway.btcbridge.Params.relayer_params":
+		x.RelayerParams = nil
+	case "bitway.btcbridge.Params.guardian_params":
+		x.GuardianParams = nil
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		x.DepositConfirmationParams = nil
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		x.VaultRecoveryParams = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Params"))
@@ -483,9 +461,6 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		value := x.DepositConfirmationDepth
-		return protoreflect.ValueOfInt32(value)
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		value := x.WithdrawConfirmationDepth
 		return protoreflect.ValueOfInt32(value)
@@ -501,12 +476,6 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "bitway.btcbridge.Params.withdraw_enabled":
 		value := x.WithdrawEnabled
 		return protoreflect.ValueOfBool(value)
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		if len(x.TrustedNonBtcRelayers) == 0 {
-			return protoreflect.ValueOfList(&_Params_7_list{})
-		}
-		listValue := &_Params_7_list{list: &x.TrustedNonBtcRelayers}
-		return protoreflect.ValueOfList(listValue)
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		if len(x.TrustedFeeProviders) == 0 {
 			return protoreflect.ValueOfList(&_Params_8_list{})
@@ -543,6 +512,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "bitway.btcbridge.Params.fee_sponsorship_params":
 		value := x.FeeSponsorshipParams
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Params.relayer_params":
+		value := x.RelayerParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Params.guardian_params":
+		value := x.GuardianParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		value := x.DepositConfirmationParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		value := x.VaultRecoveryParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Params"))
@@ -563,8 +544,6 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		x.DepositConfirmationDepth = int32(value.Int())
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		x.WithdrawConfirmationDepth = int32(value.Int())
 	case "bitway.btcbridge.Params.max_acceptable_block_depth":
@@ -575,10 +554,6 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DepositEnabled = value.Bool()
 	case "bitway.btcbridge.Params.withdraw_enabled":
 		x.WithdrawEnabled = value.Bool()
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		lv := value.List()
-		clv := lv.(*_Params_7_list)
-		x.TrustedNonBtcRelayers = *clv.list
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		lv := value.List()
 		clv := lv.(*_Params_8_list)
@@ -603,6 +578,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.IbcParams = value.Message().Interface().(*IBCParams)
 	case "bitway.btcbridge.Params.fee_sponsorship_params":
 		x.FeeSponsorshipParams = value.Message().Interface().(*FeeSponsorshipParams)
+	case "bitway.btcbridge.Params.relayer_params":
+		x.RelayerParams = value.Message().Interface().(*RelayerParams)
+	case "bitway.btcbridge.Params.guardian_params":
+		x.GuardianParams = value.Message().Interface().(*GuardianParams)
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		x.DepositConfirmationParams = value.Message().Interface().(*DepositConfirmationParams)
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		x.VaultRecoveryParams = value.Message().Interface().(*VaultRecoveryParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Params"))
@@ -623,12 +606,6 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		if x.TrustedNonBtcRelayers == nil {
-			x.TrustedNonBtcRelayers = []string{}
-		}
-		value := &_Params_7_list{list: &x.TrustedNonBtcRelayers}
-		return protoreflect.ValueOfList(value)
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		if x.TrustedFeeProviders == nil {
 			x.TrustedFeeProviders = []string{}
@@ -676,8 +653,26 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.FeeSponsorshipParams = new(FeeSponsorshipParams)
 		}
 		return protoreflect.ValueOfMessage(x.FeeSponsorshipParams.ProtoReflect())
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		panic(fmt.Errorf("field deposit_confirmation_depth of message bitway.btcbridge.Params is not mutable"))
+	case "bitway.btcbridge.Params.relayer_params":
+		if x.RelayerParams == nil {
+			x.RelayerParams = new(RelayerParams)
+		}
+		return protoreflect.ValueOfMessage(x.RelayerParams.ProtoReflect())
+	case "bitway.btcbridge.Params.guardian_params":
+		if x.GuardianParams == nil {
+			x.GuardianParams = new(GuardianParams)
+		}
+		return protoreflect.ValueOfMessage(x.GuardianParams.ProtoReflect())
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		if x.DepositConfirmationParams == nil {
+			x.DepositConfirmationParams = new(DepositConfirmationParams)
+		}
+		return protoreflect.ValueOfMessage(x.DepositConfirmationParams.ProtoReflect())
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		if x.VaultRecoveryParams == nil {
+			x.VaultRecoveryParams = new(VaultRecoveryParams)
+		}
+		return protoreflect.ValueOfMessage(x.VaultRecoveryParams.ProtoReflect())
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		panic(fmt.Errorf("field withdraw_confirmation_depth of message bitway.btcbridge.Params is not mutable"))
 	case "bitway.btcbridge.Params.max_acceptable_block_depth":
@@ -703,8 +698,6 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.Params.deposit_confirmation_depth":
-		return protoreflect.ValueOfInt32(int32(0))
 	case "bitway.btcbridge.Params.withdraw_confirmation_depth":
 		return protoreflect.ValueOfInt32(int32(0))
 	case "bitway.btcbridge.Params.max_acceptable_block_depth":
@@ -715,9 +708,6 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "bitway.btcbridge.Params.withdraw_enabled":
 		return protoreflect.ValueOfBool(false)
-	case "bitway.btcbridge.Params.trusted_non_btc_relayers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
 	case "bitway.btcbridge.Params.trusted_fee_providers":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
@@ -747,6 +737,18 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "bitway.btcbridge.Params.fee_sponsorship_params":
 		m := new(FeeSponsorshipParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Params.relayer_params":
+		m := new(RelayerParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Params.guardian_params":
+		m := new(GuardianParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Params.deposit_confirmation_params":
+		m := new(DepositConfirmationParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.Params.vault_recovery_params":
+		m := new(VaultRecoveryParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Params"))
@@ -816,9 +818,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DepositConfirmationDepth != 0 {
-			n += 1 + runtime.Sov(uint64(x.DepositConfirmationDepth))
-		}
 		if x.WithdrawConfirmationDepth != 0 {
 			n += 1 + runtime.Sov(uint64(x.WithdrawConfirmationDepth))
 		}
@@ -835,12 +834,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.WithdrawEnabled {
 			n += 2
 		}
-		if len(x.TrustedNonBtcRelayers) > 0 {
-			for _, s := range x.TrustedNonBtcRelayers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if len(x.TrustedFeeProviders) > 0 {
 			for _, s := range x.TrustedFeeProviders {
 				l = len(s)
@@ -884,6 +877,22 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.FeeSponsorshipParams)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.RelayerParams != nil {
+			l = options.Size(x.RelayerParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.GuardianParams != nil {
+			l = options.Size(x.GuardianParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositConfirmationParams != nil {
+			l = options.Size(x.DepositConfirmationParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.VaultRecoveryParams != nil {
+			l = options.Size(x.VaultRecoveryParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -913,6 +922,70 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VaultRecoveryParams != nil {
+			encoded, err := options.Marshal(x.VaultRecoveryParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.DepositConfirmationParams != nil {
+			encoded, err := options.Marshal(x.DepositConfirmationParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.GuardianParams != nil {
+			encoded, err := options.Marshal(x.GuardianParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.RelayerParams != nil {
+			encoded, err := options.Marshal(x.RelayerParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 		if x.FeeSponsorshipParams != nil {
 			encoded, err := options.Marshal(x.FeeSponsorshipParams)
 			if err != nil {
@@ -1045,15 +1118,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x42
 			}
 		}
-		if len(x.TrustedNonBtcRelayers) > 0 {
-			for iNdEx := len(x.TrustedNonBtcRelayers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.TrustedNonBtcRelayers[iNdEx])
-				copy(dAtA[i:], x.TrustedNonBtcRelayers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrustedNonBtcRelayers[iNdEx])))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
 		if x.WithdrawEnabled {
 			i--
 			if x.WithdrawEnabled {
@@ -1091,11 +1155,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.DepositConfirmationDepth != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositConfirmationDepth))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1145,25 +1204,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationDepth", wireType)
-				}
-				x.DepositConfirmationDepth = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DepositConfirmationDepth |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawConfirmationDepth", wireType)
@@ -1274,38 +1314,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.WithdrawEnabled = bool(v != 0)
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedNonBtcRelayers", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TrustedNonBtcRelayers = append(x.TrustedNonBtcRelayers, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			case 8:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedFeeProviders", wireType)
@@ -1643,32 +1651,176 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RelayerParams == nil {
+					x.RelayerParams = &RelayerParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayerParams); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GuardianParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.GuardianParams == nil {
+					x.GuardianParams = &GuardianParams{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GuardianParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositConfirmationParams == nil {
+					x.DepositConfirmationParams = &DepositConfirmationParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositConfirmationParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultRecoveryParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VaultRecoveryParams == nil {
+					x.VaultRecoveryParams = &VaultRecoveryParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VaultRecoveryParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
 		Size:              size,
 		Marshal:           marshal,
@@ -1684,6 +1836,7 @@ var (
 	fd_Vault_pub_key    protoreflect.FieldDescriptor
 	fd_Vault_asset_type protoreflect.FieldDescriptor
 	fd_Vault_version    protoreflect.FieldDescriptor
+	fd_Vault_recovery   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1693,6 +1846,7 @@ func init() {
 	fd_Vault_pub_key = md_Vault.Fields().ByName("pub_key")
 	fd_Vault_asset_type = md_Vault.Fields().ByName("asset_type")
 	fd_Vault_version = md_Vault.Fields().ByName("version")
+	fd_Vault_recovery = md_Vault.Fields().ByName("recovery")
 }
 
 var _ protoreflect.Message = (*fastReflection_Vault)(nil)
@@ -1784,6 +1938,12 @@ func (x *fastReflection_Vault) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.Recovery != nil {
+		value := protoreflect.ValueOfMessage(x.Recovery.ProtoReflect())
+		if !f(fd_Vault_recovery, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1807,6 +1967,8 @@ func (x *fastReflection_Vault) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AssetType != 0
 	case "bitway.btcbridge.Vault.version":
 		return x.Version != uint64(0)
+	case "bitway.btcbridge.Vault.recovery":
+		return x.Recovery != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Vault"))
@@ -1831,6 +1993,8 @@ func (x *fastReflection_Vault) Clear(fd protoreflect.FieldDescriptor) {
 		x.AssetType = 0
 	case "bitway.btcbridge.Vault.version":
 		x.Version = uint64(0)
+	case "bitway.btcbridge.Vault.recovery":
+		x.Recovery = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Vault"))
@@ -1859,6 +2023,9 @@ func (x *fastReflection_Vault) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "bitway.btcbridge.Vault.version":
 		value := x.Version
 		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.Vault.recovery":
+		value := x.Recovery
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Vault"))
@@ -1887,6 +2054,8 @@ func (x *fastReflection_Vault) Set(fd protoreflect.FieldDescriptor, value protor
 		x.AssetType = (AssetType)(value.Enum())
 	case "bitway.btcbridge.Vault.version":
 		x.Version = value.Uint()
+	case "bitway.btcbridge.Vault.recovery":
+		x.Recovery = value.Message().Interface().(*VaultRecovery)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Vault"))
@@ -1907,6 +2076,11 @@ func (x *fastReflection_Vault) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Vault) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "bitway.btcbridge.Vault.recovery":
+		if x.Recovery == nil {
+			x.Recovery = new(VaultRecovery)
+		}
+		return protoreflect.ValueOfMessage(x.Recovery.ProtoReflect())
 	case "bitway.btcbridge.Vault.address":
 		panic(fmt.Errorf("field address of message bitway.btcbridge.Vault is not mutable"))
 	case "bitway.btcbridge.Vault.pub_key":
@@ -1936,6 +2110,9 @@ func (x *fastReflection_Vault) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfEnum(0)
 	case "bitway.btcbridge.Vault.version":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.Vault.recovery":
+		m := new(VaultRecovery)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.Vault"))
@@ -2019,6 +2196,10 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 		if x.Version != 0 {
 			n += 1 + runtime.Sov(uint64(x.Version))
 		}
+		if x.Recovery != nil {
+			l = options.Size(x.Recovery)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2048,6 +2229,20 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Recovery != nil {
+			encoded, err := options.Marshal(x.Recovery)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.Version != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
@@ -2223,6 +2418,42 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Recovery == nil {
+					x.Recovery = &VaultRecovery{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recovery); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2259,29 +2490,27 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_WithdrawParams                            protoreflect.MessageDescriptor
-	fd_WithdrawParams_max_utxo_num               protoreflect.FieldDescriptor
-	fd_WithdrawParams_btc_batch_withdraw_period  protoreflect.FieldDescriptor
-	fd_WithdrawParams_max_btc_batch_withdraw_num protoreflect.FieldDescriptor
+	md_VaultRecovery              protoreflect.MessageDescriptor
+	fd_VaultRecovery_internal_key protoreflect.FieldDescriptor
+	fd_VaultRecovery_params       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_WithdrawParams = File_bitway_btcbridge_params_proto.Messages().ByName("WithdrawParams")
-	fd_WithdrawParams_max_utxo_num = md_WithdrawParams.Fields().ByName("max_utxo_num")
-	fd_WithdrawParams_btc_batch_withdraw_period = md_WithdrawParams.Fields().ByName("btc_batch_withdraw_period")
-	fd_WithdrawParams_max_btc_batch_withdraw_num = md_WithdrawParams.Fields().ByName("max_btc_batch_withdraw_num")
+	md_VaultRecovery = File_bitway_btcbridge_params_proto.Messages().ByName("VaultRecovery")
+	fd_VaultRecovery_internal_key = md_VaultRecovery.Fields().ByName("internal_key")
+	fd_VaultRecovery_params = md_VaultRecovery.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_WithdrawParams)(nil)
+var _ protoreflect.Message = (*fastReflection_VaultRecovery)(nil)
 
-type fastReflection_WithdrawParams WithdrawParams
+type fastReflection_VaultRecovery VaultRecovery
 
-func (x *WithdrawParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WithdrawParams)(x)
+func (x *VaultRecovery) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultRecovery)(x)
 }
 
-func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
+func (x *VaultRecovery) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2293,43 +2522,43 @@ func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WithdrawParams_messageType fastReflection_WithdrawParams_messageType
-var _ protoreflect.MessageType = fastReflection_WithdrawParams_messageType{}
+var _fastReflection_VaultRecovery_messageType fastReflection_VaultRecovery_messageType
+var _ protoreflect.MessageType = fastReflection_VaultRecovery_messageType{}
 
-type fastReflection_WithdrawParams_messageType struct{}
+type fastReflection_VaultRecovery_messageType struct{}
 
-func (x fastReflection_WithdrawParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WithdrawParams)(nil)
+func (x fastReflection_VaultRecovery_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultRecovery)(nil)
 }
-func (x fastReflection_WithdrawParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_WithdrawParams)
+func (x fastReflection_VaultRecovery_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultRecovery)
 }
-func (x fastReflection_WithdrawParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawParams
+func (x fastReflection_VaultRecovery_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecovery
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WithdrawParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawParams
+func (x *fastReflection_VaultRecovery) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecovery
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WithdrawParams) Type() protoreflect.MessageType {
-	return _fastReflection_WithdrawParams_messageType
+func (x *fastReflection_VaultRecovery) Type() protoreflect.MessageType {
+	return _fastReflection_VaultRecovery_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WithdrawParams) New() protoreflect.Message {
-	return new(fastReflection_WithdrawParams)
+func (x *fastReflection_VaultRecovery) New() protoreflect.Message {
+	return new(fastReflection_VaultRecovery)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
-	return (*WithdrawParams)(x)
+func (x *fastReflection_VaultRecovery) Interface() protoreflect.ProtoMessage {
+	return (*VaultRecovery)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2337,22 +2566,16 @@ func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MaxUtxoNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxUtxoNum)
-		if !f(fd_WithdrawParams_max_utxo_num, value) {
+func (x *fastReflection_VaultRecovery) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InternalKey != "" {
+		value := protoreflect.ValueOfString(x.InternalKey)
+		if !f(fd_VaultRecovery_internal_key, value) {
 			return
 		}
 	}
-	if x.BtcBatchWithdrawPeriod != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcBatchWithdrawPeriod)
-		if !f(fd_WithdrawParams_btc_batch_withdraw_period, value) {
-			return
-		}
-	}
-	if x.MaxBtcBatchWithdrawNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxBtcBatchWithdrawNum)
-		if !f(fd_WithdrawParams_max_btc_batch_withdraw_num, value) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_VaultRecovery_params, value) {
 			return
 		}
 	}
@@ -2369,19 +2592,17 @@ func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VaultRecovery) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		return x.MaxUtxoNum != uint32(0)
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		return x.BtcBatchWithdrawPeriod != int64(0)
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		return x.MaxBtcBatchWithdrawNum != uint32(0)
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		return x.InternalKey != ""
+	case "bitway.btcbridge.VaultRecovery.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2391,19 +2612,17 @@ func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VaultRecovery) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		x.MaxUtxoNum = uint32(0)
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		x.BtcBatchWithdrawPeriod = int64(0)
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		x.MaxBtcBatchWithdrawNum = uint32(0)
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		x.InternalKey = ""
+	case "bitway.btcbridge.VaultRecovery.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2413,22 +2632,19 @@ func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecovery) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		value := x.MaxUtxoNum
-		return protoreflect.ValueOfUint32(value)
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		value := x.BtcBatchWithdrawPeriod
-		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		value := x.MaxBtcBatchWithdrawNum
-		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		value := x.InternalKey
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.VaultRecovery.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2442,19 +2658,17 @@ func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VaultRecovery) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		x.MaxUtxoNum = uint32(value.Uint())
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		x.BtcBatchWithdrawPeriod = value.Int()
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		x.MaxBtcBatchWithdrawNum = uint32(value.Uint())
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		x.InternalKey = value.Interface().(string)
+	case "bitway.btcbridge.VaultRecovery.params":
+		x.Params = value.Message().Interface().(*VaultRecoveryParams)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2468,48 +2682,48 @@ func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecovery) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		panic(fmt.Errorf("field max_utxo_num of message bitway.btcbridge.WithdrawParams is not mutable"))
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		panic(fmt.Errorf("field btc_batch_withdraw_period of message bitway.btcbridge.WithdrawParams is not mutable"))
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		panic(fmt.Errorf("field max_btc_batch_withdraw_num of message bitway.btcbridge.WithdrawParams is not mutable"))
+	case "bitway.btcbridge.VaultRecovery.params":
+		if x.Params == nil {
+			x.Params = new(VaultRecoveryParams)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		panic(fmt.Errorf("field internal_key of message bitway.btcbridge.VaultRecovery is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WithdrawParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecovery) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.VaultRecovery.internal_key":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.VaultRecovery.params":
+		m := new(VaultRecoveryParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecovery"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecovery does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VaultRecovery) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.WithdrawParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.VaultRecovery", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2517,7 +2731,7 @@ func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VaultRecovery) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2528,7 +2742,7 @@ func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VaultRecovery) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2540,7 +2754,7 @@ func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WithdrawParams) IsValid() bool {
+func (x *fastReflection_VaultRecovery) IsValid() bool {
 	return x != nil
 }
 
@@ -2550,9 +2764,9 @@ func (x *fastReflection_WithdrawParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VaultRecovery) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultRecovery)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2564,14 +2778,13 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxUtxoNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxUtxoNum))
-		}
-		if x.BtcBatchWithdrawPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcBatchWithdrawPeriod))
+		l = len(x.InternalKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxBtcBatchWithdrawNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxBtcBatchWithdrawNum))
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2583,7 +2796,7 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultRecovery)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2602,20 +2815,26 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxBtcBatchWithdrawNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBtcBatchWithdrawNum))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.BtcBatchWithdrawPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcBatchWithdrawPeriod))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.MaxUtxoNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUtxoNum))
+		if len(x.InternalKey) > 0 {
+			i -= len(x.InternalKey)
+			copy(dAtA[i:], x.InternalKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InternalKey)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2628,7 +2847,7 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultRecovery)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2660,17 +2879,17 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecovery: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
 				}
-				x.MaxUtxoNum = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2680,16 +2899,29 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxUtxoNum |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InternalKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				x.BtcBatchWithdrawPeriod = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2699,30 +2931,28 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.MaxBtcBatchWithdrawNum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &VaultRecoveryParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2759,29 +2989,29 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ProtocolLimits                  protoreflect.MessageDescriptor
-	fd_ProtocolLimits_btc_min_deposit  protoreflect.FieldDescriptor
-	fd_ProtocolLimits_btc_min_withdraw protoreflect.FieldDescriptor
-	fd_ProtocolLimits_btc_max_withdraw protoreflect.FieldDescriptor
+	md_WithdrawParams                            protoreflect.MessageDescriptor
+	fd_WithdrawParams_max_utxo_num               protoreflect.FieldDescriptor
+	fd_WithdrawParams_btc_batch_withdraw_period  protoreflect.FieldDescriptor
+	fd_WithdrawParams_max_btc_batch_withdraw_num protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_ProtocolLimits = File_bitway_btcbridge_params_proto.Messages().ByName("ProtocolLimits")
-	fd_ProtocolLimits_btc_min_deposit = md_ProtocolLimits.Fields().ByName("btc_min_deposit")
-	fd_ProtocolLimits_btc_min_withdraw = md_ProtocolLimits.Fields().ByName("btc_min_withdraw")
-	fd_ProtocolLimits_btc_max_withdraw = md_ProtocolLimits.Fields().ByName("btc_max_withdraw")
+	md_WithdrawParams = File_bitway_btcbridge_params_proto.Messages().ByName("WithdrawParams")
+	fd_WithdrawParams_max_utxo_num = md_WithdrawParams.Fields().ByName("max_utxo_num")
+	fd_WithdrawParams_btc_batch_withdraw_period = md_WithdrawParams.Fields().ByName("btc_batch_withdraw_period")
+	fd_WithdrawParams_max_btc_batch_withdraw_num = md_WithdrawParams.Fields().ByName("max_btc_batch_withdraw_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolLimits)(nil)
+var _ protoreflect.Message = (*fastReflection_WithdrawParams)(nil)
 
-type fastReflection_ProtocolLimits ProtocolLimits
+type fastReflection_WithdrawParams WithdrawParams
 
-func (x *ProtocolLimits) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolLimits)(x)
+func (x *WithdrawParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawParams)(x)
 }
 
-func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
+func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2793,43 +3023,43 @@ func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolLimits_messageType fastReflection_ProtocolLimits_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolLimits_messageType{}
+var _fastReflection_WithdrawParams_messageType fastReflection_WithdrawParams_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawParams_messageType{}
 
-type fastReflection_ProtocolLimits_messageType struct{}
+type fastReflection_WithdrawParams_messageType struct{}
 
-func (x fastReflection_ProtocolLimits_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolLimits)(nil)
+func (x fastReflection_WithdrawParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawParams)(nil)
 }
-func (x fastReflection_ProtocolLimits_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolLimits)
+func (x fastReflection_WithdrawParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawParams)
 }
-func (x fastReflection_ProtocolLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolLimits
+func (x fastReflection_WithdrawParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolLimits) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolLimits
+func (x *fastReflection_WithdrawParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolLimits) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolLimits_messageType
+func (x *fastReflection_WithdrawParams) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolLimits) New() protoreflect.Message {
-	return new(fastReflection_ProtocolLimits)
+func (x *fastReflection_WithdrawParams) New() protoreflect.Message {
+	return new(fastReflection_WithdrawParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolLimits)(x)
+func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2837,22 +3067,22 @@ func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BtcMinDeposit != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMinDeposit)
-		if !f(fd_ProtocolLimits_btc_min_deposit, value) {
+func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxUtxoNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxUtxoNum)
+		if !f(fd_WithdrawParams_max_utxo_num, value) {
 			return
 		}
 	}
-	if x.BtcMinWithdraw != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMinWithdraw)
-		if !f(fd_ProtocolLimits_btc_min_withdraw, value) {
+	if x.BtcBatchWithdrawPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcBatchWithdrawPeriod)
+		if !f(fd_WithdrawParams_btc_batch_withdraw_period, value) {
 			return
 		}
 	}
-	if x.BtcMaxWithdraw != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMaxWithdraw)
-		if !f(fd_ProtocolLimits_btc_max_withdraw, value) {
+	if x.MaxBtcBatchWithdrawNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBtcBatchWithdrawNum)
+		if !f(fd_WithdrawParams_max_btc_batch_withdraw_num, value) {
 			return
 		}
 	}
@@ -2869,19 +3099,19 @@ func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		return x.BtcMinDeposit != int64(0)
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		return x.BtcMinWithdraw != int64(0)
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
-		return x.BtcMaxWithdraw != int64(0)
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		return x.MaxUtxoNum != uint32(0)
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		return x.BtcBatchWithdrawPeriod != int64(0)
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		return x.MaxBtcBatchWithdrawNum != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2891,19 +3121,19 @@ func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		x.BtcMinDeposit = int64(0)
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		x.BtcMinWithdraw = int64(0)
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
-		x.BtcMaxWithdraw = int64(0)
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		x.MaxUtxoNum = uint32(0)
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		x.BtcBatchWithdrawPeriod = int64(0)
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		x.MaxBtcBatchWithdrawNum = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2913,22 +3143,22 @@ func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		value := x.BtcMinDeposit
-		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		value := x.BtcMinWithdraw
-		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
-		value := x.BtcMaxWithdraw
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		value := x.MaxUtxoNum
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		value := x.BtcBatchWithdrawPeriod
 		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		value := x.MaxBtcBatchWithdrawNum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2942,19 +3172,19 @@ func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		x.BtcMinDeposit = value.Int()
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		x.BtcMinWithdraw = value.Int()
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
-		x.BtcMaxWithdraw = value.Int()
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		x.MaxUtxoNum = uint32(value.Uint())
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		x.BtcBatchWithdrawPeriod = value.Int()
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		x.MaxBtcBatchWithdrawNum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2968,48 +3198,48 @@ func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		panic(fmt.Errorf("field btc_min_deposit of message bitway.btcbridge.ProtocolLimits is not mutable"))
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		panic(fmt.Errorf("field btc_min_withdraw of message bitway.btcbridge.ProtocolLimits is not mutable"))
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
-		panic(fmt.Errorf("field btc_max_withdraw of message bitway.btcbridge.ProtocolLimits is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		panic(fmt.Errorf("field max_utxo_num of message bitway.btcbridge.WithdrawParams is not mutable"))
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		panic(fmt.Errorf("field btc_batch_withdraw_period of message bitway.btcbridge.WithdrawParams is not mutable"))
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		panic(fmt.Errorf("field max_btc_batch_withdraw_num of message bitway.btcbridge.WithdrawParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+	case "bitway.btcbridge.WithdrawParams.max_utxo_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.WithdrawParams.btc_batch_withdraw_period":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.ProtocolLimits", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.WithdrawParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3017,7 +3247,7 @@ func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3028,7 +3258,7 @@ func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3040,7 +3270,7 @@ func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolLimits) IsValid() bool {
+func (x *fastReflection_WithdrawParams) IsValid() bool {
 	return x != nil
 }
 
@@ -3050,9 +3280,9 @@ func (x *fastReflection_ProtocolLimits) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3064,14 +3294,14 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BtcMinDeposit != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMinDeposit))
+		if x.MaxUtxoNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxUtxoNum))
 		}
-		if x.BtcMinWithdraw != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMinWithdraw))
+		if x.BtcBatchWithdrawPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcBatchWithdrawPeriod))
 		}
-		if x.BtcMaxWithdraw != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMaxWithdraw))
+		if x.MaxBtcBatchWithdrawNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBtcBatchWithdrawNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3083,7 +3313,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3102,18 +3332,18 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BtcMaxWithdraw != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMaxWithdraw))
+		if x.MaxBtcBatchWithdrawNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBtcBatchWithdrawNum))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.BtcMinWithdraw != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinWithdraw))
+		if x.BtcBatchWithdrawPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcBatchWithdrawPeriod))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.BtcMinDeposit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinDeposit))
+		if x.MaxUtxoNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUtxoNum))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3128,7 +3358,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3160,17 +3390,17 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
 				}
-				x.BtcMinDeposit = 0
+				x.MaxUtxoNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3180,16 +3410,16 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMinDeposit |= int64(b&0x7F) << shift
+					x.MaxUtxoNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
 				}
-				x.BtcMinWithdraw = 0
+				x.BtcBatchWithdrawPeriod = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3199,16 +3429,16 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMinWithdraw |= int64(b&0x7F) << shift
+					x.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
 				}
-				x.BtcMaxWithdraw = 0
+				x.MaxBtcBatchWithdrawNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3218,7 +3448,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMaxWithdraw |= int64(b&0x7F) << shift
+					x.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3259,29 +3489,29 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ProtocolFees              protoreflect.MessageDescriptor
-	fd_ProtocolFees_deposit_fee  protoreflect.FieldDescriptor
-	fd_ProtocolFees_withdraw_fee protoreflect.FieldDescriptor
-	fd_ProtocolFees_collector    protoreflect.FieldDescriptor
+	md_ProtocolLimits                  protoreflect.MessageDescriptor
+	fd_ProtocolLimits_btc_min_deposit  protoreflect.FieldDescriptor
+	fd_ProtocolLimits_btc_min_withdraw protoreflect.FieldDescriptor
+	fd_ProtocolLimits_btc_max_withdraw protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_ProtocolFees = File_bitway_btcbridge_params_proto.Messages().ByName("ProtocolFees")
-	fd_ProtocolFees_deposit_fee = md_ProtocolFees.Fields().ByName("deposit_fee")
-	fd_ProtocolFees_withdraw_fee = md_ProtocolFees.Fields().ByName("withdraw_fee")
-	fd_ProtocolFees_collector = md_ProtocolFees.Fields().ByName("collector")
+	md_ProtocolLimits = File_bitway_btcbridge_params_proto.Messages().ByName("ProtocolLimits")
+	fd_ProtocolLimits_btc_min_deposit = md_ProtocolLimits.Fields().ByName("btc_min_deposit")
+	fd_ProtocolLimits_btc_min_withdraw = md_ProtocolLimits.Fields().ByName("btc_min_withdraw")
+	fd_ProtocolLimits_btc_max_withdraw = md_ProtocolLimits.Fields().ByName("btc_max_withdraw")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolFees)(nil)
+var _ protoreflect.Message = (*fastReflection_ProtocolLimits)(nil)
 
-type fastReflection_ProtocolFees ProtocolFees
+type fastReflection_ProtocolLimits ProtocolLimits
 
-func (x *ProtocolFees) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolFees)(x)
+func (x *ProtocolLimits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolLimits)(x)
 }
 
-func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
+func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3293,43 +3523,43 @@ func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolFees_messageType fastReflection_ProtocolFees_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolFees_messageType{}
+var _fastReflection_ProtocolLimits_messageType fastReflection_ProtocolLimits_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolLimits_messageType{}
 
-type fastReflection_ProtocolFees_messageType struct{}
+type fastReflection_ProtocolLimits_messageType struct{}
 
-func (x fastReflection_ProtocolFees_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolFees)(nil)
+func (x fastReflection_ProtocolLimits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolLimits)(nil)
 }
-func (x fastReflection_ProtocolFees_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolFees)
+func (x fastReflection_ProtocolLimits_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolLimits)
 }
-func (x fastReflection_ProtocolFees_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolFees
+func (x fastReflection_ProtocolLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolLimits
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolFees) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolFees
+func (x *fastReflection_ProtocolLimits) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolLimits
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolFees) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolFees_messageType
+func (x *fastReflection_ProtocolLimits) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolLimits_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolFees) New() protoreflect.Message {
-	return new(fastReflection_ProtocolFees)
+func (x *fastReflection_ProtocolLimits) New() protoreflect.Message {
+	return new(fastReflection_ProtocolLimits)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolFees)(x)
+func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolLimits)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3337,22 +3567,22 @@ func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DepositFee != int64(0) {
-		value := protoreflect.ValueOfInt64(x.DepositFee)
-		if !f(fd_ProtocolFees_deposit_fee, value) {
+func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BtcMinDeposit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMinDeposit)
+		if !f(fd_ProtocolLimits_btc_min_deposit, value) {
 			return
 		}
 	}
-	if x.WithdrawFee != int64(0) {
-		value := protoreflect.ValueOfInt64(x.WithdrawFee)
-		if !f(fd_ProtocolFees_withdraw_fee, value) {
+	if x.BtcMinWithdraw != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMinWithdraw)
+		if !f(fd_ProtocolLimits_btc_min_withdraw, value) {
 			return
 		}
 	}
-	if x.Collector != "" {
-		value := protoreflect.ValueOfString(x.Collector)
-		if !f(fd_ProtocolFees_collector, value) {
+	if x.BtcMaxWithdraw != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMaxWithdraw)
+		if !f(fd_ProtocolLimits_btc_max_withdraw, value) {
 			return
 		}
 	}
@@ -3369,19 +3599,19 @@ func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
-		return x.DepositFee != int64(0)
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
-		return x.WithdrawFee != int64(0)
-	case "bitway.btcbridge.ProtocolFees.collector":
-		return x.Collector != ""
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
+		return x.BtcMinDeposit != int64(0)
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		return x.BtcMinWithdraw != int64(0)
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+		return x.BtcMaxWithdraw != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3391,19 +3621,19 @@ func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
-		x.DepositFee = int64(0)
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
-		x.WithdrawFee = int64(0)
-	case "bitway.btcbridge.ProtocolFees.collector":
-		x.Collector = ""
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
+		x.BtcMinDeposit = int64(0)
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		x.BtcMinWithdraw = int64(0)
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+		x.BtcMaxWithdraw = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3413,22 +3643,22 @@ func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
-		value := x.DepositFee
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
+		value := x.BtcMinDeposit
 		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
-		value := x.WithdrawFee
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		value := x.BtcMinWithdraw
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+		value := x.BtcMaxWithdraw
 		return protoreflect.ValueOfInt64(value)
-	case "bitway.btcbridge.ProtocolFees.collector":
-		value := x.Collector
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3442,19 +3672,19 @@ func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
-		x.DepositFee = value.Int()
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
-		x.WithdrawFee = value.Int()
-	case "bitway.btcbridge.ProtocolFees.collector":
-		x.Collector = value.Interface().(string)
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
+		x.BtcMinDeposit = value.Int()
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		x.BtcMinWithdraw = value.Int()
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+		x.BtcMaxWithdraw = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3468,48 +3698,48 @@ func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
-		panic(fmt.Errorf("field deposit_fee of message bitway.btcbridge.ProtocolFees is not mutable"))
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
-		panic(fmt.Errorf("field withdraw_fee of message bitway.btcbridge.ProtocolFees is not mutable"))
-	case "bitway.btcbridge.ProtocolFees.collector":
-		panic(fmt.Errorf("field collector of message bitway.btcbridge.ProtocolFees is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
+		panic(fmt.Errorf("field btc_min_deposit of message bitway.btcbridge.ProtocolLimits is not mutable"))
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		panic(fmt.Errorf("field btc_min_withdraw of message bitway.btcbridge.ProtocolLimits is not mutable"))
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
+		panic(fmt.Errorf("field btc_max_withdraw of message bitway.btcbridge.ProtocolLimits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+	case "bitway.btcbridge.ProtocolLimits.btc_min_deposit":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+	case "bitway.btcbridge.ProtocolLimits.btc_min_withdraw":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.ProtocolLimits.btc_max_withdraw":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "bitway.btcbridge.ProtocolFees.collector":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolLimits"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.ProtocolFees", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.ProtocolLimits", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3517,7 +3747,7 @@ func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3528,7 +3758,7 @@ func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3540,7 +3770,7 @@ func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolFees) IsValid() bool {
+func (x *fastReflection_ProtocolLimits) IsValid() bool {
 	return x != nil
 }
 
@@ -3550,9 +3780,9 @@ func (x *fastReflection_ProtocolFees) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*ProtocolLimits)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3564,15 +3794,14 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DepositFee != 0 {
-			n += 1 + runtime.Sov(uint64(x.DepositFee))
+		if x.BtcMinDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMinDeposit))
 		}
-		if x.WithdrawFee != 0 {
-			n += 1 + runtime.Sov(uint64(x.WithdrawFee))
+		if x.BtcMinWithdraw != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMinWithdraw))
 		}
-		l = len(x.Collector)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BtcMaxWithdraw != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMaxWithdraw))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3584,7 +3813,7 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*ProtocolLimits)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3603,20 +3832,18 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Collector) > 0 {
-			i -= len(x.Collector)
-			copy(dAtA[i:], x.Collector)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collector)))
+		if x.BtcMaxWithdraw != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMaxWithdraw))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.WithdrawFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithdrawFee))
+		if x.BtcMinWithdraw != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinWithdraw))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.DepositFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositFee))
+		if x.BtcMinDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinDeposit))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3631,7 +3858,7 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*ProtocolLimits)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3663,17 +3890,17 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
 				}
-				x.DepositFee = 0
+				x.BtcMinDeposit = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3683,16 +3910,16 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DepositFee |= int64(b&0x7F) << shift
+					x.BtcMinDeposit |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
 				}
-				x.WithdrawFee = 0
+				x.BtcMinWithdraw = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3702,16 +3929,16 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.WithdrawFee |= int64(b&0x7F) << shift
+					x.BtcMinWithdraw |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
 				}
-				var stringLen uint64
+				x.BtcMaxWithdraw = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3721,24 +3948,11 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BtcMaxWithdraw |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Collector = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3775,27 +3989,29 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TSSParams                                      protoreflect.MessageDescriptor
-	fd_TSSParams_dkg_timeout_period                   protoreflect.FieldDescriptor
-	fd_TSSParams_participant_update_transition_period protoreflect.FieldDescriptor
+	md_ProtocolFees              protoreflect.MessageDescriptor
+	fd_ProtocolFees_deposit_fee  protoreflect.FieldDescriptor
+	fd_ProtocolFees_withdraw_fee protoreflect.FieldDescriptor
+	fd_ProtocolFees_collector    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_TSSParams = File_bitway_btcbridge_params_proto.Messages().ByName("TSSParams")
-	fd_TSSParams_dkg_timeout_period = md_TSSParams.Fields().ByName("dkg_timeout_period")
-	fd_TSSParams_participant_update_transition_period = md_TSSParams.Fields().ByName("participant_update_transition_period")
+	md_ProtocolFees = File_bitway_btcbridge_params_proto.Messages().ByName("ProtocolFees")
+	fd_ProtocolFees_deposit_fee = md_ProtocolFees.Fields().ByName("deposit_fee")
+	fd_ProtocolFees_withdraw_fee = md_ProtocolFees.Fields().ByName("withdraw_fee")
+	fd_ProtocolFees_collector = md_ProtocolFees.Fields().ByName("collector")
 }
 
-var _ protoreflect.Message = (*fastReflection_TSSParams)(nil)
+var _ protoreflect.Message = (*fastReflection_ProtocolFees)(nil)
 
-type fastReflection_TSSParams TSSParams
+type fastReflection_ProtocolFees ProtocolFees
 
-func (x *TSSParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TSSParams)(x)
+func (x *ProtocolFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolFees)(x)
 }
 
-func (x *TSSParams) slowProtoReflect() protoreflect.Message {
+func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3807,43 +4023,43 @@ func (x *TSSParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TSSParams_messageType fastReflection_TSSParams_messageType
-var _ protoreflect.MessageType = fastReflection_TSSParams_messageType{}
+var _fastReflection_ProtocolFees_messageType fastReflection_ProtocolFees_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolFees_messageType{}
 
-type fastReflection_TSSParams_messageType struct{}
+type fastReflection_ProtocolFees_messageType struct{}
 
-func (x fastReflection_TSSParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TSSParams)(nil)
+func (x fastReflection_ProtocolFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolFees)(nil)
 }
-func (x fastReflection_TSSParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_TSSParams)
+func (x fastReflection_ProtocolFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolFees)
 }
-func (x fastReflection_TSSParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TSSParams
+func (x fastReflection_ProtocolFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolFees
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TSSParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_TSSParams
+func (x *fastReflection_ProtocolFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolFees
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TSSParams) Type() protoreflect.MessageType {
-	return _fastReflection_TSSParams_messageType
+func (x *fastReflection_ProtocolFees) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolFees_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TSSParams) New() protoreflect.Message {
-	return new(fastReflection_TSSParams)
+func (x *fastReflection_ProtocolFees) New() protoreflect.Message {
+	return new(fastReflection_ProtocolFees)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
-	return (*TSSParams)(x)
+func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolFees)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3851,16 +4067,22 @@ func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DkgTimeoutPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
-		if !f(fd_TSSParams_dkg_timeout_period, value) {
+func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DepositFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DepositFee)
+		if !f(fd_ProtocolFees_deposit_fee, value) {
 			return
 		}
 	}
-	if x.ParticipantUpdateTransitionPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
-		if !f(fd_TSSParams_participant_update_transition_period, value) {
+	if x.WithdrawFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WithdrawFee)
+		if !f(fd_ProtocolFees_withdraw_fee, value) {
+			return
+		}
+	}
+	if x.Collector != "" {
+		value := protoreflect.ValueOfString(x.Collector)
+		if !f(fd_ProtocolFees_collector, value) {
 			return
 		}
 	}
@@ -3877,17 +4099,19 @@ func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		return x.DkgTimeoutPeriod != nil
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		return x.ParticipantUpdateTransitionPeriod != nil
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		return x.DepositFee != int64(0)
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		return x.WithdrawFee != int64(0)
+	case "bitway.btcbridge.ProtocolFees.collector":
+		return x.Collector != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3897,17 +4121,19 @@ func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		x.DkgTimeoutPeriod = nil
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		x.ParticipantUpdateTransitionPeriod = nil
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		x.DepositFee = int64(0)
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		x.WithdrawFee = int64(0)
+	case "bitway.btcbridge.ProtocolFees.collector":
+		x.Collector = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3917,19 +4143,22 @@ func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		value := x.DkgTimeoutPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		value := x.ParticipantUpdateTransitionPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		value := x.DepositFee
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		value := x.WithdrawFee
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.ProtocolFees.collector":
+		value := x.Collector
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3943,17 +4172,19 @@ func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		x.DkgTimeoutPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		x.ParticipantUpdateTransitionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		x.DepositFee = value.Int()
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		x.WithdrawFee = value.Int()
+	case "bitway.btcbridge.ProtocolFees.collector":
+		x.Collector = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3967,52 +4198,48 @@ func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		if x.DkgTimeoutPeriod == nil {
-			x.DkgTimeoutPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		if x.ParticipantUpdateTransitionPeriod == nil {
-			x.ParticipantUpdateTransitionPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		panic(fmt.Errorf("field deposit_fee of message bitway.btcbridge.ProtocolFees is not mutable"))
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		panic(fmt.Errorf("field withdraw_fee of message bitway.btcbridge.ProtocolFees is not mutable"))
+	case "bitway.btcbridge.ProtocolFees.collector":
+		panic(fmt.Errorf("field collector of message bitway.btcbridge.ProtocolFees is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TSSParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.ProtocolFees.deposit_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.ProtocolFees.withdraw_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.ProtocolFees.collector":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.ProtocolFees"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.TSSParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.ProtocolFees", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4020,7 +4247,7 @@ func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4031,7 +4258,7 @@ func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4043,7 +4270,7 @@ func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TSSParams) IsValid() bool {
+func (x *fastReflection_ProtocolFees) IsValid() bool {
 	return x != nil
 }
 
@@ -4053,9 +4280,9 @@ func (x *fastReflection_TSSParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*ProtocolFees)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4067,12 +4294,14 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DkgTimeoutPeriod != nil {
-			l = options.Size(x.DkgTimeoutPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.DepositFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.DepositFee))
 		}
-		if x.ParticipantUpdateTransitionPeriod != nil {
-			l = options.Size(x.ParticipantUpdateTransitionPeriod)
+		if x.WithdrawFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.WithdrawFee))
+		}
+		l = len(x.Collector)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4085,7 +4314,7 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*ProtocolFees)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4104,33 +4333,22 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ParticipantUpdateTransitionPeriod != nil {
-			encoded, err := options.Marshal(x.ParticipantUpdateTransitionPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Collector) > 0 {
+			i -= len(x.Collector)
+			copy(dAtA[i:], x.Collector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collector)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if x.DkgTimeoutPeriod != nil {
-			encoded, err := options.Marshal(x.DkgTimeoutPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.WithdrawFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithdrawFee))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x10
+		}
+		if x.DepositFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositFee))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4143,7 +4361,7 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*ProtocolFees)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4175,17 +4393,17 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
 				}
-				var msglen int
+				x.DepositFee = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4195,33 +4413,35 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.DepositFee |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.DkgTimeoutPeriod == nil {
-					x.DkgTimeoutPeriod = &durationpb.Duration{}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgTimeoutPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.WithdrawFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WithdrawFee |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4231,27 +4451,23 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ParticipantUpdateTransitionPeriod == nil {
-					x.ParticipantUpdateTransitionPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantUpdateTransitionPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Collector = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4289,27 +4505,27 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RateLimitParams                           protoreflect.MessageDescriptor
-	fd_RateLimitParams_global_rate_limit_params  protoreflect.FieldDescriptor
-	fd_RateLimitParams_address_rate_limit_params protoreflect.FieldDescriptor
+	md_TSSParams                                      protoreflect.MessageDescriptor
+	fd_TSSParams_dkg_timeout_period                   protoreflect.FieldDescriptor
+	fd_TSSParams_participant_update_transition_period protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_RateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("RateLimitParams")
-	fd_RateLimitParams_global_rate_limit_params = md_RateLimitParams.Fields().ByName("global_rate_limit_params")
-	fd_RateLimitParams_address_rate_limit_params = md_RateLimitParams.Fields().ByName("address_rate_limit_params")
+	md_TSSParams = File_bitway_btcbridge_params_proto.Messages().ByName("TSSParams")
+	fd_TSSParams_dkg_timeout_period = md_TSSParams.Fields().ByName("dkg_timeout_period")
+	fd_TSSParams_participant_update_transition_period = md_TSSParams.Fields().ByName("participant_update_transition_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_RateLimitParams)(nil)
+var _ protoreflect.Message = (*fastReflection_TSSParams)(nil)
 
-type fastReflection_RateLimitParams RateLimitParams
+type fastReflection_TSSParams TSSParams
 
-func (x *RateLimitParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RateLimitParams)(x)
+func (x *TSSParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TSSParams)(x)
 }
 
-func (x *RateLimitParams) slowProtoReflect() protoreflect.Message {
+func (x *TSSParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4321,43 +4537,43 @@ func (x *RateLimitParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RateLimitParams_messageType fastReflection_RateLimitParams_messageType
-var _ protoreflect.MessageType = fastReflection_RateLimitParams_messageType{}
+var _fastReflection_TSSParams_messageType fastReflection_TSSParams_messageType
+var _ protoreflect.MessageType = fastReflection_TSSParams_messageType{}
 
-type fastReflection_RateLimitParams_messageType struct{}
+type fastReflection_TSSParams_messageType struct{}
 
-func (x fastReflection_RateLimitParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RateLimitParams)(nil)
+func (x fastReflection_TSSParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TSSParams)(nil)
 }
-func (x fastReflection_RateLimitParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_RateLimitParams)
+func (x fastReflection_TSSParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TSSParams)
 }
-func (x fastReflection_RateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RateLimitParams
+func (x fastReflection_TSSParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RateLimitParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_RateLimitParams
+func (x *fastReflection_TSSParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RateLimitParams) Type() protoreflect.MessageType {
-	return _fastReflection_RateLimitParams_messageType
+func (x *fastReflection_TSSParams) Type() protoreflect.MessageType {
+	return _fastReflection_TSSParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RateLimitParams) New() protoreflect.Message {
-	return new(fastReflection_RateLimitParams)
+func (x *fastReflection_TSSParams) New() protoreflect.Message {
+	return new(fastReflection_TSSParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RateLimitParams) Interface() protoreflect.ProtoMessage {
-	return (*RateLimitParams)(x)
+func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
+	return (*TSSParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4365,16 +4581,16 @@ func (x *fastReflection_RateLimitParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GlobalRateLimitParams != nil {
-		value := protoreflect.ValueOfMessage(x.GlobalRateLimitParams.ProtoReflect())
-		if !f(fd_RateLimitParams_global_rate_limit_params, value) {
+func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DkgTimeoutPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
+		if !f(fd_TSSParams_dkg_timeout_period, value) {
 			return
 		}
 	}
-	if x.AddressRateLimitParams != nil {
-		value := protoreflect.ValueOfMessage(x.AddressRateLimitParams.ProtoReflect())
-		if !f(fd_RateLimitParams_address_rate_limit_params, value) {
+	if x.ParticipantUpdateTransitionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
+		if !f(fd_TSSParams_participant_update_transition_period, value) {
 			return
 		}
 	}
@@ -4391,17 +4607,17 @@ func (x *fastReflection_RateLimitParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		return x.GlobalRateLimitParams != nil
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		return x.AddressRateLimitParams != nil
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		return x.DkgTimeoutPeriod != nil
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		return x.ParticipantUpdateTransitionPeriod != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4411,17 +4627,17 @@ func (x *fastReflection_RateLimitParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		x.GlobalRateLimitParams = nil
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		x.AddressRateLimitParams = nil
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		x.DkgTimeoutPeriod = nil
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		x.ParticipantUpdateTransitionPeriod = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4431,19 +4647,19 @@ func (x *fastReflection_RateLimitParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		value := x.GlobalRateLimitParams
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		value := x.DkgTimeoutPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		value := x.AddressRateLimitParams
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		value := x.ParticipantUpdateTransitionPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4457,17 +4673,17 @@ func (x *fastReflection_RateLimitParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		x.GlobalRateLimitParams = value.Message().Interface().(*GlobalRateLimitParams)
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		x.AddressRateLimitParams = value.Message().Interface().(*AddressRateLimitParams)
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		x.DkgTimeoutPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		x.ParticipantUpdateTransitionPeriod = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4481,52 +4697,52 @@ func (x *fastReflection_RateLimitParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		if x.GlobalRateLimitParams == nil {
-			x.GlobalRateLimitParams = new(GlobalRateLimitParams)
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		if x.DkgTimeoutPeriod == nil {
+			x.DkgTimeoutPeriod = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.GlobalRateLimitParams.ProtoReflect())
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		if x.AddressRateLimitParams == nil {
-			x.AddressRateLimitParams = new(AddressRateLimitParams)
+		return protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		if x.ParticipantUpdateTransitionPeriod == nil {
+			x.ParticipantUpdateTransitionPeriod = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.AddressRateLimitParams.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
-		m := new(GlobalRateLimitParams)
+	case "bitway.btcbridge.TSSParams.dkg_timeout_period":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
-		m := new(AddressRateLimitParams)
+	case "bitway.btcbridge.TSSParams.participant_update_transition_period":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.TSSParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.TSSParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RateLimitParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.TSSParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4534,7 +4750,7 @@ func (x *fastReflection_RateLimitParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RateLimitParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4545,7 +4761,7 @@ func (x *fastReflection_RateLimitParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RateLimitParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4557,7 +4773,7 @@ func (x *fastReflection_RateLimitParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RateLimitParams) IsValid() bool {
+func (x *fastReflection_TSSParams) IsValid() bool {
 	return x != nil
 }
 
@@ -4567,9 +4783,9 @@ func (x *fastReflection_RateLimitParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RateLimitParams)
+		x := input.Message.Interface().(*TSSParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4581,12 +4797,12 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.GlobalRateLimitParams != nil {
-			l = options.Size(x.GlobalRateLimitParams)
+		if x.DkgTimeoutPeriod != nil {
+			l = options.Size(x.DkgTimeoutPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AddressRateLimitParams != nil {
-			l = options.Size(x.AddressRateLimitParams)
+		if x.ParticipantUpdateTransitionPeriod != nil {
+			l = options.Size(x.ParticipantUpdateTransitionPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4599,7 +4815,7 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RateLimitParams)
+		x := input.Message.Interface().(*TSSParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4618,8 +4834,8 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AddressRateLimitParams != nil {
-			encoded, err := options.Marshal(x.AddressRateLimitParams)
+		if x.ParticipantUpdateTransitionPeriod != nil {
+			encoded, err := options.Marshal(x.ParticipantUpdateTransitionPeriod)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4632,8 +4848,8 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.GlobalRateLimitParams != nil {
-			encoded, err := options.Marshal(x.GlobalRateLimitParams)
+		if x.DkgTimeoutPeriod != nil {
+			encoded, err := options.Marshal(x.DkgTimeoutPeriod)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4657,7 +4873,7 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RateLimitParams)
+		x := input.Message.Interface().(*TSSParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4689,15 +4905,15 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimitParams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4724,16 +4940,16 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.GlobalRateLimitParams == nil {
-					x.GlobalRateLimitParams = &GlobalRateLimitParams{}
+				if x.DkgTimeoutPeriod == nil {
+					x.DkgTimeoutPeriod = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GlobalRateLimitParams); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgTimeoutPeriod); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimitParams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4760,10 +4976,10 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.AddressRateLimitParams == nil {
-					x.AddressRateLimitParams = &AddressRateLimitParams{}
+				if x.ParticipantUpdateTransitionPeriod == nil {
+					x.ParticipantUpdateTransitionPeriod = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AddressRateLimitParams); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantUpdateTransitionPeriod); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4803,27 +5019,27 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GlobalRateLimitParams                         protoreflect.MessageDescriptor
-	fd_GlobalRateLimitParams_period                  protoreflect.FieldDescriptor
-	fd_GlobalRateLimitParams_supply_percentage_quota protoreflect.FieldDescriptor
+	md_RateLimitParams                           protoreflect.MessageDescriptor
+	fd_RateLimitParams_global_rate_limit_params  protoreflect.FieldDescriptor
+	fd_RateLimitParams_address_rate_limit_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_GlobalRateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("GlobalRateLimitParams")
-	fd_GlobalRateLimitParams_period = md_GlobalRateLimitParams.Fields().ByName("period")
-	fd_GlobalRateLimitParams_supply_percentage_quota = md_GlobalRateLimitParams.Fields().ByName("supply_percentage_quota")
+	md_RateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("RateLimitParams")
+	fd_RateLimitParams_global_rate_limit_params = md_RateLimitParams.Fields().ByName("global_rate_limit_params")
+	fd_RateLimitParams_address_rate_limit_params = md_RateLimitParams.Fields().ByName("address_rate_limit_params")
 }
 
-var _ protoreflect.Message = (*fastReflection_GlobalRateLimitParams)(nil)
+var _ protoreflect.Message = (*fastReflection_RateLimitParams)(nil)
 
-type fastReflection_GlobalRateLimitParams GlobalRateLimitParams
+type fastReflection_RateLimitParams RateLimitParams
 
-func (x *GlobalRateLimitParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GlobalRateLimitParams)(x)
+func (x *RateLimitParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimitParams)(x)
 }
 
-func (x *GlobalRateLimitParams) slowProtoReflect() protoreflect.Message {
+func (x *RateLimitParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4835,43 +5051,43 @@ func (x *GlobalRateLimitParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GlobalRateLimitParams_messageType fastReflection_GlobalRateLimitParams_messageType
-var _ protoreflect.MessageType = fastReflection_GlobalRateLimitParams_messageType{}
+var _fastReflection_RateLimitParams_messageType fastReflection_RateLimitParams_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimitParams_messageType{}
 
-type fastReflection_GlobalRateLimitParams_messageType struct{}
+type fastReflection_RateLimitParams_messageType struct{}
 
-func (x fastReflection_GlobalRateLimitParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GlobalRateLimitParams)(nil)
+func (x fastReflection_RateLimitParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimitParams)(nil)
 }
-func (x fastReflection_GlobalRateLimitParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_GlobalRateLimitParams)
+func (x fastReflection_RateLimitParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimitParams)
 }
-func (x fastReflection_GlobalRateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GlobalRateLimitParams
+func (x fastReflection_RateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GlobalRateLimitParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_GlobalRateLimitParams
+func (x *fastReflection_RateLimitParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GlobalRateLimitParams) Type() protoreflect.MessageType {
-	return _fastReflection_GlobalRateLimitParams_messageType
+func (x *fastReflection_RateLimitParams) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimitParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GlobalRateLimitParams) New() protoreflect.Message {
-	return new(fastReflection_GlobalRateLimitParams)
+func (x *fastReflection_RateLimitParams) New() protoreflect.Message {
+	return new(fastReflection_RateLimitParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GlobalRateLimitParams) Interface() protoreflect.ProtoMessage {
-	return (*GlobalRateLimitParams)(x)
+func (x *fastReflection_RateLimitParams) Interface() protoreflect.ProtoMessage {
+	return (*RateLimitParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4879,16 +5095,16 @@ func (x *fastReflection_GlobalRateLimitParams) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GlobalRateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Period != nil {
-		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-		if !f(fd_GlobalRateLimitParams_period, value) {
+func (x *fastReflection_RateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GlobalRateLimitParams != nil {
+		value := protoreflect.ValueOfMessage(x.GlobalRateLimitParams.ProtoReflect())
+		if !f(fd_RateLimitParams_global_rate_limit_params, value) {
 			return
 		}
 	}
-	if x.SupplyPercentageQuota != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.SupplyPercentageQuota)
-		if !f(fd_GlobalRateLimitParams_supply_percentage_quota, value) {
+	if x.AddressRateLimitParams != nil {
+		value := protoreflect.ValueOfMessage(x.AddressRateLimitParams.ProtoReflect())
+		if !f(fd_RateLimitParams_address_rate_limit_params, value) {
 			return
 		}
 	}
@@ -4905,17 +5121,17 @@ func (x *fastReflection_GlobalRateLimitParams) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GlobalRateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		return x.Period != nil
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		return x.SupplyPercentageQuota != uint32(0)
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		return x.GlobalRateLimitParams != nil
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		return x.AddressRateLimitParams != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4925,17 +5141,17 @@ func (x *fastReflection_GlobalRateLimitParams) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GlobalRateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		x.Period = nil
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		x.SupplyPercentageQuota = uint32(0)
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		x.GlobalRateLimitParams = nil
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		x.AddressRateLimitParams = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4945,19 +5161,19 @@ func (x *fastReflection_GlobalRateLimitParams) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GlobalRateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		value := x.Period
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		value := x.GlobalRateLimitParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		value := x.AddressRateLimitParams
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		value := x.SupplyPercentageQuota
-		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4971,17 +5187,17 @@ func (x *fastReflection_GlobalRateLimitParams) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GlobalRateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		x.Period = value.Message().Interface().(*durationpb.Duration)
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		x.SupplyPercentageQuota = uint32(value.Uint())
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		x.GlobalRateLimitParams = value.Message().Interface().(*GlobalRateLimitParams)
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		x.AddressRateLimitParams = value.Message().Interface().(*AddressRateLimitParams)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4995,48 +5211,52 @@ func (x *fastReflection_GlobalRateLimitParams) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GlobalRateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		if x.Period == nil {
-			x.Period = new(durationpb.Duration)
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		if x.GlobalRateLimitParams == nil {
+			x.GlobalRateLimitParams = new(GlobalRateLimitParams)
 		}
-		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		panic(fmt.Errorf("field supply_percentage_quota of message bitway.btcbridge.GlobalRateLimitParams is not mutable"))
+		return protoreflect.ValueOfMessage(x.GlobalRateLimitParams.ProtoReflect())
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		if x.AddressRateLimitParams == nil {
+			x.AddressRateLimitParams = new(AddressRateLimitParams)
+		}
+		return protoreflect.ValueOfMessage(x.AddressRateLimitParams.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GlobalRateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.GlobalRateLimitParams.period":
-		m := new(durationpb.Duration)
+	case "bitway.btcbridge.RateLimitParams.global_rate_limit_params":
+		m := new(GlobalRateLimitParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.RateLimitParams.address_rate_limit_params":
+		m := new(AddressRateLimitParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
-		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.RateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GlobalRateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.GlobalRateLimitParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RateLimitParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5044,7 +5264,7 @@ func (x *fastReflection_GlobalRateLimitParams) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GlobalRateLimitParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RateLimitParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5055,7 +5275,7 @@ func (x *fastReflection_GlobalRateLimitParams) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GlobalRateLimitParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RateLimitParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5067,7 +5287,7 @@ func (x *fastReflection_GlobalRateLimitParams) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GlobalRateLimitParams) IsValid() bool {
+func (x *fastReflection_RateLimitParams) IsValid() bool {
 	return x != nil
 }
 
@@ -5077,9 +5297,9 @@ func (x *fastReflection_GlobalRateLimitParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GlobalRateLimitParams)
+		x := input.Message.Interface().(*RateLimitParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5091,12 +5311,13 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Period != nil {
-			l = options.Size(x.Period)
+		if x.GlobalRateLimitParams != nil {
+			l = options.Size(x.GlobalRateLimitParams)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SupplyPercentageQuota != 0 {
-			n += 1 + runtime.Sov(uint64(x.SupplyPercentageQuota))
+		if x.AddressRateLimitParams != nil {
+			l = options.Size(x.AddressRateLimitParams)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5108,7 +5329,7 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GlobalRateLimitParams)
+		x := input.Message.Interface().(*RateLimitParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5127,13 +5348,22 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SupplyPercentageQuota != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SupplyPercentageQuota))
+		if x.AddressRateLimitParams != nil {
+			encoded, err := options.Marshal(x.AddressRateLimitParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Period != nil {
-			encoded, err := options.Marshal(x.Period)
+		if x.GlobalRateLimitParams != nil {
+			encoded, err := options.Marshal(x.GlobalRateLimitParams)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5157,7 +5387,7 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GlobalRateLimitParams)
+		x := input.Message.Interface().(*RateLimitParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5189,15 +5419,15 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GlobalRateLimitParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GlobalRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimitParams", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5224,18 +5454,18 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Period == nil {
-					x.Period = &durationpb.Duration{}
+				if x.GlobalRateLimitParams == nil {
+					x.GlobalRateLimitParams = &GlobalRateLimitParams{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GlobalRateLimitParams); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyPercentageQuota", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimitParams", wireType)
 				}
-				x.SupplyPercentageQuota = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5245,11 +5475,28 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SupplyPercentageQuota |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AddressRateLimitParams == nil {
+					x.AddressRateLimitParams = &AddressRateLimitParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AddressRateLimitParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5286,27 +5533,27 @@ func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_AddressRateLimitParams        protoreflect.MessageDescriptor
-	fd_AddressRateLimitParams_period protoreflect.FieldDescriptor
-	fd_AddressRateLimitParams_quota  protoreflect.FieldDescriptor
+	md_GlobalRateLimitParams                         protoreflect.MessageDescriptor
+	fd_GlobalRateLimitParams_period                  protoreflect.FieldDescriptor
+	fd_GlobalRateLimitParams_supply_percentage_quota protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_AddressRateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("AddressRateLimitParams")
-	fd_AddressRateLimitParams_period = md_AddressRateLimitParams.Fields().ByName("period")
-	fd_AddressRateLimitParams_quota = md_AddressRateLimitParams.Fields().ByName("quota")
+	md_GlobalRateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("GlobalRateLimitParams")
+	fd_GlobalRateLimitParams_period = md_GlobalRateLimitParams.Fields().ByName("period")
+	fd_GlobalRateLimitParams_supply_percentage_quota = md_GlobalRateLimitParams.Fields().ByName("supply_percentage_quota")
 }
 
-var _ protoreflect.Message = (*fastReflection_AddressRateLimitParams)(nil)
+var _ protoreflect.Message = (*fastReflection_GlobalRateLimitParams)(nil)
 
-type fastReflection_AddressRateLimitParams AddressRateLimitParams
+type fastReflection_GlobalRateLimitParams GlobalRateLimitParams
 
-func (x *AddressRateLimitParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AddressRateLimitParams)(x)
+func (x *GlobalRateLimitParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GlobalRateLimitParams)(x)
 }
 
-func (x *AddressRateLimitParams) slowProtoReflect() protoreflect.Message {
+func (x *GlobalRateLimitParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5318,43 +5565,43 @@ func (x *AddressRateLimitParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AddressRateLimitParams_messageType fastReflection_AddressRateLimitParams_messageType
-var _ protoreflect.MessageType = fastReflection_AddressRateLimitParams_messageType{}
+var _fastReflection_GlobalRateLimitParams_messageType fastReflection_GlobalRateLimitParams_messageType
+var _ protoreflect.MessageType = fastReflection_GlobalRateLimitParams_messageType{}
 
-type fastReflection_AddressRateLimitParams_messageType struct{}
+type fastReflection_GlobalRateLimitParams_messageType struct{}
 
-func (x fastReflection_AddressRateLimitParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AddressRateLimitParams)(nil)
+func (x fastReflection_GlobalRateLimitParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GlobalRateLimitParams)(nil)
 }
-func (x fastReflection_AddressRateLimitParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_AddressRateLimitParams)
+func (x fastReflection_GlobalRateLimitParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_GlobalRateLimitParams)
 }
-func (x fastReflection_AddressRateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AddressRateLimitParams
+func (x fastReflection_GlobalRateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GlobalRateLimitParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AddressRateLimitParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_AddressRateLimitParams
+func (x *fastReflection_GlobalRateLimitParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_GlobalRateLimitParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AddressRateLimitParams) Type() protoreflect.MessageType {
-	return _fastReflection_AddressRateLimitParams_messageType
+func (x *fastReflection_GlobalRateLimitParams) Type() protoreflect.MessageType {
+	return _fastReflection_GlobalRateLimitParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AddressRateLimitParams) New() protoreflect.Message {
-	return new(fastReflection_AddressRateLimitParams)
+func (x *fastReflection_GlobalRateLimitParams) New() protoreflect.Message {
+	return new(fastReflection_GlobalRateLimitParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AddressRateLimitParams) Interface() protoreflect.ProtoMessage {
-	return (*AddressRateLimitParams)(x)
+func (x *fastReflection_GlobalRateLimitParams) Interface() protoreflect.ProtoMessage {
+	return (*GlobalRateLimitParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5362,16 +5609,16 @@ func (x *fastReflection_AddressRateLimitParams) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AddressRateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GlobalRateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Period != nil {
 		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-		if !f(fd_AddressRateLimitParams_period, value) {
+		if !f(fd_GlobalRateLimitParams_period, value) {
 			return
 		}
 	}
-	if x.Quota != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Quota)
-		if !f(fd_AddressRateLimitParams_quota, value) {
+	if x.SupplyPercentageQuota != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SupplyPercentageQuota)
+		if !f(fd_GlobalRateLimitParams_supply_percentage_quota, value) {
 			return
 		}
 	}
@@ -5388,17 +5635,17 @@ func (x *fastReflection_AddressRateLimitParams) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AddressRateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GlobalRateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		return x.Period != nil
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		return x.Quota != int64(0)
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		return x.SupplyPercentageQuota != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5408,17 +5655,17 @@ func (x *fastReflection_AddressRateLimitParams) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AddressRateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GlobalRateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		x.Period = nil
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		x.Quota = int64(0)
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		x.SupplyPercentageQuota = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5428,19 +5675,19 @@ func (x *fastReflection_AddressRateLimitParams) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AddressRateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GlobalRateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		value := x.Period
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		value := x.Quota
-		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		value := x.SupplyPercentageQuota
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5454,17 +5701,17 @@ func (x *fastReflection_AddressRateLimitParams) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AddressRateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GlobalRateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		x.Period = value.Message().Interface().(*durationpb.Duration)
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		x.Quota = value.Int()
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		x.SupplyPercentageQuota = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5478,48 +5725,48 @@ func (x *fastReflection_AddressRateLimitParams) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AddressRateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GlobalRateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		if x.Period == nil {
 			x.Period = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		panic(fmt.Errorf("field quota of message bitway.btcbridge.AddressRateLimitParams is not mutable"))
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		panic(fmt.Errorf("field supply_percentage_quota of message bitway.btcbridge.GlobalRateLimitParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AddressRateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GlobalRateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.AddressRateLimitParams.period":
+	case "bitway.btcbridge.GlobalRateLimitParams.period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.btcbridge.AddressRateLimitParams.quota":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.GlobalRateLimitParams.supply_percentage_quota":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GlobalRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.GlobalRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AddressRateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GlobalRateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.AddressRateLimitParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.GlobalRateLimitParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5527,7 +5774,7 @@ func (x *fastReflection_AddressRateLimitParams) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AddressRateLimitParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GlobalRateLimitParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5538,7 +5785,7 @@ func (x *fastReflection_AddressRateLimitParams) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AddressRateLimitParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GlobalRateLimitParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5550,7 +5797,7 @@ func (x *fastReflection_AddressRateLimitParams) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AddressRateLimitParams) IsValid() bool {
+func (x *fastReflection_GlobalRateLimitParams) IsValid() bool {
 	return x != nil
 }
 
@@ -5560,9 +5807,9 @@ func (x *fastReflection_AddressRateLimitParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GlobalRateLimitParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AddressRateLimitParams)
+		x := input.Message.Interface().(*GlobalRateLimitParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5578,8 +5825,8 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 			l = options.Size(x.Period)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Quota != 0 {
-			n += 1 + runtime.Sov(uint64(x.Quota))
+		if x.SupplyPercentageQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.SupplyPercentageQuota))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5591,7 +5838,7 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AddressRateLimitParams)
+		x := input.Message.Interface().(*GlobalRateLimitParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5610,8 +5857,8 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Quota != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quota))
+		if x.SupplyPercentageQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SupplyPercentageQuota))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -5640,7 +5887,7 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AddressRateLimitParams)
+		x := input.Message.Interface().(*GlobalRateLimitParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5672,10 +5919,10 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRateLimitParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GlobalRateLimitParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GlobalRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5716,9 +5963,9 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyPercentageQuota", wireType)
 				}
-				x.Quota = 0
+				x.SupplyPercentageQuota = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5728,7 +5975,7 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Quota |= int64(b&0x7F) << shift
+					x.SupplyPercentageQuota |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -5769,27 +6016,27 @@ func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_IBCParams                       protoreflect.MessageDescriptor
-	fd_IBCParams_timeout_height_offset protoreflect.FieldDescriptor
-	fd_IBCParams_timeout_duration      protoreflect.FieldDescriptor
+	md_AddressRateLimitParams        protoreflect.MessageDescriptor
+	fd_AddressRateLimitParams_period protoreflect.FieldDescriptor
+	fd_AddressRateLimitParams_quota  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_IBCParams = File_bitway_btcbridge_params_proto.Messages().ByName("IBCParams")
-	fd_IBCParams_timeout_height_offset = md_IBCParams.Fields().ByName("timeout_height_offset")
-	fd_IBCParams_timeout_duration = md_IBCParams.Fields().ByName("timeout_duration")
+	md_AddressRateLimitParams = File_bitway_btcbridge_params_proto.Messages().ByName("AddressRateLimitParams")
+	fd_AddressRateLimitParams_period = md_AddressRateLimitParams.Fields().ByName("period")
+	fd_AddressRateLimitParams_quota = md_AddressRateLimitParams.Fields().ByName("quota")
 }
 
-var _ protoreflect.Message = (*fastReflection_IBCParams)(nil)
+var _ protoreflect.Message = (*fastReflection_AddressRateLimitParams)(nil)
 
-type fastReflection_IBCParams IBCParams
+type fastReflection_AddressRateLimitParams AddressRateLimitParams
 
-func (x *IBCParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IBCParams)(x)
+func (x *AddressRateLimitParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AddressRateLimitParams)(x)
 }
 
-func (x *IBCParams) slowProtoReflect() protoreflect.Message {
+func (x *AddressRateLimitParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5801,43 +6048,43 @@ func (x *IBCParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IBCParams_messageType fastReflection_IBCParams_messageType
-var _ protoreflect.MessageType = fastReflection_IBCParams_messageType{}
+var _fastReflection_AddressRateLimitParams_messageType fastReflection_AddressRateLimitParams_messageType
+var _ protoreflect.MessageType = fastReflection_AddressRateLimitParams_messageType{}
 
-type fastReflection_IBCParams_messageType struct{}
+type fastReflection_AddressRateLimitParams_messageType struct{}
 
-func (x fastReflection_IBCParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IBCParams)(nil)
+func (x fastReflection_AddressRateLimitParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AddressRateLimitParams)(nil)
 }
-func (x fastReflection_IBCParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_IBCParams)
+func (x fastReflection_AddressRateLimitParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_AddressRateLimitParams)
 }
-func (x fastReflection_IBCParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCParams
+func (x fastReflection_AddressRateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressRateLimitParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IBCParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCParams
+func (x *fastReflection_AddressRateLimitParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressRateLimitParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IBCParams) Type() protoreflect.MessageType {
-	return _fastReflection_IBCParams_messageType
+func (x *fastReflection_AddressRateLimitParams) Type() protoreflect.MessageType {
+	return _fastReflection_AddressRateLimitParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IBCParams) New() protoreflect.Message {
-	return new(fastReflection_IBCParams)
+func (x *fastReflection_AddressRateLimitParams) New() protoreflect.Message {
+	return new(fastReflection_AddressRateLimitParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IBCParams) Interface() protoreflect.ProtoMessage {
-	return (*IBCParams)(x)
+func (x *fastReflection_AddressRateLimitParams) Interface() protoreflect.ProtoMessage {
+	return (*AddressRateLimitParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5845,16 +6092,16 @@ func (x *fastReflection_IBCParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IBCParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TimeoutHeightOffset != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TimeoutHeightOffset)
-		if !f(fd_IBCParams_timeout_height_offset, value) {
+func (x *fastReflection_AddressRateLimitParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Period != nil {
+		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+		if !f(fd_AddressRateLimitParams_period, value) {
 			return
 		}
 	}
-	if x.TimeoutDuration != nil {
-		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
-		if !f(fd_IBCParams_timeout_duration, value) {
+	if x.Quota != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Quota)
+		if !f(fd_AddressRateLimitParams_quota, value) {
 			return
 		}
 	}
@@ -5871,17 +6118,17 @@ func (x *fastReflection_IBCParams) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IBCParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AddressRateLimitParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		return x.TimeoutHeightOffset != uint64(0)
-	case "bitway.btcbridge.IBCParams.timeout_duration":
-		return x.TimeoutDuration != nil
+	case "bitway.btcbridge.AddressRateLimitParams.period":
+		return x.Period != nil
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		return x.Quota != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5891,17 +6138,17 @@ func (x *fastReflection_IBCParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AddressRateLimitParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		x.TimeoutHeightOffset = uint64(0)
-	case "bitway.btcbridge.IBCParams.timeout_duration":
-		x.TimeoutDuration = nil
+	case "bitway.btcbridge.AddressRateLimitParams.period":
+		x.Period = nil
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		x.Quota = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5911,19 +6158,19 @@ func (x *fastReflection_IBCParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IBCParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRateLimitParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		value := x.TimeoutHeightOffset
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.btcbridge.IBCParams.timeout_duration":
-		value := x.TimeoutDuration
+	case "bitway.btcbridge.AddressRateLimitParams.period":
+		value := x.Period
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		value := x.Quota
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5937,17 +6184,17 @@ func (x *fastReflection_IBCParams) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AddressRateLimitParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		x.TimeoutHeightOffset = value.Uint()
-	case "bitway.btcbridge.IBCParams.timeout_duration":
-		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.AddressRateLimitParams.period":
+		x.Period = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		x.Quota = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5961,48 +6208,48 @@ func (x *fastReflection_IBCParams) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRateLimitParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_duration":
-		if x.TimeoutDuration == nil {
-			x.TimeoutDuration = new(durationpb.Duration)
+	case "bitway.btcbridge.AddressRateLimitParams.period":
+		if x.Period == nil {
+			x.Period = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		panic(fmt.Errorf("field timeout_height_offset of message bitway.btcbridge.IBCParams is not mutable"))
+		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		panic(fmt.Errorf("field quota of message bitway.btcbridge.AddressRateLimitParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IBCParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRateLimitParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.IBCParams.timeout_height_offset":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.btcbridge.IBCParams.timeout_duration":
+	case "bitway.btcbridge.AddressRateLimitParams.period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.AddressRateLimitParams.quota":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.AddressRateLimitParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.AddressRateLimitParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IBCParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AddressRateLimitParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.IBCParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.AddressRateLimitParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6010,7 +6257,7 @@ func (x *fastReflection_IBCParams) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IBCParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AddressRateLimitParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6021,7 +6268,7 @@ func (x *fastReflection_IBCParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AddressRateLimitParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6033,7 +6280,7 @@ func (x *fastReflection_IBCParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IBCParams) IsValid() bool {
+func (x *fastReflection_AddressRateLimitParams) IsValid() bool {
 	return x != nil
 }
 
@@ -6043,9 +6290,9 @@ func (x *fastReflection_IBCParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AddressRateLimitParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IBCParams)
+		x := input.Message.Interface().(*AddressRateLimitParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6057,13 +6304,13 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TimeoutHeightOffset != 0 {
-			n += 1 + runtime.Sov(uint64(x.TimeoutHeightOffset))
-		}
-		if x.TimeoutDuration != nil {
-			l = options.Size(x.TimeoutDuration)
+		if x.Period != nil {
+			l = options.Size(x.Period)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Quota != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quota))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6074,7 +6321,7 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IBCParams)
+		x := input.Message.Interface().(*AddressRateLimitParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6093,8 +6340,13 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TimeoutDuration != nil {
-			encoded, err := options.Marshal(x.TimeoutDuration)
+		if x.Quota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quota))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Period != nil {
+			encoded, err := options.Marshal(x.Period)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6105,12 +6357,7 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.TimeoutHeightOffset != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutHeightOffset))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6123,7 +6370,7 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IBCParams)
+		x := input.Message.Interface().(*AddressRateLimitParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6155,17 +6402,17 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRateLimitParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 				}
-				x.TimeoutHeightOffset = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6175,16 +6422,33 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Period == nil {
+					x.Period = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 				}
-				var msglen int
+				x.Quota = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6194,28 +6458,11 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Quota |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TimeoutDuration == nil {
-					x.TimeoutDuration = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6251,77 +6498,28 @@ func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_FeeSponsorshipParams_1_list)(nil)
-
-type _FeeSponsorshipParams_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_FeeSponsorshipParams_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_FeeSponsorshipParams_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_FeeSponsorshipParams_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_FeeSponsorshipParams_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_FeeSponsorshipParams_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_FeeSponsorshipParams_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_FeeSponsorshipParams_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_FeeSponsorshipParams_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_FeeSponsorshipParams                 protoreflect.MessageDescriptor
-	fd_FeeSponsorshipParams_max_sponsor_fee protoreflect.FieldDescriptor
+	md_IBCParams                       protoreflect.MessageDescriptor
+	fd_IBCParams_timeout_height_offset protoreflect.FieldDescriptor
+	fd_IBCParams_timeout_duration      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_btcbridge_params_proto_init()
-	md_FeeSponsorshipParams = File_bitway_btcbridge_params_proto.Messages().ByName("FeeSponsorshipParams")
-	fd_FeeSponsorshipParams_max_sponsor_fee = md_FeeSponsorshipParams.Fields().ByName("max_sponsor_fee")
+	md_IBCParams = File_bitway_btcbridge_params_proto.Messages().ByName("IBCParams")
+	fd_IBCParams_timeout_height_offset = md_IBCParams.Fields().ByName("timeout_height_offset")
+	fd_IBCParams_timeout_duration = md_IBCParams.Fields().ByName("timeout_duration")
 }
 
-var _ protoreflect.Message = (*fastReflection_FeeSponsorshipParams)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCParams)(nil)
 
-type fastReflection_FeeSponsorshipParams FeeSponsorshipParams
+type fastReflection_IBCParams IBCParams
 
-func (x *FeeSponsorshipParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeeSponsorshipParams)(x)
+func (x *IBCParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCParams)(x)
 }
 
-func (x *FeeSponsorshipParams) slowProtoReflect() protoreflect.Message {
+func (x *IBCParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_btcbridge_params_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6333,43 +6531,43 @@ func (x *FeeSponsorshipParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_FeeSponsorshipParams_messageType fastReflection_FeeSponsorshipParams_messageType
-var _ protoreflect.MessageType = fastReflection_FeeSponsorshipParams_messageType{}
+var _fastReflection_IBCParams_messageType fastReflection_IBCParams_messageType
+var _ protoreflect.MessageType = fastReflection_IBCParams_messageType{}
 
-type fastReflection_FeeSponsorshipParams_messageType struct{}
+type fastReflection_IBCParams_messageType struct{}
 
-func (x fastReflection_FeeSponsorshipParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeeSponsorshipParams)(nil)
+func (x fastReflection_IBCParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCParams)(nil)
 }
-func (x fastReflection_FeeSponsorshipParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeeSponsorshipParams)
+func (x fastReflection_IBCParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCParams)
 }
-func (x fastReflection_FeeSponsorshipParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeeSponsorshipParams
+func (x fastReflection_IBCParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_FeeSponsorshipParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeeSponsorshipParams
+func (x *fastReflection_IBCParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeeSponsorshipParams) Type() protoreflect.MessageType {
-	return _fastReflection_FeeSponsorshipParams_messageType
+func (x *fastReflection_IBCParams) Type() protoreflect.MessageType {
+	return _fastReflection_IBCParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeeSponsorshipParams) New() protoreflect.Message {
-	return new(fastReflection_FeeSponsorshipParams)
+func (x *fastReflection_IBCParams) New() protoreflect.Message {
+	return new(fastReflection_IBCParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeeSponsorshipParams) Interface() protoreflect.ProtoMessage {
-	return (*FeeSponsorshipParams)(x)
+func (x *fastReflection_IBCParams) Interface() protoreflect.ProtoMessage {
+	return (*IBCParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6377,10 +6575,16 @@ func (x *fastReflection_FeeSponsorshipParams) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeeSponsorshipParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MaxSponsorFee) != 0 {
-		value := protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee})
-		if !f(fd_FeeSponsorshipParams_max_sponsor_fee, value) {
+func (x *fastReflection_IBCParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TimeoutHeightOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutHeightOffset)
+		if !f(fd_IBCParams_timeout_height_offset, value) {
+			return
+		}
+	}
+	if x.TimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+		if !f(fd_IBCParams_timeout_duration, value) {
 			return
 		}
 	}
@@ -6397,15 +6601,17 @@ func (x *fastReflection_FeeSponsorshipParams) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeeSponsorshipParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		return len(x.MaxSponsorFee) != 0
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		return x.TimeoutHeightOffset != uint64(0)
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		return x.TimeoutDuration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6415,15 +6621,17 @@ func (x *fastReflection_FeeSponsorshipParams) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeSponsorshipParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		x.MaxSponsorFee = nil
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		x.TimeoutHeightOffset = uint64(0)
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		x.TimeoutDuration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6433,19 +6641,19 @@ func (x *fastReflection_FeeSponsorshipParams) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeeSponsorshipParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		if len(x.MaxSponsorFee) == 0 {
-			return protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{})
-		}
-		listValue := &_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee}
-		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		value := x.TimeoutHeightOffset
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		value := x.TimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6459,17 +6667,17 @@ func (x *fastReflection_FeeSponsorshipParams) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeSponsorshipParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		lv := value.List()
-		clv := lv.(*_FeeSponsorshipParams_1_list)
-		x.MaxSponsorFee = *clv.list
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		x.TimeoutHeightOffset = value.Uint()
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6483,45 +6691,48 @@ func (x *fastReflection_FeeSponsorshipParams) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeSponsorshipParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		if x.MaxSponsorFee == nil {
-			x.MaxSponsorFee = []*v1beta1.Coin{}
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		if x.TimeoutDuration == nil {
+			x.TimeoutDuration = new(durationpb.Duration)
 		}
-		value := &_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		panic(fmt.Errorf("field timeout_height_offset of message bitway.btcbridge.IBCParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeeSponsorshipParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{list: &list})
+	case "bitway.btcbridge.IBCParams.timeout_height_offset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.btcbridge.IBCParams.timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.IBCParams"))
 		}
-		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.btcbridge.IBCParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeeSponsorshipParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.FeeSponsorshipParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.IBCParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6529,7 +6740,7 @@ func (x *fastReflection_FeeSponsorshipParams) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeeSponsorshipParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6540,7 +6751,7 @@ func (x *fastReflection_FeeSponsorshipParams) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeSponsorshipParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6552,7 +6763,7 @@ func (x *fastReflection_FeeSponsorshipParams) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeeSponsorshipParams) IsValid() bool {
+func (x *fastReflection_IBCParams) IsValid() bool {
 	return x != nil
 }
 
@@ -6562,9 +6773,9 @@ func (x *fastReflection_FeeSponsorshipParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeeSponsorshipParams)
+		x := input.Message.Interface().(*IBCParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6576,11 +6787,12 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.MaxSponsorFee) > 0 {
-			for _, e := range x.MaxSponsorFee {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.TimeoutHeightOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutHeightOffset))
+		}
+		if x.TimeoutDuration != nil {
+			l = options.Size(x.TimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6592,7 +6804,7 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeeSponsorshipParams)
+		x := input.Message.Interface().(*IBCParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6611,21 +6823,24 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxSponsorFee) > 0 {
-			for iNdEx := len(x.MaxSponsorFee) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MaxSponsorFee[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.TimeoutDuration != nil {
+			encoded, err := options.Marshal(x.TimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TimeoutHeightOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutHeightOffset))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6638,7 +6853,7 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeeSponsorshipParams)
+		x := input.Message.Interface().(*IBCParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6670,15 +6885,34 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
+				}
+				x.TimeoutHeightOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSponsorFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6705,8 +6939,10 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxSponsorFee = append(x.MaxSponsorFee, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxSponsorFee[len(x.MaxSponsorFee)-1]); err != nil {
+				if x.TimeoutDuration == nil {
+					x.TimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6745,752 +6981,6314 @@ func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: bitway/btcbridge/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// AssetType defines the type of asset
-type AssetType int32
+var _ protoreflect.List = (*_FeeSponsorshipParams_1_list)(nil)
 
-const (
-	// Unspecified asset type
-	AssetType_ASSET_TYPE_UNSPECIFIED AssetType = 0
-	// BTC
-	AssetType_ASSET_TYPE_BTC AssetType = 1
-	// BRC20: ordi, sats
-	AssetType_ASSET_TYPE_BRC20 AssetType = 2
-	// RUNE: dog•go•to•the•moon
-	AssetType_ASSET_TYPE_RUNES AssetType = 3
-)
+type _FeeSponsorshipParams_1_list struct {
+	list *[]*v1beta1.Coin
+}
 
-// Enum value maps for AssetType.
-var (
-	AssetType_name = map[int32]string{
-		0: "ASSET_TYPE_UNSPECIFIED",
-		1: "ASSET_TYPE_BTC",
-		2: "ASSET_TYPE_BRC20",
-		3: "ASSET_TYPE_RUNES",
-	}
-	AssetType_value = map[string]int32{
-		"ASSET_TYPE_UNSPECIFIED": 0,
-		"ASSET_TYPE_BTC":         1,
-		"ASSET_TYPE_BRC20":       2,
-		"ASSET_TYPE_RUNES":       3,
+func (x *_FeeSponsorshipParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x AssetType) Enum() *AssetType {
-	p := new(AssetType)
-	*p = x
-	return p
+func (x *_FeeSponsorshipParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x AssetType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_FeeSponsorshipParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
 }
 
-func (AssetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_btcbridge_params_proto_enumTypes[0].Descriptor()
+func (x *_FeeSponsorshipParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (AssetType) Type() protoreflect.EnumType {
-	return &file_bitway_btcbridge_params_proto_enumTypes[0]
+func (x *_FeeSponsorshipParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x AssetType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_FeeSponsorshipParams_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use AssetType.Descriptor instead.
-func (AssetType) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{0}
+func (x *_FeeSponsorshipParams_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Params defines the parameters for the module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_FeeSponsorshipParams_1_list) IsValid() bool {
+	return x.list != nil
+}
 
-	// The minimum number of confirmations required for the deposit transactions
-	DepositConfirmationDepth int32 `protobuf:"varint,1,opt,name=deposit_confirmation_depth,json=depositConfirmationDepth,proto3" json:"deposit_confirmation_depth,omitempty"`
-	// The minimum number of confirmations required for the withdrawal transactions
-	WithdrawConfirmationDepth int32 `protobuf:"varint,2,opt,name=withdraw_confirmation_depth,json=withdrawConfirmationDepth,proto3" json:"withdraw_confirmation_depth,omitempty"`
-	// Indicates the maximum depth or distance from the latest block up to which transactions are considered for acceptance.
-	MaxAcceptableBlockDepth uint64 `protobuf:"varint,3,opt,name=max_acceptable_block_depth,json=maxAcceptableBlockDepth,proto3" json:"max_acceptable_block_depth,omitempty"`
-	// The denomination of the voucher
-	BtcVoucherDenom string `protobuf:"bytes,4,opt,name=btc_voucher_denom,json=btcVoucherDenom,proto3" json:"btc_voucher_denom,omitempty"`
-	// Indicates if deposit is enabled
-	DepositEnabled bool `protobuf:"varint,5,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	// Indicates if withdrawal is enabled
-	WithdrawEnabled bool `protobuf:"varint,6,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	// Trusted relayers for non-btc asset deposit
-	TrustedNonBtcRelayers []string `protobuf:"bytes,7,rep,name=trusted_non_btc_relayers,json=trustedNonBtcRelayers,proto3" json:"trusted_non_btc_relayers,omitempty"`
-	// Trusted fee providers to submit bitcoin fee rate
-	TrustedFeeProviders []string `protobuf:"bytes,8,rep,name=trusted_fee_providers,json=trustedFeeProviders,proto3" json:"trusted_fee_providers,omitempty"`
-	// Period of validity for the fee rate
-	FeeRateValidityPeriod int64 `protobuf:"varint,9,opt,name=fee_rate_validity_period,json=feeRateValidityPeriod,proto3" json:"fee_rate_validity_period,omitempty"`
-	// Asset vaults
-	Vaults []*Vault `protobuf:"bytes,10,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// Withdrawal params
-	WithdrawParams *WithdrawParams `protobuf:"bytes,11,opt,name=withdraw_params,json=withdrawParams,proto3" json:"withdraw_params,omitempty"`
-	// Protocol limitations
-	ProtocolLimits *ProtocolLimits `protobuf:"bytes,12,opt,name=protocol_limits,json=protocolLimits,proto3" json:"protocol_limits,omitempty"`
-	// Protocol fees
-	ProtocolFees *ProtocolFees `protobuf:"bytes,13,opt,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees,omitempty"`
-	// TSS params
-	TssParams *TSSParams `protobuf:"bytes,14,opt,name=tss_params,json=tssParams,proto3" json:"tss_params,omitempty"`
-	// Rate limit params
-	RateLimitParams *RateLimitParams `protobuf:"bytes,15,opt,name=rate_limit_params,json=rateLimitParams,proto3" json:"rate_limit_params,omitempty"`
-	// IBC params
-	IbcParams *IBCParams `protobuf:"bytes,16,opt,name=ibc_params,json=ibcParams,proto3" json:"ibc_params,omitempty"`
-	// Fee sponsorship params
-	FeeSponsorshipParams *FeeSponsorshipParams `protobuf:"bytes,17,opt,name=fee_sponsorship_params,json=feeSponsorshipParams,proto3" json:"fee_sponsorship_params,omitempty"`
+var _ protoreflect.List = (*_FeeSponsorshipParams_2_list)(nil)
+
+type _FeeSponsorshipParams_2_list struct {
+	list *[]*FeeSponsorshipPolicy
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_FeeSponsorshipParams_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_FeeSponsorshipParams_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_FeeSponsorshipParams_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipPolicy)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{0}
+func (x *_FeeSponsorshipParams_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsorshipPolicy)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Params) GetDepositConfirmationDepth() int32 {
-	if x != nil {
-		return x.DepositConfirmationDepth
-	}
-	return 0
+func (x *_FeeSponsorshipParams_2_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSponsorshipPolicy)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Params) GetWithdrawConfirmationDepth() int32 {
-	if x != nil {
-		return x.WithdrawConfirmationDepth
+func (x *_FeeSponsorshipParams_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return 0
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Params) GetMaxAcceptableBlockDepth() uint64 {
-	if x != nil {
-		return x.MaxAcceptableBlockDepth
-	}
-	return 0
+func (x *_FeeSponsorshipParams_2_list) NewElement() protoreflect.Value {
+	v := new(FeeSponsorshipPolicy)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Params) GetBtcVoucherDenom() string {
-	if x != nil {
-		return x.BtcVoucherDenom
-	}
-	return ""
+func (x *_FeeSponsorshipParams_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Params) GetDepositEnabled() bool {
-	if x != nil {
-		return x.DepositEnabled
-	}
+var (
+	md_FeeSponsorshipParams                 protoreflect.MessageDescriptor
+	fd_FeeSponsorshipParams_max_sponsor_fee protoreflect.FieldDescriptor
+	fd_FeeSponsorshipParams_policies        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_FeeSponsorshipParams = File_bitway_btcbridge_params_proto.Messages().ByName("FeeSponsorshipParams")
+	fd_FeeSponsorshipParams_max_sponsor_fee = md_FeeSponsorshipParams.Fields().ByName("max_sponsor_fee")
+	fd_FeeSponsorshipParams_policies = md_FeeSponsorshipParams.Fields().ByName("policies")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSponsorshipParams)(nil)
+
+type fastReflection_FeeSponsorshipParams FeeSponsorshipParams
+
+func (x *FeeSponsorshipParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipParams)(x)
+}
+
+func (x *FeeSponsorshipParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSponsorshipParams_messageType fastReflection_FeeSponsorshipParams_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSponsorshipParams_messageType{}
+
+type fastReflection_FeeSponsorshipParams_messageType struct{}
+
+func (x fastReflection_FeeSponsorshipParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipParams)(nil)
+}
+func (x fastReflection_FeeSponsorshipParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipParams)
+}
+func (x fastReflection_FeeSponsorshipParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSponsorshipParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSponsorshipParams) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSponsorshipParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSponsorshipParams) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSponsorshipParams) Interface() protoreflect.ProtoMessage {
+	return (*FeeSponsorshipParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSponsorshipParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MaxSponsorFee) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee})
+		if !f(fd_FeeSponsorshipParams_max_sponsor_fee, value) {
+			return
+		}
+	}
+	if len(x.Policies) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipParams_2_list{list: &x.Policies})
+		if !f(fd_FeeSponsorshipParams_policies, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSponsorshipParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		return len(x.MaxSponsorFee) != 0
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		return len(x.Policies) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		x.MaxSponsorFee = nil
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		x.Policies = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSponsorshipParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		if len(x.MaxSponsorFee) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{})
+		}
+		listValue := &_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		if len(x.Policies) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipParams_2_list{})
+		}
+		listValue := &_FeeSponsorshipParams_2_list{list: &x.Policies}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipParams_1_list)
+		x.MaxSponsorFee = *clv.list
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipParams_2_list)
+		x.Policies = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		if x.MaxSponsorFee == nil {
+			x.MaxSponsorFee = []*v1beta1.Coin{}
+		}
+		value := &_FeeSponsorshipParams_1_list{list: &x.MaxSponsorFee}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		if x.Policies == nil {
+			x.Policies = []*FeeSponsorshipPolicy{}
+		}
+		value := &_FeeSponsorshipParams_2_list{list: &x.Policies}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSponsorshipParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipParams_1_list{list: &list})
+	case "bitway.btcbridge.FeeSponsorshipParams.policies":
+		list := []*FeeSponsorshipPolicy{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipParams_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSponsorshipParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.FeeSponsorshipParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSponsorshipParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSponsorshipParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSponsorshipParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSponsorshipParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MaxSponsorFee) > 0 {
+			for _, e := range x.MaxSponsorFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Policies) > 0 {
+			for _, e := range x.Policies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSponsorshipParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Policies) > 0 {
+			for iNdEx := len(x.Policies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Policies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MaxSponsorFee) > 0 {
+			for iNdEx := len(x.MaxSponsorFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxSponsorFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSponsorshipParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSponsorFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSponsorFee = append(x.MaxSponsorFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxSponsorFee[len(x.MaxSponsorFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Policies = append(x.Policies, &FeeSponsorshipPolicy{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Policies[len(x.Policies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeSponsorshipPolicy_2_list)(nil)
+
+type _FeeSponsorshipPolicy_2_list struct {
+	list *[]string
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeSponsorshipPolicy at list field MsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FeeSponsorshipPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeSponsorshipPolicy_4_list)(nil)
+
+type _FeeSponsorshipPolicy_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSponsorshipPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeSponsorshipPolicy                 protoreflect.MessageDescriptor
+	fd_FeeSponsorshipPolicy_id              protoreflect.FieldDescriptor
+	fd_FeeSponsorshipPolicy_msg_type_urls   protoreflect.FieldDescriptor
+	fd_FeeSponsorshipPolicy_account_quota   protoreflect.FieldDescriptor
+	fd_FeeSponsorshipPolicy_epoch_budget    protoreflect.FieldDescriptor
+	fd_FeeSponsorshipPolicy_epoch_duration  protoreflect.FieldDescriptor
+	fd_FeeSponsorshipPolicy_funding_account protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_FeeSponsorshipPolicy = File_bitway_btcbridge_params_proto.Messages().ByName("FeeSponsorshipPolicy")
+	fd_FeeSponsorshipPolicy_id = md_FeeSponsorshipPolicy.Fields().ByName("id")
+	fd_FeeSponsorshipPolicy_msg_type_urls = md_FeeSponsorshipPolicy.Fields().ByName("msg_type_urls")
+	fd_FeeSponsorshipPolicy_account_quota = md_FeeSponsorshipPolicy.Fields().ByName("account_quota")
+	fd_FeeSponsorshipPolicy_epoch_budget = md_FeeSponsorshipPolicy.Fields().ByName("epoch_budget")
+	fd_FeeSponsorshipPolicy_epoch_duration = md_FeeSponsorshipPolicy.Fields().ByName("epoch_duration")
+	fd_FeeSponsorshipPolicy_funding_account = md_FeeSponsorshipPolicy.Fields().ByName("funding_account")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSponsorshipPolicy)(nil)
+
+type fastReflection_FeeSponsorshipPolicy FeeSponsorshipPolicy
+
+func (x *FeeSponsorshipPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipPolicy)(x)
+}
+
+func (x *FeeSponsorshipPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSponsorshipPolicy_messageType fastReflection_FeeSponsorshipPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSponsorshipPolicy_messageType{}
+
+type fastReflection_FeeSponsorshipPolicy_messageType struct{}
+
+func (x fastReflection_FeeSponsorshipPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSponsorshipPolicy)(nil)
+}
+func (x fastReflection_FeeSponsorshipPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipPolicy)
+}
+func (x fastReflection_FeeSponsorshipPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSponsorshipPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSponsorshipPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSponsorshipPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSponsorshipPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSponsorshipPolicy) New() protoreflect.Message {
+	return new(fastReflection_FeeSponsorshipPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSponsorshipPolicy) Interface() protoreflect.ProtoMessage {
+	return (*FeeSponsorshipPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSponsorshipPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_FeeSponsorshipPolicy_id, value) {
+			return
+		}
+	}
+	if len(x.MsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipPolicy_2_list{list: &x.MsgTypeUrls})
+		if !f(fd_FeeSponsorshipPolicy_msg_type_urls, value) {
+			return
+		}
+	}
+	if x.AccountQuota != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AccountQuota)
+		if !f(fd_FeeSponsorshipPolicy_account_quota, value) {
+			return
+		}
+	}
+	if len(x.EpochBudget) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSponsorshipPolicy_4_list{list: &x.EpochBudget})
+		if !f(fd_FeeSponsorshipPolicy_epoch_budget, value) {
+			return
+		}
+	}
+	if x.EpochDuration != nil {
+		value := protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+		if !f(fd_FeeSponsorshipPolicy_epoch_duration, value) {
+			return
+		}
+	}
+	if x.FundingAccount != "" {
+		value := protoreflect.ValueOfString(x.FundingAccount)
+		if !f(fd_FeeSponsorshipPolicy_funding_account, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSponsorshipPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		return x.Id != ""
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		return len(x.MsgTypeUrls) != 0
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		return x.AccountQuota != uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		return len(x.EpochBudget) != 0
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		return x.EpochDuration != nil
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		return x.FundingAccount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		x.Id = ""
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		x.MsgTypeUrls = nil
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		x.AccountQuota = uint32(0)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		x.EpochBudget = nil
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		x.EpochDuration = nil
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		x.FundingAccount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSponsorshipPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		if len(x.MsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipPolicy_2_list{})
+		}
+		listValue := &_FeeSponsorshipPolicy_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		value := x.AccountQuota
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		if len(x.EpochBudget) == 0 {
+			return protoreflect.ValueOfList(&_FeeSponsorshipPolicy_4_list{})
+		}
+		listValue := &_FeeSponsorshipPolicy_4_list{list: &x.EpochBudget}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		value := x.EpochDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		value := x.FundingAccount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		x.Id = value.Interface().(string)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipPolicy_2_list)
+		x.MsgTypeUrls = *clv.list
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		x.AccountQuota = uint32(value.Uint())
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		lv := value.List()
+		clv := lv.(*_FeeSponsorshipPolicy_4_list)
+		x.EpochBudget = *clv.list
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		x.EpochDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		x.FundingAccount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		if x.MsgTypeUrls == nil {
+			x.MsgTypeUrls = []string{}
+		}
+		value := &_FeeSponsorshipPolicy_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		if x.EpochBudget == nil {
+			x.EpochBudget = []*v1beta1.Coin{}
+		}
+		value := &_FeeSponsorshipPolicy_4_list{list: &x.EpochBudget}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		if x.EpochDuration == nil {
+			x.EpochDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.FeeSponsorshipPolicy is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		panic(fmt.Errorf("field account_quota of message bitway.btcbridge.FeeSponsorshipPolicy is not mutable"))
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		panic(fmt.Errorf("field funding_account of message bitway.btcbridge.FeeSponsorshipPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSponsorshipPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.FeeSponsorshipPolicy.id":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.FeeSponsorshipPolicy.msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipPolicy_2_list{list: &list})
+	case "bitway.btcbridge.FeeSponsorshipPolicy.account_quota":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeSponsorshipPolicy_4_list{list: &list})
+	case "bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.FeeSponsorshipPolicy.funding_account":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.FeeSponsorshipPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.FeeSponsorshipPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSponsorshipPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.FeeSponsorshipPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSponsorshipPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSponsorshipPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSponsorshipPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSponsorshipPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSponsorshipPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypeUrls) > 0 {
+			for _, s := range x.MsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AccountQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountQuota))
+		}
+		if len(x.EpochBudget) > 0 {
+			for _, e := range x.EpochBudget {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EpochDuration != nil {
+			l = options.Size(x.EpochDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FundingAccount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSponsorshipPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FundingAccount) > 0 {
+			i -= len(x.FundingAccount)
+			copy(dAtA[i:], x.FundingAccount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FundingAccount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.EpochDuration != nil {
+			encoded, err := options.Marshal(x.EpochDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.EpochBudget) > 0 {
+			for iNdEx := len(x.EpochBudget) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochBudget[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.AccountQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountQuota))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MsgTypeUrls) > 0 {
+			for iNdEx := len(x.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.MsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSponsorshipPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSponsorshipPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrls = append(x.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountQuota", wireType)
+				}
+				x.AccountQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountQuota |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochBudget", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochBudget = append(x.EpochBudget, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochBudget[len(x.EpochBudget)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EpochDuration == nil {
+					x.EpochDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingAccount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FundingAccount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RelayerParams_6_list)(nil)
+
+type _RelayerParams_6_list struct {
+	list *[]string
+}
+
+func (x *_RelayerParams_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RelayerParams_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RelayerParams_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RelayerParams_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RelayerParams_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RelayerParams at list field GrandfatheredRelayers as it is not of Message kind"))
+}
+
+func (x *_RelayerParams_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RelayerParams_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RelayerParams_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RelayerParams                           protoreflect.MessageDescriptor
+	fd_RelayerParams_min_bond                  protoreflect.FieldDescriptor
+	fd_RelayerParams_deposit_reward_percentage protoreflect.FieldDescriptor
+	fd_RelayerParams_slash_percentage          protoreflect.FieldDescriptor
+	fd_RelayerParams_unbonding_period          protoreflect.FieldDescriptor
+	fd_RelayerParams_rune_etching_quorum       protoreflect.FieldDescriptor
+	fd_RelayerParams_grandfathered_relayers    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_RelayerParams = File_bitway_btcbridge_params_proto.Messages().ByName("RelayerParams")
+	fd_RelayerParams_min_bond = md_RelayerParams.Fields().ByName("min_bond")
+	fd_RelayerParams_deposit_reward_percentage = md_RelayerParams.Fields().ByName("deposit_reward_percentage")
+	fd_RelayerParams_slash_percentage = md_RelayerParams.Fields().ByName("slash_percentage")
+	fd_RelayerParams_unbonding_period = md_RelayerParams.Fields().ByName("unbonding_period")
+	fd_RelayerParams_rune_etching_quorum = md_RelayerParams.Fields().ByName("rune_etching_quorum")
+	fd_RelayerParams_grandfathered_relayers = md_RelayerParams.Fields().ByName("grandfathered_relayers")
+}
+
+var _ protoreflect.Message = (*fastReflection_RelayerParams)(nil)
+
+type fastReflection_RelayerParams RelayerParams
+
+func (x *RelayerParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RelayerParams)(x)
+}
+
+func (x *RelayerParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RelayerParams_messageType fastReflection_RelayerParams_messageType
+var _ protoreflect.MessageType = fastReflection_RelayerParams_messageType{}
+
+type fastReflection_RelayerParams_messageType struct{}
+
+func (x fastReflection_RelayerParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RelayerParams)(nil)
+}
+func (x fastReflection_RelayerParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_RelayerParams)
+}
+func (x fastReflection_RelayerParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RelayerParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RelayerParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_RelayerParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RelayerParams) Type() protoreflect.MessageType {
+	return _fastReflection_RelayerParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RelayerParams) New() protoreflect.Message {
+	return new(fastReflection_RelayerParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RelayerParams) Interface() protoreflect.ProtoMessage {
+	return (*RelayerParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RelayerParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinBond != nil {
+		value := protoreflect.ValueOfMessage(x.MinBond.ProtoReflect())
+		if !f(fd_RelayerParams_min_bond, value) {
+			return
+		}
+	}
+	if x.DepositRewardPercentage != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DepositRewardPercentage)
+		if !f(fd_RelayerParams_deposit_reward_percentage, value) {
+			return
+		}
+	}
+	if x.SlashPercentage != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SlashPercentage)
+		if !f(fd_RelayerParams_slash_percentage, value) {
+			return
+		}
+	}
+	if x.UnbondingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.UnbondingPeriod.ProtoReflect())
+		if !f(fd_RelayerParams_unbonding_period, value) {
+			return
+		}
+	}
+	if x.RuneEtchingQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RuneEtchingQuorum)
+		if !f(fd_RelayerParams_rune_etching_quorum, value) {
+			return
+		}
+	}
+	if len(x.GrandfatheredRelayers) != 0 {
+		value := protoreflect.ValueOfList(&_RelayerParams_6_list{list: &x.GrandfatheredRelayers})
+		if !f(fd_RelayerParams_grandfathered_relayers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RelayerParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		return x.MinBond != nil
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		return x.DepositRewardPercentage != uint32(0)
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		return x.SlashPercentage != uint32(0)
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		return x.UnbondingPeriod != nil
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		return x.RuneEtchingQuorum != uint32(0)
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		return len(x.GrandfatheredRelayers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RelayerParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		x.MinBond = nil
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		x.DepositRewardPercentage = uint32(0)
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		x.SlashPercentage = uint32(0)
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		x.UnbondingPeriod = nil
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		x.RuneEtchingQuorum = uint32(0)
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		x.GrandfatheredRelayers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RelayerParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		value := x.MinBond
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		value := x.DepositRewardPercentage
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		value := x.SlashPercentage
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		value := x.UnbondingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		value := x.RuneEtchingQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		if len(x.GrandfatheredRelayers) == 0 {
+			return protoreflect.ValueOfList(&_RelayerParams_6_list{})
+		}
+		listValue := &_RelayerParams_6_list{list: &x.GrandfatheredRelayers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RelayerParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		x.MinBond = value.Message().Interface().(*v1beta1.Coin)
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		x.DepositRewardPercentage = uint32(value.Uint())
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		x.SlashPercentage = uint32(value.Uint())
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		x.UnbondingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		x.RuneEtchingQuorum = uint32(value.Uint())
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		lv := value.List()
+		clv := lv.(*_RelayerParams_6_list)
+		x.GrandfatheredRelayers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RelayerParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		if x.MinBond == nil {
+			x.MinBond = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinBond.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		if x.UnbondingPeriod == nil {
+			x.UnbondingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.UnbondingPeriod.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		if x.GrandfatheredRelayers == nil {
+			x.GrandfatheredRelayers = []string{}
+		}
+		value := &_RelayerParams_6_list{list: &x.GrandfatheredRelayers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		panic(fmt.Errorf("field deposit_reward_percentage of message bitway.btcbridge.RelayerParams is not mutable"))
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		panic(fmt.Errorf("field slash_percentage of message bitway.btcbridge.RelayerParams is not mutable"))
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		panic(fmt.Errorf("field rune_etching_quorum of message bitway.btcbridge.RelayerParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RelayerParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RelayerParams.min_bond":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.deposit_reward_percentage":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.RelayerParams.slash_percentage":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.RelayerParams.unbonding_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.RelayerParams.rune_etching_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.RelayerParams.grandfathered_relayers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RelayerParams_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RelayerParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RelayerParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RelayerParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RelayerParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RelayerParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RelayerParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RelayerParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RelayerParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RelayerParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinBond != nil {
+			l = options.Size(x.MinBond)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositRewardPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.DepositRewardPercentage))
+		}
+		if x.SlashPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashPercentage))
+		}
+		if x.UnbondingPeriod != nil {
+			l = options.Size(x.UnbondingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RuneEtchingQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.RuneEtchingQuorum))
+		}
+		if len(x.GrandfatheredRelayers) > 0 {
+			for _, s := range x.GrandfatheredRelayers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RelayerParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GrandfatheredRelayers) > 0 {
+			for iNdEx := len(x.GrandfatheredRelayers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GrandfatheredRelayers[iNdEx])
+				copy(dAtA[i:], x.GrandfatheredRelayers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrandfatheredRelayers[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.RuneEtchingQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RuneEtchingQuorum))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.UnbondingPeriod != nil {
+			encoded, err := options.Marshal(x.UnbondingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.SlashPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashPercentage))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DepositRewardPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositRewardPercentage))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MinBond != nil {
+			encoded, err := options.Marshal(x.MinBond)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RelayerParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RelayerParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RelayerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinBond == nil {
+					x.MinBond = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinBond); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositRewardPercentage", wireType)
+				}
+				x.DepositRewardPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DepositRewardPercentage |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashPercentage", wireType)
+				}
+				x.SlashPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashPercentage |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnbondingPeriod == nil {
+					x.UnbondingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneEtchingQuorum", wireType)
+				}
+				x.RuneEtchingQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RuneEtchingQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrandfatheredRelayers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrandfatheredRelayers = append(x.GrandfatheredRelayers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GuardianParams                    protoreflect.MessageDescriptor
+	fd_GuardianParams_guardian           protoreflect.FieldDescriptor
+	fd_GuardianParams_max_pause_duration protoreflect.FieldDescriptor
+	fd_GuardianParams_pause_cooldown     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_GuardianParams = File_bitway_btcbridge_params_proto.Messages().ByName("GuardianParams")
+	fd_GuardianParams_guardian = md_GuardianParams.Fields().ByName("guardian")
+	fd_GuardianParams_max_pause_duration = md_GuardianParams.Fields().ByName("max_pause_duration")
+	fd_GuardianParams_pause_cooldown = md_GuardianParams.Fields().ByName("pause_cooldown")
+}
+
+var _ protoreflect.Message = (*fastReflection_GuardianParams)(nil)
+
+type fastReflection_GuardianParams GuardianParams
+
+func (x *GuardianParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GuardianParams)(x)
+}
+
+func (x *GuardianParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GuardianParams_messageType fastReflection_GuardianParams_messageType
+var _ protoreflect.MessageType = fastReflection_GuardianParams_messageType{}
+
+type fastReflection_GuardianParams_messageType struct{}
+
+func (x fastReflection_GuardianParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GuardianParams)(nil)
+}
+func (x fastReflection_GuardianParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_GuardianParams)
+}
+func (x fastReflection_GuardianParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GuardianParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GuardianParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_GuardianParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GuardianParams) Type() protoreflect.MessageType {
+	return _fastReflection_GuardianParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GuardianParams) New() protoreflect.Message {
+	return new(fastReflection_GuardianParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GuardianParams) Interface() protoreflect.ProtoMessage {
+	return (*GuardianParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GuardianParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Guardian != "" {
+		value := protoreflect.ValueOfString(x.Guardian)
+		if !f(fd_GuardianParams_guardian, value) {
+			return
+		}
+	}
+	if x.MaxPauseDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxPauseDuration.ProtoReflect())
+		if !f(fd_GuardianParams_max_pause_duration, value) {
+			return
+		}
+	}
+	if x.PauseCooldown != nil {
+		value := protoreflect.ValueOfMessage(x.PauseCooldown.ProtoReflect())
+		if !f(fd_GuardianParams_pause_cooldown, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GuardianParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GuardianParams.guardian":
+		return x.Guardian != ""
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		return x.MaxPauseDuration != nil
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		return x.PauseCooldown != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GuardianParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GuardianParams.guardian":
+		x.Guardian = ""
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		x.MaxPauseDuration = nil
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		x.PauseCooldown = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GuardianParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.GuardianParams.guardian":
+		value := x.Guardian
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		value := x.MaxPauseDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		value := x.PauseCooldown
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GuardianParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GuardianParams.guardian":
+		x.Guardian = value.Interface().(string)
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		x.MaxPauseDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		x.PauseCooldown = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GuardianParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		if x.MaxPauseDuration == nil {
+			x.MaxPauseDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxPauseDuration.ProtoReflect())
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		if x.PauseCooldown == nil {
+			x.PauseCooldown = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.PauseCooldown.ProtoReflect())
+	case "bitway.btcbridge.GuardianParams.guardian":
+		panic(fmt.Errorf("field guardian of message bitway.btcbridge.GuardianParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GuardianParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.GuardianParams.guardian":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.GuardianParams.max_pause_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.btcbridge.GuardianParams.pause_cooldown":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.GuardianParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.GuardianParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GuardianParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.GuardianParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GuardianParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GuardianParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GuardianParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GuardianParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GuardianParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Guardian)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxPauseDuration != nil {
+			l = options.Size(x.MaxPauseDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PauseCooldown != nil {
+			l = options.Size(x.PauseCooldown)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GuardianParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PauseCooldown != nil {
+			encoded, err := options.Marshal(x.PauseCooldown)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxPauseDuration != nil {
+			encoded, err := options.Marshal(x.MaxPauseDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Guardian) > 0 {
+			i -= len(x.Guardian)
+			copy(dAtA[i:], x.Guardian)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Guardian)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GuardianParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GuardianParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GuardianParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Guardian = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPauseDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxPauseDuration == nil {
+					x.MaxPauseDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxPauseDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseCooldown", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PauseCooldown == nil {
+					x.PauseCooldown = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauseCooldown); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DepositConfirmationParams_1_list)(nil)
+
+type _DepositConfirmationParams_1_list struct {
+	list *[]*DepositConfirmationTier
+}
+
+func (x *_DepositConfirmationParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositConfirmationParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositConfirmationTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositConfirmationParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositConfirmationTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositConfirmationParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(DepositConfirmationTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositConfirmationParams_1_list) NewElement() protoreflect.Value {
+	v := new(DepositConfirmationTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DepositConfirmationParams_2_list)(nil)
+
+type _DepositConfirmationParams_2_list struct {
+	list *[]*RunesPriceSymbol
+}
+
+func (x *_DepositConfirmationParams_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositConfirmationParams_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RunesPriceSymbol)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositConfirmationParams_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RunesPriceSymbol)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositConfirmationParams_2_list) AppendMutable() protoreflect.Value {
+	v := new(RunesPriceSymbol)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositConfirmationParams_2_list) NewElement() protoreflect.Value {
+	v := new(RunesPriceSymbol)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositConfirmationParams_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DepositConfirmationParams                     protoreflect.MessageDescriptor
+	fd_DepositConfirmationParams_tiers               protoreflect.FieldDescriptor
+	fd_DepositConfirmationParams_runes_price_symbols protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_DepositConfirmationParams = File_bitway_btcbridge_params_proto.Messages().ByName("DepositConfirmationParams")
+	fd_DepositConfirmationParams_tiers = md_DepositConfirmationParams.Fields().ByName("tiers")
+	fd_DepositConfirmationParams_runes_price_symbols = md_DepositConfirmationParams.Fields().ByName("runes_price_symbols")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositConfirmationParams)(nil)
+
+type fastReflection_DepositConfirmationParams DepositConfirmationParams
+
+func (x *DepositConfirmationParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositConfirmationParams)(x)
+}
+
+func (x *DepositConfirmationParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositConfirmationParams_messageType fastReflection_DepositConfirmationParams_messageType
+var _ protoreflect.MessageType = fastReflection_DepositConfirmationParams_messageType{}
+
+type fastReflection_DepositConfirmationParams_messageType struct{}
+
+func (x fastReflection_DepositConfirmationParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositConfirmationParams)(nil)
+}
+func (x fastReflection_DepositConfirmationParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositConfirmationParams)
+}
+func (x fastReflection_DepositConfirmationParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositConfirmationParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositConfirmationParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositConfirmationParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositConfirmationParams) Type() protoreflect.MessageType {
+	return _fastReflection_DepositConfirmationParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositConfirmationParams) New() protoreflect.Message {
+	return new(fastReflection_DepositConfirmationParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositConfirmationParams) Interface() protoreflect.ProtoMessage {
+	return (*DepositConfirmationParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositConfirmationParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Tiers) != 0 {
+		value := protoreflect.ValueOfList(&_DepositConfirmationParams_1_list{list: &x.Tiers})
+		if !f(fd_DepositConfirmationParams_tiers, value) {
+			return
+		}
+	}
+	if len(x.RunesPriceSymbols) != 0 {
+		value := protoreflect.ValueOfList(&_DepositConfirmationParams_2_list{list: &x.RunesPriceSymbols})
+		if !f(fd_DepositConfirmationParams_runes_price_symbols, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositConfirmationParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		return len(x.Tiers) != 0
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		return len(x.RunesPriceSymbols) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		x.Tiers = nil
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		x.RunesPriceSymbols = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositConfirmationParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		if len(x.Tiers) == 0 {
+			return protoreflect.ValueOfList(&_DepositConfirmationParams_1_list{})
+		}
+		listValue := &_DepositConfirmationParams_1_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		if len(x.RunesPriceSymbols) == 0 {
+			return protoreflect.ValueOfList(&_DepositConfirmationParams_2_list{})
+		}
+		listValue := &_DepositConfirmationParams_2_list{list: &x.RunesPriceSymbols}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		lv := value.List()
+		clv := lv.(*_DepositConfirmationParams_1_list)
+		x.Tiers = *clv.list
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		lv := value.List()
+		clv := lv.(*_DepositConfirmationParams_2_list)
+		x.RunesPriceSymbols = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		if x.Tiers == nil {
+			x.Tiers = []*DepositConfirmationTier{}
+		}
+		value := &_DepositConfirmationParams_1_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		if x.RunesPriceSymbols == nil {
+			x.RunesPriceSymbols = []*RunesPriceSymbol{}
+		}
+		value := &_DepositConfirmationParams_2_list{list: &x.RunesPriceSymbols}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositConfirmationParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationParams.tiers":
+		list := []*DepositConfirmationTier{}
+		return protoreflect.ValueOfList(&_DepositConfirmationParams_1_list{list: &list})
+	case "bitway.btcbridge.DepositConfirmationParams.runes_price_symbols":
+		list := []*RunesPriceSymbol{}
+		return protoreflect.ValueOfList(&_DepositConfirmationParams_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositConfirmationParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DepositConfirmationParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositConfirmationParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositConfirmationParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositConfirmationParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositConfirmationParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Tiers) > 0 {
+			for _, e := range x.Tiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RunesPriceSymbols) > 0 {
+			for _, e := range x.RunesPriceSymbols {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositConfirmationParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RunesPriceSymbols) > 0 {
+			for iNdEx := len(x.RunesPriceSymbols) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RunesPriceSymbols[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Tiers) > 0 {
+			for iNdEx := len(x.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositConfirmationParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositConfirmationParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositConfirmationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tiers = append(x.Tiers, &DepositConfirmationTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tiers[len(x.Tiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RunesPriceSymbols", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RunesPriceSymbols = append(x.RunesPriceSymbols, &RunesPriceSymbol{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RunesPriceSymbols[len(x.RunesPriceSymbols)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DepositConfirmationTier                    protoreflect.MessageDescriptor
+	fd_DepositConfirmationTier_min_amount         protoreflect.FieldDescriptor
+	fd_DepositConfirmationTier_confirmation_depth protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_DepositConfirmationTier = File_bitway_btcbridge_params_proto.Messages().ByName("DepositConfirmationTier")
+	fd_DepositConfirmationTier_min_amount = md_DepositConfirmationTier.Fields().ByName("min_amount")
+	fd_DepositConfirmationTier_confirmation_depth = md_DepositConfirmationTier.Fields().ByName("confirmation_depth")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositConfirmationTier)(nil)
+
+type fastReflection_DepositConfirmationTier DepositConfirmationTier
+
+func (x *DepositConfirmationTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositConfirmationTier)(x)
+}
+
+func (x *DepositConfirmationTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositConfirmationTier_messageType fastReflection_DepositConfirmationTier_messageType
+var _ protoreflect.MessageType = fastReflection_DepositConfirmationTier_messageType{}
+
+type fastReflection_DepositConfirmationTier_messageType struct{}
+
+func (x fastReflection_DepositConfirmationTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositConfirmationTier)(nil)
+}
+func (x fastReflection_DepositConfirmationTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositConfirmationTier)
+}
+func (x fastReflection_DepositConfirmationTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositConfirmationTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositConfirmationTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositConfirmationTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositConfirmationTier) Type() protoreflect.MessageType {
+	return _fastReflection_DepositConfirmationTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositConfirmationTier) New() protoreflect.Message {
+	return new(fastReflection_DepositConfirmationTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositConfirmationTier) Interface() protoreflect.ProtoMessage {
+	return (*DepositConfirmationTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositConfirmationTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinAmount)
+		if !f(fd_DepositConfirmationTier_min_amount, value) {
+			return
+		}
+	}
+	if x.ConfirmationDepth != int32(0) {
+		value := protoreflect.ValueOfInt32(x.ConfirmationDepth)
+		if !f(fd_DepositConfirmationTier_confirmation_depth, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositConfirmationTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		return x.MinAmount != int64(0)
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		return x.ConfirmationDepth != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		x.MinAmount = int64(0)
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		x.ConfirmationDepth = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositConfirmationTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		value := x.MinAmount
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		value := x.ConfirmationDepth
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		x.MinAmount = value.Int()
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		x.ConfirmationDepth = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		panic(fmt.Errorf("field min_amount of message bitway.btcbridge.DepositConfirmationTier is not mutable"))
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		panic(fmt.Errorf("field confirmation_depth of message bitway.btcbridge.DepositConfirmationTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositConfirmationTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.DepositConfirmationTier.min_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.btcbridge.DepositConfirmationTier.confirmation_depth":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.DepositConfirmationTier"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.DepositConfirmationTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositConfirmationTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.DepositConfirmationTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositConfirmationTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositConfirmationTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositConfirmationTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositConfirmationTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositConfirmationTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinAmount))
+		}
+		if x.ConfirmationDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConfirmationDepth))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositConfirmationTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ConfirmationDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConfirmationDepth))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MinAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinAmount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositConfirmationTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositConfirmationTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+				}
+				x.MinAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfirmationDepth", wireType)
+				}
+				x.ConfirmationDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConfirmationDepth |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RunesPriceSymbol              protoreflect.MessageDescriptor
+	fd_RunesPriceSymbol_id           protoreflect.FieldDescriptor
+	fd_RunesPriceSymbol_symbol       protoreflect.FieldDescriptor
+	fd_RunesPriceSymbol_divisibility protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_RunesPriceSymbol = File_bitway_btcbridge_params_proto.Messages().ByName("RunesPriceSymbol")
+	fd_RunesPriceSymbol_id = md_RunesPriceSymbol.Fields().ByName("id")
+	fd_RunesPriceSymbol_symbol = md_RunesPriceSymbol.Fields().ByName("symbol")
+	fd_RunesPriceSymbol_divisibility = md_RunesPriceSymbol.Fields().ByName("divisibility")
+}
+
+var _ protoreflect.Message = (*fastReflection_RunesPriceSymbol)(nil)
+
+type fastReflection_RunesPriceSymbol RunesPriceSymbol
+
+func (x *RunesPriceSymbol) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RunesPriceSymbol)(x)
+}
+
+func (x *RunesPriceSymbol) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RunesPriceSymbol_messageType fastReflection_RunesPriceSymbol_messageType
+var _ protoreflect.MessageType = fastReflection_RunesPriceSymbol_messageType{}
+
+type fastReflection_RunesPriceSymbol_messageType struct{}
+
+func (x fastReflection_RunesPriceSymbol_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RunesPriceSymbol)(nil)
+}
+func (x fastReflection_RunesPriceSymbol_messageType) New() protoreflect.Message {
+	return new(fastReflection_RunesPriceSymbol)
+}
+func (x fastReflection_RunesPriceSymbol_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesPriceSymbol
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RunesPriceSymbol) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesPriceSymbol
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RunesPriceSymbol) Type() protoreflect.MessageType {
+	return _fastReflection_RunesPriceSymbol_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RunesPriceSymbol) New() protoreflect.Message {
+	return new(fastReflection_RunesPriceSymbol)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RunesPriceSymbol) Interface() protoreflect.ProtoMessage {
+	return (*RunesPriceSymbol)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RunesPriceSymbol) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_RunesPriceSymbol_id, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_RunesPriceSymbol_symbol, value) {
+			return
+		}
+	}
+	if x.Divisibility != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Divisibility)
+		if !f(fd_RunesPriceSymbol_divisibility, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RunesPriceSymbol) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		return x.Id != ""
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		return x.Symbol != ""
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		return x.Divisibility != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesPriceSymbol) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		x.Id = ""
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		x.Symbol = ""
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		x.Divisibility = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RunesPriceSymbol) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		value := x.Divisibility
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesPriceSymbol) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		x.Id = value.Interface().(string)
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		x.Symbol = value.Interface().(string)
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		x.Divisibility = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesPriceSymbol) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		panic(fmt.Errorf("field id of message bitway.btcbridge.RunesPriceSymbol is not mutable"))
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		panic(fmt.Errorf("field symbol of message bitway.btcbridge.RunesPriceSymbol is not mutable"))
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		panic(fmt.Errorf("field divisibility of message bitway.btcbridge.RunesPriceSymbol is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RunesPriceSymbol) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.RunesPriceSymbol.id":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesPriceSymbol.symbol":
+		return protoreflect.ValueOfString("")
+	case "bitway.btcbridge.RunesPriceSymbol.divisibility":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.RunesPriceSymbol"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.RunesPriceSymbol does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RunesPriceSymbol) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.RunesPriceSymbol", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RunesPriceSymbol) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesPriceSymbol) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RunesPriceSymbol) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RunesPriceSymbol) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RunesPriceSymbol)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Divisibility != 0 {
+			n += 1 + runtime.Sov(uint64(x.Divisibility))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RunesPriceSymbol)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Divisibility != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Divisibility))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RunesPriceSymbol)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesPriceSymbol: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesPriceSymbol: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
+				}
+				x.Divisibility = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Divisibility |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VaultRecoveryParams_1_list)(nil)
+
+type _VaultRecoveryParams_1_list struct {
+	list *[]string
+}
+
+func (x *_VaultRecoveryParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultRecoveryParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VaultRecoveryParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultRecoveryParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultRecoveryParams_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultRecoveryParams at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_VaultRecoveryParams_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultRecoveryParams_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VaultRecoveryParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VaultRecoveryParams           protoreflect.MessageDescriptor
+	fd_VaultRecoveryParams_pub_keys  protoreflect.FieldDescriptor
+	fd_VaultRecoveryParams_threshold protoreflect.FieldDescriptor
+	fd_VaultRecoveryParams_timelock  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_btcbridge_params_proto_init()
+	md_VaultRecoveryParams = File_bitway_btcbridge_params_proto.Messages().ByName("VaultRecoveryParams")
+	fd_VaultRecoveryParams_pub_keys = md_VaultRecoveryParams.Fields().ByName("pub_keys")
+	fd_VaultRecoveryParams_threshold = md_VaultRecoveryParams.Fields().ByName("threshold")
+	fd_VaultRecoveryParams_timelock = md_VaultRecoveryParams.Fields().ByName("timelock")
+}
+
+var _ protoreflect.Message = (*fastReflection_VaultRecoveryParams)(nil)
+
+type fastReflection_VaultRecoveryParams VaultRecoveryParams
+
+func (x *VaultRecoveryParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultRecoveryParams)(x)
+}
+
+func (x *VaultRecoveryParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_btcbridge_params_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VaultRecoveryParams_messageType fastReflection_VaultRecoveryParams_messageType
+var _ protoreflect.MessageType = fastReflection_VaultRecoveryParams_messageType{}
+
+type fastReflection_VaultRecoveryParams_messageType struct{}
+
+func (x fastReflection_VaultRecoveryParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultRecoveryParams)(nil)
+}
+func (x fastReflection_VaultRecoveryParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultRecoveryParams)
+}
+func (x fastReflection_VaultRecoveryParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecoveryParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VaultRecoveryParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecoveryParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VaultRecoveryParams) Type() protoreflect.MessageType {
+	return _fastReflection_VaultRecoveryParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VaultRecoveryParams) New() protoreflect.Message {
+	return new(fastReflection_VaultRecoveryParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VaultRecoveryParams) Interface() protoreflect.ProtoMessage {
+	return (*VaultRecoveryParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VaultRecoveryParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_VaultRecoveryParams_1_list{list: &x.PubKeys})
+		if !f(fd_VaultRecoveryParams_pub_keys, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_VaultRecoveryParams_threshold, value) {
+			return
+		}
+	}
+	if x.Timelock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Timelock)
+		if !f(fd_VaultRecoveryParams_timelock, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VaultRecoveryParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		return len(x.PubKeys) != 0
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		return x.Threshold != uint32(0)
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		return x.Timelock != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultRecoveryParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		x.PubKeys = nil
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		x.Threshold = uint32(0)
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		x.Timelock = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VaultRecoveryParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_VaultRecoveryParams_1_list{})
+		}
+		listValue := &_VaultRecoveryParams_1_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		value := x.Timelock
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultRecoveryParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		lv := value.List()
+		clv := lv.(*_VaultRecoveryParams_1_list)
+		x.PubKeys = *clv.list
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		x.Timelock = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultRecoveryParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_VaultRecoveryParams_1_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		panic(fmt.Errorf("field threshold of message bitway.btcbridge.VaultRecoveryParams is not mutable"))
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		panic(fmt.Errorf("field timelock of message bitway.btcbridge.VaultRecoveryParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VaultRecoveryParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.btcbridge.VaultRecoveryParams.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VaultRecoveryParams_1_list{list: &list})
+	case "bitway.btcbridge.VaultRecoveryParams.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.btcbridge.VaultRecoveryParams.timelock":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.btcbridge.VaultRecoveryParams"))
+		}
+		panic(fmt.Errorf("message bitway.btcbridge.VaultRecoveryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VaultRecoveryParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.btcbridge.VaultRecoveryParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VaultRecoveryParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultRecoveryParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VaultRecoveryParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VaultRecoveryParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VaultRecoveryParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.Timelock != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timelock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VaultRecoveryParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Timelock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timelock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VaultRecoveryParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecoveryParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecoveryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+				}
+				x.Timelock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timelock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: bitway/btcbridge/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssetType defines the type of asset
+type AssetType int32
+
+const (
+	// Unspecified asset type
+	AssetType_ASSET_TYPE_UNSPECIFIED AssetType = 0
+	// BTC
+	AssetType_ASSET_TYPE_BTC AssetType = 1
+	// BRC20: ordi, sats
+	AssetType_ASSET_TYPE_BRC20 AssetType = 2
+	// RUNE: dog•go•to•the•moon
+	AssetType_ASSET_TYPE_RUNES AssetType = 3
+)
+
+// Enum value maps for AssetType.
+var (
+	AssetType_name = map[int32]string{
+		0: "ASSET_TYPE_UNSPECIFIED",
+		1: "ASSET_TYPE_BTC",
+		2: "ASSET_TYPE_BRC20",
+		3: "ASSET_TYPE_RUNES",
+	}
+	AssetType_value = map[string]int32{
+		"ASSET_TYPE_UNSPECIFIED": 0,
+		"ASSET_TYPE_BTC":         1,
+		"ASSET_TYPE_BRC20":       2,
+		"ASSET_TYPE_RUNES":       3,
+	}
+)
+
+func (x AssetType) Enum() *AssetType {
+	p := new(AssetType)
+	*p = x
+	return p
+}
+
+func (x AssetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_btcbridge_params_proto_enumTypes[0].Descriptor()
+}
+
+func (AssetType) Type() protoreflect.EnumType {
+	return &file_bitway_btcbridge_params_proto_enumTypes[0]
+}
+
+func (x AssetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetType.Descriptor instead.
+func (AssetType) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum number of confirmations required for the withdrawal transactions
+	WithdrawConfirmationDepth int32 `protobuf:"varint,2,opt,name=withdraw_confirmation_depth,json=withdrawConfirmationDepth,proto3" json:"withdraw_confirmation_depth,omitempty"`
+	// Indicates the maximum depth or distance from the latest block up to which transactions are considered for acceptance.
+	MaxAcceptableBlockDepth uint64 `protobuf:"varint,3,opt,name=max_acceptable_block_depth,json=maxAcceptableBlockDepth,proto3" json:"max_acceptable_block_depth,omitempty"`
+	// The denomination of the voucher
+	BtcVoucherDenom string `protobuf:"bytes,4,opt,name=btc_voucher_denom,json=btcVoucherDenom,proto3" json:"btc_voucher_denom,omitempty"`
+	// Indicates if deposit is enabled
+	DepositEnabled bool `protobuf:"varint,5,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	// Indicates if withdrawal is enabled
+	WithdrawEnabled bool `protobuf:"varint,6,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	// Trusted fee providers to submit bitcoin fee rate
+	TrustedFeeProviders []string `protobuf:"bytes,8,rep,name=trusted_fee_providers,json=trustedFeeProviders,proto3" json:"trusted_fee_providers,omitempty"`
+	// Period of validity for the fee rate
+	FeeRateValidityPeriod int64 `protobuf:"varint,9,opt,name=fee_rate_validity_period,json=feeRateValidityPeriod,proto3" json:"fee_rate_validity_period,omitempty"`
+	// Asset vaults
+	Vaults []*Vault `protobuf:"bytes,10,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// Withdrawal params
+	WithdrawParams *WithdrawParams `protobuf:"bytes,11,opt,name=withdraw_params,json=withdrawParams,proto3" json:"withdraw_params,omitempty"`
+	// Protocol limitations
+	ProtocolLimits *ProtocolLimits `protobuf:"bytes,12,opt,name=protocol_limits,json=protocolLimits,proto3" json:"protocol_limits,omitempty"`
+	// Protocol fees
+	ProtocolFees *ProtocolFees `protobuf:"bytes,13,opt,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees,omitempty"`
+	// TSS params
+	TssParams *TSSParams `protobuf:"bytes,14,opt,name=tss_params,json=tssParams,proto3" json:"tss_params,omitempty"`
+	// Rate limit params
+	RateLimitParams *RateLimitParams `protobuf:"bytes,15,opt,name=rate_limit_params,json=rateLimitParams,proto3" json:"rate_limit_params,omitempty"`
+	// IBC params
+	IbcParams *IBCParams `protobuf:"bytes,16,opt,name=ibc_params,json=ibcParams,proto3" json:"ibc_params,omitempty"`
+	// Fee sponsorship params
+	FeeSponsorshipParams *FeeSponsorshipParams `protobuf:"bytes,17,opt,name=fee_sponsorship_params,json=feeSponsorshipParams,proto3" json:"fee_sponsorship_params,omitempty"`
+	// Relayer params
+	RelayerParams *RelayerParams `protobuf:"bytes,18,opt,name=relayer_params,json=relayerParams,proto3" json:"relayer_params,omitempty"`
+	// Guardian params
+	GuardianParams *GuardianParams `protobuf:"bytes,19,opt,name=guardian_params,json=guardianParams,proto3" json:"guardian_params,omitempty"`
+	// Deposit confirmation params
+	DepositConfirmationParams *DepositConfirmationParams `protobuf:"bytes,20,opt,name=deposit_confirmation_params,json=depositConfirmationParams,proto3" json:"deposit_confirmation_params,omitempty"`
+	// Vault recovery params
+	VaultRecoveryParams *VaultRecoveryParams `protobuf:"bytes,21,opt,name=vault_recovery_params,json=vaultRecoveryParams,proto3" json:"vault_recovery_params,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetWithdrawConfirmationDepth() int32 {
+	if x != nil {
+		return x.WithdrawConfirmationDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAcceptableBlockDepth() uint64 {
+	if x != nil {
+		return x.MaxAcceptableBlockDepth
+	}
+	return 0
+}
+
+func (x *Params) GetBtcVoucherDenom() string {
+	if x != nil {
+		return x.BtcVoucherDenom
+	}
+	return ""
+}
+
+func (x *Params) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *Params) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
 	return false
 }
 
-func (x *Params) GetWithdrawEnabled() bool {
+func (x *Params) GetTrustedFeeProviders() []string {
+	if x != nil {
+		return x.TrustedFeeProviders
+	}
+	return nil
+}
+
+func (x *Params) GetFeeRateValidityPeriod() int64 {
+	if x != nil {
+		return x.FeeRateValidityPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetVaults() []*Vault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+func (x *Params) GetWithdrawParams() *WithdrawParams {
+	if x != nil {
+		return x.WithdrawParams
+	}
+	return nil
+}
+
+func (x *Params) GetProtocolLimits() *ProtocolLimits {
+	if x != nil {
+		return x.ProtocolLimits
+	}
+	return nil
+}
+
+func (x *Params) GetProtocolFees() *ProtocolFees {
+	if x != nil {
+		return x.ProtocolFees
+	}
+	return nil
+}
+
+func (x *Params) GetTssParams() *TSSParams {
+	if x != nil {
+		return x.TssParams
+	}
+	return nil
+}
+
+func (x *Params) GetRateLimitParams() *RateLimitParams {
+	if x != nil {
+		return x.RateLimitParams
+	}
+	return nil
+}
+
+func (x *Params) GetIbcParams() *IBCParams {
+	if x != nil {
+		return x.IbcParams
+	}
+	return nil
+}
+
+func (x *Params) GetFeeSponsorshipParams() *FeeSponsorshipParams {
+	if x != nil {
+		return x.FeeSponsorshipParams
+	}
+	return nil
+}
+
+func (x *Params) GetRelayerParams() *RelayerParams {
+	if x != nil {
+		return x.RelayerParams
+	}
+	return nil
+}
+
+func (x *Params) GetGuardianParams() *GuardianParams {
+	if x != nil {
+		return x.GuardianParams
+	}
+	return nil
+}
+
+func (x *Params) GetDepositConfirmationParams() *DepositConfirmationParams {
+	if x != nil {
+		return x.DepositConfirmationParams
+	}
+	return nil
+}
+
+func (x *Params) GetVaultRecoveryParams() *VaultRecoveryParams {
+	if x != nil {
+		return x.VaultRecoveryParams
+	}
+	return nil
+}
+
+// Vault defines the asset vault
+type Vault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the vault address for deposit
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// public key of the vault
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// the asset type supported by the vault
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// version
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// emergency recovery script path committed by the vault; empty if the vault is key path only
+	Recovery *VaultRecovery `protobuf:"bytes,5,opt,name=recovery,proto3" json:"recovery,omitempty"`
+}
+
+func (x *Vault) Reset() {
+	*x = Vault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vault) ProtoMessage() {}
+
+// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
+func (*Vault) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Vault) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Vault) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *Vault) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *Vault) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vault) GetRecovery() *VaultRecovery {
+	if x != nil {
+		return x.Recovery
+	}
+	return nil
+}
+
+// VaultRecovery defines the emergency recovery script path of the taproot vault
+type VaultRecovery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// x-only internal key of the vault generated by DKG
+	InternalKey string `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// recovery params committed by the vault
+	Params *VaultRecoveryParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *VaultRecovery) Reset() {
+	*x = VaultRecovery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRecovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRecovery) ProtoMessage() {}
+
+// Deprecated: Use VaultRecovery.ProtoReflect.Descriptor instead.
+func (*VaultRecovery) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultRecovery) GetInternalKey() string {
 	if x != nil {
-		return x.WithdrawEnabled
+		return x.InternalKey
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetTrustedNonBtcRelayers() []string {
+func (x *VaultRecovery) GetParams() *VaultRecoveryParams {
 	if x != nil {
-		return x.TrustedNonBtcRelayers
+		return x.Params
 	}
 	return nil
 }
 
-func (x *Params) GetTrustedFeeProviders() []string {
+type WithdrawParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of utxos used to build the signing request; O means unlimited
+	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
+	// Period for handling btc withdrawal requests
+	BtcBatchWithdrawPeriod int64 `protobuf:"varint,2,opt,name=btc_batch_withdraw_period,json=btcBatchWithdrawPeriod,proto3" json:"btc_batch_withdraw_period,omitempty"`
+	// Maximum number of btc withdrawal requests to be handled per batch
+	MaxBtcBatchWithdrawNum uint32 `protobuf:"varint,3,opt,name=max_btc_batch_withdraw_num,json=maxBtcBatchWithdrawNum,proto3" json:"max_btc_batch_withdraw_num,omitempty"`
+}
+
+func (x *WithdrawParams) Reset() {
+	*x = WithdrawParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawParams) ProtoMessage() {}
+
+// Deprecated: Use WithdrawParams.ProtoReflect.Descriptor instead.
+func (*WithdrawParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WithdrawParams) GetMaxUtxoNum() uint32 {
 	if x != nil {
-		return x.TrustedFeeProviders
+		return x.MaxUtxoNum
+	}
+	return 0
+}
+
+func (x *WithdrawParams) GetBtcBatchWithdrawPeriod() int64 {
+	if x != nil {
+		return x.BtcBatchWithdrawPeriod
+	}
+	return 0
+}
+
+func (x *WithdrawParams) GetMaxBtcBatchWithdrawNum() uint32 {
+	if x != nil {
+		return x.MaxBtcBatchWithdrawNum
+	}
+	return 0
+}
+
+// ProtocolLimits defines the params related to the the protocol limitations
+type ProtocolLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum deposit amount for btc in sat
+	BtcMinDeposit int64 `protobuf:"varint,1,opt,name=btc_min_deposit,json=btcMinDeposit,proto3" json:"btc_min_deposit,omitempty"`
+	// The minimum withdrawal amount for btc in sat
+	BtcMinWithdraw int64 `protobuf:"varint,2,opt,name=btc_min_withdraw,json=btcMinWithdraw,proto3" json:"btc_min_withdraw,omitempty"`
+	// The maximum withdrawal amount for btc in sat
+	BtcMaxWithdraw int64 `protobuf:"varint,3,opt,name=btc_max_withdraw,json=btcMaxWithdraw,proto3" json:"btc_max_withdraw,omitempty"`
+}
+
+func (x *ProtocolLimits) Reset() {
+	*x = ProtocolLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolLimits) ProtoMessage() {}
+
+// Deprecated: Use ProtocolLimits.ProtoReflect.Descriptor instead.
+func (*ProtocolLimits) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProtocolLimits) GetBtcMinDeposit() int64 {
+	if x != nil {
+		return x.BtcMinDeposit
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetBtcMinWithdraw() int64 {
+	if x != nil {
+		return x.BtcMinWithdraw
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetBtcMaxWithdraw() int64 {
+	if x != nil {
+		return x.BtcMaxWithdraw
+	}
+	return 0
+}
+
+// ProtocolFees defines the params related to the protocol fees
+type ProtocolFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Protocol fee amount for deposit in sat
+	DepositFee int64 `protobuf:"varint,1,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
+	// Protocol fee amount for withdrawal in sat
+	WithdrawFee int64 `protobuf:"varint,2,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
+	// Protocol fee collector
+	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+}
+
+func (x *ProtocolFees) Reset() {
+	*x = ProtocolFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolFees) ProtoMessage() {}
+
+// Deprecated: Use ProtocolFees.ProtoReflect.Descriptor instead.
+func (*ProtocolFees) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProtocolFees) GetDepositFee() int64 {
+	if x != nil {
+		return x.DepositFee
+	}
+	return 0
+}
+
+func (x *ProtocolFees) GetWithdrawFee() int64 {
+	if x != nil {
+		return x.WithdrawFee
+	}
+	return 0
+}
+
+func (x *ProtocolFees) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+// TSSParams defines the params related to TSS
+type TSSParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Timeout duration for DKG request
+	DkgTimeoutPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=dkg_timeout_period,json=dkgTimeoutPeriod,proto3" json:"dkg_timeout_period,omitempty"`
+	// Transition period after which TSS participants update process is completed
+	ParticipantUpdateTransitionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=participant_update_transition_period,json=participantUpdateTransitionPeriod,proto3" json:"participant_update_transition_period,omitempty"`
+}
+
+func (x *TSSParams) Reset() {
+	*x = TSSParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSParams) ProtoMessage() {}
+
+// Deprecated: Use TSSParams.ProtoReflect.Descriptor instead.
+func (*TSSParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TSSParams) GetDkgTimeoutPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DkgTimeoutPeriod
 	}
 	return nil
 }
 
-func (x *Params) GetFeeRateValidityPeriod() int64 {
-	if x != nil {
-		return x.FeeRateValidityPeriod
-	}
-	return 0
+func (x *TSSParams) GetParticipantUpdateTransitionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ParticipantUpdateTransitionPeriod
+	}
+	return nil
+}
+
+// RateLimitParams defines the params related to the rate limit for BTC withdrawal
+type RateLimitParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Global rate limit params
+	GlobalRateLimitParams *GlobalRateLimitParams `protobuf:"bytes,1,opt,name=global_rate_limit_params,json=globalRateLimitParams,proto3" json:"global_rate_limit_params,omitempty"`
+	// Per address rate limit params
+	AddressRateLimitParams *AddressRateLimitParams `protobuf:"bytes,2,opt,name=address_rate_limit_params,json=addressRateLimitParams,proto3" json:"address_rate_limit_params,omitempty"`
+}
+
+func (x *RateLimitParams) Reset() {
+	*x = RateLimitParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitParams) ProtoMessage() {}
+
+// Deprecated: Use RateLimitParams.ProtoReflect.Descriptor instead.
+func (*RateLimitParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Params) GetVaults() []*Vault {
+func (x *RateLimitParams) GetGlobalRateLimitParams() *GlobalRateLimitParams {
 	if x != nil {
-		return x.Vaults
+		return x.GlobalRateLimitParams
 	}
 	return nil
 }
 
-func (x *Params) GetWithdrawParams() *WithdrawParams {
+func (x *RateLimitParams) GetAddressRateLimitParams() *AddressRateLimitParams {
 	if x != nil {
-		return x.WithdrawParams
+		return x.AddressRateLimitParams
 	}
 	return nil
 }
 
-func (x *Params) GetProtocolLimits() *ProtocolLimits {
-	if x != nil {
-		return x.ProtocolLimits
-	}
-	return nil
+// GlobalRateLimitParams defines the global rate limit params
+type GlobalRateLimitParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Duration of each rate limit epoch
+	Period *durationpb.Duration `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Maximum withdrawable supply percentage during the rate limit period; 100 means no limit
+	SupplyPercentageQuota uint32 `protobuf:"varint,2,opt,name=supply_percentage_quota,json=supplyPercentageQuota,proto3" json:"supply_percentage_quota,omitempty"`
 }
 
-func (x *Params) GetProtocolFees() *ProtocolFees {
-	if x != nil {
-		return x.ProtocolFees
+func (x *GlobalRateLimitParams) Reset() {
+	*x = GlobalRateLimitParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Params) GetTssParams() *TSSParams {
-	if x != nil {
-		return x.TssParams
-	}
-	return nil
+func (x *GlobalRateLimitParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Params) GetRateLimitParams() *RateLimitParams {
-	if x != nil {
-		return x.RateLimitParams
-	}
-	return nil
+func (*GlobalRateLimitParams) ProtoMessage() {}
+
+// Deprecated: Use GlobalRateLimitParams.ProtoReflect.Descriptor instead.
+func (*GlobalRateLimitParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Params) GetIbcParams() *IBCParams {
+func (x *GlobalRateLimitParams) GetPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.IbcParams
+		return x.Period
 	}
 	return nil
 }
 
-func (x *Params) GetFeeSponsorshipParams() *FeeSponsorshipParams {
+func (x *GlobalRateLimitParams) GetSupplyPercentageQuota() uint32 {
 	if x != nil {
-		return x.FeeSponsorshipParams
+		return x.SupplyPercentageQuota
 	}
-	return nil
+	return 0
 }
 
-// Vault defines the asset vault
-type Vault struct {
+// AddressRateLimitParams defines the per address rate limit params
+type AddressRateLimitParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the vault address for deposit
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// public key of the vault
-	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// the asset type supported by the vault
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
-	// version
-	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Duration of each rate limit epoch
+	Period *durationpb.Duration `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Maximum withdrawable amount during the rate limit period; 0 means no limit
+	Quota int64 `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
-func (x *Vault) Reset() {
-	*x = Vault{}
+func (x *AddressRateLimitParams) Reset() {
+	*x = AddressRateLimitParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[1]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vault) String() string {
+func (x *AddressRateLimitParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vault) ProtoMessage() {}
-
-// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
-func (*Vault) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *Vault) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+func (*AddressRateLimitParams) ProtoMessage() {}
 
-func (x *Vault) GetPubKey() string {
-	if x != nil {
-		return x.PubKey
-	}
-	return ""
+// Deprecated: Use AddressRateLimitParams.ProtoReflect.Descriptor instead.
+func (*AddressRateLimitParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Vault) GetAssetType() AssetType {
+func (x *AddressRateLimitParams) GetPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.AssetType
+		return x.Period
 	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Vault) GetVersion() uint64 {
+func (x *AddressRateLimitParams) GetQuota() int64 {
 	if x != nil {
-		return x.Version
+		return x.Quota
 	}
 	return 0
 }
 
-type WithdrawParams struct {
+// IBCParams defines the params related to IBC
+type IBCParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maximum number of utxos used to build the signing request; O means unlimited
-	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
-	// Period for handling btc withdrawal requests
-	BtcBatchWithdrawPeriod int64 `protobuf:"varint,2,opt,name=btc_batch_withdraw_period,json=btcBatchWithdrawPeriod,proto3" json:"btc_batch_withdraw_period,omitempty"`
-	// Maximum number of btc withdrawal requests to be handled per batch
-	MaxBtcBatchWithdrawNum uint32 `protobuf:"varint,3,opt,name=max_btc_batch_withdraw_num,json=maxBtcBatchWithdrawNum,proto3" json:"max_btc_batch_withdraw_num,omitempty"`
+	// Timeout height offset relative to the current client height
+	TimeoutHeightOffset uint64 `protobuf:"varint,1,opt,name=timeout_height_offset,json=timeoutHeightOffset,proto3" json:"timeout_height_offset,omitempty"`
+	// Timeout duration relative to the current time
+	TimeoutDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
 }
 
-func (x *WithdrawParams) Reset() {
-	*x = WithdrawParams{}
+func (x *IBCParams) Reset() {
+	*x = IBCParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[2]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawParams) String() string {
+func (x *IBCParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawParams) ProtoMessage() {}
-
-// Deprecated: Use WithdrawParams.ProtoReflect.Descriptor instead.
-func (*WithdrawParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{2}
-}
+func (*IBCParams) ProtoMessage() {}
 
-func (x *WithdrawParams) GetMaxUtxoNum() uint32 {
-	if x != nil {
-		return x.MaxUtxoNum
-	}
-	return 0
+// Deprecated: Use IBCParams.ProtoReflect.Descriptor instead.
+func (*IBCParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WithdrawParams) GetBtcBatchWithdrawPeriod() int64 {
+func (x *IBCParams) GetTimeoutHeightOffset() uint64 {
 	if x != nil {
-		return x.BtcBatchWithdrawPeriod
+		return x.TimeoutHeightOffset
 	}
 	return 0
 }
 
-func (x *WithdrawParams) GetMaxBtcBatchWithdrawNum() uint32 {
+func (x *IBCParams) GetTimeoutDuration() *durationpb.Duration {
 	if x != nil {
-		return x.MaxBtcBatchWithdrawNum
+		return x.TimeoutDuration
 	}
-	return 0
+	return nil
 }
 
-// ProtocolLimits defines the params related to the the protocol limitations
-type ProtocolLimits struct {
+// FeeSponsorshipParams defines the params related to fee sponsorship
+type FeeSponsorshipParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The minimum deposit amount for btc in sat
-	BtcMinDeposit int64 `protobuf:"varint,1,opt,name=btc_min_deposit,json=btcMinDeposit,proto3" json:"btc_min_deposit,omitempty"`
-	// The minimum withdrawal amount for btc in sat
-	BtcMinWithdraw int64 `protobuf:"varint,2,opt,name=btc_min_withdraw,json=btcMinWithdraw,proto3" json:"btc_min_withdraw,omitempty"`
-	// The maximum withdrawal amount for btc in sat
-	BtcMaxWithdraw int64 `protobuf:"varint,3,opt,name=btc_max_withdraw,json=btcMaxWithdraw,proto3" json:"btc_max_withdraw,omitempty"`
+	// Maximum sponsorship fee per tx
+	MaxSponsorFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=max_sponsor_fee,json=maxSponsorFee,proto3" json:"max_sponsor_fee,omitempty"`
+	// Sponsorship policies in addition to the default BTC transfer sponsorship
+	Policies []*FeeSponsorshipPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
 }
 
-func (x *ProtocolLimits) Reset() {
-	*x = ProtocolLimits{}
+func (x *FeeSponsorshipParams) Reset() {
+	*x = FeeSponsorshipParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[3]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtocolLimits) String() string {
+func (x *FeeSponsorshipParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolLimits) ProtoMessage() {}
-
-// Deprecated: Use ProtocolLimits.ProtoReflect.Descriptor instead.
-func (*ProtocolLimits) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{3}
-}
+func (*FeeSponsorshipParams) ProtoMessage() {}
 
-func (x *ProtocolLimits) GetBtcMinDeposit() int64 {
-	if x != nil {
-		return x.BtcMinDeposit
-	}
-	return 0
+// Deprecated: Use FeeSponsorshipParams.ProtoReflect.Descriptor instead.
+func (*FeeSponsorshipParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ProtocolLimits) GetBtcMinWithdraw() int64 {
+func (x *FeeSponsorshipParams) GetMaxSponsorFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.BtcMinWithdraw
+		return x.MaxSponsorFee
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtocolLimits) GetBtcMaxWithdraw() int64 {
+func (x *FeeSponsorshipParams) GetPolicies() []*FeeSponsorshipPolicy {
 	if x != nil {
-		return x.BtcMaxWithdraw
+		return x.Policies
 	}
-	return 0
+	return nil
 }
 
-// ProtocolFees defines the params related to the protocol fees
-type ProtocolFees struct {
+// FeeSponsorshipPolicy defines the policy under which the gas fee of the matched tx is sponsored
+type FeeSponsorshipPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Protocol fee amount for deposit in sat
-	DepositFee int64 `protobuf:"varint,1,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
-	// Protocol fee amount for withdrawal in sat
-	WithdrawFee int64 `protobuf:"varint,2,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
-	// Protocol fee collector
-	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
-}
-
-func (x *ProtocolFees) Reset() {
-	*x = ProtocolFees{}
+	// Unique policy id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Msg type urls covered by the policy; all msgs of the tx must be covered
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// Maximum number of the sponsored txs per account during each epoch; 0 means unlimited
+	AccountQuota uint32 `protobuf:"varint,3,opt,name=account_quota,json=accountQuota,proto3" json:"account_quota,omitempty"`
+	// Maximum total fee sponsored during each epoch; empty means unlimited
+	EpochBudget []*v1beta1.Coin `protobuf:"bytes,4,rep,name=epoch_budget,json=epochBudget,proto3" json:"epoch_budget,omitempty"`
+	// Duration of each epoch; 0 means the quota and budget are never reset
+	EpochDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`
+	// Account from which the sponsored fee is deducted; defaulted to the fee sponsor module account if empty
+	// A non-default funding account must grant the fee allowance to the fee sponsor module account
+	FundingAccount string `protobuf:"bytes,6,opt,name=funding_account,json=fundingAccount,proto3" json:"funding_account,omitempty"`
+}
+
+func (x *FeeSponsorshipPolicy) Reset() {
+	*x = FeeSponsorshipPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[4]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtocolFees) String() string {
+func (x *FeeSponsorshipPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolFees) ProtoMessage() {}
+func (*FeeSponsorshipPolicy) ProtoMessage() {}
 
-// Deprecated: Use ProtocolFees.ProtoReflect.Descriptor instead.
-func (*ProtocolFees) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FeeSponsorshipPolicy.ProtoReflect.Descriptor instead.
+func (*FeeSponsorshipPolicy) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ProtocolFees) GetDepositFee() int64 {
+func (x *FeeSponsorshipPolicy) GetId() string {
 	if x != nil {
-		return x.DepositFee
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ProtocolFees) GetWithdrawFee() int64 {
+func (x *FeeSponsorshipPolicy) GetMsgTypeUrls() []string {
 	if x != nil {
-		return x.WithdrawFee
+		return x.MsgTypeUrls
+	}
+	return nil
+}
+
+func (x *FeeSponsorshipPolicy) GetAccountQuota() uint32 {
+	if x != nil {
+		return x.AccountQuota
 	}
 	return 0
 }
 
-func (x *ProtocolFees) GetCollector() string {
+func (x *FeeSponsorshipPolicy) GetEpochBudget() []*v1beta1.Coin {
 	if x != nil {
-		return x.Collector
+		return x.EpochBudget
+	}
+	return nil
+}
+
+func (x *FeeSponsorshipPolicy) GetEpochDuration() *durationpb.Duration {
+	if x != nil {
+		return x.EpochDuration
+	}
+	return nil
+}
+
+func (x *FeeSponsorshipPolicy) GetFundingAccount() string {
+	if x != nil {
+		return x.FundingAccount
 	}
 	return ""
 }
 
-// TSSParams defines the params related to TSS
-type TSSParams struct {
+// RelayerParams defines the params related to the bonded relayers
+type RelayerParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Timeout duration for DKG request
-	DkgTimeoutPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=dkg_timeout_period,json=dkgTimeoutPeriod,proto3" json:"dkg_timeout_period,omitempty"`
-	// Transition period after which TSS participants update process is completed
-	ParticipantUpdateTransitionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=participant_update_transition_period,json=participantUpdateTransitionPeriod,proto3" json:"participant_update_transition_period,omitempty"`
-}
-
-func (x *TSSParams) Reset() {
-	*x = TSSParams{}
+	// Minimum bond required to be an active relayer
+	MinBond *v1beta1.Coin `protobuf:"bytes,1,opt,name=min_bond,json=minBond,proto3" json:"min_bond,omitempty"`
+	// Percentage of the protocol deposit fee rewarded to the relayer; [0, 100]
+	DepositRewardPercentage uint32 `protobuf:"varint,2,opt,name=deposit_reward_percentage,json=depositRewardPercentage,proto3" json:"deposit_reward_percentage,omitempty"`
+	// Percentage of the bond slashed for each misbehaviour; [0, 100]
+	SlashPercentage uint32 `protobuf:"varint,3,opt,name=slash_percentage,json=slashPercentage,proto3" json:"slash_percentage,omitempty"`
+	// Duration for which the bond is locked after unbonding
+	UnbondingPeriod *durationpb.Duration `protobuf:"bytes,4,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// Number of the active relayers required to submit the identical rune etching before it is accepted
+	RuneEtchingQuorum uint32 `protobuf:"varint,5,opt,name=rune_etching_quorum,json=runeEtchingQuorum,proto3" json:"rune_etching_quorum,omitempty"`
+	// Relayers grandfathered from the deprecated trusted non-btc relayers which are active without bonding;
+	// temporary until they bond and are removed by governance
+	GrandfatheredRelayers []string `protobuf:"bytes,6,rep,name=grandfathered_relayers,json=grandfatheredRelayers,proto3" json:"grandfathered_relayers,omitempty"`
+}
+
+func (x *RelayerParams) Reset() {
+	*x = RelayerParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[5]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TSSParams) String() string {
+func (x *RelayerParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TSSParams) ProtoMessage() {}
+func (*RelayerParams) ProtoMessage() {}
 
-// Deprecated: Use TSSParams.ProtoReflect.Descriptor instead.
-func (*TSSParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RelayerParams.ProtoReflect.Descriptor instead.
+func (*RelayerParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TSSParams) GetDkgTimeoutPeriod() *durationpb.Duration {
+func (x *RelayerParams) GetMinBond() *v1beta1.Coin {
 	if x != nil {
-		return x.DkgTimeoutPeriod
+		return x.MinBond
 	}
 	return nil
 }
 
-func (x *TSSParams) GetParticipantUpdateTransitionPeriod() *durationpb.Duration {
+func (x *RelayerParams) GetDepositRewardPercentage() uint32 {
 	if x != nil {
-		return x.ParticipantUpdateTransitionPeriod
+		return x.DepositRewardPercentage
+	}
+	return 0
+}
+
+func (x *RelayerParams) GetSlashPercentage() uint32 {
+	if x != nil {
+		return x.SlashPercentage
+	}
+	return 0
+}
+
+func (x *RelayerParams) GetUnbondingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.UnbondingPeriod
 	}
 	return nil
 }
 
-// RateLimitParams defines the params related to the rate limit for BTC withdrawal
-type RateLimitParams struct {
+func (x *RelayerParams) GetRuneEtchingQuorum() uint32 {
+	if x != nil {
+		return x.RuneEtchingQuorum
+	}
+	return 0
+}
+
+func (x *RelayerParams) GetGrandfatheredRelayers() []string {
+	if x != nil {
+		return x.GrandfatheredRelayers
+	}
+	return nil
+}
+
+// GuardianParams defines the params related to the guardian emergency pause
+type GuardianParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Global rate limit params
-	GlobalRateLimitParams *GlobalRateLimitParams `protobuf:"bytes,1,opt,name=global_rate_limit_params,json=globalRateLimitParams,proto3" json:"global_rate_limit_params,omitempty"`
-	// Per address rate limit params
-	AddressRateLimitParams *AddressRateLimitParams `protobuf:"bytes,2,opt,name=address_rate_limit_params,json=addressRateLimitParams,proto3" json:"address_rate_limit_params,omitempty"`
+	// Guardian multisig address which is allowed to pause the protocol; empty means disabled
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Maximum duration of a pause initiated by the guardian
+	MaxPauseDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=max_pause_duration,json=maxPauseDuration,proto3" json:"max_pause_duration,omitempty"`
+	// Cooldown period after the end of a guardian pause during which the guardian cannot pause the same target again
+	PauseCooldown *durationpb.Duration `protobuf:"bytes,3,opt,name=pause_cooldown,json=pauseCooldown,proto3" json:"pause_cooldown,omitempty"`
 }
 
-func (x *RateLimitParams) Reset() {
-	*x = RateLimitParams{}
+func (x *GuardianParams) Reset() {
+	*x = GuardianParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[6]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimitParams) String() string {
+func (x *GuardianParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitParams) ProtoMessage() {}
+func (*GuardianParams) ProtoMessage() {}
 
-// Deprecated: Use RateLimitParams.ProtoReflect.Descriptor instead.
-func (*RateLimitParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GuardianParams.ProtoReflect.Descriptor instead.
+func (*GuardianParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RateLimitParams) GetGlobalRateLimitParams() *GlobalRateLimitParams {
+func (x *GuardianParams) GetGuardian() string {
 	if x != nil {
-		return x.GlobalRateLimitParams
+		return x.Guardian
+	}
+	return ""
+}
+
+func (x *GuardianParams) GetMaxPauseDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxPauseDuration
 	}
 	return nil
 }
 
-func (x *RateLimitParams) GetAddressRateLimitParams() *AddressRateLimitParams {
+func (x *GuardianParams) GetPauseCooldown() *durationpb.Duration {
 	if x != nil {
-		return x.AddressRateLimitParams
+		return x.PauseCooldown
 	}
 	return nil
 }
 
-// GlobalRateLimitParams defines the global rate limit params
-type GlobalRateLimitParams struct {
+// DepositConfirmationParams defines the amount tiered confirmation params for the deposit transactions
+type DepositConfirmationParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Duration of each rate limit epoch
-	Period *durationpb.Duration `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	// Maximum withdrawable supply percentage during the rate limit period; 100 means no limit
-	SupplyPercentageQuota uint32 `protobuf:"varint,2,opt,name=supply_percentage_quota,json=supplyPercentageQuota,proto3" json:"supply_percentage_quota,omitempty"`
+	// Confirmation tiers in ascending order of the minimum amount; the first tier must start from 0
+	Tiers []*DepositConfirmationTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Oracle price symbols used to convert the runes amount to the BTC equivalent
+	RunesPriceSymbols []*RunesPriceSymbol `protobuf:"bytes,2,rep,name=runes_price_symbols,json=runesPriceSymbols,proto3" json:"runes_price_symbols,omitempty"`
 }
 
-func (x *GlobalRateLimitParams) Reset() {
-	*x = GlobalRateLimitParams{}
+func (x *DepositConfirmationParams) Reset() {
+	*x = DepositConfirmationParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[7]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GlobalRateLimitParams) String() string {
+func (x *DepositConfirmationParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalRateLimitParams) ProtoMessage() {}
+func (*DepositConfirmationParams) ProtoMessage() {}
 
-// Deprecated: Use GlobalRateLimitParams.ProtoReflect.Descriptor instead.
-func (*GlobalRateLimitParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DepositConfirmationParams.ProtoReflect.Descriptor instead.
+func (*DepositConfirmationParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GlobalRateLimitParams) GetPeriod() *durationpb.Duration {
+func (x *DepositConfirmationParams) GetTiers() []*DepositConfirmationTier {
 	if x != nil {
-		return x.Period
+		return x.Tiers
 	}
 	return nil
 }
 
-func (x *GlobalRateLimitParams) GetSupplyPercentageQuota() uint32 {
+func (x *DepositConfirmationParams) GetRunesPriceSymbols() []*RunesPriceSymbol {
 	if x != nil {
-		return x.SupplyPercentageQuota
+		return x.RunesPriceSymbols
 	}
-	return 0
+	return nil
 }
 
-// AddressRateLimitParams defines the per address rate limit params
-type AddressRateLimitParams struct {
+// DepositConfirmationTier defines the confirmation depth required for the deposits of at least the given BTC equivalent amount
+type DepositConfirmationTier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Duration of each rate limit epoch
-	Period *durationpb.Duration `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	// Maximum withdrawable amount during the rate limit period; 0 means no limit
-	Quota int64 `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Minimum BTC equivalent amount in satoshi
+	MinAmount int64 `protobuf:"varint,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// The minimum number of confirmations required
+	ConfirmationDepth int32 `protobuf:"varint,2,opt,name=confirmation_depth,json=confirmationDepth,proto3" json:"confirmation_depth,omitempty"`
 }
 
-func (x *AddressRateLimitParams) Reset() {
-	*x = AddressRateLimitParams{}
+func (x *DepositConfirmationTier) Reset() {
+	*x = DepositConfirmationTier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[8]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddressRateLimitParams) String() string {
+func (x *DepositConfirmationTier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressRateLimitParams) ProtoMessage() {}
+func (*DepositConfirmationTier) ProtoMessage() {}
 
-// Deprecated: Use AddressRateLimitParams.ProtoReflect.Descriptor instead.
-func (*AddressRateLimitParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DepositConfirmationTier.ProtoReflect.Descriptor instead.
+func (*DepositConfirmationTier) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddressRateLimitParams) GetPeriod() *durationpb.Duration {
+func (x *DepositConfirmationTier) GetMinAmount() int64 {
 	if x != nil {
-		return x.Period
+		return x.MinAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *AddressRateLimitParams) GetQuota() int64 {
+func (x *DepositConfirmationTier) GetConfirmationDepth() int32 {
 	if x != nil {
-		return x.Quota
+		return x.ConfirmationDepth
 	}
 	return 0
 }
 
-// IBCParams defines the params related to IBC
-type IBCParams struct {
+// RunesPriceSymbol defines the oracle price symbol of the rune against BTC
+type RunesPriceSymbol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Timeout height offset relative to the current client height
-	TimeoutHeightOffset uint64 `protobuf:"varint,1,opt,name=timeout_height_offset,json=timeoutHeightOffset,proto3" json:"timeout_height_offset,omitempty"`
-	// Timeout duration relative to the current time
-	TimeoutDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
+	// Rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Oracle price symbol, e.g. DOGBTC; the price is denominated in BTC per whole rune
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Divisibility of the rune
+	Divisibility uint32 `protobuf:"varint,3,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
 }
 
-func (x *IBCParams) Reset() {
-	*x = IBCParams{}
+func (x *RunesPriceSymbol) Reset() {
+	*x = RunesPriceSymbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[9]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IBCParams) String() string {
+func (x *RunesPriceSymbol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IBCParams) ProtoMessage() {}
+func (*RunesPriceSymbol) ProtoMessage() {}
 
-// Deprecated: Use IBCParams.ProtoReflect.Descriptor instead.
-func (*IBCParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RunesPriceSymbol.ProtoReflect.Descriptor instead.
+func (*RunesPriceSymbol) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *IBCParams) GetTimeoutHeightOffset() uint64 {
+func (x *RunesPriceSymbol) GetId() string {
 	if x != nil {
-		return x.TimeoutHeightOffset
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *IBCParams) GetTimeoutDuration() *durationpb.Duration {
+func (x *RunesPriceSymbol) GetSymbol() string {
 	if x != nil {
-		return x.TimeoutDuration
+		return x.Symbol
 	}
-	return nil
+	return ""
 }
 
-// FeeSponsorshipParams defines the params related to fee sponsorship
-type FeeSponsorshipParams struct {
+func (x *RunesPriceSymbol) GetDivisibility() uint32 {
+	if x != nil {
+		return x.Divisibility
+	}
+	return 0
+}
+
+// VaultRecoveryParams defines the timelocked recovery multisig committed by the newly generated vaults
+type VaultRecoveryParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maximum sponsorship fee per tx
-	MaxSponsorFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=max_sponsor_fee,json=maxSponsorFee,proto3" json:"max_sponsor_fee,omitempty"`
+	// x-only public keys of the governance held recovery multisig; empty means disabled
+	PubKeys []string `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// Number of signatures required by the recovery multisig
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Relative time lock in blocks after which the vault utxo can be spent by the recovery multisig
+	Timelock uint32 `protobuf:"varint,3,opt,name=timelock,proto3" json:"timelock,omitempty"`
 }
 
-func (x *FeeSponsorshipParams) Reset() {
-	*x = FeeSponsorshipParams{}
+func (x *VaultRecoveryParams) Reset() {
+	*x = VaultRecoveryParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_btcbridge_params_proto_msgTypes[10]
+		mi := &file_bitway_btcbridge_params_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeSponsorshipParams) String() string {
+func (x *VaultRecoveryParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeSponsorshipParams) ProtoMessage() {}
+func (*VaultRecoveryParams) ProtoMessage() {}
 
-// Deprecated: Use FeeSponsorshipParams.ProtoReflect.Descriptor instead.
-func (*FeeSponsorshipParams) Descriptor() ([]byte, []int) {
-	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use VaultRecoveryParams.ProtoReflect.Descriptor instead.
+func (*VaultRecoveryParams) Descriptor() ([]byte, []int) {
+	return file_bitway_btcbridge_params_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *FeeSponsorshipParams) GetMaxSponsorFee() []*v1beta1.Coin {
+func (x *VaultRecoveryParams) GetPubKeys() []string {
 	if x != nil {
-		return x.MaxSponsorFee
+		return x.PubKeys
 	}
 	return nil
 }
 
+func (x *VaultRecoveryParams) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *VaultRecoveryParams) GetTimelock() uint32 {
+	if x != nil {
+		return x.Timelock
+	}
+	return 0
+}
+
 var File_bitway_btcbridge_params_proto protoreflect.FileDescriptor
 
 var file_bitway_btcbridge_params_proto_rawDesc = []byte{
@@ -7502,192 +13300,316 @@ var file_bitway_btcbridge_params_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68,
-	0x12, 0x3e, 0x0a, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68,
-	0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2a, 0x0a,
-	0x11, 0x62, 0x74, 0x63, 0x5f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x74, 0x63, 0x56, 0x6f, 0x75,
-	0x63, 0x68, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a,
-	0x18, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x62, 0x74, 0x63,
-	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x42, 0x74, 0x63, 0x52, 0x65,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65,
-	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x65,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x66, 0x65,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x2a, 0x0a, 0x11, 0x62, 0x74, 0x63, 0x5f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x74, 0x63, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x32, 0x0a, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x06,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x49, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x73,
+	0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62,
-	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x73, 0x73, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x65, 0x2e, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x09, 0x74, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x11,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62,
+	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x49, 0x42, 0x43, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x69, 0x62, 0x63, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x62, 0x0a, 0x16, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x14, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x62, 0x63, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x49, 0x42, 0x43, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x09, 0x69, 0x62, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x62, 0x0a, 0x16, 0x66, 0x65,
-	0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x69, 0x74,
-	0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65,
-	0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6f,
-	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x90,
-	0x01, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x74, 0x78, 0x6f,
-	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x55,
-	0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x62, 0x74, 0x63, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x42, 0x74, 0x63, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4e, 0x75, 0x6d, 0x22, 0x8c, 0x01,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x74, 0x63, 0x4d, 0x69,
-	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f,
-	0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x62, 0x74, 0x63, 0x4d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x74,
-	0x63, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0x70, 0x0a, 0x0c,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xd4,
-	0x01, 0x0a, 0x09, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x51, 0x0a, 0x12,
-	0x64, 0x6b, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x71, 0x0a, 0x1b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x19,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5f, 0x0a, 0x15, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x52, 0x18, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x62, 0x74, 0x63, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x01, 0x0a,
+	0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x77, 0x0a, 0x0d,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x75, 0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x55, 0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x74,
+	0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x62,
+	0x74, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x74, 0x63,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x42, 0x74,
+	0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4e, 0x75,
+	0x6d, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x74, 0x63, 0x4d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x74, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x74, 0x63, 0x4d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x62, 0x74, 0x63, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x22, 0x70, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0xd4, 0x01, 0x0a, 0x09, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x51, 0x0a, 0x12, 0x64, 0x6b, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0x52, 0x10, 0x64, 0x6b, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x74, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x66, 0x0a,
+	0x18, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x8c, 0x01, 0x0a, 0x15, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22,
+	0x6b, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a,
+	0x09, 0x49, 0x42, 0x43, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x4e,
+	0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x64,
-	0x6b, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
-	0x74, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
-	0x1f, 0x01, 0x52, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x18, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x69,
-	0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x69, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74,
-	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8c, 0x01, 0x0a,
-	0x15, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x16, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x49, 0x42, 0x43,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd5,
+	0x01, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x73, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x08,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x53, 0x70,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55,
+	0x72, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x6e, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe9, 0x02,
+	0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x3a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x65, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x72, 0x75, 0x6e, 0x65, 0x45, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x12, 0x35, 0x0a, 0x16, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x66, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x66, 0x61, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x46,
-	0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x73, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70,
-	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x2a, 0x67, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x42, 0x54, 0x43, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x43, 0x32, 0x30, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x41,
-	0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x45, 0x53, 0x10,
-	0x03, 0x42, 0xb7, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x42,
-	0x42, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x74, 0x63, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x42,
-	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1c, 0x42, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0e, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x43,
+	0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x62, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x12, 0x58, 0x0a, 0x13,
+	0x72, 0x75, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e,
+	0x65, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x11, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x67, 0x0a, 0x17, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22,
+	0x5e, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0x6a, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2a, 0x67, 0x0a, 0x09, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x42, 0x54, 0x43, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x43, 0x32, 0x30, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x55, 0x4e,
+	0x45, 0x53, 0x10, 0x03, 0x42, 0xb7, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2,
+	0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x42,
+	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x10, 0x42, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1c, 0x42, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x42, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7703,46 +13625,69 @@ func file_bitway_btcbridge_params_proto_rawDescGZIP() []byte {
 }
 
 var file_bitway_btcbridge_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bitway_btcbridge_params_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bitway_btcbridge_params_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_bitway_btcbridge_params_proto_goTypes = []interface{}{
-	(AssetType)(0),                 // 0: bitway.btcbridge.AssetType
-	(*Params)(nil),                 // 1: bitway.btcbridge.Params
-	(*Vault)(nil),                  // 2: bitway.btcbridge.Vault
-	(*WithdrawParams)(nil),         // 3: bitway.btcbridge.WithdrawParams
-	(*ProtocolLimits)(nil),         // 4: bitway.btcbridge.ProtocolLimits
-	(*ProtocolFees)(nil),           // 5: bitway.btcbridge.ProtocolFees
-	(*TSSParams)(nil),              // 6: bitway.btcbridge.TSSParams
-	(*RateLimitParams)(nil),        // 7: bitway.btcbridge.RateLimitParams
-	(*GlobalRateLimitParams)(nil),  // 8: bitway.btcbridge.GlobalRateLimitParams
-	(*AddressRateLimitParams)(nil), // 9: bitway.btcbridge.AddressRateLimitParams
-	(*IBCParams)(nil),              // 10: bitway.btcbridge.IBCParams
-	(*FeeSponsorshipParams)(nil),   // 11: bitway.btcbridge.FeeSponsorshipParams
-	(*durationpb.Duration)(nil),    // 12: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),           // 13: cosmos.base.v1beta1.Coin
+	(AssetType)(0),                    // 0: bitway.btcbridge.AssetType
+	(*Params)(nil),                    // 1: bitway.btcbridge.Params
+	(*Vault)(nil),                     // 2: bitway.btcbridge.Vault
+	(*VaultRecovery)(nil),             // 3: bitway.btcbridge.VaultRecovery
+	(*WithdrawParams)(nil),            // 4: bitway.btcbridge.WithdrawParams
+	(*ProtocolLimits)(nil),            // 5: bitway.btcbridge.ProtocolLimits
+	(*ProtocolFees)(nil),              // 6: bitway.btcbridge.ProtocolFees
+	(*TSSParams)(nil),                 // 7: bitway.btcbridge.TSSParams
+	(*RateLimitParams)(nil),           // 8: bitway.btcbridge.RateLimitParams
+	(*GlobalRateLimitParams)(nil),     // 9: bitway.btcbridge.GlobalRateLimitParams
+	(*AddressRateLimitParams)(nil),    // 10: bitway.btcbridge.AddressRateLimitParams
+	(*IBCParams)(nil),                 // 11: bitway.btcbridge.IBCParams
+	(*FeeSponsorshipParams)(nil),      // 12: bitway.btcbridge.FeeSponsorshipParams
+	(*FeeSponsorshipPolicy)(nil),      // 13: bitway.btcbridge.FeeSponsorshipPolicy
+	(*RelayerParams)(nil),             // 14: bitway.btcbridge.RelayerParams
+	(*GuardianParams)(nil),            // 15: bitway.btcbridge.GuardianParams
+	(*DepositConfirmationParams)(nil), // 16: bitway.btcbridge.DepositConfirmationParams
+	(*DepositConfirmationTier)(nil),   // 17: bitway.btcbridge.DepositConfirmationTier
+	(*RunesPriceSymbol)(nil),          // 18: bitway.btcbridge.RunesPriceSymbol
+	(*VaultRecoveryParams)(nil),       // 19: bitway.btcbridge.VaultRecoveryParams
+	(*durationpb.Duration)(nil),       // 20: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),              // 21: cosmos.base.v1beta1.Coin
 }
 var file_bitway_btcbridge_params_proto_depIdxs = []int32{
 	2,  // 0: bitway.btcbridge.Params.vaults:type_name -> bitway.btcbridge.Vault
-	3,  // 1: bitway.btcbridge.Params.withdraw_params:type_name -> bitway.btcbridge.WithdrawParams
-	4,  // 2: bitway.btcbridge.Params.protocol_limits:type_name -> bitway.btcbridge.ProtocolLimits
-	5,  // 3: bitway.btcbridge.Params.protocol_fees:type_name -> bitway.btcbridge.ProtocolFees
-	6,  // 4: bitway.btcbridge.Params.tss_params:type_name -> bitway.btcbridge.TSSParams
-	7,  // 5: bitway.btcbridge.Params.rate_limit_params:type_name -> bitway.btcbridge.RateLimitParams
-	10, // 6: bitway.btcbridge.Params.ibc_params:type_name -> bitway.btcbridge.IBCParams
-	11, // 7: bitway.btcbridge.Params.fee_sponsorship_params:type_name -> bitway.btcbridge.FeeSponsorshipParams
-	0,  // 8: bitway.btcbridge.Vault.asset_type:type_name -> bitway.btcbridge.AssetType
-	12, // 9: bitway.btcbridge.TSSParams.dkg_timeout_period:type_name -> google.protobuf.Duration
-	12, // 10: bitway.btcbridge.TSSParams.participant_update_transition_period:type_name -> google.protobuf.Duration
-	8,  // 11: bitway.btcbridge.RateLimitParams.global_rate_limit_params:type_name -> bitway.btcbridge.GlobalRateLimitParams
-	9,  // 12: bitway.btcbridge.RateLimitParams.address_rate_limit_params:type_name -> bitway.btcbridge.AddressRateLimitParams
-	12, // 13: bitway.btcbridge.GlobalRateLimitParams.period:type_name -> google.protobuf.Duration
-	12, // 14: bitway.btcbridge.AddressRateLimitParams.period:type_name -> google.protobuf.Duration
-	12, // 15: bitway.btcbridge.IBCParams.timeout_duration:type_name -> google.protobuf.Duration
-	13, // 16: bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee:type_name -> cosmos.base.v1beta1.Coin
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	4,  // 1: bitway.btcbridge.Params.withdraw_params:type_name -> bitway.btcbridge.WithdrawParams
+	5,  // 2: bitway.btcbridge.Params.protocol_limits:type_name -> bitway.btcbridge.ProtocolLimits
+	6,  // 3: bitway.btcbridge.Params.protocol_fees:type_name -> bitway.btcbridge.ProtocolFees
+	7,  // 4: bitway.btcbridge.Params.tss_params:type_name -> bitway.btcbridge.TSSParams
+	8,  // 5: bitway.btcbridge.Params.rate_limit_params:type_name -> bitway.btcbridge.RateLimitParams
+	11, // 6: bitway.btcbridge.Params.ibc_params:type_name -> bitway.btcbridge.IBCParams
+	12, // 7: bitway.btcbridge.Params.fee_sponsorship_params:type_name -> bitway.btcbridge.FeeSponsorshipParams
+	14, // 8: bitway.btcbridge.Params.relayer_params:type_name -> bitway.btcbridge.RelayerParams
+	15, // 9: bitway.btcbridge.Params.guardian_params:type_name -> bitway.btcbridge.GuardianParams
+	16, // 10: bitway.btcbridge.Params.deposit_confirmation_params:type_name -> bitway.btcbridge.DepositConfirmationParams
+	19, // 11: bitway.btcbridge.Params.vault_recovery_params:type_name -> bitway.btcbridge.VaultRecoveryParams
+	0,  // 12: bitway.btcbridge.Vault.asset_type:type_name -> bitway.btcbridge.AssetType
+	3,  // 13: bitway.btcbridge.Vault.recovery:type_name -> bitway.btcbridge.VaultRecovery
+	19, // 14: bitway.btcbridge.VaultRecovery.params:type_name -> bitway.btcbridge.VaultRecoveryParams
+	20, // 15: bitway.btcbridge.TSSParams.dkg_timeout_period:type_name -> google.protobuf.Duration
+	20, // 16: bitway.btcbridge.TSSParams.participant_update_transition_period:type_name -> google.protobuf.Duration
+	9,  // 17: bitway.btcbridge.RateLimitParams.global_rate_limit_params:type_name -> bitway.btcbridge.GlobalRateLimitParams
+	10, // 18: bitway.btcbridge.RateLimitParams.address_rate_limit_params:type_name -> bitway.btcbridge.AddressRateLimitParams
+	20, // 19: bitway.btcbridge.GlobalRateLimitParams.period:type_name -> google.protobuf.Duration
+	20, // 20: bitway.btcbridge.AddressRateLimitParams.period:type_name -> google.protobuf.Duration
+	20, // 21: bitway.btcbridge.IBCParams.timeout_duration:type_name -> google.protobuf.Duration
+	21, // 22: bitway.btcbridge.FeeSponsorshipParams.max_sponsor_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 23: bitway.btcbridge.FeeSponsorshipParams.policies:type_name -> bitway.btcbridge.FeeSponsorshipPolicy
+	21, // 24: bitway.btcbridge.FeeSponsorshipPolicy.epoch_budget:type_name -> cosmos.base.v1beta1.Coin
+	20, // 25: bitway.btcbridge.FeeSponsorshipPolicy.epoch_duration:type_name -> google.protobuf.Duration
+	21, // 26: bitway.btcbridge.RelayerParams.min_bond:type_name -> cosmos.base.v1beta1.Coin
+	20, // 27: bitway.btcbridge.RelayerParams.unbonding_period:type_name -> google.protobuf.Duration
+	20, // 28: bitway.btcbridge.GuardianParams.max_pause_duration:type_name -> google.protobuf.Duration
+	20, // 29: bitway.btcbridge.GuardianParams.pause_cooldown:type_name -> google.protobuf.Duration
+	17, // 30: bitway.btcbridge.DepositConfirmationParams.tiers:type_name -> bitway.btcbridge.DepositConfirmationTier
+	18, // 31: bitway.btcbridge.DepositConfirmationParams.runes_price_symbols:type_name -> bitway.btcbridge.RunesPriceSymbol
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_bitway_btcbridge_params_proto_init() }
@@ -7776,7 +13721,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawParams); i {
+			switch v := v.(*VaultRecovery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7788,7 +13733,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolLimits); i {
+			switch v := v.(*WithdrawParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7800,7 +13745,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolFees); i {
+			switch v := v.(*ProtocolLimits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7812,7 +13757,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TSSParams); i {
+			switch v := v.(*ProtocolFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7824,7 +13769,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimitParams); i {
+			switch v := v.(*TSSParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7836,7 +13781,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GlobalRateLimitParams); i {
+			switch v := v.(*RateLimitParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7848,7 +13793,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressRateLimitParams); i {
+			switch v := v.(*GlobalRateLimitParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7860,7 +13805,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCParams); i {
+			switch v := v.(*AddressRateLimitParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7872,6 +13817,18 @@ func file_bitway_btcbridge_params_proto_init() {
 			}
 		}
 		file_bitway_btcbridge_params_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeeSponsorshipParams); i {
 			case 0:
 				return &v.state
@@ -7883,6 +13840,90 @@ func file_bitway_btcbridge_params_proto_init() {
 				return nil
 			}
 		}
+		file_bitway_btcbridge_params_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSponsorshipPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayerParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardianParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositConfirmationParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositConfirmationTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunesPriceSymbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_btcbridge_params_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRecoveryParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7890,7 +13931,7 @@ func file_bitway_btcbridge_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bitway_btcbridge_params_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
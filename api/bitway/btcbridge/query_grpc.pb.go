@@ -43,6 +43,26 @@ const (
 	Query_QueryIBCDepositScript_FullMethodName              = "/bitway.btcbridge.Query/QueryIBCDepositScript"
 	Query_QueryRateLimit_FullMethodName                     = "/bitway.btcbridge.Query/QueryRateLimit"
 	Query_QueryRateLimitByAddress_FullMethodName            = "/bitway.btcbridge.Query/QueryRateLimitByAddress"
+	Query_QueryRelayer_FullMethodName                       = "/bitway.btcbridge.Query/QueryRelayer"
+	Query_QueryRelayers_FullMethodName                      = "/bitway.btcbridge.Query/QueryRelayers"
+	Query_QueryRunesAttestation_FullMethodName              = "/bitway.btcbridge.Query/QueryRunesAttestation"
+	Query_QueryPauseState_FullMethodName                    = "/bitway.btcbridge.Query/QueryPauseState"
+	Query_QueryPause_FullMethodName                         = "/bitway.btcbridge.Query/QueryPause"
+	Query_QueryPauses_FullMethodName                        = "/bitway.btcbridge.Query/QueryPauses"
+	Query_QueryScreenedAddress_FullMethodName               = "/bitway.btcbridge.Query/QueryScreenedAddress"
+	Query_QueryScreenedAddresses_FullMethodName             = "/bitway.btcbridge.Query/QueryScreenedAddresses"
+	Query_QueryQuarantinedDeposits_FullMethodName           = "/bitway.btcbridge.Query/QueryQuarantinedDeposits"
+	Query_QueryQuarantinedWithdrawals_FullMethodName        = "/bitway.btcbridge.Query/QueryQuarantinedWithdrawals"
+	Query_QueryDepositConfirmationDepth_FullMethodName      = "/bitway.btcbridge.Query/QueryDepositConfirmationDepth"
+	Query_QueryDepositRecord_FullMethodName                 = "/bitway.btcbridge.Query/QueryDepositRecord"
+	Query_QueryDepositRecords_FullMethodName                = "/bitway.btcbridge.Query/QueryDepositRecords"
+	Query_QueryDepositRecordsByRecipient_FullMethodName     = "/bitway.btcbridge.Query/QueryDepositRecordsByRecipient"
+	Query_QueryDepositRecordsBySender_FullMethodName        = "/bitway.btcbridge.Query/QueryDepositRecordsBySender"
+	Query_QueryRuneEtching_FullMethodName                   = "/bitway.btcbridge.Query/QueryRuneEtching"
+	Query_QueryFeeSponsorshipUsage_FullMethodName           = "/bitway.btcbridge.Query/QueryFeeSponsorshipUsage"
+	Query_QueryFeeSponsorshipAccountUsage_FullMethodName    = "/bitway.btcbridge.Query/QueryFeeSponsorshipAccountUsage"
+	Query_QueryVaultRecoveries_FullMethodName               = "/bitway.btcbridge.Query/QueryVaultRecoveries"
+	Query_QueryVaultRecoveryPsbt_FullMethodName             = "/bitway.btcbridge.Query/QueryVaultRecoveryPsbt"
 )
 
 // QueryClient is the client API for Query service.
@@ -97,6 +117,46 @@ type QueryClient interface {
 	QueryRateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
 	// QueryRateLimitByAddress queries the current rate limit by the given address
 	QueryRateLimitByAddress(ctx context.Context, in *QueryRateLimitByAddressRequest, opts ...grpc.CallOption) (*QueryRateLimitByAddressResponse, error)
+	// QueryRelayer queries the relayer by the given address
+	QueryRelayer(ctx context.Context, in *QueryRelayerRequest, opts ...grpc.CallOption) (*QueryRelayerResponse, error)
+	// QueryRelayers queries the relayers by the optional status
+	QueryRelayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error)
+	// QueryRunesAttestation queries the runes deposit attestation by the given tx hash
+	QueryRunesAttestation(ctx context.Context, in *QueryRunesAttestationRequest, opts ...grpc.CallOption) (*QueryRunesAttestationResponse, error)
+	// QueryPauseState queries the pauses currently in effect
+	QueryPauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// QueryPause queries the pause by the given id
+	QueryPause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+	// QueryPauses queries the pause history by the optional status
+	QueryPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error)
+	// QueryScreenedAddress queries the screening state of the given address
+	QueryScreenedAddress(ctx context.Context, in *QueryScreenedAddressRequest, opts ...grpc.CallOption) (*QueryScreenedAddressResponse, error)
+	// QueryScreenedAddresses queries the screened addresses by the optional source
+	QueryScreenedAddresses(ctx context.Context, in *QueryScreenedAddressesRequest, opts ...grpc.CallOption) (*QueryScreenedAddressesResponse, error)
+	// QueryQuarantinedDeposits queries the quarantined deposits
+	QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error)
+	// QueryQuarantinedWithdrawals queries the quarantined withdrawals
+	QueryQuarantinedWithdrawals(ctx context.Context, in *QueryQuarantinedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryQuarantinedWithdrawalsResponse, error)
+	// QueryDepositConfirmationDepth queries the confirmation depth required for the deposit of the given amount
+	QueryDepositConfirmationDepth(ctx context.Context, in *QueryDepositConfirmationDepthRequest, opts ...grpc.CallOption) (*QueryDepositConfirmationDepthResponse, error)
+	// QueryDepositRecord queries the deposit record by the given tx hash
+	QueryDepositRecord(ctx context.Context, in *QueryDepositRecordRequest, opts ...grpc.CallOption) (*QueryDepositRecordResponse, error)
+	// QueryDepositRecords queries the deposit records by the given asset type
+	QueryDepositRecords(ctx context.Context, in *QueryDepositRecordsRequest, opts ...grpc.CallOption) (*QueryDepositRecordsResponse, error)
+	// QueryDepositRecordsByRecipient queries the deposit records of the given recipient
+	QueryDepositRecordsByRecipient(ctx context.Context, in *QueryDepositRecordsByRecipientRequest, opts ...grpc.CallOption) (*QueryDepositRecordsByRecipientResponse, error)
+	// QueryDepositRecordsBySender queries the deposit records of the given bitcoin sender
+	QueryDepositRecordsBySender(ctx context.Context, in *QueryDepositRecordsBySenderRequest, opts ...grpc.CallOption) (*QueryDepositRecordsBySenderResponse, error)
+	// QueryRuneEtching queries the etching data of the given rune
+	QueryRuneEtching(ctx context.Context, in *QueryRuneEtchingRequest, opts ...grpc.CallOption) (*QueryRuneEtchingResponse, error)
+	// QueryFeeSponsorshipUsage queries the current epoch usage of the given fee sponsorship policy
+	QueryFeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error)
+	// QueryFeeSponsorshipAccountUsage queries the current epoch usage of the given fee sponsorship policy by the account
+	QueryFeeSponsorshipAccountUsage(ctx context.Context, in *QueryFeeSponsorshipAccountUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipAccountUsageResponse, error)
+	// QueryVaultRecoveries queries the emergency recovery params of the vaults
+	QueryVaultRecoveries(ctx context.Context, in *QueryVaultRecoveriesRequest, opts ...grpc.CallOption) (*QueryVaultRecoveriesResponse, error)
+	// QueryVaultRecoveryPsbt queries the psbt to sweep the given vault via the recovery script path
+	QueryVaultRecoveryPsbt(ctx context.Context, in *QueryVaultRecoveryPsbtRequest, opts ...grpc.CallOption) (*QueryVaultRecoveryPsbtResponse, error)
 }
 
 type queryClient struct {
@@ -323,6 +383,186 @@ func (c *queryClient) QueryRateLimitByAddress(ctx context.Context, in *QueryRate
 	return out, nil
 }
 
+func (c *queryClient) QueryRelayer(ctx context.Context, in *QueryRelayerRequest, opts ...grpc.CallOption) (*QueryRelayerResponse, error) {
+	out := new(QueryRelayerResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRelayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRelayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error) {
+	out := new(QueryRelayersResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRelayers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRunesAttestation(ctx context.Context, in *QueryRunesAttestationRequest, opts ...grpc.CallOption) (*QueryRunesAttestationResponse, error) {
+	out := new(QueryRunesAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRunesAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, Query_QueryPauseState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
+	out := new(QueryPauseResponse)
+	err := c.cc.Invoke(ctx, Query_QueryPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error) {
+	out := new(QueryPausesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryPauses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScreenedAddress(ctx context.Context, in *QueryScreenedAddressRequest, opts ...grpc.CallOption) (*QueryScreenedAddressResponse, error) {
+	out := new(QueryScreenedAddressResponse)
+	err := c.cc.Invoke(ctx, Query_QueryScreenedAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScreenedAddresses(ctx context.Context, in *QueryScreenedAddressesRequest, opts ...grpc.CallOption) (*QueryScreenedAddressesResponse, error) {
+	out := new(QueryScreenedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryScreenedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error) {
+	out := new(QueryQuarantinedDepositsResponse)
+	err := c.cc.Invoke(ctx, Query_QueryQuarantinedDeposits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryQuarantinedWithdrawals(ctx context.Context, in *QueryQuarantinedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryQuarantinedWithdrawalsResponse, error) {
+	out := new(QueryQuarantinedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, Query_QueryQuarantinedWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositConfirmationDepth(ctx context.Context, in *QueryDepositConfirmationDepthRequest, opts ...grpc.CallOption) (*QueryDepositConfirmationDepthResponse, error) {
+	out := new(QueryDepositConfirmationDepthResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositConfirmationDepth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositRecord(ctx context.Context, in *QueryDepositRecordRequest, opts ...grpc.CallOption) (*QueryDepositRecordResponse, error) {
+	out := new(QueryDepositRecordResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositRecords(ctx context.Context, in *QueryDepositRecordsRequest, opts ...grpc.CallOption) (*QueryDepositRecordsResponse, error) {
+	out := new(QueryDepositRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositRecordsByRecipient(ctx context.Context, in *QueryDepositRecordsByRecipientRequest, opts ...grpc.CallOption) (*QueryDepositRecordsByRecipientResponse, error) {
+	out := new(QueryDepositRecordsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositRecordsByRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositRecordsBySender(ctx context.Context, in *QueryDepositRecordsBySenderRequest, opts ...grpc.CallOption) (*QueryDepositRecordsBySenderResponse, error) {
+	out := new(QueryDepositRecordsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositRecordsBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRuneEtching(ctx context.Context, in *QueryRuneEtchingRequest, opts ...grpc.CallOption) (*QueryRuneEtchingResponse, error) {
+	out := new(QueryRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRuneEtching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error) {
+	out := new(QueryFeeSponsorshipUsageResponse)
+	err := c.cc.Invoke(ctx, Query_QueryFeeSponsorshipUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFeeSponsorshipAccountUsage(ctx context.Context, in *QueryFeeSponsorshipAccountUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipAccountUsageResponse, error) {
+	out := new(QueryFeeSponsorshipAccountUsageResponse)
+	err := c.cc.Invoke(ctx, Query_QueryFeeSponsorshipAccountUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultRecoveries(ctx context.Context, in *QueryVaultRecoveriesRequest, opts ...grpc.CallOption) (*QueryVaultRecoveriesResponse, error) {
+	out := new(QueryVaultRecoveriesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryVaultRecoveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultRecoveryPsbt(ctx context.Context, in *QueryVaultRecoveryPsbtRequest, opts ...grpc.CallOption) (*QueryVaultRecoveryPsbtResponse, error) {
+	out := new(QueryVaultRecoveryPsbtResponse)
+	err := c.cc.Invoke(ctx, Query_QueryVaultRecoveryPsbt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -375,6 +615,46 @@ type QueryServer interface {
 	QueryRateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
 	// QueryRateLimitByAddress queries the current rate limit by the given address
 	QueryRateLimitByAddress(context.Context, *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error)
+	// QueryRelayer queries the relayer by the given address
+	QueryRelayer(context.Context, *QueryRelayerRequest) (*QueryRelayerResponse, error)
+	// QueryRelayers queries the relayers by the optional status
+	QueryRelayers(context.Context, *QueryRelayersRequest) (*QueryRelayersResponse, error)
+	// QueryRunesAttestation queries the runes deposit attestation by the given tx hash
+	QueryRunesAttestation(context.Context, *QueryRunesAttestationRequest) (*QueryRunesAttestationResponse, error)
+	// QueryPauseState queries the pauses currently in effect
+	QueryPauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// QueryPause queries the pause by the given id
+	QueryPause(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+	// QueryPauses queries the pause history by the optional status
+	QueryPauses(context.Context, *QueryPausesRequest) (*QueryPausesResponse, error)
+	// QueryScreenedAddress queries the screening state of the given address
+	QueryScreenedAddress(context.Context, *QueryScreenedAddressRequest) (*QueryScreenedAddressResponse, error)
+	// QueryScreenedAddresses queries the screened addresses by the optional source
+	QueryScreenedAddresses(context.Context, *QueryScreenedAddressesRequest) (*QueryScreenedAddressesResponse, error)
+	// QueryQuarantinedDeposits queries the quarantined deposits
+	QueryQuarantinedDeposits(context.Context, *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error)
+	// QueryQuarantinedWithdrawals queries the quarantined withdrawals
+	QueryQuarantinedWithdrawals(context.Context, *QueryQuarantinedWithdrawalsRequest) (*QueryQuarantinedWithdrawalsResponse, error)
+	// QueryDepositConfirmationDepth queries the confirmation depth required for the deposit of the given amount
+	QueryDepositConfirmationDepth(context.Context, *QueryDepositConfirmationDepthRequest) (*QueryDepositConfirmationDepthResponse, error)
+	// QueryDepositRecord queries the deposit record by the given tx hash
+	QueryDepositRecord(context.Context, *QueryDepositRecordRequest) (*QueryDepositRecordResponse, error)
+	// QueryDepositRecords queries the deposit records by the given asset type
+	QueryDepositRecords(context.Context, *QueryDepositRecordsRequest) (*QueryDepositRecordsResponse, error)
+	// QueryDepositRecordsByRecipient queries the deposit records of the given recipient
+	QueryDepositRecordsByRecipient(context.Context, *QueryDepositRecordsByRecipientRequest) (*QueryDepositRecordsByRecipientResponse, error)
+	// QueryDepositRecordsBySender queries the deposit records of the given bitcoin sender
+	QueryDepositRecordsBySender(context.Context, *QueryDepositRecordsBySenderRequest) (*QueryDepositRecordsBySenderResponse, error)
+	// QueryRuneEtching queries the etching data of the given rune
+	QueryRuneEtching(context.Context, *QueryRuneEtchingRequest) (*QueryRuneEtchingResponse, error)
+	// QueryFeeSponsorshipUsage queries the current epoch usage of the given fee sponsorship policy
+	QueryFeeSponsorshipUsage(context.Context, *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error)
+	// QueryFeeSponsorshipAccountUsage queries the current epoch usage of the given fee sponsorship policy by the account
+	QueryFeeSponsorshipAccountUsage(context.Context, *QueryFeeSponsorshipAccountUsageRequest) (*QueryFeeSponsorshipAccountUsageResponse, error)
+	// QueryVaultRecoveries queries the emergency recovery params of the vaults
+	QueryVaultRecoveries(context.Context, *QueryVaultRecoveriesRequest) (*QueryVaultRecoveriesResponse, error)
+	// QueryVaultRecoveryPsbt queries the psbt to sweep the given vault via the recovery script path
+	QueryVaultRecoveryPsbt(context.Context, *QueryVaultRecoveryPsbtRequest) (*QueryVaultRecoveryPsbtResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -454,6 +734,66 @@ func (UnimplementedQueryServer) QueryRateLimit(context.Context, *QueryRateLimitR
 func (UnimplementedQueryServer) QueryRateLimitByAddress(context.Context, *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryRateLimitByAddress not implemented")
 }
+func (UnimplementedQueryServer) QueryRelayer(context.Context, *QueryRelayerRequest) (*QueryRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRelayer not implemented")
+}
+func (UnimplementedQueryServer) QueryRelayers(context.Context, *QueryRelayersRequest) (*QueryRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRelayers not implemented")
+}
+func (UnimplementedQueryServer) QueryRunesAttestation(context.Context, *QueryRunesAttestationRequest) (*QueryRunesAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRunesAttestation not implemented")
+}
+func (UnimplementedQueryServer) QueryPauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPauseState not implemented")
+}
+func (UnimplementedQueryServer) QueryPause(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPause not implemented")
+}
+func (UnimplementedQueryServer) QueryPauses(context.Context, *QueryPausesRequest) (*QueryPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPauses not implemented")
+}
+func (UnimplementedQueryServer) QueryScreenedAddress(context.Context, *QueryScreenedAddressRequest) (*QueryScreenedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScreenedAddress not implemented")
+}
+func (UnimplementedQueryServer) QueryScreenedAddresses(context.Context, *QueryScreenedAddressesRequest) (*QueryScreenedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScreenedAddresses not implemented")
+}
+func (UnimplementedQueryServer) QueryQuarantinedDeposits(context.Context, *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuarantinedDeposits not implemented")
+}
+func (UnimplementedQueryServer) QueryQuarantinedWithdrawals(context.Context, *QueryQuarantinedWithdrawalsRequest) (*QueryQuarantinedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuarantinedWithdrawals not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositConfirmationDepth(context.Context, *QueryDepositConfirmationDepthRequest) (*QueryDepositConfirmationDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositConfirmationDepth not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositRecord(context.Context, *QueryDepositRecordRequest) (*QueryDepositRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecord not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositRecords(context.Context, *QueryDepositRecordsRequest) (*QueryDepositRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecords not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositRecordsByRecipient(context.Context, *QueryDepositRecordsByRecipientRequest) (*QueryDepositRecordsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecordsByRecipient not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositRecordsBySender(context.Context, *QueryDepositRecordsBySenderRequest) (*QueryDepositRecordsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecordsBySender not implemented")
+}
+func (UnimplementedQueryServer) QueryRuneEtching(context.Context, *QueryRuneEtchingRequest) (*QueryRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRuneEtching not implemented")
+}
+func (UnimplementedQueryServer) QueryFeeSponsorshipUsage(context.Context, *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeSponsorshipUsage not implemented")
+}
+func (UnimplementedQueryServer) QueryFeeSponsorshipAccountUsage(context.Context, *QueryFeeSponsorshipAccountUsageRequest) (*QueryFeeSponsorshipAccountUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeSponsorshipAccountUsage not implemented")
+}
+func (UnimplementedQueryServer) QueryVaultRecoveries(context.Context, *QueryVaultRecoveriesRequest) (*QueryVaultRecoveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultRecoveries not implemented")
+}
+func (UnimplementedQueryServer) QueryVaultRecoveryPsbt(context.Context, *QueryVaultRecoveryPsbtRequest) (*QueryVaultRecoveryPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultRecoveryPsbt not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -899,6 +1239,366 @@ func _Query_QueryRateLimitByAddress_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRelayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRelayer(ctx, req.(*QueryRelayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRelayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRelayers(ctx, req.(*QueryRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRunesAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunesAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRunesAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRunesAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRunesAttestation(ctx, req.(*QueryRunesAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryPauseState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPause(ctx, req.(*QueryPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPauses(ctx, req.(*QueryPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScreenedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScreenedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryScreenedAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScreenedAddress(ctx, req.(*QueryScreenedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScreenedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScreenedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryScreenedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScreenedAddresses(ctx, req.(*QueryScreenedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryQuarantinedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryQuarantinedDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, req.(*QueryQuarantinedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryQuarantinedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuarantinedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryQuarantinedWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuarantinedWithdrawals(ctx, req.(*QueryQuarantinedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositConfirmationDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositConfirmationDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositConfirmationDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositConfirmationDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositConfirmationDepth(ctx, req.(*QueryDepositConfirmationDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecord(ctx, req.(*QueryDepositRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecords(ctx, req.(*QueryDepositRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecordsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecordsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositRecordsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecordsByRecipient(ctx, req.(*QueryDepositRecordsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecordsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecordsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositRecordsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecordsBySender(ctx, req.(*QueryDepositRecordsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneEtchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRuneEtching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRuneEtching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRuneEtching(ctx, req.(*QueryRuneEtchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeSponsorshipUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeSponsorshipUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryFeeSponsorshipUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeSponsorshipUsage(ctx, req.(*QueryFeeSponsorshipUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeSponsorshipAccountUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipAccountUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeSponsorshipAccountUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryFeeSponsorshipAccountUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeSponsorshipAccountUsage(ctx, req.(*QueryFeeSponsorshipAccountUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultRecoveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRecoveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultRecoveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryVaultRecoveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultRecoveries(ctx, req.(*QueryVaultRecoveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultRecoveryPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRecoveryPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultRecoveryPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryVaultRecoveryPsbt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultRecoveryPsbt(ctx, req.(*QueryVaultRecoveryPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1002,6 +1702,86 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryRateLimitByAddress",
 			Handler:    _Query_QueryRateLimitByAddress_Handler,
 		},
+		{
+			MethodName: "QueryRelayer",
+			Handler:    _Query_QueryRelayer_Handler,
+		},
+		{
+			MethodName: "QueryRelayers",
+			Handler:    _Query_QueryRelayers_Handler,
+		},
+		{
+			MethodName: "QueryRunesAttestation",
+			Handler:    _Query_QueryRunesAttestation_Handler,
+		},
+		{
+			MethodName: "QueryPauseState",
+			Handler:    _Query_QueryPauseState_Handler,
+		},
+		{
+			MethodName: "QueryPause",
+			Handler:    _Query_QueryPause_Handler,
+		},
+		{
+			MethodName: "QueryPauses",
+			Handler:    _Query_QueryPauses_Handler,
+		},
+		{
+			MethodName: "QueryScreenedAddress",
+			Handler:    _Query_QueryScreenedAddress_Handler,
+		},
+		{
+			MethodName: "QueryScreenedAddresses",
+			Handler:    _Query_QueryScreenedAddresses_Handler,
+		},
+		{
+			MethodName: "QueryQuarantinedDeposits",
+			Handler:    _Query_QueryQuarantinedDeposits_Handler,
+		},
+		{
+			MethodName: "QueryQuarantinedWithdrawals",
+			Handler:    _Query_QueryQuarantinedWithdrawals_Handler,
+		},
+		{
+			MethodName: "QueryDepositConfirmationDepth",
+			Handler:    _Query_QueryDepositConfirmationDepth_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecord",
+			Handler:    _Query_QueryDepositRecord_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecords",
+			Handler:    _Query_QueryDepositRecords_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecordsByRecipient",
+			Handler:    _Query_QueryDepositRecordsByRecipient_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecordsBySender",
+			Handler:    _Query_QueryDepositRecordsBySender_Handler,
+		},
+		{
+			MethodName: "QueryRuneEtching",
+			Handler:    _Query_QueryRuneEtching_Handler,
+		},
+		{
+			MethodName: "QueryFeeSponsorshipUsage",
+			Handler:    _Query_QueryFeeSponsorshipUsage_Handler,
+		},
+		{
+			MethodName: "QueryFeeSponsorshipAccountUsage",
+			Handler:    _Query_QueryFeeSponsorshipAccountUsage_Handler,
+		},
+		{
+			MethodName: "QueryVaultRecoveries",
+			Handler:    _Query_QueryVaultRecoveries_Handler,
+		},
+		{
+			MethodName: "QueryVaultRecoveryPsbt",
+			Handler:    _Query_QueryVaultRecoveryPsbt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitway/btcbridge/query.proto",
@@ -19,20 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitDepositTransaction_FullMethodName    = "/bitway.btcbridge.Msg/SubmitDepositTransaction"
-	Msg_SubmitWithdrawTransaction_FullMethodName   = "/bitway.btcbridge.Msg/SubmitWithdrawTransaction"
-	Msg_SubmitFeeRate_FullMethodName               = "/bitway.btcbridge.Msg/SubmitFeeRate"
-	Msg_UpdateTrustedNonBtcRelayers_FullMethodName = "/bitway.btcbridge.Msg/UpdateTrustedNonBtcRelayers"
-	Msg_UpdateTrustedFeeProviders_FullMethodName   = "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders"
-	Msg_WithdrawToBitcoin_FullMethodName           = "/bitway.btcbridge.Msg/WithdrawToBitcoin"
-	Msg_SubmitSignatures_FullMethodName            = "/bitway.btcbridge.Msg/SubmitSignatures"
-	Msg_ConsolidateVaults_FullMethodName           = "/bitway.btcbridge.Msg/ConsolidateVaults"
-	Msg_InitiateDKG_FullMethodName                 = "/bitway.btcbridge.Msg/InitiateDKG"
-	Msg_CompleteDKG_FullMethodName                 = "/bitway.btcbridge.Msg/CompleteDKG"
-	Msg_Refresh_FullMethodName                     = "/bitway.btcbridge.Msg/Refresh"
-	Msg_CompleteRefreshing_FullMethodName          = "/bitway.btcbridge.Msg/CompleteRefreshing"
-	Msg_TransferVault_FullMethodName               = "/bitway.btcbridge.Msg/TransferVault"
-	Msg_UpdateParams_FullMethodName                = "/bitway.btcbridge.Msg/UpdateParams"
+	Msg_SubmitDepositTransaction_FullMethodName  = "/bitway.btcbridge.Msg/SubmitDepositTransaction"
+	Msg_SubmitDepositTransactions_FullMethodName = "/bitway.btcbridge.Msg/SubmitDepositTransactions"
+	Msg_SubmitWithdrawTransaction_FullMethodName = "/bitway.btcbridge.Msg/SubmitWithdrawTransaction"
+	Msg_SubmitFeeRate_FullMethodName             = "/bitway.btcbridge.Msg/SubmitFeeRate"
+	Msg_BondRelayer_FullMethodName               = "/bitway.btcbridge.Msg/BondRelayer"
+	Msg_UnbondRelayer_FullMethodName             = "/bitway.btcbridge.Msg/UnbondRelayer"
+	Msg_SlashRelayer_FullMethodName              = "/bitway.btcbridge.Msg/SlashRelayer"
+	Msg_SubmitRuneEtching_FullMethodName         = "/bitway.btcbridge.Msg/SubmitRuneEtching"
+	Msg_UpdateTrustedFeeProviders_FullMethodName = "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders"
+	Msg_WithdrawToBitcoin_FullMethodName         = "/bitway.btcbridge.Msg/WithdrawToBitcoin"
+	Msg_MultiWithdrawToBitcoin_FullMethodName    = "/bitway.btcbridge.Msg/MultiWithdrawToBitcoin"
+	Msg_SubmitSignatures_FullMethodName          = "/bitway.btcbridge.Msg/SubmitSignatures"
+	Msg_ConsolidateVaults_FullMethodName         = "/bitway.btcbridge.Msg/ConsolidateVaults"
+	Msg_InitiateDKG_FullMethodName               = "/bitway.btcbridge.Msg/InitiateDKG"
+	Msg_CompleteDKG_FullMethodName               = "/bitway.btcbridge.Msg/CompleteDKG"
+	Msg_Refresh_FullMethodName                   = "/bitway.btcbridge.Msg/Refresh"
+	Msg_CompleteRefreshing_FullMethodName        = "/bitway.btcbridge.Msg/CompleteRefreshing"
+	Msg_TransferVault_FullMethodName             = "/bitway.btcbridge.Msg/TransferVault"
+	Msg_Pause_FullMethodName                     = "/bitway.btcbridge.Msg/Pause"
+	Msg_Unpause_FullMethodName                   = "/bitway.btcbridge.Msg/Unpause"
+	Msg_ExtendPause_FullMethodName               = "/bitway.btcbridge.Msg/ExtendPause"
+	Msg_UpdateDenylist_FullMethodName            = "/bitway.btcbridge.Msg/UpdateDenylist"
+	Msg_UpdateRuneEtching_FullMethodName         = "/bitway.btcbridge.Msg/UpdateRuneEtching"
+	Msg_UpdateParams_FullMethodName              = "/bitway.btcbridge.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,16 +50,27 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
 	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitDepositTransactions submits a batch of bitcoin deposit transactions to the bitway chain.
+	SubmitDepositTransactions(ctx context.Context, in *MsgSubmitDepositTransactions, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionsResponse, error)
 	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
 	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
 	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
 	SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
+	// BondRelayer bonds the stake to register or top up the relayer.
+	BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error)
+	// UnbondRelayer starts unbonding the stake of the relayer.
+	UnbondRelayer(ctx context.Context, in *MsgUnbondRelayer, opts ...grpc.CallOption) (*MsgUnbondRelayerResponse, error)
+	// SlashRelayer slashes the relayer for the given misbehaviour adjudicated by governance.
+	// This is a governance only slash and no misbehaviour evidence is verified on chain.
+	SlashRelayer(ctx context.Context, in *MsgSlashRelayer, opts ...grpc.CallOption) (*MsgSlashRelayerResponse, error)
+	// SubmitRuneEtching submits the etching data of the rune by the relayer.
+	SubmitRuneEtching(ctx context.Context, in *MsgSubmitRuneEtching, opts ...grpc.CallOption) (*MsgSubmitRuneEtchingResponse, error)
 	// UpdateTrustedFeeProviders updates the trusted fee providers.
 	UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error)
 	// WithdrawToBitcoin withdraws the asset to bitcoin.
 	WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error)
+	// MultiWithdrawToBitcoin withdraws btc to multiple bitcoin addresses.
+	MultiWithdrawToBitcoin(ctx context.Context, in *MsgMultiWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgMultiWithdrawToBitcoinResponse, error)
 	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
 	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
 	// ConsolidateVaults performs the utxo consolidation for the given vaults.
@@ -64,6 +85,16 @@ type MsgClient interface {
 	CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error)
 	// TransferVault transfers the vault asset from the source version to the destination version.
 	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
+	// Pause pauses the given target by the guardian or governance.
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	// Unpause lifts the active pause of the given target by the guardian or governance.
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	// ExtendPause extends the active pause of the given target by governance.
+	ExtendPause(ctx context.Context, in *MsgExtendPause, opts ...grpc.CallOption) (*MsgExtendPauseResponse, error)
+	// UpdateDenylist updates the address denylist by governance.
+	UpdateDenylist(ctx context.Context, in *MsgUpdateDenylist, opts ...grpc.CallOption) (*MsgUpdateDenylistResponse, error)
+	// UpdateRuneEtching sets the etching data of the rune by governance.
+	UpdateRuneEtching(ctx context.Context, in *MsgUpdateRuneEtching, opts ...grpc.CallOption) (*MsgUpdateRuneEtchingResponse, error)
 	// UpdateParams defines a governance operation for updating the x/btcbridge module
 	// parameters. The authority defaults to the x/gov module account.
 	//
@@ -88,6 +119,15 @@ func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitD
 	return out, nil
 }
 
+func (c *msgClient) SubmitDepositTransactions(ctx context.Context, in *MsgSubmitDepositTransactions, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionsResponse, error) {
+	out := new(MsgSubmitDepositTransactionsResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitDepositTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
 	out := new(MsgSubmitWithdrawTransactionResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitWithdrawTransaction_FullMethodName, in, out, opts...)
@@ -106,9 +146,36 @@ func (c *msgClient) SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opt
 	return out, nil
 }
 
-func (c *msgClient) UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	out := new(MsgUpdateTrustedNonBtcRelayersResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateTrustedNonBtcRelayers_FullMethodName, in, out, opts...)
+func (c *msgClient) BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error) {
+	out := new(MsgBondRelayerResponse)
+	err := c.cc.Invoke(ctx, Msg_BondRelayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondRelayer(ctx context.Context, in *MsgUnbondRelayer, opts ...grpc.CallOption) (*MsgUnbondRelayerResponse, error) {
+	out := new(MsgUnbondRelayerResponse)
+	err := c.cc.Invoke(ctx, Msg_UnbondRelayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SlashRelayer(ctx context.Context, in *MsgSlashRelayer, opts ...grpc.CallOption) (*MsgSlashRelayerResponse, error) {
+	out := new(MsgSlashRelayerResponse)
+	err := c.cc.Invoke(ctx, Msg_SlashRelayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitRuneEtching(ctx context.Context, in *MsgSubmitRuneEtching, opts ...grpc.CallOption) (*MsgSubmitRuneEtchingResponse, error) {
+	out := new(MsgSubmitRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitRuneEtching_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +200,15 @@ func (c *msgClient) WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitc
 	return out, nil
 }
 
+func (c *msgClient) MultiWithdrawToBitcoin(ctx context.Context, in *MsgMultiWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgMultiWithdrawToBitcoinResponse, error) {
+	out := new(MsgMultiWithdrawToBitcoinResponse)
+	err := c.cc.Invoke(ctx, Msg_MultiWithdrawToBitcoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
 	out := new(MsgSubmitSignaturesResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSignatures_FullMethodName, in, out, opts...)
@@ -196,6 +272,51 @@ func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opt
 	return out, nil
 }
 
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Pause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Unpause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendPause(ctx context.Context, in *MsgExtendPause, opts ...grpc.CallOption) (*MsgExtendPauseResponse, error) {
+	out := new(MsgExtendPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenylist(ctx context.Context, in *MsgUpdateDenylist, opts ...grpc.CallOption) (*MsgUpdateDenylistResponse, error) {
+	out := new(MsgUpdateDenylistResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRuneEtching(ctx context.Context, in *MsgUpdateRuneEtching, opts ...grpc.CallOption) (*MsgUpdateRuneEtchingResponse, error) {
+	out := new(MsgUpdateRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRuneEtching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -210,16 +331,27 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 // for forward compatibility
 type MsgServer interface {
 	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitDepositTransactions submits a batch of bitcoin deposit transactions to the bitway chain.
+	SubmitDepositTransactions(context.Context, *MsgSubmitDepositTransactions) (*MsgSubmitDepositTransactionsResponse, error)
 	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
 	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error)
 	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
 	SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(context.Context, *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
+	// BondRelayer bonds the stake to register or top up the relayer.
+	BondRelayer(context.Context, *MsgBondRelayer) (*MsgBondRelayerResponse, error)
+	// UnbondRelayer starts unbonding the stake of the relayer.
+	UnbondRelayer(context.Context, *MsgUnbondRelayer) (*MsgUnbondRelayerResponse, error)
+	// SlashRelayer slashes the relayer for the given misbehaviour adjudicated by governance.
+	// This is a governance only slash and no misbehaviour evidence is verified on chain.
+	SlashRelayer(context.Context, *MsgSlashRelayer) (*MsgSlashRelayerResponse, error)
+	// SubmitRuneEtching submits the etching data of the rune by the relayer.
+	SubmitRuneEtching(context.Context, *MsgSubmitRuneEtching) (*MsgSubmitRuneEtchingResponse, error)
 	// UpdateTrustedFeeProviders updates the trusted fee providers.
 	UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error)
 	// WithdrawToBitcoin withdraws the asset to bitcoin.
 	WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error)
+	// MultiWithdrawToBitcoin withdraws btc to multiple bitcoin addresses.
+	MultiWithdrawToBitcoin(context.Context, *MsgMultiWithdrawToBitcoin) (*MsgMultiWithdrawToBitcoinResponse, error)
 	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
 	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
 	// ConsolidateVaults performs the utxo consolidation for the given vaults.
@@ -234,6 +366,16 @@ type MsgServer interface {
 	CompleteRefreshing(context.Context, *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error)
 	// TransferVault transfers the vault asset from the source version to the destination version.
 	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
+	// Pause pauses the given target by the guardian or governance.
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	// Unpause lifts the active pause of the given target by the guardian or governance.
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	// ExtendPause extends the active pause of the given target by governance.
+	ExtendPause(context.Context, *MsgExtendPause) (*MsgExtendPauseResponse, error)
+	// UpdateDenylist updates the address denylist by governance.
+	UpdateDenylist(context.Context, *MsgUpdateDenylist) (*MsgUpdateDenylistResponse, error)
+	// UpdateRuneEtching sets the etching data of the rune by governance.
+	UpdateRuneEtching(context.Context, *MsgUpdateRuneEtching) (*MsgUpdateRuneEtchingResponse, error)
 	// UpdateParams defines a governance operation for updating the x/btcbridge module
 	// parameters. The authority defaults to the x/gov module account.
 	//
@@ -249,14 +391,26 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
 }
+func (UnimplementedMsgServer) SubmitDepositTransactions(context.Context, *MsgSubmitDepositTransactions) (*MsgSubmitDepositTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransactions not implemented")
+}
 func (UnimplementedMsgServer) SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
 }
 func (UnimplementedMsgServer) SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeeRate not implemented")
 }
-func (UnimplementedMsgServer) UpdateTrustedNonBtcRelayers(context.Context, *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedNonBtcRelayers not implemented")
+func (UnimplementedMsgServer) BondRelayer(context.Context, *MsgBondRelayer) (*MsgBondRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondRelayer not implemented")
+}
+func (UnimplementedMsgServer) UnbondRelayer(context.Context, *MsgUnbondRelayer) (*MsgUnbondRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondRelayer not implemented")
+}
+func (UnimplementedMsgServer) SlashRelayer(context.Context, *MsgSlashRelayer) (*MsgSlashRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRelayer not implemented")
+}
+func (UnimplementedMsgServer) SubmitRuneEtching(context.Context, *MsgSubmitRuneEtching) (*MsgSubmitRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRuneEtching not implemented")
 }
 func (UnimplementedMsgServer) UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedFeeProviders not implemented")
@@ -264,6 +418,9 @@ func (UnimplementedMsgServer) UpdateTrustedFeeProviders(context.Context, *MsgUpd
 func (UnimplementedMsgServer) WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawToBitcoin not implemented")
 }
+func (UnimplementedMsgServer) MultiWithdrawToBitcoin(context.Context, *MsgMultiWithdrawToBitcoin) (*MsgMultiWithdrawToBitcoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiWithdrawToBitcoin not implemented")
+}
 func (UnimplementedMsgServer) SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
 }
@@ -285,6 +442,21 @@ func (UnimplementedMsgServer) CompleteRefreshing(context.Context, *MsgCompleteRe
 func (UnimplementedMsgServer) TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
 }
+func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedMsgServer) ExtendPause(context.Context, *MsgExtendPause) (*MsgExtendPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendPause not implemented")
+}
+func (UnimplementedMsgServer) UpdateDenylist(context.Context, *MsgUpdateDenylist) (*MsgUpdateDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenylist not implemented")
+}
+func (UnimplementedMsgServer) UpdateRuneEtching(context.Context, *MsgUpdateRuneEtching) (*MsgUpdateRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuneEtching not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -319,6 +491,24 @@ func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitDepositTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDepositTransactions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDepositTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitDepositTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDepositTransactions(ctx, req.(*MsgSubmitDepositTransactions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitWithdrawTransaction)
 	if err := dec(in); err != nil {
@@ -355,20 +545,74 @@ func _Msg_SubmitFeeRate_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateTrustedNonBtcRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTrustedNonBtcRelayers)
+func _Msg_BondRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BondRelayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondRelayer(ctx, req.(*MsgBondRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnbondRelayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondRelayer(ctx, req.(*MsgUnbondRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SlashRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SlashRelayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashRelayer(ctx, req.(*MsgSlashRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRuneEtching)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, in)
+		return srv.(MsgServer).SubmitRuneEtching(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_UpdateTrustedNonBtcRelayers_FullMethodName,
+		FullMethod: Msg_SubmitRuneEtching_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, req.(*MsgUpdateTrustedNonBtcRelayers))
+		return srv.(MsgServer).SubmitRuneEtching(ctx, req.(*MsgSubmitRuneEtching))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -409,6 +653,24 @@ func _Msg_WithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiWithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiWithdrawToBitcoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiWithdrawToBitcoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MultiWithdrawToBitcoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiWithdrawToBitcoin(ctx, req.(*MsgMultiWithdrawToBitcoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSignatures)
 	if err := dec(in); err != nil {
@@ -535,6 +797,96 @@ func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendPause(ctx, req.(*MsgExtendPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenylist(ctx, req.(*MsgUpdateDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRuneEtching)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRuneEtching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRuneEtching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRuneEtching(ctx, req.(*MsgUpdateRuneEtching))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -564,6 +916,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitDepositTransaction",
 			Handler:    _Msg_SubmitDepositTransaction_Handler,
 		},
+		{
+			MethodName: "SubmitDepositTransactions",
+			Handler:    _Msg_SubmitDepositTransactions_Handler,
+		},
 		{
 			MethodName: "SubmitWithdrawTransaction",
 			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
@@ -573,8 +929,20 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Msg_SubmitFeeRate_Handler,
 		},
 		{
-			MethodName: "UpdateTrustedNonBtcRelayers",
-			Handler:    _Msg_UpdateTrustedNonBtcRelayers_Handler,
+			MethodName: "BondRelayer",
+			Handler:    _Msg_BondRelayer_Handler,
+		},
+		{
+			MethodName: "UnbondRelayer",
+			Handler:    _Msg_UnbondRelayer_Handler,
+		},
+		{
+			MethodName: "SlashRelayer",
+			Handler:    _Msg_SlashRelayer_Handler,
+		},
+		{
+			MethodName: "SubmitRuneEtching",
+			Handler:    _Msg_SubmitRuneEtching_Handler,
 		},
 		{
 			MethodName: "UpdateTrustedFeeProviders",
@@ -584,6 +952,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawToBitcoin",
 			Handler:    _Msg_WithdrawToBitcoin_Handler,
 		},
+		{
+			MethodName: "MultiWithdrawToBitcoin",
+			Handler:    _Msg_MultiWithdrawToBitcoin_Handler,
+		},
 		{
 			MethodName: "SubmitSignatures",
 			Handler:    _Msg_SubmitSignatures_Handler,
@@ -612,6 +984,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferVault",
 			Handler:    _Msg_TransferVault_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "ExtendPause",
+			Handler:    _Msg_ExtendPause_Handler,
+		},
+		{
+			MethodName: "UpdateDenylist",
+			Handler:    _Msg_UpdateDenylist_Handler,
+		},
+		{
+			MethodName: "UpdateRuneEtching",
+			Handler:    _Msg_UpdateRuneEtching_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
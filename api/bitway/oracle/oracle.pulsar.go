@@ -1483,12 +1483,59 @@ func (x *_OracleVoteExtension_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_OracleVoteExtension_5_list)(nil)
+
+type _OracleVoteExtension_5_list struct {
+	list *[]string
+}
+
+func (x *_OracleVoteExtension_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleVoteExtension_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OracleVoteExtension_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleVoteExtension_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleVoteExtension_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OracleVoteExtension at list field ScreenedAddresses as it is not of Message kind"))
+}
+
+func (x *_OracleVoteExtension_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleVoteExtension_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OracleVoteExtension_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_OracleVoteExtension           protoreflect.MessageDescriptor
-	fd_OracleVoteExtension_height    protoreflect.FieldDescriptor
-	fd_OracleVoteExtension_prices    protoreflect.FieldDescriptor
-	fd_OracleVoteExtension_blocks    protoreflect.FieldDescriptor
-	fd_OracleVoteExtension_has_error protoreflect.FieldDescriptor
+	md_OracleVoteExtension                    protoreflect.MessageDescriptor
+	fd_OracleVoteExtension_height             protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_prices             protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_blocks             protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_has_error          protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_screened_addresses protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1498,6 +1545,7 @@ func init() {
 	fd_OracleVoteExtension_prices = md_OracleVoteExtension.Fields().ByName("prices")
 	fd_OracleVoteExtension_blocks = md_OracleVoteExtension.Fields().ByName("blocks")
 	fd_OracleVoteExtension_has_error = md_OracleVoteExtension.Fields().ByName("has_error")
+	fd_OracleVoteExtension_screened_addresses = md_OracleVoteExtension.Fields().ByName("screened_addresses")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleVoteExtension)(nil)
@@ -1589,6 +1637,12 @@ func (x *fastReflection_OracleVoteExtension) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.ScreenedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_OracleVoteExtension_5_list{list: &x.ScreenedAddresses})
+		if !f(fd_OracleVoteExtension_screened_addresses, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1612,6 +1666,8 @@ func (x *fastReflection_OracleVoteExtension) Has(fd protoreflect.FieldDescriptor
 		return len(x.Blocks) != 0
 	case "bitway.oracle.OracleVoteExtension.has_error":
 		return x.HasError != false
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		return len(x.ScreenedAddresses) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.oracle.OracleVoteExtension"))
@@ -1636,6 +1692,8 @@ func (x *fastReflection_OracleVoteExtension) Clear(fd protoreflect.FieldDescript
 		x.Blocks = nil
 	case "bitway.oracle.OracleVoteExtension.has_error":
 		x.HasError = false
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		x.ScreenedAddresses = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.oracle.OracleVoteExtension"))
@@ -1670,6 +1728,12 @@ func (x *fastReflection_OracleVoteExtension) Get(descriptor protoreflect.FieldDe
 	case "bitway.oracle.OracleVoteExtension.has_error":
 		value := x.HasError
 		return protoreflect.ValueOfBool(value)
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		if len(x.ScreenedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_OracleVoteExtension_5_list{})
+		}
+		listValue := &_OracleVoteExtension_5_list{list: &x.ScreenedAddresses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.oracle.OracleVoteExtension"))
@@ -1702,6 +1766,10 @@ func (x *fastReflection_OracleVoteExtension) Set(fd protoreflect.FieldDescriptor
 		x.Blocks = *clv.list
 	case "bitway.oracle.OracleVoteExtension.has_error":
 		x.HasError = value.Bool()
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		lv := value.List()
+		clv := lv.(*_OracleVoteExtension_5_list)
+		x.ScreenedAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.oracle.OracleVoteExtension"))
@@ -1734,6 +1802,12 @@ func (x *fastReflection_OracleVoteExtension) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_OracleVoteExtension_3_list{list: &x.Blocks}
 		return protoreflect.ValueOfList(value)
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		if x.ScreenedAddresses == nil {
+			x.ScreenedAddresses = []string{}
+		}
+		value := &_OracleVoteExtension_5_list{list: &x.ScreenedAddresses}
+		return protoreflect.ValueOfList(value)
 	case "bitway.oracle.OracleVoteExtension.height":
 		panic(fmt.Errorf("field height of message bitway.oracle.OracleVoteExtension is not mutable"))
 	case "bitway.oracle.OracleVoteExtension.has_error":
@@ -1761,6 +1835,9 @@ func (x *fastReflection_OracleVoteExtension) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfList(&_OracleVoteExtension_3_list{list: &list})
 	case "bitway.oracle.OracleVoteExtension.has_error":
 		return protoreflect.ValueOfBool(false)
+	case "bitway.oracle.OracleVoteExtension.screened_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OracleVoteExtension_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.oracle.OracleVoteExtension"))
@@ -1863,6 +1940,12 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 		if x.HasError {
 			n += 2
 		}
+		if len(x.ScreenedAddresses) > 0 {
+			for _, s := range x.ScreenedAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1892,6 +1975,15 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ScreenedAddresses) > 0 {
+			for iNdEx := len(x.ScreenedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ScreenedAddresses[iNdEx])
+				copy(dAtA[i:], x.ScreenedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScreenedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.HasError {
 			i--
 			if x.HasError {
@@ -2215,6 +2307,38 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 					}
 				}
 				x.HasError = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScreenedAddresses = append(x.ScreenedAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2417,6 +2541,8 @@ type OracleVoteExtension struct {
 	Prices   map[string]string `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Blocks   []*BlockHeader    `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
 	HasError bool              `protobuf:"varint,4,opt,name=has_error,json=hasError,proto3" json:"has_error,omitempty"`
+	// addresses flagged by the optional off-chain screener
+	ScreenedAddresses []string `protobuf:"bytes,5,rep,name=screened_addresses,json=screenedAddresses,proto3" json:"screened_addresses,omitempty"`
 }
 
 func (x *OracleVoteExtension) Reset() {
@@ -2467,6 +2593,13 @@ func (x *OracleVoteExtension) GetHasError() bool {
 	return false
 }
 
+func (x *OracleVoteExtension) GetScreenedAddresses() []string {
+	if x != nil {
+		return x.ScreenedAddresses
+	}
+	return nil
+}
+
 var File_bitway_oracle_oracle_proto protoreflect.FileDescriptor
 
 var file_bitway_oracle_oracle_proto_rawDesc = []byte{
@@ -2495,7 +2628,7 @@ var file_bitway_oracle_oracle_proto_rawDesc = []byte{
 	0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x62, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x74, 0x78, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x74, 0x78, 0x22, 0x87, 0x02, 0x0a, 0x13, 0x4f,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x74, 0x78, 0x22, 0xb6, 0x02, 0x0a, 0x13, 0x4f,
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a, 0x06, 0x70, 0x72,
@@ -2508,22 +2641,25 @@ var file_bitway_oracle_oracle_proto_rawDesc = []byte{
 	0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
 	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09,
 	0x68, 0x61, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x68, 0x61, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0xa5, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74,
-	0x77, 0x61, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa,
-	0x02, 0x0d, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xca,
-	0x02, 0x0d, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xe2,
-	0x02, 0x19, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x42, 0x69,
-	0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x08, 0x68, 0x61, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0xa5, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02,
+	0x0d, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xca, 0x02,
+	0x0d, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0xe2, 0x02,
+	0x19, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x42, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -115,11 +115,323 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ParticipantReliability
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantReliability)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantReliability)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantReliability)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ParticipantReliability)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*SigningFee
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(SigningFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(SigningFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*ParticipantRewards
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantRewards)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantRewards)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantRewards)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(ParticipantRewards)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*KeyRotation
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRotation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRotation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(KeyRotation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(KeyRotation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*SigningBatch
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningBatch)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningBatch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(SigningBatch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(SigningBatch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*SigningStats
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(SigningStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(SigningStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_params           protoreflect.FieldDescriptor
-	fd_GenesisState_dkg_requests     protoreflect.FieldDescriptor
-	fd_GenesisState_signing_requests protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_dkg_requests              protoreflect.FieldDescriptor
+	fd_GenesisState_signing_requests          protoreflect.FieldDescriptor
+	fd_GenesisState_participant_reliabilities protoreflect.FieldDescriptor
+	fd_GenesisState_signing_fees              protoreflect.FieldDescriptor
+	fd_GenesisState_participant_rewards       protoreflect.FieldDescriptor
+	fd_GenesisState_key_rotations             protoreflect.FieldDescriptor
+	fd_GenesisState_signing_batches           protoreflect.FieldDescriptor
+	fd_GenesisState_signing_stats             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -128,6 +440,12 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_dkg_requests = md_GenesisState.Fields().ByName("dkg_requests")
 	fd_GenesisState_signing_requests = md_GenesisState.Fields().ByName("signing_requests")
+	fd_GenesisState_participant_reliabilities = md_GenesisState.Fields().ByName("participant_reliabilities")
+	fd_GenesisState_signing_fees = md_GenesisState.Fields().ByName("signing_fees")
+	fd_GenesisState_participant_rewards = md_GenesisState.Fields().ByName("participant_rewards")
+	fd_GenesisState_key_rotations = md_GenesisState.Fields().ByName("key_rotations")
+	fd_GenesisState_signing_batches = md_GenesisState.Fields().ByName("signing_batches")
+	fd_GenesisState_signing_stats = md_GenesisState.Fields().ByName("signing_stats")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -213,6 +531,42 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ParticipantReliabilities) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ParticipantReliabilities})
+		if !f(fd_GenesisState_participant_reliabilities, value) {
+			return
+		}
+	}
+	if len(x.SigningFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SigningFees})
+		if !f(fd_GenesisState_signing_fees, value) {
+			return
+		}
+	}
+	if len(x.ParticipantRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.ParticipantRewards})
+		if !f(fd_GenesisState_participant_rewards, value) {
+			return
+		}
+	}
+	if len(x.KeyRotations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.KeyRotations})
+		if !f(fd_GenesisState_key_rotations, value) {
+			return
+		}
+	}
+	if len(x.SigningBatches) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.SigningBatches})
+		if !f(fd_GenesisState_signing_batches, value) {
+			return
+		}
+	}
+	if len(x.SigningStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.SigningStats})
+		if !f(fd_GenesisState_signing_stats, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -234,6 +588,18 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.DkgRequests) != 0
 	case "bitway.tss.GenesisState.signing_requests":
 		return len(x.SigningRequests) != 0
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		return len(x.ParticipantReliabilities) != 0
+	case "bitway.tss.GenesisState.signing_fees":
+		return len(x.SigningFees) != 0
+	case "bitway.tss.GenesisState.participant_rewards":
+		return len(x.ParticipantRewards) != 0
+	case "bitway.tss.GenesisState.key_rotations":
+		return len(x.KeyRotations) != 0
+	case "bitway.tss.GenesisState.signing_batches":
+		return len(x.SigningBatches) != 0
+	case "bitway.tss.GenesisState.signing_stats":
+		return len(x.SigningStats) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -256,6 +622,18 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DkgRequests = nil
 	case "bitway.tss.GenesisState.signing_requests":
 		x.SigningRequests = nil
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		x.ParticipantReliabilities = nil
+	case "bitway.tss.GenesisState.signing_fees":
+		x.SigningFees = nil
+	case "bitway.tss.GenesisState.participant_rewards":
+		x.ParticipantRewards = nil
+	case "bitway.tss.GenesisState.key_rotations":
+		x.KeyRotations = nil
+	case "bitway.tss.GenesisState.signing_batches":
+		x.SigningBatches = nil
+	case "bitway.tss.GenesisState.signing_stats":
+		x.SigningStats = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -287,6 +665,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.SigningRequests}
 		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		if len(x.ParticipantReliabilities) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ParticipantReliabilities}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.signing_fees":
+		if len(x.SigningFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.SigningFees}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.participant_rewards":
+		if len(x.ParticipantRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.ParticipantRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.key_rotations":
+		if len(x.KeyRotations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.KeyRotations}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.signing_batches":
+		if len(x.SigningBatches) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.SigningBatches}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.GenesisState.signing_stats":
+		if len(x.SigningStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.SigningStats}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -317,6 +731,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.SigningRequests = *clv.list
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ParticipantReliabilities = *clv.list
+	case "bitway.tss.GenesisState.signing_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.SigningFees = *clv.list
+	case "bitway.tss.GenesisState.participant_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.ParticipantRewards = *clv.list
+	case "bitway.tss.GenesisState.key_rotations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.KeyRotations = *clv.list
+	case "bitway.tss.GenesisState.signing_batches":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.SigningBatches = *clv.list
+	case "bitway.tss.GenesisState.signing_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.SigningStats = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -354,6 +792,42 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.SigningRequests}
 		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		if x.ParticipantReliabilities == nil {
+			x.ParticipantReliabilities = []*ParticipantReliability{}
+		}
+		value := &_GenesisState_4_list{list: &x.ParticipantReliabilities}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.signing_fees":
+		if x.SigningFees == nil {
+			x.SigningFees = []*SigningFee{}
+		}
+		value := &_GenesisState_5_list{list: &x.SigningFees}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.participant_rewards":
+		if x.ParticipantRewards == nil {
+			x.ParticipantRewards = []*ParticipantRewards{}
+		}
+		value := &_GenesisState_6_list{list: &x.ParticipantRewards}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.key_rotations":
+		if x.KeyRotations == nil {
+			x.KeyRotations = []*KeyRotation{}
+		}
+		value := &_GenesisState_7_list{list: &x.KeyRotations}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.signing_batches":
+		if x.SigningBatches == nil {
+			x.SigningBatches = []*SigningBatch{}
+		}
+		value := &_GenesisState_8_list{list: &x.SigningBatches}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GenesisState.signing_stats":
+		if x.SigningStats == nil {
+			x.SigningStats = []*SigningStats{}
+		}
+		value := &_GenesisState_9_list{list: &x.SigningStats}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -376,6 +850,24 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "bitway.tss.GenesisState.signing_requests":
 		list := []*SigningRequest{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "bitway.tss.GenesisState.participant_reliabilities":
+		list := []*ParticipantReliability{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "bitway.tss.GenesisState.signing_fees":
+		list := []*SigningFee{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "bitway.tss.GenesisState.participant_rewards":
+		list := []*ParticipantRewards{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "bitway.tss.GenesisState.key_rotations":
+		list := []*KeyRotation{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "bitway.tss.GenesisState.signing_batches":
+		list := []*SigningBatch{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "bitway.tss.GenesisState.signing_stats":
+		list := []*SigningStats{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GenesisState"))
@@ -455,41 +947,173 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.SigningRequests) > 0 {
-			for _, e := range x.SigningRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SigningRequests) > 0 {
+			for _, e := range x.SigningRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ParticipantReliabilities) > 0 {
+			for _, e := range x.ParticipantReliabilities {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SigningFees) > 0 {
+			for _, e := range x.SigningFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ParticipantRewards) > 0 {
+			for _, e := range x.ParticipantRewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyRotations) > 0 {
+			for _, e := range x.KeyRotations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SigningBatches) > 0 {
+			for _, e := range x.SigningBatches {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SigningStats) > 0 {
+			for _, e := range x.SigningStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SigningStats) > 0 {
+			for iNdEx := len(x.SigningStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.SigningBatches) > 0 {
+			for iNdEx := len(x.SigningBatches) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningBatches[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.KeyRotations) > 0 {
+			for iNdEx := len(x.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyRotations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ParticipantRewards) > 0 {
+			for iNdEx := len(x.ParticipantRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SigningFees) > 0 {
+			for iNdEx := len(x.SigningFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ParticipantReliabilities) > 0 {
+			for iNdEx := len(x.ParticipantReliabilities) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantReliabilities[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
 		if len(x.SigningRequests) > 0 {
 			for iNdEx := len(x.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.SigningRequests[iNdEx])
@@ -689,6 +1313,210 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantReliabilities", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantReliabilities = append(x.ParticipantReliabilities, &ParticipantReliability{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantReliabilities[len(x.ParticipantReliabilities)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningFees = append(x.SigningFees, &SigningFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningFees[len(x.SigningFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantRewards = append(x.ParticipantRewards, &ParticipantRewards{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantRewards[len(x.ParticipantRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyRotations = append(x.KeyRotations, &KeyRotation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyRotations[len(x.KeyRotations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningBatches", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningBatches = append(x.SigningBatches, &SigningBatch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningBatches[len(x.SigningBatches)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningStats = append(x.SigningStats, &SigningStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningStats[len(x.SigningStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -743,9 +1571,15 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params          *Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	DkgRequests     []*DKGRequest     `protobuf:"bytes,2,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
-	SigningRequests []*SigningRequest `protobuf:"bytes,3,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	Params                   *Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	DkgRequests              []*DKGRequest             `protobuf:"bytes,2,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
+	SigningRequests          []*SigningRequest         `protobuf:"bytes,3,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	ParticipantReliabilities []*ParticipantReliability `protobuf:"bytes,4,rep,name=participant_reliabilities,json=participantReliabilities,proto3" json:"participant_reliabilities,omitempty"`
+	SigningFees              []*SigningFee             `protobuf:"bytes,5,rep,name=signing_fees,json=signingFees,proto3" json:"signing_fees,omitempty"`
+	ParticipantRewards       []*ParticipantRewards     `protobuf:"bytes,6,rep,name=participant_rewards,json=participantRewards,proto3" json:"participant_rewards,omitempty"`
+	KeyRotations             []*KeyRotation            `protobuf:"bytes,7,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	SigningBatches           []*SigningBatch           `protobuf:"bytes,8,rep,name=signing_batches,json=signingBatches,proto3" json:"signing_batches,omitempty"`
+	SigningStats             []*SigningStats           `protobuf:"bytes,9,rep,name=signing_stats,json=signingStats,proto3" json:"signing_stats,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -789,6 +1623,48 @@ func (x *GenesisState) GetSigningRequests() []*SigningRequest {
 	return nil
 }
 
+func (x *GenesisState) GetParticipantReliabilities() []*ParticipantReliability {
+	if x != nil {
+		return x.ParticipantReliabilities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSigningFees() []*SigningFee {
+	if x != nil {
+		return x.SigningFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParticipantRewards() []*ParticipantRewards {
+	if x != nil {
+		return x.ParticipantRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKeyRotations() []*KeyRotation {
+	if x != nil {
+		return x.KeyRotations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSigningBatches() []*SigningBatch {
+	if x != nil {
+		return x.SigningBatches
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSigningStats() []*SigningStats {
+	if x != nil {
+		return x.SigningStats
+	}
+	return nil
+}
+
 var File_bitway_tss_genesis_proto protoreflect.FileDescriptor
 
 var file_bitway_tss_genesis_proto_rawDesc = []byte{
@@ -798,7 +1674,7 @@ var file_bitway_tss_genesis_proto_rawDesc = []byte{
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x62, 0x69,
 	0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73,
-	0x73, 0x2f, 0x74, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x0c,
+	0x73, 0x2f, 0x74, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x04, 0x0a, 0x0c,
 	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x06,
 	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
 	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -811,16 +1687,43 @@ var file_bitway_tss_genesis_proto_rawDesc = []byte{
 	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
 	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
 	0x0f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x42, 0x94, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x74, 0x73, 0x73, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73,
-	0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x54, 0x73, 0x73, 0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73,
-	0xe2, 0x02, 0x16, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x5f, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x69,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x18, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x13,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3c, 0x0a,
+	0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73,
+	0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6b,
+	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0e,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x94, 0x01,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0xa2, 0x02, 0x03,
+	0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x73, 0x73,
+	0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0xe2, 0x02, 0x16,
+	0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a,
+	0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -837,20 +1740,32 @@ func file_bitway_tss_genesis_proto_rawDescGZIP() []byte {
 
 var file_bitway_tss_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_bitway_tss_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),   // 0: bitway.tss.GenesisState
-	(*Params)(nil),         // 1: bitway.tss.Params
-	(*DKGRequest)(nil),     // 2: bitway.tss.DKGRequest
-	(*SigningRequest)(nil), // 3: bitway.tss.SigningRequest
+	(*GenesisState)(nil),           // 0: bitway.tss.GenesisState
+	(*Params)(nil),                 // 1: bitway.tss.Params
+	(*DKGRequest)(nil),             // 2: bitway.tss.DKGRequest
+	(*SigningRequest)(nil),         // 3: bitway.tss.SigningRequest
+	(*ParticipantReliability)(nil), // 4: bitway.tss.ParticipantReliability
+	(*SigningFee)(nil),             // 5: bitway.tss.SigningFee
+	(*ParticipantRewards)(nil),     // 6: bitway.tss.ParticipantRewards
+	(*KeyRotation)(nil),            // 7: bitway.tss.KeyRotation
+	(*SigningBatch)(nil),           // 8: bitway.tss.SigningBatch
+	(*SigningStats)(nil),           // 9: bitway.tss.SigningStats
 }
 var file_bitway_tss_genesis_proto_depIdxs = []int32{
 	1, // 0: bitway.tss.GenesisState.params:type_name -> bitway.tss.Params
 	2, // 1: bitway.tss.GenesisState.dkg_requests:type_name -> bitway.tss.DKGRequest
 	3, // 2: bitway.tss.GenesisState.signing_requests:type_name -> bitway.tss.SigningRequest
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: bitway.tss.GenesisState.participant_reliabilities:type_name -> bitway.tss.ParticipantReliability
+	5, // 4: bitway.tss.GenesisState.signing_fees:type_name -> bitway.tss.SigningFee
+	6, // 5: bitway.tss.GenesisState.participant_rewards:type_name -> bitway.tss.ParticipantRewards
+	7, // 6: bitway.tss.GenesisState.key_rotations:type_name -> bitway.tss.KeyRotation
+	8, // 7: bitway.tss.GenesisState.signing_batches:type_name -> bitway.tss.SigningBatch
+	9, // 8: bitway.tss.GenesisState.signing_stats:type_name -> bitway.tss.SigningStats
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_bitway_tss_genesis_proto_init() }
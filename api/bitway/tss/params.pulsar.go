@@ -2,7 +2,9 @@
 package tss
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -65,10 +67,171 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*ModuleSigningTimeout
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleSigningTimeout)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleSigningTimeout)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleSigningTimeout)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(ModuleSigningTimeout)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]*KeyRotationPolicy
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRotationPolicy)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRotationPolicy)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	v := new(KeyRotationPolicy)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := new(KeyRotationPolicy)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]*ModuleSigningFee
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleSigningFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleSigningFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleSigningFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := new(ModuleSigningFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                          protoreflect.MessageDescriptor
-	fd_Params_allowed_dkg_participants protoreflect.FieldDescriptor
-	fd_Params_dkg_timeout_duration     protoreflect.FieldDescriptor
+	md_Params                            protoreflect.MessageDescriptor
+	fd_Params_allowed_dkg_participants   protoreflect.FieldDescriptor
+	fd_Params_dkg_timeout_duration       protoreflect.FieldDescriptor
+	fd_Params_signing_timeout_duration   protoreflect.FieldDescriptor
+	fd_Params_module_signing_timeouts    protoreflect.FieldDescriptor
+	fd_Params_participant_penalty_params protoreflect.FieldDescriptor
+	fd_Params_participant_set_params     protoreflect.FieldDescriptor
+	fd_Params_nonce_queue_params         protoreflect.FieldDescriptor
+	fd_Params_key_rotation_policies      protoreflect.FieldDescriptor
+	fd_Params_max_signing_retries        protoreflect.FieldDescriptor
+	fd_Params_module_signing_fees        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +239,14 @@ func init() {
 	md_Params = File_bitway_tss_params_proto.Messages().ByName("Params")
 	fd_Params_allowed_dkg_participants = md_Params.Fields().ByName("allowed_dkg_participants")
 	fd_Params_dkg_timeout_duration = md_Params.Fields().ByName("dkg_timeout_duration")
+	fd_Params_signing_timeout_duration = md_Params.Fields().ByName("signing_timeout_duration")
+	fd_Params_module_signing_timeouts = md_Params.Fields().ByName("module_signing_timeouts")
+	fd_Params_participant_penalty_params = md_Params.Fields().ByName("participant_penalty_params")
+	fd_Params_participant_set_params = md_Params.Fields().ByName("participant_set_params")
+	fd_Params_nonce_queue_params = md_Params.Fields().ByName("nonce_queue_params")
+	fd_Params_key_rotation_policies = md_Params.Fields().ByName("key_rotation_policies")
+	fd_Params_max_signing_retries = md_Params.Fields().ByName("max_signing_retries")
+	fd_Params_module_signing_fees = md_Params.Fields().ByName("module_signing_fees")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -155,6 +326,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SigningTimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.SigningTimeoutDuration.ProtoReflect())
+		if !f(fd_Params_signing_timeout_duration, value) {
+			return
+		}
+	}
+	if len(x.ModuleSigningTimeouts) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.ModuleSigningTimeouts})
+		if !f(fd_Params_module_signing_timeouts, value) {
+			return
+		}
+	}
+	if x.ParticipantPenaltyParams != nil {
+		value := protoreflect.ValueOfMessage(x.ParticipantPenaltyParams.ProtoReflect())
+		if !f(fd_Params_participant_penalty_params, value) {
+			return
+		}
+	}
+	if x.ParticipantSetParams != nil {
+		value := protoreflect.ValueOfMessage(x.ParticipantSetParams.ProtoReflect())
+		if !f(fd_Params_participant_set_params, value) {
+			return
+		}
+	}
+	if x.NonceQueueParams != nil {
+		value := protoreflect.ValueOfMessage(x.NonceQueueParams.ProtoReflect())
+		if !f(fd_Params_nonce_queue_params, value) {
+			return
+		}
+	}
+	if len(x.KeyRotationPolicies) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.KeyRotationPolicies})
+		if !f(fd_Params_key_rotation_policies, value) {
+			return
+		}
+	}
+	if x.MaxSigningRetries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSigningRetries)
+		if !f(fd_Params_max_signing_retries, value) {
+			return
+		}
+	}
+	if len(x.ModuleSigningFees) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.ModuleSigningFees})
+		if !f(fd_Params_module_signing_fees, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,6 +393,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.AllowedDkgParticipants) != 0
 	case "bitway.tss.Params.dkg_timeout_duration":
 		return x.DkgTimeoutDuration != nil
+	case "bitway.tss.Params.signing_timeout_duration":
+		return x.SigningTimeoutDuration != nil
+	case "bitway.tss.Params.module_signing_timeouts":
+		return len(x.ModuleSigningTimeouts) != 0
+	case "bitway.tss.Params.participant_penalty_params":
+		return x.ParticipantPenaltyParams != nil
+	case "bitway.tss.Params.participant_set_params":
+		return x.ParticipantSetParams != nil
+	case "bitway.tss.Params.nonce_queue_params":
+		return x.NonceQueueParams != nil
+	case "bitway.tss.Params.key_rotation_policies":
+		return len(x.KeyRotationPolicies) != 0
+	case "bitway.tss.Params.max_signing_retries":
+		return x.MaxSigningRetries != uint32(0)
+	case "bitway.tss.Params.module_signing_fees":
+		return len(x.ModuleSigningFees) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -194,6 +429,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AllowedDkgParticipants = nil
 	case "bitway.tss.Params.dkg_timeout_duration":
 		x.DkgTimeoutDuration = nil
+	case "bitway.tss.Params.signing_timeout_duration":
+		x.SigningTimeoutDuration = nil
+	case "bitway.tss.Params.module_signing_timeouts":
+		x.ModuleSigningTimeouts = nil
+	case "bitway.tss.Params.participant_penalty_params":
+		x.ParticipantPenaltyParams = nil
+	case "bitway.tss.Params.participant_set_params":
+		x.ParticipantSetParams = nil
+	case "bitway.tss.Params.nonce_queue_params":
+		x.NonceQueueParams = nil
+	case "bitway.tss.Params.key_rotation_policies":
+		x.KeyRotationPolicies = nil
+	case "bitway.tss.Params.max_signing_retries":
+		x.MaxSigningRetries = uint32(0)
+	case "bitway.tss.Params.module_signing_fees":
+		x.ModuleSigningFees = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -219,6 +470,39 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "bitway.tss.Params.dkg_timeout_duration":
 		value := x.DkgTimeoutDuration
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.Params.signing_timeout_duration":
+		value := x.SigningTimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.Params.module_signing_timeouts":
+		if len(x.ModuleSigningTimeouts) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.ModuleSigningTimeouts}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.Params.participant_penalty_params":
+		value := x.ParticipantPenaltyParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.Params.participant_set_params":
+		value := x.ParticipantSetParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.Params.nonce_queue_params":
+		value := x.NonceQueueParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.Params.key_rotation_policies":
+		if len(x.KeyRotationPolicies) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.KeyRotationPolicies}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.Params.max_signing_retries":
+		value := x.MaxSigningRetries
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.Params.module_signing_fees":
+		if len(x.ModuleSigningFees) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.ModuleSigningFees}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -245,6 +529,28 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.AllowedDkgParticipants = *clv.list
 	case "bitway.tss.Params.dkg_timeout_duration":
 		x.DkgTimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.Params.signing_timeout_duration":
+		x.SigningTimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.Params.module_signing_timeouts":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.ModuleSigningTimeouts = *clv.list
+	case "bitway.tss.Params.participant_penalty_params":
+		x.ParticipantPenaltyParams = value.Message().Interface().(*ParticipantPenaltyParams)
+	case "bitway.tss.Params.participant_set_params":
+		x.ParticipantSetParams = value.Message().Interface().(*ParticipantSetParams)
+	case "bitway.tss.Params.nonce_queue_params":
+		x.NonceQueueParams = value.Message().Interface().(*NonceQueueParams)
+	case "bitway.tss.Params.key_rotation_policies":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.KeyRotationPolicies = *clv.list
+	case "bitway.tss.Params.max_signing_retries":
+		x.MaxSigningRetries = uint32(value.Uint())
+	case "bitway.tss.Params.module_signing_fees":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.ModuleSigningFees = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -276,6 +582,46 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DkgTimeoutDuration = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.DkgTimeoutDuration.ProtoReflect())
+	case "bitway.tss.Params.signing_timeout_duration":
+		if x.SigningTimeoutDuration == nil {
+			x.SigningTimeoutDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.SigningTimeoutDuration.ProtoReflect())
+	case "bitway.tss.Params.module_signing_timeouts":
+		if x.ModuleSigningTimeouts == nil {
+			x.ModuleSigningTimeouts = []*ModuleSigningTimeout{}
+		}
+		value := &_Params_4_list{list: &x.ModuleSigningTimeouts}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.Params.participant_penalty_params":
+		if x.ParticipantPenaltyParams == nil {
+			x.ParticipantPenaltyParams = new(ParticipantPenaltyParams)
+		}
+		return protoreflect.ValueOfMessage(x.ParticipantPenaltyParams.ProtoReflect())
+	case "bitway.tss.Params.participant_set_params":
+		if x.ParticipantSetParams == nil {
+			x.ParticipantSetParams = new(ParticipantSetParams)
+		}
+		return protoreflect.ValueOfMessage(x.ParticipantSetParams.ProtoReflect())
+	case "bitway.tss.Params.nonce_queue_params":
+		if x.NonceQueueParams == nil {
+			x.NonceQueueParams = new(NonceQueueParams)
+		}
+		return protoreflect.ValueOfMessage(x.NonceQueueParams.ProtoReflect())
+	case "bitway.tss.Params.key_rotation_policies":
+		if x.KeyRotationPolicies == nil {
+			x.KeyRotationPolicies = []*KeyRotationPolicy{}
+		}
+		value := &_Params_8_list{list: &x.KeyRotationPolicies}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.Params.module_signing_fees":
+		if x.ModuleSigningFees == nil {
+			x.ModuleSigningFees = []*ModuleSigningFee{}
+		}
+		value := &_Params_10_list{list: &x.ModuleSigningFees}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.Params.max_signing_retries":
+		panic(fmt.Errorf("field max_signing_retries of message bitway.tss.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -295,6 +641,29 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "bitway.tss.Params.dkg_timeout_duration":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.Params.signing_timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.Params.module_signing_timeouts":
+		list := []*ModuleSigningTimeout{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "bitway.tss.Params.participant_penalty_params":
+		m := new(ParticipantPenaltyParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.Params.participant_set_params":
+		m := new(ParticipantSetParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.Params.nonce_queue_params":
+		m := new(NonceQueueParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.Params.key_rotation_policies":
+		list := []*KeyRotationPolicy{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "bitway.tss.Params.max_signing_retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.Params.module_signing_fees":
+		list := []*ModuleSigningFee{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.Params"))
@@ -374,6 +743,43 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.DkgTimeoutDuration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SigningTimeoutDuration != nil {
+			l = options.Size(x.SigningTimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ModuleSigningTimeouts) > 0 {
+			for _, e := range x.ModuleSigningTimeouts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ParticipantPenaltyParams != nil {
+			l = options.Size(x.ParticipantPenaltyParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ParticipantSetParams != nil {
+			l = options.Size(x.ParticipantSetParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NonceQueueParams != nil {
+			l = options.Size(x.NonceQueueParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.KeyRotationPolicies) > 0 {
+			for _, e := range x.KeyRotationPolicies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxSigningRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSigningRetries))
+		}
+		if len(x.ModuleSigningFees) > 0 {
+			for _, e := range x.ModuleSigningFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -403,8 +809,45 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DkgTimeoutDuration != nil {
-			encoded, err := options.Marshal(x.DkgTimeoutDuration)
+		if len(x.ModuleSigningFees) > 0 {
+			for iNdEx := len(x.ModuleSigningFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleSigningFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.MaxSigningRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSigningRetries))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.KeyRotationPolicies) > 0 {
+			for iNdEx := len(x.KeyRotationPolicies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyRotationPolicies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.NonceQueueParams != nil {
+			encoded, err := options.Marshal(x.NonceQueueParams)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -415,11 +858,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
-		if len(x.AllowedDkgParticipants) > 0 {
-			for iNdEx := len(x.AllowedDkgParticipants) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AllowedDkgParticipants[iNdEx])
+		if x.ParticipantSetParams != nil {
+			encoded, err := options.Marshal(x.ParticipantSetParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.ParticipantPenaltyParams != nil {
+			encoded, err := options.Marshal(x.ParticipantPenaltyParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ModuleSigningTimeouts) > 0 {
+			for iNdEx := len(x.ModuleSigningTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleSigningTimeouts[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -430,20 +901,64 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x22
 			}
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.SigningTimeoutDuration != nil {
+			encoded, err := options.Marshal(x.SigningTimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		if x.DkgTimeoutDuration != nil {
+			encoded, err := options.Marshal(x.DkgTimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AllowedDkgParticipants) > 0 {
+			for iNdEx := len(x.AllowedDkgParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedDkgParticipants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
@@ -552,175 +1067,4552 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningTimeoutDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SigningTimeoutDuration == nil {
+					x.SigningTimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningTimeoutDuration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleSigningTimeouts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ModuleSigningTimeouts = append(x.ModuleSigningTimeouts, &ModuleSigningTimeout{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleSigningTimeouts[len(x.ModuleSigningTimeouts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_DKGParticipant                  protoreflect.MessageDescriptor
-	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
-	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_bitway_tss_params_proto_init()
-	md_DKGParticipant = File_bitway_tss_params_proto.Messages().ByName("DKGParticipant")
-	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
-	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
-}
-
-var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
-
-type fastReflection_DKGParticipant DKGParticipant
-
-func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(x)
-}
-
-func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
-	mi := &file_bitway_tss_params_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
-var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
-
-type fastReflection_DKGParticipant_messageType struct{}
-
-func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(nil)
-}
-func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
-}
-func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
-	return _fastReflection_DKGParticipant_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
-	return (*DKGParticipant)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Moniker != "" {
-		value := protoreflect.ValueOfString(x.Moniker)
-		if !f(fd_DKGParticipant_moniker, value) {
-			return
-		}
-	}
-	if x.ConsensusPubkey != "" {
-		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_DKGParticipant_consensus_pubkey, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
-		return x.Moniker != ""
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
-		return x.ConsensusPubkey != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
-		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
-	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
-		x.Moniker = ""
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
-		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantPenaltyParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ParticipantPenaltyParams == nil {
+					x.ParticipantPenaltyParams = &ParticipantPenaltyParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantPenaltyParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantSetParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ParticipantSetParams == nil {
+					x.ParticipantSetParams = &ParticipantSetParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantSetParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceQueueParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NonceQueueParams == nil {
+					x.NonceQueueParams = &NonceQueueParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NonceQueueParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyRotationPolicies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyRotationPolicies = append(x.KeyRotationPolicies, &KeyRotationPolicy{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyRotationPolicies[len(x.KeyRotationPolicies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSigningRetries", wireType)
+				}
+				x.MaxSigningRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSigningRetries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleSigningFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleSigningFees = append(x.ModuleSigningFees, &ModuleSigningFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleSigningFees[len(x.ModuleSigningFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantPenaltyParams                protoreflect.MessageDescriptor
+	fd_ParticipantPenaltyParams_max_faults     protoreflect.FieldDescriptor
+	fd_ParticipantPenaltyParams_slash_fraction protoreflect.FieldDescriptor
+	fd_ParticipantPenaltyParams_jail_duration  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_ParticipantPenaltyParams = File_bitway_tss_params_proto.Messages().ByName("ParticipantPenaltyParams")
+	fd_ParticipantPenaltyParams_max_faults = md_ParticipantPenaltyParams.Fields().ByName("max_faults")
+	fd_ParticipantPenaltyParams_slash_fraction = md_ParticipantPenaltyParams.Fields().ByName("slash_fraction")
+	fd_ParticipantPenaltyParams_jail_duration = md_ParticipantPenaltyParams.Fields().ByName("jail_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantPenaltyParams)(nil)
+
+type fastReflection_ParticipantPenaltyParams ParticipantPenaltyParams
+
+func (x *ParticipantPenaltyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantPenaltyParams)(x)
+}
+
+func (x *ParticipantPenaltyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantPenaltyParams_messageType fastReflection_ParticipantPenaltyParams_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantPenaltyParams_messageType{}
+
+type fastReflection_ParticipantPenaltyParams_messageType struct{}
+
+func (x fastReflection_ParticipantPenaltyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantPenaltyParams)(nil)
+}
+func (x fastReflection_ParticipantPenaltyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantPenaltyParams)
+}
+func (x fastReflection_ParticipantPenaltyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantPenaltyParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantPenaltyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantPenaltyParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantPenaltyParams) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantPenaltyParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantPenaltyParams) New() protoreflect.Message {
+	return new(fastReflection_ParticipantPenaltyParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantPenaltyParams) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantPenaltyParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantPenaltyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxFaults != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxFaults)
+		if !f(fd_ParticipantPenaltyParams_max_faults, value) {
+			return
+		}
+	}
+	if x.SlashFraction != "" {
+		value := protoreflect.ValueOfString(x.SlashFraction)
+		if !f(fd_ParticipantPenaltyParams_slash_fraction, value) {
+			return
+		}
+	}
+	if x.JailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+		if !f(fd_ParticipantPenaltyParams_jail_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantPenaltyParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		return x.MaxFaults != uint32(0)
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		return x.SlashFraction != ""
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		return x.JailDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantPenaltyParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		x.MaxFaults = uint32(0)
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		x.SlashFraction = ""
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		x.JailDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantPenaltyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		value := x.MaxFaults
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		value := x.SlashFraction
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantPenaltyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		x.MaxFaults = uint32(value.Uint())
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		x.SlashFraction = value.Interface().(string)
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		x.JailDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantPenaltyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		if x.JailDuration == nil {
+			x.JailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		panic(fmt.Errorf("field max_faults of message bitway.tss.ParticipantPenaltyParams is not mutable"))
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		panic(fmt.Errorf("field slash_fraction of message bitway.tss.ParticipantPenaltyParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantPenaltyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantPenaltyParams.max_faults":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.ParticipantPenaltyParams.slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ParticipantPenaltyParams.jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantPenaltyParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantPenaltyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantPenaltyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantPenaltyParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantPenaltyParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantPenaltyParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantPenaltyParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantPenaltyParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantPenaltyParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxFaults != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFaults))
+		}
+		l = len(x.SlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JailDuration != nil {
+			l = options.Size(x.JailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantPenaltyParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.JailDuration != nil {
+			encoded, err := options.Marshal(x.JailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SlashFraction) > 0 {
+			i -= len(x.SlashFraction)
+			copy(dAtA[i:], x.SlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFraction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxFaults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFaults))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantPenaltyParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantPenaltyParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantPenaltyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFaults", wireType)
+				}
+				x.MaxFaults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFaults |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JailDuration == nil {
+					x.JailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ModuleSigningFee_3_list)(nil)
+
+type _ModuleSigningFee_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ModuleSigningFee_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleSigningFee_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ModuleSigningFee_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleSigningFee_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleSigningFee_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleSigningFee_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleSigningFee_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleSigningFee_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ModuleSigningFee              protoreflect.MessageDescriptor
+	fd_ModuleSigningFee_module       protoreflect.FieldDescriptor
+	fd_ModuleSigningFee_payer_module protoreflect.FieldDescriptor
+	fd_ModuleSigningFee_fee          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_ModuleSigningFee = File_bitway_tss_params_proto.Messages().ByName("ModuleSigningFee")
+	fd_ModuleSigningFee_module = md_ModuleSigningFee.Fields().ByName("module")
+	fd_ModuleSigningFee_payer_module = md_ModuleSigningFee.Fields().ByName("payer_module")
+	fd_ModuleSigningFee_fee = md_ModuleSigningFee.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleSigningFee)(nil)
+
+type fastReflection_ModuleSigningFee ModuleSigningFee
+
+func (x *ModuleSigningFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleSigningFee)(x)
+}
+
+func (x *ModuleSigningFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleSigningFee_messageType fastReflection_ModuleSigningFee_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleSigningFee_messageType{}
+
+type fastReflection_ModuleSigningFee_messageType struct{}
+
+func (x fastReflection_ModuleSigningFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleSigningFee)(nil)
+}
+func (x fastReflection_ModuleSigningFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleSigningFee)
+}
+func (x fastReflection_ModuleSigningFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleSigningFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleSigningFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleSigningFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleSigningFee) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleSigningFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleSigningFee) New() protoreflect.Message {
+	return new(fastReflection_ModuleSigningFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleSigningFee) Interface() protoreflect.ProtoMessage {
+	return (*ModuleSigningFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleSigningFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ModuleSigningFee_module, value) {
+			return
+		}
+	}
+	if x.PayerModule != "" {
+		value := protoreflect.ValueOfString(x.PayerModule)
+		if !f(fd_ModuleSigningFee_payer_module, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleSigningFee_3_list{list: &x.Fee})
+		if !f(fd_ModuleSigningFee_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleSigningFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningFee.module":
+		return x.Module != ""
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		return x.PayerModule != ""
+	case "bitway.tss.ModuleSigningFee.fee":
+		return len(x.Fee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningFee.module":
+		x.Module = ""
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		x.PayerModule = ""
+	case "bitway.tss.ModuleSigningFee.fee":
+		x.Fee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleSigningFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ModuleSigningFee.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		value := x.PayerModule
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ModuleSigningFee.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_ModuleSigningFee_3_list{})
+		}
+		listValue := &_ModuleSigningFee_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningFee.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		x.PayerModule = value.Interface().(string)
+	case "bitway.tss.ModuleSigningFee.fee":
+		lv := value.List()
+		clv := lv.(*_ModuleSigningFee_3_list)
+		x.Fee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningFee.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_ModuleSigningFee_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.ModuleSigningFee.module":
+		panic(fmt.Errorf("field module of message bitway.tss.ModuleSigningFee is not mutable"))
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		panic(fmt.Errorf("field payer_module of message bitway.tss.ModuleSigningFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleSigningFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningFee.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ModuleSigningFee.payer_module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ModuleSigningFee.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ModuleSigningFee_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleSigningFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ModuleSigningFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleSigningFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleSigningFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleSigningFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleSigningFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PayerModule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleSigningFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.PayerModule) > 0 {
+			i -= len(x.PayerModule)
+			copy(dAtA[i:], x.PayerModule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayerModule)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleSigningFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleSigningFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleSigningFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayerModule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayerModule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ModuleSigningTimeout                  protoreflect.MessageDescriptor
+	fd_ModuleSigningTimeout_module           protoreflect.FieldDescriptor
+	fd_ModuleSigningTimeout_timeout_duration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_ModuleSigningTimeout = File_bitway_tss_params_proto.Messages().ByName("ModuleSigningTimeout")
+	fd_ModuleSigningTimeout_module = md_ModuleSigningTimeout.Fields().ByName("module")
+	fd_ModuleSigningTimeout_timeout_duration = md_ModuleSigningTimeout.Fields().ByName("timeout_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleSigningTimeout)(nil)
+
+type fastReflection_ModuleSigningTimeout ModuleSigningTimeout
+
+func (x *ModuleSigningTimeout) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleSigningTimeout)(x)
+}
+
+func (x *ModuleSigningTimeout) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleSigningTimeout_messageType fastReflection_ModuleSigningTimeout_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleSigningTimeout_messageType{}
+
+type fastReflection_ModuleSigningTimeout_messageType struct{}
+
+func (x fastReflection_ModuleSigningTimeout_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleSigningTimeout)(nil)
+}
+func (x fastReflection_ModuleSigningTimeout_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleSigningTimeout)
+}
+func (x fastReflection_ModuleSigningTimeout_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleSigningTimeout
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleSigningTimeout) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleSigningTimeout
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleSigningTimeout) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleSigningTimeout_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleSigningTimeout) New() protoreflect.Message {
+	return new(fastReflection_ModuleSigningTimeout)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleSigningTimeout) Interface() protoreflect.ProtoMessage {
+	return (*ModuleSigningTimeout)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleSigningTimeout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ModuleSigningTimeout_module, value) {
+			return
+		}
+	}
+	if x.TimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+		if !f(fd_ModuleSigningTimeout_timeout_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleSigningTimeout) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.module":
+		return x.Module != ""
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		return x.TimeoutDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningTimeout) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.module":
+		x.Module = ""
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		x.TimeoutDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleSigningTimeout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		value := x.TimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningTimeout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningTimeout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		if x.TimeoutDuration == nil {
+			x.TimeoutDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+	case "bitway.tss.ModuleSigningTimeout.module":
+		panic(fmt.Errorf("field module of message bitway.tss.ModuleSigningTimeout is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleSigningTimeout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ModuleSigningTimeout.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ModuleSigningTimeout.timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ModuleSigningTimeout"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ModuleSigningTimeout does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleSigningTimeout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ModuleSigningTimeout", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleSigningTimeout) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleSigningTimeout) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleSigningTimeout) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleSigningTimeout) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleSigningTimeout)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutDuration != nil {
+			l = options.Size(x.TimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleSigningTimeout)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TimeoutDuration != nil {
+			encoded, err := options.Marshal(x.TimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleSigningTimeout)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleSigningTimeout: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleSigningTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TimeoutDuration == nil {
+					x.TimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DKGParticipant                  protoreflect.MessageDescriptor
+	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
+	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_DKGParticipant = File_bitway_tss_params_proto.Messages().ByName("DKGParticipant")
+	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
+	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
+
+type fastReflection_DKGParticipant DKGParticipant
+
+func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(x)
+}
+
+func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
+var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
+
+type fastReflection_DKGParticipant_messageType struct{}
+
+func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(nil)
+}
+func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
+}
+func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
+	return _fastReflection_DKGParticipant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
+	return (*DKGParticipant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_DKGParticipant_moniker, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_DKGParticipant_consensus_pubkey, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		return x.Moniker != ""
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		x.Moniker = ""
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		value := x.Moniker
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		x.Moniker = value.Interface().(string)
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		panic(fmt.Errorf("field moniker of message bitway.tss.DKGParticipant is not mutable"))
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.DKGParticipant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.DKGParticipant.moniker":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.DKGParticipant.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.DKGParticipant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGParticipant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Moniker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Moniker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantSetParams_5_list)(nil)
+
+type _ParticipantSetParams_5_list struct {
+	list *[]*ManagedDKG
+}
+
+func (x *_ParticipantSetParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantSetParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantSetParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ManagedDKG)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantSetParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ManagedDKG)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantSetParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(ManagedDKG)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSetParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantSetParams_5_list) NewElement() protoreflect.Value {
+	v := new(ManagedDKG)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSetParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantSetParams                  protoreflect.MessageDescriptor
+	fd_ParticipantSetParams_enabled          protoreflect.FieldDescriptor
+	fd_ParticipantSetParams_max_participants protoreflect.FieldDescriptor
+	fd_ParticipantSetParams_drift_threshold  protoreflect.FieldDescriptor
+	fd_ParticipantSetParams_update_delay     protoreflect.FieldDescriptor
+	fd_ParticipantSetParams_managed_dkgs     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_ParticipantSetParams = File_bitway_tss_params_proto.Messages().ByName("ParticipantSetParams")
+	fd_ParticipantSetParams_enabled = md_ParticipantSetParams.Fields().ByName("enabled")
+	fd_ParticipantSetParams_max_participants = md_ParticipantSetParams.Fields().ByName("max_participants")
+	fd_ParticipantSetParams_drift_threshold = md_ParticipantSetParams.Fields().ByName("drift_threshold")
+	fd_ParticipantSetParams_update_delay = md_ParticipantSetParams.Fields().ByName("update_delay")
+	fd_ParticipantSetParams_managed_dkgs = md_ParticipantSetParams.Fields().ByName("managed_dkgs")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantSetParams)(nil)
+
+type fastReflection_ParticipantSetParams ParticipantSetParams
+
+func (x *ParticipantSetParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSetParams)(x)
+}
+
+func (x *ParticipantSetParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantSetParams_messageType fastReflection_ParticipantSetParams_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSetParams_messageType{}
+
+type fastReflection_ParticipantSetParams_messageType struct{}
+
+func (x fastReflection_ParticipantSetParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSetParams)(nil)
+}
+func (x fastReflection_ParticipantSetParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSetParams)
+}
+func (x fastReflection_ParticipantSetParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSetParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantSetParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSetParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantSetParams) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSetParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantSetParams) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSetParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantSetParams) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSetParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantSetParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_ParticipantSetParams_enabled, value) {
+			return
+		}
+	}
+	if x.MaxParticipants != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxParticipants)
+		if !f(fd_ParticipantSetParams_max_participants, value) {
+			return
+		}
+	}
+	if x.DriftThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DriftThreshold)
+		if !f(fd_ParticipantSetParams_drift_threshold, value) {
+			return
+		}
+	}
+	if x.UpdateDelay != nil {
+		value := protoreflect.ValueOfMessage(x.UpdateDelay.ProtoReflect())
+		if !f(fd_ParticipantSetParams_update_delay, value) {
+			return
+		}
+	}
+	if len(x.ManagedDkgs) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantSetParams_5_list{list: &x.ManagedDkgs})
+		if !f(fd_ParticipantSetParams_managed_dkgs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantSetParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSetParams.enabled":
+		return x.Enabled != false
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		return x.MaxParticipants != uint32(0)
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		return x.DriftThreshold != uint32(0)
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		return x.UpdateDelay != nil
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		return len(x.ManagedDkgs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSetParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSetParams.enabled":
+		x.Enabled = false
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		x.MaxParticipants = uint32(0)
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		x.DriftThreshold = uint32(0)
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		x.UpdateDelay = nil
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		x.ManagedDkgs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantSetParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantSetParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		value := x.MaxParticipants
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		value := x.DriftThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		value := x.UpdateDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		if len(x.ManagedDkgs) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantSetParams_5_list{})
+		}
+		listValue := &_ParticipantSetParams_5_list{list: &x.ManagedDkgs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSetParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSetParams.enabled":
+		x.Enabled = value.Bool()
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		x.MaxParticipants = uint32(value.Uint())
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		x.DriftThreshold = uint32(value.Uint())
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		x.UpdateDelay = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		lv := value.List()
+		clv := lv.(*_ParticipantSetParams_5_list)
+		x.ManagedDkgs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSetParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		if x.UpdateDelay == nil {
+			x.UpdateDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.UpdateDelay.ProtoReflect())
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		if x.ManagedDkgs == nil {
+			x.ManagedDkgs = []*ManagedDKG{}
+		}
+		value := &_ParticipantSetParams_5_list{list: &x.ManagedDkgs}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.ParticipantSetParams.enabled":
+		panic(fmt.Errorf("field enabled of message bitway.tss.ParticipantSetParams is not mutable"))
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		panic(fmt.Errorf("field max_participants of message bitway.tss.ParticipantSetParams is not mutable"))
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		panic(fmt.Errorf("field drift_threshold of message bitway.tss.ParticipantSetParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantSetParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSetParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "bitway.tss.ParticipantSetParams.max_participants":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.ParticipantSetParams.drift_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.ParticipantSetParams.update_delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.ParticipantSetParams.managed_dkgs":
+		list := []*ManagedDKG{}
+		return protoreflect.ValueOfList(&_ParticipantSetParams_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSetParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantSetParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantSetParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantSetParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSetParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantSetParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantSetParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantSetParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.MaxParticipants != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxParticipants))
+		}
+		if x.DriftThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.DriftThreshold))
+		}
+		if x.UpdateDelay != nil {
+			l = options.Size(x.UpdateDelay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ManagedDkgs) > 0 {
+			for _, e := range x.ManagedDkgs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSetParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ManagedDkgs) > 0 {
+			for iNdEx := len(x.ManagedDkgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ManagedDkgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.UpdateDelay != nil {
+			encoded, err := options.Marshal(x.UpdateDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DriftThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DriftThreshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxParticipants != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxParticipants))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSetParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSetParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxParticipants", wireType)
+				}
+				x.MaxParticipants = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxParticipants |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+				}
+				x.DriftThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DriftThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdateDelay == nil {
+					x.UpdateDelay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateDelay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ManagedDkgs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ManagedDkgs = append(x.ManagedDkgs, &ManagedDKG{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ManagedDkgs[len(x.ManagedDkgs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ManagedDKG        protoreflect.MessageDescriptor
+	fd_ManagedDKG_module protoreflect.FieldDescriptor
+	fd_ManagedDKG_type   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_ManagedDKG = File_bitway_tss_params_proto.Messages().ByName("ManagedDKG")
+	fd_ManagedDKG_module = md_ManagedDKG.Fields().ByName("module")
+	fd_ManagedDKG_type = md_ManagedDKG.Fields().ByName("type")
+}
+
+var _ protoreflect.Message = (*fastReflection_ManagedDKG)(nil)
+
+type fastReflection_ManagedDKG ManagedDKG
+
+func (x *ManagedDKG) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ManagedDKG)(x)
+}
+
+func (x *ManagedDKG) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ManagedDKG_messageType fastReflection_ManagedDKG_messageType
+var _ protoreflect.MessageType = fastReflection_ManagedDKG_messageType{}
+
+type fastReflection_ManagedDKG_messageType struct{}
+
+func (x fastReflection_ManagedDKG_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ManagedDKG)(nil)
+}
+func (x fastReflection_ManagedDKG_messageType) New() protoreflect.Message {
+	return new(fastReflection_ManagedDKG)
+}
+func (x fastReflection_ManagedDKG_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ManagedDKG
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ManagedDKG) Descriptor() protoreflect.MessageDescriptor {
+	return md_ManagedDKG
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ManagedDKG) Type() protoreflect.MessageType {
+	return _fastReflection_ManagedDKG_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ManagedDKG) New() protoreflect.Message {
+	return new(fastReflection_ManagedDKG)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ManagedDKG) Interface() protoreflect.ProtoMessage {
+	return (*ManagedDKG)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ManagedDKG) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ManagedDKG_module, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_ManagedDKG_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ManagedDKG) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		return x.Module != ""
+	case "bitway.tss.ManagedDKG.type":
+		return x.Type_ != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ManagedDKG) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		x.Module = ""
+	case "bitway.tss.ManagedDKG.type":
+		x.Type_ = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ManagedDKG) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ManagedDKG.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ManagedDKG) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.ManagedDKG.type":
+		x.Type_ = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ManagedDKG) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		panic(fmt.Errorf("field module of message bitway.tss.ManagedDKG is not mutable"))
+	case "bitway.tss.ManagedDKG.type":
+		panic(fmt.Errorf("field type of message bitway.tss.ManagedDKG is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ManagedDKG) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ManagedDKG.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ManagedDKG.type":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ManagedDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ManagedDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ManagedDKG) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ManagedDKG", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ManagedDKG) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ManagedDKG) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ManagedDKG) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ManagedDKG) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ManagedDKG)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ManagedDKG)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ManagedDKG)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ManagedDKG: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ManagedDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_NonceQueueParams_5_list)(nil)
+
+type _NonceQueueParams_5_list struct {
+	list *[]*ManagedDKG
+}
+
+func (x *_NonceQueueParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NonceQueueParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NonceQueueParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ManagedDKG)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NonceQueueParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ManagedDKG)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NonceQueueParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(ManagedDKG)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NonceQueueParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NonceQueueParams_5_list) NewElement() protoreflect.Value {
+	v := new(ManagedDKG)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NonceQueueParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_NonceQueueParams                             protoreflect.MessageDescriptor
+	fd_NonceQueueParams_queue_size                  protoreflect.FieldDescriptor
+	fd_NonceQueueParams_generation_batch_size       protoreflect.FieldDescriptor
+	fd_NonceQueueParams_generation_interval         protoreflect.FieldDescriptor
+	fd_NonceQueueParams_generation_timeout_duration protoreflect.FieldDescriptor
+	fd_NonceQueueParams_dkgs                        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_NonceQueueParams = File_bitway_tss_params_proto.Messages().ByName("NonceQueueParams")
+	fd_NonceQueueParams_queue_size = md_NonceQueueParams.Fields().ByName("queue_size")
+	fd_NonceQueueParams_generation_batch_size = md_NonceQueueParams.Fields().ByName("generation_batch_size")
+	fd_NonceQueueParams_generation_interval = md_NonceQueueParams.Fields().ByName("generation_interval")
+	fd_NonceQueueParams_generation_timeout_duration = md_NonceQueueParams.Fields().ByName("generation_timeout_duration")
+	fd_NonceQueueParams_dkgs = md_NonceQueueParams.Fields().ByName("dkgs")
+}
+
+var _ protoreflect.Message = (*fastReflection_NonceQueueParams)(nil)
+
+type fastReflection_NonceQueueParams NonceQueueParams
+
+func (x *NonceQueueParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NonceQueueParams)(x)
+}
+
+func (x *NonceQueueParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NonceQueueParams_messageType fastReflection_NonceQueueParams_messageType
+var _ protoreflect.MessageType = fastReflection_NonceQueueParams_messageType{}
+
+type fastReflection_NonceQueueParams_messageType struct{}
+
+func (x fastReflection_NonceQueueParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NonceQueueParams)(nil)
+}
+func (x fastReflection_NonceQueueParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_NonceQueueParams)
+}
+func (x fastReflection_NonceQueueParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NonceQueueParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NonceQueueParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_NonceQueueParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NonceQueueParams) Type() protoreflect.MessageType {
+	return _fastReflection_NonceQueueParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NonceQueueParams) New() protoreflect.Message {
+	return new(fastReflection_NonceQueueParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NonceQueueParams) Interface() protoreflect.ProtoMessage {
+	return (*NonceQueueParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NonceQueueParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.QueueSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.QueueSize)
+		if !f(fd_NonceQueueParams_queue_size, value) {
+			return
+		}
+	}
+	if x.GenerationBatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.GenerationBatchSize)
+		if !f(fd_NonceQueueParams_generation_batch_size, value) {
+			return
+		}
+	}
+	if x.GenerationInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GenerationInterval)
+		if !f(fd_NonceQueueParams_generation_interval, value) {
+			return
+		}
+	}
+	if x.GenerationTimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.GenerationTimeoutDuration.ProtoReflect())
+		if !f(fd_NonceQueueParams_generation_timeout_duration, value) {
+			return
+		}
+	}
+	if len(x.Dkgs) != 0 {
+		value := protoreflect.ValueOfList(&_NonceQueueParams_5_list{list: &x.Dkgs})
+		if !f(fd_NonceQueueParams_dkgs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NonceQueueParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.NonceQueueParams.queue_size":
+		return x.QueueSize != uint32(0)
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		return x.GenerationBatchSize != uint32(0)
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		return x.GenerationInterval != int64(0)
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		return x.GenerationTimeoutDuration != nil
+	case "bitway.tss.NonceQueueParams.dkgs":
+		return len(x.Dkgs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NonceQueueParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.NonceQueueParams.queue_size":
+		x.QueueSize = uint32(0)
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		x.GenerationBatchSize = uint32(0)
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		x.GenerationInterval = int64(0)
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		x.GenerationTimeoutDuration = nil
+	case "bitway.tss.NonceQueueParams.dkgs":
+		x.Dkgs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NonceQueueParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.NonceQueueParams.queue_size":
+		value := x.QueueSize
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		value := x.GenerationBatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		value := x.GenerationInterval
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		value := x.GenerationTimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.NonceQueueParams.dkgs":
+		if len(x.Dkgs) == 0 {
+			return protoreflect.ValueOfList(&_NonceQueueParams_5_list{})
+		}
+		listValue := &_NonceQueueParams_5_list{list: &x.Dkgs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NonceQueueParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.NonceQueueParams.queue_size":
+		x.QueueSize = uint32(value.Uint())
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		x.GenerationBatchSize = uint32(value.Uint())
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		x.GenerationInterval = value.Int()
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		x.GenerationTimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.NonceQueueParams.dkgs":
+		lv := value.List()
+		clv := lv.(*_NonceQueueParams_5_list)
+		x.Dkgs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NonceQueueParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		if x.GenerationTimeoutDuration == nil {
+			x.GenerationTimeoutDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.GenerationTimeoutDuration.ProtoReflect())
+	case "bitway.tss.NonceQueueParams.dkgs":
+		if x.Dkgs == nil {
+			x.Dkgs = []*ManagedDKG{}
+		}
+		value := &_NonceQueueParams_5_list{list: &x.Dkgs}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.NonceQueueParams.queue_size":
+		panic(fmt.Errorf("field queue_size of message bitway.tss.NonceQueueParams is not mutable"))
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		panic(fmt.Errorf("field generation_batch_size of message bitway.tss.NonceQueueParams is not mutable"))
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		panic(fmt.Errorf("field generation_interval of message bitway.tss.NonceQueueParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NonceQueueParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.NonceQueueParams.queue_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.NonceQueueParams.generation_batch_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.NonceQueueParams.generation_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.tss.NonceQueueParams.generation_timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.NonceQueueParams.dkgs":
+		list := []*ManagedDKG{}
+		return protoreflect.ValueOfList(&_NonceQueueParams_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.NonceQueueParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.NonceQueueParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NonceQueueParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.NonceQueueParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NonceQueueParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NonceQueueParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NonceQueueParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NonceQueueParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NonceQueueParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.QueueSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueueSize))
+		}
+		if x.GenerationBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.GenerationBatchSize))
+		}
+		if x.GenerationInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.GenerationInterval))
+		}
+		if x.GenerationTimeoutDuration != nil {
+			l = options.Size(x.GenerationTimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Dkgs) > 0 {
+			for _, e := range x.Dkgs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NonceQueueParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Dkgs) > 0 {
+			for iNdEx := len(x.Dkgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Dkgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.GenerationTimeoutDuration != nil {
+			encoded, err := options.Marshal(x.GenerationTimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.GenerationInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenerationInterval))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.GenerationBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenerationBatchSize))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.QueueSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueueSize))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NonceQueueParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NonceQueueParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NonceQueueParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+				}
+				x.QueueSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueueSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenerationBatchSize", wireType)
+				}
+				x.GenerationBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GenerationBatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenerationInterval", wireType)
+				}
+				x.GenerationInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GenerationInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenerationTimeoutDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GenerationTimeoutDuration == nil {
+					x.GenerationTimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GenerationTimeoutDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dkgs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dkgs = append(x.Dkgs, &ManagedDKG{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dkgs[len(x.Dkgs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_KeyRotationPolicy                protoreflect.MessageDescriptor
+	fd_KeyRotationPolicy_module         protoreflect.FieldDescriptor
+	fd_KeyRotationPolicy_type           protoreflect.FieldDescriptor
+	fd_KeyRotationPolicy_method         protoreflect.FieldDescriptor
+	fd_KeyRotationPolicy_max_age        protoreflect.FieldDescriptor
+	fd_KeyRotationPolicy_overlap_window protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_params_proto_init()
+	md_KeyRotationPolicy = File_bitway_tss_params_proto.Messages().ByName("KeyRotationPolicy")
+	fd_KeyRotationPolicy_module = md_KeyRotationPolicy.Fields().ByName("module")
+	fd_KeyRotationPolicy_type = md_KeyRotationPolicy.Fields().ByName("type")
+	fd_KeyRotationPolicy_method = md_KeyRotationPolicy.Fields().ByName("method")
+	fd_KeyRotationPolicy_max_age = md_KeyRotationPolicy.Fields().ByName("max_age")
+	fd_KeyRotationPolicy_overlap_window = md_KeyRotationPolicy.Fields().ByName("overlap_window")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyRotationPolicy)(nil)
+
+type fastReflection_KeyRotationPolicy KeyRotationPolicy
+
+func (x *KeyRotationPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyRotationPolicy)(x)
+}
+
+func (x *KeyRotationPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_params_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyRotationPolicy_messageType fastReflection_KeyRotationPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_KeyRotationPolicy_messageType{}
+
+type fastReflection_KeyRotationPolicy_messageType struct{}
+
+func (x fastReflection_KeyRotationPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyRotationPolicy)(nil)
+}
+func (x fastReflection_KeyRotationPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyRotationPolicy)
+}
+func (x fastReflection_KeyRotationPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRotationPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyRotationPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRotationPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyRotationPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_KeyRotationPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyRotationPolicy) New() protoreflect.Message {
+	return new(fastReflection_KeyRotationPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyRotationPolicy) Interface() protoreflect.ProtoMessage {
+	return (*KeyRotationPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyRotationPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_KeyRotationPolicy_module, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_KeyRotationPolicy_type, value) {
+			return
+		}
+	}
+	if x.Method != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Method))
+		if !f(fd_KeyRotationPolicy_method, value) {
+			return
+		}
+	}
+	if x.MaxAge != nil {
+		value := protoreflect.ValueOfMessage(x.MaxAge.ProtoReflect())
+		if !f(fd_KeyRotationPolicy_max_age, value) {
+			return
+		}
+	}
+	if x.OverlapWindow != nil {
+		value := protoreflect.ValueOfMessage(x.OverlapWindow.ProtoReflect())
+		if !f(fd_KeyRotationPolicy_overlap_window, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyRotationPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotationPolicy.module":
+		return x.Module != ""
+	case "bitway.tss.KeyRotationPolicy.type":
+		return x.Type_ != ""
+	case "bitway.tss.KeyRotationPolicy.method":
+		return x.Method != 0
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		return x.MaxAge != nil
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		return x.OverlapWindow != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRotationPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotationPolicy.module":
+		x.Module = ""
+	case "bitway.tss.KeyRotationPolicy.type":
+		x.Type_ = ""
+	case "bitway.tss.KeyRotationPolicy.method":
+		x.Method = 0
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		x.MaxAge = nil
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		x.OverlapWindow = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -730,19 +5622,28 @@ func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KeyRotationPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
-		value := x.Moniker
+	case "bitway.tss.KeyRotationPolicy.module":
+		value := x.Module
 		return protoreflect.ValueOfString(value)
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
-		value := x.ConsensusPubkey
+	case "bitway.tss.KeyRotationPolicy.type":
+		value := x.Type_
 		return protoreflect.ValueOfString(value)
+	case "bitway.tss.KeyRotationPolicy.method":
+		value := x.Method
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		value := x.MaxAge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		value := x.OverlapWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
 		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -756,17 +5657,23 @@ func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_KeyRotationPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
-		x.Moniker = value.Interface().(string)
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.KeyRotationPolicy.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.KeyRotationPolicy.type":
+		x.Type_ = value.Interface().(string)
+	case "bitway.tss.KeyRotationPolicy.method":
+		x.Method = (KeyRotationMethod)(value.Enum())
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		x.MaxAge = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		x.OverlapWindow = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
 		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -780,44 +5687,64 @@ func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KeyRotationPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
-		panic(fmt.Errorf("field moniker of message bitway.tss.DKGParticipant is not mutable"))
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.DKGParticipant is not mutable"))
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		if x.MaxAge == nil {
+			x.MaxAge = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxAge.ProtoReflect())
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		if x.OverlapWindow == nil {
+			x.OverlapWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.OverlapWindow.ProtoReflect())
+	case "bitway.tss.KeyRotationPolicy.module":
+		panic(fmt.Errorf("field module of message bitway.tss.KeyRotationPolicy is not mutable"))
+	case "bitway.tss.KeyRotationPolicy.type":
+		panic(fmt.Errorf("field type of message bitway.tss.KeyRotationPolicy is not mutable"))
+	case "bitway.tss.KeyRotationPolicy.method":
+		panic(fmt.Errorf("field method of message bitway.tss.KeyRotationPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
 		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KeyRotationPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.DKGParticipant.moniker":
+	case "bitway.tss.KeyRotationPolicy.module":
 		return protoreflect.ValueOfString("")
-	case "bitway.tss.DKGParticipant.consensus_pubkey":
+	case "bitway.tss.KeyRotationPolicy.type":
 		return protoreflect.ValueOfString("")
+	case "bitway.tss.KeyRotationPolicy.method":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.KeyRotationPolicy.max_age":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.KeyRotationPolicy.overlap_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotationPolicy"))
 		}
-		panic(fmt.Errorf("message bitway.tss.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.KeyRotationPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_KeyRotationPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.DKGParticipant", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.KeyRotationPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -825,7 +5752,7 @@ func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_KeyRotationPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -836,7 +5763,7 @@ func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_KeyRotationPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -848,7 +5775,7 @@ func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGParticipant) IsValid() bool {
+func (x *fastReflection_KeyRotationPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -858,9 +5785,9 @@ func (x *fastReflection_DKGParticipant) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_KeyRotationPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*KeyRotationPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -872,14 +5799,25 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Moniker)
+		l = len(x.Module)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ConsensusPubkey)
+		l = len(x.Type_)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Method != 0 {
+			n += 1 + runtime.Sov(uint64(x.Method))
+		}
+		if x.MaxAge != nil {
+			l = options.Size(x.MaxAge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OverlapWindow != nil {
+			l = options.Size(x.OverlapWindow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -890,7 +5828,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*KeyRotationPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -909,17 +5847,50 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ConsensusPubkey) > 0 {
-			i -= len(x.ConsensusPubkey)
-			copy(dAtA[i:], x.ConsensusPubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+		if x.OverlapWindow != nil {
+			encoded, err := options.Marshal(x.OverlapWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MaxAge != nil {
+			encoded, err := options.Marshal(x.MaxAge)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Method != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Method))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Moniker) > 0 {
-			i -= len(x.Moniker)
-			copy(dAtA[i:], x.Moniker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -934,7 +5905,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*KeyRotationPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -962,19 +5933,51 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRotationPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRotationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1002,13 +6005,32 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Moniker = string(dAtA[iNdEx:postIndex])
+				x.Type_ = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				x.Method = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Method |= KeyRotationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1018,23 +6040,63 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				if x.MaxAge == nil {
+					x.MaxAge = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxAge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OverlapWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OverlapWindow == nil {
+					x.OverlapWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OverlapWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1071,59 +6133,336 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: bitway/tss/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: bitway/tss/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Key Rotation Method
+type KeyRotationMethod int32
+
+const (
+	// refresh the key shares of the TSS keys while keeping the pub keys unchanged
+	KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH KeyRotationMethod = 0
+	// generate the new keys by the module and retire the old keys after the overlap window
+	KeyRotationMethod_KEY_ROTATION_METHOD_REDKG KeyRotationMethod = 1
+)
+
+// Enum value maps for KeyRotationMethod.
+var (
+	KeyRotationMethod_name = map[int32]string{
+		0: "KEY_ROTATION_METHOD_REFRESH",
+		1: "KEY_ROTATION_METHOD_REDKG",
+	}
+	KeyRotationMethod_value = map[string]int32{
+		"KEY_ROTATION_METHOD_REFRESH": 0,
+		"KEY_ROTATION_METHOD_REDKG":   1,
+	}
+)
+
+func (x KeyRotationMethod) Enum() *KeyRotationMethod {
+	p := new(KeyRotationMethod)
+	*p = x
+	return p
+}
+
+func (x KeyRotationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyRotationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_params_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyRotationMethod) Type() protoreflect.EnumType {
+	return &file_bitway_tss_params_proto_enumTypes[0]
+}
+
+func (x KeyRotationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyRotationMethod.Descriptor instead.
+func (KeyRotationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedDkgParticipants []*DKGParticipant    `protobuf:"bytes,1,rep,name=allowed_dkg_participants,json=allowedDkgParticipants,proto3" json:"allowed_dkg_participants,omitempty"`
+	DkgTimeoutDuration     *durationpb.Duration `protobuf:"bytes,2,opt,name=dkg_timeout_duration,json=dkgTimeoutDuration,proto3" json:"dkg_timeout_duration,omitempty"`
+	// default signing timeout duration; 0 means no timeout
+	SigningTimeoutDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=signing_timeout_duration,json=signingTimeoutDuration,proto3" json:"signing_timeout_duration,omitempty"`
+	// module specific signing timeout durations which override the default one
+	ModuleSigningTimeouts []*ModuleSigningTimeout `protobuf:"bytes,4,rep,name=module_signing_timeouts,json=moduleSigningTimeouts,proto3" json:"module_signing_timeouts,omitempty"`
+	// penalty params for the faulty participants
+	ParticipantPenaltyParams *ParticipantPenaltyParams `protobuf:"bytes,5,opt,name=participant_penalty_params,json=participantPenaltyParams,proto3" json:"participant_penalty_params,omitempty"`
+	// params for the automatically maintained participant set
+	ParticipantSetParams *ParticipantSetParams `protobuf:"bytes,6,opt,name=participant_set_params,json=participantSetParams,proto3" json:"participant_set_params,omitempty"`
+	// params for the pre-committed nonce queues
+	NonceQueueParams *NonceQueueParams `protobuf:"bytes,7,opt,name=nonce_queue_params,json=nonceQueueParams,proto3" json:"nonce_queue_params,omitempty"`
+	// policies for the scheduled key rotation
+	KeyRotationPolicies []*KeyRotationPolicy `protobuf:"bytes,8,rep,name=key_rotation_policies,json=keyRotationPolicies,proto3" json:"key_rotation_policies,omitempty"`
+	// maximum number of times a timed out signing request can be re-initiated; 0 means unlimited
+	MaxSigningRetries uint32 `protobuf:"varint,9,opt,name=max_signing_retries,json=maxSigningRetries,proto3" json:"max_signing_retries,omitempty"`
+	// module specific fees attached to the signing requests initiated by the modules
+	ModuleSigningFees []*ModuleSigningFee `protobuf:"bytes,10,rep,name=module_signing_fees,json=moduleSigningFees,proto3" json:"module_signing_fees,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetAllowedDkgParticipants() []*DKGParticipant {
+	if x != nil {
+		return x.AllowedDkgParticipants
+	}
+	return nil
+}
+
+func (x *Params) GetDkgTimeoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DkgTimeoutDuration
+	}
+	return nil
+}
+
+func (x *Params) GetSigningTimeoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.SigningTimeoutDuration
+	}
+	return nil
+}
+
+func (x *Params) GetModuleSigningTimeouts() []*ModuleSigningTimeout {
+	if x != nil {
+		return x.ModuleSigningTimeouts
+	}
+	return nil
+}
+
+func (x *Params) GetParticipantPenaltyParams() *ParticipantPenaltyParams {
+	if x != nil {
+		return x.ParticipantPenaltyParams
+	}
+	return nil
+}
+
+func (x *Params) GetParticipantSetParams() *ParticipantSetParams {
+	if x != nil {
+		return x.ParticipantSetParams
+	}
+	return nil
+}
+
+func (x *Params) GetNonceQueueParams() *NonceQueueParams {
+	if x != nil {
+		return x.NonceQueueParams
+	}
+	return nil
+}
+
+func (x *Params) GetKeyRotationPolicies() []*KeyRotationPolicy {
+	if x != nil {
+		return x.KeyRotationPolicies
+	}
+	return nil
+}
+
+func (x *Params) GetMaxSigningRetries() uint32 {
+	if x != nil {
+		return x.MaxSigningRetries
+	}
+	return 0
+}
+
+func (x *Params) GetModuleSigningFees() []*ModuleSigningFee {
+	if x != nil {
+		return x.ModuleSigningFees
+	}
+	return nil
+}
+
+// Participant Penalty Params
+type ParticipantPenaltyParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of faults which triggers the penalty; 0 means no penalty
+	MaxFaults uint32 `protobuf:"varint,1,opt,name=max_faults,json=maxFaults,proto3" json:"max_faults,omitempty"`
+	// fraction of the validator stake to be slashed
+	SlashFraction string `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// jail duration; 0 means no jailing
+	JailDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+}
+
+func (x *ParticipantPenaltyParams) Reset() {
+	*x = ParticipantPenaltyParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantPenaltyParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantPenaltyParams) ProtoMessage() {}
+
+// Deprecated: Use ParticipantPenaltyParams.ProtoReflect.Descriptor instead.
+func (*ParticipantPenaltyParams) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantPenaltyParams) GetMaxFaults() uint32 {
+	if x != nil {
+		return x.MaxFaults
+	}
+	return 0
+}
+
+func (x *ParticipantPenaltyParams) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
+}
+
+func (x *ParticipantPenaltyParams) GetJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.JailDuration
+	}
+	return nil
+}
+
+// Module Signing Fee
+type ModuleSigningFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module name
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// name of the module account from which the fee is paid, e.g. gov for the default protocol fee collector
+	PayerModule string `protobuf:"bytes,2,opt,name=payer_module,json=payerModule,proto3" json:"payer_module,omitempty"`
+	// fee attached to each signing request initiated by the module
+	Fee []*v1beta1.Coin `protobuf:"bytes,3,rep,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *ModuleSigningFee) Reset() {
+	*x = ModuleSigningFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleSigningFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleSigningFee) ProtoMessage() {}
+
+// Deprecated: Use ModuleSigningFee.ProtoReflect.Descriptor instead.
+func (*ModuleSigningFee) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModuleSigningFee) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ModuleSigningFee) GetPayerModule() string {
+	if x != nil {
+		return x.PayerModule
+	}
+	return ""
+}
+
+func (x *ModuleSigningFee) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
 
-// Params defines the parameters for the module.
-type Params struct {
+// Module Signing Timeout
+type ModuleSigningTimeout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllowedDkgParticipants []*DKGParticipant    `protobuf:"bytes,1,rep,name=allowed_dkg_participants,json=allowedDkgParticipants,proto3" json:"allowed_dkg_participants,omitempty"`
-	DkgTimeoutDuration     *durationpb.Duration `protobuf:"bytes,2,opt,name=dkg_timeout_duration,json=dkgTimeoutDuration,proto3" json:"dkg_timeout_duration,omitempty"`
+	// module name
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// signing timeout duration; 0 means no timeout
+	TimeoutDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *ModuleSigningTimeout) Reset() {
+	*x = ModuleSigningTimeout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_params_proto_msgTypes[0]
+		mi := &file_bitway_tss_params_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *ModuleSigningTimeout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*ModuleSigningTimeout) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_params_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ModuleSigningTimeout.ProtoReflect.Descriptor instead.
+func (*ModuleSigningTimeout) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Params) GetAllowedDkgParticipants() []*DKGParticipant {
+func (x *ModuleSigningTimeout) GetModule() string {
 	if x != nil {
-		return x.AllowedDkgParticipants
+		return x.Module
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetDkgTimeoutDuration() *durationpb.Duration {
+func (x *ModuleSigningTimeout) GetTimeoutDuration() *durationpb.Duration {
 	if x != nil {
-		return x.DkgTimeoutDuration
+		return x.TimeoutDuration
 	}
 	return nil
 }
@@ -1143,7 +6482,7 @@ type DKGParticipant struct {
 func (x *DKGParticipant) Reset() {
 	*x = DKGParticipant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_params_proto_msgTypes[1]
+		mi := &file_bitway_tss_params_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1157,7 +6496,7 @@ func (*DKGParticipant) ProtoMessage() {}
 
 // Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
 func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_params_proto_rawDescGZIP(), []int{1}
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DKGParticipant) GetMoniker() string {
@@ -1174,42 +6513,448 @@ func (x *DKGParticipant) GetConsensusPubkey() string {
 	return ""
 }
 
+// Participant Set Params
+type ParticipantSetParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// indicates if the participant set is maintained automatically by the top bonded validators
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// maximum number of participants, i.e. the top N bonded validators by power
+	MaxParticipants uint32 `protobuf:"varint,2,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// number of new participants which triggers the resharing of the managed DKGs; 0 means no resharing
+	DriftThreshold uint32 `protobuf:"varint,3,opt,name=drift_threshold,json=driftThreshold,proto3" json:"drift_threshold,omitempty"`
+	// delay after the validator set changes before the participant set is updated
+	UpdateDelay *durationpb.Duration `protobuf:"bytes,4,opt,name=update_delay,json=updateDelay,proto3" json:"update_delay,omitempty"`
+	// DKGs to be maintained automatically along with the participant set
+	ManagedDkgs []*ManagedDKG `protobuf:"bytes,5,rep,name=managed_dkgs,json=managedDkgs,proto3" json:"managed_dkgs,omitempty"`
+}
+
+func (x *ParticipantSetParams) Reset() {
+	*x = ParticipantSetParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantSetParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantSetParams) ProtoMessage() {}
+
+// Deprecated: Use ParticipantSetParams.ProtoReflect.Descriptor instead.
+func (*ParticipantSetParams) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParticipantSetParams) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ParticipantSetParams) GetMaxParticipants() uint32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *ParticipantSetParams) GetDriftThreshold() uint32 {
+	if x != nil {
+		return x.DriftThreshold
+	}
+	return 0
+}
+
+func (x *ParticipantSetParams) GetUpdateDelay() *durationpb.Duration {
+	if x != nil {
+		return x.UpdateDelay
+	}
+	return nil
+}
+
+func (x *ParticipantSetParams) GetManagedDkgs() []*ManagedDKG {
+	if x != nil {
+		return x.ManagedDkgs
+	}
+	return nil
+}
+
+// Managed DKG
+type ManagedDKG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module which initiates the DKG
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// DKG type
+	Type_ string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ManagedDKG) Reset() {
+	*x = ManagedDKG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManagedDKG) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedDKG) ProtoMessage() {}
+
+// Deprecated: Use ManagedDKG.ProtoReflect.Descriptor instead.
+func (*ManagedDKG) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ManagedDKG) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ManagedDKG) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+// Nonce Queue Params
+type NonceQueueParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// target number of committed nonces in the queue of each DKG; 0 means no nonce queue
+	QueueSize uint32 `protobuf:"varint,1,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// number of nonces generated in one round
+	GenerationBatchSize uint32 `protobuf:"varint,2,opt,name=generation_batch_size,json=generationBatchSize,proto3" json:"generation_batch_size,omitempty"`
+	// block interval at which the nonce queues are replenished
+	GenerationInterval int64 `protobuf:"varint,3,opt,name=generation_interval,json=generationInterval,proto3" json:"generation_interval,omitempty"`
+	// timeout duration of the nonce generation
+	GenerationTimeoutDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=generation_timeout_duration,json=generationTimeoutDuration,proto3" json:"generation_timeout_duration,omitempty"`
+	// DKGs for which the nonce queues are maintained
+	Dkgs []*ManagedDKG `protobuf:"bytes,5,rep,name=dkgs,proto3" json:"dkgs,omitempty"`
+}
+
+func (x *NonceQueueParams) Reset() {
+	*x = NonceQueueParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonceQueueParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceQueueParams) ProtoMessage() {}
+
+// Deprecated: Use NonceQueueParams.ProtoReflect.Descriptor instead.
+func (*NonceQueueParams) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NonceQueueParams) GetQueueSize() uint32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *NonceQueueParams) GetGenerationBatchSize() uint32 {
+	if x != nil {
+		return x.GenerationBatchSize
+	}
+	return 0
+}
+
+func (x *NonceQueueParams) GetGenerationInterval() int64 {
+	if x != nil {
+		return x.GenerationInterval
+	}
+	return 0
+}
+
+func (x *NonceQueueParams) GetGenerationTimeoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.GenerationTimeoutDuration
+	}
+	return nil
+}
+
+func (x *NonceQueueParams) GetDkgs() []*ManagedDKG {
+	if x != nil {
+		return x.Dkgs
+	}
+	return nil
+}
+
+// Key Rotation Policy
+type KeyRotationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module which owns the keys
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// key type defined by the module, i.e. the DKG type for the refreshing method
+	Type_ string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// rotation method
+	Method KeyRotationMethod `protobuf:"varint,3,opt,name=method,proto3,enum=bitway.tss.KeyRotationMethod" json:"method,omitempty"`
+	// maximum age of the keys after which the rotation is initiated
+	MaxAge *durationpb.Duration `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// overlap window during which both the old and new keys are in use; only for the re-DKG method
+	OverlapWindow *durationpb.Duration `protobuf:"bytes,5,opt,name=overlap_window,json=overlapWindow,proto3" json:"overlap_window,omitempty"`
+}
+
+func (x *KeyRotationPolicy) Reset() {
+	*x = KeyRotationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_params_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRotationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRotationPolicy) ProtoMessage() {}
+
+// Deprecated: Use KeyRotationPolicy.ProtoReflect.Descriptor instead.
+func (*KeyRotationPolicy) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_params_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KeyRotationPolicy) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *KeyRotationPolicy) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *KeyRotationPolicy) GetMethod() KeyRotationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH
+}
+
+func (x *KeyRotationPolicy) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *KeyRotationPolicy) GetOverlapWindow() *durationpb.Duration {
+	if x != nil {
+		return x.OverlapWindow
+	}
+	return nil
+}
+
 var File_bitway_tss_params_proto protoreflect.FileDescriptor
 
 var file_bitway_tss_params_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x62, 0x69, 0x74, 0x77, 0x61,
 	0x79, 0x2e, 0x74, 0x73, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x5f, 0x64, 0x6b, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x44, 0x6b, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x73, 0x12, 0x55, 0x0a, 0x14, 0x64, 0x6b, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
-	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x12, 0x64, 0x6b, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x5a, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x6b, 0x67,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6b,
+	0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x55, 0x0a,
+	0x14, 0x64, 0x6b, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x12, 0x64, 0x6b, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x16, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73,
+	0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x68, 0x0a, 0x1a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x18, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5c, 0x0a,
+	0x16, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x57, 0x0a,
+	0x15, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x13, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x18, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6a, 0x61,
+	0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x79, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x7e, 0x0a, 0x14, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
 	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0e, 0x44, 0x4b, 0x47,
 	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
 	0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f,
 	0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
 	0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x42, 0x93, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x74, 0x73, 0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0xa2,
-	0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x54,
-	0x73, 0x73, 0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0xe2,
-	0x02, 0x16, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x8d, 0x02, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x3f, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x64, 0x6b, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x44, 0x4b, 0x47, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x44, 0x6b, 0x67, 0x73,
+	0x22, 0x38, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x44, 0x4b, 0x47, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x63, 0x0a, 0x1b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x19, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x6b, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x44, 0x4b, 0x47, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x64, 0x6b, 0x67, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x11, 0x4b,
+	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2a, 0x53, 0x0a,
+	0x11, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53,
+	0x48, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x52, 0x45, 0x44, 0x4b, 0x47,
+	0x10, 0x01, 0x42, 0x93, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x74, 0x73, 0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73,
+	0x73, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x54, 0x73, 0x73, 0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73,
+	0x73, 0xe2, 0x02, 0x16, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1224,20 +6969,47 @@ func file_bitway_tss_params_proto_rawDescGZIP() []byte {
 	return file_bitway_tss_params_proto_rawDescData
 }
 
-var file_bitway_tss_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bitway_tss_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bitway_tss_params_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_bitway_tss_params_proto_goTypes = []interface{}{
-	(*Params)(nil),              // 0: bitway.tss.Params
-	(*DKGParticipant)(nil),      // 1: bitway.tss.DKGParticipant
-	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	(KeyRotationMethod)(0),           // 0: bitway.tss.KeyRotationMethod
+	(*Params)(nil),                   // 1: bitway.tss.Params
+	(*ParticipantPenaltyParams)(nil), // 2: bitway.tss.ParticipantPenaltyParams
+	(*ModuleSigningFee)(nil),         // 3: bitway.tss.ModuleSigningFee
+	(*ModuleSigningTimeout)(nil),     // 4: bitway.tss.ModuleSigningTimeout
+	(*DKGParticipant)(nil),           // 5: bitway.tss.DKGParticipant
+	(*ParticipantSetParams)(nil),     // 6: bitway.tss.ParticipantSetParams
+	(*ManagedDKG)(nil),               // 7: bitway.tss.ManagedDKG
+	(*NonceQueueParams)(nil),         // 8: bitway.tss.NonceQueueParams
+	(*KeyRotationPolicy)(nil),        // 9: bitway.tss.KeyRotationPolicy
+	(*durationpb.Duration)(nil),      // 10: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),             // 11: cosmos.base.v1beta1.Coin
 }
 var file_bitway_tss_params_proto_depIdxs = []int32{
-	1, // 0: bitway.tss.Params.allowed_dkg_participants:type_name -> bitway.tss.DKGParticipant
-	2, // 1: bitway.tss.Params.dkg_timeout_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: bitway.tss.Params.allowed_dkg_participants:type_name -> bitway.tss.DKGParticipant
+	10, // 1: bitway.tss.Params.dkg_timeout_duration:type_name -> google.protobuf.Duration
+	10, // 2: bitway.tss.Params.signing_timeout_duration:type_name -> google.protobuf.Duration
+	4,  // 3: bitway.tss.Params.module_signing_timeouts:type_name -> bitway.tss.ModuleSigningTimeout
+	2,  // 4: bitway.tss.Params.participant_penalty_params:type_name -> bitway.tss.ParticipantPenaltyParams
+	6,  // 5: bitway.tss.Params.participant_set_params:type_name -> bitway.tss.ParticipantSetParams
+	8,  // 6: bitway.tss.Params.nonce_queue_params:type_name -> bitway.tss.NonceQueueParams
+	9,  // 7: bitway.tss.Params.key_rotation_policies:type_name -> bitway.tss.KeyRotationPolicy
+	3,  // 8: bitway.tss.Params.module_signing_fees:type_name -> bitway.tss.ModuleSigningFee
+	10, // 9: bitway.tss.ParticipantPenaltyParams.jail_duration:type_name -> google.protobuf.Duration
+	11, // 10: bitway.tss.ModuleSigningFee.fee:type_name -> cosmos.base.v1beta1.Coin
+	10, // 11: bitway.tss.ModuleSigningTimeout.timeout_duration:type_name -> google.protobuf.Duration
+	10, // 12: bitway.tss.ParticipantSetParams.update_delay:type_name -> google.protobuf.Duration
+	7,  // 13: bitway.tss.ParticipantSetParams.managed_dkgs:type_name -> bitway.tss.ManagedDKG
+	10, // 14: bitway.tss.NonceQueueParams.generation_timeout_duration:type_name -> google.protobuf.Duration
+	7,  // 15: bitway.tss.NonceQueueParams.dkgs:type_name -> bitway.tss.ManagedDKG
+	0,  // 16: bitway.tss.KeyRotationPolicy.method:type_name -> bitway.tss.KeyRotationMethod
+	10, // 17: bitway.tss.KeyRotationPolicy.max_age:type_name -> google.protobuf.Duration
+	10, // 18: bitway.tss.KeyRotationPolicy.overlap_window:type_name -> google.protobuf.Duration
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_bitway_tss_params_proto_init() }
@@ -1259,6 +7031,42 @@ func file_bitway_tss_params_proto_init() {
 			}
 		}
 		file_bitway_tss_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantPenaltyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleSigningFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleSigningTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DKGParticipant); i {
 			case 0:
 				return &v.state
@@ -1270,19 +7078,68 @@ func file_bitway_tss_params_proto_init() {
 				return nil
 			}
 		}
+		file_bitway_tss_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSetParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManagedDKG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonceQueueParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_params_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bitway_tss_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_bitway_tss_params_proto_goTypes,
 		DependencyIndexes: file_bitway_tss_params_proto_depIdxs,
+		EnumInfos:         file_bitway_tss_params_proto_enumTypes,
 		MessageInfos:      file_bitway_tss_params_proto_msgTypes,
 	}.Build()
 	File_bitway_tss_params_proto = out.File
@@ -19,15 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                = "/bitway.tss.Query/Params"
-	Query_DKGRequest_FullMethodName            = "/bitway.tss.Query/DKGRequest"
-	Query_DKGRequests_FullMethodName           = "/bitway.tss.Query/DKGRequests"
-	Query_DKGCompletions_FullMethodName        = "/bitway.tss.Query/DKGCompletions"
-	Query_SigningRequest_FullMethodName        = "/bitway.tss.Query/SigningRequest"
-	Query_SigningRequests_FullMethodName       = "/bitway.tss.Query/SigningRequests"
-	Query_RefreshingRequest_FullMethodName     = "/bitway.tss.Query/RefreshingRequest"
-	Query_RefreshingRequests_FullMethodName    = "/bitway.tss.Query/RefreshingRequests"
-	Query_RefreshingCompletions_FullMethodName = "/bitway.tss.Query/RefreshingCompletions"
+	Query_Params_FullMethodName                   = "/bitway.tss.Query/Params"
+	Query_DKGRequest_FullMethodName               = "/bitway.tss.Query/DKGRequest"
+	Query_DKGRequests_FullMethodName              = "/bitway.tss.Query/DKGRequests"
+	Query_DKGCompletions_FullMethodName           = "/bitway.tss.Query/DKGCompletions"
+	Query_SigningRequest_FullMethodName           = "/bitway.tss.Query/SigningRequest"
+	Query_SigningRequests_FullMethodName          = "/bitway.tss.Query/SigningRequests"
+	Query_SigningBatch_FullMethodName             = "/bitway.tss.Query/SigningBatch"
+	Query_SigningBatches_FullMethodName           = "/bitway.tss.Query/SigningBatches"
+	Query_SigningAcknowledgements_FullMethodName  = "/bitway.tss.Query/SigningAcknowledgements"
+	Query_PartialSignatures_FullMethodName        = "/bitway.tss.Query/PartialSignatures"
+	Query_SignerSet_FullMethodName                = "/bitway.tss.Query/SignerSet"
+	Query_SigningFee_FullMethodName               = "/bitway.tss.Query/SigningFee"
+	Query_ParticipantRewards_FullMethodName       = "/bitway.tss.Query/ParticipantRewards"
+	Query_GovSignatureRequest_FullMethodName      = "/bitway.tss.Query/GovSignatureRequest"
+	Query_GovSignatureRequests_FullMethodName     = "/bitway.tss.Query/GovSignatureRequests"
+	Query_KeyRotations_FullMethodName             = "/bitway.tss.Query/KeyRotations"
+	Query_AllParticipantRewards_FullMethodName    = "/bitway.tss.Query/AllParticipantRewards"
+	Query_RefreshingRequest_FullMethodName        = "/bitway.tss.Query/RefreshingRequest"
+	Query_RefreshingRequests_FullMethodName       = "/bitway.tss.Query/RefreshingRequests"
+	Query_RefreshingCompletions_FullMethodName    = "/bitway.tss.Query/RefreshingCompletions"
+	Query_ParticipantReliability_FullMethodName   = "/bitway.tss.Query/ParticipantReliability"
+	Query_ParticipantReliabilities_FullMethodName = "/bitway.tss.Query/ParticipantReliabilities"
+	Query_ParticipantHealth_FullMethodName        = "/bitway.tss.Query/ParticipantHealth"
+	Query_SigningStats_FullMethodName             = "/bitway.tss.Query/SigningStats"
+	Query_ParticipantSet_FullMethodName           = "/bitway.tss.Query/ParticipantSet"
+	Query_CommittedNonces_FullMethodName          = "/bitway.tss.Query/CommittedNonces"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,12 +63,46 @@ type QueryClient interface {
 	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
 	// SigningRequests queries the signing requests by the given params.
 	SigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
+	// SigningBatch queries the signing batch by the given id.
+	SigningBatch(ctx context.Context, in *QuerySigningBatchRequest, opts ...grpc.CallOption) (*QuerySigningBatchResponse, error)
+	// SigningBatches queries the signing batches by the given params.
+	SigningBatches(ctx context.Context, in *QuerySigningBatchesRequest, opts ...grpc.CallOption) (*QuerySigningBatchesResponse, error)
+	// SigningAcknowledgements queries signing acknowledgements by the given signing request id.
+	SigningAcknowledgements(ctx context.Context, in *QuerySigningAcknowledgementsRequest, opts ...grpc.CallOption) (*QuerySigningAcknowledgementsResponse, error)
+	// PartialSignatures queries partial signatures by the given signing request id.
+	PartialSignatures(ctx context.Context, in *QueryPartialSignaturesRequest, opts ...grpc.CallOption) (*QueryPartialSignaturesResponse, error)
+	// SignerSet queries the signer set of the given signing request.
+	SignerSet(ctx context.Context, in *QuerySignerSetRequest, opts ...grpc.CallOption) (*QuerySignerSetResponse, error)
+	// SigningFee queries the fee escrowed for the given signing request.
+	SigningFee(ctx context.Context, in *QuerySigningFeeRequest, opts ...grpc.CallOption) (*QuerySigningFeeResponse, error)
+	// ParticipantRewards queries the rewards of the given participant.
+	ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error)
+	// GovSignatureRequest queries the gov signature request by the given id.
+	GovSignatureRequest(ctx context.Context, in *QueryGovSignatureRequestRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestResponse, error)
+	// GovSignatureRequests queries all gov signature requests.
+	GovSignatureRequests(ctx context.Context, in *QueryGovSignatureRequestsRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestsResponse, error)
+	// KeyRotations queries the scheduled key rotations.
+	KeyRotations(ctx context.Context, in *QueryKeyRotationsRequest, opts ...grpc.CallOption) (*QueryKeyRotationsResponse, error)
+	// AllParticipantRewards queries the rewards of all participants.
+	AllParticipantRewards(ctx context.Context, in *QueryAllParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryAllParticipantRewardsResponse, error)
 	// RefreshingRequest queries the refreshing request by the given id.
 	RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error)
 	// RefreshingRequests queries the refreshing requests by the given status.
 	RefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error)
 	// RefreshingCompletions queries refreshing completions by the given request id.
 	RefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error)
+	// ParticipantReliability queries the reliability of the given participant.
+	ParticipantReliability(ctx context.Context, in *QueryParticipantReliabilityRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilityResponse, error)
+	// ParticipantReliabilities queries the reliabilities of all participants.
+	ParticipantReliabilities(ctx context.Context, in *QueryParticipantReliabilitiesRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilitiesResponse, error)
+	// ParticipantHealth queries the key inventory and signing performance of the given participant.
+	ParticipantHealth(ctx context.Context, in *QueryParticipantHealthRequest, opts ...grpc.CallOption) (*QueryParticipantHealthResponse, error)
+	// SigningStats queries the signing statistics by module and signing type.
+	SigningStats(ctx context.Context, in *QuerySigningStatsRequest, opts ...grpc.CallOption) (*QuerySigningStatsResponse, error)
+	// ParticipantSet queries the automatically maintained participant set.
+	ParticipantSet(ctx context.Context, in *QueryParticipantSetRequest, opts ...grpc.CallOption) (*QueryParticipantSetResponse, error)
+	// CommittedNonces queries the committed nonces in the queue of the given DKG.
+	CommittedNonces(ctx context.Context, in *QueryCommittedNoncesRequest, opts ...grpc.CallOption) (*QueryCommittedNoncesResponse, error)
 }
 
 type queryClient struct {
@@ -116,6 +167,105 @@ func (c *queryClient) SigningRequests(ctx context.Context, in *QuerySigningReque
 	return out, nil
 }
 
+func (c *queryClient) SigningBatch(ctx context.Context, in *QuerySigningBatchRequest, opts ...grpc.CallOption) (*QuerySigningBatchResponse, error) {
+	out := new(QuerySigningBatchResponse)
+	err := c.cc.Invoke(ctx, Query_SigningBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningBatches(ctx context.Context, in *QuerySigningBatchesRequest, opts ...grpc.CallOption) (*QuerySigningBatchesResponse, error) {
+	out := new(QuerySigningBatchesResponse)
+	err := c.cc.Invoke(ctx, Query_SigningBatches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningAcknowledgements(ctx context.Context, in *QuerySigningAcknowledgementsRequest, opts ...grpc.CallOption) (*QuerySigningAcknowledgementsResponse, error) {
+	out := new(QuerySigningAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, Query_SigningAcknowledgements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartialSignatures(ctx context.Context, in *QueryPartialSignaturesRequest, opts ...grpc.CallOption) (*QueryPartialSignaturesResponse, error) {
+	out := new(QueryPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, Query_PartialSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSet(ctx context.Context, in *QuerySignerSetRequest, opts ...grpc.CallOption) (*QuerySignerSetResponse, error) {
+	out := new(QuerySignerSetResponse)
+	err := c.cc.Invoke(ctx, Query_SignerSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningFee(ctx context.Context, in *QuerySigningFeeRequest, opts ...grpc.CallOption) (*QuerySigningFeeResponse, error) {
+	out := new(QuerySigningFeeResponse)
+	err := c.cc.Invoke(ctx, Query_SigningFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error) {
+	out := new(QueryParticipantRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovSignatureRequest(ctx context.Context, in *QueryGovSignatureRequestRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestResponse, error) {
+	out := new(QueryGovSignatureRequestResponse)
+	err := c.cc.Invoke(ctx, Query_GovSignatureRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovSignatureRequests(ctx context.Context, in *QueryGovSignatureRequestsRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestsResponse, error) {
+	out := new(QueryGovSignatureRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_GovSignatureRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyRotations(ctx context.Context, in *QueryKeyRotationsRequest, opts ...grpc.CallOption) (*QueryKeyRotationsResponse, error) {
+	out := new(QueryKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_KeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllParticipantRewards(ctx context.Context, in *QueryAllParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryAllParticipantRewardsResponse, error) {
+	out := new(QueryAllParticipantRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_AllParticipantRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error) {
 	out := new(QueryRefreshingRequestResponse)
 	err := c.cc.Invoke(ctx, Query_RefreshingRequest_FullMethodName, in, out, opts...)
@@ -143,6 +293,60 @@ func (c *queryClient) RefreshingCompletions(ctx context.Context, in *QueryRefres
 	return out, nil
 }
 
+func (c *queryClient) ParticipantReliability(ctx context.Context, in *QueryParticipantReliabilityRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilityResponse, error) {
+	out := new(QueryParticipantReliabilityResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantReliability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantReliabilities(ctx context.Context, in *QueryParticipantReliabilitiesRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilitiesResponse, error) {
+	out := new(QueryParticipantReliabilitiesResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantReliabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantHealth(ctx context.Context, in *QueryParticipantHealthRequest, opts ...grpc.CallOption) (*QueryParticipantHealthResponse, error) {
+	out := new(QueryParticipantHealthResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningStats(ctx context.Context, in *QuerySigningStatsRequest, opts ...grpc.CallOption) (*QuerySigningStatsResponse, error) {
+	out := new(QuerySigningStatsResponse)
+	err := c.cc.Invoke(ctx, Query_SigningStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantSet(ctx context.Context, in *QueryParticipantSetRequest, opts ...grpc.CallOption) (*QueryParticipantSetResponse, error) {
+	out := new(QueryParticipantSetResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommittedNonces(ctx context.Context, in *QueryCommittedNoncesRequest, opts ...grpc.CallOption) (*QueryCommittedNoncesResponse, error) {
+	out := new(QueryCommittedNoncesResponse)
+	err := c.cc.Invoke(ctx, Query_CommittedNonces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -159,12 +363,46 @@ type QueryServer interface {
 	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
 	// SigningRequests queries the signing requests by the given params.
 	SigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
+	// SigningBatch queries the signing batch by the given id.
+	SigningBatch(context.Context, *QuerySigningBatchRequest) (*QuerySigningBatchResponse, error)
+	// SigningBatches queries the signing batches by the given params.
+	SigningBatches(context.Context, *QuerySigningBatchesRequest) (*QuerySigningBatchesResponse, error)
+	// SigningAcknowledgements queries signing acknowledgements by the given signing request id.
+	SigningAcknowledgements(context.Context, *QuerySigningAcknowledgementsRequest) (*QuerySigningAcknowledgementsResponse, error)
+	// PartialSignatures queries partial signatures by the given signing request id.
+	PartialSignatures(context.Context, *QueryPartialSignaturesRequest) (*QueryPartialSignaturesResponse, error)
+	// SignerSet queries the signer set of the given signing request.
+	SignerSet(context.Context, *QuerySignerSetRequest) (*QuerySignerSetResponse, error)
+	// SigningFee queries the fee escrowed for the given signing request.
+	SigningFee(context.Context, *QuerySigningFeeRequest) (*QuerySigningFeeResponse, error)
+	// ParticipantRewards queries the rewards of the given participant.
+	ParticipantRewards(context.Context, *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error)
+	// GovSignatureRequest queries the gov signature request by the given id.
+	GovSignatureRequest(context.Context, *QueryGovSignatureRequestRequest) (*QueryGovSignatureRequestResponse, error)
+	// GovSignatureRequests queries all gov signature requests.
+	GovSignatureRequests(context.Context, *QueryGovSignatureRequestsRequest) (*QueryGovSignatureRequestsResponse, error)
+	// KeyRotations queries the scheduled key rotations.
+	KeyRotations(context.Context, *QueryKeyRotationsRequest) (*QueryKeyRotationsResponse, error)
+	// AllParticipantRewards queries the rewards of all participants.
+	AllParticipantRewards(context.Context, *QueryAllParticipantRewardsRequest) (*QueryAllParticipantRewardsResponse, error)
 	// RefreshingRequest queries the refreshing request by the given id.
 	RefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error)
 	// RefreshingRequests queries the refreshing requests by the given status.
 	RefreshingRequests(context.Context, *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error)
 	// RefreshingCompletions queries refreshing completions by the given request id.
 	RefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error)
+	// ParticipantReliability queries the reliability of the given participant.
+	ParticipantReliability(context.Context, *QueryParticipantReliabilityRequest) (*QueryParticipantReliabilityResponse, error)
+	// ParticipantReliabilities queries the reliabilities of all participants.
+	ParticipantReliabilities(context.Context, *QueryParticipantReliabilitiesRequest) (*QueryParticipantReliabilitiesResponse, error)
+	// ParticipantHealth queries the key inventory and signing performance of the given participant.
+	ParticipantHealth(context.Context, *QueryParticipantHealthRequest) (*QueryParticipantHealthResponse, error)
+	// SigningStats queries the signing statistics by module and signing type.
+	SigningStats(context.Context, *QuerySigningStatsRequest) (*QuerySigningStatsResponse, error)
+	// ParticipantSet queries the automatically maintained participant set.
+	ParticipantSet(context.Context, *QueryParticipantSetRequest) (*QueryParticipantSetResponse, error)
+	// CommittedNonces queries the committed nonces in the queue of the given DKG.
+	CommittedNonces(context.Context, *QueryCommittedNoncesRequest) (*QueryCommittedNoncesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -190,6 +428,39 @@ func (UnimplementedQueryServer) SigningRequest(context.Context, *QuerySigningReq
 func (UnimplementedQueryServer) SigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningRequests not implemented")
 }
+func (UnimplementedQueryServer) SigningBatch(context.Context, *QuerySigningBatchRequest) (*QuerySigningBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningBatch not implemented")
+}
+func (UnimplementedQueryServer) SigningBatches(context.Context, *QuerySigningBatchesRequest) (*QuerySigningBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningBatches not implemented")
+}
+func (UnimplementedQueryServer) SigningAcknowledgements(context.Context, *QuerySigningAcknowledgementsRequest) (*QuerySigningAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningAcknowledgements not implemented")
+}
+func (UnimplementedQueryServer) PartialSignatures(context.Context, *QueryPartialSignaturesRequest) (*QueryPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialSignatures not implemented")
+}
+func (UnimplementedQueryServer) SignerSet(context.Context, *QuerySignerSetRequest) (*QuerySignerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSet not implemented")
+}
+func (UnimplementedQueryServer) SigningFee(context.Context, *QuerySigningFeeRequest) (*QuerySigningFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningFee not implemented")
+}
+func (UnimplementedQueryServer) ParticipantRewards(context.Context, *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantRewards not implemented")
+}
+func (UnimplementedQueryServer) GovSignatureRequest(context.Context, *QueryGovSignatureRequestRequest) (*QueryGovSignatureRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSignatureRequest not implemented")
+}
+func (UnimplementedQueryServer) GovSignatureRequests(context.Context, *QueryGovSignatureRequestsRequest) (*QueryGovSignatureRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSignatureRequests not implemented")
+}
+func (UnimplementedQueryServer) KeyRotations(context.Context, *QueryKeyRotationsRequest) (*QueryKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyRotations not implemented")
+}
+func (UnimplementedQueryServer) AllParticipantRewards(context.Context, *QueryAllParticipantRewardsRequest) (*QueryAllParticipantRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllParticipantRewards not implemented")
+}
 func (UnimplementedQueryServer) RefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshingRequest not implemented")
 }
@@ -199,6 +470,24 @@ func (UnimplementedQueryServer) RefreshingRequests(context.Context, *QueryRefres
 func (UnimplementedQueryServer) RefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshingCompletions not implemented")
 }
+func (UnimplementedQueryServer) ParticipantReliability(context.Context, *QueryParticipantReliabilityRequest) (*QueryParticipantReliabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantReliability not implemented")
+}
+func (UnimplementedQueryServer) ParticipantReliabilities(context.Context, *QueryParticipantReliabilitiesRequest) (*QueryParticipantReliabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantReliabilities not implemented")
+}
+func (UnimplementedQueryServer) ParticipantHealth(context.Context, *QueryParticipantHealthRequest) (*QueryParticipantHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantHealth not implemented")
+}
+func (UnimplementedQueryServer) SigningStats(context.Context, *QuerySigningStatsRequest) (*QuerySigningStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningStats not implemented")
+}
+func (UnimplementedQueryServer) ParticipantSet(context.Context, *QueryParticipantSetRequest) (*QueryParticipantSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantSet not implemented")
+}
+func (UnimplementedQueryServer) CommittedNonces(context.Context, *QueryCommittedNoncesRequest) (*QueryCommittedNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommittedNonces not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -320,6 +609,204 @@ func _Query_SigningRequests_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SigningBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningBatch(ctx, req.(*QuerySigningBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningBatches(ctx, req.(*QuerySigningBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningAcknowledgementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningAcknowledgements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningAcknowledgements(ctx, req.(*QuerySigningAcknowledgementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartialSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartialSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PartialSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartialSignatures(ctx, req.(*QueryPartialSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignerSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSet(ctx, req.(*QuerySignerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningFee(ctx, req.(*QuerySigningFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantRewards(ctx, req.(*QueryParticipantRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovSignatureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovSignatureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovSignatureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovSignatureRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovSignatureRequest(ctx, req.(*QueryGovSignatureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovSignatureRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovSignatureRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovSignatureRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovSignatureRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovSignatureRequests(ctx, req.(*QueryGovSignatureRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyRotations(ctx, req.(*QueryKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllParticipantRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllParticipantRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllParticipantRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllParticipantRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllParticipantRewards(ctx, req.(*QueryAllParticipantRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RefreshingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRefreshingRequestRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +861,114 @@ func _Query_RefreshingCompletions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParticipantReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantReliability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantReliability(ctx, req.(*QueryParticipantReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantReliabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantReliabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantReliabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantReliabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantReliabilities(ctx, req.(*QueryParticipantReliabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantHealth(ctx, req.(*QueryParticipantHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningStats(ctx, req.(*QuerySigningStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantSet(ctx, req.(*QueryParticipantSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommittedNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommittedNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommittedNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommittedNonces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommittedNonces(ctx, req.(*QueryCommittedNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -405,6 +1000,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningRequests",
 			Handler:    _Query_SigningRequests_Handler,
 		},
+		{
+			MethodName: "SigningBatch",
+			Handler:    _Query_SigningBatch_Handler,
+		},
+		{
+			MethodName: "SigningBatches",
+			Handler:    _Query_SigningBatches_Handler,
+		},
+		{
+			MethodName: "SigningAcknowledgements",
+			Handler:    _Query_SigningAcknowledgements_Handler,
+		},
+		{
+			MethodName: "PartialSignatures",
+			Handler:    _Query_PartialSignatures_Handler,
+		},
+		{
+			MethodName: "SignerSet",
+			Handler:    _Query_SignerSet_Handler,
+		},
+		{
+			MethodName: "SigningFee",
+			Handler:    _Query_SigningFee_Handler,
+		},
+		{
+			MethodName: "ParticipantRewards",
+			Handler:    _Query_ParticipantRewards_Handler,
+		},
+		{
+			MethodName: "GovSignatureRequest",
+			Handler:    _Query_GovSignatureRequest_Handler,
+		},
+		{
+			MethodName: "GovSignatureRequests",
+			Handler:    _Query_GovSignatureRequests_Handler,
+		},
+		{
+			MethodName: "KeyRotations",
+			Handler:    _Query_KeyRotations_Handler,
+		},
+		{
+			MethodName: "AllParticipantRewards",
+			Handler:    _Query_AllParticipantRewards_Handler,
+		},
 		{
 			MethodName: "RefreshingRequest",
 			Handler:    _Query_RefreshingRequest_Handler,
@@ -417,6 +1056,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshingCompletions",
 			Handler:    _Query_RefreshingCompletions_Handler,
 		},
+		{
+			MethodName: "ParticipantReliability",
+			Handler:    _Query_ParticipantReliability_Handler,
+		},
+		{
+			MethodName: "ParticipantReliabilities",
+			Handler:    _Query_ParticipantReliabilities_Handler,
+		},
+		{
+			MethodName: "ParticipantHealth",
+			Handler:    _Query_ParticipantHealth_Handler,
+		},
+		{
+			MethodName: "SigningStats",
+			Handler:    _Query_SigningStats_Handler,
+		},
+		{
+			MethodName: "ParticipantSet",
+			Handler:    _Query_ParticipantSet_Handler,
+		},
+		{
+			MethodName: "CommittedNonces",
+			Handler:    _Query_CommittedNonces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitway/tss/query.proto",
@@ -2,12 +2,14 @@
 package tss
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
@@ -71,6 +73,7 @@ var (
 	fd_DKGRequest_batch_size      protoreflect.FieldDescriptor
 	fd_DKGRequest_expiration_time protoreflect.FieldDescriptor
 	fd_DKGRequest_status          protoreflect.FieldDescriptor
+	fd_DKGRequest_key_type        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,6 +88,7 @@ func init() {
 	fd_DKGRequest_batch_size = md_DKGRequest.Fields().ByName("batch_size")
 	fd_DKGRequest_expiration_time = md_DKGRequest.Fields().ByName("expiration_time")
 	fd_DKGRequest_status = md_DKGRequest.Fields().ByName("status")
+	fd_DKGRequest_key_type = md_DKGRequest.Fields().ByName("key_type")
 }
 
 var _ protoreflect.Message = (*fastReflection_DKGRequest)(nil)
@@ -206,6 +210,12 @@ func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_DKGRequest_key_type, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -239,6 +249,8 @@ func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ExpirationTime != nil
 	case "bitway.tss.DKGRequest.status":
 		return x.Status != 0
+	case "bitway.tss.DKGRequest.key_type":
+		return x.KeyType != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -273,6 +285,8 @@ func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.ExpirationTime = nil
 	case "bitway.tss.DKGRequest.status":
 		x.Status = 0
+	case "bitway.tss.DKGRequest.key_type":
+		x.KeyType = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -319,6 +333,9 @@ func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor)
 	case "bitway.tss.DKGRequest.status":
 		value := x.Status
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.DKGRequest.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -359,6 +376,8 @@ func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value p
 		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
 	case "bitway.tss.DKGRequest.status":
 		x.Status = (DKGStatus)(value.Enum())
+	case "bitway.tss.DKGRequest.key_type":
+		x.KeyType = (KeyType)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -404,6 +423,8 @@ func (x *fastReflection_DKGRequest) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field batch_size of message bitway.tss.DKGRequest is not mutable"))
 	case "bitway.tss.DKGRequest.status":
 		panic(fmt.Errorf("field status of message bitway.tss.DKGRequest is not mutable"))
+	case "bitway.tss.DKGRequest.key_type":
+		panic(fmt.Errorf("field key_type of message bitway.tss.DKGRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -437,6 +458,8 @@ func (x *fastReflection_DKGRequest) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "bitway.tss.DKGRequest.status":
 		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.DKGRequest.key_type":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGRequest"))
@@ -539,6 +562,9 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 		if x.Status != 0 {
 			n += 1 + runtime.Sov(uint64(x.Status))
 		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -568,6 +594,11 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x50
+		}
 		if x.Status != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
@@ -906,6 +937,25 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -987,13 +1037,107 @@ func (x *_DKGCompletion_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_DKGCompletion_6_list)(nil)
+
+type _DKGCompletion_6_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletion_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletion_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletion_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletion_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletion_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletion at list field Proofs as it is not of Message kind"))
+}
+
+func (x *_DKGCompletion_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletion_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletion_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletion_7_list)(nil)
+
+type _DKGCompletion_7_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletion_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletion_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletion_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletion_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletion_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletion at list field VerificationShares as it is not of Message kind"))
+}
+
+func (x *_DKGCompletion_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletion_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletion_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_DKGCompletion                  protoreflect.MessageDescriptor
-	fd_DKGCompletion_id               protoreflect.FieldDescriptor
-	fd_DKGCompletion_sender           protoreflect.FieldDescriptor
-	fd_DKGCompletion_pub_keys         protoreflect.FieldDescriptor
-	fd_DKGCompletion_consensus_pubkey protoreflect.FieldDescriptor
-	fd_DKGCompletion_signature        protoreflect.FieldDescriptor
+	md_DKGCompletion                     protoreflect.MessageDescriptor
+	fd_DKGCompletion_id                  protoreflect.FieldDescriptor
+	fd_DKGCompletion_sender              protoreflect.FieldDescriptor
+	fd_DKGCompletion_pub_keys            protoreflect.FieldDescriptor
+	fd_DKGCompletion_consensus_pubkey    protoreflect.FieldDescriptor
+	fd_DKGCompletion_signature           protoreflect.FieldDescriptor
+	fd_DKGCompletion_proofs              protoreflect.FieldDescriptor
+	fd_DKGCompletion_verification_shares protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1004,6 +1148,8 @@ func init() {
 	fd_DKGCompletion_pub_keys = md_DKGCompletion.Fields().ByName("pub_keys")
 	fd_DKGCompletion_consensus_pubkey = md_DKGCompletion.Fields().ByName("consensus_pubkey")
 	fd_DKGCompletion_signature = md_DKGCompletion.Fields().ByName("signature")
+	fd_DKGCompletion_proofs = md_DKGCompletion.Fields().ByName("proofs")
+	fd_DKGCompletion_verification_shares = md_DKGCompletion.Fields().ByName("verification_shares")
 }
 
 var _ protoreflect.Message = (*fastReflection_DKGCompletion)(nil)
@@ -1101,6 +1247,18 @@ func (x *fastReflection_DKGCompletion) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletion_6_list{list: &x.Proofs})
+		if !f(fd_DKGCompletion_proofs, value) {
+			return
+		}
+	}
+	if len(x.VerificationShares) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletion_7_list{list: &x.VerificationShares})
+		if !f(fd_DKGCompletion_verification_shares, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1126,6 +1284,10 @@ func (x *fastReflection_DKGCompletion) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ConsensusPubkey != ""
 	case "bitway.tss.DKGCompletion.signature":
 		return x.Signature != ""
+	case "bitway.tss.DKGCompletion.proofs":
+		return len(x.Proofs) != 0
+	case "bitway.tss.DKGCompletion.verification_shares":
+		return len(x.VerificationShares) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGCompletion"))
@@ -1152,6 +1314,10 @@ func (x *fastReflection_DKGCompletion) Clear(fd protoreflect.FieldDescriptor) {
 		x.ConsensusPubkey = ""
 	case "bitway.tss.DKGCompletion.signature":
 		x.Signature = ""
+	case "bitway.tss.DKGCompletion.proofs":
+		x.Proofs = nil
+	case "bitway.tss.DKGCompletion.verification_shares":
+		x.VerificationShares = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGCompletion"))
@@ -1186,6 +1352,18 @@ func (x *fastReflection_DKGCompletion) Get(descriptor protoreflect.FieldDescript
 	case "bitway.tss.DKGCompletion.signature":
 		value := x.Signature
 		return protoreflect.ValueOfString(value)
+	case "bitway.tss.DKGCompletion.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletion_6_list{})
+		}
+		listValue := &_DKGCompletion_6_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.DKGCompletion.verification_shares":
+		if len(x.VerificationShares) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletion_7_list{})
+		}
+		listValue := &_DKGCompletion_7_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGCompletion"))
@@ -1218,6 +1396,14 @@ func (x *fastReflection_DKGCompletion) Set(fd protoreflect.FieldDescriptor, valu
 		x.ConsensusPubkey = value.Interface().(string)
 	case "bitway.tss.DKGCompletion.signature":
 		x.Signature = value.Interface().(string)
+	case "bitway.tss.DKGCompletion.proofs":
+		lv := value.List()
+		clv := lv.(*_DKGCompletion_6_list)
+		x.Proofs = *clv.list
+	case "bitway.tss.DKGCompletion.verification_shares":
+		lv := value.List()
+		clv := lv.(*_DKGCompletion_7_list)
+		x.VerificationShares = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGCompletion"))
@@ -1244,6 +1430,18 @@ func (x *fastReflection_DKGCompletion) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_DKGCompletion_3_list{list: &x.PubKeys}
 		return protoreflect.ValueOfList(value)
+	case "bitway.tss.DKGCompletion.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []string{}
+		}
+		value := &_DKGCompletion_6_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.DKGCompletion.verification_shares":
+		if x.VerificationShares == nil {
+			x.VerificationShares = []string{}
+		}
+		value := &_DKGCompletion_7_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(value)
 	case "bitway.tss.DKGCompletion.id":
 		panic(fmt.Errorf("field id of message bitway.tss.DKGCompletion is not mutable"))
 	case "bitway.tss.DKGCompletion.sender":
@@ -1276,6 +1474,12 @@ func (x *fastReflection_DKGCompletion) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "bitway.tss.DKGCompletion.signature":
 		return protoreflect.ValueOfString("")
+	case "bitway.tss.DKGCompletion.proofs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletion_6_list{list: &list})
+	case "bitway.tss.DKGCompletion.verification_shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletion_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.DKGCompletion"))
@@ -1366,6 +1570,18 @@ func (x *fastReflection_DKGCompletion) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Proofs) > 0 {
+			for _, s := range x.Proofs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerificationShares) > 0 {
+			for _, s := range x.VerificationShares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1395,6 +1611,24 @@ func (x *fastReflection_DKGCompletion) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VerificationShares) > 0 {
+			for iNdEx := len(x.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerificationShares[iNdEx])
+				copy(dAtA[i:], x.VerificationShares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationShares[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proofs[iNdEx])
+				copy(dAtA[i:], x.Proofs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proofs[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.Signature) > 0 {
 			i -= len(x.Signature)
 			copy(dAtA[i:], x.Signature)
@@ -1626,6 +1860,70 @@ func (x *fastReflection_DKGCompletion) ProtoMethods() *protoiface.Methods {
 				}
 				x.Signature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proofs = append(x.Proofs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShares = append(x.VerificationShares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1661,11 +1959,58 @@ func (x *fastReflection_DKGCompletion) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_SigningOptions_4_list)(nil)
+
+type _SigningOptions_4_list struct {
+	list *[]string
+}
+
+func (x *_SigningOptions_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SigningOptions_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SigningOptions_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SigningOptions_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SigningOptions_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SigningOptions at list field Nonces as it is not of Message kind"))
+}
+
+func (x *_SigningOptions_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SigningOptions_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SigningOptions_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_SigningOptions               protoreflect.MessageDescriptor
 	fd_SigningOptions_tweak         protoreflect.FieldDescriptor
 	fd_SigningOptions_nonce         protoreflect.FieldDescriptor
 	fd_SigningOptions_adaptor_point protoreflect.FieldDescriptor
+	fd_SigningOptions_nonces        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1674,6 +2019,7 @@ func init() {
 	fd_SigningOptions_tweak = md_SigningOptions.Fields().ByName("tweak")
 	fd_SigningOptions_nonce = md_SigningOptions.Fields().ByName("nonce")
 	fd_SigningOptions_adaptor_point = md_SigningOptions.Fields().ByName("adaptor_point")
+	fd_SigningOptions_nonces = md_SigningOptions.Fields().ByName("nonces")
 }
 
 var _ protoreflect.Message = (*fastReflection_SigningOptions)(nil)
@@ -1759,6 +2105,12 @@ func (x *fastReflection_SigningOptions) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Nonces) != 0 {
+		value := protoreflect.ValueOfList(&_SigningOptions_4_list{list: &x.Nonces})
+		if !f(fd_SigningOptions_nonces, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1780,6 +2132,8 @@ func (x *fastReflection_SigningOptions) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Nonce != ""
 	case "bitway.tss.SigningOptions.adaptor_point":
 		return x.AdaptorPoint != ""
+	case "bitway.tss.SigningOptions.nonces":
+		return len(x.Nonces) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningOptions"))
@@ -1802,6 +2156,8 @@ func (x *fastReflection_SigningOptions) Clear(fd protoreflect.FieldDescriptor) {
 		x.Nonce = ""
 	case "bitway.tss.SigningOptions.adaptor_point":
 		x.AdaptorPoint = ""
+	case "bitway.tss.SigningOptions.nonces":
+		x.Nonces = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningOptions"))
@@ -1827,6 +2183,12 @@ func (x *fastReflection_SigningOptions) Get(descriptor protoreflect.FieldDescrip
 	case "bitway.tss.SigningOptions.adaptor_point":
 		value := x.AdaptorPoint
 		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningOptions.nonces":
+		if len(x.Nonces) == 0 {
+			return protoreflect.ValueOfList(&_SigningOptions_4_list{})
+		}
+		listValue := &_SigningOptions_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningOptions"))
@@ -1853,6 +2215,10 @@ func (x *fastReflection_SigningOptions) Set(fd protoreflect.FieldDescriptor, val
 		x.Nonce = value.Interface().(string)
 	case "bitway.tss.SigningOptions.adaptor_point":
 		x.AdaptorPoint = value.Interface().(string)
+	case "bitway.tss.SigningOptions.nonces":
+		lv := value.List()
+		clv := lv.(*_SigningOptions_4_list)
+		x.Nonces = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningOptions"))
@@ -1873,6 +2239,12 @@ func (x *fastReflection_SigningOptions) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_SigningOptions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "bitway.tss.SigningOptions.nonces":
+		if x.Nonces == nil {
+			x.Nonces = []string{}
+		}
+		value := &_SigningOptions_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(value)
 	case "bitway.tss.SigningOptions.tweak":
 		panic(fmt.Errorf("field tweak of message bitway.tss.SigningOptions is not mutable"))
 	case "bitway.tss.SigningOptions.nonce":
@@ -1898,6 +2270,9 @@ func (x *fastReflection_SigningOptions) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "bitway.tss.SigningOptions.adaptor_point":
 		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningOptions.nonces":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SigningOptions_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningOptions"))
@@ -1979,6 +2354,12 @@ func (x *fastReflection_SigningOptions) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Nonces) > 0 {
+			for _, s := range x.Nonces {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2008,6 +2389,15 @@ func (x *fastReflection_SigningOptions) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Nonces) > 0 {
+			for iNdEx := len(x.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Nonces[iNdEx])
+				copy(dAtA[i:], x.Nonces[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nonces[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.AdaptorPoint) > 0 {
 			i -= len(x.AdaptorPoint)
 			copy(dAtA[i:], x.AdaptorPoint)
@@ -2174,22 +2564,54 @@ func (x *fastReflection_SigningOptions) ProtoMethods() *protoiface.Methods {
 				}
 				x.AdaptorPoint = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 				}
-				iNdEx += skippy
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Nonces = append(x.Nonces, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
 			}
 		}
 
@@ -2256,17 +2678,21 @@ func (x *_SigningRequest_7_list) IsValid() bool {
 }
 
 var (
-	md_SigningRequest               protoreflect.MessageDescriptor
-	fd_SigningRequest_id            protoreflect.FieldDescriptor
-	fd_SigningRequest_module        protoreflect.FieldDescriptor
-	fd_SigningRequest_scoped_id     protoreflect.FieldDescriptor
-	fd_SigningRequest_type          protoreflect.FieldDescriptor
-	fd_SigningRequest_intent        protoreflect.FieldDescriptor
-	fd_SigningRequest_pub_key       protoreflect.FieldDescriptor
-	fd_SigningRequest_sig_hashes    protoreflect.FieldDescriptor
-	fd_SigningRequest_options       protoreflect.FieldDescriptor
-	fd_SigningRequest_creation_time protoreflect.FieldDescriptor
-	fd_SigningRequest_status        protoreflect.FieldDescriptor
+	md_SigningRequest                 protoreflect.MessageDescriptor
+	fd_SigningRequest_id              protoreflect.FieldDescriptor
+	fd_SigningRequest_module          protoreflect.FieldDescriptor
+	fd_SigningRequest_scoped_id       protoreflect.FieldDescriptor
+	fd_SigningRequest_type            protoreflect.FieldDescriptor
+	fd_SigningRequest_intent          protoreflect.FieldDescriptor
+	fd_SigningRequest_pub_key         protoreflect.FieldDescriptor
+	fd_SigningRequest_sig_hashes      protoreflect.FieldDescriptor
+	fd_SigningRequest_options         protoreflect.FieldDescriptor
+	fd_SigningRequest_creation_time   protoreflect.FieldDescriptor
+	fd_SigningRequest_status          protoreflect.FieldDescriptor
+	fd_SigningRequest_expiration_time protoreflect.FieldDescriptor
+	fd_SigningRequest_previous_id     protoreflect.FieldDescriptor
+	fd_SigningRequest_batch_id        protoreflect.FieldDescriptor
+	fd_SigningRequest_retries         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2282,6 +2708,10 @@ func init() {
 	fd_SigningRequest_options = md_SigningRequest.Fields().ByName("options")
 	fd_SigningRequest_creation_time = md_SigningRequest.Fields().ByName("creation_time")
 	fd_SigningRequest_status = md_SigningRequest.Fields().ByName("status")
+	fd_SigningRequest_expiration_time = md_SigningRequest.Fields().ByName("expiration_time")
+	fd_SigningRequest_previous_id = md_SigningRequest.Fields().ByName("previous_id")
+	fd_SigningRequest_batch_id = md_SigningRequest.Fields().ByName("batch_id")
+	fd_SigningRequest_retries = md_SigningRequest.Fields().ByName("retries")
 }
 
 var _ protoreflect.Message = (*fastReflection_SigningRequest)(nil)
@@ -2409,6 +2839,30 @@ func (x *fastReflection_SigningRequest) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.ExpirationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+		if !f(fd_SigningRequest_expiration_time, value) {
+			return
+		}
+	}
+	if x.PreviousId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousId)
+		if !f(fd_SigningRequest_previous_id, value) {
+			return
+		}
+	}
+	if x.BatchId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchId)
+		if !f(fd_SigningRequest_batch_id, value) {
+			return
+		}
+	}
+	if x.Retries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Retries)
+		if !f(fd_SigningRequest_retries, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2444,6 +2898,14 @@ func (x *fastReflection_SigningRequest) Has(fd protoreflect.FieldDescriptor) boo
 		return x.CreationTime != nil
 	case "bitway.tss.SigningRequest.status":
 		return x.Status != 0
+	case "bitway.tss.SigningRequest.expiration_time":
+		return x.ExpirationTime != nil
+	case "bitway.tss.SigningRequest.previous_id":
+		return x.PreviousId != uint64(0)
+	case "bitway.tss.SigningRequest.batch_id":
+		return x.BatchId != uint64(0)
+	case "bitway.tss.SigningRequest.retries":
+		return x.Retries != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2480,6 +2942,14 @@ func (x *fastReflection_SigningRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.CreationTime = nil
 	case "bitway.tss.SigningRequest.status":
 		x.Status = 0
+	case "bitway.tss.SigningRequest.expiration_time":
+		x.ExpirationTime = nil
+	case "bitway.tss.SigningRequest.previous_id":
+		x.PreviousId = uint64(0)
+	case "bitway.tss.SigningRequest.batch_id":
+		x.BatchId = uint64(0)
+	case "bitway.tss.SigningRequest.retries":
+		x.Retries = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2529,6 +2999,18 @@ func (x *fastReflection_SigningRequest) Get(descriptor protoreflect.FieldDescrip
 	case "bitway.tss.SigningRequest.status":
 		value := x.Status
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.SigningRequest.expiration_time":
+		value := x.ExpirationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.SigningRequest.previous_id":
+		value := x.PreviousId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningRequest.batch_id":
+		value := x.BatchId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningRequest.retries":
+		value := x.Retries
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2571,6 +3053,14 @@ func (x *fastReflection_SigningRequest) Set(fd protoreflect.FieldDescriptor, val
 		x.CreationTime = value.Message().Interface().(*timestamppb.Timestamp)
 	case "bitway.tss.SigningRequest.status":
 		x.Status = (SigningStatus)(value.Enum())
+	case "bitway.tss.SigningRequest.expiration_time":
+		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.SigningRequest.previous_id":
+		x.PreviousId = value.Uint()
+	case "bitway.tss.SigningRequest.batch_id":
+		x.BatchId = value.Uint()
+	case "bitway.tss.SigningRequest.retries":
+		x.Retries = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2607,6 +3097,11 @@ func (x *fastReflection_SigningRequest) Mutable(fd protoreflect.FieldDescriptor)
 			x.CreationTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.CreationTime.ProtoReflect())
+	case "bitway.tss.SigningRequest.expiration_time":
+		if x.ExpirationTime == nil {
+			x.ExpirationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
 	case "bitway.tss.SigningRequest.id":
 		panic(fmt.Errorf("field id of message bitway.tss.SigningRequest is not mutable"))
 	case "bitway.tss.SigningRequest.module":
@@ -2621,6 +3116,12 @@ func (x *fastReflection_SigningRequest) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field pub_key of message bitway.tss.SigningRequest is not mutable"))
 	case "bitway.tss.SigningRequest.status":
 		panic(fmt.Errorf("field status of message bitway.tss.SigningRequest is not mutable"))
+	case "bitway.tss.SigningRequest.previous_id":
+		panic(fmt.Errorf("field previous_id of message bitway.tss.SigningRequest is not mutable"))
+	case "bitway.tss.SigningRequest.batch_id":
+		panic(fmt.Errorf("field batch_id of message bitway.tss.SigningRequest is not mutable"))
+	case "bitway.tss.SigningRequest.retries":
+		panic(fmt.Errorf("field retries of message bitway.tss.SigningRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2657,6 +3158,15 @@ func (x *fastReflection_SigningRequest) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "bitway.tss.SigningRequest.status":
 		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.SigningRequest.expiration_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.SigningRequest.previous_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningRequest.batch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningRequest.retries":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningRequest"))
@@ -2764,6 +3274,19 @@ func (x *fastReflection_SigningRequest) ProtoMethods() *protoiface.Methods {
 		if x.Status != 0 {
 			n += 1 + runtime.Sov(uint64(x.Status))
 		}
+		if x.ExpirationTime != nil {
+			l = options.Size(x.ExpirationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PreviousId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousId))
+		}
+		if x.BatchId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchId))
+		}
+		if x.Retries != 0 {
+			n += 1 + runtime.Sov(uint64(x.Retries))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2793,6 +3316,35 @@ func (x *fastReflection_SigningRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Retries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retries))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BatchId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.PreviousId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousId))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ExpirationTime != nil {
+			encoded, err := options.Marshal(x.ExpirationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if x.Status != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
@@ -3196,6 +3748,99 @@ func (x *fastReflection_SigningRequest) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpirationTime == nil {
+					x.ExpirationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+				}
+				x.PreviousId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				}
+				x.BatchId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				}
+				x.Retries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3231,80 +3876,80 @@ func (x *fastReflection_SigningRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_RefreshingRequest_3_list)(nil)
+var _ protoreflect.List = (*_SigningBatchItem_3_list)(nil)
 
-type _RefreshingRequest_3_list struct {
+type _SigningBatchItem_3_list struct {
 	list *[]string
 }
 
-func (x *_RefreshingRequest_3_list) Len() int {
+func (x *_SigningBatchItem_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_RefreshingRequest_3_list) Get(i int) protoreflect.Value {
+func (x *_SigningBatchItem_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_RefreshingRequest_3_list) Set(i int, value protoreflect.Value) {
+func (x *_SigningBatchItem_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_RefreshingRequest_3_list) Append(value protoreflect.Value) {
+func (x *_SigningBatchItem_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_RefreshingRequest_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingRequest at list field RemovedParticipants as it is not of Message kind"))
+func (x *_SigningBatchItem_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SigningBatchItem at list field SigHashes as it is not of Message kind"))
 }
 
-func (x *_RefreshingRequest_3_list) Truncate(n int) {
+func (x *_SigningBatchItem_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_RefreshingRequest_3_list) NewElement() protoreflect.Value {
+func (x *_SigningBatchItem_3_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_RefreshingRequest_3_list) IsValid() bool {
+func (x *_SigningBatchItem_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_RefreshingRequest                      protoreflect.MessageDescriptor
-	fd_RefreshingRequest_id                   protoreflect.FieldDescriptor
-	fd_RefreshingRequest_dkg_id               protoreflect.FieldDescriptor
-	fd_RefreshingRequest_removed_participants protoreflect.FieldDescriptor
-	fd_RefreshingRequest_expiration_time      protoreflect.FieldDescriptor
-	fd_RefreshingRequest_status               protoreflect.FieldDescriptor
+	md_SigningBatchItem            protoreflect.MessageDescriptor
+	fd_SigningBatchItem_type       protoreflect.FieldDescriptor
+	fd_SigningBatchItem_pub_key    protoreflect.FieldDescriptor
+	fd_SigningBatchItem_sig_hashes protoreflect.FieldDescriptor
+	fd_SigningBatchItem_options    protoreflect.FieldDescriptor
+	fd_SigningBatchItem_scoped_id  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_tss_tss_proto_init()
-	md_RefreshingRequest = File_bitway_tss_tss_proto.Messages().ByName("RefreshingRequest")
-	fd_RefreshingRequest_id = md_RefreshingRequest.Fields().ByName("id")
-	fd_RefreshingRequest_dkg_id = md_RefreshingRequest.Fields().ByName("dkg_id")
-	fd_RefreshingRequest_removed_participants = md_RefreshingRequest.Fields().ByName("removed_participants")
-	fd_RefreshingRequest_expiration_time = md_RefreshingRequest.Fields().ByName("expiration_time")
-	fd_RefreshingRequest_status = md_RefreshingRequest.Fields().ByName("status")
+	md_SigningBatchItem = File_bitway_tss_tss_proto.Messages().ByName("SigningBatchItem")
+	fd_SigningBatchItem_type = md_SigningBatchItem.Fields().ByName("type")
+	fd_SigningBatchItem_pub_key = md_SigningBatchItem.Fields().ByName("pub_key")
+	fd_SigningBatchItem_sig_hashes = md_SigningBatchItem.Fields().ByName("sig_hashes")
+	fd_SigningBatchItem_options = md_SigningBatchItem.Fields().ByName("options")
+	fd_SigningBatchItem_scoped_id = md_SigningBatchItem.Fields().ByName("scoped_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_RefreshingRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_SigningBatchItem)(nil)
 
-type fastReflection_RefreshingRequest RefreshingRequest
+type fastReflection_SigningBatchItem SigningBatchItem
 
-func (x *RefreshingRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RefreshingRequest)(x)
+func (x *SigningBatchItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningBatchItem)(x)
 }
 
-func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
+func (x *SigningBatchItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tss_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3316,43 +3961,43 @@ func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RefreshingRequest_messageType fastReflection_RefreshingRequest_messageType
-var _ protoreflect.MessageType = fastReflection_RefreshingRequest_messageType{}
+var _fastReflection_SigningBatchItem_messageType fastReflection_SigningBatchItem_messageType
+var _ protoreflect.MessageType = fastReflection_SigningBatchItem_messageType{}
 
-type fastReflection_RefreshingRequest_messageType struct{}
+type fastReflection_SigningBatchItem_messageType struct{}
 
-func (x fastReflection_RefreshingRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RefreshingRequest)(nil)
+func (x fastReflection_SigningBatchItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningBatchItem)(nil)
 }
-func (x fastReflection_RefreshingRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_RefreshingRequest)
+func (x fastReflection_SigningBatchItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningBatchItem)
 }
-func (x fastReflection_RefreshingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingRequest
+func (x fastReflection_SigningBatchItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningBatchItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RefreshingRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingRequest
+func (x *fastReflection_SigningBatchItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningBatchItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RefreshingRequest) Type() protoreflect.MessageType {
-	return _fastReflection_RefreshingRequest_messageType
+func (x *fastReflection_SigningBatchItem) Type() protoreflect.MessageType {
+	return _fastReflection_SigningBatchItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RefreshingRequest) New() protoreflect.Message {
-	return new(fastReflection_RefreshingRequest)
+func (x *fastReflection_SigningBatchItem) New() protoreflect.Message {
+	return new(fastReflection_SigningBatchItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage {
-	return (*RefreshingRequest)(x)
+func (x *fastReflection_SigningBatchItem) Interface() protoreflect.ProtoMessage {
+	return (*SigningBatchItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3360,34 +4005,34 @@ func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_RefreshingRequest_id, value) {
+func (x *fastReflection_SigningBatchItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_SigningBatchItem_type, value) {
 			return
 		}
 	}
-	if x.DkgId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DkgId)
-		if !f(fd_RefreshingRequest_dkg_id, value) {
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_SigningBatchItem_pub_key, value) {
 			return
 		}
 	}
-	if len(x.RemovedParticipants) != 0 {
-		value := protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &x.RemovedParticipants})
-		if !f(fd_RefreshingRequest_removed_participants, value) {
+	if len(x.SigHashes) != 0 {
+		value := protoreflect.ValueOfList(&_SigningBatchItem_3_list{list: &x.SigHashes})
+		if !f(fd_SigningBatchItem_sig_hashes, value) {
 			return
 		}
 	}
-	if x.ExpirationTime != nil {
-		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
-		if !f(fd_RefreshingRequest_expiration_time, value) {
+	if x.Options != nil {
+		value := protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+		if !f(fd_SigningBatchItem_options, value) {
 			return
 		}
 	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_RefreshingRequest_status, value) {
+	if x.ScopedId != "" {
+		value := protoreflect.ValueOfString(x.ScopedId)
+		if !f(fd_SigningBatchItem_scoped_id, value) {
 			return
 		}
 	}
@@ -3404,23 +4049,23 @@ func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SigningBatchItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingRequest.id":
-		return x.Id != uint64(0)
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		return x.DkgId != uint64(0)
-	case "bitway.tss.RefreshingRequest.removed_participants":
-		return len(x.RemovedParticipants) != 0
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		return x.ExpirationTime != nil
-	case "bitway.tss.RefreshingRequest.status":
-		return x.Status != 0
+	case "bitway.tss.SigningBatchItem.type":
+		return x.Type_ != 0
+	case "bitway.tss.SigningBatchItem.pub_key":
+		return x.PubKey != ""
+	case "bitway.tss.SigningBatchItem.sig_hashes":
+		return len(x.SigHashes) != 0
+	case "bitway.tss.SigningBatchItem.options":
+		return x.Options != nil
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		return x.ScopedId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3430,23 +4075,23 @@ func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SigningBatchItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingRequest.id":
-		x.Id = uint64(0)
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		x.DkgId = uint64(0)
-	case "bitway.tss.RefreshingRequest.removed_participants":
-		x.RemovedParticipants = nil
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		x.ExpirationTime = nil
-	case "bitway.tss.RefreshingRequest.status":
-		x.Status = 0
+	case "bitway.tss.SigningBatchItem.type":
+		x.Type_ = 0
+	case "bitway.tss.SigningBatchItem.pub_key":
+		x.PubKey = ""
+	case "bitway.tss.SigningBatchItem.sig_hashes":
+		x.SigHashes = nil
+	case "bitway.tss.SigningBatchItem.options":
+		x.Options = nil
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		x.ScopedId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3456,31 +4101,31 @@ func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningBatchItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.RefreshingRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		value := x.DkgId
-		return protoreflect.ValueOfUint64(value)
-	case "bitway.tss.RefreshingRequest.removed_participants":
-		if len(x.RemovedParticipants) == 0 {
-			return protoreflect.ValueOfList(&_RefreshingRequest_3_list{})
+	case "bitway.tss.SigningBatchItem.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.SigningBatchItem.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningBatchItem.sig_hashes":
+		if len(x.SigHashes) == 0 {
+			return protoreflect.ValueOfList(&_SigningBatchItem_3_list{})
 		}
-		listValue := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		listValue := &_SigningBatchItem_3_list{list: &x.SigHashes}
 		return protoreflect.ValueOfList(listValue)
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		value := x.ExpirationTime
+	case "bitway.tss.SigningBatchItem.options":
+		value := x.Options
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "bitway.tss.RefreshingRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		value := x.ScopedId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3494,25 +4139,25 @@ func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SigningBatchItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingRequest.id":
-		x.Id = value.Uint()
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		x.DkgId = value.Uint()
-	case "bitway.tss.RefreshingRequest.removed_participants":
+	case "bitway.tss.SigningBatchItem.type":
+		x.Type_ = (SigningType)(value.Enum())
+	case "bitway.tss.SigningBatchItem.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "bitway.tss.SigningBatchItem.sig_hashes":
 		lv := value.List()
-		clv := lv.(*_RefreshingRequest_3_list)
-		x.RemovedParticipants = *clv.list
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "bitway.tss.RefreshingRequest.status":
-		x.Status = (RefreshingStatus)(value.Enum())
+		clv := lv.(*_SigningBatchItem_3_list)
+		x.SigHashes = *clv.list
+	case "bitway.tss.SigningBatchItem.options":
+		x.Options = value.Message().Interface().(*SigningOptions)
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		x.ScopedId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3526,65 +4171,65 @@ func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningBatchItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingRequest.removed_participants":
-		if x.RemovedParticipants == nil {
-			x.RemovedParticipants = []string{}
+	case "bitway.tss.SigningBatchItem.sig_hashes":
+		if x.SigHashes == nil {
+			x.SigHashes = []string{}
 		}
-		value := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		value := &_SigningBatchItem_3_list{list: &x.SigHashes}
 		return protoreflect.ValueOfList(value)
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		if x.ExpirationTime == nil {
-			x.ExpirationTime = new(timestamppb.Timestamp)
+	case "bitway.tss.SigningBatchItem.options":
+		if x.Options == nil {
+			x.Options = new(SigningOptions)
 		}
-		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
-	case "bitway.tss.RefreshingRequest.id":
-		panic(fmt.Errorf("field id of message bitway.tss.RefreshingRequest is not mutable"))
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		panic(fmt.Errorf("field dkg_id of message bitway.tss.RefreshingRequest is not mutable"))
-	case "bitway.tss.RefreshingRequest.status":
-		panic(fmt.Errorf("field status of message bitway.tss.RefreshingRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+	case "bitway.tss.SigningBatchItem.type":
+		panic(fmt.Errorf("field type of message bitway.tss.SigningBatchItem is not mutable"))
+	case "bitway.tss.SigningBatchItem.pub_key":
+		panic(fmt.Errorf("field pub_key of message bitway.tss.SigningBatchItem is not mutable"))
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		panic(fmt.Errorf("field scoped_id of message bitway.tss.SigningBatchItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RefreshingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningBatchItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.tss.RefreshingRequest.dkg_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.tss.RefreshingRequest.removed_participants":
+	case "bitway.tss.SigningBatchItem.type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.SigningBatchItem.pub_key":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningBatchItem.sig_hashes":
 		list := []string{}
-		return protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &list})
-	case "bitway.tss.RefreshingRequest.expiration_time":
-		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfList(&_SigningBatchItem_3_list{list: &list})
+	case "bitway.tss.SigningBatchItem.options":
+		m := new(SigningOptions)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "bitway.tss.RefreshingRequest.status":
-		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.SigningBatchItem.scoped_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatchItem"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.SigningBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SigningBatchItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.RefreshingRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SigningBatchItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3592,7 +4237,7 @@ func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SigningBatchItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3603,7 +4248,7 @@ func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SigningBatchItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3615,7 +4260,7 @@ func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RefreshingRequest) IsValid() bool {
+func (x *fastReflection_SigningBatchItem) IsValid() bool {
 	return x != nil
 }
 
@@ -3625,9 +4270,9 @@ func (x *fastReflection_RefreshingRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SigningBatchItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*SigningBatchItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3639,24 +4284,26 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
 		}
-		if x.DkgId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DkgId))
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.RemovedParticipants) > 0 {
-			for _, s := range x.RemovedParticipants {
+		if len(x.SigHashes) > 0 {
+			for _, s := range x.SigHashes {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.ExpirationTime != nil {
-			l = options.Size(x.ExpirationTime)
+		if x.Options != nil {
+			l = options.Size(x.Options)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		l = len(x.ScopedId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3668,7 +4315,7 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*SigningBatchItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3687,13 +4334,15 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+		if len(x.ScopedId) > 0 {
+			i -= len(x.ScopedId)
+			copy(dAtA[i:], x.ScopedId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScopedId)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x2a
 		}
-		if x.ExpirationTime != nil {
-			encoded, err := options.Marshal(x.ExpirationTime)
+		if x.Options != nil {
+			encoded, err := options.Marshal(x.Options)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3706,22 +4355,24 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.RemovedParticipants) > 0 {
-			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RemovedParticipants[iNdEx])
-				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
+		if len(x.SigHashes) > 0 {
+			for iNdEx := len(x.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SigHashes[iNdEx])
+				copy(dAtA[i:], x.SigHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigHashes[iNdEx])))
 				i--
 				dAtA[i] = 0x1a
 			}
 		}
-		if x.DkgId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3736,7 +4387,7 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingRequest)
+		x := input.Message.Interface().(*SigningBatchItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3768,17 +4419,17 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningBatchItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
 				}
-				x.Id = 0
+				x.Type_ = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3788,16 +4439,16 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.Type_ |= SigningType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 				}
-				x.DkgId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3807,14 +4458,27 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DkgId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3842,11 +4506,11 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+				x.SigHashes = append(x.SigHashes, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3873,18 +4537,18 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpirationTime == nil {
-					x.ExpirationTime = &timestamppb.Timestamp{}
+				if x.Options == nil {
+					x.Options = &SigningOptions{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
 				}
-				x.Status = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3894,11 +4558,24 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= RefreshingStatus(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScopedId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3934,32 +4611,74 @@ func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_RefreshingCompletion                  protoreflect.MessageDescriptor
-	fd_RefreshingCompletion_id               protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_sender           protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_consensus_pubkey protoreflect.FieldDescriptor
-	fd_RefreshingCompletion_signature        protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_BatchSignatures_2_list)(nil)
 
-func init() {
-	file_bitway_tss_tss_proto_init()
-	md_RefreshingCompletion = File_bitway_tss_tss_proto.Messages().ByName("RefreshingCompletion")
-	fd_RefreshingCompletion_id = md_RefreshingCompletion.Fields().ByName("id")
-	fd_RefreshingCompletion_sender = md_RefreshingCompletion.Fields().ByName("sender")
-	fd_RefreshingCompletion_consensus_pubkey = md_RefreshingCompletion.Fields().ByName("consensus_pubkey")
-	fd_RefreshingCompletion_signature = md_RefreshingCompletion.Fields().ByName("signature")
+type _BatchSignatures_2_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_RefreshingCompletion)(nil)
-
-type fastReflection_RefreshingCompletion RefreshingCompletion
-
-func (x *RefreshingCompletion) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RefreshingCompletion)(x)
+func (x *_BatchSignatures_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
+func (x *_BatchSignatures_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_BatchSignatures_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BatchSignatures_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BatchSignatures_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BatchSignatures at list field Signatures as it is not of Message kind"))
+}
+
+func (x *_BatchSignatures_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BatchSignatures_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_BatchSignatures_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BatchSignatures            protoreflect.MessageDescriptor
+	fd_BatchSignatures_signing_id protoreflect.FieldDescriptor
+	fd_BatchSignatures_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_BatchSignatures = File_bitway_tss_tss_proto.Messages().ByName("BatchSignatures")
+	fd_BatchSignatures_signing_id = md_BatchSignatures.Fields().ByName("signing_id")
+	fd_BatchSignatures_signatures = md_BatchSignatures.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchSignatures)(nil)
+
+type fastReflection_BatchSignatures BatchSignatures
+
+func (x *BatchSignatures) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchSignatures)(x)
+}
+
+func (x *BatchSignatures) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tss_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3971,43 +4690,43 @@ func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RefreshingCompletion_messageType fastReflection_RefreshingCompletion_messageType
-var _ protoreflect.MessageType = fastReflection_RefreshingCompletion_messageType{}
+var _fastReflection_BatchSignatures_messageType fastReflection_BatchSignatures_messageType
+var _ protoreflect.MessageType = fastReflection_BatchSignatures_messageType{}
 
-type fastReflection_RefreshingCompletion_messageType struct{}
+type fastReflection_BatchSignatures_messageType struct{}
 
-func (x fastReflection_RefreshingCompletion_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RefreshingCompletion)(nil)
+func (x fastReflection_BatchSignatures_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchSignatures)(nil)
 }
-func (x fastReflection_RefreshingCompletion_messageType) New() protoreflect.Message {
-	return new(fastReflection_RefreshingCompletion)
+func (x fastReflection_BatchSignatures_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchSignatures)
 }
-func (x fastReflection_RefreshingCompletion_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingCompletion
+func (x fastReflection_BatchSignatures_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchSignatures
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RefreshingCompletion) Descriptor() protoreflect.MessageDescriptor {
-	return md_RefreshingCompletion
+func (x *fastReflection_BatchSignatures) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchSignatures
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RefreshingCompletion) Type() protoreflect.MessageType {
-	return _fastReflection_RefreshingCompletion_messageType
+func (x *fastReflection_BatchSignatures) Type() protoreflect.MessageType {
+	return _fastReflection_BatchSignatures_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RefreshingCompletion) New() protoreflect.Message {
-	return new(fastReflection_RefreshingCompletion)
+func (x *fastReflection_BatchSignatures) New() protoreflect.Message {
+	return new(fastReflection_BatchSignatures)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMessage {
-	return (*RefreshingCompletion)(x)
+func (x *fastReflection_BatchSignatures) Interface() protoreflect.ProtoMessage {
+	return (*BatchSignatures)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4015,28 +4734,16 @@ func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_RefreshingCompletion_id, value) {
-			return
-		}
-	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_RefreshingCompletion_sender, value) {
-			return
-		}
-	}
-	if x.ConsensusPubkey != "" {
-		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_RefreshingCompletion_consensus_pubkey, value) {
+func (x *fastReflection_BatchSignatures) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_BatchSignatures_signing_id, value) {
 			return
 		}
 	}
-	if x.Signature != "" {
-		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_RefreshingCompletion_signature, value) {
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_BatchSignatures_2_list{list: &x.Signatures})
+		if !f(fd_BatchSignatures_signatures, value) {
 			return
 		}
 	}
@@ -4053,21 +4760,17 @@ func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BatchSignatures) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
-		return x.Id != uint64(0)
-	case "bitway.tss.RefreshingCompletion.sender":
-		return x.Sender != ""
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		return x.ConsensusPubkey != ""
-	case "bitway.tss.RefreshingCompletion.signature":
-		return x.Signature != ""
+	case "bitway.tss.BatchSignatures.signing_id":
+		return x.SigningId != uint64(0)
+	case "bitway.tss.BatchSignatures.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4077,21 +4780,17 @@ func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BatchSignatures) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
-		x.Id = uint64(0)
-	case "bitway.tss.RefreshingCompletion.sender":
-		x.Sender = ""
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		x.ConsensusPubkey = ""
-	case "bitway.tss.RefreshingCompletion.signature":
-		x.Signature = ""
+	case "bitway.tss.BatchSignatures.signing_id":
+		x.SigningId = uint64(0)
+	case "bitway.tss.BatchSignatures.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4101,25 +4800,22 @@ func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchSignatures) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
-		value := x.Id
+	case "bitway.tss.BatchSignatures.signing_id":
+		value := x.SigningId
 		return protoreflect.ValueOfUint64(value)
-	case "bitway.tss.RefreshingCompletion.sender":
-		value := x.Sender
-		return protoreflect.ValueOfString(value)
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		value := x.ConsensusPubkey
-		return protoreflect.ValueOfString(value)
-	case "bitway.tss.RefreshingCompletion.signature":
-		value := x.Signature
-		return protoreflect.ValueOfString(value)
+	case "bitway.tss.BatchSignatures.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_BatchSignatures_2_list{})
+		}
+		listValue := &_BatchSignatures_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4133,21 +4829,19 @@ func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BatchSignatures) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
-		x.Id = value.Uint()
-	case "bitway.tss.RefreshingCompletion.sender":
-		x.Sender = value.Interface().(string)
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		x.ConsensusPubkey = value.Interface().(string)
-	case "bitway.tss.RefreshingCompletion.signature":
-		x.Signature = value.Interface().(string)
+	case "bitway.tss.BatchSignatures.signing_id":
+		x.SigningId = value.Uint()
+	case "bitway.tss.BatchSignatures.signatures":
+		lv := value.List()
+		clv := lv.(*_BatchSignatures_2_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4161,52 +4855,49 @@ func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchSignatures) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
-		panic(fmt.Errorf("field id of message bitway.tss.RefreshingCompletion is not mutable"))
-	case "bitway.tss.RefreshingCompletion.sender":
-		panic(fmt.Errorf("field sender of message bitway.tss.RefreshingCompletion is not mutable"))
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.RefreshingCompletion is not mutable"))
-	case "bitway.tss.RefreshingCompletion.signature":
-		panic(fmt.Errorf("field signature of message bitway.tss.RefreshingCompletion is not mutable"))
+	case "bitway.tss.BatchSignatures.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []string{}
+		}
+		value := &_BatchSignatures_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.BatchSignatures.signing_id":
+		panic(fmt.Errorf("field signing_id of message bitway.tss.BatchSignatures is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RefreshingCompletion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchSignatures) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.RefreshingCompletion.id":
+	case "bitway.tss.BatchSignatures.signing_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.tss.RefreshingCompletion.sender":
-		return protoreflect.ValueOfString("")
-	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
-		return protoreflect.ValueOfString("")
-	case "bitway.tss.RefreshingCompletion.signature":
-		return protoreflect.ValueOfString("")
+	case "bitway.tss.BatchSignatures.signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_BatchSignatures_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.BatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.BatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BatchSignatures) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.RefreshingCompletion", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.BatchSignatures", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4214,7 +4905,7 @@ func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BatchSignatures) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4225,7 +4916,7 @@ func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BatchSignatures) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4237,7 +4928,7 @@ func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RefreshingCompletion) IsValid() bool {
+func (x *fastReflection_BatchSignatures) IsValid() bool {
 	return x != nil
 }
 
@@ -4247,9 +4938,9 @@ func (x *fastReflection_RefreshingCompletion) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BatchSignatures) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*BatchSignatures)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4261,20 +4952,14 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Sender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConsensusPubkey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Signatures) > 0 {
+			for _, s := range x.Signatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4286,7 +4971,7 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*BatchSignatures)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4305,29 +4990,17 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ConsensusPubkey) > 0 {
-			i -= len(x.ConsensusPubkey)
-			copy(dAtA[i:], x.ConsensusPubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signatures[iNdEx])
+				copy(dAtA[i:], x.Signatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4342,7 +5015,7 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RefreshingCompletion)
+		x := input.Message.Interface().(*BatchSignatures)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4374,17 +5047,17 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchSignatures: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
 				}
-				x.Id = 0
+				x.SigningId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4394,14 +5067,14 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.SigningId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4429,86 +5102,22 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
+				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -4530,741 +5139,14030 @@ func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: bitway/tss/tss.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// DKG Status
-type DKGStatus int32
+var _ protoreflect.List = (*_SigningBatch_5_list)(nil)
 
-const (
-	// DKG_STATUS_UNSPECIFIED defines the unknown DKG request status
-	DKGStatus_DKG_STATUS_UNSPECIFIED DKGStatus = 0
-	// DKG_STATUS_PENDING defines the status of the DKG request which is pending
-	DKGStatus_DKG_STATUS_PENDING DKGStatus = 1
-	// DKG_STATUS_COMPLETED defines the status of the DKG request which is completed
-	DKGStatus_DKG_STATUS_COMPLETED DKGStatus = 2
-	// DKG_STATUS_FAILED defines the status of the DKG request which failed
-	DKGStatus_DKG_STATUS_FAILED DKGStatus = 3
-	// DKG_STATUS_TIMEDOUT defines the status of the DKG request which timed out
-	DKGStatus_DKG_STATUS_TIMEDOUT DKGStatus = 4
-)
+type _SigningBatch_5_list struct {
+	list *[]uint64
+}
 
-// Enum value maps for DKGStatus.
-var (
-	DKGStatus_name = map[int32]string{
-		0: "DKG_STATUS_UNSPECIFIED",
-		1: "DKG_STATUS_PENDING",
-		2: "DKG_STATUS_COMPLETED",
-		3: "DKG_STATUS_FAILED",
-		4: "DKG_STATUS_TIMEDOUT",
-	}
-	DKGStatus_value = map[string]int32{
-		"DKG_STATUS_UNSPECIFIED": 0,
-		"DKG_STATUS_PENDING":     1,
-		"DKG_STATUS_COMPLETED":   2,
-		"DKG_STATUS_FAILED":      3,
-		"DKG_STATUS_TIMEDOUT":    4,
+func (x *_SigningBatch_5_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x DKGStatus) Enum() *DKGStatus {
-	p := new(DKGStatus)
-	*p = x
-	return p
+func (x *_SigningBatch_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (x DKGStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_SigningBatch_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (DKGStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_tss_tss_proto_enumTypes[0].Descriptor()
+func (x *_SigningBatch_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (DKGStatus) Type() protoreflect.EnumType {
-	return &file_bitway_tss_tss_proto_enumTypes[0]
+func (x *_SigningBatch_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SigningBatch at list field SigningIds as it is not of Message kind"))
 }
 
-func (x DKGStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_SigningBatch_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use DKGStatus.Descriptor instead.
-func (DKGStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{0}
+func (x *_SigningBatch_5_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-// Signing Status
-type SigningStatus int32
+func (x *_SigningBatch_5_list) IsValid() bool {
+	return x.list != nil
+}
 
-const (
-	// SIGNING_STATUS_UNSPECIFIED defines the unknown signing status
-	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
-	// SIGNING_STATUS_PENDING defines the status of the signing request which is pending
-	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
-	// SIGNING_STATUS_SIGNED defines the status of the signing request which is signed
-	SigningStatus_SIGNING_STATUS_SIGNED SigningStatus = 2
-	// SIGNING_STATUS_FAILED defines the status of the signing request which failed due to unexpected reasons
-	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 3
-)
+var _ protoreflect.List = (*_SigningBatch_6_list)(nil)
 
-// Enum value maps for SigningStatus.
-var (
-	SigningStatus_name = map[int32]string{
-		0: "SIGNING_STATUS_UNSPECIFIED",
-		1: "SIGNING_STATUS_PENDING",
-		2: "SIGNING_STATUS_SIGNED",
-		3: "SIGNING_STATUS_FAILED",
-	}
-	SigningStatus_value = map[string]int32{
-		"SIGNING_STATUS_UNSPECIFIED": 0,
-		"SIGNING_STATUS_PENDING":     1,
-		"SIGNING_STATUS_SIGNED":      2,
-		"SIGNING_STATUS_FAILED":      3,
-	}
-)
+type _SigningBatch_6_list struct {
+	list *[]*BatchSignatures
+}
 
-func (x SigningStatus) Enum() *SigningStatus {
-	p := new(SigningStatus)
-	*p = x
-	return p
+func (x *_SigningBatch_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x SigningStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_SigningBatch_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_tss_tss_proto_enumTypes[1].Descriptor()
+func (x *_SigningBatch_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BatchSignatures)
+	(*x.list)[i] = concreteValue
 }
 
-func (SigningStatus) Type() protoreflect.EnumType {
-	return &file_bitway_tss_tss_proto_enumTypes[1]
+func (x *_SigningBatch_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BatchSignatures)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x SigningStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_SigningBatch_6_list) AppendMutable() protoreflect.Value {
+	v := new(BatchSignatures)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Deprecated: Use SigningStatus.Descriptor instead.
-func (SigningStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{1}
+func (x *_SigningBatch_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
 
-// Signing Type
-type SigningType int32
+func (x *_SigningBatch_6_list) NewElement() protoreflect.Value {
+	v := new(BatchSignatures)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-const (
-	// SIGNING_TYPE_SCHNORR defines the common schnorr signing
-	SigningType_SIGNING_TYPE_SCHNORR SigningType = 0
-	// SIGNING_TYPE_SCHNORR_WITH_TWEAK defines the schnorr signing with tweak
-	SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK SigningType = 1
-	// SIGNING_TYPE_SCHNORR_WITH_COMMITMENT defines the schnorr signing with commitment
-	SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT SigningType = 2
-	// SIGNING_TYPE_SCHNORR_ADAPTOR defines the schnorr adaptor signing
-	SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR SigningType = 3
-)
+func (x *_SigningBatch_6_list) IsValid() bool {
+	return x.list != nil
+}
 
-// Enum value maps for SigningType.
 var (
-	SigningType_name = map[int32]string{
-		0: "SIGNING_TYPE_SCHNORR",
-		1: "SIGNING_TYPE_SCHNORR_WITH_TWEAK",
-		2: "SIGNING_TYPE_SCHNORR_WITH_COMMITMENT",
-		3: "SIGNING_TYPE_SCHNORR_ADAPTOR",
-	}
-	SigningType_value = map[string]int32{
-		"SIGNING_TYPE_SCHNORR":                 0,
-		"SIGNING_TYPE_SCHNORR_WITH_TWEAK":      1,
-		"SIGNING_TYPE_SCHNORR_WITH_COMMITMENT": 2,
-		"SIGNING_TYPE_SCHNORR_ADAPTOR":         3,
-	}
+	md_SigningBatch                 protoreflect.MessageDescriptor
+	fd_SigningBatch_id              protoreflect.FieldDescriptor
+	fd_SigningBatch_module          protoreflect.FieldDescriptor
+	fd_SigningBatch_scoped_id       protoreflect.FieldDescriptor
+	fd_SigningBatch_intent          protoreflect.FieldDescriptor
+	fd_SigningBatch_signing_ids     protoreflect.FieldDescriptor
+	fd_SigningBatch_signatures      protoreflect.FieldDescriptor
+	fd_SigningBatch_creation_time   protoreflect.FieldDescriptor
+	fd_SigningBatch_status          protoreflect.FieldDescriptor
+	fd_SigningBatch_expiration_time protoreflect.FieldDescriptor
+	fd_SigningBatch_previous_id     protoreflect.FieldDescriptor
 )
 
-func (x SigningType) Enum() *SigningType {
-	p := new(SigningType)
-	*p = x
-	return p
-}
-
-func (x SigningType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_SigningBatch = File_bitway_tss_tss_proto.Messages().ByName("SigningBatch")
+	fd_SigningBatch_id = md_SigningBatch.Fields().ByName("id")
+	fd_SigningBatch_module = md_SigningBatch.Fields().ByName("module")
+	fd_SigningBatch_scoped_id = md_SigningBatch.Fields().ByName("scoped_id")
+	fd_SigningBatch_intent = md_SigningBatch.Fields().ByName("intent")
+	fd_SigningBatch_signing_ids = md_SigningBatch.Fields().ByName("signing_ids")
+	fd_SigningBatch_signatures = md_SigningBatch.Fields().ByName("signatures")
+	fd_SigningBatch_creation_time = md_SigningBatch.Fields().ByName("creation_time")
+	fd_SigningBatch_status = md_SigningBatch.Fields().ByName("status")
+	fd_SigningBatch_expiration_time = md_SigningBatch.Fields().ByName("expiration_time")
+	fd_SigningBatch_previous_id = md_SigningBatch.Fields().ByName("previous_id")
 }
 
-func (SigningType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_tss_tss_proto_enumTypes[2].Descriptor()
-}
+var _ protoreflect.Message = (*fastReflection_SigningBatch)(nil)
 
-func (SigningType) Type() protoreflect.EnumType {
-	return &file_bitway_tss_tss_proto_enumTypes[2]
-}
+type fastReflection_SigningBatch SigningBatch
 
-func (x SigningType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *SigningBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningBatch)(x)
 }
 
-// Deprecated: Use SigningType.Descriptor instead.
-func (SigningType) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{2}
+func (x *SigningBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-// Refreshing Status
-type RefreshingStatus int32
+var _fastReflection_SigningBatch_messageType fastReflection_SigningBatch_messageType
+var _ protoreflect.MessageType = fastReflection_SigningBatch_messageType{}
 
-const (
-	// REFRESHING_STATUS_UNSPECIFIED defines the unknown refreshing status
-	RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED RefreshingStatus = 0
-	// REFRESHING_STATUS_PENDING defines the status of the refreshing request which is pending
-	RefreshingStatus_REFRESHING_STATUS_PENDING RefreshingStatus = 1
-	// REFRESHING_STATUS_COMPLETED defines the status of the refreshing request which is completed
-	RefreshingStatus_REFRESHING_STATUS_COMPLETED RefreshingStatus = 2
-	// REFRESHING_STATUS_TIMEDOUT defines the status of the refreshing request which timed out
-	RefreshingStatus_REFRESHING_STATUS_TIMEDOUT RefreshingStatus = 3
-)
+type fastReflection_SigningBatch_messageType struct{}
 
-// Enum value maps for RefreshingStatus.
-var (
-	RefreshingStatus_name = map[int32]string{
-		0: "REFRESHING_STATUS_UNSPECIFIED",
-		1: "REFRESHING_STATUS_PENDING",
-		2: "REFRESHING_STATUS_COMPLETED",
-		3: "REFRESHING_STATUS_TIMEDOUT",
-	}
-	RefreshingStatus_value = map[string]int32{
-		"REFRESHING_STATUS_UNSPECIFIED": 0,
-		"REFRESHING_STATUS_PENDING":     1,
-		"REFRESHING_STATUS_COMPLETED":   2,
-		"REFRESHING_STATUS_TIMEDOUT":    3,
-	}
-)
+func (x fastReflection_SigningBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningBatch)(nil)
+}
+func (x fastReflection_SigningBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningBatch)
+}
+func (x fastReflection_SigningBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningBatch
+}
 
-func (x RefreshingStatus) Enum() *RefreshingStatus {
-	p := new(RefreshingStatus)
-	*p = x
-	return p
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningBatch
 }
 
-func (x RefreshingStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningBatch) Type() protoreflect.MessageType {
+	return _fastReflection_SigningBatch_messageType
 }
 
-func (RefreshingStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_bitway_tss_tss_proto_enumTypes[3].Descriptor()
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningBatch) New() protoreflect.Message {
+	return new(fastReflection_SigningBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningBatch) Interface() protoreflect.ProtoMessage {
+	return (*SigningBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SigningBatch_id, value) {
+			return
+		}
+	}
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_SigningBatch_module, value) {
+			return
+		}
+	}
+	if x.ScopedId != "" {
+		value := protoreflect.ValueOfString(x.ScopedId)
+		if !f(fd_SigningBatch_scoped_id, value) {
+			return
+		}
+	}
+	if x.Intent != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Intent)
+		if !f(fd_SigningBatch_intent, value) {
+			return
+		}
+	}
+	if len(x.SigningIds) != 0 {
+		value := protoreflect.ValueOfList(&_SigningBatch_5_list{list: &x.SigningIds})
+		if !f(fd_SigningBatch_signing_ids, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_SigningBatch_6_list{list: &x.Signatures})
+		if !f(fd_SigningBatch_signatures, value) {
+			return
+		}
+	}
+	if x.CreationTime != nil {
+		value := protoreflect.ValueOfMessage(x.CreationTime.ProtoReflect())
+		if !f(fd_SigningBatch_creation_time, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SigningBatch_status, value) {
+			return
+		}
+	}
+	if x.ExpirationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+		if !f(fd_SigningBatch_expiration_time, value) {
+			return
+		}
+	}
+	if x.PreviousId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousId)
+		if !f(fd_SigningBatch_previous_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.SigningBatch.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.SigningBatch.module":
+		return x.Module != ""
+	case "bitway.tss.SigningBatch.scoped_id":
+		return x.ScopedId != ""
+	case "bitway.tss.SigningBatch.intent":
+		return x.Intent != int32(0)
+	case "bitway.tss.SigningBatch.signing_ids":
+		return len(x.SigningIds) != 0
+	case "bitway.tss.SigningBatch.signatures":
+		return len(x.Signatures) != 0
+	case "bitway.tss.SigningBatch.creation_time":
+		return x.CreationTime != nil
+	case "bitway.tss.SigningBatch.status":
+		return x.Status != 0
+	case "bitway.tss.SigningBatch.expiration_time":
+		return x.ExpirationTime != nil
+	case "bitway.tss.SigningBatch.previous_id":
+		return x.PreviousId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningBatch.id":
+		x.Id = uint64(0)
+	case "bitway.tss.SigningBatch.module":
+		x.Module = ""
+	case "bitway.tss.SigningBatch.scoped_id":
+		x.ScopedId = ""
+	case "bitway.tss.SigningBatch.intent":
+		x.Intent = int32(0)
+	case "bitway.tss.SigningBatch.signing_ids":
+		x.SigningIds = nil
+	case "bitway.tss.SigningBatch.signatures":
+		x.Signatures = nil
+	case "bitway.tss.SigningBatch.creation_time":
+		x.CreationTime = nil
+	case "bitway.tss.SigningBatch.status":
+		x.Status = 0
+	case "bitway.tss.SigningBatch.expiration_time":
+		x.ExpirationTime = nil
+	case "bitway.tss.SigningBatch.previous_id":
+		x.PreviousId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.SigningBatch.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningBatch.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningBatch.scoped_id":
+		value := x.ScopedId
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningBatch.intent":
+		value := x.Intent
+		return protoreflect.ValueOfInt32(value)
+	case "bitway.tss.SigningBatch.signing_ids":
+		if len(x.SigningIds) == 0 {
+			return protoreflect.ValueOfList(&_SigningBatch_5_list{})
+		}
+		listValue := &_SigningBatch_5_list{list: &x.SigningIds}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.SigningBatch.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_SigningBatch_6_list{})
+		}
+		listValue := &_SigningBatch_6_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.SigningBatch.creation_time":
+		value := x.CreationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.SigningBatch.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.SigningBatch.expiration_time":
+		value := x.ExpirationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.SigningBatch.previous_id":
+		value := x.PreviousId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningBatch.id":
+		x.Id = value.Uint()
+	case "bitway.tss.SigningBatch.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.SigningBatch.scoped_id":
+		x.ScopedId = value.Interface().(string)
+	case "bitway.tss.SigningBatch.intent":
+		x.Intent = int32(value.Int())
+	case "bitway.tss.SigningBatch.signing_ids":
+		lv := value.List()
+		clv := lv.(*_SigningBatch_5_list)
+		x.SigningIds = *clv.list
+	case "bitway.tss.SigningBatch.signatures":
+		lv := value.List()
+		clv := lv.(*_SigningBatch_6_list)
+		x.Signatures = *clv.list
+	case "bitway.tss.SigningBatch.creation_time":
+		x.CreationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.SigningBatch.status":
+		x.Status = (SigningStatus)(value.Enum())
+	case "bitway.tss.SigningBatch.expiration_time":
+		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.SigningBatch.previous_id":
+		x.PreviousId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningBatch.signing_ids":
+		if x.SigningIds == nil {
+			x.SigningIds = []uint64{}
+		}
+		value := &_SigningBatch_5_list{list: &x.SigningIds}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.SigningBatch.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*BatchSignatures{}
+		}
+		value := &_SigningBatch_6_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.SigningBatch.creation_time":
+		if x.CreationTime == nil {
+			x.CreationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreationTime.ProtoReflect())
+	case "bitway.tss.SigningBatch.expiration_time":
+		if x.ExpirationTime == nil {
+			x.ExpirationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+	case "bitway.tss.SigningBatch.id":
+		panic(fmt.Errorf("field id of message bitway.tss.SigningBatch is not mutable"))
+	case "bitway.tss.SigningBatch.module":
+		panic(fmt.Errorf("field module of message bitway.tss.SigningBatch is not mutable"))
+	case "bitway.tss.SigningBatch.scoped_id":
+		panic(fmt.Errorf("field scoped_id of message bitway.tss.SigningBatch is not mutable"))
+	case "bitway.tss.SigningBatch.intent":
+		panic(fmt.Errorf("field intent of message bitway.tss.SigningBatch is not mutable"))
+	case "bitway.tss.SigningBatch.status":
+		panic(fmt.Errorf("field status of message bitway.tss.SigningBatch is not mutable"))
+	case "bitway.tss.SigningBatch.previous_id":
+		panic(fmt.Errorf("field previous_id of message bitway.tss.SigningBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningBatch.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningBatch.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningBatch.scoped_id":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningBatch.intent":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "bitway.tss.SigningBatch.signing_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_SigningBatch_5_list{list: &list})
+	case "bitway.tss.SigningBatch.signatures":
+		list := []*BatchSignatures{}
+		return protoreflect.ValueOfList(&_SigningBatch_6_list{list: &list})
+	case "bitway.tss.SigningBatch.creation_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.SigningBatch.status":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.SigningBatch.expiration_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.SigningBatch.previous_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningBatch"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SigningBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ScopedId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Intent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Intent))
+		}
+		if len(x.SigningIds) > 0 {
+			l = 0
+			for _, e := range x.SigningIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreationTime != nil {
+			l = options.Size(x.CreationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.ExpirationTime != nil {
+			l = options.Size(x.ExpirationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PreviousId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PreviousId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousId))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ExpirationTime != nil {
+			encoded, err := options.Marshal(x.ExpirationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.CreationTime != nil {
+			encoded, err := options.Marshal(x.CreationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SigningIds) > 0 {
+			var pksize2 int
+			for _, num := range x.SigningIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.SigningIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Intent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Intent))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ScopedId) > 0 {
+			i -= len(x.ScopedId)
+			copy(dAtA[i:], x.ScopedId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScopedId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScopedId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+				}
+				x.Intent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Intent |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.SigningIds = append(x.SigningIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.SigningIds) == 0 {
+						x.SigningIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.SigningIds = append(x.SigningIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningIds", wireType)
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &BatchSignatures{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreationTime == nil {
+					x.CreationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SigningStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpirationTime == nil {
+					x.ExpirationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+				}
+				x.PreviousId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SigningAcknowledgement                  protoreflect.MessageDescriptor
+	fd_SigningAcknowledgement_id               protoreflect.FieldDescriptor
+	fd_SigningAcknowledgement_sender           protoreflect.FieldDescriptor
+	fd_SigningAcknowledgement_consensus_pubkey protoreflect.FieldDescriptor
+	fd_SigningAcknowledgement_signature        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_SigningAcknowledgement = File_bitway_tss_tss_proto.Messages().ByName("SigningAcknowledgement")
+	fd_SigningAcknowledgement_id = md_SigningAcknowledgement.Fields().ByName("id")
+	fd_SigningAcknowledgement_sender = md_SigningAcknowledgement.Fields().ByName("sender")
+	fd_SigningAcknowledgement_consensus_pubkey = md_SigningAcknowledgement.Fields().ByName("consensus_pubkey")
+	fd_SigningAcknowledgement_signature = md_SigningAcknowledgement.Fields().ByName("signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningAcknowledgement)(nil)
+
+type fastReflection_SigningAcknowledgement SigningAcknowledgement
+
+func (x *SigningAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningAcknowledgement)(x)
+}
+
+func (x *SigningAcknowledgement) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningAcknowledgement_messageType fastReflection_SigningAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_SigningAcknowledgement_messageType{}
+
+type fastReflection_SigningAcknowledgement_messageType struct{}
+
+func (x fastReflection_SigningAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningAcknowledgement)(nil)
+}
+func (x fastReflection_SigningAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningAcknowledgement)
+}
+func (x fastReflection_SigningAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningAcknowledgement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningAcknowledgement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_SigningAcknowledgement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_SigningAcknowledgement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*SigningAcknowledgement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SigningAcknowledgement_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_SigningAcknowledgement_sender, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_SigningAcknowledgement_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_SigningAcknowledgement_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.SigningAcknowledgement.sender":
+		return x.Sender != ""
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.SigningAcknowledgement.signature":
+		return x.Signature != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		x.Id = uint64(0)
+	case "bitway.tss.SigningAcknowledgement.sender":
+		x.Sender = ""
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.SigningAcknowledgement.signature":
+		x.Signature = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningAcknowledgement.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningAcknowledgement.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		x.Id = value.Uint()
+	case "bitway.tss.SigningAcknowledgement.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.SigningAcknowledgement.signature":
+		x.Signature = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		panic(fmt.Errorf("field id of message bitway.tss.SigningAcknowledgement is not mutable"))
+	case "bitway.tss.SigningAcknowledgement.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.SigningAcknowledgement is not mutable"))
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.SigningAcknowledgement is not mutable"))
+	case "bitway.tss.SigningAcknowledgement.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.SigningAcknowledgement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningAcknowledgement.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningAcknowledgement.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningAcknowledgement.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningAcknowledgement.signature":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningAcknowledgement"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SigningAcknowledgement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningAcknowledgement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningAcknowledgement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningAcknowledgement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningAcknowledgement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningAcknowledgement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningAcknowledgement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningAcknowledgement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PartialSignature_4_list)(nil)
+
+type _PartialSignature_4_list struct {
+	list *[]string
+}
+
+func (x *_PartialSignature_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PartialSignature_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PartialSignature_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PartialSignature_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PartialSignature_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PartialSignature at list field Nonces as it is not of Message kind"))
+}
+
+func (x *_PartialSignature_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PartialSignature_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PartialSignature_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PartialSignature_5_list)(nil)
+
+type _PartialSignature_5_list struct {
+	list *[]string
+}
+
+func (x *_PartialSignature_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PartialSignature_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PartialSignature_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PartialSignature_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PartialSignature_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PartialSignature at list field PartialSignatures as it is not of Message kind"))
+}
+
+func (x *_PartialSignature_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PartialSignature_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PartialSignature_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PartialSignature_7_list)(nil)
+
+type _PartialSignature_7_list struct {
+	list *[]string
+}
+
+func (x *_PartialSignature_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PartialSignature_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PartialSignature_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PartialSignature_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PartialSignature_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PartialSignature at list field Signers as it is not of Message kind"))
+}
+
+func (x *_PartialSignature_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PartialSignature_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PartialSignature_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PartialSignature_8_list)(nil)
+
+type _PartialSignature_8_list struct {
+	list *[]string
+}
+
+func (x *_PartialSignature_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PartialSignature_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PartialSignature_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PartialSignature_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PartialSignature_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PartialSignature at list field AggregatedNonces as it is not of Message kind"))
+}
+
+func (x *_PartialSignature_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PartialSignature_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PartialSignature_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PartialSignature                    protoreflect.MessageDescriptor
+	fd_PartialSignature_id                 protoreflect.FieldDescriptor
+	fd_PartialSignature_sender             protoreflect.FieldDescriptor
+	fd_PartialSignature_consensus_pubkey   protoreflect.FieldDescriptor
+	fd_PartialSignature_nonces             protoreflect.FieldDescriptor
+	fd_PartialSignature_partial_signatures protoreflect.FieldDescriptor
+	fd_PartialSignature_signature          protoreflect.FieldDescriptor
+	fd_PartialSignature_signers            protoreflect.FieldDescriptor
+	fd_PartialSignature_aggregated_nonces  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_PartialSignature = File_bitway_tss_tss_proto.Messages().ByName("PartialSignature")
+	fd_PartialSignature_id = md_PartialSignature.Fields().ByName("id")
+	fd_PartialSignature_sender = md_PartialSignature.Fields().ByName("sender")
+	fd_PartialSignature_consensus_pubkey = md_PartialSignature.Fields().ByName("consensus_pubkey")
+	fd_PartialSignature_nonces = md_PartialSignature.Fields().ByName("nonces")
+	fd_PartialSignature_partial_signatures = md_PartialSignature.Fields().ByName("partial_signatures")
+	fd_PartialSignature_signature = md_PartialSignature.Fields().ByName("signature")
+	fd_PartialSignature_signers = md_PartialSignature.Fields().ByName("signers")
+	fd_PartialSignature_aggregated_nonces = md_PartialSignature.Fields().ByName("aggregated_nonces")
+}
+
+var _ protoreflect.Message = (*fastReflection_PartialSignature)(nil)
+
+type fastReflection_PartialSignature PartialSignature
+
+func (x *PartialSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PartialSignature)(x)
+}
+
+func (x *PartialSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PartialSignature_messageType fastReflection_PartialSignature_messageType
+var _ protoreflect.MessageType = fastReflection_PartialSignature_messageType{}
+
+type fastReflection_PartialSignature_messageType struct{}
+
+func (x fastReflection_PartialSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PartialSignature)(nil)
+}
+func (x fastReflection_PartialSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_PartialSignature)
+}
+func (x fastReflection_PartialSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PartialSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PartialSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_PartialSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PartialSignature) Type() protoreflect.MessageType {
+	return _fastReflection_PartialSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PartialSignature) New() protoreflect.Message {
+	return new(fastReflection_PartialSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PartialSignature) Interface() protoreflect.ProtoMessage {
+	return (*PartialSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PartialSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PartialSignature_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_PartialSignature_sender, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_PartialSignature_consensus_pubkey, value) {
+			return
+		}
+	}
+	if len(x.Nonces) != 0 {
+		value := protoreflect.ValueOfList(&_PartialSignature_4_list{list: &x.Nonces})
+		if !f(fd_PartialSignature_nonces, value) {
+			return
+		}
+	}
+	if len(x.PartialSignatures) != 0 {
+		value := protoreflect.ValueOfList(&_PartialSignature_5_list{list: &x.PartialSignatures})
+		if !f(fd_PartialSignature_partial_signatures, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_PartialSignature_signature, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_PartialSignature_7_list{list: &x.Signers})
+		if !f(fd_PartialSignature_signers, value) {
+			return
+		}
+	}
+	if len(x.AggregatedNonces) != 0 {
+		value := protoreflect.ValueOfList(&_PartialSignature_8_list{list: &x.AggregatedNonces})
+		if !f(fd_PartialSignature_aggregated_nonces, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PartialSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.PartialSignature.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.PartialSignature.sender":
+		return x.Sender != ""
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.PartialSignature.nonces":
+		return len(x.Nonces) != 0
+	case "bitway.tss.PartialSignature.partial_signatures":
+		return len(x.PartialSignatures) != 0
+	case "bitway.tss.PartialSignature.signature":
+		return x.Signature != ""
+	case "bitway.tss.PartialSignature.signers":
+		return len(x.Signers) != 0
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		return len(x.AggregatedNonces) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PartialSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.PartialSignature.id":
+		x.Id = uint64(0)
+	case "bitway.tss.PartialSignature.sender":
+		x.Sender = ""
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.PartialSignature.nonces":
+		x.Nonces = nil
+	case "bitway.tss.PartialSignature.partial_signatures":
+		x.PartialSignatures = nil
+	case "bitway.tss.PartialSignature.signature":
+		x.Signature = ""
+	case "bitway.tss.PartialSignature.signers":
+		x.Signers = nil
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		x.AggregatedNonces = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PartialSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.PartialSignature.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.PartialSignature.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.PartialSignature.nonces":
+		if len(x.Nonces) == 0 {
+			return protoreflect.ValueOfList(&_PartialSignature_4_list{})
+		}
+		listValue := &_PartialSignature_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.PartialSignature.partial_signatures":
+		if len(x.PartialSignatures) == 0 {
+			return protoreflect.ValueOfList(&_PartialSignature_5_list{})
+		}
+		listValue := &_PartialSignature_5_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.PartialSignature.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.PartialSignature.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_PartialSignature_7_list{})
+		}
+		listValue := &_PartialSignature_7_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		if len(x.AggregatedNonces) == 0 {
+			return protoreflect.ValueOfList(&_PartialSignature_8_list{})
+		}
+		listValue := &_PartialSignature_8_list{list: &x.AggregatedNonces}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PartialSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.PartialSignature.id":
+		x.Id = value.Uint()
+	case "bitway.tss.PartialSignature.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.PartialSignature.nonces":
+		lv := value.List()
+		clv := lv.(*_PartialSignature_4_list)
+		x.Nonces = *clv.list
+	case "bitway.tss.PartialSignature.partial_signatures":
+		lv := value.List()
+		clv := lv.(*_PartialSignature_5_list)
+		x.PartialSignatures = *clv.list
+	case "bitway.tss.PartialSignature.signature":
+		x.Signature = value.Interface().(string)
+	case "bitway.tss.PartialSignature.signers":
+		lv := value.List()
+		clv := lv.(*_PartialSignature_7_list)
+		x.Signers = *clv.list
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		lv := value.List()
+		clv := lv.(*_PartialSignature_8_list)
+		x.AggregatedNonces = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PartialSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.PartialSignature.nonces":
+		if x.Nonces == nil {
+			x.Nonces = []string{}
+		}
+		value := &_PartialSignature_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.PartialSignature.partial_signatures":
+		if x.PartialSignatures == nil {
+			x.PartialSignatures = []string{}
+		}
+		value := &_PartialSignature_5_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.PartialSignature.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_PartialSignature_7_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		if x.AggregatedNonces == nil {
+			x.AggregatedNonces = []string{}
+		}
+		value := &_PartialSignature_8_list{list: &x.AggregatedNonces}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.PartialSignature.id":
+		panic(fmt.Errorf("field id of message bitway.tss.PartialSignature is not mutable"))
+	case "bitway.tss.PartialSignature.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.PartialSignature is not mutable"))
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.PartialSignature is not mutable"))
+	case "bitway.tss.PartialSignature.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.PartialSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PartialSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.PartialSignature.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.PartialSignature.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.PartialSignature.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.PartialSignature.nonces":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PartialSignature_4_list{list: &list})
+	case "bitway.tss.PartialSignature.partial_signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PartialSignature_5_list{list: &list})
+	case "bitway.tss.PartialSignature.signature":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.PartialSignature.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PartialSignature_7_list{list: &list})
+	case "bitway.tss.PartialSignature.aggregated_nonces":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PartialSignature_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.PartialSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.PartialSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PartialSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.PartialSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PartialSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PartialSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PartialSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PartialSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PartialSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Nonces) > 0 {
+			for _, s := range x.Nonces {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PartialSignatures) > 0 {
+			for _, s := range x.PartialSignatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AggregatedNonces) > 0 {
+			for _, s := range x.AggregatedNonces {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PartialSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AggregatedNonces) > 0 {
+			for iNdEx := len(x.AggregatedNonces) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AggregatedNonces[iNdEx])
+				copy(dAtA[i:], x.AggregatedNonces[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggregatedNonces[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.PartialSignatures) > 0 {
+			for iNdEx := len(x.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PartialSignatures[iNdEx])
+				copy(dAtA[i:], x.PartialSignatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartialSignatures[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Nonces) > 0 {
+			for iNdEx := len(x.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Nonces[iNdEx])
+				copy(dAtA[i:], x.Nonces[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nonces[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PartialSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PartialSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PartialSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Nonces = append(x.Nonces, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PartialSignatures = append(x.PartialSignatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedNonces", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggregatedNonces = append(x.AggregatedNonces, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VerificationShares_2_list)(nil)
+
+type _VerificationShares_2_list struct {
+	list *[]string
+}
+
+func (x *_VerificationShares_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VerificationShares_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VerificationShares_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VerificationShares_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VerificationShares_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VerificationShares at list field Shares as it is not of Message kind"))
+}
+
+func (x *_VerificationShares_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VerificationShares_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VerificationShares_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VerificationShares        protoreflect.MessageDescriptor
+	fd_VerificationShares_dkg_id protoreflect.FieldDescriptor
+	fd_VerificationShares_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_VerificationShares = File_bitway_tss_tss_proto.Messages().ByName("VerificationShares")
+	fd_VerificationShares_dkg_id = md_VerificationShares.Fields().ByName("dkg_id")
+	fd_VerificationShares_shares = md_VerificationShares.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_VerificationShares)(nil)
+
+type fastReflection_VerificationShares VerificationShares
+
+func (x *VerificationShares) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VerificationShares)(x)
+}
+
+func (x *VerificationShares) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VerificationShares_messageType fastReflection_VerificationShares_messageType
+var _ protoreflect.MessageType = fastReflection_VerificationShares_messageType{}
+
+type fastReflection_VerificationShares_messageType struct{}
+
+func (x fastReflection_VerificationShares_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VerificationShares)(nil)
+}
+func (x fastReflection_VerificationShares_messageType) New() protoreflect.Message {
+	return new(fastReflection_VerificationShares)
+}
+func (x fastReflection_VerificationShares_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationShares
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VerificationShares) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationShares
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VerificationShares) Type() protoreflect.MessageType {
+	return _fastReflection_VerificationShares_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VerificationShares) New() protoreflect.Message {
+	return new(fastReflection_VerificationShares)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VerificationShares) Interface() protoreflect.ProtoMessage {
+	return (*VerificationShares)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VerificationShares) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_VerificationShares_dkg_id, value) {
+			return
+		}
+	}
+	if len(x.Shares) != 0 {
+		value := protoreflect.ValueOfList(&_VerificationShares_2_list{list: &x.Shares})
+		if !f(fd_VerificationShares_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VerificationShares) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.VerificationShares.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.VerificationShares.shares":
+		return len(x.Shares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.VerificationShares.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.VerificationShares.shares":
+		x.Shares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VerificationShares) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.VerificationShares.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.VerificationShares.shares":
+		if len(x.Shares) == 0 {
+			return protoreflect.ValueOfList(&_VerificationShares_2_list{})
+		}
+		listValue := &_VerificationShares_2_list{list: &x.Shares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.VerificationShares.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.VerificationShares.shares":
+		lv := value.List()
+		clv := lv.(*_VerificationShares_2_list)
+		x.Shares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.VerificationShares.shares":
+		if x.Shares == nil {
+			x.Shares = []string{}
+		}
+		value := &_VerificationShares_2_list{list: &x.Shares}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.VerificationShares.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.VerificationShares is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VerificationShares) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.VerificationShares.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.VerificationShares.shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VerificationShares_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.VerificationShares"))
+		}
+		panic(fmt.Errorf("message bitway.tss.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VerificationShares) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.VerificationShares", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VerificationShares) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VerificationShares) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VerificationShares) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		if len(x.Shares) > 0 {
+			for _, s := range x.Shares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Shares[iNdEx])
+				copy(dAtA[i:], x.Shares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationShares: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = append(x.Shares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SignerSet_2_list)(nil)
+
+type _SignerSet_2_list struct {
+	list *[]string
+}
+
+func (x *_SignerSet_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SignerSet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SignerSet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SignerSet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SignerSet_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SignerSet at list field Signers as it is not of Message kind"))
+}
+
+func (x *_SignerSet_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SignerSet_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SignerSet_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SignerSet            protoreflect.MessageDescriptor
+	fd_SignerSet_id         protoreflect.FieldDescriptor
+	fd_SignerSet_signers    protoreflect.FieldDescriptor
+	fd_SignerSet_aggregated protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_SignerSet = File_bitway_tss_tss_proto.Messages().ByName("SignerSet")
+	fd_SignerSet_id = md_SignerSet.Fields().ByName("id")
+	fd_SignerSet_signers = md_SignerSet.Fields().ByName("signers")
+	fd_SignerSet_aggregated = md_SignerSet.Fields().ByName("aggregated")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignerSet)(nil)
+
+type fastReflection_SignerSet SignerSet
+
+func (x *SignerSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignerSet)(x)
+}
+
+func (x *SignerSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignerSet_messageType fastReflection_SignerSet_messageType
+var _ protoreflect.MessageType = fastReflection_SignerSet_messageType{}
+
+type fastReflection_SignerSet_messageType struct{}
+
+func (x fastReflection_SignerSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignerSet)(nil)
+}
+func (x fastReflection_SignerSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignerSet)
+}
+func (x fastReflection_SignerSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignerSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignerSet) Type() protoreflect.MessageType {
+	return _fastReflection_SignerSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignerSet) New() protoreflect.Message {
+	return new(fastReflection_SignerSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignerSet) Interface() protoreflect.ProtoMessage {
+	return (*SignerSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignerSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SignerSet_id, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_SignerSet_2_list{list: &x.Signers})
+		if !f(fd_SignerSet_signers, value) {
+			return
+		}
+	}
+	if x.Aggregated != false {
+		value := protoreflect.ValueOfBool(x.Aggregated)
+		if !f(fd_SignerSet_aggregated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignerSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.SignerSet.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.SignerSet.signers":
+		return len(x.Signers) != 0
+	case "bitway.tss.SignerSet.aggregated":
+		return x.Aggregated != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.SignerSet.id":
+		x.Id = uint64(0)
+	case "bitway.tss.SignerSet.signers":
+		x.Signers = nil
+	case "bitway.tss.SignerSet.aggregated":
+		x.Aggregated = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignerSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.SignerSet.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SignerSet.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_SignerSet_2_list{})
+		}
+		listValue := &_SignerSet_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.SignerSet.aggregated":
+		value := x.Aggregated
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.SignerSet.id":
+		x.Id = value.Uint()
+	case "bitway.tss.SignerSet.signers":
+		lv := value.List()
+		clv := lv.(*_SignerSet_2_list)
+		x.Signers = *clv.list
+	case "bitway.tss.SignerSet.aggregated":
+		x.Aggregated = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SignerSet.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_SignerSet_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.SignerSet.id":
+		panic(fmt.Errorf("field id of message bitway.tss.SignerSet is not mutable"))
+	case "bitway.tss.SignerSet.aggregated":
+		panic(fmt.Errorf("field aggregated of message bitway.tss.SignerSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignerSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SignerSet.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SignerSet.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SignerSet_2_list{list: &list})
+	case "bitway.tss.SignerSet.aggregated":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SignerSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignerSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SignerSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignerSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignerSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignerSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Aggregated {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Aggregated {
+			i--
+			if x.Aggregated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aggregated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Aggregated = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RefreshingRequest_3_list)(nil)
+
+type _RefreshingRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_RefreshingRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RefreshingRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RefreshingRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RefreshingRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RefreshingRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingRequest at list field RemovedParticipants as it is not of Message kind"))
+}
+
+func (x *_RefreshingRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RefreshingRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RefreshingRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RefreshingRequest_6_list)(nil)
+
+type _RefreshingRequest_6_list struct {
+	list *[]string
+}
+
+func (x *_RefreshingRequest_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RefreshingRequest_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RefreshingRequest_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RefreshingRequest_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RefreshingRequest_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingRequest at list field AddedParticipants as it is not of Message kind"))
+}
+
+func (x *_RefreshingRequest_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RefreshingRequest_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RefreshingRequest_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RefreshingRequest                      protoreflect.MessageDescriptor
+	fd_RefreshingRequest_id                   protoreflect.FieldDescriptor
+	fd_RefreshingRequest_dkg_id               protoreflect.FieldDescriptor
+	fd_RefreshingRequest_removed_participants protoreflect.FieldDescriptor
+	fd_RefreshingRequest_expiration_time      protoreflect.FieldDescriptor
+	fd_RefreshingRequest_status               protoreflect.FieldDescriptor
+	fd_RefreshingRequest_added_participants   protoreflect.FieldDescriptor
+	fd_RefreshingRequest_threshold            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_RefreshingRequest = File_bitway_tss_tss_proto.Messages().ByName("RefreshingRequest")
+	fd_RefreshingRequest_id = md_RefreshingRequest.Fields().ByName("id")
+	fd_RefreshingRequest_dkg_id = md_RefreshingRequest.Fields().ByName("dkg_id")
+	fd_RefreshingRequest_removed_participants = md_RefreshingRequest.Fields().ByName("removed_participants")
+	fd_RefreshingRequest_expiration_time = md_RefreshingRequest.Fields().ByName("expiration_time")
+	fd_RefreshingRequest_status = md_RefreshingRequest.Fields().ByName("status")
+	fd_RefreshingRequest_added_participants = md_RefreshingRequest.Fields().ByName("added_participants")
+	fd_RefreshingRequest_threshold = md_RefreshingRequest.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_RefreshingRequest)(nil)
+
+type fastReflection_RefreshingRequest RefreshingRequest
+
+func (x *RefreshingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RefreshingRequest)(x)
+}
+
+func (x *RefreshingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RefreshingRequest_messageType fastReflection_RefreshingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RefreshingRequest_messageType{}
+
+type fastReflection_RefreshingRequest_messageType struct{}
+
+func (x fastReflection_RefreshingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RefreshingRequest)(nil)
+}
+func (x fastReflection_RefreshingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RefreshingRequest)
+}
+func (x fastReflection_RefreshingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RefreshingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RefreshingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RefreshingRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RefreshingRequest) New() protoreflect.Message {
+	return new(fastReflection_RefreshingRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RefreshingRequest) Interface() protoreflect.ProtoMessage {
+	return (*RefreshingRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RefreshingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RefreshingRequest_id, value) {
+			return
+		}
+	}
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_RefreshingRequest_dkg_id, value) {
+			return
+		}
+	}
+	if len(x.RemovedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &x.RemovedParticipants})
+		if !f(fd_RefreshingRequest_removed_participants, value) {
+			return
+		}
+	}
+	if x.ExpirationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+		if !f(fd_RefreshingRequest_expiration_time, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_RefreshingRequest_status, value) {
+			return
+		}
+	}
+	if len(x.AddedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_RefreshingRequest_6_list{list: &x.AddedParticipants})
+		if !f(fd_RefreshingRequest_added_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_RefreshingRequest_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RefreshingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingRequest.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		return len(x.RemovedParticipants) != 0
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		return x.ExpirationTime != nil
+	case "bitway.tss.RefreshingRequest.status":
+		return x.Status != 0
+	case "bitway.tss.RefreshingRequest.added_participants":
+		return len(x.AddedParticipants) != 0
+	case "bitway.tss.RefreshingRequest.threshold":
+		return x.Threshold != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingRequest.id":
+		x.Id = uint64(0)
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		x.RemovedParticipants = nil
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		x.ExpirationTime = nil
+	case "bitway.tss.RefreshingRequest.status":
+		x.Status = 0
+	case "bitway.tss.RefreshingRequest.added_participants":
+		x.AddedParticipants = nil
+	case "bitway.tss.RefreshingRequest.threshold":
+		x.Threshold = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RefreshingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.RefreshingRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		if len(x.RemovedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_RefreshingRequest_3_list{})
+		}
+		listValue := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		value := x.ExpirationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.RefreshingRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.RefreshingRequest.added_participants":
+		if len(x.AddedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_RefreshingRequest_6_list{})
+		}
+		listValue := &_RefreshingRequest_6_list{list: &x.AddedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.RefreshingRequest.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingRequest.id":
+		x.Id = value.Uint()
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		lv := value.List()
+		clv := lv.(*_RefreshingRequest_3_list)
+		x.RemovedParticipants = *clv.list
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.RefreshingRequest.status":
+		x.Status = (RefreshingStatus)(value.Enum())
+	case "bitway.tss.RefreshingRequest.added_participants":
+		lv := value.List()
+		clv := lv.(*_RefreshingRequest_6_list)
+		x.AddedParticipants = *clv.list
+	case "bitway.tss.RefreshingRequest.threshold":
+		x.Threshold = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		if x.RemovedParticipants == nil {
+			x.RemovedParticipants = []string{}
+		}
+		value := &_RefreshingRequest_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		if x.ExpirationTime == nil {
+			x.ExpirationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+	case "bitway.tss.RefreshingRequest.added_participants":
+		if x.AddedParticipants == nil {
+			x.AddedParticipants = []string{}
+		}
+		value := &_RefreshingRequest_6_list{list: &x.AddedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.RefreshingRequest.id":
+		panic(fmt.Errorf("field id of message bitway.tss.RefreshingRequest is not mutable"))
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.RefreshingRequest is not mutable"))
+	case "bitway.tss.RefreshingRequest.status":
+		panic(fmt.Errorf("field status of message bitway.tss.RefreshingRequest is not mutable"))
+	case "bitway.tss.RefreshingRequest.threshold":
+		panic(fmt.Errorf("field threshold of message bitway.tss.RefreshingRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RefreshingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.RefreshingRequest.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.RefreshingRequest.removed_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RefreshingRequest_3_list{list: &list})
+	case "bitway.tss.RefreshingRequest.expiration_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.RefreshingRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.RefreshingRequest.added_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RefreshingRequest_6_list{list: &list})
+	case "bitway.tss.RefreshingRequest.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RefreshingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.RefreshingRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RefreshingRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RefreshingRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RefreshingRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for _, s := range x.RemovedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpirationTime != nil {
+			l = options.Size(x.ExpirationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if len(x.AddedParticipants) > 0 {
+			for _, s := range x.AddedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.AddedParticipants) > 0 {
+			for iNdEx := len(x.AddedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AddedParticipants[iNdEx])
+				copy(dAtA[i:], x.AddedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpirationTime != nil {
+			encoded, err := options.Marshal(x.ExpirationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedParticipants[iNdEx])
+				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpirationTime == nil {
+					x.ExpirationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RefreshingStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddedParticipants = append(x.AddedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RefreshingCompletion_5_list)(nil)
+
+type _RefreshingCompletion_5_list struct {
+	list *[]string
+}
+
+func (x *_RefreshingCompletion_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RefreshingCompletion_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RefreshingCompletion_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RefreshingCompletion_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RefreshingCompletion_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RefreshingCompletion at list field VerificationShares as it is not of Message kind"))
+}
+
+func (x *_RefreshingCompletion_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RefreshingCompletion_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RefreshingCompletion_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RefreshingCompletion                     protoreflect.MessageDescriptor
+	fd_RefreshingCompletion_id                  protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_sender              protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_consensus_pubkey    protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_signature           protoreflect.FieldDescriptor
+	fd_RefreshingCompletion_verification_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_RefreshingCompletion = File_bitway_tss_tss_proto.Messages().ByName("RefreshingCompletion")
+	fd_RefreshingCompletion_id = md_RefreshingCompletion.Fields().ByName("id")
+	fd_RefreshingCompletion_sender = md_RefreshingCompletion.Fields().ByName("sender")
+	fd_RefreshingCompletion_consensus_pubkey = md_RefreshingCompletion.Fields().ByName("consensus_pubkey")
+	fd_RefreshingCompletion_signature = md_RefreshingCompletion.Fields().ByName("signature")
+	fd_RefreshingCompletion_verification_shares = md_RefreshingCompletion.Fields().ByName("verification_shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_RefreshingCompletion)(nil)
+
+type fastReflection_RefreshingCompletion RefreshingCompletion
+
+func (x *RefreshingCompletion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RefreshingCompletion)(x)
+}
+
+func (x *RefreshingCompletion) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RefreshingCompletion_messageType fastReflection_RefreshingCompletion_messageType
+var _ protoreflect.MessageType = fastReflection_RefreshingCompletion_messageType{}
+
+type fastReflection_RefreshingCompletion_messageType struct{}
+
+func (x fastReflection_RefreshingCompletion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RefreshingCompletion)(nil)
+}
+func (x fastReflection_RefreshingCompletion_messageType) New() protoreflect.Message {
+	return new(fastReflection_RefreshingCompletion)
+}
+func (x fastReflection_RefreshingCompletion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingCompletion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RefreshingCompletion) Descriptor() protoreflect.MessageDescriptor {
+	return md_RefreshingCompletion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RefreshingCompletion) Type() protoreflect.MessageType {
+	return _fastReflection_RefreshingCompletion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RefreshingCompletion) New() protoreflect.Message {
+	return new(fastReflection_RefreshingCompletion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RefreshingCompletion) Interface() protoreflect.ProtoMessage {
+	return (*RefreshingCompletion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RefreshingCompletion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RefreshingCompletion_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_RefreshingCompletion_sender, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_RefreshingCompletion_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_RefreshingCompletion_signature, value) {
+			return
+		}
+	}
+	if len(x.VerificationShares) != 0 {
+		value := protoreflect.ValueOfList(&_RefreshingCompletion_5_list{list: &x.VerificationShares})
+		if !f(fd_RefreshingCompletion_verification_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RefreshingCompletion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingCompletion.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.RefreshingCompletion.sender":
+		return x.Sender != ""
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.RefreshingCompletion.signature":
+		return x.Signature != ""
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		return len(x.VerificationShares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingCompletion.id":
+		x.Id = uint64(0)
+	case "bitway.tss.RefreshingCompletion.sender":
+		x.Sender = ""
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.RefreshingCompletion.signature":
+		x.Signature = ""
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		x.VerificationShares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RefreshingCompletion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.RefreshingCompletion.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.RefreshingCompletion.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.RefreshingCompletion.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		if len(x.VerificationShares) == 0 {
+			return protoreflect.ValueOfList(&_RefreshingCompletion_5_list{})
+		}
+		listValue := &_RefreshingCompletion_5_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingCompletion.id":
+		x.Id = value.Uint()
+	case "bitway.tss.RefreshingCompletion.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.RefreshingCompletion.signature":
+		x.Signature = value.Interface().(string)
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		lv := value.List()
+		clv := lv.(*_RefreshingCompletion_5_list)
+		x.VerificationShares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		if x.VerificationShares == nil {
+			x.VerificationShares = []string{}
+		}
+		value := &_RefreshingCompletion_5_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.RefreshingCompletion.id":
+		panic(fmt.Errorf("field id of message bitway.tss.RefreshingCompletion is not mutable"))
+	case "bitway.tss.RefreshingCompletion.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.RefreshingCompletion is not mutable"))
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.RefreshingCompletion is not mutable"))
+	case "bitway.tss.RefreshingCompletion.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.RefreshingCompletion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RefreshingCompletion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.RefreshingCompletion.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.RefreshingCompletion.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.RefreshingCompletion.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.RefreshingCompletion.signature":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.RefreshingCompletion.verification_shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RefreshingCompletion_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.RefreshingCompletion"))
+		}
+		panic(fmt.Errorf("message bitway.tss.RefreshingCompletion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RefreshingCompletion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.RefreshingCompletion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RefreshingCompletion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RefreshingCompletion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RefreshingCompletion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RefreshingCompletion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.VerificationShares) > 0 {
+			for _, s := range x.VerificationShares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerificationShares) > 0 {
+			for iNdEx := len(x.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerificationShares[iNdEx])
+				copy(dAtA[i:], x.VerificationShares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationShares[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RefreshingCompletion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShares = append(x.VerificationShares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantReliability                        protoreflect.MessageDescriptor
+	fd_ParticipantReliability_consensus_pubkey       protoreflect.FieldDescriptor
+	fd_ParticipantReliability_completed_dkgs         protoreflect.FieldDescriptor
+	fd_ParticipantReliability_missed_dkgs            protoreflect.FieldDescriptor
+	fd_ParticipantReliability_completed_refreshings  protoreflect.FieldDescriptor
+	fd_ParticipantReliability_missed_refreshings     protoreflect.FieldDescriptor
+	fd_ParticipantReliability_acknowledged_signings  protoreflect.FieldDescriptor
+	fd_ParticipantReliability_missed_signings        protoreflect.FieldDescriptor
+	fd_ParticipantReliability_pending_faults         protoreflect.FieldDescriptor
+	fd_ParticipantReliability_penalty_count          protoreflect.FieldDescriptor
+	fd_ParticipantReliability_last_fault_height      protoreflect.FieldDescriptor
+	fd_ParticipantReliability_last_penalty_height    protoreflect.FieldDescriptor
+	fd_ParticipantReliability_last_refreshing_height protoreflect.FieldDescriptor
+	fd_ParticipantReliability_measured_signings      protoreflect.FieldDescriptor
+	fd_ParticipantReliability_total_signing_latency  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_ParticipantReliability = File_bitway_tss_tss_proto.Messages().ByName("ParticipantReliability")
+	fd_ParticipantReliability_consensus_pubkey = md_ParticipantReliability.Fields().ByName("consensus_pubkey")
+	fd_ParticipantReliability_completed_dkgs = md_ParticipantReliability.Fields().ByName("completed_dkgs")
+	fd_ParticipantReliability_missed_dkgs = md_ParticipantReliability.Fields().ByName("missed_dkgs")
+	fd_ParticipantReliability_completed_refreshings = md_ParticipantReliability.Fields().ByName("completed_refreshings")
+	fd_ParticipantReliability_missed_refreshings = md_ParticipantReliability.Fields().ByName("missed_refreshings")
+	fd_ParticipantReliability_acknowledged_signings = md_ParticipantReliability.Fields().ByName("acknowledged_signings")
+	fd_ParticipantReliability_missed_signings = md_ParticipantReliability.Fields().ByName("missed_signings")
+	fd_ParticipantReliability_pending_faults = md_ParticipantReliability.Fields().ByName("pending_faults")
+	fd_ParticipantReliability_penalty_count = md_ParticipantReliability.Fields().ByName("penalty_count")
+	fd_ParticipantReliability_last_fault_height = md_ParticipantReliability.Fields().ByName("last_fault_height")
+	fd_ParticipantReliability_last_penalty_height = md_ParticipantReliability.Fields().ByName("last_penalty_height")
+	fd_ParticipantReliability_last_refreshing_height = md_ParticipantReliability.Fields().ByName("last_refreshing_height")
+	fd_ParticipantReliability_measured_signings = md_ParticipantReliability.Fields().ByName("measured_signings")
+	fd_ParticipantReliability_total_signing_latency = md_ParticipantReliability.Fields().ByName("total_signing_latency")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantReliability)(nil)
+
+type fastReflection_ParticipantReliability ParticipantReliability
+
+func (x *ParticipantReliability) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantReliability)(x)
+}
+
+func (x *ParticipantReliability) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantReliability_messageType fastReflection_ParticipantReliability_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantReliability_messageType{}
+
+type fastReflection_ParticipantReliability_messageType struct{}
+
+func (x fastReflection_ParticipantReliability_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantReliability)(nil)
+}
+func (x fastReflection_ParticipantReliability_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantReliability)
+}
+func (x fastReflection_ParticipantReliability_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantReliability
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantReliability) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantReliability
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantReliability) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantReliability_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantReliability) New() protoreflect.Message {
+	return new(fastReflection_ParticipantReliability)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantReliability) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantReliability)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantReliability) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_ParticipantReliability_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.CompletedDkgs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CompletedDkgs)
+		if !f(fd_ParticipantReliability_completed_dkgs, value) {
+			return
+		}
+	}
+	if x.MissedDkgs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedDkgs)
+		if !f(fd_ParticipantReliability_missed_dkgs, value) {
+			return
+		}
+	}
+	if x.CompletedRefreshings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CompletedRefreshings)
+		if !f(fd_ParticipantReliability_completed_refreshings, value) {
+			return
+		}
+	}
+	if x.MissedRefreshings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedRefreshings)
+		if !f(fd_ParticipantReliability_missed_refreshings, value) {
+			return
+		}
+	}
+	if x.AcknowledgedSignings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AcknowledgedSignings)
+		if !f(fd_ParticipantReliability_acknowledged_signings, value) {
+			return
+		}
+	}
+	if x.MissedSignings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedSignings)
+		if !f(fd_ParticipantReliability_missed_signings, value) {
+			return
+		}
+	}
+	if x.PendingFaults != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingFaults)
+		if !f(fd_ParticipantReliability_pending_faults, value) {
+			return
+		}
+	}
+	if x.PenaltyCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PenaltyCount)
+		if !f(fd_ParticipantReliability_penalty_count, value) {
+			return
+		}
+	}
+	if x.LastFaultHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastFaultHeight)
+		if !f(fd_ParticipantReliability_last_fault_height, value) {
+			return
+		}
+	}
+	if x.LastPenaltyHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastPenaltyHeight)
+		if !f(fd_ParticipantReliability_last_penalty_height, value) {
+			return
+		}
+	}
+	if x.LastRefreshingHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastRefreshingHeight)
+		if !f(fd_ParticipantReliability_last_refreshing_height, value) {
+			return
+		}
+	}
+	if x.MeasuredSignings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MeasuredSignings)
+		if !f(fd_ParticipantReliability_measured_signings, value) {
+			return
+		}
+	}
+	if x.TotalSigningLatency != nil {
+		value := protoreflect.ValueOfMessage(x.TotalSigningLatency.ProtoReflect())
+		if !f(fd_ParticipantReliability_total_signing_latency, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantReliability) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		return x.CompletedDkgs != uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		return x.MissedDkgs != uint64(0)
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		return x.CompletedRefreshings != uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		return x.MissedRefreshings != uint64(0)
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		return x.AcknowledgedSignings != uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		return x.MissedSignings != uint64(0)
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		return x.PendingFaults != uint64(0)
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		return x.PenaltyCount != uint64(0)
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		return x.LastFaultHeight != int64(0)
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		return x.LastPenaltyHeight != int64(0)
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		return x.LastRefreshingHeight != int64(0)
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		return x.MeasuredSignings != uint64(0)
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		return x.TotalSigningLatency != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantReliability) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		x.CompletedDkgs = uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		x.MissedDkgs = uint64(0)
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		x.CompletedRefreshings = uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		x.MissedRefreshings = uint64(0)
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		x.AcknowledgedSignings = uint64(0)
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		x.MissedSignings = uint64(0)
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		x.PendingFaults = uint64(0)
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		x.PenaltyCount = uint64(0)
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		x.LastFaultHeight = int64(0)
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		x.LastPenaltyHeight = int64(0)
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		x.LastRefreshingHeight = int64(0)
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		x.MeasuredSignings = uint64(0)
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		x.TotalSigningLatency = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantReliability) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		value := x.CompletedDkgs
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		value := x.MissedDkgs
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		value := x.CompletedRefreshings
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		value := x.MissedRefreshings
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		value := x.AcknowledgedSignings
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		value := x.MissedSignings
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		value := x.PendingFaults
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		value := x.PenaltyCount
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		value := x.LastFaultHeight
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		value := x.LastPenaltyHeight
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		value := x.LastRefreshingHeight
+		return protoreflect.ValueOfInt64(value)
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		value := x.MeasuredSignings
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		value := x.TotalSigningLatency
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantReliability) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		x.CompletedDkgs = value.Uint()
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		x.MissedDkgs = value.Uint()
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		x.CompletedRefreshings = value.Uint()
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		x.MissedRefreshings = value.Uint()
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		x.AcknowledgedSignings = value.Uint()
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		x.MissedSignings = value.Uint()
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		x.PendingFaults = value.Uint()
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		x.PenaltyCount = value.Uint()
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		x.LastFaultHeight = value.Int()
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		x.LastPenaltyHeight = value.Int()
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		x.LastRefreshingHeight = value.Int()
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		x.MeasuredSignings = value.Uint()
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		x.TotalSigningLatency = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantReliability) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		if x.TotalSigningLatency == nil {
+			x.TotalSigningLatency = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TotalSigningLatency.ProtoReflect())
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		panic(fmt.Errorf("field completed_dkgs of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		panic(fmt.Errorf("field missed_dkgs of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		panic(fmt.Errorf("field completed_refreshings of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		panic(fmt.Errorf("field missed_refreshings of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		panic(fmt.Errorf("field acknowledged_signings of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		panic(fmt.Errorf("field missed_signings of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		panic(fmt.Errorf("field pending_faults of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		panic(fmt.Errorf("field penalty_count of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		panic(fmt.Errorf("field last_fault_height of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		panic(fmt.Errorf("field last_penalty_height of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		panic(fmt.Errorf("field last_refreshing_height of message bitway.tss.ParticipantReliability is not mutable"))
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		panic(fmt.Errorf("field measured_signings of message bitway.tss.ParticipantReliability is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantReliability) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantReliability.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ParticipantReliability.completed_dkgs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.missed_dkgs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.completed_refreshings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.missed_refreshings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.acknowledged_signings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.missed_signings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.pending_faults":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.penalty_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.last_fault_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.tss.ParticipantReliability.last_penalty_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.tss.ParticipantReliability.last_refreshing_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "bitway.tss.ParticipantReliability.measured_signings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantReliability.total_signing_latency":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantReliability"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantReliability) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantReliability", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantReliability) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantReliability) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantReliability) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantReliability) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantReliability)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CompletedDkgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletedDkgs))
+		}
+		if x.MissedDkgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedDkgs))
+		}
+		if x.CompletedRefreshings != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletedRefreshings))
+		}
+		if x.MissedRefreshings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedRefreshings))
+		}
+		if x.AcknowledgedSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.AcknowledgedSignings))
+		}
+		if x.MissedSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedSignings))
+		}
+		if x.PendingFaults != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingFaults))
+		}
+		if x.PenaltyCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PenaltyCount))
+		}
+		if x.LastFaultHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastFaultHeight))
+		}
+		if x.LastPenaltyHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastPenaltyHeight))
+		}
+		if x.LastRefreshingHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastRefreshingHeight))
+		}
+		if x.MeasuredSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MeasuredSignings))
+		}
+		if x.TotalSigningLatency != nil {
+			l = options.Size(x.TotalSigningLatency)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantReliability)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalSigningLatency != nil {
+			encoded, err := options.Marshal(x.TotalSigningLatency)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.MeasuredSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MeasuredSignings))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.LastRefreshingHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRefreshingHeight))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.LastPenaltyHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastPenaltyHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.LastFaultHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastFaultHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.PenaltyCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PenaltyCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PendingFaults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingFaults))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MissedSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedSignings))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.AcknowledgedSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AcknowledgedSignings))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MissedRefreshings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedRefreshings))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CompletedRefreshings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletedRefreshings))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MissedDkgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedDkgs))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CompletedDkgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletedDkgs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantReliability)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantReliability: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantReliability: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedDkgs", wireType)
+				}
+				x.CompletedDkgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletedDkgs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedDkgs", wireType)
+				}
+				x.MissedDkgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedDkgs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedRefreshings", wireType)
+				}
+				x.CompletedRefreshings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletedRefreshings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedRefreshings", wireType)
+				}
+				x.MissedRefreshings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedRefreshings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedSignings", wireType)
+				}
+				x.AcknowledgedSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AcknowledgedSignings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedSignings", wireType)
+				}
+				x.MissedSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedSignings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingFaults", wireType)
+				}
+				x.PendingFaults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingFaults |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyCount", wireType)
+				}
+				x.PenaltyCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PenaltyCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastFaultHeight", wireType)
+				}
+				x.LastFaultHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastFaultHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastPenaltyHeight", wireType)
+				}
+				x.LastPenaltyHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastPenaltyHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRefreshingHeight", wireType)
+				}
+				x.LastRefreshingHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRefreshingHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MeasuredSignings", wireType)
+				}
+				x.MeasuredSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MeasuredSignings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSigningLatency", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalSigningLatency == nil {
+					x.TotalSigningLatency = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalSigningLatency); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantSet_1_list)(nil)
+
+type _ParticipantSet_1_list struct {
+	list *[]*DKGParticipant
+}
+
+func (x *_ParticipantSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantSet_1_list) AppendMutable() protoreflect.Value {
+	v := new(DKGParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSet_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantSet_1_list) NewElement() protoreflect.Value {
+	v := new(DKGParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSet_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantSet              protoreflect.MessageDescriptor
+	fd_ParticipantSet_participants protoreflect.FieldDescriptor
+	fd_ParticipantSet_height       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_ParticipantSet = File_bitway_tss_tss_proto.Messages().ByName("ParticipantSet")
+	fd_ParticipantSet_participants = md_ParticipantSet.Fields().ByName("participants")
+	fd_ParticipantSet_height = md_ParticipantSet.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantSet)(nil)
+
+type fastReflection_ParticipantSet ParticipantSet
+
+func (x *ParticipantSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSet)(x)
+}
+
+func (x *ParticipantSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantSet_messageType fastReflection_ParticipantSet_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSet_messageType{}
+
+type fastReflection_ParticipantSet_messageType struct{}
+
+func (x fastReflection_ParticipantSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSet)(nil)
+}
+func (x fastReflection_ParticipantSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSet)
+}
+func (x fastReflection_ParticipantSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantSet) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantSet) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantSet) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantSet_1_list{list: &x.Participants})
+		if !f(fd_ParticipantSet_participants, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ParticipantSet_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		return len(x.Participants) != 0
+	case "bitway.tss.ParticipantSet.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		x.Participants = nil
+	case "bitway.tss.ParticipantSet.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantSet_1_list{})
+		}
+		listValue := &_ParticipantSet_1_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.ParticipantSet.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		lv := value.List()
+		clv := lv.(*_ParticipantSet_1_list)
+		x.Participants = *clv.list
+	case "bitway.tss.ParticipantSet.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		if x.Participants == nil {
+			x.Participants = []*DKGParticipant{}
+		}
+		value := &_ParticipantSet_1_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.ParticipantSet.height":
+		panic(fmt.Errorf("field height of message bitway.tss.ParticipantSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantSet.participants":
+		list := []*DKGParticipant{}
+		return protoreflect.ValueOfList(&_ParticipantSet_1_list{list: &list})
+	case "bitway.tss.ParticipantSet.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantSet"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &DKGParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CommittedNonce               protoreflect.MessageDescriptor
+	fd_CommittedNonce_dkg_id        protoreflect.FieldDescriptor
+	fd_CommittedNonce_index         protoreflect.FieldDescriptor
+	fd_CommittedNonce_nonce         protoreflect.FieldDescriptor
+	fd_CommittedNonce_generation_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_CommittedNonce = File_bitway_tss_tss_proto.Messages().ByName("CommittedNonce")
+	fd_CommittedNonce_dkg_id = md_CommittedNonce.Fields().ByName("dkg_id")
+	fd_CommittedNonce_index = md_CommittedNonce.Fields().ByName("index")
+	fd_CommittedNonce_nonce = md_CommittedNonce.Fields().ByName("nonce")
+	fd_CommittedNonce_generation_id = md_CommittedNonce.Fields().ByName("generation_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_CommittedNonce)(nil)
+
+type fastReflection_CommittedNonce CommittedNonce
+
+func (x *CommittedNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CommittedNonce)(x)
+}
+
+func (x *CommittedNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CommittedNonce_messageType fastReflection_CommittedNonce_messageType
+var _ protoreflect.MessageType = fastReflection_CommittedNonce_messageType{}
+
+type fastReflection_CommittedNonce_messageType struct{}
+
+func (x fastReflection_CommittedNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CommittedNonce)(nil)
+}
+func (x fastReflection_CommittedNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_CommittedNonce)
+}
+func (x fastReflection_CommittedNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommittedNonce
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CommittedNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommittedNonce
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CommittedNonce) Type() protoreflect.MessageType {
+	return _fastReflection_CommittedNonce_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CommittedNonce) New() protoreflect.Message {
+	return new(fastReflection_CommittedNonce)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CommittedNonce) Interface() protoreflect.ProtoMessage {
+	return (*CommittedNonce)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CommittedNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_CommittedNonce_dkg_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_CommittedNonce_index, value) {
+			return
+		}
+	}
+	if x.Nonce != "" {
+		value := protoreflect.ValueOfString(x.Nonce)
+		if !f(fd_CommittedNonce_nonce, value) {
+			return
+		}
+	}
+	if x.GenerationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GenerationId)
+		if !f(fd_CommittedNonce_generation_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CommittedNonce) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.CommittedNonce.index":
+		return x.Index != uint64(0)
+	case "bitway.tss.CommittedNonce.nonce":
+		return x.Nonce != ""
+	case "bitway.tss.CommittedNonce.generation_id":
+		return x.GenerationId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommittedNonce) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.CommittedNonce.index":
+		x.Index = uint64(0)
+	case "bitway.tss.CommittedNonce.nonce":
+		x.Nonce = ""
+	case "bitway.tss.CommittedNonce.generation_id":
+		x.GenerationId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CommittedNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.CommittedNonce.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.CommittedNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.CommittedNonce.generation_id":
+		value := x.GenerationId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommittedNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.CommittedNonce.index":
+		x.Index = value.Uint()
+	case "bitway.tss.CommittedNonce.nonce":
+		x.Nonce = value.Interface().(string)
+	case "bitway.tss.CommittedNonce.generation_id":
+		x.GenerationId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommittedNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.CommittedNonce is not mutable"))
+	case "bitway.tss.CommittedNonce.index":
+		panic(fmt.Errorf("field index of message bitway.tss.CommittedNonce is not mutable"))
+	case "bitway.tss.CommittedNonce.nonce":
+		panic(fmt.Errorf("field nonce of message bitway.tss.CommittedNonce is not mutable"))
+	case "bitway.tss.CommittedNonce.generation_id":
+		panic(fmt.Errorf("field generation_id of message bitway.tss.CommittedNonce is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CommittedNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.CommittedNonce.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.CommittedNonce.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.CommittedNonce.nonce":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.CommittedNonce.generation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.CommittedNonce"))
+		}
+		panic(fmt.Errorf("message bitway.tss.CommittedNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CommittedNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.CommittedNonce", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CommittedNonce) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommittedNonce) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CommittedNonce) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CommittedNonce) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CommittedNonce)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Nonce)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GenerationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GenerationId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CommittedNonce)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GenerationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenerationId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Nonce) > 0 {
+			i -= len(x.Nonce)
+			copy(dAtA[i:], x.Nonce)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nonce)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CommittedNonce)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommittedNonce: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommittedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Nonce = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenerationId", wireType)
+				}
+				x.GenerationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GenerationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SigningFee_3_list)(nil)
+
+type _SigningFee_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SigningFee_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SigningFee_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SigningFee_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SigningFee_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SigningFee_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SigningFee_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SigningFee_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SigningFee_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SigningFee       protoreflect.MessageDescriptor
+	fd_SigningFee_id    protoreflect.FieldDescriptor
+	fd_SigningFee_payer protoreflect.FieldDescriptor
+	fd_SigningFee_fee   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_SigningFee = File_bitway_tss_tss_proto.Messages().ByName("SigningFee")
+	fd_SigningFee_id = md_SigningFee.Fields().ByName("id")
+	fd_SigningFee_payer = md_SigningFee.Fields().ByName("payer")
+	fd_SigningFee_fee = md_SigningFee.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningFee)(nil)
+
+type fastReflection_SigningFee SigningFee
+
+func (x *SigningFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningFee)(x)
+}
+
+func (x *SigningFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningFee_messageType fastReflection_SigningFee_messageType
+var _ protoreflect.MessageType = fastReflection_SigningFee_messageType{}
+
+type fastReflection_SigningFee_messageType struct{}
+
+func (x fastReflection_SigningFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningFee)(nil)
+}
+func (x fastReflection_SigningFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningFee)
+}
+func (x fastReflection_SigningFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningFee) Type() protoreflect.MessageType {
+	return _fastReflection_SigningFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningFee) New() protoreflect.Message {
+	return new(fastReflection_SigningFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningFee) Interface() protoreflect.ProtoMessage {
+	return (*SigningFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SigningFee_id, value) {
+			return
+		}
+	}
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_SigningFee_payer, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_SigningFee_3_list{list: &x.Fee})
+		if !f(fd_SigningFee_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.SigningFee.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.SigningFee.payer":
+		return x.Payer != ""
+	case "bitway.tss.SigningFee.fee":
+		return len(x.Fee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningFee.id":
+		x.Id = uint64(0)
+	case "bitway.tss.SigningFee.payer":
+		x.Payer = ""
+	case "bitway.tss.SigningFee.fee":
+		x.Fee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.SigningFee.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningFee.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningFee.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_SigningFee_3_list{})
+		}
+		listValue := &_SigningFee_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningFee.id":
+		x.Id = value.Uint()
+	case "bitway.tss.SigningFee.payer":
+		x.Payer = value.Interface().(string)
+	case "bitway.tss.SigningFee.fee":
+		lv := value.List()
+		clv := lv.(*_SigningFee_3_list)
+		x.Fee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningFee.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_SigningFee_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.SigningFee.id":
+		panic(fmt.Errorf("field id of message bitway.tss.SigningFee is not mutable"))
+	case "bitway.tss.SigningFee.payer":
+		panic(fmt.Errorf("field payer of message bitway.tss.SigningFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningFee.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningFee.payer":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningFee.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SigningFee_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningFee"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SigningFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Payer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantRewards_2_list)(nil)
+
+type _ParticipantRewards_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ParticipantRewards_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantRewards_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantRewards_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantRewards_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantRewards_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantRewards_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantRewards_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantRewards_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantRewards                  protoreflect.MessageDescriptor
+	fd_ParticipantRewards_consensus_pubkey protoreflect.FieldDescriptor
+	fd_ParticipantRewards_rewards          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_ParticipantRewards = File_bitway_tss_tss_proto.Messages().ByName("ParticipantRewards")
+	fd_ParticipantRewards_consensus_pubkey = md_ParticipantRewards.Fields().ByName("consensus_pubkey")
+	fd_ParticipantRewards_rewards = md_ParticipantRewards.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantRewards)(nil)
+
+type fastReflection_ParticipantRewards ParticipantRewards
+
+func (x *ParticipantRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantRewards)(x)
+}
+
+func (x *ParticipantRewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantRewards_messageType fastReflection_ParticipantRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantRewards_messageType{}
+
+type fastReflection_ParticipantRewards_messageType struct{}
+
+func (x fastReflection_ParticipantRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantRewards)(nil)
+}
+func (x fastReflection_ParticipantRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantRewards)
+}
+func (x fastReflection_ParticipantRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantRewards
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantRewards
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantRewards_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantRewards) New() protoreflect.Message {
+	return new(fastReflection_ParticipantRewards)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantRewards) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantRewards)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_ParticipantRewards_consensus_pubkey, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantRewards_2_list{list: &x.Rewards})
+		if !f(fd_ParticipantRewards_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantRewards) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.ParticipantRewards.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewards) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.ParticipantRewards.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ParticipantRewards.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantRewards_2_list{})
+		}
+		listValue := &_ParticipantRewards_2_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.ParticipantRewards.rewards":
+		lv := value.List()
+		clv := lv.(*_ParticipantRewards_2_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.Coin{}
+		}
+		value := &_ParticipantRewards_2_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.ParticipantRewards is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantRewards.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ParticipantRewards.rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ParticipantRewards_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantRewards", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantRewards) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewards) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantRewards) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantRewards) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantRewards)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantRewards)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantRewards)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantRewards: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_KeyRotation_6_list)(nil)
+
+type _KeyRotation_6_list struct {
+	list *[]uint64
+}
+
+func (x *_KeyRotation_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_KeyRotation_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_KeyRotation_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_KeyRotation_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_KeyRotation_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message KeyRotation at list field RefreshingIds as it is not of Message kind"))
+}
+
+func (x *_KeyRotation_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_KeyRotation_6_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_KeyRotation_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_KeyRotation                    protoreflect.MessageDescriptor
+	fd_KeyRotation_module             protoreflect.FieldDescriptor
+	fd_KeyRotation_type               protoreflect.FieldDescriptor
+	fd_KeyRotation_status             protoreflect.FieldDescriptor
+	fd_KeyRotation_last_rotation_time protoreflect.FieldDescriptor
+	fd_KeyRotation_retirement_time    protoreflect.FieldDescriptor
+	fd_KeyRotation_refreshing_ids     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_KeyRotation = File_bitway_tss_tss_proto.Messages().ByName("KeyRotation")
+	fd_KeyRotation_module = md_KeyRotation.Fields().ByName("module")
+	fd_KeyRotation_type = md_KeyRotation.Fields().ByName("type")
+	fd_KeyRotation_status = md_KeyRotation.Fields().ByName("status")
+	fd_KeyRotation_last_rotation_time = md_KeyRotation.Fields().ByName("last_rotation_time")
+	fd_KeyRotation_retirement_time = md_KeyRotation.Fields().ByName("retirement_time")
+	fd_KeyRotation_refreshing_ids = md_KeyRotation.Fields().ByName("refreshing_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyRotation)(nil)
+
+type fastReflection_KeyRotation KeyRotation
+
+func (x *KeyRotation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyRotation)(x)
+}
+
+func (x *KeyRotation) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyRotation_messageType fastReflection_KeyRotation_messageType
+var _ protoreflect.MessageType = fastReflection_KeyRotation_messageType{}
+
+type fastReflection_KeyRotation_messageType struct{}
+
+func (x fastReflection_KeyRotation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyRotation)(nil)
+}
+func (x fastReflection_KeyRotation_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyRotation)
+}
+func (x fastReflection_KeyRotation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRotation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyRotation) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRotation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyRotation) Type() protoreflect.MessageType {
+	return _fastReflection_KeyRotation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyRotation) New() protoreflect.Message {
+	return new(fastReflection_KeyRotation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyRotation) Interface() protoreflect.ProtoMessage {
+	return (*KeyRotation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyRotation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_KeyRotation_module, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_KeyRotation_type, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_KeyRotation_status, value) {
+			return
+		}
+	}
+	if x.LastRotationTime != nil {
+		value := protoreflect.ValueOfMessage(x.LastRotationTime.ProtoReflect())
+		if !f(fd_KeyRotation_last_rotation_time, value) {
+			return
+		}
+	}
+	if x.RetirementTime != nil {
+		value := protoreflect.ValueOfMessage(x.RetirementTime.ProtoReflect())
+		if !f(fd_KeyRotation_retirement_time, value) {
+			return
+		}
+	}
+	if len(x.RefreshingIds) != 0 {
+		value := protoreflect.ValueOfList(&_KeyRotation_6_list{list: &x.RefreshingIds})
+		if !f(fd_KeyRotation_refreshing_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyRotation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotation.module":
+		return x.Module != ""
+	case "bitway.tss.KeyRotation.type":
+		return x.Type_ != ""
+	case "bitway.tss.KeyRotation.status":
+		return x.Status != 0
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		return x.LastRotationTime != nil
+	case "bitway.tss.KeyRotation.retirement_time":
+		return x.RetirementTime != nil
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		return len(x.RefreshingIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRotation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotation.module":
+		x.Module = ""
+	case "bitway.tss.KeyRotation.type":
+		x.Type_ = ""
+	case "bitway.tss.KeyRotation.status":
+		x.Status = 0
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		x.LastRotationTime = nil
+	case "bitway.tss.KeyRotation.retirement_time":
+		x.RetirementTime = nil
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		x.RefreshingIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KeyRotation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.KeyRotation.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.KeyRotation.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.KeyRotation.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		value := x.LastRotationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.KeyRotation.retirement_time":
+		value := x.RetirementTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		if len(x.RefreshingIds) == 0 {
+			return protoreflect.ValueOfList(&_KeyRotation_6_list{})
+		}
+		listValue := &_KeyRotation_6_list{list: &x.RefreshingIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRotation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotation.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.KeyRotation.type":
+		x.Type_ = value.Interface().(string)
+	case "bitway.tss.KeyRotation.status":
+		x.Status = (KeyRotationStatus)(value.Enum())
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		x.LastRotationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.KeyRotation.retirement_time":
+		x.RetirementTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		lv := value.List()
+		clv := lv.(*_KeyRotation_6_list)
+		x.RefreshingIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRotation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		if x.LastRotationTime == nil {
+			x.LastRotationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastRotationTime.ProtoReflect())
+	case "bitway.tss.KeyRotation.retirement_time":
+		if x.RetirementTime == nil {
+			x.RetirementTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RetirementTime.ProtoReflect())
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		if x.RefreshingIds == nil {
+			x.RefreshingIds = []uint64{}
+		}
+		value := &_KeyRotation_6_list{list: &x.RefreshingIds}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.KeyRotation.module":
+		panic(fmt.Errorf("field module of message bitway.tss.KeyRotation is not mutable"))
+	case "bitway.tss.KeyRotation.type":
+		panic(fmt.Errorf("field type of message bitway.tss.KeyRotation is not mutable"))
+	case "bitway.tss.KeyRotation.status":
+		panic(fmt.Errorf("field status of message bitway.tss.KeyRotation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KeyRotation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.KeyRotation.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.KeyRotation.type":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.KeyRotation.status":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.KeyRotation.last_rotation_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.KeyRotation.retirement_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.KeyRotation.refreshing_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_KeyRotation_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.KeyRotation"))
+		}
+		panic(fmt.Errorf("message bitway.tss.KeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KeyRotation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.KeyRotation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KeyRotation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRotation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KeyRotation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KeyRotation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KeyRotation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.LastRotationTime != nil {
+			l = options.Size(x.LastRotationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RetirementTime != nil {
+			l = options.Size(x.RetirementTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefreshingIds) > 0 {
+			l = 0
+			for _, e := range x.RefreshingIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KeyRotation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RefreshingIds) > 0 {
+			var pksize2 int
+			for _, num := range x.RefreshingIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.RefreshingIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.RetirementTime != nil {
+			encoded, err := options.Marshal(x.RetirementTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.LastRotationTime != nil {
+			encoded, err := options.Marshal(x.LastRotationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KeyRotation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= KeyRotationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRotationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastRotationTime == nil {
+					x.LastRotationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastRotationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RetirementTime == nil {
+					x.RetirementTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RetirementTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RefreshingIds = append(x.RefreshingIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.RefreshingIds) == 0 {
+						x.RefreshingIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RefreshingIds = append(x.RefreshingIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefreshingIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GovSignatureRequest_5_list)(nil)
+
+type _GovSignatureRequest_5_list struct {
+	list *[]string
+}
+
+func (x *_GovSignatureRequest_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GovSignatureRequest_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GovSignatureRequest_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GovSignatureRequest_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GovSignatureRequest_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GovSignatureRequest at list field Signatures as it is not of Message kind"))
+}
+
+func (x *_GovSignatureRequest_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GovSignatureRequest_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GovSignatureRequest_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GovSignatureRequest            protoreflect.MessageDescriptor
+	fd_GovSignatureRequest_id         protoreflect.FieldDescriptor
+	fd_GovSignatureRequest_dkg_id     protoreflect.FieldDescriptor
+	fd_GovSignatureRequest_psbt       protoreflect.FieldDescriptor
+	fd_GovSignatureRequest_signing_id protoreflect.FieldDescriptor
+	fd_GovSignatureRequest_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_GovSignatureRequest = File_bitway_tss_tss_proto.Messages().ByName("GovSignatureRequest")
+	fd_GovSignatureRequest_id = md_GovSignatureRequest.Fields().ByName("id")
+	fd_GovSignatureRequest_dkg_id = md_GovSignatureRequest.Fields().ByName("dkg_id")
+	fd_GovSignatureRequest_psbt = md_GovSignatureRequest.Fields().ByName("psbt")
+	fd_GovSignatureRequest_signing_id = md_GovSignatureRequest.Fields().ByName("signing_id")
+	fd_GovSignatureRequest_signatures = md_GovSignatureRequest.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_GovSignatureRequest)(nil)
+
+type fastReflection_GovSignatureRequest GovSignatureRequest
+
+func (x *GovSignatureRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GovSignatureRequest)(x)
+}
+
+func (x *GovSignatureRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GovSignatureRequest_messageType fastReflection_GovSignatureRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GovSignatureRequest_messageType{}
+
+type fastReflection_GovSignatureRequest_messageType struct{}
+
+func (x fastReflection_GovSignatureRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GovSignatureRequest)(nil)
+}
+func (x fastReflection_GovSignatureRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GovSignatureRequest)
+}
+func (x fastReflection_GovSignatureRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GovSignatureRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GovSignatureRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GovSignatureRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GovSignatureRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GovSignatureRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GovSignatureRequest) New() protoreflect.Message {
+	return new(fastReflection_GovSignatureRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GovSignatureRequest) Interface() protoreflect.ProtoMessage {
+	return (*GovSignatureRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GovSignatureRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_GovSignatureRequest_id, value) {
+			return
+		}
+	}
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_GovSignatureRequest_dkg_id, value) {
+			return
+		}
+	}
+	if x.Psbt != "" {
+		value := protoreflect.ValueOfString(x.Psbt)
+		if !f(fd_GovSignatureRequest_psbt, value) {
+			return
+		}
+	}
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_GovSignatureRequest_signing_id, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_GovSignatureRequest_5_list{list: &x.Signatures})
+		if !f(fd_GovSignatureRequest_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GovSignatureRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.GovSignatureRequest.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.GovSignatureRequest.psbt":
+		return x.Psbt != ""
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		return x.SigningId != uint64(0)
+	case "bitway.tss.GovSignatureRequest.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovSignatureRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.GovSignatureRequest.id":
+		x.Id = uint64(0)
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.GovSignatureRequest.psbt":
+		x.Psbt = ""
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		x.SigningId = uint64(0)
+	case "bitway.tss.GovSignatureRequest.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GovSignatureRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.GovSignatureRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.GovSignatureRequest.psbt":
+		value := x.Psbt
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		value := x.SigningId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.GovSignatureRequest.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_GovSignatureRequest_5_list{})
+		}
+		listValue := &_GovSignatureRequest_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovSignatureRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.GovSignatureRequest.id":
+		x.Id = value.Uint()
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.GovSignatureRequest.psbt":
+		x.Psbt = value.Interface().(string)
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		x.SigningId = value.Uint()
+	case "bitway.tss.GovSignatureRequest.signatures":
+		lv := value.List()
+		clv := lv.(*_GovSignatureRequest_5_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovSignatureRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.GovSignatureRequest.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []string{}
+		}
+		value := &_GovSignatureRequest_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.GovSignatureRequest.id":
+		panic(fmt.Errorf("field id of message bitway.tss.GovSignatureRequest is not mutable"))
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.GovSignatureRequest is not mutable"))
+	case "bitway.tss.GovSignatureRequest.psbt":
+		panic(fmt.Errorf("field psbt of message bitway.tss.GovSignatureRequest is not mutable"))
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		panic(fmt.Errorf("field signing_id of message bitway.tss.GovSignatureRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GovSignatureRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.GovSignatureRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.GovSignatureRequest.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.GovSignatureRequest.psbt":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.GovSignatureRequest.signing_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.GovSignatureRequest.signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GovSignatureRequest_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.GovSignatureRequest"))
+		}
+		panic(fmt.Errorf("message bitway.tss.GovSignatureRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GovSignatureRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.GovSignatureRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GovSignatureRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovSignatureRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GovSignatureRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GovSignatureRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GovSignatureRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		l = len(x.Psbt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
+		}
+		if len(x.Signatures) > 0 {
+			for _, s := range x.Signatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GovSignatureRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signatures[iNdEx])
+				copy(dAtA[i:], x.Signatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Psbt) > 0 {
+			i -= len(x.Psbt)
+			copy(dAtA[i:], x.Psbt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Psbt)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GovSignatureRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GovSignatureRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GovSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Psbt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+				}
+				x.SigningId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigningId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SigningStats               protoreflect.MessageDescriptor
+	fd_SigningStats_module        protoreflect.FieldDescriptor
+	fd_SigningStats_type          protoreflect.FieldDescriptor
+	fd_SigningStats_requested     protoreflect.FieldDescriptor
+	fd_SigningStats_signed        protoreflect.FieldDescriptor
+	fd_SigningStats_timed_out     protoreflect.FieldDescriptor
+	fd_SigningStats_total_latency protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_SigningStats = File_bitway_tss_tss_proto.Messages().ByName("SigningStats")
+	fd_SigningStats_module = md_SigningStats.Fields().ByName("module")
+	fd_SigningStats_type = md_SigningStats.Fields().ByName("type")
+	fd_SigningStats_requested = md_SigningStats.Fields().ByName("requested")
+	fd_SigningStats_signed = md_SigningStats.Fields().ByName("signed")
+	fd_SigningStats_timed_out = md_SigningStats.Fields().ByName("timed_out")
+	fd_SigningStats_total_latency = md_SigningStats.Fields().ByName("total_latency")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningStats)(nil)
+
+type fastReflection_SigningStats SigningStats
+
+func (x *SigningStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningStats)(x)
+}
+
+func (x *SigningStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningStats_messageType fastReflection_SigningStats_messageType
+var _ protoreflect.MessageType = fastReflection_SigningStats_messageType{}
+
+type fastReflection_SigningStats_messageType struct{}
+
+func (x fastReflection_SigningStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningStats)(nil)
+}
+func (x fastReflection_SigningStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningStats)
+}
+func (x fastReflection_SigningStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningStats) Type() protoreflect.MessageType {
+	return _fastReflection_SigningStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningStats) New() protoreflect.Message {
+	return new(fastReflection_SigningStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningStats) Interface() protoreflect.ProtoMessage {
+	return (*SigningStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_SigningStats_module, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_SigningStats_type, value) {
+			return
+		}
+	}
+	if x.Requested != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Requested)
+		if !f(fd_SigningStats_requested, value) {
+			return
+		}
+	}
+	if x.Signed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Signed)
+		if !f(fd_SigningStats_signed, value) {
+			return
+		}
+	}
+	if x.TimedOut != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimedOut)
+		if !f(fd_SigningStats_timed_out, value) {
+			return
+		}
+	}
+	if x.TotalLatency != nil {
+		value := protoreflect.ValueOfMessage(x.TotalLatency.ProtoReflect())
+		if !f(fd_SigningStats_total_latency, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.SigningStats.module":
+		return x.Module != ""
+	case "bitway.tss.SigningStats.type":
+		return x.Type_ != 0
+	case "bitway.tss.SigningStats.requested":
+		return x.Requested != uint64(0)
+	case "bitway.tss.SigningStats.signed":
+		return x.Signed != uint64(0)
+	case "bitway.tss.SigningStats.timed_out":
+		return x.TimedOut != uint64(0)
+	case "bitway.tss.SigningStats.total_latency":
+		return x.TotalLatency != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningStats.module":
+		x.Module = ""
+	case "bitway.tss.SigningStats.type":
+		x.Type_ = 0
+	case "bitway.tss.SigningStats.requested":
+		x.Requested = uint64(0)
+	case "bitway.tss.SigningStats.signed":
+		x.Signed = uint64(0)
+	case "bitway.tss.SigningStats.timed_out":
+		x.TimedOut = uint64(0)
+	case "bitway.tss.SigningStats.total_latency":
+		x.TotalLatency = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.SigningStats.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.SigningStats.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.SigningStats.requested":
+		value := x.Requested
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningStats.signed":
+		value := x.Signed
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningStats.timed_out":
+		value := x.TimedOut
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.SigningStats.total_latency":
+		value := x.TotalLatency
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.SigningStats.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.SigningStats.type":
+		x.Type_ = (SigningType)(value.Enum())
+	case "bitway.tss.SigningStats.requested":
+		x.Requested = value.Uint()
+	case "bitway.tss.SigningStats.signed":
+		x.Signed = value.Uint()
+	case "bitway.tss.SigningStats.timed_out":
+		x.TimedOut = value.Uint()
+	case "bitway.tss.SigningStats.total_latency":
+		x.TotalLatency = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningStats.total_latency":
+		if x.TotalLatency == nil {
+			x.TotalLatency = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TotalLatency.ProtoReflect())
+	case "bitway.tss.SigningStats.module":
+		panic(fmt.Errorf("field module of message bitway.tss.SigningStats is not mutable"))
+	case "bitway.tss.SigningStats.type":
+		panic(fmt.Errorf("field type of message bitway.tss.SigningStats is not mutable"))
+	case "bitway.tss.SigningStats.requested":
+		panic(fmt.Errorf("field requested of message bitway.tss.SigningStats is not mutable"))
+	case "bitway.tss.SigningStats.signed":
+		panic(fmt.Errorf("field signed of message bitway.tss.SigningStats is not mutable"))
+	case "bitway.tss.SigningStats.timed_out":
+		panic(fmt.Errorf("field timed_out of message bitway.tss.SigningStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.SigningStats.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.SigningStats.type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.SigningStats.requested":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningStats.signed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningStats.timed_out":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.SigningStats.total_latency":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.SigningStats"))
+		}
+		panic(fmt.Errorf("message bitway.tss.SigningStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.SigningStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if x.Requested != 0 {
+			n += 1 + runtime.Sov(uint64(x.Requested))
+		}
+		if x.Signed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Signed))
+		}
+		if x.TimedOut != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimedOut))
+		}
+		if x.TotalLatency != nil {
+			l = options.Size(x.TotalLatency)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalLatency != nil {
+			encoded, err := options.Marshal(x.TotalLatency)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.TimedOut != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimedOut))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Signed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Signed))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Requested != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Requested))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= SigningType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requested", wireType)
+				}
+				x.Requested = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Requested |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
+				}
+				x.Signed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Signed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+				}
+				x.TimedOut = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimedOut |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLatency", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalLatency == nil {
+					x.TotalLatency = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalLatency); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantKey_5_list)(nil)
+
+type _ParticipantKey_5_list struct {
+	list *[]string
+}
+
+func (x *_ParticipantKey_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantKey_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ParticipantKey_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantKey_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantKey_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ParticipantKey at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_ParticipantKey_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantKey_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ParticipantKey_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantKey          protoreflect.MessageDescriptor
+	fd_ParticipantKey_dkg_id   protoreflect.FieldDescriptor
+	fd_ParticipantKey_module   protoreflect.FieldDescriptor
+	fd_ParticipantKey_type     protoreflect.FieldDescriptor
+	fd_ParticipantKey_key_type protoreflect.FieldDescriptor
+	fd_ParticipantKey_pub_keys protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tss_proto_init()
+	md_ParticipantKey = File_bitway_tss_tss_proto.Messages().ByName("ParticipantKey")
+	fd_ParticipantKey_dkg_id = md_ParticipantKey.Fields().ByName("dkg_id")
+	fd_ParticipantKey_module = md_ParticipantKey.Fields().ByName("module")
+	fd_ParticipantKey_type = md_ParticipantKey.Fields().ByName("type")
+	fd_ParticipantKey_key_type = md_ParticipantKey.Fields().ByName("key_type")
+	fd_ParticipantKey_pub_keys = md_ParticipantKey.Fields().ByName("pub_keys")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantKey)(nil)
+
+type fastReflection_ParticipantKey ParticipantKey
+
+func (x *ParticipantKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantKey)(x)
+}
+
+func (x *ParticipantKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tss_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantKey_messageType fastReflection_ParticipantKey_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantKey_messageType{}
+
+type fastReflection_ParticipantKey_messageType struct{}
+
+func (x fastReflection_ParticipantKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantKey)(nil)
+}
+func (x fastReflection_ParticipantKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantKey)
+}
+func (x fastReflection_ParticipantKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantKey) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantKey) New() protoreflect.Message {
+	return new(fastReflection_ParticipantKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantKey) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_ParticipantKey_dkg_id, value) {
+			return
+		}
+	}
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ParticipantKey_module, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_ParticipantKey_type, value) {
+			return
+		}
+	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_ParticipantKey_key_type, value) {
+			return
+		}
+	}
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantKey_5_list{list: &x.PubKeys})
+		if !f(fd_ParticipantKey_pub_keys, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantKey.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.ParticipantKey.module":
+		return x.Module != ""
+	case "bitway.tss.ParticipantKey.type":
+		return x.Type_ != ""
+	case "bitway.tss.ParticipantKey.key_type":
+		return x.KeyType != 0
+	case "bitway.tss.ParticipantKey.pub_keys":
+		return len(x.PubKeys) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantKey.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.ParticipantKey.module":
+		x.Module = ""
+	case "bitway.tss.ParticipantKey.type":
+		x.Type_ = ""
+	case "bitway.tss.ParticipantKey.key_type":
+		x.KeyType = 0
+	case "bitway.tss.ParticipantKey.pub_keys":
+		x.PubKeys = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.ParticipantKey.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.ParticipantKey.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ParticipantKey.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.ParticipantKey.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.ParticipantKey.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantKey_5_list{})
+		}
+		listValue := &_ParticipantKey_5_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantKey.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.ParticipantKey.module":
+		x.Module = value.Interface().(string)
+	case "bitway.tss.ParticipantKey.type":
+		x.Type_ = value.Interface().(string)
+	case "bitway.tss.ParticipantKey.key_type":
+		x.KeyType = (KeyType)(value.Enum())
+	case "bitway.tss.ParticipantKey.pub_keys":
+		lv := value.List()
+		clv := lv.(*_ParticipantKey_5_list)
+		x.PubKeys = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantKey.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_ParticipantKey_5_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.ParticipantKey.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.ParticipantKey is not mutable"))
+	case "bitway.tss.ParticipantKey.module":
+		panic(fmt.Errorf("field module of message bitway.tss.ParticipantKey is not mutable"))
+	case "bitway.tss.ParticipantKey.type":
+		panic(fmt.Errorf("field type of message bitway.tss.ParticipantKey is not mutable"))
+	case "bitway.tss.ParticipantKey.key_type":
+		panic(fmt.Errorf("field key_type of message bitway.tss.ParticipantKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.ParticipantKey.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.ParticipantKey.module":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ParticipantKey.type":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.ParticipantKey.key_type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.ParticipantKey.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ParticipantKey_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.ParticipantKey"))
+		}
+		panic(fmt.Errorf("message bitway.tss.ParticipantKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.ParticipantKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: bitway/tss/tss.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DKG Status
+type DKGStatus int32
+
+const (
+	// DKG_STATUS_UNSPECIFIED defines the unknown DKG request status
+	DKGStatus_DKG_STATUS_UNSPECIFIED DKGStatus = 0
+	// DKG_STATUS_PENDING defines the status of the DKG request which is pending
+	DKGStatus_DKG_STATUS_PENDING DKGStatus = 1
+	// DKG_STATUS_COMPLETED defines the status of the DKG request which is completed
+	DKGStatus_DKG_STATUS_COMPLETED DKGStatus = 2
+	// DKG_STATUS_FAILED defines the status of the DKG request which failed
+	DKGStatus_DKG_STATUS_FAILED DKGStatus = 3
+	// DKG_STATUS_TIMEDOUT defines the status of the DKG request which timed out
+	DKGStatus_DKG_STATUS_TIMEDOUT DKGStatus = 4
+)
+
+// Enum value maps for DKGStatus.
+var (
+	DKGStatus_name = map[int32]string{
+		0: "DKG_STATUS_UNSPECIFIED",
+		1: "DKG_STATUS_PENDING",
+		2: "DKG_STATUS_COMPLETED",
+		3: "DKG_STATUS_FAILED",
+		4: "DKG_STATUS_TIMEDOUT",
+	}
+	DKGStatus_value = map[string]int32{
+		"DKG_STATUS_UNSPECIFIED": 0,
+		"DKG_STATUS_PENDING":     1,
+		"DKG_STATUS_COMPLETED":   2,
+		"DKG_STATUS_FAILED":      3,
+		"DKG_STATUS_TIMEDOUT":    4,
+	}
+)
+
+func (x DKGStatus) Enum() *DKGStatus {
+	p := new(DKGStatus)
+	*p = x
+	return p
+}
+
+func (x DKGStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DKGStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[0].Descriptor()
+}
+
+func (DKGStatus) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[0]
+}
+
+func (x DKGStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DKGStatus.Descriptor instead.
+func (DKGStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{0}
+}
+
+// Key Type
+type KeyType int32
+
+const (
+	// KEY_TYPE_SCHNORR defines the secp256k1 key for BIP-340 schnorr signing, i.e. the x-only pub key
+	KeyType_KEY_TYPE_SCHNORR KeyType = 0
+	// KEY_TYPE_ECDSA defines the secp256k1 key for ECDSA signing, i.e. the compressed pub key
+	KeyType_KEY_TYPE_ECDSA KeyType = 1
+)
+
+// Enum value maps for KeyType.
+var (
+	KeyType_name = map[int32]string{
+		0: "KEY_TYPE_SCHNORR",
+		1: "KEY_TYPE_ECDSA",
+	}
+	KeyType_value = map[string]int32{
+		"KEY_TYPE_SCHNORR": 0,
+		"KEY_TYPE_ECDSA":   1,
+	}
+)
+
+func (x KeyType) Enum() *KeyType {
+	p := new(KeyType)
+	*p = x
+	return p
+}
+
+func (x KeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[1].Descriptor()
+}
+
+func (KeyType) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[1]
+}
+
+func (x KeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyType.Descriptor instead.
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{1}
+}
+
+// Signing Status
+type SigningStatus int32
+
+const (
+	// SIGNING_STATUS_UNSPECIFIED defines the unknown signing status
+	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
+	// SIGNING_STATUS_PENDING defines the status of the signing request which is pending
+	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
+	// SIGNING_STATUS_SIGNED defines the status of the signing request which is signed
+	SigningStatus_SIGNING_STATUS_SIGNED SigningStatus = 2
+	// SIGNING_STATUS_FAILED defines the status of the signing request which failed due to unexpected reasons
+	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 3
+	// SIGNING_STATUS_TIMEDOUT defines the status of the signing request which timed out
+	SigningStatus_SIGNING_STATUS_TIMEDOUT SigningStatus = 4
+)
+
+// Enum value maps for SigningStatus.
+var (
+	SigningStatus_name = map[int32]string{
+		0: "SIGNING_STATUS_UNSPECIFIED",
+		1: "SIGNING_STATUS_PENDING",
+		2: "SIGNING_STATUS_SIGNED",
+		3: "SIGNING_STATUS_FAILED",
+		4: "SIGNING_STATUS_TIMEDOUT",
+	}
+	SigningStatus_value = map[string]int32{
+		"SIGNING_STATUS_UNSPECIFIED": 0,
+		"SIGNING_STATUS_PENDING":     1,
+		"SIGNING_STATUS_SIGNED":      2,
+		"SIGNING_STATUS_FAILED":      3,
+		"SIGNING_STATUS_TIMEDOUT":    4,
+	}
+)
+
+func (x SigningStatus) Enum() *SigningStatus {
+	p := new(SigningStatus)
+	*p = x
+	return p
+}
+
+func (x SigningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[2].Descriptor()
+}
+
+func (SigningStatus) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[2]
+}
+
+func (x SigningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningStatus.Descriptor instead.
+func (SigningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{2}
+}
+
+// Signing Type
+type SigningType int32
+
+const (
+	// SIGNING_TYPE_SCHNORR defines the common schnorr signing
+	SigningType_SIGNING_TYPE_SCHNORR SigningType = 0
+	// SIGNING_TYPE_SCHNORR_WITH_TWEAK defines the schnorr signing with tweak
+	SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK SigningType = 1
+	// SIGNING_TYPE_SCHNORR_WITH_COMMITMENT defines the schnorr signing with commitment
+	SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT SigningType = 2
+	// SIGNING_TYPE_SCHNORR_ADAPTOR defines the schnorr adaptor signing
+	SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR SigningType = 3
+	// SIGNING_TYPE_ECDSA defines the ECDSA signing, e.g. segwit v0
+	SigningType_SIGNING_TYPE_ECDSA SigningType = 4
+)
+
+// Enum value maps for SigningType.
+var (
+	SigningType_name = map[int32]string{
+		0: "SIGNING_TYPE_SCHNORR",
+		1: "SIGNING_TYPE_SCHNORR_WITH_TWEAK",
+		2: "SIGNING_TYPE_SCHNORR_WITH_COMMITMENT",
+		3: "SIGNING_TYPE_SCHNORR_ADAPTOR",
+		4: "SIGNING_TYPE_ECDSA",
+	}
+	SigningType_value = map[string]int32{
+		"SIGNING_TYPE_SCHNORR":                 0,
+		"SIGNING_TYPE_SCHNORR_WITH_TWEAK":      1,
+		"SIGNING_TYPE_SCHNORR_WITH_COMMITMENT": 2,
+		"SIGNING_TYPE_SCHNORR_ADAPTOR":         3,
+		"SIGNING_TYPE_ECDSA":                   4,
+	}
+)
+
+func (x SigningType) Enum() *SigningType {
+	p := new(SigningType)
+	*p = x
+	return p
+}
+
+func (x SigningType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[3].Descriptor()
+}
+
+func (SigningType) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[3]
+}
+
+func (x SigningType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningType.Descriptor instead.
+func (SigningType) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{3}
+}
+
+// Refreshing Status
+type RefreshingStatus int32
+
+const (
+	// REFRESHING_STATUS_UNSPECIFIED defines the unknown refreshing status
+	RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED RefreshingStatus = 0
+	// REFRESHING_STATUS_PENDING defines the status of the refreshing request which is pending
+	RefreshingStatus_REFRESHING_STATUS_PENDING RefreshingStatus = 1
+	// REFRESHING_STATUS_COMPLETED defines the status of the refreshing request which is completed
+	RefreshingStatus_REFRESHING_STATUS_COMPLETED RefreshingStatus = 2
+	// REFRESHING_STATUS_TIMEDOUT defines the status of the refreshing request which timed out
+	RefreshingStatus_REFRESHING_STATUS_TIMEDOUT RefreshingStatus = 3
+)
+
+// Enum value maps for RefreshingStatus.
+var (
+	RefreshingStatus_name = map[int32]string{
+		0: "REFRESHING_STATUS_UNSPECIFIED",
+		1: "REFRESHING_STATUS_PENDING",
+		2: "REFRESHING_STATUS_COMPLETED",
+		3: "REFRESHING_STATUS_TIMEDOUT",
+	}
+	RefreshingStatus_value = map[string]int32{
+		"REFRESHING_STATUS_UNSPECIFIED": 0,
+		"REFRESHING_STATUS_PENDING":     1,
+		"REFRESHING_STATUS_COMPLETED":   2,
+		"REFRESHING_STATUS_TIMEDOUT":    3,
+	}
+)
+
+func (x RefreshingStatus) Enum() *RefreshingStatus {
+	p := new(RefreshingStatus)
+	*p = x
+	return p
+}
+
+func (x RefreshingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[4].Descriptor()
 }
 
 func (RefreshingStatus) Type() protoreflect.EnumType {
-	return &file_bitway_tss_tss_proto_enumTypes[3]
+	return &file_bitway_tss_tss_proto_enumTypes[4]
+}
+
+func (x RefreshingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshingStatus.Descriptor instead.
+func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{4}
+}
+
+// Fault Type
+type FaultType int32
+
+const (
+	// FAULT_TYPE_UNSPECIFIED defines the unknown fault type
+	FaultType_FAULT_TYPE_UNSPECIFIED FaultType = 0
+	// FAULT_TYPE_DKG defines the fault of missing the DKG completion
+	FaultType_FAULT_TYPE_DKG FaultType = 1
+	// FAULT_TYPE_REFRESHING defines the fault of missing the refreshing completion
+	FaultType_FAULT_TYPE_REFRESHING FaultType = 2
+	// FAULT_TYPE_SIGNING defines the fault of missing the signing acknowledgement
+	FaultType_FAULT_TYPE_SIGNING FaultType = 3
+)
+
+// Enum value maps for FaultType.
+var (
+	FaultType_name = map[int32]string{
+		0: "FAULT_TYPE_UNSPECIFIED",
+		1: "FAULT_TYPE_DKG",
+		2: "FAULT_TYPE_REFRESHING",
+		3: "FAULT_TYPE_SIGNING",
+	}
+	FaultType_value = map[string]int32{
+		"FAULT_TYPE_UNSPECIFIED": 0,
+		"FAULT_TYPE_DKG":         1,
+		"FAULT_TYPE_REFRESHING":  2,
+		"FAULT_TYPE_SIGNING":     3,
+	}
+)
+
+func (x FaultType) Enum() *FaultType {
+	p := new(FaultType)
+	*p = x
+	return p
+}
+
+func (x FaultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FaultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[5].Descriptor()
+}
+
+func (FaultType) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[5]
+}
+
+func (x FaultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FaultType.Descriptor instead.
+func (FaultType) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{5}
+}
+
+// Key Rotation Status
+type KeyRotationStatus int32
+
+const (
+	// the current keys are active
+	KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE KeyRotationStatus = 0
+	// the rotation is in progress
+	KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING KeyRotationStatus = 1
+	// the new keys are active and the old keys are to be retired
+	KeyRotationStatus_KEY_ROTATION_STATUS_OVERLAPPING KeyRotationStatus = 2
+)
+
+// Enum value maps for KeyRotationStatus.
+var (
+	KeyRotationStatus_name = map[int32]string{
+		0: "KEY_ROTATION_STATUS_ACTIVE",
+		1: "KEY_ROTATION_STATUS_ROTATING",
+		2: "KEY_ROTATION_STATUS_OVERLAPPING",
+	}
+	KeyRotationStatus_value = map[string]int32{
+		"KEY_ROTATION_STATUS_ACTIVE":      0,
+		"KEY_ROTATION_STATUS_ROTATING":    1,
+		"KEY_ROTATION_STATUS_OVERLAPPING": 2,
+	}
+)
+
+func (x KeyRotationStatus) Enum() *KeyRotationStatus {
+	p := new(KeyRotationStatus)
+	*p = x
+	return p
+}
+
+func (x KeyRotationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyRotationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bitway_tss_tss_proto_enumTypes[6].Descriptor()
+}
+
+func (KeyRotationStatus) Type() protoreflect.EnumType {
+	return &file_bitway_tss_tss_proto_enumTypes[6]
+}
+
+func (x KeyRotationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyRotationStatus.Descriptor instead.
+func (KeyRotationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{6}
+}
+
+// DKG Request
+type DKGRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// dkg type
+	Type_ string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// dkg intent
+	Intent int32 `protobuf:"varint,4,opt,name=intent,proto3" json:"intent,omitempty"`
+	// participant set
+	Participants []string `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold required to perform DKG
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// batch size of keys to be generated
+	BatchSize uint32 `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// expiration time
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// status
+	Status DKGStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bitway.tss.DKGStatus" json:"status,omitempty"`
+	// type of keys to be generated
+	KeyType KeyType `protobuf:"varint,10,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
+}
+
+func (x *DKGRequest) Reset() {
+	*x = DKGRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGRequest) ProtoMessage() {}
+
+// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
+func (*DKGRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DKGRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DKGRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *DKGRequest) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *DKGRequest) GetIntent() int32 {
+	if x != nil {
+		return x.Intent
+	}
+	return 0
+}
+
+func (x *DKGRequest) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *DKGRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DKGRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DKGRequest) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *DKGRequest) GetStatus() DKGStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DKGStatus_DKG_STATUS_UNSPECIFIED
+}
+
+func (x *DKGRequest) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
+// DKG Completion
+type DKGCompletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// public keys generated by DKG
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded schnorr proofs of possession for the public keys
+	Proofs []string `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// hex encoded compressed verification shares of the participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+}
+
+func (x *DKGCompletion) Reset() {
+	*x = DKGCompletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGCompletion) ProtoMessage() {}
+
+// Deprecated: Use DKGCompletion.ProtoReflect.Descriptor instead.
+func (*DKGCompletion) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DKGCompletion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DKGCompletion) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *DKGCompletion) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+func (x *DKGCompletion) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *DKGCompletion) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DKGCompletion) GetProofs() []string {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *DKGCompletion) GetVerificationShares() []string {
+	if x != nil {
+		return x.VerificationShares
+	}
+	return nil
+}
+
+// Signing Options
+type SigningOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// optional tweak
+	Tweak string `protobuf:"bytes,1,opt,name=tweak,proto3" json:"tweak,omitempty"`
+	// optional public nonce, i.e. commitment
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// optional adaptor point
+	AdaptorPoint string `protobuf:"bytes,3,opt,name=adaptor_point,json=adaptorPoint,proto3" json:"adaptor_point,omitempty"`
+	// optional committed nonces consumed from the nonce queue, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
+}
+
+func (x *SigningOptions) Reset() {
+	*x = SigningOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningOptions) ProtoMessage() {}
+
+// Deprecated: Use SigningOptions.ProtoReflect.Descriptor instead.
+func (*SigningOptions) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SigningOptions) GetTweak() string {
+	if x != nil {
+		return x.Tweak
+	}
+	return ""
+}
+
+func (x *SigningOptions) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SigningOptions) GetAdaptorPoint() string {
+	if x != nil {
+		return x.AdaptorPoint
+	}
+	return ""
+}
+
+func (x *SigningOptions) GetNonces() []string {
+	if x != nil {
+		return x.Nonces
+	}
+	return nil
+}
+
+// Signing Request
+type SigningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// module specific id
+	ScopedId string `protobuf:"bytes,3,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
+	// signing type
+	Type_ SigningType `protobuf:"varint,4,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// signing intent
+	Intent int32 `protobuf:"varint,5,opt,name=intent,proto3" json:"intent,omitempty"`
+	// signing pub key
+	PubKey string `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// hashes to be signed
+	SigHashes []string `protobuf:"bytes,7,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	// signing options
+	Options *SigningOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	// creation time
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// status
+	Status SigningStatus `protobuf:"varint,10,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	// expiration time; zero means no timeout
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// id of the timed out signing request which is re-initiated by this request; 0 if none
+	PreviousId uint64 `protobuf:"varint,12,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	// id of the signing batch which the request belongs to; 0 if none
+	BatchId uint64 `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// number of times the original signing request has been re-initiated
+	Retries uint32 `protobuf:"varint,14,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (x *SigningRequest) Reset() {
+	*x = SigningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningRequest) ProtoMessage() {}
+
+// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
+func (*SigningRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SigningRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetScopedId() string {
+	if x != nil {
+		return x.ScopedId
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetType_() SigningType {
+	if x != nil {
+		return x.Type_
+	}
+	return SigningType_SIGNING_TYPE_SCHNORR
+}
+
+func (x *SigningRequest) GetIntent() int32 {
+	if x != nil {
+		return x.Intent
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetSigHashes() []string {
+	if x != nil {
+		return x.SigHashes
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetOptions() *SigningOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetStatus() SigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+}
+
+func (x *SigningRequest) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetPreviousId() uint64 {
+	if x != nil {
+		return x.PreviousId
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetBatchId() uint64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+// Signing Batch Item
+type SigningBatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing type
+	Type_ SigningType `protobuf:"varint,1,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// signing pub key
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// hashes to be signed
+	SigHashes []string `protobuf:"bytes,3,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	// signing options
+	Options *SigningOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// module specific id of the signing request; the scoped id of the batch is used if empty
+	ScopedId string `protobuf:"bytes,5,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
+}
+
+func (x *SigningBatchItem) Reset() {
+	*x = SigningBatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningBatchItem) ProtoMessage() {}
+
+// Deprecated: Use SigningBatchItem.ProtoReflect.Descriptor instead.
+func (*SigningBatchItem) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SigningBatchItem) GetType_() SigningType {
+	if x != nil {
+		return x.Type_
+	}
+	return SigningType_SIGNING_TYPE_SCHNORR
+}
+
+func (x *SigningBatchItem) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *SigningBatchItem) GetSigHashes() []string {
+	if x != nil {
+		return x.SigHashes
+	}
+	return nil
+}
+
+func (x *SigningBatchItem) GetOptions() *SigningOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SigningBatchItem) GetScopedId() string {
+	if x != nil {
+		return x.ScopedId
+	}
+	return ""
+}
+
+// Batch Signatures
+type BatchSignatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing request id
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// hex encoded signatures
+	Signatures []string `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *BatchSignatures) Reset() {
+	*x = BatchSignatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSignatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSignatures) ProtoMessage() {}
+
+// Deprecated: Use BatchSignatures.ProtoReflect.Descriptor instead.
+func (*BatchSignatures) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchSignatures) GetSigningId() uint64 {
+	if x != nil {
+		return x.SigningId
+	}
+	return 0
+}
+
+func (x *BatchSignatures) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// Signing Batch
+type SigningBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// module specific id
+	ScopedId string `protobuf:"bytes,3,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
+	// signing intent
+	Intent int32 `protobuf:"varint,4,opt,name=intent,proto3" json:"intent,omitempty"`
+	// ids of the signing requests in the batch
+	SigningIds []uint64 `protobuf:"varint,5,rep,packed,name=signing_ids,json=signingIds,proto3" json:"signing_ids,omitempty"`
+	// signatures of the signed requests in the batch
+	Signatures []*BatchSignatures `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// creation time
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// status
+	Status SigningStatus `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	// shared expiration time of the signing requests; zero means no timeout
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// id of the timed out batch which is re-initiated by this batch; 0 if none
+	PreviousId uint64 `protobuf:"varint,10,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+}
+
+func (x *SigningBatch) Reset() {
+	*x = SigningBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningBatch) ProtoMessage() {}
+
+// Deprecated: Use SigningBatch.ProtoReflect.Descriptor instead.
+func (*SigningBatch) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SigningBatch) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SigningBatch) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SigningBatch) GetScopedId() string {
+	if x != nil {
+		return x.ScopedId
+	}
+	return ""
+}
+
+func (x *SigningBatch) GetIntent() int32 {
+	if x != nil {
+		return x.Intent
+	}
+	return 0
+}
+
+func (x *SigningBatch) GetSigningIds() []uint64 {
+	if x != nil {
+		return x.SigningIds
+	}
+	return nil
+}
+
+func (x *SigningBatch) GetSignatures() []*BatchSignatures {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *SigningBatch) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *SigningBatch) GetStatus() SigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+}
+
+func (x *SigningBatch) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *SigningBatch) GetPreviousId() uint64 {
+	if x != nil {
+		return x.PreviousId
+	}
+	return 0
+}
+
+// Signing Acknowledgement
+type SigningAcknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SigningAcknowledgement) Reset() {
+	*x = SigningAcknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningAcknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningAcknowledgement) ProtoMessage() {}
+
+// Deprecated: Use SigningAcknowledgement.ProtoReflect.Descriptor instead.
+func (*SigningAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SigningAcknowledgement) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SigningAcknowledgement) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SigningAcknowledgement) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *SigningAcknowledgement) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// Partial Signature
+type PartialSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded public nonces of the participant, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// hex encoded partial signatures, one for each sig hash
+	PartialSignatures []string `protobuf:"bytes,5,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// consensus pub keys of the participants which jointly sign in the same session
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+	// hex encoded aggregated public nonces of the signers, one for each sig hash
+	AggregatedNonces []string `protobuf:"bytes,8,rep,name=aggregated_nonces,json=aggregatedNonces,proto3" json:"aggregated_nonces,omitempty"`
+}
+
+func (x *PartialSignature) Reset() {
+	*x = PartialSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartialSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialSignature) ProtoMessage() {}
+
+// Deprecated: Use PartialSignature.ProtoReflect.Descriptor instead.
+func (*PartialSignature) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PartialSignature) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PartialSignature) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *PartialSignature) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *PartialSignature) GetNonces() []string {
+	if x != nil {
+		return x.Nonces
+	}
+	return nil
+}
+
+func (x *PartialSignature) GetPartialSignatures() []string {
+	if x != nil {
+		return x.PartialSignatures
+	}
+	return nil
+}
+
+func (x *PartialSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
 }
 
-func (x RefreshingStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *PartialSignature) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
 }
 
-// Deprecated: Use RefreshingStatus.Descriptor instead.
-func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{3}
+func (x *PartialSignature) GetAggregatedNonces() []string {
+	if x != nil {
+		return x.AggregatedNonces
+	}
+	return nil
 }
 
-// DKG Request
-type DKGRequest struct {
+// Verification Shares
+type VerificationShares struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DKG request id
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// hex encoded compressed verification shares of the current participants
+	// ordered by the participants for each public key
+	Shares []string `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *VerificationShares) Reset() {
+	*x = VerificationShares{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerificationShares) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationShares) ProtoMessage() {}
+
+// Deprecated: Use VerificationShares.ProtoReflect.Descriptor instead.
+func (*VerificationShares) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerificationShares) GetDkgId() uint64 {
+	if x != nil {
+		return x.DkgId
+	}
+	return 0
+}
+
+func (x *VerificationShares) GetShares() []string {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// Signer Set
+type SignerSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// participants which took part in the signing
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// indicates if the signatures are aggregated on chain from the partial signatures
+	Aggregated bool `protobuf:"varint,3,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
+}
+
+func (x *SignerSet) Reset() {
+	*x = SignerSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignerSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignerSet) ProtoMessage() {}
+
+// Deprecated: Use SignerSet.ProtoReflect.Descriptor instead.
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignerSet) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SignerSet) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *SignerSet) GetAggregated() bool {
+	if x != nil {
+		return x.Aggregated
+	}
+	return false
+}
+
+// Refreshing Request
+type RefreshingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// request id
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// initiator module
-	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	// dkg type
-	Type_ string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	// dkg intent
-	Intent int32 `protobuf:"varint,4,opt,name=intent,proto3" json:"intent,omitempty"`
-	// participant set
-	Participants []string `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
-	// threshold required to perform DKG
-	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// batch size of keys to be generated
-	BatchSize uint32 `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// request id of the DKG corresponding to the key shares to be refreshed
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// removed participant set
+	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
 	// expiration time
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	// status
-	Status DKGStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bitway.tss.DKGStatus" json:"status,omitempty"`
+	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
+	// added participant set
+	AddedParticipants []string `protobuf:"bytes,6,rep,name=added_participants,json=addedParticipants,proto3" json:"added_participants,omitempty"`
+	// threshold after refreshing
+	Threshold uint32 `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (x *DKGRequest) Reset() {
-	*x = DKGRequest{}
+func (x *RefreshingRequest) Reset() {
+	*x = RefreshingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[0]
+		mi := &file_bitway_tss_tss_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshingRequest) ProtoMessage() {}
+
+// Deprecated: Use RefreshingRequest.ProtoReflect.Descriptor instead.
+func (*RefreshingRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefreshingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshingRequest) GetDkgId() uint64 {
+	if x != nil {
+		return x.DkgId
+	}
+	return 0
+}
+
+func (x *RefreshingRequest) GetRemovedParticipants() []string {
+	if x != nil {
+		return x.RemovedParticipants
+	}
+	return nil
+}
+
+func (x *RefreshingRequest) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *RefreshingRequest) GetStatus() RefreshingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+}
+
+func (x *RefreshingRequest) GetAddedParticipants() []string {
+	if x != nil {
+		return x.AddedParticipants
+	}
+	return nil
+}
+
+func (x *RefreshingRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// Refreshing Completion
+type RefreshingCompletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded compressed verification shares of the refreshed participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,5,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+}
+
+func (x *RefreshingCompletion) Reset() {
+	*x = RefreshingCompletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshingCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshingCompletion) ProtoMessage() {}
+
+// Deprecated: Use RefreshingCompletion.ProtoReflect.Descriptor instead.
+func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefreshingCompletion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshingCompletion) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *RefreshingCompletion) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *RefreshingCompletion) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *RefreshingCompletion) GetVerificationShares() []string {
+	if x != nil {
+		return x.VerificationShares
+	}
+	return nil
+}
+
+// Participant Reliability
+type ParticipantReliability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// number of completed DKG rounds
+	CompletedDkgs uint64 `protobuf:"varint,2,opt,name=completed_dkgs,json=completedDkgs,proto3" json:"completed_dkgs,omitempty"`
+	// number of missed DKG rounds
+	MissedDkgs uint64 `protobuf:"varint,3,opt,name=missed_dkgs,json=missedDkgs,proto3" json:"missed_dkgs,omitempty"`
+	// number of completed refreshing rounds
+	CompletedRefreshings uint64 `protobuf:"varint,4,opt,name=completed_refreshings,json=completedRefreshings,proto3" json:"completed_refreshings,omitempty"`
+	// number of missed refreshing rounds
+	MissedRefreshings uint64 `protobuf:"varint,5,opt,name=missed_refreshings,json=missedRefreshings,proto3" json:"missed_refreshings,omitempty"`
+	// number of acknowledged signing rounds
+	AcknowledgedSignings uint64 `protobuf:"varint,6,opt,name=acknowledged_signings,json=acknowledgedSignings,proto3" json:"acknowledged_signings,omitempty"`
+	// number of missed signing rounds
+	MissedSignings uint64 `protobuf:"varint,7,opt,name=missed_signings,json=missedSignings,proto3" json:"missed_signings,omitempty"`
+	// faults accumulated since the last penalty
+	PendingFaults uint64 `protobuf:"varint,8,opt,name=pending_faults,json=pendingFaults,proto3" json:"pending_faults,omitempty"`
+	// number of penalties
+	PenaltyCount uint64 `protobuf:"varint,9,opt,name=penalty_count,json=penaltyCount,proto3" json:"penalty_count,omitempty"`
+	// block height of the last fault
+	LastFaultHeight int64 `protobuf:"varint,10,opt,name=last_fault_height,json=lastFaultHeight,proto3" json:"last_fault_height,omitempty"`
+	// block height of the last penalty
+	LastPenaltyHeight int64 `protobuf:"varint,11,opt,name=last_penalty_height,json=lastPenaltyHeight,proto3" json:"last_penalty_height,omitempty"`
+	// block height of the last completed refreshing round
+	LastRefreshingHeight int64 `protobuf:"varint,12,opt,name=last_refreshing_height,json=lastRefreshingHeight,proto3" json:"last_refreshing_height,omitempty"`
+	// number of signing rounds of which the latency is measured
+	MeasuredSignings uint64 `protobuf:"varint,13,opt,name=measured_signings,json=measuredSignings,proto3" json:"measured_signings,omitempty"`
+	// accumulated latency of the measured signing rounds
+	TotalSigningLatency *durationpb.Duration `protobuf:"bytes,14,opt,name=total_signing_latency,json=totalSigningLatency,proto3" json:"total_signing_latency,omitempty"`
+}
+
+func (x *ParticipantReliability) Reset() {
+	*x = ParticipantReliability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ParticipantReliability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantReliability) ProtoMessage() {}
+
+// Deprecated: Use ParticipantReliability.ProtoReflect.Descriptor instead.
+func (*ParticipantReliability) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ParticipantReliability) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *ParticipantReliability) GetCompletedDkgs() uint64 {
+	if x != nil {
+		return x.CompletedDkgs
+	}
+	return 0
+}
+
+func (x *ParticipantReliability) GetMissedDkgs() uint64 {
+	if x != nil {
+		return x.MissedDkgs
+	}
+	return 0
 }
 
-func (*DKGRequest) ProtoMessage() {}
+func (x *ParticipantReliability) GetCompletedRefreshings() uint64 {
+	if x != nil {
+		return x.CompletedRefreshings
+	}
+	return 0
+}
 
-// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
-func (*DKGRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{0}
+func (x *ParticipantReliability) GetMissedRefreshings() uint64 {
+	if x != nil {
+		return x.MissedRefreshings
+	}
+	return 0
 }
 
-func (x *DKGRequest) GetId() uint64 {
+func (x *ParticipantReliability) GetAcknowledgedSignings() uint64 {
 	if x != nil {
-		return x.Id
+		return x.AcknowledgedSignings
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetModule() string {
+func (x *ParticipantReliability) GetMissedSignings() uint64 {
 	if x != nil {
-		return x.Module
+		return x.MissedSignings
 	}
-	return ""
+	return 0
 }
 
-func (x *DKGRequest) GetType_() string {
+func (x *ParticipantReliability) GetPendingFaults() uint64 {
 	if x != nil {
-		return x.Type_
+		return x.PendingFaults
 	}
-	return ""
+	return 0
 }
 
-func (x *DKGRequest) GetIntent() int32 {
+func (x *ParticipantReliability) GetPenaltyCount() uint64 {
 	if x != nil {
-		return x.Intent
+		return x.PenaltyCount
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetParticipants() []string {
+func (x *ParticipantReliability) GetLastFaultHeight() int64 {
 	if x != nil {
-		return x.Participants
+		return x.LastFaultHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *DKGRequest) GetThreshold() uint32 {
+func (x *ParticipantReliability) GetLastPenaltyHeight() int64 {
 	if x != nil {
-		return x.Threshold
+		return x.LastPenaltyHeight
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetBatchSize() uint32 {
+func (x *ParticipantReliability) GetLastRefreshingHeight() int64 {
 	if x != nil {
-		return x.BatchSize
+		return x.LastRefreshingHeight
 	}
 	return 0
 }
 
-func (x *DKGRequest) GetExpirationTime() *timestamppb.Timestamp {
+func (x *ParticipantReliability) GetMeasuredSignings() uint64 {
 	if x != nil {
-		return x.ExpirationTime
+		return x.MeasuredSignings
 	}
-	return nil
+	return 0
 }
 
-func (x *DKGRequest) GetStatus() DKGStatus {
+func (x *ParticipantReliability) GetTotalSigningLatency() *durationpb.Duration {
 	if x != nil {
-		return x.Status
+		return x.TotalSigningLatency
 	}
-	return DKGStatus_DKG_STATUS_UNSPECIFIED
+	return nil
 }
 
-// DKG Completion
-type DKGCompletion struct {
+// Participant Set
+type ParticipantSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// public keys generated by DKG
-	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// participants
+	Participants []*DKGParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	// block height at which the participant set is updated
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *DKGCompletion) Reset() {
-	*x = DKGCompletion{}
+func (x *ParticipantSet) Reset() {
+	*x = ParticipantSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[1]
+		mi := &file_bitway_tss_tss_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGCompletion) String() string {
+func (x *ParticipantSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGCompletion) ProtoMessage() {}
+func (*ParticipantSet) ProtoMessage() {}
 
-// Deprecated: Use DKGCompletion.ProtoReflect.Descriptor instead.
-func (*DKGCompletion) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ParticipantSet.ProtoReflect.Descriptor instead.
+func (*ParticipantSet) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DKGCompletion) GetId() uint64 {
+func (x *ParticipantSet) GetParticipants() []*DKGParticipant {
 	if x != nil {
-		return x.Id
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *ParticipantSet) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-func (x *DKGCompletion) GetSender() string {
+// Committed Nonce
+type CommittedNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the DKG for which the nonce is committed
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// index in the nonce queue
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded public nonce
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// id of the DKG which generated the nonce
+	GenerationId uint64 `protobuf:"varint,4,opt,name=generation_id,json=generationId,proto3" json:"generation_id,omitempty"`
+}
+
+func (x *CommittedNonce) Reset() {
+	*x = CommittedNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommittedNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommittedNonce) ProtoMessage() {}
+
+// Deprecated: Use CommittedNonce.ProtoReflect.Descriptor instead.
+func (*CommittedNonce) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CommittedNonce) GetDkgId() uint64 {
 	if x != nil {
-		return x.Sender
+		return x.DkgId
 	}
-	return ""
+	return 0
 }
 
-func (x *DKGCompletion) GetPubKeys() []string {
+func (x *CommittedNonce) GetIndex() uint64 {
 	if x != nil {
-		return x.PubKeys
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *DKGCompletion) GetConsensusPubkey() string {
+func (x *CommittedNonce) GetNonce() string {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Nonce
 	}
 	return ""
 }
 
-func (x *DKGCompletion) GetSignature() string {
+func (x *CommittedNonce) GetGenerationId() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.GenerationId
 	}
-	return ""
+	return 0
 }
 
-// Signing Options
-type SigningOptions struct {
+// Signing Fee
+type SigningFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// optional tweak
-	Tweak string `protobuf:"bytes,1,opt,name=tweak,proto3" json:"tweak,omitempty"`
-	// optional public nonce, i.e. commitment
-	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// optional adaptor point
-	AdaptorPoint string `protobuf:"bytes,3,opt,name=adaptor_point,json=adaptorPoint,proto3" json:"adaptor_point,omitempty"`
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address which paid the fee
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// fee escrowed in the module account
+	Fee []*v1beta1.Coin `protobuf:"bytes,3,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *SigningOptions) Reset() {
-	*x = SigningOptions{}
+func (x *SigningFee) Reset() {
+	*x = SigningFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[2]
+		mi := &file_bitway_tss_tss_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SigningOptions) String() string {
+func (x *SigningFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningOptions) ProtoMessage() {}
+func (*SigningFee) ProtoMessage() {}
 
-// Deprecated: Use SigningOptions.ProtoReflect.Descriptor instead.
-func (*SigningOptions) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SigningFee.ProtoReflect.Descriptor instead.
+func (*SigningFee) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SigningOptions) GetTweak() string {
+func (x *SigningFee) GetId() uint64 {
 	if x != nil {
-		return x.Tweak
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *SigningOptions) GetNonce() string {
+func (x *SigningFee) GetPayer() string {
 	if x != nil {
-		return x.Nonce
+		return x.Payer
 	}
 	return ""
 }
 
-func (x *SigningOptions) GetAdaptorPoint() string {
+func (x *SigningFee) GetFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.AdaptorPoint
+		return x.Fee
 	}
-	return ""
+	return nil
 }
 
-// Signing Request
-type SigningRequest struct {
+// Participant Rewards
+type ParticipantRewards struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// initiator module
-	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	// module specific id
-	ScopedId string `protobuf:"bytes,3,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
-	// signing type
-	Type_ SigningType `protobuf:"varint,4,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
-	// signing intent
-	Intent int32 `protobuf:"varint,5,opt,name=intent,proto3" json:"intent,omitempty"`
-	// signing pub key
-	PubKey string `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// hashes to be signed
-	SigHashes []string `protobuf:"bytes,7,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
-	// signing options
-	Options *SigningOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	// creation time
-	CreationTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	// status
-	Status SigningStatus `protobuf:"varint,10,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// accumulated rewards to be claimed
+	Rewards []*v1beta1.Coin `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *SigningRequest) Reset() {
-	*x = SigningRequest{}
+func (x *ParticipantRewards) Reset() {
+	*x = ParticipantRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[3]
+		mi := &file_bitway_tss_tss_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SigningRequest) String() string {
+func (x *ParticipantRewards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningRequest) ProtoMessage() {}
+func (*ParticipantRewards) ProtoMessage() {}
 
-// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
-func (*SigningRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ParticipantRewards.ProtoReflect.Descriptor instead.
+func (*ParticipantRewards) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SigningRequest) GetId() uint64 {
+func (x *ParticipantRewards) GetConsensusPubkey() string {
 	if x != nil {
-		return x.Id
+		return x.ConsensusPubkey
 	}
-	return 0
+	return ""
 }
 
-func (x *SigningRequest) GetModule() string {
+func (x *ParticipantRewards) GetRewards() []*v1beta1.Coin {
 	if x != nil {
-		return x.Module
+		return x.Rewards
 	}
-	return ""
+	return nil
 }
 
-func (x *SigningRequest) GetScopedId() string {
+// Key Rotation
+type KeyRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module which owns the keys
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// key type defined by the module
+	Type_ string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// status
+	Status KeyRotationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=bitway.tss.KeyRotationStatus" json:"status,omitempty"`
+	// time at which the current keys became active
+	LastRotationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_rotation_time,json=lastRotationTime,proto3" json:"last_rotation_time,omitempty"`
+	// time at which the old keys are retired when overlapping
+	RetirementTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retirement_time,json=retirementTime,proto3" json:"retirement_time,omitempty"`
+	// pending refreshing request ids for the refreshing method
+	RefreshingIds []uint64 `protobuf:"varint,6,rep,packed,name=refreshing_ids,json=refreshingIds,proto3" json:"refreshing_ids,omitempty"`
+}
+
+func (x *KeyRotation) Reset() {
+	*x = KeyRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRotation) ProtoMessage() {}
+
+// Deprecated: Use KeyRotation.ProtoReflect.Descriptor instead.
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *KeyRotation) GetModule() string {
 	if x != nil {
-		return x.ScopedId
+		return x.Module
 	}
 	return ""
 }
 
-func (x *SigningRequest) GetType_() SigningType {
+func (x *KeyRotation) GetType_() string {
 	if x != nil {
 		return x.Type_
 	}
-	return SigningType_SIGNING_TYPE_SCHNORR
+	return ""
 }
 
-func (x *SigningRequest) GetIntent() int32 {
+func (x *KeyRotation) GetStatus() KeyRotationStatus {
 	if x != nil {
-		return x.Intent
+		return x.Status
 	}
-	return 0
+	return KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE
 }
 
-func (x *SigningRequest) GetPubKey() string {
+func (x *KeyRotation) GetLastRotationTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PubKey
+		return x.LastRotationTime
 	}
-	return ""
+	return nil
 }
 
-func (x *SigningRequest) GetSigHashes() []string {
+func (x *KeyRotation) GetRetirementTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SigHashes
+		return x.RetirementTime
 	}
 	return nil
 }
 
-func (x *SigningRequest) GetOptions() *SigningOptions {
+func (x *KeyRotation) GetRefreshingIds() []uint64 {
 	if x != nil {
-		return x.Options
+		return x.RefreshingIds
 	}
 	return nil
 }
 
-func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+// Gov Signature Request
+type GovSignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DKG id
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// optional base64 encoded psbt from which the sig hashes are derived
+	Psbt string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// id of the latest signing request
+	SigningId uint64 `protobuf:"varint,4,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// hex encoded signatures; empty until the signing request is completed
+	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *GovSignatureRequest) Reset() {
+	*x = GovSignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tss_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovSignatureRequest) ProtoMessage() {}
+
+// Deprecated: Use GovSignatureRequest.ProtoReflect.Descriptor instead.
+func (*GovSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GovSignatureRequest) GetId() uint64 {
 	if x != nil {
-		return x.CreationTime
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *SigningRequest) GetStatus() SigningStatus {
+func (x *GovSignatureRequest) GetDkgId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.DkgId
 	}
-	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+	return 0
+}
+
+func (x *GovSignatureRequest) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *GovSignatureRequest) GetSigningId() uint64 {
+	if x != nil {
+		return x.SigningId
+	}
+	return 0
+}
+
+func (x *GovSignatureRequest) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
 }
 
-// Refreshing Request
-type RefreshingRequest struct {
+// Signing Stats
+type SigningStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// request id of the DKG corresponding to the key shares to be refreshed
-	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
-	// removed participant set
-	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
-	// expiration time
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	// status
-	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
-}
-
-func (x *RefreshingRequest) Reset() {
-	*x = RefreshingRequest{}
+	// initiator module
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// signing type
+	Type_ SigningType `protobuf:"varint,2,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// number of initiated signing requests
+	Requested uint64 `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	// number of signed requests
+	Signed uint64 `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	// number of timed out requests
+	TimedOut uint64 `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// accumulated latency of the signed requests
+	TotalLatency *durationpb.Duration `protobuf:"bytes,6,opt,name=total_latency,json=totalLatency,proto3" json:"total_latency,omitempty"`
+}
+
+func (x *SigningStats) Reset() {
+	*x = SigningStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[4]
+		mi := &file_bitway_tss_tss_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshingRequest) String() string {
+func (x *SigningStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshingRequest) ProtoMessage() {}
+func (*SigningStats) ProtoMessage() {}
 
-// Deprecated: Use RefreshingRequest.ProtoReflect.Descriptor instead.
-func (*RefreshingRequest) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SigningStats.ProtoReflect.Descriptor instead.
+func (*SigningStats) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RefreshingRequest) GetId() uint64 {
+func (x *SigningStats) GetModule() string {
 	if x != nil {
-		return x.Id
+		return x.Module
 	}
-	return 0
+	return ""
 }
 
-func (x *RefreshingRequest) GetDkgId() uint64 {
+func (x *SigningStats) GetType_() SigningType {
 	if x != nil {
-		return x.DkgId
+		return x.Type_
+	}
+	return SigningType_SIGNING_TYPE_SCHNORR
+}
+
+func (x *SigningStats) GetRequested() uint64 {
+	if x != nil {
+		return x.Requested
 	}
 	return 0
 }
 
-func (x *RefreshingRequest) GetRemovedParticipants() []string {
+func (x *SigningStats) GetSigned() uint64 {
 	if x != nil {
-		return x.RemovedParticipants
+		return x.Signed
 	}
-	return nil
+	return 0
 }
 
-func (x *RefreshingRequest) GetExpirationTime() *timestamppb.Timestamp {
+func (x *SigningStats) GetTimedOut() uint64 {
 	if x != nil {
-		return x.ExpirationTime
+		return x.TimedOut
 	}
-	return nil
+	return 0
 }
 
-func (x *RefreshingRequest) GetStatus() RefreshingStatus {
+func (x *SigningStats) GetTotalLatency() *durationpb.Duration {
 	if x != nil {
-		return x.Status
+		return x.TotalLatency
 	}
-	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+	return nil
 }
 
-// Refreshing Completion
-type RefreshingCompletion struct {
+// Participant Key
+type ParticipantKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// DKG id
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// dkg type
+	Type_ string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// key type
+	KeyType KeyType `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
+	// public keys generated by the DKG
+	PubKeys []string `protobuf:"bytes,5,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
 }
 
-func (x *RefreshingCompletion) Reset() {
-	*x = RefreshingCompletion{}
+func (x *ParticipantKey) Reset() {
+	*x = ParticipantKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tss_proto_msgTypes[5]
+		mi := &file_bitway_tss_tss_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshingCompletion) String() string {
+func (x *ParticipantKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshingCompletion) ProtoMessage() {}
+func (*ParticipantKey) ProtoMessage() {}
 
-// Deprecated: Use RefreshingCompletion.ProtoReflect.Descriptor instead.
-func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ParticipantKey.ProtoReflect.Descriptor instead.
+func (*ParticipantKey) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tss_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RefreshingCompletion) GetId() uint64 {
+func (x *ParticipantKey) GetDkgId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.DkgId
 	}
 	return 0
 }
 
-func (x *RefreshingCompletion) GetSender() string {
+func (x *ParticipantKey) GetModule() string {
 	if x != nil {
-		return x.Sender
+		return x.Module
 	}
 	return ""
 }
 
-func (x *RefreshingCompletion) GetConsensusPubkey() string {
+func (x *ParticipantKey) GetType_() string {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Type_
 	}
 	return ""
 }
 
-func (x *RefreshingCompletion) GetSignature() string {
+func (x *ParticipantKey) GetKeyType() KeyType {
 	if x != nil {
-		return x.Signature
+		return x.KeyType
 	}
-	return ""
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
+func (x *ParticipantKey) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
 }
 
 var File_bitway_tss_tss_proto protoreflect.FileDescriptor
@@ -5273,94 +19171,340 @@ var file_bitway_tss_tss_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x73, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
 	0x73, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x02, 0x0a, 0x0a, 0x44, 0x4b,
-	0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4d, 0x0a,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63,
+	0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xef, 0x02, 0x0a, 0x0a, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0d, 0x44, 0x4b, 0x47, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x0e, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x77,
+	0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xab, 0x04, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x49,
+	0x64, 0x22, 0x50, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0xb7, 0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x49, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a,
 	0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0d,
-	0x44, 0x4b, 0x47, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64, 0x22, 0x89, 0x01,
+	0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
 	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
 	0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x0e, 0x53, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x77, 0x65, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x77, 0x65, 0x61,
-	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x61, 0x70, 0x74,
-	0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x86, 0x03, 0x0a,
-	0x0e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x43, 0x0a,
+	0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x22, 0x55, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x22, 0xbf, 0x02, 0x0a, 0x11, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x64, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65,
-	0x73, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xb1, 0x05, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6b, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x44,
+	0x6b, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x6b,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x44, 0x6b, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x61, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x57, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x6e, 0x0a, 0x0e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
-	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64,
-	0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67,
-	0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
-	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73,
-	0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x2a, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74,
+	0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x78, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b,
+	0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x65, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x22, 0xba, 0x02, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x8f,
+	0x01, 0x0a, 0x13, 0x47, 0x6f, 0x76, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x62,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x73, 0x2a, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x4b, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
 	0x0a, 0x12, 0x44, 0x4b, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
@@ -5369,44 +19513,65 @@ var file_bitway_tss_tss_proto_rawDesc = []byte{
 	0x12, 0x15, 0x0a, 0x11, 0x44, 0x4b, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
 	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x4b, 0x47, 0x5f, 0x53,
 	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x04,
-	0x2a, 0x81, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19,
-	0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47,
-	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x03, 0x2a, 0x98, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x10, 0x00, 0x12, 0x23,
-	0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54, 0x57, 0x45, 0x41,
-	0x4b, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a,
-	0x1c, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43,
-	0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x2a,
-	0x95, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49,
+	0x2a, 0x33, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4b,
+	0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x43,
+	0x44, 0x53, 0x41, 0x10, 0x01, 0x2a, 0x9e, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e, 0x49,
 	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x46, 0x52, 0x45,
-	0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53,
-	0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x46, 0x52, 0x45,
-	0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x42, 0x90, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x42, 0x08, 0x54, 0x73, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69,
-	0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f,
-	0x74, 0x73, 0x73, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2e, 0x54, 0x73, 0x73, 0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c,
-	0x54, 0x73, 0x73, 0xe2, 0x02, 0x16, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x49, 0x47, 0x4e, 0x49,
+	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x49, 0x47,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x44, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0xb0, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x10, 0x00,
+	0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54, 0x57,
+	0x45, 0x41, 0x4b, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x4e, 0x4f, 0x52, 0x52, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x4f, 0x52, 0x10,
+	0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x10, 0x04, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x1d, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x10,
+	0x03, 0x2a, 0x6e, 0x0a, 0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4b, 0x47, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x46,
+	0x52, 0x45, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x03, 0x2a, 0x7a, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x4f,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x4f,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x4f,
+	0x54, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4b, 0x45, 0x59, 0x5f,
+	0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x42, 0x90, 0x01,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x42, 0x08, 0x54, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa,
+	0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x73, 0x73, 0xca, 0x02, 0x0a, 0x42,
+	0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0xe2, 0x02, 0x16, 0x42, 0x69, 0x74, 0x77,
+	0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5421,35 +19586,75 @@ func file_bitway_tss_tss_proto_rawDescGZIP() []byte {
 	return file_bitway_tss_tss_proto_rawDescData
 }
 
-var file_bitway_tss_tss_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_bitway_tss_tss_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_bitway_tss_tss_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_bitway_tss_tss_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_bitway_tss_tss_proto_goTypes = []interface{}{
-	(DKGStatus)(0),                // 0: bitway.tss.DKGStatus
-	(SigningStatus)(0),            // 1: bitway.tss.SigningStatus
-	(SigningType)(0),              // 2: bitway.tss.SigningType
-	(RefreshingStatus)(0),         // 3: bitway.tss.RefreshingStatus
-	(*DKGRequest)(nil),            // 4: bitway.tss.DKGRequest
-	(*DKGCompletion)(nil),         // 5: bitway.tss.DKGCompletion
-	(*SigningOptions)(nil),        // 6: bitway.tss.SigningOptions
-	(*SigningRequest)(nil),        // 7: bitway.tss.SigningRequest
-	(*RefreshingRequest)(nil),     // 8: bitway.tss.RefreshingRequest
-	(*RefreshingCompletion)(nil),  // 9: bitway.tss.RefreshingCompletion
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(DKGStatus)(0),                 // 0: bitway.tss.DKGStatus
+	(KeyType)(0),                   // 1: bitway.tss.KeyType
+	(SigningStatus)(0),             // 2: bitway.tss.SigningStatus
+	(SigningType)(0),               // 3: bitway.tss.SigningType
+	(RefreshingStatus)(0),          // 4: bitway.tss.RefreshingStatus
+	(FaultType)(0),                 // 5: bitway.tss.FaultType
+	(KeyRotationStatus)(0),         // 6: bitway.tss.KeyRotationStatus
+	(*DKGRequest)(nil),             // 7: bitway.tss.DKGRequest
+	(*DKGCompletion)(nil),          // 8: bitway.tss.DKGCompletion
+	(*SigningOptions)(nil),         // 9: bitway.tss.SigningOptions
+	(*SigningRequest)(nil),         // 10: bitway.tss.SigningRequest
+	(*SigningBatchItem)(nil),       // 11: bitway.tss.SigningBatchItem
+	(*BatchSignatures)(nil),        // 12: bitway.tss.BatchSignatures
+	(*SigningBatch)(nil),           // 13: bitway.tss.SigningBatch
+	(*SigningAcknowledgement)(nil), // 14: bitway.tss.SigningAcknowledgement
+	(*PartialSignature)(nil),       // 15: bitway.tss.PartialSignature
+	(*VerificationShares)(nil),     // 16: bitway.tss.VerificationShares
+	(*SignerSet)(nil),              // 17: bitway.tss.SignerSet
+	(*RefreshingRequest)(nil),      // 18: bitway.tss.RefreshingRequest
+	(*RefreshingCompletion)(nil),   // 19: bitway.tss.RefreshingCompletion
+	(*ParticipantReliability)(nil), // 20: bitway.tss.ParticipantReliability
+	(*ParticipantSet)(nil),         // 21: bitway.tss.ParticipantSet
+	(*CommittedNonce)(nil),         // 22: bitway.tss.CommittedNonce
+	(*SigningFee)(nil),             // 23: bitway.tss.SigningFee
+	(*ParticipantRewards)(nil),     // 24: bitway.tss.ParticipantRewards
+	(*KeyRotation)(nil),            // 25: bitway.tss.KeyRotation
+	(*GovSignatureRequest)(nil),    // 26: bitway.tss.GovSignatureRequest
+	(*SigningStats)(nil),           // 27: bitway.tss.SigningStats
+	(*ParticipantKey)(nil),         // 28: bitway.tss.ParticipantKey
+	(*timestamppb.Timestamp)(nil),  // 29: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 30: google.protobuf.Duration
+	(*DKGParticipant)(nil),         // 31: bitway.tss.DKGParticipant
+	(*v1beta1.Coin)(nil),           // 32: cosmos.base.v1beta1.Coin
 }
 var file_bitway_tss_tss_proto_depIdxs = []int32{
-	10, // 0: bitway.tss.DKGRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	29, // 0: bitway.tss.DKGRequest.expiration_time:type_name -> google.protobuf.Timestamp
 	0,  // 1: bitway.tss.DKGRequest.status:type_name -> bitway.tss.DKGStatus
-	2,  // 2: bitway.tss.SigningRequest.type:type_name -> bitway.tss.SigningType
-	6,  // 3: bitway.tss.SigningRequest.options:type_name -> bitway.tss.SigningOptions
-	10, // 4: bitway.tss.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
-	1,  // 5: bitway.tss.SigningRequest.status:type_name -> bitway.tss.SigningStatus
-	10, // 6: bitway.tss.RefreshingRequest.expiration_time:type_name -> google.protobuf.Timestamp
-	3,  // 7: bitway.tss.RefreshingRequest.status:type_name -> bitway.tss.RefreshingStatus
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	1,  // 2: bitway.tss.DKGRequest.key_type:type_name -> bitway.tss.KeyType
+	3,  // 3: bitway.tss.SigningRequest.type:type_name -> bitway.tss.SigningType
+	9,  // 4: bitway.tss.SigningRequest.options:type_name -> bitway.tss.SigningOptions
+	29, // 5: bitway.tss.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
+	2,  // 6: bitway.tss.SigningRequest.status:type_name -> bitway.tss.SigningStatus
+	29, // 7: bitway.tss.SigningRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	3,  // 8: bitway.tss.SigningBatchItem.type:type_name -> bitway.tss.SigningType
+	9,  // 9: bitway.tss.SigningBatchItem.options:type_name -> bitway.tss.SigningOptions
+	12, // 10: bitway.tss.SigningBatch.signatures:type_name -> bitway.tss.BatchSignatures
+	29, // 11: bitway.tss.SigningBatch.creation_time:type_name -> google.protobuf.Timestamp
+	2,  // 12: bitway.tss.SigningBatch.status:type_name -> bitway.tss.SigningStatus
+	29, // 13: bitway.tss.SigningBatch.expiration_time:type_name -> google.protobuf.Timestamp
+	29, // 14: bitway.tss.RefreshingRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	4,  // 15: bitway.tss.RefreshingRequest.status:type_name -> bitway.tss.RefreshingStatus
+	30, // 16: bitway.tss.ParticipantReliability.total_signing_latency:type_name -> google.protobuf.Duration
+	31, // 17: bitway.tss.ParticipantSet.participants:type_name -> bitway.tss.DKGParticipant
+	32, // 18: bitway.tss.SigningFee.fee:type_name -> cosmos.base.v1beta1.Coin
+	32, // 19: bitway.tss.ParticipantRewards.rewards:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 20: bitway.tss.KeyRotation.status:type_name -> bitway.tss.KeyRotationStatus
+	29, // 21: bitway.tss.KeyRotation.last_rotation_time:type_name -> google.protobuf.Timestamp
+	29, // 22: bitway.tss.KeyRotation.retirement_time:type_name -> google.protobuf.Timestamp
+	3,  // 23: bitway.tss.SigningStats.type:type_name -> bitway.tss.SigningType
+	30, // 24: bitway.tss.SigningStats.total_latency:type_name -> google.protobuf.Duration
+	1,  // 25: bitway.tss.ParticipantKey.key_type:type_name -> bitway.tss.KeyType
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_bitway_tss_tss_proto_init() }
@@ -5457,6 +19662,7 @@ func file_bitway_tss_tss_proto_init() {
 	if File_bitway_tss_tss_proto != nil {
 		return
 	}
+	file_bitway_tss_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_bitway_tss_tss_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DKGRequest); i {
@@ -5507,7 +19713,7 @@ func file_bitway_tss_tss_proto_init() {
 			}
 		}
 		file_bitway_tss_tss_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshingRequest); i {
+			switch v := v.(*SigningBatchItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5519,6 +19725,90 @@ func file_bitway_tss_tss_proto_init() {
 			}
 		}
 		file_bitway_tss_tss_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSignatures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningAcknowledgement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartialSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationShares); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignerSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshingCompletion); i {
 			case 0:
 				return &v.state
@@ -5530,14 +19820,122 @@ func file_bitway_tss_tss_proto_init() {
 				return nil
 			}
 		}
+		file_bitway_tss_tss_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantReliability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommittedNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovSignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tss_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bitway_tss_tss_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   6,
+			NumEnums:      7,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
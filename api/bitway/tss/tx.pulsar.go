@@ -2,6 +2,7 @@
 package tss
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -61,13 +62,107 @@ func (x *_MsgCompleteDKG_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgCompleteDKG_6_list)(nil)
+
+type _MsgCompleteDKG_6_list struct {
+	list *[]string
+}
+
+func (x *_MsgCompleteDKG_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCompleteDKG_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCompleteDKG_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCompleteDKG_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCompleteDKG_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCompleteDKG at list field Proofs as it is not of Message kind"))
+}
+
+func (x *_MsgCompleteDKG_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCompleteDKG_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCompleteDKG_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCompleteDKG_7_list)(nil)
+
+type _MsgCompleteDKG_7_list struct {
+	list *[]string
+}
+
+func (x *_MsgCompleteDKG_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCompleteDKG_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCompleteDKG_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCompleteDKG_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCompleteDKG_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCompleteDKG at list field VerificationShares as it is not of Message kind"))
+}
+
+func (x *_MsgCompleteDKG_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCompleteDKG_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCompleteDKG_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCompleteDKG                  protoreflect.MessageDescriptor
-	fd_MsgCompleteDKG_sender           protoreflect.FieldDescriptor
-	fd_MsgCompleteDKG_id               protoreflect.FieldDescriptor
-	fd_MsgCompleteDKG_pub_keys         protoreflect.FieldDescriptor
-	fd_MsgCompleteDKG_consensus_pubkey protoreflect.FieldDescriptor
-	fd_MsgCompleteDKG_signature        protoreflect.FieldDescriptor
+	md_MsgCompleteDKG                     protoreflect.MessageDescriptor
+	fd_MsgCompleteDKG_sender              protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_id                  protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_pub_keys            protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_consensus_pubkey    protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_signature           protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_proofs              protoreflect.FieldDescriptor
+	fd_MsgCompleteDKG_verification_shares protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -78,6 +173,8 @@ func init() {
 	fd_MsgCompleteDKG_pub_keys = md_MsgCompleteDKG.Fields().ByName("pub_keys")
 	fd_MsgCompleteDKG_consensus_pubkey = md_MsgCompleteDKG.Fields().ByName("consensus_pubkey")
 	fd_MsgCompleteDKG_signature = md_MsgCompleteDKG.Fields().ByName("signature")
+	fd_MsgCompleteDKG_proofs = md_MsgCompleteDKG.Fields().ByName("proofs")
+	fd_MsgCompleteDKG_verification_shares = md_MsgCompleteDKG.Fields().ByName("verification_shares")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCompleteDKG)(nil)
@@ -175,6 +272,18 @@ func (x *fastReflection_MsgCompleteDKG) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCompleteDKG_6_list{list: &x.Proofs})
+		if !f(fd_MsgCompleteDKG_proofs, value) {
+			return
+		}
+	}
+	if len(x.VerificationShares) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCompleteDKG_7_list{list: &x.VerificationShares})
+		if !f(fd_MsgCompleteDKG_verification_shares, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -200,6 +309,10 @@ func (x *fastReflection_MsgCompleteDKG) Has(fd protoreflect.FieldDescriptor) boo
 		return x.ConsensusPubkey != ""
 	case "bitway.tss.MsgCompleteDKG.signature":
 		return x.Signature != ""
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		return len(x.Proofs) != 0
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		return len(x.VerificationShares) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteDKG"))
@@ -226,6 +339,10 @@ func (x *fastReflection_MsgCompleteDKG) Clear(fd protoreflect.FieldDescriptor) {
 		x.ConsensusPubkey = ""
 	case "bitway.tss.MsgCompleteDKG.signature":
 		x.Signature = ""
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		x.Proofs = nil
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		x.VerificationShares = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteDKG"))
@@ -260,6 +377,18 @@ func (x *fastReflection_MsgCompleteDKG) Get(descriptor protoreflect.FieldDescrip
 	case "bitway.tss.MsgCompleteDKG.signature":
 		value := x.Signature
 		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_MsgCompleteDKG_6_list{})
+		}
+		listValue := &_MsgCompleteDKG_6_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		if len(x.VerificationShares) == 0 {
+			return protoreflect.ValueOfList(&_MsgCompleteDKG_7_list{})
+		}
+		listValue := &_MsgCompleteDKG_7_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteDKG"))
@@ -292,6 +421,14 @@ func (x *fastReflection_MsgCompleteDKG) Set(fd protoreflect.FieldDescriptor, val
 		x.ConsensusPubkey = value.Interface().(string)
 	case "bitway.tss.MsgCompleteDKG.signature":
 		x.Signature = value.Interface().(string)
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		lv := value.List()
+		clv := lv.(*_MsgCompleteDKG_6_list)
+		x.Proofs = *clv.list
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		lv := value.List()
+		clv := lv.(*_MsgCompleteDKG_7_list)
+		x.VerificationShares = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteDKG"))
@@ -318,6 +455,18 @@ func (x *fastReflection_MsgCompleteDKG) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgCompleteDKG_3_list{list: &x.PubKeys}
 		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []string{}
+		}
+		value := &_MsgCompleteDKG_6_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		if x.VerificationShares == nil {
+			x.VerificationShares = []string{}
+		}
+		value := &_MsgCompleteDKG_7_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(value)
 	case "bitway.tss.MsgCompleteDKG.sender":
 		panic(fmt.Errorf("field sender of message bitway.tss.MsgCompleteDKG is not mutable"))
 	case "bitway.tss.MsgCompleteDKG.id":
@@ -350,6 +499,12 @@ func (x *fastReflection_MsgCompleteDKG) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "bitway.tss.MsgCompleteDKG.signature":
 		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgCompleteDKG.proofs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCompleteDKG_6_list{list: &list})
+	case "bitway.tss.MsgCompleteDKG.verification_shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCompleteDKG_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteDKG"))
@@ -440,6 +595,18 @@ func (x *fastReflection_MsgCompleteDKG) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Proofs) > 0 {
+			for _, s := range x.Proofs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerificationShares) > 0 {
+			for _, s := range x.VerificationShares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -469,6 +636,24 @@ func (x *fastReflection_MsgCompleteDKG) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VerificationShares) > 0 {
+			for iNdEx := len(x.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerificationShares[iNdEx])
+				copy(dAtA[i:], x.VerificationShares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationShares[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proofs[iNdEx])
+				copy(dAtA[i:], x.Proofs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proofs[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.Signature) > 0 {
 			i -= len(x.Signature)
 			copy(dAtA[i:], x.Signature)
@@ -700,6 +885,70 @@ func (x *fastReflection_MsgCompleteDKG) ProtoMethods() *protoiface.Methods {
 				}
 				x.Signature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proofs = append(x.Proofs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShares = append(x.VerificationShares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2039,124 +2288,81 @@ func (x *fastReflection_MsgSubmitSignaturesResponse) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_MsgRefresh_2_list)(nil)
+var _ protoreflect.List = (*_MsgSubmitBatchSignatures_3_list)(nil)
 
-type _MsgRefresh_2_list struct {
-	list *[]uint64
+type _MsgSubmitBatchSignatures_3_list struct {
+	list *[]*BatchSignatures
 }
 
-func (x *_MsgRefresh_2_list) Len() int {
+func (x *_MsgSubmitBatchSignatures_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgRefresh_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
+func (x *_MsgSubmitBatchSignatures_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgRefresh_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_MsgSubmitBatchSignatures_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BatchSignatures)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgRefresh_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_MsgSubmitBatchSignatures_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BatchSignatures)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgRefresh_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgRefresh at list field DkgIds as it is not of Message kind"))
-}
-
-func (x *_MsgRefresh_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgRefresh_2_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_MsgRefresh_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgRefresh_3_list)(nil)
-
-type _MsgRefresh_3_list struct {
-	list *[]string
+func (x *_MsgSubmitBatchSignatures_3_list) AppendMutable() protoreflect.Value {
+	v := new(BatchSignatures)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgRefresh_3_list) Len() int {
-	if x.list == nil {
-		return 0
+func (x *_MsgSubmitBatchSignatures_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return len(*x.list)
-}
-
-func (x *_MsgRefresh_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgRefresh_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgRefresh_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgRefresh_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgRefresh at list field RemovedParticipants as it is not of Message kind"))
-}
-
-func (x *_MsgRefresh_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgRefresh_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgSubmitBatchSignatures_3_list) NewElement() protoreflect.Value {
+	v := new(BatchSignatures)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgRefresh_3_list) IsValid() bool {
+func (x *_MsgSubmitBatchSignatures_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgRefresh                      protoreflect.MessageDescriptor
-	fd_MsgRefresh_authority            protoreflect.FieldDescriptor
-	fd_MsgRefresh_dkg_ids              protoreflect.FieldDescriptor
-	fd_MsgRefresh_removed_participants protoreflect.FieldDescriptor
-	fd_MsgRefresh_timeout_duration     protoreflect.FieldDescriptor
+	md_MsgSubmitBatchSignatures            protoreflect.MessageDescriptor
+	fd_MsgSubmitBatchSignatures_sender     protoreflect.FieldDescriptor
+	fd_MsgSubmitBatchSignatures_id         protoreflect.FieldDescriptor
+	fd_MsgSubmitBatchSignatures_signatures protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgRefresh = File_bitway_tss_tx_proto.Messages().ByName("MsgRefresh")
-	fd_MsgRefresh_authority = md_MsgRefresh.Fields().ByName("authority")
-	fd_MsgRefresh_dkg_ids = md_MsgRefresh.Fields().ByName("dkg_ids")
-	fd_MsgRefresh_removed_participants = md_MsgRefresh.Fields().ByName("removed_participants")
-	fd_MsgRefresh_timeout_duration = md_MsgRefresh.Fields().ByName("timeout_duration")
+	md_MsgSubmitBatchSignatures = File_bitway_tss_tx_proto.Messages().ByName("MsgSubmitBatchSignatures")
+	fd_MsgSubmitBatchSignatures_sender = md_MsgSubmitBatchSignatures.Fields().ByName("sender")
+	fd_MsgSubmitBatchSignatures_id = md_MsgSubmitBatchSignatures.Fields().ByName("id")
+	fd_MsgSubmitBatchSignatures_signatures = md_MsgSubmitBatchSignatures.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRefresh)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitBatchSignatures)(nil)
 
-type fastReflection_MsgRefresh MsgRefresh
+type fastReflection_MsgSubmitBatchSignatures MsgSubmitBatchSignatures
 
-func (x *MsgRefresh) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRefresh)(x)
+func (x *MsgSubmitBatchSignatures) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitBatchSignatures)(x)
 }
 
-func (x *MsgRefresh) slowProtoReflect() protoreflect.Message {
+func (x *MsgSubmitBatchSignatures) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2168,43 +2374,43 @@ func (x *MsgRefresh) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRefresh_messageType fastReflection_MsgRefresh_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRefresh_messageType{}
+var _fastReflection_MsgSubmitBatchSignatures_messageType fastReflection_MsgSubmitBatchSignatures_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitBatchSignatures_messageType{}
 
-type fastReflection_MsgRefresh_messageType struct{}
+type fastReflection_MsgSubmitBatchSignatures_messageType struct{}
 
-func (x fastReflection_MsgRefresh_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRefresh)(nil)
+func (x fastReflection_MsgSubmitBatchSignatures_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitBatchSignatures)(nil)
 }
-func (x fastReflection_MsgRefresh_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRefresh)
+func (x fastReflection_MsgSubmitBatchSignatures_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitBatchSignatures)
 }
-func (x fastReflection_MsgRefresh_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRefresh
+func (x fastReflection_MsgSubmitBatchSignatures_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitBatchSignatures
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRefresh) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRefresh
+func (x *fastReflection_MsgSubmitBatchSignatures) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitBatchSignatures
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRefresh) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRefresh_messageType
+func (x *fastReflection_MsgSubmitBatchSignatures) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitBatchSignatures_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRefresh) New() protoreflect.Message {
-	return new(fastReflection_MsgRefresh)
+func (x *fastReflection_MsgSubmitBatchSignatures) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitBatchSignatures)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRefresh) Interface() protoreflect.ProtoMessage {
-	return (*MsgRefresh)(x)
+func (x *fastReflection_MsgSubmitBatchSignatures) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitBatchSignatures)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2212,28 +2418,22 @@ func (x *fastReflection_MsgRefresh) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRefresh) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgRefresh_authority, value) {
-			return
-		}
-	}
-	if len(x.DkgIds) != 0 {
-		value := protoreflect.ValueOfList(&_MsgRefresh_2_list{list: &x.DkgIds})
-		if !f(fd_MsgRefresh_dkg_ids, value) {
+func (x *fastReflection_MsgSubmitBatchSignatures) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgSubmitBatchSignatures_sender, value) {
 			return
 		}
 	}
-	if len(x.RemovedParticipants) != 0 {
-		value := protoreflect.ValueOfList(&_MsgRefresh_3_list{list: &x.RemovedParticipants})
-		if !f(fd_MsgRefresh_removed_participants, value) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgSubmitBatchSignatures_id, value) {
 			return
 		}
 	}
-	if x.TimeoutDuration != nil {
-		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
-		if !f(fd_MsgRefresh_timeout_duration, value) {
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitBatchSignatures_3_list{list: &x.Signatures})
+		if !f(fd_MsgSubmitBatchSignatures_signatures, value) {
 			return
 		}
 	}
@@ -2250,21 +2450,19 @@ func (x *fastReflection_MsgRefresh) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRefresh) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitBatchSignatures) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.tss.MsgRefresh.authority":
-		return x.Authority != ""
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		return len(x.DkgIds) != 0
-	case "bitway.tss.MsgRefresh.removed_participants":
-		return len(x.RemovedParticipants) != 0
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		return x.TimeoutDuration != nil
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
+		return x.Sender != ""
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2274,21 +2472,19 @@ func (x *fastReflection_MsgRefresh) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefresh) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitBatchSignatures) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgRefresh.authority":
-		x.Authority = ""
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		x.DkgIds = nil
-	case "bitway.tss.MsgRefresh.removed_participants":
-		x.RemovedParticipants = nil
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		x.TimeoutDuration = nil
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
+		x.Sender = ""
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		x.Id = uint64(0)
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2298,31 +2494,25 @@ func (x *fastReflection_MsgRefresh) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRefresh) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignatures) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.MsgRefresh.authority":
-		value := x.Authority
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		if len(x.DkgIds) == 0 {
-			return protoreflect.ValueOfList(&_MsgRefresh_2_list{})
-		}
-		listValue := &_MsgRefresh_2_list{list: &x.DkgIds}
-		return protoreflect.ValueOfList(listValue)
-	case "bitway.tss.MsgRefresh.removed_participants":
-		if len(x.RemovedParticipants) == 0 {
-			return protoreflect.ValueOfList(&_MsgRefresh_3_list{})
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitBatchSignatures_3_list{})
 		}
-		listValue := &_MsgRefresh_3_list{list: &x.RemovedParticipants}
+		listValue := &_MsgSubmitBatchSignatures_3_list{list: &x.Signatures}
 		return protoreflect.ValueOfList(listValue)
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		value := x.TimeoutDuration
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2336,25 +2526,21 @@ func (x *fastReflection_MsgRefresh) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefresh) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitBatchSignatures) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgRefresh.authority":
-		x.Authority = value.Interface().(string)
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		lv := value.List()
-		clv := lv.(*_MsgRefresh_2_list)
-		x.DkgIds = *clv.list
-	case "bitway.tss.MsgRefresh.removed_participants":
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		x.Id = value.Uint()
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
 		lv := value.List()
-		clv := lv.(*_MsgRefresh_3_list)
-		x.RemovedParticipants = *clv.list
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+		clv := lv.(*_MsgSubmitBatchSignatures_3_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2368,66 +2554,53 @@ func (x *fastReflection_MsgRefresh) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefresh) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignatures) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		if x.DkgIds == nil {
-			x.DkgIds = []uint64{}
-		}
-		value := &_MsgRefresh_2_list{list: &x.DkgIds}
-		return protoreflect.ValueOfList(value)
-	case "bitway.tss.MsgRefresh.removed_participants":
-		if x.RemovedParticipants == nil {
-			x.RemovedParticipants = []string{}
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*BatchSignatures{}
 		}
-		value := &_MsgRefresh_3_list{list: &x.RemovedParticipants}
+		value := &_MsgSubmitBatchSignatures_3_list{list: &x.Signatures}
 		return protoreflect.ValueOfList(value)
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		if x.TimeoutDuration == nil {
-			x.TimeoutDuration = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
-	case "bitway.tss.MsgRefresh.authority":
-		panic(fmt.Errorf("field authority of message bitway.tss.MsgRefresh is not mutable"))
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.MsgSubmitBatchSignatures is not mutable"))
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgSubmitBatchSignatures is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRefresh) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignatures) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgRefresh.authority":
+	case "bitway.tss.MsgSubmitBatchSignatures.sender":
 		return protoreflect.ValueOfString("")
-	case "bitway.tss.MsgRefresh.dkg_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_MsgRefresh_2_list{list: &list})
-	case "bitway.tss.MsgRefresh.removed_participants":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgRefresh_3_list{list: &list})
-	case "bitway.tss.MsgRefresh.timeout_duration":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.MsgSubmitBatchSignatures.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.MsgSubmitBatchSignatures.signatures":
+		list := []*BatchSignatures{}
+		return protoreflect.ValueOfList(&_MsgSubmitBatchSignatures_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRefresh) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitBatchSignatures) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRefresh", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgSubmitBatchSignatures", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2435,7 +2608,7 @@ func (x *fastReflection_MsgRefresh) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRefresh) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitBatchSignatures) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2446,7 +2619,7 @@ func (x *fastReflection_MsgRefresh) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefresh) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitBatchSignatures) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2458,7 +2631,7 @@ func (x *fastReflection_MsgRefresh) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRefresh) IsValid() bool {
+func (x *fastReflection_MsgSubmitBatchSignatures) IsValid() bool {
 	return x != nil
 }
 
@@ -2468,9 +2641,9 @@ func (x *fastReflection_MsgRefresh) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitBatchSignatures) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRefresh)
+		x := input.Message.Interface().(*MsgSubmitBatchSignatures)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2482,27 +2655,19 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.DkgIds) > 0 {
-			l = 0
-			for _, e := range x.DkgIds {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		if len(x.RemovedParticipants) > 0 {
-			for _, s := range x.RemovedParticipants {
-				l = len(s)
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.TimeoutDuration != nil {
-			l = options.Size(x.TimeoutDuration)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2513,7 +2678,7 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRefresh)
+		x := input.Message.Interface().(*MsgSubmitBatchSignatures)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2532,53 +2697,31 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TimeoutDuration != nil {
-			encoded, err := options.Marshal(x.TimeoutDuration)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.RemovedParticipants) > 0 {
-			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RemovedParticipants[iNdEx])
-				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0x1a
 			}
 		}
-		if len(x.DkgIds) > 0 {
-			var pksize2 int
-			for _, num := range x.DkgIds {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.DkgIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2593,7 +2736,7 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRefresh)
+		x := input.Message.Interface().(*MsgSubmitBatchSignatures)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2625,15 +2768,15 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefresh: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitBatchSignatures: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitBatchSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2661,119 +2804,30 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.DkgIds = append(x.DkgIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.DkgIds) == 0 {
-						x.DkgIds = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.DkgIds = append(x.DkgIds, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgIds", wireType)
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2800,10 +2854,8 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TimeoutDuration == nil {
-					x.TimeoutDuration = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
+				x.Signatures = append(x.Signatures, &BatchSignatures{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2843,23 +2895,23 @@ func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRefreshResponse protoreflect.MessageDescriptor
+	md_MsgSubmitBatchSignaturesResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgRefreshResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgRefreshResponse")
+	md_MsgSubmitBatchSignaturesResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgSubmitBatchSignaturesResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRefreshResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitBatchSignaturesResponse)(nil)
 
-type fastReflection_MsgRefreshResponse MsgRefreshResponse
+type fastReflection_MsgSubmitBatchSignaturesResponse MsgSubmitBatchSignaturesResponse
 
-func (x *MsgRefreshResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRefreshResponse)(x)
+func (x *MsgSubmitBatchSignaturesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitBatchSignaturesResponse)(x)
 }
 
-func (x *MsgRefreshResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSubmitBatchSignaturesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2871,43 +2923,43 @@ func (x *MsgRefreshResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRefreshResponse_messageType fastReflection_MsgRefreshResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRefreshResponse_messageType{}
+var _fastReflection_MsgSubmitBatchSignaturesResponse_messageType fastReflection_MsgSubmitBatchSignaturesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitBatchSignaturesResponse_messageType{}
 
-type fastReflection_MsgRefreshResponse_messageType struct{}
+type fastReflection_MsgSubmitBatchSignaturesResponse_messageType struct{}
 
-func (x fastReflection_MsgRefreshResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRefreshResponse)(nil)
+func (x fastReflection_MsgSubmitBatchSignaturesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitBatchSignaturesResponse)(nil)
 }
-func (x fastReflection_MsgRefreshResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRefreshResponse)
+func (x fastReflection_MsgSubmitBatchSignaturesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitBatchSignaturesResponse)
 }
-func (x fastReflection_MsgRefreshResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRefreshResponse
+func (x fastReflection_MsgSubmitBatchSignaturesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitBatchSignaturesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRefreshResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRefreshResponse
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitBatchSignaturesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRefreshResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRefreshResponse_messageType
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitBatchSignaturesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRefreshResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRefreshResponse)
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitBatchSignaturesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRefreshResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRefreshResponse)(x)
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitBatchSignaturesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2915,7 +2967,7 @@ func (x *fastReflection_MsgRefreshResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRefreshResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2929,13 +2981,13 @@ func (x *fastReflection_MsgRefreshResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRefreshResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2945,13 +2997,13 @@ func (x *fastReflection_MsgRefreshResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefreshResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2961,13 +3013,13 @@ func (x *fastReflection_MsgRefreshResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRefreshResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2981,13 +3033,13 @@ func (x *fastReflection_MsgRefreshResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefreshResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3001,36 +3053,36 @@ func (x *fastReflection_MsgRefreshResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefreshResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRefreshResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitBatchSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitBatchSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRefreshResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRefreshResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgSubmitBatchSignaturesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3038,7 +3090,7 @@ func (x *fastReflection_MsgRefreshResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRefreshResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3049,7 +3101,7 @@ func (x *fastReflection_MsgRefreshResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRefreshResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3061,7 +3113,7 @@ func (x *fastReflection_MsgRefreshResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRefreshResponse) IsValid() bool {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3071,9 +3123,9 @@ func (x *fastReflection_MsgRefreshResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitBatchSignaturesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRefreshResponse)
+		x := input.Message.Interface().(*MsgSubmitBatchSignaturesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3095,7 +3147,7 @@ func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRefreshResponse)
+		x := input.Message.Interface().(*MsgSubmitBatchSignaturesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3125,7 +3177,7 @@ func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRefreshResponse)
+		x := input.Message.Interface().(*MsgSubmitBatchSignaturesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3157,10 +3209,10 @@ func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefreshResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitBatchSignaturesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitBatchSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3199,31 +3251,31 @@ func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgCompleteRefreshing                  protoreflect.MessageDescriptor
-	fd_MsgCompleteRefreshing_sender           protoreflect.FieldDescriptor
-	fd_MsgCompleteRefreshing_id               protoreflect.FieldDescriptor
-	fd_MsgCompleteRefreshing_consensus_pubkey protoreflect.FieldDescriptor
-	fd_MsgCompleteRefreshing_signature        protoreflect.FieldDescriptor
+	md_MsgAcknowledgeSigning                  protoreflect.MessageDescriptor
+	fd_MsgAcknowledgeSigning_sender           protoreflect.FieldDescriptor
+	fd_MsgAcknowledgeSigning_id               protoreflect.FieldDescriptor
+	fd_MsgAcknowledgeSigning_consensus_pubkey protoreflect.FieldDescriptor
+	fd_MsgAcknowledgeSigning_signature        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgCompleteRefreshing = File_bitway_tss_tx_proto.Messages().ByName("MsgCompleteRefreshing")
-	fd_MsgCompleteRefreshing_sender = md_MsgCompleteRefreshing.Fields().ByName("sender")
-	fd_MsgCompleteRefreshing_id = md_MsgCompleteRefreshing.Fields().ByName("id")
-	fd_MsgCompleteRefreshing_consensus_pubkey = md_MsgCompleteRefreshing.Fields().ByName("consensus_pubkey")
-	fd_MsgCompleteRefreshing_signature = md_MsgCompleteRefreshing.Fields().ByName("signature")
+	md_MsgAcknowledgeSigning = File_bitway_tss_tx_proto.Messages().ByName("MsgAcknowledgeSigning")
+	fd_MsgAcknowledgeSigning_sender = md_MsgAcknowledgeSigning.Fields().ByName("sender")
+	fd_MsgAcknowledgeSigning_id = md_MsgAcknowledgeSigning.Fields().ByName("id")
+	fd_MsgAcknowledgeSigning_consensus_pubkey = md_MsgAcknowledgeSigning.Fields().ByName("consensus_pubkey")
+	fd_MsgAcknowledgeSigning_signature = md_MsgAcknowledgeSigning.Fields().ByName("signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCompleteRefreshing)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcknowledgeSigning)(nil)
 
-type fastReflection_MsgCompleteRefreshing MsgCompleteRefreshing
+type fastReflection_MsgAcknowledgeSigning MsgAcknowledgeSigning
 
-func (x *MsgCompleteRefreshing) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCompleteRefreshing)(x)
+func (x *MsgAcknowledgeSigning) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcknowledgeSigning)(x)
 }
 
-func (x *MsgCompleteRefreshing) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcknowledgeSigning) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3235,43 +3287,43 @@ func (x *MsgCompleteRefreshing) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCompleteRefreshing_messageType fastReflection_MsgCompleteRefreshing_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCompleteRefreshing_messageType{}
+var _fastReflection_MsgAcknowledgeSigning_messageType fastReflection_MsgAcknowledgeSigning_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcknowledgeSigning_messageType{}
 
-type fastReflection_MsgCompleteRefreshing_messageType struct{}
+type fastReflection_MsgAcknowledgeSigning_messageType struct{}
 
-func (x fastReflection_MsgCompleteRefreshing_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCompleteRefreshing)(nil)
+func (x fastReflection_MsgAcknowledgeSigning_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcknowledgeSigning)(nil)
 }
-func (x fastReflection_MsgCompleteRefreshing_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCompleteRefreshing)
+func (x fastReflection_MsgAcknowledgeSigning_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcknowledgeSigning)
 }
-func (x fastReflection_MsgCompleteRefreshing_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCompleteRefreshing
+func (x fastReflection_MsgAcknowledgeSigning_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcknowledgeSigning
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCompleteRefreshing) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCompleteRefreshing
+func (x *fastReflection_MsgAcknowledgeSigning) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcknowledgeSigning
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCompleteRefreshing) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCompleteRefreshing_messageType
+func (x *fastReflection_MsgAcknowledgeSigning) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcknowledgeSigning_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCompleteRefreshing) New() protoreflect.Message {
-	return new(fastReflection_MsgCompleteRefreshing)
+func (x *fastReflection_MsgAcknowledgeSigning) New() protoreflect.Message {
+	return new(fastReflection_MsgAcknowledgeSigning)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCompleteRefreshing) Interface() protoreflect.ProtoMessage {
-	return (*MsgCompleteRefreshing)(x)
+func (x *fastReflection_MsgAcknowledgeSigning) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcknowledgeSigning)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3279,28 +3331,28 @@ func (x *fastReflection_MsgCompleteRefreshing) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCompleteRefreshing) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAcknowledgeSigning) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgCompleteRefreshing_sender, value) {
+		if !f(fd_MsgAcknowledgeSigning_sender, value) {
 			return
 		}
 	}
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_MsgCompleteRefreshing_id, value) {
+		if !f(fd_MsgAcknowledgeSigning_id, value) {
 			return
 		}
 	}
 	if x.ConsensusPubkey != "" {
 		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_MsgCompleteRefreshing_consensus_pubkey, value) {
+		if !f(fd_MsgAcknowledgeSigning_consensus_pubkey, value) {
 			return
 		}
 	}
 	if x.Signature != "" {
 		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_MsgCompleteRefreshing_signature, value) {
+		if !f(fd_MsgAcknowledgeSigning_signature, value) {
 			return
 		}
 	}
@@ -3317,21 +3369,21 @@ func (x *fastReflection_MsgCompleteRefreshing) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCompleteRefreshing) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcknowledgeSigning) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
 		return x.Sender != ""
-	case "bitway.tss.MsgCompleteRefreshing.id":
+	case "bitway.tss.MsgAcknowledgeSigning.id":
 		return x.Id != uint64(0)
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
 		return x.ConsensusPubkey != ""
-	case "bitway.tss.MsgCompleteRefreshing.signature":
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
 		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3341,21 +3393,21 @@ func (x *fastReflection_MsgCompleteRefreshing) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshing) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcknowledgeSigning) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
 		x.Sender = ""
-	case "bitway.tss.MsgCompleteRefreshing.id":
+	case "bitway.tss.MsgAcknowledgeSigning.id":
 		x.Id = uint64(0)
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
 		x.ConsensusPubkey = ""
-	case "bitway.tss.MsgCompleteRefreshing.signature":
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
 		x.Signature = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3365,25 +3417,25 @@ func (x *fastReflection_MsgCompleteRefreshing) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCompleteRefreshing) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigning) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "bitway.tss.MsgCompleteRefreshing.id":
+	case "bitway.tss.MsgAcknowledgeSigning.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
 		value := x.ConsensusPubkey
 		return protoreflect.ValueOfString(value)
-	case "bitway.tss.MsgCompleteRefreshing.signature":
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
 		value := x.Signature
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3397,21 +3449,21 @@ func (x *fastReflection_MsgCompleteRefreshing) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshing) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcknowledgeSigning) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
 		x.Sender = value.Interface().(string)
-	case "bitway.tss.MsgCompleteRefreshing.id":
+	case "bitway.tss.MsgAcknowledgeSigning.id":
 		x.Id = value.Uint()
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
 		x.ConsensusPubkey = value.Interface().(string)
-	case "bitway.tss.MsgCompleteRefreshing.signature":
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
 		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3425,52 +3477,52 @@ func (x *fastReflection_MsgCompleteRefreshing) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshing) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigning) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
-		panic(fmt.Errorf("field sender of message bitway.tss.MsgCompleteRefreshing is not mutable"))
-	case "bitway.tss.MsgCompleteRefreshing.id":
-		panic(fmt.Errorf("field id of message bitway.tss.MsgCompleteRefreshing is not mutable"))
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.MsgCompleteRefreshing is not mutable"))
-	case "bitway.tss.MsgCompleteRefreshing.signature":
-		panic(fmt.Errorf("field signature of message bitway.tss.MsgCompleteRefreshing is not mutable"))
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.MsgAcknowledgeSigning is not mutable"))
+	case "bitway.tss.MsgAcknowledgeSigning.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgAcknowledgeSigning is not mutable"))
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.MsgAcknowledgeSigning is not mutable"))
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.MsgAcknowledgeSigning is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCompleteRefreshing) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigning) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgCompleteRefreshing.sender":
+	case "bitway.tss.MsgAcknowledgeSigning.sender":
 		return protoreflect.ValueOfString("")
-	case "bitway.tss.MsgCompleteRefreshing.id":
+	case "bitway.tss.MsgAcknowledgeSigning.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+	case "bitway.tss.MsgAcknowledgeSigning.consensus_pubkey":
 		return protoreflect.ValueOfString("")
-	case "bitway.tss.MsgCompleteRefreshing.signature":
+	case "bitway.tss.MsgAcknowledgeSigning.signature":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigning"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigning does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCompleteRefreshing) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcknowledgeSigning) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgCompleteRefreshing", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgAcknowledgeSigning", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3478,7 +3530,7 @@ func (x *fastReflection_MsgCompleteRefreshing) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCompleteRefreshing) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcknowledgeSigning) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3489,7 +3541,7 @@ func (x *fastReflection_MsgCompleteRefreshing) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshing) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcknowledgeSigning) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3501,7 +3553,7 @@ func (x *fastReflection_MsgCompleteRefreshing) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCompleteRefreshing) IsValid() bool {
+func (x *fastReflection_MsgAcknowledgeSigning) IsValid() bool {
 	return x != nil
 }
 
@@ -3511,9 +3563,9 @@ func (x *fastReflection_MsgCompleteRefreshing) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcknowledgeSigning) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		x := input.Message.Interface().(*MsgAcknowledgeSigning)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3550,7 +3602,7 @@ func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		x := input.Message.Interface().(*MsgAcknowledgeSigning)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3606,7 +3658,7 @@ func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		x := input.Message.Interface().(*MsgAcknowledgeSigning)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3638,10 +3690,10 @@ func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshing: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcknowledgeSigning: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshing: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcknowledgeSigning: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3795,23 +3847,23 @@ func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgCompleteRefreshingResponse protoreflect.MessageDescriptor
+	md_MsgAcknowledgeSigningResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgCompleteRefreshingResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgCompleteRefreshingResponse")
+	md_MsgAcknowledgeSigningResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgAcknowledgeSigningResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCompleteRefreshingResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcknowledgeSigningResponse)(nil)
 
-type fastReflection_MsgCompleteRefreshingResponse MsgCompleteRefreshingResponse
+type fastReflection_MsgAcknowledgeSigningResponse MsgAcknowledgeSigningResponse
 
-func (x *MsgCompleteRefreshingResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCompleteRefreshingResponse)(x)
+func (x *MsgAcknowledgeSigningResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcknowledgeSigningResponse)(x)
 }
 
-func (x *MsgCompleteRefreshingResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcknowledgeSigningResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3823,43 +3875,43 @@ func (x *MsgCompleteRefreshingResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCompleteRefreshingResponse_messageType fastReflection_MsgCompleteRefreshingResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCompleteRefreshingResponse_messageType{}
+var _fastReflection_MsgAcknowledgeSigningResponse_messageType fastReflection_MsgAcknowledgeSigningResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcknowledgeSigningResponse_messageType{}
 
-type fastReflection_MsgCompleteRefreshingResponse_messageType struct{}
+type fastReflection_MsgAcknowledgeSigningResponse_messageType struct{}
 
-func (x fastReflection_MsgCompleteRefreshingResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCompleteRefreshingResponse)(nil)
+func (x fastReflection_MsgAcknowledgeSigningResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcknowledgeSigningResponse)(nil)
 }
-func (x fastReflection_MsgCompleteRefreshingResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCompleteRefreshingResponse)
+func (x fastReflection_MsgAcknowledgeSigningResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcknowledgeSigningResponse)
 }
-func (x fastReflection_MsgCompleteRefreshingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCompleteRefreshingResponse
+func (x fastReflection_MsgAcknowledgeSigningResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcknowledgeSigningResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCompleteRefreshingResponse
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcknowledgeSigningResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCompleteRefreshingResponse_messageType
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcknowledgeSigningResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCompleteRefreshingResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCompleteRefreshingResponse)
+func (x *fastReflection_MsgAcknowledgeSigningResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcknowledgeSigningResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCompleteRefreshingResponse)(x)
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcknowledgeSigningResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3867,7 +3919,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3881,13 +3933,13 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3897,13 +3949,13 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3913,13 +3965,13 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3933,13 +3985,13 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3953,36 +4005,36 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCompleteRefreshingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgAcknowledgeSigningResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgAcknowledgeSigningResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCompleteRefreshingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgCompleteRefreshingResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgAcknowledgeSigningResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3990,7 +4042,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCompleteRefreshingResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4001,7 +4053,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCompleteRefreshingResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4013,7 +4065,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCompleteRefreshingResponse) IsValid() bool {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4023,9 +4075,9 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcknowledgeSigningResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		x := input.Message.Interface().(*MsgAcknowledgeSigningResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4047,7 +4099,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		x := input.Message.Interface().(*MsgAcknowledgeSigningResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4077,7 +4129,7 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		x := input.Message.Interface().(*MsgAcknowledgeSigningResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4109,10 +4161,10 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshingResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcknowledgeSigningResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcknowledgeSigningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4150,28 +4202,224 @@ func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_MsgSubmitPartialSignatures_4_list)(nil)
+
+type _MsgSubmitPartialSignatures_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitPartialSignatures at list field Nonces as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitPartialSignatures_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitPartialSignatures_5_list)(nil)
+
+type _MsgSubmitPartialSignatures_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitPartialSignatures at list field PartialSignatures as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitPartialSignatures_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitPartialSignatures_7_list)(nil)
+
+type _MsgSubmitPartialSignatures_7_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitPartialSignatures at list field Signers as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitPartialSignatures_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitPartialSignatures_8_list)(nil)
+
+type _MsgSubmitPartialSignatures_8_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitPartialSignatures at list field AggregatedNonces as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitPartialSignatures_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgSubmitPartialSignatures                    protoreflect.MessageDescriptor
+	fd_MsgSubmitPartialSignatures_sender             protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_id                 protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_consensus_pubkey   protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_nonces             protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_partial_signatures protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_signature          protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_signers            protoreflect.FieldDescriptor
+	fd_MsgSubmitPartialSignatures_aggregated_nonces  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgUpdateParams = File_bitway_tss_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgSubmitPartialSignatures = File_bitway_tss_tx_proto.Messages().ByName("MsgSubmitPartialSignatures")
+	fd_MsgSubmitPartialSignatures_sender = md_MsgSubmitPartialSignatures.Fields().ByName("sender")
+	fd_MsgSubmitPartialSignatures_id = md_MsgSubmitPartialSignatures.Fields().ByName("id")
+	fd_MsgSubmitPartialSignatures_consensus_pubkey = md_MsgSubmitPartialSignatures.Fields().ByName("consensus_pubkey")
+	fd_MsgSubmitPartialSignatures_nonces = md_MsgSubmitPartialSignatures.Fields().ByName("nonces")
+	fd_MsgSubmitPartialSignatures_partial_signatures = md_MsgSubmitPartialSignatures.Fields().ByName("partial_signatures")
+	fd_MsgSubmitPartialSignatures_signature = md_MsgSubmitPartialSignatures.Fields().ByName("signature")
+	fd_MsgSubmitPartialSignatures_signers = md_MsgSubmitPartialSignatures.Fields().ByName("signers")
+	fd_MsgSubmitPartialSignatures_aggregated_nonces = md_MsgSubmitPartialSignatures.Fields().ByName("aggregated_nonces")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitPartialSignatures)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgSubmitPartialSignatures MsgSubmitPartialSignatures
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgSubmitPartialSignatures) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPartialSignatures)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+func (x *MsgSubmitPartialSignatures) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4183,43 +4431,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgSubmitPartialSignatures_messageType fastReflection_MsgSubmitPartialSignatures_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitPartialSignatures_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgSubmitPartialSignatures_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgSubmitPartialSignatures_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPartialSignatures)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgSubmitPartialSignatures_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPartialSignatures)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgSubmitPartialSignatures_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPartialSignatures
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgSubmitPartialSignatures) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPartialSignatures
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgSubmitPartialSignatures) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitPartialSignatures_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgSubmitPartialSignatures) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPartialSignatures)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgSubmitPartialSignatures) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitPartialSignatures)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4227,16 +4475,52 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgSubmitPartialSignatures) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgSubmitPartialSignatures_sender, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgSubmitPartialSignatures_id, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_MsgSubmitPartialSignatures_consensus_pubkey, value) {
+			return
+		}
+	}
+	if len(x.Nonces) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_4_list{list: &x.Nonces})
+		if !f(fd_MsgSubmitPartialSignatures_nonces, value) {
+			return
+		}
+	}
+	if len(x.PartialSignatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_5_list{list: &x.PartialSignatures})
+		if !f(fd_MsgSubmitPartialSignatures_partial_signatures, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_MsgSubmitPartialSignatures_signature, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_7_list{list: &x.Signers})
+		if !f(fd_MsgSubmitPartialSignatures_signers, value) {
+			return
+		}
+	}
+	if len(x.AggregatedNonces) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_8_list{list: &x.AggregatedNonces})
+		if !f(fd_MsgSubmitPartialSignatures_aggregated_nonces, value) {
 			return
 		}
 	}
@@ -4253,17 +4537,29 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitPartialSignatures) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "bitway.tss.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "bitway.tss.MsgUpdateParams.params":
-		return x.Params != nil
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
+		return x.Sender != ""
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		return len(x.Nonces) != 0
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		return len(x.PartialSignatures) != 0
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		return x.Signature != ""
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		return len(x.Signers) != 0
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		return len(x.AggregatedNonces) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4273,17 +4569,29 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitPartialSignatures) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "bitway.tss.MsgUpdateParams.params":
-		x.Params = nil
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
+		x.Sender = ""
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		x.Id = uint64(0)
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		x.Nonces = nil
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		x.PartialSignatures = nil
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		x.Signature = ""
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		x.Signers = nil
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		x.AggregatedNonces = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4293,19 +4601,49 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignatures) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "bitway.tss.MsgUpdateParams.authority":
-		value := x.Authority
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "bitway.tss.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		if len(x.Nonces) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_4_list{})
+		}
+		listValue := &_MsgSubmitPartialSignatures_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		if len(x.PartialSignatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_5_list{})
+		}
+		listValue := &_MsgSubmitPartialSignatures_5_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_7_list{})
+		}
+		listValue := &_MsgSubmitPartialSignatures_7_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		if len(x.AggregatedNonces) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_8_list{})
+		}
+		listValue := &_MsgSubmitPartialSignatures_8_list{list: &x.AggregatedNonces}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4319,17 +4657,37 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitPartialSignatures) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "bitway.tss.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "bitway.tss.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		x.Id = value.Uint()
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitPartialSignatures_4_list)
+		x.Nonces = *clv.list
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitPartialSignatures_5_list)
+		x.PartialSignatures = *clv.list
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		x.Signature = value.Interface().(string)
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitPartialSignatures_7_list)
+		x.Signers = *clv.list
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitPartialSignatures_8_list)
+		x.AggregatedNonces = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4343,48 +4701,88 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignatures) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		if x.Nonces == nil {
+			x.Nonces = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "bitway.tss.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message bitway.tss.MsgUpdateParams is not mutable"))
+		value := &_MsgSubmitPartialSignatures_4_list{list: &x.Nonces}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		if x.PartialSignatures == nil {
+			x.PartialSignatures = []string{}
+		}
+		value := &_MsgSubmitPartialSignatures_5_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_MsgSubmitPartialSignatures_7_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		if x.AggregatedNonces == nil {
+			x.AggregatedNonces = []string{}
+		}
+		value := &_MsgSubmitPartialSignatures_8_list{list: &x.AggregatedNonces}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.MsgSubmitPartialSignatures is not mutable"))
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgSubmitPartialSignatures is not mutable"))
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.MsgSubmitPartialSignatures is not mutable"))
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.MsgSubmitPartialSignatures is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignatures) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "bitway.tss.MsgUpdateParams.authority":
+	case "bitway.tss.MsgSubmitPartialSignatures.sender":
 		return protoreflect.ValueOfString("")
-	case "bitway.tss.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.MsgSubmitPartialSignatures.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.MsgSubmitPartialSignatures.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgSubmitPartialSignatures.nonces":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_4_list{list: &list})
+	case "bitway.tss.MsgSubmitPartialSignatures.partial_signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_5_list{list: &list})
+	case "bitway.tss.MsgSubmitPartialSignatures.signature":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgSubmitPartialSignatures.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_7_list{list: &list})
+	case "bitway.tss.MsgSubmitPartialSignatures.aggregated_nonces":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitPartialSignatures_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignatures"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignatures does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitPartialSignatures) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgSubmitPartialSignatures", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4392,7 +4790,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitPartialSignatures) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4403,7 +4801,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitPartialSignatures) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4415,7 +4813,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgSubmitPartialSignatures) IsValid() bool {
 	return x != nil
 }
 
@@ -4425,9 +4823,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitPartialSignatures) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgSubmitPartialSignatures)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4439,14 +4837,45 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Nonces) > 0 {
+			for _, s := range x.Nonces {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PartialSignatures) > 0 {
+			for _, s := range x.PartialSignatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Signature)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AggregatedNonces) > 0 {
+			for _, s := range x.AggregatedNonces {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4457,7 +4886,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgSubmitPartialSignatures)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4476,24 +4905,65 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.AggregatedNonces) > 0 {
+			for iNdEx := len(x.AggregatedNonces) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AggregatedNonces[iNdEx])
+				copy(dAtA[i:], x.AggregatedNonces[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggregatedNonces[iNdEx])))
+				i--
+				dAtA[i] = 0x42
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.PartialSignatures) > 0 {
+			for iNdEx := len(x.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PartialSignatures[iNdEx])
+				copy(dAtA[i:], x.PartialSignatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartialSignatures[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Nonces) > 0 {
+			for iNdEx := len(x.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Nonces[iNdEx])
+				copy(dAtA[i:], x.Nonces[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nonces[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4508,7 +4978,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgSubmitPartialSignatures)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4540,15 +5010,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPartialSignatures: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPartialSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4576,13 +5046,32 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4592,81 +5081,237 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Nonces = append(x.Nonces, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PartialSignatures = append(x.PartialSignatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
-)
-
-func init() {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedNonces", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggregatedNonces = append(x.AggregatedNonces, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitPartialSignaturesResponse protoreflect.MessageDescriptor
+)
+
+func init() {
 	file_bitway_tss_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgSubmitPartialSignaturesResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgSubmitPartialSignaturesResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitPartialSignaturesResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgSubmitPartialSignaturesResponse MsgSubmitPartialSignaturesResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgSubmitPartialSignaturesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPartialSignaturesResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSubmitPartialSignaturesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_bitway_tss_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4678,43 +5323,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgSubmitPartialSignaturesResponse_messageType fastReflection_MsgSubmitPartialSignaturesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitPartialSignaturesResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgSubmitPartialSignaturesResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgSubmitPartialSignaturesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPartialSignaturesResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgSubmitPartialSignaturesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPartialSignaturesResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgSubmitPartialSignaturesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPartialSignaturesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPartialSignaturesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitPartialSignaturesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPartialSignaturesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitPartialSignaturesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4722,7 +5367,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4736,13 +5381,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4752,13 +5397,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4768,13 +5413,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4788,13 +5433,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4808,36 +5453,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgSubmitPartialSignaturesResponse"))
 		}
-		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message bitway.tss.MsgSubmitPartialSignaturesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgSubmitPartialSignaturesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4845,7 +5490,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4856,7 +5501,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4868,7 +5513,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4878,9 +5523,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitPartialSignaturesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgSubmitPartialSignaturesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4902,7 +5547,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgSubmitPartialSignaturesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4932,7 +5577,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgSubmitPartialSignaturesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4964,10 +5609,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5005,196 +5650,7507 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: bitway/tss/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgClaimRewards                  protoreflect.MessageDescriptor
+	fd_MsgClaimRewards_sender           protoreflect.FieldDescriptor
+	fd_MsgClaimRewards_consensus_pubkey protoreflect.FieldDescriptor
 )
 
-// MsgCompleteDKG is the Msg/CompleteDKG request type.
-type MsgCompleteDKG struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgClaimRewards = File_bitway_tss_tx_proto.Messages().ByName("MsgClaimRewards")
+	fd_MsgClaimRewards_sender = md_MsgClaimRewards.Fields().ByName("sender")
+	fd_MsgClaimRewards_consensus_pubkey = md_MsgClaimRewards.Fields().ByName("consensus_pubkey")
+}
 
-	// sender
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// DKG request id
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// public keys generated by DKG
-	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgClaimRewards)(nil)
+
+type fastReflection_MsgClaimRewards MsgClaimRewards
+
+func (x *MsgClaimRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClaimRewards)(x)
 }
 
-func (x *MsgCompleteDKG) Reset() {
-	*x = MsgCompleteDKG{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[0]
+func (x *MsgClaimRewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgCompleteDKG) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_MsgClaimRewards_messageType fastReflection_MsgClaimRewards_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClaimRewards_messageType{}
+
+type fastReflection_MsgClaimRewards_messageType struct{}
+
+func (x fastReflection_MsgClaimRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClaimRewards)(nil)
+}
+func (x fastReflection_MsgClaimRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimRewards)
+}
+func (x fastReflection_MsgClaimRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimRewards
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClaimRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimRewards
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClaimRewards) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClaimRewards_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClaimRewards) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimRewards)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClaimRewards) Interface() protoreflect.ProtoMessage {
+	return (*MsgClaimRewards)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClaimRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgClaimRewards_sender, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_MsgClaimRewards_consensus_pubkey, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClaimRewards) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		return x.Sender != ""
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewards) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		x.Sender = ""
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClaimRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.MsgClaimRewards is not mutable"))
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.MsgClaimRewards is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClaimRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewards.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgClaimRewards.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewards"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClaimRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgClaimRewards", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClaimRewards) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewards) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClaimRewards) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClaimRewards) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClaimRewards)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimRewards)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimRewards)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgClaimRewardsResponse_1_list)(nil)
+
+type _MsgClaimRewardsResponse_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgClaimRewardsResponse         protoreflect.MessageDescriptor
+	fd_MsgClaimRewardsResponse_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgClaimRewardsResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgClaimRewardsResponse")
+	fd_MsgClaimRewardsResponse_rewards = md_MsgClaimRewardsResponse.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgClaimRewardsResponse)(nil)
+
+type fastReflection_MsgClaimRewardsResponse MsgClaimRewardsResponse
+
+func (x *MsgClaimRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClaimRewardsResponse)(x)
+}
+
+func (x *MsgClaimRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgClaimRewardsResponse_messageType fastReflection_MsgClaimRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClaimRewardsResponse_messageType{}
+
+type fastReflection_MsgClaimRewardsResponse_messageType struct{}
+
+func (x fastReflection_MsgClaimRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClaimRewardsResponse)(nil)
+}
+func (x fastReflection_MsgClaimRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimRewardsResponse)
+}
+func (x fastReflection_MsgClaimRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClaimRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClaimRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClaimRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClaimRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClaimRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgClaimRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClaimRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_MsgClaimRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_MsgClaimRewardsResponse_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClaimRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClaimRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_MsgClaimRewardsResponse_1_list{})
+		}
+		listValue := &_MsgClaimRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_MsgClaimRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.Coin{}
+		}
+		value := &_MsgClaimRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClaimRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgClaimRewardsResponse.rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgClaimRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgClaimRewardsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgClaimRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClaimRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgClaimRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClaimRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClaimRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClaimRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClaimRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRequestSignature_5_list)(nil)
+
+type _MsgRequestSignature_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgRequestSignature_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRequestSignature_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRequestSignature_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRequestSignature_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRequestSignature_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRequestSignature at list field SigHashes as it is not of Message kind"))
+}
+
+func (x *_MsgRequestSignature_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRequestSignature_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRequestSignature_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRequestSignature            protoreflect.MessageDescriptor
+	fd_MsgRequestSignature_authority  protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_dkg_id     protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_pub_key    protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_type       protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_sig_hashes protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_psbt       protoreflect.FieldDescriptor
+	fd_MsgRequestSignature_options    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgRequestSignature = File_bitway_tss_tx_proto.Messages().ByName("MsgRequestSignature")
+	fd_MsgRequestSignature_authority = md_MsgRequestSignature.Fields().ByName("authority")
+	fd_MsgRequestSignature_dkg_id = md_MsgRequestSignature.Fields().ByName("dkg_id")
+	fd_MsgRequestSignature_pub_key = md_MsgRequestSignature.Fields().ByName("pub_key")
+	fd_MsgRequestSignature_type = md_MsgRequestSignature.Fields().ByName("type")
+	fd_MsgRequestSignature_sig_hashes = md_MsgRequestSignature.Fields().ByName("sig_hashes")
+	fd_MsgRequestSignature_psbt = md_MsgRequestSignature.Fields().ByName("psbt")
+	fd_MsgRequestSignature_options = md_MsgRequestSignature.Fields().ByName("options")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestSignature)(nil)
+
+type fastReflection_MsgRequestSignature MsgRequestSignature
+
+func (x *MsgRequestSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestSignature)(x)
+}
+
+func (x *MsgRequestSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestSignature_messageType fastReflection_MsgRequestSignature_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestSignature_messageType{}
+
+type fastReflection_MsgRequestSignature_messageType struct{}
+
+func (x fastReflection_MsgRequestSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestSignature)(nil)
+}
+func (x fastReflection_MsgRequestSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestSignature)
+}
+func (x fastReflection_MsgRequestSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestSignature) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestSignature) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestSignature) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRequestSignature_authority, value) {
+			return
+		}
+	}
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_MsgRequestSignature_dkg_id, value) {
+			return
+		}
+	}
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_MsgRequestSignature_pub_key, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_MsgRequestSignature_type, value) {
+			return
+		}
+	}
+	if len(x.SigHashes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRequestSignature_5_list{list: &x.SigHashes})
+		if !f(fd_MsgRequestSignature_sig_hashes, value) {
+			return
+		}
+	}
+	if x.Psbt != "" {
+		value := protoreflect.ValueOfString(x.Psbt)
+		if !f(fd_MsgRequestSignature_psbt, value) {
+			return
+		}
+	}
+	if x.Options != nil {
+		value := protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+		if !f(fd_MsgRequestSignature_options, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignature.authority":
+		return x.Authority != ""
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		return x.DkgId != uint64(0)
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		return x.PubKey != ""
+	case "bitway.tss.MsgRequestSignature.type":
+		return x.Type_ != 0
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		return len(x.SigHashes) != 0
+	case "bitway.tss.MsgRequestSignature.psbt":
+		return x.Psbt != ""
+	case "bitway.tss.MsgRequestSignature.options":
+		return x.Options != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignature.authority":
+		x.Authority = ""
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		x.DkgId = uint64(0)
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		x.PubKey = ""
+	case "bitway.tss.MsgRequestSignature.type":
+		x.Type_ = 0
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		x.SigHashes = nil
+	case "bitway.tss.MsgRequestSignature.psbt":
+		x.Psbt = ""
+	case "bitway.tss.MsgRequestSignature.options":
+		x.Options = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgRequestSignature.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgRequestSignature.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		if len(x.SigHashes) == 0 {
+			return protoreflect.ValueOfList(&_MsgRequestSignature_5_list{})
+		}
+		listValue := &_MsgRequestSignature_5_list{list: &x.SigHashes}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgRequestSignature.psbt":
+		value := x.Psbt
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgRequestSignature.options":
+		value := x.Options
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignature.authority":
+		x.Authority = value.Interface().(string)
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		x.DkgId = value.Uint()
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "bitway.tss.MsgRequestSignature.type":
+		x.Type_ = (SigningType)(value.Enum())
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		lv := value.List()
+		clv := lv.(*_MsgRequestSignature_5_list)
+		x.SigHashes = *clv.list
+	case "bitway.tss.MsgRequestSignature.psbt":
+		x.Psbt = value.Interface().(string)
+	case "bitway.tss.MsgRequestSignature.options":
+		x.Options = value.Message().Interface().(*SigningOptions)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		if x.SigHashes == nil {
+			x.SigHashes = []string{}
+		}
+		value := &_MsgRequestSignature_5_list{list: &x.SigHashes}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgRequestSignature.options":
+		if x.Options == nil {
+			x.Options = new(SigningOptions)
+		}
+		return protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+	case "bitway.tss.MsgRequestSignature.authority":
+		panic(fmt.Errorf("field authority of message bitway.tss.MsgRequestSignature is not mutable"))
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message bitway.tss.MsgRequestSignature is not mutable"))
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		panic(fmt.Errorf("field pub_key of message bitway.tss.MsgRequestSignature is not mutable"))
+	case "bitway.tss.MsgRequestSignature.type":
+		panic(fmt.Errorf("field type of message bitway.tss.MsgRequestSignature is not mutable"))
+	case "bitway.tss.MsgRequestSignature.psbt":
+		panic(fmt.Errorf("field psbt of message bitway.tss.MsgRequestSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignature.authority":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgRequestSignature.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.MsgRequestSignature.pub_key":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgRequestSignature.type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.MsgRequestSignature.sig_hashes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRequestSignature_5_list{list: &list})
+	case "bitway.tss.MsgRequestSignature.psbt":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgRequestSignature.options":
+		m := new(SigningOptions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignature"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRequestSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if len(x.SigHashes) > 0 {
+			for _, s := range x.SigHashes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Psbt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Options != nil {
+			l = options.Size(x.Options)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Options != nil {
+			encoded, err := options.Marshal(x.Options)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Psbt) > 0 {
+			i -= len(x.Psbt)
+			copy(dAtA[i:], x.Psbt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Psbt)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SigHashes) > 0 {
+			for iNdEx := len(x.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SigHashes[iNdEx])
+				copy(dAtA[i:], x.SigHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigHashes[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= SigningType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigHashes = append(x.SigHashes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Psbt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Options == nil {
+					x.Options = &SigningOptions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRequestSignatureResponse    protoreflect.MessageDescriptor
+	fd_MsgRequestSignatureResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgRequestSignatureResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgRequestSignatureResponse")
+	fd_MsgRequestSignatureResponse_id = md_MsgRequestSignatureResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestSignatureResponse)(nil)
+
+type fastReflection_MsgRequestSignatureResponse MsgRequestSignatureResponse
+
+func (x *MsgRequestSignatureResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestSignatureResponse)(x)
+}
+
+func (x *MsgRequestSignatureResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestSignatureResponse_messageType fastReflection_MsgRequestSignatureResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestSignatureResponse_messageType{}
+
+type fastReflection_MsgRequestSignatureResponse_messageType struct{}
+
+func (x fastReflection_MsgRequestSignatureResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestSignatureResponse)(nil)
+}
+func (x fastReflection_MsgRequestSignatureResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestSignatureResponse)
+}
+func (x fastReflection_MsgRequestSignatureResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestSignatureResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestSignatureResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestSignatureResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestSignatureResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestSignatureResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestSignatureResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestSignatureResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestSignatureResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestSignatureResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestSignatureResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgRequestSignatureResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestSignatureResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignatureResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestSignatureResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignatureResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignatureResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgRequestSignatureResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestSignatureResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRequestSignatureResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRequestSignatureResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRequestSignatureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestSignatureResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRequestSignatureResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestSignatureResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestSignatureResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestSignatureResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestSignatureResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestSignatureResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestSignatureResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestSignatureResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgInitiateDKG_2_list)(nil)
+
+type _MsgInitiateDKG_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgInitiateDKG_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgInitiateDKG_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgInitiateDKG_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgInitiateDKG_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgInitiateDKG_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgInitiateDKG at list field Participants as it is not of Message kind"))
+}
+
+func (x *_MsgInitiateDKG_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgInitiateDKG_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgInitiateDKG_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgInitiateDKG                  protoreflect.MessageDescriptor
+	fd_MsgInitiateDKG_authority        protoreflect.FieldDescriptor
+	fd_MsgInitiateDKG_participants     protoreflect.FieldDescriptor
+	fd_MsgInitiateDKG_threshold        protoreflect.FieldDescriptor
+	fd_MsgInitiateDKG_batch_size       protoreflect.FieldDescriptor
+	fd_MsgInitiateDKG_key_type         protoreflect.FieldDescriptor
+	fd_MsgInitiateDKG_timeout_duration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgInitiateDKG = File_bitway_tss_tx_proto.Messages().ByName("MsgInitiateDKG")
+	fd_MsgInitiateDKG_authority = md_MsgInitiateDKG.Fields().ByName("authority")
+	fd_MsgInitiateDKG_participants = md_MsgInitiateDKG.Fields().ByName("participants")
+	fd_MsgInitiateDKG_threshold = md_MsgInitiateDKG.Fields().ByName("threshold")
+	fd_MsgInitiateDKG_batch_size = md_MsgInitiateDKG.Fields().ByName("batch_size")
+	fd_MsgInitiateDKG_key_type = md_MsgInitiateDKG.Fields().ByName("key_type")
+	fd_MsgInitiateDKG_timeout_duration = md_MsgInitiateDKG.Fields().ByName("timeout_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgInitiateDKG)(nil)
+
+type fastReflection_MsgInitiateDKG MsgInitiateDKG
+
+func (x *MsgInitiateDKG) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitiateDKG)(x)
+}
+
+func (x *MsgInitiateDKG) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgInitiateDKG_messageType fastReflection_MsgInitiateDKG_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitiateDKG_messageType{}
+
+type fastReflection_MsgInitiateDKG_messageType struct{}
+
+func (x fastReflection_MsgInitiateDKG_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitiateDKG)(nil)
+}
+func (x fastReflection_MsgInitiateDKG_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateDKG)
+}
+func (x fastReflection_MsgInitiateDKG_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateDKG
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgInitiateDKG) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateDKG
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgInitiateDKG) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitiateDKG_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgInitiateDKG) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateDKG)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgInitiateDKG) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitiateDKG)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgInitiateDKG) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgInitiateDKG_authority, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitiateDKG_2_list{list: &x.Participants})
+		if !f(fd_MsgInitiateDKG_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_MsgInitiateDKG_threshold, value) {
+			return
+		}
+	}
+	if x.BatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BatchSize)
+		if !f(fd_MsgInitiateDKG_batch_size, value) {
+			return
+		}
+	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_MsgInitiateDKG_key_type, value) {
+			return
+		}
+	}
+	if x.TimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+		if !f(fd_MsgInitiateDKG_timeout_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgInitiateDKG) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKG.authority":
+		return x.Authority != ""
+	case "bitway.tss.MsgInitiateDKG.participants":
+		return len(x.Participants) != 0
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		return x.Threshold != uint32(0)
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		return x.BatchSize != uint32(0)
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		return x.KeyType != 0
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		return x.TimeoutDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKG) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKG.authority":
+		x.Authority = ""
+	case "bitway.tss.MsgInitiateDKG.participants":
+		x.Participants = nil
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		x.Threshold = uint32(0)
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		x.BatchSize = uint32(0)
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		x.KeyType = 0
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		x.TimeoutDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgInitiateDKG) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgInitiateDKG.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgInitiateDKG.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitiateDKG_2_list{})
+		}
+		listValue := &_MsgInitiateDKG_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		value := x.BatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		value := x.TimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKG) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKG.authority":
+		x.Authority = value.Interface().(string)
+	case "bitway.tss.MsgInitiateDKG.participants":
+		lv := value.List()
+		clv := lv.(*_MsgInitiateDKG_2_list)
+		x.Participants = *clv.list
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		x.BatchSize = uint32(value.Uint())
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		x.KeyType = (KeyType)(value.Enum())
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKG) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKG.participants":
+		if x.Participants == nil {
+			x.Participants = []string{}
+		}
+		value := &_MsgInitiateDKG_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		if x.TimeoutDuration == nil {
+			x.TimeoutDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+	case "bitway.tss.MsgInitiateDKG.authority":
+		panic(fmt.Errorf("field authority of message bitway.tss.MsgInitiateDKG is not mutable"))
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		panic(fmt.Errorf("field threshold of message bitway.tss.MsgInitiateDKG is not mutable"))
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		panic(fmt.Errorf("field batch_size of message bitway.tss.MsgInitiateDKG is not mutable"))
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		panic(fmt.Errorf("field key_type of message bitway.tss.MsgInitiateDKG is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgInitiateDKG) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKG.authority":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgInitiateDKG.participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgInitiateDKG_2_list{list: &list})
+	case "bitway.tss.MsgInitiateDKG.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.MsgInitiateDKG.batch_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "bitway.tss.MsgInitiateDKG.key_type":
+		return protoreflect.ValueOfEnum(0)
+	case "bitway.tss.MsgInitiateDKG.timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKG"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKG does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgInitiateDKG) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgInitiateDKG", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgInitiateDKG) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKG) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgInitiateDKG) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgInitiateDKG) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgInitiateDKG)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Participants) > 0 {
+			for _, s := range x.Participants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.BatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchSize))
+		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
+		if x.TimeoutDuration != nil {
+			l = options.Size(x.TimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgInitiateDKG)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TimeoutDuration != nil {
+			encoded, err := options.Marshal(x.TimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchSize))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Participants[iNdEx])
+				copy(dAtA[i:], x.Participants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participants[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgInitiateDKG)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				}
+				x.BatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TimeoutDuration == nil {
+					x.TimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgInitiateDKGResponse    protoreflect.MessageDescriptor
+	fd_MsgInitiateDKGResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgInitiateDKGResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgInitiateDKGResponse")
+	fd_MsgInitiateDKGResponse_id = md_MsgInitiateDKGResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgInitiateDKGResponse)(nil)
+
+type fastReflection_MsgInitiateDKGResponse MsgInitiateDKGResponse
+
+func (x *MsgInitiateDKGResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitiateDKGResponse)(x)
+}
+
+func (x *MsgInitiateDKGResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgInitiateDKGResponse_messageType fastReflection_MsgInitiateDKGResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitiateDKGResponse_messageType{}
+
+type fastReflection_MsgInitiateDKGResponse_messageType struct{}
+
+func (x fastReflection_MsgInitiateDKGResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitiateDKGResponse)(nil)
+}
+func (x fastReflection_MsgInitiateDKGResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateDKGResponse)
+}
+func (x fastReflection_MsgInitiateDKGResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateDKGResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgInitiateDKGResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateDKGResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgInitiateDKGResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitiateDKGResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgInitiateDKGResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateDKGResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgInitiateDKGResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitiateDKGResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgInitiateDKGResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgInitiateDKGResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgInitiateDKGResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKGResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgInitiateDKGResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKGResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKGResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgInitiateDKGResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgInitiateDKGResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgInitiateDKGResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgInitiateDKGResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgInitiateDKGResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgInitiateDKGResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgInitiateDKGResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgInitiateDKGResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgInitiateDKGResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgInitiateDKGResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgInitiateDKGResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgInitiateDKGResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgInitiateDKGResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgInitiateDKGResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRefresh_2_list)(nil)
+
+type _MsgRefresh_2_list struct {
+	list *[]uint64
+}
+
+func (x *_MsgRefresh_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRefresh_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MsgRefresh_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRefresh_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRefresh_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRefresh at list field DkgIds as it is not of Message kind"))
+}
+
+func (x *_MsgRefresh_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRefresh_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgRefresh_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRefresh_3_list)(nil)
+
+type _MsgRefresh_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgRefresh_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRefresh_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRefresh_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRefresh_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRefresh_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRefresh at list field RemovedParticipants as it is not of Message kind"))
+}
+
+func (x *_MsgRefresh_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRefresh_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRefresh_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgRefresh_5_list)(nil)
+
+type _MsgRefresh_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgRefresh_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRefresh_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRefresh_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRefresh_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRefresh_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRefresh at list field AddedParticipants as it is not of Message kind"))
+}
+
+func (x *_MsgRefresh_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRefresh_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRefresh_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRefresh                      protoreflect.MessageDescriptor
+	fd_MsgRefresh_authority            protoreflect.FieldDescriptor
+	fd_MsgRefresh_dkg_ids              protoreflect.FieldDescriptor
+	fd_MsgRefresh_removed_participants protoreflect.FieldDescriptor
+	fd_MsgRefresh_timeout_duration     protoreflect.FieldDescriptor
+	fd_MsgRefresh_added_participants   protoreflect.FieldDescriptor
+	fd_MsgRefresh_threshold            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgRefresh = File_bitway_tss_tx_proto.Messages().ByName("MsgRefresh")
+	fd_MsgRefresh_authority = md_MsgRefresh.Fields().ByName("authority")
+	fd_MsgRefresh_dkg_ids = md_MsgRefresh.Fields().ByName("dkg_ids")
+	fd_MsgRefresh_removed_participants = md_MsgRefresh.Fields().ByName("removed_participants")
+	fd_MsgRefresh_timeout_duration = md_MsgRefresh.Fields().ByName("timeout_duration")
+	fd_MsgRefresh_added_participants = md_MsgRefresh.Fields().ByName("added_participants")
+	fd_MsgRefresh_threshold = md_MsgRefresh.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRefresh)(nil)
+
+type fastReflection_MsgRefresh MsgRefresh
+
+func (x *MsgRefresh) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRefresh)(x)
+}
+
+func (x *MsgRefresh) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRefresh_messageType fastReflection_MsgRefresh_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRefresh_messageType{}
+
+type fastReflection_MsgRefresh_messageType struct{}
+
+func (x fastReflection_MsgRefresh_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRefresh)(nil)
+}
+func (x fastReflection_MsgRefresh_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRefresh)
+}
+func (x fastReflection_MsgRefresh_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefresh
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRefresh) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefresh
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRefresh) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRefresh_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRefresh) New() protoreflect.Message {
+	return new(fastReflection_MsgRefresh)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRefresh) Interface() protoreflect.ProtoMessage {
+	return (*MsgRefresh)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRefresh) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRefresh_authority, value) {
+			return
+		}
+	}
+	if len(x.DkgIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRefresh_2_list{list: &x.DkgIds})
+		if !f(fd_MsgRefresh_dkg_ids, value) {
+			return
+		}
+	}
+	if len(x.RemovedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRefresh_3_list{list: &x.RemovedParticipants})
+		if !f(fd_MsgRefresh_removed_participants, value) {
+			return
+		}
+	}
+	if x.TimeoutDuration != nil {
+		value := protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+		if !f(fd_MsgRefresh_timeout_duration, value) {
+			return
+		}
+	}
+	if len(x.AddedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRefresh_5_list{list: &x.AddedParticipants})
+		if !f(fd_MsgRefresh_added_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_MsgRefresh_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRefresh) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRefresh.authority":
+		return x.Authority != ""
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		return len(x.DkgIds) != 0
+	case "bitway.tss.MsgRefresh.removed_participants":
+		return len(x.RemovedParticipants) != 0
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		return x.TimeoutDuration != nil
+	case "bitway.tss.MsgRefresh.added_participants":
+		return len(x.AddedParticipants) != 0
+	case "bitway.tss.MsgRefresh.threshold":
+		return x.Threshold != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefresh) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRefresh.authority":
+		x.Authority = ""
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		x.DkgIds = nil
+	case "bitway.tss.MsgRefresh.removed_participants":
+		x.RemovedParticipants = nil
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		x.TimeoutDuration = nil
+	case "bitway.tss.MsgRefresh.added_participants":
+		x.AddedParticipants = nil
+	case "bitway.tss.MsgRefresh.threshold":
+		x.Threshold = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRefresh) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgRefresh.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		if len(x.DkgIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgRefresh_2_list{})
+		}
+		listValue := &_MsgRefresh_2_list{list: &x.DkgIds}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgRefresh.removed_participants":
+		if len(x.RemovedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_MsgRefresh_3_list{})
+		}
+		listValue := &_MsgRefresh_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		value := x.TimeoutDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "bitway.tss.MsgRefresh.added_participants":
+		if len(x.AddedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_MsgRefresh_5_list{})
+		}
+		listValue := &_MsgRefresh_5_list{list: &x.AddedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "bitway.tss.MsgRefresh.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefresh) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRefresh.authority":
+		x.Authority = value.Interface().(string)
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		lv := value.List()
+		clv := lv.(*_MsgRefresh_2_list)
+		x.DkgIds = *clv.list
+	case "bitway.tss.MsgRefresh.removed_participants":
+		lv := value.List()
+		clv := lv.(*_MsgRefresh_3_list)
+		x.RemovedParticipants = *clv.list
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		x.TimeoutDuration = value.Message().Interface().(*durationpb.Duration)
+	case "bitway.tss.MsgRefresh.added_participants":
+		lv := value.List()
+		clv := lv.(*_MsgRefresh_5_list)
+		x.AddedParticipants = *clv.list
+	case "bitway.tss.MsgRefresh.threshold":
+		x.Threshold = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefresh) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		if x.DkgIds == nil {
+			x.DkgIds = []uint64{}
+		}
+		value := &_MsgRefresh_2_list{list: &x.DkgIds}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgRefresh.removed_participants":
+		if x.RemovedParticipants == nil {
+			x.RemovedParticipants = []string{}
+		}
+		value := &_MsgRefresh_3_list{list: &x.RemovedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		if x.TimeoutDuration == nil {
+			x.TimeoutDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TimeoutDuration.ProtoReflect())
+	case "bitway.tss.MsgRefresh.added_participants":
+		if x.AddedParticipants == nil {
+			x.AddedParticipants = []string{}
+		}
+		value := &_MsgRefresh_5_list{list: &x.AddedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgRefresh.authority":
+		panic(fmt.Errorf("field authority of message bitway.tss.MsgRefresh is not mutable"))
+	case "bitway.tss.MsgRefresh.threshold":
+		panic(fmt.Errorf("field threshold of message bitway.tss.MsgRefresh is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRefresh) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgRefresh.authority":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgRefresh.dkg_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgRefresh_2_list{list: &list})
+	case "bitway.tss.MsgRefresh.removed_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRefresh_3_list{list: &list})
+	case "bitway.tss.MsgRefresh.timeout_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "bitway.tss.MsgRefresh.added_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRefresh_5_list{list: &list})
+	case "bitway.tss.MsgRefresh.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefresh"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefresh does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRefresh) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRefresh", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRefresh) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefresh) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRefresh) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRefresh) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRefresh)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DkgIds) > 0 {
+			l = 0
+			for _, e := range x.DkgIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for _, s := range x.RemovedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TimeoutDuration != nil {
+			l = options.Size(x.TimeoutDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AddedParticipants) > 0 {
+			for _, s := range x.AddedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefresh)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.AddedParticipants) > 0 {
+			for iNdEx := len(x.AddedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AddedParticipants[iNdEx])
+				copy(dAtA[i:], x.AddedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.TimeoutDuration != nil {
+			encoded, err := options.Marshal(x.TimeoutDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RemovedParticipants) > 0 {
+			for iNdEx := len(x.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedParticipants[iNdEx])
+				copy(dAtA[i:], x.RemovedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DkgIds) > 0 {
+			var pksize2 int
+			for _, num := range x.DkgIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.DkgIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefresh)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefresh: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.DkgIds = append(x.DkgIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.DkgIds) == 0 {
+						x.DkgIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.DkgIds = append(x.DkgIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgIds", wireType)
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedParticipants = append(x.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TimeoutDuration == nil {
+					x.TimeoutDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeoutDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddedParticipants = append(x.AddedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRefreshResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgRefreshResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgRefreshResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRefreshResponse)(nil)
+
+type fastReflection_MsgRefreshResponse MsgRefreshResponse
+
+func (x *MsgRefreshResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRefreshResponse)(x)
+}
+
+func (x *MsgRefreshResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRefreshResponse_messageType fastReflection_MsgRefreshResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRefreshResponse_messageType{}
+
+type fastReflection_MsgRefreshResponse_messageType struct{}
+
+func (x fastReflection_MsgRefreshResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRefreshResponse)(nil)
+}
+func (x fastReflection_MsgRefreshResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRefreshResponse)
+}
+func (x fastReflection_MsgRefreshResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefreshResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRefreshResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefreshResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRefreshResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRefreshResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRefreshResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRefreshResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRefreshResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRefreshResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRefreshResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRefreshResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefreshResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRefreshResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefreshResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefreshResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRefreshResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgRefreshResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgRefreshResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRefreshResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgRefreshResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRefreshResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefreshResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRefreshResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRefreshResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRefreshResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefreshResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefreshResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefreshResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgCompleteRefreshing_5_list)(nil)
+
+type _MsgCompleteRefreshing_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgCompleteRefreshing_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCompleteRefreshing_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCompleteRefreshing_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCompleteRefreshing_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCompleteRefreshing_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCompleteRefreshing at list field VerificationShares as it is not of Message kind"))
+}
+
+func (x *_MsgCompleteRefreshing_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCompleteRefreshing_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCompleteRefreshing_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCompleteRefreshing                     protoreflect.MessageDescriptor
+	fd_MsgCompleteRefreshing_sender              protoreflect.FieldDescriptor
+	fd_MsgCompleteRefreshing_id                  protoreflect.FieldDescriptor
+	fd_MsgCompleteRefreshing_consensus_pubkey    protoreflect.FieldDescriptor
+	fd_MsgCompleteRefreshing_signature           protoreflect.FieldDescriptor
+	fd_MsgCompleteRefreshing_verification_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgCompleteRefreshing = File_bitway_tss_tx_proto.Messages().ByName("MsgCompleteRefreshing")
+	fd_MsgCompleteRefreshing_sender = md_MsgCompleteRefreshing.Fields().ByName("sender")
+	fd_MsgCompleteRefreshing_id = md_MsgCompleteRefreshing.Fields().ByName("id")
+	fd_MsgCompleteRefreshing_consensus_pubkey = md_MsgCompleteRefreshing.Fields().ByName("consensus_pubkey")
+	fd_MsgCompleteRefreshing_signature = md_MsgCompleteRefreshing.Fields().ByName("signature")
+	fd_MsgCompleteRefreshing_verification_shares = md_MsgCompleteRefreshing.Fields().ByName("verification_shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCompleteRefreshing)(nil)
+
+type fastReflection_MsgCompleteRefreshing MsgCompleteRefreshing
+
+func (x *MsgCompleteRefreshing) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCompleteRefreshing)(x)
+}
+
+func (x *MsgCompleteRefreshing) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCompleteRefreshing_messageType fastReflection_MsgCompleteRefreshing_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCompleteRefreshing_messageType{}
+
+type fastReflection_MsgCompleteRefreshing_messageType struct{}
+
+func (x fastReflection_MsgCompleteRefreshing_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCompleteRefreshing)(nil)
+}
+func (x fastReflection_MsgCompleteRefreshing_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCompleteRefreshing)
+}
+func (x fastReflection_MsgCompleteRefreshing_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCompleteRefreshing
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCompleteRefreshing) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCompleteRefreshing
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCompleteRefreshing) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCompleteRefreshing_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCompleteRefreshing) New() protoreflect.Message {
+	return new(fastReflection_MsgCompleteRefreshing)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCompleteRefreshing) Interface() protoreflect.ProtoMessage {
+	return (*MsgCompleteRefreshing)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCompleteRefreshing) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCompleteRefreshing_sender, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgCompleteRefreshing_id, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_MsgCompleteRefreshing_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_MsgCompleteRefreshing_signature, value) {
+			return
+		}
+	}
+	if len(x.VerificationShares) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCompleteRefreshing_5_list{list: &x.VerificationShares})
+		if !f(fd_MsgCompleteRefreshing_verification_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCompleteRefreshing) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		return x.Sender != ""
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		return x.Id != uint64(0)
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		return x.Signature != ""
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		return len(x.VerificationShares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshing) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		x.Sender = ""
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		x.Id = uint64(0)
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		x.Signature = ""
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		x.VerificationShares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCompleteRefreshing) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		if len(x.VerificationShares) == 0 {
+			return protoreflect.ValueOfList(&_MsgCompleteRefreshing_5_list{})
+		}
+		listValue := &_MsgCompleteRefreshing_5_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshing) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		x.Sender = value.Interface().(string)
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		x.Id = value.Uint()
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		x.Signature = value.Interface().(string)
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		lv := value.List()
+		clv := lv.(*_MsgCompleteRefreshing_5_list)
+		x.VerificationShares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshing) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		if x.VerificationShares == nil {
+			x.VerificationShares = []string{}
+		}
+		value := &_MsgCompleteRefreshing_5_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(value)
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		panic(fmt.Errorf("field sender of message bitway.tss.MsgCompleteRefreshing is not mutable"))
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		panic(fmt.Errorf("field id of message bitway.tss.MsgCompleteRefreshing is not mutable"))
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message bitway.tss.MsgCompleteRefreshing is not mutable"))
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		panic(fmt.Errorf("field signature of message bitway.tss.MsgCompleteRefreshing is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCompleteRefreshing) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgCompleteRefreshing.sender":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgCompleteRefreshing.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "bitway.tss.MsgCompleteRefreshing.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgCompleteRefreshing.signature":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgCompleteRefreshing.verification_shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCompleteRefreshing_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshing"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshing does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCompleteRefreshing) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgCompleteRefreshing", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCompleteRefreshing) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshing) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCompleteRefreshing) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCompleteRefreshing) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.VerificationShares) > 0 {
+			for _, s := range x.VerificationShares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerificationShares) > 0 {
+			for iNdEx := len(x.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerificationShares[iNdEx])
+				copy(dAtA[i:], x.VerificationShares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationShares[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCompleteRefreshing)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshing: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshing: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShares = append(x.VerificationShares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCompleteRefreshingResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgCompleteRefreshingResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgCompleteRefreshingResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCompleteRefreshingResponse)(nil)
+
+type fastReflection_MsgCompleteRefreshingResponse MsgCompleteRefreshingResponse
+
+func (x *MsgCompleteRefreshingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCompleteRefreshingResponse)(x)
+}
+
+func (x *MsgCompleteRefreshingResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCompleteRefreshingResponse_messageType fastReflection_MsgCompleteRefreshingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCompleteRefreshingResponse_messageType{}
+
+type fastReflection_MsgCompleteRefreshingResponse_messageType struct{}
+
+func (x fastReflection_MsgCompleteRefreshingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCompleteRefreshingResponse)(nil)
+}
+func (x fastReflection_MsgCompleteRefreshingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCompleteRefreshingResponse)
+}
+func (x fastReflection_MsgCompleteRefreshingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCompleteRefreshingResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCompleteRefreshingResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCompleteRefreshingResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCompleteRefreshingResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCompleteRefreshingResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCompleteRefreshingResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCompleteRefreshingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgCompleteRefreshingResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgCompleteRefreshingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCompleteRefreshingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgCompleteRefreshingResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCompleteRefreshingResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCompleteRefreshingResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCompleteRefreshingResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCompleteRefreshingResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCompleteRefreshingResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshingResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCompleteRefreshingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgUpdateParams = File_bitway_tss_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "bitway.tss.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "bitway.tss.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "bitway.tss.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "bitway.tss.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "bitway.tss.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "bitway.tss.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "bitway.tss.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "bitway.tss.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message bitway.tss.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "bitway.tss.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "bitway.tss.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_bitway_tss_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_bitway_tss_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_bitway_tss_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: bitway.tss.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message bitway.tss.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in bitway.tss.MsgUpdateParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: bitway/tss/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCompleteDKG is the Msg/CompleteDKG request type.
+type MsgCompleteDKG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// DKG request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// public keys generated by DKG
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded schnorr proofs of possession for the public keys
+	Proofs []string `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// hex encoded compressed verification shares of the participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+}
+
+func (x *MsgCompleteDKG) Reset() {
+	*x = MsgCompleteDKG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCompleteDKG) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCompleteDKG) ProtoMessage() {}
+
+// Deprecated: Use MsgCompleteDKG.ProtoReflect.Descriptor instead.
+func (*MsgCompleteDKG) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCompleteDKG) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgCompleteDKG) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgCompleteDKG) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+func (x *MsgCompleteDKG) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *MsgCompleteDKG) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *MsgCompleteDKG) GetProofs() []string {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *MsgCompleteDKG) GetVerificationShares() []string {
+	if x != nil {
+		return x.VerificationShares
+	}
+	return nil
+}
+
+// MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
+type MsgCompleteDKGResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCompleteDKGResponse) Reset() {
+	*x = MsgCompleteDKGResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCompleteDKGResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCompleteDKGResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCompleteDKGResponse.ProtoReflect.Descriptor instead.
+func (*MsgCompleteDKGResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgSubmitSignatures defines the Msg/SubmitSignatures request type.
+type MsgSubmitSignatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender     string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id         uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Signatures []string `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgSubmitSignatures) Reset() {
+	*x = MsgSubmitSignatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitSignatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitSignatures) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitSignatures.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSignatures) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgSubmitSignatures) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgSubmitSignatures) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSubmitSignatures) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgSubmitSignaturesResponse defines the Msg/SubmitSignatures response type.
+type MsgSubmitSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitSignaturesResponse) Reset() {
+	*x = MsgSubmitSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitSignaturesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// MsgSubmitBatchSignatures defines the Msg/SubmitBatchSignatures request type.
+type MsgSubmitBatchSignatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// signing batch id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// signatures for each pending signing request of the batch
+	Signatures []*BatchSignatures `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgSubmitBatchSignatures) Reset() {
+	*x = MsgSubmitBatchSignatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitBatchSignatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitBatchSignatures) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitBatchSignatures.ProtoReflect.Descriptor instead.
+func (*MsgSubmitBatchSignatures) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgSubmitBatchSignatures) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgSubmitBatchSignatures) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSubmitBatchSignatures) GetSignatures() []*BatchSignatures {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgSubmitBatchSignaturesResponse defines the Msg/SubmitBatchSignatures response type.
+type MsgSubmitBatchSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitBatchSignaturesResponse) Reset() {
+	*x = MsgSubmitBatchSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitBatchSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitBatchSignaturesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitBatchSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitBatchSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgAcknowledgeSigning defines the Msg/AcknowledgeSigning request type.
+type MsgAcknowledgeSigning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// signing request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *MsgAcknowledgeSigning) Reset() {
+	*x = MsgAcknowledgeSigning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcknowledgeSigning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcknowledgeSigning) ProtoMessage() {}
+
+// Deprecated: Use MsgAcknowledgeSigning.ProtoReflect.Descriptor instead.
+func (*MsgAcknowledgeSigning) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgAcknowledgeSigning) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgAcknowledgeSigning) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgAcknowledgeSigning) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *MsgAcknowledgeSigning) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// MsgAcknowledgeSigningResponse defines the Msg/AcknowledgeSigning response type.
+type MsgAcknowledgeSigningResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAcknowledgeSigningResponse) Reset() {
+	*x = MsgAcknowledgeSigningResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcknowledgeSigningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcknowledgeSigningResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAcknowledgeSigningResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcknowledgeSigningResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgSubmitPartialSignatures defines the Msg/SubmitPartialSignatures request type.
+type MsgSubmitPartialSignatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// signing request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded public nonces of the participant, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// hex encoded partial signatures, one for each sig hash
+	PartialSignatures []string `protobuf:"bytes,5,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// consensus pub keys of the participants which jointly sign in the same session
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+	// hex encoded aggregated public nonces of the signers, one for each sig hash
+	AggregatedNonces []string `protobuf:"bytes,8,rep,name=aggregated_nonces,json=aggregatedNonces,proto3" json:"aggregated_nonces,omitempty"`
+}
+
+func (x *MsgSubmitPartialSignatures) Reset() {
+	*x = MsgSubmitPartialSignatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitPartialSignatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitPartialSignatures) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitPartialSignatures.ProtoReflect.Descriptor instead.
+func (*MsgSubmitPartialSignatures) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgSubmitPartialSignatures) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgSubmitPartialSignatures) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSubmitPartialSignatures) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+func (x *MsgSubmitPartialSignatures) GetNonces() []string {
+	if x != nil {
+		return x.Nonces
+	}
+	return nil
+}
+
+func (x *MsgSubmitPartialSignatures) GetPartialSignatures() []string {
+	if x != nil {
+		return x.PartialSignatures
+	}
+	return nil
+}
+
+func (x *MsgSubmitPartialSignatures) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *MsgSubmitPartialSignatures) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *MsgSubmitPartialSignatures) GetAggregatedNonces() []string {
+	if x != nil {
+		return x.AggregatedNonces
+	}
+	return nil
+}
+
+// MsgSubmitPartialSignaturesResponse defines the Msg/SubmitPartialSignatures response type.
+type MsgSubmitPartialSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitPartialSignaturesResponse) Reset() {
+	*x = MsgSubmitPartialSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitPartialSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitPartialSignaturesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitPartialSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitPartialSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgClaimRewards defines the Msg/ClaimRewards request type.
+type MsgClaimRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender, i.e. the account of the validator operator corresponding to the participant
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+}
+
+func (x *MsgClaimRewards) Reset() {
+	*x = MsgClaimRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgClaimRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimRewards) ProtoMessage() {}
+
+// Deprecated: Use MsgClaimRewards.ProtoReflect.Descriptor instead.
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgClaimRewards) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgClaimRewards) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
+
+// MsgClaimRewardsResponse defines the Msg/ClaimRewards response type.
+type MsgClaimRewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// claimed rewards
+	Rewards []*v1beta1.Coin `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *MsgClaimRewardsResponse) Reset() {
+	*x = MsgClaimRewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgClaimRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimRewardsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgClaimRewardsResponse.ProtoReflect.Descriptor instead.
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgClaimRewardsResponse) GetRewards() []*v1beta1.Coin {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// MsgRequestSignature defines the Msg/RequestSignature request type.
+type MsgRequestSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// DKG id
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// hex encoded pub key to sign with; default to the first pub key of the DKG if empty
+	PubKey string `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signing type
+	Type_ SigningType `protobuf:"varint,4,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// base64 encoded sig hashes; mutually exclusive with psbt
+	SigHashes []string `protobuf:"bytes,5,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	// base64 encoded psbt with witness utxos; mutually exclusive with sig hashes
+	Psbt string `protobuf:"bytes,6,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// signing options
+	Options *SigningOptions `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *MsgRequestSignature) Reset() {
+	*x = MsgRequestSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bitway_tss_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestSignature) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestSignature.ProtoReflect.Descriptor instead.
+func (*MsgRequestSignature) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgRequestSignature) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
 }
 
-func (*MsgCompleteDKG) ProtoMessage() {}
-
-// Deprecated: Use MsgCompleteDKG.ProtoReflect.Descriptor instead.
-func (*MsgCompleteDKG) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{0}
+func (x *MsgRequestSignature) GetDkgId() uint64 {
+	if x != nil {
+		return x.DkgId
+	}
+	return 0
 }
 
-func (x *MsgCompleteDKG) GetSender() string {
+func (x *MsgRequestSignature) GetPubKey() string {
 	if x != nil {
-		return x.Sender
+		return x.PubKey
 	}
 	return ""
 }
 
-func (x *MsgCompleteDKG) GetId() uint64 {
+func (x *MsgRequestSignature) GetType_() SigningType {
 	if x != nil {
-		return x.Id
+		return x.Type_
 	}
-	return 0
+	return SigningType_SIGNING_TYPE_SCHNORR
 }
 
-func (x *MsgCompleteDKG) GetPubKeys() []string {
+func (x *MsgRequestSignature) GetSigHashes() []string {
 	if x != nil {
-		return x.PubKeys
+		return x.SigHashes
 	}
 	return nil
 }
 
-func (x *MsgCompleteDKG) GetConsensusPubkey() string {
+func (x *MsgRequestSignature) GetPsbt() string {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Psbt
 	}
 	return ""
 }
 
-func (x *MsgCompleteDKG) GetSignature() string {
+func (x *MsgRequestSignature) GetOptions() *SigningOptions {
 	if x != nil {
-		return x.Signature
+		return x.Options
 	}
-	return ""
+	return nil
 }
 
-// MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
-type MsgCompleteDKGResponse struct {
+// MsgRequestSignatureResponse defines the Msg/RequestSignature response type.
+type MsgRequestSignatureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// gov signature request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgCompleteDKGResponse) Reset() {
-	*x = MsgCompleteDKGResponse{}
+func (x *MsgRequestSignatureResponse) Reset() {
+	*x = MsgRequestSignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[1]
+		mi := &file_bitway_tss_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCompleteDKGResponse) String() string {
+func (x *MsgRequestSignatureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCompleteDKGResponse) ProtoMessage() {}
+func (*MsgRequestSignatureResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCompleteDKGResponse.ProtoReflect.Descriptor instead.
-func (*MsgCompleteDKGResponse) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRequestSignatureResponse.ProtoReflect.Descriptor instead.
+func (*MsgRequestSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{13}
 }
 
-// MsgSubmitSignatures defines the Msg/SubmitSignatures request type.
-type MsgSubmitSignatures struct {
+func (x *MsgRequestSignatureResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MsgInitiateDKG defines the Msg/InitiateDKG request type.
+type MsgInitiateDKG struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender     string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Id         uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Signatures []string `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
-}
-
-func (x *MsgSubmitSignatures) Reset() {
-	*x = MsgSubmitSignatures{}
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// participant set
+	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// number of keys to be generated
+	BatchSize uint32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// key type
+	KeyType KeyType `protobuf:"varint,5,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
+	// timeout duration; default to the DKG timeout duration param if zero
+	TimeoutDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
+}
+
+func (x *MsgInitiateDKG) Reset() {
+	*x = MsgInitiateDKG{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[2]
+		mi := &file_bitway_tss_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSignatures) String() string {
+func (x *MsgInitiateDKG) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSignatures) ProtoMessage() {}
+func (*MsgInitiateDKG) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSignatures.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSignatures) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgInitiateDKG.ProtoReflect.Descriptor instead.
+func (*MsgInitiateDKG) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgSubmitSignatures) GetSender() string {
+func (x *MsgInitiateDKG) GetAuthority() string {
 	if x != nil {
-		return x.Sender
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgSubmitSignatures) GetId() uint64 {
+func (x *MsgInitiateDKG) GetParticipants() []string {
 	if x != nil {
-		return x.Id
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *MsgInitiateDKG) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
 	}
 	return 0
 }
 
-func (x *MsgSubmitSignatures) GetSignatures() []string {
+func (x *MsgInitiateDKG) GetBatchSize() uint32 {
 	if x != nil {
-		return x.Signatures
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *MsgInitiateDKG) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
+func (x *MsgInitiateDKG) GetTimeoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TimeoutDuration
 	}
 	return nil
 }
 
-// MsgSubmitSignaturesResponse defines the Msg/SubmitSignatures response type.
-type MsgSubmitSignaturesResponse struct {
+// MsgInitiateDKGResponse defines the Msg/InitiateDKG response type.
+type MsgInitiateDKGResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// DKG id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgSubmitSignaturesResponse) Reset() {
-	*x = MsgSubmitSignaturesResponse{}
+func (x *MsgInitiateDKGResponse) Reset() {
+	*x = MsgInitiateDKGResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[3]
+		mi := &file_bitway_tss_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSignaturesResponse) String() string {
+func (x *MsgInitiateDKGResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSignaturesResponse) ProtoMessage() {}
+func (*MsgInitiateDKGResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSignaturesResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSignaturesResponse) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgInitiateDKGResponse.ProtoReflect.Descriptor instead.
+func (*MsgInitiateDKGResponse) Descriptor() ([]byte, []int) {
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgInitiateDKGResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 // MsgRefresh defines the Msg/Refresh request type.
@@ -5211,12 +13167,16 @@ type MsgRefresh struct {
 	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
 	// timeout duration per DKG refreshing
 	TimeoutDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
+	// added participant set
+	AddedParticipants []string `protobuf:"bytes,5,rep,name=added_participants,json=addedParticipants,proto3" json:"added_participants,omitempty"`
+	// new threshold; 0 means unchanged
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (x *MsgRefresh) Reset() {
 	*x = MsgRefresh{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[4]
+		mi := &file_bitway_tss_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5230,7 +13190,7 @@ func (*MsgRefresh) ProtoMessage() {}
 
 // Deprecated: Use MsgRefresh.ProtoReflect.Descriptor instead.
 func (*MsgRefresh) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{4}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgRefresh) GetAuthority() string {
@@ -5261,6 +13221,20 @@ func (x *MsgRefresh) GetTimeoutDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *MsgRefresh) GetAddedParticipants() []string {
+	if x != nil {
+		return x.AddedParticipants
+	}
+	return nil
+}
+
+func (x *MsgRefresh) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
 // MsgRefreshResponse defines the Msg/Refresh response type.
 type MsgRefreshResponse struct {
 	state         protoimpl.MessageState
@@ -5271,7 +13245,7 @@ type MsgRefreshResponse struct {
 func (x *MsgRefreshResponse) Reset() {
 	*x = MsgRefreshResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[5]
+		mi := &file_bitway_tss_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5285,7 +13259,7 @@ func (*MsgRefreshResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRefreshResponse.ProtoReflect.Descriptor instead.
 func (*MsgRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{5}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{17}
 }
 
 // MsgCompleteRefreshing defines the Msg/CompleteRefreshing request type.
@@ -5302,12 +13276,15 @@ type MsgCompleteRefreshing struct {
 	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 	// hex encoded participant signature
 	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded compressed verification shares of the refreshed participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,5,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
 func (x *MsgCompleteRefreshing) Reset() {
 	*x = MsgCompleteRefreshing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[6]
+		mi := &file_bitway_tss_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5321,7 +13298,7 @@ func (*MsgCompleteRefreshing) ProtoMessage() {}
 
 // Deprecated: Use MsgCompleteRefreshing.ProtoReflect.Descriptor instead.
 func (*MsgCompleteRefreshing) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{6}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MsgCompleteRefreshing) GetSender() string {
@@ -5352,6 +13329,13 @@ func (x *MsgCompleteRefreshing) GetSignature() string {
 	return ""
 }
 
+func (x *MsgCompleteRefreshing) GetVerificationShares() []string {
+	if x != nil {
+		return x.VerificationShares
+	}
+	return nil
+}
+
 // MsgCompleteRefreshingResponse defines the Msg/CompleteRefreshing response type.
 type MsgCompleteRefreshingResponse struct {
 	state         protoimpl.MessageState
@@ -5362,7 +13346,7 @@ type MsgCompleteRefreshingResponse struct {
 func (x *MsgCompleteRefreshingResponse) Reset() {
 	*x = MsgCompleteRefreshingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[7]
+		mi := &file_bitway_tss_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5376,7 +13360,7 @@ func (*MsgCompleteRefreshingResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCompleteRefreshingResponse.ProtoReflect.Descriptor instead.
 func (*MsgCompleteRefreshingResponse) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{7}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{19}
 }
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
@@ -5398,7 +13382,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[8]
+		mi := &file_bitway_tss_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5412,7 +13396,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{8}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -5441,7 +13425,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bitway_tss_tx_proto_msgTypes[9]
+		mi := &file_bitway_tss_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5455,7 +13439,7 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{9}
+	return file_bitway_tss_tx_proto_rawDescGZIP(), []int{21}
 }
 
 var File_bitway_tss_tx_proto protoreflect.FileDescriptor
@@ -5468,101 +13452,252 @@ var file_bitway_tss_tx_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x17, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x4d, 0x73,
-	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12,
-	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x6a, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xf2, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x4b, 0x47, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a,
+	0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e,
 	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b,
 	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x6b, 0x67, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x73,
-	0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
-	0x1f, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x4d, 0x73,
-	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xb2, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x4d, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x12, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
-	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x44, 0x4b, 0x47, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x69,
-	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x27, 0x2e, 0x62,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x12, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x1a, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x21,
-	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a,
+	0x15, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x3a, 0x0b, 0x82, 0xe7,
+	0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x89, 0x02, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73,
+	0x62, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x28, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x06, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x10,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xc6, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43,
 	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x1a, 0x29, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x62,
-	0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x8f, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69,
-	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61,
-	0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73,
-	0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
-	0x54, 0x73, 0x73, 0xca, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73,
-	0xe2, 0x02, 0x16, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77,
-	0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf5, 0x07, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x4d, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x12, 0x1a,
+	0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x15,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x2c, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x1a, 0x29, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
+	0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x1a, 0x2e, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x23, 0x2e,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x12,
+	0x1a, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x1a, 0x22, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x65, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x16, 0x2e, 0x62, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x1a, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61,
+	0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x1a, 0x29, 0x2e, 0x62, 0x69,
+	0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x73, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42,
+	0x8f, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x74,
+	0x73, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x73, 0x73, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58,
+	0xaa, 0x02, 0x0a, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x73, 0x73, 0xca, 0x02, 0x0a,
+	0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0xe2, 0x02, 0x16, 0x42, 0x69, 0x74,
+	0x77, 0x61, 0x79, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x42, 0x69, 0x74, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x54, 0x73,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5577,39 +13712,74 @@ func file_bitway_tss_tx_proto_rawDescGZIP() []byte {
 	return file_bitway_tss_tx_proto_rawDescData
 }
 
-var file_bitway_tss_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_bitway_tss_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_bitway_tss_tx_proto_goTypes = []interface{}{
-	(*MsgCompleteDKG)(nil),                // 0: bitway.tss.MsgCompleteDKG
-	(*MsgCompleteDKGResponse)(nil),        // 1: bitway.tss.MsgCompleteDKGResponse
-	(*MsgSubmitSignatures)(nil),           // 2: bitway.tss.MsgSubmitSignatures
-	(*MsgSubmitSignaturesResponse)(nil),   // 3: bitway.tss.MsgSubmitSignaturesResponse
-	(*MsgRefresh)(nil),                    // 4: bitway.tss.MsgRefresh
-	(*MsgRefreshResponse)(nil),            // 5: bitway.tss.MsgRefreshResponse
-	(*MsgCompleteRefreshing)(nil),         // 6: bitway.tss.MsgCompleteRefreshing
-	(*MsgCompleteRefreshingResponse)(nil), // 7: bitway.tss.MsgCompleteRefreshingResponse
-	(*MsgUpdateParams)(nil),               // 8: bitway.tss.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),       // 9: bitway.tss.MsgUpdateParamsResponse
-	(*durationpb.Duration)(nil),           // 10: google.protobuf.Duration
-	(*Params)(nil),                        // 11: bitway.tss.Params
+	(*MsgCompleteDKG)(nil),                     // 0: bitway.tss.MsgCompleteDKG
+	(*MsgCompleteDKGResponse)(nil),             // 1: bitway.tss.MsgCompleteDKGResponse
+	(*MsgSubmitSignatures)(nil),                // 2: bitway.tss.MsgSubmitSignatures
+	(*MsgSubmitSignaturesResponse)(nil),        // 3: bitway.tss.MsgSubmitSignaturesResponse
+	(*MsgSubmitBatchSignatures)(nil),           // 4: bitway.tss.MsgSubmitBatchSignatures
+	(*MsgSubmitBatchSignaturesResponse)(nil),   // 5: bitway.tss.MsgSubmitBatchSignaturesResponse
+	(*MsgAcknowledgeSigning)(nil),              // 6: bitway.tss.MsgAcknowledgeSigning
+	(*MsgAcknowledgeSigningResponse)(nil),      // 7: bitway.tss.MsgAcknowledgeSigningResponse
+	(*MsgSubmitPartialSignatures)(nil),         // 8: bitway.tss.MsgSubmitPartialSignatures
+	(*MsgSubmitPartialSignaturesResponse)(nil), // 9: bitway.tss.MsgSubmitPartialSignaturesResponse
+	(*MsgClaimRewards)(nil),                    // 10: bitway.tss.MsgClaimRewards
+	(*MsgClaimRewardsResponse)(nil),            // 11: bitway.tss.MsgClaimRewardsResponse
+	(*MsgRequestSignature)(nil),                // 12: bitway.tss.MsgRequestSignature
+	(*MsgRequestSignatureResponse)(nil),        // 13: bitway.tss.MsgRequestSignatureResponse
+	(*MsgInitiateDKG)(nil),                     // 14: bitway.tss.MsgInitiateDKG
+	(*MsgInitiateDKGResponse)(nil),             // 15: bitway.tss.MsgInitiateDKGResponse
+	(*MsgRefresh)(nil),                         // 16: bitway.tss.MsgRefresh
+	(*MsgRefreshResponse)(nil),                 // 17: bitway.tss.MsgRefreshResponse
+	(*MsgCompleteRefreshing)(nil),              // 18: bitway.tss.MsgCompleteRefreshing
+	(*MsgCompleteRefreshingResponse)(nil),      // 19: bitway.tss.MsgCompleteRefreshingResponse
+	(*MsgUpdateParams)(nil),                    // 20: bitway.tss.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 21: bitway.tss.MsgUpdateParamsResponse
+	(*BatchSignatures)(nil),                    // 22: bitway.tss.BatchSignatures
+	(*v1beta1.Coin)(nil),                       // 23: cosmos.base.v1beta1.Coin
+	(SigningType)(0),                           // 24: bitway.tss.SigningType
+	(*SigningOptions)(nil),                     // 25: bitway.tss.SigningOptions
+	(KeyType)(0),                               // 26: bitway.tss.KeyType
+	(*durationpb.Duration)(nil),                // 27: google.protobuf.Duration
+	(*Params)(nil),                             // 28: bitway.tss.Params
 }
 var file_bitway_tss_tx_proto_depIdxs = []int32{
-	10, // 0: bitway.tss.MsgRefresh.timeout_duration:type_name -> google.protobuf.Duration
-	11, // 1: bitway.tss.MsgUpdateParams.params:type_name -> bitway.tss.Params
-	0,  // 2: bitway.tss.Msg.CompleteDKG:input_type -> bitway.tss.MsgCompleteDKG
-	2,  // 3: bitway.tss.Msg.SubmitSignatures:input_type -> bitway.tss.MsgSubmitSignatures
-	4,  // 4: bitway.tss.Msg.Refresh:input_type -> bitway.tss.MsgRefresh
-	6,  // 5: bitway.tss.Msg.CompleteRefreshing:input_type -> bitway.tss.MsgCompleteRefreshing
-	8,  // 6: bitway.tss.Msg.UpdateParams:input_type -> bitway.tss.MsgUpdateParams
-	1,  // 7: bitway.tss.Msg.CompleteDKG:output_type -> bitway.tss.MsgCompleteDKGResponse
-	3,  // 8: bitway.tss.Msg.SubmitSignatures:output_type -> bitway.tss.MsgSubmitSignaturesResponse
-	5,  // 9: bitway.tss.Msg.Refresh:output_type -> bitway.tss.MsgRefreshResponse
-	7,  // 10: bitway.tss.Msg.CompleteRefreshing:output_type -> bitway.tss.MsgCompleteRefreshingResponse
-	9,  // 11: bitway.tss.Msg.UpdateParams:output_type -> bitway.tss.MsgUpdateParamsResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	22, // 0: bitway.tss.MsgSubmitBatchSignatures.signatures:type_name -> bitway.tss.BatchSignatures
+	23, // 1: bitway.tss.MsgClaimRewardsResponse.rewards:type_name -> cosmos.base.v1beta1.Coin
+	24, // 2: bitway.tss.MsgRequestSignature.type:type_name -> bitway.tss.SigningType
+	25, // 3: bitway.tss.MsgRequestSignature.options:type_name -> bitway.tss.SigningOptions
+	26, // 4: bitway.tss.MsgInitiateDKG.key_type:type_name -> bitway.tss.KeyType
+	27, // 5: bitway.tss.MsgInitiateDKG.timeout_duration:type_name -> google.protobuf.Duration
+	27, // 6: bitway.tss.MsgRefresh.timeout_duration:type_name -> google.protobuf.Duration
+	28, // 7: bitway.tss.MsgUpdateParams.params:type_name -> bitway.tss.Params
+	0,  // 8: bitway.tss.Msg.CompleteDKG:input_type -> bitway.tss.MsgCompleteDKG
+	2,  // 9: bitway.tss.Msg.SubmitSignatures:input_type -> bitway.tss.MsgSubmitSignatures
+	4,  // 10: bitway.tss.Msg.SubmitBatchSignatures:input_type -> bitway.tss.MsgSubmitBatchSignatures
+	6,  // 11: bitway.tss.Msg.AcknowledgeSigning:input_type -> bitway.tss.MsgAcknowledgeSigning
+	8,  // 12: bitway.tss.Msg.SubmitPartialSignatures:input_type -> bitway.tss.MsgSubmitPartialSignatures
+	10, // 13: bitway.tss.Msg.ClaimRewards:input_type -> bitway.tss.MsgClaimRewards
+	12, // 14: bitway.tss.Msg.RequestSignature:input_type -> bitway.tss.MsgRequestSignature
+	14, // 15: bitway.tss.Msg.InitiateDKG:input_type -> bitway.tss.MsgInitiateDKG
+	16, // 16: bitway.tss.Msg.Refresh:input_type -> bitway.tss.MsgRefresh
+	18, // 17: bitway.tss.Msg.CompleteRefreshing:input_type -> bitway.tss.MsgCompleteRefreshing
+	20, // 18: bitway.tss.Msg.UpdateParams:input_type -> bitway.tss.MsgUpdateParams
+	1,  // 19: bitway.tss.Msg.CompleteDKG:output_type -> bitway.tss.MsgCompleteDKGResponse
+	3,  // 20: bitway.tss.Msg.SubmitSignatures:output_type -> bitway.tss.MsgSubmitSignaturesResponse
+	5,  // 21: bitway.tss.Msg.SubmitBatchSignatures:output_type -> bitway.tss.MsgSubmitBatchSignaturesResponse
+	7,  // 22: bitway.tss.Msg.AcknowledgeSigning:output_type -> bitway.tss.MsgAcknowledgeSigningResponse
+	9,  // 23: bitway.tss.Msg.SubmitPartialSignatures:output_type -> bitway.tss.MsgSubmitPartialSignaturesResponse
+	11, // 24: bitway.tss.Msg.ClaimRewards:output_type -> bitway.tss.MsgClaimRewardsResponse
+	13, // 25: bitway.tss.Msg.RequestSignature:output_type -> bitway.tss.MsgRequestSignatureResponse
+	15, // 26: bitway.tss.Msg.InitiateDKG:output_type -> bitway.tss.MsgInitiateDKGResponse
+	17, // 27: bitway.tss.Msg.Refresh:output_type -> bitway.tss.MsgRefreshResponse
+	19, // 28: bitway.tss.Msg.CompleteRefreshing:output_type -> bitway.tss.MsgCompleteRefreshingResponse
+	21, // 29: bitway.tss.Msg.UpdateParams:output_type -> bitway.tss.MsgUpdateParamsResponse
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_bitway_tss_tx_proto_init() }
@@ -5618,6 +13788,7 @@ func file_bitway_tss_tx_proto_init() {
 		return
 	}
 	file_bitway_tss_params_proto_init()
+	file_bitway_tss_tss_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_bitway_tss_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgCompleteDKG); i {
@@ -5668,7 +13839,7 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRefresh); i {
+			switch v := v.(*MsgSubmitBatchSignatures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5680,7 +13851,7 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRefreshResponse); i {
+			switch v := v.(*MsgSubmitBatchSignaturesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5692,7 +13863,7 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCompleteRefreshing); i {
+			switch v := v.(*MsgAcknowledgeSigning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5704,7 +13875,7 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCompleteRefreshingResponse); i {
+			switch v := v.(*MsgAcknowledgeSigningResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5716,7 +13887,7 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgSubmitPartialSignatures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5728,6 +13899,150 @@ func file_bitway_tss_tx_proto_init() {
 			}
 		}
 		file_bitway_tss_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitPartialSignaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClaimRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClaimRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestSignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgInitiateDKG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgInitiateDKGResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRefresh); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCompleteRefreshing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCompleteRefreshingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bitway_tss_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -5746,7 +14061,7 @@ func file_bitway_tss_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bitway_tss_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
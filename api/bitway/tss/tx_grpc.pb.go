@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CompleteDKG_FullMethodName        = "/bitway.tss.Msg/CompleteDKG"
-	Msg_SubmitSignatures_FullMethodName   = "/bitway.tss.Msg/SubmitSignatures"
-	Msg_Refresh_FullMethodName            = "/bitway.tss.Msg/Refresh"
-	Msg_CompleteRefreshing_FullMethodName = "/bitway.tss.Msg/CompleteRefreshing"
-	Msg_UpdateParams_FullMethodName       = "/bitway.tss.Msg/UpdateParams"
+	Msg_CompleteDKG_FullMethodName             = "/bitway.tss.Msg/CompleteDKG"
+	Msg_SubmitSignatures_FullMethodName        = "/bitway.tss.Msg/SubmitSignatures"
+	Msg_SubmitBatchSignatures_FullMethodName   = "/bitway.tss.Msg/SubmitBatchSignatures"
+	Msg_AcknowledgeSigning_FullMethodName      = "/bitway.tss.Msg/AcknowledgeSigning"
+	Msg_SubmitPartialSignatures_FullMethodName = "/bitway.tss.Msg/SubmitPartialSignatures"
+	Msg_ClaimRewards_FullMethodName            = "/bitway.tss.Msg/ClaimRewards"
+	Msg_RequestSignature_FullMethodName        = "/bitway.tss.Msg/RequestSignature"
+	Msg_InitiateDKG_FullMethodName             = "/bitway.tss.Msg/InitiateDKG"
+	Msg_Refresh_FullMethodName                 = "/bitway.tss.Msg/Refresh"
+	Msg_CompleteRefreshing_FullMethodName      = "/bitway.tss.Msg/CompleteRefreshing"
+	Msg_UpdateParams_FullMethodName            = "/bitway.tss.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +40,18 @@ type MsgClient interface {
 	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
 	// SubmitSignatures submits signatures.
 	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
+	// SubmitBatchSignatures submits signatures for all pending signing requests of the given batch.
+	SubmitBatchSignatures(ctx context.Context, in *MsgSubmitBatchSignatures, opts ...grpc.CallOption) (*MsgSubmitBatchSignaturesResponse, error)
+	// AcknowledgeSigning acknowledges the participation in the given signing request by the participant.
+	AcknowledgeSigning(ctx context.Context, in *MsgAcknowledgeSigning, opts ...grpc.CallOption) (*MsgAcknowledgeSigningResponse, error)
+	// SubmitPartialSignatures submits the partial signatures of the given signing request by the participant.
+	SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error)
+	// ClaimRewards claims the signing rewards of the participant by the corresponding validator operator.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// RequestSignature requests the signatures by the given DKG on behalf of the governance.
+	RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error)
+	// InitiateDKG initiates the DKG request to generate keys of the given key type on behalf of the governance.
+	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
 	// Refresh refreshes key shares.
 	Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error)
 	// CompleteRefreshing completes the given refreshing request by the participant.
@@ -71,6 +89,60 @@ func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignature
 	return out, nil
 }
 
+func (c *msgClient) SubmitBatchSignatures(ctx context.Context, in *MsgSubmitBatchSignatures, opts ...grpc.CallOption) (*MsgSubmitBatchSignaturesResponse, error) {
+	out := new(MsgSubmitBatchSignaturesResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitBatchSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeSigning(ctx context.Context, in *MsgAcknowledgeSigning, opts ...grpc.CallOption) (*MsgAcknowledgeSigningResponse, error) {
+	out := new(MsgAcknowledgeSigningResponse)
+	err := c.cc.Invoke(ctx, Msg_AcknowledgeSigning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error) {
+	out := new(MsgSubmitPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitPartialSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error) {
+	out := new(MsgRequestSignatureResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestSignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
+	out := new(MsgInitiateDKGResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateDKG_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error) {
 	out := new(MsgRefreshResponse)
 	err := c.cc.Invoke(ctx, Msg_Refresh_FullMethodName, in, out, opts...)
@@ -106,6 +178,18 @@ type MsgServer interface {
 	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
 	// SubmitSignatures submits signatures.
 	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
+	// SubmitBatchSignatures submits signatures for all pending signing requests of the given batch.
+	SubmitBatchSignatures(context.Context, *MsgSubmitBatchSignatures) (*MsgSubmitBatchSignaturesResponse, error)
+	// AcknowledgeSigning acknowledges the participation in the given signing request by the participant.
+	AcknowledgeSigning(context.Context, *MsgAcknowledgeSigning) (*MsgAcknowledgeSigningResponse, error)
+	// SubmitPartialSignatures submits the partial signatures of the given signing request by the participant.
+	SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error)
+	// ClaimRewards claims the signing rewards of the participant by the corresponding validator operator.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// RequestSignature requests the signatures by the given DKG on behalf of the governance.
+	RequestSignature(context.Context, *MsgRequestSignature) (*MsgRequestSignatureResponse, error)
+	// InitiateDKG initiates the DKG request to generate keys of the given key type on behalf of the governance.
+	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
 	// Refresh refreshes key shares.
 	Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error)
 	// CompleteRefreshing completes the given refreshing request by the participant.
@@ -128,6 +212,24 @@ func (UnimplementedMsgServer) CompleteDKG(context.Context, *MsgCompleteDKG) (*Ms
 func (UnimplementedMsgServer) SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
 }
+func (UnimplementedMsgServer) SubmitBatchSignatures(context.Context, *MsgSubmitBatchSignatures) (*MsgSubmitBatchSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatchSignatures not implemented")
+}
+func (UnimplementedMsgServer) AcknowledgeSigning(context.Context, *MsgAcknowledgeSigning) (*MsgAcknowledgeSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeSigning not implemented")
+}
+func (UnimplementedMsgServer) SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPartialSignatures not implemented")
+}
+func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) RequestSignature(context.Context, *MsgRequestSignature) (*MsgRequestSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSignature not implemented")
+}
+func (UnimplementedMsgServer) InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+}
 func (UnimplementedMsgServer) Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
 }
@@ -186,6 +288,114 @@ func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitBatchSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBatchSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBatchSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitBatchSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBatchSignatures(ctx, req.(*MsgSubmitBatchSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeSigning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcknowledgeSigning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeSigning(ctx, req.(*MsgAcknowledgeSigning))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPartialSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitPartialSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, req.(*MsgSubmitPartialSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSignature(ctx, req.(*MsgRequestSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDKG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateDKG_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRefresh)
 	if err := dec(in); err != nil {
@@ -255,6 +465,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitSignatures",
 			Handler:    _Msg_SubmitSignatures_Handler,
 		},
+		{
+			MethodName: "SubmitBatchSignatures",
+			Handler:    _Msg_SubmitBatchSignatures_Handler,
+		},
+		{
+			MethodName: "AcknowledgeSigning",
+			Handler:    _Msg_AcknowledgeSigning_Handler,
+		},
+		{
+			MethodName: "SubmitPartialSignatures",
+			Handler:    _Msg_SubmitPartialSignatures_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "RequestSignature",
+			Handler:    _Msg_RequestSignature_Handler,
+		},
+		{
+			MethodName: "InitiateDKG",
+			Handler:    _Msg_InitiateDKG_Handler,
+		},
 		{
 			MethodName: "Refresh",
 			Handler:    _Msg_Refresh_Handler,
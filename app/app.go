@@ -237,6 +237,8 @@ var (
 		tsstypes.ModuleName:                 nil,
 		btcbridgetypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 		btcbridgetypes.FeeSponsorName:       nil,
+		btcbridgetypes.RelayerBondPoolName:  {authtypes.Burner},
+		btcbridgetypes.QuarantineName:       nil,
 		incentivetypes.ModuleName:           nil,
 		liquidationtypes.ModuleName:         nil,
 		dlctypes.ModuleName:                 nil,
@@ -335,6 +337,14 @@ type App struct {
 
 	// vote extension handler
 	voteExtensionHandler oracleabci.PriceOracleVoteExtHandler
+
+	// in-process tss committee for local devnets
+	tssSimulator TSSSimulator
+}
+
+// TSSSimulator defines the in-process TSS committee which processes the pending TSS requests every block
+type TSSSimulator interface {
+	Process(ctx sdk.Context)
 }
 
 // New returns a reference to an initialized blockchain app
@@ -671,7 +681,9 @@ func New(
 		appCodec,
 		keys[tsstypes.StoreKey],
 		keys[tsstypes.MemStoreKey],
+		app.BankKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -741,6 +753,9 @@ func New(
 	// set lending keeper and register tss handler for liquidation module
 	app.LiquidationKeeper.SetLendingKeeper(app.LendingKeeper)
 	app.TSSKeeper.RegisterSigningRequestCompletedHandler(liquidationtypes.ModuleName, app.LiquidationKeeper.SigningCompletedHandler)
+	app.TSSKeeper.RegisterSigningRequestTimeoutHandler(liquidationtypes.ModuleName, app.LiquidationKeeper.SigningTimeoutHandler)
+	app.TSSKeeper.RegisterSigningBatchCompletedHandler(liquidationtypes.ModuleName, app.LiquidationKeeper.SigningBatchCompletedHandler)
+	app.TSSKeeper.RegisterSigningBatchTimeoutHandler(liquidationtypes.ModuleName, app.LiquidationKeeper.SigningBatchTimeoutHandler)
 
 	app.FarmingKeeper = farmingkeeper.NewKeeper(
 		appCodec,
@@ -802,6 +817,7 @@ func New(
 			// insert staking hooks receivers here
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
+			app.TSSKeeper.Hooks(),
 		),
 	)
 
@@ -1049,6 +1065,12 @@ func New(
 	// create vote extension handler
 	app.voteExtensionHandler = oracleabci.NewPriceOracleVoteExtHandler(app.Logger(), app.StakingKeeper, app.OracleKeeper, &oracleConfig)
 
+	// enable the address screening; the off-chain screener is plugged in if configured by the validator
+	app.voteExtensionHandler.SetAddressScreening(app.BtcBridgeKeeper, nil)
+	if len(oracleConfig.ScreenerDenylist) != 0 {
+		app.SetAddressScreener(oracletypes.NewDenylistScreener(oracleConfig.ScreenerDenylist))
+	}
+
 	// initialize BaseApp
 	app.SetAnteHandler(anteHandler)
 	app.SetInitChainer(app.InitChainer)
@@ -1092,6 +1114,17 @@ func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sd
 	return res, nil
 }
 
+// SetAddressScreener plugs in the off-chain address screener used during vote extension
+func (app *App) SetAddressScreener(screener oracletypes.AddressScreener) {
+	app.voteExtensionHandler.SetAddressScreening(app.BtcBridgeKeeper, screener)
+}
+
+// SetTSSSimulator plugs in the in-process TSS committee used by local devnets
+// It must never be set for the production nodes
+func (app *App) SetTSSSimulator(simulator TSSSimulator) {
+	app.tssSimulator = simulator
+}
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 	return app.ModuleManager.BeginBlock(ctx)
@@ -1099,6 +1132,10 @@ func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	if app.tssSimulator != nil {
+		app.tssSimulator.Process(ctx)
+	}
+
 	return app.ModuleManager.EndBlock(ctx)
 }
 
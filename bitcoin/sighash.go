@@ -0,0 +1,111 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+var (
+	ErrUnsupportedScriptType   = errors.New("unsupported script type")
+	ErrMissingWitnessScript    = errors.New("witness script required")
+	ErrMismatchedWitnessScript = errors.New("mismatched witness script")
+)
+
+// CalcSigHash computes the sig hash of the given input according to the script type of the witness utxo
+// Taproot sig hash is computed for taproot inputs and BIP-143 sig hash for segwit v0 inputs
+func CalcSigHash(p *psbt.Packet, idx int, sigHashType txscript.SigHashType) ([]byte, error) {
+	switch txscript.GetScriptClass(p.Inputs[idx].WitnessUtxo.PkScript) {
+	case txscript.WitnessV1TaprootTy:
+		return CalcTaprootSigHash(p, idx, sigHashType)
+
+	case txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+		return CalcSegwitV0SigHash(p, idx, sigHashType)
+
+	default:
+		return nil, ErrUnsupportedScriptType
+	}
+}
+
+// CalcTaprootSigHash computes the sig hash of the given input
+// Assume that the psbt is valid
+func CalcTaprootSigHash(p *psbt.Packet, idx int, sigHashType txscript.SigHashType) ([]byte, error) {
+	prevOutFetcher := getPrevOutFetcher(p)
+
+	sigHash, err := txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(p.UnsignedTx, prevOutFetcher), sigHashType, p.UnsignedTx, idx, prevOutFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigHash, nil
+}
+
+// CalcSegwitV0SigHash computes the BIP-143 sig hash of the given P2WPKH or P2WSH input
+// The witness script is required for the P2WSH input
+func CalcSegwitV0SigHash(p *psbt.Packet, idx int, sigHashType txscript.SigHashType) ([]byte, error) {
+	input := p.Inputs[idx]
+
+	scriptCode, err := GetSegwitV0ScriptCode(input.WitnessUtxo.PkScript, input.WitnessScript)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHash, err := txscript.CalcWitnessSigHash(scriptCode, txscript.NewTxSigHashes(p.UnsignedTx, getPrevOutFetcher(p)), GetSegwitV0SigHashType(sigHashType), p.UnsignedTx, idx, input.WitnessUtxo.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigHash, nil
+}
+
+// GetSegwitV0ScriptCode gets the BIP-143 script code from the given pk script and witness script
+func GetSegwitV0ScriptCode(pkScript []byte, witnessScript []byte) ([]byte, error) {
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.WitnessV0PubKeyHashTy:
+		return txscript.NewScriptBuilder().
+			AddOp(txscript.OP_DUP).
+			AddOp(txscript.OP_HASH160).
+			AddData(pkScript[2:22]).
+			AddOp(txscript.OP_EQUALVERIFY).
+			AddOp(txscript.OP_CHECKSIG).
+			Script()
+
+	case txscript.WitnessV0ScriptHashTy:
+		if len(witnessScript) == 0 {
+			return nil, ErrMissingWitnessScript
+		}
+
+		witnessScriptHash := sha256.Sum256(witnessScript)
+		if !bytes.Equal(pkScript[2:34], witnessScriptHash[:]) {
+			return nil, ErrMismatchedWitnessScript
+		}
+
+		return witnessScript, nil
+
+	default:
+		return nil, ErrUnsupportedScriptType
+	}
+}
+
+// GetSegwitV0SigHashType gets the sig hash type for the segwit v0 input
+// SigHashDefault is only valid for taproot and defaulted to SigHashAll
+func GetSegwitV0SigHashType(sigHashType txscript.SigHashType) txscript.SigHashType {
+	if sigHashType == txscript.SigHashDefault {
+		return txscript.SigHashAll
+	}
+
+	return sigHashType
+}
+
+// getPrevOutFetcher gets the prev out fetcher from the witness utxos of the given psbt
+func getPrevOutFetcher(p *psbt.Packet) *txscript.MultiPrevOutFetcher {
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range p.UnsignedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+	}
+
+	return prevOutFetcher
+}
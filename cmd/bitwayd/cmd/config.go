@@ -67,6 +67,9 @@ bitcoin_rpc_password = ""
 http_post_mode = true
 disable_tls = true
 
+# Optional denylist file used to screen the withdrawal addresses, one address per line. Empty means disabled.
+screener_denylist = ""
+
 `
 
 	return customAppTemplate, customAppConfig
@@ -28,6 +28,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/bitwaylabs/bitway/app"
+	"github.com/bitwaylabs/bitway/testutil/tsssim"
 )
 
 const (
@@ -35,7 +36,9 @@ const (
 )
 
 var (
-	flagAccountsToFund = "accounts-to-fund"
+	flagAccountsToFund   = "accounts-to-fund"
+	flagTSSSimulator     = "tss-simulator"
+	flagTSSSimulatorSeed = "tss-simulator-seed"
 )
 
 type valArgs struct {
@@ -59,6 +62,8 @@ it enables developers to configure their local environments to reflect mainnet c
 	cmd.Example = fmt.Sprintf(`%sd in-place-testnet testing-1 cosmosvaloper1w7f3xx7e75p4l7qdym5msqem9rd4dyc4mq79dm --home $HOME/.%sd/validator1 --validator-privkey=6dq+/KHNvyiw2TToCgOpUpQKIzrLs69Rb8Az39xvmxPHNoPxY1Cil8FY+4DhT9YwD6s0tFABMlLcpaylzKKBOg== --accounts-to-fund="cosmos1f7twgcq4ypzg7y24wuywy06xmdet8pc4473tnq,cosmos1qvuhm5m644660nd8377d6l7yz9e9hhm9evmx3x"`, "bitway", "bitway")
 
 	cmd.Flags().String(flagAccountsToFund, "", "Comma-separated list of account addresses that will be funded for testing purposes")
+	cmd.Flags().Bool(flagTSSSimulator, false, "Complete the TSS requests by the in-process simulator instead of the off-chain signing network")
+	cmd.Flags().String(flagTSSSimulatorSeed, "devnet", "Seed from which the TSS simulator derives the keys")
 	return cmd
 }
 
@@ -78,6 +83,11 @@ func newTestnetApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts s
 		panic(err)
 	}
 
+	if cast.ToBool(appOpts.Get(flagTSSSimulator)) {
+		seed := cast.ToString(appOpts.Get(flagTSSSimulatorSeed))
+		testApp.SetTSSSimulator(tsssim.NewSimulator(*testApp.TSSKeeper, []byte(seed)))
+	}
+
 	return initAppForTestnet(testApp, args)
 }
 
@@ -20,7 +20,6 @@ MAX_GAS=10000000000
 # btcbridge params
 BTC_VAULT=() # ("<address>" "<pk>" "<asset type>")
 RUNES_VAULT=()
-TRUSTED_NON_BTC_RELAYER=""
 TRUSTED_FEE_PROVIDER=""
 PROTOCOL_FEE_COLLECTOR=""
 
@@ -128,11 +127,6 @@ if [[ $overwrite == "y" || $overwrite == "Y" ]]; then
 		jq --arg runes_vault_asset_type "${RUNES_VAULT[2]}" '.app_state["btcbridge"]["params"]["vaults"][1]["asset_type"]=$runes_vault_asset_type' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
     fi
 
-	# set trusted non btc relayer
-	if [ -n "$TRUSTED_NON_BTC_RELAYER" ]; then
-	    jq --arg relayer "$TRUSTED_NON_BTC_RELAYER" '.app_state["btcbridge"]["params"]["trusted_non_btc_relayers"][0]=$relayer' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
-    fi
-
 	# set trusted fee provider
 	if [ -n "$TRUSTED_FEE_PROVIDER" ]; then
 	    jq --arg provider "$TRUSTED_FEE_PROVIDER" '.app_state["btcbridge"]["params"]["trusted_fee_providers"][0]=$provider' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
@@ -45,7 +45,9 @@ func TSSKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		cdc,
 		storeKey,
 		memStoreKey,
+		app.BankKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
 		authority,
 	)
 
@@ -0,0 +1,303 @@
+package tsssim
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+	"github.com/bitwaylabs/bitway/x/btcbridge/keeper"
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// BridgeSimulator acts as a deterministic local TSS committee for the btc bridge vaults
+type BridgeSimulator struct {
+	keeper         keeper.Keeper
+	seed           []byte
+	segwitV0Vaults bool
+}
+
+// NewBridgeSimulator creates a new bridge simulator with the given btcbridge keeper and seed
+// The keys are derived separately from the x/tss keys of the same seed
+func NewBridgeSimulator(k keeper.Keeper, seed []byte) *BridgeSimulator {
+	return &BridgeSimulator{
+		keeper: k,
+		seed:   append(slices.Clone(seed), []byte(types.ModuleName)...),
+	}
+}
+
+// WithSegwitV0Vaults makes the simulator generate P2WPKH vaults instead of taproot vaults
+// Only taproot vaults can be generated if the vault recovery is enabled
+func (s *BridgeSimulator) WithSegwitV0Vaults() *BridgeSimulator {
+	s.segwitV0Vaults = true
+
+	return s
+}
+
+// Process completes all pending DKG requests and signs all pending signing requests
+// The DKG requests are completed by the btcbridge end blocker once all completions are submitted
+// Failed requests are skipped and left for the timeout handling
+func (s *BridgeSimulator) Process(ctx sdk.Context) {
+	for _, req := range s.keeper.GetPendingDKGRequests(ctx) {
+		s.tryProcess(ctx, func(ctx sdk.Context) error { return s.CompleteDKG(ctx, req.Id) })
+	}
+
+	signingRequests, _, err := s.keeper.GetSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING, nil)
+	if err != nil {
+		s.keeper.Logger(ctx).Debug("Bridge simulator failed to get the signing requests", "err", err)
+		return
+	}
+
+	for _, req := range signingRequests {
+		s.tryProcess(ctx, func(ctx sdk.Context) error { return s.SubmitSignatures(ctx, req.Txid) })
+	}
+}
+
+// tryProcess runs the given function in a cache context which is only written on success
+func (s *BridgeSimulator) tryProcess(ctx sdk.Context, fn func(ctx sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		s.keeper.Logger(ctx).Debug("Bridge simulator skipped the request", "err", err)
+		return
+	}
+
+	write()
+}
+
+// CompleteDKG submits the completions with the derived vaults on behalf of all participants of the given DKG request
+// The completions are validated as MsgCompleteDKG except for the participant signatures
+func (s *BridgeSimulator) CompleteDKG(ctx sdk.Context, id uint64) error {
+	if !s.keeper.HasDKGRequest(ctx, id) {
+		return types.ErrDKGRequestDoesNotExist
+	}
+
+	req := s.keeper.GetDKGRequest(ctx, id)
+
+	if req.Status != types.DKGRequestStatus_DKG_REQUEST_STATUS_PENDING {
+		return types.ErrInvalidDKGStatus
+	}
+
+	if !ctx.BlockTime().Before(*req.Expiration) {
+		return errorsmod.Wrap(types.ErrInvalidDKGCompletionRequest, "dkg request expired")
+	}
+
+	vaults, internalKeys, pubKeys, err := s.generateVaults(req)
+	if err != nil {
+		return err
+	}
+
+	if err := s.keeper.CheckVaults(ctx, vaults, req.VaultTypes); err != nil {
+		return err
+	}
+
+	if req.RecoveryParams != nil {
+		if err := types.CheckVaultRecoveries(vaults, internalKeys, req.RecoveryParams); err != nil {
+			return err
+		}
+	}
+
+	if err := types.CheckVaultPubKeys(vaults, pubKeys); err != nil {
+		return err
+	}
+
+	for _, participant := range req.Participants {
+		if s.keeper.HasDKGCompletionRequest(ctx, id, participant.ConsensusPubkey) {
+			continue
+		}
+
+		s.keeper.SetDKGCompletionRequest(ctx, &types.DKGCompletionRequest{
+			Id:              id,
+			Vaults:          vaults,
+			ConsensusPubkey: participant.ConsensusPubkey,
+			InternalKeys:    internalKeys,
+			PubKeys:         pubKeys,
+		})
+	}
+
+	return nil
+}
+
+// SubmitSignatures signs all inputs of the given signing request with the derived vault keys
+// and submits the signatures via MsgSubmitSignatures
+func (s *BridgeSimulator) SubmitSignatures(ctx sdk.Context, txid string) error {
+	if !s.keeper.HasSigningRequestByTxHash(ctx, txid) {
+		return types.ErrSigningRequestDoesNotExist
+	}
+
+	req := s.keeper.GetSigningRequestByTxHash(ctx, txid)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return fmt.Errorf("signing request %s not pending", txid)
+	}
+
+	signatures, err := s.Sign(ctx, req.Psbt)
+	if err != nil {
+		return err
+	}
+
+	_, err = keeper.NewMsgServerImpl(s.keeper).SubmitSignatures(ctx, &types.MsgSubmitSignatures{
+		Sender:     authtypes.NewModuleAddress(types.ModuleName).String(),
+		Txid:       txid,
+		Signatures: signatures,
+	})
+
+	return err
+}
+
+// Sign produces the hex encoded signatures for all inputs of the given psbt
+// Taproot inputs are signed with the tweaked key by schnorr and segwit v0 inputs by ECDSA
+func (s *BridgeSimulator) Sign(ctx sdk.Context, psbtB64 string) ([]string, error) {
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbtB64)), true)
+	if err != nil {
+		return nil, err
+	}
+
+	vaults := s.keeper.GetParams(ctx).Vaults
+
+	signatures := []string{}
+
+	for i, input := range p.Inputs {
+		vault := types.SelectVaultByPkScript(vaults, input.WitnessUtxo.PkScript)
+		if vault == nil {
+			return nil, fmt.Errorf("no vault for input %d", i)
+		}
+
+		privKey, err := s.GetPrivKey(ctx, vault.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		sigHash, err := bitcoin.CalcSigHash(p, i, input.SighashType)
+		if err != nil {
+			return nil, err
+		}
+
+		var sigBytes []byte
+
+		switch txscript.GetScriptClass(input.WitnessUtxo.PkScript) {
+		case txscript.WitnessV1TaprootTy:
+			var merkleRoot []byte
+			if vault.Recovery != nil {
+				if merkleRoot, err = vault.Recovery.GetMerkleRoot(); err != nil {
+					return nil, err
+				}
+			}
+
+			sig, err := schnorr.Sign(txscript.TweakTaprootPrivKey(*privKey, merkleRoot), sigHash)
+			if err != nil {
+				return nil, err
+			}
+
+			sigBytes = sig.Serialize()
+
+		default:
+			sigBytes = ecdsa.Sign(privKey, sigHash).Serialize()
+		}
+
+		signatures = append(signatures, hex.EncodeToString(sigBytes))
+	}
+
+	return signatures, nil
+}
+
+// GetPrivKey gets the private key of the given vault generated by the simulator
+// An error is returned if the vault is not generated by the simulator
+func (s *BridgeSimulator) GetPrivKey(ctx sdk.Context, vault string) (*btcec.PrivateKey, error) {
+	for _, req := range s.keeper.GetDKGRequests(ctx, types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED) {
+		completions := s.keeper.GetDKGCompletionRequests(ctx, req.Id)
+		if len(completions) == 0 {
+			continue
+		}
+
+		index := slices.Index(completions[0].Vaults, vault)
+		if index < 0 {
+			continue
+		}
+
+		vaults, _, _, err := s.generateVaults(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if index >= len(vaults) || vaults[index] != vault {
+			return nil, fmt.Errorf("vault %s not generated by the simulator", vault)
+		}
+
+		return s.PrivKey(req.Id, index), nil
+	}
+
+	return nil, fmt.Errorf("no completed dkg for vault %s", vault)
+}
+
+// PrivKey derives the private key of the given index for the specified DKG request
+func (s *BridgeSimulator) PrivKey(dkgId uint64, index int) *btcec.PrivateKey {
+	return derivePrivKey(s.seed, dkgId, index)
+}
+
+// generateVaults generates the vaults along with the internal keys and pub keys for the given DKG request
+// The taproot vaults commit to the recovery script if the recovery is enabled, or no script otherwise
+func (s *BridgeSimulator) generateVaults(req *types.DKGRequest) ([]string, []string, []string, error) {
+	vaults := []string{}
+	internalKeys := []string{}
+	pubKeys := []string{}
+
+	for i := range req.VaultTypes {
+		privKey := s.PrivKey(req.Id, i)
+		internalKey := hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+
+		var vault string
+
+		switch {
+		case req.RecoveryParams != nil:
+			recovery := &types.VaultRecovery{
+				InternalKey: internalKey,
+				Params:      *req.RecoveryParams,
+			}
+
+			address, err := recovery.GetAddress()
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			vault = address
+			internalKeys = append(internalKeys, internalKey)
+
+		case s.segwitV0Vaults:
+			pubKey := privKey.PubKey().SerializeCompressed()
+
+			address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), bitcoin.Network)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			vault = address.EncodeAddress()
+			pubKeys = append(pubKeys, hex.EncodeToString(pubKey))
+
+		default:
+			outputKey := txscript.ComputeTaprootKeyNoScript(privKey.PubKey())
+
+			address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), bitcoin.Network)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			vault = address.EncodeAddress()
+		}
+
+		vaults = append(vaults, vault)
+	}
+
+	return vaults, internalKeys, pubKeys, nil
+}
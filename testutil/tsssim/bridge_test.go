@@ -0,0 +1,137 @@
+package tsssim_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/bitwaylabs/bitway/app"
+	"github.com/bitwaylabs/bitway/bitcoin"
+	"github.com/bitwaylabs/bitway/testutil/tsssim"
+	btcbridge "github.com/bitwaylabs/bitway/x/btcbridge/module"
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+func TestBridgeSimulator(t *testing.T) {
+	recoveryKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	recoveryParams := types.VaultRecoveryParams{
+		PubKeys:   []string{hex.EncodeToString(schnorr.SerializePubKey(recoveryKey.PubKey()))},
+		Threshold: 1,
+		Timelock:  144,
+	}
+
+	testCases := []struct {
+		name           string
+		segwitV0       bool
+		recoveryParams types.VaultRecoveryParams
+		scriptClass    txscript.ScriptClass
+	}{
+		{"taproot vaults", false, types.VaultRecoveryParams{}, txscript.WitnessV1TaprootTy},
+		{"taproot vaults with recovery", false, recoveryParams, txscript.WitnessV1TaprootTy},
+		{"segwit v0 vaults", true, types.VaultRecoveryParams{}, txscript.WitnessV0PubKeyHashTy},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t)
+			ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+			k := app.BtcBridgeKeeper
+
+			params := k.GetParams(ctx)
+			params.VaultRecoveryParams = tc.recoveryParams
+			k.SetParams(ctx, params)
+
+			participants := []*types.DKGParticipant{}
+			for i := 0; i < 3; i++ {
+				participants = append(participants, &types.DKGParticipant{
+					ConsensusPubkey: base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()),
+				})
+			}
+
+			vaultTypes := []types.AssetType{types.AssetType_ASSET_TYPE_BTC, types.AssetType_ASSET_TYPE_RUNES}
+
+			dkgRequest, err := k.InitiateDKG(ctx, participants, 2, vaultTypes, false, 0)
+			require.NoError(t, err)
+
+			sim := tsssim.NewBridgeSimulator(k, []byte("test"))
+			if tc.segwitV0 {
+				sim = sim.WithSegwitV0Vaults()
+			}
+
+			sim.Process(ctx)
+			btcbridge.EndBlocker(ctx, k)
+
+			require.Equal(t, types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED, k.GetDKGRequest(ctx, dkgRequest.Id).Status)
+
+			btcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, k.GetLatestVaultVersion(ctx))
+			require.NotNil(t, btcVault)
+			require.Equal(t, tc.recoveryParams.Enabled(), btcVault.Recovery != nil)
+
+			btcVaultPkScript := types.MustPkScriptFromAddress(btcVault.Address)
+			require.Equal(t, tc.scriptClass, txscript.GetScriptClass(btcVaultPkScript))
+
+			// the keys are deterministic
+			privKey, err := sim.GetPrivKey(ctx, btcVault.Address)
+			require.NoError(t, err)
+			require.Equal(t, sim.PrivKey(dkgRequest.Id, 0), privKey)
+
+			_, err = tsssim.NewBridgeSimulator(k, []byte("other")).GetPrivKey(ctx, btcVault.Address)
+			require.Error(t, err)
+
+			k.SaveUTXO(ctx, &types.UTXO{
+				Txid:         chainhash.HashH([]byte("payment")).String(),
+				Vout:         1,
+				Address:      btcVault.Address,
+				Amount:       100000000,
+				PubKeyScript: btcVaultPkScript,
+			})
+
+			recipient, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(recoveryKey.PubKey().SerializeCompressed()), bitcoin.Network)
+			require.NoError(t, err)
+
+			withdrawRequest := k.NewWithdrawRequest(ctx, recipient.EncodeAddress(), sdk.NewInt64Coin(params.BtcVoucherDenom, 100000).String())
+
+			signingRequest, err := k.BuildBtcBatchWithdrawSigningRequest(ctx, []*types.WithdrawRequest{withdrawRequest}, 10, btcVault.Address)
+			require.NoError(t, err)
+
+			sim.Process(ctx)
+
+			signingRequest = k.GetSigningRequestByTxHash(ctx, signingRequest.Txid)
+			require.Equal(t, types.SigningStatus_SIGNING_STATUS_BROADCASTED, signingRequest.Status)
+
+			// the finalized tx is valid
+			p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingRequest.Psbt)), true)
+			require.NoError(t, err)
+
+			tx, err := psbt.Extract(p)
+			require.NoError(t, err)
+
+			prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+			for i, txIn := range tx.TxIn {
+				prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+			}
+
+			for i := range tx.TxIn {
+				vm, err := txscript.NewEngine(p.Inputs[i].WitnessUtxo.PkScript, tx, i, txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(tx, prevOutFetcher), p.Inputs[i].WitnessUtxo.Value, prevOutFetcher)
+				require.NoError(t, err)
+				require.NoError(t, vm.Execute())
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+// Package tsssim provides an in-process TSS committee which deterministically completes the DKG,
+// refreshing and signing requests of x/tss as well as the DKG and withdrawal signing requests of x/btcbridge,
+// so that the dependent modules can be tested end to end in keeper tests and local devnets without the off-chain signing network.
+//
+// The keys are derived from the seed and the DKG request, which makes the simulator stateless:
+// any node with the same seed can produce the signatures for the keys generated by the simulator.
+package tsssim
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/bitcoin/crypto/adaptor"
+	"github.com/bitwaylabs/bitway/x/tss/keeper"
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// Simulator acts as a deterministic local TSS committee
+type Simulator struct {
+	keeper keeper.Keeper
+	seed   []byte
+}
+
+// NewSimulator creates a new simulator with the given tss keeper and seed
+func NewSimulator(k keeper.Keeper, seed []byte) *Simulator {
+	return &Simulator{
+		keeper: k,
+		seed:   seed,
+	}
+}
+
+// Process completes all pending DKG, refreshing and signing requests
+// The DKG and refreshing requests are completed by the tss end blocker once all completions are submitted
+// Failed requests are skipped and left for the timeout handling
+func (s *Simulator) Process(ctx sdk.Context) {
+	for _, req := range s.keeper.GetPendingDKGRequests(ctx) {
+		s.tryProcess(ctx, func(ctx sdk.Context) error { return s.CompleteDKG(ctx, req.Id) })
+	}
+
+	for _, req := range s.keeper.GetPendingRefreshingRequests(ctx) {
+		s.tryProcess(ctx, func(ctx sdk.Context) error { return s.CompleteRefreshing(ctx, req.Id) })
+	}
+
+	for _, req := range s.keeper.GetSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING) {
+		s.tryProcess(ctx, func(ctx sdk.Context) error { return s.CompleteSigningRequest(ctx, req.Id) })
+	}
+}
+
+// tryProcess runs the given function in a cache context which is only written on success
+func (s *Simulator) tryProcess(ctx sdk.Context, fn func(ctx sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		s.keeper.Logger(ctx).Debug("TSS simulator skipped the request", "err", err)
+		return
+	}
+
+	write()
+}
+
+// CompleteDKG submits the completions with the derived keys on behalf of all participants of the given DKG request
+func (s *Simulator) CompleteDKG(ctx sdk.Context, id uint64) error {
+	if !s.keeper.HasDKGRequest(ctx, id) {
+		return types.ErrDKGRequestDoesNotExist
+	}
+
+	req := s.keeper.GetDKGRequest(ctx, id)
+
+	if req.Status != types.DKGStatus_DKG_STATUS_PENDING {
+		return types.ErrInvalidDKGStatus
+	}
+
+	if !req.ExpirationTime.IsZero() && !ctx.BlockTime().Before(req.ExpirationTime) {
+		return types.ErrDKGRequestExpired
+	}
+
+	pubKeys := []string{}
+	proofs := []string{}
+
+	for i := 0; i < int(req.BatchSize); i++ {
+		privKey := s.PrivKey(id, i)
+		pubKey := serializePubKey(req.KeyType, privKey.PubKey())
+
+		proof, err := schnorr.Sign(privKey, types.GetPossessionProofSigMsg(id, pubKey))
+		if err != nil {
+			return err
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+		proofs = append(proofs, hex.EncodeToString(proof.Serialize()))
+	}
+
+	for _, participant := range req.Participants {
+		if s.keeper.HasDKGCompletion(ctx, id, participant) {
+			continue
+		}
+
+		s.keeper.SetDKGCompletion(ctx, &types.DKGCompletion{
+			Id:              id,
+			PubKeys:         pubKeys,
+			ConsensusPubkey: participant,
+			Proofs:          proofs,
+		})
+
+		if handler := s.keeper.GetDKGCompletionReceivedHandler(req.Module); handler != nil {
+			if err := handler(ctx, req.Id, req.Type, req.Intent, participant); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CompleteRefreshing submits the completions on behalf of all participants of the given refreshing request
+// The derived keys remain unchanged since only the key shares are refreshed
+func (s *Simulator) CompleteRefreshing(ctx sdk.Context, id uint64) error {
+	if !s.keeper.HasRefreshingRequest(ctx, id) {
+		return types.ErrRefreshingRequestDoesNotExist
+	}
+
+	req := s.keeper.GetRefreshingRequest(ctx, id)
+	if req.Status != types.RefreshingStatus_REFRESHING_STATUS_PENDING {
+		return types.ErrInvalidRefreshingStatus
+	}
+
+	if !req.ExpirationTime.IsZero() && !ctx.BlockTime().Before(req.ExpirationTime) {
+		return types.ErrRefreshingRequestExpired
+	}
+
+	for _, participant := range s.keeper.GetRefreshingParticipants(ctx, req) {
+		if s.keeper.HasRefreshingCompletion(ctx, id, participant) {
+			continue
+		}
+
+		s.keeper.SetRefreshingCompletion(ctx, &types.RefreshingCompletion{
+			Id:              id,
+			ConsensusPubkey: participant,
+		})
+	}
+
+	return nil
+}
+
+// CompleteSigningRequest signs and completes the given signing request on behalf of all participants
+// The module handler is called as if the signatures were submitted by the relayer
+func (s *Simulator) CompleteSigningRequest(ctx sdk.Context, id uint64) error {
+	if !s.keeper.HasSigningRequest(ctx, id) {
+		return types.ErrSigningRequestDoesNotExist
+	}
+
+	req := s.keeper.GetSigningRequest(ctx, id)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return types.ErrInvalidSigningStatus
+	}
+
+	if !req.ExpirationTime.IsZero() && !ctx.BlockTime().Before(req.ExpirationTime) {
+		return types.ErrSigningRequestExpired
+	}
+
+	signatures, err := s.Sign(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err := s.keeper.VerifySignatures(ctx, req, signatures); err != nil {
+		return err
+	}
+
+	return s.keeper.CompleteSigningRequest(ctx, "", req, signatures, s.keeper.GetSigningParticipants(ctx, req), false)
+}
+
+// Sign produces the hex encoded signatures for the given signing request
+func (s *Simulator) Sign(ctx sdk.Context, req *types.SigningRequest) ([]string, error) {
+	privKey, err := s.GetPrivKey(ctx, req.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	options := req.Options
+	if options == nil {
+		options = &types.SigningOptions{}
+	}
+
+	signatures := []string{}
+
+	for i, sigHash := range req.SigHashes {
+		hash, err := base64.StdEncoding.DecodeString(sigHash)
+		if err != nil {
+			return nil, err
+		}
+
+		var sigBytes []byte
+
+		switch req.Type {
+		case types.SigningType_SIGNING_TYPE_SCHNORR:
+			sig, err := schnorr.Sign(privKey, hash)
+			if err != nil {
+				return nil, err
+			}
+
+			sigBytes = sig.Serialize()
+
+		case types.SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK:
+			tweak, err := hex.DecodeString(options.Tweak)
+			if err != nil {
+				return nil, err
+			}
+
+			sig, err := schnorr.Sign(txscript.TweakTaprootPrivKey(*privKey, tweak), hash)
+			if err != nil {
+				return nil, err
+			}
+
+			sigBytes = sig.Serialize()
+
+		case types.SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT:
+			nonce := options.Nonce
+			if len(options.Nonces) != 0 {
+				nonce = options.Nonces[i]
+			}
+
+			noncePrivKey, err := s.GetPrivKey(ctx, nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			sigBytes = signWithNonce(privKey, noncePrivKey, hash)
+
+		case types.SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR:
+			adaptorPoint, err := hex.DecodeString(options.AdaptorPoint)
+			if err != nil {
+				return nil, err
+			}
+
+			// adaptor.Sign verifies against the full pub key, so the key with the even y is required
+			sig, err := adaptor.Sign(evenPrivKey(privKey), hash, adaptorPoint)
+			if err != nil {
+				return nil, err
+			}
+
+			sigBytes = sig.Serialize()
+
+		case types.SigningType_SIGNING_TYPE_ECDSA:
+			sigBytes = ecdsa.Sign(privKey, hash).Serialize()
+
+		default:
+			return nil, fmt.Errorf("unsupported signing type %s", req.Type)
+		}
+
+		signatures = append(signatures, hex.EncodeToString(sigBytes))
+	}
+
+	return signatures, nil
+}
+
+// GetPrivKey gets the private key of the given pub key generated by the simulator
+// An error is returned if the pub key is not generated by the simulator
+func (s *Simulator) GetPrivKey(ctx sdk.Context, pubKey string) (*btcec.PrivateKey, error) {
+	dkgRequest := s.keeper.GetDKGRequestByPubKey(ctx, pubKey)
+	if dkgRequest == nil {
+		return nil, fmt.Errorf("no completed dkg for pub key %s", pubKey)
+	}
+
+	index := slices.Index(s.keeper.GetDKGPubKeys(ctx, dkgRequest.Id), pubKey)
+
+	privKey := s.PrivKey(dkgRequest.Id, index)
+	if serializePubKey(dkgRequest.KeyType, privKey.PubKey()) != pubKey {
+		return nil, fmt.Errorf("pub key %s not generated by the simulator", pubKey)
+	}
+
+	return privKey, nil
+}
+
+// PrivKey derives the private key of the given index for the specified DKG request
+func (s *Simulator) PrivKey(dkgId uint64, index int) *btcec.PrivateKey {
+	return derivePrivKey(s.seed, dkgId, index)
+}
+
+// derivePrivKey derives the private key of the given index for the specified DKG request from the seed
+func derivePrivKey(seed []byte, dkgId uint64, index int) *btcec.PrivateKey {
+	data := slices.Clone(seed)
+	data = binary.BigEndian.AppendUint64(data, dkgId)
+	data = binary.BigEndian.AppendUint32(data, uint32(index))
+
+	for {
+		hash := chainhash.HashB(data)
+
+		var scalar btcec.ModNScalar
+		if overflow := scalar.SetByteSlice(hash); !overflow && !scalar.IsZero() {
+			return btcec.PrivKeyFromScalar(&scalar)
+		}
+
+		// retry with the hash in the negligible case of the invalid scalar
+		data = hash
+	}
+}
+
+// serializePubKey serializes the given pub key according to the key type
+func serializePubKey(keyType types.KeyType, pubKey *btcec.PublicKey) string {
+	if keyType == types.KeyType_KEY_TYPE_ECDSA {
+		return hex.EncodeToString(pubKey.SerializeCompressed())
+	}
+
+	return hex.EncodeToString(schnorr.SerializePubKey(pubKey))
+}
+
+// evenPrivKey returns the private key of which the pub key has the even y and the same x-only pub key
+func evenPrivKey(privKey *btcec.PrivateKey) *btcec.PrivateKey {
+	if privKey.PubKey().SerializeCompressed()[0] == 0x02 {
+		return privKey
+	}
+
+	var d btcec.ModNScalar
+	d.Set(&privKey.Key)
+
+	return btcec.PrivKeyFromScalar(d.Negate())
+}
+
+// signWithNonce produces the BIP340 signature with the given nonce
+func signWithNonce(privKey *btcec.PrivateKey, noncePrivKey *btcec.PrivateKey, hash []byte) []byte {
+	var d, k btcec.ModNScalar
+	d.Set(&privKey.Key)
+	k.Set(&noncePrivKey.Key)
+
+	pubKey := privKey.PubKey()
+	if pubKey.SerializeCompressed()[0] == 0x03 {
+		d.Negate()
+	}
+
+	nonce := noncePrivKey.PubKey()
+	if nonce.SerializeCompressed()[0] == 0x03 {
+		k.Negate()
+	}
+
+	r := schnorr.SerializePubKey(nonce)
+
+	var e btcec.ModNScalar
+	e.SetByteSlice(chainhash.TaggedHash(chainhash.TagBIP0340Challenge, r, schnorr.SerializePubKey(pubKey), hash)[:])
+
+	var rx btcec.FieldVal
+	rx.SetByteSlice(r)
+
+	sig := schnorr.NewSignature(&rx, new(btcec.ModNScalar).Mul2(&e, &d).Add(&k))
+
+	return sig.Serialize()
+}
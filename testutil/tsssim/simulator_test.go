@@ -0,0 +1,93 @@
+package tsssim_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	keepertest "github.com/bitwaylabs/bitway/testutil/keeper"
+	"github.com/bitwaylabs/bitway/testutil/tsssim"
+	tss "github.com/bitwaylabs/bitway/x/tss/module"
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+func TestSimulator(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	signed := make(map[uint64][]string)
+
+	k.RegisterDKGRequestCompletedHandler("test", func(ctx sdk.Context, id uint64, ty string, intent int32, pubKeys []string) error {
+		return nil
+	})
+	k.RegisterSigningRequestCompletedHandler("test", func(ctx sdk.Context, sender string, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, signatures []string) error {
+		signed[id] = signatures
+		return nil
+	})
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	schnorrDKG := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 2, time.Hour)
+	nonceDKG := k.InitiateDKG(ctx, "test", "nonce", 0, participants, 2, 1, time.Hour)
+	ecdsaDKG := k.InitiateDKGWithKeyType(ctx, "test", "signing", 0, participants, 2, 1, time.Hour, types.KeyType_KEY_TYPE_ECDSA)
+
+	sim := tsssim.NewSimulator(k, []byte("test"))
+	sim.Process(ctx)
+
+	require.NoError(t, tss.EndBlocker(ctx, k))
+
+	for _, dkg := range []*types.DKGRequest{schnorrDKG, nonceDKG, ecdsaDKG} {
+		require.Equal(t, types.DKGStatus_DKG_STATUS_COMPLETED, k.GetDKGRequest(ctx, dkg.Id).Status)
+	}
+
+	// the keys are deterministic
+	pubKeys := k.GetDKGPubKeys(ctx, schnorrDKG.Id)
+	require.Len(t, pubKeys, 2)
+
+	privKey, err := tsssim.NewSimulator(k, []byte("test")).GetPrivKey(ctx, pubKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, sim.PrivKey(schnorrDKG.Id, 1), privKey)
+
+	_, err = tsssim.NewSimulator(k, []byte("other")).GetPrivKey(ctx, pubKeys[1])
+	require.Error(t, err)
+
+	adaptorSecret, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(chainhash.HashB([]byte("sig hash")))}
+
+	requests := []*types.SigningRequest{
+		k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKeys[0], sigHashes, nil),
+		k.InitiateSigningRequest(ctx, "test", "2", types.SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK, 0, pubKeys[1], sigHashes, &types.SigningOptions{Tweak: ""}),
+		k.InitiateSigningRequest(ctx, "test", "3", types.SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT, 0, pubKeys[0], sigHashes, &types.SigningOptions{Nonce: k.GetDKGPubKeys(ctx, nonceDKG.Id)[0]}),
+		k.InitiateSigningRequest(ctx, "test", "4", types.SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR, 0, pubKeys[1], sigHashes, &types.SigningOptions{AdaptorPoint: hex.EncodeToString(adaptorSecret.PubKey().SerializeCompressed())}),
+		k.InitiateSigningRequest(ctx, "test", "5", types.SigningType_SIGNING_TYPE_ECDSA, 0, k.GetDKGPubKeys(ctx, ecdsaDKG.Id)[0], sigHashes, nil),
+	}
+
+	// the key not generated by the simulator is left pending
+	externalKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	externalReq := k.InitiateSigningRequest(ctx, "test", "6", types.SigningType_SIGNING_TYPE_ECDSA, 0, hex.EncodeToString(externalKey.PubKey().SerializeCompressed()), sigHashes, nil)
+
+	sim.Process(ctx)
+
+	for _, req := range requests {
+		require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, k.GetSigningRequest(ctx, req.Id).Status, "signing type %s", req.Type)
+		require.NoError(t, k.VerifySignatures(ctx, req, signed[req.Id]))
+	}
+
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_PENDING, k.GetSigningRequest(ctx, externalReq.Id).Status)
+}
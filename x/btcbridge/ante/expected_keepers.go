@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
 )
 
 // BtcBridgeKeeper defines the expected btcbridge keeper interface
@@ -12,6 +14,10 @@ type BtcBridgeKeeper interface {
 
 	FeeSponsorshipEnabled(ctx sdk.Context) bool
 	MaxSponsorFee(ctx sdk.Context) sdk.Coins
+
+	MatchFeeSponsorshipPolicy(ctx sdk.Context, msgs []sdk.Msg, account string, fee sdk.Coins) *types.FeeSponsorshipPolicy
+	GetFeeSponsorshipFundingAccount(ctx sdk.Context, policy *types.FeeSponsorshipPolicy) sdk.AccAddress
+	RecordFeeSponsorship(ctx sdk.Context, policy *types.FeeSponsorshipPolicy, account string, fee sdk.Coins)
 }
 
 // FeeGrantKeeper defines the expected feegrant keeper.
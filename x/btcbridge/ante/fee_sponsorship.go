@@ -11,7 +11,7 @@ import (
 )
 
 // FeeSponsorshipDecorator implements fee sponsorship
-// The gas fee is sponsored if the tx satisfies the sponsorship requirement
+// The gas fee is sponsored if the tx satisfies the default BTC transfer sponsorship or any governance configured sponsorship policy
 // Fallback to the default DeductFeeDecorator otherwise
 type FeeSponsorshipDecorator struct {
 	accountKeeper   types.AccountKeeper
@@ -64,25 +64,30 @@ func (fsd FeeSponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 	}
 
-	if !fsd.checkSponsorship(ctx, tx, fee) {
+	if fsd.checkSponsorship(ctx, tx, fee) {
+		// sponsor fee
+		if err := fsd.sponsorFee(ctx, fsd.getFeeSponsorAddress(), fee); err != nil {
+			return ctx, err
+		}
+	} else if policy := fsd.getSponsorshipPolicy(ctx, feeTx, fee); policy != nil && fsd.useFundingAccountGrant(ctx, feeTx, policy, fee) {
+		// sponsor fee by the policy
+		if err := fsd.sponsorFee(ctx, fsd.btcbridgeKeeper.GetFeeSponsorshipFundingAccount(ctx, policy), fee); err != nil {
+			return ctx, err
+		}
+
+		fsd.btcbridgeKeeper.RecordFeeSponsorship(ctx, policy, sdk.AccAddress(feeTx.FeePayer()).String(), fee)
+	} else {
 		// fallback to default fee decorator
 		return fsd.defaultFeeDecorator.AnteHandle(ctx, tx, simulate, next)
 	}
 
-	// sponsor fee
-	if err := fsd.sponsorFee(ctx, fee); err != nil {
-		return ctx, err
-	}
-
 	newCtx := ctx.WithPriority(priority)
 
 	return next(newCtx, tx, simulate)
 }
 
-// sponsorFee performs the fee sponsorship for the given tx
-func (fsd FeeSponsorshipDecorator) sponsorFee(ctx sdk.Context, fee sdk.Coins) error {
-	sponsorAddress := fsd.getFeeSponsorAddress()
-
+// sponsorFee performs the fee sponsorship for the given tx by the given sponsor
+func (fsd FeeSponsorshipDecorator) sponsorFee(ctx sdk.Context, sponsorAddress sdk.AccAddress, fee sdk.Coins) error {
 	sponsorAccount := fsd.accountKeeper.GetAccount(ctx, sponsorAddress)
 	if sponsorAccount == nil {
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee sponsor address: %s does not exist", sponsorAddress)
@@ -134,6 +139,62 @@ func (fsd FeeSponsorshipDecorator) checkSponsorship(ctx sdk.Context, tx sdk.Tx,
 	return true
 }
 
+// getSponsorshipPolicy gets the sponsorship policy under which the given tx should be sponsored
+// NOTE: The following requirements must be satisfied for fee sponsorship by the policy
+// 1. Fee sponsorship is enabled
+// 2. No fee granter is specified
+// 3. The gas fee does not exceed the maximum sponsorship fee
+// 4. All msgs of the tx are covered by the policy
+// 5. The fee payer quota and the epoch budget of the policy are not exhausted
+// 6. The funding account of the policy has sufficient balances
+// 7. The funding account of the policy has granted the sufficient fee allowance to the fee sponsor if it is not the fee sponsor (checked by useFundingAccountGrant)
+// Nil returned if no policy satisfied
+func (fsd FeeSponsorshipDecorator) getSponsorshipPolicy(ctx sdk.Context, feeTx sdk.FeeTx, fee sdk.Coins) *types.FeeSponsorshipPolicy {
+	if !fsd.btcbridgeKeeper.FeeSponsorshipEnabled(ctx) {
+		return nil
+	}
+
+	if len(feeTx.FeeGranter()) != 0 {
+		return nil
+	}
+
+	if !fee.IsAllLTE(fsd.btcbridgeKeeper.MaxSponsorFee(ctx)) {
+		return nil
+	}
+
+	policy := fsd.btcbridgeKeeper.MatchFeeSponsorshipPolicy(ctx, feeTx.GetMsgs(), sdk.AccAddress(feeTx.FeePayer()).String(), fee)
+	if policy == nil {
+		return nil
+	}
+
+	if !fsd.bankKeeper.SpendableCoins(ctx, fsd.btcbridgeKeeper.GetFeeSponsorshipFundingAccount(ctx, policy)).IsAllGTE(fee) {
+		return nil
+	}
+
+	return policy
+}
+
+// useFundingAccountGrant uses the fee allowance granted by the funding account of the given policy to the fee sponsor
+// No grant required if the funding account is the fee sponsor
+// Return true if the fee allowance is used successfully, false otherwise
+func (fsd FeeSponsorshipDecorator) useFundingAccountGrant(ctx sdk.Context, feeTx sdk.FeeTx, policy *types.FeeSponsorshipPolicy, fee sdk.Coins) bool {
+	fundingAccount := fsd.btcbridgeKeeper.GetFeeSponsorshipFundingAccount(ctx, policy)
+	if fundingAccount.Equals(fsd.getFeeSponsorAddress()) {
+		return true
+	}
+
+	// the allowance is only updated if used successfully
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := fsd.feegrantKeeper.UseGrantedFees(cacheCtx, fundingAccount, fsd.getFeeSponsorAddress(), fee, feeTx.GetMsgs()); err != nil {
+		return false
+	}
+
+	write()
+
+	return true
+}
+
 // isSponsorableTx returns true if the given tx is sponsorable, false otherwise
 func (fsd FeeSponsorshipDecorator) isSponsorableTx(ctx sdk.Context, tx sdk.Tx) bool {
 	for _, m := range tx.GetMsgs() {
@@ -40,6 +40,22 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryRefreshingRequests())
 	cmd.AddCommand(CmdQueryRefreshingCompletions())
 	cmd.AddCommand(CmdQueryRateLimit())
+	cmd.AddCommand(CmdQueryRelayers())
+	cmd.AddCommand(CmdQueryRunesAttestation())
+	cmd.AddCommand(CmdQueryPauseState())
+	cmd.AddCommand(CmdQueryPauses())
+	cmd.AddCommand(CmdQueryScreenedAddresses())
+	cmd.AddCommand(CmdQueryQuarantinedDeposits())
+	cmd.AddCommand(CmdQueryQuarantinedWithdrawals())
+	cmd.AddCommand(CmdQueryDepositConfirmationDepth())
+	cmd.AddCommand(CmdQueryDepositRecord())
+	cmd.AddCommand(CmdQueryDepositRecords())
+	cmd.AddCommand(CmdQueryDepositRecordsByRecipient())
+	cmd.AddCommand(CmdQueryDepositRecordsBySender())
+	cmd.AddCommand(CmdQueryRuneEtching())
+	cmd.AddCommand(CmdQueryFeeSponsorshipUsage())
+	cmd.AddCommand(CmdQueryVaultRecoveries())
+	cmd.AddCommand(CmdQueryVaultRecoveryPsbt())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -490,3 +506,565 @@ func CmdQueryRateLimit() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryRelayers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayers [status | address]",
+		Short: "Query relayers by the optional status or query the relayer by address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status := int64(0)
+
+			if len(args) > 0 {
+				status, err = strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					_, err = sdk.AccAddressFromBech32(args[0])
+					if err != nil {
+						return fmt.Errorf("invalid arg, neither status nor address: %s", args[0])
+					}
+
+					res, err := queryClient.QueryRelayer(cmd.Context(), &types.QueryRelayerRequest{Address: args[0]})
+					if err != nil {
+						return err
+					}
+
+					return clientCtx.PrintProto(res)
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryRelayers(cmd.Context(), &types.QueryRelayersRequest{Status: types.RelayerStatus(status), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryRunesAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runes-attestation [tx hash]",
+		Short: "Query the runes deposit attestation by the given tx hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryRunesAttestation(cmd.Context(), &types.QueryRunesAttestationRequest{Txid: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-state",
+		Short: "Query the pauses currently in effect",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPauseState(cmd.Context(), &types.QueryPauseStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pauses [status | id]",
+		Short: "Query the pause history by the optional status or query the pause by id",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status := int32(0)
+
+			if len(args) > 0 {
+				if value, ok := types.PauseStatus_value[args[0]]; ok {
+					status = value
+				} else {
+					id, err := strconv.ParseUint(args[0], 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid arg, neither status nor id: %s", args[0])
+					}
+
+					res, err := queryClient.QueryPause(cmd.Context(), &types.QueryPauseRequest{Id: id})
+					if err != nil {
+						return err
+					}
+
+					return clientCtx.PrintProto(res)
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryPauses(cmd.Context(), &types.QueryPausesRequest{Status: types.PauseStatus(status), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryScreenedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "screened-addresses [source | address]",
+		Short: "Query screened addresses by the optional source or query the screening state of the given address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			source := int64(0)
+
+			if len(args) > 0 {
+				source, err = strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					res, err := queryClient.QueryScreenedAddress(cmd.Context(), &types.QueryScreenedAddressRequest{Address: args[0]})
+					if err != nil {
+						return err
+					}
+
+					return clientCtx.PrintProto(res)
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryScreenedAddresses(cmd.Context(), &types.QueryScreenedAddressesRequest{Source: types.ScreeningSource(source), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryQuarantinedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Short: "Query the deposits credited to the quarantine account",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryQuarantinedDeposits(cmd.Context(), &types.QueryQuarantinedDepositsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryQuarantinedWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-withdrawals",
+		Short: "Query the withdrawals credited to the quarantine account",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryQuarantinedWithdrawals(cmd.Context(), &types.QueryQuarantinedWithdrawalsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryDepositConfirmationDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-confirmation-depth [amount]",
+		Short: "Query the confirmation depth required for the deposit of the given amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryDepositConfirmationDepth(cmd.Context(), &types.QueryDepositConfirmationDepthRequest{Amount: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDepositRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-record [tx hash]",
+		Short: "Query the deposit record by the given tx hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryDepositRecord(cmd.Context(), &types.QueryDepositRecordRequest{Txid: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDepositRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-records [asset type]",
+		Short: "Query the deposit records by the given asset type; all deposit records are returned if not specified",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			asset := types.AssetType_ASSET_TYPE_UNSPECIFIED
+			if len(args) > 0 {
+				assetType, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+
+				asset = types.AssetType(assetType)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryDepositRecords(cmd.Context(), &types.QueryDepositRecordsRequest{Asset: asset, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryDepositRecordsByRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-records-by-recipient [address]",
+		Short: "Query the deposit records of the given recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryDepositRecordsByRecipient(cmd.Context(), &types.QueryDepositRecordsByRecipientRequest{Address: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryDepositRecordsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-records-by-sender [bitcoin address]",
+		Short: "Query the deposit records of the given bitcoin sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryDepositRecordsBySender(cmd.Context(), &types.QueryDepositRecordsBySenderRequest{Address: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdQueryRuneEtching() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rune-etching [rune id]",
+		Short: "Query the etching data of the given rune",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryRuneEtching(cmd.Context(), &types.QueryRuneEtchingRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFeeSponsorshipUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorship-usage [policy id] [optional address]",
+		Short: "Query the current epoch usage of the given fee sponsorship policy, optionally by the given account",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.QueryFeeSponsorshipUsage(cmd.Context(), &types.QueryFeeSponsorshipUsageRequest{PolicyId: args[0]})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryFeeSponsorshipAccountUsage(cmd.Context(), &types.QueryFeeSponsorshipAccountUsageRequest{PolicyId: args[0], Address: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultRecoveries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-recoveries",
+		Short: "Query the emergency recovery params of the vaults",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryVaultRecoveries(cmd.Context(), &types.QueryVaultRecoveriesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultRecoveryPsbt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-recovery-psbt [vault] [recipient] [fee rate]",
+		Short: "Query the psbt to sweep the given vault via the recovery script path",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeRate, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryVaultRecoveryPsbt(cmd.Context(), &types.QueryVaultRecoveryPsbtRequest{Address: args[0], Recipient: args[1], FeeRate: feeRate})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
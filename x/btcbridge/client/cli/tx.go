@@ -35,12 +35,17 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSubmitFeeRate())
-	cmd.AddCommand(CmdUpdateNonBtcRelayers())
 	cmd.AddCommand(CmdUpdateFeeProviders())
 	cmd.AddCommand(CmdWithdrawToBitcoin())
+	cmd.AddCommand(CmdMultiWithdrawToBitcoin())
 	cmd.AddCommand(CmdSubmitSignatures())
 	cmd.AddCommand(CmdCompleteDKG())
 	cmd.AddCommand(CmdCompleteRefreshing())
+	cmd.AddCommand(CmdBondRelayer())
+	cmd.AddCommand(CmdUnbondRelayer())
+	cmd.AddCommand(CmdSubmitRuneEtching())
+	cmd.AddCommand(CmdPause())
+	cmd.AddCommand(CmdUnpause())
 
 	return cmd
 }
@@ -79,10 +84,10 @@ func CmdSubmitFeeRate() *cobra.Command {
 	return cmd
 }
 
-func CmdUpdateNonBtcRelayers() *cobra.Command {
+func CmdUpdateFeeProviders() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-non-btc-relayers [relayers]",
-		Short: "Update trusted non-btc asset relayers",
+		Use:   "update-fee-providers [fee providers]",
+		Short: "Update trusted fee providers",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -90,7 +95,7 @@ func CmdUpdateNonBtcRelayers() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgUpdateTrustedNonBtcRelayers(
+			msg := types.NewMsgUpdateTrustedFeeProviders(
 				clientCtx.GetFromAddress().String(),
 				strings.Split(args[0], listSeparator),
 			)
@@ -108,10 +113,11 @@ func CmdUpdateNonBtcRelayers() *cobra.Command {
 	return cmd
 }
 
-func CmdUpdateFeeProviders() *cobra.Command {
+// Withdraw To Bitcoin
+func CmdWithdrawToBitcoin() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-fee-providers [fee providers]",
-		Short: "Update trusted fee providers",
+		Use:   "withdraw [amount]",
+		Short: "Withdraw bitcoin asset to the given sender",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -119,9 +125,14 @@ func CmdUpdateFeeProviders() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgUpdateTrustedFeeProviders(
+			_, err = sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount")
+			}
+
+			msg := types.NewMsgWithdrawToBitcoin(
 				clientCtx.GetFromAddress().String(),
-				strings.Split(args[0], listSeparator),
+				args[0],
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -137,26 +148,35 @@ func CmdUpdateFeeProviders() *cobra.Command {
 	return cmd
 }
 
-// Withdraw To Bitcoin
-func CmdWithdrawToBitcoin() *cobra.Command {
+func CmdMultiWithdrawToBitcoin() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "withdraw [amount]",
-		Short: "Withdraw bitcoin asset to the given sender",
-		Args:  cobra.ExactArgs(1),
+		Use:     "multi-withdraw [address:amount]...",
+		Short:   "Withdraw bitcoin to multiple bitcoin addresses",
+		Example: "multi-withdraw bc1q...:100000sat bc1p...:200000sat",
+		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			_, err = sdk.ParseCoinsNormalized(args[0])
-			if err != nil {
-				return fmt.Errorf("invalid amount")
+			outputs := make([]types.WithdrawOutput, len(args))
+
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid output %s", arg)
+				}
+
+				outputs[i] = types.WithdrawOutput{
+					Address: parts[0],
+					Amount:  parts[1],
+				}
 			}
 
-			msg := types.NewMsgWithdrawToBitcoin(
+			msg := types.NewMsgMultiWithdrawToBitcoin(
 				clientCtx.GetFromAddress().String(),
-				args[0],
+				outputs,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -205,9 +225,9 @@ func CmdSubmitSignatures() *cobra.Command {
 // Complete DKG
 func CmdCompleteDKG() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "complete-dkg [id] [vaults] [consensus pub key] [signature]",
-		Short: "Complete dkg request with new vaults",
-		Args:  cobra.ExactArgs(4),
+		Use:   "complete-dkg [id] [vaults] [consensus pub key] [signature] [internal keys] [pub keys]",
+		Short: "Complete dkg request with new vaults; internal keys are required if the vault recovery is enabled and pub keys are required for the segwit v0 vaults",
+		Args:  cobra.RangeArgs(4, 6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -221,12 +241,24 @@ func CmdCompleteDKG() *cobra.Command {
 
 			vaults := strings.Split(args[1], listSeparator)
 
+			internalKeys := []string{}
+			if len(args) >= 5 && len(args[4]) != 0 {
+				internalKeys = strings.Split(args[4], listSeparator)
+			}
+
+			pubKeys := []string{}
+			if len(args) == 6 && len(args[5]) != 0 {
+				pubKeys = strings.Split(args[5], listSeparator)
+			}
+
 			msg := types.NewMsgCompleteDKG(
 				clientCtx.GetFromAddress().String(),
 				id,
 				vaults,
 				args[2],
 				args[3],
+				internalKeys,
+				pubKeys,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -278,3 +310,197 @@ func CmdCompleteRefreshing() *cobra.Command {
 
 	return cmd
 }
+
+func CmdBondRelayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-relayer [amount]",
+		Short: "Bond the stake to register or top up the relayer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBondRelayer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitRuneEtching() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-rune-etching [rune id] [name] [spacers] [symbol] [divisibility]",
+		Short: "Submit the etching data of the rune by the relayer",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spacers, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			divisibility, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitRuneEtching(
+				clientCtx.GetFromAddress().String(),
+				types.RuneEtching{
+					Id:           args[0],
+					Name:         args[1],
+					Spacers:      uint32(spacers),
+					Symbol:       args[3],
+					Divisibility: uint32(divisibility),
+				},
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnbondRelayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-relayer",
+		Short: "Start unbonding the stake of the relayer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbondRelayer(
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [target] [asset type] [duration] [reason]",
+		Short: "Pause the given target by the guardian; the asset type is only required for the asset target and the maximum duration is used if the duration is 0",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			assetType, err := strconv.ParseInt(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPause(
+				clientCtx.GetFromAddress().String(),
+				types.PauseTarget(target),
+				types.AssetType(assetType),
+				duration,
+				args[3],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [target] [asset type]",
+		Short: "Lift the active pause of the given target by the guardian; the asset type is only required for the asset target",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			assetType, err := strconv.ParseInt(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpause(
+				clientCtx.GetFromAddress().String(),
+				types.PauseTarget(target),
+				types.AssetType(assetType),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
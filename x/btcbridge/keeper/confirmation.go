@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// GetDepositConfirmationDepth gets the confirmation depth required for the deposit of the given amount
+// The BTC equivalent amount is returned as well, which is 0 if the runes price is not available
+// The maximum confirmation depth is required for runes if the price is not available
+func (k Keeper) GetDepositConfirmationDepth(ctx sdk.Context, amount sdk.Coin) (int32, int64, error) {
+	params := k.GetParams(ctx)
+	confirmationParams := params.DepositConfirmationParams
+
+	switch types.AssetTypeFromDenom(amount.Denom, params) {
+	case types.AssetType_ASSET_TYPE_BTC:
+		if !amount.Amount.IsInt64() {
+			return 0, 0, types.ErrInvalidDepositAmount
+		}
+
+		return confirmationParams.GetConfirmationDepth(amount.Amount.Int64()), amount.Amount.Int64(), nil
+
+	case types.AssetType_ASSET_TYPE_RUNES:
+		var id types.RuneId
+		if err := id.FromString(strings.TrimPrefix(amount.Denom, fmt.Sprintf("%s/", types.RunesProtocolName))); err != nil {
+			return 0, 0, err
+		}
+
+		btcAmount, ok := k.GetRunesBTCEquivalent(ctx, id.ToString(), amount.Amount)
+		if !ok {
+			return confirmationParams.MaxConfirmationDepth(), 0, nil
+		}
+
+		return confirmationParams.GetConfirmationDepth(btcAmount), btcAmount, nil
+
+	default:
+		return 0, 0, errorsmod.Wrapf(types.ErrAssetNotSupported, "denom %s", amount.Denom)
+	}
+}
+
+// GetDepositConfirmationDepthByTx gets the confirmation depth required for the given deposit transaction
+func (k Keeper) GetDepositConfirmationDepthByTx(ctx sdk.Context, tx *wire.MsgTx) (int32, error) {
+	params := k.GetParams(ctx)
+
+	edict, err := types.CheckRunesDepositTransaction(tx, params.Vaults)
+	if err != nil {
+		return 0, err
+	}
+
+	var amount sdk.Coin
+
+	if edict == nil {
+		out, _, _, err := k.getOutputForMintBTC(ctx, tx, bitcoin.Network)
+		if err != nil {
+			return 0, err
+		}
+
+		amount = sdk.NewInt64Coin(params.BtcVoucherDenom, out.Value)
+	} else {
+		amount = sdk.NewCoin(edict.Id.Denom(), sdkmath.NewIntFromBigInt(types.RuneAmountFromString(edict.Amount).Big()))
+	}
+
+	confirmationDepth, _, err := k.GetDepositConfirmationDepth(ctx, amount)
+
+	return confirmationDepth, err
+}
+
+// GetRunesBTCEquivalent gets the BTC equivalent amount in satoshi of the given runes amount by the oracle price
+// False is returned if the price is not available
+func (k Keeper) GetRunesBTCEquivalent(ctx sdk.Context, id string, amount sdkmath.Int) (int64, bool) {
+	priceSymbol := k.DepositConfirmationParams(ctx).GetRunesPriceSymbol(id)
+	if priceSymbol == nil {
+		return 0, false
+	}
+
+	price, err := k.oracleKeeper.GetPrice(ctx, priceSymbol.Symbol)
+	if err != nil || !price.IsPositive() {
+		return 0, false
+	}
+
+	return types.GetBTCEquivalent(amount, price, priceSymbol.Divisibility), true
+}
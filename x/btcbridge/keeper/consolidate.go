@@ -52,6 +52,10 @@ func (k Keeper) handleBtcConsolidation(ctx sdk.Context, vaultVersion uint64, tar
 		return err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(p, k.GetParams(ctx).Vaults); err != nil {
+		return err
+	}
+
 	psbtB64, err := p.B64Encode()
 	if err != nil {
 		return types.ErrFailToSerializePsbt
@@ -119,6 +123,10 @@ func (k Keeper) handleRunesConsolidation(ctx sdk.Context, vaultVersion uint64, r
 		return err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(p, k.GetParams(ctx).Vaults); err != nil {
+		return err
+	}
+
 	psbtB64, err := p.B64Encode()
 	if err != nil {
 		return types.ErrFailToSerializePsbt
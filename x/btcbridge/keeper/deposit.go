@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,18 +18,24 @@ import (
 
 // ProcessBitcoinDepositTransaction handles the deposit transaction
 func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.MsgSubmitDepositTransaction) (*chainhash.Hash, btcutil.Address, error) {
-	tx, prevTx, err := k.ValidateTransaction(ctx, msg.TxBytes, msg.PrevTxBytes, msg.Blockhash, msg.Proof, k.DepositConfirmationDepth(ctx))
+	blockHash, err := types.ResolveBlockHash(msg.Blockhash, msg.MerkleBlock)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	assetType, recipient, amount, err := k.Mint(ctx, msg.Sender, tx, prevTx, uint64(k.oracleKeeper.GetBlockHeader(ctx, msg.Blockhash).Height))
+	tx, prevTx, err := k.ValidateDepositTransaction(ctx, msg.TxBytes, msg.PrevTxBytes, blockHash, msg.Proof, msg.MerkleBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assetType, recipient, amount, err := k.Mint(ctx, msg.Sender, tx, prevTx, uint64(k.oracleKeeper.GetBlockHeader(ctx, blockHash).Height))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// hook
-	if assetType == types.AssetType_ASSET_TYPE_BTC {
+	// skip for the quarantined deposit
+	if assetType == types.AssetType_ASSET_TYPE_BTC && !k.HasQuarantinedDeposit(ctx, tx.Hash().String()) {
 		if err := k.AfterDeposit(ctx, recipient.EncodeAddress(), *amount, tx.MsgTx()); err != nil {
 			return nil, nil, err
 		}
@@ -37,6 +44,40 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 	return tx.Hash(), recipient, nil
 }
 
+// ProcessBitcoinDepositTransactions handles the batched deposit transactions
+// Each deposit is processed independently so that the failed one does not revert the whole batch
+func (k Keeper) ProcessBitcoinDepositTransactions(ctx sdk.Context, msg *types.MsgSubmitDepositTransactions) []types.DepositResult {
+	results := make([]types.DepositResult, len(msg.Deposits))
+
+	for i := range msg.Deposits {
+		deposit := msg.GetDeposit(i)
+
+		cacheCtx, write := ctx.CacheContext()
+
+		txHash, recipient, err := k.ProcessBitcoinDepositTransaction(cacheCtx, deposit)
+		if err != nil {
+			k.Logger(ctx).Info("Failed to process the deposit in the batch", "index", i, "relayer", msg.Sender, "err", err)
+
+			results[i] = types.DepositResult{
+				Txid:  types.GetTxHash(deposit.TxBytes),
+				Error: err.Error(),
+			}
+
+			continue
+		}
+
+		write()
+
+		results[i] = types.DepositResult{
+			Txid:      txHash.String(),
+			Success:   true,
+			Recipient: recipient.EncodeAddress(),
+		}
+	}
+
+	return results
+}
+
 // Mint performs the minting operation of the voucher token
 func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btcutil.Tx, height uint64) (types.AssetType, btcutil.Address, *sdk.Coin, error) {
 	hash := tx.Hash().String()
@@ -64,8 +105,13 @@ func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btc
 		assetType = types.AssetType_ASSET_TYPE_RUNES
 	}
 
-	// check if the sender is trusted to relay runes deposit
-	if isRunes && !k.IsTrustedNonBtcRelayer(ctx, sender) {
+	// check if the asset is paused
+	if k.IsAssetPaused(ctx, assetType) {
+		return assetType, nil, nil, errorsmod.Wrapf(types.ErrPaused, "asset %s paused", assetType)
+	}
+
+	// check if the sender is an active relayer to relay runes deposit
+	if isRunes && !k.IsActiveRelayer(ctx, sender) {
 		return assetType, nil, nil, types.ErrUntrustedNonBtcRelayer
 	}
 
@@ -75,7 +121,17 @@ func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btc
 		return assetType, nil, nil, err
 	}
 
+	// screen the deposit sender and recipient
+	// the deposit will be credited to the quarantine account if either is screened
+	depositSender := ""
+	if senderAddr, err := types.ExtractDepositSenderAddr(tx.MsgTx(), prevTx.MsgTx(), chainCfg); err == nil {
+		depositSender = senderAddr.EncodeAddress()
+	}
+
+	quarantined := k.IsScreenedAddress(ctx, recipient.EncodeAddress()) || (len(depositSender) != 0 && k.IsScreenedAddress(ctx, depositSender))
+
 	var amount *sdk.Coin
+	var depositVout int
 
 	if !isRunes {
 		out, vout, vault, err := k.getOutputForMintBTC(ctx, tx.MsgTx(), chainCfg)
@@ -83,26 +139,61 @@ func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btc
 			return assetType, nil, nil, err
 		}
 
-		amount, err = k.mintBTC(ctx, tx, height, recipient.EncodeAddress(), vault, out, vout, params.BtcVoucherDenom)
+		amount, err = k.mintBTC(ctx, sender, tx, height, recipient.EncodeAddress(), quarantined, vault, out, vout, params.BtcVoucherDenom)
 		if err != nil {
 			return assetType, nil, nil, err
 		}
+
+		depositVout = vout
 	} else {
 		outs, vouts, vaults, err := k.getOutputsForMintRunes(ctx, tx.MsgTx(), edict, chainCfg)
 		if err != nil {
 			return assetType, nil, nil, err
 		}
 
-		amount, err = k.mintRunes(ctx, tx, height, recipient.EncodeAddress(), vaults, outs, vouts, edict.Id, edict.Amount)
+		amount, err = k.mintRunes(ctx, sender, tx, height, recipient.EncodeAddress(), quarantined, vaults, outs, vouts, edict.Id, edict.Amount)
 		if err != nil {
 			return assetType, nil, nil, err
 		}
+
+		depositVout = vouts[0]
+
+		// record the attestation for accountability
+		k.SetRunesAttestation(ctx, &types.RunesAttestation{
+			Txid:    hash,
+			Relayer: sender,
+			Height:  ctx.BlockHeight(),
+		})
+	}
+
+	if quarantined {
+		k.quarantineDeposit(ctx, hash, depositSender, recipient.EncodeAddress(), *amount)
 	}
 
+	fee := sdk.NewInt64Coin(params.BtcVoucherDenom, 0)
+	if k.ProtocolDepositFeeEnabled(ctx) {
+		fee = sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee)
+	}
+
+	k.SetDepositRecord(ctx, &types.DepositRecord{
+		Txid:           hash,
+		Vout:           uint64(depositVout),
+		Sender:         depositSender,
+		Recipient:      recipient.EncodeAddress(),
+		Asset:          assetType,
+		Amount:         *amount,
+		Fee:            fee,
+		BtcBlockHeight: height,
+		Height:         ctx.BlockHeight(),
+		Quarantined:    quarantined,
+	})
+
+	k.afterRelayerDeposit(ctx, sender)
+
 	return assetType, recipient, amount, nil
 }
 
-func (k Keeper) mintBTC(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipient string, vault string, out *wire.TxOut, vout int, denom string) (*sdk.Coin, error) {
+func (k Keeper) mintBTC(ctx sdk.Context, relayer string, tx *btcutil.Tx, height uint64, recipient string, quarantined bool, vault string, out *wire.TxOut, vout int, denom string) (*sdk.Coin, error) {
 	amount := sdk.NewInt64Coin(denom, out.Value)
 
 	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
@@ -114,7 +205,7 @@ func (k Keeper) mintBTC(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipien
 		return nil, err
 	}
 
-	actualAmount, err := k.mintBTCWithProtocolFee(ctx, recipientAddr, amount)
+	actualAmount, err := k.mintBTCWithProtocolFee(ctx, relayer, recipientAddr, quarantined, amount)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +225,7 @@ func (k Keeper) mintBTC(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipien
 	return actualAmount, nil
 }
 
-func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipient string, vaults []string, outs []*wire.TxOut, vouts []int, id *types.RuneId, amount string) (*sdk.Coin, error) {
+func (k Keeper) mintRunes(ctx sdk.Context, relayer string, tx *btcutil.Tx, height uint64, recipient string, quarantined bool, vaults []string, outs []*wire.TxOut, vouts []int, id *types.RuneId, amount string) (*sdk.Coin, error) {
 	coin := sdk.NewCoin(id.Denom(), sdkmath.NewIntFromBigInt(types.RuneAmountFromString(amount).Big()))
 
 	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
@@ -146,12 +237,17 @@ func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipi
 		return nil, err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, sdk.NewCoins(coin)); err != nil {
+	// register the denom metadata on the first deposit if the etching is available
+	if !k.bankKeeper.HasDenomMetaData(ctx, coin.Denom) && k.HasRuneEtching(ctx, id.ToString()) {
+		k.RegisterRuneMetadata(ctx, k.GetRuneEtching(ctx, id.ToString()))
+	}
+
+	if err := k.creditDeposit(ctx, recipientAddr, sdk.NewCoins(coin), quarantined); err != nil {
 		return nil, err
 	}
 
 	if k.ProtocolDepositFeeEnabled(ctx) {
-		if err := k.handleRunesProtocolFee(ctx, tx.Hash().String(), height, outs[1], vouts[1], vaults[1]); err != nil {
+		if err := k.handleRunesProtocolFee(ctx, relayer, tx.Hash().String(), height, outs[1], vouts[1], vaults[1]); err != nil {
 			return nil, err
 		}
 	}
@@ -176,21 +272,20 @@ func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipi
 }
 
 // mintBTCWithProtocolFee performs btc minting along with the protocol fee handling
-func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) (*sdk.Coin, error) {
+func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, relayer string, recipient sdk.AccAddress, quarantined bool, amount sdk.Coin) (*sdk.Coin, error) {
 	params := k.GetParams(ctx)
 
 	var err error
 
 	if k.ProtocolDepositFeeEnabled(ctx) {
 		protocolFee := sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee)
-		protocolFeeCollector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
 
 		amount, err = amount.SafeSub(protocolFee)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, protocolFeeCollector, sdk.NewCoins(protocolFee)); err != nil {
+		if err := k.distributeProtocolDepositFee(ctx, relayer, protocolFee); err != nil {
 			return nil, err
 		}
 	}
@@ -199,7 +294,7 @@ func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress
 		return nil, types.ErrInvalidDepositAmount
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+	if err := k.creditDeposit(ctx, recipient, sdk.NewCoins(amount), quarantined); err != nil {
 		return nil, err
 	}
 
@@ -208,13 +303,12 @@ func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress
 
 // handleRunesProtocolFee performs the protocol fee handling for runes deposit
 // Assume that the protocol deposit fee is enabled
-func (k Keeper) handleRunesProtocolFee(ctx sdk.Context, txHash string, height uint64, btcOut *wire.TxOut, btcVout int, btcVault string) error {
+func (k Keeper) handleRunesProtocolFee(ctx sdk.Context, relayer string, txHash string, height uint64, btcOut *wire.TxOut, btcVout int, btcVault string) error {
 	params := k.GetParams(ctx)
 
 	btcAmount := sdk.NewInt64Coin(params.BtcVoucherDenom, btcOut.Value)
 
 	protocolFee := sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee)
-	protocolFeeCollector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
 
 	if btcAmount.IsLT(protocolFee) {
 		return types.ErrInvalidDepositAmount
@@ -224,7 +318,7 @@ func (k Keeper) handleRunesProtocolFee(ctx sdk.Context, txHash string, height ui
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, protocolFeeCollector, sdk.NewCoins(protocolFee)); err != nil {
+	if err := k.distributeProtocolDepositFee(ctx, relayer, protocolFee); err != nil {
 		return err
 	}
 
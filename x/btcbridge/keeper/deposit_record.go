@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// setDepositIBCTransfer records the IBC auto transfer outcome to the deposit record of the given tx
+func (k Keeper) setDepositIBCTransfer(ctx sdk.Context, txid string, channelId string, recipient string, sequence uint64, transferErr error) {
+	record := k.GetDepositRecord(ctx, txid)
+	if record == nil {
+		return
+	}
+
+	record.IbcTransfer = &types.DepositIBCTransfer{
+		ChannelId: channelId,
+		Recipient: recipient,
+		Sequence:  sequence,
+	}
+
+	if transferErr != nil {
+		record.IbcTransfer.Error = transferErr.Error()
+	}
+
+	k.SetDepositRecord(ctx, record)
+}
+
+// SetDepositRecord sets the given deposit record along with the indexes
+func (k Keeper) SetDepositRecord(ctx sdk.Context, record *types.DepositRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(record)
+	store.Set(types.DepositRecordKey(record.Txid), bz)
+
+	store.Set(types.DepositRecordByRecipientKey(record.Recipient, record.Height, record.Txid), []byte(record.Txid))
+	store.Set(types.DepositRecordByAssetKey(record.Asset, record.Height, record.Txid), []byte(record.Txid))
+
+	if len(record.Sender) != 0 {
+		store.Set(types.DepositRecordBySenderKey(record.Sender, record.Height, record.Txid), []byte(record.Txid))
+	}
+}
+
+// HasDepositRecord returns true if the deposit record of the given tx exists, false otherwise
+func (k Keeper) HasDepositRecord(ctx sdk.Context, txid string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.DepositRecordKey(txid))
+}
+
+// GetDepositRecord gets the deposit record by the given tx hash
+// Nil is returned if the record does not exist
+func (k Keeper) GetDepositRecord(ctx sdk.Context, txid string) *types.DepositRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DepositRecordKey(txid))
+	if bz == nil {
+		return nil
+	}
+
+	var record types.DepositRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return &record
+}
+
+// GetAllDepositRecords gets all deposit records
+func (k Keeper) GetAllDepositRecords(ctx sdk.Context) []*types.DepositRecord {
+	records := make([]*types.DepositRecord, 0)
+
+	k.IterateDepositRecords(ctx, func(record *types.DepositRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// IterateDepositRecords iterates through all deposit records
+func (k Keeper) IterateDepositRecords(ctx sdk.Context, cb func(record *types.DepositRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.DepositRecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DepositRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(&record) {
+			break
+		}
+	}
+}
+
+// GetDepositRecordsWithPagination gets the deposit records of the given asset type with pagination
+// All deposit records are returned if the asset type is unspecified
+func (k Keeper) GetDepositRecordsWithPagination(ctx sdk.Context, asset types.AssetType, pagination *query.PageRequest) ([]*types.DepositRecord, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	if asset == types.AssetType_ASSET_TYPE_UNSPECIFIED {
+		recordStore := prefix.NewStore(store, types.DepositRecordKeyPrefix)
+
+		var records []*types.DepositRecord
+
+		pageRes, err := query.Paginate(recordStore, pagination, func(key []byte, value []byte) error {
+			var record types.DepositRecord
+			k.cdc.MustUnmarshal(value, &record)
+
+			records = append(records, &record)
+
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return records, pageRes, nil
+	}
+
+	keyPrefix := append(types.DepositRecordByAssetKeyPrefix, sdk.Uint64ToBigEndian(uint64(asset))...)
+
+	return k.getDepositRecordsByIndexWithPagination(ctx, keyPrefix, pagination)
+}
+
+// GetDepositRecordsByRecipientWithPagination gets the deposit records of the given recipient with pagination
+func (k Keeper) GetDepositRecordsByRecipientWithPagination(ctx sdk.Context, recipient string, pagination *query.PageRequest) ([]*types.DepositRecord, *query.PageResponse, error) {
+	keyPrefix := types.DepositRecordsByRecipientKeyPrefix(recipient)
+
+	return k.getDepositRecordsByIndexWithPagination(ctx, keyPrefix, pagination)
+}
+
+// GetDepositRecordsBySenderWithPagination gets the deposit records of the given sender with pagination
+func (k Keeper) GetDepositRecordsBySenderWithPagination(ctx sdk.Context, sender string, pagination *query.PageRequest) ([]*types.DepositRecord, *query.PageResponse, error) {
+	keyPrefix := types.DepositRecordsBySenderKeyPrefix(sender)
+
+	return k.getDepositRecordsByIndexWithPagination(ctx, keyPrefix, pagination)
+}
+
+// getDepositRecordsByIndexWithPagination gets the deposit records by the given index prefix with pagination
+// The records are ordered by the block height at which the deposits are minted
+func (k Keeper) getDepositRecordsByIndexWithPagination(ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest) ([]*types.DepositRecord, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, keyPrefix)
+
+	var records []*types.DepositRecord
+
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		records = append(records, k.GetDepositRecord(ctx, string(value)))
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
@@ -181,6 +181,11 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, participants []*types.DKGParticipan
 		}
 	}
 
+	var recoveryParams *types.VaultRecoveryParams
+	if params := k.GetParams(ctx).VaultRecoveryParams; params.Enabled() {
+		recoveryParams = &params
+	}
+
 	req := &types.DKGRequest{
 		Id:             k.GetNextDKGRequestID(ctx),
 		Participants:   participants,
@@ -190,6 +195,7 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, participants []*types.DKGParticipan
 		TargetUtxoNum:  targetUtxoNum,
 		Expiration:     k.GetDKGRequestExpirationTime(ctx),
 		Status:         types.DKGRequestStatus_DKG_REQUEST_STATUS_PENDING,
+		RecoveryParams: recoveryParams,
 	}
 
 	k.SetDKGRequest(ctx, req)
@@ -226,6 +232,18 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, req *types.DKGCompletionRequest) er
 		return err
 	}
 
+	if dkgReq.RecoveryParams != nil {
+		if err := types.CheckVaultRecoveries(req.Vaults, req.InternalKeys, dkgReq.RecoveryParams); err != nil {
+			return err
+		}
+	} else if len(req.InternalKeys) != 0 {
+		return errorsmod.Wrap(types.ErrInvalidDKGCompletionRequest, "internal keys not allowed when the recovery is disabled")
+	}
+
+	if err := types.CheckVaultPubKeys(req.Vaults, req.PubKeys); err != nil {
+		return err
+	}
+
 	if !types.VerifySignature(req.Signature, req.ConsensusPubkey, types.GetDKGCompletionSigMsg(req)) {
 		return errorsmod.Wrap(types.ErrInvalidDKGCompletionRequest, "invalid signature")
 	}
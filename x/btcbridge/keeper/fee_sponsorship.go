@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// GetFeeSponsorshipPolicy gets the fee sponsorship policy by the given id
+func (k Keeper) GetFeeSponsorshipPolicy(ctx sdk.Context, id string) *types.FeeSponsorshipPolicy {
+	for _, policy := range k.FeeSponsorshipPolicies(ctx) {
+		if policy.Id == id {
+			return &policy
+		}
+	}
+
+	return nil
+}
+
+// MatchFeeSponsorshipPolicy gets the first fee sponsorship policy which covers the given msgs and can sponsor the fee for the account
+// Nil returned if no policy matched
+func (k Keeper) MatchFeeSponsorshipPolicy(ctx sdk.Context, msgs []sdk.Msg, account string, fee sdk.Coins) *types.FeeSponsorshipPolicy {
+	for _, policy := range k.FeeSponsorshipPolicies(ctx) {
+		if !policy.Covers(msgs) {
+			continue
+		}
+
+		usage := k.GetFeeSponsorshipUsage(ctx, &policy)
+		accountUsage := k.GetFeeSponsorshipAccountUsage(ctx, &policy, usage, account)
+
+		if policy.CanSponsor(usage, accountUsage, fee) {
+			return &policy
+		}
+	}
+
+	return nil
+}
+
+// GetFeeSponsorshipFundingAccount gets the account from which the fee sponsored by the given policy is deducted
+func (k Keeper) GetFeeSponsorshipFundingAccount(ctx sdk.Context, policy *types.FeeSponsorshipPolicy) sdk.AccAddress {
+	if len(policy.FundingAccount) == 0 {
+		return k.authKeeper.GetModuleAddress(types.FeeSponsorName)
+	}
+
+	return sdk.MustAccAddressFromBech32(policy.FundingAccount)
+}
+
+// RecordFeeSponsorship records the fee sponsored by the given policy for the account
+func (k Keeper) RecordFeeSponsorship(ctx sdk.Context, policy *types.FeeSponsorshipPolicy, account string, fee sdk.Coins) {
+	usage := k.GetFeeSponsorshipUsage(ctx, policy)
+	accountUsage := k.GetFeeSponsorshipAccountUsage(ctx, policy, usage, account)
+
+	usage.Spent = usage.Spent.Add(fee...)
+	usage.TxCount++
+
+	accountUsage.Spent = accountUsage.Spent.Add(fee...)
+	accountUsage.TxCount++
+
+	k.SetFeeSponsorshipUsage(ctx, usage)
+	k.SetFeeSponsorshipAccountUsage(ctx, accountUsage)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeSponsored,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.Id),
+			sdk.NewAttribute(types.AttributeKeyAddress, account),
+			sdk.NewAttribute(types.AttributeKeySponsor, k.GetFeeSponsorshipFundingAccount(ctx, policy).String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
+
+// GetFeeSponsorshipUsage gets the usage of the given policy in the current epoch
+// The usage is reset if the recorded epoch has elapsed
+func (k Keeper) GetFeeSponsorshipUsage(ctx sdk.Context, policy *types.FeeSponsorshipPolicy) *types.FeeSponsorshipUsage {
+	usage := k.getFeeSponsorshipUsage(ctx, policy.Id)
+
+	epochStartTime := policy.GetEpochStartTime(usage.EpochStartTime, ctx.BlockTime())
+	if !epochStartTime.Equal(usage.EpochStartTime) {
+		return &types.FeeSponsorshipUsage{
+			PolicyId:       policy.Id,
+			EpochStartTime: epochStartTime,
+			Spent:          sdk.Coins{},
+		}
+	}
+
+	return usage
+}
+
+// GetFeeSponsorshipAccountUsage gets the usage of the given policy by the account in the epoch of the given policy usage
+// Empty usage returned if not recorded in the epoch
+func (k Keeper) GetFeeSponsorshipAccountUsage(ctx sdk.Context, policy *types.FeeSponsorshipPolicy, usage *types.FeeSponsorshipUsage, account string) *types.FeeSponsorshipAccountUsage {
+	return k.getFeeSponsorshipAccountUsage(ctx, policy.Id, usage.EpochStartTime, account)
+}
+
+// PruneFeeSponsorshipAccountUsages removes the account usages recorded in the elapsed epochs or by the removed policies
+// At most MaxPrunedFeeSponsorshipAccountUsages usages are removed per call and the remaining ones are left to the subsequent calls
+func (k Keeper) PruneFeeSponsorshipAccountUsages(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	pruned := 0
+
+	for _, usage := range k.GetAllFeeSponsorshipUsages(ctx) {
+		if pruned >= types.MaxPrunedFeeSponsorshipAccountUsages {
+			return
+		}
+
+		// all account usages are stale if the policy has been removed
+		policy := k.GetFeeSponsorshipPolicy(ctx, usage.PolicyId)
+
+		keys, exhausted := k.getStaleFeeSponsorshipAccountUsageKeys(ctx, policy, usage.PolicyId, types.MaxPrunedFeeSponsorshipAccountUsages-pruned)
+		for _, key := range keys {
+			store.Delete(key)
+		}
+
+		pruned += len(keys)
+
+		if policy == nil && exhausted {
+			store.Delete(types.FeeSponsorshipUsageKey(usage.PolicyId))
+		}
+	}
+}
+
+// getStaleFeeSponsorshipAccountUsageKeys gets at most limit keys of the stale account usages of the given policy
+// The account usages are ordered by epoch, so the iteration stops at the current epoch
+// Also return true if no more stale account usages exist, false otherwise
+func (k Keeper) getStaleFeeSponsorshipAccountUsageKeys(ctx sdk.Context, policy *types.FeeSponsorshipPolicy, policyId string, limit int) ([][]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var epochStartTime time.Time
+	if policy != nil {
+		epochStartTime = k.GetFeeSponsorshipUsage(ctx, policy).EpochStartTime
+	}
+
+	keys := make([][]byte, 0)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipAccountUsageByPolicyKey(policyId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accountUsage types.FeeSponsorshipAccountUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &accountUsage)
+
+		if policy != nil && !accountUsage.EpochStartTime.Before(epochStartTime) {
+			return keys, true
+		}
+
+		if len(keys) >= limit {
+			return keys, false
+		}
+
+		keys = append(keys, iterator.Key())
+	}
+
+	return keys, true
+}
+
+// SetFeeSponsorshipUsage sets the given fee sponsorship usage
+func (k Keeper) SetFeeSponsorshipUsage(ctx sdk.Context, usage *types.FeeSponsorshipUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(usage)
+	store.Set(types.FeeSponsorshipUsageKey(usage.PolicyId), bz)
+}
+
+// SetFeeSponsorshipAccountUsage sets the given fee sponsorship account usage
+func (k Keeper) SetFeeSponsorshipAccountUsage(ctx sdk.Context, usage *types.FeeSponsorshipAccountUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(usage)
+	store.Set(types.FeeSponsorshipAccountUsageKey(usage.PolicyId, usage.EpochStartTime, usage.Address), bz)
+}
+
+// getFeeSponsorshipUsage gets the recorded usage of the given policy
+// Empty usage returned if not recorded
+func (k Keeper) getFeeSponsorshipUsage(ctx sdk.Context, policyId string) *types.FeeSponsorshipUsage {
+	store := ctx.KVStore(k.storeKey)
+
+	var usage types.FeeSponsorshipUsage
+
+	bz := store.Get(types.FeeSponsorshipUsageKey(policyId))
+	if bz == nil {
+		return &types.FeeSponsorshipUsage{PolicyId: policyId, Spent: sdk.Coins{}}
+	}
+
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	return &usage
+}
+
+// getFeeSponsorshipAccountUsage gets the recorded usage of the given policy by the account in the given epoch
+// Empty usage returned if not recorded
+func (k Keeper) getFeeSponsorshipAccountUsage(ctx sdk.Context, policyId string, epochStartTime time.Time, account string) *types.FeeSponsorshipAccountUsage {
+	store := ctx.KVStore(k.storeKey)
+
+	var usage types.FeeSponsorshipAccountUsage
+
+	bz := store.Get(types.FeeSponsorshipAccountUsageKey(policyId, epochStartTime, account))
+	if bz == nil {
+		return &types.FeeSponsorshipAccountUsage{PolicyId: policyId, Address: account, EpochStartTime: epochStartTime, Spent: sdk.Coins{}}
+	}
+
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	return &usage
+}
+
+// GetAllFeeSponsorshipUsages gets all recorded fee sponsorship usages
+func (k Keeper) GetAllFeeSponsorshipUsages(ctx sdk.Context) []*types.FeeSponsorshipUsage {
+	usages := make([]*types.FeeSponsorshipUsage, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipUsageKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.FeeSponsorshipUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		usages = append(usages, &usage)
+	}
+
+	return usages
+}
+
+// GetAllFeeSponsorshipAccountUsages gets all recorded fee sponsorship account usages
+func (k Keeper) GetAllFeeSponsorshipAccountUsages(ctx sdk.Context) []*types.FeeSponsorshipAccountUsage {
+	usages := make([]*types.FeeSponsorshipAccountUsage, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipAccountUsageKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.FeeSponsorshipAccountUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		usages = append(usages, &usage)
+	}
+
+	return usages
+}
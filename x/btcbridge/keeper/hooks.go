@@ -30,6 +30,9 @@ func (k Keeper) AfterDeposit(ctx sdk.Context, addr string, amount sdk.Coin, tx *
 		// transfer
 		sequence, err := k.IBCTransfer(ctx, addr, recipient, amount, channelId)
 
+		// record the transfer outcome
+		k.setDepositIBCTransfer(ctx, tx.TxHash().String(), channelId, recipient, sequence, err)
+
 		// emit event
 		event := sdk.NewEvent(
 			types.EventTypeIBCTransfer,
@@ -53,7 +56,7 @@ func (k Keeper) AfterWithdraw(ctx sdk.Context, txHash string) error {
 	if k.incentiveKeeper.WithdrawIncentiveEnabled(ctx) {
 		withdrawRequests := k.GetWithdrawRequestsByTxHash(ctx, txHash)
 		for _, req := range withdrawRequests {
-			_ = k.incentiveKeeper.DistributeWithdrawReward(ctx, req.Address)
+			_ = k.incentiveKeeper.DistributeWithdrawReward(ctx, req.Initiator())
 		}
 	}
 
@@ -10,12 +10,14 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+	oracletypes "github.com/bitwaylabs/bitway/x/oracle/types"
 )
 
 type (
@@ -67,7 +69,17 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeSponsorName))
 	}
 
-	return &Keeper{
+	// ensure the relayer bond pool module account is set
+	if addr := authKeeper.GetModuleAddress(types.RelayerBondPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.RelayerBondPoolName))
+	}
+
+	// ensure the quarantine module account is set
+	if addr := authKeeper.GetModuleAddress(types.QuarantineName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.QuarantineName))
+	}
+
+	k := &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
 		memKey:              memKey,
@@ -84,6 +96,12 @@ func NewKeeper(
 		BaseUTXOKeeper:      *NewBaseUTXOKeeper(cdc, storeKey),
 		authority:           authority,
 	}
+
+	// register handlers for the scheduled vault key rotation
+	tssKeeper.RegisterKeyRotationHandler(types.ModuleName, k.KeyRotationHandler)
+	tssKeeper.RegisterKeyRetirementHandler(types.ModuleName, k.KeyRetirementHandler)
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -113,21 +131,29 @@ func (k Keeper) GetFeeSponsorAccount(ctx sdk.Context) sdk.ModuleAccountI {
 	return k.authKeeper.GetModuleAccount(ctx, types.FeeSponsorName)
 }
 
+func (k Keeper) GetRelayerBondPoolAccount(ctx sdk.Context) sdk.ModuleAccountI {
+	return k.authKeeper.GetModuleAccount(ctx, types.RelayerBondPoolName)
+}
+
+func (k Keeper) GetQuarantineAccount(ctx sdk.Context) sdk.ModuleAccountI {
+	return k.authKeeper.GetModuleAccount(ctx, types.QuarantineName)
+}
+
 func (k Keeper) BankKeeper() types.BankKeeper {
 	return k.bankKeeper
 }
 
 // ValidateTransaction validates the given transaction
-func (k Keeper) ValidateTransaction(ctx sdk.Context, txBytes string, prevTxBytes string, blockHash string, proof []string, confirmationDepth int32) (*btcutil.Tx, *btcutil.Tx, error) {
+// The inclusion of the transaction is proved by either the merkle proof or the BIP-37 merkle block
+func (k Keeper) ValidateTransaction(ctx sdk.Context, txBytes string, prevTxBytes string, blockHash string, proof []string, merkleBlock string, confirmationDepth int32) (*btcutil.Tx, *btcutil.Tx, error) {
 	if !k.oracleKeeper.HasBlockHeader(ctx, blockHash) {
 		return nil, nil, types.ErrBlockNotFound
 	}
 
 	header := k.oracleKeeper.GetBlockHeader(ctx, blockHash)
-	bestHeader := k.oracleKeeper.GetBestBlockHeader(ctx)
 
 	// Check if the block is confirmed
-	if bestHeader.Height-header.Height+1 < confirmationDepth {
+	if !k.IsConfirmed(ctx, header.Height, confirmationDepth) {
 		return nil, nil, types.ErrNotConfirmed
 	}
 
@@ -191,6 +217,15 @@ func (k Keeper) ValidateTransaction(ctx sdk.Context, txBytes string, prevTxBytes
 		}
 	}
 
+	// check if the merkle block is valid if provided
+	if len(merkleBlock) != 0 {
+		if err := k.VerifyMerkleBlock(ctx, header, tx.Hash(), merkleBlock); err != nil {
+			return nil, nil, err
+		}
+
+		return tx, prevTx, nil
+	}
+
 	// check if the proof is valid
 	root, err := chainhash.NewHashFromStr(header.MerkleRoot)
 	if err != nil {
@@ -204,3 +239,55 @@ func (k Keeper) ValidateTransaction(ctx sdk.Context, txBytes string, prevTxBytes
 
 	return tx, prevTx, nil
 }
+
+// ValidateDepositTransaction validates the given deposit transaction
+// The required confirmation depth is determined by the BTC equivalent amount of the deposit according to the confirmation tiers
+func (k Keeper) ValidateDepositTransaction(ctx sdk.Context, txBytes string, prevTxBytes string, blockHash string, proof []string, merkleBlock string) (*btcutil.Tx, *btcutil.Tx, error) {
+	tx, prevTx, err := k.ValidateTransaction(ctx, txBytes, prevTxBytes, blockHash, proof, merkleBlock, k.DepositConfirmationDepth(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	confirmationDepth, err := k.GetDepositConfirmationDepthByTx(ctx, tx.MsgTx())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !k.IsConfirmed(ctx, k.oracleKeeper.GetBlockHeader(ctx, blockHash).Height, confirmationDepth) {
+		return nil, nil, errorsmod.Wrapf(types.ErrNotConfirmed, "%d confirmations required", confirmationDepth)
+	}
+
+	return tx, prevTx, nil
+}
+
+// VerifyMerkleBlock verifies the given merkle block against the oracle block header
+// The given tx must be matched in the merkle block
+func (k Keeper) VerifyMerkleBlock(ctx sdk.Context, header *oracletypes.BlockHeader, txHash *chainhash.Hash, merkleBlockHex string) error {
+	merkleBlock, err := types.ParseMerkleBlock(merkleBlockHex)
+	if err != nil {
+		return err
+	}
+
+	blockHash := merkleBlock.BlockHash()
+	if blockHash.String() != header.Hash {
+		return errorsmod.Wrap(types.ErrInvalidMerkleBlock, "block hash mismatch")
+	}
+
+	if merkleBlock.MerkleRoot.String() != header.MerkleRoot {
+		return errorsmod.Wrap(types.ErrInvalidMerkleBlock, "merkle root mismatch")
+	}
+
+	if !merkleBlock.HasTx(txHash) {
+		k.Logger(ctx).Error("Transaction not matched in merkle block", "txhash", txHash, "blockhash", header.Hash)
+		return types.ErrTransactionNotIncluded
+	}
+
+	return nil
+}
+
+// IsConfirmed returns true if the block of the given height reaches the specified confirmation depth, false otherwise
+func (k Keeper) IsConfirmed(ctx sdk.Context, height int32, confirmationDepth int32) bool {
+	bestHeader := k.oracleKeeper.GetBestBlockHeader(ctx)
+
+	return bestHeader.Height-height+1 >= confirmationDepth
+}
@@ -2,18 +2,29 @@ package keeper_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
 	"lukechampine.com/uint128"
 
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/btcutil/bech32"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -21,7 +32,10 @@ import (
 	simapp "github.com/bitwaylabs/bitway/app"
 	"github.com/bitwaylabs/bitway/bitcoin"
 	"github.com/bitwaylabs/bitway/bitcoin/keys/segwit"
+	"github.com/bitwaylabs/bitway/x/btcbridge/keeper"
+	btcbridge "github.com/bitwaylabs/bitway/x/btcbridge/module"
 	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+	oracletypes "github.com/bitwaylabs/bitway/x/oracle/types"
 )
 
 var (
@@ -60,18 +74,18 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.runesVaultPkScript = types.MustPkScriptFromAddress(suite.runesVault)
 	suite.senderPkScript = types.MustPkScriptFromAddress(suite.sender)
 
-	suite.setupParams(suite.btcVault, suite.runesVault, suite.sender)
+	suite.setupParams(suite.btcVault, suite.runesVault)
 	suite.mintAssets(suite.sender)
+	suite.bondRelayer(suite.sender)
 }
 
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
-func (suite *KeeperTestSuite) setupParams(btcVault string, runesVault string, nonBtcRelayer string) {
+func (suite *KeeperTestSuite) setupParams(btcVault string, runesVault string) {
 	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
 
-	params.TrustedNonBtcRelayers = []string{nonBtcRelayer}
 	params.Vaults = []*types.Vault{
 		{
 			Address:   btcVault,
@@ -96,6 +110,16 @@ func (suite *KeeperTestSuite) mintAssets(addresses ...string) {
 	}
 }
 
+func (suite *KeeperTestSuite) bondRelayer(relayer string) {
+	minBond := suite.app.BtcBridgeKeeper.RelayerMinBond(suite.ctx)
+
+	suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(minBond))
+	suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.MustAccAddressFromBech32(relayer), sdk.NewCoins(minBond))
+
+	_, err := suite.app.BtcBridgeKeeper.BondRelayer(suite.ctx, relayer, minBond)
+	suite.NoError(err)
+}
+
 func (suite *KeeperTestSuite) setupUTXOs(utxos []*types.UTXO) {
 	for _, utxo := range utxos {
 		suite.app.BtcBridgeKeeper.SaveUTXO(suite.ctx, utxo)
@@ -162,6 +186,470 @@ func (suite *KeeperTestSuite) TestMintRunes() {
 	suite.Equal(expectedBtcUTXO, utxos[1], "btc utxo does not match")
 }
 
+func (suite *KeeperTestSuite) TestRuneEtching() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+
+	etching := &types.RuneEtching{
+		Id:           "840000:3",
+		Name:         "DOGGOTOTHEMOON",
+		Spacers:      0b1001010100,
+		Symbol:       "🐕",
+		Divisibility: 5,
+	}
+	suite.NoError(etching.Validate())
+	suite.Equal("DOG•GO•TO•THE•MOON", etching.SpacedName())
+
+	denom := etching.Denom()
+
+	// only the active relayer is allowed to submit the etching
+	suite.ErrorIs(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, suite.btcVault, etching), types.ErrUntrustedNonBtcRelayer)
+
+	relayer2, _ := bech32.Encode(bitcoin.Network.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	relayer3, _ := bech32.Encode(bitcoin.Network.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	suite.bondRelayer(relayer2)
+	suite.bondRelayer(relayer3)
+
+	// the etching is pending until submitted by the relayer quorum
+	suite.Equal(uint32(2), suite.app.BtcBridgeKeeper.RuneEtchingQuorum(suite.ctx))
+
+	suite.NoError(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, suite.sender, etching))
+	suite.ErrorIs(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, suite.sender, etching), types.ErrRuneEtchingSubmitted)
+	suite.False(suite.app.BtcBridgeKeeper.HasRuneEtching(suite.ctx, etching.Id))
+
+	// the conflicting etching is not counted
+	conflictingEtching := *etching
+	conflictingEtching.Divisibility = 0
+	suite.NoError(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, relayer2, &conflictingEtching))
+	suite.False(suite.app.BtcBridgeKeeper.HasRuneEtching(suite.ctx, etching.Id))
+	suite.Len(suite.app.BtcBridgeKeeper.GetRuneEtchingSubmissions(suite.ctx, etching.Id), 2)
+
+	suite.NoError(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, relayer3, etching))
+	suite.Equal(etching, suite.app.BtcBridgeKeeper.GetRuneEtching(suite.ctx, etching.Id))
+	suite.Empty(suite.app.BtcBridgeKeeper.GetRuneEtchingSubmissions(suite.ctx, etching.Id), "submissions should be removed once accepted")
+	suite.ErrorIs(suite.app.BtcBridgeKeeper.SubmitRuneEtching(suite.ctx, suite.sender, etching), types.ErrRuneEtchingExists)
+
+	// not registered before the first deposit
+	suite.False(suite.app.BankKeeper.HasDenomMetaData(suite.ctx, denom))
+
+	runesScript, err := types.BuildEdictScript(etching.Id, uint128.From64(500000000), 2)
+	suite.NoError(err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, runesScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(params.ProtocolFees.DepositFee, suite.btcVaultPkScript))
+
+	_, _, _, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.NoError(err)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.True(found, "denom metadata should be registered on the first deposit")
+	suite.NoError(metadata.Validate())
+	suite.Equal("DOG•GO•TO•THE•MOON", metadata.Name)
+	suite.Equal("🐕", metadata.Symbol)
+	suite.Equal("runes/DOGGOTOTHEMOON", metadata.Display)
+	suite.Len(metadata.DenomUnits, 2)
+	suite.Equal(uint32(5), metadata.DenomUnits[1].Exponent)
+
+	// governance overrides the existing etching and metadata
+	etching.Symbol = ""
+	etching.Divisibility = 0
+	suite.app.BtcBridgeKeeper.UpdateRuneEtching(suite.ctx, etching)
+
+	metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.NoError(metadata.Validate())
+	suite.Equal(types.DefaultRuneSymbol, metadata.Symbol)
+	suite.Equal(denom, metadata.Display)
+	suite.Len(metadata.DenomUnits, 1)
+
+	// invalid etchings
+	suite.Error((&types.RuneEtching{Id: "840000:03", Name: "DOG"}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "dog"}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Spacers: 0b100}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Symbol: "AB"}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Symbol: " "}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Symbol: "\u00a0"}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Symbol: "\u200b"}).Validate())
+	suite.Error((&types.RuneEtching{Id: "840000:3", Name: "DOG", Divisibility: 39}).Validate())
+}
+
+func (suite *KeeperTestSuite) TestRelayer() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+
+	runeId := "840000:3"
+	runesScript, err := types.BuildEdictScript(runeId, uint128.From64(500000000), 2)
+	suite.NoError(err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, runesScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(params.ProtocolFees.DepositFee, suite.btcVaultPkScript))
+
+	collector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
+	collectorBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, collector, params.BtcVoucherDenom)
+
+	_, _, _, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.NoError(err)
+
+	expectedReward := params.ProtocolFees.DepositFee * int64(params.RelayerParams.DepositRewardPercentage) / 100
+
+	relayer := suite.app.BtcBridgeKeeper.GetRelayer(suite.ctx, suite.sender)
+	suite.Equal(uint64(1), relayer.RelayedDeposits, "incorrect relayed deposits")
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(params.BtcVoucherDenom, expectedReward)), relayer.TotalRewards, "incorrect relayer rewards")
+
+	collectorBalanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, collector, params.BtcVoucherDenom)
+	suite.Equal(params.ProtocolFees.DepositFee-expectedReward, collectorBalanceAfter.Amount.Sub(collectorBalanceBefore.Amount).Int64(), "incorrect protocol fee received by the collector")
+
+	txid := tx.TxHash().String()
+	suite.True(suite.app.BtcBridgeKeeper.HasRunesAttestation(suite.ctx, txid), "runes attestation should exist")
+
+	_, err = suite.app.BtcBridgeKeeper.GovSlashRelayer(suite.ctx, suite.sender, txid, types.RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION)
+	suite.NoError(err)
+
+	_, err = suite.app.BtcBridgeKeeper.GovSlashRelayer(suite.ctx, suite.sender, txid, types.RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION)
+	suite.ErrorIs(err, types.ErrRunesAttestationSlashed, "should not be slashed twice for the same attestation")
+
+	suite.False(suite.app.BtcBridgeKeeper.IsActiveRelayer(suite.ctx, suite.sender), "relayer should be inactive due to insufficient bond")
+
+	// the existing bond can not be topped up in the different denom once the minimum bond denom changed
+	params = suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.RelayerParams.MinBond = sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 1000)
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	_, err = suite.app.BtcBridgeKeeper.BondRelayer(suite.ctx, suite.sender, params.RelayerParams.MinBond)
+	suite.ErrorIs(err, types.ErrInvalidRelayerBond, "bond denom mismatch should be rejected")
+
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+
+	_, _, _, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.ErrorIs(err, types.ErrUntrustedNonBtcRelayer, "inactive relayer should not relay runes deposit")
+
+	relayer, err = suite.app.BtcBridgeKeeper.UnbondRelayer(suite.ctx, suite.sender)
+	suite.NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(relayer.UnbondingTime)
+	suite.app.BtcBridgeKeeper.HandleUnbondingRelayers(suite.ctx)
+
+	suite.False(suite.app.BtcBridgeKeeper.HasRelayer(suite.ctx, suite.sender), "relayer should be removed after unbonding")
+	suite.Equal(relayer.Bond, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), relayer.Bond.Denom), "bond should be released")
+}
+
+func (suite *KeeperTestSuite) TestPause() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.GuardianParams.Guardian = suite.sender
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	maxDuration := params.GuardianParams.MaxPauseDuration
+
+	_, err := suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_ASSET, types.AssetType_ASSET_TYPE_RUNES, maxDuration+1, "")
+	suite.ErrorIs(err, types.ErrInvalidPause, "guardian should not pause beyond the maximum duration")
+
+	pause, err := suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_ASSET, types.AssetType_ASSET_TYPE_RUNES, 0, "incident")
+	suite.NoError(err)
+	suite.Equal(suite.ctx.BlockTime().Add(maxDuration), pause.EndTime, "maximum duration should be used by default")
+
+	_, err = suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_ASSET, types.AssetType_ASSET_TYPE_RUNES, 0, "")
+	suite.ErrorIs(err, types.ErrAlreadyPaused)
+
+	suite.True(suite.app.BtcBridgeKeeper.IsAssetPaused(suite.ctx, types.AssetType_ASSET_TYPE_RUNES), "runes should be paused")
+	suite.False(suite.app.BtcBridgeKeeper.IsAssetPaused(suite.ctx, types.AssetType_ASSET_TYPE_BTC), "btc should not be paused")
+
+	runesScript, err := types.BuildEdictScript("840000:3", uint128.From64(500000000), 2)
+	suite.NoError(err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, runesScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(params.ProtocolFees.DepositFee, suite.btcVaultPkScript))
+
+	_, _, _, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.ErrorIs(err, types.ErrPaused, "runes deposit should be paused")
+
+	pause, err = suite.app.BtcBridgeKeeper.ExtendPause(suite.ctx, types.PauseTarget_PAUSE_TARGET_ASSET, types.AssetType_ASSET_TYPE_RUNES, maxDuration)
+	suite.NoError(err)
+	suite.Equal(uint32(1), pause.Extensions)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(maxDuration))
+	suite.app.BtcBridgeKeeper.HandleExpiredPauses(suite.ctx)
+	suite.True(suite.app.BtcBridgeKeeper.IsAssetPaused(suite.ctx, types.AssetType_ASSET_TYPE_RUNES), "pause should be extended")
+
+	suite.ctx = suite.ctx.WithBlockTime(pause.EndTime)
+	suite.app.BtcBridgeKeeper.HandleExpiredPauses(suite.ctx)
+	suite.False(suite.app.BtcBridgeKeeper.IsAssetPaused(suite.ctx, types.AssetType_ASSET_TYPE_RUNES), "pause should expire")
+	suite.Equal(types.PauseStatus_PAUSE_STATUS_EXPIRED, suite.app.BtcBridgeKeeper.GetPause(suite.ctx, pause.Id).Status)
+
+	pause, err = suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED, 0, "")
+	suite.NoError(err)
+
+	_, err = suite.app.BtcBridgeKeeper.Unpause(suite.ctx, suite.btcVault, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED)
+	suite.ErrorIs(err, types.ErrUnauthorizedPauser)
+
+	_, err = suite.app.BtcBridgeKeeper.Unpause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED)
+	suite.NoError(err)
+	suite.Equal(types.PauseStatus_PAUSE_STATUS_LIFTED, suite.app.BtcBridgeKeeper.GetPause(suite.ctx, pause.Id).Status)
+	suite.Len(suite.app.BtcBridgeKeeper.GetActivePauses(suite.ctx), 0)
+
+	_, err = suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED, 0, "")
+	suite.ErrorIs(err, types.ErrInvalidPause, "guardian should not pause the same target again during the cooldown")
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	pause, err = suite.app.BtcBridgeKeeper.Pause(suite.ctx, authority, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED, 0, "")
+	suite.NoError(err, "authority should not be limited by the cooldown")
+
+	_, err = suite.app.BtcBridgeKeeper.Unpause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED)
+	suite.ErrorIs(err, types.ErrUnauthorizedPauser, "guardian should not lift the pause initiated by the authority")
+
+	_, err = suite.app.BtcBridgeKeeper.Unpause(suite.ctx, authority, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED)
+	suite.NoError(err)
+	suite.Equal(types.PauseStatus_PAUSE_STATUS_LIFTED, suite.app.BtcBridgeKeeper.GetPause(suite.ctx, pause.Id).Status)
+
+	_, err = suite.app.BtcBridgeKeeper.Pause(suite.ctx, suite.sender, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED, 0, "")
+	suite.NoError(err, "cooldown should only apply after the guardian pause")
+}
+
+func (suite *KeeperTestSuite) TestScreening() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+
+	suite.app.BtcBridgeKeeper.UpdateDenylist(suite.ctx, []string{suite.sender}, nil, "sanctioned")
+	suite.True(suite.app.BtcBridgeKeeper.IsScreenedAddress(suite.ctx, suite.sender), "sender should be screened")
+
+	_, err := suite.app.BtcBridgeKeeper.HandleBtcWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(params.BtcVoucherDenom, 100000))
+	suite.ErrorIs(err, types.ErrAddressScreened, "withdrawal to the screened address should be refused")
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(1000000, suite.btcVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), params.BtcVoucherDenom)
+
+	_, _, amount, err := suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.NoError(err)
+
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), params.BtcVoucherDenom)
+	relayerReward := params.ProtocolFees.DepositFee * int64(params.RelayerParams.DepositRewardPercentage) / 100
+	suite.Equal(relayerReward, balanceAfter.Amount.Sub(balanceBefore.Amount).Int64(), "screened recipient should only receive the relayer reward")
+
+	quarantineBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.app.BtcBridgeKeeper.GetQuarantineAccount(suite.ctx).GetAddress(), params.BtcVoucherDenom)
+	suite.Equal(*amount, quarantineBalance, "deposit should be credited to the quarantine account")
+
+	suite.True(suite.app.BtcBridgeKeeper.HasQuarantinedDeposit(suite.ctx, tx.TxHash().String()), "quarantined deposit should be recorded")
+
+	// the group containing the screened address is quarantined as a whole
+	grouped := []*types.WithdrawRequest{}
+	for _, address := range []string{suite.runesVault, suite.sender} {
+		req := suite.app.BtcBridgeKeeper.NewWithdrawRequest(suite.ctx, address, sdk.NewInt64Coin(params.BtcVoucherDenom, 100000).String())
+		req.Sender = suite.btcVault
+		grouped = append(grouped, req)
+	}
+
+	standalone := suite.app.BtcBridgeKeeper.NewWithdrawRequest(suite.ctx, suite.btcVault, sdk.NewInt64Coin(params.BtcVoucherDenom, 100000).String())
+
+	for _, req := range append(grouped, standalone) {
+		if req.Sender != "" {
+			req.Group = grouped[0].Sequence
+		}
+
+		suite.app.BtcBridgeKeeper.SetWithdrawRequest(suite.ctx, req)
+		suite.app.BtcBridgeKeeper.AddToBtcWithdrawRequestQueue(suite.ctx, req)
+	}
+
+	remaining := suite.app.BtcBridgeKeeper.QuarantineScreenedWithdrawRequests(suite.ctx, suite.app.BtcBridgeKeeper.GetPendingBtcWithdrawRequests(suite.ctx, 0))
+	suite.Len(remaining, 1)
+	suite.Equal(standalone.Sequence, remaining[0].Sequence)
+
+	for _, req := range grouped {
+		suite.True(suite.app.BtcBridgeKeeper.HasQuarantinedWithdrawal(suite.ctx, req.Sequence), "all withdrawals of the group should be quarantined")
+		suite.Equal(req.Group, suite.app.BtcBridgeKeeper.GetQuarantinedWithdrawal(suite.ctx, req.Sequence).Group)
+	}
+
+	suite.Len(suite.app.BtcBridgeKeeper.GetPendingBtcWithdrawRequests(suite.ctx, 0), 1, "quarantined withdrawals should be removed from the queue")
+	suite.Equal(quarantineBalance.AddAmount(sdkmath.NewInt(200000)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.app.BtcBridgeKeeper.GetQuarantineAccount(suite.ctx).GetAddress(), params.BtcVoucherDenom))
+
+	suite.app.BtcBridgeKeeper.UpdateDenylist(suite.ctx, nil, []string{suite.sender}, "")
+	suite.False(suite.app.BtcBridgeKeeper.IsScreenedAddress(suite.ctx, suite.sender), "sender should be removed from the denylist")
+}
+
+func (suite *KeeperTestSuite) TestDepositRecords() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+
+	prevTx := wire.NewMsgTx(types.TxVersion)
+	prevTx.AddTxOut(wire.NewTxOut(2000000, suite.senderPkScript))
+
+	prevTxHash := prevTx.TxHash()
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevTxHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000000, suite.btcVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+
+	_, _, amount, err := suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(prevTx), 100)
+	suite.NoError(err)
+
+	record := suite.app.BtcBridgeKeeper.GetDepositRecord(suite.ctx, tx.TxHash().String())
+	suite.NotNil(record, "deposit record should be persisted")
+	suite.Equal(uint64(0), record.Vout)
+	suite.Equal(suite.sender, record.Recipient)
+	suite.Equal(types.AssetType_ASSET_TYPE_BTC, record.Asset)
+	suite.Equal(*amount, record.Amount)
+	suite.Equal(sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee), record.Fee)
+	suite.Equal(uint64(100), record.BtcBlockHeight)
+	suite.False(record.Quarantined)
+	suite.Equal(suite.sender, record.Sender, "deposit sender should be resolved")
+
+	records, _, err := suite.app.BtcBridgeKeeper.GetDepositRecordsByRecipientWithPagination(suite.ctx, suite.sender, nil)
+	suite.NoError(err)
+	suite.Equal([]*types.DepositRecord{record}, records)
+
+	records, _, err = suite.app.BtcBridgeKeeper.GetDepositRecordsBySenderWithPagination(suite.ctx, record.Sender, nil)
+	suite.NoError(err)
+	suite.Equal([]*types.DepositRecord{record}, records)
+
+	records, _, err = suite.app.BtcBridgeKeeper.GetDepositRecordsWithPagination(suite.ctx, types.AssetType_ASSET_TYPE_RUNES, nil)
+	suite.NoError(err)
+	suite.Empty(records)
+
+	records, _, err = suite.app.BtcBridgeKeeper.GetDepositRecordsWithPagination(suite.ctx, types.AssetType_ASSET_TYPE_BTC, nil)
+	suite.NoError(err)
+	suite.Len(records, 1)
+
+	// the address which the given address is a prefix of is not matched
+	otherRecord := *record
+	otherRecord.Txid = chainhash.HashH([]byte("other deposit")).String()
+	otherRecord.Recipient = suite.sender + "0"
+	otherRecord.Sender = record.Sender + "0"
+	suite.app.BtcBridgeKeeper.SetDepositRecord(suite.ctx, &otherRecord)
+
+	records, _, err = suite.app.BtcBridgeKeeper.GetDepositRecordsByRecipientWithPagination(suite.ctx, suite.sender, nil)
+	suite.NoError(err)
+	suite.Equal([]*types.DepositRecord{record}, records)
+
+	records, _, err = suite.app.BtcBridgeKeeper.GetDepositRecordsBySenderWithPagination(suite.ctx, record.Sender, nil)
+	suite.NoError(err)
+	suite.Equal([]*types.DepositRecord{record}, records)
+}
+
+func (suite *KeeperTestSuite) TestSubmitDepositTransactions() {
+	fundingTxHash := chainhash.DoubleHashH([]byte("funding"))
+
+	prevTx := wire.NewMsgTx(types.TxVersion)
+	prevTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingTxHash, 0), nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(2000000, suite.senderPkScript))
+	prevTx.AddTxOut(wire.NewTxOut(2000000, suite.senderPkScript))
+
+	prevTxHash := prevTx.TxHash()
+
+	tx1 := wire.NewMsgTx(types.TxVersion)
+	tx1.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevTxHash, 0), nil, nil))
+	tx1.AddTxOut(wire.NewTxOut(1000000, suite.btcVaultPkScript))
+
+	tx2 := wire.NewMsgTx(types.TxVersion)
+	tx2.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevTxHash, 1), nil, nil))
+	tx2.AddTxOut(wire.NewTxOut(1500000, suite.btcVaultPkScript))
+
+	tx1Hash := tx1.TxHash()
+	tx2Hash := tx2.TxHash()
+	merkleRoot := blockchain.HashMerkleBranches(&tx1Hash, &tx2Hash)
+
+	header := &oracletypes.BlockHeader{
+		Hash:       chainhash.DoubleHashH([]byte("block")).String(),
+		Height:     100,
+		MerkleRoot: merkleRoot.String(),
+	}
+	suite.app.OracleKeeper.SetBlockHeader(suite.ctx, header)
+	suite.app.OracleKeeper.SetBestBlockHeader(suite.ctx, &oracletypes.BlockHeader{Height: 200})
+
+	msg := types.NewMsgSubmitDepositTransactions(
+		suite.sender,
+		[]types.DepositBlock{{Blockhash: header.Hash}},
+		[]string{serializeTx(prevTx)},
+		[]types.DepositTransaction{
+			{TxBytes: serializeTx(tx1), Proof: []string{merkleProofNode(0, &tx2Hash)}},
+			{TxBytes: serializeTx(tx2), Proof: []string{merkleProofNode(1, &tx1Hash)}},
+			// duplicate deposit
+			{TxBytes: serializeTx(tx1), Proof: []string{merkleProofNode(0, &tx2Hash)}},
+		},
+	)
+	suite.NoError(msg.ValidateBasic())
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+
+	results := suite.app.BtcBridgeKeeper.ProcessBitcoinDepositTransactions(suite.ctx, msg)
+	suite.Len(results, 3)
+
+	suite.True(results[0].Success)
+	suite.Equal(tx1Hash.String(), results[0].Txid)
+	suite.Equal(suite.sender, results[0].Recipient)
+
+	suite.True(results[1].Success)
+	suite.Equal(tx2Hash.String(), results[1].Txid)
+
+	suite.False(results[2].Success, "duplicate deposit should fail")
+	suite.Equal(tx1Hash.String(), results[2].Txid)
+	suite.Contains(results[2].Error, types.ErrTransactionAlreadyMinted.Error())
+
+	suite.NotNil(suite.app.BtcBridgeKeeper.GetDepositRecord(suite.ctx, tx1Hash.String()))
+	suite.NotNil(suite.app.BtcBridgeKeeper.GetDepositRecord(suite.ctx, tx2Hash.String()))
+
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+	suite.True(balanceAfter.Amount.GT(balanceBefore.Amount), "deposits should be minted")
+}
+
+func serializeTx(tx *wire.MsgTx) string {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		panic(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func merkleProofNode(position byte, hash *chainhash.Hash) string {
+	return base64.StdEncoding.EncodeToString(append([]byte{position}, hash[:]...))
+}
+
+func (suite *KeeperTestSuite) TestDepositConfirmationDepth() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.DepositConfirmationParams = types.DepositConfirmationParams{
+		Tiers: []types.DepositConfirmationTier{
+			{MinAmount: 0, ConfirmationDepth: 1},
+			{MinAmount: 10000000, ConfirmationDepth: 3},
+			{MinAmount: 100000000, ConfirmationDepth: 6},
+		},
+		RunesPriceSymbols: []types.RunesPriceSymbol{
+			{Id: "840000:3", Symbol: "DOGBTC", Divisibility: 5},
+		},
+	}
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	testCases := []struct {
+		amount            sdk.Coin
+		confirmationDepth int32
+	}{
+		{sdk.NewInt64Coin(params.BtcVoucherDenom, 100000), 1},
+		{sdk.NewInt64Coin(params.BtcVoucherDenom, 10000000), 3},
+		{sdk.NewInt64Coin(params.BtcVoucherDenom, 500000000), 6},
+		{sdk.NewInt64Coin("runes/840000:3", 100000000), 6},
+	}
+
+	for _, tc := range testCases {
+		confirmationDepth, _, err := suite.app.BtcBridgeKeeper.GetDepositConfirmationDepth(suite.ctx, tc.amount)
+		suite.NoError(err)
+		suite.Equal(tc.confirmationDepth, confirmationDepth, "amount: %s", tc.amount)
+	}
+
+	// 1000 DOG at 0.0001 BTC per DOG
+	suite.app.OracleKeeper.SetPrice(suite.ctx, "DOGBTC", "0.0001")
+
+	confirmationDepth, btcAmount, err := suite.app.BtcBridgeKeeper.GetDepositConfirmationDepth(suite.ctx, sdk.NewInt64Coin("runes/840000:3", 100000000))
+	suite.NoError(err)
+	suite.Equal(int64(10000000), btcAmount)
+	suite.Equal(int32(3), confirmationDepth)
+}
+
 func (suite *KeeperTestSuite) TestWithdrawRunes() {
 	runeId := "840000:3"
 	runeAmount := 500000000
@@ -241,3 +729,397 @@ func (suite *KeeperTestSuite) TestWithdrawRunes() {
 	suite.Equal(suite.senderPkScript, p.UnsignedTx.TxOut[2].PkScript, "the third output should be sender output")
 	suite.Equal(suite.btcVaultPkScript, p.UnsignedTx.TxOut[3].PkScript, "the fouth output should be btc change output")
 }
+
+func (suite *KeeperTestSuite) TestMultiWithdraw() {
+	suite.setupUTXOs([]*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("payment")).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       100000000,
+			PubKeyScript: suite.btcVaultPkScript,
+			IsLocked:     false,
+		},
+	})
+
+	suite.app.BtcBridgeKeeper.SetFeeRate(suite.ctx, 10)
+
+	// queue a standalone withdrawal request ahead of the group
+	standalone := suite.app.BtcBridgeKeeper.NewWithdrawRequest(suite.ctx, suite.sender, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 100000).String())
+	suite.app.BtcBridgeKeeper.SetWithdrawRequest(suite.ctx, standalone)
+	suite.app.BtcBridgeKeeper.AddToBtcWithdrawRequestQueue(suite.ctx, standalone)
+
+	recipients := []string{suite.btcVault, suite.runesVault}
+	amounts := []sdk.Coin{sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 200000), sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 300000)}
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+
+	requests, err := suite.app.BtcBridgeKeeper.HandleBtcMultiWithdrawal(suite.ctx, suite.sender, recipients, amounts)
+	suite.NoError(err)
+	suite.Len(requests, 2)
+
+	for i, req := range requests {
+		suite.Equal(recipients[i], req.Address)
+		suite.Equal(suite.sender, req.Sender)
+		suite.Equal(suite.sender, req.Initiator())
+		suite.Equal(requests[0].Sequence, req.Group, "the withdrawal requests should share the same group")
+	}
+
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+	suite.True(balanceBefore.Amount.Sub(balanceAfter.Amount).GT(amounts[0].Amount.Add(amounts[1].Amount)), "the total amount and network fee should be burned")
+
+	pendingRequests := suite.app.BtcBridgeKeeper.GetPendingBtcWithdrawRequests(suite.ctx, 2)
+	suite.Len(pendingRequests, 1, "the group should not be split across batches")
+	suite.Equal(standalone.Sequence, pendingRequests[0].Sequence)
+
+	pendingRequests = suite.app.BtcBridgeKeeper.GetPendingBtcWithdrawRequests(suite.ctx, 3)
+	suite.Len(pendingRequests, 3)
+
+	// the oversized group is kept as a whole
+	suite.app.BtcBridgeKeeper.RemoveFromBtcWithdrawRequestQueue(suite.ctx, standalone)
+
+	pendingRequests = suite.app.BtcBridgeKeeper.GetPendingBtcWithdrawRequests(suite.ctx, 1)
+	suite.Len(pendingRequests, 2)
+
+	// the protocol fee is charged once for the group
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	protocolFee := sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, params.ProtocolFees.WithdrawFee)
+	collector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
+
+	collectorBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, collector, types.DefaultBtcVoucherDenom)
+
+	actualAmounts, err := suite.app.BtcBridgeKeeper.HandleMultiWithdrawProtocolFee(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), amounts)
+	suite.NoError(err)
+	suite.Equal([]sdk.Coin{amounts[0].Sub(protocolFee), amounts[1]}, actualAmounts)
+
+	collectorBalanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, collector, types.DefaultBtcVoucherDenom)
+	suite.Equal(protocolFee, collectorBalanceAfter.Sub(collectorBalanceBefore))
+
+	// every output is checked against the protocol limits
+	_, err = suite.app.BtcBridgeKeeper.HandleMultiWithdrawProtocolFee(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), []sdk.Coin{amounts[0], sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, params.ProtocolLimits.BtcMinWithdraw-1)})
+	suite.ErrorIs(err, types.ErrInvalidWithdrawAmount)
+}
+
+func (suite *KeeperTestSuite) TestFeeSponsorshipPolicy() {
+	withdrawMsg := &types.MsgWithdrawToBitcoin{Sender: suite.sender}
+	etchingMsg := &types.MsgSubmitRuneEtching{Sender: suite.sender}
+
+	policy := types.FeeSponsorshipPolicy{
+		Id:            "withdrawal",
+		MsgTypeUrls:   []string{sdk.MsgTypeURL(withdrawMsg)},
+		AccountQuota:  2,
+		EpochBudget:   sdk.NewCoins(sdk.NewInt64Coin("ubtw", 500)),
+		EpochDuration: time.Hour,
+	}
+	suite.NoError(policy.Validate())
+
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.FeeSponsorshipParams.Policies = []types.FeeSponsorshipPolicy{policy}
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	account1 := sdk.AccAddress("account1").String()
+	account2 := sdk.AccAddress("account2").String()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ubtw", 250))
+
+	suite.Nil(suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg, etchingMsg}, account1, fee), "all msgs should be covered")
+	suite.Equal(suite.app.AccountKeeper.GetModuleAddress(types.FeeSponsorName), suite.app.BtcBridgeKeeper.GetFeeSponsorshipFundingAccount(suite.ctx, &policy))
+
+	// account quota
+	for i := 0; i < int(policy.AccountQuota); i++ {
+		matched := suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg}, account1, sdk.NewCoins(sdk.NewInt64Coin("ubtw", 10)))
+		suite.NotNil(matched)
+
+		suite.app.BtcBridgeKeeper.RecordFeeSponsorship(suite.ctx, matched, account1, sdk.NewCoins(sdk.NewInt64Coin("ubtw", 10)))
+	}
+
+	suite.Nil(suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg}, account1, fee), "account quota should be exhausted")
+
+	// epoch budget
+	suite.NotNil(suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg}, account2, fee))
+	suite.app.BtcBridgeKeeper.RecordFeeSponsorship(suite.ctx, &policy, account2, fee)
+	suite.Nil(suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg}, account2, fee), "epoch budget should be exhausted")
+
+	usage := suite.app.BtcBridgeKeeper.GetFeeSponsorshipUsage(suite.ctx, &policy)
+	suite.Equal(uint64(3), usage.TxCount)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtw", 270)), usage.Spent)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtw", 230)), policy.RemainingBudget(usage))
+
+	res, err := suite.app.BtcBridgeKeeper.QueryFeeSponsorshipAccountUsage(suite.ctx, &types.QueryFeeSponsorshipAccountUsageRequest{PolicyId: policy.Id, Address: account2})
+	suite.NoError(err)
+	suite.Equal(uint32(1), res.Usage.TxCount)
+	suite.Equal(uint32(1), res.RemainingQuota)
+
+	// quota and budget are reset in the new epoch
+	suite.ctx = suite.ctx.WithBlockTime(usage.EpochStartTime.Add(policy.EpochDuration))
+
+	suite.NotNil(suite.app.BtcBridgeKeeper.MatchFeeSponsorshipPolicy(suite.ctx, []sdk.Msg{withdrawMsg}, account1, fee))
+	suite.Equal(uint64(0), suite.app.BtcBridgeKeeper.GetFeeSponsorshipUsage(suite.ctx, &policy).TxCount)
+
+	// account usages of the elapsed epoch are pruned
+	suite.app.BtcBridgeKeeper.RecordFeeSponsorship(suite.ctx, &policy, account1, fee)
+	suite.Len(suite.app.BtcBridgeKeeper.GetAllFeeSponsorshipAccountUsages(suite.ctx), 3)
+
+	suite.app.BtcBridgeKeeper.PruneFeeSponsorshipAccountUsages(suite.ctx)
+
+	accountUsages := suite.app.BtcBridgeKeeper.GetAllFeeSponsorshipAccountUsages(suite.ctx)
+	suite.Len(accountUsages, 1)
+	suite.Equal(account1, accountUsages[0].Address)
+	suite.Equal(uint32(1), accountUsages[0].TxCount)
+
+	// all usages of the removed policy are pruned
+	params.FeeSponsorshipParams.Policies = []types.FeeSponsorshipPolicy{}
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	suite.app.BtcBridgeKeeper.PruneFeeSponsorshipAccountUsages(suite.ctx)
+	suite.Empty(suite.app.BtcBridgeKeeper.GetAllFeeSponsorshipAccountUsages(suite.ctx))
+	suite.Empty(suite.app.BtcBridgeKeeper.GetAllFeeSponsorshipUsages(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+
+	// version 1 params with the deprecated deposit confirmation depth
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.DepositConfirmationParams = types.DepositConfirmationParams{}
+	params.RelayerParams = types.RelayerParams{}
+	params.GuardianParams = types.GuardianParams{}
+	params.VaultRecoveryParams = types.VaultRecoveryParams{}
+
+	bz := suite.app.AppCodec().MustMarshal(&params)
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 6)
+
+	// version 1 params with the deprecated trusted non-btc relayers
+	trustedRelayer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	bz = protowire.AppendTag(bz, 7, protowire.BytesType)
+	bz = protowire.AppendString(bz, trustedRelayer)
+
+	store.Set(types.ParamsStoreKey, bz)
+
+	suite.False(suite.app.BtcBridgeKeeper.IsActiveRelayer(suite.ctx, trustedRelayer))
+
+	suite.NoError(keeper.NewMigrator(suite.app.BtcBridgeKeeper).Migrate1to2(suite.ctx))
+
+	params = suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	suite.NoError(params.Validate())
+	suite.Equal(int32(6), params.DepositConfirmationParams.BaseConfirmationDepth())
+	suite.Equal(int32(6), suite.app.BtcBridgeKeeper.DepositConfirmationDepth(suite.ctx))
+	suite.Equal(types.DefaultParams().RelayerParams.MinBond, params.RelayerParams.MinBond)
+	suite.Equal(types.DefaultRuneEtchingQuorum, params.RelayerParams.RuneEtchingQuorum)
+	suite.Equal([]string{trustedRelayer}, params.RelayerParams.GrandfatheredRelayers)
+	suite.True(suite.app.BtcBridgeKeeper.IsActiveRelayer(suite.ctx, trustedRelayer), "trusted non-btc relayer should be grandfathered")
+	suite.Equal(types.DefaultParams().GuardianParams, params.GuardianParams)
+	suite.Equal(types.DefaultVaultRecoveryTimelock, params.VaultRecoveryParams.Timelock)
+	suite.False(params.VaultRecoveryParams.Enabled(), "vault recovery should be disabled after migration")
+}
+
+func (suite *KeeperTestSuite) TestCompleteDKGWithPubKeys() {
+	participantKey := ed25519.GenPrivKey()
+	consensusPubKey := base64.StdEncoding.EncodeToString(participantKey.PubKey().Bytes())
+
+	participants := []*types.DKGParticipant{{ConsensusPubkey: consensusPubKey}}
+
+	dkgReq, err := suite.app.BtcBridgeKeeper.InitiateDKG(suite.ctx, participants, 1, []types.AssetType{types.AssetType_ASSET_TYPE_BTC}, false, 0)
+	suite.NoError(err)
+
+	vaultPubKey := segwit.GenPrivKey().PubKey().Bytes()
+
+	witnessScript, err := types.GetSingleKeyWitnessScript(vaultPubKey)
+	suite.NoError(err)
+
+	witnessScriptHash := sha256.Sum256(witnessScript)
+	vaultAddr, err := btcutil.NewAddressWitnessScriptHash(witnessScriptHash[:], bitcoin.Network)
+	suite.NoError(err)
+
+	vault := vaultAddr.EncodeAddress()
+
+	completionReq := &types.DKGCompletionRequest{
+		Id:              dkgReq.Id,
+		Sender:          suite.sender,
+		Vaults:          []string{vault},
+		ConsensusPubkey: consensusPubKey,
+	}
+
+	sig, err := participantKey.Sign(types.GetDKGCompletionSigMsg(completionReq))
+	suite.NoError(err)
+	completionReq.Signature = hex.EncodeToString(sig)
+
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.ErrorIs(err, types.ErrInvalidDKGCompletionRequest, "pub key should be required for the P2WSH vault")
+
+	completionReq.PubKeys = []string{hex.EncodeToString(segwit.GenPrivKey().PubKey().Bytes())}
+
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.ErrorIs(err, types.ErrInvalidDKGCompletionRequest, "pub key should match the vault")
+
+	completionReq.PubKeys = []string{hex.EncodeToString(vaultPubKey)}
+
+	sig, err = participantKey.Sign(types.GetDKGCompletionSigMsg(completionReq))
+	suite.NoError(err)
+	completionReq.Signature = hex.EncodeToString(sig)
+
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.NoError(err)
+
+	btcbridge.EndBlocker(suite.ctx, suite.app.BtcBridgeKeeper)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED, suite.app.BtcBridgeKeeper.GetDKGRequest(suite.ctx, dkgReq.Id).Status)
+
+	vaults := suite.app.BtcBridgeKeeper.GetParams(suite.ctx).Vaults
+	suite.Equal(hex.EncodeToString(vaultPubKey), types.SelectVaultByAddress(vaults, vault).PubKey, "vault pub key should be stored")
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(100000, suite.senderPkScript))
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	suite.NoError(err)
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(200000, types.MustPkScriptFromAddress(vault))
+
+	err = types.PopulatePsbtWithWitnessScripts(p, vaults)
+	suite.NoError(err)
+	suite.Equal(witnessScript, p.Inputs[0].WitnessScript, "witness script should be populated for the P2WSH vault")
+}
+
+func (suite *KeeperTestSuite) TestCompleteDKGWithInternalKeys() {
+	recoveryKey, err := btcec.NewPrivateKey()
+	suite.NoError(err)
+
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.VaultRecoveryParams = types.VaultRecoveryParams{
+		PubKeys:   []string{hex.EncodeToString(schnorr.SerializePubKey(recoveryKey.PubKey()))},
+		Threshold: 1,
+		Timelock:  144,
+	}
+	suite.NoError(params.Validate())
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	participantKey := ed25519.GenPrivKey()
+	consensusPubKey := base64.StdEncoding.EncodeToString(participantKey.PubKey().Bytes())
+
+	participants := []*types.DKGParticipant{{ConsensusPubkey: consensusPubKey}}
+
+	dkgReq, err := suite.app.BtcBridgeKeeper.InitiateDKG(suite.ctx, participants, 1, []types.AssetType{types.AssetType_ASSET_TYPE_BTC}, false, 0)
+	suite.NoError(err)
+	suite.Equal(params.VaultRecoveryParams, *dkgReq.RecoveryParams, "recovery params should be committed by the dkg request")
+
+	internalKey, err := btcec.NewPrivateKey()
+	suite.NoError(err)
+
+	recovery := &types.VaultRecovery{
+		InternalKey: hex.EncodeToString(schnorr.SerializePubKey(internalKey.PubKey())),
+		Params:      params.VaultRecoveryParams,
+	}
+
+	vault, err := recovery.GetAddress()
+	suite.NoError(err)
+
+	completionReq := &types.DKGCompletionRequest{
+		Id:              dkgReq.Id,
+		Sender:          suite.sender,
+		Vaults:          []string{vault},
+		ConsensusPubkey: consensusPubKey,
+	}
+
+	signCompletionReq := func() {
+		sig, err := participantKey.Sign(types.GetDKGCompletionSigMsg(completionReq))
+		suite.NoError(err)
+		completionReq.Signature = hex.EncodeToString(sig)
+	}
+
+	signCompletionReq()
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.ErrorIs(err, types.ErrInvalidDKGCompletionRequest, "internal keys should be required when the recovery is enabled")
+
+	otherKey, err := btcec.NewPrivateKey()
+	suite.NoError(err)
+
+	completionReq.InternalKeys = []string{hex.EncodeToString(schnorr.SerializePubKey(otherKey.PubKey()))}
+	signCompletionReq()
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.ErrorIs(err, types.ErrInvalidDKGCompletionRequest, "vault should commit to the recovery script with the internal key")
+
+	completionReq.InternalKeys = []string{recovery.InternalKey}
+	err = suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq)
+	suite.ErrorIs(err, types.ErrInvalidDKGCompletionRequest, "internal keys should be signed")
+
+	signCompletionReq()
+	suite.NoError(suite.app.BtcBridgeKeeper.CompleteDKG(suite.ctx, completionReq))
+
+	btcbridge.EndBlocker(suite.ctx, suite.app.BtcBridgeKeeper)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED, suite.app.BtcBridgeKeeper.GetDKGRequest(suite.ctx, dkgReq.Id).Status)
+
+	storedVault := types.SelectVaultByAddress(suite.app.BtcBridgeKeeper.GetParams(suite.ctx).Vaults, vault)
+	suite.NotNil(storedVault)
+	suite.Equal(recovery, storedVault.Recovery, "vault recovery should be stored")
+
+	recoveries, err := suite.app.BtcBridgeKeeper.GetVaultRecoveries(suite.ctx)
+	suite.NoError(err)
+	suite.Len(recoveries, 3)
+
+	// build the recovery psbt
+	_, _, err = suite.app.BtcBridgeKeeper.BuildVaultRecoveryPsbt(suite.ctx, suite.sender, suite.sender, 10)
+	suite.ErrorIs(err, types.ErrVaultDoesNotExist)
+
+	_, _, err = suite.app.BtcBridgeKeeper.BuildVaultRecoveryPsbt(suite.ctx, suite.btcVault, suite.sender, 10)
+	suite.ErrorIs(err, types.ErrVaultRecoveryNotEnabled)
+
+	_, _, err = suite.app.BtcBridgeKeeper.BuildVaultRecoveryPsbt(suite.ctx, vault, suite.sender, 0)
+	suite.ErrorIs(err, types.ErrInvalidFeeRate)
+
+	_, _, err = suite.app.BtcBridgeKeeper.BuildVaultRecoveryPsbt(suite.ctx, vault, suite.sender, 10)
+	suite.ErrorIs(err, types.ErrInsufficientUTXOs)
+
+	vaultPkScript := types.MustPkScriptFromAddress(vault)
+
+	suite.setupUTXOs([]*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("deposit1")).String(),
+			Vout:         0,
+			Address:      vault,
+			Amount:       100000,
+			PubKeyScript: vaultPkScript,
+		},
+		{
+			Txid:         chainhash.HashH([]byte("deposit2")).String(),
+			Vout:         1,
+			Address:      vault,
+			Amount:       200000,
+			PubKeyScript: vaultPkScript,
+			IsLocked:     true,
+		},
+	})
+
+	p, utxos, err := suite.app.BtcBridgeKeeper.BuildVaultRecoveryPsbt(suite.ctx, vault, suite.sender, 10)
+	suite.NoError(err)
+	suite.Len(utxos, 2, "locked utxos should be included")
+	suite.Len(p.UnsignedTx.TxOut, 1)
+	suite.Equal(suite.senderPkScript, p.UnsignedTx.TxOut[0].PkScript)
+
+	// the recovery key is able to spend the vault utxos via the script path after the time lock
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range p.UnsignedTx.TxIn {
+		suite.Equal(params.VaultRecoveryParams.Timelock, txIn.Sequence)
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+	}
+
+	tx := p.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+
+	for i, input := range p.Inputs {
+		leaf := txscript.NewBaseTapLeaf(input.TaprootLeafScript[0].Script)
+
+		sigHash, err := txscript.CalcTapscriptSignaturehash(sigHashes, input.SighashType, tx, i, prevOutFetcher, leaf)
+		suite.NoError(err)
+
+		sig, err := schnorr.Sign(recoveryKey, sigHash)
+		suite.NoError(err)
+
+		tx.TxIn[i].Witness = wire.TxWitness{sig.Serialize(), input.TaprootLeafScript[0].Script, input.TaprootLeafScript[0].ControlBlock}
+	}
+
+	for i, input := range p.Inputs {
+		vm, err := txscript.NewEngine(input.WitnessUtxo.PkScript, tx, i, txscript.StandardVerifyFlags, nil, sigHashes, input.WitnessUtxo.Value, prevOutFetcher)
+		suite.NoError(err)
+		suite.NoError(vm.Execute())
+	}
+}
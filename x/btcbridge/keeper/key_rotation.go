@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// KeyRotationHandler initiates the DKG for the new vaults on the scheduled key rotation
+// The participants, threshold and vault types are inherited from the latest completed DKG
+func (k Keeper) KeyRotationHandler(ctx sdk.Context, ty string) error {
+	if ty != types.VaultKeyType {
+		return errorsmod.Wrapf(types.ErrInvalidDKGParams, "unsupported key type %s", ty)
+	}
+
+	if len(k.GetPendingDKGRequests(ctx)) != 0 {
+		return errorsmod.Wrap(types.ErrInvalidDKGParams, "pending dkg request exists")
+	}
+
+	latestReq := k.GetLatestCompletedDKGRequest(ctx)
+	if latestReq == nil {
+		return errorsmod.Wrap(types.ErrInvalidDKGParams, "no completed dkg request")
+	}
+
+	// the vault transfer is enabled when the old vaults are retired
+	req, err := k.InitiateDKG(ctx, latestReq.Participants, latestReq.Threshold, latestReq.VaultTypes, false, latestReq.TargetUtxoNum)
+	if err != nil {
+		return err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInitiateDKG,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyParticipants, strings.Join(types.GetParticipantPubKeys(req.Participants), types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", req.Threshold)),
+			sdk.NewAttribute(types.AttributeKeyBatchSize, fmt.Sprintf("%d", len(req.VaultTypes))),
+			sdk.NewAttribute(types.AttributeKeyExpirationTime, req.Expiration.String()),
+		),
+	)
+
+	return nil
+}
+
+// KeyRetirementHandler retires the old vaults after the overlap window of the scheduled key rotation
+// The assets of the old vaults are transferred to the new vaults
+func (k Keeper) KeyRetirementHandler(ctx sdk.Context, ty string) error {
+	if ty != types.VaultKeyType {
+		return errorsmod.Wrapf(types.ErrInvalidDKGParams, "unsupported key type %s", ty)
+	}
+
+	req := k.GetLatestCompletedDKGRequest(ctx)
+	if req == nil {
+		return errorsmod.Wrap(types.ErrInvalidDKGParams, "no completed dkg request")
+	}
+
+	// enable the vault transfer which is performed in the end blocker
+	req.EnableTransfer = true
+	k.SetDKGRequest(ctx, req)
+
+	return nil
+}
+
+// GetLatestCompletedDKGRequest gets the completed DKG request with the largest id
+// Nil is returned if no DKG request completed
+func (k Keeper) GetLatestCompletedDKGRequest(ctx sdk.Context) *types.DKGRequest {
+	var latestReq *types.DKGRequest
+
+	for _, req := range k.GetDKGRequests(ctx, types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED) {
+		if latestReq == nil || req.Id > latestReq.Id {
+			latestReq = req
+		}
+	}
+
+	return latestReq
+}
+
+// HandleKeyRotationResult notifies the tss module of the result of the vault key rotation if in progress
+func (k Keeper) HandleKeyRotationResult(ctx sdk.Context, completed bool) {
+	if !k.tssKeeper.IsKeyRotating(ctx, types.ModuleName, types.VaultKeyType) {
+		return
+	}
+
+	if completed {
+		_ = k.tssKeeper.CompleteKeyRotation(ctx, types.ModuleName, types.VaultKeyType)
+	} else {
+		_ = k.tssKeeper.AbortKeyRotation(ctx, types.ModuleName, types.VaultKeyType)
+	}
+}
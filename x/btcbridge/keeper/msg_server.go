@@ -8,11 +8,13 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/bitwaylabs/bitway/bitcoin"
 	"github.com/bitwaylabs/bitway/bitcoin/crypto/schnorr"
 	"github.com/bitwaylabs/bitway/x/btcbridge/types"
 	tsstypes "github.com/bitwaylabs/bitway/x/tss/types"
@@ -22,26 +24,6 @@ type msgServer struct {
 	Keeper
 }
 
-// UpdateTrustedNonBtcRelayers implements types.MsgServer.
-func (m msgServer) UpdateTrustedNonBtcRelayers(goCtx context.Context, msg *types.MsgUpdateTrustedNonBtcRelayers) (*types.MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	if err := msg.ValidateBasic(); err != nil {
-		return nil, err
-	}
-
-	if !m.IsTrustedNonBtcRelayer(ctx, msg.Sender) {
-		return nil, types.ErrUntrustedNonBtcRelayer
-	}
-
-	// update non-btc relayers
-	params := m.GetParams(ctx)
-	params.TrustedNonBtcRelayers = msg.Relayers
-	m.SetParams(ctx, params)
-
-	return &types.MsgUpdateTrustedNonBtcRelayersResponse{}, nil
-}
-
 // UpdateTrustedFeeProviders implements types.MsgServer.
 func (m msgServer) UpdateTrustedFeeProviders(goCtx context.Context, msg *types.MsgUpdateTrustedFeeProviders) (*types.MsgUpdateTrustedFeeProvidersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -77,6 +59,10 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 		return nil, types.ErrDepositNotEnabled
 	}
 
+	if m.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_DEPOSIT, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, errorsmod.Wrap(types.ErrPaused, "deposit paused")
+	}
+
 	txHash, recipient, err := m.ProcessBitcoinDepositTransaction(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -92,6 +78,45 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 	return &types.MsgSubmitDepositTransactionResponse{}, nil
 }
 
+// SubmitDepositTransactions implements types.MsgServer.
+// No Permission check required for this message
+// The failed deposits are reported in the response without reverting the others
+// This message is usually sent by relayers
+func (m msgServer) SubmitDepositTransactions(goCtx context.Context, msg *types.MsgSubmitDepositTransactions) (*types.MsgSubmitDepositTransactionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !m.DepositEnabled(ctx) {
+		return nil, types.ErrDepositNotEnabled
+	}
+
+	if m.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_DEPOSIT, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, errorsmod.Wrap(types.ErrPaused, "deposit paused")
+	}
+
+	results := m.ProcessBitcoinDepositTransactions(ctx, msg)
+
+	// Emit Events
+	for i, result := range results {
+		if !result.Success {
+			continue
+		}
+
+		m.EmitEvent(ctx, msg.Sender,
+			sdk.NewAttribute("blockhash", msg.GetDeposit(i).Blockhash),
+			sdk.NewAttribute("txid", result.Txid),
+			sdk.NewAttribute("recipient", result.Recipient),
+		)
+	}
+
+	return &types.MsgSubmitDepositTransactionsResponse{
+		Results: results,
+	}, nil
+}
+
 // SubmitWithdrawTransaction implements types.MsgServer.
 // No Permission check required for this message
 // This message is usually sent by relayers
@@ -151,6 +176,10 @@ func (m msgServer) WithdrawToBitcoin(goCtx context.Context, msg *types.MsgWithdr
 		return nil, types.ErrWithdrawNotEnabled
 	}
 
+	if m.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, errorsmod.Wrap(types.ErrPaused, "withdrawal paused")
+	}
+
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 
 	amount, err := sdk.ParseCoinNormalized(msg.Amount)
@@ -186,6 +215,79 @@ func (m msgServer) WithdrawToBitcoin(goCtx context.Context, msg *types.MsgWithdr
 	return &types.MsgWithdrawToBitcoinResponse{}, nil
 }
 
+// MultiWithdrawToBitcoin withdraws btc to multiple bitcoin addresses.
+func (m msgServer) MultiWithdrawToBitcoin(goCtx context.Context, msg *types.MsgMultiWithdrawToBitcoin) (*types.MsgMultiWithdrawToBitcoinResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.WithdrawEnabled(ctx) {
+		return nil, types.ErrWithdrawNotEnabled
+	}
+
+	if m.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, errorsmod.Wrap(types.ErrPaused, "withdrawal paused")
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	recipients := make([]string, len(msg.Outputs))
+	amounts := make([]sdk.Coin, len(msg.Outputs))
+	totalAmount := sdk.NewInt64Coin(m.BtcDenom(ctx), 0)
+
+	for i, output := range msg.Outputs {
+		amount, err := sdk.ParseCoinNormalized(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		// only btc is supported for multi-recipient withdrawal
+		if amount.Denom != m.BtcDenom(ctx) {
+			return nil, types.ErrAssetNotSupported
+		}
+
+		recipients[i] = output.Address
+		amounts[i] = amount
+		totalAmount = totalAmount.Add(amount)
+	}
+
+	// handle rate limit against the total amount
+	if err := m.HandleRateLimit(ctx, msg.Sender, totalAmount); err != nil {
+		return nil, err
+	}
+
+	if m.ProtocolWithdrawFeeEnabled(ctx) {
+		// deduct the protocol fee once for the whole group and get the actual withdrawal amounts
+		actualAmounts, err := m.HandleMultiWithdrawProtocolFee(ctx, sender, amounts)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts = actualAmounts
+	}
+
+	withdrawRequests, err := m.HandleBtcMultiWithdrawal(ctx, msg.Sender, recipients, amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	for _, req := range withdrawRequests {
+		m.EmitEvent(ctx, msg.Sender,
+			sdk.NewAttribute("address", req.Address),
+			sdk.NewAttribute("amount", req.Amount),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", req.Sequence)),
+			sdk.NewAttribute("group", fmt.Sprintf("%d", req.Group)),
+		)
+	}
+
+	return &types.MsgMultiWithdrawToBitcoinResponse{
+		Group: withdrawRequests[0].Group,
+	}, nil
+}
+
 // SubmitSignatures submits the signatures of the signing request.
 func (m msgServer) SubmitSignatures(goCtx context.Context, msg *types.MsgSubmitSignatures) (*types.MsgSubmitSignaturesResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
@@ -210,20 +312,43 @@ func (m msgServer) SubmitSignatures(goCtx context.Context, msg *types.MsgSubmitS
 		return nil, errorsmod.Wrap(types.ErrInvalidSignatures, "mismatched signature number")
 	}
 
+	vaults := m.GetParams(ctx).Vaults
+
 	for i, input := range p.Inputs {
-		sigHash, err := types.CalcTaprootSigHash(p, i, input.SighashType)
+		sigHash, err := bitcoin.CalcSigHash(p, i, input.SighashType)
 		if err != nil {
-			return nil, err
+			return nil, errorsmod.Wrapf(types.ErrInvalidPsbt, "failed to calculate sig hash for input %d: %v", i, err)
 		}
 
-		pubKeyBytes := input.WitnessUtxo.PkScript[2:34]
 		sigBytes, _ := hex.DecodeString(msg.Signatures[i])
 
-		if !schnorr.Verify(sigBytes, sigHash, pubKeyBytes) {
-			return nil, types.ErrInvalidSignature
-		}
+		switch txscript.GetScriptClass(input.WitnessUtxo.PkScript) {
+		case txscript.WitnessV1TaprootTy:
+			pubKeyBytes := input.WitnessUtxo.PkScript[2:34]
 
-		p.Inputs[i].TaprootKeySpendSig = sigBytes
+			if !schnorr.Verify(sigBytes, sigHash, pubKeyBytes) {
+				return nil, types.ErrInvalidSignature
+			}
+
+			p.Inputs[i].TaprootKeySpendSig = sigBytes
+
+		default:
+			// segwit v0 input signed by the vault ECDSA key
+			vault := types.SelectVaultByPkScript(vaults, input.WitnessUtxo.PkScript)
+			if vault == nil || len(vault.PubKey) == 0 {
+				return nil, types.ErrInvalidVault
+			}
+
+			pubKeyBytes, _ := hex.DecodeString(vault.PubKey)
+
+			if !tsstypes.VerifyECDSASignature(sigBytes, sigHash, pubKeyBytes) {
+				return nil, types.ErrInvalidSignature
+			}
+
+			if err := types.FinalizeSegwitV0Input(p, i, sigBytes, pubKeyBytes); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if err := psbt.MaybeFinalizeAll(p); err != nil {
@@ -309,6 +434,8 @@ func (m msgServer) CompleteDKG(goCtx context.Context, msg *types.MsgCompleteDKG)
 		Vaults:          msg.Vaults,
 		ConsensusPubkey: msg.ConsensusPubkey,
 		Signature:       msg.Signature,
+		InternalKeys:    msg.InternalKeys,
+		PubKeys:         msg.PubKeys,
 	}
 
 	if err := m.Keeper.CompleteDKG(ctx, req); err != nil {
@@ -417,6 +544,247 @@ func (m msgServer) TransferVault(goCtx context.Context, msg *types.MsgTransferVa
 	return &types.MsgTransferVaultResponse{}, nil
 }
 
+// Pause implements types.MsgServer.
+func (m msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pause, err := m.Keeper.Pause(ctx, msg.Sender, msg.Target, msg.AssetType, msg.Duration, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePause,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", pause.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target.String()),
+			sdk.NewAttribute(types.AttributeKeyAssetType, msg.AssetType.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyEndTime, pause.EndTime.String()),
+		),
+	)
+
+	return &types.MsgPauseResponse{
+		Id: pause.Id,
+	}, nil
+}
+
+// Unpause implements types.MsgServer.
+func (m msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pause, err := m.Keeper.Unpause(ctx, msg.Sender, msg.Target, msg.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpause,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", pause.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target.String()),
+			sdk.NewAttribute(types.AttributeKeyAssetType, msg.AssetType.String()),
+		),
+	)
+
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+// ExtendPause implements types.MsgServer.
+func (m msgServer) ExtendPause(goCtx context.Context, msg *types.MsgExtendPause) (*types.MsgExtendPauseResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pause, err := m.Keeper.ExtendPause(ctx, msg.Target, msg.AssetType, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtendPause,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", pause.Id)),
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target.String()),
+			sdk.NewAttribute(types.AttributeKeyAssetType, msg.AssetType.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, pause.EndTime.String()),
+		),
+	)
+
+	return &types.MsgExtendPauseResponse{}, nil
+}
+
+// UpdateDenylist implements types.MsgServer.
+func (m msgServer) UpdateDenylist(goCtx context.Context, msg *types.MsgUpdateDenylist) (*types.MsgUpdateDenylistResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.Keeper.UpdateDenylist(ctx, msg.Additions, msg.Removals, msg.Reason)
+
+	return &types.MsgUpdateDenylistResponse{}, nil
+}
+
+// UpdateRuneEtching implements types.MsgServer.
+func (m msgServer) UpdateRuneEtching(goCtx context.Context, msg *types.MsgUpdateRuneEtching) (*types.MsgUpdateRuneEtchingResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.Keeper.UpdateRuneEtching(ctx, &msg.Etching)
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRuneEtching,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyRuneId, msg.Etching.Id),
+			sdk.NewAttribute(types.AttributeKeyName, msg.Etching.SpacedName()),
+		),
+	)
+
+	return &types.MsgUpdateRuneEtchingResponse{}, nil
+}
+
+// BondRelayer implements types.MsgServer.
+func (m msgServer) BondRelayer(goCtx context.Context, msg *types.MsgBondRelayer) (*types.MsgBondRelayerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := m.Keeper.BondRelayer(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBondRelayer,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, relayer.Bond.String()),
+		),
+	)
+
+	return &types.MsgBondRelayerResponse{}, nil
+}
+
+// UnbondRelayer implements types.MsgServer.
+func (m msgServer) UnbondRelayer(goCtx context.Context, msg *types.MsgUnbondRelayer) (*types.MsgUnbondRelayerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := m.Keeper.UnbondRelayer(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondRelayer,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyBond, relayer.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingTime, relayer.UnbondingTime.String()),
+		),
+	)
+
+	return &types.MsgUnbondRelayerResponse{}, nil
+}
+
+// SlashRelayer implements types.MsgServer.
+func (m msgServer) SlashRelayer(goCtx context.Context, msg *types.MsgSlashRelayer) (*types.MsgSlashRelayerResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	slashedAmount, err := m.Keeper.GovSlashRelayer(ctx, msg.Relayer, msg.Txid, msg.Misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashRelayer,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+			sdk.NewAttribute(types.AttributeKeyTxHash, msg.Txid),
+			sdk.NewAttribute(types.AttributeKeyMisbehaviour, msg.Misbehaviour.String()),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, slashedAmount.String()),
+		),
+	)
+
+	return &types.MsgSlashRelayerResponse{}, nil
+}
+
+// SubmitRuneEtching implements types.MsgServer.
+func (m msgServer) SubmitRuneEtching(goCtx context.Context, msg *types.MsgSubmitRuneEtching) (*types.MsgSubmitRuneEtchingResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SubmitRuneEtching(ctx, msg.Sender, &msg.Etching); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRuneEtching,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRuneId, msg.Etching.Id),
+			sdk.NewAttribute(types.AttributeKeyName, msg.Etching.SpacedName()),
+		),
+	)
+
+	return &types.MsgSubmitRuneEtchingResponse{}, nil
+}
+
 // UpdateParams updates the module params.
 func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if m.authority != msg.Authority {
@@ -8,9 +8,14 @@ import (
 	"github.com/bitwaylabs/bitway/x/btcbridge/types"
 )
 
-// DepositConfirmationDepth gets the confirmation depth for deposit transactions
+// DepositConfirmationDepth gets the base confirmation depth for deposit transactions
 func (k Keeper) DepositConfirmationDepth(ctx sdk.Context) int32 {
-	return k.GetParams(ctx).DepositConfirmationDepth
+	return k.GetParams(ctx).DepositConfirmationParams.BaseConfirmationDepth()
+}
+
+// DepositConfirmationParams gets the amount tiered confirmation params for deposit transactions
+func (k Keeper) DepositConfirmationParams(ctx sdk.Context) types.DepositConfirmationParams {
+	return k.GetParams(ctx).DepositConfirmationParams
 }
 
 // WithdrawConfirmationDepth gets the confirmation depth for withdrawal transactions
@@ -124,9 +129,39 @@ func (k Keeper) FeeSponsorshipEnabled(ctx sdk.Context) bool {
 	return !k.GetParams(ctx).FeeSponsorshipParams.MaxSponsorFee.Empty()
 }
 
-// IsTrustedNonBtcRelayer returns true if the given address is a trusted non-btc relayer, false otherwise
-func (k Keeper) IsTrustedNonBtcRelayer(ctx sdk.Context, addr string) bool {
-	for _, relayer := range k.GetParams(ctx).TrustedNonBtcRelayers {
+// FeeSponsorshipPolicies gets the fee sponsorship policies
+func (k Keeper) FeeSponsorshipPolicies(ctx sdk.Context) []types.FeeSponsorshipPolicy {
+	return k.GetParams(ctx).FeeSponsorshipParams.Policies
+}
+
+// RelayerMinBond gets the minimum bond required for the relayer
+func (k Keeper) RelayerMinBond(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).RelayerParams.MinBond
+}
+
+// RelayerDepositRewardPercentage gets the percentage of the protocol deposit fee rewarded to the relayer
+func (k Keeper) RelayerDepositRewardPercentage(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).RelayerParams.DepositRewardPercentage
+}
+
+// RelayerSlashPercentage gets the percentage of the relayer bond slashed for each misbehaviour
+func (k Keeper) RelayerSlashPercentage(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).RelayerParams.SlashPercentage
+}
+
+// RelayerUnbondingPeriod gets the relayer unbonding period
+func (k Keeper) RelayerUnbondingPeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).RelayerParams.UnbondingPeriod
+}
+
+// RuneEtchingQuorum gets the number of the relayers required to submit the identical rune etching
+func (k Keeper) RuneEtchingQuorum(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).RelayerParams.RuneEtchingQuorum
+}
+
+// IsGrandfatheredRelayer returns true if the given address is a grandfathered relayer, false otherwise
+func (k Keeper) IsGrandfatheredRelayer(ctx sdk.Context, addr string) bool {
+	for _, relayer := range k.GetParams(ctx).RelayerParams.GrandfatheredRelayers {
 		if relayer == addr {
 			return true
 		}
@@ -174,3 +209,18 @@ func (k Keeper) GetMaxUtxoNum(ctx sdk.Context) int {
 
 	return int(params.WithdrawParams.MaxUtxoNum)
 }
+
+// Guardian returns the guardian address
+func (k Keeper) Guardian(ctx sdk.Context) string {
+	return k.GetParams(ctx).GuardianParams.Guardian
+}
+
+// MaxPauseDuration returns the maximum duration of the pause initiated by the guardian
+func (k Keeper) MaxPauseDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).GuardianParams.MaxPauseDuration
+}
+
+// PauseCooldown returns the cooldown period after the guardian pause
+func (k Keeper) PauseCooldown(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).GuardianParams.PauseCooldown
+}
@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// Pause pauses the given target
+// The guardian can only pause for at most the maximum pause duration while the authority is not limited
+// The guardian cannot pause the same target again until the cooldown period after the previous guardian pause elapses
+func (k Keeper) Pause(ctx sdk.Context, sender string, target types.PauseTarget, assetType types.AssetType, duration time.Duration, reason string) (*types.Pause, error) {
+	isGuardian := k.IsGuardian(ctx, sender)
+	if !isGuardian && sender != k.authority {
+		return nil, types.ErrUnauthorizedPauser
+	}
+
+	maxDuration := k.MaxPauseDuration(ctx)
+	if duration == 0 {
+		duration = maxDuration
+	}
+
+	if isGuardian && duration > maxDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPause, "duration cannot be greater than %s", maxDuration)
+	}
+
+	if activePause := k.GetActivePause(ctx, target, assetType); activePause != nil {
+		if activePause.IsActive(ctx.BlockTime()) {
+			return nil, types.ErrAlreadyPaused
+		}
+
+		// expire the previous pause which has not been handled yet
+		k.expirePause(ctx, activePause)
+	}
+
+	if isGuardian {
+		if latestPause := k.GetLatestPause(ctx, target, assetType); latestPause != nil && latestPause.Initiator == sender {
+			if cooldownEndTime := latestPause.EndTime.Add(k.PauseCooldown(ctx)); ctx.BlockTime().Before(cooldownEndTime) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidPause, "guardian cannot pause the target again until %s", cooldownEndTime)
+			}
+		}
+	}
+
+	pause := &types.Pause{
+		Id:        k.IncrementPauseId(ctx),
+		Target:    target,
+		AssetType: assetType,
+		Initiator: sender,
+		Reason:    reason,
+		StartTime: ctx.BlockTime(),
+		EndTime:   ctx.BlockTime().Add(duration),
+		Status:    types.PauseStatus_PAUSE_STATUS_ACTIVE,
+	}
+
+	k.SetPause(ctx, pause)
+	k.SetActivePause(ctx, pause)
+	k.SetLatestPause(ctx, pause)
+
+	return pause, nil
+}
+
+// Unpause lifts the active pause of the given target
+// The pause initiated by the authority can only be lifted by the authority
+func (k Keeper) Unpause(ctx sdk.Context, sender string, target types.PauseTarget, assetType types.AssetType) (*types.Pause, error) {
+	if !k.IsGuardian(ctx, sender) && sender != k.authority {
+		return nil, types.ErrUnauthorizedPauser
+	}
+
+	pause := k.GetActivePause(ctx, target, assetType)
+	if pause == nil || !pause.IsActive(ctx.BlockTime()) {
+		return nil, types.ErrPauseDoesNotExist
+	}
+
+	if pause.Initiator == k.authority && sender != k.authority {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedPauser, "pause initiated by the authority can only be lifted by the authority")
+	}
+
+	pause.Status = types.PauseStatus_PAUSE_STATUS_LIFTED
+	pause.EndTime = ctx.BlockTime()
+
+	k.SetPause(ctx, pause)
+	k.RemoveActivePause(ctx, target, assetType)
+
+	return pause, nil
+}
+
+// ExtendPause extends the active pause of the given target by the specified duration
+// Only governance is allowed to extend the pause, which should be checked by the caller
+func (k Keeper) ExtendPause(ctx sdk.Context, target types.PauseTarget, assetType types.AssetType, duration time.Duration) (*types.Pause, error) {
+	pause := k.GetActivePause(ctx, target, assetType)
+	if pause == nil || !pause.IsActive(ctx.BlockTime()) {
+		return nil, types.ErrPauseDoesNotExist
+	}
+
+	pause.EndTime = pause.EndTime.Add(duration)
+	pause.Extensions++
+
+	k.SetPause(ctx, pause)
+
+	return pause, nil
+}
+
+// IsPaused returns true if the given target is paused currently, false otherwise
+func (k Keeper) IsPaused(ctx sdk.Context, target types.PauseTarget, assetType types.AssetType) bool {
+	pause := k.GetActivePause(ctx, target, assetType)
+	if pause == nil {
+		return false
+	}
+
+	return pause.IsActive(ctx.BlockTime())
+}
+
+// IsAssetPaused returns true if the given asset type is paused currently, false otherwise
+func (k Keeper) IsAssetPaused(ctx sdk.Context, assetType types.AssetType) bool {
+	return k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_ASSET, assetType)
+}
+
+// IsGuardian returns true if the given address is the guardian, false otherwise
+func (k Keeper) IsGuardian(ctx sdk.Context, addr string) bool {
+	guardian := k.Guardian(ctx)
+
+	return len(guardian) != 0 && addr == guardian
+}
+
+// HandleExpiredPauses marks the active pauses which reach the end time as expired
+func (k Keeper) HandleExpiredPauses(ctx sdk.Context) {
+	pauses := []*types.Pause{}
+
+	k.IterateActivePauses(ctx, func(pause *types.Pause) (stop bool) {
+		if !pause.IsActive(ctx.BlockTime()) {
+			pauses = append(pauses, pause)
+		}
+
+		return false
+	})
+
+	for _, pause := range pauses {
+		k.expirePause(ctx, pause)
+	}
+}
+
+// expirePause marks the given pause as expired
+func (k Keeper) expirePause(ctx sdk.Context, pause *types.Pause) {
+	pause.Status = types.PauseStatus_PAUSE_STATUS_EXPIRED
+
+	k.SetPause(ctx, pause)
+	k.RemoveActivePause(ctx, pause.Target, pause.AssetType)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseExpired,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", pause.Id)),
+			sdk.NewAttribute(types.AttributeKeyTarget, pause.Target.String()),
+			sdk.NewAttribute(types.AttributeKeyAssetType, pause.AssetType.String()),
+		),
+	)
+}
+
+// GetPauseId gets the current pause id
+func (k Keeper) GetPauseId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PauseIdKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPauseId sets the current pause id
+func (k Keeper) SetPauseId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.PauseIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IncrementPauseId increments the pause id and returns the new id
+func (k Keeper) IncrementPauseId(ctx sdk.Context) uint64 {
+	id := k.GetPauseId(ctx) + 1
+	k.SetPauseId(ctx, id)
+
+	return id
+}
+
+// SetPause sets the given pause
+func (k Keeper) SetPause(ctx sdk.Context, pause *types.Pause) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(pause)
+	store.Set(types.PauseKey(pause.Id), bz)
+}
+
+// HasPause returns true if the given pause exists, false otherwise
+func (k Keeper) HasPause(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.PauseKey(id))
+}
+
+// GetPause gets the pause by the given id
+func (k Keeper) GetPause(ctx sdk.Context, id uint64) *types.Pause {
+	store := ctx.KVStore(k.storeKey)
+
+	var pause types.Pause
+	bz := store.Get(types.PauseKey(id))
+	k.cdc.MustUnmarshal(bz, &pause)
+
+	return &pause
+}
+
+// GetAllPauses gets all pauses
+func (k Keeper) GetAllPauses(ctx sdk.Context) []*types.Pause {
+	pauses := make([]*types.Pause, 0)
+
+	k.IteratePauses(ctx, func(pause *types.Pause) (stop bool) {
+		pauses = append(pauses, pause)
+		return false
+	})
+
+	return pauses
+}
+
+// GetPauses gets the pauses by the given status with pagination
+func (k Keeper) GetPauses(ctx sdk.Context, status types.PauseStatus, pagination *query.PageRequest) ([]*types.Pause, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	pauseStore := prefix.NewStore(store, types.PauseKeyPrefix)
+
+	var pauses []*types.Pause
+
+	pageRes, err := query.FilteredPaginate(pauseStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var pause types.Pause
+		k.cdc.MustUnmarshal(value, &pause)
+
+		if status != types.PauseStatus_PAUSE_STATUS_UNSPECIFIED && pause.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			pauses = append(pauses, &pause)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pauses, pageRes, nil
+}
+
+// IteratePauses iterates through all pauses
+func (k Keeper) IteratePauses(ctx sdk.Context, cb func(pause *types.Pause) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.PauseKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.Pause
+		k.cdc.MustUnmarshal(iterator.Value(), &pause)
+
+		if cb(&pause) {
+			break
+		}
+	}
+}
+
+// SetActivePause sets the given pause as the active pause of the corresponding target
+func (k Keeper) SetActivePause(ctx sdk.Context, pause *types.Pause) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ActivePauseKey(pause.Target, pause.AssetType), sdk.Uint64ToBigEndian(pause.Id))
+}
+
+// GetActivePause gets the active pause of the given target
+// Nil is returned if the active pause does not exist
+func (k Keeper) GetActivePause(ctx sdk.Context, target types.PauseTarget, assetType types.AssetType) *types.Pause {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ActivePauseKey(target, assetType))
+	if bz == nil {
+		return nil
+	}
+
+	return k.GetPause(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// RemoveActivePause removes the active pause of the given target
+func (k Keeper) RemoveActivePause(ctx sdk.Context, target types.PauseTarget, assetType types.AssetType) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ActivePauseKey(target, assetType))
+}
+
+// GetActivePauses gets all active pauses which are currently in effect
+func (k Keeper) GetActivePauses(ctx sdk.Context) []*types.Pause {
+	pauses := make([]*types.Pause, 0)
+
+	k.IterateActivePauses(ctx, func(pause *types.Pause) (stop bool) {
+		if pause.IsActive(ctx.BlockTime()) {
+			pauses = append(pauses, pause)
+		}
+
+		return false
+	})
+
+	return pauses
+}
+
+// SetLatestPause sets the given pause as the latest pause of the corresponding target
+func (k Keeper) SetLatestPause(ctx sdk.Context, pause *types.Pause) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.LatestPauseKey(pause.Target, pause.AssetType), sdk.Uint64ToBigEndian(pause.Id))
+}
+
+// GetLatestPause gets the latest pause of the given target regardless of the status
+// Nil is returned if the target has never been paused
+func (k Keeper) GetLatestPause(ctx sdk.Context, target types.PauseTarget, assetType types.AssetType) *types.Pause {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LatestPauseKey(target, assetType))
+	if bz == nil {
+		return nil
+	}
+
+	return k.GetPause(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// IterateActivePauses iterates through all active pauses
+func (k Keeper) IterateActivePauses(ctx sdk.Context, cb func(pause *types.Pause) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ActivePauseKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pause := k.GetPause(ctx, sdk.BigEndianToUint64(iterator.Value()))
+
+		if cb(pause) {
+			break
+		}
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/btcsuite/btcd/btcutil"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bitwaylabs/bitway/bitcoin"
 	"github.com/bitwaylabs/bitway/x/btcbridge/types"
 )
 
@@ -371,3 +374,329 @@ func (k Keeper) QueryRateLimitByAddress(goCtx context.Context, req *types.QueryR
 		Used:      rateLimitDetails.Used,
 	}, nil
 }
+
+func (k Keeper) QueryRelayer(goCtx context.Context, req *types.QueryRelayerRequest) (*types.QueryRelayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasRelayer(ctx, req.Address) {
+		return nil, status.Error(codes.NotFound, "relayer does not exist")
+	}
+
+	return &types.QueryRelayerResponse{Relayer: k.GetRelayer(ctx, req.Address), Active: k.IsActiveRelayer(ctx, req.Address)}, nil
+}
+
+func (k Keeper) QueryRelayers(goCtx context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayers, pagination, err := k.GetRelayers(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayersResponse{Relayers: relayers, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryRunesAttestation(goCtx context.Context, req *types.QueryRunesAttestationRequest) (*types.QueryRunesAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasRunesAttestation(ctx, req.Txid) {
+		return nil, status.Error(codes.NotFound, "runes attestation does not exist")
+	}
+
+	return &types.QueryRunesAttestationResponse{Attestation: k.GetRunesAttestation(ctx, req.Txid)}, nil
+}
+
+func (k Keeper) QueryPauseState(goCtx context.Context, req *types.QueryPauseStateRequest) (*types.QueryPauseStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPauseStateResponse{Pauses: k.GetActivePauses(ctx)}, nil
+}
+
+func (k Keeper) QueryPause(goCtx context.Context, req *types.QueryPauseRequest) (*types.QueryPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasPause(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "pause does not exist")
+	}
+
+	return &types.QueryPauseResponse{Pause: k.GetPause(ctx, req.Id)}, nil
+}
+
+func (k Keeper) QueryPauses(goCtx context.Context, req *types.QueryPausesRequest) (*types.QueryPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pauses, pagination, err := k.GetPauses(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausesResponse{Pauses: pauses, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryScreenedAddress(goCtx context.Context, req *types.QueryScreenedAddressRequest) (*types.QueryScreenedAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasScreenedAddress(ctx, req.Address) {
+		return &types.QueryScreenedAddressResponse{}, nil
+	}
+
+	return &types.QueryScreenedAddressResponse{Screened: true, ScreenedAddress: k.GetScreenedAddress(ctx, req.Address)}, nil
+}
+
+func (k Keeper) QueryScreenedAddresses(goCtx context.Context, req *types.QueryScreenedAddressesRequest) (*types.QueryScreenedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	screenedAddresses, pagination, err := k.GetScreenedAddresses(ctx, req.Source, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScreenedAddressesResponse{ScreenedAddresses: screenedAddresses, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryQuarantinedDeposits(goCtx context.Context, req *types.QueryQuarantinedDepositsRequest) (*types.QueryQuarantinedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deposits, pagination, err := k.GetQuarantinedDeposits(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQuarantinedDepositsResponse{Deposits: deposits, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryQuarantinedWithdrawals(goCtx context.Context, req *types.QueryQuarantinedWithdrawalsRequest) (*types.QueryQuarantinedWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawals, pagination, err := k.GetQuarantinedWithdrawals(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQuarantinedWithdrawalsResponse{Withdrawals: withdrawals, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryDepositConfirmationDepth(goCtx context.Context, req *types.QueryDepositConfirmationDepthRequest) (*types.QueryDepositConfirmationDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	confirmationDepth, btcAmount, err := k.GetDepositConfirmationDepth(ctx, amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDepositConfirmationDepthResponse{ConfirmationDepth: confirmationDepth, BtcAmount: btcAmount}, nil
+}
+
+func (k Keeper) QueryDepositRecord(goCtx context.Context, req *types.QueryDepositRecordRequest) (*types.QueryDepositRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record := k.GetDepositRecord(ctx, req.Txid)
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "deposit record does not exist")
+	}
+
+	return &types.QueryDepositRecordResponse{Record: record}, nil
+}
+
+func (k Keeper) QueryDepositRecords(goCtx context.Context, req *types.QueryDepositRecordsRequest) (*types.QueryDepositRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pagination, err := k.GetDepositRecordsWithPagination(ctx, req.Asset, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositRecordsResponse{Records: records, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryDepositRecordsByRecipient(goCtx context.Context, req *types.QueryDepositRecordsByRecipientRequest) (*types.QueryDepositRecordsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pagination, err := k.GetDepositRecordsByRecipientWithPagination(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositRecordsByRecipientResponse{Records: records, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryDepositRecordsBySender(goCtx context.Context, req *types.QueryDepositRecordsBySenderRequest) (*types.QueryDepositRecordsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := btcutil.DecodeAddress(req.Address, bitcoin.Network); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pagination, err := k.GetDepositRecordsBySenderWithPagination(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositRecordsBySenderResponse{Records: records, Pagination: pagination}, nil
+}
+
+func (k Keeper) QueryRuneEtching(goCtx context.Context, req *types.QueryRuneEtchingRequest) (*types.QueryRuneEtchingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var id types.RuneId
+	if err := id.FromString(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rune id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	etching := k.GetRuneEtching(ctx, id.ToString())
+	if etching == nil {
+		return nil, status.Error(codes.NotFound, "rune etching does not exist")
+	}
+
+	return &types.QueryRuneEtchingResponse{Etching: etching}, nil
+}
+
+func (k Keeper) QueryFeeSponsorshipUsage(goCtx context.Context, req *types.QueryFeeSponsorshipUsageRequest) (*types.QueryFeeSponsorshipUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy := k.GetFeeSponsorshipPolicy(ctx, req.PolicyId)
+	if policy == nil {
+		return nil, status.Error(codes.NotFound, "fee sponsorship policy does not exist")
+	}
+
+	usage := k.GetFeeSponsorshipUsage(ctx, policy)
+
+	return &types.QueryFeeSponsorshipUsageResponse{Policy: policy, Usage: usage, RemainingBudget: policy.RemainingBudget(usage)}, nil
+}
+
+func (k Keeper) QueryFeeSponsorshipAccountUsage(goCtx context.Context, req *types.QueryFeeSponsorshipAccountUsageRequest) (*types.QueryFeeSponsorshipAccountUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy := k.GetFeeSponsorshipPolicy(ctx, req.PolicyId)
+	if policy == nil {
+		return nil, status.Error(codes.NotFound, "fee sponsorship policy does not exist")
+	}
+
+	usage := k.GetFeeSponsorshipAccountUsage(ctx, policy, k.GetFeeSponsorshipUsage(ctx, policy), req.Address)
+
+	return &types.QueryFeeSponsorshipAccountUsageResponse{Usage: usage, RemainingQuota: policy.RemainingQuota(usage)}, nil
+}
+
+func (k Keeper) QueryVaultRecoveries(goCtx context.Context, req *types.QueryVaultRecoveriesRequest) (*types.QueryVaultRecoveriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recoveries, err := k.GetVaultRecoveries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (k Keeper) QueryVaultRecoveryPsbt(goCtx context.Context, req *types.QueryVaultRecoveryPsbtRequest) (*types.QueryVaultRecoveryPsbtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !types.IsValidBtcAddress(req.Recipient) {
+		return nil, status.Error(codes.InvalidArgument, "invalid recipient")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, utxos, err := k.BuildVaultRecoveryPsbt(ctx, req.Address, req.Recipient, req.FeeRate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	psbtB64, err := p.B64Encode()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultRecoveryPsbtResponse{Psbt: psbtB64, UtxoNum: uint32(len(utxos))}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// GetVaultRecoveries gets the emergency recovery info of all vaults
+func (k Keeper) GetVaultRecoveries(ctx sdk.Context) ([]types.VaultRecoveryInfo, error) {
+	recoveries := make([]types.VaultRecoveryInfo, 0)
+
+	for _, v := range k.GetParams(ctx).Vaults {
+		info := types.VaultRecoveryInfo{
+			Address:   v.Address,
+			AssetType: v.AssetType,
+			Version:   v.Version,
+			Recovery:  v.Recovery,
+		}
+
+		if v.Recovery != nil {
+			script, err := v.Recovery.GetScript()
+			if err != nil {
+				return nil, err
+			}
+
+			controlBlock, err := v.Recovery.GetControlBlock()
+			if err != nil {
+				return nil, err
+			}
+
+			info.Script = hex.EncodeToString(script)
+			info.ControlBlock = hex.EncodeToString(controlBlock)
+		}
+
+		recoveries = append(recoveries, info)
+	}
+
+	return recoveries, nil
+}
+
+// BuildVaultRecoveryPsbt builds the psbt to sweep the utxos of the given vault to the recipient via the recovery script path
+// Locked utxos are included as the recovery is expected to be performed when the signing is not available
+func (k Keeper) BuildVaultRecoveryPsbt(ctx sdk.Context, vaultAddress string, recipient string, feeRate int64) (*psbt.Packet, []*types.UTXO, error) {
+	vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, vaultAddress)
+	if vault == nil {
+		return nil, nil, types.ErrVaultDoesNotExist
+	}
+
+	if vault.Recovery == nil {
+		return nil, nil, types.ErrVaultRecoveryNotEnabled
+	}
+
+	if feeRate <= 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidFeeRate, "fee rate must be greater than 0")
+	}
+
+	maxUtxoNum := k.GetMaxUtxoNum(ctx)
+	utxos := make([]*types.UTXO, 0)
+
+	k.IterateUTXOsByAddr(ctx, vaultAddress, func(utxo *types.UTXO) (stop bool) {
+		utxos = append(utxos, utxo)
+
+		return maxUtxoNum != 0 && len(utxos) >= maxUtxoNum
+	})
+
+	p, err := types.BuildVaultRecoveryPsbt(utxos, vault.Recovery, recipient, feeRate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p, utxos, nil
+}
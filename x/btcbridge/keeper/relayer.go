@@ -0,0 +1,366 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// BondRelayer bonds the given stake for the relayer
+// The relayer will be registered if it does not exist
+func (k Keeper) BondRelayer(ctx sdk.Context, sender string, amount sdk.Coin) (*types.Relayer, error) {
+	minBond := k.RelayerMinBond(ctx)
+	if amount.Denom != minBond.Denom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRelayerBond, "bond denom must be %s", minBond.Denom)
+	}
+
+	relayer := &types.Relayer{
+		Address: sender,
+		Bond:    sdk.NewCoin(minBond.Denom, sdkmath.ZeroInt()),
+		Status:  types.RelayerStatus_RELAYER_STATUS_BONDED,
+	}
+
+	if k.HasRelayer(ctx, sender) {
+		relayer = k.GetRelayer(ctx, sender)
+		if relayer.Status != types.RelayerStatus_RELAYER_STATUS_BONDED {
+			return nil, errorsmod.Wrap(types.ErrInvalidRelayerStatus, "relayer is unbonding")
+		}
+
+		// the bond denom may differ from the minimum bond denom if changed by governance
+		if relayer.Bond.Denom != minBond.Denom {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRelayerBond, "existing bond denom %s differs from %s; unbond first", relayer.Bond.Denom, minBond.Denom)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(sender), types.RelayerBondPoolName, sdk.NewCoins(amount)); err != nil {
+		return nil, err
+	}
+
+	relayer.Bond = relayer.Bond.Add(amount)
+	if relayer.Bond.IsLT(minBond) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRelayerBond, "total bond %s less than the minimum bond %s", relayer.Bond, minBond)
+	}
+
+	k.SetRelayer(ctx, relayer)
+
+	return relayer, nil
+}
+
+// UnbondRelayer starts unbonding the stake of the given relayer
+func (k Keeper) UnbondRelayer(ctx sdk.Context, sender string) (*types.Relayer, error) {
+	if !k.HasRelayer(ctx, sender) {
+		return nil, types.ErrRelayerDoesNotExist
+	}
+
+	relayer := k.GetRelayer(ctx, sender)
+	if relayer.Status != types.RelayerStatus_RELAYER_STATUS_BONDED {
+		return nil, errorsmod.Wrap(types.ErrInvalidRelayerStatus, "relayer is unbonding")
+	}
+
+	relayer.Status = types.RelayerStatus_RELAYER_STATUS_UNBONDING
+	relayer.UnbondingTime = ctx.BlockTime().Add(k.RelayerUnbondingPeriod(ctx))
+
+	k.SetRelayer(ctx, relayer)
+
+	return relayer, nil
+}
+
+// CompleteRelayerUnbonding releases the bond of the given unbonding relayer and removes the relayer
+func (k Keeper) CompleteRelayerUnbonding(ctx sdk.Context, relayer *types.Relayer) error {
+	if relayer.Bond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RelayerBondPoolName, sdk.MustAccAddressFromBech32(relayer.Address), sdk.NewCoins(relayer.Bond)); err != nil {
+			return err
+		}
+	}
+
+	k.RemoveRelayer(ctx, relayer.Address)
+
+	return nil
+}
+
+// GovSlashRelayer slashes the relayer for the misbehaviour related to the given runes attestation
+// This is a governance only slash: no evidence is verified on chain as the runes deposit validity can not be proven on chain,
+// and the misbehaviour is adjudicated by the governance proposal which only needs to refer to an attestation made by the relayer
+func (k Keeper) GovSlashRelayer(ctx sdk.Context, address string, txid string, misbehaviour types.RelayerMisbehaviour) (sdk.Coin, error) {
+	if !k.HasRelayer(ctx, address) {
+		return sdk.Coin{}, types.ErrRelayerDoesNotExist
+	}
+
+	if !k.HasRunesAttestation(ctx, txid) {
+		return sdk.Coin{}, types.ErrRunesAttestationDoesNotExist
+	}
+
+	attestation := k.GetRunesAttestation(ctx, txid)
+	if attestation.Relayer != address {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrRunesAttestationDoesNotExist, "attestation not made by the relayer")
+	}
+
+	if attestation.Slashed {
+		return sdk.Coin{}, types.ErrRunesAttestationSlashed
+	}
+
+	relayer := k.GetRelayer(ctx, address)
+
+	slashedAmount := sdk.NewCoin(relayer.Bond.Denom, relayer.Bond.Amount.MulRaw(int64(k.RelayerSlashPercentage(ctx))).QuoRaw(100))
+	if slashedAmount.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.RelayerBondPoolName, sdk.NewCoins(slashedAmount)); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		relayer.Bond = relayer.Bond.Sub(slashedAmount)
+	}
+
+	relayer.SlashCount++
+	k.SetRelayer(ctx, relayer)
+
+	attestation.Slashed = true
+	k.SetRunesAttestation(ctx, attestation)
+
+	return slashedAmount, nil
+}
+
+// IsActiveRelayer returns true if the given address is an active relayer, false otherwise
+// The grandfathered relayers are active without bonding
+func (k Keeper) IsActiveRelayer(ctx sdk.Context, address string) bool {
+	return k.isBondedRelayer(ctx, address) || k.IsGrandfatheredRelayer(ctx, address)
+}
+
+// isBondedRelayer returns true if the given address is a relayer with the sufficient bond, false otherwise
+func (k Keeper) isBondedRelayer(ctx sdk.Context, address string) bool {
+	if !k.HasRelayer(ctx, address) {
+		return false
+	}
+
+	return k.GetRelayer(ctx, address).IsActive(k.RelayerMinBond(ctx))
+}
+
+// afterRelayerDeposit updates the relayer stats after the deposit tx relayed successfully
+// Only the bonded relayers are tracked
+func (k Keeper) afterRelayerDeposit(ctx sdk.Context, address string) {
+	if !k.isBondedRelayer(ctx, address) {
+		return
+	}
+
+	relayer := k.GetRelayer(ctx, address)
+	relayer.RelayedDeposits++
+
+	k.SetRelayer(ctx, relayer)
+}
+
+// distributeProtocolDepositFee distributes the given protocol deposit fee between the relayer and the protocol fee collector
+// Only the first valid submission of the deposit tx can reach here due to the mint history
+// Only the bonded relayers are rewarded
+func (k Keeper) distributeProtocolDepositFee(ctx sdk.Context, address string, protocolFee sdk.Coin) error {
+	protocolFeeCollector := sdk.MustAccAddressFromBech32(k.ProtocolFeeCollector(ctx))
+
+	reward := sdk.NewCoin(protocolFee.Denom, sdkmath.ZeroInt())
+	if k.isBondedRelayer(ctx, address) {
+		reward.Amount = protocolFee.Amount.MulRaw(int64(k.RelayerDepositRewardPercentage(ctx))).QuoRaw(100)
+	}
+
+	if reward.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(address), sdk.NewCoins(reward)); err != nil {
+			return err
+		}
+
+		relayer := k.GetRelayer(ctx, address)
+		relayer.TotalRewards = relayer.TotalRewards.Add(reward)
+		k.SetRelayer(ctx, relayer)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRelayerReward,
+				sdk.NewAttribute(types.AttributeKeyRelayer, address),
+				sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+			),
+		)
+	}
+
+	collectorFee := protocolFee.Sub(reward)
+	if collectorFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, protocolFeeCollector, sdk.NewCoins(collectorFee)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleUnbondingRelayers completes unbonding for the relayers whose unbonding period ends
+func (k Keeper) HandleUnbondingRelayers(ctx sdk.Context) {
+	relayers := []*types.Relayer{}
+
+	k.IterateRelayers(ctx, func(relayer *types.Relayer) (stop bool) {
+		if relayer.Status == types.RelayerStatus_RELAYER_STATUS_UNBONDING && !ctx.BlockTime().Before(relayer.UnbondingTime) {
+			relayers = append(relayers, relayer)
+		}
+
+		return false
+	})
+
+	for _, relayer := range relayers {
+		if err := k.CompleteRelayerUnbonding(ctx, relayer); err != nil {
+			k.Logger(ctx).Error("failed to complete relayer unbonding", "relayer", relayer.Address, "err", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRelayerUnbonded,
+				sdk.NewAttribute(types.AttributeKeyRelayer, relayer.Address),
+				sdk.NewAttribute(types.AttributeKeyBond, relayer.Bond.String()),
+			),
+		)
+	}
+}
+
+// SetRelayer sets the given relayer
+func (k Keeper) SetRelayer(ctx sdk.Context, relayer *types.Relayer) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(relayer)
+	store.Set(types.RelayerKey(relayer.Address), bz)
+}
+
+// HasRelayer returns true if the given relayer exists, false otherwise
+func (k Keeper) HasRelayer(ctx sdk.Context, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.RelayerKey(address))
+}
+
+// GetRelayer gets the relayer by the given address
+func (k Keeper) GetRelayer(ctx sdk.Context, address string) *types.Relayer {
+	store := ctx.KVStore(k.storeKey)
+
+	var relayer types.Relayer
+	bz := store.Get(types.RelayerKey(address))
+	k.cdc.MustUnmarshal(bz, &relayer)
+
+	return &relayer
+}
+
+// RemoveRelayer removes the given relayer
+func (k Keeper) RemoveRelayer(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.RelayerKey(address))
+}
+
+// GetAllRelayers gets all relayers
+func (k Keeper) GetAllRelayers(ctx sdk.Context) []*types.Relayer {
+	relayers := make([]*types.Relayer, 0)
+
+	k.IterateRelayers(ctx, func(relayer *types.Relayer) (stop bool) {
+		relayers = append(relayers, relayer)
+		return false
+	})
+
+	return relayers
+}
+
+// GetRelayers gets relayers by the given status with pagination
+// All relayers will be returned if the status is unspecified
+func (k Keeper) GetRelayers(ctx sdk.Context, status types.RelayerStatus, pagination *query.PageRequest) ([]*types.Relayer, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	relayerStore := prefix.NewStore(store, types.RelayerKeyPrefix)
+
+	var relayers []*types.Relayer
+
+	pageRes, err := query.FilteredPaginate(relayerStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var relayer types.Relayer
+		k.cdc.MustUnmarshal(value, &relayer)
+
+		if status != types.RelayerStatus_RELAYER_STATUS_UNSPECIFIED && relayer.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			relayers = append(relayers, &relayer)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return relayers, pageRes, nil
+}
+
+// IterateRelayers iterates through all relayers
+func (k Keeper) IterateRelayers(ctx sdk.Context, cb func(relayer *types.Relayer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RelayerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var relayer types.Relayer
+		k.cdc.MustUnmarshal(iterator.Value(), &relayer)
+
+		if cb(&relayer) {
+			break
+		}
+	}
+}
+
+// SetRunesAttestation sets the given runes attestation
+func (k Keeper) SetRunesAttestation(ctx sdk.Context, attestation *types.RunesAttestation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(attestation)
+	store.Set(types.RunesAttestationKey(attestation.Txid), bz)
+}
+
+// HasRunesAttestation returns true if the runes attestation exists for the given tx hash, false otherwise
+func (k Keeper) HasRunesAttestation(ctx sdk.Context, txid string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.RunesAttestationKey(txid))
+}
+
+// GetRunesAttestation gets the runes attestation by the given tx hash
+func (k Keeper) GetRunesAttestation(ctx sdk.Context, txid string) *types.RunesAttestation {
+	store := ctx.KVStore(k.storeKey)
+
+	var attestation types.RunesAttestation
+	bz := store.Get(types.RunesAttestationKey(txid))
+	k.cdc.MustUnmarshal(bz, &attestation)
+
+	return &attestation
+}
+
+// GetAllRunesAttestations gets all runes attestations
+func (k Keeper) GetAllRunesAttestations(ctx sdk.Context) []*types.RunesAttestation {
+	attestations := make([]*types.RunesAttestation, 0)
+
+	k.IterateRunesAttestations(ctx, func(attestation *types.RunesAttestation) (stop bool) {
+		attestations = append(attestations, attestation)
+		return false
+	})
+
+	return attestations
+}
+
+// IterateRunesAttestations iterates through all runes attestations
+func (k Keeper) IterateRunesAttestations(ctx sdk.Context, cb func(attestation *types.RunesAttestation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RunesAttestationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.RunesAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+
+		if cb(&attestation) {
+			break
+		}
+	}
+}
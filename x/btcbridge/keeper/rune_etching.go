@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// SubmitRuneEtching submits the etching data of the rune by the relayer
+// The etching is accepted once the identical etching is submitted by the quorum of the active relayers
+// Only governance can override the accepted etching
+func (k Keeper) SubmitRuneEtching(ctx sdk.Context, sender string, etching *types.RuneEtching) error {
+	if !k.IsActiveRelayer(ctx, sender) {
+		return types.ErrUntrustedNonBtcRelayer
+	}
+
+	if k.HasRuneEtching(ctx, etching.Id) {
+		return types.ErrRuneEtchingExists
+	}
+
+	if k.HasRuneEtchingSubmission(ctx, etching.Id, sender) {
+		return types.ErrRuneEtchingSubmitted
+	}
+
+	k.SetRuneEtchingSubmission(ctx, &types.RuneEtchingSubmission{
+		Relayer: sender,
+		Etching: etching,
+	})
+
+	if k.getRuneEtchingVotes(ctx, etching) < k.RuneEtchingQuorum(ctx) {
+		return nil
+	}
+
+	k.SetRuneEtching(ctx, etching)
+	k.RemoveRuneEtchingSubmissions(ctx, etching.Id)
+
+	// register the denom metadata if the rune has been deposited
+	if k.bankKeeper.HasSupply(ctx, etching.Denom()) {
+		k.RegisterRuneMetadata(ctx, etching)
+	}
+
+	return nil
+}
+
+// getRuneEtchingVotes gets the number of the active relayers which submitted the identical etching
+func (k Keeper) getRuneEtchingVotes(ctx sdk.Context, etching *types.RuneEtching) uint32 {
+	bz := k.cdc.MustMarshal(etching)
+
+	votes := uint32(0)
+
+	k.IterateRuneEtchingSubmissions(ctx, etching.Id, func(submission *types.RuneEtchingSubmission) (stop bool) {
+		if bytes.Equal(k.cdc.MustMarshal(submission.Etching), bz) && k.IsActiveRelayer(ctx, submission.Relayer) {
+			votes++
+		}
+
+		return false
+	})
+
+	return votes
+}
+
+// UpdateRuneEtching sets the etching data of the rune by governance
+// The pending submissions are discarded and the denom metadata is overridden if the rune has been deposited
+func (k Keeper) UpdateRuneEtching(ctx sdk.Context, etching *types.RuneEtching) {
+	k.SetRuneEtching(ctx, etching)
+	k.RemoveRuneEtchingSubmissions(ctx, etching.Id)
+
+	if k.bankKeeper.HasSupply(ctx, etching.Denom()) || k.bankKeeper.HasDenomMetaData(ctx, etching.Denom()) {
+		k.RegisterRuneMetadata(ctx, etching)
+	}
+}
+
+// RegisterRuneMetadata registers the bank denom metadata of the rune from the given etching
+func (k Keeper) RegisterRuneMetadata(ctx sdk.Context, etching *types.RuneEtching) {
+	metadata := etching.Metadata()
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRuneMetadataRegistered,
+			sdk.NewAttribute(types.AttributeKeyRuneId, etching.Id),
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+			sdk.NewAttribute(types.AttributeKeyName, metadata.Name),
+			sdk.NewAttribute(types.AttributeKeyDivisibility, fmt.Sprintf("%d", etching.Divisibility)),
+		),
+	)
+}
+
+// SetRuneEtching sets the given rune etching
+func (k Keeper) SetRuneEtching(ctx sdk.Context, etching *types.RuneEtching) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(etching)
+	store.Set(types.RuneEtchingKey(etching.Id), bz)
+}
+
+// HasRuneEtching returns true if the etching of the given rune exists, false otherwise
+func (k Keeper) HasRuneEtching(ctx sdk.Context, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.RuneEtchingKey(id))
+}
+
+// GetRuneEtching gets the etching of the given rune
+func (k Keeper) GetRuneEtching(ctx sdk.Context, id string) *types.RuneEtching {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.RuneEtchingKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var etching types.RuneEtching
+	k.cdc.MustUnmarshal(bz, &etching)
+
+	return &etching
+}
+
+// GetAllRuneEtchings gets all rune etchings
+func (k Keeper) GetAllRuneEtchings(ctx sdk.Context) []*types.RuneEtching {
+	etchings := make([]*types.RuneEtching, 0)
+
+	k.IterateRuneEtchings(ctx, func(etching *types.RuneEtching) (stop bool) {
+		etchings = append(etchings, etching)
+		return false
+	})
+
+	return etchings
+}
+
+// IterateRuneEtchings iterates through all rune etchings
+func (k Keeper) IterateRuneEtchings(ctx sdk.Context, cb func(etching *types.RuneEtching) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RuneEtchingKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var etching types.RuneEtching
+		k.cdc.MustUnmarshal(iterator.Value(), &etching)
+
+		if cb(&etching) {
+			break
+		}
+	}
+}
+
+// SetRuneEtchingSubmission sets the given rune etching submission
+func (k Keeper) SetRuneEtchingSubmission(ctx sdk.Context, submission *types.RuneEtchingSubmission) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(submission)
+	store.Set(types.RuneEtchingSubmissionKey(submission.Etching.Id, submission.Relayer), bz)
+}
+
+// HasRuneEtchingSubmission returns true if the etching of the given rune has been submitted by the relayer, false otherwise
+func (k Keeper) HasRuneEtchingSubmission(ctx sdk.Context, id string, relayer string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.RuneEtchingSubmissionKey(id, relayer))
+}
+
+// GetRuneEtchingSubmissions gets the pending etching submissions of the given rune
+func (k Keeper) GetRuneEtchingSubmissions(ctx sdk.Context, id string) []*types.RuneEtchingSubmission {
+	submissions := make([]*types.RuneEtchingSubmission, 0)
+
+	k.IterateRuneEtchingSubmissions(ctx, id, func(submission *types.RuneEtchingSubmission) (stop bool) {
+		submissions = append(submissions, submission)
+		return false
+	})
+
+	return submissions
+}
+
+// RemoveRuneEtchingSubmissions removes the pending etching submissions of the given rune
+func (k Keeper) RemoveRuneEtchingSubmissions(ctx sdk.Context, id string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, submission := range k.GetRuneEtchingSubmissions(ctx, id) {
+		store.Delete(types.RuneEtchingSubmissionKey(id, submission.Relayer))
+	}
+}
+
+// IterateRuneEtchingSubmissions iterates through the pending etching submissions of the given rune
+func (k Keeper) IterateRuneEtchingSubmissions(ctx sdk.Context, id string, cb func(submission *types.RuneEtchingSubmission) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RuneEtchingSubmissionsKeyPrefix(id))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var submission types.RuneEtchingSubmission
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		if cb(&submission) {
+			break
+		}
+	}
+}
+
+// GetAllRuneEtchingSubmissions gets all pending rune etching submissions
+func (k Keeper) GetAllRuneEtchingSubmissions(ctx sdk.Context) []*types.RuneEtchingSubmission {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RuneEtchingSubmissionKeyPrefix)
+	defer iterator.Close()
+
+	submissions := make([]*types.RuneEtchingSubmission, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var submission types.RuneEtchingSubmission
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		submissions = append(submissions, &submission)
+	}
+
+	return submissions
+}
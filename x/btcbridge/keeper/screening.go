@@ -0,0 +1,466 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+// ScreenAddress returns an error if the given address is screened
+func (k Keeper) ScreenAddress(ctx sdk.Context, address string) error {
+	if k.IsScreenedAddress(ctx, address) {
+		return errorsmod.Wrapf(types.ErrAddressScreened, "address %s", address)
+	}
+
+	return nil
+}
+
+// IsScreenedAddress returns true if the given address is screened, false otherwise
+func (k Keeper) IsScreenedAddress(ctx sdk.Context, address string) bool {
+	return k.HasScreenedAddress(ctx, address)
+}
+
+// UpdateDenylist adds and removes the given addresses to and from the denylist
+func (k Keeper) UpdateDenylist(ctx sdk.Context, additions []string, removals []string, reason string) {
+	for _, address := range additions {
+		k.screenAddress(ctx, address, types.ScreeningSource_SCREENING_SOURCE_GOVERNANCE, reason)
+	}
+
+	for _, address := range removals {
+		if !k.HasScreenedAddress(ctx, address) {
+			continue
+		}
+
+		k.RemoveScreenedAddress(ctx, address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddressUnscreened,
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+			),
+		)
+	}
+}
+
+// AddScreenedAddresses adds the given addresses flagged by the off-chain screeners
+// The addresses which are already screened are skipped
+func (k Keeper) AddScreenedAddresses(ctx sdk.Context, addresses []string) {
+	for _, address := range addresses {
+		if k.HasScreenedAddress(ctx, address) {
+			continue
+		}
+
+		k.screenAddress(ctx, address, types.ScreeningSource_SCREENING_SOURCE_SCREENER, "")
+	}
+}
+
+// GetScreeningCandidates gets the addresses to be screened by the off-chain screeners
+// Currently the candidates are the unscreened addresses of the pending btc withdrawal requests
+func (k Keeper) GetScreeningCandidates(ctx sdk.Context) []string {
+	candidates := []string{}
+	seen := make(map[string]bool)
+
+	for _, req := range k.GetPendingBtcWithdrawRequests(ctx, k.MaxBtcBatchWithdrawNum(ctx)) {
+		if seen[req.Address] || k.HasScreenedAddress(ctx, req.Address) {
+			continue
+		}
+
+		candidates = append(candidates, req.Address)
+		seen[req.Address] = true
+	}
+
+	return candidates
+}
+
+// QuarantineScreenedWithdrawRequests removes the given pending btc withdrawal requests of the screened addresses from the queue
+// The grouped withdrawal requests are quarantined as a whole if any address of the group is screened
+// The withdrawal amount is credited to the quarantine account and recorded as the quarantined withdrawal
+// Returns the remaining withdrawal requests
+func (k Keeper) QuarantineScreenedWithdrawRequests(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest) []*types.WithdrawRequest {
+	remaining := []*types.WithdrawRequest{}
+
+	for _, requests := range groupWithdrawRequests(withdrawRequests) {
+		screened := false
+
+		for _, req := range requests {
+			if k.IsScreenedAddress(ctx, req.Address) {
+				screened = true
+				break
+			}
+		}
+
+		if !screened {
+			remaining = append(remaining, requests...)
+			continue
+		}
+
+		// quarantine the whole group or none of it
+		cacheCtx, write := ctx.CacheContext()
+
+		if err := k.quarantineWithdrawRequests(cacheCtx, requests); err != nil {
+			k.Logger(ctx).Error("failed to quarantine withdrawal", "sequence", requests[0].Sequence, "group", requests[0].Group, "err", err)
+			continue
+		}
+
+		write()
+	}
+
+	return remaining
+}
+
+// quarantineWithdrawRequests credits the amount of the given withdrawal requests to the quarantine account
+// and removes the requests from the queue
+func (k Keeper) quarantineWithdrawRequests(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest) error {
+	for _, req := range withdrawRequests {
+		amount, err := sdk.ParseCoinNormalized(req.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.QuarantineName, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+
+		k.RemoveFromBtcWithdrawRequestQueue(ctx, req)
+
+		k.SetQuarantinedWithdrawal(ctx, &types.QuarantinedWithdrawal{
+			Sequence: req.Sequence,
+			Address:  req.Address,
+			Amount:   amount,
+			Sender:   req.Sender,
+			Group:    req.Group,
+			Height:   ctx.BlockHeight(),
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawalQuarantined,
+				sdk.NewAttribute(types.AttributeKeyAddress, req.Address),
+				sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", req.Sequence)),
+				sdk.NewAttribute(types.AttributeKeyAmount, req.Amount),
+			),
+		)
+	}
+
+	return nil
+}
+
+// groupWithdrawRequests splits the given withdrawal requests by the sequence group
+// Assume that the grouped requests are adjacent; each ungrouped request forms its own group
+func groupWithdrawRequests(withdrawRequests []*types.WithdrawRequest) [][]*types.WithdrawRequest {
+	groups := [][]*types.WithdrawRequest{}
+
+	for i, req := range withdrawRequests {
+		if i > 0 && req.Group != 0 && req.Group == withdrawRequests[i-1].Group {
+			groups[len(groups)-1] = append(groups[len(groups)-1], req)
+			continue
+		}
+
+		groups = append(groups, []*types.WithdrawRequest{req})
+	}
+
+	return groups
+}
+
+// creditDeposit credits the deposited coins to the recipient or the quarantine account if quarantined
+func (k Keeper) creditDeposit(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins, quarantined bool) error {
+	if quarantined {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.QuarantineName, coins)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+// quarantineDeposit records the given quarantined deposit
+func (k Keeper) quarantineDeposit(ctx sdk.Context, txid string, sender string, recipient string, amount sdk.Coin) {
+	k.SetQuarantinedDeposit(ctx, &types.QuarantinedDeposit{
+		Txid:      txid,
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Height:    ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositQuarantined,
+			sdk.NewAttribute(types.AttributeKeyTxHash, txid),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// screenAddress screens the given address
+func (k Keeper) screenAddress(ctx sdk.Context, address string, source types.ScreeningSource, reason string) {
+	k.SetScreenedAddress(ctx, &types.ScreenedAddress{
+		Address: address,
+		Source:  source,
+		Reason:  reason,
+		Height:  ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddressScreened,
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeySource, source.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// SetScreenedAddress sets the given screened address
+func (k Keeper) SetScreenedAddress(ctx sdk.Context, screenedAddress *types.ScreenedAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(screenedAddress)
+	store.Set(types.ScreenedAddressKey(screenedAddress.Address), bz)
+}
+
+// HasScreenedAddress returns true if the given screened address exists, false otherwise
+func (k Keeper) HasScreenedAddress(ctx sdk.Context, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.ScreenedAddressKey(address))
+}
+
+// GetScreenedAddress gets the screened address by the given address
+func (k Keeper) GetScreenedAddress(ctx sdk.Context, address string) *types.ScreenedAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	var screenedAddress types.ScreenedAddress
+	bz := store.Get(types.ScreenedAddressKey(address))
+	k.cdc.MustUnmarshal(bz, &screenedAddress)
+
+	return &screenedAddress
+}
+
+// RemoveScreenedAddress removes the given screened address
+func (k Keeper) RemoveScreenedAddress(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ScreenedAddressKey(address))
+}
+
+// GetAllScreenedAddresses gets all screened addresses
+func (k Keeper) GetAllScreenedAddresses(ctx sdk.Context) []*types.ScreenedAddress {
+	screenedAddresses := make([]*types.ScreenedAddress, 0)
+
+	k.IterateScreenedAddresses(ctx, func(screenedAddress *types.ScreenedAddress) (stop bool) {
+		screenedAddresses = append(screenedAddresses, screenedAddress)
+		return false
+	})
+
+	return screenedAddresses
+}
+
+// GetScreenedAddresses gets the screened addresses by the given source with pagination
+func (k Keeper) GetScreenedAddresses(ctx sdk.Context, source types.ScreeningSource, pagination *query.PageRequest) ([]*types.ScreenedAddress, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	screenedAddressStore := prefix.NewStore(store, types.ScreenedAddressKeyPrefix)
+
+	var screenedAddresses []*types.ScreenedAddress
+
+	pageRes, err := query.FilteredPaginate(screenedAddressStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var screenedAddress types.ScreenedAddress
+		k.cdc.MustUnmarshal(value, &screenedAddress)
+
+		if source != types.ScreeningSource_SCREENING_SOURCE_UNSPECIFIED && screenedAddress.Source != source {
+			return false, nil
+		}
+
+		if accumulate {
+			screenedAddresses = append(screenedAddresses, &screenedAddress)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return screenedAddresses, pageRes, nil
+}
+
+// IterateScreenedAddresses iterates through all screened addresses
+func (k Keeper) IterateScreenedAddresses(ctx sdk.Context, cb func(screenedAddress *types.ScreenedAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScreenedAddressKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var screenedAddress types.ScreenedAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &screenedAddress)
+
+		if cb(&screenedAddress) {
+			break
+		}
+	}
+}
+
+// SetQuarantinedDeposit sets the given quarantined deposit
+func (k Keeper) SetQuarantinedDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(deposit)
+	store.Set(types.QuarantinedDepositKey(deposit.Txid), bz)
+}
+
+// HasQuarantinedDeposit returns true if the quarantined deposit exists for the given tx hash, false otherwise
+func (k Keeper) HasQuarantinedDeposit(ctx sdk.Context, txid string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.QuarantinedDepositKey(txid))
+}
+
+// GetQuarantinedDeposit gets the quarantined deposit by the given tx hash
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, txid string) *types.QuarantinedDeposit {
+	store := ctx.KVStore(k.storeKey)
+
+	var deposit types.QuarantinedDeposit
+	bz := store.Get(types.QuarantinedDepositKey(txid))
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return &deposit
+}
+
+// GetAllQuarantinedDeposits gets all quarantined deposits
+func (k Keeper) GetAllQuarantinedDeposits(ctx sdk.Context) []*types.QuarantinedDeposit {
+	deposits := make([]*types.QuarantinedDeposit, 0)
+
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
+
+// GetQuarantinedDeposits gets the quarantined deposits with pagination
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context, pagination *query.PageRequest) ([]*types.QuarantinedDeposit, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	depositStore := prefix.NewStore(store, types.QuarantinedDepositKeyPrefix)
+
+	var deposits []*types.QuarantinedDeposit
+
+	pageRes, err := query.Paginate(depositStore, pagination, func(key []byte, value []byte) error {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(value, &deposit)
+
+		deposits = append(deposits, &deposit)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deposits, pageRes, nil
+}
+
+// IterateQuarantinedDeposits iterates through all quarantined deposits
+func (k Keeper) IterateQuarantinedDeposits(ctx sdk.Context, cb func(deposit *types.QuarantinedDeposit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.QuarantinedDepositKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		if cb(&deposit) {
+			break
+		}
+	}
+}
+
+// SetQuarantinedWithdrawal sets the given quarantined withdrawal
+func (k Keeper) SetQuarantinedWithdrawal(ctx sdk.Context, withdrawal *types.QuarantinedWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(withdrawal)
+	store.Set(types.QuarantinedWithdrawalKey(withdrawal.Sequence), bz)
+}
+
+// HasQuarantinedWithdrawal returns true if the quarantined withdrawal exists for the given sequence, false otherwise
+func (k Keeper) HasQuarantinedWithdrawal(ctx sdk.Context, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.QuarantinedWithdrawalKey(sequence))
+}
+
+// GetQuarantinedWithdrawal gets the quarantined withdrawal by the given sequence
+func (k Keeper) GetQuarantinedWithdrawal(ctx sdk.Context, sequence uint64) *types.QuarantinedWithdrawal {
+	store := ctx.KVStore(k.storeKey)
+
+	var withdrawal types.QuarantinedWithdrawal
+	bz := store.Get(types.QuarantinedWithdrawalKey(sequence))
+	k.cdc.MustUnmarshal(bz, &withdrawal)
+
+	return &withdrawal
+}
+
+// GetAllQuarantinedWithdrawals gets all quarantined withdrawals
+func (k Keeper) GetAllQuarantinedWithdrawals(ctx sdk.Context) []*types.QuarantinedWithdrawal {
+	withdrawals := make([]*types.QuarantinedWithdrawal, 0)
+
+	k.IterateQuarantinedWithdrawals(ctx, func(withdrawal *types.QuarantinedWithdrawal) (stop bool) {
+		withdrawals = append(withdrawals, withdrawal)
+		return false
+	})
+
+	return withdrawals
+}
+
+// GetQuarantinedWithdrawals gets the quarantined withdrawals with pagination
+func (k Keeper) GetQuarantinedWithdrawals(ctx sdk.Context, pagination *query.PageRequest) ([]*types.QuarantinedWithdrawal, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	withdrawalStore := prefix.NewStore(store, types.QuarantinedWithdrawalKeyPrefix)
+
+	var withdrawals []*types.QuarantinedWithdrawal
+
+	pageRes, err := query.Paginate(withdrawalStore, pagination, func(key []byte, value []byte) error {
+		var withdrawal types.QuarantinedWithdrawal
+		k.cdc.MustUnmarshal(value, &withdrawal)
+
+		withdrawals = append(withdrawals, &withdrawal)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return withdrawals, pageRes, nil
+}
+
+// IterateQuarantinedWithdrawals iterates through all quarantined withdrawals
+func (k Keeper) IterateQuarantinedWithdrawals(ctx sdk.Context, cb func(withdrawal *types.QuarantinedWithdrawal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.QuarantinedWithdrawalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.QuarantinedWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+
+		if cb(&withdrawal) {
+			break
+		}
+	}
+}
@@ -14,6 +14,10 @@ import (
 
 // TransferVault performs the vault asset transfer from the source version to the destination version
 func (k Keeper) TransferVault(ctx sdk.Context, sourceVersion uint64, destVersion uint64, assetType types.AssetType, psbts []string, targetUtxoNum uint32) error {
+	if k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_VAULT_TRANSFER, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return errorsmod.Wrap(types.ErrPaused, "vault transfer paused")
+	}
+
 	sourceVault := k.GetVaultByAssetTypeAndVersion(ctx, assetType, sourceVersion)
 	if sourceVault == nil {
 		return types.ErrVaultDoesNotExist
@@ -211,6 +215,10 @@ func (k Keeper) BuildTransferVaultBtcSigningRequest(ctx sdk.Context, sourceVault
 		return nil, err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(p, k.GetParams(ctx).Vaults); err != nil {
+		return nil, err
+	}
+
 	psbtB64, err := p.B64Encode()
 	if err != nil {
 		return nil, types.ErrFailToSerializePsbt
@@ -275,6 +283,10 @@ func (k Keeper) BuildTransferVaultRunesSigningRequest(ctx sdk.Context, sourceVau
 		return nil, err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(p, k.GetParams(ctx).Vaults); err != nil {
+		return nil, err
+	}
+
 	psbtB64, err := p.B64Encode()
 	if err != nil {
 		return nil, types.ErrFailToSerializePsbt
@@ -320,8 +332,8 @@ func (k Keeper) CheckVaults(ctx sdk.Context, vaults []string, vaultTypes []types
 }
 
 // UpdateVaults updates the asset vaults of the btc bridge
-// Assume that vaults are validated and match vault types
-func (k Keeper) UpdateVaults(ctx sdk.Context, newVaults []string, vaultTypes []types.AssetType) {
+// Assume that vaults are validated and match vault types, pub keys if provided and internal keys if the recovery params are provided
+func (k Keeper) UpdateVaults(ctx sdk.Context, newVaults []string, vaultTypes []types.AssetType, pubKeys []string, internalKeys []string, recoveryParams *types.VaultRecoveryParams) {
 	params := k.GetParams(ctx)
 
 	version := k.IncreaseVaultVersion(ctx)
@@ -333,6 +345,17 @@ func (k Keeper) UpdateVaults(ctx sdk.Context, newVaults []string, vaultTypes []t
 			Version:   version,
 		}
 
+		if len(pubKeys) != 0 {
+			newVault.PubKey = pubKeys[i]
+		}
+
+		if recoveryParams != nil {
+			newVault.Recovery = &types.VaultRecovery{
+				InternalKey: internalKeys[i],
+				Params:      *recoveryParams,
+			}
+		}
+
 		params.Vaults = append(params.Vaults, newVault)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -64,7 +65,12 @@ func (k Keeper) IncrementSigningRequestSequence(ctx sdk.Context) uint64 {
 
 // HandleWithdrawal handles the given withdrawal request
 func (k Keeper) HandleWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
-	switch types.AssetTypeFromDenom(amount.Denom, k.GetParams(ctx)) {
+	assetType := types.AssetTypeFromDenom(amount.Denom, k.GetParams(ctx))
+	if k.IsAssetPaused(ctx, assetType) {
+		return nil, errorsmod.Wrapf(types.ErrPaused, "asset %s paused", assetType)
+	}
+
+	switch assetType {
 	case types.AssetType_ASSET_TYPE_BTC:
 		return k.HandleBtcWithdrawal(ctx, sender, amount)
 
@@ -79,6 +85,11 @@ func (k Keeper) HandleWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin
 // HandleBtcWithdrawal handles the given btc withdrawal request
 // Btc withdrawal request will be dispatched to the batch withdrawal queue which is handled periodically
 func (k Keeper) HandleBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	// screen the withdrawal address
+	if err := k.ScreenAddress(ctx, sender); err != nil {
+		return nil, err
+	}
+
 	// set the withdrawal request
 	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
 	k.SetWithdrawRequest(ctx, withdrawRequest)
@@ -110,9 +121,85 @@ func (k Keeper) HandleBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.C
 	return withdrawRequest, nil
 }
 
+// HandleBtcMultiWithdrawal handles the given multi-recipient btc withdrawal
+// The withdrawal requests are linked by the same sequence group and dispatched to the batch withdrawal queue together
+func (k Keeper) HandleBtcMultiWithdrawal(ctx sdk.Context, sender string, recipients []string, amounts []sdk.Coin) ([]*types.WithdrawRequest, error) {
+	if k.IsAssetPaused(ctx, types.AssetType_ASSET_TYPE_BTC) {
+		return nil, errorsmod.Wrapf(types.ErrPaused, "asset %s paused", types.AssetType_ASSET_TYPE_BTC)
+	}
+
+	// the grouped withdrawal requests must fit into a single batch
+	maxBatchNum := k.GetParams(ctx).WithdrawParams.MaxBtcBatchWithdrawNum
+	if maxBatchNum != 0 && len(recipients) > int(maxBatchNum) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidWithdrawOutputs, "the number of outputs cannot exceed %d", maxBatchNum)
+	}
+
+	// screen the sender and withdrawal addresses
+	if err := k.ScreenAddress(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	for _, recipient := range recipients {
+		if err := k.ScreenAddress(ctx, recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	withdrawRequests := make([]*types.WithdrawRequest, len(recipients))
+	totalAmount := sdk.NewInt64Coin(k.BtcDenom(ctx), 0)
+
+	for i, recipient := range recipients {
+		withdrawRequest := k.NewWithdrawRequest(ctx, recipient, amounts[i].String())
+		withdrawRequest.Sender = sender
+
+		if i == 0 {
+			withdrawRequest.Group = withdrawRequest.Sequence
+		} else {
+			withdrawRequest.Group = withdrawRequests[0].Group
+		}
+
+		// set the withdrawal request
+		k.SetWithdrawRequest(ctx, withdrawRequest)
+
+		// add to the pending queue
+		k.AddToBtcWithdrawRequestQueue(ctx, withdrawRequest)
+
+		withdrawRequests[i] = withdrawRequest
+		totalAmount = totalAmount.Add(amounts[i])
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		return nil, err
+	}
+
+	// estimate the btc network fee for all outputs
+	networkFee, err := k.EstimateBtcBatchWithdrawalNetworkFee(ctx, withdrawRequests, feeRate.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// burn asset
+	if err := k.BurnAsset(ctx, sender, totalAmount); err != nil {
+		return nil, err
+	}
+
+	// burn btc network fee
+	if err := k.BurnAsset(ctx, sender, networkFee); err != nil {
+		return nil, err
+	}
+
+	return withdrawRequests, nil
+}
+
 // HandleRunesWithdrawal handles the given runes withdrawal request
 // Runes withdrawal will generate a signing request immediately
 func (k Keeper) HandleRunesWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	// screen the withdrawal address
+	if err := k.ScreenAddress(ctx, sender); err != nil {
+		return nil, err
+	}
+
 	// build the withdrawal request
 	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
 
@@ -192,6 +279,10 @@ func (k Keeper) NewRunesSigningRequest(ctx sdk.Context, sender string, amount sd
 		return nil, err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(psbt, k.GetParams(ctx).Vaults); err != nil {
+		return nil, err
+	}
+
 	psbtB64, err := psbt.B64Encode()
 	if err != nil {
 		return nil, types.ErrFailToSerializePsbt
@@ -230,6 +321,10 @@ func (k Keeper) BuildBtcBatchWithdrawSigningRequest(ctx sdk.Context, withdrawReq
 		return nil, err
 	}
 
+	if err := types.PopulatePsbtWithWitnessScripts(psbt, k.GetParams(ctx).Vaults); err != nil {
+		return nil, err
+	}
+
 	psbtB64, err := psbt.B64Encode()
 	if err != nil {
 		return nil, types.ErrFailToSerializePsbt
@@ -330,6 +425,28 @@ func (k Keeper) EstimateWithdrawalNetworkFee(ctx sdk.Context, address string, am
 	return networkFee, nil
 }
 
+// EstimateBtcBatchWithdrawalNetworkFee estimates the btc network fee for the given withdrawal requests in a single batch
+func (k Keeper) EstimateBtcBatchWithdrawalNetworkFee(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest, feeRate int64) (sdk.Coin, error) {
+	btcVault := types.SelectVaultByAssetType(k.GetParams(ctx).Vaults, types.AssetType_ASSET_TYPE_BTC)
+	if btcVault == nil {
+		return sdk.Coin{}, types.ErrVaultDoesNotExist
+	}
+
+	utxoIterator := k.GetUTXOIteratorByAddr(ctx, btcVault.Address)
+
+	psbt, _, _, err := types.BuildBtcBatchWithdrawPsbt(utxoIterator, withdrawRequests, feeRate, btcVault.Address, k.GetMaxUtxoNum(ctx))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	psbtB64, err := psbt.B64Encode()
+	if err != nil {
+		return sdk.Coin{}, types.ErrFailToSerializePsbt
+	}
+
+	return k.getBtcNetworkFee(ctx, psbtB64)
+}
+
 // GetWithdrawRequest gets the withdrawal request by the given sequence
 func (k Keeper) GetWithdrawRequest(ctx sdk.Context, sequence uint64) *types.WithdrawRequest {
 	store := ctx.KVStore(k.storeKey)
@@ -360,13 +477,34 @@ func (k Keeper) GetWithdrawRequestsByAddress(ctx sdk.Context, address string) []
 }
 
 // GetPendingBtcWithdrawRequests gets the pending btc withdrawal requests up to the given maximum number
+// The grouped withdrawal requests are never split across batches
 func (k Keeper) GetPendingBtcWithdrawRequests(ctx sdk.Context, maxNum uint32) []*types.WithdrawRequest {
 	requests := make([]*types.WithdrawRequest, 0)
 
 	k.IterateBtcWithdrawRequestQueue(ctx, func(req *types.WithdrawRequest) (stop bool) {
+		if maxNum != 0 && len(requests) >= int(maxNum) {
+			last := requests[len(requests)-1]
+			if req.Group == 0 || req.Group != last.Group {
+				return true
+			}
+
+			// keep the oversized group which fills the whole batch
+			if requests[0].Group == req.Group {
+				requests = append(requests, req)
+				return false
+			}
+
+			// exclude the trailing group which does not fit into the batch
+			for len(requests) > 0 && requests[len(requests)-1].Group == req.Group {
+				requests = requests[:len(requests)-1]
+			}
+
+			return true
+		}
+
 		requests = append(requests, req)
 
-		return maxNum != 0 && len(requests) >= int(maxNum)
+		return false
 	})
 
 	return requests
@@ -643,7 +781,12 @@ func (k Keeper) IterateSigningRequests(ctx sdk.Context, cb func(signingRequest *
 
 // ProcessBitcoinWithdrawTransaction handles the withdrawal transaction
 func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.MsgSubmitWithdrawTransaction) (*chainhash.Hash, error) {
-	tx, _, err := k.ValidateTransaction(ctx, msg.TxBytes, "", msg.Blockhash, msg.Proof, k.WithdrawConfirmationDepth(ctx))
+	blockHash, err := types.ResolveBlockHash(msg.Blockhash, msg.MerkleBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, _, err := k.ValidateTransaction(ctx, msg.TxBytes, "", blockHash, msg.Proof, msg.MerkleBlock, k.WithdrawConfirmationDepth(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -734,7 +877,7 @@ func (k Keeper) HandleWithdrawProtocolFee(ctx sdk.Context, sender sdk.AccAddress
 
 	if amount.Denom == params.BtcVoucherDenom {
 		withdrawAmount, err = amount.SafeSub(protocolFee)
-		if err != nil || withdrawAmount.Amount.Int64() < params.ProtocolLimits.BtcMinWithdraw || withdrawAmount.Amount.Int64() > params.ProtocolLimits.BtcMaxWithdraw {
+		if err != nil || !k.isValidBtcWithdrawAmount(ctx, withdrawAmount) {
 			return sdk.Coin{}, types.ErrInvalidWithdrawAmount
 		}
 	}
@@ -746,6 +889,41 @@ func (k Keeper) HandleWithdrawProtocolFee(ctx sdk.Context, sender sdk.AccAddress
 	return withdrawAmount, nil
 }
 
+// HandleMultiWithdrawProtocolFee performs the protocol fee handling for the given multi-recipient btc withdrawal and returns the actual withdrawal amounts
+// The protocol fee is charged once for the whole group and deducted from the first output
+// The other outputs are withdrawn in full and only checked against the protocol limits
+func (k Keeper) HandleMultiWithdrawProtocolFee(ctx sdk.Context, sender sdk.AccAddress, amounts []sdk.Coin) ([]sdk.Coin, error) {
+	withdrawAmounts := make([]sdk.Coin, len(amounts))
+
+	for i, amount := range amounts {
+		if i == 0 {
+			withdrawAmount, err := k.HandleWithdrawProtocolFee(ctx, sender, amount)
+			if err != nil {
+				return nil, err
+			}
+
+			withdrawAmounts[i] = withdrawAmount
+
+			continue
+		}
+
+		if !k.isValidBtcWithdrawAmount(ctx, amount) {
+			return nil, types.ErrInvalidWithdrawAmount
+		}
+
+		withdrawAmounts[i] = amount
+	}
+
+	return withdrawAmounts, nil
+}
+
+// isValidBtcWithdrawAmount returns true if the given btc withdrawal amount is within the protocol limits, false otherwise
+func (k Keeper) isValidBtcWithdrawAmount(ctx sdk.Context, amount sdk.Coin) bool {
+	protocolLimits := k.GetParams(ctx).ProtocolLimits
+
+	return amount.Amount.Int64() >= protocolLimits.BtcMinWithdraw && amount.Amount.Int64() <= protocolLimits.BtcMaxWithdraw
+}
+
 // getBtcNetworkFee gets the bitcoin network fee for the given withdrawal psbt
 func (k Keeper) getBtcNetworkFee(ctx sdk.Context, packet string) (sdk.Coin, error) {
 	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(packet)), true)
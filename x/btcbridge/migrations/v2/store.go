@@ -0,0 +1,179 @@
+package v2
+
+import (
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+const (
+	// field number of the deprecated deposit confirmation depth in the version 1 params
+	depositConfirmationDepthFieldNumber = 1
+
+	// field number of the deprecated trusted non-btc relayers in the version 1 params
+	trustedNonBtcRelayersFieldNumber = 7
+)
+
+// MigrateStore migrates the x/btcbridge module state from the consensus version 1 to
+// version 2
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsStoreKey)
+
+	// the deprecated fields are skipped as unknown fields
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	migrateDepositConfirmationParams(&params, getDepositConfirmationDepth(bz))
+	migrateRelayerParams(&params)
+	migrateTrustedNonBtcRelayers(&params, getTrustedNonBtcRelayers(bz))
+	migrateRuneEtchingQuorum(&params)
+	migrateGuardianParams(&params)
+	migrateVaultRecoveryParams(&params)
+
+	store.Set(types.ParamsStoreKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// migrateDepositConfirmationParams migrates the deposit confirmation depth to the amount tiered deposit confirmation params
+// The base tier depth is set to the given deposit confirmation depth and no tier is allowed to require less confirmations
+func migrateDepositConfirmationParams(params *types.Params, depositConfirmationDepth int32) {
+	if len(params.DepositConfirmationParams.Tiers) != 0 {
+		return
+	}
+
+	if depositConfirmationDepth <= 0 {
+		depositConfirmationDepth = types.DefaultDepositConfirmationDepth
+	}
+
+	confirmationParams := types.DefaultParams().DepositConfirmationParams
+
+	for i := range confirmationParams.Tiers {
+		if confirmationParams.Tiers[i].ConfirmationDepth < depositConfirmationDepth {
+			confirmationParams.Tiers[i].ConfirmationDepth = depositConfirmationDepth
+		}
+	}
+
+	params.DepositConfirmationParams = confirmationParams
+}
+
+// migrateRelayerParams initializes the relayer params with the default values
+func migrateRelayerParams(params *types.Params) {
+	if len(params.RelayerParams.MinBond.Denom) != 0 {
+		return
+	}
+
+	params.RelayerParams = types.DefaultParams().RelayerParams
+}
+
+// migrateTrustedNonBtcRelayers grandfathers the given deprecated trusted non-btc relayers
+// The grandfathered relayers remain active without bonding until removed by governance
+func migrateTrustedNonBtcRelayers(params *types.Params, relayers []string) {
+	for _, relayer := range relayers {
+		if slices.Contains(params.RelayerParams.GrandfatheredRelayers, relayer) {
+			continue
+		}
+
+		params.RelayerParams.GrandfatheredRelayers = append(params.RelayerParams.GrandfatheredRelayers, relayer)
+	}
+}
+
+// migrateRuneEtchingQuorum initializes the rune etching quorum with the default value
+func migrateRuneEtchingQuorum(params *types.Params) {
+	if params.RelayerParams.RuneEtchingQuorum > 0 {
+		return
+	}
+
+	params.RelayerParams.RuneEtchingQuorum = types.DefaultRuneEtchingQuorum
+}
+
+// migrateGuardianParams initializes the guardian params with the default values
+// The guardian is disabled by default until set by governance
+func migrateGuardianParams(params *types.Params) {
+	if params.GuardianParams.MaxPauseDuration > 0 {
+		return
+	}
+
+	params.GuardianParams = types.DefaultParams().GuardianParams
+}
+
+// migrateVaultRecoveryParams initializes the vault recovery params with the default values
+// The vault recovery is disabled by default until the recovery pub keys are set by governance
+func migrateVaultRecoveryParams(params *types.Params) {
+	if params.VaultRecoveryParams.Timelock > 0 {
+		return
+	}
+
+	params.VaultRecoveryParams = types.DefaultParams().VaultRecoveryParams
+}
+
+// getDepositConfirmationDepth gets the deprecated deposit confirmation depth from the given version 1 params bytes
+// 0 returned if not found
+func getDepositConfirmationDepth(bz []byte) int32 {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return 0
+		}
+
+		bz = bz[n:]
+
+		if num == depositConfirmationDepthFieldNumber && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return 0
+			}
+
+			return int32(v)
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return 0
+		}
+
+		bz = bz[n:]
+	}
+
+	return 0
+}
+
+// getTrustedNonBtcRelayers gets the deprecated trusted non-btc relayers from the given version 1 params bytes
+func getTrustedNonBtcRelayers(bz []byte) []string {
+	relayers := []string{}
+
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return relayers
+		}
+
+		bz = bz[n:]
+
+		if num == trustedNonBtcRelayersFieldNumber && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return relayers
+			}
+
+			relayers = append(relayers, string(v))
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return relayers
+		}
+
+		bz = bz[n:]
+	}
+
+	return relayers
+}
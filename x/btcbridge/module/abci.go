@@ -21,6 +21,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	updateRateLimit(ctx, k)
 
 	handleVaultTransfer(ctx, k)
+
+	k.HandleUnbondingRelayers(ctx)
+
+	k.HandleExpiredPauses(ctx)
+
+	k.PruneFeeSponsorshipAccountUsages(ctx)
 }
 
 // handleBtcWithdrawRequests performs the batch btc withdrawal request handling
@@ -32,6 +38,11 @@ func handleBtcWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
+	// check if withdrawal is paused
+	if k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED) || k.IsAssetPaused(ctx, types.AssetType_ASSET_TYPE_BTC) {
+		return
+	}
+
 	// check block height
 	if ctx.BlockHeight()%p.WithdrawParams.BtcBatchWithdrawPeriod != 0 {
 		return
@@ -39,6 +50,9 @@ func handleBtcWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
 
 	// get the pending btc withdrawal requests
 	pendingWithdrawRequests := k.GetPendingBtcWithdrawRequests(ctx, p.WithdrawParams.MaxBtcBatchWithdrawNum)
+
+	// quarantine the withdrawal requests of the screened addresses
+	pendingWithdrawRequests = k.QuarantineScreenedWithdrawRequests(ctx, pendingWithdrawRequests)
 	if len(pendingWithdrawRequests) == 0 {
 		return
 	}
@@ -96,6 +110,8 @@ func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
 			req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT
 			k.SetDKGRequest(ctx, req)
 
+			k.HandleKeyRotationResult(ctx, false)
+
 			continue
 		}
 
@@ -110,20 +126,30 @@ func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
 			req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_FAILED
 			k.SetDKGRequest(ctx, req)
 
+			k.HandleKeyRotationResult(ctx, false)
+
 			continue
 		}
 
 		// update vaults
-		k.UpdateVaults(ctx, completionRequests[0].Vaults, req.VaultTypes)
+		k.UpdateVaults(ctx, completionRequests[0].Vaults, req.VaultTypes, completionRequests[0].PubKeys, completionRequests[0].InternalKeys, req.RecoveryParams)
 
 		// update status
 		req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED
 		k.SetDKGRequest(ctx, req)
+
+		// complete the vault key rotation if in progress
+		k.HandleKeyRotationResult(ctx, true)
 	}
 }
 
 // handleVaultTransfer performs the vault asset transfer
 func handleVaultTransfer(ctx sdk.Context, k keeper.Keeper) {
+	// check if vault transfer is paused
+	if k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_VAULT_TRANSFER, types.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return
+	}
+
 	completedDKGRequests := k.GetDKGRequests(ctx, types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED)
 
 	for _, req := range completedDKGRequests {
@@ -166,6 +192,13 @@ func handleVaultTransfer(ctx sdk.Context, k keeper.Keeper) {
 
 // handleIBCWithdrawRequests handles BTC withdrawal requests via IBC
 func handleIBCWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
+	// check if IBC withdrawal is paused
+	if k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_IBC_PEGOUT, types.AssetType_ASSET_TYPE_UNSPECIFIED) ||
+		k.IsPaused(ctx, types.PauseTarget_PAUSE_TARGET_WITHDRAW, types.AssetType_ASSET_TYPE_UNSPECIFIED) ||
+		k.IsAssetPaused(ctx, types.AssetType_ASSET_TYPE_BTC) {
+		return
+	}
+
 	// get the pending IBC withdrawal requests
 	pendingIBCWithdrawRequests := k.GetPendingIBCWithdrawRequests(ctx, k.MaxBtcBatchWithdrawNum(ctx))
 	if len(pendingIBCWithdrawRequests) == 0 {
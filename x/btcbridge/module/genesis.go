@@ -53,6 +53,70 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.AddToMintHistory(ctx, txHash)
 	}
 
+	// set relayers
+	for _, relayer := range genState.Relayers {
+		k.SetRelayer(ctx, relayer)
+	}
+
+	// set runes attestations
+	for _, attestation := range genState.RunesAttestations {
+		k.SetRunesAttestation(ctx, attestation)
+	}
+
+	// set rune etchings
+	for _, etching := range genState.RuneEtchings {
+		k.SetRuneEtching(ctx, etching)
+	}
+
+	for _, submission := range genState.RuneEtchingSubmissions {
+		k.SetRuneEtchingSubmission(ctx, submission)
+	}
+
+	// set pauses
+	for _, pause := range genState.Pauses {
+		k.SetPause(ctx, pause)
+		if pause.Status == types.PauseStatus_PAUSE_STATUS_ACTIVE {
+			k.SetActivePause(ctx, pause)
+		}
+
+		if latestPause := k.GetLatestPause(ctx, pause.Target, pause.AssetType); latestPause == nil || pause.Id > latestPause.Id {
+			k.SetLatestPause(ctx, pause)
+		}
+
+		if pause.Id > k.GetPauseId(ctx) {
+			k.SetPauseId(ctx, pause.Id)
+		}
+	}
+
+	// set screened addresses
+	for _, screenedAddress := range genState.ScreenedAddresses {
+		k.SetScreenedAddress(ctx, screenedAddress)
+	}
+
+	// set quarantined deposits
+	for _, deposit := range genState.QuarantinedDeposits {
+		k.SetQuarantinedDeposit(ctx, deposit)
+	}
+
+	// set quarantined withdrawals
+	for _, withdrawal := range genState.QuarantinedWithdrawals {
+		k.SetQuarantinedWithdrawal(ctx, withdrawal)
+	}
+
+	// set deposit records
+	for _, record := range genState.DepositRecords {
+		k.SetDepositRecord(ctx, record)
+	}
+
+	// set fee sponsorship usages
+	for _, usage := range genState.FeeSponsorshipUsages {
+		k.SetFeeSponsorshipUsage(ctx, usage)
+	}
+
+	for _, usage := range genState.FeeSponsorshipAccountUsages {
+		k.SetFeeSponsorshipAccountUsage(ctx, usage)
+	}
+
 	// sort vaults and set the latest vault version
 	if len(genState.Params.Vaults) > 0 {
 		vaults := genState.Params.Vaults
@@ -75,6 +139,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeSponsorName))
 	}
+
+	// check if the relayer bond pool module account exists
+	moduleAcc = k.GetRelayerBondPoolAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.RelayerBondPoolName))
+	}
+
+	// check if the quarantine module account exists
+	moduleAcc = k.GetQuarantineAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.QuarantineName))
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -94,6 +170,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.MintedTxHashes = k.GetAllMintHistories(ctx)
 
+	genesis.Relayers = k.GetAllRelayers(ctx)
+	genesis.RunesAttestations = k.GetAllRunesAttestations(ctx)
+	genesis.RuneEtchings = k.GetAllRuneEtchings(ctx)
+	genesis.RuneEtchingSubmissions = k.GetAllRuneEtchingSubmissions(ctx)
+	genesis.Pauses = k.GetAllPauses(ctx)
+	genesis.ScreenedAddresses = k.GetAllScreenedAddresses(ctx)
+	genesis.QuarantinedDeposits = k.GetAllQuarantinedDeposits(ctx)
+	genesis.QuarantinedWithdrawals = k.GetAllQuarantinedWithdrawals(ctx)
+	genesis.DepositRecords = k.GetAllDepositRecords(ctx)
+	genesis.FeeSponsorshipUsages = k.GetAllFeeSponsorshipUsages(ctx)
+	genesis.FeeSponsorshipAccountUsages = k.GetAllFeeSponsorshipAccountUsages(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,11 +2,14 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 
 	"lukechampine.com/uint128"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -14,6 +17,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/bitcoin"
@@ -548,6 +552,10 @@ func PopulateTxWithDummyWitness(tx *wire.MsgTx, utxos []*UTXO) *wire.MsgTx {
 		case txscript.WitnessV0PubKeyHashTy:
 			dummyWitness = make([]byte, 73+33)
 
+		case txscript.WitnessV0ScriptHashTy:
+			// single key witness script
+			dummyWitness = make([]byte, 73+35)
+
 		default:
 		}
 
@@ -587,6 +595,22 @@ func IsValidBtcAddress(address string) bool {
 	return err == nil
 }
 
+// GetTxHash gets the tx hash from the given base64 encoded transaction
+// Return an empty string if the transaction is invalid
+func GetTxHash(txB64 string) string {
+	rawTx, err := base64.StdEncoding.DecodeString(txB64)
+	if err != nil {
+		return ""
+	}
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return ""
+	}
+
+	return tx.TxHash().String()
+}
+
 // GetSigners gets all signer addresses from the given psbt
 // Assume that the given psbt is valid and contains witness utxos
 func GetSigners(psbtB64 string) []string {
@@ -602,14 +626,14 @@ func GetSigners(psbtB64 string) []string {
 }
 
 // GetSigHashes gets all sig hashes from the given psbt
-// Assume that the given psbt is valid and contains taproot witness utxos
+// Assume that the given psbt is valid and contains taproot or segwit v0 witness utxos
 func GetSigHashes(psbtB64 string) []string {
 	sigHashes := []string{}
 
 	p, _ := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbtB64)), true)
 
 	for i, input := range p.Inputs {
-		sigHash, _ := CalcTaprootSigHash(p, i, input.SighashType)
+		sigHash, _ := bitcoin.CalcSigHash(p, i, input.SighashType)
 
 		sigHashes = append(sigHashes, base64.StdEncoding.EncodeToString(sigHash))
 	}
@@ -649,18 +673,164 @@ func MustAddressFromPkScript(pkScript []byte) string {
 	return address.EncodeAddress()
 }
 
-// CalcTaprootSigHash computes the sig hash of the given input
-// Assume that the psbt is valid
-func CalcTaprootSigHash(p *psbt.Packet, idx int, sigHashType txscript.SigHashType) ([]byte, error) {
-	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
-	for i, txIn := range p.UnsignedTx.TxIn {
-		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+// GetSingleKeyWitnessScript gets the single key witness script for the P2WSH vault, i.e. <pub key> OP_CHECKSIG
+func GetSingleKeyWitnessScript(pubKey []byte) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddData(pubKey).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// CheckVaultPubKeys checks if the given pub keys match the corresponding vaults
+// The pub key is required for the segwit v0 vault and must be empty for the taproot vault
+// The pub keys can be omitted entirely if no segwit v0 vault is included
+func CheckVaultPubKeys(vaults []string, pubKeys []string) error {
+	if len(pubKeys) != 0 && len(pubKeys) != len(vaults) {
+		return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "pub keys do not match vaults")
 	}
 
-	sigHash, err := txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(p.UnsignedTx, prevOutFetcher), sigHashType, p.UnsignedTx, idx, prevOutFetcher)
-	if err != nil {
-		return nil, err
+	for i, v := range vaults {
+		addr, err := btcutil.DecodeAddress(v, bitcoin.Network)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "invalid vault %s", v)
+		}
+
+		pubKey := ""
+		if len(pubKeys) != 0 {
+			pubKey = pubKeys[i]
+		}
+
+		switch addr.(type) {
+		case *btcutil.AddressTaproot:
+			if len(pubKey) != 0 {
+				return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "pub key not allowed for the taproot vault %s", v)
+			}
+
+			continue
+
+		case *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+			if len(pubKey) == 0 {
+				return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "pub key required for the segwit v0 vault %s", v)
+			}
+
+		default:
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "unsupported vault %s", v)
+		}
+
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "failed to decode the pub key of the vault %s", v)
+		}
+
+		if _, err := btcec.ParsePubKey(pubKeyBytes); err != nil || len(pubKeyBytes) != btcec.PubKeyBytesLenCompressed {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "invalid pub key of the vault %s", v)
+		}
+
+		program := btcutil.Hash160(pubKeyBytes)
+
+		if _, ok := addr.(*btcutil.AddressWitnessScriptHash); ok {
+			witnessScript, err := GetSingleKeyWitnessScript(pubKeyBytes)
+			if err != nil {
+				return err
+			}
+
+			witnessScriptHash := sha256.Sum256(witnessScript)
+			program = witnessScriptHash[:]
+		}
+
+		if !bytes.Equal(addr.ScriptAddress(), program) {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "pub key does not match the vault %s", v)
+		}
+	}
+
+	return nil
+}
+
+// PopulatePsbtWithWitnessScripts populates the P2WSH inputs of the given psbt with the witness scripts of the corresponding vaults
+// The sig hash type of the segwit v0 inputs is set to SigHashAll
+// The taproot inputs of the vaults committing to the recovery script are populated with the internal key and merkle root for the key path signing
+func PopulatePsbtWithWitnessScripts(p *psbt.Packet, vaults []*Vault) error {
+	for i, input := range p.Inputs {
+		switch txscript.GetScriptClass(input.WitnessUtxo.PkScript) {
+		case txscript.WitnessV1TaprootTy:
+			vault := SelectVaultByPkScript(vaults, input.WitnessUtxo.PkScript)
+			if vault == nil || vault.Recovery == nil {
+				continue
+			}
+
+			internalKey, err := hex.DecodeString(vault.Recovery.InternalKey)
+			if err != nil {
+				return errorsmod.Wrap(ErrInvalidVault, "failed to decode the vault internal key")
+			}
+
+			merkleRoot, err := vault.Recovery.GetMerkleRoot()
+			if err != nil {
+				return err
+			}
+
+			p.Inputs[i].TaprootInternalKey = internalKey
+			p.Inputs[i].TaprootMerkleRoot = merkleRoot
+
+		case txscript.WitnessV0PubKeyHashTy:
+			p.Inputs[i].SighashType = bitcoin.GetSegwitV0SigHashType(input.SighashType)
+
+		case txscript.WitnessV0ScriptHashTy:
+			vault := SelectVaultByPkScript(vaults, input.WitnessUtxo.PkScript)
+			if vault == nil || len(vault.PubKey) == 0 {
+				return errorsmod.Wrap(ErrInvalidVault, "vault pub key required for P2WSH input")
+			}
+
+			pubKey, err := hex.DecodeString(vault.PubKey)
+			if err != nil {
+				return errorsmod.Wrap(ErrInvalidVault, "failed to decode the vault pub key")
+			}
+
+			witnessScript, err := GetSingleKeyWitnessScript(pubKey)
+			if err != nil {
+				return err
+			}
+
+			p.Inputs[i].WitnessScript = witnessScript
+			p.Inputs[i].SighashType = bitcoin.GetSegwitV0SigHashType(input.SighashType)
+		}
 	}
 
-	return sigHash, nil
+	return nil
+}
+
+// FinalizeSegwitV0Input finalizes the given P2WPKH or P2WSH input with the DER encoded ECDSA signature
+// Assume that the signature is valid and the witness script is populated for the P2WSH input
+// The given pub key must match the pub key hash for the P2WPKH input
+func FinalizeSegwitV0Input(p *psbt.Packet, idx int, sigBytes []byte, pubKey []byte) error {
+	input := p.Inputs[idx]
+
+	sig := make([]byte, 0, len(sigBytes)+1)
+	sig = append(sig, sigBytes...)
+	sig = append(sig, byte(bitcoin.GetSegwitV0SigHashType(input.SighashType)))
+
+	var witness wire.TxWitness
+
+	switch txscript.GetScriptClass(input.WitnessUtxo.PkScript) {
+	case txscript.WitnessV0PubKeyHashTy:
+		if !bytes.Equal(btcutil.Hash160(pubKey), input.WitnessUtxo.PkScript[2:22]) {
+			return errorsmod.Wrap(ErrInvalidPsbt, "mismatched pub key hash")
+		}
+
+		witness = wire.TxWitness{sig, pubKey}
+
+	case txscript.WitnessV0ScriptHashTy:
+		witness = wire.TxWitness{sig, input.WitnessScript}
+
+	default:
+		return ErrUnsupportedScriptType
+	}
+
+	var buf bytes.Buffer
+	if err := psbt.WriteTxWitness(&buf, witness); err != nil {
+		return err
+	}
+
+	p.Inputs[idx].FinalScriptWitness = buf.Bytes()
+
+	return nil
 }
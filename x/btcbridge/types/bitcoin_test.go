@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+	tsstypes "github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+func TestSegwitV0Signing(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	p2wpkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), bitcoin.Network)
+	require.NoError(t, err)
+
+	witnessScript, err := types.GetSingleKeyWitnessScript(pubKey)
+	require.NoError(t, err)
+
+	witnessScriptHash := sha256.Sum256(witnessScript)
+	p2wshAddr, err := btcutil.NewAddressWitnessScriptHash(witnessScriptHash[:], bitcoin.Network)
+	require.NoError(t, err)
+
+	vaults := []*types.Vault{
+		{Address: p2wpkhAddr.EncodeAddress(), PubKey: hex.EncodeToString(pubKey), AssetType: types.AssetType_ASSET_TYPE_BTC},
+		{Address: p2wshAddr.EncodeAddress(), PubKey: hex.EncodeToString(pubKey), AssetType: types.AssetType_ASSET_TYPE_RUNES},
+	}
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 1), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(150000, types.MustPkScriptFromAddress(p2wpkhAddr.EncodeAddress())))
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+
+	prevOuts := []*wire.TxOut{
+		wire.NewTxOut(100000, types.MustPkScriptFromAddress(p2wpkhAddr.EncodeAddress())),
+		wire.NewTxOut(60000, types.MustPkScriptFromAddress(p2wshAddr.EncodeAddress())),
+	}
+
+	for i, prevOut := range prevOuts {
+		p.Inputs[i].SighashType = types.DefaultSigHashType
+		p.Inputs[i].WitnessUtxo = prevOut
+	}
+
+	require.NoError(t, types.PopulatePsbtWithWitnessScripts(p, vaults))
+	require.Equal(t, witnessScript, p.Inputs[1].WitnessScript)
+
+	for i := range p.Inputs {
+		require.Equal(t, txscript.SigHashAll, p.Inputs[i].SighashType)
+
+		sigHash, err := bitcoin.CalcSigHash(p, i, p.Inputs[i].SighashType)
+		require.NoError(t, err)
+
+		sig := ecdsa.Sign(privKey, sigHash).Serialize()
+		require.True(t, tsstypes.VerifyECDSASignature(sig, sigHash, pubKey))
+
+		require.NoError(t, types.FinalizeSegwitV0Input(p, i, sig, pubKey))
+	}
+
+	require.NoError(t, psbt.MaybeFinalizeAll(p))
+
+	signedTx, err := psbt.Extract(p)
+	require.NoError(t, err)
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range signedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, prevOuts[i])
+	}
+
+	sigHashes := txscript.NewTxSigHashes(signedTx, prevOutFetcher)
+
+	for i, prevOut := range prevOuts {
+		vm, err := txscript.NewEngine(prevOut.PkScript, signedTx, i, txscript.StandardVerifyFlags, nil, sigHashes, prevOut.Value, prevOutFetcher)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute(), "input %d should be valid", i)
+	}
+
+	// the P2WPKH input requires the matching pub key
+	otherPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.Error(t, types.FinalizeSegwitV0Input(p, 0, []byte{}, otherPrivKey.PubKey().SerializeCompressed()))
+
+	// the P2WSH input requires the matching witness script
+	p.Inputs[1].WitnessScript = bytes.Repeat([]byte{txscript.OP_TRUE}, 2)
+	_, err = bitcoin.CalcSigHash(p, 1, txscript.SigHashAll)
+	require.ErrorIs(t, err, bitcoin.ErrMismatchedWitnessScript)
+}
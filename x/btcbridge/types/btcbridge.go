@@ -14,6 +14,15 @@ func (req *SigningRequest) Compact() *CompactSigningRequest {
 	}
 }
 
+// Initiator returns the account which initiated the withdrawal request
+func (req *WithdrawRequest) Initiator() string {
+	if len(req.Sender) != 0 {
+		return req.Sender
+	}
+
+	return req.Address
+}
+
 // GlobalRateLimitEnabled returns true if the global rate limit is enabled, false otherwise
 func GlobalRateLimitEnabled(rateLimit *RateLimit) bool {
 	return rateLimit.GlobalRateLimit.Quota > 0
@@ -7,7 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -145,6 +146,192 @@ func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0f64c00fd58c2a9e, []int{2}
 }
 
+// Relayer Status
+type RelayerStatus int32
+
+const (
+	// RELAYER_STATUS_UNSPECIFIED defines the unknown relayer status
+	RelayerStatus_RELAYER_STATUS_UNSPECIFIED RelayerStatus = 0
+	// RELAYER_STATUS_BONDED defines the status of the relayer which is bonded
+	RelayerStatus_RELAYER_STATUS_BONDED RelayerStatus = 1
+	// RELAYER_STATUS_UNBONDING defines the status of the relayer which is unbonding
+	RelayerStatus_RELAYER_STATUS_UNBONDING RelayerStatus = 2
+)
+
+var RelayerStatus_name = map[int32]string{
+	0: "RELAYER_STATUS_UNSPECIFIED",
+	1: "RELAYER_STATUS_BONDED",
+	2: "RELAYER_STATUS_UNBONDING",
+}
+
+var RelayerStatus_value = map[string]int32{
+	"RELAYER_STATUS_UNSPECIFIED": 0,
+	"RELAYER_STATUS_BONDED":      1,
+	"RELAYER_STATUS_UNBONDING":   2,
+}
+
+func (x RelayerStatus) String() string {
+	return proto.EnumName(RelayerStatus_name, int32(x))
+}
+
+func (RelayerStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{3}
+}
+
+// Relayer Misbehaviour
+// The validity of the runes attestation depends on the runes protocol state which is not available on chain,
+// so the misbehaviour is adjudicated by governance against the recorded attestation
+type RelayerMisbehaviour int32
+
+const (
+	// RELAYER_MISBEHAVIOUR_UNSPECIFIED defines the unknown misbehaviour
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_UNSPECIFIED RelayerMisbehaviour = 0
+	// RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION defines the misbehaviour of attesting an invalid runes deposit
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION RelayerMisbehaviour = 1
+	// RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION defines the misbehaviour of attesting a duplicate runes deposit
+	RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION RelayerMisbehaviour = 2
+)
+
+var RelayerMisbehaviour_name = map[int32]string{
+	0: "RELAYER_MISBEHAVIOUR_UNSPECIFIED",
+	1: "RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION",
+	2: "RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION",
+}
+
+var RelayerMisbehaviour_value = map[string]int32{
+	"RELAYER_MISBEHAVIOUR_UNSPECIFIED":                 0,
+	"RELAYER_MISBEHAVIOUR_INVALID_RUNES_ATTESTATION":   1,
+	"RELAYER_MISBEHAVIOUR_DUPLICATE_RUNES_ATTESTATION": 2,
+}
+
+func (x RelayerMisbehaviour) String() string {
+	return proto.EnumName(RelayerMisbehaviour_name, int32(x))
+}
+
+func (RelayerMisbehaviour) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{4}
+}
+
+// Pause Target
+type PauseTarget int32
+
+const (
+	// PAUSE_TARGET_UNSPECIFIED defines the unknown pause target
+	PauseTarget_PAUSE_TARGET_UNSPECIFIED PauseTarget = 0
+	// PAUSE_TARGET_DEPOSIT defines the pause target for deposits
+	PauseTarget_PAUSE_TARGET_DEPOSIT PauseTarget = 1
+	// PAUSE_TARGET_WITHDRAW defines the pause target for withdrawals
+	PauseTarget_PAUSE_TARGET_WITHDRAW PauseTarget = 2
+	// PAUSE_TARGET_IBC_PEGOUT defines the pause target for the automatic withdrawals via IBC
+	PauseTarget_PAUSE_TARGET_IBC_PEGOUT PauseTarget = 3
+	// PAUSE_TARGET_VAULT_TRANSFER defines the pause target for vault transfers
+	PauseTarget_PAUSE_TARGET_VAULT_TRANSFER PauseTarget = 4
+	// PAUSE_TARGET_ASSET defines the pause target for the specific asset type
+	PauseTarget_PAUSE_TARGET_ASSET PauseTarget = 5
+	// PAUSE_TARGET_LENDING_APPLY defines the pause target for lending applications
+	PauseTarget_PAUSE_TARGET_LENDING_APPLY PauseTarget = 6
+	// PAUSE_TARGET_LIQUIDATION defines the pause target for liquidations
+	PauseTarget_PAUSE_TARGET_LIQUIDATION PauseTarget = 7
+)
+
+var PauseTarget_name = map[int32]string{
+	0: "PAUSE_TARGET_UNSPECIFIED",
+	1: "PAUSE_TARGET_DEPOSIT",
+	2: "PAUSE_TARGET_WITHDRAW",
+	3: "PAUSE_TARGET_IBC_PEGOUT",
+	4: "PAUSE_TARGET_VAULT_TRANSFER",
+	5: "PAUSE_TARGET_ASSET",
+	6: "PAUSE_TARGET_LENDING_APPLY",
+	7: "PAUSE_TARGET_LIQUIDATION",
+}
+
+var PauseTarget_value = map[string]int32{
+	"PAUSE_TARGET_UNSPECIFIED":    0,
+	"PAUSE_TARGET_DEPOSIT":        1,
+	"PAUSE_TARGET_WITHDRAW":       2,
+	"PAUSE_TARGET_IBC_PEGOUT":     3,
+	"PAUSE_TARGET_VAULT_TRANSFER": 4,
+	"PAUSE_TARGET_ASSET":          5,
+	"PAUSE_TARGET_LENDING_APPLY":  6,
+	"PAUSE_TARGET_LIQUIDATION":    7,
+}
+
+func (x PauseTarget) String() string {
+	return proto.EnumName(PauseTarget_name, int32(x))
+}
+
+func (PauseTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{5}
+}
+
+// Pause Status
+type PauseStatus int32
+
+const (
+	// PAUSE_STATUS_UNSPECIFIED defines the unknown pause status
+	PauseStatus_PAUSE_STATUS_UNSPECIFIED PauseStatus = 0
+	// PAUSE_STATUS_ACTIVE defines the status of the pause which is in effect
+	PauseStatus_PAUSE_STATUS_ACTIVE PauseStatus = 1
+	// PAUSE_STATUS_EXPIRED defines the status of the pause which expired
+	PauseStatus_PAUSE_STATUS_EXPIRED PauseStatus = 2
+	// PAUSE_STATUS_LIFTED defines the status of the pause which is lifted manually
+	PauseStatus_PAUSE_STATUS_LIFTED PauseStatus = 3
+)
+
+var PauseStatus_name = map[int32]string{
+	0: "PAUSE_STATUS_UNSPECIFIED",
+	1: "PAUSE_STATUS_ACTIVE",
+	2: "PAUSE_STATUS_EXPIRED",
+	3: "PAUSE_STATUS_LIFTED",
+}
+
+var PauseStatus_value = map[string]int32{
+	"PAUSE_STATUS_UNSPECIFIED": 0,
+	"PAUSE_STATUS_ACTIVE":      1,
+	"PAUSE_STATUS_EXPIRED":     2,
+	"PAUSE_STATUS_LIFTED":      3,
+}
+
+func (x PauseStatus) String() string {
+	return proto.EnumName(PauseStatus_name, int32(x))
+}
+
+func (PauseStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{6}
+}
+
+// Screening Source
+type ScreeningSource int32
+
+const (
+	// SCREENING_SOURCE_UNSPECIFIED defines the unknown screening source
+	ScreeningSource_SCREENING_SOURCE_UNSPECIFIED ScreeningSource = 0
+	// SCREENING_SOURCE_GOVERNANCE defines the address denylisted by governance
+	ScreeningSource_SCREENING_SOURCE_GOVERNANCE ScreeningSource = 1
+	// SCREENING_SOURCE_SCREENER defines the address flagged by the off-chain screeners of validators
+	ScreeningSource_SCREENING_SOURCE_SCREENER ScreeningSource = 2
+)
+
+var ScreeningSource_name = map[int32]string{
+	0: "SCREENING_SOURCE_UNSPECIFIED",
+	1: "SCREENING_SOURCE_GOVERNANCE",
+	2: "SCREENING_SOURCE_SCREENER",
+}
+
+var ScreeningSource_value = map[string]int32{
+	"SCREENING_SOURCE_UNSPECIFIED": 0,
+	"SCREENING_SOURCE_GOVERNANCE":  1,
+	"SCREENING_SOURCE_SCREENER":    2,
+}
+
+func (x ScreeningSource) String() string {
+	return proto.EnumName(ScreeningSource_name, int32(x))
+}
+
+func (ScreeningSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{7}
+}
+
 // Fee rate
 type FeeRate struct {
 	// fee rate
@@ -400,6 +587,12 @@ type WithdrawRequest struct {
 	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Txid     string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	// sequence group shared by the linked withdrawal requests, i.e. the sequence of the first request in the group
+	// 0 if not grouped; the grouped requests are always handled in the same batch
+	Group uint64 `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	// account which initiated the grouped withdrawal requests
+	// empty if not grouped, in which case the withdrawal address is the initiator
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
@@ -463,6 +656,20 @@ func (m *WithdrawRequest) GetTxid() string {
 	return ""
 }
 
+func (m *WithdrawRequest) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
+func (m *WithdrawRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // Withdrawal request via IBC
 type IBCWithdrawRequest struct {
 	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
@@ -989,6 +1196,298 @@ func (m *RuneId) GetTx() uint32 {
 	return 0
 }
 
+// FeeSponsorshipUsage defines the usage of the fee sponsorship policy in the current epoch
+type FeeSponsorshipUsage struct {
+	// policy id
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// start time of the current epoch
+	EpochStartTime time.Time `protobuf:"bytes,2,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time"`
+	// total fee sponsored in the current epoch
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// number of the sponsored txs in the current epoch
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *FeeSponsorshipUsage) Reset()         { *m = FeeSponsorshipUsage{} }
+func (m *FeeSponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipUsage) ProtoMessage()    {}
+func (*FeeSponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{12}
+}
+func (m *FeeSponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipUsage.Merge(m, src)
+}
+func (m *FeeSponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorshipUsage proto.InternalMessageInfo
+
+func (m *FeeSponsorshipUsage) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *FeeSponsorshipUsage) GetEpochStartTime() time.Time {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *FeeSponsorshipUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *FeeSponsorshipUsage) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// FeeSponsorshipAccountUsage defines the usage of the fee sponsorship policy by the account
+type FeeSponsorshipAccountUsage struct {
+	// policy id
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// account address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// start time of the epoch in which the usage is recorded
+	EpochStartTime time.Time `protobuf:"bytes,3,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time"`
+	// total fee sponsored for the account in the epoch
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// number of the sponsored txs for the account in the epoch
+	TxCount uint32 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *FeeSponsorshipAccountUsage) Reset()         { *m = FeeSponsorshipAccountUsage{} }
+func (m *FeeSponsorshipAccountUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipAccountUsage) ProtoMessage()    {}
+func (*FeeSponsorshipAccountUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{13}
+}
+func (m *FeeSponsorshipAccountUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorshipAccountUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipAccountUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsorshipAccountUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipAccountUsage.Merge(m, src)
+}
+func (m *FeeSponsorshipAccountUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipAccountUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipAccountUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorshipAccountUsage proto.InternalMessageInfo
+
+func (m *FeeSponsorshipAccountUsage) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *FeeSponsorshipAccountUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeSponsorshipAccountUsage) GetEpochStartTime() time.Time {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *FeeSponsorshipAccountUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *FeeSponsorshipAccountUsage) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// RuneEtching defines the etching data of the rune used to register the denom metadata
+type RuneEtching struct {
+	// rune id, e.g. 840000:3
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rune name without spacers, e.g. DOGGOTOTHEMOON
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// bit field of the spacers between the rune name characters
+	Spacers uint32 `protobuf:"varint,3,opt,name=spacers,proto3" json:"spacers,omitempty"`
+	// single non-whitespace currency symbol; defaulted to ¤ if empty
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// divisibility of the rune
+	Divisibility uint32 `protobuf:"varint,5,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
+}
+
+func (m *RuneEtching) Reset()         { *m = RuneEtching{} }
+func (m *RuneEtching) String() string { return proto.CompactTextString(m) }
+func (*RuneEtching) ProtoMessage()    {}
+func (*RuneEtching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{14}
+}
+func (m *RuneEtching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneEtching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneEtching.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneEtching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneEtching.Merge(m, src)
+}
+func (m *RuneEtching) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneEtching) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneEtching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneEtching proto.InternalMessageInfo
+
+func (m *RuneEtching) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RuneEtching) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuneEtching) GetSpacers() uint32 {
+	if m != nil {
+		return m.Spacers
+	}
+	return 0
+}
+
+func (m *RuneEtching) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RuneEtching) GetDivisibility() uint32 {
+	if m != nil {
+		return m.Divisibility
+	}
+	return 0
+}
+
+// RuneEtchingSubmission defines the rune etching submitted by the relayer which is pending the relayer quorum
+type RuneEtchingSubmission struct {
+	// relayer address
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// rune etching data
+	Etching *RuneEtching `protobuf:"bytes,2,opt,name=etching,proto3" json:"etching,omitempty"`
+}
+
+func (m *RuneEtchingSubmission) Reset()         { *m = RuneEtchingSubmission{} }
+func (m *RuneEtchingSubmission) String() string { return proto.CompactTextString(m) }
+func (*RuneEtchingSubmission) ProtoMessage()    {}
+func (*RuneEtchingSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{15}
+}
+func (m *RuneEtchingSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneEtchingSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneEtchingSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneEtchingSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneEtchingSubmission.Merge(m, src)
+}
+func (m *RuneEtchingSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneEtchingSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneEtchingSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneEtchingSubmission proto.InternalMessageInfo
+
+func (m *RuneEtchingSubmission) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RuneEtchingSubmission) GetEtching() *RuneEtching {
+	if m != nil {
+		return m.Etching
+	}
+	return nil
+}
+
 // Rune Edict
 type Edict struct {
 	Id     *RuneId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1000,7 +1499,7 @@ func (m *Edict) Reset()         { *m = Edict{} }
 func (m *Edict) String() string { return proto.CompactTextString(m) }
 func (*Edict) ProtoMessage()    {}
 func (*Edict) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{12}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{16}
 }
 func (m *Edict) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1561,7 @@ func (m *BtcConsolidation) Reset()         { *m = BtcConsolidation{} }
 func (m *BtcConsolidation) String() string { return proto.CompactTextString(m) }
 func (*BtcConsolidation) ProtoMessage()    {}
 func (*BtcConsolidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{13}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{17}
 }
 func (m *BtcConsolidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,7 +1618,7 @@ func (m *RunesConsolidation) Reset()         { *m = RunesConsolidation{} }
 func (m *RunesConsolidation) String() string { return proto.CompactTextString(m) }
 func (*RunesConsolidation) ProtoMessage()    {}
 func (*RunesConsolidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{14}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{18}
 }
 func (m *RunesConsolidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1682,7 @@ func (m *DKGParticipant) Reset()         { *m = DKGParticipant{} }
 func (m *DKGParticipant) String() string { return proto.CompactTextString(m) }
 func (*DKGParticipant) ProtoMessage()    {}
 func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{15}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{19}
 }
 func (m *DKGParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,13 +1750,15 @@ type DKGRequest struct {
 	Expiration *time.Time `protobuf:"bytes,7,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 	// status
 	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.btcbridge.DKGRequestStatus" json:"status,omitempty"`
+	// recovery params committed by the vaults to be generated; empty if the recovery is disabled
+	RecoveryParams *VaultRecoveryParams `protobuf:"bytes,9,opt,name=recovery_params,json=recoveryParams,proto3" json:"recovery_params,omitempty"`
 }
 
 func (m *DKGRequest) Reset()         { *m = DKGRequest{} }
 func (m *DKGRequest) String() string { return proto.CompactTextString(m) }
 func (*DKGRequest) ProtoMessage()    {}
 func (*DKGRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{16}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{20}
 }
 func (m *DKGRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,10 +1843,17 @@ func (m *DKGRequest) GetStatus() DKGRequestStatus {
 	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
 }
 
-// DKG Completion Request
-type DKGCompletionRequest struct {
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *DKGRequest) GetRecoveryParams() *VaultRecoveryParams {
+	if m != nil {
+		return m.RecoveryParams
+	}
+	return nil
+}
+
+// DKG Completion Request
+type DKGCompletionRequest struct {
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// sender
 	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	// new vaults generated by DKG
@@ -1354,13 +1862,17 @@ type DKGCompletionRequest struct {
 	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 	// hex encoded participant signature
 	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// x-only internal keys of the new vaults; required if the recovery is enabled
+	InternalKeys []string `protobuf:"bytes,6,rep,name=internal_keys,json=internalKeys,proto3" json:"internal_keys,omitempty"`
+	// hex encoded compressed pub keys of the new vaults; required for the segwit v0 vaults
+	PubKeys []string `protobuf:"bytes,7,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
 }
 
 func (m *DKGCompletionRequest) Reset()         { *m = DKGCompletionRequest{} }
 func (m *DKGCompletionRequest) String() string { return proto.CompactTextString(m) }
 func (*DKGCompletionRequest) ProtoMessage()    {}
 func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{17}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{21}
 }
 func (m *DKGCompletionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1424,6 +1936,20 @@ func (m *DKGCompletionRequest) GetSignature() string {
 	return ""
 }
 
+func (m *DKGCompletionRequest) GetInternalKeys() []string {
+	if m != nil {
+		return m.InternalKeys
+	}
+	return nil
+}
+
+func (m *DKGCompletionRequest) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
 // Refreshing Request
 type RefreshingRequest struct {
 	// request id
@@ -1442,7 +1968,7 @@ func (m *RefreshingRequest) Reset()         { *m = RefreshingRequest{} }
 func (m *RefreshingRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshingRequest) ProtoMessage()    {}
 func (*RefreshingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{18}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{22}
 }
 func (m *RefreshingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1522,7 +2048,7 @@ func (m *RefreshingCompletion) Reset()         { *m = RefreshingCompletion{} }
 func (m *RefreshingCompletion) String() string { return proto.CompactTextString(m) }
 func (*RefreshingCompletion) ProtoMessage()    {}
 func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f64c00fd58c2a9e, []int{19}
+	return fileDescriptor_0f64c00fd58c2a9e, []int{23}
 }
 func (m *RefreshingCompletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,503 +2105,971 @@ func (m *RefreshingCompletion) GetSignature() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("bitway.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
-	proto.RegisterEnum("bitway.btcbridge.DKGRequestStatus", DKGRequestStatus_name, DKGRequestStatus_value)
-	proto.RegisterEnum("bitway.btcbridge.RefreshingStatus", RefreshingStatus_name, RefreshingStatus_value)
-	proto.RegisterType((*FeeRate)(nil), "bitway.btcbridge.FeeRate")
-	proto.RegisterType((*SigningRequest)(nil), "bitway.btcbridge.SigningRequest")
-	proto.RegisterType((*CompactSigningRequest)(nil), "bitway.btcbridge.CompactSigningRequest")
-	proto.RegisterType((*WithdrawRequest)(nil), "bitway.btcbridge.WithdrawRequest")
-	proto.RegisterType((*IBCWithdrawRequest)(nil), "bitway.btcbridge.IBCWithdrawRequest")
-	proto.RegisterType((*RateLimit)(nil), "bitway.btcbridge.RateLimit")
-	proto.RegisterType((*GlobalRateLimit)(nil), "bitway.btcbridge.GlobalRateLimit")
-	proto.RegisterType((*AddressRateLimit)(nil), "bitway.btcbridge.AddressRateLimit")
-	proto.RegisterType((*AddressRateLimitDetails)(nil), "bitway.btcbridge.AddressRateLimitDetails")
-	proto.RegisterType((*UTXO)(nil), "bitway.btcbridge.UTXO")
-	proto.RegisterType((*RuneBalance)(nil), "bitway.btcbridge.RuneBalance")
-	proto.RegisterType((*RuneId)(nil), "bitway.btcbridge.RuneId")
-	proto.RegisterType((*Edict)(nil), "bitway.btcbridge.Edict")
-	proto.RegisterType((*BtcConsolidation)(nil), "bitway.btcbridge.BtcConsolidation")
-	proto.RegisterType((*RunesConsolidation)(nil), "bitway.btcbridge.RunesConsolidation")
-	proto.RegisterType((*DKGParticipant)(nil), "bitway.btcbridge.DKGParticipant")
-	proto.RegisterType((*DKGRequest)(nil), "bitway.btcbridge.DKGRequest")
-	proto.RegisterType((*DKGCompletionRequest)(nil), "bitway.btcbridge.DKGCompletionRequest")
-	proto.RegisterType((*RefreshingRequest)(nil), "bitway.btcbridge.RefreshingRequest")
-	proto.RegisterType((*RefreshingCompletion)(nil), "bitway.btcbridge.RefreshingCompletion")
+// Relayer
+type Relayer struct {
+	// relayer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// bonded stake
+	Bond types.Coin `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond"`
+	// status
+	Status RelayerStatus `protobuf:"varint,3,opt,name=status,proto3,enum=bitway.btcbridge.RelayerStatus" json:"status,omitempty"`
+	// number of the deposit transactions relayed
+	RelayedDeposits uint64 `protobuf:"varint,4,opt,name=relayed_deposits,json=relayedDeposits,proto3" json:"relayed_deposits,omitempty"`
+	// total rewards earned
+	TotalRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_rewards,json=totalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rewards"`
+	// number of times slashed
+	SlashCount uint64 `protobuf:"varint,6,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// time at which the bond is released; only set when unbonding
+	UnbondingTime time.Time `protobuf:"bytes,7,opt,name=unbonding_time,json=unbondingTime,proto3,stdtime" json:"unbonding_time"`
+}
+
+func (m *Relayer) Reset()         { *m = Relayer{} }
+func (m *Relayer) String() string { return proto.CompactTextString(m) }
+func (*Relayer) ProtoMessage()    {}
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{24}
+}
+func (m *Relayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relayer.Merge(m, src)
+}
+func (m *Relayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relayer.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("bitway/btcbridge/btcbridge.proto", fileDescriptor_0f64c00fd58c2a9e) }
+var xxx_messageInfo_Relayer proto.InternalMessageInfo
 
-var fileDescriptor_0f64c00fd58c2a9e = []byte{
-	// 1529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xbf, 0x6f, 0xdb, 0x48,
-	0x16, 0x36, 0x25, 0x4a, 0xb2, 0x9e, 0x7f, 0x29, 0x73, 0x8e, 0x23, 0xcb, 0xb1, 0xac, 0x10, 0x87,
-	0x3b, 0x5f, 0x0a, 0x09, 0x71, 0x70, 0x08, 0x70, 0x38, 0xe0, 0x60, 0x4b, 0xb2, 0x22, 0xd8, 0x96,
-	0x7d, 0x94, 0x9c, 0x3b, 0x5c, 0x43, 0x0c, 0xc5, 0x09, 0x45, 0x58, 0x22, 0x19, 0xce, 0xd0, 0x91,
-	0xbb, 0xbb, 0xea, 0xda, 0x6c, 0xb1, 0xf5, 0x16, 0xbb, 0xf5, 0xd6, 0x69, 0xb7, 0x4b, 0xb7, 0x29,
-	0xb7, 0xda, 0x5d, 0x24, 0xff, 0xc0, 0x96, 0x5b, 0x2e, 0x66, 0x48, 0x89, 0x12, 0x25, 0x3b, 0x36,
-	0xb0, 0x45, 0xba, 0x79, 0xdf, 0x7b, 0x33, 0xef, 0x9b, 0xef, 0xbd, 0x19, 0x0e, 0xa1, 0xa4, 0x5b,
-	0xec, 0x35, 0xbe, 0xaa, 0xe8, 0xac, 0xab, 0x7b, 0x96, 0x61, 0x92, 0x68, 0x54, 0x76, 0x3d, 0x87,
-	0x39, 0x28, 0x17, 0x44, 0x94, 0xc7, 0x78, 0x61, 0xdd, 0x74, 0x4c, 0x47, 0x38, 0x2b, 0x7c, 0x14,
-	0xc4, 0x15, 0x36, 0x4d, 0xc7, 0x31, 0xfb, 0xa4, 0x22, 0x2c, 0xdd, 0x7f, 0x59, 0xc1, 0xf6, 0x55,
-	0xe8, 0xda, 0x89, 0xbb, 0x98, 0x35, 0x20, 0x94, 0xe1, 0x81, 0x1b, 0x06, 0x14, 0xbb, 0x0e, 0x1d,
-	0x38, 0xb4, 0xa2, 0x63, 0x4a, 0x2a, 0x97, 0x4f, 0x74, 0xc2, 0xf0, 0x93, 0x4a, 0xd7, 0xb1, 0xec,
-	0xd1, 0xda, 0x81, 0x5f, 0x0b, 0x92, 0x06, 0x46, 0xe8, 0xda, 0x9e, 0xd9, 0x80, 0x8b, 0x3d, 0x3c,
-	0x08, 0xdd, 0xca, 0x33, 0xc8, 0x1c, 0x12, 0xa2, 0x62, 0x46, 0xd0, 0x3a, 0xa4, 0x2e, 0x71, 0xdf,
-	0x27, 0x79, 0xa9, 0x24, 0xed, 0x26, 0xd5, 0xc0, 0x40, 0x1b, 0x90, 0xee, 0x11, 0xcb, 0xec, 0xb1,
-	0x7c, 0x42, 0xc0, 0xa1, 0xa5, 0x7c, 0x9d, 0x80, 0xd5, 0xb6, 0x65, 0xda, 0x96, 0x6d, 0xaa, 0xe4,
-	0x95, 0x4f, 0x28, 0x43, 0x79, 0xc8, 0x60, 0xc3, 0xf0, 0x08, 0xa5, 0x62, 0x89, 0xac, 0x3a, 0x32,
-	0x51, 0x01, 0x16, 0x29, 0x0f, 0xb2, 0xbb, 0x44, 0x2c, 0x23, 0xab, 0x63, 0x1b, 0x55, 0x40, 0x66,
-	0x57, 0x2e, 0xc9, 0x27, 0x4b, 0xd2, 0xee, 0xea, 0xde, 0x56, 0x39, 0x2e, 0x67, 0x79, 0x9f, 0x52,
-	0xc2, 0x3a, 0x57, 0x2e, 0x51, 0x45, 0x20, 0x42, 0x20, 0xb3, 0xa1, 0x65, 0xe4, 0x65, 0x91, 0x43,
-	0x8c, 0x39, 0xe6, 0x52, 0x9d, 0xe5, 0x53, 0x01, 0xc6, 0xc7, 0xa8, 0x09, 0x2b, 0x5d, 0x8f, 0x60,
-	0x66, 0x39, 0xb6, 0xc6, 0x05, 0xcd, 0xa7, 0x4b, 0xd2, 0xee, 0xd2, 0x5e, 0xa1, 0x1c, 0xa8, 0x5d,
-	0x1e, 0xa9, 0x5d, 0xee, 0x8c, 0xd4, 0x3e, 0x58, 0x7c, 0xf7, 0xe3, 0xce, 0xc2, 0x9b, 0x9f, 0x76,
-	0x24, 0x75, 0x79, 0x34, 0x95, 0x3b, 0xd1, 0x33, 0x48, 0x53, 0x86, 0x99, 0x4f, 0xf3, 0x19, 0xc1,
-	0x72, 0x67, 0x96, 0x65, 0xa8, 0x45, 0x5b, 0x84, 0xa9, 0x61, 0xb8, 0xf2, 0x7d, 0x02, 0xee, 0x57,
-	0x9d, 0x81, 0x8b, 0xbb, 0xec, 0xf3, 0x11, 0x2b, 0x0f, 0x19, 0x6a, 0x99, 0x36, 0xf1, 0x68, 0x3e,
-	0x55, 0x4a, 0xf2, 0xd4, 0xa1, 0x89, 0xb6, 0x01, 0xa8, 0x65, 0x6a, 0x3d, 0x4c, 0x7b, 0x84, 0xe6,
-	0xd3, 0xc2, 0x99, 0xa5, 0x96, 0xf9, 0x5c, 0x00, 0xb3, 0x8a, 0x66, 0x7e, 0x07, 0x45, 0x17, 0xef,
-	0xa6, 0x28, 0x85, 0xb5, 0x7f, 0x59, 0xac, 0x67, 0x78, 0xf8, 0xf5, 0xa7, 0xa5, 0xdc, 0x80, 0x34,
-	0x1e, 0x38, 0xbe, 0x1d, 0x34, 0x6f, 0x56, 0x0d, 0xad, 0x29, 0x89, 0x93, 0x31, 0x89, 0xe7, 0x28,
-	0xa6, 0xfc, 0x4f, 0x02, 0xd4, 0x3c, 0xa8, 0xc6, 0x13, 0x6f, 0x03, 0x74, 0x7b, 0xd8, 0xb6, 0x49,
-	0x5f, 0xb3, 0x8c, 0x30, 0x77, 0x36, 0x44, 0x9a, 0xc6, 0x8d, 0x85, 0x9c, 0xe0, 0x9c, 0xbc, 0x8e,
-	0xb3, 0x3c, 0xc9, 0x59, 0x79, 0x2b, 0x41, 0x96, 0x9f, 0xd3, 0x63, 0x6b, 0x60, 0x31, 0xd4, 0x86,
-	0x7b, 0x66, 0xdf, 0xd1, 0x71, 0x5f, 0xf3, 0x30, 0x23, 0x5a, 0x9f, 0x83, 0x82, 0xc1, 0xd2, 0xde,
-	0xa3, 0x59, 0x29, 0x1b, 0x22, 0x74, 0x3c, 0xfb, 0x40, 0xe6, 0x55, 0x51, 0xd7, 0xcc, 0x69, 0x18,
-	0xbd, 0x00, 0x14, 0xb2, 0x98, 0x5c, 0x35, 0x21, 0x56, 0x55, 0xe6, 0xf4, 0x5a, 0x10, 0x1b, 0x5f,
-	0x36, 0x87, 0x63, 0xb8, 0xf2, 0x9d, 0x04, 0x6b, 0x31, 0x0a, 0xa8, 0x0a, 0x40, 0x19, 0xf6, 0x58,
-	0xd0, 0x48, 0xd2, 0x1d, 0x1a, 0x29, 0x2b, 0xe6, 0x89, 0x2e, 0xfa, 0x07, 0x2c, 0x12, 0xdb, 0x08,
-	0x96, 0x48, 0xdc, 0x61, 0x89, 0x0c, 0xb1, 0x0d, 0xb1, 0xc0, 0x3a, 0xa4, 0x5e, 0xf9, 0x0e, 0xc3,
-	0xa2, 0x08, 0x49, 0x35, 0x30, 0x78, 0x0b, 0xf8, 0x94, 0x04, 0x2d, 0x90, 0x54, 0xc5, 0x58, 0xf9,
-	0x56, 0x82, 0x5c, 0x7c, 0xc3, 0x9f, 0xf3, 0x26, 0x94, 0x06, 0x3c, 0x88, 0xf3, 0xad, 0x11, 0x86,
-	0xad, 0x3e, 0xbd, 0xe1, 0xc0, 0x8c, 0x76, 0x9e, 0x98, 0xd8, 0xf9, 0x2f, 0x12, 0xc8, 0xe7, 0x9d,
-	0x7f, 0x9f, 0x8e, 0x4f, 0x86, 0x34, 0x7d, 0xf1, 0x5e, 0x3a, 0x3e, 0x0b, 0xfb, 0x5b, 0x8c, 0x6f,
-	0xdd, 0xdb, 0xf2, 0xf8, 0x3c, 0x46, 0x1f, 0x99, 0x54, 0x80, 0x07, 0x16, 0xfa, 0x23, 0xac, 0xba,
-	0xbe, 0xae, 0x5d, 0x90, 0x2b, 0x8d, 0x76, 0x3d, 0xcb, 0x65, 0xe2, 0x0e, 0x5f, 0x56, 0x97, 0x5d,
-	0x5f, 0x3f, 0x22, 0x57, 0x6d, 0x81, 0xa1, 0x2d, 0xc8, 0x5a, 0x54, 0xeb, 0x3b, 0xdd, 0x0b, 0x62,
-	0x88, 0x2b, 0x69, 0x51, 0x5d, 0xb4, 0xe8, 0xb1, 0xb0, 0xd1, 0x53, 0x48, 0x79, 0xbe, 0x4d, 0xf8,
-	0x3d, 0x93, 0xdc, 0x5d, 0xda, 0xdb, 0x9e, 0x6d, 0x63, 0xd5, 0xb7, 0xc9, 0x01, 0xee, 0x63, 0xbb,
-	0x4b, 0xd4, 0x20, 0x56, 0xf9, 0x2b, 0x2c, 0x4d, 0xa0, 0x68, 0x15, 0x12, 0xe3, 0x6d, 0x27, 0x2c,
-	0xe3, 0xba, 0x6b, 0x45, 0x29, 0x43, 0x9a, 0x4f, 0x6b, 0x1a, 0xbc, 0x24, 0x3a, 0x27, 0x24, 0x26,
-	0xc9, 0x6a, 0x60, 0xf0, 0x75, 0xd8, 0x50, 0xcc, 0x59, 0x51, 0x13, 0x6c, 0xa8, 0x60, 0x48, 0xd5,
-	0x0d, 0xab, 0xcb, 0xd0, 0xee, 0x38, 0xc1, 0xd2, 0x5e, 0x7e, 0x3e, 0xc3, 0xa6, 0x71, 0x53, 0x6a,
-	0x8e, 0x3b, 0x3e, 0x73, 0x7d, 0x26, 0x24, 0x5f, 0x51, 0x43, 0x4b, 0x79, 0x01, 0xb9, 0x03, 0xd6,
-	0xad, 0x3a, 0x36, 0x75, 0xfa, 0x96, 0x21, 0xee, 0x5f, 0xf4, 0x17, 0xc8, 0x31, 0xec, 0x99, 0x84,
-	0x69, 0xac, 0xe7, 0x11, 0xda, 0x73, 0xfa, 0x46, 0xf8, 0xcd, 0x5f, 0x0b, 0xf0, 0xce, 0x08, 0x46,
-	0x0f, 0x20, 0x33, 0xc0, 0x43, 0xcd, 0xf6, 0x07, 0x21, 0xed, 0xf4, 0x00, 0x0f, 0x5b, 0xfe, 0x40,
-	0x79, 0x05, 0x88, 0xb3, 0xa2, 0xd3, 0x2b, 0x3f, 0x80, 0x0c, 0x17, 0x30, 0xba, 0x0d, 0xd3, 0x5e,
-	0xa0, 0xc7, 0xbc, 0x94, 0xc1, 0x06, 0x6e, 0x4a, 0x99, 0x9c, 0x4a, 0xf9, 0x5f, 0x09, 0x56, 0x6b,
-	0x47, 0x8d, 0x33, 0xec, 0x31, 0xab, 0x6b, 0xb9, 0xd8, 0x16, 0x9d, 0x36, 0x70, 0x6c, 0xeb, 0x82,
-	0x78, 0xa3, 0x46, 0x0e, 0x4d, 0x9e, 0xd0, 0x71, 0x89, 0x87, 0x99, 0xe3, 0x69, 0xa3, 0x66, 0x0c,
-	0x13, 0x8e, 0xf0, 0xf0, 0x74, 0xf0, 0xd0, 0xae, 0x63, 0x53, 0x62, 0x53, 0x9f, 0x6a, 0xae, 0xaf,
-	0x5f, 0x90, 0xab, 0xb0, 0x6f, 0xd7, 0xc6, 0xf8, 0x99, 0x80, 0x95, 0x2f, 0x92, 0x00, 0xb5, 0xa3,
-	0xc6, 0xe8, 0xfe, 0x8f, 0xfa, 0x42, 0x16, 0xc5, 0xa9, 0xc1, 0xb2, 0x1b, 0xb1, 0xe3, 0x09, 0x79,
-	0xcb, 0x95, 0x66, 0x0b, 0x3a, 0xbd, 0x0d, 0x75, 0x6a, 0x16, 0x7a, 0x08, 0xd9, 0x48, 0xa4, 0x40,
-	0x82, 0x08, 0x40, 0x7f, 0x87, 0xa5, 0x4b, 0xec, 0xf7, 0x99, 0xc6, 0x3f, 0xef, 0x34, 0x2f, 0x97,
-	0x92, 0x9f, 0x7a, 0x08, 0x80, 0x88, 0xe7, 0x43, 0x8a, 0xfe, 0x0c, 0x6b, 0xc4, 0xc6, 0x7a, 0x9f,
-	0x68, 0xcc, 0xc3, 0x36, 0x7d, 0x49, 0x3c, 0x71, 0xe2, 0x16, 0xd5, 0xd5, 0x00, 0xee, 0x84, 0x28,
-	0xfa, 0x13, 0x84, 0x85, 0xd1, 0x7c, 0x36, 0x74, 0x44, 0x35, 0xd2, 0x82, 0xca, 0x4a, 0x00, 0x9f,
-	0xb3, 0xa1, 0xd3, 0xf2, 0x07, 0xa8, 0x06, 0x40, 0x86, 0xae, 0xe5, 0x89, 0xfa, 0xdf, 0xf2, 0x3d,
-	0x20, 0x89, 0xeb, 0x6b, 0x62, 0x1e, 0xfa, 0x5b, 0xec, 0x35, 0xa0, 0xcc, 0x95, 0x2c, 0x94, 0x3d,
-	0xf6, 0x20, 0xf8, 0x4a, 0x82, 0xf5, 0xda, 0x51, 0x83, 0xbf, 0xb2, 0xfa, 0x84, 0xaf, 0x76, 0x5d,
-	0x75, 0x36, 0x20, 0x4d, 0x89, 0x6d, 0x10, 0x6f, 0x74, 0x74, 0x02, 0x8b, 0xe3, 0x42, 0x21, 0x7e,
-	0x5b, 0xf1, 0x07, 0x4f, 0x68, 0xcd, 0xed, 0x0b, 0x79, 0x6e, 0x5f, 0xf0, 0x92, 0xf1, 0x27, 0x14,
-	0x66, 0xbe, 0x47, 0xc2, 0x37, 0x68, 0x04, 0x28, 0xbf, 0x4a, 0x70, 0x4f, 0x25, 0x2f, 0x79, 0x05,
-	0x27, 0x1e, 0x80, 0x71, 0x7a, 0xf7, 0x21, 0x6d, 0x5c, 0x98, 0xfc, 0xe8, 0x04, 0x77, 0x69, 0xca,
-	0xb8, 0x30, 0x9b, 0x06, 0x7a, 0x02, 0xeb, 0x1e, 0x19, 0x38, 0x97, 0xc4, 0xd0, 0xa6, 0x7a, 0x2b,
-	0xe0, 0xfa, 0x87, 0xd0, 0x77, 0x36, 0xd9, 0x40, 0x27, 0xb0, 0x16, 0x69, 0x1b, 0x7c, 0x57, 0xe4,
-	0x3b, 0x7c, 0x57, 0x56, 0xa3, 0xc9, 0xe2, 0xf3, 0x12, 0x15, 0x27, 0x75, 0x5d, 0x71, 0xa2, 0xdd,
-	0xc5, 0x8a, 0xf3, 0x7f, 0x09, 0xd6, 0x23, 0x67, 0x54, 0xa3, 0x5b, 0x17, 0xe7, 0xf6, 0x87, 0x73,
-	0xba, 0x08, 0x72, 0xac, 0x08, 0x8f, 0xbf, 0x91, 0x60, 0x65, 0xea, 0x45, 0x89, 0x8a, 0x50, 0x68,
-	0x37, 0x1b, 0xad, 0x66, 0xab, 0xa1, 0xb5, 0x3b, 0xfb, 0x9d, 0xf3, 0xb6, 0x76, 0xde, 0x6a, 0x9f,
-	0xd5, 0xab, 0xcd, 0xc3, 0x66, 0xbd, 0x96, 0x5b, 0x40, 0x05, 0xd8, 0x88, 0xf9, 0xcf, 0xea, 0xad,
-	0x5a, 0xb3, 0xd5, 0xc8, 0x49, 0x73, 0xe6, 0x1e, 0xa8, 0xa7, 0xfb, 0xb5, 0xea, 0x7e, 0xbb, 0x53,
-	0xaf, 0xe5, 0x12, 0xe8, 0x21, 0xe4, 0x63, 0xfe, 0xea, 0x69, 0xeb, 0xb0, 0xa9, 0x9e, 0xd4, 0x6b,
-	0xb9, 0x24, 0xda, 0x84, 0xfb, 0x31, 0xef, 0xe1, 0x7e, 0xf3, 0xb8, 0x5e, 0xcb, 0xc9, 0x8f, 0xdf,
-	0x4a, 0x90, 0x8b, 0xb7, 0x3a, 0x52, 0xa0, 0x58, 0x3b, 0x6a, 0x68, 0x6a, 0xfd, 0x9f, 0xe7, 0xf5,
-	0x76, 0x67, 0x3e, 0xdb, 0x22, 0x14, 0xe6, 0xc4, 0x44, 0x8c, 0x4b, 0xf0, 0x70, 0x8e, 0xbf, 0x7a,
-	0x7a, 0x72, 0x76, 0x5c, 0x0f, 0x38, 0x6f, 0xc3, 0xe6, 0x9c, 0x88, 0x90, 0x59, 0x12, 0xed, 0xc0,
-	0xd6, 0x1c, 0x77, 0xa7, 0x79, 0x52, 0xaf, 0x9d, 0x9e, 0x77, 0x72, 0xf2, 0xe3, 0x2f, 0x25, 0xc8,
-	0xc5, 0x1b, 0x01, 0x3d, 0x82, 0x6d, 0xb5, 0x7e, 0xa8, 0xd6, 0xdb, 0xcf, 0xaf, 0xd5, 0x79, 0x1b,
-	0x36, 0x67, 0x43, 0x22, 0xe2, 0x3b, 0xb0, 0x35, 0xeb, 0x9e, 0xe4, 0x5d, 0x84, 0xc2, 0x6c, 0xc0,
-	0x98, 0x57, 0xf2, 0xe0, 0xf9, 0xbb, 0x0f, 0x45, 0xe9, 0xfd, 0x87, 0xa2, 0xf4, 0xf3, 0x87, 0xa2,
-	0xf4, 0xe6, 0x63, 0x71, 0xe1, 0xfd, 0xc7, 0xe2, 0xc2, 0x0f, 0x1f, 0x8b, 0x0b, 0xff, 0x29, 0x9b,
-	0x16, 0xeb, 0xf9, 0x7a, 0xb9, 0xeb, 0x0c, 0x2a, 0x41, 0x4f, 0xf7, 0xb1, 0x4e, 0xc3, 0x61, 0x65,
-	0x38, 0xf1, 0xcf, 0x2c, 0x2e, 0x5b, 0x3d, 0x2d, 0xce, 0xcd, 0xd3, 0xdf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0xf1, 0x62, 0x35, 0x15, 0x10, 0x00, 0x00,
+func (m *Relayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *FeeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Relayer) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Relayer) GetStatus() RelayerStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RelayerStatus_RELAYER_STATUS_UNSPECIFIED
 }
 
-func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+func (m *Relayer) GetRelayedDeposits() uint64 {
+	if m != nil {
+		return m.RelayedDeposits
 	}
-	if m.Value != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Relayer) GetTotalRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Relayer) GetSlashCount() uint64 {
+	if m != nil {
+		return m.SlashCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Relayer) GetUnbondingTime() time.Time {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return time.Time{}
 }
 
-func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
+// Runes deposit attestation made by the relayer
+type RunesAttestation struct {
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// relayer address
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// block height at which the attestation is made
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// indicates if the relayer has been slashed for the attestation
+	Slashed bool `protobuf:"varint,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
+}
+
+func (m *RunesAttestation) Reset()         { *m = RunesAttestation{} }
+func (m *RunesAttestation) String() string { return proto.CompactTextString(m) }
+func (*RunesAttestation) ProtoMessage()    {}
+func (*RunesAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{25}
+}
+func (m *RunesAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunesAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunesAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
-	if err1 != nil {
-		return 0, err1
+}
+func (m *RunesAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunesAttestation.Merge(m, src)
+}
+func (m *RunesAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunesAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunesAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunesAttestation proto.InternalMessageInfo
+
+func (m *RunesAttestation) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	i -= n1
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	if len(m.Psbt) > 0 {
-		i -= len(m.Psbt)
-		copy(dAtA[i:], m.Psbt)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Psbt)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *RunesAttestation) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *RunesAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.Type != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *RunesAttestation) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+// Pause
+type Pause struct {
+	// pause id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pause target
+	Target PauseTarget `protobuf:"varint,2,opt,name=target,proto3,enum=bitway.btcbridge.PauseTarget" json:"target,omitempty"`
+	// asset type; only set for PAUSE_TARGET_ASSET
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// address which initiated the pause
+	Initiator string `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// reason
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// start time
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// time at which the pause expires
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// number of times extended by governance
+	Extensions uint32 `protobuf:"varint,8,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	// status
+	Status PauseStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bitway.btcbridge.PauseStatus" json:"status,omitempty"`
+}
+
+func (m *Pause) Reset()         { *m = Pause{} }
+func (m *Pause) String() string { return proto.CompactTextString(m) }
+func (*Pause) ProtoMessage()    {}
+func (*Pause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{26}
+}
+func (m *Pause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Pause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pause.Merge(m, src)
+}
+func (m *Pause) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pause) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pause proto.InternalMessageInfo
+
+func (m *Pause) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CompactSigningRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Pause) GetTarget() PauseTarget {
+	if m != nil {
+		return m.Target
 	}
-	return dAtA[:n], nil
+	return PauseTarget_PAUSE_TARGET_UNSPECIFIED
 }
 
-func (m *CompactSigningRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Pause) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *CompactSigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
+func (m *Pause) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
-	if err2 != nil {
-		return 0, err2
+	return ""
+}
+
+func (m *Pause) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	i -= n2
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.SigHashes) > 0 {
-		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SigHashes[iNdEx])
-			copy(dAtA[i:], m.SigHashes[iNdEx])
-			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Type != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Pause) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Pause) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *Pause) GetExtensions() uint32 {
+	if m != nil {
+		return m.Extensions
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IBCWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Pause) GetStatus() PauseStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return PauseStatus_PAUSE_STATUS_UNSPECIFIED
 }
 
-func (m *IBCWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Screened address
+type ScreenedAddress struct {
+	// address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// screening source
+	Source ScreeningSource `protobuf:"varint,2,opt,name=source,proto3,enum=bitway.btcbridge.ScreeningSource" json:"source,omitempty"`
+	// reason
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block height at which the address is screened
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *IBCWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+func (m *ScreenedAddress) Reset()         { *m = ScreenedAddress{} }
+func (m *ScreenedAddress) String() string { return proto.CompactTextString(m) }
+func (*ScreenedAddress) ProtoMessage()    {}
+func (*ScreenedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{27}
+}
+func (m *ScreenedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScreenedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScreenedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ScreenedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScreenedAddress.Merge(m, src)
+}
+func (m *ScreenedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScreenedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScreenedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScreenedAddress proto.InternalMessageInfo
+
+func (m *ScreenedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ScreenedAddress) GetSource() ScreeningSource {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	return ScreeningSource_SCREENING_SOURCE_UNSPECIFIED
+}
+
+func (m *ScreenedAddress) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScreenedAddress) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Deposit credited to the quarantine account due to screening
+type QuarantinedDeposit struct {
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// deposit sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// intended recipient address
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// quarantined amount
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// block height at which the deposit is quarantined
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AddressRateLimit.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{28}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.GlobalRateLimit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GlobalRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuarantinedDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GlobalRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuarantinedDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *GlobalRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Used != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Used))
-		i--
-		dAtA[i] = 0x20
+func (m *QuarantinedDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Quota != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Quota))
-		i--
-		dAtA[i] = 0x18
+	return types.Coin{}
+}
+
+func (m *QuarantinedDeposit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err5 != nil {
-		return 0, err5
+	return 0
+}
+
+// Quarantined Withdrawal
+type QuarantinedWithdrawal struct {
+	// withdrawal request sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// withdrawal address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// quarantined amount
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// account which initiated the grouped withdrawal request; empty if not grouped
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// sequence group of the withdrawal request; 0 if not grouped
+	Group uint64 `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	// block height at which the withdrawal is quarantined
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuarantinedWithdrawal) Reset()         { *m = QuarantinedWithdrawal{} }
+func (m *QuarantinedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedWithdrawal) ProtoMessage()    {}
+func (*QuarantinedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{29}
+}
+func (m *QuarantinedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+}
+func (m *QuarantinedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedWithdrawal.Merge(m, src)
+}
+func (m *QuarantinedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedWithdrawal proto.InternalMessageInfo
+
+func (m *QuarantinedWithdrawal) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	i -= n6
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AddressRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *QuarantinedWithdrawal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuarantinedWithdrawal) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *QuarantinedWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuarantinedWithdrawal) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
+func (m *QuarantinedWithdrawal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Deposit Record
+type DepositRecord struct {
+	// deposit tx hash
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// output index of the deposit to the vault
+	Vout uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	// deposit sender address; empty if not resolved
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient address
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// asset type
+	Asset AssetType `protobuf:"varint,5,opt,name=asset,proto3,enum=bitway.btcbridge.AssetType" json:"asset,omitempty"`
+	// amount credited to the recipient
+	Amount types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// protocol deposit fee deducted
+	Fee types.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	// bitcoin block height of the deposit tx
+	BtcBlockHeight uint64 `protobuf:"varint,8,opt,name=btc_block_height,json=btcBlockHeight,proto3" json:"btc_block_height,omitempty"`
+	// block height at which the deposit is minted
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// indicates if the deposit is credited to the quarantine account
+	Quarantined bool `protobuf:"varint,10,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	// outcome of the IBC auto transfer if any
+	IbcTransfer *DepositIBCTransfer `protobuf:"bytes,11,opt,name=ibc_transfer,json=ibcTransfer,proto3" json:"ibc_transfer,omitempty"`
+}
+
+func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
+func (m *DepositRecord) String() string { return proto.CompactTextString(m) }
+func (*DepositRecord) ProtoMessage()    {}
+func (*DepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{30}
+}
+func (m *DepositRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositRecord.Merge(m, src)
+}
+func (m *DepositRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositRecord proto.InternalMessageInfo
+
+func (m *DepositRecord) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetVout() uint64 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetAsset() AssetType {
+	if m != nil {
+		return m.Asset
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (m *DepositRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *DepositRecord) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *DepositRecord) GetBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BtcBlockHeight
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
+	}
+	return false
+}
+
+func (m *DepositRecord) GetIbcTransfer() *DepositIBCTransfer {
+	if m != nil {
+		return m.IbcTransfer
+	}
+	return nil
+}
+
+// Deposit IBC Transfer
+type DepositIBCTransfer struct {
+	// channel id
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// recipient address on the counterparty chain
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// packet sequence; 0 if the transfer failed
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// error message if the transfer failed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DepositIBCTransfer) Reset()         { *m = DepositIBCTransfer{} }
+func (m *DepositIBCTransfer) String() string { return proto.CompactTextString(m) }
+func (*DepositIBCTransfer) ProtoMessage()    {}
+func (*DepositIBCTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f64c00fd58c2a9e, []int{31}
+}
+func (m *DepositIBCTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositIBCTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositIBCTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositIBCTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositIBCTransfer.Merge(m, src)
+}
+func (m *DepositIBCTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositIBCTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositIBCTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositIBCTransfer proto.InternalMessageInfo
+
+func (m *DepositIBCTransfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DepositIBCTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DepositIBCTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *DepositIBCTransfer) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("bitway.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
+	proto.RegisterEnum("bitway.btcbridge.DKGRequestStatus", DKGRequestStatus_name, DKGRequestStatus_value)
+	proto.RegisterEnum("bitway.btcbridge.RefreshingStatus", RefreshingStatus_name, RefreshingStatus_value)
+	proto.RegisterEnum("bitway.btcbridge.RelayerStatus", RelayerStatus_name, RelayerStatus_value)
+	proto.RegisterEnum("bitway.btcbridge.RelayerMisbehaviour", RelayerMisbehaviour_name, RelayerMisbehaviour_value)
+	proto.RegisterEnum("bitway.btcbridge.PauseTarget", PauseTarget_name, PauseTarget_value)
+	proto.RegisterEnum("bitway.btcbridge.PauseStatus", PauseStatus_name, PauseStatus_value)
+	proto.RegisterEnum("bitway.btcbridge.ScreeningSource", ScreeningSource_name, ScreeningSource_value)
+	proto.RegisterType((*FeeRate)(nil), "bitway.btcbridge.FeeRate")
+	proto.RegisterType((*SigningRequest)(nil), "bitway.btcbridge.SigningRequest")
+	proto.RegisterType((*CompactSigningRequest)(nil), "bitway.btcbridge.CompactSigningRequest")
+	proto.RegisterType((*WithdrawRequest)(nil), "bitway.btcbridge.WithdrawRequest")
+	proto.RegisterType((*IBCWithdrawRequest)(nil), "bitway.btcbridge.IBCWithdrawRequest")
+	proto.RegisterType((*RateLimit)(nil), "bitway.btcbridge.RateLimit")
+	proto.RegisterType((*GlobalRateLimit)(nil), "bitway.btcbridge.GlobalRateLimit")
+	proto.RegisterType((*AddressRateLimit)(nil), "bitway.btcbridge.AddressRateLimit")
+	proto.RegisterType((*AddressRateLimitDetails)(nil), "bitway.btcbridge.AddressRateLimitDetails")
+	proto.RegisterType((*UTXO)(nil), "bitway.btcbridge.UTXO")
+	proto.RegisterType((*RuneBalance)(nil), "bitway.btcbridge.RuneBalance")
+	proto.RegisterType((*RuneId)(nil), "bitway.btcbridge.RuneId")
+	proto.RegisterType((*FeeSponsorshipUsage)(nil), "bitway.btcbridge.FeeSponsorshipUsage")
+	proto.RegisterType((*FeeSponsorshipAccountUsage)(nil), "bitway.btcbridge.FeeSponsorshipAccountUsage")
+	proto.RegisterType((*RuneEtching)(nil), "bitway.btcbridge.RuneEtching")
+	proto.RegisterType((*RuneEtchingSubmission)(nil), "bitway.btcbridge.RuneEtchingSubmission")
+	proto.RegisterType((*Edict)(nil), "bitway.btcbridge.Edict")
+	proto.RegisterType((*BtcConsolidation)(nil), "bitway.btcbridge.BtcConsolidation")
+	proto.RegisterType((*RunesConsolidation)(nil), "bitway.btcbridge.RunesConsolidation")
+	proto.RegisterType((*DKGParticipant)(nil), "bitway.btcbridge.DKGParticipant")
+	proto.RegisterType((*DKGRequest)(nil), "bitway.btcbridge.DKGRequest")
+	proto.RegisterType((*DKGCompletionRequest)(nil), "bitway.btcbridge.DKGCompletionRequest")
+	proto.RegisterType((*RefreshingRequest)(nil), "bitway.btcbridge.RefreshingRequest")
+	proto.RegisterType((*RefreshingCompletion)(nil), "bitway.btcbridge.RefreshingCompletion")
+	proto.RegisterType((*Relayer)(nil), "bitway.btcbridge.Relayer")
+	proto.RegisterType((*RunesAttestation)(nil), "bitway.btcbridge.RunesAttestation")
+	proto.RegisterType((*Pause)(nil), "bitway.btcbridge.Pause")
+	proto.RegisterType((*ScreenedAddress)(nil), "bitway.btcbridge.ScreenedAddress")
+	proto.RegisterType((*QuarantinedDeposit)(nil), "bitway.btcbridge.QuarantinedDeposit")
+	proto.RegisterType((*QuarantinedWithdrawal)(nil), "bitway.btcbridge.QuarantinedWithdrawal")
+	proto.RegisterType((*DepositRecord)(nil), "bitway.btcbridge.DepositRecord")
+	proto.RegisterType((*DepositIBCTransfer)(nil), "bitway.btcbridge.DepositIBCTransfer")
+}
+
+func init() { proto.RegisterFile("bitway/btcbridge/btcbridge.proto", fileDescriptor_0f64c00fd58c2a9e) }
+
+var fileDescriptor_0f64c00fd58c2a9e = []byte{
+	// 2701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0x4b, 0x73, 0x23, 0x47,
+	0x79, 0x47, 0x2f, 0x5b, 0x9f, 0x5f, 0x4a, 0xaf, 0x77, 0x57, 0xf6, 0xae, 0x1f, 0x11, 0x01, 0x36,
+	0xae, 0x42, 0xce, 0x6e, 0x48, 0xa5, 0x48, 0x51, 0x45, 0xe9, 0x31, 0xf6, 0x4e, 0xad, 0x57, 0x56,
+	0x46, 0x92, 0x93, 0x70, 0x99, 0x6a, 0xcd, 0xf4, 0x4a, 0xc3, 0x4a, 0x33, 0xda, 0xe9, 0x1e, 0xaf,
+	0x7d, 0x0b, 0xc5, 0x21, 0xd7, 0x5c, 0xe0, 0x04, 0x87, 0x14, 0x70, 0xe1, 0x00, 0xd7, 0x70, 0xe4,
+	0x96, 0x03, 0x55, 0xe4, 0xc8, 0x89, 0x40, 0xf2, 0x07, 0x38, 0x70, 0xe0, 0x48, 0xf5, 0x43, 0xd2,
+	0xcc, 0x48, 0xf6, 0xda, 0x90, 0xa2, 0x72, 0xd2, 0x7c, 0xaf, 0xee, 0xef, 0xd5, 0x5f, 0x7f, 0x5f,
+	0x0b, 0x76, 0xbb, 0x2e, 0x7b, 0x81, 0xcf, 0xf7, 0xbb, 0xcc, 0xee, 0x06, 0xae, 0xd3, 0x23, 0xd3,
+	0xaf, 0xf2, 0x28, 0xf0, 0x99, 0x8f, 0x0a, 0x92, 0xa3, 0x3c, 0xc1, 0x6f, 0xae, 0xf7, 0xfc, 0x9e,
+	0x2f, 0x88, 0xfb, 0xfc, 0x4b, 0xf2, 0x6d, 0x6e, 0xf4, 0x7c, 0xbf, 0x37, 0x20, 0xfb, 0x02, 0xea,
+	0x86, 0x4f, 0xf7, 0xb1, 0x77, 0xae, 0x48, 0x3b, 0x49, 0x12, 0x73, 0x87, 0x84, 0x32, 0x3c, 0x1c,
+	0x29, 0x86, 0x6d, 0xdb, 0xa7, 0x43, 0x9f, 0xee, 0x77, 0x31, 0x25, 0xfb, 0xa7, 0x0f, 0xba, 0x84,
+	0xe1, 0x07, 0xfb, 0xb6, 0xef, 0x7a, 0xe3, 0xb5, 0x25, 0xdd, 0x92, 0x9b, 0x4a, 0x40, 0x91, 0xb6,
+	0x66, 0x0c, 0x18, 0xe1, 0x00, 0x0f, 0x15, 0xb9, 0xf4, 0x36, 0x2c, 0x1c, 0x10, 0x62, 0x62, 0x46,
+	0xd0, 0x3a, 0x64, 0x4f, 0xf1, 0x20, 0x24, 0x45, 0x6d, 0x57, 0xbb, 0x9f, 0x36, 0x25, 0x80, 0x6e,
+	0x43, 0xae, 0x4f, 0xdc, 0x5e, 0x9f, 0x15, 0x53, 0x02, 0xad, 0xa0, 0xd2, 0xaf, 0x53, 0xb0, 0xda,
+	0x72, 0x7b, 0x9e, 0xeb, 0xf5, 0x4c, 0xf2, 0x3c, 0x24, 0x94, 0xa1, 0x22, 0x2c, 0x60, 0xc7, 0x09,
+	0x08, 0xa5, 0x62, 0x89, 0xbc, 0x39, 0x06, 0xd1, 0x26, 0x2c, 0x52, 0xce, 0xe4, 0xd9, 0x44, 0x2c,
+	0x93, 0x31, 0x27, 0x30, 0xda, 0x87, 0x0c, 0x3b, 0x1f, 0x91, 0x62, 0x7a, 0x57, 0xbb, 0xbf, 0xfa,
+	0xf0, 0x6e, 0x39, 0xe9, 0xce, 0x72, 0x85, 0x52, 0xc2, 0xda, 0xe7, 0x23, 0x62, 0x0a, 0x46, 0x84,
+	0x20, 0xc3, 0xce, 0x5c, 0xa7, 0x98, 0x11, 0x7b, 0x88, 0x6f, 0x8e, 0x1b, 0xd1, 0x2e, 0x2b, 0x66,
+	0x25, 0x8e, 0x7f, 0x23, 0x03, 0x56, 0xec, 0x80, 0x60, 0xe6, 0xfa, 0x9e, 0xc5, 0x1d, 0x5a, 0xcc,
+	0xed, 0x6a, 0xf7, 0x97, 0x1e, 0x6e, 0x96, 0xa5, 0xb7, 0xcb, 0x63, 0x6f, 0x97, 0xdb, 0x63, 0x6f,
+	0x57, 0x17, 0x3f, 0xfb, 0xdb, 0xce, 0x8d, 0x8f, 0xbf, 0xd8, 0xd1, 0xcc, 0xe5, 0xb1, 0x28, 0x27,
+	0xa2, 0xb7, 0x21, 0x47, 0x19, 0x66, 0x21, 0x2d, 0x2e, 0x08, 0x2d, 0x77, 0x66, 0xb5, 0x54, 0xbe,
+	0x68, 0x09, 0x36, 0x53, 0xb1, 0x97, 0xfe, 0x92, 0x82, 0x5b, 0x35, 0x7f, 0x38, 0xc2, 0x36, 0xfb,
+	0xe6, 0x38, 0xab, 0x08, 0x0b, 0xd4, 0xed, 0x79, 0x24, 0xa0, 0xc5, 0xec, 0x6e, 0x9a, 0x6f, 0xad,
+	0x40, 0xb4, 0x05, 0x40, 0xdd, 0x9e, 0xd5, 0xc7, 0xb4, 0x4f, 0x68, 0x31, 0x27, 0x88, 0x79, 0xea,
+	0xf6, 0x1e, 0x09, 0xc4, 0xac, 0x47, 0x17, 0xbe, 0x06, 0x8f, 0x2e, 0x5e, 0xcf, 0xa3, 0x9f, 0x68,
+	0xb0, 0xf6, 0x9e, 0xcb, 0xfa, 0x4e, 0x80, 0x5f, 0xbc, 0xdc, 0x97, 0xb7, 0x21, 0x87, 0x87, 0x7e,
+	0xe8, 0xc9, 0xec, 0xcd, 0x9b, 0x0a, 0x8a, 0xf9, 0x38, 0x9d, 0xf0, 0xf1, 0x3c, 0x97, 0xad, 0x43,
+	0xb6, 0x17, 0xf8, 0xe1, 0x48, 0x24, 0x58, 0xc6, 0x94, 0x00, 0x5f, 0x9d, 0x12, 0xcf, 0x21, 0x81,
+	0x48, 0xad, 0xbc, 0xa9, 0xa0, 0xd2, 0x4f, 0x35, 0x40, 0x46, 0xb5, 0x96, 0x54, 0x73, 0x0b, 0xc0,
+	0xee, 0x63, 0xcf, 0x23, 0x03, 0xcb, 0x75, 0x94, 0xa6, 0x79, 0x85, 0x31, 0x9c, 0x4b, 0xe3, 0x1e,
+	0xb1, 0x30, 0x7d, 0x91, 0x85, 0x99, 0xa8, 0x85, 0xa5, 0x4f, 0x35, 0xc8, 0xf3, 0x63, 0x7d, 0xe4,
+	0x0e, 0x5d, 0x86, 0x5a, 0xf0, 0x4a, 0x6f, 0xe0, 0x77, 0xf1, 0xc0, 0x0a, 0x30, 0x23, 0xd6, 0x80,
+	0x23, 0x85, 0x06, 0x4b, 0x0f, 0x5f, 0x9d, 0xf5, 0xfc, 0xa1, 0x60, 0x9d, 0x48, 0x57, 0x33, 0x3c,
+	0x88, 0xe6, 0x5a, 0x2f, 0x8e, 0x46, 0x27, 0x80, 0x94, 0x16, 0xd1, 0x55, 0x53, 0x62, 0xd5, 0xd2,
+	0x9c, 0xd4, 0x94, 0xbc, 0xc9, 0x65, 0x0b, 0x38, 0x81, 0x2f, 0xfd, 0x49, 0x83, 0xb5, 0x84, 0x0a,
+	0xa8, 0x06, 0x40, 0x19, 0x0e, 0x98, 0xcc, 0x3b, 0xed, 0x1a, 0x79, 0x97, 0x17, 0x72, 0x9c, 0x82,
+	0x7e, 0x04, 0x8b, 0xc4, 0x73, 0xe4, 0x12, 0xa9, 0x6b, 0x2c, 0xb1, 0x40, 0x3c, 0x47, 0x2c, 0xb0,
+	0x0e, 0xd9, 0xe7, 0xa1, 0xcf, 0xb0, 0x08, 0x42, 0xda, 0x94, 0x00, 0x4f, 0x98, 0x90, 0x12, 0x99,
+	0x30, 0x69, 0x53, 0x7c, 0x97, 0x7e, 0xaf, 0x41, 0x21, 0x69, 0xf0, 0x37, 0xd9, 0x88, 0xd2, 0x21,
+	0xdc, 0x49, 0xea, 0x5b, 0x27, 0x0c, 0xbb, 0x03, 0x7a, 0xc9, 0xf1, 0x1a, 0x5b, 0x9e, 0x8a, 0x58,
+	0xfe, 0x4f, 0x0d, 0x32, 0x9d, 0xf6, 0xfb, 0xc7, 0x93, 0x73, 0xa4, 0xc5, 0xeb, 0xf4, 0xa9, 0x1f,
+	0x32, 0x95, 0xdf, 0xe2, 0xfb, 0xca, 0xb9, 0x9d, 0x99, 0x9c, 0xde, 0xe9, 0x9d, 0x24, 0x8f, 0xa3,
+	0x82, 0xd0, 0x6b, 0xb0, 0x3a, 0x0a, 0xbb, 0xd6, 0x33, 0x72, 0x6e, 0x51, 0x3b, 0x70, 0x47, 0x4c,
+	0x9c, 0xcb, 0x65, 0x73, 0x79, 0x14, 0x76, 0x1f, 0x93, 0xf3, 0x96, 0xc0, 0xa1, 0xbb, 0x90, 0x77,
+	0xa9, 0x35, 0xf0, 0xed, 0x67, 0xc4, 0x11, 0x15, 0x6c, 0xd1, 0x5c, 0x74, 0xe9, 0x91, 0x80, 0xd1,
+	0x9b, 0x90, 0x0d, 0x42, 0x8f, 0xf0, 0xb2, 0x94, 0xbe, 0xbf, 0xf4, 0x70, 0x6b, 0x36, 0x8d, 0xcd,
+	0xd0, 0x23, 0x55, 0x3c, 0xc0, 0x9e, 0x4d, 0x4c, 0xc9, 0x5b, 0x7a, 0x0b, 0x96, 0x22, 0x58, 0xb4,
+	0x0a, 0xa9, 0x89, 0xd9, 0x29, 0xd7, 0xb9, 0xa8, 0x08, 0x95, 0xca, 0x90, 0xe3, 0x62, 0x86, 0x28,
+	0x2f, 0x5d, 0xae, 0x90, 0x10, 0xca, 0x98, 0x12, 0xe0, 0xeb, 0xb0, 0x33, 0x21, 0xb3, 0x62, 0xa6,
+	0xd8, 0x59, 0xe9, 0xc3, 0x14, 0xdc, 0x3c, 0x20, 0xa4, 0x35, 0xf2, 0x3d, 0xea, 0x07, 0xb4, 0xef,
+	0x8e, 0x3a, 0x14, 0xf7, 0x08, 0x37, 0x68, 0xe4, 0x0f, 0x5c, 0xfb, 0x7c, 0x5a, 0x56, 0x16, 0x25,
+	0xc2, 0x70, 0x50, 0x03, 0x0a, 0x64, 0xe4, 0xdb, 0x7d, 0x2b, 0x92, 0x79, 0xd7, 0x49, 0x9b, 0x55,
+	0x21, 0xdd, 0x9a, 0xa4, 0x1f, 0x86, 0x2c, 0x1d, 0x11, 0x8f, 0x15, 0xd3, 0xc2, 0x41, 0x1b, 0x65,
+	0xd5, 0x6d, 0xf0, 0xd6, 0xa4, 0xac, 0x5a, 0x93, 0x72, 0xcd, 0x77, 0xbd, 0xea, 0x1b, 0x7c, 0x8d,
+	0xdf, 0x7d, 0xb1, 0x73, 0xbf, 0xe7, 0xb2, 0x7e, 0xd8, 0x2d, 0xdb, 0xfe, 0x50, 0xb5, 0x26, 0xea,
+	0xe7, 0x7b, 0xd4, 0x79, 0xb6, 0xcf, 0x6f, 0x29, 0x2a, 0x04, 0xa8, 0x29, 0x57, 0x46, 0x1b, 0xb0,
+	0xc8, 0xce, 0x2c, 0x3b, 0x12, 0xf8, 0x05, 0x76, 0x56, 0x13, 0x2e, 0xfb, 0x24, 0x05, 0x9b, 0x71,
+	0x17, 0x54, 0x6c, 0xc1, 0x78, 0x05, 0x4f, 0x44, 0xf2, 0x2c, 0x15, 0xcf, 0xb3, 0x79, 0x3e, 0x4a,
+	0x7f, 0x1d, 0x3e, 0xca, 0xfc, 0x5f, 0x7c, 0x94, 0x15, 0x19, 0x32, 0xf1, 0xd1, 0x47, 0x9a, 0x4c,
+	0x47, 0x9d, 0xd9, 0x7d, 0xd7, 0xeb, 0xcd, 0xa4, 0x23, 0x82, 0x8c, 0x87, 0x55, 0x16, 0xe4, 0x4d,
+	0xf1, 0x2d, 0x5a, 0x82, 0x11, 0xb6, 0x79, 0x4b, 0x90, 0x96, 0xab, 0x29, 0x50, 0xdc, 0x71, 0xe7,
+	0xc3, 0xae, 0x3f, 0x18, 0xdf, 0x2f, 0x12, 0x42, 0x25, 0x58, 0x76, 0xdc, 0x53, 0x97, 0xba, 0x5d,
+	0x77, 0xe0, 0xb2, 0x73, 0xa5, 0x44, 0x0c, 0x57, 0xfa, 0x09, 0xdc, 0x8a, 0x28, 0xd2, 0x0a, 0xbb,
+	0x43, 0x97, 0x52, 0xd7, 0xf7, 0xf8, 0x76, 0x01, 0x19, 0xe0, 0x73, 0x12, 0x8c, 0x2b, 0x8a, 0x02,
+	0xd1, 0xdb, 0xb0, 0x40, 0x24, 0xbb, 0xca, 0xd2, 0x0b, 0x4e, 0xa0, 0x5a, 0xd3, 0x1c, 0x73, 0x97,
+	0x30, 0x64, 0x75, 0xc7, 0xb5, 0x19, 0xba, 0x3f, 0x31, 0x77, 0xe9, 0x61, 0x71, 0xbe, 0xb0, 0xe1,
+	0x5c, 0x76, 0x2e, 0x39, 0xde, 0x0f, 0xd9, 0x28, 0x64, 0xca, 0x17, 0x0a, 0x2a, 0x9d, 0x40, 0xa1,
+	0xca, 0xec, 0x1a, 0x4f, 0xbd, 0x81, 0xeb, 0x88, 0x5e, 0x06, 0xbd, 0x0e, 0x05, 0x86, 0x83, 0x1e,
+	0x61, 0x16, 0xeb, 0x07, 0x84, 0xf6, 0xfd, 0x81, 0xa3, 0xfa, 0xe7, 0x35, 0x89, 0x6f, 0x8f, 0xd1,
+	0xe8, 0x0e, 0x2c, 0x0c, 0xf1, 0x99, 0xe5, 0x85, 0x43, 0x75, 0xa6, 0x73, 0x43, 0x7c, 0xd6, 0x08,
+	0x87, 0xa5, 0xe7, 0x80, 0xb8, 0x56, 0x34, 0xbe, 0xf2, 0x1d, 0x58, 0xe0, 0xd5, 0x65, 0x9a, 0xc9,
+	0xb9, 0x40, 0x16, 0x8b, 0x79, 0x5b, 0x4a, 0x03, 0x2e, 0xdb, 0x32, 0x1d, 0xdb, 0xf2, 0x43, 0x0d,
+	0x56, 0xeb, 0x8f, 0x0f, 0x9b, 0x38, 0x60, 0xae, 0xed, 0x8e, 0xb0, 0x27, 0xca, 0xf0, 0xd0, 0xf7,
+	0xdc, 0x67, 0xd3, 0x98, 0x28, 0x90, 0x6f, 0xe8, 0x8f, 0x48, 0x80, 0x99, 0x1f, 0x58, 0xf1, 0x13,
+	0xb4, 0x36, 0xc6, 0xab, 0xab, 0x83, 0xb3, 0xda, 0xbe, 0x47, 0x89, 0x47, 0x43, 0x6a, 0x8d, 0xc2,
+	0xee, 0x33, 0x72, 0xae, 0x8a, 0xfa, 0xda, 0x04, 0xdf, 0x14, 0xe8, 0xd2, 0x3f, 0xd2, 0x00, 0xf5,
+	0xc7, 0x87, 0xe3, 0xe6, 0x68, 0x9a, 0xa5, 0x19, 0x11, 0x9c, 0x3a, 0x2c, 0x8f, 0xa6, 0xda, 0xf1,
+	0x0d, 0xf9, 0x51, 0xda, 0x9d, 0x0d, 0x68, 0xdc, 0x0c, 0x33, 0x26, 0x85, 0xee, 0x41, 0x7e, 0xea,
+	0x24, 0xe9, 0x82, 0x29, 0x02, 0xfd, 0x10, 0x96, 0x4e, 0x71, 0x38, 0x60, 0x96, 0x38, 0x60, 0xe2,
+	0xb4, 0xbe, 0xa4, 0xa9, 0x06, 0xc1, 0xcf, 0x3f, 0x29, 0xfa, 0x2e, 0xac, 0x11, 0x0f, 0x77, 0x07,
+	0xc4, 0x62, 0x01, 0xf6, 0xe8, 0x53, 0x12, 0x88, 0x43, 0xb0, 0x68, 0xae, 0x4a, 0x74, 0x5b, 0x61,
+	0xd1, 0x77, 0x40, 0x05, 0xc6, 0x0a, 0xd9, 0x99, 0x2f, 0xa2, 0x91, 0x13, 0xaa, 0xac, 0x48, 0x74,
+	0x87, 0x9d, 0xf9, 0x8d, 0x70, 0x88, 0xea, 0x00, 0xe4, 0x6c, 0xe4, 0x06, 0x22, 0xfe, 0x57, 0xec,
+	0xad, 0x35, 0x51, 0x80, 0x22, 0x72, 0xe8, 0x9d, 0x44, 0x67, 0x5d, 0x9a, 0xeb, 0x32, 0xe5, 0xf6,
+	0x78, 0x73, 0x8d, 0x1a, 0xb0, 0x16, 0x10, 0xdb, 0x3f, 0x25, 0xc1, 0xb9, 0x25, 0xc7, 0xc4, 0x62,
+	0x5e, 0xa8, 0xf1, 0xed, 0xd9, 0x45, 0x4e, 0xb8, 0x27, 0x4c, 0xc5, 0xdd, 0x14, 0xcc, 0xe6, 0x6a,
+	0x10, 0x83, 0x4b, 0x5f, 0x68, 0xb0, 0x5e, 0x7f, 0x7c, 0xc8, 0x27, 0xa0, 0x01, 0xe1, 0xda, 0x5d,
+	0x14, 0xed, 0x69, 0x27, 0x9d, 0x8a, 0x76, 0xd2, 0x1c, 0x2f, 0x3c, 0x4e, 0xc5, 0x75, 0x93, 0x37,
+	0x15, 0x34, 0x37, 0xcf, 0x32, 0x73, 0xf3, 0x8c, 0xa7, 0x00, 0x1f, 0x6f, 0x30, 0x0b, 0x03, 0xa2,
+	0xe6, 0xc3, 0x29, 0x02, 0x7d, 0x0b, 0x56, 0x5c, 0x8f, 0x91, 0xc0, 0xc3, 0x03, 0xde, 0x37, 0x8c,
+	0x87, 0x9e, 0xe5, 0x31, 0xf2, 0x31, 0x39, 0xa7, 0xbc, 0xd8, 0xaa, 0xbe, 0x82, 0x0f, 0x80, 0x62,
+	0x62, 0x92, 0x1d, 0x05, 0x2d, 0xfd, 0x5b, 0x83, 0x57, 0x4c, 0xf2, 0x94, 0x67, 0x54, 0x64, 0xb8,
+	0x4b, 0x9a, 0x77, 0x0b, 0x72, 0xce, 0xb3, 0x1e, 0x3f, 0xca, 0xb2, 0xf1, 0xc9, 0x3a, 0xcf, 0x7a,
+	0x86, 0x83, 0x1e, 0xc0, 0x7a, 0x40, 0x86, 0xfe, 0x29, 0x71, 0xac, 0x58, 0xae, 0x4b, 0x5b, 0x6f,
+	0x2a, 0x5a, 0x24, 0xbb, 0x29, 0x7a, 0x02, 0x6b, 0xd3, 0x58, 0xcb, 0x9b, 0x2a, 0x73, 0xad, 0x9b,
+	0x6a, 0x22, 0xcc, 0xc9, 0x91, 0x64, 0xc9, 0x5e, 0x94, 0x2c, 0x53, 0xeb, 0x12, 0x93, 0xd8, 0x47,
+	0x1a, 0xac, 0x4f, 0x89, 0xd3, 0x18, 0x5f, 0x39, 0xb8, 0x57, 0x2f, 0x16, 0xf1, 0x20, 0x66, 0x12,
+	0x41, 0x2c, 0xfd, 0x32, 0x0d, 0x0b, 0xa6, 0xba, 0x40, 0x2e, 0x6e, 0x56, 0xdf, 0x84, 0x4c, 0xd7,
+	0xf7, 0x1c, 0x75, 0xaf, 0x5c, 0x72, 0x29, 0xcb, 0xb9, 0x44, 0x30, 0x47, 0xe6, 0xd4, 0xf4, 0x45,
+	0x73, 0xaa, 0xda, 0x39, 0x71, 0x94, 0x5e, 0x87, 0x82, 0xbc, 0xd3, 0x1c, 0xcb, 0x21, 0x23, 0x9f,
+	0xba, 0x8c, 0xaa, 0x66, 0x66, 0x4d, 0xe1, 0xeb, 0x0a, 0x8d, 0x46, 0xb0, 0xc2, 0x7c, 0xc6, 0x67,
+	0x33, 0xf2, 0x02, 0x07, 0x8e, 0x9c, 0xca, 0xbf, 0xe6, 0xb6, 0x61, 0x59, 0xec, 0x60, 0xca, 0x0d,
+	0xd0, 0x0e, 0x2c, 0xd1, 0x01, 0xa6, 0x7d, 0xd5, 0x40, 0xe4, 0x84, 0x5e, 0x20, 0x50, 0xa2, 0x87,
+	0x40, 0x8f, 0x61, 0x35, 0xf4, 0xb8, 0x03, 0x5c, 0xaf, 0x77, 0xfd, 0x51, 0x7f, 0x65, 0x22, 0xcb,
+	0xa9, 0xa5, 0x00, 0x0a, 0xe2, 0x7e, 0xab, 0x30, 0xc6, 0x59, 0x45, 0x8e, 0xcc, 0x1b, 0x0e, 0x22,
+	0x5d, 0x41, 0x2a, 0xde, 0x15, 0x4c, 0x1b, 0xfe, 0x74, 0xf4, 0x11, 0x8a, 0x4b, 0x08, 0xa5, 0xd5,
+	0xf0, 0xb5, 0x68, 0x8e, 0xc1, 0xd2, 0xaf, 0xd2, 0x90, 0x6d, 0xe2, 0x90, 0x92, 0x99, 0x6c, 0x7c,
+	0x0b, 0x72, 0xb2, 0xec, 0x8a, 0x4d, 0x56, 0xe7, 0x35, 0x18, 0x42, 0xb0, 0x2d, 0x98, 0x4c, 0xc5,
+	0x8c, 0xde, 0x01, 0xc0, 0x94, 0x12, 0x79, 0x57, 0x5c, 0xe5, 0xfd, 0x25, 0x8f, 0xc7, 0x9f, 0x3c,
+	0x7b, 0x5d, 0xcf, 0x65, 0x2e, 0xbf, 0x29, 0xc7, 0xd9, 0x3b, 0x41, 0x70, 0xe3, 0x02, 0x82, 0xa9,
+	0xef, 0xa9, 0xea, 0xa4, 0xa0, 0xc4, 0xb4, 0x98, 0xfb, 0xdf, 0xa7, 0xc5, 0x85, 0xff, 0x66, 0x5a,
+	0xdc, 0xe6, 0x97, 0x12, 0x23, 0x1e, 0xef, 0xdb, 0xe4, 0x95, 0xb2, 0x62, 0x46, 0x30, 0xdc, 0x9d,
+	0xea, 0x80, 0xe4, 0x2f, 0x75, 0x67, 0xa2, 0x78, 0xfc, 0x42, 0x83, 0xb5, 0x96, 0x1d, 0x10, 0xe2,
+	0x11, 0x67, 0xdc, 0x3c, 0x5c, 0x7c, 0x74, 0x7f, 0x00, 0x39, 0xea, 0x87, 0x81, 0x7a, 0x18, 0x59,
+	0x9d, 0xf7, 0x66, 0x21, 0x17, 0xe3, 0x55, 0x4a, 0x30, 0x9a, 0x4a, 0x20, 0xe2, 0xdd, 0x74, 0xcc,
+	0xbb, 0xd3, 0x94, 0xca, 0xc4, 0xde, 0x35, 0xff, 0xa0, 0x01, 0x7a, 0x37, 0xc4, 0x01, 0xf6, 0x98,
+	0xeb, 0x4d, 0x0e, 0xe9, 0xdc, 0x7c, 0xbd, 0xa8, 0xae, 0xdd, 0x83, 0x7c, 0x40, 0x6c, 0x77, 0xe4,
+	0xca, 0x31, 0x49, 0x84, 0x7b, 0x82, 0xe0, 0x15, 0x25, 0x32, 0xd4, 0x5e, 0xa1, 0x10, 0xcd, 0x9f,
+	0x7a, 0xa7, 0x1a, 0xff, 0x59, 0x83, 0x5b, 0x11, 0x8d, 0xc7, 0xaf, 0x4e, 0x78, 0x10, 0x7b, 0x51,
+	0xd2, 0x2e, 0x7e, 0x51, 0x4a, 0x4c, 0x43, 0x53, 0x05, 0xd3, 0xd7, 0x56, 0x50, 0xf9, 0x23, 0x13,
+	0xf3, 0xc7, 0x85, 0x8f, 0x67, 0xca, 0x9c, 0x5c, 0xcc, 0x9c, 0x3f, 0xa6, 0x61, 0x45, 0x79, 0x9d,
+	0x77, 0x17, 0x81, 0x73, 0xe5, 0x87, 0x84, 0xe9, 0xfe, 0xe9, 0x8b, 0xe3, 0x91, 0x49, 0xc6, 0xe3,
+	0x01, 0x64, 0xc5, 0x49, 0x2d, 0x66, 0x5f, 0x7e, 0xa6, 0x25, 0x67, 0xc4, 0x43, 0xb9, 0xeb, 0x79,
+	0xe8, 0x01, 0xa4, 0x9f, 0x92, 0xf1, 0x41, 0x7c, 0xa9, 0x14, 0xe7, 0x45, 0xf7, 0xa1, 0xd0, 0x65,
+	0xb6, 0x25, 0x5e, 0x04, 0x2c, 0xe5, 0xb0, 0x45, 0x61, 0xf4, 0x6a, 0x97, 0xd9, 0x55, 0x8e, 0x7e,
+	0x24, 0xb0, 0x11, 0x87, 0xe6, 0x63, 0x45, 0x72, 0x17, 0x96, 0x9e, 0x4f, 0xd3, 0xa3, 0x08, 0xa2,
+	0x50, 0x46, 0x51, 0xe8, 0x10, 0x96, 0xdd, 0xae, 0x3d, 0x6d, 0x63, 0x97, 0x84, 0x7e, 0xaf, 0xcd,
+	0x69, 0x1c, 0x65, 0x5c, 0x8c, 0x6a, 0x6d, 0xdc, 0xdc, 0x9a, 0x4b, 0x6e, 0xd7, 0x1e, 0x03, 0xa5,
+	0x9f, 0x69, 0x80, 0x66, 0x79, 0x5e, 0xf6, 0xf0, 0x19, 0x8b, 0x4f, 0x2a, 0x19, 0x9f, 0xcb, 0x9e,
+	0x6a, 0xd7, 0x21, 0x4b, 0x82, 0x60, 0x52, 0x54, 0x25, 0xb0, 0xf7, 0x1b, 0x0d, 0x56, 0x62, 0x8f,
+	0xc7, 0x68, 0x1b, 0x36, 0x5b, 0xc6, 0x61, 0xc3, 0x68, 0x1c, 0x5a, 0xad, 0x76, 0xa5, 0xdd, 0x69,
+	0x59, 0x9d, 0x46, 0xab, 0xa9, 0xd7, 0x8c, 0x03, 0x43, 0xaf, 0x17, 0x6e, 0xa0, 0x4d, 0xb8, 0x9d,
+	0xa0, 0x37, 0xf5, 0x46, 0xdd, 0x68, 0x1c, 0x16, 0xb4, 0x39, 0xb2, 0x55, 0xf3, 0xb8, 0x52, 0xaf,
+	0x55, 0x5a, 0x6d, 0xbd, 0x5e, 0x48, 0xa1, 0x7b, 0x50, 0x4c, 0xd0, 0x6b, 0xc7, 0x8d, 0x03, 0xc3,
+	0x7c, 0xa2, 0xd7, 0x0b, 0x69, 0xb4, 0x01, 0xb7, 0x12, 0xd4, 0x83, 0x8a, 0x71, 0xa4, 0xd7, 0x0b,
+	0x99, 0xbd, 0x4f, 0x35, 0x28, 0x24, 0x3b, 0x71, 0x54, 0x82, 0xed, 0xfa, 0xe3, 0x43, 0xcb, 0xd4,
+	0xdf, 0xed, 0xe8, 0xad, 0xf6, 0x7c, 0x6d, 0xb7, 0x61, 0x73, 0x0e, 0xcf, 0x54, 0xe3, 0x5d, 0xb8,
+	0x37, 0x87, 0x5e, 0x3b, 0x7e, 0xd2, 0x3c, 0xd2, 0xa5, 0xce, 0x5b, 0xb0, 0x31, 0x87, 0x43, 0x69,
+	0x96, 0x46, 0x3b, 0x70, 0x77, 0x0e, 0xb9, 0x6d, 0x3c, 0xd1, 0xeb, 0xc7, 0x9d, 0x76, 0x21, 0xb3,
+	0xf7, 0x73, 0x0d, 0x0a, 0xc9, 0xbe, 0x10, 0xbd, 0x0a, 0x5b, 0xa6, 0x7e, 0x60, 0xea, 0xad, 0x47,
+	0x17, 0xfa, 0x79, 0x0b, 0x36, 0x66, 0x59, 0xa6, 0x8a, 0xef, 0xc0, 0xdd, 0x59, 0x72, 0x54, 0xef,
+	0x6d, 0xd8, 0x9c, 0x65, 0x98, 0xe8, 0x95, 0xde, 0xeb, 0xc3, 0x4a, 0xac, 0x1b, 0x93, 0x02, 0x47,
+	0x95, 0x0f, 0x74, 0x73, 0xbe, 0x42, 0x1b, 0x70, 0x2b, 0x41, 0xaf, 0x1e, 0x37, 0xea, 0x7a, 0xbd,
+	0xa0, 0xf1, 0xb8, 0xce, 0x88, 0x56, 0x8f, 0xa5, 0xaa, 0xa9, 0xbd, 0xdf, 0x6a, 0x70, 0x53, 0x6d,
+	0xf5, 0xc4, 0xa5, 0x5d, 0xd2, 0xc7, 0xa7, 0xae, 0x1f, 0x06, 0xe8, 0x35, 0xd8, 0x1d, 0x4b, 0x3d,
+	0x31, 0x5a, 0x55, 0xfd, 0x51, 0xe5, 0xc4, 0x38, 0xee, 0x98, 0x89, 0x6d, 0x1f, 0x42, 0x79, 0x2e,
+	0x97, 0xd1, 0x38, 0xa9, 0x1c, 0x19, 0x75, 0xcb, 0xec, 0x34, 0xf4, 0x96, 0x55, 0x69, 0xb7, 0x75,
+	0xbe, 0xb5, 0x71, 0xdc, 0x28, 0x68, 0xe8, 0xfb, 0xf0, 0xc6, 0x5c, 0x99, 0x7a, 0xa7, 0x79, 0x64,
+	0xd4, 0x2a, 0x6d, 0x7d, 0x8e, 0x54, 0x6a, 0xef, 0x5f, 0x1a, 0x2c, 0x45, 0xda, 0x19, 0x6e, 0x55,
+	0xb3, 0xd2, 0x69, 0xe9, 0x56, 0xbb, 0x62, 0x1e, 0xea, 0xed, 0x84, 0x5e, 0x45, 0x58, 0x8f, 0x51,
+	0xeb, 0x7a, 0xf3, 0xb8, 0x65, 0xb4, 0x0b, 0x1a, 0x77, 0x54, 0x8c, 0xf2, 0x9e, 0xd1, 0x7e, 0x54,
+	0x37, 0x2b, 0xef, 0x15, 0x52, 0xe8, 0x2e, 0xdc, 0x89, 0x91, 0x8c, 0x6a, 0xcd, 0x6a, 0xea, 0x87,
+	0x22, 0x22, 0x3c, 0xa4, 0x31, 0xe2, 0x49, 0xa5, 0x73, 0xd4, 0xb6, 0xda, 0x66, 0xa5, 0xd1, 0x3a,
+	0xd0, 0xcd, 0x42, 0x06, 0xdd, 0x06, 0x14, 0x63, 0xa8, 0xb4, 0x5a, 0x7a, 0xbb, 0x90, 0xe5, 0x91,
+	0x8b, 0xe1, 0x8f, 0x64, 0x96, 0x58, 0x95, 0x66, 0xf3, 0xe8, 0x83, 0x42, 0x6e, 0xc6, 0x90, 0x23,
+	0xe3, 0xdd, 0x8e, 0x51, 0x97, 0x66, 0x2f, 0xec, 0xbd, 0x50, 0x56, 0xab, 0x34, 0x98, 0x30, 0xcf,
+	0x4d, 0x82, 0x3b, 0x70, 0x33, 0x46, 0xad, 0xd4, 0xda, 0xc6, 0x89, 0x5e, 0xd0, 0xa6, 0xee, 0x50,
+	0x04, 0xfd, 0xfd, 0xa6, 0x61, 0x8a, 0x44, 0x4c, 0x8a, 0x1c, 0x19, 0x07, 0x3c, 0x43, 0xd3, 0x7b,
+	0x74, 0xdc, 0xd6, 0x4c, 0x3a, 0x11, 0x7e, 0x1c, 0x5b, 0x35, 0x53, 0xd7, 0x65, 0x11, 0x38, 0xee,
+	0x98, 0x35, 0x3d, 0xa1, 0xc0, 0x0e, 0xdc, 0x9d, 0xe1, 0x38, 0x3c, 0x3e, 0xd1, 0xcd, 0x46, 0xa5,
+	0x51, 0xe3, 0x8a, 0x6c, 0xc1, 0xc6, 0x0c, 0x83, 0x44, 0xe8, 0x66, 0x21, 0x55, 0x7d, 0xf4, 0xd9,
+	0x97, 0xdb, 0xda, 0xe7, 0x5f, 0x6e, 0x6b, 0x7f, 0xff, 0x72, 0x5b, 0xfb, 0xf8, 0xab, 0xed, 0x1b,
+	0x9f, 0x7f, 0xb5, 0x7d, 0xe3, 0xaf, 0x5f, 0x6d, 0xdf, 0xf8, 0x71, 0x39, 0x32, 0x20, 0xc8, 0x6a,
+	0x3e, 0xc0, 0x5d, 0xaa, 0x3e, 0xf7, 0xcf, 0x22, 0xff, 0x0a, 0x8b, 0x61, 0xa1, 0x9b, 0x13, 0x4d,
+	0xe1, 0x9b, 0xff, 0x19, 0x00, 0x1a, 0x39, 0x5b, 0x2c, 0xf7, 0x1e, 0x00, 0x00,
+}
+
+func (m *FeeRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2085,41 +3079,30 @@ func (m *AddressRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddressRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddressRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Quota != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Quota))
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x18
-	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err7 != nil {
-		return 0, err7
+		dAtA[i] = 0x10
 	}
-	i -= n7
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x12
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err8 != nil {
-		return 0, err8
+	if m.Value != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	i -= n8
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AddressRateLimitDetails) Marshal() (dAtA []byte, err error) {
+func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2129,18 +3112,50 @@ func (m *AddressRateLimitDetails) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddressRateLimitDetails) MarshalTo(dAtA []byte) (int, error) {
+func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddressRateLimitDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Used != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Used))
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2154,7 +3169,7 @@ func (m *AddressRateLimitDetails) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UTXO) Marshal() (dAtA []byte, err error) {
+func (m *CompactSigningRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2164,80 +3179,75 @@ func (m *UTXO) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactSigningRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactSigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Runes) > 0 {
-		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
-			}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SigHashes) > 0 {
+		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigHashes[iNdEx])
+			copy(dAtA[i:], m.SigHashes[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x32
 		}
 	}
-	if m.IsLocked {
-		i--
-		if m.IsLocked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x38
 	}
-	if len(m.PubKeyScript) > 0 {
-		i -= len(m.PubKeyScript)
-		copy(dAtA[i:], m.PubKeyScript)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeyScript)))
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	if m.Height != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+	if m.Type != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x18
 	}
-	if m.Amount != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
 		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Vout != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Vout))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2247,16 +3257,40 @@ func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RuneBalance) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Group != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -2264,17 +3298,17 @@ func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RuneId) Marshal() (dAtA []byte, err error) {
+func (m *IBCWithdrawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2284,30 +3318,46 @@ func (m *RuneId) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RuneId) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RuneId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tx != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Tx))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Block != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Block))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ChannelId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Edict) Marshal() (dAtA []byte, err error) {
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2317,44 +3367,40 @@ func (m *Edict) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Edict) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Edict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Output != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Output))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.AddressRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GlobalRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BtcConsolidation) Marshal() (dAtA []byte, err error) {
+func (m *GlobalRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2364,32 +3410,48 @@ func (m *BtcConsolidation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BtcConsolidation) MarshalTo(dAtA []byte) (int, error) {
+func (m *GlobalRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BtcConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GlobalRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+	if m.Used != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Used))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.TargetThreshold != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetThreshold))
+	if m.Quota != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Quota))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RunesConsolidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -2397,39 +3459,41 @@ func (m *RunesConsolidation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunesConsolidation) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddressRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunesConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddressRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+	if m.Quota != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Quota))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.TargetThreshold) > 0 {
-		i -= len(m.TargetThreshold)
-		copy(dAtA[i:], m.TargetThreshold)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.TargetThreshold)))
-		i--
-		dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if len(m.RuneId) > 0 {
-		i -= len(m.RuneId)
-		copy(dAtA[i:], m.RuneId)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RuneId)))
-		i--
-		dAtA[i] = 0xa
+	i -= n7
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
 	}
+	i -= n8
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
+func (m *AddressRateLimitDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2439,41 +3503,32 @@ func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddressRateLimitDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddressRateLimitDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+	if m.Used != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Used))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Moniker)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
+func (m *UTXO) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2483,92 +3538,80 @@ func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Expiration != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err10 != nil {
-			return 0, err10
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		i -= n10
-		i = encodeVarintBtcbridge(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
 	}
-	if m.EnableTransfer {
+	if m.IsLocked {
 		i--
-		if m.EnableTransfer {
+		if m.IsLocked {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PubKeyScript) > 0 {
+		i -= len(m.PubKeyScript)
+		copy(dAtA[i:], m.PubKeyScript)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeyScript)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.VaultTypes) > 0 {
-		dAtA12 := make([]byte, len(m.VaultTypes)*10)
-		var j11 int
-		for _, num := range m.VaultTypes {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintBtcbridge(dAtA, i, uint64(j11))
+	if m.Amount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.Threshold != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.Vout != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DKGCompletionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2578,55 +3621,34 @@ func (m *DKGCompletionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DKGCompletionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuneBalance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DKGCompletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Vaults[iNdEx])
-			copy(dAtA[i:], m.Vaults[iNdEx])
-			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Vaults[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshingRequest) Marshal() (dAtA []byte, err error) {
+func (m *RuneId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2636,52 +3658,30 @@ func (m *RefreshingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuneId) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RuneId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
-	if err13 != nil {
-		return 0, err13
-	}
-	i -= n13
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n13))
-	i--
-	dAtA[i] = 0x22
-	if len(m.RemovedParticipants) > 0 {
-		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedParticipants[iNdEx])
-			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
-			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.DkgId != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.DkgId))
+	if m.Tx != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Tx))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+	if m.Block != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Block))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshingCompletion) Marshal() (dAtA []byte, err error) {
+func (m *FeeSponsorshipUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2691,530 +3691,4144 @@ func (m *RefreshingCompletion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshingCompletion) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeSponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshingCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeSponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
+	if m.TxCount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TxCount))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+	i -= n9
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PolicyId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBtcbridge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtcbridge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeSponsorshipAccountUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *FeeRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Value))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Height))
-	}
-	return n
+
+func (m *FeeSponsorshipAccountUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SigningRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeSponsorshipAccountUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	if m.TxCount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Type != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Type))
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.Psbt)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	i -= n10
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CompactSigningRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RuneEtching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneEtching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneEtching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
-	}
-	if m.Type != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Type))
+	if m.Divisibility != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Divisibility))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovBtcbridge(uint64(l))
-		}
+	if m.Spacers != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Spacers))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.SigHashes) > 0 {
-		for _, s := range m.SigHashes {
-			l = len(s)
-			n += 1 + l + sovBtcbridge(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
-	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+func (m *RuneEtchingSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IBCWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RuneEtchingSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneEtchingSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Etching != nil {
+		{
+			size, err := m.Etching.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Edict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GlobalRateLimit.Size()
-	n += 1 + l + sovBtcbridge(uint64(l))
-	l = m.AddressRateLimit.Size()
-	n += 1 + l + sovBtcbridge(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GlobalRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Quota != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Quota))
-	}
-	if m.Used != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Used))
-	}
-	return n
+func (m *Edict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddressRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Edict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Quota != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Quota))
+	if m.Output != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Output))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddressRateLimitDetails) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BtcConsolidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BtcConsolidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BtcConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.MaxNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Used != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Used))
+	if m.TargetThreshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetThreshold))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UTXO) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RunesConsolidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RunesConsolidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunesConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Vout != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Vout))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Amount))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Height))
-	}
-	l = len(m.PubKeyScript)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.MaxNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.IsLocked {
-		n += 2
+	if len(m.TargetThreshold) > 0 {
+		i -= len(m.TargetThreshold)
+		copy(dAtA[i:], m.TargetThreshold)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.TargetThreshold)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Runes) > 0 {
-		for _, e := range m.Runes {
-			l = e.Size()
-			n += 1 + l + sovBtcbridge(uint64(l))
-		}
+	if len(m.RuneId) > 0 {
+		i -= len(m.RuneId)
+		copy(dAtA[i:], m.RuneId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RuneId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RuneBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RuneId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Block))
-	}
-	if m.Tx != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Tx))
-	}
-	return n
+func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Edict) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Output != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Output))
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BtcConsolidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TargetThreshold != 0 {
-		n += 1 + sovBtcbridge(uint64(m.TargetThreshold))
-	}
-	if m.MaxNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RunesConsolidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RuneId)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.RecoveryParams != nil {
+		{
+			size, err := m.RecoveryParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.TargetThreshold)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MaxNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	if m.Expiration != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintBtcbridge(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *DKGParticipant) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.EnableTransfer {
+		i--
+		if m.EnableTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.VaultTypes) > 0 {
+		dAtA16 := make([]byte, len(m.VaultTypes)*10)
+		var j15 int
+		for _, num := range m.VaultTypes {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintBtcbridge(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Threshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DKGRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DKGCompletionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGCompletionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGCompletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
-	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Threshold))
-	}
-	if len(m.VaultTypes) > 0 {
-		l = 0
-		for _, e := range m.VaultTypes {
-			l += sovBtcbridge(uint64(e))
+	if len(m.InternalKeys) > 0 {
+		for iNdEx := len(m.InternalKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InternalKeys[iNdEx])
+			copy(dAtA[i:], m.InternalKeys[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.InternalKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		n += 1 + sovBtcbridge(uint64(l)) + l
 	}
-	if m.EnableTransfer {
-		n += 2
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.TargetUtxoNum))
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vaults[iNdEx])
+			copy(dAtA[i:], m.Vaults[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Vaults[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DKGCompletionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	if len(m.Vaults) > 0 {
-		for _, s := range m.Vaults {
-			l = len(s)
-			n += 1 + l + sovBtcbridge(uint64(l))
+	i -= n17
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RemovedParticipants) > 0 {
+		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedParticipants[iNdEx])
+			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.DkgId != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshingCompletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshingCompletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshingCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
-	}
-	if m.DkgId != 0 {
-		n += 1 + sovBtcbridge(uint64(m.DkgId))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.RemovedParticipants) > 0 {
-		for _, s := range m.RemovedParticipants {
-			l = len(s)
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Relayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashCount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RelayedDeposits != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.RelayedDeposits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunesAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunesAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunesAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Extensions != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Extensions))
+		i--
+		dAtA[i] = 0x40
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x3a
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScreenedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScreenedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScreenedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Group != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcTransfer != nil {
+		{
+			size, err := m.IbcTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BtcBlockHeight != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.BtcBlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Asset != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Asset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vout != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositIBCTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositIBCTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositIBCTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcbridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcbridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Value))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Type))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *CompactSigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Type))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.SigHashes) > 0 {
+		for _, s := range m.SigHashes {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *WithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Group))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalRateLimit.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = m.AddressRateLimit.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	return n
+}
+
+func (m *GlobalRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Quota != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Quota))
+	}
+	if m.Used != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *AddressRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Quota != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Quota))
+	}
+	return n
+}
+
+func (m *AddressRateLimitDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Used != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *UTXO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Vout))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = len(m.PubKeyScript)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.IsLocked {
+		n += 2
+	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuneBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *RuneId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Block))
+	}
+	if m.Tx != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Tx))
+	}
+	return n
+}
+
+func (m *FeeSponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *FeeSponsorshipAccountUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *RuneEtching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Spacers != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Spacers))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Divisibility != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Divisibility))
+	}
+	return n
+}
+
+func (m *RuneEtchingSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Etching != nil {
+		l = m.Etching.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Edict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Output != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Output))
+	}
+	return n
+}
+
+func (m *BtcConsolidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetThreshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TargetThreshold))
+	}
+	if m.MaxNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	}
+	return n
+}
+
+func (m *RunesConsolidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuneId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.TargetThreshold)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.MaxNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	}
+	return n
+}
+
+func (m *DKGParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Threshold))
+	}
+	if len(m.VaultTypes) > 0 {
+		l = 0
+		for _, e := range m.VaultTypes {
+			l += sovBtcbridge(uint64(e))
+		}
+		n += 1 + sovBtcbridge(uint64(l)) + l
+	}
+	if m.EnableTransfer {
+		n += 2
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TargetUtxoNum))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if m.RecoveryParams != nil {
+		l = m.RecoveryParams.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGCompletionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.Vaults) > 0 {
+		for _, s := range m.Vaults {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.InternalKeys) > 0 {
+		for _, s := range m.InternalKeys {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RefreshingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	if m.DkgId != 0 {
+		n += 1 + sovBtcbridge(uint64(m.DkgId))
+	}
+	if len(m.RemovedParticipants) > 0 {
+		for _, s := range m.RemovedParticipants {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RefreshingCompletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Relayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if m.RelayedDeposits != 0 {
+		n += 1 + sovBtcbridge(uint64(m.RelayedDeposits))
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
 			n += 1 + l + sovBtcbridge(uint64(l))
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
-	}
-	return n
-}
+	if m.SlashCount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.SlashCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	return n
+}
+
+func (m *RunesAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	return n
+}
+
+func (m *Pause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	if m.Target != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Target))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovBtcbridge(uint64(m.AssetType))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Extensions != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Extensions))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ScreenedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Source))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuarantinedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Group))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Vout))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Asset != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Asset))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.BtcBlockHeight != 0 {
+		n += 1 + sovBtcbridge(uint64(m.BtcBlockHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	if m.Quarantined {
+		n += 2
+	}
+	if m.IbcTransfer != nil {
+		l = m.IbcTransfer.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositIBCTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func sovBtcbridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcbridge(x uint64) (n int) {
+	return sovBtcbridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactSigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRateLimitDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRateLimitDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UTXO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeyScript == nil {
+				m.PubKeyScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLocked = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneBalance{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			m.Tx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorshipUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RefreshingCompletion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBtcbridge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtcbridge(x uint64) (n int) {
-	return sovBtcbridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *FeeRate) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorshipAccountUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,17 +7851,17 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorshipAccountUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorshipAccountUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3257,16 +7871,128 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 			}
-			m.Height = 0
+			m.TxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3276,7 +8002,7 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.TxCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3302,7 +8028,7 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+func (m *RuneEtching) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,15 +8051,15 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuneEtching: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuneEtching: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3361,13 +8087,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3377,16 +8103,29 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spacers", wireType)
 			}
-			m.Type = 0
+			m.Spacers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3396,14 +8135,14 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= AssetType(b&0x7F) << shift
+				m.Spacers |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3428,14 +8167,83 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
+			}
+			m.Divisibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Divisibility |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneEtchingSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneEtchingSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneEtchingSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3463,11 +8271,11 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbt = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Etching", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3494,29 +8302,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Etching == nil {
+				m.Etching = &RuneEtching{}
+			}
+			if err := m.Etching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -3538,7 +8330,7 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
+func (m *Edict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3561,17 +8353,17 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactSigningRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Edict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3581,29 +8373,33 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &RuneId{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3613,16 +8409,29 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			m.Type = 0
+			m.Output = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3632,16 +8441,66 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= AssetType(b&0x7F) << shift
+				m.Output |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+			}
+			m.TargetThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3651,29 +8510,16 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TargetThreshold |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
 			}
-			var stringLen uint64
+			m.MaxNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3683,27 +8529,64 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3731,13 +8614,13 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
+			m.RuneId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3747,30 +8630,29 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
 			}
-			m.Status = 0
+			m.MaxNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3780,7 +8662,7 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
+				m.MaxNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3806,7 +8688,7 @@ func (m *CompactSigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3829,15 +8711,15 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3865,11 +8747,11 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3897,30 +8779,11 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3948,7 +8811,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3971,7 +8834,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3994,17 +8857,36 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4014,29 +8896,31 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, &DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Sequence = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4046,16 +8930,160 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
+			if wireType == 0 {
+				var v AssetType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VaultTypes = append(m.VaultTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.VaultTypes) == 0 {
+					m.VaultTypes = make([]AssetType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtcbridge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VaultTypes = append(m.VaultTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableTransfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			}
+			m.TargetUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4065,29 +9093,16 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= DKGRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4097,23 +9112,27 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			if m.RecoveryParams == nil {
+				m.RecoveryParams = &VaultRecoveryParams{}
+			}
+			if err := m.RecoveryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4136,7 +9155,7 @@ func (m *IBCWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimit) Unmarshal(dAtA []byte) error {
+func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4159,17 +9178,36 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4179,30 +9217,29 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4212,80 +9249,29 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddressRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalRateLimit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4295,30 +9281,29 @@ func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4328,30 +9313,29 @@ func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKeys", wireType)
 			}
-			m.Quota = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4361,16 +9345,29 @@ func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quota |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			m.Used = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalKeys = append(m.InternalKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4380,11 +9377,24 @@ func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Used |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -4406,7 +9416,7 @@ func (m *GlobalRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
+func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4419,27 +9429,65 @@ func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddressRateLimit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4449,28 +9497,27 @@ func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4497,15 +9544,15 @@ func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Quota = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4515,7 +9562,7 @@ func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quota |= int64(b&0x7F) << shift
+				m.Status |= RefreshingStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4541,7 +9588,7 @@ func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
+func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4564,15 +9611,34 @@ func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressRateLimitDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressRateLimitDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4600,13 +9666,13 @@ func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
-			m.Used = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4616,11 +9682,56 @@ func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Used |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -4642,7 +9753,7 @@ func (m *AddressRateLimitDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UTXO) Unmarshal(dAtA []byte) error {
+func (m *Relayer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4665,15 +9776,15 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+			return fmt.Errorf("proto: Relayer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Relayer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4701,32 +9812,13 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
-			}
-			m.Vout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Vout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4736,29 +9828,30 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Amount = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4768,16 +9861,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Status |= RelayerStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayedDeposits", wireType)
 			}
-			m.Height = 0
+			m.RelayedDeposits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4787,16 +9880,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.RelayedDeposits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4806,31 +9899,31 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKeyScript == nil {
-				m.PubKeyScript = []byte{}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
 			}
-			var v int
+			m.SlashCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4840,15 +9933,14 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SlashCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLocked = bool(v != 0)
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4875,8 +9967,7 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runes = append(m.Runes, &RuneBalance{})
-			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4901,7 +9992,7 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuneBalance) Unmarshal(dAtA []byte) error {
+func (m *RunesAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4924,15 +10015,47 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunesAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunesAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4960,13 +10083,13 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4976,24 +10099,31 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Slashed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -5015,7 +10145,7 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuneId) Unmarshal(dAtA []byte) error {
+func (m *Pause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5038,17 +10168,17 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuneId: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Block = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5058,16 +10188,16 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			m.Tx = 0
+			m.Target = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5077,66 +10207,35 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tx |= uint32(b&0x7F) << shift
+				m.Target |= PauseTarget(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Edict) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Edict: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5146,31 +10245,27 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &RuneId{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5198,13 +10293,13 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.Output = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5214,66 +10309,63 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Output |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
-			m.TargetThreshold = 0
+			m.Extensions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5283,16 +10375,16 @@ func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetThreshold |= int64(b&0x7F) << shift
+				m.Extensions |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.MaxNum = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5302,7 +10394,7 @@ func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNum |= uint32(b&0x7F) << shift
+				m.Status |= PauseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5328,7 +10420,7 @@ func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
+func (m *ScreenedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5351,15 +10443,15 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScreenedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScreenedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5387,11 +10479,30 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= ScreeningSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5419,13 +10530,13 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetThreshold = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxNum = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5435,7 +10546,7 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNum |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5461,7 +10572,7 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5484,15 +10595,15 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5520,11 +10631,11 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Txid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5552,11 +10663,11 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5584,8 +10695,60 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -5607,7 +10770,7 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGRequest) Unmarshal(dAtA []byte) error {
+func (m *QuarantinedWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5630,17 +10793,17 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantinedWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantinedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Id = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5650,16 +10813,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5669,31 +10832,29 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, &DKGParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Threshold = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5703,105 +10864,30 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v AssetType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBtcbridge
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= AssetType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.VaultTypes = append(m.VaultTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBtcbridge
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBtcbridge
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBtcbridge
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.VaultTypes) == 0 {
-					m.VaultTypes = make([]AssetType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBtcbridge
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.VaultTypes = append(m.VaultTypes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EnableTransfer = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetUtxoNum = 0
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5811,16 +10897,29 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5830,33 +10929,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Status = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5866,7 +10948,7 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= DKGRequestStatus(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5892,7 +10974,7 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
+func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5915,17 +10997,17 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -5935,12 +11017,44 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -5972,9 +11086,9 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6002,13 +11116,32 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			m.Asset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Asset |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6018,29 +11151,30 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6050,79 +11184,30 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHeight", wireType)
 			}
-			m.Id = 0
+			m.BtcBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6132,16 +11217,16 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.BtcBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.DkgId = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6151,16 +11236,16 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DkgId |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6170,27 +11255,15 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			m.Quarantined = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6217,29 +11290,13 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.IbcTransfer == nil {
+				m.IbcTransfer = &DepositIBCTransfer{}
+			}
+			if err := m.IbcTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RefreshingStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -6261,7 +11318,7 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
+func (m *DepositIBCTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6284,17 +11341,17 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositIBCTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositIBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6304,14 +11361,27 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6339,13 +11409,13 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -6355,27 +11425,14 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6403,7 +11460,7 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9,30 +9,50 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitDepositTransaction{}, "btcbridge/MsgSubmitDepositTransaction", nil)
+	cdc.RegisterConcrete(&MsgSubmitDepositTransactions{}, "btcbridge/MsgSubmitDepositTransactions", nil)
 	cdc.RegisterConcrete(&MsgSubmitWithdrawTransaction{}, "btcbridge/MsgSubmitWithdrawTransaction", nil)
 	cdc.RegisterConcrete(&MsgSubmitFeeRate{}, "btcbridge/MsgSubmitFeeRate", nil)
-	cdc.RegisterConcrete(&MsgUpdateTrustedNonBtcRelayers{}, "btcbridge/MsgUpdateTrustedNonBtcRelayers", nil)
 	cdc.RegisterConcrete(&MsgUpdateTrustedFeeProviders{}, "btcbridge/MsgUpdateTrustedFeeProviders", nil)
 	cdc.RegisterConcrete(&MsgWithdrawToBitcoin{}, "btcbridge/MsgWithdrawToBitcoin", nil)
+	cdc.RegisterConcrete(&MsgMultiWithdrawToBitcoin{}, "btcbridge/MsgMultiWithdrawToBitcoin", nil)
 	cdc.RegisterConcrete(&MsgSubmitSignatures{}, "btcbridge/MsgSubmitSignatures", nil)
 	cdc.RegisterConcrete(&MsgCompleteDKG{}, "btcbridge/MsgCompleteDKG", nil)
 	cdc.RegisterConcrete(&MsgRefresh{}, "btcbridge/MsgRefresh", nil)
 	cdc.RegisterConcrete(&MsgCompleteRefreshing{}, "btcbridge/MsgCompleteRefreshing", nil)
+	cdc.RegisterConcrete(&MsgBondRelayer{}, "btcbridge/MsgBondRelayer", nil)
+	cdc.RegisterConcrete(&MsgUnbondRelayer{}, "btcbridge/MsgUnbondRelayer", nil)
+	cdc.RegisterConcrete(&MsgSlashRelayer{}, "btcbridge/MsgSlashRelayer", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "btcbridge/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "btcbridge/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgExtendPause{}, "btcbridge/MsgExtendPause", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenylist{}, "btcbridge/MsgUpdateDenylist", nil)
+	cdc.RegisterConcrete(&MsgSubmitRuneEtching{}, "btcbridge/MsgSubmitRuneEtching", nil)
+	cdc.RegisterConcrete(&MsgUpdateRuneEtching{}, "btcbridge/MsgUpdateRuneEtching", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcbridge/MsgUpdateParams", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitDepositTransaction{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitDepositTransactions{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitWithdrawTransaction{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitFeeRate{})
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateTrustedNonBtcRelayers{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateTrustedFeeProviders{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawToBitcoin{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgMultiWithdrawToBitcoin{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitSignatures{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCompleteDKG{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRefresh{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCompleteRefreshing{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgBondRelayer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnbondRelayer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSlashRelayer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgExtendPause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateDenylist{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitRuneEtching{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateRuneEtching{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 	// this line is used by starport scaffolding # 3
 
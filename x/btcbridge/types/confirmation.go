@@ -0,0 +1,126 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// maximum divisibility of runes
+	MaxRunesDivisibility = 38
+)
+
+// BaseConfirmationDepth returns the confirmation depth of the first tier
+func (p DepositConfirmationParams) BaseConfirmationDepth() int32 {
+	if len(p.Tiers) == 0 {
+		return 0
+	}
+
+	return p.Tiers[0].ConfirmationDepth
+}
+
+// MaxConfirmationDepth returns the confirmation depth of the last tier
+func (p DepositConfirmationParams) MaxConfirmationDepth() int32 {
+	if len(p.Tiers) == 0 {
+		return 0
+	}
+
+	return p.Tiers[len(p.Tiers)-1].ConfirmationDepth
+}
+
+// GetConfirmationDepth returns the confirmation depth required for the given BTC equivalent amount
+// Assume that the tiers are sorted in ascending order of the minimum amount
+func (p DepositConfirmationParams) GetConfirmationDepth(btcAmount int64) int32 {
+	confirmationDepth := p.BaseConfirmationDepth()
+
+	for _, tier := range p.Tiers {
+		if btcAmount < tier.MinAmount {
+			break
+		}
+
+		confirmationDepth = tier.ConfirmationDepth
+	}
+
+	return confirmationDepth
+}
+
+// GetRunesPriceSymbol returns the price symbol of the given rune
+// Nil is returned if not found
+func (p DepositConfirmationParams) GetRunesPriceSymbol(id string) *RunesPriceSymbol {
+	for i := range p.RunesPriceSymbols {
+		if p.RunesPriceSymbols[i].Id == id {
+			return &p.RunesPriceSymbols[i]
+		}
+	}
+
+	return nil
+}
+
+// GetBTCEquivalent converts the given runes amount to the BTC equivalent in satoshi by the specified price
+// The price is denominated in BTC per whole rune
+func GetBTCEquivalent(amount sdkmath.Int, price sdkmath.LegacyDec, divisibility uint32) int64 {
+	btcAmount := amount.ToLegacyDec().Mul(price).MulInt64(1e8).QuoInt(sdkmath.NewIntWithDecimal(1, int(divisibility))).TruncateInt()
+	if !btcAmount.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return btcAmount.Int64()
+}
+
+// validateDepositConfirmationParams validates the given deposit confirmation params
+func validateDepositConfirmationParams(params *DepositConfirmationParams) error {
+	if len(params.Tiers) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "deposit confirmation tiers cannot be empty")
+	}
+
+	if params.Tiers[0].MinAmount != 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "the first deposit confirmation tier must start from 0")
+	}
+
+	if params.BaseConfirmationDepth() <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "base deposit confirmation depth must be greater than 0")
+	}
+
+	for i, tier := range params.Tiers {
+		if tier.ConfirmationDepth <= 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "confirmation depth must be greater than 0")
+		}
+
+		if i > 0 {
+			if tier.MinAmount <= params.Tiers[i-1].MinAmount {
+				return errorsmod.Wrapf(ErrInvalidParams, "deposit confirmation tiers must be in strictly ascending order of the minimum amount")
+			}
+
+			if tier.ConfirmationDepth < params.Tiers[i-1].ConfirmationDepth {
+				return errorsmod.Wrapf(ErrInvalidParams, "confirmation depth must not decrease with the amount")
+			}
+		}
+	}
+
+	runes := make(map[string]bool)
+
+	for _, s := range params.RunesPriceSymbols {
+		var id RuneId
+		if err := id.FromString(s.Id); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid rune id %s", s.Id)
+		}
+
+		if runes[s.Id] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate rune id %s", s.Id)
+		}
+
+		runes[s.Id] = true
+
+		if len(s.Symbol) == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "price symbol of rune %s cannot be empty", s.Id)
+		}
+
+		if s.Divisibility > MaxRunesDivisibility {
+			return errorsmod.Wrapf(ErrInvalidParams, "divisibility of rune %s cannot be greater than %d", s.Id, MaxRunesDivisibility)
+		}
+	}
+
+	return nil
+}
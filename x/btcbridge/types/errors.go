@@ -22,6 +22,8 @@ var (
 	ErrDepositNotEnabled         = errorsmod.Register(ModuleName, 2110, "deposit not enabled")
 	ErrUntrustedNonBtcRelayer    = errorsmod.Register(ModuleName, 2111, "untrusted non btc relayer")
 	ErrUntrustedFeeProvider      = errorsmod.Register(ModuleName, 2112, "untrusted fee provider")
+	ErrInvalidMerkleBlock        = errorsmod.Register(ModuleName, 2113, "invalid merkle block")
+	ErrInvalidDepositBatch       = errorsmod.Register(ModuleName, 2114, "invalid deposit batch")
 
 	ErrInvalidWithdrawAmount        = errorsmod.Register(ModuleName, 3100, "invalid withdrawal amount")
 	ErrInvalidBtcAddress            = errorsmod.Register(ModuleName, 3101, "invalid btc address")
@@ -39,16 +41,27 @@ var (
 	ErrSigningRequestConfirmed      = errorsmod.Register(ModuleName, 3113, "signing request has been confirmed")
 	ErrWithdrawNotEnabled           = errorsmod.Register(ModuleName, 3114, "withdrawal not enabled")
 	ErrRateLimitReached             = errorsmod.Register(ModuleName, 3115, "rate limit reached")
+	ErrInvalidWithdrawOutputs       = errorsmod.Register(ModuleName, 3116, "invalid withdrawal outputs")
 
 	ErrUTXODoesNotExist = errorsmod.Register(ModuleName, 4100, "utxo does not exist")
 	ErrUTXOLocked       = errorsmod.Register(ModuleName, 4101, "utxo locked")
 	ErrUTXOUnlocked     = errorsmod.Register(ModuleName, 4102, "utxo unlocked")
 
-	ErrInvalidRunes  = errorsmod.Register(ModuleName, 5100, "invalid runes")
-	ErrInvalidRuneId = errorsmod.Register(ModuleName, 5101, "invalid rune id")
+	ErrInvalidRunes         = errorsmod.Register(ModuleName, 5100, "invalid runes")
+	ErrInvalidRuneId        = errorsmod.Register(ModuleName, 5101, "invalid rune id")
+	ErrInvalidRuneEtching   = errorsmod.Register(ModuleName, 5102, "invalid rune etching")
+	ErrRuneEtchingExists    = errorsmod.Register(ModuleName, 5103, "rune etching already exists")
+	ErrRuneEtchingNotFound  = errorsmod.Register(ModuleName, 5104, "rune etching does not exist")
+	ErrRuneEtchingSubmitted = errorsmod.Register(ModuleName, 5105, "rune etching already submitted by the relayer")
 
-	ErrInvalidRelayers     = errorsmod.Register(ModuleName, 6100, "invalid relayers")
-	ErrInvalidFeeProviders = errorsmod.Register(ModuleName, 6101, "invalid fee providers")
+	ErrInvalidRelayers              = errorsmod.Register(ModuleName, 6100, "invalid relayers")
+	ErrInvalidFeeProviders          = errorsmod.Register(ModuleName, 6101, "invalid fee providers")
+	ErrInvalidRelayerBond           = errorsmod.Register(ModuleName, 6102, "invalid relayer bond")
+	ErrRelayerDoesNotExist          = errorsmod.Register(ModuleName, 6103, "relayer does not exist")
+	ErrInvalidRelayerStatus         = errorsmod.Register(ModuleName, 6104, "invalid relayer status")
+	ErrRunesAttestationDoesNotExist = errorsmod.Register(ModuleName, 6105, "runes attestation does not exist")
+	ErrRunesAttestationSlashed      = errorsmod.Register(ModuleName, 6106, "runes attestation already slashed")
+	ErrInvalidMisbehaviour          = errorsmod.Register(ModuleName, 6107, "invalid misbehaviour")
 
 	ErrInvalidDKGParams                 = errorsmod.Register(ModuleName, 7100, "invalid dkg params")
 	ErrDKGRequestDoesNotExist           = errorsmod.Register(ModuleName, 7101, "dkg request does not exist")
@@ -59,6 +72,7 @@ var (
 	ErrInvalidVault                     = errorsmod.Register(ModuleName, 7106, "invalid vault")
 	ErrVaultDoesNotExist                = errorsmod.Register(ModuleName, 7107, "vault does not exist")
 	ErrInvalidPsbt                      = errorsmod.Register(ModuleName, 7108, "invalid psbt")
+	ErrVaultRecoveryNotEnabled          = errorsmod.Register(ModuleName, 7109, "vault recovery not enabled")
 
 	ErrInvalidConsolidation = errorsmod.Register(ModuleName, 8100, "invalid consolidation")
 
@@ -74,4 +88,15 @@ var (
 	ErrRefreshingCompletionAlreadyExists = errorsmod.Register(ModuleName, 9009, "refreshing completion already exists")
 
 	ErrInvalidIBCTransferScript = errorsmod.Register(ModuleName, 10000, "invalid deposit script for IBC transfer")
+
+	ErrPaused             = errorsmod.Register(ModuleName, 11000, "paused")
+	ErrInvalidPause       = errorsmod.Register(ModuleName, 11001, "invalid pause")
+	ErrAlreadyPaused      = errorsmod.Register(ModuleName, 11002, "already paused")
+	ErrPauseDoesNotExist  = errorsmod.Register(ModuleName, 11003, "pause does not exist")
+	ErrUnauthorizedPauser = errorsmod.Register(ModuleName, 11004, "unauthorized pauser")
+
+	ErrAddressScreened = errorsmod.Register(ModuleName, 12000, "address screened")
+	ErrInvalidDenylist = errorsmod.Register(ModuleName, 12001, "invalid denylist")
+
+	ErrFeeSponsorshipPolicyNotFound = errorsmod.Register(ModuleName, 13000, "fee sponsorship policy does not exist")
 )
@@ -11,6 +11,22 @@ const (
 	EventTypeIBCWithdrawQueue       = "ibc_withdraw_queue"
 	EventTypeIBCWithdraw            = "ibc_withdraw"
 	EventTypeGlobalRateLimitUpdated = "global_rate_limit_updated"
+	EventTypeBondRelayer            = "bond_relayer"
+	EventTypeUnbondRelayer          = "unbond_relayer"
+	EventTypeRelayerUnbonded        = "relayer_unbonded"
+	EventTypeRelayerReward          = "relayer_reward"
+	EventTypeSlashRelayer           = "slash_relayer"
+	EventTypeRuneEtching            = "rune_etching"
+	EventTypeRuneMetadataRegistered = "rune_metadata_registered"
+	EventTypePause                  = "pause"
+	EventTypeUnpause                = "unpause"
+	EventTypeExtendPause            = "extend_pause"
+	EventTypePauseExpired           = "pause_expired"
+	EventTypeAddressScreened        = "address_screened"
+	EventTypeAddressUnscreened      = "address_unscreened"
+	EventTypeDepositQuarantined     = "deposit_quarantined"
+	EventTypeWithdrawalQuarantined  = "withdrawal_quarantined"
+	EventTypeFeeSponsored           = "fee_sponsored"
 
 	AttributeKeyId = "id"
 
@@ -43,6 +59,29 @@ const (
 	AttributeKeyStartTime         = "start_time"
 	AttributeKeyEndTime           = "end_time"
 	AttributeKeyQuota             = "quota"
+
+	AttributeKeyRelayer       = "relayer"
+	AttributeKeyBond          = "bond"
+	AttributeKeyTxHash        = "txid"
+	AttributeKeyMisbehaviour  = "misbehaviour"
+	AttributeKeySlashedAmount = "slashed_amount"
+	AttributeKeyUnbondingTime = "unbonding_time"
+
+	AttributeKeyRuneId       = "rune_id"
+	AttributeKeyName         = "name"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyDivisibility = "divisibility"
+
+	AttributeKeyTarget    = "target"
+	AttributeKeyAssetType = "asset_type"
+	AttributeKeyReason    = "reason"
+
+	AttributeKeySource    = "source"
+	AttributeKeyRecipient = "recipient"
+
+	AttributeKeyPolicyId = "policy_id"
+	AttributeKeyFee      = "fee"
+	AttributeKeySponsor  = "sponsor"
 )
 
 const (
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -14,6 +15,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	oracletypes "github.com/bitwaylabs/bitway/x/oracle/types"
+	tsstypes "github.com/bitwaylabs/bitway/x/tss/types"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -40,6 +42,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	HasDenomMetaData(ctx context.Context, denom string) bool
 
 	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
@@ -62,6 +65,8 @@ type OracleKeeper interface {
 	GetBestBlockHeader(ctx sdk.Context) *oracletypes.BlockHeader
 	GetBlockHeader(ctx sdk.Context, hash string) *oracletypes.BlockHeader
 	GetBlockHeaderByHeight(ctx sdk.Context, height int32) *oracletypes.BlockHeader
+
+	GetPrice(ctx sdk.Context, symbol string) (sdkmath.LegacyDec, error)
 }
 
 // IncentiveKeeper defines the expected incentive keeper
@@ -76,6 +81,13 @@ type IncentiveKeeper interface {
 // TSSKeeper defines the expected TSS keeper interfaces
 type TSSKeeper interface {
 	AllowedDKGParticipants(ctx sdk.Context) []string
+
+	IsKeyRotating(ctx sdk.Context, module string, ty string) bool
+	CompleteKeyRotation(ctx sdk.Context, module string, ty string) error
+	AbortKeyRotation(ctx sdk.Context, module string, ty string) error
+
+	RegisterKeyRotationHandler(module string, handler tsstypes.KeyRotationHandler)
+	RegisterKeyRetirementHandler(module string, handler tsstypes.KeyRetirementHandler)
 }
 
 // IBCClientKeeper defines the expected IBC client keeper
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum length of the fee sponsorship policy id
+	MaxFeeSponsorshipPolicyIdLength = 64
+
+	// maximum number of the stale fee sponsorship account usages to be pruned per block
+	MaxPrunedFeeSponsorshipAccountUsages = 1000
+)
+
+// Validate validates the fee sponsorship policy
+func (p *FeeSponsorshipPolicy) Validate() error {
+	if len(p.Id) == 0 || len(p.Id) > MaxFeeSponsorshipPolicyIdLength {
+		return errorsmod.Wrapf(ErrInvalidParams, "fee sponsorship policy id length must be between [1, %d]", MaxFeeSponsorshipPolicyIdLength)
+	}
+
+	if len(p.MsgTypeUrls) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "msg type urls of the fee sponsorship policy %s can not be empty", p.Id)
+	}
+
+	msgTypeUrls := make(map[string]bool)
+
+	for _, msgTypeUrl := range p.MsgTypeUrls {
+		if !strings.HasPrefix(msgTypeUrl, "/") || len(msgTypeUrl) == 1 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid msg type url %s of the fee sponsorship policy %s", msgTypeUrl, p.Id)
+		}
+
+		if msgTypeUrls[msgTypeUrl] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate msg type url %s of the fee sponsorship policy %s", msgTypeUrl, p.Id)
+		}
+
+		msgTypeUrls[msgTypeUrl] = true
+	}
+
+	if err := p.EpochBudget.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid epoch budget of the fee sponsorship policy %s: %v", p.Id, err)
+	}
+
+	if p.EpochDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "epoch duration of the fee sponsorship policy %s can not be negative", p.Id)
+	}
+
+	if len(p.FundingAccount) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.FundingAccount); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid funding account of the fee sponsorship policy %s: %v", p.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Covers returns true if all the given msgs are covered by the policy, false otherwise
+func (p *FeeSponsorshipPolicy) Covers(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		covered := false
+
+		for _, msgTypeUrl := range p.MsgTypeUrls {
+			if sdk.MsgTypeURL(msg) == msgTypeUrl {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetEpochStartTime gets the start time of the current epoch from the given epoch start time
+// A new epoch is started if the given epoch has elapsed
+func (p *FeeSponsorshipPolicy) GetEpochStartTime(epochStartTime time.Time, now time.Time) time.Time {
+	if epochStartTime.IsZero() {
+		return now
+	}
+
+	if p.EpochDuration > 0 && !now.Before(epochStartTime.Add(p.EpochDuration)) {
+		return now
+	}
+
+	return epochStartTime
+}
+
+// RemainingBudget gets the remaining budget in the epoch from the given usage
+// Empty coins returned if the budget is unlimited
+func (p *FeeSponsorshipPolicy) RemainingBudget(usage *FeeSponsorshipUsage) sdk.Coins {
+	if p.EpochBudget.Empty() {
+		return sdk.Coins{}
+	}
+
+	remaining, _ := p.EpochBudget.SafeSub(usage.Spent...)
+
+	return remaining
+}
+
+// RemainingQuota gets the remaining number of the sponsored txs in the epoch from the given account usage
+// 0 returned if the quota is unlimited
+func (p *FeeSponsorshipPolicy) RemainingQuota(usage *FeeSponsorshipAccountUsage) uint32 {
+	if p.AccountQuota == 0 || usage.TxCount >= p.AccountQuota {
+		return 0
+	}
+
+	return p.AccountQuota - usage.TxCount
+}
+
+// CanSponsor returns true if the given fee can be sponsored for the account with the given usages, false otherwise
+func (p *FeeSponsorshipPolicy) CanSponsor(usage *FeeSponsorshipUsage, accountUsage *FeeSponsorshipAccountUsage, fee sdk.Coins) bool {
+	if p.AccountQuota > 0 && accountUsage.TxCount >= p.AccountQuota {
+		return false
+	}
+
+	if !p.EpochBudget.Empty() && !usage.Spent.Add(fee...).IsAllLTE(p.EpochBudget) {
+		return false
+	}
+
+	return true
+}
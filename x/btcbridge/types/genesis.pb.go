@@ -25,14 +25,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the btc bridge module's genesis state.
 type GenesisState struct {
-	Params                     Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Utxos                      []*UTXO                 `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	DkgRequests                []*DKGRequest           `protobuf:"bytes,3,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
-	DkgCompletions             []*DKGCompletionRequest `protobuf:"bytes,4,rep,name=dkg_completions,json=dkgCompletions,proto3" json:"dkg_completions,omitempty"`
-	SigningRequests            []*SigningRequest       `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
-	WithdrawRequests           []*WithdrawRequest      `protobuf:"bytes,6,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests,omitempty"`
-	PendingBtcWithdrawRequests []*WithdrawRequest      `protobuf:"bytes,7,rep,name=pending_btc_withdraw_requests,json=pendingBtcWithdrawRequests,proto3" json:"pending_btc_withdraw_requests,omitempty"`
-	MintedTxHashes             []string                `protobuf:"bytes,8,rep,name=minted_tx_hashes,json=mintedTxHashes,proto3" json:"minted_tx_hashes,omitempty"`
+	Params                      Params                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Utxos                       []*UTXO                       `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	DkgRequests                 []*DKGRequest                 `protobuf:"bytes,3,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
+	DkgCompletions              []*DKGCompletionRequest       `protobuf:"bytes,4,rep,name=dkg_completions,json=dkgCompletions,proto3" json:"dkg_completions,omitempty"`
+	SigningRequests             []*SigningRequest             `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	WithdrawRequests            []*WithdrawRequest            `protobuf:"bytes,6,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests,omitempty"`
+	PendingBtcWithdrawRequests  []*WithdrawRequest            `protobuf:"bytes,7,rep,name=pending_btc_withdraw_requests,json=pendingBtcWithdrawRequests,proto3" json:"pending_btc_withdraw_requests,omitempty"`
+	MintedTxHashes              []string                      `protobuf:"bytes,8,rep,name=minted_tx_hashes,json=mintedTxHashes,proto3" json:"minted_tx_hashes,omitempty"`
+	Relayers                    []*Relayer                    `protobuf:"bytes,9,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	RunesAttestations           []*RunesAttestation           `protobuf:"bytes,10,rep,name=runes_attestations,json=runesAttestations,proto3" json:"runes_attestations,omitempty"`
+	Pauses                      []*Pause                      `protobuf:"bytes,11,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	ScreenedAddresses           []*ScreenedAddress            `protobuf:"bytes,12,rep,name=screened_addresses,json=screenedAddresses,proto3" json:"screened_addresses,omitempty"`
+	QuarantinedDeposits         []*QuarantinedDeposit         `protobuf:"bytes,13,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	DepositRecords              []*DepositRecord              `protobuf:"bytes,14,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records,omitempty"`
+	RuneEtchings                []*RuneEtching                `protobuf:"bytes,15,rep,name=rune_etchings,json=runeEtchings,proto3" json:"rune_etchings,omitempty"`
+	FeeSponsorshipUsages        []*FeeSponsorshipUsage        `protobuf:"bytes,16,rep,name=fee_sponsorship_usages,json=feeSponsorshipUsages,proto3" json:"fee_sponsorship_usages,omitempty"`
+	FeeSponsorshipAccountUsages []*FeeSponsorshipAccountUsage `protobuf:"bytes,17,rep,name=fee_sponsorship_account_usages,json=feeSponsorshipAccountUsages,proto3" json:"fee_sponsorship_account_usages,omitempty"`
+	QuarantinedWithdrawals      []*QuarantinedWithdrawal      `protobuf:"bytes,18,rep,name=quarantined_withdrawals,json=quarantinedWithdrawals,proto3" json:"quarantined_withdrawals,omitempty"`
+	RuneEtchingSubmissions      []*RuneEtchingSubmission      `protobuf:"bytes,19,rep,name=rune_etching_submissions,json=runeEtchingSubmissions,proto3" json:"rune_etching_submissions,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +135,83 @@ func (m *GenesisState) GetMintedTxHashes() []string {
 	return nil
 }
 
+func (m *GenesisState) GetRelayers() []*Relayer {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRunesAttestations() []*RunesAttestation {
+	if m != nil {
+		return m.RunesAttestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauses() []*Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScreenedAddresses() []*ScreenedAddress {
+	if m != nil {
+		return m.ScreenedAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDepositRecords() []*DepositRecord {
+	if m != nil {
+		return m.DepositRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRuneEtchings() []*RuneEtching {
+	if m != nil {
+		return m.RuneEtchings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorshipUsages() []*FeeSponsorshipUsage {
+	if m != nil {
+		return m.FeeSponsorshipUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorshipAccountUsages() []*FeeSponsorshipAccountUsage {
+	if m != nil {
+		return m.FeeSponsorshipAccountUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedWithdrawals() []*QuarantinedWithdrawal {
+	if m != nil {
+		return m.QuarantinedWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRuneEtchingSubmissions() []*RuneEtchingSubmission {
+	if m != nil {
+		return m.RuneEtchingSubmissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "bitway.btcbridge.GenesisState")
 }
@@ -131,33 +219,52 @@ func init() {
 func init() { proto.RegisterFile("bitway/btcbridge/genesis.proto", fileDescriptor_8f3a94b884470b49) }
 
 var fileDescriptor_8f3a94b884470b49 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x13, 0x77, 0xbb, 0xea, 0x6c, 0x69, 0xe3, 0x20, 0x12, 0x82, 0x8d, 0xd1, 0x83, 0xe4,
-	0x20, 0x09, 0x54, 0xf0, 0x2a, 0xac, 0x42, 0x0b, 0x05, 0x2b, 0x69, 0xa5, 0xe2, 0x25, 0x24, 0x99,
-	0x61, 0x32, 0x6c, 0x93, 0x89, 0x79, 0xb3, 0x24, 0xfd, 0x16, 0x7e, 0x17, 0xbf, 0x44, 0x8f, 0x3d,
-	0x7a, 0x12, 0xd9, 0xfd, 0x22, 0xb2, 0x33, 0xd9, 0xed, 0xee, 0xc6, 0x83, 0xb7, 0x37, 0xef, 0xff,
-	0x7b, 0xbf, 0x79, 0x87, 0x87, 0xdc, 0x94, 0xcb, 0x26, 0xb9, 0x09, 0x53, 0x99, 0xa5, 0x35, 0x27,
-	0x8c, 0x86, 0x8c, 0x96, 0x14, 0x38, 0x04, 0x55, 0x2d, 0xa4, 0xc0, 0x96, 0xce, 0x83, 0x75, 0xee,
-	0x3c, 0x65, 0x82, 0x09, 0x15, 0x86, 0xcb, 0x4a, 0x73, 0xce, 0x51, 0xcf, 0x53, 0x25, 0x75, 0x52,
-	0x74, 0x1a, 0xc7, 0xeb, 0xc5, 0xeb, 0x4a, 0x13, 0xaf, 0x7e, 0x0e, 0xd1, 0xfe, 0x89, 0xfe, 0xfa,
-	0x42, 0x26, 0x92, 0xe2, 0x77, 0x68, 0xa4, 0x15, 0xb6, 0xe9, 0x99, 0xfe, 0xf8, 0xd8, 0x0e, 0x76,
-	0x57, 0x09, 0x3e, 0xab, 0x7c, 0x32, 0xbc, 0xfd, 0xfd, 0xc2, 0x88, 0x3a, 0x1a, 0xbf, 0x41, 0x7b,
-	0x33, 0xd9, 0x0a, 0xb0, 0x1f, 0x78, 0x03, 0x7f, 0x7c, 0xfc, 0xac, 0x3f, 0xf6, 0xe5, 0xf2, 0xeb,
-	0x79, 0xa4, 0x21, 0xfc, 0x1e, 0xed, 0x93, 0x29, 0x8b, 0x6b, 0xfa, 0x7d, 0x46, 0x41, 0x82, 0x3d,
-	0x50, 0x43, 0xcf, 0xfb, 0x43, 0x1f, 0xcf, 0x4e, 0x22, 0x0d, 0x45, 0x63, 0x32, 0x65, 0x5d, 0x0d,
-	0xf8, 0x1c, 0x1d, 0x2e, 0x05, 0x99, 0x28, 0xaa, 0x6b, 0x2a, 0xb9, 0x28, 0xc1, 0x1e, 0x2a, 0xc7,
-	0xeb, 0x7f, 0x3a, 0x3e, 0xac, 0xb9, 0x95, 0xed, 0x80, 0x4c, 0xd9, 0x7d, 0x17, 0xf0, 0x19, 0xb2,
-	0x80, 0xb3, 0x92, 0x97, 0x1b, 0x5b, 0xed, 0x29, 0xa3, 0xd7, 0x37, 0x5e, 0x68, 0x72, 0xe5, 0x3a,
-	0x84, 0xad, 0x37, 0xe0, 0x4f, 0xe8, 0x49, 0xc3, 0x65, 0x4e, 0xea, 0xa4, 0xb9, 0xb7, 0x8d, 0x94,
-	0xed, 0x65, 0xdf, 0x76, 0xd5, 0xa1, 0x2b, 0x9d, 0xd5, 0x6c, 0x37, 0x00, 0x13, 0x74, 0x54, 0xd1,
-	0x92, 0x2c, 0x97, 0x4b, 0x65, 0x16, 0xf7, 0xdd, 0x0f, 0xff, 0xd7, 0xed, 0x74, 0x9e, 0x89, 0xcc,
-	0xae, 0x76, 0x7f, 0xf1, 0x91, 0x55, 0xf0, 0x52, 0x52, 0x12, 0xcb, 0x36, 0xce, 0x13, 0xc8, 0x29,
-	0xd8, 0x8f, 0xbc, 0x81, 0xff, 0x38, 0x3a, 0xd0, 0xfd, 0xcb, 0xf6, 0x54, 0x75, 0x27, 0xa7, 0xb7,
-	0x73, 0xd7, 0xbc, 0x9b, 0xbb, 0xe6, 0x9f, 0xb9, 0x6b, 0xfe, 0x58, 0xb8, 0xc6, 0xdd, 0xc2, 0x35,
-	0x7e, 0x2d, 0x5c, 0xe3, 0x5b, 0xc0, 0xb8, 0xcc, 0x67, 0x69, 0x90, 0x89, 0x22, 0xd4, 0xcb, 0x5c,
-	0x27, 0x29, 0x74, 0x65, 0xd8, 0x6e, 0x5c, 0xa2, 0xbc, 0xa9, 0x28, 0xa4, 0x23, 0x75, 0x86, 0x6f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x0c, 0x17, 0xc1, 0x11, 0x03, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x5d, 0x4f, 0xdb, 0x3a,
+	0x18, 0xc7, 0xdb, 0xc3, 0xcb, 0x01, 0xb7, 0xf4, 0xc5, 0xa0, 0x92, 0xd3, 0x73, 0x08, 0x3d, 0x68,
+	0x2f, 0xbd, 0x40, 0xad, 0xc4, 0xb4, 0xdd, 0x4e, 0x74, 0x6c, 0x20, 0x21, 0x0d, 0x70, 0x41, 0x9d,
+	0xb6, 0x8b, 0xcc, 0x49, 0x4c, 0x1a, 0xd1, 0x26, 0xa9, 0x1f, 0x47, 0x2d, 0xdf, 0x62, 0x1f, 0x68,
+	0x1f, 0x80, 0x4b, 0x2e, 0x77, 0x35, 0x4d, 0xf0, 0x45, 0xa6, 0xd8, 0xe9, 0x0b, 0x4d, 0xd8, 0x76,
+	0x67, 0x3f, 0xcf, 0xcf, 0xbf, 0xbf, 0x65, 0x3b, 0x0a, 0xd2, 0x4d, 0x57, 0x0c, 0xe9, 0x75, 0xd3,
+	0x14, 0x96, 0xc9, 0x5d, 0xdb, 0x61, 0x4d, 0x87, 0x79, 0x0c, 0x5c, 0x68, 0x04, 0xdc, 0x17, 0x3e,
+	0x2e, 0xa9, 0x7e, 0x63, 0xd2, 0xaf, 0x6e, 0x38, 0xbe, 0xe3, 0xcb, 0x66, 0x33, 0x1a, 0x29, 0xae,
+	0xba, 0x95, 0xf0, 0x04, 0x94, 0xd3, 0x7e, 0xac, 0xa9, 0xd6, 0x12, 0xed, 0xc9, 0x48, 0x11, 0x3b,
+	0x5f, 0x73, 0x28, 0x7f, 0xa8, 0xa2, 0xdb, 0x82, 0x0a, 0x86, 0x5f, 0xa1, 0x65, 0xa5, 0xd0, 0xb2,
+	0xb5, 0x6c, 0x3d, 0xb7, 0xa7, 0x35, 0xe6, 0xb7, 0xd2, 0x38, 0x95, 0xfd, 0xd6, 0xe2, 0xcd, 0xf7,
+	0xed, 0x0c, 0x89, 0x69, 0xbc, 0x8b, 0x96, 0x42, 0x31, 0xf2, 0x41, 0xfb, 0xab, 0xb6, 0x50, 0xcf,
+	0xed, 0x55, 0x92, 0xcb, 0x2e, 0xce, 0x3f, 0x9c, 0x10, 0x05, 0xe1, 0xd7, 0x28, 0x6f, 0x5f, 0x39,
+	0x06, 0x67, 0x83, 0x90, 0x81, 0x00, 0x6d, 0x41, 0x2e, 0xfa, 0x2f, 0xb9, 0xe8, 0xe0, 0xf8, 0x90,
+	0x28, 0x88, 0xe4, 0xec, 0x2b, 0x27, 0x1e, 0x03, 0x3e, 0x41, 0xc5, 0x48, 0x60, 0xf9, 0xfd, 0xa0,
+	0xc7, 0x84, 0xeb, 0x7b, 0xa0, 0x2d, 0x4a, 0xc7, 0xb3, 0x54, 0xc7, 0x9b, 0x09, 0x37, 0xb6, 0x15,
+	0xec, 0x2b, 0x67, 0x5a, 0x05, 0x7c, 0x8c, 0x4a, 0xe0, 0x3a, 0x9e, 0xeb, 0xcd, 0xec, 0x6a, 0x49,
+	0x1a, 0x6b, 0x49, 0x63, 0x5b, 0x91, 0x63, 0x57, 0x11, 0x1e, 0xcc, 0x01, 0xbf, 0x47, 0xe5, 0xa1,
+	0x2b, 0xba, 0x36, 0xa7, 0xc3, 0xa9, 0x6d, 0x59, 0xda, 0xfe, 0x4f, 0xda, 0x3a, 0x31, 0x3a, 0xd6,
+	0x95, 0x86, 0x0f, 0x0b, 0x80, 0x6d, 0xb4, 0x15, 0x30, 0xcf, 0x8e, 0x36, 0x67, 0x0a, 0xcb, 0x48,
+	0xba, 0xff, 0xfe, 0x53, 0x77, 0x35, 0xf6, 0xb4, 0x84, 0xd5, 0x99, 0x4f, 0xa9, 0xa3, 0x52, 0xdf,
+	0xf5, 0x04, 0xb3, 0x0d, 0x31, 0x32, 0xba, 0x14, 0xba, 0x0c, 0xb4, 0x95, 0xda, 0x42, 0x7d, 0x95,
+	0x14, 0x54, 0xfd, 0x7c, 0x74, 0x24, 0xab, 0xf8, 0x25, 0x5a, 0xe1, 0xac, 0x47, 0xaf, 0x19, 0x07,
+	0x6d, 0x55, 0x46, 0xff, 0x93, 0x8c, 0x26, 0x8a, 0x20, 0x13, 0x14, 0x9f, 0x21, 0xcc, 0x43, 0x8f,
+	0x81, 0x41, 0x85, 0x60, 0x20, 0xa8, 0xba, 0x37, 0x24, 0x05, 0x3b, 0x29, 0x82, 0x88, 0xdd, 0x9f,
+	0xa2, 0xa4, 0xcc, 0xe7, 0x2a, 0x80, 0x9b, 0xd1, 0x73, 0x0d, 0x81, 0x81, 0x96, 0x93, 0x9a, 0xcd,
+	0xb4, 0xe7, 0x1a, 0x02, 0x23, 0x31, 0x86, 0x4f, 0x11, 0x06, 0x8b, 0x33, 0xe6, 0x31, 0xdb, 0xa0,
+	0xb6, 0xcd, 0x19, 0x44, 0x8b, 0xf3, 0x8f, 0x9d, 0x5f, 0x3b, 0x66, 0xf7, 0x15, 0x4a, 0xca, 0xf0,
+	0xb0, 0xc0, 0x00, 0x77, 0xd0, 0xc6, 0x20, 0xa4, 0x9c, 0x7a, 0xc2, 0x8d, 0xa4, 0x36, 0x0b, 0x7c,
+	0x70, 0x05, 0x68, 0x6b, 0xd2, 0xf9, 0x24, 0xe9, 0x3c, 0x9b, 0xd2, 0x07, 0x0a, 0x26, 0xeb, 0x83,
+	0x44, 0x0d, 0xf0, 0x11, 0x2a, 0xc6, 0x32, 0x83, 0x33, 0xcb, 0xe7, 0x36, 0x68, 0x05, 0xe9, 0xdc,
+	0x4e, 0x79, 0xe3, 0xb1, 0x48, 0x72, 0xa4, 0x60, 0xcf, 0x4e, 0x01, 0xb7, 0xd0, 0x5a, 0x74, 0x74,
+	0x06, 0x13, 0x56, 0xd7, 0xf5, 0x1c, 0xd0, 0x8a, 0xd2, 0xb3, 0x95, 0x7e, 0xe6, 0x6f, 0x15, 0x45,
+	0xf2, 0x7c, 0x3a, 0x01, 0xfc, 0x09, 0x55, 0x2e, 0x19, 0x33, 0x20, 0xf0, 0x3d, 0xf0, 0x39, 0x74,
+	0xdd, 0xc0, 0x08, 0x81, 0x3a, 0x0c, 0xb4, 0x92, 0x94, 0x3d, 0x4d, 0xca, 0xde, 0x31, 0xd6, 0x9e,
+	0xe2, 0x17, 0x11, 0x4d, 0x36, 0x2e, 0x93, 0x45, 0xc0, 0x03, 0xa4, 0xcf, 0xcb, 0xa9, 0x65, 0xf9,
+	0xa1, 0x27, 0xc6, 0x21, 0x65, 0x19, 0xb2, 0xfb, 0xbb, 0x90, 0x7d, 0xb5, 0x4a, 0x65, 0xfd, 0x7b,
+	0xf9, 0x68, 0x0f, 0xf0, 0x67, 0xb4, 0x39, 0x7b, 0x6d, 0xe3, 0x6f, 0x8a, 0xf6, 0x40, 0xc3, 0x32,
+	0xeb, 0xf9, 0x2f, 0x6f, 0xae, 0x33, 0xe1, 0x49, 0x65, 0x90, 0x56, 0x06, 0x4c, 0x91, 0x36, 0x7b,
+	0xea, 0x06, 0x84, 0x66, 0xdf, 0x05, 0x90, 0x8f, 0x7e, 0xfd, 0xb1, 0x88, 0x99, 0x0b, 0x68, 0x4f,
+	0x78, 0x52, 0xe1, 0x69, 0x65, 0x68, 0x1d, 0xdd, 0xdc, 0xe9, 0xd9, 0xdb, 0x3b, 0x3d, 0xfb, 0xe3,
+	0x4e, 0xcf, 0x7e, 0xb9, 0xd7, 0x33, 0xb7, 0xf7, 0x7a, 0xe6, 0xdb, 0xbd, 0x9e, 0xf9, 0xd8, 0x70,
+	0x5c, 0xd1, 0x0d, 0xcd, 0x86, 0xe5, 0xf7, 0x9b, 0x2a, 0xa4, 0x47, 0x4d, 0x88, 0x87, 0xcd, 0xd1,
+	0xcc, 0x2f, 0x41, 0x5c, 0x07, 0x0c, 0xcc, 0x65, 0xf9, 0x3f, 0x78, 0xf1, 0x73, 0x00, 0x04, 0x29,
+	0x0c, 0x13, 0x9a, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +287,168 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RuneEtchingSubmissions) > 0 {
+		for iNdEx := len(m.RuneEtchingSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneEtchingSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.QuarantinedWithdrawals) > 0 {
+		for iNdEx := len(m.QuarantinedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.FeeSponsorshipAccountUsages) > 0 {
+		for iNdEx := len(m.FeeSponsorshipAccountUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorshipAccountUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FeeSponsorshipUsages) > 0 {
+		for iNdEx := len(m.FeeSponsorshipUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorshipUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RuneEtchings) > 0 {
+		for iNdEx := len(m.RuneEtchings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneEtchings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for iNdEx := len(m.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScreenedAddresses) > 0 {
+		for iNdEx := len(m.ScreenedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScreenedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RunesAttestations) > 0 {
+		for iNdEx := len(m.RunesAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunesAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.MintedTxHashes) > 0 {
 		for iNdEx := len(m.MintedTxHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MintedTxHashes[iNdEx])
@@ -347,6 +616,72 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RunesAttestations) > 0 {
+		for _, e := range m.RunesAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScreenedAddresses) > 0 {
+		for _, e := range m.ScreenedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for _, e := range m.DepositRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RuneEtchings) > 0 {
+		for _, e := range m.RuneEtchings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorshipUsages) > 0 {
+		for _, e := range m.FeeSponsorshipUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorshipAccountUsages) > 0 {
+		for _, e := range m.FeeSponsorshipAccountUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedWithdrawals) > 0 {
+		for _, e := range m.QuarantinedWithdrawals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RuneEtchingSubmissions) > 0 {
+		for _, e := range m.RuneEtchingSubmissions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -654,6 +989,380 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.MintedTxHashes = append(m.MintedTxHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, &Relayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunesAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunesAttestations = append(m.RunesAttestations, &RunesAttestation{})
+			if err := m.RunesAttestations[len(m.RunesAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, &Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreenedAddresses = append(m.ScreenedAddresses, &ScreenedAddress{})
+			if err := m.ScreenedAddresses[len(m.ScreenedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, &QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRecords = append(m.DepositRecords, &DepositRecord{})
+			if err := m.DepositRecords[len(m.DepositRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneEtchings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneEtchings = append(m.RuneEtchings, &RuneEtching{})
+			if err := m.RuneEtchings[len(m.RuneEtchings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorshipUsages = append(m.FeeSponsorshipUsages, &FeeSponsorshipUsage{})
+			if err := m.FeeSponsorshipUsages[len(m.FeeSponsorshipUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipAccountUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorshipAccountUsages = append(m.FeeSponsorshipAccountUsages, &FeeSponsorshipAccountUsage{})
+			if err := m.FeeSponsorshipAccountUsages[len(m.FeeSponsorshipAccountUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedWithdrawals = append(m.QuarantinedWithdrawals, &QuarantinedWithdrawal{})
+			if err := m.QuarantinedWithdrawals[len(m.QuarantinedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneEtchingSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneEtchingSubmissions = append(m.RuneEtchingSubmissions, &RuneEtchingSubmission{})
+			if err := m.RuneEtchingSubmissions[len(m.RuneEtchingSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -19,6 +22,12 @@ const (
 
 	// FeeSponsorName defines the gas fee sponsor module account name
 	FeeSponsorName = "fee_sponsor"
+
+	// RelayerBondPoolName defines the module account name for the relayer bonds
+	RelayerBondPoolName = "relayer_bond_pool"
+
+	// QuarantineName defines the module account name for the quarantined deposits
+	QuarantineName = "quarantine"
 )
 
 var (
@@ -54,6 +63,28 @@ var (
 
 	RateLimitKey                = []byte{0x70} // key for the rate limit
 	RateLimitByAddressKeyPrefix = []byte{0x71} // key prefix for the rate limit by address
+
+	RelayerKeyPrefix               = []byte{0x80} // key prefix for the relayer
+	RunesAttestationKeyPrefix      = []byte{0x81} // key prefix for the runes deposit attestation
+	RuneEtchingKeyPrefix           = []byte{0x82} // key prefix for the rune etching
+	RuneEtchingSubmissionKeyPrefix = []byte{0x83} // key prefix for the rune etching submission
+
+	PauseIdKey           = []byte{0x90} // key for the pause id
+	PauseKeyPrefix       = []byte{0x91} // key prefix for the pause
+	ActivePauseKeyPrefix = []byte{0x92} // key prefix for the active pause by target
+	LatestPauseKeyPrefix = []byte{0x93} // key prefix for the latest pause by target
+
+	ScreenedAddressKeyPrefix       = []byte{0xA0} // key prefix for the screened address
+	QuarantinedDepositKeyPrefix    = []byte{0xA1} // key prefix for the quarantined deposit
+	QuarantinedWithdrawalKeyPrefix = []byte{0xA2} // key prefix for the quarantined withdrawal
+
+	DepositRecordKeyPrefix            = []byte{0xB0} // key prefix for the deposit record
+	DepositRecordByRecipientKeyPrefix = []byte{0xB1} // key prefix for the deposit record by recipient
+	DepositRecordBySenderKeyPrefix    = []byte{0xB2} // key prefix for the deposit record by sender
+	DepositRecordByAssetKeyPrefix     = []byte{0xB3} // key prefix for the deposit record by asset type
+
+	FeeSponsorshipUsageKeyPrefix        = []byte{0xC0} // key prefix for the fee sponsorship usage by policy
+	FeeSponsorshipAccountUsageKeyPrefix = []byte{0xC1} // key prefix for the fee sponsorship usage by policy, epoch and account
 )
 
 func BtcWithdrawRequestKey(sequence uint64) []byte {
@@ -137,3 +168,93 @@ func IBCWithdrawRequestQueueKey(channelId string, sequence uint64) []byte {
 func RateLimitByAddressKey(address string) []byte {
 	return append(RateLimitByAddressKeyPrefix, []byte(address)...)
 }
+
+func RelayerKey(address string) []byte {
+	return append(RelayerKeyPrefix, []byte(address)...)
+}
+
+func RunesAttestationKey(txid string) []byte {
+	return append(RunesAttestationKeyPrefix, []byte(txid)...)
+}
+
+func RuneEtchingKey(id string) []byte {
+	return append(RuneEtchingKeyPrefix, []byte(id)...)
+}
+
+func RuneEtchingSubmissionsKeyPrefix(id string) []byte {
+	return append(RuneEtchingSubmissionKeyPrefix, address.MustLengthPrefix([]byte(id))...)
+}
+
+func RuneEtchingSubmissionKey(id string, relayer string) []byte {
+	return append(RuneEtchingSubmissionsKeyPrefix(id), []byte(relayer)...)
+}
+
+func PauseKey(id uint64) []byte {
+	return append(PauseKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func ActivePauseKey(target PauseTarget, assetType AssetType) []byte {
+	return append(append(ActivePauseKeyPrefix, sdk.Uint64ToBigEndian(uint64(target))...), sdk.Uint64ToBigEndian(uint64(assetType))...)
+}
+
+func LatestPauseKey(target PauseTarget, assetType AssetType) []byte {
+	return append(append(LatestPauseKeyPrefix, sdk.Uint64ToBigEndian(uint64(target))...), sdk.Uint64ToBigEndian(uint64(assetType))...)
+}
+
+func ScreenedAddressKey(address string) []byte {
+	return append(ScreenedAddressKeyPrefix, []byte(address)...)
+}
+
+func QuarantinedDepositKey(txid string) []byte {
+	return append(QuarantinedDepositKeyPrefix, []byte(txid)...)
+}
+
+func QuarantinedWithdrawalKey(sequence uint64) []byte {
+	return append(QuarantinedWithdrawalKeyPrefix, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func DepositRecordKey(txid string) []byte {
+	return append(DepositRecordKeyPrefix, []byte(txid)...)
+}
+
+func DepositRecordByRecipientKey(recipient string, height int64, txid string) []byte {
+	key := append(DepositRecordsByRecipientKeyPrefix(recipient), sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, []byte(txid)...)
+}
+
+func DepositRecordsByRecipientKeyPrefix(recipient string) []byte {
+	return append(DepositRecordByRecipientKeyPrefix, address.MustLengthPrefix([]byte(recipient))...)
+}
+
+func DepositRecordBySenderKey(sender string, height int64, txid string) []byte {
+	key := append(DepositRecordsBySenderKeyPrefix(sender), sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, []byte(txid)...)
+}
+
+func DepositRecordsBySenderKeyPrefix(sender string) []byte {
+	return append(DepositRecordBySenderKeyPrefix, address.MustLengthPrefix([]byte(sender))...)
+}
+
+func DepositRecordByAssetKey(asset AssetType, height int64, txid string) []byte {
+	key := append(append(DepositRecordByAssetKeyPrefix, sdk.Uint64ToBigEndian(uint64(asset))...), sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, []byte(txid)...)
+}
+
+func FeeSponsorshipUsageKey(policyId string) []byte {
+	return append(FeeSponsorshipUsageKeyPrefix, []byte(policyId)...)
+}
+
+func FeeSponsorshipAccountUsageKey(policyId string, epochStartTime time.Time, address string) []byte {
+	key := append(FeeSponsorshipAccountUsageByPolicyKey(policyId), sdk.FormatTimeBytes(epochStartTime)...)
+
+	return append(key, []byte(address)...)
+}
+
+func FeeSponsorshipAccountUsageByPolicyKey(policyId string) []byte {
+	key := append(FeeSponsorshipAccountUsageKeyPrefix, sdk.Uint64ToBigEndian(uint64(len(policyId)))...)
+
+	return append(key, []byte(policyId)...)
+}
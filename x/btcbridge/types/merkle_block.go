@@ -0,0 +1,199 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// minimum transaction size in bytes, used to bound the number of transactions in a block
+	MinTransactionSize = 60
+)
+
+// MerkleBlock defines the parsed BIP-37 merkle block
+type MerkleBlock struct {
+	// block header
+	Header wire.BlockHeader
+	// merkle root computed from the partial merkle tree
+	MerkleRoot chainhash.Hash
+	// matched tx hashes
+	MatchedTxHashes []chainhash.Hash
+}
+
+// BlockHash returns the block hash of the merkle block
+func (mb *MerkleBlock) BlockHash() chainhash.Hash {
+	return mb.Header.BlockHash()
+}
+
+// HasTx returns true if the given tx is matched in the merkle block, false otherwise
+func (mb *MerkleBlock) HasTx(txHash *chainhash.Hash) bool {
+	for _, hash := range mb.MatchedTxHashes {
+		if hash.IsEqual(txHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseMerkleBlock parses and verifies the given hex encoded merkle block, i.e. the output of gettxoutproof
+// The merkle root computed from the partial merkle tree must match the one in the block header
+func ParseMerkleBlock(merkleBlockHex string) (*MerkleBlock, error) {
+	bz, err := hex.DecodeString(merkleBlockHex)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleBlock, "failed to decode: %v", err)
+	}
+
+	var msg wire.MsgMerkleBlock
+	if err := msg.BtcDecode(bytes.NewReader(bz), wire.ProtocolVersion, wire.BaseEncoding); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleBlock, "failed to deserialize: %v", err)
+	}
+
+	root, matches, err := extractMatches(msg.Transactions, msg.Hashes, msg.Flags)
+	if err != nil {
+		return nil, err
+	}
+
+	if !root.IsEqual(&msg.Header.MerkleRoot) {
+		return nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "merkle root mismatch")
+	}
+
+	return &MerkleBlock{
+		Header:          msg.Header,
+		MerkleRoot:      root,
+		MatchedTxHashes: matches,
+	}, nil
+}
+
+// ResolveBlockHash returns the block hash from the given merkle block if provided, otherwise the given block hash
+// The given block hash must match the merkle block if both provided
+func ResolveBlockHash(blockHash string, merkleBlockHex string) (string, error) {
+	if len(merkleBlockHex) == 0 {
+		return blockHash, nil
+	}
+
+	merkleBlock, err := ParseMerkleBlock(merkleBlockHex)
+	if err != nil {
+		return "", err
+	}
+
+	merkleBlockHash := merkleBlock.BlockHash()
+	if len(blockHash) != 0 && blockHash != merkleBlockHash.String() {
+		return "", errorsmod.Wrap(ErrInvalidMerkleBlock, "block hash mismatch")
+	}
+
+	return merkleBlockHash.String(), nil
+}
+
+// partialMerkleTree is used to traverse the BIP-37 partial merkle tree
+type partialMerkleTree struct {
+	numTxs uint32
+	hashes []*chainhash.Hash
+	flags  []byte
+
+	bitsUsed   uint32
+	hashesUsed uint32
+	bad        bool
+
+	matches []chainhash.Hash
+}
+
+// extractMatches extracts the matched tx hashes and computes the merkle root from the partial merkle tree
+// See https://github.com/bitcoin/bips/blob/master/bip-0037.mediawiki#partial-merkle-branch-format
+func extractMatches(numTxs uint32, hashes []*chainhash.Hash, flags []byte) (chainhash.Hash, []chainhash.Hash, error) {
+	if numTxs == 0 {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "no transactions")
+	}
+
+	if numTxs > blockchain.MaxBlockBaseSize/MinTransactionSize {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "too many transactions")
+	}
+
+	if uint32(len(hashes)) > numTxs {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "more hashes than transactions")
+	}
+
+	if len(flags)*8 < len(hashes) {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "fewer flag bits than hashes")
+	}
+
+	tree := &partialMerkleTree{
+		numTxs: numTxs,
+		hashes: hashes,
+		flags:  flags,
+	}
+
+	height := uint32(0)
+	for tree.width(height) > 1 {
+		height++
+	}
+
+	root := tree.traverseAndExtract(height, 0)
+	if tree.bad {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "malformed partial merkle tree")
+	}
+
+	// all flag bits and hashes must be consumed
+	if (tree.bitsUsed+7)/8 != uint32(len(flags)) || tree.hashesUsed != uint32(len(hashes)) {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "unused flag bits or hashes")
+	}
+
+	if len(tree.matches) == 0 {
+		return chainhash.Hash{}, nil, errorsmod.Wrap(ErrInvalidMerkleBlock, "no matched transactions")
+	}
+
+	return root, tree.matches, nil
+}
+
+// width returns the number of nodes at the given height
+func (t *partialMerkleTree) width(height uint32) uint32 {
+	return (t.numTxs + (1 << height) - 1) >> height
+}
+
+// traverseAndExtract traverses the tree in depth-first order and returns the hash of the node at the given height and position
+func (t *partialMerkleTree) traverseAndExtract(height uint32, pos uint32) chainhash.Hash {
+	if t.bitsUsed >= uint32(len(t.flags))*8 {
+		t.bad = true
+		return chainhash.Hash{}
+	}
+
+	parentOfMatch := t.flags[t.bitsUsed/8]&(1<<(t.bitsUsed%8)) != 0
+	t.bitsUsed++
+
+	if height == 0 || !parentOfMatch {
+		// leaf or the node whose descendants are not matched
+		if t.hashesUsed >= uint32(len(t.hashes)) {
+			t.bad = true
+			return chainhash.Hash{}
+		}
+
+		hash := *t.hashes[t.hashesUsed]
+		t.hashesUsed++
+
+		if height == 0 && parentOfMatch {
+			t.matches = append(t.matches, hash)
+		}
+
+		return hash
+	}
+
+	left := t.traverseAndExtract(height-1, pos*2)
+
+	right := left
+	if pos*2+1 < t.width(height-1) {
+		right = t.traverseAndExtract(height-1, pos*2+1)
+
+		// the left and right branches must not be identical to prevent CVE-2012-2459
+		if right.IsEqual(&left) {
+			t.bad = true
+		}
+	}
+
+	return blockchain.HashMerkleBranches(&left, &right)
+}
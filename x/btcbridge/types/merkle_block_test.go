@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bloom"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+func TestParseMerkleBlock(t *testing.T) {
+	msgBlock := &wire.MsgBlock{}
+
+	for i := 0; i < 5; i++ {
+		tx := wire.NewMsgTx(types.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(int64(i+1)*1000, []byte{0x51}))
+
+		msgBlock.AddTransaction(tx)
+	}
+
+	block := btcutil.NewBlock(msgBlock)
+	msgBlock.Header.MerkleRoot = blockchain.CalcMerkleRoot(block.Transactions(), false)
+
+	matchedTxs := []*btcutil.Tx{block.Transactions()[1], block.Transactions()[4]}
+
+	filter := bloom.NewFilter(uint32(len(matchedTxs)), 0, 0.000001, wire.BloomUpdateNone)
+	for _, tx := range matchedTxs {
+		filter.AddHash(tx.Hash())
+	}
+
+	merkleBlock, _ := bloom.NewMerkleBlock(block, filter)
+
+	var buf bytes.Buffer
+	require.NoError(t, merkleBlock.BtcEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding))
+
+	parsed, err := types.ParseMerkleBlock(hex.EncodeToString(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, msgBlock.BlockHash(), parsed.BlockHash())
+	require.Equal(t, msgBlock.Header.MerkleRoot, parsed.MerkleRoot)
+
+	for _, tx := range matchedTxs {
+		require.True(t, parsed.HasTx(tx.Hash()), "tx %s should be matched", tx.Hash())
+	}
+
+	require.False(t, parsed.HasTx(block.Transactions()[0].Hash()), "unmatched tx should not be included")
+
+	blockHash, err := types.ResolveBlockHash("", hex.EncodeToString(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, msgBlock.BlockHash().String(), blockHash)
+
+	_, err = types.ResolveBlockHash(block.Transactions()[0].Hash().String(), hex.EncodeToString(buf.Bytes()))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleBlock, "block hash mismatch should fail")
+
+	// tamper with the merkle root
+	merkleBlock.Header.MerkleRoot[0] ^= 0xff
+
+	buf.Reset()
+	require.NoError(t, merkleBlock.BtcEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding))
+
+	_, err = types.ParseMerkleBlock(hex.EncodeToString(buf.Bytes()))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleBlock, "tampered merkle root should fail")
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgBondRelayer{}
+
+func NewMsgBondRelayer(
+	sender string,
+	amount sdk.Coin,
+) *MsgBondRelayer {
+	return &MsgBondRelayer{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBondRelayer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRelayerBond, "invalid bond amount %s", msg.Amount)
+	}
+
+	return nil
+}
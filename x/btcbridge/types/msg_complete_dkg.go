@@ -17,6 +17,8 @@ func NewMsgCompleteDKG(
 	vaults []string,
 	consPubKey string,
 	signature string,
+	internalKeys []string,
+	pubKeys []string,
 ) *MsgCompleteDKG {
 	return &MsgCompleteDKG{
 		Sender:          sender,
@@ -24,6 +26,8 @@ func NewMsgCompleteDKG(
 		Vaults:          vaults,
 		ConsensusPubkey: consPubKey,
 		Signature:       signature,
+		InternalKeys:    internalKeys,
+		PubKeys:         pubKeys,
 	}
 }
 
@@ -47,6 +51,22 @@ func (m *MsgCompleteDKG) ValidateBasic() error {
 		vaults[v] = true
 	}
 
+	if len(m.InternalKeys) != 0 {
+		if len(m.InternalKeys) != len(m.Vaults) {
+			return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "internal keys do not match vaults")
+		}
+
+		for _, k := range m.InternalKeys {
+			if _, err := ParseXOnlyPubKey(k); err != nil {
+				return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "invalid internal key")
+			}
+		}
+	}
+
+	if len(m.PubKeys) != 0 && len(m.PubKeys) != len(m.Vaults) {
+		return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "pub keys do not match vaults")
+	}
+
 	consensusPubKey, err := base64.StdEncoding.DecodeString(m.ConsensusPubkey)
 	if err != nil {
 		return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "failed to decode the consensus pub key")
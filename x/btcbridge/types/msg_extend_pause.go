@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgExtendPause{}
+
+// ValidateBasic performs basic MsgExtendPause message validation.
+func (m *MsgExtendPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidatePauseTarget(m.Target, m.AssetType); err != nil {
+		return err
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrap(ErrInvalidPause, "duration must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum number of outputs in a single multi-recipient withdrawal
+	MaxWithdrawOutputNum = 100
+)
+
+var _ sdk.Msg = &MsgMultiWithdrawToBitcoin{}
+
+func NewMsgMultiWithdrawToBitcoin(
+	sender string,
+	outputs []WithdrawOutput,
+) *MsgMultiWithdrawToBitcoin {
+	return &MsgMultiWithdrawToBitcoin{
+		Sender:  sender,
+		Outputs: outputs,
+	}
+}
+
+func (msg *MsgMultiWithdrawToBitcoin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if len(msg.Outputs) == 0 || len(msg.Outputs) > MaxWithdrawOutputNum {
+		return errorsmod.Wrapf(ErrInvalidWithdrawOutputs, "the number of outputs must be between 1 and %d", MaxWithdrawOutputNum)
+	}
+
+	denom := ""
+
+	for _, output := range msg.Outputs {
+		if !IsValidBtcAddress(output.Address) {
+			return errorsmod.Wrapf(ErrInvalidBtcAddress, "invalid output address %s", output.Address)
+		}
+
+		amount, err := sdk.ParseCoinNormalized(output.Amount)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid withdrawal amount")
+		}
+
+		if !amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidWithdrawAmount, "withdrawal amount must be positive")
+		}
+
+		if len(denom) != 0 && amount.Denom != denom {
+			return errorsmod.Wrap(ErrInvalidWithdrawOutputs, "all outputs must be of the same denom")
+		}
+
+		denom = amount.Denom
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgPause{}
+
+func NewMsgPause(
+	sender string,
+	target PauseTarget,
+	assetType AssetType,
+	duration time.Duration,
+	reason string,
+) *MsgPause {
+	return &MsgPause{
+		Sender:    sender,
+		Target:    target,
+		AssetType: assetType,
+		Duration:  duration,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if err := ValidatePauseTarget(msg.Target, msg.AssetType); err != nil {
+		return err
+	}
+
+	if msg.Duration < 0 {
+		return errorsmod.Wrap(ErrInvalidPause, "duration cannot be negative")
+	}
+
+	return nil
+}
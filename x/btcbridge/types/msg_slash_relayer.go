@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSlashRelayer{}
+
+// ValidateBasic performs basic MsgSlashRelayer message validation.
+func (m *MsgSlashRelayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Relayer); err != nil {
+		return errorsmod.Wrap(err, "invalid relayer address")
+	}
+
+	if _, err := chainhash.NewHashFromStr(m.Txid); err != nil {
+		return ErrInvalidTxHash
+	}
+
+	if _, ok := RelayerMisbehaviour_name[int32(m.Misbehaviour)]; !ok || m.Misbehaviour == RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_UNSPECIFIED {
+		return ErrInvalidMisbehaviour
+	}
+
+	return nil
+}
@@ -29,7 +29,7 @@ func (msg *MsgSubmitDepositTransaction) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
 	}
 
-	if len(msg.Blockhash) == 0 {
+	if len(msg.Blockhash) == 0 && len(msg.MerkleBlock) == 0 {
 		return errorsmod.Wrap(ErrInvalidBtcTransaction, "blockhash cannot be empty")
 	}
 
@@ -41,7 +41,15 @@ func (msg *MsgSubmitDepositTransaction) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidBtcTransaction, "transaction cannot be empty")
 	}
 
-	if len(msg.Proof) == 0 {
+	if len(msg.MerkleBlock) != 0 {
+		if len(msg.Proof) != 0 {
+			return errorsmod.Wrap(ErrInvalidBtcTransaction, "proof and merkle block cannot be both provided")
+		}
+
+		if _, err := ResolveBlockHash(msg.Blockhash, msg.MerkleBlock); err != nil {
+			return err
+		}
+	} else if len(msg.Proof) == 0 {
 		return errorsmod.Wrap(ErrInvalidBtcTransaction, "proof cannot be empty")
 	}
 
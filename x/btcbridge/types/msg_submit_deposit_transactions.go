@@ -0,0 +1,102 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum number of deposits in a single batch
+	MaxDepositBatchSize = 100
+)
+
+var _ sdk.Msg = &MsgSubmitDepositTransactions{}
+
+func NewMsgSubmitDepositTransactions(
+	sender string,
+	blocks []DepositBlock,
+	prevTxs []string,
+	deposits []DepositTransaction,
+) *MsgSubmitDepositTransactions {
+	return &MsgSubmitDepositTransactions{
+		Sender:   sender,
+		Blocks:   blocks,
+		PrevTxs:  prevTxs,
+		Deposits: deposits,
+	}
+}
+
+func (msg *MsgSubmitDepositTransactions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if len(msg.Blocks) == 0 {
+		return errorsmod.Wrap(ErrInvalidDepositBatch, "blocks cannot be empty")
+	}
+
+	for _, block := range msg.Blocks {
+		if len(block.Blockhash) == 0 && len(block.MerkleBlock) == 0 {
+			return errorsmod.Wrap(ErrInvalidBtcTransaction, "blockhash cannot be empty")
+		}
+
+		if _, err := ResolveBlockHash(block.Blockhash, block.MerkleBlock); err != nil {
+			return err
+		}
+	}
+
+	if len(msg.PrevTxs) == 0 {
+		return errorsmod.Wrap(ErrInvalidDepositBatch, "previous transactions cannot be empty")
+	}
+
+	for _, prevTx := range msg.PrevTxs {
+		if len(prevTx) == 0 {
+			return errorsmod.Wrap(ErrInvalidBtcTransaction, "previous transaction cannot be empty")
+		}
+	}
+
+	if len(msg.Deposits) == 0 || len(msg.Deposits) > MaxDepositBatchSize {
+		return errorsmod.Wrapf(ErrInvalidDepositBatch, "the number of deposits must be between 1 and %d", MaxDepositBatchSize)
+	}
+
+	for i, deposit := range msg.Deposits {
+		if int(deposit.BlockIndex) >= len(msg.Blocks) {
+			return errorsmod.Wrapf(ErrInvalidDepositBatch, "block index out of range for deposit %d", i)
+		}
+
+		if int(deposit.PrevTxIndex) >= len(msg.PrevTxs) {
+			return errorsmod.Wrapf(ErrInvalidDepositBatch, "previous transaction index out of range for deposit %d", i)
+		}
+
+		if len(deposit.TxBytes) == 0 {
+			return errorsmod.Wrap(ErrInvalidBtcTransaction, "transaction cannot be empty")
+		}
+
+		if len(msg.Blocks[deposit.BlockIndex].MerkleBlock) != 0 {
+			if len(deposit.Proof) != 0 {
+				return errorsmod.Wrap(ErrInvalidBtcTransaction, "proof and merkle block cannot be both provided")
+			}
+		} else if len(deposit.Proof) == 0 {
+			return errorsmod.Wrap(ErrInvalidBtcTransaction, "proof cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GetDeposit returns the single deposit message for the deposit of the given index
+// Assume that the message has been validated
+func (msg *MsgSubmitDepositTransactions) GetDeposit(index int) *MsgSubmitDepositTransaction {
+	deposit := msg.Deposits[index]
+	block := msg.Blocks[deposit.BlockIndex]
+
+	return &MsgSubmitDepositTransaction{
+		Sender:      msg.Sender,
+		Blockhash:   block.Blockhash,
+		PrevTxBytes: msg.PrevTxs[deposit.PrevTxIndex],
+		TxBytes:     deposit.TxBytes,
+		Proof:       deposit.Proof,
+		MerkleBlock: block.MerkleBlock,
+	}
+}
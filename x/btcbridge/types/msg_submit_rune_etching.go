@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitRuneEtching{}
+
+func NewMsgSubmitRuneEtching(
+	sender string,
+	etching RuneEtching,
+) *MsgSubmitRuneEtching {
+	return &MsgSubmitRuneEtching{
+		Sender:  sender,
+		Etching: etching,
+	}
+}
+
+// ValidateBasic performs basic MsgSubmitRuneEtching message validation.
+func (m *MsgSubmitRuneEtching) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return m.Etching.Validate()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/schnorr"
 
@@ -47,8 +48,11 @@ func (msg *MsgSubmitSignatures) ValidateBasic() error {
 			return errorsmod.Wrap(ErrInvalidSignature, "failed to decode signature")
 		}
 
+		// schnorr signature for taproot inputs or DER encoded ECDSA signature for segwit v0 inputs
 		if _, err := schnorr.ParseSignature(sigBytes); err != nil {
-			return errorsmod.Wrap(ErrInvalidSignature, "invalid schnorr signature")
+			if _, err := ecdsa.ParseDERSignature(sigBytes); err != nil {
+				return errorsmod.Wrap(ErrInvalidSignature, "invalid schnorr or ecdsa signature")
+			}
 		}
 	}
 
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUnbondRelayer{}
+
+func NewMsgUnbondRelayer(
+	sender string,
+) *MsgUnbondRelayer {
+	return &MsgUnbondRelayer{
+		Sender: sender,
+	}
+}
+
+func (msg *MsgUnbondRelayer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUnpause{}
+
+func NewMsgUnpause(
+	sender string,
+	target PauseTarget,
+	assetType AssetType,
+) *MsgUnpause {
+	return &MsgUnpause{
+		Sender:    sender,
+		Target:    target,
+		AssetType: assetType,
+	}
+}
+
+func (msg *MsgUnpause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	return ValidatePauseTarget(msg.Target, msg.AssetType)
+}
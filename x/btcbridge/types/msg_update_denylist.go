@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+)
+
+var _ sdk.Msg = &MsgUpdateDenylist{}
+
+// ValidateBasic performs basic MsgUpdateDenylist message validation.
+func (m *MsgUpdateDenylist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Additions) == 0 && len(m.Removals) == 0 {
+		return errorsmod.Wrap(ErrInvalidDenylist, "neither additions nor removals provided")
+	}
+
+	addresses := make(map[string]bool)
+
+	for _, addr := range append(m.Additions, m.Removals...) {
+		if _, err := btcutil.DecodeAddress(addr, bitcoin.Network); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDenylist, "invalid bitcoin address %s: %v", addr, err)
+		}
+
+		if addresses[addr] {
+			return errorsmod.Wrapf(ErrInvalidDenylist, "duplicate address %s", addr)
+		}
+
+		addresses[addr] = true
+	}
+
+	return nil
+}
@@ -20,7 +20,7 @@ import (
 )
 
 var (
-	// default confirmation depth relative to oracle for bitcoin deposit transactions
+	// default confirmation depth relative to oracle for bitcoin deposit transactions of the base tier
 	DefaultDepositConfirmationDepth = int32(1)
 
 	// default confirmation depth relative to oracle for bitcoin withdrawal transactions
@@ -64,18 +64,40 @@ var (
 
 	// default maximum sponsorship fee
 	DefaultMaxSponsorFee = sdk.NewCoins(sdk.NewCoin("ubtw", sdkmath.NewInt(200))) // 200 UBTW
+
+	// default minimum relayer bond
+	DefaultRelayerMinBond = sdk.NewCoin("ubtw", sdkmath.NewInt(1000000000)) // 1000 BTW
+
+	// default percentage of the protocol deposit fee rewarded to the relayer
+	DefaultRelayerDepositRewardPercentage = uint32(50) // 50%
+
+	// default percentage of the relayer bond slashed for each misbehaviour
+	DefaultRelayerSlashPercentage = uint32(10) // 10%
+
+	// default relayer unbonding period
+	DefaultRelayerUnbondingPeriod = time.Duration(1209600) * time.Second // 14 days
+
+	// default number of the relayers required to submit the identical rune etching
+	DefaultRuneEtchingQuorum = uint32(2)
+
+	// default maximum duration of the pause initiated by the guardian
+	DefaultMaxPauseDuration = time.Duration(259200) * time.Second // 3 days
+
+	// default cooldown period after the guardian pause
+	DefaultPauseCooldown = time.Duration(86400) * time.Second // 1 day
+
+	// default relative time lock of the vault recovery script path
+	DefaultVaultRecoveryTimelock = uint32(52560) // about 1 year
 )
 
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		DepositConfirmationDepth:  DefaultDepositConfirmationDepth,
 		WithdrawConfirmationDepth: DefaultWithdrawConfirmationDepth,
 		MaxAcceptableBlockDepth:   100,
 		BtcVoucherDenom:           DefaultBtcVoucherDenom,
 		DepositEnabled:            true,
 		WithdrawEnabled:           true,
-		TrustedNonBtcRelayers:     []string{},
 		TrustedFeeProviders:       []string{},
 		FeeRateValidityPeriod:     DefaultFeeRateValidityPeriod,
 		Vaults:                    []*Vault{},
@@ -115,6 +137,30 @@ func NewParams() Params {
 		FeeSponsorshipParams: FeeSponsorshipParams{
 			MaxSponsorFee: DefaultMaxSponsorFee,
 		},
+		RelayerParams: RelayerParams{
+			MinBond:                 DefaultRelayerMinBond,
+			DepositRewardPercentage: DefaultRelayerDepositRewardPercentage,
+			SlashPercentage:         DefaultRelayerSlashPercentage,
+			UnbondingPeriod:         DefaultRelayerUnbondingPeriod,
+			RuneEtchingQuorum:       DefaultRuneEtchingQuorum,
+			GrandfatheredRelayers:   []string{},
+		},
+		GuardianParams: GuardianParams{
+			Guardian:         "",
+			MaxPauseDuration: DefaultMaxPauseDuration,
+			PauseCooldown:    DefaultPauseCooldown,
+		},
+		DepositConfirmationParams: DepositConfirmationParams{
+			Tiers: []DepositConfirmationTier{
+				{MinAmount: 0, ConfirmationDepth: DefaultDepositConfirmationDepth},
+			},
+			RunesPriceSymbols: []RunesPriceSymbol{},
+		},
+		VaultRecoveryParams: VaultRecoveryParams{
+			PubKeys:   []string{},
+			Threshold: 0,
+			Timelock:  DefaultVaultRecoveryTimelock,
+		},
 	}
 }
 
@@ -125,7 +171,7 @@ func DefaultParams() Params {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if err := validateConfirmationParams(p.DepositConfirmationDepth, p.WithdrawConfirmationDepth); err != nil {
+	if err := validateConfirmationParams(&p.DepositConfirmationParams, p.WithdrawConfirmationDepth); err != nil {
 		return err
 	}
 
@@ -133,10 +179,6 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateNonBtcRelayers(p.TrustedNonBtcRelayers); err != nil {
-		return err
-	}
-
 	if err := validateFeeProviders(p.TrustedFeeProviders); err != nil {
 		return err
 	}
@@ -169,7 +211,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateFeeSponsorshipParams(&p.FeeSponsorshipParams)
+	if err := validateFeeSponsorshipParams(&p.FeeSponsorshipParams); err != nil {
+		return err
+	}
+
+	if err := validateRelayerParams(&p.RelayerParams); err != nil {
+		return err
+	}
+
+	if err := validateGuardianParams(&p.GuardianParams); err != nil {
+		return err
+	}
+
+	return p.VaultRecoveryParams.Validate()
 }
 
 // SelectVaultByAddress returns the vault by the given address
@@ -233,24 +287,12 @@ func SelectVaultByPkScript(vaults []*Vault, pkScript []byte) *Vault {
 }
 
 // validateConfirmationParams validates the given confirmation params
-func validateConfirmationParams(depositConfirmationDepth int32, withdrawConfirmationDepth int32) error {
-	if depositConfirmationDepth <= 0 || withdrawConfirmationDepth <= 0 {
+func validateConfirmationParams(depositConfirmationParams *DepositConfirmationParams, withdrawConfirmationDepth int32) error {
+	if withdrawConfirmationDepth <= 0 {
 		return errorsmod.Wrapf(ErrInvalidParams, "confirmation depth must be greater than 0")
 	}
 
-	return nil
-}
-
-// validateNonBtcRelayers validates the given non btc relayers
-func validateNonBtcRelayers(relayers []string) error {
-	for _, relayer := range relayers {
-		_, err := sdk.AccAddressFromBech32(relayer)
-		if err != nil {
-			return ErrInvalidRelayers
-		}
-	}
-
-	return nil
+	return validateDepositConfirmationParams(depositConfirmationParams)
 }
 
 // validateFeeProviders validates the given fee providers
@@ -305,6 +347,12 @@ func validateVaults(vaults []*Vault) error {
 		if v.AssetType == AssetType_ASSET_TYPE_UNSPECIFIED {
 			return errorsmod.Wrapf(ErrInvalidParams, "invalid asset type")
 		}
+
+		if v.Recovery != nil {
+			if err := v.Recovery.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -380,5 +428,81 @@ func validateIBCParams(params *IBCParams) error {
 
 // validateFeeSponsorshipParams validates the given fee sponsorship params
 func validateFeeSponsorshipParams(params *FeeSponsorshipParams) error {
-	return params.MaxSponsorFee.Validate()
+	if err := params.MaxSponsorFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid maximum sponsorship fee: %v", err)
+	}
+
+	policyIds := make(map[string]bool)
+
+	for _, policy := range params.Policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		if policyIds[policy.Id] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate fee sponsorship policy %s", policy.Id)
+		}
+
+		policyIds[policy.Id] = true
+	}
+
+	return nil
+}
+
+// validateRelayerParams validates the given relayer params
+func validateRelayerParams(params *RelayerParams) error {
+	if err := params.MinBond.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid minimum relayer bond: %v", err)
+	}
+
+	if params.DepositRewardPercentage > 100 {
+		return errorsmod.Wrapf(ErrInvalidParams, "deposit reward percentage must be between [0, 100]")
+	}
+
+	if params.SlashPercentage > 100 {
+		return errorsmod.Wrapf(ErrInvalidParams, "slash percentage must be between [0, 100]")
+	}
+
+	if params.UnbondingPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid relayer unbonding period")
+	}
+
+	if params.RuneEtchingQuorum == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "rune etching quorum must be greater than 0")
+	}
+
+	relayers := make(map[string]bool)
+
+	for _, relayer := range params.GrandfatheredRelayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRelayers, "invalid grandfathered relayer address: %v", err)
+		}
+
+		if relayers[relayer] {
+			return errorsmod.Wrapf(ErrInvalidRelayers, "duplicate grandfathered relayer %s", relayer)
+		}
+
+		relayers[relayer] = true
+	}
+
+	return nil
+}
+
+// validateGuardianParams validates the given guardian params
+func validateGuardianParams(params *GuardianParams) error {
+	if len(params.Guardian) != 0 {
+		if _, err := sdk.AccAddressFromBech32(params.Guardian); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid guardian address: %v", err)
+		}
+	}
+
+	if params.MaxPauseDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid maximum pause duration")
+	}
+
+	if params.PauseCooldown < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "pause cooldown cannot be negative")
+	}
+
+	return nil
 }
@@ -67,8 +67,6 @@ func (AssetType) EnumDescriptor() ([]byte, []int) {
 
 // Params defines the parameters for the module.
 type Params struct {
-	// The minimum number of confirmations required for the deposit transactions
-	DepositConfirmationDepth int32 `protobuf:"varint,1,opt,name=deposit_confirmation_depth,json=depositConfirmationDepth,proto3" json:"deposit_confirmation_depth,omitempty"`
 	// The minimum number of confirmations required for the withdrawal transactions
 	WithdrawConfirmationDepth int32 `protobuf:"varint,2,opt,name=withdraw_confirmation_depth,json=withdrawConfirmationDepth,proto3" json:"withdraw_confirmation_depth,omitempty"`
 	// Indicates the maximum depth or distance from the latest block up to which transactions are considered for acceptance.
@@ -79,8 +77,6 @@ type Params struct {
 	DepositEnabled bool `protobuf:"varint,5,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
 	// Indicates if withdrawal is enabled
 	WithdrawEnabled bool `protobuf:"varint,6,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	// Trusted relayers for non-btc asset deposit
-	TrustedNonBtcRelayers []string `protobuf:"bytes,7,rep,name=trusted_non_btc_relayers,json=trustedNonBtcRelayers,proto3" json:"trusted_non_btc_relayers,omitempty"`
 	// Trusted fee providers to submit bitcoin fee rate
 	TrustedFeeProviders []string `protobuf:"bytes,8,rep,name=trusted_fee_providers,json=trustedFeeProviders,proto3" json:"trusted_fee_providers,omitempty"`
 	// Period of validity for the fee rate
@@ -101,6 +97,14 @@ type Params struct {
 	IbcParams IBCParams `protobuf:"bytes,16,opt,name=ibc_params,json=ibcParams,proto3" json:"ibc_params"`
 	// Fee sponsorship params
 	FeeSponsorshipParams FeeSponsorshipParams `protobuf:"bytes,17,opt,name=fee_sponsorship_params,json=feeSponsorshipParams,proto3" json:"fee_sponsorship_params"`
+	// Relayer params
+	RelayerParams RelayerParams `protobuf:"bytes,18,opt,name=relayer_params,json=relayerParams,proto3" json:"relayer_params"`
+	// Guardian params
+	GuardianParams GuardianParams `protobuf:"bytes,19,opt,name=guardian_params,json=guardianParams,proto3" json:"guardian_params"`
+	// Deposit confirmation params
+	DepositConfirmationParams DepositConfirmationParams `protobuf:"bytes,20,opt,name=deposit_confirmation_params,json=depositConfirmationParams,proto3" json:"deposit_confirmation_params"`
+	// Vault recovery params
+	VaultRecoveryParams VaultRecoveryParams `protobuf:"bytes,21,opt,name=vault_recovery_params,json=vaultRecoveryParams,proto3" json:"vault_recovery_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,13 +140,6 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetDepositConfirmationDepth() int32 {
-	if m != nil {
-		return m.DepositConfirmationDepth
-	}
-	return 0
-}
-
 func (m *Params) GetWithdrawConfirmationDepth() int32 {
 	if m != nil {
 		return m.WithdrawConfirmationDepth
@@ -178,13 +175,6 @@ func (m *Params) GetWithdrawEnabled() bool {
 	return false
 }
 
-func (m *Params) GetTrustedNonBtcRelayers() []string {
-	if m != nil {
-		return m.TrustedNonBtcRelayers
-	}
-	return nil
-}
-
 func (m *Params) GetTrustedFeeProviders() []string {
 	if m != nil {
 		return m.TrustedFeeProviders
@@ -255,6 +245,34 @@ func (m *Params) GetFeeSponsorshipParams() FeeSponsorshipParams {
 	return FeeSponsorshipParams{}
 }
 
+func (m *Params) GetRelayerParams() RelayerParams {
+	if m != nil {
+		return m.RelayerParams
+	}
+	return RelayerParams{}
+}
+
+func (m *Params) GetGuardianParams() GuardianParams {
+	if m != nil {
+		return m.GuardianParams
+	}
+	return GuardianParams{}
+}
+
+func (m *Params) GetDepositConfirmationParams() DepositConfirmationParams {
+	if m != nil {
+		return m.DepositConfirmationParams
+	}
+	return DepositConfirmationParams{}
+}
+
+func (m *Params) GetVaultRecoveryParams() VaultRecoveryParams {
+	if m != nil {
+		return m.VaultRecoveryParams
+	}
+	return VaultRecoveryParams{}
+}
+
 // Vault defines the asset vault
 type Vault struct {
 	// the vault address for deposit
@@ -265,6 +283,8 @@ type Vault struct {
 	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
 	// version
 	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// emergency recovery script path committed by the vault; empty if the vault is key path only
+	Recovery *VaultRecovery `protobuf:"bytes,5,opt,name=recovery,proto3" json:"recovery,omitempty"`
 }
 
 func (m *Vault) Reset()         { *m = Vault{} }
@@ -328,6 +348,68 @@ func (m *Vault) GetVersion() uint64 {
 	return 0
 }
 
+func (m *Vault) GetRecovery() *VaultRecovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return nil
+}
+
+// VaultRecovery defines the emergency recovery script path of the taproot vault
+type VaultRecovery struct {
+	// x-only internal key of the vault generated by DKG
+	InternalKey string `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// recovery params committed by the vault
+	Params VaultRecoveryParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *VaultRecovery) Reset()         { *m = VaultRecovery{} }
+func (m *VaultRecovery) String() string { return proto.CompactTextString(m) }
+func (*VaultRecovery) ProtoMessage()    {}
+func (*VaultRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{2}
+}
+func (m *VaultRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRecovery.Merge(m, src)
+}
+func (m *VaultRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRecovery proto.InternalMessageInfo
+
+func (m *VaultRecovery) GetInternalKey() string {
+	if m != nil {
+		return m.InternalKey
+	}
+	return ""
+}
+
+func (m *VaultRecovery) GetParams() VaultRecoveryParams {
+	if m != nil {
+		return m.Params
+	}
+	return VaultRecoveryParams{}
+}
+
 type WithdrawParams struct {
 	// Maximum number of utxos used to build the signing request; O means unlimited
 	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
@@ -341,7 +423,7 @@ func (m *WithdrawParams) Reset()         { *m = WithdrawParams{} }
 func (m *WithdrawParams) String() string { return proto.CompactTextString(m) }
 func (*WithdrawParams) ProtoMessage()    {}
 func (*WithdrawParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{2}
+	return fileDescriptor_d3836e234e3468c1, []int{3}
 }
 func (m *WithdrawParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +487,7 @@ func (m *ProtocolLimits) Reset()         { *m = ProtocolLimits{} }
 func (m *ProtocolLimits) String() string { return proto.CompactTextString(m) }
 func (*ProtocolLimits) ProtoMessage()    {}
 func (*ProtocolLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{3}
+	return fileDescriptor_d3836e234e3468c1, []int{4}
 }
 func (m *ProtocolLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +551,7 @@ func (m *ProtocolFees) Reset()         { *m = ProtocolFees{} }
 func (m *ProtocolFees) String() string { return proto.CompactTextString(m) }
 func (*ProtocolFees) ProtoMessage()    {}
 func (*ProtocolFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{4}
+	return fileDescriptor_d3836e234e3468c1, []int{5}
 }
 func (m *ProtocolFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +613,7 @@ func (m *TSSParams) Reset()         { *m = TSSParams{} }
 func (m *TSSParams) String() string { return proto.CompactTextString(m) }
 func (*TSSParams) ProtoMessage()    {}
 func (*TSSParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{5}
+	return fileDescriptor_d3836e234e3468c1, []int{6}
 }
 func (m *TSSParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +668,7 @@ func (m *RateLimitParams) Reset()         { *m = RateLimitParams{} }
 func (m *RateLimitParams) String() string { return proto.CompactTextString(m) }
 func (*RateLimitParams) ProtoMessage()    {}
 func (*RateLimitParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{6}
+	return fileDescriptor_d3836e234e3468c1, []int{7}
 }
 func (m *RateLimitParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +723,7 @@ func (m *GlobalRateLimitParams) Reset()         { *m = GlobalRateLimitParams{} }
 func (m *GlobalRateLimitParams) String() string { return proto.CompactTextString(m) }
 func (*GlobalRateLimitParams) ProtoMessage()    {}
 func (*GlobalRateLimitParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{7}
+	return fileDescriptor_d3836e234e3468c1, []int{8}
 }
 func (m *GlobalRateLimitParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +778,7 @@ func (m *AddressRateLimitParams) Reset()         { *m = AddressRateLimitParams{}
 func (m *AddressRateLimitParams) String() string { return proto.CompactTextString(m) }
 func (*AddressRateLimitParams) ProtoMessage()    {}
 func (*AddressRateLimitParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{8}
+	return fileDescriptor_d3836e234e3468c1, []int{9}
 }
 func (m *AddressRateLimitParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +833,7 @@ func (m *IBCParams) Reset()         { *m = IBCParams{} }
 func (m *IBCParams) String() string { return proto.CompactTextString(m) }
 func (*IBCParams) ProtoMessage()    {}
 func (*IBCParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{9}
+	return fileDescriptor_d3836e234e3468c1, []int{10}
 }
 func (m *IBCParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,13 +880,15 @@ func (m *IBCParams) GetTimeoutDuration() time.Duration {
 type FeeSponsorshipParams struct {
 	// Maximum sponsorship fee per tx
 	MaxSponsorFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_sponsor_fee,json=maxSponsorFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_sponsor_fee"`
+	// Sponsorship policies in addition to the default BTC transfer sponsorship
+	Policies []FeeSponsorshipPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies"`
 }
 
 func (m *FeeSponsorshipParams) Reset()         { *m = FeeSponsorshipParams{} }
 func (m *FeeSponsorshipParams) String() string { return proto.CompactTextString(m) }
 func (*FeeSponsorshipParams) ProtoMessage()    {}
 func (*FeeSponsorshipParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3836e234e3468c1, []int{10}
+	return fileDescriptor_d3836e234e3468c1, []int{11}
 }
 func (m *FeeSponsorshipParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,489 +924,652 @@ func (m *FeeSponsorshipParams) GetMaxSponsorFee() github_com_cosmos_cosmos_sdk_t
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("bitway.btcbridge.AssetType", AssetType_name, AssetType_value)
-	proto.RegisterType((*Params)(nil), "bitway.btcbridge.Params")
-	proto.RegisterType((*Vault)(nil), "bitway.btcbridge.Vault")
-	proto.RegisterType((*WithdrawParams)(nil), "bitway.btcbridge.WithdrawParams")
-	proto.RegisterType((*ProtocolLimits)(nil), "bitway.btcbridge.ProtocolLimits")
-	proto.RegisterType((*ProtocolFees)(nil), "bitway.btcbridge.ProtocolFees")
-	proto.RegisterType((*TSSParams)(nil), "bitway.btcbridge.TSSParams")
-	proto.RegisterType((*RateLimitParams)(nil), "bitway.btcbridge.RateLimitParams")
-	proto.RegisterType((*GlobalRateLimitParams)(nil), "bitway.btcbridge.GlobalRateLimitParams")
-	proto.RegisterType((*AddressRateLimitParams)(nil), "bitway.btcbridge.AddressRateLimitParams")
-	proto.RegisterType((*IBCParams)(nil), "bitway.btcbridge.IBCParams")
-	proto.RegisterType((*FeeSponsorshipParams)(nil), "bitway.btcbridge.FeeSponsorshipParams")
+func (m *FeeSponsorshipParams) GetPolicies() []FeeSponsorshipPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("bitway/btcbridge/params.proto", fileDescriptor_d3836e234e3468c1) }
+// FeeSponsorshipPolicy defines the policy under which the gas fee of the matched tx is sponsored
+type FeeSponsorshipPolicy struct {
+	// Unique policy id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Msg type urls covered by the policy; all msgs of the tx must be covered
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// Maximum number of the sponsored txs per account during each epoch; 0 means unlimited
+	AccountQuota uint32 `protobuf:"varint,3,opt,name=account_quota,json=accountQuota,proto3" json:"account_quota,omitempty"`
+	// Maximum total fee sponsored during each epoch; empty means unlimited
+	EpochBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=epoch_budget,json=epochBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_budget"`
+	// Duration of each epoch; 0 means the quota and budget are never reset
+	EpochDuration time.Duration `protobuf:"bytes,5,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+	// Account from which the sponsored fee is deducted; defaulted to the fee sponsor module account if empty
+	// A non-default funding account must grant the fee allowance to the fee sponsor module account
+	FundingAccount string `protobuf:"bytes,6,opt,name=funding_account,json=fundingAccount,proto3" json:"funding_account,omitempty"`
+}
+
+func (m *FeeSponsorshipPolicy) Reset()         { *m = FeeSponsorshipPolicy{} }
+func (m *FeeSponsorshipPolicy) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipPolicy) ProtoMessage()    {}
+func (*FeeSponsorshipPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{12}
+}
+func (m *FeeSponsorshipPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorshipPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsorshipPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipPolicy.Merge(m, src)
+}
+func (m *FeeSponsorshipPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipPolicy.DiscardUnknown(m)
+}
 
-var fileDescriptor_d3836e234e3468c1 = []byte{
-	// 1315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x36, 0x23, 0xdb, 0xb1, 0xc6, 0xb6, 0xa4, 0x6c, 0xfc, 0x23, 0x3b, 0xe7, 0xc8, 0x8a, 0x70,
-	0x90, 0xe8, 0x04, 0x38, 0x52, 0xe2, 0x03, 0xb4, 0x68, 0x52, 0x14, 0x8d, 0xfc, 0xd3, 0x18, 0x6d,
-	0x1d, 0x87, 0x92, 0x53, 0xb4, 0x37, 0xc4, 0x92, 0x5c, 0x49, 0x0b, 0x93, 0x5c, 0x96, 0xbb, 0xb4,
-	0xa5, 0x67, 0x68, 0x81, 0xe6, 0xb2, 0xaf, 0xd0, 0x3e, 0x47, 0x2f, 0x72, 0x99, 0x8b, 0x5e, 0xf4,
-	0xaa, 0x29, 0x92, 0x3e, 0x48, 0xb1, 0xcb, 0x5d, 0x59, 0xb2, 0x14, 0xa0, 0x41, 0xaf, 0x44, 0xce,
-	0xf7, 0xcd, 0x37, 0x33, 0xe2, 0xcc, 0xec, 0xc2, 0xbf, 0x5d, 0x2a, 0x2e, 0xf0, 0xb0, 0xe9, 0x0a,
-	0xcf, 0x4d, 0xa8, 0xdf, 0x23, 0xcd, 0x18, 0x27, 0x38, 0xe4, 0x8d, 0x38, 0x61, 0x82, 0xa1, 0x52,
-	0x06, 0x37, 0x46, 0xf0, 0xf6, 0x5a, 0x8f, 0xf5, 0x98, 0x02, 0x9b, 0xf2, 0x29, 0xe3, 0x6d, 0x57,
-	0x7a, 0x8c, 0xf5, 0x02, 0xd2, 0x54, 0x6f, 0x6e, 0xda, 0x6d, 0xfa, 0x69, 0x82, 0x05, 0x65, 0x91,
-	0xc1, 0x3d, 0xc6, 0x43, 0xc6, 0x9b, 0x2e, 0xe6, 0xa4, 0x79, 0xfe, 0xc0, 0x25, 0x02, 0x3f, 0x68,
-	0x7a, 0x8c, 0x6a, 0xbc, 0xf6, 0xcb, 0x12, 0x2c, 0x9e, 0xa8, 0xc0, 0xe8, 0x63, 0xd8, 0xf6, 0x49,
-	0xcc, 0x38, 0x15, 0x8e, 0xc7, 0xa2, 0x2e, 0x4d, 0x42, 0x25, 0xe4, 0xf8, 0x24, 0x16, 0xfd, 0xb2,
-	0x55, 0xb5, 0xea, 0x0b, 0x76, 0x59, 0x33, 0xf6, 0xc6, 0x08, 0xfb, 0x12, 0x47, 0x9f, 0xc0, 0xad,
-	0x0b, 0x2a, 0xfa, 0x7e, 0x82, 0x2f, 0x66, 0xb9, 0x5f, 0x53, 0xee, 0x5b, 0x86, 0x32, 0xed, 0xff,
-	0x08, 0xb6, 0x43, 0x3c, 0x70, 0xb0, 0xe7, 0x91, 0x58, 0x60, 0x37, 0x20, 0x8e, 0x1b, 0x30, 0xef,
-	0x4c, 0xbb, 0xe7, 0xaa, 0x56, 0x7d, 0xde, 0xde, 0x0c, 0xf1, 0xe0, 0xf1, 0x88, 0xd0, 0x92, 0x78,
-	0xe6, 0x7c, 0x0f, 0x6e, 0xb8, 0xc2, 0x73, 0xce, 0x59, 0xea, 0xf5, 0x49, 0xe2, 0xf8, 0x24, 0x62,
-	0x61, 0x79, 0xbe, 0x6a, 0xd5, 0xf3, 0x76, 0xd1, 0x15, 0xde, 0xf3, 0xcc, 0xbe, 0x2f, 0xcd, 0xe8,
-	0x2e, 0x14, 0x4d, 0x99, 0x24, 0x92, 0x3a, 0x7e, 0x79, 0xa1, 0x6a, 0xd5, 0x97, 0xec, 0x82, 0x36,
-	0x1f, 0x64, 0x56, 0xf4, 0x5f, 0x28, 0x8d, 0x2a, 0x32, 0xcc, 0x45, 0xc5, 0x2c, 0x1a, 0xbb, 0xa1,
-	0x7e, 0x08, 0x65, 0x91, 0xa4, 0x5c, 0x10, 0xdf, 0x89, 0x58, 0xe4, 0xc8, 0x5c, 0x12, 0x12, 0xe0,
-	0x21, 0x49, 0x78, 0xf9, 0x7a, 0x35, 0x57, 0xcf, 0xdb, 0xeb, 0x1a, 0x3f, 0x66, 0x51, 0x4b, 0x78,
-	0xb6, 0x06, 0xd1, 0x2e, 0x18, 0xc0, 0xe9, 0x12, 0xe2, 0xc4, 0x09, 0x3b, 0xa7, 0xbe, 0xf4, 0x5a,
-	0x52, 0x5e, 0x37, 0x35, 0x78, 0x48, 0xc8, 0x89, 0x81, 0x64, 0x30, 0xc9, 0x4d, 0xb0, 0x20, 0xce,
-	0x39, 0x0e, 0xa8, 0x4f, 0xc5, 0xd0, 0x89, 0x49, 0x42, 0x99, 0x5f, 0xce, 0x57, 0xad, 0x7a, 0xce,
-	0x5e, 0xef, 0x12, 0x62, 0x63, 0x41, 0x9e, 0x6b, 0xf4, 0x44, 0x81, 0xa8, 0x09, 0x8b, 0xe7, 0x38,
-	0x0d, 0x04, 0x2f, 0x43, 0x35, 0x57, 0x5f, 0xde, 0xdd, 0x6c, 0x5c, 0x6d, 0xb2, 0xc6, 0x73, 0x89,
-	0xdb, 0x9a, 0x86, 0x9e, 0xc2, 0xa8, 0x52, 0x27, 0xeb, 0xce, 0xf2, 0x72, 0xd5, 0xaa, 0x2f, 0xef,
-	0x56, 0xa7, 0x3d, 0xbf, 0xd2, 0xc4, 0xac, 0x99, 0x5a, 0xf3, 0x2f, 0x7f, 0xdf, 0x99, 0xb3, 0x0b,
-	0x17, 0x13, 0x56, 0x29, 0xa8, 0xda, 0xce, 0x63, 0x81, 0x13, 0xd0, 0x90, 0x0a, 0x5e, 0x5e, 0x79,
-	0x97, 0xe0, 0x89, 0x26, 0x7e, 0xa1, 0x78, 0x46, 0x30, 0x9e, 0xb0, 0xa2, 0x23, 0x58, 0x1d, 0x09,
-	0x76, 0x09, 0xe1, 0xe5, 0x55, 0x25, 0x57, 0x79, 0xb7, 0xdc, 0x21, 0x21, 0x46, 0x6c, 0x25, 0x1e,
-	0xb3, 0xa1, 0x4f, 0x01, 0x04, 0xe7, 0xa6, 0xce, 0x82, 0xd2, 0xb9, 0x35, 0xad, 0xd3, 0x69, 0xb7,
-	0x27, 0x4a, 0xcc, 0x0b, 0xce, 0x75, 0x75, 0x6d, 0xb8, 0xa1, 0x3e, 0x8a, 0xaa, 0xcc, 0x08, 0x15,
-	0x95, 0xd0, 0xed, 0x69, 0x21, 0xf9, 0x81, 0x54, 0x15, 0x13, 0x72, 0xc5, 0x64, 0xd2, 0x2c, 0xd3,
-	0xa2, 0xae, 0x67, 0xd4, 0x4a, 0xef, 0x4a, 0xeb, 0xa8, 0xb5, 0x37, 0x99, 0x16, 0x75, 0x3d, 0xad,
-	0xe0, 0xc2, 0x86, 0xec, 0x17, 0x1e, 0xb3, 0x88, 0xb3, 0x84, 0xf7, 0x69, 0x6c, 0xd4, 0x6e, 0x28,
-	0xb5, 0x3b, 0xd3, 0x6a, 0x87, 0x84, 0xb4, 0x2f, 0xe9, 0x13, 0xc2, 0x6b, 0xdd, 0x19, 0x58, 0xed,
-	0x85, 0x05, 0x0b, 0xaa, 0x77, 0x50, 0x19, 0xae, 0x63, 0xdf, 0x4f, 0x08, 0xe7, 0x6a, 0x65, 0xe4,
-	0x6d, 0xf3, 0x8a, 0x36, 0xe1, 0x7a, 0x9c, 0xba, 0xce, 0x19, 0x19, 0xaa, 0x6d, 0x90, 0xb7, 0x17,
-	0xe3, 0xd4, 0xfd, 0x9c, 0x0c, 0xd1, 0x43, 0x00, 0xcc, 0x39, 0x11, 0x8e, 0x18, 0xc6, 0x44, 0x8d,
-	0x7a, 0x61, 0x56, 0x89, 0x8f, 0x25, 0xa7, 0x33, 0x8c, 0x89, 0x9d, 0xc7, 0xe6, 0x51, 0x86, 0x3b,
-	0x27, 0x09, 0xa7, 0x2c, 0x52, 0xf3, 0x3e, 0x6f, 0x9b, 0xd7, 0xda, 0x4f, 0x16, 0x14, 0x26, 0x9b,
-	0x12, 0x55, 0x61, 0x45, 0xee, 0x98, 0x54, 0x0c, 0x98, 0x13, 0xa5, 0xa1, 0x4a, 0x70, 0xd5, 0x86,
-	0x10, 0x0f, 0x4e, 0xc5, 0x80, 0x1d, 0xa7, 0x21, 0xfa, 0x08, 0xb6, 0xe4, 0xf0, 0xba, 0x58, 0x78,
-	0x7d, 0xe7, 0xb2, 0xf7, 0xb3, 0xe1, 0xba, 0xa6, 0x86, 0x6b, 0xc3, 0x15, 0x5e, 0x4b, 0xe2, 0x23,
-	0xf1, 0x6c, 0xba, 0x1e, 0x66, 0x0b, 0x6c, 0x86, 0xbb, 0x0c, 0x95, 0x53, 0xa1, 0x36, 0x42, 0x3c,
-	0x68, 0x5d, 0x71, 0x3f, 0x4e, 0xc3, 0xda, 0xf7, 0x16, 0x14, 0x26, 0xfb, 0x1d, 0xdd, 0x01, 0xb9,
-	0xb9, 0x9c, 0x90, 0xaa, 0x0d, 0x2a, 0xf7, 0x92, 0x4a, 0x37, 0x67, 0xaf, 0xba, 0xc2, 0xfb, 0x92,
-	0xca, 0xad, 0x29, 0x8d, 0xa8, 0x0e, 0x25, 0xc3, 0x33, 0x01, 0x75, 0xa2, 0x85, 0x8c, 0x68, 0xe2,
-	0x8c, 0x98, 0x78, 0x70, 0xc9, 0xcc, 0x5d, 0x32, 0xf1, 0xc0, 0x30, 0x6b, 0x31, 0xac, 0x8c, 0x8f,
-	0x0b, 0xda, 0x81, 0x65, 0xb3, 0x32, 0xbb, 0x84, 0xe8, 0x3c, 0x40, 0x9b, 0x0e, 0x09, 0x41, 0xb7,
-	0x61, 0x65, 0x54, 0xad, 0x64, 0x64, 0x09, 0x2c, 0x1b, 0x9b, 0xa4, 0xfc, 0x0b, 0xf2, 0x1e, 0x0b,
-	0x02, 0xe2, 0x09, 0x96, 0xa8, 0xb0, 0x79, 0xfb, 0xd2, 0x50, 0xfb, 0xd5, 0x82, 0xfc, 0x68, 0xb2,
-	0xd0, 0x33, 0x40, 0xfe, 0x59, 0xcf, 0x11, 0x34, 0x24, 0x2c, 0x15, 0xe6, 0xef, 0xb7, 0x54, 0xb7,
-	0x6e, 0x35, 0xb2, 0x13, 0xaf, 0x61, 0x4e, 0xbc, 0xc6, 0xbe, 0x3e, 0xf1, 0x5a, 0x4b, 0xb2, 0x41,
-	0x7f, 0x7c, 0xbd, 0x63, 0xd9, 0x25, 0xff, 0xac, 0xd7, 0xc9, 0xbc, 0xf5, 0xd7, 0x11, 0xf0, 0x9f,
-	0x18, 0x27, 0x82, 0x7a, 0x34, 0xc6, 0x91, 0x70, 0xd2, 0xd8, 0x97, 0x93, 0x2a, 0x12, 0x1c, 0x71,
-	0xaa, 0x8e, 0xa9, 0xb1, 0x6f, 0xfc, 0x37, 0x83, 0xdc, 0x1e, 0x13, 0x3c, 0x55, 0x7a, 0x9d, 0x91,
-	0x5c, 0x16, 0xb5, 0xf6, 0xa7, 0x05, 0xc5, 0x2b, 0x73, 0x8e, 0xba, 0x50, 0xee, 0x05, 0xcc, 0xc5,
-	0x81, 0x33, 0xbd, 0x2c, 0xb2, 0x12, 0xef, 0x4e, 0xf7, 0xfe, 0x67, 0xca, 0x63, 0xf6, 0xca, 0x58,
-	0xef, 0xcd, 0x02, 0x11, 0x85, 0x2d, 0x3d, 0x79, 0x33, 0x02, 0x65, 0x65, 0xd6, 0x67, 0x0c, 0x59,
-	0xe6, 0x32, 0x3b, 0xd2, 0x06, 0x9e, 0x89, 0xca, 0xf6, 0x5d, 0x9f, 0x99, 0x21, 0x7a, 0x04, 0x8b,
-	0xef, 0xff, 0xf5, 0xb4, 0x0b, 0xfa, 0x00, 0x36, 0x79, 0x1a, 0xc7, 0x81, 0x3a, 0xdd, 0x3c, 0x12,
-	0x09, 0xdc, 0x23, 0xce, 0xb7, 0x29, 0x13, 0x58, 0xe5, 0xbf, 0x6a, 0xaf, 0x67, 0xf0, 0xc9, 0x08,
-	0x7d, 0x26, 0xc1, 0xda, 0x19, 0x6c, 0xcc, 0x2e, 0xe3, 0x9f, 0xa5, 0xb3, 0x06, 0x0b, 0x97, 0xc1,
-	0x73, 0x76, 0xf6, 0x52, 0xfb, 0xc1, 0x82, 0xfc, 0x68, 0xf9, 0xaa, 0xf3, 0x5c, 0x77, 0x6d, 0x9f,
-	0xd0, 0x5e, 0x5f, 0x38, 0xac, 0xdb, 0xe5, 0x24, 0x9b, 0xdd, 0x79, 0xfb, 0xa6, 0x06, 0x9f, 0x28,
-	0xec, 0xa9, 0x82, 0xd0, 0x31, 0x94, 0x8c, 0x8f, 0xb9, 0xbc, 0xbd, 0x4f, 0x1b, 0x16, 0xb5, 0xb3,
-	0x81, 0x6a, 0xdf, 0x59, 0xb0, 0x36, 0x6b, 0x81, 0x23, 0x0e, 0x45, 0x39, 0xfc, 0xfa, 0x20, 0xd0,
-	0xa3, 0x9c, 0x53, 0x71, 0xb2, 0x5b, 0x62, 0x43, 0xde, 0x12, 0x1b, 0xfa, 0x96, 0xd8, 0xd8, 0x63,
-	0x34, 0x6a, 0xdd, 0x97, 0x71, 0x7e, 0x7e, 0xbd, 0x53, 0xef, 0x51, 0xd1, 0x4f, 0xdd, 0x86, 0xc7,
-	0xc2, 0xa6, 0xbe, 0x52, 0x66, 0x3f, 0xff, 0xe3, 0xfe, 0x59, 0x53, 0x2e, 0x6e, 0xae, 0x1c, 0xb8,
-	0xbd, 0x1a, 0xe2, 0x81, 0x8e, 0x7d, 0x48, 0xc8, 0xbd, 0x1e, 0xe4, 0x47, 0x8b, 0x1b, 0x6d, 0xc3,
-	0xc6, 0xe3, 0x76, 0xfb, 0xa0, 0xe3, 0x74, 0xbe, 0x3e, 0x39, 0x70, 0x4e, 0x8f, 0xdb, 0x27, 0x07,
-	0x7b, 0x47, 0x87, 0x47, 0x07, 0xfb, 0xa5, 0x39, 0x84, 0xa0, 0x30, 0x86, 0xb5, 0x3a, 0x7b, 0x25,
-	0x0b, 0xad, 0x41, 0x69, 0xdc, 0x66, 0xef, 0xed, 0xde, 0x2f, 0x5d, 0xbb, 0x62, 0xb5, 0x4f, 0x8f,
-	0x0f, 0xda, 0xa5, 0x5c, 0xeb, 0xc9, 0xcb, 0x37, 0x15, 0xeb, 0xd5, 0x9b, 0x8a, 0xf5, 0xc7, 0x9b,
-	0x8a, 0xf5, 0xe2, 0x6d, 0x65, 0xee, 0xd5, 0xdb, 0xca, 0xdc, 0x6f, 0x6f, 0x2b, 0x73, 0xdf, 0x34,
-	0xc6, 0x72, 0xcf, 0x1a, 0x3e, 0xc0, 0x2e, 0xd7, 0x8f, 0xcd, 0xc1, 0xd8, 0x15, 0x5c, 0xd5, 0xe1,
-	0x2e, 0xaa, 0xbf, 0xfb, 0xff, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xc1, 0xb2, 0xee, 0xa3,
-	0x0b, 0x00, 0x00,
+var xxx_messageInfo_FeeSponsorshipPolicy proto.InternalMessageInfo
+
+func (m *FeeSponsorshipPolicy) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeSponsorshipPolicy) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorshipPolicy) GetAccountQuota() uint32 {
+	if m != nil {
+		return m.AccountQuota
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FeeSponsorshipParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+func (m *FeeSponsorshipPolicy) GetEpochBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochBudget
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size, err := m.IbcParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *FeeSponsorshipPolicy) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size, err := m.RateLimitParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *FeeSponsorshipPolicy) GetFundingAccount() string {
+	if m != nil {
+		return m.FundingAccount
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size, err := m.TssParams.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// RelayerParams defines the params related to the bonded relayers
+type RelayerParams struct {
+	// Minimum bond required to be an active relayer
+	MinBond types.Coin `protobuf:"bytes,1,opt,name=min_bond,json=minBond,proto3" json:"min_bond"`
+	// Percentage of the protocol deposit fee rewarded to the relayer; [0, 100]
+	DepositRewardPercentage uint32 `protobuf:"varint,2,opt,name=deposit_reward_percentage,json=depositRewardPercentage,proto3" json:"deposit_reward_percentage,omitempty"`
+	// Percentage of the bond slashed for each misbehaviour; [0, 100]
+	SlashPercentage uint32 `protobuf:"varint,3,opt,name=slash_percentage,json=slashPercentage,proto3" json:"slash_percentage,omitempty"`
+	// Duration for which the bond is locked after unbonding
+	UnbondingPeriod time.Duration `protobuf:"bytes,4,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// Number of the active relayers required to submit the identical rune etching before it is accepted
+	RuneEtchingQuorum uint32 `protobuf:"varint,5,opt,name=rune_etching_quorum,json=runeEtchingQuorum,proto3" json:"rune_etching_quorum,omitempty"`
+	// Relayers grandfathered from the deprecated trusted non-btc relayers which are active without bonding;
+	// temporary until they bond and are removed by governance
+	GrandfatheredRelayers []string `protobuf:"bytes,6,rep,name=grandfathered_relayers,json=grandfatheredRelayers,proto3" json:"grandfathered_relayers,omitempty"`
+}
+
+func (m *RelayerParams) Reset()         { *m = RelayerParams{} }
+func (m *RelayerParams) String() string { return proto.CompactTextString(m) }
+func (*RelayerParams) ProtoMessage()    {}
+func (*RelayerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{13}
+}
+func (m *RelayerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size, err := m.ProtocolFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+}
+func (m *RelayerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerParams.Merge(m, src)
+}
+func (m *RelayerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerParams proto.InternalMessageInfo
+
+func (m *RelayerParams) GetMinBond() types.Coin {
+	if m != nil {
+		return m.MinBond
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size, err := m.ProtocolLimits.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *RelayerParams) GetDepositRewardPercentage() uint32 {
+	if m != nil {
+		return m.DepositRewardPercentage
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.WithdrawParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *RelayerParams) GetSlashPercentage() uint32 {
+	if m != nil {
+		return m.SlashPercentage
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	return 0
+}
+
+func (m *RelayerParams) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
 	}
-	if m.FeeRateValidityPeriod != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.FeeRateValidityPeriod))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.TrustedFeeProviders) > 0 {
-		for iNdEx := len(m.TrustedFeeProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TrustedFeeProviders[iNdEx])
-			copy(dAtA[i:], m.TrustedFeeProviders[iNdEx])
-			i = encodeVarintParams(dAtA, i, uint64(len(m.TrustedFeeProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.TrustedNonBtcRelayers) > 0 {
-		for iNdEx := len(m.TrustedNonBtcRelayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TrustedNonBtcRelayers[iNdEx])
-			copy(dAtA[i:], m.TrustedNonBtcRelayers[iNdEx])
-			i = encodeVarintParams(dAtA, i, uint64(len(m.TrustedNonBtcRelayers[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+	return 0
+}
+
+func (m *RelayerParams) GetRuneEtchingQuorum() uint32 {
+	if m != nil {
+		return m.RuneEtchingQuorum
 	}
-	if m.WithdrawEnabled {
-		i--
-		if m.WithdrawEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *RelayerParams) GetGrandfatheredRelayers() []string {
+	if m != nil {
+		return m.GrandfatheredRelayers
 	}
-	if m.DepositEnabled {
-		i--
-		if m.DepositEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// GuardianParams defines the params related to the guardian emergency pause
+type GuardianParams struct {
+	// Guardian multisig address which is allowed to pause the protocol; empty means disabled
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Maximum duration of a pause initiated by the guardian
+	MaxPauseDuration time.Duration `protobuf:"bytes,2,opt,name=max_pause_duration,json=maxPauseDuration,proto3,stdduration" json:"max_pause_duration"`
+	// Cooldown period after the end of a guardian pause during which the guardian cannot pause the same target again
+	PauseCooldown time.Duration `protobuf:"bytes,3,opt,name=pause_cooldown,json=pauseCooldown,proto3,stdduration" json:"pause_cooldown"`
+}
+
+func (m *GuardianParams) Reset()         { *m = GuardianParams{} }
+func (m *GuardianParams) String() string { return proto.CompactTextString(m) }
+func (*GuardianParams) ProtoMessage()    {}
+func (*GuardianParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{14}
+}
+func (m *GuardianParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BtcVoucherDenom) > 0 {
-		i -= len(m.BtcVoucherDenom)
-		copy(dAtA[i:], m.BtcVoucherDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.BtcVoucherDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MaxAcceptableBlockDepth != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxAcceptableBlockDepth))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.WithdrawConfirmationDepth != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawConfirmationDepth))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *GuardianParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianParams.Merge(m, src)
+}
+func (m *GuardianParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianParams proto.InternalMessageInfo
+
+func (m *GuardianParams) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	if m.DepositConfirmationDepth != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.DepositConfirmationDepth))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *GuardianParams) GetMaxPauseDuration() time.Duration {
+	if m != nil {
+		return m.MaxPauseDuration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Vault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GuardianParams) GetPauseCooldown() time.Duration {
+	if m != nil {
+		return m.PauseCooldown
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DepositConfirmationParams defines the amount tiered confirmation params for the deposit transactions
+type DepositConfirmationParams struct {
+	// Confirmation tiers in ascending order of the minimum amount; the first tier must start from 0
+	Tiers []DepositConfirmationTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+	// Oracle price symbols used to convert the runes amount to the BTC equivalent
+	RunesPriceSymbols []RunesPriceSymbol `protobuf:"bytes,2,rep,name=runes_price_symbols,json=runesPriceSymbols,proto3" json:"runes_price_symbols"`
 }
 
-func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AssetType != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x12
+func (m *DepositConfirmationParams) Reset()         { *m = DepositConfirmationParams{} }
+func (m *DepositConfirmationParams) String() string { return proto.CompactTextString(m) }
+func (*DepositConfirmationParams) ProtoMessage()    {}
+func (*DepositConfirmationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{15}
+}
+func (m *DepositConfirmationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositConfirmationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositConfirmationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DepositConfirmationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositConfirmationParams.Merge(m, src)
+}
+func (m *DepositConfirmationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositConfirmationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositConfirmationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositConfirmationParams proto.InternalMessageInfo
+
+func (m *DepositConfirmationParams) GetTiers() []DepositConfirmationTier {
+	if m != nil {
+		return m.Tiers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *WithdrawParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositConfirmationParams) GetRunesPriceSymbols() []RunesPriceSymbol {
+	if m != nil {
+		return m.RunesPriceSymbols
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WithdrawParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DepositConfirmationTier defines the confirmation depth required for the deposits of at least the given BTC equivalent amount
+type DepositConfirmationTier struct {
+	// Minimum BTC equivalent amount in satoshi
+	MinAmount int64 `protobuf:"varint,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// The minimum number of confirmations required
+	ConfirmationDepth int32 `protobuf:"varint,2,opt,name=confirmation_depth,json=confirmationDepth,proto3" json:"confirmation_depth,omitempty"`
 }
 
-func (m *WithdrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxBtcBatchWithdrawNum != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcBatchWithdrawNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BtcBatchWithdrawPeriod != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BtcBatchWithdrawPeriod))
-		i--
-		dAtA[i] = 0x10
+func (m *DepositConfirmationTier) Reset()         { *m = DepositConfirmationTier{} }
+func (m *DepositConfirmationTier) String() string { return proto.CompactTextString(m) }
+func (*DepositConfirmationTier) ProtoMessage()    {}
+func (*DepositConfirmationTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{16}
+}
+func (m *DepositConfirmationTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositConfirmationTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositConfirmationTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxUtxoNum != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxUtxoNum))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DepositConfirmationTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositConfirmationTier.Merge(m, src)
+}
+func (m *DepositConfirmationTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositConfirmationTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositConfirmationTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositConfirmationTier proto.InternalMessageInfo
+
+func (m *DepositConfirmationTier) GetMinAmount() int64 {
+	if m != nil {
+		return m.MinAmount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProtocolLimits) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositConfirmationTier) GetConfirmationDepth() int32 {
+	if m != nil {
+		return m.ConfirmationDepth
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProtocolLimits) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RunesPriceSymbol defines the oracle price symbol of the rune against BTC
+type RunesPriceSymbol struct {
+	// Rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Oracle price symbol, e.g. DOGBTC; the price is denominated in BTC per whole rune
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Divisibility of the rune
+	Divisibility uint32 `protobuf:"varint,3,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
 }
 
-func (m *ProtocolLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcMaxWithdraw != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BtcMaxWithdraw))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BtcMinWithdraw != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BtcMinWithdraw))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BtcMinDeposit != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BtcMinDeposit))
-		i--
-		dAtA[i] = 0x8
+func (m *RunesPriceSymbol) Reset()         { *m = RunesPriceSymbol{} }
+func (m *RunesPriceSymbol) String() string { return proto.CompactTextString(m) }
+func (*RunesPriceSymbol) ProtoMessage()    {}
+func (*RunesPriceSymbol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{17}
+}
+func (m *RunesPriceSymbol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunesPriceSymbol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunesPriceSymbol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RunesPriceSymbol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunesPriceSymbol.Merge(m, src)
+}
+func (m *RunesPriceSymbol) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunesPriceSymbol) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunesPriceSymbol.DiscardUnknown(m)
 }
 
-func (m *ProtocolFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RunesPriceSymbol proto.InternalMessageInfo
+
+func (m *RunesPriceSymbol) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProtocolFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RunesPriceSymbol) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *ProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Collector) > 0 {
-		i -= len(m.Collector)
-		copy(dAtA[i:], m.Collector)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Collector)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WithdrawFee != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawFee))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DepositFee != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.DepositFee))
-		i--
-		dAtA[i] = 0x8
+func (m *RunesPriceSymbol) GetDivisibility() uint32 {
+	if m != nil {
+		return m.Divisibility
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TSSParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// VaultRecoveryParams defines the timelocked recovery multisig committed by the newly generated vaults
+type VaultRecoveryParams struct {
+	// x-only public keys of the governance held recovery multisig; empty means disabled
+	PubKeys []string `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// Number of signatures required by the recovery multisig
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Relative time lock in blocks after which the vault utxo can be spent by the recovery multisig
+	Timelock uint32 `protobuf:"varint,3,opt,name=timelock,proto3" json:"timelock,omitempty"`
+}
+
+func (m *VaultRecoveryParams) Reset()         { *m = VaultRecoveryParams{} }
+func (m *VaultRecoveryParams) String() string { return proto.CompactTextString(m) }
+func (*VaultRecoveryParams) ProtoMessage()    {}
+func (*VaultRecoveryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3836e234e3468c1, []int{18}
+}
+func (m *VaultRecoveryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRecoveryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRecoveryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *VaultRecoveryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRecoveryParams.Merge(m, src)
+}
+func (m *VaultRecoveryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRecoveryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRecoveryParams.DiscardUnknown(m)
 }
 
-func (m *TSSParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_VaultRecoveryParams proto.InternalMessageInfo
+
+func (m *VaultRecoveryParams) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
 }
 
-func (m *TSSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ParticipantUpdateTransitionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod):])
-	if err8 != nil {
-		return 0, err8
+func (m *VaultRecoveryParams) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	i -= n8
-	i = encodeVarintParams(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x12
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod):])
-	if err9 != nil {
-		return 0, err9
+	return 0
+}
+
+func (m *VaultRecoveryParams) GetTimelock() uint32 {
+	if m != nil {
+		return m.Timelock
 	}
-	i -= n9
-	i = encodeVarintParams(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("bitway.btcbridge.AssetType", AssetType_name, AssetType_value)
+	proto.RegisterType((*Params)(nil), "bitway.btcbridge.Params")
+	proto.RegisterType((*Vault)(nil), "bitway.btcbridge.Vault")
+	proto.RegisterType((*VaultRecovery)(nil), "bitway.btcbridge.VaultRecovery")
+	proto.RegisterType((*WithdrawParams)(nil), "bitway.btcbridge.WithdrawParams")
+	proto.RegisterType((*ProtocolLimits)(nil), "bitway.btcbridge.ProtocolLimits")
+	proto.RegisterType((*ProtocolFees)(nil), "bitway.btcbridge.ProtocolFees")
+	proto.RegisterType((*TSSParams)(nil), "bitway.btcbridge.TSSParams")
+	proto.RegisterType((*RateLimitParams)(nil), "bitway.btcbridge.RateLimitParams")
+	proto.RegisterType((*GlobalRateLimitParams)(nil), "bitway.btcbridge.GlobalRateLimitParams")
+	proto.RegisterType((*AddressRateLimitParams)(nil), "bitway.btcbridge.AddressRateLimitParams")
+	proto.RegisterType((*IBCParams)(nil), "bitway.btcbridge.IBCParams")
+	proto.RegisterType((*FeeSponsorshipParams)(nil), "bitway.btcbridge.FeeSponsorshipParams")
+	proto.RegisterType((*FeeSponsorshipPolicy)(nil), "bitway.btcbridge.FeeSponsorshipPolicy")
+	proto.RegisterType((*RelayerParams)(nil), "bitway.btcbridge.RelayerParams")
+	proto.RegisterType((*GuardianParams)(nil), "bitway.btcbridge.GuardianParams")
+	proto.RegisterType((*DepositConfirmationParams)(nil), "bitway.btcbridge.DepositConfirmationParams")
+	proto.RegisterType((*DepositConfirmationTier)(nil), "bitway.btcbridge.DepositConfirmationTier")
+	proto.RegisterType((*RunesPriceSymbol)(nil), "bitway.btcbridge.RunesPriceSymbol")
+	proto.RegisterType((*VaultRecoveryParams)(nil), "bitway.btcbridge.VaultRecoveryParams")
+}
+
+func init() { proto.RegisterFile("bitway/btcbridge/params.proto", fileDescriptor_d3836e234e3468c1) }
+
+var fileDescriptor_d3836e234e3468c1 = []byte{
+	// 1954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x0f, 0x2d, 0xdb, 0x91, 0x8e, 0x2d, 0x59, 0x1e, 0xdf, 0x64, 0xe7, 0xbf, 0x8e, 0xa3, 0x7f,
+	0xbb, 0xab, 0xdd, 0x62, 0xa5, 0xdd, 0x14, 0x6d, 0xd1, 0x04, 0x28, 0x1a, 0x39, 0x4e, 0x93, 0x74,
+	0xeb, 0x75, 0x68, 0x27, 0xbd, 0x3c, 0x94, 0x18, 0x92, 0x23, 0x6a, 0x6a, 0x92, 0xc3, 0x70, 0x86,
+	0xb6, 0xf4, 0xda, 0xe7, 0x02, 0xed, 0x63, 0xbf, 0x42, 0xfb, 0x19, 0xfa, 0x01, 0x16, 0x28, 0x0a,
+	0xec, 0xc3, 0x16, 0xe8, 0x53, 0xb7, 0x48, 0xfa, 0xd2, 0x6f, 0x51, 0xcc, 0x85, 0xb4, 0x68, 0x49,
+	0x80, 0xd3, 0xf6, 0x49, 0xe2, 0xb9, 0xfc, 0xce, 0x1c, 0x9e, 0xeb, 0x10, 0xde, 0x73, 0xa9, 0xb8,
+	0xc4, 0xe3, 0x9e, 0x2b, 0x3c, 0x37, 0xa5, 0x7e, 0x40, 0x7a, 0x09, 0x4e, 0x71, 0xc4, 0xbb, 0x49,
+	0xca, 0x04, 0x43, 0x4d, 0xcd, 0xee, 0x16, 0xec, 0xbd, 0xcd, 0x80, 0x05, 0x4c, 0x31, 0x7b, 0xf2,
+	0x9f, 0x96, 0xdb, 0xdb, 0x0f, 0x18, 0x0b, 0x42, 0xd2, 0x53, 0x4f, 0x6e, 0x36, 0xe8, 0xf9, 0x59,
+	0x8a, 0x05, 0x65, 0x71, 0xce, 0xf7, 0x18, 0x8f, 0x18, 0xef, 0xb9, 0x98, 0x93, 0xde, 0xc5, 0xa7,
+	0x2e, 0x11, 0xf8, 0xd3, 0x9e, 0xc7, 0xa8, 0xe1, 0xb7, 0x7f, 0xbd, 0x02, 0xcb, 0x27, 0xca, 0x30,
+	0xfa, 0x01, 0xdc, 0xb9, 0xa4, 0x62, 0xe8, 0xa7, 0xf8, 0xd2, 0xf1, 0x58, 0x3c, 0xa0, 0x69, 0xa4,
+	0x90, 0x1c, 0x9f, 0x24, 0x62, 0xd8, 0x5a, 0x38, 0xb0, 0x3a, 0x4b, 0xf6, 0x6e, 0x2e, 0x72, 0x38,
+	0x21, 0xf1, 0x58, 0x0a, 0xa0, 0x87, 0xb0, 0x17, 0xe1, 0x91, 0x83, 0x3d, 0x8f, 0x24, 0x02, 0xbb,
+	0x21, 0x71, 0xdc, 0x90, 0x79, 0xe7, 0x46, 0xbd, 0x72, 0x60, 0x75, 0x16, 0xed, 0x9d, 0x08, 0x8f,
+	0x1e, 0x15, 0x02, 0x7d, 0xc9, 0xd7, 0xca, 0x1f, 0xc1, 0xba, 0x2b, 0x3c, 0xe7, 0x82, 0x65, 0xde,
+	0x90, 0xa4, 0x8e, 0x4f, 0x62, 0x16, 0xb5, 0x16, 0x0f, 0xac, 0x4e, 0xcd, 0x5e, 0x73, 0x85, 0xf7,
+	0x4a, 0xd3, 0x1f, 0x4b, 0x32, 0xfa, 0x00, 0xd6, 0x7c, 0x92, 0x30, 0x4e, 0x85, 0x43, 0x62, 0x89,
+	0xe3, 0xb7, 0x96, 0x0e, 0xac, 0x4e, 0xd5, 0x6e, 0x18, 0xf2, 0x91, 0xa6, 0xa2, 0x0f, 0xa1, 0x59,
+	0x78, 0x94, 0x4b, 0x2e, 0x2b, 0xc9, 0xb5, 0x9c, 0x9e, 0x8b, 0xde, 0x87, 0x2d, 0x91, 0x66, 0x5c,
+	0x10, 0xdf, 0x19, 0x10, 0xe2, 0x24, 0x29, 0xbb, 0xa0, 0x3e, 0x49, 0x79, 0xab, 0x7a, 0x50, 0xe9,
+	0xd4, 0xec, 0x0d, 0xc3, 0x7c, 0x42, 0xc8, 0x49, 0xce, 0x42, 0xdf, 0x83, 0x96, 0x94, 0x4d, 0xb1,
+	0x20, 0xce, 0x05, 0x0e, 0xa9, 0x4f, 0xc5, 0xd8, 0x49, 0x48, 0x4a, 0x99, 0xdf, 0xaa, 0x1d, 0x58,
+	0x9d, 0x8a, 0xbd, 0x35, 0x20, 0xc4, 0xc6, 0x82, 0xbc, 0x32, 0xdc, 0x13, 0xc5, 0x44, 0x3d, 0x58,
+	0xbe, 0xc0, 0x59, 0x28, 0x78, 0x0b, 0x0e, 0x2a, 0x9d, 0x95, 0xfb, 0x3b, 0xdd, 0xeb, 0xd1, 0xee,
+	0xbe, 0x92, 0x7c, 0xdb, 0x88, 0xa1, 0xcf, 0xa1, 0x38, 0xb0, 0xa3, 0xd3, 0xa4, 0xb5, 0x72, 0x60,
+	0x75, 0x56, 0xee, 0x1f, 0x4c, 0x6b, 0xfe, 0xd4, 0x08, 0xea, 0xa8, 0xf6, 0x17, 0xbf, 0xf8, 0xfb,
+	0xdd, 0x5b, 0x76, 0xe3, 0xb2, 0x44, 0x95, 0x80, 0x2a, 0xfe, 0x1e, 0x0b, 0x9d, 0x90, 0x46, 0x54,
+	0xf0, 0xd6, 0xea, 0x3c, 0xc0, 0x13, 0x23, 0xf8, 0x99, 0x92, 0xcb, 0x01, 0x93, 0x12, 0x15, 0x3d,
+	0x83, 0x7a, 0x01, 0x38, 0x20, 0x84, 0xb7, 0xea, 0x0a, 0x6e, 0x7f, 0x3e, 0xdc, 0x13, 0x42, 0x72,
+	0xb0, 0xd5, 0x64, 0x82, 0x86, 0x7e, 0x08, 0x20, 0x38, 0xcf, 0xfd, 0x6c, 0x28, 0x9c, 0x3b, 0xd3,
+	0x38, 0x67, 0xa7, 0xa7, 0x25, 0x17, 0x6b, 0x82, 0x73, 0xe3, 0xdd, 0x29, 0xac, 0xab, 0xa0, 0x28,
+	0xcf, 0x72, 0xa0, 0x35, 0x05, 0x74, 0x6f, 0x1a, 0x48, 0x06, 0x48, 0x79, 0x51, 0x82, 0x5b, 0x4b,
+	0xcb, 0x64, 0x79, 0x2c, 0xea, 0x7a, 0x39, 0x5a, 0x73, 0xde, 0xb1, 0x9e, 0xf5, 0x0f, 0xcb, 0xc7,
+	0xa2, 0xae, 0x67, 0x10, 0x5c, 0xd8, 0x96, 0xf9, 0xc2, 0x13, 0x16, 0x73, 0x96, 0xf2, 0x21, 0x4d,
+	0x72, 0xb4, 0x75, 0x85, 0xf6, 0xfe, 0x34, 0xda, 0x13, 0x42, 0x4e, 0xaf, 0xc4, 0x4b, 0xc0, 0x9b,
+	0x83, 0x19, 0x3c, 0xf4, 0x19, 0x34, 0x52, 0x12, 0xe2, 0x31, 0x49, 0x73, 0x6c, 0xa4, 0xb0, 0xef,
+	0xce, 0xf0, 0x5b, 0xcb, 0x95, 0x40, 0xeb, 0xe9, 0x24, 0x51, 0xa6, 0x49, 0x90, 0xe1, 0xd4, 0xa7,
+	0x38, 0xce, 0xe1, 0x36, 0xe6, 0xa5, 0xc9, 0x8f, 0x8c, 0x60, 0x39, 0xef, 0x82, 0x12, 0x15, 0xbd,
+	0x86, 0x3b, 0x79, 0xe9, 0x96, 0x5a, 0x8c, 0x01, 0xdf, 0x54, 0xe0, 0xdf, 0x9a, 0x06, 0x7f, 0xac,
+	0x95, 0x26, 0x9b, 0x4e, 0xc9, 0xce, 0xae, 0x3f, 0x4f, 0x00, 0x39, 0xb0, 0xa5, 0xaa, 0xc8, 0x49,
+	0x89, 0xc7, 0x2e, 0x48, 0x3a, 0xce, 0x8d, 0x6d, 0x29, 0x63, 0xdf, 0x9c, 0x57, 0x7b, 0x46, 0xba,
+	0x64, 0x66, 0xe3, 0x62, 0x9a, 0xf5, 0x7c, 0xb1, 0x6a, 0x35, 0x17, 0x9e, 0x2f, 0x56, 0x6f, 0x37,
+	0xab, 0xf6, 0xde, 0x4c, 0xef, 0x54, 0x07, 0xb4, 0x5b, 0x79, 0x83, 0x89, 0x59, 0xec, 0xc8, 0x66,
+	0x67, 0xde, 0x35, 0x6f, 0xff, 0xc5, 0x82, 0x25, 0x65, 0x14, 0xb5, 0xe0, 0x36, 0xf6, 0xfd, 0x94,
+	0x70, 0xde, 0xb2, 0x54, 0xf3, 0xcb, 0x1f, 0xd1, 0x0e, 0xdc, 0x4e, 0x32, 0xd7, 0x39, 0x27, 0x63,
+	0xd5, 0x89, 0x6b, 0xf6, 0x72, 0x92, 0xb9, 0x3f, 0x26, 0x63, 0xf4, 0x00, 0x00, 0x73, 0x4e, 0x84,
+	0x23, 0xc6, 0x09, 0x51, 0x6d, 0xb6, 0x31, 0x2b, 0x2f, 0x1f, 0x49, 0x99, 0xb3, 0x71, 0x42, 0xec,
+	0x1a, 0xce, 0xff, 0x4a, 0x73, 0x17, 0x24, 0xe5, 0x94, 0xc5, 0xaa, 0xd7, 0x2e, 0xda, 0xf9, 0x23,
+	0x7a, 0x08, 0xd5, 0xfc, 0x7d, 0xb5, 0x96, 0xe6, 0x65, 0x50, 0xe9, 0x45, 0xd9, 0x85, 0x42, 0xfb,
+	0x12, 0xea, 0x25, 0x16, 0xba, 0x07, 0xab, 0x34, 0x16, 0x24, 0x8d, 0x71, 0xa8, 0x3c, 0xd0, 0xbe,
+	0xad, 0xe4, 0x34, 0xe9, 0xc6, 0x21, 0x2c, 0x9b, 0xb8, 0x2c, 0xbc, 0x7b, 0x5c, 0x8c, 0x6a, 0xfb,
+	0x0f, 0x16, 0x34, 0xca, 0xfd, 0x0f, 0x1d, 0xc0, 0xaa, 0x9c, 0x4a, 0x99, 0x18, 0x31, 0x27, 0xce,
+	0x22, 0x65, 0xba, 0x6e, 0x43, 0x84, 0x47, 0x2f, 0xc5, 0x88, 0x1d, 0x67, 0x11, 0xfa, 0x3e, 0xec,
+	0xca, 0x68, 0xb8, 0x58, 0x78, 0x43, 0xe7, 0xaa, 0xcd, 0xea, 0x3e, 0xbe, 0xa0, 0xfa, 0xf8, 0xb6,
+	0x2b, 0xbc, 0xbe, 0xe4, 0x17, 0xe0, 0x8a, 0x8b, 0x1e, 0xe8, 0x91, 0x37, 0x43, 0x5d, 0x9a, 0xaa,
+	0x28, 0x53, 0xdb, 0x11, 0x1e, 0xf5, 0xaf, 0xa9, 0x1f, 0x67, 0x51, 0xfb, 0x37, 0x16, 0x34, 0xca,
+	0xad, 0x15, 0xbd, 0x0f, 0x72, 0xd6, 0x39, 0x11, 0x8d, 0x1d, 0x93, 0x47, 0xea, 0xb8, 0x15, 0xbb,
+	0xee, 0x0a, 0xef, 0x27, 0x34, 0x36, 0x55, 0x80, 0x3a, 0xd0, 0xcc, 0xe5, 0x72, 0x83, 0xe6, 0xa0,
+	0x0d, 0x2d, 0x98, 0xdb, 0x29, 0x24, 0xf1, 0xe8, 0x4a, 0xb2, 0x72, 0x25, 0x89, 0x47, 0xb9, 0x64,
+	0x3b, 0x81, 0xd5, 0xc9, 0xce, 0x8c, 0xee, 0xc2, 0x4a, 0x9e, 0xcb, 0x03, 0x42, 0xcc, 0x39, 0xc0,
+	0x90, 0x9e, 0x10, 0x22, 0x63, 0x5a, 0x78, 0x2b, 0x25, 0xf4, 0x01, 0x56, 0x72, 0x9a, 0x14, 0xf9,
+	0x3f, 0xa8, 0x79, 0x2c, 0x0c, 0x89, 0x27, 0x58, 0xaa, 0xcc, 0xd6, 0xec, 0x2b, 0x42, 0xfb, 0x2b,
+	0x0b, 0x6a, 0x45, 0x13, 0x47, 0x2f, 0x00, 0xf9, 0xe7, 0x81, 0x23, 0x68, 0x44, 0x58, 0x26, 0xf2,
+	0xd7, 0x6f, 0xa9, 0x5c, 0xd8, 0xed, 0xea, 0x2d, 0xa7, 0x9b, 0x6f, 0x39, 0xdd, 0xc7, 0x66, 0xcb,
+	0xe9, 0x57, 0x65, 0xfc, 0x7f, 0xff, 0xf5, 0x5d, 0xcb, 0x6e, 0xfa, 0xe7, 0xc1, 0x99, 0xd6, 0x36,
+	0xd1, 0x11, 0xf0, 0x8d, 0x04, 0xa7, 0x82, 0x7a, 0x34, 0xc1, 0xb1, 0x70, 0xb2, 0xc4, 0x97, 0x43,
+	0x41, 0xa4, 0x38, 0xe6, 0x54, 0x77, 0x9d, 0xab, 0x18, 0xdf, 0xd0, 0xc8, 0xbd, 0x09, 0xc0, 0x97,
+	0x0a, 0xef, 0xac, 0x80, 0xd3, 0x56, 0xdb, 0xff, 0xb4, 0x60, 0xed, 0xda, 0x48, 0x41, 0x03, 0x68,
+	0x05, 0x21, 0x73, 0x71, 0xe8, 0x4c, 0xcf, 0x25, 0xed, 0xe2, 0x07, 0x33, 0x1a, 0xaa, 0xd2, 0x98,
+	0x3d, 0x9d, 0xb6, 0x82, 0x59, 0x4c, 0x44, 0x61, 0xd7, 0xf4, 0x8b, 0x19, 0x86, 0xb4, 0x9b, 0x9d,
+	0x19, 0xad, 0x41, 0xab, 0xcc, 0xb6, 0xb4, 0x8d, 0x67, 0x72, 0x65, 0xfa, 0x6e, 0xcd, 0x3c, 0x21,
+	0x7a, 0x08, 0xcb, 0xef, 0x1e, 0x3d, 0xa3, 0x82, 0xbe, 0x0b, 0x3b, 0x3c, 0x4b, 0x92, 0x50, 0x2d,
+	0x52, 0x1e, 0x89, 0x05, 0x0e, 0x88, 0xf3, 0x3a, 0x63, 0x02, 0xab, 0xf3, 0xd7, 0xed, 0x2d, 0xcd,
+	0x3e, 0x29, 0xb8, 0x2f, 0x24, 0xb3, 0x7d, 0x0e, 0xdb, 0xb3, 0xdd, 0xf8, 0xef, 0x8e, 0xb3, 0x09,
+	0x4b, 0x57, 0xc6, 0x2b, 0xb6, 0x7e, 0x68, 0xff, 0xd6, 0x82, 0x5a, 0x31, 0xe7, 0xd5, 0xea, 0x68,
+	0xb2, 0x76, 0x48, 0x68, 0x30, 0x14, 0x0e, 0x1b, 0x0c, 0x38, 0xd1, 0xb5, 0xbb, 0x68, 0x6f, 0x18,
+	0xe6, 0x53, 0xc5, 0xfb, 0x5c, 0xb1, 0xd0, 0x31, 0x34, 0x73, 0x9d, 0x7c, 0x61, 0x7f, 0x97, 0x34,
+	0x5c, 0x33, 0xca, 0x39, 0xab, 0xfd, 0x57, 0x0b, 0x36, 0x67, 0xed, 0x0a, 0x88, 0xc3, 0x9a, 0x2c,
+	0x7e, 0xb3, 0x73, 0x98, 0x52, 0xae, 0x28, 0x3b, 0xfa, 0x66, 0xd0, 0x95, 0x37, 0x83, 0xae, 0xb9,
+	0x19, 0x74, 0x0f, 0x19, 0x8d, 0xfb, 0x9f, 0x48, 0x3b, 0x7f, 0xfc, 0xfa, 0x6e, 0x27, 0xa0, 0x62,
+	0x98, 0xb9, 0x5d, 0x8f, 0x45, 0x3d, 0x73, 0x8d, 0xd0, 0x3f, 0x1f, 0x73, 0xff, 0xbc, 0x27, 0xc7,
+	0x0d, 0x57, 0x0a, 0xdc, 0xae, 0x47, 0x78, 0x64, 0x6c, 0xcb, 0xba, 0x7f, 0x0a, 0xd5, 0x84, 0x85,
+	0xd4, 0xa3, 0x44, 0x66, 0x5d, 0xe5, 0x46, 0xab, 0x8d, 0x94, 0x1f, 0x9b, 0x9c, 0x2b, 0xb4, 0xdb,
+	0x5f, 0x2d, 0x4c, 0xf9, 0xa5, 0x04, 0x51, 0x03, 0x16, 0xa8, 0x6f, 0xe6, 0xc8, 0x02, 0xf5, 0x51,
+	0x1b, 0xea, 0x11, 0x0f, 0xd4, 0x0c, 0x74, 0xb2, 0x34, 0xd4, 0x76, 0x6b, 0xf6, 0x4a, 0xc4, 0x03,
+	0x39, 0xe9, 0x5e, 0xa6, 0x21, 0x47, 0xff, 0x0f, 0x75, 0xec, 0x79, 0x2c, 0x8b, 0x85, 0xc9, 0x28,
+	0xdd, 0xa0, 0x57, 0x0d, 0x51, 0x25, 0x12, 0x8a, 0x61, 0x95, 0x24, 0xcc, 0x1b, 0x3a, 0x6e, 0xe6,
+	0x07, 0x44, 0xb4, 0x16, 0xff, 0xf7, 0x6f, 0x6b, 0x45, 0x19, 0xe8, 0x2b, 0x7c, 0xf4, 0x1c, 0x1a,
+	0xda, 0x5e, 0x91, 0x07, 0x4b, 0x37, 0xcf, 0x83, 0xba, 0x52, 0xcd, 0x19, 0xf2, 0x62, 0x34, 0xc8,
+	0x62, 0x9f, 0xc6, 0x81, 0x63, 0x7c, 0x52, 0xd7, 0x9d, 0x9a, 0xdd, 0x30, 0xe4, 0x47, 0x9a, 0xda,
+	0xfe, 0xd7, 0x02, 0xd4, 0x4b, 0xeb, 0x1f, 0x7a, 0x00, 0x55, 0x39, 0x4e, 0x5c, 0x16, 0x5f, 0xd5,
+	0xc9, 0x5c, 0x97, 0x75, 0x94, 0x6e, 0x47, 0x34, 0xee, 0xb3, 0x58, 0x4e, 0xc1, 0x7c, 0xfd, 0x72,
+	0x52, 0x72, 0x89, 0x53, 0x7f, 0xa2, 0x76, 0x4d, 0xd5, 0xee, 0x18, 0x01, 0x5b, 0xf1, 0xaf, 0x8a,
+	0x57, 0x5e, 0xd1, 0x78, 0x88, 0xf9, 0x70, 0x52, 0x45, 0x87, 0x65, 0x4d, 0xd1, 0x27, 0x44, 0x8f,
+	0xa1, 0x99, 0xc5, 0x2e, 0xd3, 0xfe, 0x99, 0x92, 0x5e, 0x7c, 0x87, 0x9a, 0x29, 0x94, 0xcd, 0x78,
+	0xe8, 0xc2, 0x46, 0x9a, 0xc5, 0xc4, 0x21, 0xc2, 0x1b, 0x4a, 0xc8, 0xd7, 0x19, 0x4b, 0xb3, 0x48,
+	0xbd, 0xfe, 0xba, 0xbd, 0x2e, 0x59, 0x47, 0x9a, 0xf3, 0x42, 0x31, 0xd0, 0x77, 0x60, 0x3b, 0x48,
+	0x71, 0xec, 0x0f, 0xb0, 0x18, 0x92, 0x94, 0xf8, 0xc5, 0xfe, 0xd6, 0x5a, 0x56, 0xb9, 0xb6, 0x55,
+	0xe2, 0xda, 0xf9, 0x72, 0xf7, 0x67, 0x0b, 0x1a, 0xe5, 0xdd, 0x18, 0xed, 0x41, 0x35, 0xdf, 0x8b,
+	0x4d, 0x0a, 0x17, 0xcf, 0x72, 0x0e, 0xca, 0x82, 0x4d, 0x70, 0xc6, 0xc9, 0x7f, 0xd4, 0x1b, 0x9a,
+	0x11, 0x1e, 0x9d, 0x48, 0xed, 0x22, 0x2d, 0x9e, 0x43, 0x43, 0xc3, 0x79, 0x8c, 0x85, 0x3e, 0xbb,
+	0x8c, 0x5b, 0x95, 0x9b, 0xc3, 0xd5, 0x95, 0xea, 0xa1, 0xd1, 0x6c, 0xff, 0xc9, 0x82, 0xdd, 0xb9,
+	0xcb, 0x38, 0x3a, 0x82, 0x25, 0x41, 0xe5, 0x1b, 0xd1, 0x3d, 0xe6, 0xc3, 0x1b, 0x2d, 0xf2, 0x67,
+	0x94, 0xa4, 0x26, 0xa5, 0xb4, 0x36, 0xfa, 0x99, 0x8e, 0x0c, 0x77, 0x92, 0x94, 0x7a, 0xc4, 0xe1,
+	0xe3, 0xc8, 0x65, 0x61, 0xde, 0x4a, 0xda, 0xd3, 0xa0, 0xb6, 0x14, 0x3e, 0x91, 0xb2, 0xa7, 0x4a,
+	0xd4, 0xa0, 0xad, 0xa7, 0xd7, 0xe8, 0xbc, 0x1d, 0xc0, 0xce, 0x9c, 0x13, 0xa0, 0xf7, 0x00, 0x64,
+	0x05, 0xe0, 0x48, 0xd5, 0x8d, 0xde, 0x77, 0x6a, 0x11, 0x8d, 0x1f, 0x29, 0x02, 0xfa, 0x18, 0xd0,
+	0xdc, 0x8f, 0x22, 0xeb, 0xde, 0xf5, 0x8f, 0x21, 0xed, 0x5f, 0x42, 0xf3, 0xfa, 0xa9, 0xa6, 0x7a,
+	0xd6, 0x36, 0x2c, 0x6b, 0xd7, 0xf2, 0x8d, 0x5e, 0x3f, 0xa1, 0x36, 0xac, 0xfa, 0xf4, 0x82, 0x72,
+	0xea, 0xd2, 0x90, 0x8a, 0x71, 0xde, 0xa6, 0x26, 0x69, 0xed, 0x5f, 0xc1, 0xc6, 0x8c, 0x75, 0x18,
+	0xed, 0x42, 0xd5, 0xdc, 0x12, 0x74, 0x0c, 0x6a, 0xf6, 0x6d, 0x7d, 0x4d, 0xe0, 0x72, 0x19, 0x13,
+	0xc3, 0x94, 0xf0, 0x21, 0x0b, 0x7d, 0x53, 0x95, 0x57, 0x04, 0x99, 0x92, 0x72, 0xa6, 0xc8, 0x0f,
+	0x32, 0xc6, 0x5e, 0xf1, 0xfc, 0x51, 0x00, 0xb5, 0xe2, 0xf6, 0x80, 0xf6, 0x60, 0xfb, 0xd1, 0xe9,
+	0xe9, 0xd1, 0x99, 0x73, 0xf6, 0xf3, 0x93, 0x23, 0xe7, 0xe5, 0xf1, 0xe9, 0xc9, 0xd1, 0xe1, 0xb3,
+	0x27, 0xcf, 0x8e, 0x1e, 0x37, 0x6f, 0x21, 0x04, 0x8d, 0x09, 0x5e, 0xff, 0xec, 0xb0, 0x69, 0xa1,
+	0x4d, 0x68, 0x4e, 0xd2, 0xec, 0xc3, 0xfb, 0x9f, 0x34, 0x17, 0xae, 0x51, 0xed, 0x97, 0xc7, 0x47,
+	0xa7, 0xcd, 0x4a, 0xff, 0xe9, 0x17, 0x6f, 0xf6, 0xad, 0x2f, 0xdf, 0xec, 0x5b, 0xff, 0x78, 0xb3,
+	0x6f, 0xfd, 0xee, 0xed, 0xfe, 0xad, 0x2f, 0xdf, 0xee, 0xdf, 0xfa, 0xdb, 0xdb, 0xfd, 0x5b, 0xbf,
+	0xe8, 0x4e, 0x34, 0x57, 0x1d, 0xfe, 0x10, 0xbb, 0xdc, 0xfc, 0xed, 0x8d, 0x26, 0xbe, 0xa2, 0xa9,
+	0x46, 0xeb, 0x2e, 0xab, 0x94, 0xfe, 0xf6, 0xbf, 0x07, 0x00, 0xa2, 0x18, 0x71, 0x56, 0x66, 0x13,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1332,18 +1579,18 @@ func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AddressRateLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.VaultRecoveryParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1351,9 +1598,11 @@ func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	{
-		size, err := m.GlobalRateLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DepositConfirmationParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1361,119 +1610,176 @@ func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GlobalRateLimitParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GlobalRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GlobalRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SupplyPercentageQuota != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.SupplyPercentageQuota))
-		i--
-		dAtA[i] = 0x10
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.GuardianParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
-	if err12 != nil {
-		return 0, err12
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size, err := m.RelayerParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i -= n12
-	i = encodeVarintParams(dAtA, i, uint64(n12))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *AddressRateLimitParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.FeeSponsorshipParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddressRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddressRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Quota != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.Quota))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.IbcParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
-	if err13 != nil {
-		return 0, err13
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.RateLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i -= n13
-	i = encodeVarintParams(dAtA, i, uint64(n13))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *IBCParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x7a
+	{
+		size, err := m.TssParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IBCParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
-	if err14 != nil {
-		return 0, err14
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.ProtocolFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i -= n14
-	i = encodeVarintParams(dAtA, i, uint64(n14))
 	i--
-	dAtA[i] = 0x12
-	if m.TimeoutHeightOffset != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutHeightOffset))
+	dAtA[i] = 0x6a
+	{
+		size, err := m.ProtocolLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.WithdrawParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FeeRateValidityPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeRateValidityPeriod))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x48
+	}
+	if len(m.TrustedFeeProviders) > 0 {
+		for iNdEx := len(m.TrustedFeeProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustedFeeProviders[iNdEx])
+			copy(dAtA[i:], m.TrustedFeeProviders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.TrustedFeeProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.WithdrawEnabled {
+		i--
+		if m.WithdrawEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DepositEnabled {
+		i--
+		if m.DepositEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BtcVoucherDenom) > 0 {
+		i -= len(m.BtcVoucherDenom)
+		copy(dAtA[i:], m.BtcVoucherDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BtcVoucherDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAcceptableBlockDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WithdrawConfirmationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawConfirmationDepth))
+		i--
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeSponsorshipParams) Marshal() (dAtA []byte, err error) {
+func (m *Vault) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1483,275 +1789,2244 @@ func (m *FeeSponsorshipParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeSponsorshipParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeSponsorshipParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MaxSponsorFee) > 0 {
-		for iNdEx := len(m.MaxSponsorFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxSponsorFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
+	if m.Recovery != nil {
+		{
+			size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VaultRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VaultRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DepositConfirmationDepth != 0 {
-		n += 1 + sovParams(uint64(m.DepositConfirmationDepth))
-	}
-	if m.WithdrawConfirmationDepth != 0 {
-		n += 1 + sovParams(uint64(m.WithdrawConfirmationDepth))
-	}
-	if m.MaxAcceptableBlockDepth != 0 {
-		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
-	}
-	l = len(m.BtcVoucherDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if m.DepositEnabled {
-		n += 2
-	}
-	if m.WithdrawEnabled {
-		n += 2
-	}
-	if len(m.TrustedNonBtcRelayers) > 0 {
-		for _, s := range m.TrustedNonBtcRelayers {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
-		}
-	}
-	if len(m.TrustedFeeProviders) > 0 {
-		for _, s := range m.TrustedFeeProviders {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.FeeRateValidityPeriod != 0 {
-		n += 1 + sovParams(uint64(m.FeeRateValidityPeriod))
-	}
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.WithdrawParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.ProtocolLimits.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.ProtocolFees.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.TssParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.RateLimitParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.IbcParams.Size()
-	n += 2 + l + sovParams(uint64(l))
-	l = m.FeeSponsorshipParams.Size()
-	n += 2 + l + sovParams(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Vault) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.MaxBtcBatchWithdrawNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcBatchWithdrawNum))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AssetType != 0 {
-		n += 1 + sovParams(uint64(m.AssetType))
+	if m.BtcBatchWithdrawPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcBatchWithdrawPeriod))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Version != 0 {
-		n += 1 + sovParams(uint64(m.Version))
+	if m.MaxUtxoNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUtxoNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxUtxoNum != 0 {
-		n += 1 + sovParams(uint64(m.MaxUtxoNum))
-	}
-	if m.BtcBatchWithdrawPeriod != 0 {
-		n += 1 + sovParams(uint64(m.BtcBatchWithdrawPeriod))
-	}
-	if m.MaxBtcBatchWithdrawNum != 0 {
-		n += 1 + sovParams(uint64(m.MaxBtcBatchWithdrawNum))
+func (m *ProtocolLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProtocolLimits) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ProtocolLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcMinDeposit != 0 {
-		n += 1 + sovParams(uint64(m.BtcMinDeposit))
+	if m.BtcMaxWithdraw != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcMaxWithdraw))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.BtcMinWithdraw != 0 {
-		n += 1 + sovParams(uint64(m.BtcMinWithdraw))
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcMinWithdraw))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.BtcMaxWithdraw != 0 {
-		n += 1 + sovParams(uint64(m.BtcMaxWithdraw))
+	if m.BtcMinDeposit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcMinDeposit))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtocolFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DepositFee != 0 {
-		n += 1 + sovParams(uint64(m.DepositFee))
+	if len(m.Collector) > 0 {
+		i -= len(m.Collector)
+		copy(dAtA[i:], m.Collector)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Collector)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.WithdrawFee != 0 {
-		n += 1 + sovParams(uint64(m.WithdrawFee))
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawFee))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Collector)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.DepositFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositFee))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TSSParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TSSParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TSSParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TSSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod)
-	n += 1 + l + sovParams(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod)
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ParticipantUpdateTransitionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintParams(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintParams(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *RateLimitParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GlobalRateLimitParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.AddressRateLimitParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	{
+		size, err := m.AddressRateLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GlobalRateLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalRateLimitParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GlobalRateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovParams(uint64(l))
 	if m.SupplyPercentageQuota != 0 {
-		n += 1 + sovParams(uint64(m.SupplyPercentageQuota))
+		i = encodeVarintParams(dAtA, i, uint64(m.SupplyPercentageQuota))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintParams(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AddressRateLimitParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddressRateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovParams(uint64(l))
 	if m.Quota != 0 {
-		n += 1 + sovParams(uint64(m.Quota))
+		i = encodeVarintParams(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintParams(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *IBCParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TimeoutHeightOffset != 0 {
-		n += 1 + sovParams(uint64(m.TimeoutHeightOffset))
+func (m *IBCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FeeSponsorshipParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IBCParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintParams(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x12
+	if m.TimeoutHeightOffset != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.MaxSponsorFee) > 0 {
-		for _, e := range m.MaxSponsorFee {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+		for iNdEx := len(m.MaxSponsorFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSponsorFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FeeSponsorshipPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *FeeSponsorshipPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundingAccount) > 0 {
+		i -= len(m.FundingAccount)
+		copy(dAtA[i:], m.FundingAccount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FundingAccount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintParams(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EpochBudget) > 0 {
+		for iNdEx := len(m.EpochBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AccountQuota != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountQuota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrandfatheredRelayers) > 0 {
+		for iNdEx := len(m.GrandfatheredRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GrandfatheredRelayers[iNdEx])
+			copy(dAtA[i:], m.GrandfatheredRelayers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.GrandfatheredRelayers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RuneEtchingQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RuneEtchingQuorum))
+		i--
+		dAtA[i] = 0x28
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintParams(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	if m.SlashPercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DepositRewardPercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositRewardPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GuardianParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PauseCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseCooldown):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintParams(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	n25, err25 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPauseDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPauseDuration):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintParams(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositConfirmationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositConfirmationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositConfirmationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunesPriceSymbols) > 0 {
+		for iNdEx := len(m.RunesPriceSymbols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunesPriceSymbols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositConfirmationTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositConfirmationTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositConfirmationTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmationDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinAmount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunesPriceSymbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunesPriceSymbol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunesPriceSymbol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Divisibility != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Divisibility))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecoveryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecoveryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecoveryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timelock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Timelock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawConfirmationDepth != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawConfirmationDepth))
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+	}
+	l = len(m.BtcVoucherDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DepositEnabled {
+		n += 2
+	}
+	if m.WithdrawEnabled {
+		n += 2
+	}
+	if len(m.TrustedFeeProviders) > 0 {
+		for _, s := range m.TrustedFeeProviders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeRateValidityPeriod != 0 {
+		n += 1 + sovParams(uint64(m.FeeRateValidityPeriod))
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.WithdrawParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProtocolLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProtocolFees.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TssParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RateLimitParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.IbcParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.FeeSponsorshipParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RelayerParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.GuardianParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.DepositConfirmationParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.VaultRecoveryParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovParams(uint64(m.AssetType))
+	}
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	if m.Recovery != nil {
+		l = m.Recovery.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *WithdrawParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUtxoNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxUtxoNum))
+	}
+	if m.BtcBatchWithdrawPeriod != 0 {
+		n += 1 + sovParams(uint64(m.BtcBatchWithdrawPeriod))
+	}
+	if m.MaxBtcBatchWithdrawNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxBtcBatchWithdrawNum))
+	}
+	return n
+}
+
+func (m *ProtocolLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcMinDeposit != 0 {
+		n += 1 + sovParams(uint64(m.BtcMinDeposit))
+	}
+	if m.BtcMinWithdraw != 0 {
+		n += 1 + sovParams(uint64(m.BtcMinWithdraw))
+	}
+	if m.BtcMaxWithdraw != 0 {
+		n += 1 + sovParams(uint64(m.BtcMaxWithdraw))
+	}
+	return n
+}
+
+func (m *ProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositFee != 0 {
+		n += 1 + sovParams(uint64(m.DepositFee))
+	}
+	if m.WithdrawFee != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawFee))
+	}
+	l = len(m.Collector)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *TSSParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalRateLimitParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AddressRateLimitParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *GlobalRateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovParams(uint64(l))
+	if m.SupplyPercentageQuota != 0 {
+		n += 1 + sovParams(uint64(m.SupplyPercentageQuota))
+	}
+	return n
+}
+
+func (m *AddressRateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovParams(uint64(l))
+	if m.Quota != 0 {
+		n += 1 + sovParams(uint64(m.Quota))
+	}
+	return n
+}
+
+func (m *IBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutHeightOffset != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutHeightOffset))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeSponsorshipParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxSponsorFee) > 0 {
+		for _, e := range m.MaxSponsorFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorshipPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AccountQuota != 0 {
+		n += 1 + sovParams(uint64(m.AccountQuota))
+	}
+	if len(m.EpochBudget) > 0 {
+		for _, e := range m.EpochBudget {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FundingAccount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DepositRewardPercentage != 0 {
+		n += 1 + sovParams(uint64(m.DepositRewardPercentage))
+	}
+	if m.SlashPercentage != 0 {
+		n += 1 + sovParams(uint64(m.SlashPercentage))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.RuneEtchingQuorum != 0 {
+		n += 1 + sovParams(uint64(m.RuneEtchingQuorum))
+	}
+	if len(m.GrandfatheredRelayers) > 0 {
+		for _, s := range m.GrandfatheredRelayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GuardianParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPauseDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseCooldown)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DepositConfirmationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RunesPriceSymbols) > 0 {
+		for _, e := range m.RunesPriceSymbols {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositConfirmationTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinAmount != 0 {
+		n += 1 + sovParams(uint64(m.MinAmount))
+	}
+	if m.ConfirmationDepth != 0 {
+		n += 1 + sovParams(uint64(m.ConfirmationDepth))
+	}
+	return n
+}
+
+func (m *RunesPriceSymbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Divisibility != 0 {
+		n += 1 + sovParams(uint64(m.Divisibility))
+	}
+	return n
+}
+
+func (m *VaultRecoveryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovParams(uint64(m.Threshold))
+	}
+	if m.Timelock != 0 {
+		n += 1 + sovParams(uint64(m.Timelock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawConfirmationDepth", wireType)
+			}
+			m.WithdrawConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawConfirmationDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+			}
+			m.MaxAcceptableBlockDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFeeProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedFeeProviders = append(m.TrustedFeeProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateValidityPeriod", wireType)
+			}
+			m.FeeRateValidityPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRateValidityPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IbcParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSponsorshipParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositConfirmationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultRecoveryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultRecoveryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recovery == nil {
+				m.Recovery = &VaultRecovery{}
+			}
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+			}
+			m.MaxUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
+			}
+			m.BtcBatchWithdrawPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+			}
+			m.MaxBtcBatchWithdrawNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1774,17 +4049,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
 			}
-			m.DepositConfirmationDepth = 0
+			m.BtcMinDeposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1794,16 +4069,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositConfirmationDepth |= int32(b&0x7F) << shift
+				m.BtcMinDeposit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawConfirmationDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
 			}
-			m.WithdrawConfirmationDepth = 0
+			m.BtcMinWithdraw = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1813,16 +4088,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawConfirmationDepth |= int32(b&0x7F) << shift
+				m.BtcMinWithdraw |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
 			}
-			m.MaxAcceptableBlockDepth = 0
+			m.BtcMaxWithdraw = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1832,48 +4107,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				m.BtcMaxWithdraw |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
 			}
-			var v int
+			m.DepositFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1883,17 +4176,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DepositFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DepositEnabled = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
 			}
-			var v int
+			m.WithdrawFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1903,15 +4195,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WithdrawFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawEnabled = bool(v != 0)
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedNonBtcRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1939,13 +4230,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedNonBtcRelayers = append(m.TrustedNonBtcRelayers, string(dAtA[iNdEx:postIndex]))
+			m.Collector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TSSParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFeeProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1955,29 +4296,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedFeeProviders = append(m.TrustedFeeProviders, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateValidityPeriod", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
 			}
-			m.FeeRateValidityPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1987,14 +4329,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRateValidityPeriod |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ParticipantUpdateTransitionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimitParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2021,14 +4427,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, &Vault{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalRateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimitParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2055,13 +4460,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddressRateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalRateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2088,15 +4543,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProtocolLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPercentageQuota", wireType)
 			}
-			var msglen int
+			m.SupplyPercentageQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2106,28 +4561,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SupplyPercentageQuota |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
 			}
-			iNdEx = postIndex
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2154,13 +4645,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TssParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
+			}
+			m.TimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2184,16 +4763,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorshipParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSponsorFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2220,13 +4849,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IbcParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxSponsorFee = append(m.MaxSponsorFee, types.Coin{})
+			if err := m.MaxSponsorFee[len(m.MaxSponsorFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2253,7 +4883,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeSponsorshipParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policies = append(m.Policies, FeeSponsorshipPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2278,7 +4909,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vault) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorshipPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,15 +4932,15 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorshipPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorshipPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2337,11 +4968,11 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2369,13 +5000,13 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountQuota", wireType)
 			}
-			m.AssetType = 0
+			m.AccountQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2385,16 +5016,16 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= AssetType(b&0x7F) << shift
+				m.AccountQuota |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBudget", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2404,66 +5035,31 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EpochBudget = append(m.EpochBudget, types.Coin{})
+			if err := m.EpochBudget[len(m.EpochBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
 			}
-			m.MaxUtxoNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2473,35 +5069,30 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUtxoNum |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthParams
 			}
-			m.BtcBatchWithdrawPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxBtcBatchWithdrawNum = 0
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAccount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2511,11 +5102,24 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2537,7 +5141,7 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
+func (m *RelayerParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,17 +5164,17 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
 			}
-			m.BtcMinDeposit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2580,16 +5184,30 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMinDeposit |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRewardPercentage", wireType)
 			}
-			m.BtcMinWithdraw = 0
+			m.DepositRewardPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2599,16 +5217,16 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMinWithdraw |= int64(b&0x7F) << shift
+				m.DepositRewardPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPercentage", wireType)
 			}
-			m.BtcMaxWithdraw = 0
+			m.SlashPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2618,66 +5236,16 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMaxWithdraw |= int64(b&0x7F) << shift
+				m.SlashPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
-			m.DepositFee = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2687,16 +5255,30 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositFee |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneEtchingQuorum", wireType)
 			}
-			m.WithdrawFee = 0
+			m.RuneEtchingQuorum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2706,14 +5288,14 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawFee |= int64(b&0x7F) << shift
+				m.RuneEtchingQuorum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrandfatheredRelayers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2741,7 +5323,7 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collector = string(dAtA[iNdEx:postIndex])
+			m.GrandfatheredRelayers = append(m.GrandfatheredRelayers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2764,7 +5346,7 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TSSParams) Unmarshal(dAtA []byte) error {
+func (m *GuardianParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2787,15 +5369,47 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuardianParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuardianParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPauseDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2822,13 +5436,13 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPauseDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseCooldown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2855,7 +5469,7 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ParticipantUpdateTransitionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PauseCooldown, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2880,7 +5494,7 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+func (m *DepositConfirmationParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2903,15 +5517,15 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositConfirmationParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositConfirmationParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2938,13 +5552,14 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalRateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, DepositConfirmationTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunesPriceSymbols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2971,7 +5586,8 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddressRateLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RunesPriceSymbols = append(m.RunesPriceSymbols, RunesPriceSymbol{})
+			if err := m.RunesPriceSymbols[len(m.RunesPriceSymbols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2996,7 +5612,7 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
+func (m *DepositConfirmationTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,17 +5635,17 @@ func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalRateLimitParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositConfirmationTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
-			var msglen int
+			m.MinAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3039,30 +5655,16 @@ func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinAmount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPercentageQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationDepth", wireType)
 			}
-			m.SupplyPercentageQuota = 0
+			m.ConfirmationDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3072,7 +5674,7 @@ func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SupplyPercentageQuota |= uint32(b&0x7F) << shift
+				m.ConfirmationDepth |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3098,7 +5700,7 @@ func (m *GlobalRateLimitParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressRateLimitParams) Unmarshal(dAtA []byte) error {
+func (m *RunesPriceSymbol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3121,17 +5723,17 @@ func (m *AddressRateLimitParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressRateLimitParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunesPriceSymbol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunesPriceSymbol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3141,30 +5743,29 @@ func (m *AddressRateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			m.Quota = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3174,85 +5775,29 @@ func (m *AddressRateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quota |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IBCParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IBCParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
-			}
-			m.TimeoutHeightOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutHeightOffset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
 			}
-			var msglen int
+			m.Divisibility = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3262,25 +5807,11 @@ func (m *IBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Divisibility |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -3302,7 +5833,7 @@ func (m *IBCParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeSponsorshipParams) Unmarshal(dAtA []byte) error {
+func (m *VaultRecoveryParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,17 +5856,17 @@ func (m *FeeSponsorshipParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeSponsorshipParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultRecoveryParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeSponsorshipParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultRecoveryParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSponsorFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3345,26 +5876,62 @@ func (m *FeeSponsorshipParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSponsorFee = append(m.MaxSponsorFee, types.Coin{})
-			if err := m.MaxSponsorFee[len(m.MaxSponsorFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			m.Timelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timelock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+)
+
+// ValidatePauseTarget validates the given pause target and asset type
+// The asset type is only required for PAUSE_TARGET_ASSET
+func ValidatePauseTarget(target PauseTarget, assetType AssetType) error {
+	if _, ok := PauseTarget_name[int32(target)]; !ok || target == PauseTarget_PAUSE_TARGET_UNSPECIFIED {
+		return ErrInvalidPause
+	}
+
+	if target == PauseTarget_PAUSE_TARGET_ASSET {
+		if _, ok := AssetType_name[int32(assetType)]; !ok || assetType == AssetType_ASSET_TYPE_UNSPECIFIED {
+			return ErrInvalidPause
+		}
+	} else if assetType != AssetType_ASSET_TYPE_UNSPECIFIED {
+		return ErrInvalidPause
+	}
+
+	return nil
+}
+
+// IsActive returns true if the pause is in effect at the given time, false otherwise
+func (p *Pause) IsActive(blockTime time.Time) bool {
+	return p.Status == PauseStatus_PAUSE_STATUS_ACTIVE && blockTime.Before(p.EndTime)
+}
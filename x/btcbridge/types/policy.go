@@ -157,3 +157,22 @@ func CheckRunesDepositTransaction(tx *wire.MsgTx, vaults []*Vault) (*Edict, erro
 
 	return edicts[0], nil
 }
+
+// ExtractDepositSenderAddr extracts the sender address of the deposit tx from the first input
+func ExtractDepositSenderAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, chainCfg *chaincfg.Params) (btcutil.Address, error) {
+	if len(tx.TxIn) == 0 {
+		return nil, ErrInvalidDepositTransaction
+	}
+
+	prevOutIndex := tx.TxIn[0].PreviousOutPoint.Index
+	if int(prevOutIndex) >= len(prevTx.TxOut) {
+		return nil, ErrInvalidDepositTransaction
+	}
+
+	pkScript, err := txscript.ParsePkScript(prevTx.TxOut[prevOutIndex].PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkScript.Address(chainCfg)
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -2344,3600 +2346,12529 @@ func (m *QueryRateLimitByAddressResponse) GetUsed() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryWithdrawRequestsByAddressRequest)(nil), "bitway.btcbridge.QueryWithdrawRequestsByAddressRequest")
-	proto.RegisterType((*QueryWithdrawRequestsByAddressResponse)(nil), "bitway.btcbridge.QueryWithdrawRequestsByAddressResponse")
-	proto.RegisterType((*QueryWithdrawRequestsByTxHashRequest)(nil), "bitway.btcbridge.QueryWithdrawRequestsByTxHashRequest")
-	proto.RegisterType((*QueryWithdrawRequestsByTxHashResponse)(nil), "bitway.btcbridge.QueryWithdrawRequestsByTxHashResponse")
-	proto.RegisterType((*QueryPendingBtcWithdrawRequestsRequest)(nil), "bitway.btcbridge.QueryPendingBtcWithdrawRequestsRequest")
-	proto.RegisterType((*QueryPendingBtcWithdrawRequestsResponse)(nil), "bitway.btcbridge.QueryPendingBtcWithdrawRequestsResponse")
-	proto.RegisterType((*QuerySigningRequestRequest)(nil), "bitway.btcbridge.QuerySigningRequestRequest")
-	proto.RegisterType((*QuerySigningRequestResponse)(nil), "bitway.btcbridge.QuerySigningRequestResponse")
-	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "bitway.btcbridge.QuerySigningRequestsRequest")
-	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "bitway.btcbridge.QuerySigningRequestsResponse")
-	proto.RegisterType((*QuerySigningRequestsByAddressRequest)(nil), "bitway.btcbridge.QuerySigningRequestsByAddressRequest")
-	proto.RegisterType((*QuerySigningRequestsByAddressResponse)(nil), "bitway.btcbridge.QuerySigningRequestsByAddressResponse")
-	proto.RegisterType((*QuerySigningRequestByTxHashRequest)(nil), "bitway.btcbridge.QuerySigningRequestByTxHashRequest")
-	proto.RegisterType((*QuerySigningRequestByTxHashResponse)(nil), "bitway.btcbridge.QuerySigningRequestByTxHashResponse")
-	proto.RegisterType((*QueryPendingSigningRequestsRequest)(nil), "bitway.btcbridge.QueryPendingSigningRequestsRequest")
-	proto.RegisterType((*QueryPendingSigningRequestsResponse)(nil), "bitway.btcbridge.QueryPendingSigningRequestsResponse")
-	proto.RegisterType((*QueryFeeRateRequest)(nil), "bitway.btcbridge.QueryFeeRateRequest")
-	proto.RegisterType((*QueryFeeRateResponse)(nil), "bitway.btcbridge.QueryFeeRateResponse")
-	proto.RegisterType((*QueryWithdrawalNetworkFeeRequest)(nil), "bitway.btcbridge.QueryWithdrawalNetworkFeeRequest")
-	proto.RegisterType((*QueryWithdrawalNetworkFeeResponse)(nil), "bitway.btcbridge.QueryWithdrawalNetworkFeeResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "bitway.btcbridge.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "bitway.btcbridge.QueryParamsResponse")
-	proto.RegisterType((*QueryUTXOsRequest)(nil), "bitway.btcbridge.QueryUTXOsRequest")
-	proto.RegisterType((*QueryUTXOsResponse)(nil), "bitway.btcbridge.QueryUTXOsResponse")
-	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "bitway.btcbridge.QueryUTXOsByAddressRequest")
-	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "bitway.btcbridge.QueryUTXOsByAddressResponse")
-	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressRequest)(nil), "bitway.btcbridge.QueryUTXOCountAndBalancesByAddressRequest")
-	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressResponse)(nil), "bitway.btcbridge.QueryUTXOCountAndBalancesByAddressResponse")
-	proto.RegisterType((*QueryDKGRequestRequest)(nil), "bitway.btcbridge.QueryDKGRequestRequest")
-	proto.RegisterType((*QueryDKGRequestResponse)(nil), "bitway.btcbridge.QueryDKGRequestResponse")
-	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "bitway.btcbridge.QueryDKGRequestsRequest")
-	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "bitway.btcbridge.QueryDKGRequestsResponse")
-	proto.RegisterType((*QueryAllDKGRequestsRequest)(nil), "bitway.btcbridge.QueryAllDKGRequestsRequest")
-	proto.RegisterType((*QueryAllDKGRequestsResponse)(nil), "bitway.btcbridge.QueryAllDKGRequestsResponse")
-	proto.RegisterType((*QueryDKGCompletionRequestsRequest)(nil), "bitway.btcbridge.QueryDKGCompletionRequestsRequest")
-	proto.RegisterType((*QueryDKGCompletionRequestsResponse)(nil), "bitway.btcbridge.QueryDKGCompletionRequestsResponse")
-	proto.RegisterType((*QueryRefreshingRequestRequest)(nil), "bitway.btcbridge.QueryRefreshingRequestRequest")
-	proto.RegisterType((*QueryRefreshingRequestResponse)(nil), "bitway.btcbridge.QueryRefreshingRequestResponse")
-	proto.RegisterType((*QueryRefreshingRequestsRequest)(nil), "bitway.btcbridge.QueryRefreshingRequestsRequest")
-	proto.RegisterType((*QueryRefreshingRequestsResponse)(nil), "bitway.btcbridge.QueryRefreshingRequestsResponse")
-	proto.RegisterType((*QueryRefreshingCompletionsRequest)(nil), "bitway.btcbridge.QueryRefreshingCompletionsRequest")
-	proto.RegisterType((*QueryRefreshingCompletionsResponse)(nil), "bitway.btcbridge.QueryRefreshingCompletionsResponse")
-	proto.RegisterType((*QueryIBCDepositScriptRequest)(nil), "bitway.btcbridge.QueryIBCDepositScriptRequest")
-	proto.RegisterType((*QueryIBCDepositScriptResponse)(nil), "bitway.btcbridge.QueryIBCDepositScriptResponse")
-	proto.RegisterType((*QueryRateLimitRequest)(nil), "bitway.btcbridge.QueryRateLimitRequest")
-	proto.RegisterType((*QueryRateLimitResponse)(nil), "bitway.btcbridge.QueryRateLimitResponse")
-	proto.RegisterType((*QueryRateLimitByAddressRequest)(nil), "bitway.btcbridge.QueryRateLimitByAddressRequest")
-	proto.RegisterType((*QueryRateLimitByAddressResponse)(nil), "bitway.btcbridge.QueryRateLimitByAddressResponse")
+// QueryRelayerRequest is the request type for the Query/Relayer RPC method.
+type QueryRelayerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("bitway/btcbridge/query.proto", fileDescriptor_c2db215f5737906e) }
-
-var fileDescriptor_c2db215f5737906e = []byte{
-	// 2048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0xc5, 0x1f, 0x89, 0x2b, 0x4b, 0xc8, 0xbe, 0xf5, 0x26, 0xe3, 0x8e, 0x3d, 0x9e, 0x74,
-	0x1c, 0xdb, 0xf1, 0x3a, 0xd3, 0xeb, 0x8f, 0xb5, 0x13, 0x13, 0xb4, 0xca, 0x38, 0x6c, 0xb2, 0xca,
-	0x02, 0xd9, 0x8e, 0x57, 0x0b, 0x5c, 0xac, 0x9e, 0x99, 0xf2, 0xb8, 0xd9, 0x99, 0xee, 0x49, 0x77,
-	0xcf, 0xc6, 0x96, 0x89, 0x84, 0x38, 0xb1, 0xb7, 0x08, 0x6e, 0x20, 0x90, 0x90, 0x00, 0x09, 0x21,
-	0xbe, 0x84, 0x04, 0xe2, 0x82, 0x38, 0xa1, 0xe5, 0x82, 0x82, 0xe0, 0xc0, 0x09, 0x50, 0xc2, 0x0d,
-	0xfe, 0x08, 0xd4, 0xd5, 0xaf, 0x7b, 0x66, 0xba, 0xba, 0xba, 0x7b, 0x8c, 0xad, 0xcd, 0xad, 0xbb,
-	0xea, 0x7d, 0xfc, 0xde, 0xab, 0x57, 0x55, 0xaf, 0x7e, 0x74, 0xb2, 0x6a, 0x7a, 0x8f, 0x8c, 0x7d,
-	0xad, 0xea, 0xd5, 0xaa, 0x8e, 0x59, 0x6f, 0x30, 0xed, 0x61, 0x87, 0x39, 0xfb, 0xe5, 0xb6, 0x63,
-	0x7b, 0x36, 0x9c, 0x0b, 0x66, 0xcb, 0xd1, 0xac, 0x32, 0xde, 0xb0, 0x1b, 0x36, 0x9f, 0xd4, 0xfc,
-	0xaf, 0x40, 0x4e, 0x99, 0x6c, 0xd8, 0x76, 0xa3, 0xc9, 0x34, 0xa3, 0x6d, 0x6a, 0x86, 0x65, 0xd9,
-	0x9e, 0xe1, 0x99, 0xb6, 0xe5, 0xe2, 0xec, 0x34, 0xce, 0xf2, 0xbf, 0x6a, 0x67, 0x47, 0xf3, 0xcc,
-	0x16, 0x73, 0x3d, 0xa3, 0xd5, 0x46, 0x81, 0x85, 0x9a, 0xed, 0xb6, 0x6c, 0x57, 0xab, 0x1a, 0x2e,
-	0xfa, 0xd7, 0x3e, 0x5c, 0xaa, 0x32, 0xcf, 0x58, 0xd2, 0xda, 0x46, 0xc3, 0xb4, 0xb8, 0x35, 0x94,
-	0x9d, 0x12, 0x00, 0xb7, 0x0d, 0xc7, 0x68, 0x85, 0xbe, 0x4a, 0xc2, 0x74, 0xf4, 0x15, 0x48, 0xa8,
-	0x1f, 0x11, 0x7a, 0xe5, 0x5d, 0xdf, 0xc7, 0xfb, 0xa6, 0xb7, 0x5b, 0x77, 0x8c, 0x47, 0x3a, 0x7b,
-	0xd8, 0x61, 0xae, 0xe7, 0x56, 0xf6, 0x6f, 0xd5, 0xeb, 0x0e, 0x73, 0x5d, 0x1c, 0x80, 0x02, 0x3d,
-	0x65, 0x04, 0x23, 0x05, 0x52, 0x22, 0xf3, 0x63, 0x7a, 0xf8, 0x0b, 0x6f, 0x51, 0xda, 0x05, 0x56,
-	0x38, 0x59, 0x22, 0xf3, 0x67, 0x96, 0x67, 0xcb, 0x41, 0x14, 0x65, 0x3f, 0x8a, 0x72, 0x90, 0x45,
-	0x8c, 0xa2, 0x7c, 0xdf, 0x68, 0x30, 0xb4, 0xaa, 0xf7, 0x68, 0xaa, 0xbf, 0x24, 0x74, 0x36, 0x0b,
-	0x8b, 0xdb, 0xb6, 0x2d, 0x97, 0xc1, 0x67, 0xe9, 0x69, 0x07, 0x27, 0x0b, 0xa4, 0x34, 0x34, 0x7f,
-	0x66, 0xf9, 0x52, 0x39, 0xbe, 0x3a, 0xe5, 0x98, 0x19, 0x3d, 0x52, 0x81, 0x3b, 0x09, 0x88, 0xe7,
-	0x32, 0x11, 0x07, 0xbe, 0xfb, 0x20, 0x6f, 0xd0, 0x19, 0x09, 0xe2, 0xad, 0xbd, 0xbb, 0x86, 0xbb,
-	0x1b, 0x26, 0x0f, 0xe8, 0xb0, 0xb7, 0x67, 0xd6, 0x31, 0x73, 0xfc, 0x5b, 0xdd, 0x91, 0x66, 0x3e,
-	0xd4, 0x3d, 0x92, 0x60, 0xd5, 0x36, 0x66, 0xf5, 0x3e, 0xb3, 0xea, 0xa6, 0xd5, 0xa8, 0x78, 0xb5,
-	0xb8, 0xc7, 0x10, 0x65, 0xff, 0x42, 0x92, 0x43, 0x2f, 0xe4, 0xaf, 0x08, 0x9d, 0xcb, 0x74, 0xf9,
-	0x82, 0xad, 0xe4, 0x75, 0xaa, 0x70, 0xc8, 0x0f, 0xcc, 0x86, 0x65, 0x5a, 0x8d, 0xd0, 0x13, 0x66,
-	0x46, 0xa1, 0xa7, 0x5d, 0xff, 0xd3, 0xaa, 0x31, 0x9e, 0x97, 0x61, 0x3d, 0xfa, 0x57, 0xbf, 0x4c,
-	0x2f, 0x26, 0x6a, 0x62, 0x80, 0x1b, 0xf4, 0x14, 0xa2, 0xc5, 0x8c, 0x96, 0xc4, 0xf8, 0x62, 0xaa,
-	0xa1, 0x82, 0xfa, 0x7d, 0x92, 0x68, 0x3b, 0x5a, 0xb0, 0x75, 0x3a, 0xea, 0x7a, 0x86, 0xd7, 0x09,
-	0xb6, 0xe4, 0xd9, 0xe5, 0x69, 0xa9, 0xe9, 0x07, 0x5c, 0x4c, 0x47, 0xf1, 0x23, 0xdb, 0xb2, 0x3f,
-	0x22, 0x74, 0x32, 0x19, 0x20, 0x46, 0x7f, 0x53, 0x58, 0xde, 0xec, 0xf0, 0x8f, 0x61, 0x75, 0xbf,
-	0x49, 0x70, 0xa3, 0xc6, 0x70, 0x7e, 0x02, 0xa7, 0xdc, 0xcf, 0xc3, 0x13, 0x57, 0x0e, 0xe5, 0xc5,
-	0xca, 0xdd, 0x75, 0xaa, 0x26, 0xe0, 0xcd, 0x73, 0xc2, 0x19, 0xf4, 0x72, 0xaa, 0xe6, 0x11, 0xec,
-	0x90, 0x26, 0x82, 0xc3, 0x93, 0x46, 0xb2, 0x4f, 0x8e, 0xea, 0x60, 0xfb, 0x35, 0xc1, 0x88, 0x64,
-	0xee, 0x30, 0xa2, 0x4d, 0x61, 0xe5, 0xe6, 0xc4, 0x90, 0x36, 0xed, 0x56, 0xdb, 0xa8, 0x79, 0xc7,
-	0xbf, 0x80, 0xaf, 0xd2, 0x57, 0x38, 0xe8, 0xb7, 0x18, 0xd3, 0x0d, 0x2f, 0x0c, 0x4c, 0x7d, 0x87,
-	0x8e, 0xf7, 0x0f, 0x23, 0xf8, 0x55, 0x7a, 0x7a, 0x87, 0xb1, 0x6d, 0xc7, 0xf0, 0x18, 0xa6, 0x6a,
-	0x42, 0x04, 0x1f, 0x2a, 0x9d, 0xda, 0x09, 0x3e, 0x54, 0x9b, 0x96, 0xfa, 0x6e, 0x33, 0xa3, 0xf9,
-	0x05, 0xe6, 0x3d, 0xb2, 0x9d, 0x0f, 0x7c, 0xc9, 0xcc, 0xcd, 0x75, 0x9e, 0x8e, 0x1a, 0x2d, 0xbb,
-	0x63, 0x79, 0x3c, 0xce, 0x31, 0x1d, 0xff, 0x60, 0xa2, 0x07, 0xcb, 0x50, 0x89, 0xcc, 0x0f, 0x75,
-	0x1d, 0xde, 0xa7, 0x97, 0x52, 0x1c, 0x62, 0x2c, 0x13, 0xb1, 0x58, 0xba, 0xfa, 0x70, 0x8e, 0x0e,
-	0xed, 0x30, 0x86, 0xfe, 0xfc, 0x4f, 0x75, 0x9c, 0x42, 0xb0, 0xb8, 0xbc, 0x85, 0x0a, 0xd3, 0xf4,
-	0x79, 0xcc, 0x5e, 0x38, 0x8a, 0x96, 0xd7, 0xe8, 0x68, 0xd0, 0x6a, 0x61, 0x8e, 0x0a, 0x62, 0x8e,
-	0x02, 0x8d, 0xca, 0xf0, 0xc7, 0xff, 0x98, 0x3e, 0xa1, 0xa3, 0xb4, 0xfa, 0x0a, 0x7d, 0x99, 0x9b,
-	0x7b, 0x6f, 0xeb, 0x4b, 0x5f, 0x8c, 0x7c, 0x54, 0xd0, 0x33, 0x0e, 0xa2, 0x8b, 0x45, 0x3a, 0xd2,
-	0xf1, 0xf6, 0xec, 0xb0, 0x84, 0xce, 0x8b, 0x1e, 0x7c, 0x79, 0x3d, 0x10, 0x52, 0xd7, 0xf0, 0x02,
-	0xe3, 0x36, 0xf2, 0x9f, 0x6b, 0xea, 0x3d, 0xbc, 0x62, 0xe2, 0x7a, 0x87, 0x02, 0xf1, 0x39, 0x7a,
-	0x35, 0x32, 0xb6, 0xe9, 0xaf, 0xe0, 0x2d, 0xab, 0x5e, 0x31, 0x9a, 0x86, 0x55, 0x63, 0x83, 0x60,
-	0xfa, 0x2e, 0xa1, 0x0b, 0x79, 0xec, 0x20, 0xc6, 0x71, 0x3a, 0x52, 0xe3, 0xc5, 0xe3, 0x9b, 0xf9,
-	0x94, 0x1e, 0xfc, 0xf8, 0xa3, 0x1f, 0x1a, 0xcd, 0x4e, 0xb0, 0xc4, 0x43, 0x7a, 0xf0, 0x03, 0xb7,
-	0xe8, 0x4b, 0x4e, 0xc7, 0x62, 0xa1, 0xb1, 0xc2, 0x10, 0x0f, 0x6b, 0x4a, 0x0c, 0x4b, 0xef, 0x4a,
-	0xe9, 0x7d, 0x2a, 0xea, 0x3c, 0x3d, 0xcf, 0xc1, 0xdd, 0xbe, 0x77, 0x27, 0xd6, 0x26, 0x9c, 0xa5,
-	0x27, 0xf1, 0x08, 0x1c, 0xd6, 0x4f, 0x9a, 0x75, 0xf5, 0x5d, 0x7a, 0x41, 0x90, 0x8c, 0xea, 0x27,
-	0x76, 0xe8, 0x4d, 0x8a, 0x10, 0x7a, 0xd4, 0xa2, 0x03, 0xef, 0x3d, 0xc1, 0x64, 0x94, 0xcf, 0x8d,
-	0x58, 0x37, 0xa0, 0xa6, 0x59, 0xec, 0x6f, 0x08, 0xd4, 0x2d, 0x5a, 0x10, 0xcd, 0x22, 0xd4, 0xeb,
-	0xc2, 0x69, 0x96, 0x8e, 0xb5, 0xdb, 0x7a, 0x4e, 0x62, 0x4d, 0xde, 0x6a, 0x36, 0x45, 0xbc, 0xea,
-	0xfb, 0x58, 0x79, 0xf1, 0xd9, 0xff, 0xdb, 0xed, 0x0a, 0x1e, 0x0d, 0xb7, 0xef, 0xdd, 0xf1, 0x0f,
-	0xd9, 0x26, 0xf3, 0x8f, 0xc1, 0x78, 0xb6, 0xe2, 0x6b, 0xb5, 0x8b, 0x37, 0x89, 0x44, 0x09, 0x41,
-	0x55, 0x04, 0x50, 0xb3, 0x89, 0xa0, 0x04, 0x13, 0x3d, 0xf0, 0x34, 0x3a, 0xc5, 0x3d, 0xe9, 0x6c,
-	0xc7, 0x61, 0xee, 0xae, 0xd8, 0x6d, 0xc6, 0xa1, 0x6d, 0xd3, 0xa2, 0x4c, 0x21, 0xea, 0xa2, 0x63,
-	0xd5, 0x74, 0x39, 0xa1, 0xa0, 0x05, 0xed, 0xa8, 0xa8, 0x7e, 0x48, 0x64, 0x1e, 0x06, 0x29, 0xae,
-	0xae, 0xf2, 0x31, 0x75, 0x9b, 0x3f, 0x25, 0x74, 0x5a, 0x0a, 0x13, 0x33, 0xf1, 0xa6, 0xb0, 0x40,
-	0xb9, 0x52, 0x71, 0x0c, 0xd7, 0xee, 0x01, 0x56, 0x61, 0xd7, 0x59, 0xb7, 0x28, 0x64, 0x55, 0x78,
-	0x64, 0xa9, 0xfa, 0x2d, 0xc1, 0x72, 0x96, 0x78, 0xc7, 0x6c, 0xdd, 0xa5, 0x67, 0x6a, 0xdd, 0x61,
-	0x79, 0x45, 0x27, 0x59, 0xd1, 0x7b, 0x55, 0x8f, 0x2e, 0x6d, 0x5f, 0xc5, 0x17, 0xc5, 0xdb, 0x95,
-	0xcd, 0xdb, 0xac, 0x6d, 0xbb, 0xa6, 0xf7, 0xa0, 0xe6, 0x98, 0xed, 0x68, 0x73, 0x4c, 0x51, 0x5a,
-	0xdb, 0x35, 0x2c, 0x8b, 0x35, 0xb7, 0xa3, 0x76, 0x73, 0x0c, 0x47, 0xde, 0xae, 0xc3, 0x6b, 0xf4,
-	0x65, 0x87, 0xd5, 0xcc, 0xb6, 0xc9, 0x2c, 0x6f, 0x3b, 0xbc, 0x5e, 0x82, 0x4b, 0xfe, 0x5c, 0x34,
-	0x81, 0x17, 0x88, 0xba, 0x8e, 0x3b, 0x51, 0xf4, 0x85, 0xf9, 0x39, 0x4f, 0x47, 0x5d, 0x3e, 0x82,
-	0x8e, 0xf0, 0x4f, 0xbd, 0x40, 0x5f, 0x0d, 0xb2, 0x6b, 0x78, 0xec, 0x1d, 0xb3, 0x65, 0x86, 0xe8,
-	0xd4, 0x2d, 0xbc, 0x1b, 0x7a, 0x26, 0xa2, 0x2e, 0x97, 0xfa, 0x6d, 0xc8, 0x76, 0xd3, 0x1f, 0xc5,
-	0x5d, 0x7a, 0x31, 0x21, 0xd3, 0x91, 0xe2, 0x98, 0x13, 0x7e, 0xaa, 0x1b, 0xe1, 0xf6, 0x0c, 0x47,
-	0x06, 0xb8, 0x4b, 0xff, 0x13, 0x6d, 0x9a, 0x04, 0x65, 0xc4, 0x26, 0x6f, 0xcc, 0x36, 0x29, 0x75,
-	0x3d, 0xc3, 0xf1, 0xb6, 0x3d, 0xb3, 0xc5, 0x70, 0x59, 0x95, 0x72, 0x40, 0x61, 0x95, 0x43, 0x0a,
-	0xab, 0xbc, 0x15, 0x52, 0x58, 0x95, 0xd3, 0x7e, 0xb3, 0xf3, 0xe4, 0x9f, 0xd3, 0x44, 0x1f, 0xe3,
-	0x7a, 0xfe, 0x8c, 0xbf, 0x27, 0x99, 0x55, 0x0f, 0x4c, 0x0c, 0x0d, 0x60, 0xe2, 0x14, 0xb3, 0xea,
-	0xdc, 0xc0, 0x38, 0x1d, 0x79, 0xd8, 0xb1, 0x3d, 0xa3, 0x30, 0x1c, 0x5c, 0xe5, 0xfc, 0xc7, 0x7f,
-	0x72, 0x74, 0x5c, 0x56, 0x2f, 0x8c, 0xf0, 0x41, 0xfe, 0xbd, 0xfc, 0xdf, 0x12, 0x1d, 0xe1, 0xd1,
-	0xc2, 0xd7, 0xe8, 0x99, 0x9e, 0xbe, 0x0d, 0x66, 0xc4, 0x54, 0x8b, 0xcd, 0x9e, 0x72, 0x25, 0x43,
-	0x2a, 0xc8, 0x97, 0x5a, 0xfa, 0xc6, 0x5f, 0xff, 0xfd, 0xed, 0x93, 0x0a, 0x14, 0x34, 0x09, 0xff,
-	0x06, 0x5f, 0x27, 0xf4, 0xa5, 0xde, 0xee, 0x1a, 0x64, 0x96, 0xfb, 0x9b, 0x72, 0x65, 0x36, 0x4b,
-	0x0c, 0x11, 0x5c, 0xe2, 0x08, 0x2e, 0xc2, 0x84, 0x88, 0x60, 0x87, 0x31, 0xbf, 0x72, 0xe0, 0x77,
-	0x84, 0x4e, 0x48, 0x3b, 0x64, 0x58, 0x96, 0x38, 0x4a, 0xe9, 0xdf, 0x95, 0x95, 0x81, 0x74, 0x10,
-	0xe9, 0x0a, 0x47, 0x7a, 0x0d, 0x5e, 0x13, 0x91, 0x3e, 0x8a, 0xf4, 0x7c, 0xd0, 0x1a, 0x73, 0x3d,
-	0xb3, 0xc5, 0x0f, 0x01, 0xf8, 0x5b, 0x78, 0x21, 0x49, 0xa9, 0x40, 0x58, 0xcf, 0x00, 0x23, 0x7b,
-	0xe2, 0x2b, 0xd7, 0x07, 0x57, 0xc4, 0x50, 0xde, 0xe4, 0xa1, 0xdc, 0x80, 0xf5, 0xd4, 0x50, 0xc2,
-	0x9b, 0x44, 0xc3, 0x3d, 0xa4, 0x1d, 0xe0, 0xc7, 0x63, 0xf8, 0x13, 0xc1, 0x03, 0x47, 0xc6, 0xf9,
-	0xc1, 0x5a, 0x6e, 0x70, 0x7d, 0xcf, 0x6f, 0x65, 0x7d, 0x60, 0x3d, 0x8c, 0x69, 0x9d, 0xc7, 0xb4,
-	0x04, 0x5a, 0xbe, 0x98, 0xbc, 0x3d, 0xed, 0xc0, 0x7f, 0xdb, 0x3f, 0x86, 0xbf, 0x84, 0xe7, 0x8a,
-	0x9c, 0xe4, 0x03, 0x59, 0xaa, 0x33, 0xa9, 0x48, 0xe5, 0xc6, 0x21, 0x34, 0x31, 0xa2, 0x1b, 0x3c,
-	0xa2, 0x15, 0x58, 0xca, 0x17, 0x51, 0xd5, 0xab, 0x69, 0xed, 0xc0, 0x2a, 0xfc, 0x84, 0xe0, 0x63,
-	0xaf, 0xff, 0x51, 0x0e, 0x8b, 0x12, 0x34, 0x89, 0x64, 0xa1, 0x72, 0x2d, 0xa7, 0x34, 0xe2, 0x7d,
-	0x83, 0xe3, 0xd5, 0xe0, 0x9a, 0x88, 0xd7, 0x0d, 0x34, 0xba, 0x60, 0x0f, 0x42, 0xd6, 0xf1, 0x31,
-	0xfc, 0x80, 0xe0, 0xfb, 0x3d, 0x46, 0x42, 0x40, 0x3e, 0xf7, 0x51, 0xa6, 0xcb, 0x79, 0xc5, 0x11,
-	0xee, 0x02, 0x87, 0x3b, 0x03, 0x6a, 0x36, 0x5c, 0xf8, 0x73, 0x58, 0xef, 0x32, 0xae, 0x4b, 0x5a,
-	0xef, 0x19, 0x3c, 0x9d, 0xb4, 0xde, 0xb3, 0x48, 0x35, 0xf5, 0x26, 0x87, 0xbf, 0x06, 0xab, 0x39,
-	0xb2, 0x2d, 0x6e, 0xe0, 0x3f, 0x24, 0x13, 0xb2, 0xd1, 0xf6, 0x5d, 0xcd, 0x05, 0x2b, 0xbe, 0x79,
-	0xdf, 0x18, 0x50, 0x0b, 0x43, 0x59, 0xe5, 0xa1, 0x94, 0x61, 0x31, 0x47, 0x28, 0xdd, 0x7d, 0xfb,
-	0xc7, 0x30, 0x84, 0x64, 0x0e, 0x4b, 0x1a, 0x42, 0x2a, 0xc3, 0x26, 0x0d, 0x21, 0x9d, 0x28, 0x53,
-	0x37, 0x78, 0x08, 0xab, 0xb0, 0x9c, 0x23, 0x04, 0xdc, 0xa4, 0x5a, 0x2d, 0xe0, 0xcd, 0x60, 0x8f,
-	0xd2, 0x2e, 0x71, 0x01, 0x97, 0x25, 0x00, 0x7a, 0x79, 0x16, 0x65, 0x26, 0x5d, 0x08, 0x41, 0x4d,
-	0x73, 0x50, 0x13, 0x70, 0x41, 0x04, 0xc5, 0x59, 0x0e, 0xf8, 0x5e, 0x78, 0x4c, 0xf4, 0x73, 0x26,
-	0xd2, 0x63, 0x22, 0x91, 0x92, 0x91, 0x1e, 0x13, 0xc9, 0x44, 0x8c, 0x7a, 0x95, 0xa3, 0xba, 0x0c,
-	0x97, 0x24, 0xa8, 0x7a, 0xaa, 0xf4, 0x69, 0xd8, 0xfc, 0xa7, 0xd2, 0x27, 0xf0, 0x99, 0x14, 0x00,
-	0x59, 0xe4, 0x8d, 0x72, 0xf3, 0x70, 0xca, 0x18, 0x8c, 0xc6, 0x83, 0xb9, 0x0a, 0x73, 0x99, 0xc1,
-	0x68, 0xfe, 0x1b, 0xd2, 0x85, 0x6f, 0x11, 0xfa, 0xe9, 0x18, 0x41, 0x01, 0xf3, 0x12, 0x08, 0x02,
-	0x2f, 0xa3, 0x5c, 0xcd, 0x21, 0x99, 0x7d, 0xbc, 0xd5, 0x3f, 0x88, 0xaa, 0x51, 0x3b, 0xf0, 0xb7,
-	0xd2, 0x13, 0x42, 0xcf, 0xc5, 0x59, 0x13, 0xc8, 0xf6, 0x15, 0xe5, 0x70, 0x21, 0x8f, 0x28, 0xe2,
-	0xba, 0xc2, 0x71, 0x4d, 0xc3, 0x54, 0x2a, 0x2e, 0xf8, 0x4e, 0x58, 0x9a, 0xfd, 0xa4, 0x8a, 0xb4,
-	0x34, 0x13, 0x99, 0x19, 0x69, 0x69, 0x26, 0x33, 0x35, 0xea, 0x2c, 0xc7, 0x56, 0x82, 0x62, 0x2a,
-	0x36, 0x17, 0x7e, 0x4f, 0x90, 0x0f, 0x4a, 0xe4, 0x58, 0x60, 0x45, 0x9e, 0x0e, 0x29, 0x8d, 0xa3,
-	0xac, 0x0e, 0xa6, 0x94, 0x7d, 0xe7, 0xfa, 0x88, 0xbb, 0x0f, 0xdb, 0xfe, 0x05, 0xff, 0x05, 0x09,
-	0x9f, 0x77, 0x71, 0x02, 0x01, 0x34, 0x09, 0x0e, 0x19, 0xc9, 0xa3, 0xbc, 0x9e, 0x5f, 0x01, 0x41,
-	0x2f, 0x73, 0xd0, 0x8b, 0xb0, 0x20, 0x82, 0x76, 0x22, 0xa5, 0x9e, 0x5e, 0xc1, 0x47, 0xfc, 0x33,
-	0x82, 0x7c, 0xa1, 0x48, 0x99, 0x40, 0x6e, 0x04, 0x51, 0xb2, 0x97, 0x06, 0xd0, 0x40, 0xd0, 0xd7,
-	0x38, 0xe8, 0x39, 0xb8, 0x92, 0x0b, 0x74, 0xb7, 0x44, 0x12, 0x79, 0x0b, 0x69, 0x89, 0xa4, 0x71,
-	0x2c, 0xd2, 0x12, 0x49, 0xa5, 0x46, 0xd2, 0x4a, 0xa4, 0x07, 0x78, 0x0f, 0x05, 0x12, 0x24, 0xfc,
-	0xc7, 0x04, 0xa9, 0x81, 0x38, 0xa7, 0x00, 0xb2, 0x46, 0x4b, 0x42, 0x74, 0x28, 0x5a, 0x6e, 0x79,
-	0x44, 0xbc, 0xc8, 0x11, 0xcf, 0xc2, 0x4c, 0x42, 0x51, 0x07, 0x0a, 0x9a, 0x59, 0xad, 0x6d, 0x07,
-	0x14, 0x06, 0x7c, 0x44, 0xe8, 0xd9, 0x7e, 0x5e, 0x00, 0xe6, 0x64, 0x89, 0x8a, 0xb1, 0x1c, 0xca,
-	0x7c, 0xb6, 0x20, 0x62, 0x9a, 0xe1, 0x98, 0x8a, 0x30, 0x99, 0x90, 0xc5, 0x88, 0x0d, 0x81, 0xdf,
-	0x44, 0x55, 0x2a, 0x70, 0x14, 0xf2, 0x2a, 0x95, 0x71, 0x21, 0xf2, 0x2a, 0x95, 0x12, 0x20, 0xea,
-	0x1a, 0x87, 0xf9, 0x3a, 0x94, 0xd3, 0x60, 0x8a, 0xfd, 0x60, 0xe5, 0xee, 0xc7, 0xcf, 0x8a, 0xe4,
-	0xe9, 0xb3, 0x22, 0xf9, 0xd7, 0xb3, 0x22, 0x79, 0xf2, 0xbc, 0x78, 0xe2, 0xe9, 0xf3, 0xe2, 0x89,
-	0xbf, 0x3f, 0x2f, 0x9e, 0xf8, 0x4a, 0xb9, 0x61, 0x7a, 0xbb, 0x9d, 0x6a, 0xb9, 0x66, 0xb7, 0xd0,
-	0x66, 0xd3, 0xa8, 0xba, 0xa1, 0xf9, 0xbd, 0x1e, 0x07, 0xde, 0x7e, 0x9b, 0xb9, 0xd5, 0x51, 0xce,
-	0x84, 0xac, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x65, 0x99, 0xd5, 0xfa, 0x83, 0x24, 0x00, 0x00,
+func (m *QueryRelayerRequest) Reset()         { *m = QueryRelayerRequest{} }
+func (m *QueryRelayerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerRequest) ProtoMessage()    {}
+func (*QueryRelayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{48}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryFeeRate queries the bitcoin network fee rate on the bitway chain.
-	QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error)
-	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
-	QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error)
-	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
-	QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error)
-	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
-	QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error)
-	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
-	QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error)
-	// QuerySigningRequest queries the signing request by sequence.
-	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
-	// QuerySigningRequests queries the signing requests by the given status.
-	QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
-	// QuerySigningRequestsByAddress queries the signing requests by the given address.
-	QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error)
-	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
-	QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error)
-	// QueryPendingSigningRequests queries the pending signing requests.
-	QueryPendingSigningRequests(ctx context.Context, in *QueryPendingSigningRequestsRequest, opts ...grpc.CallOption) (*QueryPendingSigningRequestsResponse, error)
-	// QueryUTXOs queries all utxos.
-	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
-	// QueryUTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
-	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
-	QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error)
-	// QueryDKGRequest queries the DKG request by the given id.
-	QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
-	// QueryDKGRequests queries the DKG requests by the given status
-	QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
-	// QueryAllDKGRequests queries all DKG requests.
-	QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error)
-	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
-	QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error)
-	// QueryRefreshingRequest queries the refreshing request by the given id.
-	QueryRefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error)
-	// QueryRefreshingRequests queries the refreshing requests by the given status.
-	QueryRefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error)
-	// QueryRefreshingCompletions queries refreshing completions by the given request id.
-	QueryRefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error)
-	// QueryIBCDepositScript queries the deposit OP_RETURN script for cross-chain via IBC.
-	QueryIBCDepositScript(ctx context.Context, in *QueryIBCDepositScriptRequest, opts ...grpc.CallOption) (*QueryIBCDepositScriptResponse, error)
-	// QueryRateLimit queries the current rate limit
-	QueryRateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
-	// QueryRateLimitByAddress queries the current rate limit by the given address
-	QueryRateLimitByAddress(ctx context.Context, in *QueryRateLimitByAddressRequest, opts ...grpc.CallOption) (*QueryRateLimitByAddressResponse, error)
+func (m *QueryRelayerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRelayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRelayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerRequest.Merge(m, src)
 }
-
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayerRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error) {
-	out := new(QueryFeeRateResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryFeeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error) {
-	out := new(QueryWithdrawalNetworkFeeResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawalNetworkFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayerRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error) {
-	out := new(QueryWithdrawRequestsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawRequestsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRelayerResponse is the response type for the Query/Relayer RPC method.
+type QueryRelayerResponse struct {
+	Relayer *Relayer `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// indicates if the relayer is active
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (c *queryClient) QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error) {
-	out := new(QueryWithdrawRequestsByTxHashResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawRequestsByTxHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayerResponse) Reset()         { *m = QueryRelayerResponse{} }
+func (m *QueryRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerResponse) ProtoMessage()    {}
+func (*QueryRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{49}
+}
+func (m *QueryRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerResponse.Merge(m, src)
+}
+func (m *QueryRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error) {
-	out := new(QueryPendingBtcWithdrawRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPendingBtcWithdrawRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayerResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerResponse) GetRelayer() *Relayer {
+	if m != nil {
+		return m.Relayer
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
-	out := new(QuerySigningRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayerResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
-	out := new(QuerySigningRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRelayersRequest is the request type for the Query/Relayers RPC method.
+type QueryRelayersRequest struct {
+	Status     RelayerStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=bitway.btcbridge.RelayerStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error) {
-	out := new(QuerySigningRequestsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequestsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersRequest) Reset()         { *m = QueryRelayersRequest{} }
+func (m *QueryRelayersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersRequest) ProtoMessage()    {}
+func (*QueryRelayersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{50}
+}
+func (m *QueryRelayersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRelayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersRequest.Merge(m, src)
+}
+func (m *QueryRelayersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error) {
-	out := new(QuerySigningRequestByTxHashResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequestByTxHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayersRequest proto.InternalMessageInfo
+
+func (m *QueryRelayersRequest) GetStatus() RelayerStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return RelayerStatus_RELAYER_STATUS_UNSPECIFIED
 }
 
-func (c *queryClient) QueryPendingSigningRequests(ctx context.Context, in *QueryPendingSigningRequestsRequest, opts ...grpc.CallOption) (*QueryPendingSigningRequestsResponse, error) {
-	out := new(QueryPendingSigningRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPendingSigningRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
-	out := new(QueryUTXOsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRelayersResponse is the response type for the Query/Relayers RPC method.
+type QueryRelayersResponse struct {
+	Relayers   []*Relayer          `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
-	out := new(QueryUTXOsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayersResponse) Reset()         { *m = QueryRelayersResponse{} }
+func (m *QueryRelayersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersResponse) ProtoMessage()    {}
+func (*QueryRelayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{51}
 }
-
-func (c *queryClient) QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
-	out := new(QueryUTXOCountAndBalancesByAddressResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOCountAndBalancesByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
-	out := new(QueryDKGRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
-	out := new(QueryDKGRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersResponse.Merge(m, src)
+}
+func (m *QueryRelayersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error) {
-	out := new(QueryAllDKGRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryAllDKGRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayersResponse proto.InternalMessageInfo
+
+func (m *QueryRelayersResponse) GetRelayers() []*Relayer {
+	if m != nil {
+		return m.Relayers
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error) {
-	out := new(QueryDKGCompletionRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGCompletionRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryRefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error) {
-	out := new(QueryRefreshingRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRunesAttestationRequest is the request type for the Query/RunesAttestation RPC method.
+type QueryRunesAttestationRequest struct {
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (c *queryClient) QueryRefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error) {
-	out := new(QueryRefreshingRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRunesAttestationRequest) Reset()         { *m = QueryRunesAttestationRequest{} }
+func (m *QueryRunesAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRunesAttestationRequest) ProtoMessage()    {}
+func (*QueryRunesAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{52}
+}
+func (m *QueryRunesAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunesAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunesAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRunesAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunesAttestationRequest.Merge(m, src)
+}
+func (m *QueryRunesAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunesAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunesAttestationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error) {
-	out := new(QueryRefreshingCompletionsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingCompletions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRunesAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryRunesAttestationRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryIBCDepositScript(ctx context.Context, in *QueryIBCDepositScriptRequest, opts ...grpc.CallOption) (*QueryIBCDepositScriptResponse, error) {
-	out := new(QueryIBCDepositScriptResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryIBCDepositScript", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRunesAttestationResponse is the response type for the Query/RunesAttestation RPC method.
+type QueryRunesAttestationResponse struct {
+	Attestation *RunesAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
-func (c *queryClient) QueryRateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
-	out := new(QueryRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRunesAttestationResponse) Reset()         { *m = QueryRunesAttestationResponse{} }
+func (m *QueryRunesAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRunesAttestationResponse) ProtoMessage()    {}
+func (*QueryRunesAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{53}
+}
+func (m *QueryRunesAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunesAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunesAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRunesAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunesAttestationResponse.Merge(m, src)
+}
+func (m *QueryRunesAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunesAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunesAttestationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRateLimitByAddress(ctx context.Context, in *QueryRateLimitByAddressRequest, opts ...grpc.CallOption) (*QueryRateLimitByAddressResponse, error) {
-	out := new(QueryRateLimitByAddressResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRateLimitByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRunesAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryRunesAttestationResponse) GetAttestation() *RunesAttestation {
+	if m != nil {
+		return m.Attestation
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryFeeRate queries the bitcoin network fee rate on the bitway chain.
-	QueryFeeRate(context.Context, *QueryFeeRateRequest) (*QueryFeeRateResponse, error)
-	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
-	QueryWithdrawalNetworkFee(context.Context, *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error)
-	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
-	QueryWithdrawRequestsByAddress(context.Context, *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error)
-	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
-	QueryWithdrawRequestsByTxHash(context.Context, *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error)
-	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
-	QueryPendingBtcWithdrawRequests(context.Context, *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error)
-	// QuerySigningRequest queries the signing request by sequence.
-	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
-	// QuerySigningRequests queries the signing requests by the given status.
-	QuerySigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
-	// QuerySigningRequestsByAddress queries the signing requests by the given address.
-	QuerySigningRequestsByAddress(context.Context, *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error)
-	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
-	QuerySigningRequestByTxHash(context.Context, *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error)
-	// QueryPendingSigningRequests queries the pending signing requests.
-	QueryPendingSigningRequests(context.Context, *QueryPendingSigningRequestsRequest) (*QueryPendingSigningRequestsResponse, error)
-	// QueryUTXOs queries all utxos.
-	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
-	// QueryUTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
-	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
-	QueryUTXOCountAndBalancesByAddress(context.Context, *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error)
-	// QueryDKGRequest queries the DKG request by the given id.
-	QueryDKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
-	// QueryDKGRequests queries the DKG requests by the given status
-	QueryDKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
-	// QueryAllDKGRequests queries all DKG requests.
-	QueryAllDKGRequests(context.Context, *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error)
-	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
-	QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error)
-	// QueryRefreshingRequest queries the refreshing request by the given id.
-	QueryRefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error)
-	// QueryRefreshingRequests queries the refreshing requests by the given status.
-	QueryRefreshingRequests(context.Context, *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error)
-	// QueryRefreshingCompletions queries refreshing completions by the given request id.
-	QueryRefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error)
-	// QueryIBCDepositScript queries the deposit OP_RETURN script for cross-chain via IBC.
-	QueryIBCDepositScript(context.Context, *QueryIBCDepositScriptRequest) (*QueryIBCDepositScriptResponse, error)
-	// QueryRateLimit queries the current rate limit
-	QueryRateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
-	// QueryRateLimitByAddress queries the current rate limit by the given address
-	QueryRateLimitByAddress(context.Context, *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error)
+// QueryPauseStateRequest is the request type for the Query/PauseState RPC method.
+type QueryPauseStateRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{54}
 }
-
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryFeeRate(ctx context.Context, req *QueryFeeRateRequest) (*QueryFeeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeRate not implemented")
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryWithdrawalNetworkFee(ctx context.Context, req *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawalNetworkFee not implemented")
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryWithdrawRequestsByAddress(ctx context.Context, req *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByAddress not implemented")
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryWithdrawRequestsByTxHash(ctx context.Context, req *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByTxHash not implemented")
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryPendingBtcWithdrawRequests(ctx context.Context, req *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingBtcWithdrawRequests not implemented")
+
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+// QueryPauseStateResponse is the response type for the Query/PauseState RPC method.
+type QueryPauseStateResponse struct {
+	Pauses []*Pause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
 }
-func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{55}
 }
-func (*UnimplementedQueryServer) QuerySigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequests not implemented")
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySigningRequestsByAddress(ctx context.Context, req *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestsByAddress not implemented")
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QuerySigningRequestByTxHash(ctx context.Context, req *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestByTxHash not implemented")
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryPendingSigningRequests(ctx context.Context, req *QueryPendingSigningRequestsRequest) (*QueryPendingSigningRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingSigningRequests not implemented")
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStateResponse) GetPauses() []*Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryUTXOCountAndBalancesByAddress(ctx context.Context, req *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOCountAndBalancesByAddress not implemented")
+
+// QueryPauseRequest is the request type for the Query/Pause RPC method.
+type QueryPauseRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryDKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequest not implemented")
+
+func (m *QueryPauseRequest) Reset()         { *m = QueryPauseRequest{} }
+func (m *QueryPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseRequest) ProtoMessage()    {}
+func (*QueryPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{56}
 }
-func (*UnimplementedQueryServer) QueryDKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequests not implemented")
+func (m *QueryPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryAllDKGRequests(ctx context.Context, req *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllDKGRequests not implemented")
+func (m *QueryPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryDKGCompletionRequests(ctx context.Context, req *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGCompletionRequests not implemented")
+func (m *QueryPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryRefreshingRequest(ctx context.Context, req *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingRequest not implemented")
+func (m *QueryPauseRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryRefreshingRequests(ctx context.Context, req *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingRequests not implemented")
+func (m *QueryPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryRefreshingCompletions(ctx context.Context, req *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingCompletions not implemented")
+
+var xxx_messageInfo_QueryPauseRequest proto.InternalMessageInfo
+
+func (m *QueryPauseRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) QueryIBCDepositScript(ctx context.Context, req *QueryIBCDepositScriptRequest) (*QueryIBCDepositScriptResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryIBCDepositScript not implemented")
+
+// QueryPauseResponse is the response type for the Query/Pause RPC method.
+type QueryPauseResponse struct {
+	Pause *Pause `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryRateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRateLimit not implemented")
+
+func (m *QueryPauseResponse) Reset()         { *m = QueryPauseResponse{} }
+func (m *QueryPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseResponse) ProtoMessage()    {}
+func (*QueryPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{57}
 }
-func (*UnimplementedQueryServer) QueryRateLimitByAddress(ctx context.Context, req *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRateLimitByAddress not implemented")
+func (m *QueryPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseResponse.Merge(m, src)
+}
+func (m *QueryPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPauseResponse proto.InternalMessageInfo
+
+func (m *QueryPauseResponse) GetPause() *Pause {
+	if m != nil {
+		return m.Pause
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryFeeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryFeeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFeeRate(ctx, req.(*QueryFeeRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPausesRequest is the request type for the Query/Pauses RPC method.
+type QueryPausesRequest struct {
+	Status     PauseStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=bitway.btcbridge.PauseStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueryWithdrawalNetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalNetworkFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawalNetworkFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, req.(*QueryWithdrawalNetworkFeeRequest))
+func (m *QueryPausesRequest) Reset()         { *m = QueryPausesRequest{} }
+func (m *QueryPausesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausesRequest) ProtoMessage()    {}
+func (*QueryPausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{58}
+}
+func (m *QueryPausesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausesRequest.Merge(m, src)
+}
+func (m *QueryPausesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryWithdrawRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawRequestsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawRequestsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, req.(*QueryWithdrawRequestsByAddressRequest))
+var xxx_messageInfo_QueryPausesRequest proto.InternalMessageInfo
+
+func (m *QueryPausesRequest) GetStatus() PauseStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return PauseStatus_PAUSE_STATUS_UNSPECIFIED
 }
 
-func _Query_QueryWithdrawRequestsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawRequestsByTxHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawRequestsByTxHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, req.(*QueryWithdrawRequestsByTxHashRequest))
+func (m *QueryPausesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryPendingBtcWithdrawRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingBtcWithdrawRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryPendingBtcWithdrawRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, req.(*QueryPendingBtcWithdrawRequestsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPausesResponse is the response type for the Query/Pauses RPC method.
+type QueryPausesResponse struct {
+	Pauses     []*Pause            `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
+func (m *QueryPausesResponse) Reset()         { *m = QueryPausesResponse{} }
+func (m *QueryPausesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausesResponse) ProtoMessage()    {}
+func (*QueryPausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{59}
+}
+func (m *QueryPausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausesResponse.Merge(m, src)
+}
+func (m *QueryPausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausesResponse.DiscardUnknown(m)
 }
 
-func _Query_QuerySigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequests(ctx, req.(*QuerySigningRequestsRequest))
+var xxx_messageInfo_QueryPausesResponse proto.InternalMessageInfo
+
+func (m *QueryPausesResponse) GetPauses() []*Pause {
+	if m != nil {
+		return m.Pauses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySigningRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequestsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, req.(*QuerySigningRequestsByAddressRequest))
+func (m *QueryPausesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySigningRequestByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestByTxHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequestByTxHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, req.(*QuerySigningRequestByTxHashRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryScreenedAddressRequest is the request type for the Query/ScreenedAddress RPC method.
+type QueryScreenedAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_QueryPendingSigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingSigningRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPendingSigningRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryPendingSigningRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPendingSigningRequests(ctx, req.(*QueryPendingSigningRequestsRequest))
+func (m *QueryScreenedAddressRequest) Reset()         { *m = QueryScreenedAddressRequest{} }
+func (m *QueryScreenedAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedAddressRequest) ProtoMessage()    {}
+func (*QueryScreenedAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{60}
+}
+func (m *QueryScreenedAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryScreenedAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedAddressRequest.Merge(m, src)
+}
+func (m *QueryScreenedAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryUTXOs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
+var xxx_messageInfo_QueryScreenedAddressRequest proto.InternalMessageInfo
+
+func (m *QueryScreenedAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryUTXOsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryScreenedAddressResponse is the response type for the Query/ScreenedAddress RPC method.
+type QueryScreenedAddressResponse struct {
+	Screened        bool             `protobuf:"varint,1,opt,name=screened,proto3" json:"screened,omitempty"`
+	ScreenedAddress *ScreenedAddress `protobuf:"bytes,2,opt,name=screened_address,json=screenedAddress,proto3" json:"screened_address,omitempty"`
 }
 
-func _Query_QueryUTXOCountAndBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOCountAndBalancesByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryUTXOCountAndBalancesByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, req.(*QueryUTXOCountAndBalancesByAddressRequest))
+func (m *QueryScreenedAddressResponse) Reset()         { *m = QueryScreenedAddressResponse{} }
+func (m *QueryScreenedAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedAddressResponse) ProtoMessage()    {}
+func (*QueryScreenedAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{61}
+}
+func (m *QueryScreenedAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryScreenedAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedAddressResponse.Merge(m, src)
+}
+func (m *QueryScreenedAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedAddressResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryDKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryDKGRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGRequest(ctx, req.(*QueryDKGRequestRequest))
+var xxx_messageInfo_QueryScreenedAddressResponse proto.InternalMessageInfo
+
+func (m *QueryScreenedAddressResponse) GetScreened() bool {
+	if m != nil {
+		return m.Screened
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_QueryDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryDKGRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGRequests(ctx, req.(*QueryDKGRequestsRequest))
+func (m *QueryScreenedAddressResponse) GetScreenedAddress() *ScreenedAddress {
+	if m != nil {
+		return m.ScreenedAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryAllDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDKGRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllDKGRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryAllDKGRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllDKGRequests(ctx, req.(*QueryAllDKGRequestsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryScreenedAddressesRequest is the request type for the Query/ScreenedAddresses RPC method.
+type QueryScreenedAddressesRequest struct {
+	Source     ScreeningSource    `protobuf:"varint,1,opt,name=source,proto3,enum=bitway.btcbridge.ScreeningSource" json:"source,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueryDKGCompletionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGCompletionRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryDKGCompletionRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, req.(*QueryDKGCompletionRequestsRequest))
+func (m *QueryScreenedAddressesRequest) Reset()         { *m = QueryScreenedAddressesRequest{} }
+func (m *QueryScreenedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedAddressesRequest) ProtoMessage()    {}
+func (*QueryScreenedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{62}
+}
+func (m *QueryScreenedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryScreenedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedAddressesRequest.Merge(m, src)
+}
+func (m *QueryScreenedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedAddressesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryRefreshingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRefreshingRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRefreshingRequest(ctx, req.(*QueryRefreshingRequestRequest))
+var xxx_messageInfo_QueryScreenedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryScreenedAddressesRequest) GetSource() ScreeningSource {
+	if m != nil {
+		return m.Source
 	}
-	return interceptor(ctx, in, info, handler)
+	return ScreeningSource_SCREENING_SOURCE_UNSPECIFIED
 }
 
-func _Query_QueryRefreshingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRefreshingRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRefreshingRequests(ctx, req.(*QueryRefreshingRequestsRequest))
+func (m *QueryScreenedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryRefreshingCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingCompletionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRefreshingCompletions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingCompletions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRefreshingCompletions(ctx, req.(*QueryRefreshingCompletionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryScreenedAddressesResponse is the response type for the Query/ScreenedAddresses RPC method.
+type QueryScreenedAddressesResponse struct {
+	ScreenedAddresses []*ScreenedAddress  `protobuf:"bytes,1,rep,name=screened_addresses,json=screenedAddresses,proto3" json:"screened_addresses,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueryIBCDepositScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCDepositScriptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryIBCDepositScript(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryIBCDepositScript",
+func (m *QueryScreenedAddressesResponse) Reset()         { *m = QueryScreenedAddressesResponse{} }
+func (m *QueryScreenedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedAddressesResponse) ProtoMessage()    {}
+func (*QueryScreenedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{63}
+}
+func (m *QueryScreenedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryIBCDepositScript(ctx, req.(*QueryIBCDepositScriptRequest))
+}
+func (m *QueryScreenedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedAddressesResponse.Merge(m, src)
+}
+func (m *QueryScreenedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScreenedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryScreenedAddressesResponse) GetScreenedAddresses() []*ScreenedAddress {
+	if m != nil {
+		return m.ScreenedAddresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRateLimitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRateLimit(ctx, req.(*QueryRateLimitRequest))
+func (m *QueryScreenedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryRateLimitByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRateLimitByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRateLimitByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Query/QueryRateLimitByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRateLimitByAddress(ctx, req.(*QueryRateLimitByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryQuarantinedDepositsRequest is the request type for the Query/QuarantinedDeposits RPC method.
+type QueryQuarantinedDepositsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitway.btcbridge.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryFeeRate",
-			Handler:    _Query_QueryFeeRate_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawalNetworkFee",
-			Handler:    _Query_QueryWithdrawalNetworkFee_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawRequestsByAddress",
-			Handler:    _Query_QueryWithdrawRequestsByAddress_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawRequestsByTxHash",
-			Handler:    _Query_QueryWithdrawRequestsByTxHash_Handler,
-		},
-		{
-			MethodName: "QueryPendingBtcWithdrawRequests",
-			Handler:    _Query_QueryPendingBtcWithdrawRequests_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequest",
-			Handler:    _Query_QuerySigningRequest_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequests",
-			Handler:    _Query_QuerySigningRequests_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequestsByAddress",
-			Handler:    _Query_QuerySigningRequestsByAddress_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequestByTxHash",
-			Handler:    _Query_QuerySigningRequestByTxHash_Handler,
-		},
-		{
-			MethodName: "QueryPendingSigningRequests",
-			Handler:    _Query_QueryPendingSigningRequests_Handler,
-		},
-		{
-			MethodName: "QueryUTXOs",
-			Handler:    _Query_QueryUTXOs_Handler,
-		},
-		{
-			MethodName: "QueryUTXOsByAddress",
-			Handler:    _Query_QueryUTXOsByAddress_Handler,
-		},
-		{
-			MethodName: "QueryUTXOCountAndBalancesByAddress",
-			Handler:    _Query_QueryUTXOCountAndBalancesByAddress_Handler,
-		},
-		{
-			MethodName: "QueryDKGRequest",
-			Handler:    _Query_QueryDKGRequest_Handler,
-		},
-		{
-			MethodName: "QueryDKGRequests",
-			Handler:    _Query_QueryDKGRequests_Handler,
-		},
-		{
-			MethodName: "QueryAllDKGRequests",
-			Handler:    _Query_QueryAllDKGRequests_Handler,
-		},
-		{
-			MethodName: "QueryDKGCompletionRequests",
-			Handler:    _Query_QueryDKGCompletionRequests_Handler,
-		},
-		{
-			MethodName: "QueryRefreshingRequest",
-			Handler:    _Query_QueryRefreshingRequest_Handler,
-		},
-		{
-			MethodName: "QueryRefreshingRequests",
-			Handler:    _Query_QueryRefreshingRequests_Handler,
-		},
-		{
-			MethodName: "QueryRefreshingCompletions",
-			Handler:    _Query_QueryRefreshingCompletions_Handler,
-		},
-		{
-			MethodName: "QueryIBCDepositScript",
-			Handler:    _Query_QueryIBCDepositScript_Handler,
-		},
-		{
-			MethodName: "QueryRateLimit",
-			Handler:    _Query_QueryRateLimit_Handler,
-		},
-		{
-			MethodName: "QueryRateLimitByAddress",
-			Handler:    _Query_QueryRateLimitByAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitway/btcbridge/query.proto",
+func (m *QueryQuarantinedDepositsRequest) Reset()         { *m = QueryQuarantinedDepositsRequest{} }
+func (m *QueryQuarantinedDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedDepositsRequest) ProtoMessage()    {}
+func (*QueryQuarantinedDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{64}
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedDepositsRequest.Merge(m, src)
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedDepositsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuarantinedDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryQuarantinedDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuarantinedDepositsResponse is the response type for the Query/QuarantinedDeposits RPC method.
+type QueryQuarantinedDepositsResponse struct {
+	Deposits   []*QuarantinedDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryQuarantinedDepositsResponse) Reset()         { *m = QueryQuarantinedDepositsResponse{} }
+func (m *QueryQuarantinedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedDepositsResponse) ProtoMessage()    {}
+func (*QueryQuarantinedDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{65}
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedDepositsResponse.Merge(m, src)
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuarantinedDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryQuarantinedDepositsResponse) GetDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.Deposits
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuarantinedDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuarantinedWithdrawalsRequest is the request type for the Query/QuarantinedWithdrawals RPC method.
+type QueryQuarantinedWithdrawalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryQuarantinedWithdrawalsRequest) Reset()         { *m = QueryQuarantinedWithdrawalsRequest{} }
+func (m *QueryQuarantinedWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryQuarantinedWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{66}
+}
+func (m *QueryQuarantinedWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQuarantinedWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryQuarantinedWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedWithdrawalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuarantinedWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryQuarantinedWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuarantinedWithdrawalsResponse is the response type for the Query/QuarantinedWithdrawals RPC method.
+type QueryQuarantinedWithdrawalsResponse struct {
+	Withdrawals []*QuarantinedWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Pagination  *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryQuarantinedWithdrawalsResponse) Reset()         { *m = QueryQuarantinedWithdrawalsResponse{} }
+func (m *QueryQuarantinedWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryQuarantinedWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{67}
 }
-
-func (m *QueryWithdrawRequestsByTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuarantinedWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryWithdrawRequestsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQuarantinedWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryQuarantinedWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryQuarantinedWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryQuarantinedWithdrawalsResponse) GetWithdrawals() []*QuarantinedWithdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuarantinedWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositConfirmationDepthRequest is the request type for the Query/DepositConfirmationDepth RPC method.
+type QueryDepositConfirmationDepthRequest struct {
+	// deposit amount, e.g. 100000sat or 1000runes/840000:1
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositConfirmationDepthRequest) Reset()         { *m = QueryDepositConfirmationDepthRequest{} }
+func (m *QueryDepositConfirmationDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositConfirmationDepthRequest) ProtoMessage()    {}
+func (*QueryDepositConfirmationDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{68}
+}
+func (m *QueryDepositConfirmationDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositConfirmationDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositConfirmationDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositConfirmationDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositConfirmationDepthRequest.Merge(m, src)
+}
+func (m *QueryDepositConfirmationDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositConfirmationDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositConfirmationDepthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositConfirmationDepthRequest proto.InternalMessageInfo
+
+func (m *QueryDepositConfirmationDepthRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositConfirmationDepthResponse is the response type for the Query/DepositConfirmationDepth RPC method.
+type QueryDepositConfirmationDepthResponse struct {
+	ConfirmationDepth int32 `protobuf:"varint,1,opt,name=confirmation_depth,json=confirmationDepth,proto3" json:"confirmation_depth,omitempty"`
+	// BTC equivalent amount in satoshi; 0 if the price is not available
+	BtcAmount int64 `protobuf:"varint,2,opt,name=btc_amount,json=btcAmount,proto3" json:"btc_amount,omitempty"`
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositConfirmationDepthResponse) Reset()         { *m = QueryDepositConfirmationDepthResponse{} }
+func (m *QueryDepositConfirmationDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositConfirmationDepthResponse) ProtoMessage()    {}
+func (*QueryDepositConfirmationDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{69}
+}
+func (m *QueryDepositConfirmationDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositConfirmationDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositConfirmationDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositConfirmationDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositConfirmationDepthResponse.Merge(m, src)
 }
-
-func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositConfirmationDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositConfirmationDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositConfirmationDepthResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryDepositConfirmationDepthResponse proto.InternalMessageInfo
+
+func (m *QueryDepositConfirmationDepthResponse) GetConfirmationDepth() int32 {
+	if m != nil {
+		return m.ConfirmationDepth
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositConfirmationDepthResponse) GetBtcAmount() int64 {
+	if m != nil {
+		return m.BtcAmount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordRequest is the request type for the Query/DepositRecord RPC method.
+type QueryDepositRecordRequest struct {
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositRecordRequest) Reset()         { *m = QueryDepositRecordRequest{} }
+func (m *QueryDepositRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordRequest) ProtoMessage()    {}
+func (*QueryDepositRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{70}
+}
+func (m *QueryDepositRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordRequest.Merge(m, src)
+}
+func (m *QueryDepositRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositRecordRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRecordRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordResponse is the response type for the Query/DepositRecord RPC method.
+type QueryDepositRecordResponse struct {
+	Record *DepositRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
 }
 
-func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositRecordResponse) Reset()         { *m = QueryDepositRecordResponse{} }
+func (m *QueryDepositRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordResponse) ProtoMessage()    {}
+func (*QueryDepositRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{71}
+}
+func (m *QueryDepositRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordResponse.Merge(m, src)
+}
+func (m *QueryDepositRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositRecordResponse proto.InternalMessageInfo
+
+func (m *QueryDepositRecordResponse) GetRecord() *DepositRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordsRequest is the request type for the Query/DepositRecords RPC method.
+type QueryDepositRecordsRequest struct {
+	// optional asset type filter
+	Asset      AssetType          `protobuf:"varint,1,opt,name=asset,proto3,enum=bitway.btcbridge.AssetType" json:"asset,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositRecordsRequest) Reset()         { *m = QueryDepositRecordsRequest{} }
+func (m *QueryDepositRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsRequest) ProtoMessage()    {}
+func (*QueryDepositRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{72}
+}
+func (m *QueryDepositRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDepositRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsRequest.Merge(m, src)
+}
+func (m *QueryDepositRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsRequest) GetAsset() AssetType {
+	if m != nil {
+		return m.Asset
 	}
-	return len(dAtA) - i, nil
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *QuerySigningRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordsResponse is the response type for the Query/DepositRecords RPC method.
+type QueryDepositRecordsResponse struct {
+	Records    []*DepositRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositRecordsResponse) Reset()         { *m = QueryDepositRecordsResponse{} }
+func (m *QueryDepositRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsResponse) ProtoMessage()    {}
+func (*QueryDepositRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{73}
+}
+func (m *QueryDepositRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryDepositRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsResponse.Merge(m, src)
+}
+func (m *QueryDepositRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsResponse) GetRecords() []*DepositRecord {
+	if m != nil {
+		return m.Records
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordsByRecipientRequest is the request type for the Query/DepositRecordsByRecipient RPC method.
+type QueryDepositRecordsByRecipientRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositRecordsByRecipientRequest) Reset()         { *m = QueryDepositRecordsByRecipientRequest{} }
+func (m *QueryDepositRecordsByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsByRecipientRequest) ProtoMessage()    {}
+func (*QueryDepositRecordsByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{74}
+}
+func (m *QueryDepositRecordsByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositRecordsByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsByRecipientRequest.Merge(m, src)
+}
+func (m *QueryDepositRecordsByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsByRecipientRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestByTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositRecordsByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsByRecipientRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySigningRequestByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositRecordsByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySigningRequestByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryDepositRecordsByRecipientResponse is the response type for the Query/DepositRecordsByRecipient RPC method.
+type QueryDepositRecordsByRecipientResponse struct {
+	Records    []*DepositRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestByTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositRecordsByRecipientResponse) Reset() {
+	*m = QueryDepositRecordsByRecipientResponse{}
+}
+func (m *QueryDepositRecordsByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsByRecipientResponse) ProtoMessage()    {}
+func (*QueryDepositRecordsByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{75}
+}
+func (m *QueryDepositRecordsByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySigningRequestByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositRecordsByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsByRecipientResponse.Merge(m, src)
+}
+func (m *QueryDepositRecordsByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsByRecipientResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDepositRecordsByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsByRecipientResponse) GetRecords() []*DepositRecord {
+	if m != nil {
+		return m.Records
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPendingSigningRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositRecordsByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingSigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositRecordsBySenderRequest is the request type for the Query/DepositRecordsBySender RPC method.
+type QueryDepositRecordsBySenderRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPendingSigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositRecordsBySenderRequest) Reset()         { *m = QueryDepositRecordsBySenderRequest{} }
+func (m *QueryDepositRecordsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsBySenderRequest) ProtoMessage()    {}
+func (*QueryDepositRecordsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{76}
+}
+func (m *QueryDepositRecordsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositRecordsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsBySenderRequest.Merge(m, src)
+}
+func (m *QueryDepositRecordsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsBySenderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingSigningRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositRecordsBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsBySenderRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPendingSigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositRecordsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPendingSigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryDepositRecordsBySenderResponse is the response type for the Query/DepositRecordsBySender RPC method.
+type QueryDepositRecordsBySenderResponse struct {
+	Records    []*DepositRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositRecordsBySenderResponse) Reset()         { *m = QueryDepositRecordsBySenderResponse{} }
+func (m *QueryDepositRecordsBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRecordsBySenderResponse) ProtoMessage()    {}
+func (*QueryDepositRecordsBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{77}
+}
+func (m *QueryDepositRecordsBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRecordsBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRecordsBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDepositRecordsBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRecordsBySenderResponse.Merge(m, src)
+}
+func (m *QueryDepositRecordsBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRecordsBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRecordsBySenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositRecordsBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryDepositRecordsBySenderResponse) GetRecords() []*DepositRecord {
+	if m != nil {
+		return m.Records
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFeeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositRecordsBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRuneEtchingRequest is the request type for the Query/RuneEtching RPC method.
+type QueryRuneEtchingRequest struct {
+	// rune id, e.g. 840000:3
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRuneEtchingRequest) Reset()         { *m = QueryRuneEtchingRequest{} }
+func (m *QueryRuneEtchingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneEtchingRequest) ProtoMessage()    {}
+func (*QueryRuneEtchingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{78}
+}
+func (m *QueryRuneEtchingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneEtchingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneEtchingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRuneEtchingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneEtchingRequest.Merge(m, src)
+}
+func (m *QueryRuneEtchingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneEtchingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneEtchingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneEtchingRequest proto.InternalMessageInfo
+
+func (m *QueryRuneEtchingRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRuneEtchingResponse is the response type for the Query/RuneEtching RPC method.
+type QueryRuneEtchingResponse struct {
+	Etching *RuneEtching `protobuf:"bytes,1,opt,name=etching,proto3" json:"etching,omitempty"`
 }
 
-func (m *QueryFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != nil {
-		{
-			size, err := m.FeeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRuneEtchingResponse) Reset()         { *m = QueryRuneEtchingResponse{} }
+func (m *QueryRuneEtchingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneEtchingResponse) ProtoMessage()    {}
+func (*QueryRuneEtchingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{79}
+}
+func (m *QueryRuneEtchingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneEtchingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneEtchingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRuneEtchingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneEtchingResponse.Merge(m, src)
+}
+func (m *QueryRuneEtchingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneEtchingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneEtchingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneEtchingResponse proto.InternalMessageInfo
+
+func (m *QueryRuneEtchingResponse) GetEtching() *RuneEtching {
+	if m != nil {
+		return m.Etching
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeSponsorshipUsageRequest is the request type for the Query/FeeSponsorshipUsage RPC method.
+type QueryFeeSponsorshipUsageRequest struct {
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeeSponsorshipUsageRequest) Reset()         { *m = QueryFeeSponsorshipUsageRequest{} }
+func (m *QueryFeeSponsorshipUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipUsageRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{80}
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipUsageRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorshipUsageRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipUsageRequest) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeSponsorshipUsageResponse is the response type for the Query/FeeSponsorshipUsage RPC method.
+type QueryFeeSponsorshipUsageResponse struct {
+	Policy *FeeSponsorshipPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Usage  *FeeSponsorshipUsage  `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	// remaining budget in the current epoch; not applicable if the epoch budget is unlimited
+	RemainingBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining_budget,json=remainingBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_budget"`
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.FeeRate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeeSponsorshipUsageResponse) Reset()         { *m = QueryFeeSponsorshipUsageResponse{} }
+func (m *QueryFeeSponsorshipUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipUsageResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{81}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipUsageResponse.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipUsageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeSponsorshipUsageResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipUsageResponse) GetPolicy() *FeeSponsorshipPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeeSponsorshipUsageResponse) GetUsage() *FeeSponsorshipUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipUsageResponse) GetRemainingBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingBudget
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeSponsorshipAccountUsageRequest is the request type for the Query/FeeSponsorshipAccountUsage RPC method.
+type QueryFeeSponsorshipAccountUsageRequest struct {
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeeSponsorshipAccountUsageRequest) Reset() {
+	*m = QueryFeeSponsorshipAccountUsageRequest{}
+}
+func (m *QueryFeeSponsorshipAccountUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipAccountUsageRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipAccountUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{82}
+}
+func (m *QueryFeeSponsorshipAccountUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipAccountUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipAccountUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeeSponsorshipAccountUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipAccountUsageRequest.Merge(m, src)
 }
-
-func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeSponsorshipAccountUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipAccountUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipAccountUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryFeeSponsorshipAccountUsageRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipAccountUsageRequest) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
 }
 
-func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipAccountUsageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeSponsorshipAccountUsageResponse is the response type for the Query/FeeSponsorshipAccountUsage RPC method.
+type QueryFeeSponsorshipAccountUsageResponse struct {
+	Usage *FeeSponsorshipAccountUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// remaining number of the sponsored txs in the current epoch; not applicable if the account quota is unlimited
+	RemainingQuota uint32 `protobuf:"varint,2,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"`
 }
 
-func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeeSponsorshipAccountUsageResponse) Reset() {
+	*m = QueryFeeSponsorshipAccountUsageResponse{}
+}
+func (m *QueryFeeSponsorshipAccountUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipAccountUsageResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipAccountUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{83}
+}
+func (m *QueryFeeSponsorshipAccountUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipAccountUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipAccountUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeeSponsorshipAccountUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipAccountUsageResponse.Merge(m, src)
 }
-
-func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeSponsorshipAccountUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipAccountUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipAccountUsageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFeeSponsorshipAccountUsageResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipAccountUsageResponse) GetUsage() *FeeSponsorshipAccountUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipAccountUsageResponse) GetRemainingQuota() uint32 {
+	if m != nil {
+		return m.RemainingQuota
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultRecoveriesRequest is the request type for the Query/VaultRecoveries RPC method.
+type QueryVaultRecoveriesRequest struct {
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVaultRecoveriesRequest) Reset()         { *m = QueryVaultRecoveriesRequest{} }
+func (m *QueryVaultRecoveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRecoveriesRequest) ProtoMessage()    {}
+func (*QueryVaultRecoveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{84}
+}
+func (m *QueryVaultRecoveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRecoveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRecoveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVaultRecoveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRecoveriesRequest.Merge(m, src)
+}
+func (m *QueryVaultRecoveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRecoveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRecoveriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVaultRecoveriesRequest proto.InternalMessageInfo
+
+// QueryVaultRecoveriesResponse is the response type for the Query/VaultRecoveries RPC method.
+type QueryVaultRecoveriesResponse struct {
+	Recoveries []VaultRecoveryInfo `protobuf:"bytes,1,rep,name=recoveries,proto3" json:"recoveries"`
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVaultRecoveriesResponse) Reset()         { *m = QueryVaultRecoveriesResponse{} }
+func (m *QueryVaultRecoveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRecoveriesResponse) ProtoMessage()    {}
+func (*QueryVaultRecoveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{85}
+}
+func (m *QueryVaultRecoveriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRecoveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRecoveriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultRecoveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRecoveriesResponse.Merge(m, src)
+}
+func (m *QueryVaultRecoveriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRecoveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRecoveriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultRecoveriesResponse proto.InternalMessageInfo
+
+func (m *QueryVaultRecoveriesResponse) GetRecoveries() []VaultRecoveryInfo {
+	if m != nil {
+		return m.Recoveries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VaultRecoveryInfo defines the emergency recovery info of the vault
+type VaultRecoveryInfo struct {
+	// vault address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// asset type of the vault
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// vault version
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// recovery params committed by the vault; empty if the vault is key path only
+	Recovery *VaultRecovery `protobuf:"bytes,4,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	// hex encoded recovery tapscript
+	Script string `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`
+	// hex encoded control block of the recovery tapscript
+	ControlBlock string `protobuf:"bytes,6,opt,name=control_block,json=controlBlock,proto3" json:"control_block,omitempty"`
+}
+
+func (m *VaultRecoveryInfo) Reset()         { *m = VaultRecoveryInfo{} }
+func (m *VaultRecoveryInfo) String() string { return proto.CompactTextString(m) }
+func (*VaultRecoveryInfo) ProtoMessage()    {}
+func (*VaultRecoveryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{86}
+}
+func (m *VaultRecoveryInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RuneBalances) > 0 {
-		for iNdEx := len(m.RuneBalances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RuneBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *VaultRecoveryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRecoveryInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Value != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *VaultRecoveryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRecoveryInfo.Merge(m, src)
+}
+func (m *VaultRecoveryInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRecoveryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRecoveryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRecoveryInfo proto.InternalMessageInfo
+
+func (m *VaultRecoveryInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *VaultRecoveryInfo) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	return len(dAtA) - i, nil
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultRecoveryInfo) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultRecoveryInfo) GetRecovery() *VaultRecovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return nil
 }
 
-func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *VaultRecoveryInfo) GetScript() string {
+	if m != nil {
+		return m.Script
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultRecoveryInfo) GetControlBlock() string {
+	if m != nil {
+		return m.ControlBlock
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultRecoveryPsbtRequest is the request type for the Query/VaultRecoveryPsbt RPC method.
+type QueryVaultRecoveryPsbtRequest struct {
+	// vault address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// recipient of the recovered assets
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fee rate in sat/vbyte
+	FeeRate int64 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultRecoveryPsbtRequest) Reset()         { *m = QueryVaultRecoveryPsbtRequest{} }
+func (m *QueryVaultRecoveryPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRecoveryPsbtRequest) ProtoMessage()    {}
+func (*QueryVaultRecoveryPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{87}
+}
+func (m *QueryVaultRecoveryPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRecoveryPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRecoveryPsbtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultRecoveryPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRecoveryPsbtRequest.Merge(m, src)
+}
+func (m *QueryVaultRecoveryPsbtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRecoveryPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRecoveryPsbtRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultRecoveryPsbtRequest proto.InternalMessageInfo
+
+func (m *QueryVaultRecoveryPsbtRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultRecoveryPsbtRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryVaultRecoveryPsbtRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryVaultRecoveryPsbtResponse is the response type for the Query/VaultRecoveryPsbt RPC method.
+type QueryVaultRecoveryPsbtResponse struct {
+	// base64 encoded psbt
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// number of the vault utxos spent by the psbt
+	UtxoNum uint32 `protobuf:"varint,2,opt,name=utxo_num,json=utxoNum,proto3" json:"utxo_num,omitempty"`
+}
+
+func (m *QueryVaultRecoveryPsbtResponse) Reset()         { *m = QueryVaultRecoveryPsbtResponse{} }
+func (m *QueryVaultRecoveryPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRecoveryPsbtResponse) ProtoMessage()    {}
+func (*QueryVaultRecoveryPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2db215f5737906e, []int{88}
+}
+func (m *QueryVaultRecoveryPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRecoveryPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRecoveryPsbtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVaultRecoveryPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRecoveryPsbtResponse.Merge(m, src)
+}
+func (m *QueryVaultRecoveryPsbtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRecoveryPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRecoveryPsbtResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVaultRecoveryPsbtResponse proto.InternalMessageInfo
+
+func (m *QueryVaultRecoveryPsbtResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
 }
 
-func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryVaultRecoveryPsbtResponse) GetUtxoNum() uint32 {
+	if m != nil {
+		return m.UtxoNum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryWithdrawRequestsByAddressRequest)(nil), "bitway.btcbridge.QueryWithdrawRequestsByAddressRequest")
+	proto.RegisterType((*QueryWithdrawRequestsByAddressResponse)(nil), "bitway.btcbridge.QueryWithdrawRequestsByAddressResponse")
+	proto.RegisterType((*QueryWithdrawRequestsByTxHashRequest)(nil), "bitway.btcbridge.QueryWithdrawRequestsByTxHashRequest")
+	proto.RegisterType((*QueryWithdrawRequestsByTxHashResponse)(nil), "bitway.btcbridge.QueryWithdrawRequestsByTxHashResponse")
+	proto.RegisterType((*QueryPendingBtcWithdrawRequestsRequest)(nil), "bitway.btcbridge.QueryPendingBtcWithdrawRequestsRequest")
+	proto.RegisterType((*QueryPendingBtcWithdrawRequestsResponse)(nil), "bitway.btcbridge.QueryPendingBtcWithdrawRequestsResponse")
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "bitway.btcbridge.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "bitway.btcbridge.QuerySigningRequestResponse")
+	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "bitway.btcbridge.QuerySigningRequestsRequest")
+	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "bitway.btcbridge.QuerySigningRequestsResponse")
+	proto.RegisterType((*QuerySigningRequestsByAddressRequest)(nil), "bitway.btcbridge.QuerySigningRequestsByAddressRequest")
+	proto.RegisterType((*QuerySigningRequestsByAddressResponse)(nil), "bitway.btcbridge.QuerySigningRequestsByAddressResponse")
+	proto.RegisterType((*QuerySigningRequestByTxHashRequest)(nil), "bitway.btcbridge.QuerySigningRequestByTxHashRequest")
+	proto.RegisterType((*QuerySigningRequestByTxHashResponse)(nil), "bitway.btcbridge.QuerySigningRequestByTxHashResponse")
+	proto.RegisterType((*QueryPendingSigningRequestsRequest)(nil), "bitway.btcbridge.QueryPendingSigningRequestsRequest")
+	proto.RegisterType((*QueryPendingSigningRequestsResponse)(nil), "bitway.btcbridge.QueryPendingSigningRequestsResponse")
+	proto.RegisterType((*QueryFeeRateRequest)(nil), "bitway.btcbridge.QueryFeeRateRequest")
+	proto.RegisterType((*QueryFeeRateResponse)(nil), "bitway.btcbridge.QueryFeeRateResponse")
+	proto.RegisterType((*QueryWithdrawalNetworkFeeRequest)(nil), "bitway.btcbridge.QueryWithdrawalNetworkFeeRequest")
+	proto.RegisterType((*QueryWithdrawalNetworkFeeResponse)(nil), "bitway.btcbridge.QueryWithdrawalNetworkFeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "bitway.btcbridge.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "bitway.btcbridge.QueryParamsResponse")
+	proto.RegisterType((*QueryUTXOsRequest)(nil), "bitway.btcbridge.QueryUTXOsRequest")
+	proto.RegisterType((*QueryUTXOsResponse)(nil), "bitway.btcbridge.QueryUTXOsResponse")
+	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "bitway.btcbridge.QueryUTXOsByAddressRequest")
+	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "bitway.btcbridge.QueryUTXOsByAddressResponse")
+	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressRequest)(nil), "bitway.btcbridge.QueryUTXOCountAndBalancesByAddressRequest")
+	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressResponse)(nil), "bitway.btcbridge.QueryUTXOCountAndBalancesByAddressResponse")
+	proto.RegisterType((*QueryDKGRequestRequest)(nil), "bitway.btcbridge.QueryDKGRequestRequest")
+	proto.RegisterType((*QueryDKGRequestResponse)(nil), "bitway.btcbridge.QueryDKGRequestResponse")
+	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "bitway.btcbridge.QueryDKGRequestsRequest")
+	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "bitway.btcbridge.QueryDKGRequestsResponse")
+	proto.RegisterType((*QueryAllDKGRequestsRequest)(nil), "bitway.btcbridge.QueryAllDKGRequestsRequest")
+	proto.RegisterType((*QueryAllDKGRequestsResponse)(nil), "bitway.btcbridge.QueryAllDKGRequestsResponse")
+	proto.RegisterType((*QueryDKGCompletionRequestsRequest)(nil), "bitway.btcbridge.QueryDKGCompletionRequestsRequest")
+	proto.RegisterType((*QueryDKGCompletionRequestsResponse)(nil), "bitway.btcbridge.QueryDKGCompletionRequestsResponse")
+	proto.RegisterType((*QueryRefreshingRequestRequest)(nil), "bitway.btcbridge.QueryRefreshingRequestRequest")
+	proto.RegisterType((*QueryRefreshingRequestResponse)(nil), "bitway.btcbridge.QueryRefreshingRequestResponse")
+	proto.RegisterType((*QueryRefreshingRequestsRequest)(nil), "bitway.btcbridge.QueryRefreshingRequestsRequest")
+	proto.RegisterType((*QueryRefreshingRequestsResponse)(nil), "bitway.btcbridge.QueryRefreshingRequestsResponse")
+	proto.RegisterType((*QueryRefreshingCompletionsRequest)(nil), "bitway.btcbridge.QueryRefreshingCompletionsRequest")
+	proto.RegisterType((*QueryRefreshingCompletionsResponse)(nil), "bitway.btcbridge.QueryRefreshingCompletionsResponse")
+	proto.RegisterType((*QueryIBCDepositScriptRequest)(nil), "bitway.btcbridge.QueryIBCDepositScriptRequest")
+	proto.RegisterType((*QueryIBCDepositScriptResponse)(nil), "bitway.btcbridge.QueryIBCDepositScriptResponse")
+	proto.RegisterType((*QueryRateLimitRequest)(nil), "bitway.btcbridge.QueryRateLimitRequest")
+	proto.RegisterType((*QueryRateLimitResponse)(nil), "bitway.btcbridge.QueryRateLimitResponse")
+	proto.RegisterType((*QueryRateLimitByAddressRequest)(nil), "bitway.btcbridge.QueryRateLimitByAddressRequest")
+	proto.RegisterType((*QueryRateLimitByAddressResponse)(nil), "bitway.btcbridge.QueryRateLimitByAddressResponse")
+	proto.RegisterType((*QueryRelayerRequest)(nil), "bitway.btcbridge.QueryRelayerRequest")
+	proto.RegisterType((*QueryRelayerResponse)(nil), "bitway.btcbridge.QueryRelayerResponse")
+	proto.RegisterType((*QueryRelayersRequest)(nil), "bitway.btcbridge.QueryRelayersRequest")
+	proto.RegisterType((*QueryRelayersResponse)(nil), "bitway.btcbridge.QueryRelayersResponse")
+	proto.RegisterType((*QueryRunesAttestationRequest)(nil), "bitway.btcbridge.QueryRunesAttestationRequest")
+	proto.RegisterType((*QueryRunesAttestationResponse)(nil), "bitway.btcbridge.QueryRunesAttestationResponse")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "bitway.btcbridge.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "bitway.btcbridge.QueryPauseStateResponse")
+	proto.RegisterType((*QueryPauseRequest)(nil), "bitway.btcbridge.QueryPauseRequest")
+	proto.RegisterType((*QueryPauseResponse)(nil), "bitway.btcbridge.QueryPauseResponse")
+	proto.RegisterType((*QueryPausesRequest)(nil), "bitway.btcbridge.QueryPausesRequest")
+	proto.RegisterType((*QueryPausesResponse)(nil), "bitway.btcbridge.QueryPausesResponse")
+	proto.RegisterType((*QueryScreenedAddressRequest)(nil), "bitway.btcbridge.QueryScreenedAddressRequest")
+	proto.RegisterType((*QueryScreenedAddressResponse)(nil), "bitway.btcbridge.QueryScreenedAddressResponse")
+	proto.RegisterType((*QueryScreenedAddressesRequest)(nil), "bitway.btcbridge.QueryScreenedAddressesRequest")
+	proto.RegisterType((*QueryScreenedAddressesResponse)(nil), "bitway.btcbridge.QueryScreenedAddressesResponse")
+	proto.RegisterType((*QueryQuarantinedDepositsRequest)(nil), "bitway.btcbridge.QueryQuarantinedDepositsRequest")
+	proto.RegisterType((*QueryQuarantinedDepositsResponse)(nil), "bitway.btcbridge.QueryQuarantinedDepositsResponse")
+	proto.RegisterType((*QueryQuarantinedWithdrawalsRequest)(nil), "bitway.btcbridge.QueryQuarantinedWithdrawalsRequest")
+	proto.RegisterType((*QueryQuarantinedWithdrawalsResponse)(nil), "bitway.btcbridge.QueryQuarantinedWithdrawalsResponse")
+	proto.RegisterType((*QueryDepositConfirmationDepthRequest)(nil), "bitway.btcbridge.QueryDepositConfirmationDepthRequest")
+	proto.RegisterType((*QueryDepositConfirmationDepthResponse)(nil), "bitway.btcbridge.QueryDepositConfirmationDepthResponse")
+	proto.RegisterType((*QueryDepositRecordRequest)(nil), "bitway.btcbridge.QueryDepositRecordRequest")
+	proto.RegisterType((*QueryDepositRecordResponse)(nil), "bitway.btcbridge.QueryDepositRecordResponse")
+	proto.RegisterType((*QueryDepositRecordsRequest)(nil), "bitway.btcbridge.QueryDepositRecordsRequest")
+	proto.RegisterType((*QueryDepositRecordsResponse)(nil), "bitway.btcbridge.QueryDepositRecordsResponse")
+	proto.RegisterType((*QueryDepositRecordsByRecipientRequest)(nil), "bitway.btcbridge.QueryDepositRecordsByRecipientRequest")
+	proto.RegisterType((*QueryDepositRecordsByRecipientResponse)(nil), "bitway.btcbridge.QueryDepositRecordsByRecipientResponse")
+	proto.RegisterType((*QueryDepositRecordsBySenderRequest)(nil), "bitway.btcbridge.QueryDepositRecordsBySenderRequest")
+	proto.RegisterType((*QueryDepositRecordsBySenderResponse)(nil), "bitway.btcbridge.QueryDepositRecordsBySenderResponse")
+	proto.RegisterType((*QueryRuneEtchingRequest)(nil), "bitway.btcbridge.QueryRuneEtchingRequest")
+	proto.RegisterType((*QueryRuneEtchingResponse)(nil), "bitway.btcbridge.QueryRuneEtchingResponse")
+	proto.RegisterType((*QueryFeeSponsorshipUsageRequest)(nil), "bitway.btcbridge.QueryFeeSponsorshipUsageRequest")
+	proto.RegisterType((*QueryFeeSponsorshipUsageResponse)(nil), "bitway.btcbridge.QueryFeeSponsorshipUsageResponse")
+	proto.RegisterType((*QueryFeeSponsorshipAccountUsageRequest)(nil), "bitway.btcbridge.QueryFeeSponsorshipAccountUsageRequest")
+	proto.RegisterType((*QueryFeeSponsorshipAccountUsageResponse)(nil), "bitway.btcbridge.QueryFeeSponsorshipAccountUsageResponse")
+	proto.RegisterType((*QueryVaultRecoveriesRequest)(nil), "bitway.btcbridge.QueryVaultRecoveriesRequest")
+	proto.RegisterType((*QueryVaultRecoveriesResponse)(nil), "bitway.btcbridge.QueryVaultRecoveriesResponse")
+	proto.RegisterType((*VaultRecoveryInfo)(nil), "bitway.btcbridge.VaultRecoveryInfo")
+	proto.RegisterType((*QueryVaultRecoveryPsbtRequest)(nil), "bitway.btcbridge.QueryVaultRecoveryPsbtRequest")
+	proto.RegisterType((*QueryVaultRecoveryPsbtResponse)(nil), "bitway.btcbridge.QueryVaultRecoveryPsbtResponse")
 }
 
-func (m *QueryAllDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("bitway/btcbridge/query.proto", fileDescriptor_c2db215f5737906e) }
+
+var fileDescriptor_c2db215f5737906e = []byte{
+	// 3595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdb, 0x6f, 0x1d, 0x57,
+	0xd5, 0xcf, 0xd8, 0xb1, 0x63, 0xef, 0xb4, 0x69, 0xb2, 0x93, 0x26, 0x27, 0x13, 0xdf, 0x32, 0xb9,
+	0xd8, 0x49, 0xe3, 0x33, 0x89, 0x1d, 0xc7, 0xb9, 0x35, 0xfd, 0x6c, 0xa7, 0x69, 0xf2, 0xb5, 0x5f,
+	0xeb, 0x8c, 0x93, 0xaf, 0xdf, 0xc7, 0xcb, 0xd1, 0x9c, 0x39, 0xdb, 0xf6, 0x90, 0xe3, 0x99, 0x93,
+	0x99, 0x39, 0x4e, 0xac, 0x10, 0x84, 0x10, 0x42, 0xad, 0x10, 0xa2, 0xa2, 0x12, 0x45, 0x20, 0x10,
+	0x45, 0x80, 0xd4, 0x42, 0x4b, 0x11, 0x02, 0x54, 0x55, 0x42, 0x3c, 0xb5, 0xe5, 0x05, 0x95, 0xcb,
+	0x03, 0x4f, 0x14, 0xb5, 0x88, 0x17, 0xfe, 0x05, 0x90, 0xd0, 0xec, 0x59, 0x7b, 0xcf, 0x75, 0xcf,
+	0xcc, 0x49, 0x4f, 0x4a, 0x9e, 0x7c, 0x66, 0xef, 0x75, 0xf9, 0xad, 0xb5, 0x6f, 0x6b, 0xaf, 0xbd,
+	0x8c, 0x86, 0xea, 0xa6, 0x77, 0x4b, 0xdf, 0x50, 0xeb, 0x9e, 0x51, 0x77, 0xcc, 0xc6, 0x0a, 0x51,
+	0x6f, 0xb6, 0x89, 0xb3, 0x51, 0x6d, 0x39, 0xb6, 0x67, 0xe3, 0xed, 0x41, 0x6f, 0x95, 0xf7, 0xca,
+	0xbb, 0x56, 0xec, 0x15, 0x9b, 0x76, 0xaa, 0xfe, 0xaf, 0x80, 0x4e, 0x1e, 0x5a, 0xb1, 0xed, 0x95,
+	0x26, 0x51, 0xf5, 0x96, 0xa9, 0xea, 0x96, 0x65, 0x7b, 0xba, 0x67, 0xda, 0x96, 0x0b, 0xbd, 0xa3,
+	0xd0, 0x4b, 0xbf, 0xea, 0xed, 0x65, 0xd5, 0x33, 0xd7, 0x88, 0xeb, 0xe9, 0x6b, 0x2d, 0x20, 0x38,
+	0x6a, 0xd8, 0xee, 0x9a, 0xed, 0xaa, 0x75, 0xdd, 0x05, 0xfd, 0xea, 0xfa, 0x89, 0x3a, 0xf1, 0xf4,
+	0x13, 0x6a, 0x4b, 0x5f, 0x31, 0x2d, 0x2a, 0x0d, 0x68, 0x47, 0xa2, 0xb4, 0x8c, 0xca, 0xb0, 0x4d,
+	0xd6, 0x3f, 0x9c, 0x32, 0xa8, 0xa5, 0x3b, 0xfa, 0x1a, 0xc3, 0x32, 0x96, 0xea, 0xe6, 0xbf, 0x02,
+	0x0a, 0xe5, 0x45, 0x09, 0x1d, 0xba, 0xea, 0x63, 0x78, 0xde, 0xf4, 0x56, 0x1b, 0x8e, 0x7e, 0x4b,
+	0x23, 0x37, 0xdb, 0xc4, 0xf5, 0xdc, 0xf9, 0x8d, 0xb9, 0x46, 0xc3, 0x21, 0xae, 0x0b, 0x0d, 0xb8,
+	0x82, 0xb6, 0xe8, 0x41, 0x4b, 0x45, 0x1a, 0x93, 0x26, 0x06, 0x35, 0xf6, 0x89, 0x2f, 0x21, 0x14,
+	0x02, 0xaf, 0xf4, 0x8c, 0x49, 0x13, 0x5b, 0xa7, 0x0e, 0x57, 0x03, 0xe4, 0x55, 0x1f, 0x79, 0x35,
+	0xf0, 0x32, 0xe0, 0xaf, 0x2e, 0xea, 0x2b, 0x04, 0xa4, 0x6a, 0x11, 0x4e, 0xe5, 0x2d, 0x09, 0x1d,
+	0x2e, 0xc2, 0xe2, 0xb6, 0x6c, 0xcb, 0x25, 0xf8, 0x71, 0x34, 0xe0, 0x40, 0x67, 0x45, 0x1a, 0xeb,
+	0x9d, 0xd8, 0x3a, 0xb5, 0xbf, 0x9a, 0x1c, 0xbd, 0x6a, 0x42, 0x8c, 0xc6, 0x59, 0xf0, 0x53, 0x19,
+	0x88, 0xc7, 0x0b, 0x11, 0x07, 0xba, 0x63, 0x90, 0xcf, 0xa2, 0x83, 0x02, 0xc4, 0xd7, 0x6e, 0x5f,
+	0xd6, 0xdd, 0x55, 0xe6, 0x3c, 0x8c, 0x36, 0x7b, 0xb7, 0xcd, 0x06, 0x78, 0x8e, 0xfe, 0x56, 0x96,
+	0xd1, 0xa1, 0x02, 0xde, 0xae, 0x18, 0xab, 0xb4, 0xc0, 0xab, 0x8b, 0xc4, 0x6a, 0x98, 0xd6, 0xca,
+	0xbc, 0x67, 0x24, 0x35, 0x32, 0x94, 0xf1, 0x81, 0x94, 0xee, 0x79, 0x20, 0x7f, 0x26, 0xa1, 0xf1,
+	0x42, 0x95, 0x0f, 0xd8, 0x48, 0x9e, 0x46, 0x32, 0x85, 0xbc, 0x64, 0xae, 0x58, 0xa6, 0xb5, 0xc2,
+	0x34, 0x05, 0x7f, 0xb0, 0x8c, 0x06, 0x5c, 0xff, 0xa7, 0x65, 0x10, 0xea, 0x97, 0xcd, 0x1a, 0xff,
+	0x56, 0xfe, 0x1f, 0xed, 0xcb, 0xe4, 0x04, 0x03, 0xcf, 0xa2, 0x2d, 0x80, 0x16, 0x3c, 0x3a, 0x96,
+	0xb6, 0x2f, 0xc1, 0xca, 0x18, 0x94, 0xef, 0x4a, 0x99, 0xb2, 0xf9, 0x80, 0xcd, 0xa2, 0x7e, 0xd7,
+	0xd3, 0xbd, 0x76, 0xb0, 0x24, 0xb7, 0x4d, 0x8d, 0x0a, 0x45, 0x2f, 0x51, 0x32, 0x0d, 0xc8, 0xbb,
+	0xb6, 0x64, 0x7f, 0x28, 0xa1, 0xa1, 0x6c, 0x80, 0x60, 0xfd, 0xf9, 0xd4, 0xf0, 0x16, 0x9b, 0x7f,
+	0x1f, 0x46, 0xf7, 0x05, 0x09, 0x16, 0x6a, 0x02, 0xe7, 0x7f, 0x60, 0x97, 0x7b, 0x93, 0xed, 0xb8,
+	0x62, 0x28, 0x0f, 0x96, 0xef, 0x4e, 0x23, 0x25, 0x03, 0x6f, 0x99, 0x1d, 0x4e, 0x47, 0x07, 0x72,
+	0x39, 0xbb, 0xb0, 0x42, 0x9a, 0x48, 0x89, 0xee, 0x34, 0x82, 0x75, 0xd2, 0xad, 0x8d, 0xed, 0xe7,
+	0x12, 0x3a, 0x90, 0xab, 0x0e, 0x2c, 0x5a, 0x48, 0x8d, 0xdc, 0x78, 0xda, 0xa4, 0x05, 0x7b, 0xad,
+	0xa5, 0x1b, 0xde, 0xfd, 0x1f, 0xc0, 0x47, 0xd1, 0x4e, 0x0a, 0xfa, 0x12, 0x21, 0x9a, 0xee, 0x31,
+	0xc3, 0x94, 0x67, 0xd0, 0xae, 0x78, 0x33, 0x80, 0x3f, 0x89, 0x06, 0x96, 0x09, 0xa9, 0x39, 0xba,
+	0x47, 0xc0, 0x55, 0x7b, 0xd3, 0xe0, 0x19, 0xd3, 0x96, 0xe5, 0xe0, 0x87, 0x62, 0xa3, 0xb1, 0xd8,
+	0x69, 0xa6, 0x37, 0x9f, 0x25, 0xde, 0x2d, 0xdb, 0xb9, 0x71, 0x89, 0x30, 0x8d, 0x39, 0x8b, 0x6b,
+	0x37, 0xea, 0xd7, 0xd7, 0xec, 0xb6, 0xe5, 0x51, 0x3b, 0x07, 0x35, 0xf8, 0xc2, 0x7b, 0x23, 0x58,
+	0x7a, 0xc7, 0xa4, 0x89, 0xde, 0x50, 0xe1, 0x22, 0xda, 0x9f, 0xa3, 0x10, 0x6c, 0xd9, 0x9b, 0xb0,
+	0x25, 0xe4, 0xc7, 0xdb, 0x51, 0xef, 0x32, 0x21, 0xa0, 0xcf, 0xff, 0xa9, 0xec, 0x42, 0x38, 0x18,
+	0x5c, 0x1a, 0x42, 0x31, 0x37, 0xfd, 0x0f, 0xda, 0x19, 0x6b, 0x05, 0xc9, 0xa7, 0x50, 0x7f, 0x10,
+	0x6a, 0x81, 0x8f, 0x2a, 0x69, 0x1f, 0x05, 0x1c, 0xf3, 0x9b, 0xdf, 0xff, 0xcb, 0xe8, 0x26, 0x0d,
+	0xa8, 0x95, 0x9d, 0x68, 0x07, 0x15, 0x77, 0xfd, 0xda, 0xff, 0x3d, 0xc7, 0x75, 0xcc, 0x23, 0x1c,
+	0x6d, 0x04, 0x15, 0xc7, 0x50, 0x5f, 0xdb, 0xbb, 0x6d, 0xb3, 0x29, 0xb4, 0x3b, 0xad, 0xc1, 0xa7,
+	0xd7, 0x02, 0x22, 0xe5, 0x14, 0x1c, 0x60, 0x54, 0x46, 0xf9, 0x7d, 0x4d, 0x79, 0x1a, 0xed, 0xcb,
+	0xe4, 0xbb, 0x27, 0x10, 0x4f, 0xa2, 0x23, 0x5c, 0xd8, 0x82, 0x3f, 0x82, 0x73, 0x56, 0x63, 0x5e,
+	0x6f, 0xea, 0x96, 0x41, 0x3a, 0xc1, 0xf4, 0x6d, 0x09, 0x1d, 0x2d, 0x23, 0x07, 0x30, 0xee, 0x42,
+	0x7d, 0x06, 0x9d, 0x3c, 0xbe, 0x98, 0x87, 0xb5, 0xe0, 0xc3, 0x6f, 0x5d, 0xd7, 0x9b, 0xed, 0x60,
+	0x88, 0x7b, 0xb5, 0xe0, 0x03, 0xcf, 0xa1, 0x87, 0x9c, 0xb6, 0x45, 0x98, 0xb0, 0x4a, 0x2f, 0x35,
+	0x6b, 0x38, 0x6d, 0x96, 0x16, 0x52, 0x69, 0x31, 0x16, 0x65, 0x02, 0xed, 0xa6, 0xe0, 0x2e, 0x3e,
+	0xfd, 0x54, 0x22, 0x4c, 0xd8, 0x86, 0x7a, 0x60, 0x0b, 0xdc, 0xac, 0xf5, 0x98, 0x0d, 0xe5, 0x2a,
+	0xda, 0x93, 0xa2, 0xe4, 0xf3, 0x27, 0xb1, 0xe9, 0x0d, 0xa5, 0x21, 0x44, 0xd8, 0xf8, 0x86, 0x77,
+	0x3d, 0x25, 0x92, 0xfb, 0xf3, 0x6c, 0x22, 0x1a, 0x50, 0xf2, 0x24, 0xc6, 0x03, 0x02, 0xe5, 0x1a,
+	0xaa, 0xa4, 0xc5, 0x02, 0xd4, 0xd3, 0xa9, 0xdd, 0x2c, 0x1f, 0x6b, 0x18, 0x7a, 0x0e, 0xc1, 0x9c,
+	0x9c, 0x6b, 0x36, 0xd3, 0x78, 0x95, 0xe7, 0xd1, 0xbe, 0xcc, 0xde, 0x4f, 0xac, 0x76, 0x1a, 0xb6,
+	0x86, 0x8b, 0x4f, 0x3f, 0xe5, 0x6f, 0xb2, 0x4d, 0xe2, 0x6f, 0x83, 0x49, 0x6f, 0x25, 0xc7, 0x6a,
+	0x15, 0x29, 0x79, 0x4c, 0x00, 0x6a, 0x3e, 0x05, 0xea, 0x70, 0x26, 0xa8, 0x94, 0x88, 0x08, 0x3c,
+	0x15, 0x0d, 0x53, 0x4d, 0x1a, 0x59, 0x76, 0x88, 0xbb, 0x9a, 0x8e, 0x36, 0x93, 0xd0, 0x6a, 0x68,
+	0x44, 0xc4, 0xc0, 0xa3, 0xe8, 0xc4, 0x6c, 0x3a, 0x90, 0x31, 0xa1, 0x53, 0xdc, 0x7c, 0x52, 0xfd,
+	0x40, 0x12, 0x69, 0xe8, 0x64, 0x72, 0x85, 0xcc, 0xf7, 0x29, 0xda, 0xfc, 0xb1, 0x84, 0x46, 0x85,
+	0x30, 0xc1, 0x13, 0x4f, 0xa4, 0x06, 0xa8, 0x94, 0x2b, 0xee, 0xc3, 0xb1, 0x7b, 0x07, 0x66, 0x61,
+	0xa8, 0x2c, 0x9c, 0x14, 0xa2, 0x59, 0xd8, 0x35, 0x57, 0xfd, 0x4a, 0x42, 0x4a, 0x9e, 0x76, 0xf0,
+	0xd6, 0x65, 0xb4, 0xd5, 0x08, 0x9b, 0xc5, 0x33, 0x3a, 0x4b, 0x8a, 0x16, 0x65, 0xed, 0x9e, 0xdb,
+	0x3e, 0x0b, 0x37, 0x8a, 0x2b, 0xf3, 0x0b, 0x17, 0x49, 0xcb, 0x76, 0x4d, 0x6f, 0xc9, 0x70, 0xcc,
+	0x16, 0x5f, 0x1c, 0xc3, 0x08, 0x19, 0xab, 0xba, 0x65, 0x91, 0x66, 0x8d, 0x87, 0x9b, 0x83, 0xd0,
+	0x72, 0xa5, 0x81, 0x1f, 0x43, 0x3b, 0x1c, 0x62, 0x98, 0x2d, 0x93, 0x58, 0x5e, 0x8d, 0x1d, 0x2f,
+	0xc1, 0x21, 0xbf, 0x9d, 0x77, 0xc0, 0x01, 0xa2, 0xcc, 0xa2, 0x61, 0x81, 0x2e, 0xf0, 0xcf, 0x6e,
+	0xd4, 0xef, 0xd2, 0x16, 0x50, 0x04, 0x5f, 0xca, 0x1e, 0xf4, 0x68, 0xe0, 0x5d, 0xdd, 0x23, 0xcf,
+	0x98, 0x6b, 0x26, 0x43, 0xa7, 0x5c, 0x43, 0xbb, 0x93, 0x1d, 0x3c, 0xca, 0x45, 0x8e, 0xee, 0x91,
+	0x5a, 0xd3, 0x6f, 0x85, 0x55, 0xba, 0x2f, 0xc3, 0xd3, 0x9c, 0x71, 0xd0, 0x61, 0x3f, 0x95, 0xb3,
+	0x6c, 0x79, 0xb2, 0x96, 0x0e, 0xce, 0xd2, 0x7f, 0xf0, 0x45, 0x93, 0xc1, 0x0c, 0xd8, 0x84, 0xdc,
+	0x78, 0x01, 0x21, 0xd7, 0xd3, 0x1d, 0xaf, 0xe6, 0x99, 0x6b, 0x04, 0x86, 0x55, 0xae, 0x06, 0x29,
+	0xae, 0x2a, 0x4b, 0x71, 0x55, 0xaf, 0xb1, 0x14, 0xd7, 0xfc, 0x80, 0x1f, 0xec, 0xbc, 0xf4, 0xe1,
+	0xa8, 0xa4, 0x0d, 0x52, 0x3e, 0xbf, 0xc7, 0x5f, 0x93, 0xc4, 0x6a, 0x04, 0x22, 0x7a, 0x3b, 0x10,
+	0xb1, 0x85, 0x58, 0x0d, 0x2a, 0x60, 0x17, 0xea, 0xbb, 0xd9, 0xb6, 0x3d, 0xbd, 0xb2, 0x39, 0x38,
+	0xca, 0xe9, 0x87, 0x7f, 0xe5, 0x68, 0xbb, 0xa4, 0x51, 0xe9, 0xa3, 0x8d, 0xf4, 0xb7, 0xa2, 0x42,
+	0xb4, 0xa6, 0x91, 0xa6, 0xbe, 0x41, 0x9c, 0x62, 0xf7, 0x18, 0x68, 0x57, 0x9c, 0x01, 0x5c, 0x32,
+	0xed, 0xef, 0xa8, 0xb4, 0x49, 0x1c, 0x04, 0x33, 0x1e, 0x46, 0x49, 0xc3, 0x58, 0xc3, 0x33, 0xd7,
+	0x03, 0x4f, 0x0d, 0x68, 0xf0, 0xa5, 0xbc, 0x22, 0xc5, 0xb5, 0x74, 0x72, 0x81, 0x07, 0x96, 0xfb,
+	0xb4, 0xa5, 0xbe, 0x22, 0xb1, 0x99, 0xcc, 0x91, 0x81, 0x03, 0x66, 0xfc, 0x8d, 0x34, 0x68, 0x83,
+	0x7d, 0x21, 0xc7, 0x03, 0x9c, 0xb4, 0x7b, 0xfb, 0xc0, 0x14, 0xec, 0x03, 0x7e, 0x1c, 0xe6, 0xce,
+	0x79, 0x9e, 0x3f, 0x3b, 0x22, 0xe7, 0x69, 0xe6, 0x85, 0x93, 0xa0, 0x61, 0x01, 0x0f, 0x18, 0x75,
+	0x11, 0x6d, 0xd5, 0xc3, 0x66, 0x18, 0x59, 0x25, 0x3b, 0xf8, 0x8b, 0x09, 0x88, 0xb2, 0x29, 0x15,
+	0x58, 0xe4, 0x8b, 0x7a, 0xdb, 0x25, 0xfe, 0xc0, 0xf0, 0x3b, 0xd5, 0x7f, 0xa3, 0x3d, 0xa9, 0x1e,
+	0x50, 0xad, 0xfa, 0x17, 0x86, 0xb6, 0x4b, 0x98, 0x37, 0xf7, 0x64, 0x5d, 0x18, 0xda, 0x2e, 0xd1,
+	0x80, 0x4c, 0x39, 0x00, 0x37, 0x85, 0xa0, 0x55, 0x10, 0x1a, 0x2c, 0x20, 0x1c, 0x25, 0x02, 0x5d,
+	0x93, 0xa8, 0x8f, 0x0a, 0x01, 0x03, 0x85, 0xaa, 0x02, 0x2a, 0xe5, 0x65, 0x29, 0x2a, 0x85, 0x4f,
+	0xce, 0x99, 0xc4, 0xe4, 0x1c, 0x16, 0x88, 0xb9, 0x4f, 0x53, 0xf3, 0x6b, 0x12, 0xda, 0x19, 0x43,
+	0x75, 0x8f, 0x8e, 0xec, 0xde, 0x94, 0x9c, 0x65, 0xd9, 0x38, 0xc3, 0x21, 0xc4, 0x22, 0x8d, 0xd2,
+	0x7b, 0xf0, 0x0b, 0x3c, 0x4d, 0x96, 0xe4, 0x04, 0x9b, 0xfc, 0xfc, 0x22, 0x74, 0x51, 0xde, 0x01,
+	0x8d, 0x7f, 0xe3, 0x67, 0xd0, 0x76, 0xf6, 0x3b, 0x76, 0xa0, 0x65, 0x66, 0x4a, 0x93, 0x0a, 0x1e,
+	0x71, 0xe3, 0x0d, 0xca, 0xf7, 0x25, 0x58, 0x23, 0x09, 0xca, 0x70, 0xd8, 0xcf, 0xa0, 0x7e, 0xd7,
+	0x6e, 0x3b, 0x90, 0xe9, 0xdc, 0x26, 0xd6, 0xe2, 0x07, 0x7a, 0x94, 0x50, 0x03, 0x86, 0xae, 0x0d,
+	0xfd, 0x3b, 0x2c, 0x1e, 0xcd, 0x00, 0x09, 0x1e, 0x5b, 0x44, 0x38, 0xe9, 0x15, 0x92, 0x93, 0x41,
+	0x4e, 0xfa, 0x65, 0x87, 0x9b, 0x94, 0xdc, 0xbd, 0x69, 0x62, 0xc2, 0x81, 0x7b, 0xb5, 0xad, 0x3b,
+	0xba, 0xe5, 0x99, 0x16, 0x69, 0x40, 0x74, 0xd1, 0xf5, 0x84, 0xd4, 0x1b, 0x12, 0x1a, 0x13, 0xeb,
+	0x02, 0x57, 0xfd, 0x17, 0x1a, 0x68, 0x40, 0x1b, 0x38, 0xe8, 0x60, 0xda, 0x41, 0x69, 0x01, 0x1a,
+	0xe7, 0xea, 0x9e, 0x6b, 0x58, 0xba, 0x2e, 0xa2, 0x2d, 0xcc, 0xdf, 0x74, 0xdd, 0x3b, 0x6f, 0xb3,
+	0x74, 0x9d, 0x48, 0x1d, 0x38, 0xe8, 0x0a, 0xda, 0x7a, 0x2b, 0x6c, 0x16, 0x67, 0xec, 0x32, 0xc5,
+	0x68, 0x51, 0xde, 0xee, 0x79, 0xea, 0x02, 0x24, 0xac, 0x61, 0x30, 0x16, 0x6c, 0x6b, 0xd9, 0x74,
+	0xd6, 0x68, 0xdf, 0x45, 0xd2, 0xf2, 0x78, 0xde, 0x35, 0xcc, 0x9c, 0x49, 0xd1, 0xcc, 0x99, 0xd2,
+	0x46, 0x87, 0x0a, 0xf8, 0xf9, 0x59, 0x81, 0x8d, 0x48, 0x67, 0xad, 0xe1, 0xf7, 0x52, 0x61, 0x7d,
+	0xda, 0x0e, 0x23, 0xc9, 0xe6, 0x87, 0xdf, 0x75, 0xcf, 0xa8, 0x45, 0xb2, 0x75, 0xbd, 0xda, 0x60,
+	0xdd, 0x33, 0xe6, 0x02, 0xb5, 0x2a, 0xda, 0x1b, 0x55, 0xab, 0x11, 0xc3, 0x76, 0x1a, 0x79, 0x47,
+	0xf6, 0x75, 0x24, 0x67, 0x31, 0x00, 0xb8, 0x59, 0xd4, 0xef, 0xd0, 0x16, 0x98, 0x05, 0x19, 0xf1,
+	0x51, 0x9c, 0x11, 0xc8, 0xfd, 0xb8, 0x26, 0x43, 0x2e, 0x9f, 0x61, 0x27, 0x50, 0x9f, 0xee, 0xba,
+	0xc4, 0x83, 0x2d, 0x2e, 0x23, 0x0e, 0x9f, 0xf3, 0xbb, 0xaf, 0x6d, 0xb4, 0x88, 0x16, 0x50, 0x76,
+	0x6d, 0x6f, 0x7b, 0x95, 0xbd, 0xe9, 0x24, 0x91, 0x81, 0xc9, 0x67, 0xfc, 0xc0, 0x93, 0x36, 0xc1,
+	0x44, 0x2c, 0xb4, 0x99, 0xd1, 0x77, 0x6f, 0xf2, 0xf1, 0x57, 0xe1, 0x38, 0xc6, 0xf9, 0x0d, 0x8d,
+	0xdd, 0xa0, 0x3e, 0xbd, 0xf7, 0x92, 0x9f, 0xb0, 0x57, 0xe1, 0x1c, 0x2c, 0x0f, 0x90, 0xeb, 0xbe,
+	0xcc, 0x2e, 0xde, 0x49, 0xb8, 0x4b, 0xc4, 0x6a, 0x10, 0xe7, 0xd3, 0xf3, 0xdb, 0x6b, 0x6c, 0xf3,
+	0x13, 0x01, 0x79, 0x80, 0x9c, 0x76, 0x04, 0xc2, 0x66, 0x3f, 0xec, 0x7e, 0xd2, 0x33, 0x22, 0x89,
+	0x99, 0x48, 0xc0, 0x3b, 0x48, 0x03, 0xde, 0x25, 0x54, 0x49, 0x93, 0xf2, 0xdd, 0x62, 0x0b, 0x09,
+	0x9a, 0x60, 0xbb, 0x10, 0xa4, 0x75, 0x19, 0x1f, 0xa3, 0x56, 0x2e, 0xc0, 0x89, 0x7d, 0x89, 0x90,
+	0x25, 0x5f, 0x94, 0xed, 0xb8, 0xab, 0x66, 0xeb, 0xba, 0x1b, 0xba, 0x16, 0xef, 0x43, 0x83, 0x2d,
+	0xbb, 0x69, 0x1a, 0x1b, 0x61, 0xd6, 0x61, 0x20, 0x68, 0xb8, 0xd2, 0x50, 0x5e, 0xe9, 0x41, 0x63,
+	0x62, 0x01, 0x80, 0xee, 0x02, 0xea, 0x0f, 0x18, 0xf8, 0x89, 0x96, 0xf5, 0xaa, 0x12, 0x61, 0x5f,
+	0xa4, 0xd4, 0x1a, 0x70, 0xe1, 0x73, 0xa8, 0xaf, 0xed, 0x0b, 0x04, 0x47, 0x1f, 0x2a, 0x62, 0x0f,
+	0xb4, 0x07, 0x3c, 0x78, 0x1d, 0x6d, 0x77, 0xc8, 0x9a, 0x6e, 0xfa, 0x41, 0x5b, 0xad, 0xde, 0x6e,
+	0xac, 0x10, 0x0f, 0x52, 0xdf, 0x7b, 0x63, 0x03, 0xc6, 0x86, 0x6a, 0xc1, 0x36, 0xad, 0xf9, 0xe3,
+	0xfe, 0x4d, 0xfc, 0xf5, 0x0f, 0x47, 0x27, 0x56, 0x4c, 0x6f, 0xb5, 0x5d, 0xaf, 0x1a, 0xf6, 0x9a,
+	0x1a, 0x10, 0xc3, 0x9f, 0x49, 0xb7, 0x71, 0x43, 0xf5, 0x36, 0x5a, 0xc4, 0xa5, 0x0c, 0xae, 0xf6,
+	0x08, 0x57, 0x32, 0x4f, 0x75, 0x28, 0x35, 0x58, 0xbc, 0x71, 0x68, 0x73, 0x06, 0x4d, 0xd1, 0x97,
+	0x76, 0x70, 0x74, 0xb9, 0xf4, 0xc4, 0x43, 0xeb, 0x6f, 0xb0, 0x5a, 0x83, 0x3c, 0x0d, 0x3c, 0x79,
+	0x0b, 0x1e, 0x0c, 0x06, 0xe0, 0x58, 0x91, 0x07, 0x63, 0x42, 0xc0, 0x91, 0xe3, 0x28, 0xb4, 0xb1,
+	0x16, 0x24, 0x25, 0x7a, 0xe8, 0xab, 0xc3, 0x36, 0xde, 0x7c, 0xd5, 0x6f, 0x55, 0x86, 0x61, 0x9b,
+	0xff, 0x5f, 0xbd, 0xdd, 0xa4, 0x2b, 0x67, 0x9d, 0x38, 0x26, 0x8f, 0xb2, 0x15, 0x13, 0x0d, 0x65,
+	0x77, 0xf3, 0x98, 0x04, 0x39, 0xbc, 0x55, 0x9c, 0xc9, 0x8c, 0xb2, 0x6f, 0x5c, 0xb1, 0x96, 0x6d,
+	0x78, 0x6e, 0x8a, 0x30, 0x2b, 0xff, 0x92, 0xd0, 0x8e, 0x14, 0x5d, 0xce, 0x0e, 0x74, 0x16, 0x21,
+	0x7a, 0xe4, 0xd5, 0xfc, 0x91, 0xad, 0xf4, 0x14, 0x9f, 0x90, 0x83, 0x3a, 0xfb, 0xe9, 0x4b, 0x5d,
+	0x27, 0x8e, 0xeb, 0xef, 0x07, 0xbd, 0xf4, 0x92, 0xca, 0x3e, 0xf1, 0x39, 0x3f, 0x9f, 0x10, 0xe8,
+	0xa7, 0x69, 0x9c, 0xcc, 0x8d, 0x26, 0x06, 0x53, 0xe3, 0x0c, 0x91, 0x3c, 0x5c, 0x5f, 0x34, 0x0f,
+	0x87, 0x0f, 0xa0, 0x87, 0x0d, 0xdb, 0xf2, 0x1c, 0xbb, 0x59, 0xab, 0x37, 0x6d, 0xe3, 0x46, 0xa5,
+	0x9f, 0x76, 0x3f, 0x04, 0x8d, 0xf3, 0x7e, 0x9b, 0xe2, 0xc0, 0x8d, 0x27, 0x26, 0x7c, 0xd1, 0xad,
+	0x97, 0x38, 0xc4, 0x86, 0xd0, 0x20, 0x4f, 0x1a, 0xc2, 0xcc, 0x0b, 0x1b, 0xf2, 0x5e, 0x27, 0x9f,
+	0x43, 0x23, 0x22, 0x9d, 0x30, 0xc0, 0x18, 0x6d, 0x6e, 0xb9, 0x75, 0x16, 0xb6, 0xd1, 0xdf, 0xbe,
+	0x40, 0xff, 0x1d, 0xad, 0x66, 0xb5, 0xd7, 0x60, 0x56, 0x6d, 0xf1, 0xbf, 0x9f, 0x6d, 0xaf, 0x4d,
+	0xfd, 0xf3, 0x1c, 0xea, 0xa3, 0x12, 0xf1, 0xe7, 0xd0, 0xd6, 0xc8, 0x83, 0x24, 0xce, 0x8c, 0xe5,
+	0x93, 0xaf, 0x98, 0xf2, 0xa1, 0x02, 0xaa, 0x00, 0x94, 0x32, 0xf6, 0xc5, 0x3f, 0xfe, 0xed, 0xe5,
+	0x1e, 0x19, 0x57, 0x54, 0x41, 0x61, 0x19, 0xfe, 0x82, 0x84, 0x1e, 0x8a, 0x3e, 0x1b, 0x63, 0x91,
+	0xe4, 0xf8, 0x6b, 0xb3, 0x7c, 0xb8, 0x88, 0x0c, 0x10, 0xec, 0xa7, 0x08, 0xf6, 0xe1, 0xbd, 0x69,
+	0x04, 0xcb, 0x84, 0xf8, 0xae, 0xc6, 0x6f, 0x4b, 0x10, 0x64, 0x66, 0x3d, 0xfd, 0xe2, 0x29, 0x81,
+	0xa2, 0x9c, 0x87, 0x69, 0x79, 0xba, 0x23, 0x1e, 0x40, 0x3a, 0x4d, 0x91, 0x4e, 0xe2, 0xc7, 0xd2,
+	0x48, 0xc3, 0x1b, 0x81, 0x0f, 0x5a, 0x25, 0xae, 0x67, 0x06, 0x31, 0x34, 0xfe, 0x13, 0xbb, 0xd9,
+	0x0a, 0x6b, 0xdc, 0xf0, 0x6c, 0x01, 0x18, 0x51, 0xed, 0x8a, 0x7c, 0xba, 0x73, 0x46, 0x30, 0xe5,
+	0x09, 0x6a, 0xca, 0x19, 0x3c, 0x9b, 0x6b, 0x0a, 0x7b, 0x22, 0x51, 0x61, 0x75, 0xa8, 0x77, 0xe0,
+	0xc7, 0x5d, 0xfc, 0x5b, 0x96, 0x55, 0x10, 0x15, 0xb3, 0xe1, 0x53, 0xa5, 0xc1, 0xc5, 0xea, 0x4a,
+	0xe4, 0xd9, 0x8e, 0xf9, 0xc0, 0xa6, 0x59, 0x6a, 0xd3, 0x09, 0xac, 0x96, 0xb3, 0xc9, 0xbb, 0xad,
+	0xde, 0xf1, 0x2f, 0x24, 0x77, 0xf1, 0xef, 0x59, 0xc2, 0x5c, 0x5c, 0xbd, 0x86, 0x45, 0xae, 0x2e,
+	0xac, 0xb1, 0x93, 0xcf, 0xdc, 0x03, 0x27, 0x58, 0x74, 0x86, 0x5a, 0x34, 0x8d, 0x4f, 0x94, 0xb3,
+	0xa8, 0xee, 0x19, 0x6a, 0x2b, 0x90, 0x8a, 0x5f, 0x63, 0xb9, 0xb4, 0x78, 0xb5, 0x09, 0x3e, 0x26,
+	0x40, 0x93, 0x59, 0x05, 0x27, 0x4f, 0x96, 0xa4, 0x06, 0xbc, 0x33, 0x14, 0xaf, 0x8a, 0x27, 0xd3,
+	0x78, 0xdd, 0x80, 0x23, 0x04, 0x7b, 0x87, 0x95, 0xd3, 0xdd, 0xc5, 0xaf, 0xb2, 0x64, 0x79, 0x5c,
+	0xac, 0x8b, 0xcb, 0xa9, 0xe7, 0x9e, 0xae, 0x96, 0x25, 0x07, 0xb8, 0x47, 0x29, 0xdc, 0x83, 0x58,
+	0x29, 0x86, 0x8b, 0x7f, 0xc7, 0xb3, 0x68, 0x82, 0x22, 0x2e, 0xe1, 0x7c, 0x2f, 0x28, 0x40, 0x93,
+	0x67, 0x3b, 0xe6, 0x03, 0xf8, 0xe7, 0x29, 0xfc, 0x53, 0xf8, 0x64, 0x09, 0x6f, 0xa7, 0x17, 0xf0,
+	0x6f, 0xb2, 0x2b, 0x0d, 0xf9, 0xf2, 0x3d, 0x59, 0x0a, 0x56, 0x72, 0xf1, 0xce, 0x74, 0xc8, 0x05,
+	0xa6, 0x9c, 0xa4, 0xa6, 0x54, 0xf1, 0xb1, 0x12, 0xa6, 0x84, 0xeb, 0xf6, 0x5d, 0x66, 0x42, 0x76,
+	0x71, 0x96, 0xd0, 0x84, 0xdc, 0xd2, 0x31, 0x79, 0xa6, 0x43, 0x2e, 0x30, 0xe1, 0x2c, 0x35, 0xe1,
+	0x24, 0x9e, 0x2a, 0x61, 0x02, 0x2c, 0x52, 0xd5, 0x08, 0x0a, 0xc2, 0xf0, 0x6d, 0x84, 0xc2, 0x8a,
+	0x1c, 0x7c, 0x40, 0x00, 0x20, 0x5a, 0x40, 0x24, 0x1f, 0xcc, 0x27, 0x02, 0x50, 0xa3, 0x14, 0xd4,
+	0x5e, 0xbc, 0x27, 0x0d, 0x8a, 0x96, 0xef, 0xe0, 0xef, 0xb0, 0x6d, 0x22, 0x5e, 0x0c, 0x24, 0xdc,
+	0x26, 0x32, 0x6b, 0x8d, 0xe4, 0xc9, 0x92, 0xd4, 0x80, 0xea, 0x08, 0x45, 0x75, 0x00, 0xef, 0x17,
+	0xa0, 0x8a, 0xcc, 0xd2, 0x0f, 0xd8, 0xe5, 0x3a, 0xb7, 0x2e, 0x08, 0x9f, 0xcb, 0x01, 0x50, 0x54,
+	0x95, 0x24, 0x9f, 0xbf, 0x37, 0x66, 0x30, 0x46, 0xa5, 0xc6, 0x1c, 0xc1, 0xe3, 0x85, 0xc6, 0xa8,
+	0xae, 0xa7, 0x7b, 0x2e, 0xfe, 0xba, 0x84, 0x1e, 0x49, 0x54, 0xde, 0xe0, 0x09, 0x01, 0x84, 0x54,
+	0xc1, 0x91, 0x7c, 0xa4, 0x04, 0x65, 0xf1, 0xf6, 0xd6, 0xb8, 0xc1, 0x67, 0xa3, 0x7a, 0xc7, 0x5f,
+	0x4a, 0x2f, 0x49, 0x68, 0x7b, 0x42, 0x8e, 0x8b, 0x8b, 0x75, 0x71, 0x1f, 0x1e, 0x2d, 0x43, 0x0a,
+	0xb8, 0x0e, 0x51, 0x5c, 0xa3, 0x78, 0x38, 0x17, 0x17, 0xfe, 0x16, 0x9b, 0x9a, 0xf1, 0x6a, 0x21,
+	0xe1, 0xd4, 0xcc, 0x2c, 0x39, 0x92, 0x27, 0x4b, 0x52, 0x03, 0xb6, 0xc3, 0x14, 0xdb, 0x18, 0x1e,
+	0xc9, 0xc5, 0xe6, 0xe2, 0x5f, 0xf3, 0x6c, 0x63, 0x56, 0xf1, 0x10, 0x9e, 0x16, 0xbb, 0x43, 0x58,
+	0x9f, 0x24, 0x9f, 0xec, 0x8c, 0xa9, 0xf8, 0xcc, 0x6d, 0xdc, 0x08, 0xb6, 0x98, 0x80, 0x33, 0x3e,
+	0xe0, 0x3f, 0x95, 0x58, 0xdd, 0x42, 0xb2, 0x32, 0x06, 0xab, 0x02, 0x1c, 0xa2, 0xea, 0x25, 0xf9,
+	0x78, 0x79, 0x06, 0x00, 0x3d, 0x45, 0x41, 0x1f, 0xc3, 0x47, 0xd3, 0xa0, 0x1d, 0xce, 0x14, 0x89,
+	0x15, 0x7c, 0xc4, 0x6f, 0x48, 0x2c, 0x67, 0x94, 0x14, 0xeb, 0xe2, 0xd2, 0x08, 0xb8, 0xb3, 0x4f,
+	0x74, 0xc0, 0x01, 0xa0, 0x27, 0x29, 0xe8, 0x71, 0x7c, 0xa8, 0x14, 0xe8, 0x70, 0x8a, 0x64, 0x16,
+	0xe4, 0x08, 0xa7, 0x48, 0x5e, 0xf1, 0x90, 0x7c, 0xb2, 0x33, 0xa6, 0xe2, 0x29, 0x12, 0x01, 0x1e,
+	0xce, 0x14, 0x70, 0xf8, 0x8f, 0x58, 0xa5, 0x40, 0xb2, 0x58, 0x06, 0x8b, 0x02, 0x2d, 0x41, 0x05,
+	0x8f, 0xac, 0x96, 0xa6, 0x07, 0xc4, 0xc7, 0x28, 0xe2, 0xc3, 0xf8, 0x60, 0xc6, 0xa4, 0x0e, 0x18,
+	0x54, 0xb3, 0x6e, 0xd4, 0x20, 0x27, 0xf0, 0xa2, 0x84, 0xb6, 0xc5, 0x0b, 0x5e, 0xf0, 0xb8, 0xc8,
+	0x51, 0x89, 0xf2, 0x1d, 0x79, 0xa2, 0x98, 0x10, 0x30, 0x1d, 0xa4, 0x98, 0x46, 0xf0, 0x50, 0x86,
+	0x17, 0x79, 0x99, 0x0f, 0xfe, 0x25, 0x9f, 0xa5, 0xa9, 0xe2, 0x1b, 0xf1, 0x2c, 0x15, 0x15, 0xf9,
+	0xc8, 0x27, 0x3a, 0xe0, 0x00, 0x98, 0xa7, 0x28, 0xcc, 0xe3, 0xb8, 0x9a, 0x07, 0x33, 0x23, 0x1e,
+	0xfc, 0x0a, 0xbb, 0xe6, 0x43, 0x85, 0x87, 0xf0, 0x9a, 0x1f, 0x2f, 0xb4, 0x91, 0x0f, 0x17, 0x91,
+	0x15, 0x0f, 0x29, 0x2b, 0x25, 0x89, 0xa0, 0xf9, 0x92, 0x84, 0x1e, 0x8e, 0x8a, 0x71, 0x71, 0x81,
+	0x1e, 0xee, 0xb2, 0xf1, 0x42, 0x3a, 0x00, 0xa4, 0x50, 0x40, 0x43, 0x58, 0x16, 0x03, 0xc2, 0x6f,
+	0xf1, 0x62, 0x99, 0x44, 0x79, 0x88, 0x70, 0x09, 0x08, 0x8a, 0x57, 0x64, 0xb5, 0x34, 0x7d, 0x89,
+	0x71, 0x64, 0xfe, 0x8a, 0x94, 0xa8, 0xb8, 0x2c, 0x28, 0xfe, 0x2a, 0x0b, 0x2f, 0xc2, 0x8a, 0x14,
+	0x61, 0x78, 0x91, 0x2a, 0x67, 0x91, 0x8f, 0x94, 0xa0, 0x2c, 0x3e, 0xc6, 0x69, 0x19, 0x46, 0xcd,
+	0xa5, 0xba, 0x3f, 0x8f, 0x50, 0x28, 0x41, 0x18, 0xdb, 0x46, 0x4b, 0x5e, 0xe4, 0x83, 0xf9, 0x44,
+	0x25, 0xf5, 0xc3, 0x2e, 0x16, 0x26, 0xcf, 0xfc, 0x36, 0x9c, 0x2b, 0xbb, 0x44, 0xf2, 0x2c, 0x5a,
+	0x98, 0x92, 0x9f, 0x3c, 0xa3, 0xea, 0xde, 0xe4, 0x57, 0xdb, 0x78, 0xf5, 0x81, 0xf8, 0x6a, 0x9b,
+	0x59, 0x69, 0x22, 0x57, 0xcb, 0x92, 0x17, 0xe7, 0x42, 0x5c, 0x56, 0xca, 0xa1, 0xf2, 0x2a, 0x8a,
+	0xc8, 0xc2, 0x7b, 0x9d, 0xc5, 0x05, 0x4b, 0xa9, 0x72, 0x09, 0xb5, 0x1c, 0x86, 0xd0, 0x8b, 0xc7,
+	0xcb, 0x33, 0x14, 0x1f, 0xb1, 0x19, 0xb0, 0xf1, 0x3b, 0x12, 0xbc, 0x0d, 0x65, 0x14, 0x43, 0x60,
+	0xd1, 0xde, 0x29, 0x2e, 0xd2, 0x90, 0xa7, 0x3a, 0x61, 0x01, 0xc8, 0xa7, 0x29, 0xe4, 0x29, 0x7c,
+	0x3c, 0x0f, 0xf2, 0xcd, 0x50, 0x40, 0x8d, 0xd7, 0x58, 0xbc, 0xc7, 0xae, 0xaf, 0xd9, 0xc5, 0x0a,
+	0xc2, 0xeb, 0x6b, 0x6e, 0x29, 0x85, 0x3c, 0xd3, 0x21, 0x17, 0x98, 0x71, 0x8e, 0x9a, 0x31, 0x83,
+	0xa7, 0xcb, 0x9a, 0x11, 0xad, 0x81, 0x78, 0x97, 0x25, 0x47, 0x44, 0xb5, 0x07, 0xc2, 0xe4, 0x48,
+	0x41, 0xb1, 0x83, 0x3c, 0xdb, 0x31, 0x5f, 0x71, 0x46, 0x81, 0x45, 0x10, 0xe9, 0x22, 0x08, 0xfc,
+	0x2a, 0xab, 0x8b, 0x8b, 0xbd, 0x7f, 0xe2, 0xc7, 0xf2, 0x51, 0xc4, 0x6a, 0x1e, 0xe4, 0x63, 0xe5,
+	0x88, 0x01, 0xe7, 0x71, 0x8a, 0xf3, 0x28, 0x9e, 0x10, 0xe3, 0x84, 0xc7, 0x57, 0xb6, 0xc1, 0xf3,
+	0x2b, 0x7b, 0x4c, 0xa0, 0xf8, 0x5e, 0x94, 0x59, 0x0f, 0x21, 0x4f, 0x96, 0xa4, 0x2e, 0xbe, 0xb2,
+	0x27, 0x60, 0xe2, 0x3f, 0xb0, 0x84, 0xb7, 0xf0, 0xf9, 0x1e, 0xcf, 0x96, 0x52, 0x9e, 0x2e, 0x3e,
+	0x90, 0x4f, 0x77, 0xce, 0x08, 0x06, 0x3c, 0x4e, 0x0d, 0x98, 0xc5, 0x33, 0xc5, 0x7e, 0xe6, 0xcf,
+	0x3d, 0x91, 0x6d, 0xf1, 0xdd, 0xec, 0x1a, 0x0e, 0xf6, 0xb6, 0x2e, 0x5c, 0xab, 0xb9, 0x35, 0x01,
+	0xf2, 0x4c, 0x87, 0x5c, 0xc5, 0xa9, 0xa6, 0xa4, 0x2d, 0x2e, 0xe5, 0x8c, 0x18, 0xf2, 0x4d, 0x76,
+	0xd1, 0x8f, 0x3c, 0x8b, 0x0b, 0x2f, 0xfa, 0xe9, 0xd7, 0x79, 0xf9, 0x68, 0x19, 0xd2, 0x12, 0x17,
+	0xa6, 0xb6, 0x45, 0x5c, 0x15, 0x5e, 0xe3, 0xe1, 0xa4, 0x7e, 0x8f, 0xed, 0xe6, 0x19, 0xaf, 0xda,
+	0xc2, 0xdd, 0x5c, 0xfc, 0x80, 0x2f, 0x4f, 0x75, 0xc2, 0x02, 0x90, 0x2f, 0x52, 0xc8, 0x17, 0xf0,
+	0xf9, 0xcc, 0xc7, 0xa8, 0x9a, 0x1b, 0xf2, 0xa9, 0xf4, 0xa9, 0xda, 0xf4, 0x0f, 0x4f, 0xfe, 0x8a,
+	0x7d, 0x57, 0x0d, 0x9e, 0x8c, 0xff, 0x2e, 0x65, 0x96, 0x17, 0x44, 0x5f, 0x97, 0x85, 0x0f, 0x0a,
+	0x85, 0xef, 0xe6, 0xf2, 0x99, 0x7b, 0xe0, 0x04, 0xf3, 0x9e, 0xa5, 0xe6, 0x5d, 0xc6, 0x97, 0x3e,
+	0x89, 0x79, 0x91, 0xd9, 0xf4, 0x3d, 0x16, 0xde, 0x24, 0x1e, 0xb5, 0x85, 0xe1, 0x4d, 0xf6, 0xdb,
+	0xb8, 0x5c, 0x2d, 0x4b, 0x5e, 0xbc, 0x1d, 0xad, 0xfb, 0x2c, 0x74, 0x11, 0x07, 0x0f, 0xc9, 0xbf,
+	0x60, 0x01, 0x4d, 0xea, 0x61, 0x56, 0x18, 0xd0, 0x88, 0x9e, 0x8d, 0xe5, 0xe3, 0xe5, 0x19, 0x8a,
+	0xa3, 0x03, 0x00, 0x1a, 0xa6, 0x07, 0x19, 0x64, 0xd5, 0x7f, 0x19, 0x9e, 0xbf, 0xfc, 0xfe, 0x47,
+	0x23, 0xd2, 0x07, 0x1f, 0x8d, 0x48, 0x7f, 0xfd, 0x68, 0x44, 0x7a, 0xe9, 0xe3, 0x91, 0x4d, 0x1f,
+	0x7c, 0x3c, 0xb2, 0xe9, 0xcf, 0x1f, 0x8f, 0x6c, 0xfa, 0x4c, 0x35, 0x52, 0x9c, 0x11, 0x48, 0x6d,
+	0xea, 0x75, 0x97, 0x29, 0xb8, 0x1d, 0x51, 0x41, 0x0b, 0x35, 0xea, 0xfd, 0xf4, 0x5f, 0x2e, 0xa6,
+	0xff, 0x3d, 0x00, 0x06, 0xe7, 0x6f, 0x3c, 0x0c, 0x45, 0x00, 0x00,
 }
 
-func (m *QueryAllDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryFeeRate queries the bitcoin network fee rate on the bitway chain.
+	QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error)
+	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
+	QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error)
+	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
+	QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error)
+	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
+	QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error)
+	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
+	QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error)
+	// QuerySigningRequest queries the signing request by sequence.
+	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+	// QuerySigningRequests queries the signing requests by the given status.
+	QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
+	// QuerySigningRequestsByAddress queries the signing requests by the given address.
+	QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error)
+	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
+	QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error)
+	// QueryPendingSigningRequests queries the pending signing requests.
+	QueryPendingSigningRequests(ctx context.Context, in *QueryPendingSigningRequestsRequest, opts ...grpc.CallOption) (*QueryPendingSigningRequestsResponse, error)
+	// QueryUTXOs queries all utxos.
+	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
+	// QueryUTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
+	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
+	QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error)
+	// QueryDKGRequest queries the DKG request by the given id.
+	QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
+	// QueryDKGRequests queries the DKG requests by the given status
+	QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
+	// QueryAllDKGRequests queries all DKG requests.
+	QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error)
+	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
+	QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRefreshingRequest queries the refreshing request by the given id.
+	QueryRefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error)
+	// QueryRefreshingRequests queries the refreshing requests by the given status.
+	QueryRefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error)
+	// QueryRefreshingCompletions queries refreshing completions by the given request id.
+	QueryRefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error)
+	// QueryIBCDepositScript queries the deposit OP_RETURN script for cross-chain via IBC.
+	QueryIBCDepositScript(ctx context.Context, in *QueryIBCDepositScriptRequest, opts ...grpc.CallOption) (*QueryIBCDepositScriptResponse, error)
+	// QueryRateLimit queries the current rate limit
+	QueryRateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
+	// QueryRateLimitByAddress queries the current rate limit by the given address
+	QueryRateLimitByAddress(ctx context.Context, in *QueryRateLimitByAddressRequest, opts ...grpc.CallOption) (*QueryRateLimitByAddressResponse, error)
+	// QueryRelayer queries the relayer by the given address
+	QueryRelayer(ctx context.Context, in *QueryRelayerRequest, opts ...grpc.CallOption) (*QueryRelayerResponse, error)
+	// QueryRelayers queries the relayers by the optional status
+	QueryRelayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error)
+	// QueryRunesAttestation queries the runes deposit attestation by the given tx hash
+	QueryRunesAttestation(ctx context.Context, in *QueryRunesAttestationRequest, opts ...grpc.CallOption) (*QueryRunesAttestationResponse, error)
+	// QueryPauseState queries the pauses currently in effect
+	QueryPauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// QueryPause queries the pause by the given id
+	QueryPause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+	// QueryPauses queries the pause history by the optional status
+	QueryPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error)
+	// QueryScreenedAddress queries the screening state of the given address
+	QueryScreenedAddress(ctx context.Context, in *QueryScreenedAddressRequest, opts ...grpc.CallOption) (*QueryScreenedAddressResponse, error)
+	// QueryScreenedAddresses queries the screened addresses by the optional source
+	QueryScreenedAddresses(ctx context.Context, in *QueryScreenedAddressesRequest, opts ...grpc.CallOption) (*QueryScreenedAddressesResponse, error)
+	// QueryQuarantinedDeposits queries the quarantined deposits
+	QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error)
+	// QueryQuarantinedWithdrawals queries the quarantined withdrawals
+	QueryQuarantinedWithdrawals(ctx context.Context, in *QueryQuarantinedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryQuarantinedWithdrawalsResponse, error)
+	// QueryDepositConfirmationDepth queries the confirmation depth required for the deposit of the given amount
+	QueryDepositConfirmationDepth(ctx context.Context, in *QueryDepositConfirmationDepthRequest, opts ...grpc.CallOption) (*QueryDepositConfirmationDepthResponse, error)
+	// QueryDepositRecord queries the deposit record by the given tx hash
+	QueryDepositRecord(ctx context.Context, in *QueryDepositRecordRequest, opts ...grpc.CallOption) (*QueryDepositRecordResponse, error)
+	// QueryDepositRecords queries the deposit records by the given asset type
+	QueryDepositRecords(ctx context.Context, in *QueryDepositRecordsRequest, opts ...grpc.CallOption) (*QueryDepositRecordsResponse, error)
+	// QueryDepositRecordsByRecipient queries the deposit records of the given recipient
+	QueryDepositRecordsByRecipient(ctx context.Context, in *QueryDepositRecordsByRecipientRequest, opts ...grpc.CallOption) (*QueryDepositRecordsByRecipientResponse, error)
+	// QueryDepositRecordsBySender queries the deposit records of the given bitcoin sender
+	QueryDepositRecordsBySender(ctx context.Context, in *QueryDepositRecordsBySenderRequest, opts ...grpc.CallOption) (*QueryDepositRecordsBySenderResponse, error)
+	// QueryRuneEtching queries the etching data of the given rune
+	QueryRuneEtching(ctx context.Context, in *QueryRuneEtchingRequest, opts ...grpc.CallOption) (*QueryRuneEtchingResponse, error)
+	// QueryFeeSponsorshipUsage queries the current epoch usage of the given fee sponsorship policy
+	QueryFeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error)
+	// QueryFeeSponsorshipAccountUsage queries the current epoch usage of the given fee sponsorship policy by the account
+	QueryFeeSponsorshipAccountUsage(ctx context.Context, in *QueryFeeSponsorshipAccountUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipAccountUsageResponse, error)
+	// QueryVaultRecoveries queries the emergency recovery params of the vaults
+	QueryVaultRecoveries(ctx context.Context, in *QueryVaultRecoveriesRequest, opts ...grpc.CallOption) (*QueryVaultRecoveriesResponse, error)
+	// QueryVaultRecoveryPsbt queries the psbt to sweep the given vault via the recovery script path
+	QueryVaultRecoveryPsbt(ctx context.Context, in *QueryVaultRecoveryPsbtRequest, opts ...grpc.CallOption) (*QueryVaultRecoveryPsbtResponse, error)
 }
 
-func (m *QueryAllDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error) {
+	out := new(QueryFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error) {
+	out := new(QueryWithdrawalNetworkFeeResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawalNetworkFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error) {
+	out := new(QueryWithdrawRequestsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawRequestsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error) {
+	out := new(QueryWithdrawRequestsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryWithdrawRequestsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error) {
+	out := new(QueryPendingBtcWithdrawRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPendingBtcWithdrawRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
+	out := new(QuerySigningRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDKGCompletionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error) {
+	out := new(QuerySigningRequestsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequestsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error) {
+	out := new(QuerySigningRequestByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QuerySigningRequestByTxHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRefreshingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryPendingSigningRequests(ctx context.Context, in *QueryPendingSigningRequestsRequest, opts ...grpc.CallOption) (*QueryPendingSigningRequestsResponse, error) {
+	out := new(QueryPendingSigningRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPendingSigningRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
+	out := new(QueryUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
+	out := new(QueryUTXOsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
+	out := new(QueryUTXOCountAndBalancesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryUTXOCountAndBalancesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
+	out := new(QueryDKGRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
+	out := new(QueryDKGRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error) {
+	out := new(QueryAllDKGRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryAllDKGRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error) {
+	out := new(QueryDKGCompletionRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDKGCompletionRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error) {
+	out := new(QueryRefreshingRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRefreshingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueryRefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error) {
+	out := new(QueryRefreshingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingCompletionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error) {
+	out := new(QueryRefreshingCompletionsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRefreshingCompletions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRefreshingCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryIBCDepositScript(ctx context.Context, in *QueryIBCDepositScriptRequest, opts ...grpc.CallOption) (*QueryIBCDepositScriptResponse, error) {
+	out := new(QueryIBCDepositScriptResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryIBCDepositScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRefreshingCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryRateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRefreshingCompletionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRateLimitByAddress(ctx context.Context, in *QueryRateLimitByAddressRequest, opts ...grpc.CallOption) (*QueryRateLimitByAddressResponse, error) {
+	out := new(QueryRateLimitByAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRateLimitByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRefreshingCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRefreshingCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Completions) > 0 {
-		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueryRelayer(ctx context.Context, in *QueryRelayerRequest, opts ...grpc.CallOption) (*QueryRelayerResponse, error) {
+	out := new(QueryRelayerResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRelayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error) {
+	out := new(QueryRelayersResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRelayers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRunesAttestation(ctx context.Context, in *QueryRunesAttestationRequest, opts ...grpc.CallOption) (*QueryRunesAttestationResponse, error) {
+	out := new(QueryRunesAttestationResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRunesAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryPauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
+	out := new(QueryPauseResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error) {
+	out := new(QueryPausesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryPauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIBCDepositScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Script) > 0 {
-		i -= len(m.Script)
-		copy(dAtA[i:], m.Script)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Script)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryScreenedAddress(ctx context.Context, in *QueryScreenedAddressRequest, opts ...grpc.CallOption) (*QueryScreenedAddressResponse, error) {
+	out := new(QueryScreenedAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryScreenedAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryScreenedAddresses(ctx context.Context, in *QueryScreenedAddressesRequest, opts ...grpc.CallOption) (*QueryScreenedAddressesResponse, error) {
+	out := new(QueryScreenedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryScreenedAddresses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error) {
+	out := new(QueryQuarantinedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryQuarantinedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) QueryQuarantinedWithdrawals(ctx context.Context, in *QueryQuarantinedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryQuarantinedWithdrawalsResponse, error) {
+	out := new(QueryQuarantinedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryQuarantinedWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDepositConfirmationDepth(ctx context.Context, in *QueryDepositConfirmationDepthRequest, opts ...grpc.CallOption) (*QueryDepositConfirmationDepthResponse, error) {
+	out := new(QueryDepositConfirmationDepthResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDepositConfirmationDepth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDepositRecord(ctx context.Context, in *QueryDepositRecordRequest, opts ...grpc.CallOption) (*QueryDepositRecordResponse, error) {
+	out := new(QueryDepositRecordResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDepositRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RateLimit != nil {
-		{
-			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryDepositRecords(ctx context.Context, in *QueryDepositRecordsRequest, opts ...grpc.CallOption) (*QueryDepositRecordsResponse, error) {
+	out := new(QueryDepositRecordsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDepositRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDepositRecordsByRecipient(ctx context.Context, in *QueryDepositRecordsByRecipientRequest, opts ...grpc.CallOption) (*QueryDepositRecordsByRecipientResponse, error) {
+	out := new(QueryDepositRecordsByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDepositRecordsByRecipient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDepositRecordsBySender(ctx context.Context, in *QueryDepositRecordsBySenderRequest, opts ...grpc.CallOption) (*QueryDepositRecordsBySenderResponse, error) {
+	out := new(QueryDepositRecordsBySenderResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryDepositRecordsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryRuneEtching(ctx context.Context, in *QueryRuneEtchingRequest, opts ...grpc.CallOption) (*QueryRuneEtchingResponse, error) {
+	out := new(QueryRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryRuneEtching", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error) {
+	out := new(QueryFeeSponsorshipUsageResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryFeeSponsorshipUsage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFeeSponsorshipAccountUsage(ctx context.Context, in *QueryFeeSponsorshipAccountUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipAccountUsageResponse, error) {
+	out := new(QueryFeeSponsorshipAccountUsageResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryFeeSponsorshipAccountUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRateLimitByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Used != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Quota != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Quota))
-		i--
-		dAtA[i] = 0x20
-	}
-	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err22 != nil {
-		return 0, err22
-	}
-	i -= n22
-	i = encodeVarintQuery(dAtA, i, uint64(n22))
-	i--
-	dAtA[i] = 0x1a
-	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err23 != nil {
-		return 0, err23
-	}
-	i -= n23
-	i = encodeVarintQuery(dAtA, i, uint64(n23))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryVaultRecoveries(ctx context.Context, in *QueryVaultRecoveriesRequest, opts ...grpc.CallOption) (*QueryVaultRecoveriesResponse, error) {
+	out := new(QueryVaultRecoveriesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryVaultRecoveries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) QueryVaultRecoveryPsbt(ctx context.Context, in *QueryVaultRecoveryPsbtRequest, opts ...grpc.CallOption) (*QueryVaultRecoveryPsbtResponse, error) {
+	out := new(QueryVaultRecoveryPsbtResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Query/QueryVaultRecoveryPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryWithdrawRequestsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryFeeRate queries the bitcoin network fee rate on the bitway chain.
+	QueryFeeRate(context.Context, *QueryFeeRateRequest) (*QueryFeeRateResponse, error)
+	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
+	QueryWithdrawalNetworkFee(context.Context, *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error)
+	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
+	QueryWithdrawRequestsByAddress(context.Context, *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error)
+	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
+	QueryWithdrawRequestsByTxHash(context.Context, *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error)
+	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
+	QueryPendingBtcWithdrawRequests(context.Context, *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error)
+	// QuerySigningRequest queries the signing request by sequence.
+	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
+	// QuerySigningRequests queries the signing requests by the given status.
+	QuerySigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
+	// QuerySigningRequestsByAddress queries the signing requests by the given address.
+	QuerySigningRequestsByAddress(context.Context, *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error)
+	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
+	QuerySigningRequestByTxHash(context.Context, *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error)
+	// QueryPendingSigningRequests queries the pending signing requests.
+	QueryPendingSigningRequests(context.Context, *QueryPendingSigningRequestsRequest) (*QueryPendingSigningRequestsResponse, error)
+	// QueryUTXOs queries all utxos.
+	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
+	// QueryUTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
+	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
+	QueryUTXOCountAndBalancesByAddress(context.Context, *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error)
+	// QueryDKGRequest queries the DKG request by the given id.
+	QueryDKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
+	// QueryDKGRequests queries the DKG requests by the given status
+	QueryDKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
+	// QueryAllDKGRequests queries all DKG requests.
+	QueryAllDKGRequests(context.Context, *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error)
+	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
+	QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRefreshingRequest queries the refreshing request by the given id.
+	QueryRefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error)
+	// QueryRefreshingRequests queries the refreshing requests by the given status.
+	QueryRefreshingRequests(context.Context, *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error)
+	// QueryRefreshingCompletions queries refreshing completions by the given request id.
+	QueryRefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error)
+	// QueryIBCDepositScript queries the deposit OP_RETURN script for cross-chain via IBC.
+	QueryIBCDepositScript(context.Context, *QueryIBCDepositScriptRequest) (*QueryIBCDepositScriptResponse, error)
+	// QueryRateLimit queries the current rate limit
+	QueryRateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
+	// QueryRateLimitByAddress queries the current rate limit by the given address
+	QueryRateLimitByAddress(context.Context, *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error)
+	// QueryRelayer queries the relayer by the given address
+	QueryRelayer(context.Context, *QueryRelayerRequest) (*QueryRelayerResponse, error)
+	// QueryRelayers queries the relayers by the optional status
+	QueryRelayers(context.Context, *QueryRelayersRequest) (*QueryRelayersResponse, error)
+	// QueryRunesAttestation queries the runes deposit attestation by the given tx hash
+	QueryRunesAttestation(context.Context, *QueryRunesAttestationRequest) (*QueryRunesAttestationResponse, error)
+	// QueryPauseState queries the pauses currently in effect
+	QueryPauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// QueryPause queries the pause by the given id
+	QueryPause(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+	// QueryPauses queries the pause history by the optional status
+	QueryPauses(context.Context, *QueryPausesRequest) (*QueryPausesResponse, error)
+	// QueryScreenedAddress queries the screening state of the given address
+	QueryScreenedAddress(context.Context, *QueryScreenedAddressRequest) (*QueryScreenedAddressResponse, error)
+	// QueryScreenedAddresses queries the screened addresses by the optional source
+	QueryScreenedAddresses(context.Context, *QueryScreenedAddressesRequest) (*QueryScreenedAddressesResponse, error)
+	// QueryQuarantinedDeposits queries the quarantined deposits
+	QueryQuarantinedDeposits(context.Context, *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error)
+	// QueryQuarantinedWithdrawals queries the quarantined withdrawals
+	QueryQuarantinedWithdrawals(context.Context, *QueryQuarantinedWithdrawalsRequest) (*QueryQuarantinedWithdrawalsResponse, error)
+	// QueryDepositConfirmationDepth queries the confirmation depth required for the deposit of the given amount
+	QueryDepositConfirmationDepth(context.Context, *QueryDepositConfirmationDepthRequest) (*QueryDepositConfirmationDepthResponse, error)
+	// QueryDepositRecord queries the deposit record by the given tx hash
+	QueryDepositRecord(context.Context, *QueryDepositRecordRequest) (*QueryDepositRecordResponse, error)
+	// QueryDepositRecords queries the deposit records by the given asset type
+	QueryDepositRecords(context.Context, *QueryDepositRecordsRequest) (*QueryDepositRecordsResponse, error)
+	// QueryDepositRecordsByRecipient queries the deposit records of the given recipient
+	QueryDepositRecordsByRecipient(context.Context, *QueryDepositRecordsByRecipientRequest) (*QueryDepositRecordsByRecipientResponse, error)
+	// QueryDepositRecordsBySender queries the deposit records of the given bitcoin sender
+	QueryDepositRecordsBySender(context.Context, *QueryDepositRecordsBySenderRequest) (*QueryDepositRecordsBySenderResponse, error)
+	// QueryRuneEtching queries the etching data of the given rune
+	QueryRuneEtching(context.Context, *QueryRuneEtchingRequest) (*QueryRuneEtchingResponse, error)
+	// QueryFeeSponsorshipUsage queries the current epoch usage of the given fee sponsorship policy
+	QueryFeeSponsorshipUsage(context.Context, *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error)
+	// QueryFeeSponsorshipAccountUsage queries the current epoch usage of the given fee sponsorship policy by the account
+	QueryFeeSponsorshipAccountUsage(context.Context, *QueryFeeSponsorshipAccountUsageRequest) (*QueryFeeSponsorshipAccountUsageResponse, error)
+	// QueryVaultRecoveries queries the emergency recovery params of the vaults
+	QueryVaultRecoveries(context.Context, *QueryVaultRecoveriesRequest) (*QueryVaultRecoveriesResponse, error)
+	// QueryVaultRecoveryPsbt queries the psbt to sweep the given vault via the recovery script path
+	QueryVaultRecoveryPsbt(context.Context, *QueryVaultRecoveryPsbtRequest) (*QueryVaultRecoveryPsbtResponse, error)
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeeRate(ctx context.Context, req *QueryFeeRateRequest) (*QueryFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeRate not implemented")
+}
+func (*UnimplementedQueryServer) QueryWithdrawalNetworkFee(ctx context.Context, req *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawalNetworkFee not implemented")
+}
+func (*UnimplementedQueryServer) QueryWithdrawRequestsByAddress(ctx context.Context, req *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryWithdrawRequestsByTxHash(ctx context.Context, req *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByTxHash not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingBtcWithdrawRequests(ctx context.Context, req *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingBtcWithdrawRequests not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequests not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequestsByAddress(ctx context.Context, req *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequestByTxHash(ctx context.Context, req *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestByTxHash not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingSigningRequests(ctx context.Context, req *QueryPendingSigningRequestsRequest) (*QueryPendingSigningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingSigningRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOCountAndBalancesByAddress(ctx context.Context, req *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOCountAndBalancesByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequest not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllDKGRequests(ctx context.Context, req *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllDKGRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGCompletionRequests(ctx context.Context, req *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGCompletionRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryRefreshingRequest(ctx context.Context, req *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingRequest not implemented")
+}
+func (*UnimplementedQueryServer) QueryRefreshingRequests(ctx context.Context, req *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryRefreshingCompletions(ctx context.Context, req *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshingCompletions not implemented")
+}
+func (*UnimplementedQueryServer) QueryIBCDepositScript(ctx context.Context, req *QueryIBCDepositScriptRequest) (*QueryIBCDepositScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIBCDepositScript not implemented")
+}
+func (*UnimplementedQueryServer) QueryRateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) QueryRateLimitByAddress(ctx context.Context, req *QueryRateLimitByAddressRequest) (*QueryRateLimitByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRateLimitByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryRelayer(ctx context.Context, req *QueryRelayerRequest) (*QueryRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRelayer not implemented")
+}
+func (*UnimplementedQueryServer) QueryRelayers(ctx context.Context, req *QueryRelayersRequest) (*QueryRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRelayers not implemented")
+}
+func (*UnimplementedQueryServer) QueryRunesAttestation(ctx context.Context, req *QueryRunesAttestationRequest) (*QueryRunesAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRunesAttestation not implemented")
+}
+func (*UnimplementedQueryServer) QueryPauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPauseState not implemented")
+}
+func (*UnimplementedQueryServer) QueryPause(ctx context.Context, req *QueryPauseRequest) (*QueryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPause not implemented")
+}
+func (*UnimplementedQueryServer) QueryPauses(ctx context.Context, req *QueryPausesRequest) (*QueryPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPauses not implemented")
+}
+func (*UnimplementedQueryServer) QueryScreenedAddress(ctx context.Context, req *QueryScreenedAddressRequest) (*QueryScreenedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScreenedAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryScreenedAddresses(ctx context.Context, req *QueryScreenedAddressesRequest) (*QueryScreenedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScreenedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuarantinedDeposits(ctx context.Context, req *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuarantinedDeposits not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuarantinedWithdrawals(ctx context.Context, req *QueryQuarantinedWithdrawalsRequest) (*QueryQuarantinedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuarantinedWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositConfirmationDepth(ctx context.Context, req *QueryDepositConfirmationDepthRequest) (*QueryDepositConfirmationDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositConfirmationDepth not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositRecord(ctx context.Context, req *QueryDepositRecordRequest) (*QueryDepositRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecord not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositRecords(ctx context.Context, req *QueryDepositRecordsRequest) (*QueryDepositRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecords not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositRecordsByRecipient(ctx context.Context, req *QueryDepositRecordsByRecipientRequest) (*QueryDepositRecordsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecordsByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositRecordsBySender(ctx context.Context, req *QueryDepositRecordsBySenderRequest) (*QueryDepositRecordsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositRecordsBySender not implemented")
+}
+func (*UnimplementedQueryServer) QueryRuneEtching(ctx context.Context, req *QueryRuneEtchingRequest) (*QueryRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRuneEtching not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeeSponsorshipUsage(ctx context.Context, req *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeSponsorshipUsage not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeeSponsorshipAccountUsage(ctx context.Context, req *QueryFeeSponsorshipAccountUsageRequest) (*QueryFeeSponsorshipAccountUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeSponsorshipAccountUsage not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultRecoveries(ctx context.Context, req *QueryVaultRecoveriesRequest) (*QueryVaultRecoveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultRecoveries not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultRecoveryPsbt(ctx context.Context, req *QueryVaultRecoveryPsbtRequest) (*QueryVaultRecoveryPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultRecoveryPsbt not implemented")
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeRate(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryFeeRate",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeRate(ctx, req.(*QueryFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryWithdrawalNetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalNetworkFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, in)
 	}
-	return n
-}
-
-func (m *QuerySigningRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawalNetworkFee",
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, req.(*QueryWithdrawalNetworkFeeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryWithdrawRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawRequestsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawRequestsByAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, req.(*QueryWithdrawRequestsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryWithdrawRequestsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawRequestsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryWithdrawRequestsByTxHash",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, req.(*QueryWithdrawRequestsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryPendingBtcWithdrawRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBtcWithdrawRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryPendingBtcWithdrawRequests",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, req.(*QueryPendingBtcWithdrawRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequest(ctx, in)
 	}
-	return n
-}
-
-func (m *QuerySigningRequestByTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequest",
 	}
-	var l int
-	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestByTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequests(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryPendingSigningRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequests",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequests(ctx, req.(*QuerySigningRequestsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingSigningRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySigningRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequestsByAddress",
 	}
-	return n
-}
-
-func (m *QueryFeeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, req.(*QuerySigningRequestsByAddressRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySigningRequestByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.FeeRate != nil {
-		l = m.FeeRate.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QuerySigningRequestByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, req.(*QuerySigningRequestByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryPendingSigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSigningRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingSigningRequests(ctx, in)
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryPendingSigningRequests",
 	}
-	if m.FeeRate != 0 {
-		n += 1 + sovQuery(uint64(m.FeeRate))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingSigningRequests(ctx, req.(*QueryPendingSigningRequestsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		n += 1 + sovQuery(uint64(m.FeeRate))
+func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOs(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryUTXOs",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryUTXOsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryUTXOsByAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryUTXOCountAndBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOCountAndBalancesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryUTXOsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryUTXOCountAndBalancesByAddress",
 	}
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, req.(*QueryUTXOCountAndBalancesByAddressRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryDKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGRequest(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDKGRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGRequest(ctx, req.(*QueryDKGRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGRequests(ctx, in)
 	}
-	if m.Value != 0 {
-		n += 1 + sovQuery(uint64(m.Value))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDKGRequests",
 	}
-	if len(m.RuneBalances) > 0 {
-		for _, e := range m.RuneBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGRequests(ctx, req.(*QueryDKGRequestsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryAllDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDKGRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllDKGRequests(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryDKGRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryAllDKGRequests",
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllDKGRequests(ctx, req.(*QueryAllDKGRequestsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryDKGCompletionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGCompletionRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryDKGRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDKGCompletionRequests",
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, req.(*QueryDKGCompletionRequestsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRefreshingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryAllDKGRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRefreshingRequest(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingRequest",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRefreshingRequest(ctx, req.(*QueryRefreshingRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRefreshingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRefreshingRequests(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRefreshingRequests(ctx, req.(*QueryRefreshingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRefreshingCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingCompletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRefreshingCompletions(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRefreshingCompletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRefreshingCompletions(ctx, req.(*QueryRefreshingCompletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRefreshingRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryIBCDepositScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCDepositScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryIBCDepositScript(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryIBCDepositScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryIBCDepositScript(ctx, req.(*QueryIBCDepositScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRefreshingRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRateLimit(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRefreshingRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRateLimitByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRateLimitByAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRateLimitByAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRateLimitByAddress(ctx, req.(*QueryRateLimitByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRefreshingRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRelayer(ctx, req.(*QueryRelayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRelayers(ctx, req.(*QueryRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRunesAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunesAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRunesAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRunesAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRunesAttestation(ctx, req.(*QueryRunesAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPause(ctx, req.(*QueryPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryPauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPauses(ctx, req.(*QueryPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScreenedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScreenedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryScreenedAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScreenedAddress(ctx, req.(*QueryScreenedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScreenedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScreenedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryScreenedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScreenedAddresses(ctx, req.(*QueryScreenedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryQuarantinedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryQuarantinedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, req.(*QueryQuarantinedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryQuarantinedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuarantinedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryQuarantinedWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuarantinedWithdrawals(ctx, req.(*QueryQuarantinedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositConfirmationDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositConfirmationDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositConfirmationDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDepositConfirmationDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositConfirmationDepth(ctx, req.(*QueryDepositConfirmationDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDepositRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecord(ctx, req.(*QueryDepositRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDepositRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecords(ctx, req.(*QueryDepositRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecordsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecordsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDepositRecordsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecordsByRecipient(ctx, req.(*QueryDepositRecordsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositRecordsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRecordsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositRecordsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryDepositRecordsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositRecordsBySender(ctx, req.(*QueryDepositRecordsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneEtchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRuneEtching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryRuneEtching",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRuneEtching(ctx, req.(*QueryRuneEtchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeSponsorshipUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeSponsorshipUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryFeeSponsorshipUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeSponsorshipUsage(ctx, req.(*QueryFeeSponsorshipUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeSponsorshipAccountUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipAccountUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeSponsorshipAccountUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryFeeSponsorshipAccountUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeSponsorshipAccountUsage(ctx, req.(*QueryFeeSponsorshipAccountUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultRecoveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRecoveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultRecoveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryVaultRecoveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultRecoveries(ctx, req.(*QueryVaultRecoveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultRecoveryPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRecoveryPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultRecoveryPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Query/QueryVaultRecoveryPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultRecoveryPsbt(ctx, req.(*QueryVaultRecoveryPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitway.btcbridge.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryFeeRate",
+			Handler:    _Query_QueryFeeRate_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawalNetworkFee",
+			Handler:    _Query_QueryWithdrawalNetworkFee_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawRequestsByAddress",
+			Handler:    _Query_QueryWithdrawRequestsByAddress_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawRequestsByTxHash",
+			Handler:    _Query_QueryWithdrawRequestsByTxHash_Handler,
+		},
+		{
+			MethodName: "QueryPendingBtcWithdrawRequests",
+			Handler:    _Query_QueryPendingBtcWithdrawRequests_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequest",
+			Handler:    _Query_QuerySigningRequest_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequests",
+			Handler:    _Query_QuerySigningRequests_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequestsByAddress",
+			Handler:    _Query_QuerySigningRequestsByAddress_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequestByTxHash",
+			Handler:    _Query_QuerySigningRequestByTxHash_Handler,
+		},
+		{
+			MethodName: "QueryPendingSigningRequests",
+			Handler:    _Query_QueryPendingSigningRequests_Handler,
+		},
+		{
+			MethodName: "QueryUTXOs",
+			Handler:    _Query_QueryUTXOs_Handler,
+		},
+		{
+			MethodName: "QueryUTXOsByAddress",
+			Handler:    _Query_QueryUTXOsByAddress_Handler,
+		},
+		{
+			MethodName: "QueryUTXOCountAndBalancesByAddress",
+			Handler:    _Query_QueryUTXOCountAndBalancesByAddress_Handler,
+		},
+		{
+			MethodName: "QueryDKGRequest",
+			Handler:    _Query_QueryDKGRequest_Handler,
+		},
+		{
+			MethodName: "QueryDKGRequests",
+			Handler:    _Query_QueryDKGRequests_Handler,
+		},
+		{
+			MethodName: "QueryAllDKGRequests",
+			Handler:    _Query_QueryAllDKGRequests_Handler,
+		},
+		{
+			MethodName: "QueryDKGCompletionRequests",
+			Handler:    _Query_QueryDKGCompletionRequests_Handler,
+		},
+		{
+			MethodName: "QueryRefreshingRequest",
+			Handler:    _Query_QueryRefreshingRequest_Handler,
+		},
+		{
+			MethodName: "QueryRefreshingRequests",
+			Handler:    _Query_QueryRefreshingRequests_Handler,
+		},
+		{
+			MethodName: "QueryRefreshingCompletions",
+			Handler:    _Query_QueryRefreshingCompletions_Handler,
+		},
+		{
+			MethodName: "QueryIBCDepositScript",
+			Handler:    _Query_QueryIBCDepositScript_Handler,
+		},
+		{
+			MethodName: "QueryRateLimit",
+			Handler:    _Query_QueryRateLimit_Handler,
+		},
+		{
+			MethodName: "QueryRateLimitByAddress",
+			Handler:    _Query_QueryRateLimitByAddress_Handler,
+		},
+		{
+			MethodName: "QueryRelayer",
+			Handler:    _Query_QueryRelayer_Handler,
+		},
+		{
+			MethodName: "QueryRelayers",
+			Handler:    _Query_QueryRelayers_Handler,
+		},
+		{
+			MethodName: "QueryRunesAttestation",
+			Handler:    _Query_QueryRunesAttestation_Handler,
+		},
+		{
+			MethodName: "QueryPauseState",
+			Handler:    _Query_QueryPauseState_Handler,
+		},
+		{
+			MethodName: "QueryPause",
+			Handler:    _Query_QueryPause_Handler,
+		},
+		{
+			MethodName: "QueryPauses",
+			Handler:    _Query_QueryPauses_Handler,
+		},
+		{
+			MethodName: "QueryScreenedAddress",
+			Handler:    _Query_QueryScreenedAddress_Handler,
+		},
+		{
+			MethodName: "QueryScreenedAddresses",
+			Handler:    _Query_QueryScreenedAddresses_Handler,
+		},
+		{
+			MethodName: "QueryQuarantinedDeposits",
+			Handler:    _Query_QueryQuarantinedDeposits_Handler,
+		},
+		{
+			MethodName: "QueryQuarantinedWithdrawals",
+			Handler:    _Query_QueryQuarantinedWithdrawals_Handler,
+		},
+		{
+			MethodName: "QueryDepositConfirmationDepth",
+			Handler:    _Query_QueryDepositConfirmationDepth_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecord",
+			Handler:    _Query_QueryDepositRecord_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecords",
+			Handler:    _Query_QueryDepositRecords_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecordsByRecipient",
+			Handler:    _Query_QueryDepositRecordsByRecipient_Handler,
+		},
+		{
+			MethodName: "QueryDepositRecordsBySender",
+			Handler:    _Query_QueryDepositRecordsBySender_Handler,
+		},
+		{
+			MethodName: "QueryRuneEtching",
+			Handler:    _Query_QueryRuneEtching_Handler,
+		},
+		{
+			MethodName: "QueryFeeSponsorshipUsage",
+			Handler:    _Query_QueryFeeSponsorshipUsage_Handler,
+		},
+		{
+			MethodName: "QueryFeeSponsorshipAccountUsage",
+			Handler:    _Query_QueryFeeSponsorshipAccountUsage_Handler,
+		},
+		{
+			MethodName: "QueryVaultRecoveries",
+			Handler:    _Query_QueryVaultRecoveries_Handler,
+		},
+		{
+			MethodName: "QueryVaultRecoveryPsbt",
+			Handler:    _Query_QueryVaultRecoveryPsbt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitway/btcbridge/query.proto",
+}
+
+func (m *QueryWithdrawRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawRequestsByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSigningRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSigningRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != nil {
+		{
+			size, err := m.FeeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalNetworkFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalNetworkFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalNetworkFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuneBalances) > 0 {
+		for iNdEx := len(m.RuneBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingCompletionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingCompletionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Completions) > 0 {
+		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDepositScriptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDepositScriptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDepositScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDepositScriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDepositScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDepositScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Script) > 0 {
+		i -= len(m.Script)
+		copy(dAtA[i:], m.Script)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Script)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x20
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintQuery(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Relayer != nil {
+		{
+			size, err := m.Relayer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunesAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunesAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunesAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunesAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunesAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunesAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScreenedAddress != nil {
+		{
+			size, err := m.ScreenedAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Screened {
+		i--
+		if m.Screened {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScreenedAddresses) > 0 {
+		for iNdEx := len(m.ScreenedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScreenedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositConfirmationDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositConfirmationDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositConfirmationDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositConfirmationDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositConfirmationDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositConfirmationDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConfirmationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfirmationDepth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Asset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Asset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRecordsBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRecordsBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRecordsBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneEtchingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneEtchingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneEtchingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneEtchingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneEtchingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneEtchingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Etching != nil {
+		{
+			size, err := m.Etching.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingBudget) > 0 {
+		for iNdEx := len(m.RemainingBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipAccountUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipAccountUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipAccountUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipAccountUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipAccountUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipAccountUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingQuota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingQuota))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRecoveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRecoveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRecoveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRecoveriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRecoveriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRecoveriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recoveries) > 0 {
+		for iNdEx := len(m.Recoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecoveryInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecoveryInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecoveryInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControlBlock) > 0 {
+		i -= len(m.ControlBlock)
+		copy(dAtA[i:], m.ControlBlock)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControlBlock)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Script) > 0 {
+		i -= len(m.Script)
+		copy(dAtA[i:], m.Script)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Script)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Recovery != nil {
+		{
+			size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRecoveryPsbtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRecoveryPsbtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRecoveryPsbtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRecoveryPsbtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRecoveryPsbtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRecoveryPsbtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UtxoNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UtxoNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWithdrawRequestsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSigningRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSigningRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalNetworkFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUTXOsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUTXOsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	if len(m.RuneBalances) > 0 {
+		for _, e := range m.RuneBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDKGRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllDKGRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllDKGRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingCompletionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingCompletionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Completions) > 0 {
+		for _, e := range m.Completions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCDepositScriptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCDepositScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Quota != 0 {
+		n += 1 + sovQuery(uint64(m.Quota))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *QueryRelayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Relayer != nil {
+		l = m.Relayer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRelayersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunesAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunesAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Screened {
+		n += 2
+	}
+	if m.ScreenedAddress != nil {
+		l = m.ScreenedAddress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScreenedAddresses) > 0 {
+		for _, e := range m.ScreenedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositConfirmationDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositConfirmationDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfirmationDepth != 0 {
+		n += 1 + sovQuery(uint64(m.ConfirmationDepth))
+	}
+	if m.BtcAmount != 0 {
+		n += 1 + sovQuery(uint64(m.BtcAmount))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Asset != 0 {
+		n += 1 + sovQuery(uint64(m.Asset))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRecordsBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuneEtchingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuneEtchingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Etching != nil {
+		l = m.Etching.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RemainingBudget) > 0 {
+		for _, e := range m.RemainingBudget {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipAccountUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipAccountUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingQuota != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingQuota))
+	}
+	return n
+}
+
+func (m *QueryVaultRecoveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVaultRecoveriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recoveries) > 0 {
+		for _, e := range m.Recoveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultRecoveryInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Recovery != nil {
+		l = m.Recovery.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControlBlock)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultRecoveryPsbtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *QueryVaultRecoveryPsbtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UtxoNum != 0 {
+		n += 1 + sovQuery(uint64(m.UtxoNum))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SigningRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SigningRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSigningRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSigningRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSigningRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSigningRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &CompactSigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeRate == nil {
+				m.FeeRate = &FeeRate{}
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneBalances = append(m.RuneBalances, &RuneBalance{})
+			if err := m.RuneBalances[len(m.RuneBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &DKGRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DKGRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &DKGRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &DKGRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &DKGCompletionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RefreshingRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RefreshingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &RefreshingRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryRefreshingCompletionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryRefreshingCompletionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Completions) > 0 {
-		for _, e := range m.Completions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIBCDepositScriptRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIBCDepositScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Script)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryRateLimitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RateLimit != nil {
-		l = m.RateLimit.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryRateLimitByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryRateLimitByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Quota != 0 {
-		n += 1 + sovQuery(uint64(m.Quota))
-	}
-	if m.Used != 0 {
-		n += 1 + sovQuery(uint64(m.Used))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5960,17 +14891,17 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5980,23 +14911,25 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Completions = append(m.Completions, &RefreshingCompletion{})
+			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6028,7 +14961,7 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6055,7 +14988,7 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6078,17 +15011,17 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCDepositScriptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCDepositScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6098,31 +15031,29 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6132,27 +15063,23 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6175,7 +15102,7 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCDepositScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6198,15 +15125,15 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCDepositScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCDepositScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6234,7 +15161,7 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.Script = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6257,7 +15184,7 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6280,46 +15207,12 @@ func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6341,7 +15234,7 @@ func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6364,15 +15257,15 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6399,10 +15292,10 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6427,7 +15320,7 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6450,51 +15343,17 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6504,27 +15363,23 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6547,7 +15402,7 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6570,17 +15425,17 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6590,64 +15445,60 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6674,13 +15525,48 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SigningRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6702,7 +15588,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6725,36 +15611,17 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6764,27 +15631,23 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6807,7 +15670,7 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6830,15 +15693,15 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6865,16 +15728,18 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &SigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Relayer == nil {
+				m.Relayer = &Relayer{}
+			}
+			if err := m.Relayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6884,28 +15749,12 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6927,7 +15776,7 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6950,17 +15799,17 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6970,24 +15819,11 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= RelayerStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7045,7 +15881,7 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7068,15 +15904,15 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7103,8 +15939,8 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &SigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relayers = append(m.Relayers, &Relayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7165,7 +16001,7 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRunesAttestationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7188,10 +16024,10 @@ func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunesAttestationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunesAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7247,7 +16083,7 @@ func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRunesAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7270,15 +16106,15 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunesAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunesAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7305,10 +16141,10 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SigningRequest{}
+			if m.Attestation == nil {
+				m.Attestation = &RunesAttestation{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7333,7 +16169,7 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingSigningRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7356,48 +16192,12 @@ func (m *QueryPendingSigningRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSigningRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7419,7 +16219,7 @@ func (m *QueryPendingSigningRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingSigningRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7442,49 +16242,15 @@ func (m *QueryPendingSigningRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSigningRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &CompactSigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7511,10 +16277,8 @@ func (m *QueryPendingSigningRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pauses = append(m.Pauses, &Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7539,7 +16303,7 @@ func (m *QueryPendingSigningRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7562,12 +16326,31 @@ func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7589,7 +16372,7 @@ func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7612,15 +16395,15 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7647,10 +16430,10 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeRate == nil {
-				m.FeeRate = &FeeRate{}
+			if m.Pause == nil {
+				m.Pause = &Pause{}
 			}
-			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7675,7 +16458,7 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPausesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7698,17 +16481,17 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7718,29 +16501,16 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= PauseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7750,43 +16520,28 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.FeeRate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7808,7 +16563,7 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPausesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7831,17 +16586,17 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
-			m.FeeRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7851,16 +16606,31 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, &Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7870,23 +16640,27 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7909,7 +16683,7 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScreenedAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7932,12 +16706,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScreenedAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScreenedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7959,7 +16765,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScreenedAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7982,15 +16788,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScreenedAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScreenedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Screened", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Screened = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8017,7 +16843,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScreenedAddress == nil {
+				m.ScreenedAddress = &ScreenedAddress{}
+			}
+			if err := m.ScreenedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8042,7 +16871,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScreenedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8065,65 +16894,34 @@ func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScreenedAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScreenedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= ScreeningSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8150,8 +16948,10 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &UTXO{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8176,7 +16976,7 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScreenedAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8199,17 +16999,17 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScreenedAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScreenedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddresses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8219,23 +17019,61 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ScreenedAddresses = append(m.ScreenedAddresses, &ScreenedAddress{})
+			if err := m.ScreenedAddresses[len(m.ScreenedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8258,7 +17096,7 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8281,15 +17119,15 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8316,8 +17154,10 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &UTXO{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8342,7 +17182,7 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8365,17 +17205,17 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8385,23 +17225,61 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Deposits = append(m.Deposits, &QuarantinedDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8424,7 +17302,7 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8447,53 +17325,15 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8520,8 +17360,10 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneBalances = append(m.RuneBalances, &RuneBalance{})
-			if err := m.RuneBalances[len(m.RuneBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8546,7 +17388,7 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8569,17 +17411,17 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8589,11 +17431,62 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, &QuarantinedWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8615,7 +17508,7 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositConfirmationDepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8638,17 +17531,17 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositConfirmationDepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositConfirmationDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8658,27 +17551,23 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &DKGRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8701,7 +17590,7 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositConfirmationDepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8724,17 +17613,36 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositConfirmationDepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositConfirmationDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationDepth", wireType)
+			}
+			m.ConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAmount", wireType)
 			}
-			m.Status = 0
+			m.BtcAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8744,7 +17652,7 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= DKGRequestStatus(b&0x7F) << shift
+				m.BtcAmount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8770,7 +17678,7 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8793,17 +17701,17 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8813,25 +17721,23 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8854,7 +17760,7 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8877,12 +17783,48 @@ func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &DepositRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8904,7 +17846,7 @@ func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8927,15 +17869,34 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			m.Asset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Asset |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8962,8 +17923,10 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8988,7 +17951,7 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9011,17 +17974,17 @@ func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9031,11 +17994,62 @@ func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &DepositRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9057,7 +18071,7 @@ func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsByRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9080,15 +18094,47 @@ func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsByRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9115,8 +18161,10 @@ func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGCompletionRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9141,7 +18189,7 @@ func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsByRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9164,17 +18212,17 @@ func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsByRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9184,11 +18232,62 @@ func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &DepositRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9210,7 +18309,7 @@ func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsBySenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9233,15 +18332,47 @@ func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsBySenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9268,10 +18399,10 @@ func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RefreshingRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9296,7 +18427,7 @@ func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRecordsBySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9319,17 +18450,17 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRecordsBySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRecordsBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9339,11 +18470,26 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RefreshingStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &DepositRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -9374,7 +18520,7 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9401,7 +18547,7 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRuneEtchingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9424,17 +18570,17 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRuneEtchingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRuneEtchingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9444,29 +18590,77 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &RefreshingRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuneEtchingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuneEtchingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuneEtchingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Etching", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9493,10 +18687,10 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Etching == nil {
+				m.Etching = &RuneEtching{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Etching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9521,7 +18715,7 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorshipUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9544,36 +18738,17 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9583,27 +18758,23 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9626,7 +18797,7 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorshipUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9649,15 +18820,15 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9684,14 +18855,16 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Completions = append(m.Completions, &RefreshingCompletion{})
-			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &FeeSponsorshipPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9718,10 +18891,44 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Usage == nil {
+				m.Usage = &FeeSponsorshipUsage{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingBudget = append(m.RemainingBudget, types.Coin{})
+			if err := m.RemainingBudget[len(m.RemainingBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9746,7 +18953,7 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorshipAccountUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9769,15 +18976,15 @@ func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCDepositScriptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorshipAccountUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCDepositScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorshipAccountUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9805,11 +19012,11 @@ func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9837,7 +19044,7 @@ func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9860,7 +19067,7 @@ func (m *QueryIBCDepositScriptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCDepositScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorshipAccountUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9883,17 +19090,53 @@ func (m *QueryIBCDepositScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCDepositScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorshipAccountUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCDepositScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorshipAccountUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &FeeSponsorshipAccountUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingQuota", wireType)
 			}
-			var stringLen uint64
+			m.RemainingQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9903,24 +19146,11 @@ func (m *QueryIBCDepositScriptResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemainingQuota |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Script = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9942,7 +19172,7 @@ func (m *QueryIBCDepositScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultRecoveriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9965,10 +19195,10 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultRecoveriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultRecoveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9992,7 +19222,7 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultRecoveriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10015,15 +19245,15 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultRecoveriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultRecoveriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10050,10 +19280,8 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RateLimit == nil {
-				m.RateLimit = &RateLimit{}
-			}
-			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recoveries = append(m.Recoveries, VaultRecoveryInfo{})
+			if err := m.Recoveries[len(m.Recoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10078,7 +19306,7 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *VaultRecoveryInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10101,10 +19329,10 @@ func (m *QueryRateLimitByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultRecoveryInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultRecoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10139,6 +19367,144 @@ func (m *QueryRateLimitByAddressRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recovery == nil {
+				m.Recovery = &VaultRecovery{}
+			}
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10160,7 +19526,7 @@ func (m *QueryRateLimitByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultRecoveryPsbtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10183,10 +19549,10 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultRecoveryPsbtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultRecoveryPsbtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10223,9 +19589,9 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10235,30 +19601,29 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
-			var msglen int
+			m.FeeRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10268,30 +19633,66 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FeeRate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRecoveryPsbtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Quota = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRecoveryPsbtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRecoveryPsbtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10301,16 +19702,29 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quota |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoNum", wireType)
 			}
-			m.Used = 0
+			m.UtxoNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10320,7 +19734,7 @@ func (m *QueryRateLimitByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Used |= int64(b&0x7F) << shift
+				m.UtxoNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,304 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+)
+
+const (
+	// maximum relative time lock in blocks for the recovery script path
+	MaxRecoveryTimelock = uint32(wire.SequenceLockTimeMask)
+
+	// maximum number of the recovery multisig pub keys
+	MaxRecoveryPubKeyNum = 20
+)
+
+// Enabled returns true if the vault recovery is enabled, false otherwise
+func (p *VaultRecoveryParams) Enabled() bool {
+	return len(p.PubKeys) != 0
+}
+
+// Validate validates the vault recovery params
+// The params are considered valid if the recovery is disabled
+func (p *VaultRecoveryParams) Validate() error {
+	if !p.Enabled() {
+		return nil
+	}
+
+	if len(p.PubKeys) > MaxRecoveryPubKeyNum {
+		return errorsmod.Wrapf(ErrInvalidParams, "too many recovery pub keys")
+	}
+
+	pubKeys := make(map[string]bool)
+
+	for _, pubKey := range p.PubKeys {
+		if _, err := ParseXOnlyPubKey(pubKey); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid recovery pub key %s", pubKey)
+		}
+
+		if pubKeys[pubKey] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate recovery pub key %s", pubKey)
+		}
+
+		pubKeys[pubKey] = true
+	}
+
+	if p.Threshold == 0 || p.Threshold > uint32(len(p.PubKeys)) {
+		return errorsmod.Wrapf(ErrInvalidParams, "recovery threshold must be between [1, %d]", len(p.PubKeys))
+	}
+
+	if p.Timelock == 0 || p.Timelock > MaxRecoveryTimelock {
+		return errorsmod.Wrapf(ErrInvalidParams, "recovery time lock must be between [1, %d]", MaxRecoveryTimelock)
+	}
+
+	return nil
+}
+
+// Validate validates the vault recovery
+func (r *VaultRecovery) Validate() error {
+	if _, err := ParseXOnlyPubKey(r.InternalKey); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid internal key")
+	}
+
+	if !r.Params.Enabled() {
+		return errorsmod.Wrapf(ErrInvalidVault, "recovery pub keys required")
+	}
+
+	return r.Params.Validate()
+}
+
+// GetScript gets the recovery tapscript of the vault
+func (r *VaultRecovery) GetScript() ([]byte, error) {
+	return CreateRecoveryScript(&r.Params)
+}
+
+// GetControlBlock gets the control block of the recovery tapscript
+func (r *VaultRecovery) GetControlBlock() ([]byte, error) {
+	internalKey, err := ParseXOnlyPubKey(r.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := r.GetScript()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(script))
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+
+	return controlBlock.ToBytes()
+}
+
+// GetMerkleRoot gets the tapscript merkle root committed by the vault
+func (r *VaultRecovery) GetMerkleRoot() ([]byte, error) {
+	script, err := r.GetScript()
+	if err != nil {
+		return nil, err
+	}
+
+	// the recovery tapscript is the only leaf
+	root := txscript.NewBaseTapLeaf(script).TapHash()
+
+	return root[:], nil
+}
+
+// GetAddress gets the taproot address which commits to the recovery tapscript
+func (r *VaultRecovery) GetAddress() (string, error) {
+	internalKey, err := ParseXOnlyPubKey(r.InternalKey)
+	if err != nil {
+		return "", err
+	}
+
+	merkleRoot, err := r.GetMerkleRoot()
+	if err != nil {
+		return "", err
+	}
+
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, merkleRoot)
+
+	address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), bitcoin.Network)
+	if err != nil {
+		return "", err
+	}
+
+	return address.EncodeAddress(), nil
+}
+
+// CreateRecoveryScript creates the recovery tapscript from the given params, i.e.
+// <timelock> OP_CHECKSEQUENCEVERIFY OP_DROP <pk1> OP_CHECKSIG <pk2> OP_CHECKSIGADD ... <pkn> OP_CHECKSIGADD <threshold> OP_NUMEQUAL
+// Assume that the given params are valid
+func CreateRecoveryScript(params *VaultRecoveryParams) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	builder.AddInt64(int64(params.Timelock))
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	builder.AddOp(txscript.OP_DROP)
+
+	for i, pubKey := range params.PubKeys {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.AddData(pubKeyBytes)
+
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+
+	builder.AddInt64(int64(params.Threshold))
+	builder.AddOp(txscript.OP_NUMEQUAL)
+
+	return builder.Script()
+}
+
+// CheckVaultRecoveries checks if the given vaults commit to the recovery tapscript built from the corresponding internal keys and recovery params
+func CheckVaultRecoveries(vaults []string, internalKeys []string, params *VaultRecoveryParams) error {
+	if len(internalKeys) != len(vaults) {
+		return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "internal keys do not match vaults")
+	}
+
+	for i, v := range vaults {
+		recovery := &VaultRecovery{
+			InternalKey: internalKeys[i],
+			Params:      *params,
+		}
+
+		address, err := recovery.GetAddress()
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "invalid internal key: %v", err)
+		}
+
+		if address != v {
+			return errorsmod.Wrapf(ErrInvalidDKGCompletionRequest, "vault %s does not commit to the recovery script", v)
+		}
+	}
+
+	return nil
+}
+
+// BuildVaultRecoveryPsbt builds the psbt to sweep the given vault utxos to the recipient via the recovery script path.
+// The input sequence is set to the recovery time lock and the recovery tapscript is populated for each input.
+// Runes held by the utxos are transferred to the recipient as well.
+func BuildVaultRecoveryPsbt(utxos []*UTXO, recovery *VaultRecovery, recipient string, feeRate int64) (*psbt.Packet, error) {
+	if len(utxos) == 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	recipientAddr, err := btcutil.DecodeAddress(recipient, bitcoin.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := recovery.GetScript()
+	if err != nil {
+		return nil, err
+	}
+
+	controlBlock, err := recovery.GetControlBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	merkleRoot, err := recovery.GetMerkleRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey, err := hex.DecodeString(recovery.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(TxVersion)
+
+	totalAmount := int64(0)
+	for _, utxo := range utxos {
+		AddUTXOToTx(tx, utxo)
+		tx.TxIn[len(tx.TxIn)-1].Sequence = recovery.Params.Timelock
+
+		totalAmount += int64(utxo.Amount)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(0, recipientPkScript))
+
+	fee := GetRecoveryTxVirtualSize(tx, recovery, script, controlBlock) * feeRate
+
+	tx.TxOut[0].Value = totalAmount - fee
+	if IsDustOut(tx.TxOut[0]) {
+		return nil, ErrDustOutput
+	}
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, utxo := range utxos {
+		p.Inputs[i].SighashType = DefaultSigHashType
+		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		p.Inputs[i].TaprootInternalKey = internalKey
+		p.Inputs[i].TaprootMerkleRoot = merkleRoot
+		p.Inputs[i].TaprootLeafScript = []*psbt.TaprootTapLeafScript{
+			{
+				ControlBlock: controlBlock,
+				Script:       script,
+				LeafVersion:  txscript.BaseLeafVersion,
+			},
+		}
+	}
+
+	return p, nil
+}
+
+// GetRecoveryTxVirtualSize gets the virtual size of the given tx spending the vault utxos via the recovery script path
+func GetRecoveryTxVirtualSize(tx *wire.MsgTx, recovery *VaultRecovery, script []byte, controlBlock []byte) int64 {
+	newTx := tx.Copy()
+
+	for _, txIn := range newTx.TxIn {
+		witness := wire.TxWitness{}
+
+		for i := range recovery.Params.PubKeys {
+			if i < int(recovery.Params.Threshold) {
+				// maximum size when the sig hash is not SigHashDefault
+				witness = append(witness, make([]byte, 65))
+			} else {
+				witness = append(witness, []byte{})
+			}
+		}
+
+		txIn.Witness = append(witness, script, controlBlock)
+	}
+
+	return mempool.GetTxVirtualSize(btcutil.NewTx(newTx))
+}
+
+// ParseXOnlyPubKey parses the given hex encoded x-only public key
+func ParseXOnlyPubKey(pubKey string) (*btcec.PublicKey, error) {
+	pubKeyBytes, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParsePubKey(pubKeyBytes)
+}
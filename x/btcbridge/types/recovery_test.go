@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/bitwaylabs/bitway/x/btcbridge/types"
+)
+
+func TestVaultRecovery(t *testing.T) {
+	internalPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	recoveryPrivKeys := make([]*btcec.PrivateKey, 3)
+	recoveryPubKeys := make([]string, 3)
+
+	for i := range recoveryPrivKeys {
+		recoveryPrivKeys[i], err = btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		recoveryPubKeys[i] = hex.EncodeToString(schnorr.SerializePubKey(recoveryPrivKeys[i].PubKey()))
+	}
+
+	params := types.VaultRecoveryParams{
+		PubKeys:   recoveryPubKeys,
+		Threshold: 2,
+		Timelock:  1000,
+	}
+	require.NoError(t, params.Validate())
+
+	recovery := &types.VaultRecovery{
+		InternalKey: hex.EncodeToString(schnorr.SerializePubKey(internalPrivKey.PubKey())),
+		Params:      params,
+	}
+	require.NoError(t, recovery.Validate())
+
+	vault, err := recovery.GetAddress()
+	require.NoError(t, err)
+
+	require.NoError(t, types.CheckVaultRecoveries([]string{vault}, []string{recovery.InternalKey}, &params))
+	require.Error(t, types.CheckVaultRecoveries([]string{vault}, []string{recovery.Params.PubKeys[0]}, &params))
+
+	// the key path is still spendable by the tweaked internal key
+	merkleRoot, err := recovery.GetMerkleRoot()
+	require.NoError(t, err)
+
+	outputKey := txscript.ComputeTaprootOutputKey(internalPrivKey.PubKey(), merkleRoot)
+	require.Equal(t, schnorr.SerializePubKey(outputKey), types.MustPkScriptFromAddress(vault)[2:])
+
+	utxos := []*types.UTXO{
+		{Txid: chainhash.Hash{1}.String(), Vout: 0, Address: vault, Amount: 100000, PubKeyScript: types.MustPkScriptFromAddress(vault)},
+		{Txid: chainhash.Hash{2}.String(), Vout: 1, Address: vault, Amount: 50000, PubKeyScript: types.MustPkScriptFromAddress(vault)},
+	}
+
+	p, err := types.BuildVaultRecoveryPsbt(utxos, recovery, vault, 10)
+	require.NoError(t, err)
+	require.Len(t, p.UnsignedTx.TxOut, 1)
+	require.Less(t, p.UnsignedTx.TxOut[0].Value, int64(150000))
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range p.UnsignedTx.TxIn {
+		require.Equal(t, params.Timelock, txIn.Sequence)
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(p.UnsignedTx, prevOutFetcher)
+
+	// sign by the first and last recovery keys
+	for i, input := range p.Inputs {
+		require.Len(t, input.TaprootLeafScript, 1)
+
+		leafScript := input.TaprootLeafScript[0]
+		leaf := txscript.NewBaseTapLeaf(leafScript.Script)
+
+		witness := wire.TxWitness{}
+		for j := len(recoveryPrivKeys) - 1; j >= 0; j-- {
+			if j == 1 {
+				witness = append(witness, []byte{})
+				continue
+			}
+
+			sig, err := txscript.RawTxInTapscriptSignature(p.UnsignedTx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, leaf, txscript.SigHashDefault, recoveryPrivKeys[j])
+			require.NoError(t, err)
+
+			witness = append(witness, sig)
+		}
+
+		p.UnsignedTx.TxIn[i].Witness = append(witness, leafScript.Script, leafScript.ControlBlock)
+	}
+
+	for i, input := range p.Inputs {
+		vm, err := txscript.NewEngine(input.WitnessUtxo.PkScript, p.UnsignedTx, i, txscript.StandardVerifyFlags, nil, sigHashes, input.WitnessUtxo.Value, prevOutFetcher)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+	}
+
+	// invalid params
+	require.Error(t, (&types.VaultRecoveryParams{PubKeys: recoveryPubKeys, Threshold: 4, Timelock: 1000}).Validate())
+	require.Error(t, (&types.VaultRecoveryParams{PubKeys: recoveryPubKeys, Threshold: 2, Timelock: 0}).Validate())
+	require.Error(t, (&types.VaultRecoveryParams{PubKeys: []string{recoveryPubKeys[0], recoveryPubKeys[0]}, Threshold: 1, Timelock: 1000}).Validate())
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsActive returns true if the relayer is bonded with the sufficient stake, false otherwise
+func (r *Relayer) IsActive(minBond sdk.Coin) bool {
+	return r.Status == RelayerStatus_RELAYER_STATUS_BONDED && r.Bond.Denom == minBond.Denom && r.Bond.IsGTE(minBond)
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// maximum length of the rune name
+	MaxRuneNameLength = 26
+
+	// maximum divisibility of the rune
+	MaxRuneDivisibility = 38
+
+	// spacer character of the rune name
+	RuneSpacer = "•"
+
+	// default currency symbol of the rune
+	DefaultRuneSymbol = "¤"
+)
+
+// Validate validates the rune etching
+func (e *RuneEtching) Validate() error {
+	var id RuneId
+	if err := id.FromString(e.Id); err != nil || id.ToString() != e.Id {
+		return errorsmod.Wrapf(ErrInvalidRuneEtching, "invalid rune id %s", e.Id)
+	}
+
+	if len(e.Name) == 0 || len(e.Name) > MaxRuneNameLength {
+		return errorsmod.Wrapf(ErrInvalidRuneEtching, "rune name length must be between [1, %d]", MaxRuneNameLength)
+	}
+
+	for _, c := range e.Name {
+		if c < 'A' || c > 'Z' {
+			return errorsmod.Wrapf(ErrInvalidRuneEtching, "rune name must only contain the characters A-Z")
+		}
+	}
+
+	// spacers are only allowed between the name characters
+	if e.Spacers >= 1<<(len(e.Name)-1) {
+		return errorsmod.Wrapf(ErrInvalidRuneEtching, "invalid spacers")
+	}
+
+	if !utf8.ValidString(e.Symbol) || utf8.RuneCountInString(e.Symbol) > 1 {
+		return errorsmod.Wrapf(ErrInvalidRuneEtching, "symbol must be a single character")
+	}
+
+	for _, c := range e.Symbol {
+		if unicode.IsSpace(c) || !unicode.IsGraphic(c) {
+			return errorsmod.Wrapf(ErrInvalidRuneEtching, "symbol must be a visible character")
+		}
+	}
+
+	if e.Divisibility > MaxRuneDivisibility {
+		return errorsmod.Wrapf(ErrInvalidRuneEtching, "divisibility must not be greater than %d", MaxRuneDivisibility)
+	}
+
+	return nil
+}
+
+// Denom returns the denom of the rune voucher token
+// Assume that the rune etching is valid
+func (e *RuneEtching) Denom() string {
+	var id RuneId
+	id.MustUnmarshalFromString(e.Id)
+
+	return id.Denom()
+}
+
+// SpacedName returns the rune name with the spacers, e.g. DOG•GO•TO•THE•MOON
+func (e *RuneEtching) SpacedName() string {
+	var builder strings.Builder
+
+	for i, c := range e.Name {
+		builder.WriteRune(c)
+
+		if i < len(e.Name)-1 && e.Spacers&(1<<i) != 0 {
+			builder.WriteString(RuneSpacer)
+		}
+	}
+
+	return builder.String()
+}
+
+// CurrencySymbol returns the currency symbol of the rune
+func (e *RuneEtching) CurrencySymbol() string {
+	if len(e.Symbol) == 0 {
+		return DefaultRuneSymbol
+	}
+
+	return e.Symbol
+}
+
+// Metadata returns the bank denom metadata of the rune voucher token
+// The display denom is runes/<name> which is scaled by the divisibility of the rune
+// Assume that the rune etching is valid
+func (e *RuneEtching) Metadata() banktypes.Metadata {
+	base := e.Denom()
+	spacedName := e.SpacedName()
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Bridged rune %s (%s)", spacedName, e.Id),
+		Base:        base,
+		Display:     base,
+		Name:        spacedName,
+		Symbol:      e.CurrencySymbol(),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+		},
+	}
+
+	if e.Divisibility == 0 {
+		metadata.DenomUnits[0].Aliases = []string{spacedName}
+
+		return metadata
+	}
+
+	metadata.Display = fmt.Sprintf("%s/%s", RunesProtocolName, e.Name)
+	metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+		Denom:    metadata.Display,
+		Exponent: e.Divisibility,
+		Aliases:  []string{spacedName},
+	})
+
+	return metadata
+}
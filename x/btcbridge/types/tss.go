@@ -11,6 +11,11 @@ import (
 	"github.com/bitwaylabs/bitway/bitcoin/crypto/hash"
 )
 
+const (
+	// key type of the vaults for the scheduled key rotation
+	VaultKeyType = "vault"
+)
+
 // ParticipantExists returns true if the given participant is included in the authorized participants, false otherwise
 func ParticipantExists(participants []*DKGParticipant, consPubKey string) bool {
 	for _, p := range participants {
@@ -41,8 +46,12 @@ func CheckDKGCompletionRequests(requests []*DKGCompletionRequest) bool {
 
 	vaults := requests[0].Vaults
 
+	internalKeys := requests[0].InternalKeys
+
+	pubKeys := requests[0].PubKeys
+
 	for _, req := range requests[1:] {
-		if !reflect.DeepEqual(req.Vaults, vaults) {
+		if !reflect.DeepEqual(req.Vaults, vaults) || !reflect.DeepEqual(req.InternalKeys, internalKeys) || !reflect.DeepEqual(req.PubKeys, pubKeys) {
 			return false
 		}
 	}
@@ -68,6 +77,14 @@ func GetDKGCompletionSigMsg(req *DKGCompletionRequest) []byte {
 		msg = append(msg, []byte(v)...)
 	}
 
+	for _, k := range req.InternalKeys {
+		msg = append(msg, []byte(k)...)
+	}
+
+	for _, pk := range req.PubKeys {
+		msg = append(msg, []byte(pk)...)
+	}
+
 	return hash.Sha256(msg)
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -44,6 +45,10 @@ type MsgSubmitDepositTransaction struct {
 	// the tx bytes in base64 format
 	TxBytes string   `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Proof   []string `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the serialized BIP-37 merkle block in hex format, i.e. the output of gettxoutproof
+	// alternative to the proof; the blockhash can be omitted as the merkle block carries its own header
+	// the same merkle block can be shared by the transactions matched in one block
+	MerkleBlock string `protobuf:"bytes,6,opt,name=merkle_block,json=merkleBlock,proto3" json:"merkle_block,omitempty"`
 }
 
 func (m *MsgSubmitDepositTransaction) Reset()         { *m = MsgSubmitDepositTransaction{} }
@@ -114,6 +119,13 @@ func (m *MsgSubmitDepositTransaction) GetProof() []string {
 	return nil
 }
 
+func (m *MsgSubmitDepositTransaction) GetMerkleBlock() string {
+	if m != nil {
+		return m.MerkleBlock
+	}
+	return ""
+}
+
 // MsgSubmitDepositTransactionResponse defines the Msg/SubmitDepositTransaction response type.
 type MsgSubmitDepositTransactionResponse struct {
 }
@@ -151,6 +163,325 @@ func (m *MsgSubmitDepositTransactionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitDepositTransactionResponse proto.InternalMessageInfo
 
+// MsgSubmitDepositTransactions defines the Msg/SubmitDepositTransactions request type.
+type MsgSubmitDepositTransactions struct {
+	// this is the relayer address who submits the bitcoin transactions to the bitway chain
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the blocks shared by the deposits
+	Blocks []DepositBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks"`
+	// the previous txs in base64 format shared by the deposits
+	// used for parsing the senders of the transactions
+	PrevTxs []string `protobuf:"bytes,3,rep,name=prev_txs,json=prevTxs,proto3" json:"prev_txs,omitempty"`
+	// the deposits referencing the shared blocks and previous txs
+	Deposits []DepositTransaction `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits"`
+}
+
+func (m *MsgSubmitDepositTransactions) Reset()         { *m = MsgSubmitDepositTransactions{} }
+func (m *MsgSubmitDepositTransactions) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDepositTransactions) ProtoMessage()    {}
+func (*MsgSubmitDepositTransactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{2}
+}
+func (m *MsgSubmitDepositTransactions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDepositTransactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDepositTransactions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDepositTransactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDepositTransactions.Merge(m, src)
+}
+func (m *MsgSubmitDepositTransactions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDepositTransactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDepositTransactions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDepositTransactions proto.InternalMessageInfo
+
+func (m *MsgSubmitDepositTransactions) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitDepositTransactions) GetBlocks() []DepositBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *MsgSubmitDepositTransactions) GetPrevTxs() []string {
+	if m != nil {
+		return m.PrevTxs
+	}
+	return nil
+}
+
+func (m *MsgSubmitDepositTransactions) GetDeposits() []DepositTransaction {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+// DepositBlock defines the block in which the batched deposits are included
+type DepositBlock struct {
+	Blockhash string `protobuf:"bytes,1,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	// the serialized BIP-37 merkle block in hex format, i.e. the output of gettxoutproof
+	// alternative to the per deposit proofs; the merkle block must match all the deposits referencing the block
+	MerkleBlock string `protobuf:"bytes,2,opt,name=merkle_block,json=merkleBlock,proto3" json:"merkle_block,omitempty"`
+}
+
+func (m *DepositBlock) Reset()         { *m = DepositBlock{} }
+func (m *DepositBlock) String() string { return proto.CompactTextString(m) }
+func (*DepositBlock) ProtoMessage()    {}
+func (*DepositBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{3}
+}
+func (m *DepositBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositBlock.Merge(m, src)
+}
+func (m *DepositBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositBlock proto.InternalMessageInfo
+
+func (m *DepositBlock) GetBlockhash() string {
+	if m != nil {
+		return m.Blockhash
+	}
+	return ""
+}
+
+func (m *DepositBlock) GetMerkleBlock() string {
+	if m != nil {
+		return m.MerkleBlock
+	}
+	return ""
+}
+
+// DepositTransaction defines the deposit in the batch
+type DepositTransaction struct {
+	// index of the shared block
+	BlockIndex uint32 `protobuf:"varint,1,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
+	// index of the shared previous tx
+	PrevTxIndex uint32 `protobuf:"varint,2,opt,name=prev_tx_index,json=prevTxIndex,proto3" json:"prev_tx_index,omitempty"`
+	// the tx bytes in base64 format
+	TxBytes string `protobuf:"bytes,3,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// the merkle proof; must be empty if the referenced block carries the merkle block
+	Proof []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *DepositTransaction) Reset()         { *m = DepositTransaction{} }
+func (m *DepositTransaction) String() string { return proto.CompactTextString(m) }
+func (*DepositTransaction) ProtoMessage()    {}
+func (*DepositTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{4}
+}
+func (m *DepositTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositTransaction.Merge(m, src)
+}
+func (m *DepositTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositTransaction proto.InternalMessageInfo
+
+func (m *DepositTransaction) GetBlockIndex() uint32 {
+	if m != nil {
+		return m.BlockIndex
+	}
+	return 0
+}
+
+func (m *DepositTransaction) GetPrevTxIndex() uint32 {
+	if m != nil {
+		return m.PrevTxIndex
+	}
+	return 0
+}
+
+func (m *DepositTransaction) GetTxBytes() string {
+	if m != nil {
+		return m.TxBytes
+	}
+	return ""
+}
+
+func (m *DepositTransaction) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// DepositResult defines the processing result of the deposit in the batch
+type DepositResult struct {
+	Txid    string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// the recipient of the deposit if succeeded
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the error message if failed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DepositResult) Reset()         { *m = DepositResult{} }
+func (m *DepositResult) String() string { return proto.CompactTextString(m) }
+func (*DepositResult) ProtoMessage()    {}
+func (*DepositResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{5}
+}
+func (m *DepositResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositResult.Merge(m, src)
+}
+func (m *DepositResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositResult proto.InternalMessageInfo
+
+func (m *DepositResult) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *DepositResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DepositResult) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DepositResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgSubmitDepositTransactionsResponse defines the Msg/SubmitDepositTransactions response type.
+type MsgSubmitDepositTransactionsResponse struct {
+	// results in the same order as the deposits
+	Results []DepositResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgSubmitDepositTransactionsResponse) Reset()         { *m = MsgSubmitDepositTransactionsResponse{} }
+func (m *MsgSubmitDepositTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDepositTransactionsResponse) ProtoMessage()    {}
+func (*MsgSubmitDepositTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{6}
+}
+func (m *MsgSubmitDepositTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDepositTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDepositTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDepositTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDepositTransactionsResponse.Merge(m, src)
+}
+func (m *MsgSubmitDepositTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDepositTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDepositTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDepositTransactionsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitDepositTransactionsResponse) GetResults() []DepositResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgSubmitWithdrawTransaction defines the Msg/SubmitWithdrawTransaction request type.
 type MsgSubmitWithdrawTransaction struct {
 	// this is the relayer address who submits the bitcoin transaction to the bitway chain
@@ -159,13 +490,17 @@ type MsgSubmitWithdrawTransaction struct {
 	// the tx bytes in base64 format
 	TxBytes string   `protobuf:"bytes,3,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Proof   []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the serialized BIP-37 merkle block in hex format, i.e. the output of gettxoutproof
+	// alternative to the proof; the blockhash can be omitted as the merkle block carries its own header
+	// the same merkle block can be shared by the transactions matched in one block
+	MerkleBlock string `protobuf:"bytes,5,opt,name=merkle_block,json=merkleBlock,proto3" json:"merkle_block,omitempty"`
 }
 
 func (m *MsgSubmitWithdrawTransaction) Reset()         { *m = MsgSubmitWithdrawTransaction{} }
 func (m *MsgSubmitWithdrawTransaction) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitWithdrawTransaction) ProtoMessage()    {}
 func (*MsgSubmitWithdrawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{2}
+	return fileDescriptor_2d8f879fc570c1be, []int{7}
 }
 func (m *MsgSubmitWithdrawTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,6 +557,13 @@ func (m *MsgSubmitWithdrawTransaction) GetProof() []string {
 	return nil
 }
 
+func (m *MsgSubmitWithdrawTransaction) GetMerkleBlock() string {
+	if m != nil {
+		return m.MerkleBlock
+	}
+	return ""
+}
+
 // MsgSubmitWithdrawTransactionResponse defines the Msg/SubmitWithdrawTransaction response type.
 type MsgSubmitWithdrawTransactionResponse struct {
 }
@@ -230,7 +572,7 @@ func (m *MsgSubmitWithdrawTransactionResponse) Reset()         { *m = MsgSubmitW
 func (m *MsgSubmitWithdrawTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitWithdrawTransactionResponse) ProtoMessage()    {}
 func (*MsgSubmitWithdrawTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{3}
+	return fileDescriptor_2d8f879fc570c1be, []int{8}
 }
 func (m *MsgSubmitWithdrawTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +611,7 @@ func (m *MsgSubmitFeeRate) Reset()         { *m = MsgSubmitFeeRate{} }
 func (m *MsgSubmitFeeRate) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitFeeRate) ProtoMessage()    {}
 func (*MsgSubmitFeeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{4}
+	return fileDescriptor_2d8f879fc570c1be, []int{9}
 }
 func (m *MsgSubmitFeeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +662,7 @@ func (m *MsgSubmitFeeRateResponse) Reset()         { *m = MsgSubmitFeeRateRespon
 func (m *MsgSubmitFeeRateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitFeeRateResponse) ProtoMessage()    {}
 func (*MsgSubmitFeeRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{5}
+	return fileDescriptor_2d8f879fc570c1be, []int{10}
 }
 func (m *MsgSubmitFeeRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,24 +691,24 @@ func (m *MsgSubmitFeeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitFeeRateResponse proto.InternalMessageInfo
 
-// MsgUpdateTrustedNonBtcRelayers defines the Msg/UpdateTrustedNonBtcRelayers request type.
-type MsgUpdateTrustedNonBtcRelayers struct {
-	Sender   string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Relayers []string `protobuf:"bytes,2,rep,name=relayers,proto3" json:"relayers,omitempty"`
+// MsgBondRelayer defines the Msg/BondRelayer request type.
+type MsgBondRelayer struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) Reset()         { *m = MsgUpdateTrustedNonBtcRelayers{} }
-func (m *MsgUpdateTrustedNonBtcRelayers) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTrustedNonBtcRelayers) ProtoMessage()    {}
-func (*MsgUpdateTrustedNonBtcRelayers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{6}
+func (m *MsgBondRelayer) Reset()         { *m = MsgBondRelayer{} }
+func (m *MsgBondRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgBondRelayer) ProtoMessage()    {}
+func (*MsgBondRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{11}
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) XXX_Unmarshal(b []byte) error {
+func (m *MsgBondRelayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBondRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTrustedNonBtcRelayers.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBondRelayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -376,50 +718,48 @@ func (m *MsgUpdateTrustedNonBtcRelayers) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTrustedNonBtcRelayers.Merge(m, src)
+func (m *MsgBondRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondRelayer.Merge(m, src)
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) XXX_Size() int {
+func (m *MsgBondRelayer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTrustedNonBtcRelayers.DiscardUnknown(m)
+func (m *MsgBondRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondRelayer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTrustedNonBtcRelayers proto.InternalMessageInfo
+var xxx_messageInfo_MsgBondRelayer proto.InternalMessageInfo
 
-func (m *MsgUpdateTrustedNonBtcRelayers) GetSender() string {
+func (m *MsgBondRelayer) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) GetRelayers() []string {
+func (m *MsgBondRelayer) GetAmount() types.Coin {
 	if m != nil {
-		return m.Relayers
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-// MsgUpdateTrustedNonBtcRelayersResponse defines the Msg/UpdateTrustedNonBtcRelayers response type.
-type MsgUpdateTrustedNonBtcRelayersResponse struct {
+// MsgBondRelayerResponse defines the Msg/BondRelayer response type.
+type MsgBondRelayerResponse struct {
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Reset() {
-	*m = MsgUpdateTrustedNonBtcRelayersResponse{}
-}
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTrustedNonBtcRelayersResponse) ProtoMessage()    {}
-func (*MsgUpdateTrustedNonBtcRelayersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{7}
+func (m *MsgBondRelayerResponse) Reset()         { *m = MsgBondRelayerResponse{} }
+func (m *MsgBondRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondRelayerResponse) ProtoMessage()    {}
+func (*MsgBondRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{12}
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBondRelayerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBondRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTrustedNonBtcRelayersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBondRelayerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -429,36 +769,35 @@ func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTrustedNonBtcRelayersResponse.Merge(m, src)
+func (m *MsgBondRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondRelayerResponse.Merge(m, src)
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_Size() int {
+func (m *MsgBondRelayerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTrustedNonBtcRelayersResponse.DiscardUnknown(m)
+func (m *MsgBondRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondRelayerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTrustedNonBtcRelayersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBondRelayerResponse proto.InternalMessageInfo
 
-// MsgUpdateTrustedFeeProviders defines the Msg/UpdateTrustedFeeProviders request type.
-type MsgUpdateTrustedFeeProviders struct {
-	Sender       string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	FeeProviders []string `protobuf:"bytes,2,rep,name=FeeProviders,proto3" json:"FeeProviders,omitempty"`
+// MsgUnbondRelayer defines the Msg/UnbondRelayer request type.
+type MsgUnbondRelayer struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgUpdateTrustedFeeProviders) Reset()         { *m = MsgUpdateTrustedFeeProviders{} }
-func (m *MsgUpdateTrustedFeeProviders) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTrustedFeeProviders) ProtoMessage()    {}
-func (*MsgUpdateTrustedFeeProviders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{8}
+func (m *MsgUnbondRelayer) Reset()         { *m = MsgUnbondRelayer{} }
+func (m *MsgUnbondRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondRelayer) ProtoMessage()    {}
+func (*MsgUnbondRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{13}
 }
-func (m *MsgUpdateTrustedFeeProviders) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnbondRelayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTrustedFeeProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnbondRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTrustedFeeProviders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnbondRelayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -468,48 +807,41 @@ func (m *MsgUpdateTrustedFeeProviders) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTrustedFeeProviders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTrustedFeeProviders.Merge(m, src)
+func (m *MsgUnbondRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondRelayer.Merge(m, src)
 }
-func (m *MsgUpdateTrustedFeeProviders) XXX_Size() int {
+func (m *MsgUnbondRelayer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTrustedFeeProviders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTrustedFeeProviders.DiscardUnknown(m)
+func (m *MsgUnbondRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondRelayer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTrustedFeeProviders proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnbondRelayer proto.InternalMessageInfo
 
-func (m *MsgUpdateTrustedFeeProviders) GetSender() string {
+func (m *MsgUnbondRelayer) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateTrustedFeeProviders) GetFeeProviders() []string {
-	if m != nil {
-		return m.FeeProviders
-	}
-	return nil
-}
-
-// MsgUpdateTrustedFeeProvidersResponse defines the Msg/UpdateTrustedFeeProviders response type.
-type MsgUpdateTrustedFeeProvidersResponse struct {
+// MsgUnbondRelayerResponse defines the Msg/UnbondRelayer response type.
+type MsgUnbondRelayerResponse struct {
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) Reset()         { *m = MsgUpdateTrustedFeeProvidersResponse{} }
-func (m *MsgUpdateTrustedFeeProvidersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTrustedFeeProvidersResponse) ProtoMessage()    {}
-func (*MsgUpdateTrustedFeeProvidersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{9}
+func (m *MsgUnbondRelayerResponse) Reset()         { *m = MsgUnbondRelayerResponse{} }
+func (m *MsgUnbondRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondRelayerResponse) ProtoMessage()    {}
+func (*MsgUnbondRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{14}
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnbondRelayerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnbondRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnbondRelayerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -519,37 +851,44 @@ func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.Merge(m, src)
+func (m *MsgUnbondRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondRelayerResponse.Merge(m, src)
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Size() int {
+func (m *MsgUnbondRelayerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.DiscardUnknown(m)
+func (m *MsgUnbondRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondRelayerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse proto.InternalMessageInfo
-
-// MsgWithdrawToBitcoin defines the Msg/WithdrawToBitcoin request type.
-type MsgWithdrawToBitcoin struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// withdraw amount in satoshi, etc: 100000000sat = 1btc
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-}
+var xxx_messageInfo_MsgUnbondRelayerResponse proto.InternalMessageInfo
 
-func (m *MsgWithdrawToBitcoin) Reset()         { *m = MsgWithdrawToBitcoin{} }
-func (m *MsgWithdrawToBitcoin) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawToBitcoin) ProtoMessage()    {}
-func (*MsgWithdrawToBitcoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{10}
+// MsgSlashRelayer defines the Msg/SlashRelayer request type.
+// It can only be executed by governance, which is responsible for adjudicating the misbehaviour off chain,
+// as the runes deposit validity can not be proven on chain.
+type MsgSlashRelayer struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// relayer address
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// hash of the deposit tx attested by the relayer
+	Txid string `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	// misbehaviour adjudicated by governance; recorded in the events only
+	Misbehaviour RelayerMisbehaviour `protobuf:"varint,4,opt,name=misbehaviour,proto3,enum=bitway.btcbridge.RelayerMisbehaviour" json:"misbehaviour,omitempty"`
+}
+
+func (m *MsgSlashRelayer) Reset()         { *m = MsgSlashRelayer{} }
+func (m *MsgSlashRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashRelayer) ProtoMessage()    {}
+func (*MsgSlashRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{15}
 }
-func (m *MsgWithdrawToBitcoin) XXX_Unmarshal(b []byte) error {
+func (m *MsgSlashRelayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawToBitcoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSlashRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawToBitcoin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSlashRelayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,48 +898,62 @@ func (m *MsgWithdrawToBitcoin) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawToBitcoin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawToBitcoin.Merge(m, src)
+func (m *MsgSlashRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashRelayer.Merge(m, src)
 }
-func (m *MsgWithdrawToBitcoin) XXX_Size() int {
+func (m *MsgSlashRelayer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawToBitcoin) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawToBitcoin.DiscardUnknown(m)
+func (m *MsgSlashRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashRelayer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawToBitcoin proto.InternalMessageInfo
+var xxx_messageInfo_MsgSlashRelayer proto.InternalMessageInfo
 
-func (m *MsgWithdrawToBitcoin) GetSender() string {
+func (m *MsgSlashRelayer) GetAuthority() string {
 	if m != nil {
-		return m.Sender
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgWithdrawToBitcoin) GetAmount() string {
+func (m *MsgSlashRelayer) GetRelayer() string {
 	if m != nil {
-		return m.Amount
+		return m.Relayer
 	}
 	return ""
 }
 
-// MsgWithdrawToBitcoinResponse defines the Msg/WithdrawToBitcoin response type.
-type MsgWithdrawToBitcoinResponse struct {
+func (m *MsgSlashRelayer) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
 }
 
-func (m *MsgWithdrawToBitcoinResponse) Reset()         { *m = MsgWithdrawToBitcoinResponse{} }
-func (m *MsgWithdrawToBitcoinResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawToBitcoinResponse) ProtoMessage()    {}
-func (*MsgWithdrawToBitcoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{11}
+func (m *MsgSlashRelayer) GetMisbehaviour() RelayerMisbehaviour {
+	if m != nil {
+		return m.Misbehaviour
+	}
+	return RelayerMisbehaviour_RELAYER_MISBEHAVIOUR_UNSPECIFIED
 }
-func (m *MsgWithdrawToBitcoinResponse) XXX_Unmarshal(b []byte) error {
+
+// MsgSlashRelayerResponse defines the Msg/SlashRelayer response type.
+type MsgSlashRelayerResponse struct {
+}
+
+func (m *MsgSlashRelayerResponse) Reset()         { *m = MsgSlashRelayerResponse{} }
+func (m *MsgSlashRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashRelayerResponse) ProtoMessage()    {}
+func (*MsgSlashRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{16}
+}
+func (m *MsgSlashRelayerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawToBitcoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSlashRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawToBitcoinResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSlashRelayerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -610,37 +963,38 @@ func (m *MsgWithdrawToBitcoinResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawToBitcoinResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawToBitcoinResponse.Merge(m, src)
+func (m *MsgSlashRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashRelayerResponse.Merge(m, src)
 }
-func (m *MsgWithdrawToBitcoinResponse) XXX_Size() int {
+func (m *MsgSlashRelayerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawToBitcoinResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawToBitcoinResponse.DiscardUnknown(m)
+func (m *MsgSlashRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashRelayerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawToBitcoinResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSlashRelayerResponse proto.InternalMessageInfo
 
-// MsgSubmitSignatures defines the Msg/SubmitSignatures request type.
-type MsgSubmitSignatures struct {
-	Sender     string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Txid       string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
-	Signatures []string `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+// MsgSubmitRuneEtching defines the Msg/SubmitRuneEtching request type.
+type MsgSubmitRuneEtching struct {
+	// relayer address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// rune etching data
+	Etching RuneEtching `protobuf:"bytes,2,opt,name=etching,proto3" json:"etching"`
 }
 
-func (m *MsgSubmitSignatures) Reset()         { *m = MsgSubmitSignatures{} }
-func (m *MsgSubmitSignatures) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSignatures) ProtoMessage()    {}
-func (*MsgSubmitSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{12}
+func (m *MsgSubmitRuneEtching) Reset()         { *m = MsgSubmitRuneEtching{} }
+func (m *MsgSubmitRuneEtching) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitRuneEtching) ProtoMessage()    {}
+func (*MsgSubmitRuneEtching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{17}
 }
-func (m *MsgSubmitSignatures) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitRuneEtching) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitRuneEtching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSignatures.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitRuneEtching.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,55 +1004,48 @@ func (m *MsgSubmitSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSignatures) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSignatures.Merge(m, src)
+func (m *MsgSubmitRuneEtching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitRuneEtching.Merge(m, src)
 }
-func (m *MsgSubmitSignatures) XXX_Size() int {
+func (m *MsgSubmitRuneEtching) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSignatures) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSignatures.DiscardUnknown(m)
+func (m *MsgSubmitRuneEtching) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitRuneEtching.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSignatures proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitRuneEtching proto.InternalMessageInfo
 
-func (m *MsgSubmitSignatures) GetSender() string {
+func (m *MsgSubmitRuneEtching) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgSubmitSignatures) GetTxid() string {
-	if m != nil {
-		return m.Txid
-	}
-	return ""
-}
-
-func (m *MsgSubmitSignatures) GetSignatures() []string {
+func (m *MsgSubmitRuneEtching) GetEtching() RuneEtching {
 	if m != nil {
-		return m.Signatures
+		return m.Etching
 	}
-	return nil
+	return RuneEtching{}
 }
 
-// MsgSubmitSignaturesResponse defines the Msg/SubmitSignatures response type.
-type MsgSubmitSignaturesResponse struct {
+// MsgSubmitRuneEtchingResponse defines the Msg/SubmitRuneEtching response type.
+type MsgSubmitRuneEtchingResponse struct {
 }
 
-func (m *MsgSubmitSignaturesResponse) Reset()         { *m = MsgSubmitSignaturesResponse{} }
-func (m *MsgSubmitSignaturesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSignaturesResponse) ProtoMessage()    {}
-func (*MsgSubmitSignaturesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{13}
+func (m *MsgSubmitRuneEtchingResponse) Reset()         { *m = MsgSubmitRuneEtchingResponse{} }
+func (m *MsgSubmitRuneEtchingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitRuneEtchingResponse) ProtoMessage()    {}
+func (*MsgSubmitRuneEtchingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{18}
 }
-func (m *MsgSubmitSignaturesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitRuneEtchingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitRuneEtchingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSignaturesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitRuneEtchingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -708,42 +1055,36 @@ func (m *MsgSubmitSignaturesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSignaturesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSignaturesResponse.Merge(m, src)
+func (m *MsgSubmitRuneEtchingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitRuneEtchingResponse.Merge(m, src)
 }
-func (m *MsgSubmitSignaturesResponse) XXX_Size() int {
+func (m *MsgSubmitRuneEtchingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSignaturesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSignaturesResponse.DiscardUnknown(m)
+func (m *MsgSubmitRuneEtchingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitRuneEtchingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSignaturesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitRuneEtchingResponse proto.InternalMessageInfo
 
-// MsgConsolidateVaults is the Msg/ConsolidateVaults request type.
-type MsgConsolidateVaults struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// vault version
-	VaultVersion uint64 `protobuf:"varint,2,opt,name=vault_version,json=vaultVersion,proto3" json:"vault_version,omitempty"`
-	// btc consolidation
-	BtcConsolidation *BtcConsolidation `protobuf:"bytes,3,opt,name=btc_consolidation,json=btcConsolidation,proto3" json:"btc_consolidation,omitempty"`
-	// runes consolidations
-	RunesConsolidations []*RunesConsolidation `protobuf:"bytes,4,rep,name=runes_consolidations,json=runesConsolidations,proto3" json:"runes_consolidations,omitempty"`
+// MsgUpdateTrustedFeeProviders defines the Msg/UpdateTrustedFeeProviders request type.
+type MsgUpdateTrustedFeeProviders struct {
+	Sender       string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	FeeProviders []string `protobuf:"bytes,2,rep,name=FeeProviders,proto3" json:"FeeProviders,omitempty"`
 }
 
-func (m *MsgConsolidateVaults) Reset()         { *m = MsgConsolidateVaults{} }
-func (m *MsgConsolidateVaults) String() string { return proto.CompactTextString(m) }
-func (*MsgConsolidateVaults) ProtoMessage()    {}
-func (*MsgConsolidateVaults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{14}
+func (m *MsgUpdateTrustedFeeProviders) Reset()         { *m = MsgUpdateTrustedFeeProviders{} }
+func (m *MsgUpdateTrustedFeeProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustedFeeProviders) ProtoMessage()    {}
+func (*MsgUpdateTrustedFeeProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{19}
 }
-func (m *MsgConsolidateVaults) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateTrustedFeeProviders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgConsolidateVaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTrustedFeeProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgConsolidateVaults.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTrustedFeeProviders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,62 +1094,48 @@ func (m *MsgConsolidateVaults) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgConsolidateVaults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgConsolidateVaults.Merge(m, src)
+func (m *MsgUpdateTrustedFeeProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustedFeeProviders.Merge(m, src)
 }
-func (m *MsgConsolidateVaults) XXX_Size() int {
+func (m *MsgUpdateTrustedFeeProviders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgConsolidateVaults) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgConsolidateVaults.DiscardUnknown(m)
+func (m *MsgUpdateTrustedFeeProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustedFeeProviders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgConsolidateVaults proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTrustedFeeProviders proto.InternalMessageInfo
 
-func (m *MsgConsolidateVaults) GetAuthority() string {
+func (m *MsgUpdateTrustedFeeProviders) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgConsolidateVaults) GetVaultVersion() uint64 {
-	if m != nil {
-		return m.VaultVersion
-	}
-	return 0
-}
-
-func (m *MsgConsolidateVaults) GetBtcConsolidation() *BtcConsolidation {
-	if m != nil {
-		return m.BtcConsolidation
-	}
-	return nil
-}
-
-func (m *MsgConsolidateVaults) GetRunesConsolidations() []*RunesConsolidation {
+func (m *MsgUpdateTrustedFeeProviders) GetFeeProviders() []string {
 	if m != nil {
-		return m.RunesConsolidations
+		return m.FeeProviders
 	}
 	return nil
 }
 
-// MsgConsolidateVaultsResponse defines the Msg/ConsolidateVaults response type.
-type MsgConsolidateVaultsResponse struct {
+// MsgUpdateTrustedFeeProvidersResponse defines the Msg/UpdateTrustedFeeProviders response type.
+type MsgUpdateTrustedFeeProvidersResponse struct {
 }
 
-func (m *MsgConsolidateVaultsResponse) Reset()         { *m = MsgConsolidateVaultsResponse{} }
-func (m *MsgConsolidateVaultsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgConsolidateVaultsResponse) ProtoMessage()    {}
-func (*MsgConsolidateVaultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{15}
+func (m *MsgUpdateTrustedFeeProvidersResponse) Reset()         { *m = MsgUpdateTrustedFeeProvidersResponse{} }
+func (m *MsgUpdateTrustedFeeProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustedFeeProvidersResponse) ProtoMessage()    {}
+func (*MsgUpdateTrustedFeeProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{20}
 }
-func (m *MsgConsolidateVaultsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgConsolidateVaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgConsolidateVaultsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,46 +1145,37 @@ func (m *MsgConsolidateVaultsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgConsolidateVaultsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgConsolidateVaultsResponse.Merge(m, src)
+func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.Merge(m, src)
 }
-func (m *MsgConsolidateVaultsResponse) XXX_Size() int {
+func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgConsolidateVaultsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgConsolidateVaultsResponse.DiscardUnknown(m)
+func (m *MsgUpdateTrustedFeeProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgConsolidateVaultsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTrustedFeeProvidersResponse proto.InternalMessageInfo
 
-// MsgInitiateDKG is the Msg/InitiateDKG request type.
-type MsgInitiateDKG struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// expected participant set
-	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	// threshold required to perform DKG
-	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// asset types of vaults to be generated
-	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=bitway.btcbridge.AssetType" json:"vault_types,omitempty"`
-	// indicates if transferring the current vaults to the newly generated vaults when the DKG request is completed
-	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
-	// target number of the UTXOs to be transferred each time
-	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
+// MsgWithdrawToBitcoin defines the Msg/WithdrawToBitcoin request type.
+type MsgWithdrawToBitcoin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// withdraw amount in satoshi, etc: 100000000sat = 1btc
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgInitiateDKG) Reset()         { *m = MsgInitiateDKG{} }
-func (m *MsgInitiateDKG) String() string { return proto.CompactTextString(m) }
-func (*MsgInitiateDKG) ProtoMessage()    {}
-func (*MsgInitiateDKG) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{16}
+func (m *MsgWithdrawToBitcoin) Reset()         { *m = MsgWithdrawToBitcoin{} }
+func (m *MsgWithdrawToBitcoin) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawToBitcoin) ProtoMessage()    {}
+func (*MsgWithdrawToBitcoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{21}
 }
-func (m *MsgInitiateDKG) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawToBitcoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInitiateDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawToBitcoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInitiateDKG.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawToBitcoin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,76 +1185,48 @@ func (m *MsgInitiateDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgInitiateDKG) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInitiateDKG.Merge(m, src)
+func (m *MsgWithdrawToBitcoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawToBitcoin.Merge(m, src)
 }
-func (m *MsgInitiateDKG) XXX_Size() int {
+func (m *MsgWithdrawToBitcoin) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInitiateDKG) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInitiateDKG.DiscardUnknown(m)
+func (m *MsgWithdrawToBitcoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawToBitcoin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInitiateDKG proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawToBitcoin proto.InternalMessageInfo
 
-func (m *MsgInitiateDKG) GetAuthority() string {
+func (m *MsgWithdrawToBitcoin) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgInitiateDKG) GetParticipants() []*DKGParticipant {
-	if m != nil {
-		return m.Participants
-	}
-	return nil
-}
-
-func (m *MsgInitiateDKG) GetThreshold() uint32 {
-	if m != nil {
-		return m.Threshold
-	}
-	return 0
-}
-
-func (m *MsgInitiateDKG) GetVaultTypes() []AssetType {
-	if m != nil {
-		return m.VaultTypes
-	}
-	return nil
-}
-
-func (m *MsgInitiateDKG) GetEnableTransfer() bool {
-	if m != nil {
-		return m.EnableTransfer
-	}
-	return false
-}
-
-func (m *MsgInitiateDKG) GetTargetUtxoNum() uint32 {
+func (m *MsgWithdrawToBitcoin) GetAmount() string {
 	if m != nil {
-		return m.TargetUtxoNum
+		return m.Amount
 	}
-	return 0
+	return ""
 }
 
-// MsgInitiateDKGResponse defines the Msg/InitiateDKG response type.
-type MsgInitiateDKGResponse struct {
+// MsgWithdrawToBitcoinResponse defines the Msg/WithdrawToBitcoin response type.
+type MsgWithdrawToBitcoinResponse struct {
 }
 
-func (m *MsgInitiateDKGResponse) Reset()         { *m = MsgInitiateDKGResponse{} }
-func (m *MsgInitiateDKGResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInitiateDKGResponse) ProtoMessage()    {}
-func (*MsgInitiateDKGResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{17}
+func (m *MsgWithdrawToBitcoinResponse) Reset()         { *m = MsgWithdrawToBitcoinResponse{} }
+func (m *MsgWithdrawToBitcoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawToBitcoinResponse) ProtoMessage()    {}
+func (*MsgWithdrawToBitcoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{22}
 }
-func (m *MsgInitiateDKGResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawToBitcoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInitiateDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawToBitcoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInitiateDKGResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawToBitcoinResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -946,44 +1236,36 @@ func (m *MsgInitiateDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgInitiateDKGResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInitiateDKGResponse.Merge(m, src)
+func (m *MsgWithdrawToBitcoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawToBitcoinResponse.Merge(m, src)
 }
-func (m *MsgInitiateDKGResponse) XXX_Size() int {
+func (m *MsgWithdrawToBitcoinResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInitiateDKGResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInitiateDKGResponse.DiscardUnknown(m)
+func (m *MsgWithdrawToBitcoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawToBitcoinResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInitiateDKGResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawToBitcoinResponse proto.InternalMessageInfo
 
-// MsgCompleteDKG is the Msg/CompleteDKG request type.
-type MsgCompleteDKG struct {
-	// the sender
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// DKG request id
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// new vaults generated by DKG
-	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+// MsgMultiWithdrawToBitcoin defines the Msg/MultiWithdrawToBitcoin request type.
+type MsgMultiWithdrawToBitcoin struct {
+	Sender  string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Outputs []WithdrawOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
 }
 
-func (m *MsgCompleteDKG) Reset()         { *m = MsgCompleteDKG{} }
-func (m *MsgCompleteDKG) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteDKG) ProtoMessage()    {}
-func (*MsgCompleteDKG) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{18}
+func (m *MsgMultiWithdrawToBitcoin) Reset()         { *m = MsgMultiWithdrawToBitcoin{} }
+func (m *MsgMultiWithdrawToBitcoin) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiWithdrawToBitcoin) ProtoMessage()    {}
+func (*MsgMultiWithdrawToBitcoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{23}
 }
-func (m *MsgCompleteDKG) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiWithdrawToBitcoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiWithdrawToBitcoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteDKG.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiWithdrawToBitcoin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -993,69 +1275,105 @@ func (m *MsgCompleteDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteDKG) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteDKG.Merge(m, src)
+func (m *MsgMultiWithdrawToBitcoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiWithdrawToBitcoin.Merge(m, src)
 }
-func (m *MsgCompleteDKG) XXX_Size() int {
+func (m *MsgMultiWithdrawToBitcoin) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteDKG) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteDKG.DiscardUnknown(m)
+func (m *MsgMultiWithdrawToBitcoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiWithdrawToBitcoin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteDKG proto.InternalMessageInfo
+var xxx_messageInfo_MsgMultiWithdrawToBitcoin proto.InternalMessageInfo
 
-func (m *MsgCompleteDKG) GetSender() string {
+func (m *MsgMultiWithdrawToBitcoin) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgCompleteDKG) GetId() uint64 {
+func (m *MsgMultiWithdrawToBitcoin) GetOutputs() []WithdrawOutput {
 	if m != nil {
-		return m.Id
+		return m.Outputs
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgCompleteDKG) GetVaults() []string {
-	if m != nil {
-		return m.Vaults
+// WithdrawOutput defines the recipient and amount of the withdrawal
+type WithdrawOutput struct {
+	// bitcoin address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw amount in satoshi, etc: 100000000sat = 1btc
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *WithdrawOutput) Reset()         { *m = WithdrawOutput{} }
+func (m *WithdrawOutput) String() string { return proto.CompactTextString(m) }
+func (*WithdrawOutput) ProtoMessage()    {}
+func (*WithdrawOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{24}
+}
+func (m *WithdrawOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *WithdrawOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawOutput.Merge(m, src)
+}
+func (m *WithdrawOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawOutput.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteDKG) GetConsensusPubkey() string {
+var xxx_messageInfo_WithdrawOutput proto.InternalMessageInfo
+
+func (m *WithdrawOutput) GetAddress() string {
 	if m != nil {
-		return m.ConsensusPubkey
+		return m.Address
 	}
 	return ""
 }
 
-func (m *MsgCompleteDKG) GetSignature() string {
+func (m *WithdrawOutput) GetAmount() string {
 	if m != nil {
-		return m.Signature
+		return m.Amount
 	}
 	return ""
 }
 
-// MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
-type MsgCompleteDKGResponse struct {
+// MsgMultiWithdrawToBitcoinResponse defines the Msg/MultiWithdrawToBitcoin response type.
+type MsgMultiWithdrawToBitcoinResponse struct {
+	// sequence group of the withdrawal requests
+	Group uint64 `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (m *MsgCompleteDKGResponse) Reset()         { *m = MsgCompleteDKGResponse{} }
-func (m *MsgCompleteDKGResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteDKGResponse) ProtoMessage()    {}
-func (*MsgCompleteDKGResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{19}
+func (m *MsgMultiWithdrawToBitcoinResponse) Reset()         { *m = MsgMultiWithdrawToBitcoinResponse{} }
+func (m *MsgMultiWithdrawToBitcoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiWithdrawToBitcoinResponse) ProtoMessage()    {}
+func (*MsgMultiWithdrawToBitcoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{25}
 }
-func (m *MsgCompleteDKGResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiWithdrawToBitcoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiWithdrawToBitcoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteDKGResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiWithdrawToBitcoinResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1065,42 +1383,44 @@ func (m *MsgCompleteDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteDKGResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteDKGResponse.Merge(m, src)
+func (m *MsgMultiWithdrawToBitcoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiWithdrawToBitcoinResponse.Merge(m, src)
 }
-func (m *MsgCompleteDKGResponse) XXX_Size() int {
+func (m *MsgMultiWithdrawToBitcoinResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteDKGResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteDKGResponse.DiscardUnknown(m)
+func (m *MsgMultiWithdrawToBitcoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiWithdrawToBitcoinResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteDKGResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMultiWithdrawToBitcoinResponse proto.InternalMessageInfo
 
-// MsgRefresh defines the Msg/Refresh request type.
-type MsgRefresh struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// list of DKGs corresponding to key shares to be refreshed
-	DkgIds []uint64 `protobuf:"varint,2,rep,packed,name=dkg_ids,json=dkgIds,proto3" json:"dkg_ids,omitempty"`
-	// removed participant set
-	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
-	// timeout duration per DKG refreshing
-	TimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+func (m *MsgMultiWithdrawToBitcoinResponse) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
+	}
+	return 0
 }
 
-func (m *MsgRefresh) Reset()         { *m = MsgRefresh{} }
-func (m *MsgRefresh) String() string { return proto.CompactTextString(m) }
-func (*MsgRefresh) ProtoMessage()    {}
-func (*MsgRefresh) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{20}
+// MsgSubmitSignatures defines the Msg/SubmitSignatures request type.
+type MsgSubmitSignatures struct {
+	Sender     string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Txid       string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Signatures []string `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
-func (m *MsgRefresh) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgSubmitSignatures) Reset()         { *m = MsgSubmitSignatures{} }
+func (m *MsgSubmitSignatures) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignatures) ProtoMessage()    {}
+func (*MsgSubmitSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{26}
+}
+func (m *MsgSubmitSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefresh.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitSignatures.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1110,62 +1430,55 @@ func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgRefresh) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefresh.Merge(m, src)
+func (m *MsgSubmitSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignatures.Merge(m, src)
 }
-func (m *MsgRefresh) XXX_Size() int {
+func (m *MsgSubmitSignatures) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefresh) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefresh.DiscardUnknown(m)
+func (m *MsgSubmitSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignatures.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefresh proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitSignatures proto.InternalMessageInfo
 
-func (m *MsgRefresh) GetAuthority() string {
+func (m *MsgSubmitSignatures) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRefresh) GetDkgIds() []uint64 {
+func (m *MsgSubmitSignatures) GetTxid() string {
 	if m != nil {
-		return m.DkgIds
+		return m.Txid
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgRefresh) GetRemovedParticipants() []string {
+func (m *MsgSubmitSignatures) GetSignatures() []string {
 	if m != nil {
-		return m.RemovedParticipants
+		return m.Signatures
 	}
 	return nil
 }
 
-func (m *MsgRefresh) GetTimeoutDuration() time.Duration {
-	if m != nil {
-		return m.TimeoutDuration
-	}
-	return 0
-}
-
-// MsgRefreshResponse defines the Msg/Refresh response type.
-type MsgRefreshResponse struct {
+// MsgSubmitSignaturesResponse defines the Msg/SubmitSignatures response type.
+type MsgSubmitSignaturesResponse struct {
 }
 
-func (m *MsgRefreshResponse) Reset()         { *m = MsgRefreshResponse{} }
-func (m *MsgRefreshResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRefreshResponse) ProtoMessage()    {}
-func (*MsgRefreshResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{21}
+func (m *MsgSubmitSignaturesResponse) Reset()         { *m = MsgSubmitSignaturesResponse{} }
+func (m *MsgSubmitSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignaturesResponse) ProtoMessage()    {}
+func (*MsgSubmitSignaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{27}
 }
-func (m *MsgRefreshResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefreshResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitSignaturesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1175,42 +1488,42 @@ func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRefreshResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefreshResponse.Merge(m, src)
+func (m *MsgSubmitSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignaturesResponse.Merge(m, src)
 }
-func (m *MsgRefreshResponse) XXX_Size() int {
+func (m *MsgSubmitSignaturesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefreshResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefreshResponse.DiscardUnknown(m)
+func (m *MsgSubmitSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignaturesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefreshResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitSignaturesResponse proto.InternalMessageInfo
 
-// MsgCompleteRefreshing defines the Msg/CompleteRefreshing request type.
-type MsgCompleteRefreshing struct {
-	// sender
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// request id
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+// MsgConsolidateVaults is the Msg/ConsolidateVaults request type.
+type MsgConsolidateVaults struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault version
+	VaultVersion uint64 `protobuf:"varint,2,opt,name=vault_version,json=vaultVersion,proto3" json:"vault_version,omitempty"`
+	// btc consolidation
+	BtcConsolidation *BtcConsolidation `protobuf:"bytes,3,opt,name=btc_consolidation,json=btcConsolidation,proto3" json:"btc_consolidation,omitempty"`
+	// runes consolidations
+	RunesConsolidations []*RunesConsolidation `protobuf:"bytes,4,rep,name=runes_consolidations,json=runesConsolidations,proto3" json:"runes_consolidations,omitempty"`
 }
 
-func (m *MsgCompleteRefreshing) Reset()         { *m = MsgCompleteRefreshing{} }
-func (m *MsgCompleteRefreshing) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteRefreshing) ProtoMessage()    {}
-func (*MsgCompleteRefreshing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{22}
+func (m *MsgConsolidateVaults) Reset()         { *m = MsgConsolidateVaults{} }
+func (m *MsgConsolidateVaults) String() string { return proto.CompactTextString(m) }
+func (*MsgConsolidateVaults) ProtoMessage()    {}
+func (*MsgConsolidateVaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{28}
 }
-func (m *MsgCompleteRefreshing) XXX_Unmarshal(b []byte) error {
+func (m *MsgConsolidateVaults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConsolidateVaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteRefreshing.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConsolidateVaults.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,62 +1533,62 @@ func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteRefreshing) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteRefreshing.Merge(m, src)
+func (m *MsgConsolidateVaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsolidateVaults.Merge(m, src)
 }
-func (m *MsgCompleteRefreshing) XXX_Size() int {
+func (m *MsgConsolidateVaults) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteRefreshing) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteRefreshing.DiscardUnknown(m)
+func (m *MsgConsolidateVaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsolidateVaults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteRefreshing proto.InternalMessageInfo
+var xxx_messageInfo_MsgConsolidateVaults proto.InternalMessageInfo
 
-func (m *MsgCompleteRefreshing) GetSender() string {
+func (m *MsgConsolidateVaults) GetAuthority() string {
 	if m != nil {
-		return m.Sender
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCompleteRefreshing) GetId() uint64 {
+func (m *MsgConsolidateVaults) GetVaultVersion() uint64 {
 	if m != nil {
-		return m.Id
+		return m.VaultVersion
 	}
 	return 0
 }
 
-func (m *MsgCompleteRefreshing) GetConsensusPubkey() string {
+func (m *MsgConsolidateVaults) GetBtcConsolidation() *BtcConsolidation {
 	if m != nil {
-		return m.ConsensusPubkey
+		return m.BtcConsolidation
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCompleteRefreshing) GetSignature() string {
+func (m *MsgConsolidateVaults) GetRunesConsolidations() []*RunesConsolidation {
 	if m != nil {
-		return m.Signature
+		return m.RunesConsolidations
 	}
-	return ""
+	return nil
 }
 
-// MsgCompleteRefreshingResponse defines the Msg/CompleteRefreshing response type.
-type MsgCompleteRefreshingResponse struct {
+// MsgConsolidateVaultsResponse defines the Msg/ConsolidateVaults response type.
+type MsgConsolidateVaultsResponse struct {
 }
 
-func (m *MsgCompleteRefreshingResponse) Reset()         { *m = MsgCompleteRefreshingResponse{} }
-func (m *MsgCompleteRefreshingResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteRefreshingResponse) ProtoMessage()    {}
-func (*MsgCompleteRefreshingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{23}
+func (m *MsgConsolidateVaultsResponse) Reset()         { *m = MsgConsolidateVaultsResponse{} }
+func (m *MsgConsolidateVaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConsolidateVaultsResponse) ProtoMessage()    {}
+func (*MsgConsolidateVaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{29}
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgConsolidateVaultsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConsolidateVaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteRefreshingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConsolidateVaultsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1285,46 +1598,46 @@ func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteRefreshingResponse.Merge(m, src)
+func (m *MsgConsolidateVaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsolidateVaultsResponse.Merge(m, src)
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Size() int {
+func (m *MsgConsolidateVaultsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteRefreshingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteRefreshingResponse.DiscardUnknown(m)
+func (m *MsgConsolidateVaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsolidateVaultsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteRefreshingResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgConsolidateVaultsResponse proto.InternalMessageInfo
 
-// MsgTransferVault is the Msg/TransferVault request type.
-type MsgTransferVault struct {
+// MsgInitiateDKG is the Msg/InitiateDKG request type.
+type MsgInitiateDKG struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// version of the source vault
-	SourceVersion uint64 `protobuf:"varint,2,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
-	// version of the destination vault
-	DestVersion uint64 `protobuf:"varint,3,opt,name=dest_version,json=destVersion,proto3" json:"dest_version,omitempty"`
-	// asset type
-	AssetType AssetType `protobuf:"varint,4,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
-	// a set of optional pre-built PSBTs to perform the asset transfer
-	Psbts []string `protobuf:"bytes,5,rep,name=psbts,proto3" json:"psbts,omitempty"`
-	// target number of the UTXOs to be transferred; only take effect when psbt not provided
+	// expected participant set
+	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold required to perform DKG
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// asset types of vaults to be generated
+	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=bitway.btcbridge.AssetType" json:"vault_types,omitempty"`
+	// indicates if transferring the current vaults to the newly generated vaults when the DKG request is completed
+	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
+	// target number of the UTXOs to be transferred each time
 	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
 }
 
-func (m *MsgTransferVault) Reset()         { *m = MsgTransferVault{} }
-func (m *MsgTransferVault) String() string { return proto.CompactTextString(m) }
-func (*MsgTransferVault) ProtoMessage()    {}
-func (*MsgTransferVault) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{24}
+func (m *MsgInitiateDKG) Reset()         { *m = MsgInitiateDKG{} }
+func (m *MsgInitiateDKG) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDKG) ProtoMessage()    {}
+func (*MsgInitiateDKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{30}
 }
-func (m *MsgTransferVault) XXX_Unmarshal(b []byte) error {
+func (m *MsgInitiateDKG) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTransferVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInitiateDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTransferVault.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInitiateDKG.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1334,76 +1647,76 @@ func (m *MsgTransferVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgTransferVault) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTransferVault.Merge(m, src)
+func (m *MsgInitiateDKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDKG.Merge(m, src)
 }
-func (m *MsgTransferVault) XXX_Size() int {
+func (m *MsgInitiateDKG) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTransferVault) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTransferVault.DiscardUnknown(m)
+func (m *MsgInitiateDKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDKG.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTransferVault proto.InternalMessageInfo
+var xxx_messageInfo_MsgInitiateDKG proto.InternalMessageInfo
 
-func (m *MsgTransferVault) GetAuthority() string {
+func (m *MsgInitiateDKG) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgTransferVault) GetSourceVersion() uint64 {
+func (m *MsgInitiateDKG) GetParticipants() []*DKGParticipant {
 	if m != nil {
-		return m.SourceVersion
+		return m.Participants
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgTransferVault) GetDestVersion() uint64 {
+func (m *MsgInitiateDKG) GetThreshold() uint32 {
 	if m != nil {
-		return m.DestVersion
+		return m.Threshold
 	}
 	return 0
 }
 
-func (m *MsgTransferVault) GetAssetType() AssetType {
+func (m *MsgInitiateDKG) GetVaultTypes() []AssetType {
 	if m != nil {
-		return m.AssetType
+		return m.VaultTypes
 	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (m *MsgTransferVault) GetPsbts() []string {
+func (m *MsgInitiateDKG) GetEnableTransfer() bool {
 	if m != nil {
-		return m.Psbts
+		return m.EnableTransfer
 	}
-	return nil
+	return false
 }
 
-func (m *MsgTransferVault) GetTargetUtxoNum() uint32 {
+func (m *MsgInitiateDKG) GetTargetUtxoNum() uint32 {
 	if m != nil {
 		return m.TargetUtxoNum
 	}
 	return 0
 }
 
-// MsgTransferVaultResponse defines the Msg/TransferVault response type.
-type MsgTransferVaultResponse struct {
+// MsgInitiateDKGResponse defines the Msg/InitiateDKG response type.
+type MsgInitiateDKGResponse struct {
 }
 
-func (m *MsgTransferVaultResponse) Reset()         { *m = MsgTransferVaultResponse{} }
-func (m *MsgTransferVaultResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgTransferVaultResponse) ProtoMessage()    {}
-func (*MsgTransferVaultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{25}
+func (m *MsgInitiateDKGResponse) Reset()         { *m = MsgInitiateDKGResponse{} }
+func (m *MsgInitiateDKGResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDKGResponse) ProtoMessage()    {}
+func (*MsgInitiateDKGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{31}
 }
-func (m *MsgTransferVaultResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgInitiateDKGResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTransferVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInitiateDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTransferVaultResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInitiateDKGResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1413,42 +1726,48 @@ func (m *MsgTransferVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgTransferVaultResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTransferVaultResponse.Merge(m, src)
+func (m *MsgInitiateDKGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDKGResponse.Merge(m, src)
 }
-func (m *MsgTransferVaultResponse) XXX_Size() int {
+func (m *MsgInitiateDKGResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTransferVaultResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTransferVaultResponse.DiscardUnknown(m)
+func (m *MsgInitiateDKGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDKGResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTransferVaultResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgInitiateDKGResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/btcbridge parameters to be updated.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgCompleteDKG is the Msg/CompleteDKG request type.
+type MsgCompleteDKG struct {
+	// the sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// DKG request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// new vaults generated by DKG
+	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// x-only internal keys of the new vaults; required if the recovery is enabled
+	InternalKeys []string `protobuf:"bytes,6,rep,name=internal_keys,json=internalKeys,proto3" json:"internal_keys,omitempty"`
+	// hex encoded compressed pub keys of the new vaults; required for the segwit v0 vaults
+	PubKeys []string `protobuf:"bytes,7,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{26}
+func (m *MsgCompleteDKG) Reset()         { *m = MsgCompleteDKG{} }
+func (m *MsgCompleteDKG) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteDKG) ProtoMessage()    {}
+func (*MsgCompleteDKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{32}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCompleteDKG) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteDKG.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1458,50 +1777,83 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCompleteDKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteDKG.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCompleteDKG) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCompleteDKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteDKG.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCompleteDKG proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCompleteDKG) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCompleteDKG) GetId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.Id
 	}
-	return Params{}
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgCompleteDKG) GetVaults() []string {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d8f879fc570c1be, []int{27}
+func (m *MsgCompleteDKG) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCompleteDKG) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *MsgCompleteDKG) GetInternalKeys() []string {
+	if m != nil {
+		return m.InternalKeys
+	}
+	return nil
+}
+
+func (m *MsgCompleteDKG) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
+type MsgCompleteDKGResponse struct {
+}
+
+func (m *MsgCompleteDKGResponse) Reset()         { *m = MsgCompleteDKGResponse{} }
+func (m *MsgCompleteDKGResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteDKGResponse) ProtoMessage()    {}
+func (*MsgCompleteDKGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{33}
+}
+func (m *MsgCompleteDKGResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteDKGResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1511,2254 +1863,7941 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCompleteDKGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteDKGResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCompleteDKGResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCompleteDKGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteDKGResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCompleteDKGResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSubmitDepositTransaction)(nil), "bitway.btcbridge.MsgSubmitDepositTransaction")
-	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "bitway.btcbridge.MsgSubmitDepositTransactionResponse")
-	proto.RegisterType((*MsgSubmitWithdrawTransaction)(nil), "bitway.btcbridge.MsgSubmitWithdrawTransaction")
-	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "bitway.btcbridge.MsgSubmitWithdrawTransactionResponse")
-	proto.RegisterType((*MsgSubmitFeeRate)(nil), "bitway.btcbridge.MsgSubmitFeeRate")
-	proto.RegisterType((*MsgSubmitFeeRateResponse)(nil), "bitway.btcbridge.MsgSubmitFeeRateResponse")
-	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayers)(nil), "bitway.btcbridge.MsgUpdateTrustedNonBtcRelayers")
-	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayersResponse)(nil), "bitway.btcbridge.MsgUpdateTrustedNonBtcRelayersResponse")
-	proto.RegisterType((*MsgUpdateTrustedFeeProviders)(nil), "bitway.btcbridge.MsgUpdateTrustedFeeProviders")
-	proto.RegisterType((*MsgUpdateTrustedFeeProvidersResponse)(nil), "bitway.btcbridge.MsgUpdateTrustedFeeProvidersResponse")
-	proto.RegisterType((*MsgWithdrawToBitcoin)(nil), "bitway.btcbridge.MsgWithdrawToBitcoin")
-	proto.RegisterType((*MsgWithdrawToBitcoinResponse)(nil), "bitway.btcbridge.MsgWithdrawToBitcoinResponse")
-	proto.RegisterType((*MsgSubmitSignatures)(nil), "bitway.btcbridge.MsgSubmitSignatures")
-	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "bitway.btcbridge.MsgSubmitSignaturesResponse")
-	proto.RegisterType((*MsgConsolidateVaults)(nil), "bitway.btcbridge.MsgConsolidateVaults")
-	proto.RegisterType((*MsgConsolidateVaultsResponse)(nil), "bitway.btcbridge.MsgConsolidateVaultsResponse")
-	proto.RegisterType((*MsgInitiateDKG)(nil), "bitway.btcbridge.MsgInitiateDKG")
-	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "bitway.btcbridge.MsgInitiateDKGResponse")
-	proto.RegisterType((*MsgCompleteDKG)(nil), "bitway.btcbridge.MsgCompleteDKG")
-	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "bitway.btcbridge.MsgCompleteDKGResponse")
-	proto.RegisterType((*MsgRefresh)(nil), "bitway.btcbridge.MsgRefresh")
-	proto.RegisterType((*MsgRefreshResponse)(nil), "bitway.btcbridge.MsgRefreshResponse")
-	proto.RegisterType((*MsgCompleteRefreshing)(nil), "bitway.btcbridge.MsgCompleteRefreshing")
-	proto.RegisterType((*MsgCompleteRefreshingResponse)(nil), "bitway.btcbridge.MsgCompleteRefreshingResponse")
-	proto.RegisterType((*MsgTransferVault)(nil), "bitway.btcbridge.MsgTransferVault")
-	proto.RegisterType((*MsgTransferVaultResponse)(nil), "bitway.btcbridge.MsgTransferVaultResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "bitway.btcbridge.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitway.btcbridge.MsgUpdateParamsResponse")
+// MsgRefresh defines the Msg/Refresh request type.
+type MsgRefresh struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// list of DKGs corresponding to key shares to be refreshed
+	DkgIds []uint64 `protobuf:"varint,2,rep,packed,name=dkg_ids,json=dkgIds,proto3" json:"dkg_ids,omitempty"`
+	// removed participant set
+	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
+	// timeout duration per DKG refreshing
+	TimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
 }
 
-func init() { proto.RegisterFile("bitway/btcbridge/tx.proto", fileDescriptor_2d8f879fc570c1be) }
-
-var fileDescriptor_2d8f879fc570c1be = []byte{
-	// 1401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x8f, 0xd3, 0x46,
-	0x14, 0x5f, 0x27, 0xd9, 0x7f, 0x2f, 0xfb, 0x0f, 0xb3, 0x85, 0xac, 0x81, 0x10, 0x02, 0x2c, 0x0b,
-	0x12, 0x49, 0x49, 0x55, 0x54, 0xa1, 0x5e, 0x1a, 0x56, 0x50, 0x84, 0x42, 0x57, 0x66, 0x01, 0xb5,
-	0xaa, 0x14, 0xd9, 0xf1, 0xac, 0x63, 0x6d, 0xe2, 0xb1, 0x66, 0xc6, 0x4b, 0x72, 0x43, 0x6d, 0x2f,
-	0xbd, 0x55, 0xad, 0x2a, 0xf5, 0x13, 0xf4, 0x4c, 0x0f, 0xfd, 0x0e, 0x1c, 0x39, 0x55, 0x3d, 0xb5,
-	0x15, 0x1c, 0xf8, 0x1a, 0x95, 0xc7, 0x93, 0x89, 0xbd, 0xfe, 0x93, 0xa5, 0x3d, 0xc5, 0xf3, 0xde,
-	0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0xe6, 0xcd, 0x3c, 0x05, 0xb6, 0x4c, 0x87, 0x3d, 0x37, 0xc6, 0x4d,
-	0x93, 0xf5, 0x4c, 0xe2, 0x58, 0x36, 0x6a, 0xb2, 0x51, 0xc3, 0x23, 0x98, 0x61, 0x75, 0x23, 0x54,
-	0x35, 0xa4, 0x4a, 0x3b, 0xdb, 0xc3, 0x74, 0x88, 0x69, 0x73, 0x48, 0xed, 0xe6, 0xd1, 0xad, 0xe0,
-	0x27, 0x84, 0x6a, 0x9b, 0x36, 0xb6, 0x31, 0xff, 0x6c, 0x06, 0x5f, 0x42, 0x5a, 0xb5, 0x31, 0xb6,
-	0x07, 0xa8, 0xc9, 0x57, 0xa6, 0x7f, 0xd0, 0xb4, 0x7c, 0x62, 0x30, 0x07, 0xbb, 0x42, 0x7f, 0x21,
-	0xe1, 0xdb, 0x33, 0x88, 0x31, 0xa4, 0x42, 0x5d, 0x4b, 0xa8, 0xe5, 0x57, 0x88, 0xa8, 0xff, 0xae,
-	0xc0, 0xb9, 0x0e, 0xb5, 0x1f, 0xfb, 0xe6, 0xd0, 0x61, 0xbb, 0xc8, 0xc3, 0xd4, 0x61, 0xfb, 0xc4,
-	0x70, 0xa9, 0xd1, 0x0b, 0xdc, 0xa8, 0x67, 0x60, 0x81, 0x22, 0xd7, 0x42, 0xa4, 0xa2, 0xd4, 0x94,
-	0x9d, 0x65, 0x5d, 0xac, 0xd4, 0xf3, 0xb0, 0x6c, 0x0e, 0x70, 0xef, 0xb0, 0x6f, 0xd0, 0x7e, 0xa5,
-	0xc0, 0x55, 0x53, 0x81, 0x5a, 0x87, 0x55, 0x8f, 0xa0, 0xa3, 0x2e, 0x1b, 0x75, 0xcd, 0x31, 0x43,
-	0xb4, 0x52, 0xe4, 0x88, 0x72, 0x20, 0xdc, 0x1f, 0xb5, 0x03, 0x91, 0xba, 0x05, 0x4b, 0x52, 0x5d,
-	0xe2, 0xea, 0x45, 0x26, 0x54, 0x9b, 0x30, 0xef, 0x11, 0x8c, 0x0f, 0x2a, 0xf3, 0xb5, 0xe2, 0xce,
-	0xb2, 0x1e, 0x2e, 0xee, 0x94, 0xbf, 0x79, 0xf7, 0xf2, 0x86, 0xf0, 0x5f, 0xbf, 0x0a, 0x97, 0x73,
-	0x68, 0xeb, 0x88, 0x7a, 0xd8, 0xa5, 0xa8, 0xfe, 0xa3, 0x02, 0xe7, 0x25, 0xee, 0x99, 0xc3, 0xfa,
-	0x16, 0x31, 0x9e, 0xff, 0xff, 0xf8, 0xa2, 0xdc, 0x8b, 0x19, 0xdc, 0x4b, 0x99, 0xdc, 0xb7, 0xe1,
-	0x4a, 0x1e, 0x27, 0x49, 0x5e, 0x87, 0x0d, 0x89, 0xbb, 0x87, 0x90, 0x6e, 0x30, 0x94, 0xc9, 0x77,
-	0x0b, 0x96, 0x0e, 0x10, 0xea, 0x12, 0x83, 0x21, 0x4e, 0xb7, 0xa8, 0x2f, 0x1e, 0x84, 0x5b, 0xe2,
-	0xbe, 0x35, 0xa8, 0x1c, 0xb7, 0x29, 0xfd, 0x19, 0x50, 0xed, 0x50, 0xfb, 0x89, 0x67, 0x19, 0x0c,
-	0xed, 0x13, 0x9f, 0x32, 0x64, 0x3d, 0xc2, 0x6e, 0x9b, 0xf5, 0x74, 0x34, 0x30, 0xc6, 0x88, 0xd0,
-	0x4c, 0xef, 0x1a, 0x2c, 0x11, 0x81, 0xa9, 0x14, 0x78, 0xdc, 0x72, 0x1d, 0x77, 0xbf, 0x03, 0xdb,
-	0xf9, 0x2e, 0x24, 0x19, 0x9b, 0x17, 0x2e, 0x86, 0xbc, 0x87, 0xd0, 0x1e, 0xc1, 0x47, 0x8e, 0x95,
-	0x47, 0xa5, 0x0e, 0x2b, 0x51, 0x9c, 0xa0, 0x13, 0x93, 0xa5, 0x55, 0x23, 0xd3, 0x91, 0x24, 0xf4,
-	0x18, 0x36, 0x3b, 0xd4, 0x96, 0xf5, 0xc2, 0x6d, 0x87, 0xf5, 0xb0, 0x93, 0x7d, 0x82, 0xce, 0xc0,
-	0x82, 0x31, 0xc4, 0xbe, 0xcb, 0xc4, 0xf1, 0x11, 0xab, 0xb8, 0xf3, 0x2a, 0x8f, 0x32, 0x61, 0x54,
-	0x3a, 0x75, 0xe1, 0xb4, 0x2c, 0xd7, 0x63, 0xc7, 0x76, 0x0d, 0xe6, 0x13, 0x94, 0x1d, 0xbc, 0x0a,
-	0x25, 0x36, 0x72, 0x2c, 0xe1, 0x91, 0x7f, 0xab, 0x55, 0x00, 0x2a, 0x77, 0x56, 0x8a, 0x3c, 0x1d,
-	0x11, 0x49, 0x9c, 0xcf, 0x85, 0xc8, 0x6d, 0x30, 0xf5, 0x27, 0xe9, 0xfc, 0x54, 0xe0, 0x49, 0xb8,
-	0x8b, 0x5d, 0x8a, 0x07, 0x4e, 0x90, 0xb1, 0xa7, 0x86, 0x3f, 0x60, 0x34, 0x68, 0x17, 0xc3, 0x67,
-	0x7d, 0x4c, 0x1c, 0x36, 0x16, 0x9c, 0xa6, 0x02, 0xf5, 0x32, 0xac, 0x1e, 0x05, 0xb8, 0xee, 0x11,
-	0x22, 0xd4, 0xc1, 0x2e, 0xe7, 0x57, 0xd2, 0x57, 0xb8, 0xf0, 0x69, 0x28, 0x53, 0xbf, 0x80, 0x53,
-	0x26, 0xeb, 0x75, 0x7b, 0xd2, 0x76, 0x00, 0x0c, 0x9a, 0xab, 0xdc, 0xaa, 0x37, 0x8e, 0xdf, 0xa3,
-	0x8d, 0x36, 0xeb, 0xdd, 0x8d, 0x22, 0xf5, 0x0d, 0xf3, 0x98, 0x44, 0x7d, 0x06, 0x9b, 0xc4, 0x77,
-	0x11, 0x8d, 0x9b, 0xa4, 0xbc, 0x31, 0xcb, 0xad, 0x2b, 0x49, 0x9b, 0x7a, 0x80, 0x8e, 0x5b, 0x3d,
-	0x4d, 0x12, 0x32, 0x7a, 0x67, 0x2d, 0xc8, 0xd8, 0x34, 0x3c, 0x51, 0xc4, 0x44, 0x52, 0x64, 0xd6,
-	0x7e, 0x2b, 0xc0, 0x5a, 0x87, 0xda, 0x0f, 0x5c, 0x87, 0x39, 0x06, 0x43, 0xbb, 0x0f, 0xef, 0xcf,
-	0xc8, 0xd7, 0x2e, 0xac, 0x78, 0x06, 0x61, 0x4e, 0xcf, 0xf1, 0x0c, 0x97, 0x85, 0x67, 0xb8, 0xdc,
-	0xaa, 0x25, 0x19, 0xef, 0x3e, 0xbc, 0xbf, 0x37, 0x05, 0xea, 0xb1, 0x5d, 0x81, 0x0f, 0xd6, 0x27,
-	0x88, 0xf6, 0xf1, 0xc0, 0xe2, 0x89, 0x5c, 0xd5, 0xa7, 0x02, 0xf5, 0x53, 0x28, 0x87, 0x35, 0x61,
-	0x63, 0x0f, 0x85, 0x49, 0x59, 0x6b, 0x9d, 0x4b, 0xba, 0xf8, 0x8c, 0x52, 0xc4, 0xf6, 0xc7, 0x1e,
-	0xd2, 0x81, 0xe3, 0x83, 0x4f, 0xaa, 0x5e, 0x83, 0x75, 0xe4, 0x1a, 0xe6, 0x00, 0x75, 0x59, 0x70,
-	0x71, 0x1d, 0x20, 0x52, 0x99, 0xaf, 0x29, 0x3b, 0x4b, 0xfa, 0x5a, 0x28, 0xde, 0x17, 0x52, 0x75,
-	0x1b, 0xd6, 0x99, 0x41, 0x6c, 0xc4, 0xba, 0x3e, 0x1b, 0xe1, 0xae, 0xeb, 0x0f, 0x2b, 0x0b, 0x9c,
-	0xca, 0x6a, 0x28, 0x7e, 0xc2, 0x46, 0xf8, 0x91, 0x3f, 0x4c, 0xe4, 0xb4, 0x02, 0x67, 0xe2, 0x29,
-	0x93, 0xd9, 0xfc, 0x55, 0xe1, 0xd9, 0xbc, 0x8b, 0x87, 0xde, 0x00, 0x85, 0xd9, 0xcc, 0x6a, 0x87,
-	0x35, 0x28, 0x88, 0x66, 0x28, 0xe9, 0x05, 0xc7, 0x0a, 0x70, 0x3c, 0x86, 0x49, 0x1b, 0x88, 0x95,
-	0x7a, 0x1d, 0x36, 0x82, 0x33, 0x82, 0x5c, 0xea, 0xd3, 0xae, 0xe7, 0x9b, 0x87, 0x68, 0x2c, 0x9e,
-	0xa4, 0x75, 0x29, 0xdf, 0xe3, 0xe2, 0x20, 0xa9, 0xb2, 0x77, 0x78, 0xc8, 0xcb, 0xfa, 0x54, 0x10,
-	0xef, 0xa5, 0x30, 0x84, 0x08, 0x4f, 0x19, 0xc2, 0x1f, 0x0a, 0x40, 0x87, 0xda, 0x3a, 0x3a, 0x08,
-	0xaa, 0x31, 0xe3, 0x30, 0x9c, 0x85, 0x45, 0xeb, 0xd0, 0xee, 0x3a, 0x56, 0x78, 0x0e, 0x4a, 0xfa,
-	0x82, 0x75, 0x68, 0x3f, 0xb0, 0xa8, 0x7a, 0x0b, 0x36, 0x09, 0x1a, 0xe2, 0x23, 0x64, 0x75, 0x63,
-	0xa7, 0x25, 0x8c, 0xed, 0xb4, 0xd0, 0xed, 0x45, 0x8f, 0xc4, 0x23, 0xd8, 0x60, 0xce, 0x10, 0x61,
-	0x9f, 0x75, 0x27, 0x83, 0x04, 0x0f, 0xb4, 0xdc, 0xda, 0x6a, 0x84, 0x93, 0x46, 0x63, 0x32, 0x69,
-	0x34, 0x76, 0x05, 0xa0, 0xbd, 0xf4, 0xea, 0xaf, 0x8b, 0x73, 0xbf, 0xfc, 0x7d, 0x51, 0xd1, 0xd7,
-	0xc5, 0xe6, 0x89, 0x2a, 0x51, 0xb5, 0x4d, 0x50, 0xa7, 0x71, 0xc9, 0x70, 0x7f, 0x56, 0xe0, 0x83,
-	0x48, 0x26, 0x84, 0xda, 0x71, 0xed, 0x13, 0x17, 0x2e, 0xad, 0x40, 0xc5, 0x13, 0x14, 0xa8, 0x94,
-	0x5b, 0xa0, 0x8b, 0x70, 0x21, 0x95, 0x96, 0x24, 0xfe, 0x5d, 0x81, 0xbf, 0xc0, 0x93, 0xc3, 0xcc,
-	0xdb, 0x7a, 0x46, 0xb5, 0xae, 0xc2, 0x1a, 0xc5, 0x3e, 0xe9, 0xa1, 0x63, 0x77, 0xdd, 0x6a, 0x28,
-	0x9d, 0x5c, 0x76, 0x97, 0x60, 0xc5, 0x42, 0x74, 0x7a, 0x21, 0x16, 0x39, 0xa8, 0x1c, 0xc8, 0x26,
-	0x90, 0x3b, 0x00, 0x46, 0xd0, 0x7b, 0xbc, 0x41, 0x79, 0x24, 0x33, 0xfa, 0x73, 0xd9, 0x98, 0x7c,
-	0xf2, 0x21, 0x84, 0x9a, 0x8c, 0xca, 0x01, 0x2a, 0x58, 0xfc, 0xe7, 0x5e, 0x0c, 0x67, 0x86, 0x58,
-	0x16, 0x64, 0x8a, 0x9e, 0xc3, 0xba, 0x7c, 0x3d, 0xf7, 0xf8, 0xe8, 0x39, 0x23, 0x41, 0xb7, 0x61,
-	0x21, 0x1c, 0x51, 0x79, 0x62, 0xca, 0xad, 0x4a, 0x32, 0xa4, 0xd0, 0x4e, 0xbb, 0x14, 0x9c, 0x3b,
-	0x5d, 0xa0, 0x13, 0xa4, 0xb6, 0xe0, 0xec, 0x31, 0xc7, 0x13, 0x4e, 0xad, 0x17, 0x2b, 0x50, 0xec,
-	0x50, 0x5b, 0x7d, 0xa1, 0x40, 0x25, 0x73, 0xb0, 0xbd, 0x99, 0xf4, 0x9b, 0x33, 0x50, 0x6a, 0x1f,
-	0xbf, 0x17, 0x7c, 0x42, 0x45, 0xfd, 0x56, 0x81, 0xad, 0xec, 0xe1, 0xb3, 0x91, 0x63, 0x34, 0x05,
-	0xaf, 0xdd, 0x7e, 0x3f, 0xbc, 0x64, 0xd1, 0x85, 0xd5, 0xf8, 0x14, 0x59, 0xcf, 0x31, 0x24, 0x30,
-	0xda, 0x8d, 0xd9, 0x18, 0xe9, 0xe0, 0x7b, 0x05, 0xce, 0xe5, 0xcd, 0x8d, 0x1f, 0xa6, 0xda, 0xca,
-	0xd9, 0xa1, 0x7d, 0xf2, 0xbe, 0x3b, 0x62, 0x29, 0xcf, 0x1e, 0x1b, 0x1b, 0xb3, 0xed, 0x46, 0xf1,
-	0x19, 0x29, 0x9f, 0x39, 0x2d, 0xaa, 0x87, 0x70, 0x2a, 0x39, 0x2a, 0x6e, 0xa7, 0x1a, 0x4b, 0xe0,
-	0xb4, 0xc6, 0xc9, 0x70, 0xd2, 0x59, 0x1f, 0x36, 0x12, 0x23, 0xe2, 0xd5, 0x9c, 0xf2, 0x4d, 0x61,
-	0xda, 0xcd, 0x13, 0xc1, 0xa2, 0x61, 0x25, 0x87, 0xbf, 0xf4, 0xb0, 0x12, 0xb8, 0x8c, 0xb0, 0x32,
-	0xe7, 0x26, 0xf5, 0x4b, 0x28, 0x47, 0x67, 0xa6, 0x5a, 0xea, 0xf6, 0x08, 0x42, 0xdb, 0x99, 0x85,
-	0x88, 0x9a, 0x8e, 0x0e, 0x10, 0xb5, 0x0c, 0x66, 0x12, 0x91, 0x61, 0x3a, 0xe5, 0x71, 0x57, 0x3b,
-	0xb0, 0x28, 0x1f, 0xf6, 0xd4, 0x4d, 0x42, 0xab, 0x5d, 0xc9, 0xd3, 0x4a, 0x73, 0x2e, 0xa8, 0x29,
-	0x0f, 0xe7, 0xb5, 0x5c, 0x3a, 0x53, 0xa0, 0xd6, 0x3c, 0x21, 0x30, 0x7a, 0x57, 0xc4, 0xdf, 0xbb,
-	0xf4, 0xbb, 0x22, 0x86, 0xc9, 0xb8, 0x2b, 0x52, 0x5f, 0x0c, 0xf5, 0x6b, 0x58, 0x89, 0x3d, 0x17,
-	0x97, 0x72, 0x3a, 0x2c, 0x84, 0x68, 0xd7, 0x67, 0x42, 0x26, 0xd6, 0xb5, 0xf9, 0x17, 0xef, 0x5e,
-	0xde, 0x50, 0xda, 0x9f, 0xbf, 0x7a, 0x53, 0x55, 0x5e, 0xbf, 0xa9, 0x2a, 0xff, 0xbc, 0xa9, 0x2a,
-	0x3f, 0xbc, 0xad, 0xce, 0xbd, 0x7e, 0x5b, 0x9d, 0xfb, 0xf3, 0x6d, 0x75, 0xee, 0xab, 0x86, 0xed,
-	0xb0, 0xbe, 0x6f, 0x36, 0x7a, 0x78, 0xd8, 0x0c, 0xad, 0x0e, 0x0c, 0x93, 0x8a, 0xcf, 0xe6, 0x28,
-	0xfa, 0x2f, 0x4e, 0x30, 0xe9, 0x9a, 0x0b, 0x7c, 0x20, 0xfa, 0xe8, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x20, 0xd1, 0xc4, 0x14, 0xe6, 0x11, 0x00, 0x00,
+func (m *MsgRefresh) Reset()         { *m = MsgRefresh{} }
+func (m *MsgRefresh) String() string { return proto.CompactTextString(m) }
+func (*MsgRefresh) ProtoMessage()    {}
+func (*MsgRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{34}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
-	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
-	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
-	SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
-	// UpdateTrustedFeeProviders updates the trusted fee providers.
-	UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error)
-	// WithdrawToBitcoin withdraws the asset to bitcoin.
-	WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error)
-	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
-	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
-	// ConsolidateVaults performs the utxo consolidation for the given vaults.
-	ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error)
-	// InitiateDKG initiates the DKG request.
-	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
-	// CompleteDKG completes the given DKG request.
-	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
-	// Refresh refreshes key shares.
-	Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error)
-	// CompleteRefreshing completes the given refreshing request by the participant.
-	CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error)
-	// TransferVault transfers the vault asset from the source version to the destination version.
-	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
-	// UpdateParams defines a governance operation for updating the x/btcbridge module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefresh.Merge(m, src)
 }
-
-func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
-	out := new(MsgSubmitDepositTransactionResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRefresh) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
-	out := new(MsgSubmitWithdrawTransactionResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefresh.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error) {
-	out := new(MsgSubmitFeeRateResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitFeeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgRefresh proto.InternalMessageInfo
 
-func (c *msgClient) UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	out := new(MsgUpdateTrustedNonBtcRelayersResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateTrustedNonBtcRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error) {
-	out := new(MsgUpdateTrustedFeeProvidersResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetDkgIds() []uint64 {
+	if m != nil {
+		return m.DkgIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error) {
-	out := new(MsgWithdrawToBitcoinResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/WithdrawToBitcoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetRemovedParticipants() []string {
+	if m != nil {
+		return m.RemovedParticipants
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
-	out := new(MsgSubmitSignaturesResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitSignatures", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.TimeoutDuration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error) {
-	out := new(MsgConsolidateVaultsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/ConsolidateVaults", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRefreshResponse defines the Msg/Refresh response type.
+type MsgRefreshResponse struct {
 }
 
-func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
-	out := new(MsgInitiateDKGResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/InitiateDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRefreshResponse) Reset()         { *m = MsgRefreshResponse{} }
+func (m *MsgRefreshResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshResponse) ProtoMessage()    {}
+func (*MsgRefreshResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{35}
 }
-
-func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
-	out := new(MsgCompleteDKGResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/CompleteDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefreshResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRefreshResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshResponse.Merge(m, src)
+}
+func (m *MsgRefreshResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error) {
-	out := new(MsgRefreshResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/Refresh", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefreshResponse proto.InternalMessageInfo
+
+// MsgCompleteRefreshing defines the Msg/CompleteRefreshing request type.
+type MsgCompleteRefreshing struct {
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgCompleteRefreshing) Reset()         { *m = MsgCompleteRefreshing{} }
+func (m *MsgCompleteRefreshing) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteRefreshing) ProtoMessage()    {}
+func (*MsgCompleteRefreshing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{36}
+}
+func (m *MsgCompleteRefreshing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteRefreshing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCompleteRefreshing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteRefreshing.Merge(m, src)
+}
+func (m *MsgCompleteRefreshing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteRefreshing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteRefreshing.DiscardUnknown(m)
 }
 
-func (c *msgClient) CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error) {
-	out := new(MsgCompleteRefreshingResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/CompleteRefreshing", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCompleteRefreshing proto.InternalMessageInfo
+
+func (m *MsgCompleteRefreshing) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error) {
-	out := new(MsgTransferVaultResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/TransferVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCompleteRefreshing) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCompleteRefreshing) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
-	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error)
-	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
-	SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(context.Context, *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
-	// UpdateTrustedFeeProviders updates the trusted fee providers.
-	UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error)
-	// WithdrawToBitcoin withdraws the asset to bitcoin.
-	WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error)
-	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
-	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
-	// ConsolidateVaults performs the utxo consolidation for the given vaults.
-	ConsolidateVaults(context.Context, *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error)
-	// InitiateDKG initiates the DKG request.
-	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
-	// CompleteDKG completes the given DKG request.
-	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
-	// Refresh refreshes key shares.
-	Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error)
-	// CompleteRefreshing completes the given refreshing request by the participant.
-	CompleteRefreshing(context.Context, *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error)
-	// TransferVault transfers the vault asset from the source version to the destination version.
-	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
-	// UpdateParams defines a governance operation for updating the x/btcbridge module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgCompleteRefreshing) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCompleteRefreshingResponse defines the Msg/CompleteRefreshing response type.
+type MsgCompleteRefreshingResponse struct {
 }
 
-func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
+func (m *MsgCompleteRefreshingResponse) Reset()         { *m = MsgCompleteRefreshingResponse{} }
+func (m *MsgCompleteRefreshingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteRefreshingResponse) ProtoMessage()    {}
+func (*MsgCompleteRefreshingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{37}
 }
-func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
+func (m *MsgCompleteRefreshingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitFeeRate(ctx context.Context, req *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeeRate not implemented")
+func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteRefreshingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateTrustedNonBtcRelayers(ctx context.Context, req *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedNonBtcRelayers not implemented")
+func (m *MsgCompleteRefreshingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteRefreshingResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateTrustedFeeProviders(ctx context.Context, req *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedFeeProviders not implemented")
+func (m *MsgCompleteRefreshingResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawToBitcoin(ctx context.Context, req *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawToBitcoin not implemented")
+func (m *MsgCompleteRefreshingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteRefreshingResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+
+var xxx_messageInfo_MsgCompleteRefreshingResponse proto.InternalMessageInfo
+
+// MsgTransferVault is the Msg/TransferVault request type.
+type MsgTransferVault struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// version of the source vault
+	SourceVersion uint64 `protobuf:"varint,2,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
+	// version of the destination vault
+	DestVersion uint64 `protobuf:"varint,3,opt,name=dest_version,json=destVersion,proto3" json:"dest_version,omitempty"`
+	// asset type
+	AssetType AssetType `protobuf:"varint,4,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// a set of optional pre-built PSBTs to perform the asset transfer
+	Psbts []string `protobuf:"bytes,5,rep,name=psbts,proto3" json:"psbts,omitempty"`
+	// target number of the UTXOs to be transferred; only take effect when psbt not provided
+	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
 }
-func (*UnimplementedMsgServer) ConsolidateVaults(ctx context.Context, req *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateVaults not implemented")
+
+func (m *MsgTransferVault) Reset()         { *m = MsgTransferVault{} }
+func (m *MsgTransferVault) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVault) ProtoMessage()    {}
+func (*MsgTransferVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{38}
 }
-func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+func (m *MsgTransferVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+func (m *MsgTransferVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Refresh(ctx context.Context, req *MsgRefresh) (*MsgRefreshResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+func (m *MsgTransferVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVault.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CompleteRefreshing(ctx context.Context, req *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteRefreshing not implemented")
+func (m *MsgTransferVault) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) TransferVault(ctx context.Context, req *MsgTransferVault) (*MsgTransferVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
+func (m *MsgTransferVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVault.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgTransferVault proto.InternalMessageInfo
+
+func (m *MsgTransferVault) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgTransferVault) GetSourceVersion() uint64 {
+	if m != nil {
+		return m.SourceVersion
+	}
+	return 0
 }
 
-func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitDepositTransaction)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgTransferVault) GetDestVersion() uint64 {
+	if m != nil {
+		return m.DestVersion
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/SubmitDepositTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransaction))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitWithdrawTransaction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/SubmitWithdrawTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransaction))
+func (m *MsgTransferVault) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	return interceptor(ctx, in, info, handler)
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func _Msg_SubmitFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitFeeRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitFeeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/SubmitFeeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitFeeRate(ctx, req.(*MsgSubmitFeeRate))
+func (m *MsgTransferVault) GetPsbts() []string {
+	if m != nil {
+		return m.Psbts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateTrustedNonBtcRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTrustedNonBtcRelayers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/UpdateTrustedNonBtcRelayers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, req.(*MsgUpdateTrustedNonBtcRelayers))
+func (m *MsgTransferVault) GetTargetUtxoNum() uint32 {
+	if m != nil {
+		return m.TargetUtxoNum
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateTrustedFeeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTrustedFeeProviders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, req.(*MsgUpdateTrustedFeeProviders))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTransferVaultResponse defines the Msg/TransferVault response type.
+type MsgTransferVaultResponse struct {
 }
 
-func _Msg_WithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawToBitcoin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawToBitcoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/WithdrawToBitcoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawToBitcoin(ctx, req.(*MsgWithdrawToBitcoin))
+func (m *MsgTransferVaultResponse) Reset()         { *m = MsgTransferVaultResponse{} }
+func (m *MsgTransferVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVaultResponse) ProtoMessage()    {}
+func (*MsgTransferVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{39}
+}
+func (m *MsgTransferVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTransferVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVaultResponse.Merge(m, src)
+}
+func (m *MsgTransferVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVaultResponse.DiscardUnknown(m)
 }
 
-func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSignatures)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSignatures(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/SubmitSignatures",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
+var xxx_messageInfo_MsgTransferVaultResponse proto.InternalMessageInfo
+
+// MsgPause defines the Msg/Pause request type.
+type MsgPause struct {
+	// sender; must be the guardian or authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pause target
+	Target PauseTarget `protobuf:"varint,2,opt,name=target,proto3,enum=bitway.btcbridge.PauseTarget" json:"target,omitempty"`
+	// asset type; only required for PAUSE_TARGET_ASSET
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// pause duration; the maximum duration is used if not provided
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reason
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgPause) Reset()         { *m = MsgPause{} }
+func (m *MsgPause) String() string { return proto.CompactTextString(m) }
+func (*MsgPause) ProtoMessage()    {}
+func (*MsgPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{40}
+}
+func (m *MsgPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPause.Merge(m, src)
+}
+func (m *MsgPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPause.DiscardUnknown(m)
 }
 
-func _Msg_ConsolidateVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConsolidateVaults)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConsolidateVaults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/ConsolidateVaults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConsolidateVaults(ctx, req.(*MsgConsolidateVaults))
+var xxx_messageInfo_MsgPause proto.InternalMessageInfo
+
+func (m *MsgPause) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateDKG)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateDKG(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/InitiateDKG",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+func (m *MsgPause) GetTarget() PauseTarget {
+	if m != nil {
+		return m.Target
 	}
-	return interceptor(ctx, in, info, handler)
+	return PauseTarget_PAUSE_TARGET_UNSPECIFIED
 }
 
-func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteDKG)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteDKG(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/CompleteDKG",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
+func (m *MsgPause) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	return interceptor(ctx, in, info, handler)
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func _Msg_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefresh)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Refresh(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/Refresh",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Refresh(ctx, req.(*MsgRefresh))
+func (m *MsgPause) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CompleteRefreshing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteRefreshing)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteRefreshing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/CompleteRefreshing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteRefreshing(ctx, req.(*MsgCompleteRefreshing))
+func (m *MsgPause) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferVault)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/TransferVault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferVault(ctx, req.(*MsgTransferVault))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgPauseResponse defines the Msg/Pause response type.
+type MsgPauseResponse struct {
+	// pause id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgPauseResponse) Reset()         { *m = MsgPauseResponse{} }
+func (m *MsgPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseResponse) ProtoMessage()    {}
+func (*MsgPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{41}
+}
+func (m *MsgPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseResponse.Merge(m, src)
+}
+func (m *MsgPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseResponse proto.InternalMessageInfo
+
+func (m *MsgPauseResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.btcbridge.Msg/UpdateParams",
+	return 0
+}
+
+// MsgUnpause defines the Msg/Unpause request type.
+type MsgUnpause struct {
+	// sender; must be the guardian or authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pause target
+	Target PauseTarget `protobuf:"varint,2,opt,name=target,proto3,enum=bitway.btcbridge.PauseTarget" json:"target,omitempty"`
+	// asset type; only required for PAUSE_TARGET_ASSET
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+}
+
+func (m *MsgUnpause) Reset()         { *m = MsgUnpause{} }
+func (m *MsgUnpause) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpause) ProtoMessage()    {}
+func (*MsgUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{42}
+}
+func (m *MsgUnpause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpause.Merge(m, src)
+}
+func (m *MsgUnpause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpause proto.InternalMessageInfo
+
+func (m *MsgUnpause) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitway.btcbridge.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitDepositTransaction",
-			Handler:    _Msg_SubmitDepositTransaction_Handler,
-		},
-		{
-			MethodName: "SubmitWithdrawTransaction",
-			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
-		},
-		{
-			MethodName: "SubmitFeeRate",
-			Handler:    _Msg_SubmitFeeRate_Handler,
-		},
-		{
-			MethodName: "UpdateTrustedNonBtcRelayers",
-			Handler:    _Msg_UpdateTrustedNonBtcRelayers_Handler,
-		},
-		{
-			MethodName: "UpdateTrustedFeeProviders",
-			Handler:    _Msg_UpdateTrustedFeeProviders_Handler,
-		},
-		{
-			MethodName: "WithdrawToBitcoin",
-			Handler:    _Msg_WithdrawToBitcoin_Handler,
-		},
-		{
-			MethodName: "SubmitSignatures",
-			Handler:    _Msg_SubmitSignatures_Handler,
-		},
-		{
-			MethodName: "ConsolidateVaults",
-			Handler:    _Msg_ConsolidateVaults_Handler,
-		},
-		{
-			MethodName: "InitiateDKG",
-			Handler:    _Msg_InitiateDKG_Handler,
-		},
-		{
-			MethodName: "CompleteDKG",
-			Handler:    _Msg_CompleteDKG_Handler,
-		},
-		{
-			MethodName: "Refresh",
-			Handler:    _Msg_Refresh_Handler,
-		},
-		{
-			MethodName: "CompleteRefreshing",
-			Handler:    _Msg_CompleteRefreshing_Handler,
-		},
-		{
-			MethodName: "TransferVault",
-			Handler:    _Msg_TransferVault_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitway/btcbridge/tx.proto",
+func (m *MsgUnpause) GetTarget() PauseTarget {
+	if m != nil {
+		return m.Target
+	}
+	return PauseTarget_PAUSE_TARGET_UNSPECIFIED
 }
 
-func (m *MsgSubmitDepositTransaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnpause) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	return dAtA[:n], nil
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *MsgSubmitDepositTransaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnpauseResponse defines the Msg/Unpause response type.
+type MsgUnpauseResponse struct {
 }
 
-func (m *MsgSubmitDepositTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgUnpauseResponse) Reset()         { *m = MsgUnpauseResponse{} }
+func (m *MsgUnpauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseResponse) ProtoMessage()    {}
+func (*MsgUnpauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{43}
+}
+func (m *MsgUnpauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PrevTxBytes) > 0 {
-		i -= len(m.PrevTxBytes)
-		copy(dAtA[i:], m.PrevTxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUnpauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseResponse.Merge(m, src)
+}
+func (m *MsgUnpauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseResponse proto.InternalMessageInfo
+
+// MsgExtendPause defines the Msg/ExtendPause request type.
+type MsgExtendPause struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pause target
+	Target PauseTarget `protobuf:"varint,2,opt,name=target,proto3,enum=bitway.btcbridge.PauseTarget" json:"target,omitempty"`
+	// asset type; only required for PAUSE_TARGET_ASSET
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=bitway.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// duration by which the pause is extended
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgExtendPause) Reset()         { *m = MsgExtendPause{} }
+func (m *MsgExtendPause) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendPause) ProtoMessage()    {}
+func (*MsgExtendPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{44}
+}
+func (m *MsgExtendPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgExtendPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendPause.Merge(m, src)
+}
+func (m *MsgExtendPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendPause.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExtendPause proto.InternalMessageInfo
+
+func (m *MsgExtendPause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExtendPause) GetTarget() PauseTarget {
+	if m != nil {
+		return m.Target
+	}
+	return PauseTarget_PAUSE_TARGET_UNSPECIFIED
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExtendPause) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *MsgSubmitWithdrawTransaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExtendPause) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitWithdrawTransaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgExtendPauseResponse defines the Msg/ExtendPause response type.
+type MsgExtendPauseResponse struct {
 }
 
-func (m *MsgSubmitWithdrawTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgExtendPauseResponse) Reset()         { *m = MsgExtendPauseResponse{} }
+func (m *MsgExtendPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendPauseResponse) ProtoMessage()    {}
+func (*MsgExtendPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{45}
+}
+func (m *MsgExtendPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExtendPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendPauseResponse.Merge(m, src)
 }
-
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExtendPauseResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExtendPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendPauseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitFeeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgExtendPauseResponse proto.InternalMessageInfo
 
-func (m *MsgSubmitFeeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateDenylist defines the Msg/UpdateDenylist request type.
+type MsgUpdateDenylist struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses to be added to the denylist
+	Additions []string `protobuf:"bytes,2,rep,name=additions,proto3" json:"additions,omitempty"`
+	// addresses to be removed from the denylist
+	Removals []string `protobuf:"bytes,3,rep,name=removals,proto3" json:"removals,omitempty"`
+	// reason for the additions
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgSubmitFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateDenylist) Reset()         { *m = MsgUpdateDenylist{} }
+func (m *MsgUpdateDenylist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenylist) ProtoMessage()    {}
+func (*MsgUpdateDenylist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{46}
 }
-
-func (m *MsgSubmitFeeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateDenylist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenylist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenylist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateDenylist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenylist.Merge(m, src)
 }
-
-func (m *MsgSubmitFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateDenylist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenylist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenylist.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateDenylist proto.InternalMessageInfo
+
+func (m *MsgUpdateDenylist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateDenylist) GetAdditions() []string {
+	if m != nil {
+		return m.Additions
+	}
+	return nil
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateDenylist) GetRemovals() []string {
+	if m != nil {
+		return m.Removals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateDenylist) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateDenylistResponse defines the Msg/UpdateDenylist response type.
+type MsgUpdateDenylistResponse struct {
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateDenylistResponse) Reset()         { *m = MsgUpdateDenylistResponse{} }
+func (m *MsgUpdateDenylistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenylistResponse) ProtoMessage()    {}
+func (*MsgUpdateDenylistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{47}
 }
-
-func (m *MsgUpdateTrustedFeeProviders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateDenylistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenylistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenylistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateDenylistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenylistResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenylistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenylistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenylistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTrustedFeeProviders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateDenylistResponse proto.InternalMessageInfo
+
+// MsgUpdateRuneEtching defines the Msg/UpdateRuneEtching request type.
+type MsgUpdateRuneEtching struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rune etching data which overrides the existing one
+	Etching RuneEtching `protobuf:"bytes,2,opt,name=etching,proto3" json:"etching"`
 }
 
-func (m *MsgUpdateTrustedFeeProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeProviders) > 0 {
-		for iNdEx := len(m.FeeProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FeeProviders[iNdEx])
-			copy(dAtA[i:], m.FeeProviders[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateRuneEtching) Reset()         { *m = MsgUpdateRuneEtching{} }
+func (m *MsgUpdateRuneEtching) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRuneEtching) ProtoMessage()    {}
+func (*MsgUpdateRuneEtching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{48}
+}
+func (m *MsgUpdateRuneEtching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRuneEtching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRuneEtching.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateRuneEtching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRuneEtching.Merge(m, src)
+}
+func (m *MsgUpdateRuneEtching) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRuneEtching) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRuneEtching.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateRuneEtching proto.InternalMessageInfo
+
+func (m *MsgUpdateRuneEtching) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRuneEtching) GetEtching() RuneEtching {
+	if m != nil {
+		return m.Etching
+	}
+	return RuneEtching{}
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateRuneEtchingResponse defines the Msg/UpdateRuneEtching response type.
+type MsgUpdateRuneEtchingResponse struct {
 }
 
-func (m *MsgWithdrawToBitcoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateRuneEtchingResponse) Reset()         { *m = MsgUpdateRuneEtchingResponse{} }
+func (m *MsgUpdateRuneEtchingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRuneEtchingResponse) ProtoMessage()    {}
+func (*MsgUpdateRuneEtchingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{49}
 }
-
-func (m *MsgWithdrawToBitcoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRuneEtchingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgWithdrawToBitcoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateRuneEtchingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRuneEtchingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawToBitcoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateRuneEtchingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRuneEtchingResponse.Merge(m, src)
 }
-
-func (m *MsgWithdrawToBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRuneEtchingResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgWithdrawToBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateRuneEtchingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRuneEtchingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateRuneEtchingResponse proto.InternalMessageInfo
 
-func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/btcbridge parameters to be updated.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{50}
 }
-
-func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgConsolidateVaults) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgConsolidateVaults) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
-func (m *MsgConsolidateVaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RunesConsolidations) > 0 {
-		for iNdEx := len(m.RunesConsolidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RunesConsolidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BtcConsolidation != nil {
-		{
-			size, err := m.BtcConsolidation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.VaultVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VaultVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgConsolidateVaultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgConsolidateVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgConsolidateVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8f879fc570c1be, []int{51}
 }
-
-func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EnableTransfer {
-		i--
-		if m.EnableTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.VaultTypes) > 0 {
-		dAtA3 := make([]byte, len(m.VaultTypes)*10)
-		var j2 int
-		for _, num := range m.VaultTypes {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSubmitDepositTransaction)(nil), "bitway.btcbridge.MsgSubmitDepositTransaction")
+	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "bitway.btcbridge.MsgSubmitDepositTransactionResponse")
+	proto.RegisterType((*MsgSubmitDepositTransactions)(nil), "bitway.btcbridge.MsgSubmitDepositTransactions")
+	proto.RegisterType((*DepositBlock)(nil), "bitway.btcbridge.DepositBlock")
+	proto.RegisterType((*DepositTransaction)(nil), "bitway.btcbridge.DepositTransaction")
+	proto.RegisterType((*DepositResult)(nil), "bitway.btcbridge.DepositResult")
+	proto.RegisterType((*MsgSubmitDepositTransactionsResponse)(nil), "bitway.btcbridge.MsgSubmitDepositTransactionsResponse")
+	proto.RegisterType((*MsgSubmitWithdrawTransaction)(nil), "bitway.btcbridge.MsgSubmitWithdrawTransaction")
+	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "bitway.btcbridge.MsgSubmitWithdrawTransactionResponse")
+	proto.RegisterType((*MsgSubmitFeeRate)(nil), "bitway.btcbridge.MsgSubmitFeeRate")
+	proto.RegisterType((*MsgSubmitFeeRateResponse)(nil), "bitway.btcbridge.MsgSubmitFeeRateResponse")
+	proto.RegisterType((*MsgBondRelayer)(nil), "bitway.btcbridge.MsgBondRelayer")
+	proto.RegisterType((*MsgBondRelayerResponse)(nil), "bitway.btcbridge.MsgBondRelayerResponse")
+	proto.RegisterType((*MsgUnbondRelayer)(nil), "bitway.btcbridge.MsgUnbondRelayer")
+	proto.RegisterType((*MsgUnbondRelayerResponse)(nil), "bitway.btcbridge.MsgUnbondRelayerResponse")
+	proto.RegisterType((*MsgSlashRelayer)(nil), "bitway.btcbridge.MsgSlashRelayer")
+	proto.RegisterType((*MsgSlashRelayerResponse)(nil), "bitway.btcbridge.MsgSlashRelayerResponse")
+	proto.RegisterType((*MsgSubmitRuneEtching)(nil), "bitway.btcbridge.MsgSubmitRuneEtching")
+	proto.RegisterType((*MsgSubmitRuneEtchingResponse)(nil), "bitway.btcbridge.MsgSubmitRuneEtchingResponse")
+	proto.RegisterType((*MsgUpdateTrustedFeeProviders)(nil), "bitway.btcbridge.MsgUpdateTrustedFeeProviders")
+	proto.RegisterType((*MsgUpdateTrustedFeeProvidersResponse)(nil), "bitway.btcbridge.MsgUpdateTrustedFeeProvidersResponse")
+	proto.RegisterType((*MsgWithdrawToBitcoin)(nil), "bitway.btcbridge.MsgWithdrawToBitcoin")
+	proto.RegisterType((*MsgWithdrawToBitcoinResponse)(nil), "bitway.btcbridge.MsgWithdrawToBitcoinResponse")
+	proto.RegisterType((*MsgMultiWithdrawToBitcoin)(nil), "bitway.btcbridge.MsgMultiWithdrawToBitcoin")
+	proto.RegisterType((*WithdrawOutput)(nil), "bitway.btcbridge.WithdrawOutput")
+	proto.RegisterType((*MsgMultiWithdrawToBitcoinResponse)(nil), "bitway.btcbridge.MsgMultiWithdrawToBitcoinResponse")
+	proto.RegisterType((*MsgSubmitSignatures)(nil), "bitway.btcbridge.MsgSubmitSignatures")
+	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "bitway.btcbridge.MsgSubmitSignaturesResponse")
+	proto.RegisterType((*MsgConsolidateVaults)(nil), "bitway.btcbridge.MsgConsolidateVaults")
+	proto.RegisterType((*MsgConsolidateVaultsResponse)(nil), "bitway.btcbridge.MsgConsolidateVaultsResponse")
+	proto.RegisterType((*MsgInitiateDKG)(nil), "bitway.btcbridge.MsgInitiateDKG")
+	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "bitway.btcbridge.MsgInitiateDKGResponse")
+	proto.RegisterType((*MsgCompleteDKG)(nil), "bitway.btcbridge.MsgCompleteDKG")
+	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "bitway.btcbridge.MsgCompleteDKGResponse")
+	proto.RegisterType((*MsgRefresh)(nil), "bitway.btcbridge.MsgRefresh")
+	proto.RegisterType((*MsgRefreshResponse)(nil), "bitway.btcbridge.MsgRefreshResponse")
+	proto.RegisterType((*MsgCompleteRefreshing)(nil), "bitway.btcbridge.MsgCompleteRefreshing")
+	proto.RegisterType((*MsgCompleteRefreshingResponse)(nil), "bitway.btcbridge.MsgCompleteRefreshingResponse")
+	proto.RegisterType((*MsgTransferVault)(nil), "bitway.btcbridge.MsgTransferVault")
+	proto.RegisterType((*MsgTransferVaultResponse)(nil), "bitway.btcbridge.MsgTransferVaultResponse")
+	proto.RegisterType((*MsgPause)(nil), "bitway.btcbridge.MsgPause")
+	proto.RegisterType((*MsgPauseResponse)(nil), "bitway.btcbridge.MsgPauseResponse")
+	proto.RegisterType((*MsgUnpause)(nil), "bitway.btcbridge.MsgUnpause")
+	proto.RegisterType((*MsgUnpauseResponse)(nil), "bitway.btcbridge.MsgUnpauseResponse")
+	proto.RegisterType((*MsgExtendPause)(nil), "bitway.btcbridge.MsgExtendPause")
+	proto.RegisterType((*MsgExtendPauseResponse)(nil), "bitway.btcbridge.MsgExtendPauseResponse")
+	proto.RegisterType((*MsgUpdateDenylist)(nil), "bitway.btcbridge.MsgUpdateDenylist")
+	proto.RegisterType((*MsgUpdateDenylistResponse)(nil), "bitway.btcbridge.MsgUpdateDenylistResponse")
+	proto.RegisterType((*MsgUpdateRuneEtching)(nil), "bitway.btcbridge.MsgUpdateRuneEtching")
+	proto.RegisterType((*MsgUpdateRuneEtchingResponse)(nil), "bitway.btcbridge.MsgUpdateRuneEtchingResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "bitway.btcbridge.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitway.btcbridge.MsgUpdateParamsResponse")
 }
 
-func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("bitway/btcbridge/tx.proto", fileDescriptor_2d8f879fc570c1be) }
+
+var fileDescriptor_2d8f879fc570c1be = []byte{
+	// 2180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x94, 0x28, 0x3d, 0x49, 0x94, 0xbc, 0x56, 0x6d, 0x6a, 0x6d, 0x53, 0x12, 0x2d,
+	0x3b, 0xb2, 0x82, 0x90, 0xb0, 0x82, 0x38, 0xa8, 0x91, 0x22, 0xad, 0xac, 0xd8, 0x35, 0x0c, 0xc5,
+	0xc2, 0x5a, 0x4e, 0xd0, 0xa2, 0x00, 0xb1, 0xcb, 0x1d, 0x2d, 0x17, 0x22, 0x77, 0xd9, 0x9d, 0x59,
+	0x59, 0xcc, 0x29, 0x48, 0x7a, 0xea, 0xa9, 0x40, 0x51, 0xa0, 0x7f, 0x40, 0xff, 0x80, 0xf4, 0x50,
+	0xa0, 0xd7, 0xde, 0x72, 0xcc, 0xa9, 0xc8, 0xa9, 0x2d, 0xec, 0xa2, 0xb9, 0xf7, 0xda, 0x4b, 0x31,
+	0x1f, 0x3b, 0x9c, 0xe5, 0x7e, 0x50, 0x8a, 0x2f, 0xed, 0x49, 0xdc, 0xf7, 0x7e, 0xf3, 0xde, 0x9b,
+	0xf7, 0x35, 0x6f, 0x46, 0xb0, 0x66, 0x7b, 0xe4, 0xa5, 0x35, 0x6a, 0xdb, 0xa4, 0x6b, 0x87, 0x9e,
+	0xe3, 0xa2, 0x36, 0x39, 0x6b, 0x0d, 0xc3, 0x80, 0x04, 0xfa, 0x0a, 0x67, 0xb5, 0x24, 0xcb, 0xb8,
+	0xd6, 0x0d, 0xf0, 0x20, 0xc0, 0xed, 0x01, 0x76, 0xdb, 0xa7, 0xf7, 0xe8, 0x1f, 0x0e, 0x35, 0x56,
+	0xdd, 0xc0, 0x0d, 0xd8, 0xcf, 0x36, 0xfd, 0x25, 0xa8, 0x0d, 0x37, 0x08, 0xdc, 0x3e, 0x6a, 0xb3,
+	0x2f, 0x3b, 0x3a, 0x6e, 0x3b, 0x51, 0x68, 0x11, 0x2f, 0xf0, 0x63, 0xbe, 0x10, 0x67, 0x5b, 0x18,
+	0xb5, 0x4f, 0xef, 0xd9, 0x88, 0x58, 0xf7, 0xda, 0xdd, 0xc0, 0x8b, 0xf9, 0x37, 0x53, 0xb6, 0x0d,
+	0xad, 0xd0, 0x1a, 0x60, 0xc1, 0xde, 0x48, 0xb1, 0xe5, 0x2f, 0x8e, 0x68, 0x7e, 0xab, 0xc1, 0xf5,
+	0x03, 0xec, 0x3e, 0x8f, 0xec, 0x81, 0x47, 0xf6, 0xd1, 0x30, 0xc0, 0x1e, 0x39, 0x0a, 0x2d, 0x1f,
+	0x5b, 0x5d, 0x6a, 0x86, 0x7e, 0x15, 0x66, 0x31, 0xf2, 0x1d, 0x14, 0xd6, 0xb5, 0x0d, 0x6d, 0x7b,
+	0xde, 0x14, 0x5f, 0xfa, 0x0d, 0x98, 0xb7, 0xfb, 0x41, 0xf7, 0xa4, 0x67, 0xe1, 0x5e, 0xbd, 0xc4,
+	0x58, 0x63, 0x82, 0xde, 0x84, 0xa5, 0x61, 0x88, 0x4e, 0x3b, 0xe4, 0xac, 0x63, 0x8f, 0x08, 0xc2,
+	0xf5, 0x32, 0x43, 0x2c, 0x50, 0xe2, 0xd1, 0xd9, 0x1e, 0x25, 0xe9, 0x6b, 0x30, 0x27, 0xd9, 0x15,
+	0xc6, 0xae, 0x12, 0xc1, 0x5a, 0x85, 0x99, 0x61, 0x18, 0x04, 0xc7, 0xf5, 0x99, 0x8d, 0xf2, 0xf6,
+	0xbc, 0xc9, 0x3f, 0xf4, 0x4d, 0x58, 0x1c, 0xa0, 0xf0, 0xa4, 0x8f, 0x3a, 0x4c, 0x51, 0x7d, 0x96,
+	0xcb, 0xe4, 0xb4, 0x3d, 0x4a, 0x7a, 0xb0, 0xf0, 0xc5, 0x77, 0x5f, 0xed, 0x08, 0x13, 0x9b, 0xb7,
+	0xe1, 0x56, 0xc1, 0xce, 0x4c, 0x84, 0x87, 0x81, 0x8f, 0x51, 0xf3, 0x9f, 0x1a, 0xdc, 0x28, 0xc0,
+	0xe1, 0x5c, 0x17, 0x7c, 0x00, 0xb3, 0xcc, 0x10, 0x5c, 0x2f, 0x6d, 0x94, 0xb7, 0x17, 0x76, 0x1b,
+	0xad, 0xc9, 0x6c, 0x68, 0x09, 0x71, 0xcc, 0xb8, 0xbd, 0xca, 0xd7, 0x7f, 0x5b, 0xbf, 0x64, 0x8a,
+	0x35, 0x74, 0xfb, 0xc2, 0x45, 0xd4, 0x3b, 0x74, 0x9b, 0x55, 0xee, 0x1d, 0xac, 0x3f, 0x82, 0x39,
+	0x87, 0x2f, 0xa4, 0x9e, 0xa1, 0xa2, 0xb7, 0x72, 0x45, 0x2b, 0x96, 0x0a, 0x05, 0x72, 0x6d, 0xd2,
+	0x1b, 0xcf, 0x60, 0x51, 0xb5, 0x26, 0x19, 0x40, 0x6d, 0x32, 0x80, 0x93, 0xbe, 0x2e, 0xa5, 0x7c,
+	0xdd, 0xfc, 0xb5, 0x06, 0x7a, 0x46, 0xc2, 0xac, 0xc3, 0x02, 0x5b, 0xd2, 0xf1, 0x7c, 0x07, 0x9d,
+	0x31, 0xc9, 0x4b, 0x26, 0x30, 0xd2, 0x13, 0x4a, 0x51, 0x73, 0x83, 0x43, 0x4a, 0x0c, 0x22, 0x72,
+	0x83, 0x63, 0xd4, 0xdc, 0x28, 0xe7, 0xe4, 0x46, 0x45, 0xc9, 0x8d, 0xe6, 0x2f, 0x61, 0x49, 0xd8,
+	0x62, 0x22, 0x1c, 0xf5, 0x89, 0xae, 0x43, 0x85, 0x9c, 0x79, 0x8e, 0xd8, 0x19, 0xfb, 0xad, 0xd7,
+	0xa1, 0x8a, 0xa3, 0x6e, 0x17, 0x61, 0xcc, 0x74, 0xce, 0x99, 0xf1, 0x27, 0x75, 0x46, 0x88, 0xba,
+	0xde, 0xd0, 0x43, 0x3e, 0x11, 0x0a, 0xc7, 0x04, 0xaa, 0x12, 0x85, 0x61, 0x10, 0x8a, 0x34, 0xe5,
+	0x1f, 0x4d, 0x17, 0xb6, 0x8a, 0xd2, 0x26, 0xce, 0x2f, 0xfd, 0x43, 0xa8, 0x86, 0xcc, 0x26, 0x5c,
+	0xd7, 0x58, 0x30, 0xd7, 0x73, 0x83, 0xc9, 0x6d, 0x17, 0x71, 0x8c, 0x57, 0x35, 0xff, 0xa4, 0x26,
+	0xe8, 0xa7, 0x1e, 0xe9, 0x39, 0xa1, 0xf5, 0xf2, 0xcd, 0x6b, 0xf4, 0xa2, 0x3e, 0x4e, 0xe5, 0xc4,
+	0xcc, 0x94, 0xfa, 0xbb, 0x03, 0x5b, 0x45, 0x66, 0xcb, 0x02, 0x34, 0x61, 0x45, 0xe2, 0x1e, 0x21,
+	0x64, 0x5a, 0x04, 0xe5, 0x6e, 0x69, 0x0d, 0xe6, 0x8e, 0x11, 0xea, 0x84, 0x16, 0x41, 0x6c, 0x47,
+	0x65, 0xb3, 0x7a, 0xcc, 0x97, 0x24, 0x75, 0x1b, 0x50, 0x9f, 0x94, 0x29, 0xf5, 0xf9, 0x50, 0x3b,
+	0xc0, 0xee, 0x5e, 0xe0, 0x3b, 0x26, 0xea, 0x5b, 0x23, 0x14, 0xe6, 0x6a, 0x7b, 0x1f, 0x66, 0xad,
+	0x41, 0x10, 0xf9, 0x84, 0xe9, 0x5a, 0xd8, 0x5d, 0x6b, 0xf1, 0x76, 0xdc, 0xa2, 0xed, 0xb8, 0x25,
+	0xda, 0x71, 0xeb, 0x61, 0xe0, 0xc5, 0xb5, 0x27, 0xe0, 0x49, 0x5b, 0xea, 0x70, 0x35, 0xa9, 0x4f,
+	0x5a, 0xf2, 0x3e, 0xdb, 0xf9, 0x0b, 0xdf, 0x9e, 0x6e, 0x4b, 0xd6, 0xf6, 0x12, 0x0b, 0xa5, 0xd0,
+	0x3f, 0x6b, 0xb0, 0x4c, 0xf7, 0xde, 0xb7, 0x70, 0x2f, 0x16, 0x7a, 0x03, 0xe6, 0xad, 0x88, 0xf4,
+	0x82, 0xd0, 0x23, 0xa3, 0xb8, 0xd8, 0x25, 0x81, 0xd6, 0x45, 0xc8, 0x81, 0x22, 0x4b, 0xe2, 0x4f,
+	0x59, 0x45, 0x65, 0xa5, 0x8a, 0x9e, 0xc0, 0xe2, 0xc0, 0xc3, 0x36, 0xea, 0x59, 0xa7, 0x5e, 0x10,
+	0xf1, 0xa2, 0xa8, 0xed, 0xde, 0x4e, 0x27, 0xb5, 0x50, 0x7e, 0xa0, 0x80, 0xcd, 0xc4, 0xd2, 0x07,
+	0x35, 0xba, 0xa7, 0xb1, 0x21, 0xcd, 0x35, 0xb8, 0x36, 0x61, 0xb9, 0xdc, 0xd5, 0x67, 0xb0, 0x2a,
+	0x03, 0x6a, 0x46, 0x3e, 0xfa, 0x88, 0x74, 0x7b, 0x9e, 0xef, 0xe6, 0x86, 0xee, 0x47, 0x50, 0x45,
+	0x1c, 0x22, 0x62, 0x77, 0x33, 0xc3, 0xc0, 0xb1, 0x9c, 0xb8, 0xe6, 0xc4, 0x9a, 0xa4, 0xb7, 0x1b,
+	0x4a, 0xfd, 0x29, 0x6b, 0xa4, 0x6d, 0x2e, 0xe3, 0xbf, 0x18, 0x3a, 0x16, 0x41, 0x47, 0x61, 0x84,
+	0x09, 0x72, 0x1e, 0x21, 0x74, 0x18, 0x06, 0xa7, 0x9e, 0x83, 0xc2, 0xfc, 0x03, 0xa4, 0x09, 0x8b,
+	0x2a, 0x8e, 0x1d, 0x23, 0xf3, 0x66, 0x82, 0x96, 0x55, 0x51, 0xb9, 0x8a, 0xa4, 0x41, 0xcf, 0x99,
+	0xb3, 0x64, 0xcd, 0x05, 0x7b, 0x1e, 0xa1, 0x33, 0x43, 0xae, 0x21, 0x57, 0x13, 0x79, 0x3e, 0x9f,
+	0x9d, 0xc6, 0xdc, 0x0b, 0x29, 0xa1, 0x52, 0xe9, 0x17, 0x1a, 0xac, 0x1d, 0x60, 0xf7, 0x20, 0xea,
+	0x13, 0xef, 0xfc, 0xaa, 0x7f, 0x0c, 0xd5, 0x20, 0x22, 0xc3, 0x88, 0xc4, 0xa7, 0xe8, 0x46, 0x3a,
+	0x4e, 0xb1, 0xb4, 0x67, 0x0c, 0x18, 0x87, 0x4a, 0x2c, 0x4b, 0x1a, 0xb9, 0x07, 0xb5, 0x24, 0x9a,
+	0x26, 0xb7, 0xe5, 0x38, 0x21, 0x6d, 0xfa, 0x5c, 0x73, 0xfc, 0x99, 0xb7, 0xeb, 0xe6, 0x0f, 0x61,
+	0x33, 0x77, 0x1f, 0xb2, 0xab, 0xaf, 0xc2, 0x8c, 0x1b, 0x06, 0xd1, 0x90, 0x09, 0xad, 0x98, 0xfc,
+	0xa3, 0xe9, 0xc3, 0x15, 0x99, 0x29, 0xcf, 0x3d, 0xd7, 0xb7, 0x48, 0x14, 0xa2, 0xfc, 0x04, 0x88,
+	0xcb, 0xab, 0xa4, 0x94, 0x57, 0x03, 0x00, 0xcb, 0x95, 0x62, 0x32, 0x50, 0x28, 0xc9, 0xed, 0xde,
+	0x84, 0xeb, 0x19, 0xfa, 0x64, 0x48, 0x7e, 0x5b, 0x62, 0x89, 0xf0, 0x30, 0xf0, 0x71, 0xd0, 0xf7,
+	0x68, 0xd6, 0x7c, 0x62, 0xd1, 0x23, 0x65, 0x4a, 0x3f, 0xb8, 0x05, 0x4b, 0xa7, 0x14, 0xd7, 0x39,
+	0x45, 0x21, 0xf6, 0x02, 0x9f, 0xd9, 0x57, 0x31, 0x17, 0x19, 0xf1, 0x13, 0x4e, 0xd3, 0x9f, 0xc1,
+	0x65, 0x9b, 0x74, 0x3b, 0x5d, 0x29, 0x9b, 0x02, 0xcb, 0xac, 0xd4, 0x9a, 0xe9, 0x10, 0xee, 0x91,
+	0xee, 0x43, 0x15, 0x69, 0xae, 0xd8, 0x13, 0x14, 0xfd, 0x53, 0x58, 0x0d, 0x23, 0x1f, 0xe1, 0xa4,
+	0xc8, 0x82, 0x09, 0x88, 0x96, 0x22, 0x4e, 0x4a, 0xbd, 0x12, 0xa6, 0x68, 0x38, 0xd5, 0x65, 0x78,
+	0x22, 0xa7, 0x9c, 0x22, 0xbd, 0xf6, 0xc7, 0x12, 0x3b, 0x20, 0x9e, 0xf8, 0x1e, 0xf1, 0x2c, 0x82,
+	0xf6, 0x9f, 0x3e, 0x9e, 0xe2, 0xaf, 0x7d, 0x58, 0x1c, 0x5a, 0x21, 0xf1, 0xba, 0xde, 0xd0, 0xf2,
+	0x8b, 0x12, 0x79, 0xff, 0xe9, 0xe3, 0xc3, 0x31, 0xd0, 0x4c, 0xac, 0xa2, 0x3a, 0x48, 0x2f, 0x44,
+	0xb8, 0x17, 0xf4, 0x79, 0xc3, 0x5d, 0x32, 0xc7, 0x04, 0xfd, 0x03, 0x58, 0xe0, 0x31, 0x21, 0xa3,
+	0x21, 0xe2, 0x4e, 0xa9, 0xed, 0x5e, 0x4f, 0xab, 0xf8, 0x09, 0xc6, 0x88, 0x1c, 0x8d, 0x86, 0xc8,
+	0x04, 0x86, 0xa7, 0x3f, 0xb1, 0xfe, 0x16, 0x2c, 0x23, 0xdf, 0xb2, 0xfb, 0xa8, 0x43, 0xe8, 0x01,
+	0x7c, 0x8c, 0x42, 0x76, 0x7a, 0xcf, 0x99, 0x35, 0x4e, 0x3e, 0x12, 0x54, 0xfd, 0x0e, 0x2c, 0x13,
+	0x2b, 0x74, 0x11, 0xe9, 0x44, 0xe4, 0x2c, 0xe8, 0xf8, 0xd1, 0x80, 0x8d, 0xd9, 0x4b, 0xe6, 0x12,
+	0x27, 0xbf, 0x20, 0x67, 0xc1, 0xc7, 0xd1, 0x20, 0xe5, 0x53, 0x7e, 0xc6, 0x29, 0x2e, 0x93, 0xde,
+	0xfc, 0x97, 0xc6, 0xbc, 0xf9, 0x30, 0x18, 0x0c, 0xfb, 0x88, 0x7b, 0x33, 0xaf, 0x1c, 0x6a, 0x50,
+	0x12, 0xc5, 0x50, 0x31, 0x4b, 0x9e, 0x43, 0x71, 0x6c, 0x0f, 0x71, 0x19, 0x88, 0x2f, 0xfd, 0x2e,
+	0xac, 0xd0, 0x1c, 0x41, 0x3e, 0x8e, 0x70, 0x67, 0x18, 0xd9, 0x27, 0x68, 0x24, 0x46, 0xb3, 0x65,
+	0x49, 0x3f, 0x64, 0x64, 0xea, 0x54, 0x59, 0x3b, 0x62, 0x60, 0x19, 0x13, 0x68, 0xa2, 0x7b, 0x3e,
+	0x41, 0xa1, 0x6f, 0xf5, 0x3b, 0x27, 0x68, 0x84, 0xeb, 0xb3, 0xbc, 0x03, 0xc7, 0xc4, 0xa7, 0x68,
+	0xc4, 0x07, 0xf5, 0xc8, 0xe6, 0xfc, 0xaa, 0x18, 0xd4, 0x23, 0x9b, 0xb2, 0xb2, 0x8e, 0x79, 0x65,
+	0x9f, 0xd2, 0x05, 0x7f, 0xd5, 0x00, 0x0e, 0xb0, 0x6b, 0xa2, 0x63, 0x1a, 0xcd, 0x29, 0xc9, 0x74,
+	0x0d, 0xaa, 0xce, 0x89, 0xdb, 0xf1, 0x1c, 0x9e, 0x47, 0x15, 0x73, 0xd6, 0x39, 0x71, 0x9f, 0x38,
+	0x58, 0xbf, 0x07, 0xab, 0x21, 0x1a, 0x04, 0xa7, 0xc8, 0xe9, 0x24, 0xb2, 0x8d, 0xfb, 0xe6, 0x8a,
+	0xe0, 0x1d, 0xaa, 0x29, 0xf5, 0x31, 0xac, 0x10, 0x6f, 0x80, 0x82, 0x88, 0x74, 0xe2, 0x7b, 0x65,
+	0xbd, 0x22, 0x26, 0x19, 0x7e, 0xf1, 0x6c, 0xc5, 0x17, 0xcf, 0xd6, 0xbe, 0x00, 0xec, 0xcd, 0xd1,
+	0xf6, 0xfa, 0xfb, 0xbf, 0xaf, 0x6b, 0xe6, 0xb2, 0x58, 0x1c, 0xb3, 0x52, 0x51, 0x5f, 0x05, 0x7d,
+	0xbc, 0x2f, 0xb9, 0xdd, 0xdf, 0x69, 0xf0, 0x03, 0xc5, 0x13, 0x82, 0x5d, 0x74, 0x58, 0x4f, 0x06,
+	0x3e, 0x2b, 0xc0, 0xe5, 0x73, 0x04, 0xb8, 0x32, 0x11, 0xe0, 0x64, 0x80, 0xd6, 0xe1, 0x66, 0xa6,
+	0x59, 0xd2, 0xf0, 0x5f, 0x95, 0xd8, 0x3c, 0x16, 0x17, 0x03, 0x6b, 0x0b, 0x53, 0xa2, 0x75, 0x1b,
+	0x6a, 0x38, 0x88, 0xc2, 0x2e, 0x9a, 0xe8, 0x95, 0x4b, 0x9c, 0x1a, 0x37, 0xcb, 0x4d, 0x58, 0x74,
+	0x10, 0x1e, 0x37, 0xd4, 0x32, 0x03, 0x2d, 0x50, 0x5a, 0x0c, 0x79, 0x00, 0x60, 0xd1, 0xda, 0x65,
+	0x05, 0x2e, 0x86, 0xaa, 0xc2, 0xfa, 0x9e, 0xb7, 0xe2, 0x9f, 0x6c, 0x5e, 0xc7, 0x36, 0xc1, 0xf2,
+	0xbe, 0x4c, 0x3f, 0xbe, 0x77, 0x2d, 0xf3, 0xe1, 0x32, 0xe1, 0x05, 0xe9, 0xa2, 0xff, 0x68, 0x30,
+	0x77, 0x80, 0xdd, 0x43, 0x2b, 0xc2, 0xf9, 0x43, 0xfa, 0x7b, 0x30, 0xcb, 0x35, 0x30, 0x67, 0xd4,
+	0xb2, 0x46, 0x2f, 0x26, 0xe0, 0x88, 0x81, 0x4c, 0x01, 0x9e, 0xf0, 0x40, 0xf9, 0x42, 0x1e, 0xf8,
+	0x10, 0xe6, 0xbe, 0x4f, 0x86, 0xcb, 0x45, 0x74, 0x2f, 0x21, 0xb2, 0x70, 0xe0, 0x8b, 0x2e, 0x21,
+	0xbe, 0x92, 0x19, 0xd4, 0x64, 0xf9, 0xc1, 0x6c, 0x97, 0x83, 0x00, 0xcf, 0x5d, 0x2d, 0xce, 0xdd,
+	0xe6, 0x1f, 0x78, 0xb1, 0xbf, 0xf0, 0x87, 0xff, 0x63, 0x3e, 0x4a, 0x6e, 0x85, 0x97, 0xae, 0xb0,
+	0x52, 0x86, 0xf7, 0xdf, 0xbc, 0x59, 0x7f, 0x74, 0x46, 0x90, 0xef, 0xf0, 0x20, 0x17, 0xe7, 0xff,
+	0xff, 0x61, 0xa8, 0x73, 0xce, 0x2e, 0x65, 0xcf, 0xe3, 0xf9, 0x49, 0x83, 0xcb, 0x72, 0xe0, 0xde,
+	0x47, 0xfe, 0xa8, 0xef, 0xe1, 0x69, 0x1d, 0x81, 0x72, 0x1d, 0xc7, 0xe3, 0xb3, 0x0b, 0x9f, 0xe8,
+	0xc7, 0x04, 0xdd, 0x80, 0x39, 0xd6, 0xa8, 0xad, 0x7e, 0xdc, 0xb8, 0xe5, 0xb7, 0x92, 0x82, 0x95,
+	0x44, 0x0a, 0x4e, 0xda, 0x7b, 0x1d, 0xd6, 0x52, 0x46, 0x49, 0x93, 0xbf, 0xd4, 0x60, 0x55, 0x72,
+	0xd5, 0x8b, 0x52, 0xb1, 0xd5, 0x6f, 0x78, 0x5d, 0xca, 0x1e, 0xb1, 0x52, 0x46, 0x48, 0x2b, 0x5f,
+	0xc2, 0xb2, 0xe4, 0x1f, 0xb2, 0x07, 0xcb, 0x29, 0xf6, 0xdd, 0x87, 0x59, 0xfe, 0xb0, 0x29, 0xcc,
+	0xab, 0x67, 0xe5, 0x19, 0xe5, 0xc7, 0x17, 0x71, 0x8e, 0xce, 0xb9, 0x61, 0xaa, 0x8a, 0x63, 0x9b,
+	0x76, 0xff, 0x72, 0x05, 0xca, 0x07, 0xd8, 0xd5, 0x3f, 0xd7, 0xa0, 0x9e, 0xfb, 0x1c, 0xfa, 0x4e,
+	0x5a, 0x6f, 0xc1, 0x23, 0x90, 0xf1, 0xde, 0x85, 0xe0, 0xb2, 0xa7, 0x7c, 0xa9, 0xc1, 0x5a, 0xfe,
+	0x7b, 0x64, 0xeb, 0x42, 0x42, 0xb1, 0x71, 0xff, 0x62, 0xf8, 0x0c, 0x2b, 0xb2, 0x1e, 0x9d, 0x8a,
+	0xac, 0xc8, 0xc0, 0x1b, 0xf7, 0x2f, 0x86, 0x97, 0x56, 0x74, 0x60, 0x29, 0xf9, 0x34, 0xd4, 0x2c,
+	0x10, 0x24, 0x30, 0xc6, 0xce, 0x74, 0x8c, 0x54, 0xf0, 0x33, 0x58, 0x50, 0xdf, 0x82, 0x36, 0x32,
+	0x97, 0x2a, 0x08, 0x63, 0x7b, 0x1a, 0x42, 0xb5, 0x3d, 0xf9, 0xb8, 0x93, 0x6d, 0x7b, 0x02, 0x63,
+	0xec, 0x4c, 0xc7, 0x48, 0x05, 0xbf, 0x80, 0xc5, 0xc4, 0x3b, 0xcf, 0x66, 0xf6, 0xbe, 0x15, 0x88,
+	0x71, 0x77, 0x2a, 0x44, 0x4a, 0x3f, 0x81, 0xcb, 0xe9, 0x07, 0x97, 0x3b, 0x05, 0xae, 0x55, 0x70,
+	0x46, 0xeb, 0x7c, 0xb8, 0x44, 0xb6, 0xe5, 0x3f, 0xa1, 0x64, 0x4b, 0xcb, 0xc5, 0x1b, 0xf7, 0x2f,
+	0x86, 0x57, 0xb7, 0x9c, 0x7e, 0xbb, 0xc8, 0xde, 0x72, 0x0a, 0x67, 0xb4, 0xce, 0x87, 0x93, 0xca,
+	0x3e, 0x83, 0xab, 0x39, 0xaf, 0x25, 0x6f, 0x67, 0x4a, 0xca, 0x06, 0x1b, 0xef, 0x5e, 0x00, 0x2c,
+	0x75, 0xf7, 0x60, 0x25, 0xf5, 0x4c, 0x71, 0xbb, 0x20, 0x64, 0x63, 0x98, 0xf1, 0xce, 0xb9, 0x60,
+	0xaa, 0x4b, 0xd3, 0x0f, 0x10, 0xd9, 0x2e, 0x4d, 0xe1, 0x8c, 0xd6, 0xf9, 0x70, 0x6a, 0x31, 0xab,
+	0xf7, 0xf6, 0xec, 0x62, 0x56, 0x10, 0xc6, 0xf6, 0x34, 0x84, 0x2a, 0x5a, 0xbd, 0xc4, 0x6e, 0xe4,
+	0x58, 0x26, 0x11, 0xc6, 0xf6, 0x34, 0x84, 0x14, 0x7d, 0x00, 0x55, 0x79, 0x39, 0xcc, 0x5c, 0x24,
+	0xb8, 0xc6, 0x56, 0x11, 0x57, 0x8a, 0xf3, 0x41, 0xcf, 0xb8, 0x7c, 0xbd, 0x55, 0x68, 0xce, 0x18,
+	0x68, 0xb4, 0xcf, 0x09, 0x54, 0xdb, 0x5c, 0xf2, 0xce, 0x94, 0xdd, 0xe6, 0x12, 0x18, 0x63, 0x67,
+	0x3a, 0x46, 0x2a, 0x78, 0x0c, 0x33, 0x7c, 0x18, 0x35, 0x32, 0x17, 0x31, 0x9e, 0xd1, 0xcc, 0xe7,
+	0xa9, 0x8e, 0x8e, 0x07, 0xf3, 0x1b, 0x39, 0x6d, 0x96, 0x71, 0x8d, 0xad, 0x22, 0xae, 0x9a, 0x12,
+	0xea, 0xa8, 0x9c, 0x9d, 0x12, 0x0a, 0xc2, 0xd8, 0x9e, 0x86, 0x90, 0xa2, 0x6d, 0xa8, 0x4d, 0x8c,
+	0x9d, 0xb7, 0x0a, 0x5a, 0x5a, 0x0c, 0x32, 0xde, 0x3e, 0x07, 0x48, 0xad, 0xcc, 0xf4, 0x9c, 0x78,
+	0xa7, 0x40, 0xc2, 0xf4, 0xfe, 0x9e, 0x3b, 0xf2, 0xd1, 0xa3, 0x2a, 0x31, 0xef, 0x6d, 0x16, 0xac,
+	0xe7, 0x10, 0xe3, 0xee, 0x54, 0x48, 0x2c, 0xdd, 0x98, 0xf9, 0xfc, 0xbb, 0xaf, 0x76, 0xb4, 0xbd,
+	0x9f, 0x7e, 0xfd, 0xaa, 0xa1, 0x7d, 0xf3, 0xaa, 0xa1, 0xfd, 0xe3, 0x55, 0x43, 0xfb, 0xcd, 0xeb,
+	0xc6, 0xa5, 0x6f, 0x5e, 0x37, 0x2e, 0x7d, 0xfb, 0xba, 0x71, 0xe9, 0xe7, 0x2d, 0xd7, 0x23, 0xbd,
+	0xc8, 0x6e, 0x75, 0x83, 0x41, 0x9b, 0x4b, 0xed, 0x5b, 0x36, 0x16, 0x3f, 0xdb, 0x67, 0xea, 0x3f,
+	0xf7, 0xe9, 0x8b, 0x99, 0x3d, 0xcb, 0xee, 0x12, 0xef, 0xfe, 0x77, 0x00, 0x67, 0x6e, 0x0e, 0x32,
+	0xfd, 0x1f, 0x00, 0x00,
 }
 
-func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitDepositTransactions submits a batch of bitcoin deposit transactions to the bitway chain.
+	SubmitDepositTransactions(ctx context.Context, in *MsgSubmitDepositTransactions, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionsResponse, error)
+	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
+	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
+	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
+	SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error)
+	// BondRelayer bonds the stake to register or top up the relayer.
+	BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error)
+	// UnbondRelayer starts unbonding the stake of the relayer.
+	UnbondRelayer(ctx context.Context, in *MsgUnbondRelayer, opts ...grpc.CallOption) (*MsgUnbondRelayerResponse, error)
+	// SlashRelayer slashes the relayer for the given misbehaviour adjudicated by governance.
+	// This is a governance only slash and no misbehaviour evidence is verified on chain.
+	SlashRelayer(ctx context.Context, in *MsgSlashRelayer, opts ...grpc.CallOption) (*MsgSlashRelayerResponse, error)
+	// SubmitRuneEtching submits the etching data of the rune by the relayer.
+	SubmitRuneEtching(ctx context.Context, in *MsgSubmitRuneEtching, opts ...grpc.CallOption) (*MsgSubmitRuneEtchingResponse, error)
+	// UpdateTrustedFeeProviders updates the trusted fee providers.
+	UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error)
+	// WithdrawToBitcoin withdraws the asset to bitcoin.
+	WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error)
+	// MultiWithdrawToBitcoin withdraws btc to multiple bitcoin addresses.
+	MultiWithdrawToBitcoin(ctx context.Context, in *MsgMultiWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgMultiWithdrawToBitcoinResponse, error)
+	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
+	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
+	// ConsolidateVaults performs the utxo consolidation for the given vaults.
+	ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error)
+	// InitiateDKG initiates the DKG request.
+	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
+	// CompleteDKG completes the given DKG request.
+	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
+	// Refresh refreshes key shares.
+	Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error)
+	// CompleteRefreshing completes the given refreshing request by the participant.
+	CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error)
+	// TransferVault transfers the vault asset from the source version to the destination version.
+	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
+	// Pause pauses the given target by the guardian or governance.
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	// Unpause lifts the active pause of the given target by the guardian or governance.
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	// ExtendPause extends the active pause of the given target by governance.
+	ExtendPause(ctx context.Context, in *MsgExtendPause, opts ...grpc.CallOption) (*MsgExtendPauseResponse, error)
+	// UpdateDenylist updates the address denylist by governance.
+	UpdateDenylist(ctx context.Context, in *MsgUpdateDenylist, opts ...grpc.CallOption) (*MsgUpdateDenylistResponse, error)
+	// UpdateRuneEtching sets the etching data of the rune by governance.
+	UpdateRuneEtching(ctx context.Context, in *MsgUpdateRuneEtching, opts ...grpc.CallOption) (*MsgUpdateRuneEtchingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/btcbridge module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
+	out := new(MsgSubmitDepositTransactionResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitDepositTransactions(ctx context.Context, in *MsgSubmitDepositTransactions, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionsResponse, error) {
+	out := new(MsgSubmitDepositTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitDepositTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Vaults[iNdEx])
-			copy(dAtA[i:], m.Vaults[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Vaults[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
+	out := new(MsgSubmitWithdrawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error) {
+	out := new(MsgSubmitFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error) {
+	out := new(MsgBondRelayerResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/BondRelayer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnbondRelayer(ctx context.Context, in *MsgUnbondRelayer, opts ...grpc.CallOption) (*MsgUnbondRelayerResponse, error) {
+	out := new(MsgUnbondRelayerResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UnbondRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SlashRelayer(ctx context.Context, in *MsgSlashRelayer, opts ...grpc.CallOption) (*MsgSlashRelayerResponse, error) {
+	out := new(MsgSlashRelayerResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SlashRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRefresh) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitRuneEtching(ctx context.Context, in *MsgSubmitRuneEtching, opts ...grpc.CallOption) (*MsgSubmitRuneEtchingResponse, error) {
+	out := new(MsgSubmitRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitRuneEtching", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRefresh) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error) {
+	out := new(MsgUpdateTrustedFeeProvidersResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.RemovedParticipants) > 0 {
-		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedParticipants[iNdEx])
-			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DkgIds) > 0 {
-		dAtA6 := make([]byte, len(m.DkgIds)*10)
-		var j5 int
-		for _, num := range m.DkgIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error) {
+	out := new(MsgWithdrawToBitcoinResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/WithdrawToBitcoin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRefreshResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MultiWithdrawToBitcoin(ctx context.Context, in *MsgMultiWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgMultiWithdrawToBitcoinResponse, error) {
+	out := new(MsgMultiWithdrawToBitcoinResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/MultiWithdrawToBitcoin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRefreshResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
+	out := new(MsgSubmitSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/SubmitSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error) {
+	out := new(MsgConsolidateVaultsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/ConsolidateVaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
+	out := new(MsgInitiateDKGResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/InitiateDKG", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
+	out := new(MsgCompleteDKGResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/CompleteDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error) {
+	out := new(MsgRefreshResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error) {
+	out := new(MsgCompleteRefreshingResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/CompleteRefreshing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error) {
+	out := new(MsgTransferVaultResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/TransferVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteRefreshingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTransferVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/Unpause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTransferVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendPause(ctx context.Context, in *MsgExtendPause, opts ...grpc.CallOption) (*MsgExtendPauseResponse, error) {
+	out := new(MsgExtendPauseResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/ExtendPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTransferVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Psbts) > 0 {
-		for iNdEx := len(m.Psbts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Psbts[iNdEx])
-			copy(dAtA[i:], m.Psbts[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Psbts[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.AssetType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DestVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SourceVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SourceVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateDenylist(ctx context.Context, in *MsgUpdateDenylist, opts ...grpc.CallOption) (*MsgUpdateDenylistResponse, error) {
+	out := new(MsgUpdateDenylistResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTransferVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRuneEtching(ctx context.Context, in *MsgUpdateRuneEtching, opts ...grpc.CallOption) (*MsgUpdateRuneEtchingResponse, error) {
+	out := new(MsgUpdateRuneEtchingResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateRuneEtching", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTransferVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.btcbridge.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTransferVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitDepositTransactions submits a batch of bitcoin deposit transactions to the bitway chain.
+	SubmitDepositTransactions(context.Context, *MsgSubmitDepositTransactions) (*MsgSubmitDepositTransactionsResponse, error)
+	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the bitway chain.
+	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error)
+	// SubmitFeeRate submits the bitcoin network fee rate to the bitway chain.
+	SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error)
+	// BondRelayer bonds the stake to register or top up the relayer.
+	BondRelayer(context.Context, *MsgBondRelayer) (*MsgBondRelayerResponse, error)
+	// UnbondRelayer starts unbonding the stake of the relayer.
+	UnbondRelayer(context.Context, *MsgUnbondRelayer) (*MsgUnbondRelayerResponse, error)
+	// SlashRelayer slashes the relayer for the given misbehaviour adjudicated by governance.
+	// This is a governance only slash and no misbehaviour evidence is verified on chain.
+	SlashRelayer(context.Context, *MsgSlashRelayer) (*MsgSlashRelayerResponse, error)
+	// SubmitRuneEtching submits the etching data of the rune by the relayer.
+	SubmitRuneEtching(context.Context, *MsgSubmitRuneEtching) (*MsgSubmitRuneEtchingResponse, error)
+	// UpdateTrustedFeeProviders updates the trusted fee providers.
+	UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error)
+	// WithdrawToBitcoin withdraws the asset to bitcoin.
+	WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error)
+	// MultiWithdrawToBitcoin withdraws btc to multiple bitcoin addresses.
+	MultiWithdrawToBitcoin(context.Context, *MsgMultiWithdrawToBitcoin) (*MsgMultiWithdrawToBitcoinResponse, error)
+	// SubmitSignatures submits the signatures of the signing request to the bitway chain.
+	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
+	// ConsolidateVaults performs the utxo consolidation for the given vaults.
+	ConsolidateVaults(context.Context, *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error)
+	// InitiateDKG initiates the DKG request.
+	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
+	// CompleteDKG completes the given DKG request.
+	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
+	// Refresh refreshes key shares.
+	Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error)
+	// CompleteRefreshing completes the given refreshing request by the participant.
+	CompleteRefreshing(context.Context, *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error)
+	// TransferVault transfers the vault asset from the source version to the destination version.
+	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
+	// Pause pauses the given target by the guardian or governance.
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	// Unpause lifts the active pause of the given target by the guardian or governance.
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	// ExtendPause extends the active pause of the given target by governance.
+	ExtendPause(context.Context, *MsgExtendPause) (*MsgExtendPauseResponse, error)
+	// UpdateDenylist updates the address denylist by governance.
+	UpdateDenylist(context.Context, *MsgUpdateDenylist) (*MsgUpdateDenylistResponse, error)
+	// UpdateRuneEtching sets the etching data of the rune by governance.
+	UpdateRuneEtching(context.Context, *MsgUpdateRuneEtching) (*MsgUpdateRuneEtchingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/btcbridge module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SubmitDepositTransactions(ctx context.Context, req *MsgSubmitDepositTransactions) (*MsgSubmitDepositTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransactions not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitFeeRate(ctx context.Context, req *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeeRate not implemented")
+}
+func (*UnimplementedMsgServer) BondRelayer(ctx context.Context, req *MsgBondRelayer) (*MsgBondRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondRelayer not implemented")
+}
+func (*UnimplementedMsgServer) UnbondRelayer(ctx context.Context, req *MsgUnbondRelayer) (*MsgUnbondRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondRelayer not implemented")
+}
+func (*UnimplementedMsgServer) SlashRelayer(ctx context.Context, req *MsgSlashRelayer) (*MsgSlashRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRelayer not implemented")
+}
+func (*UnimplementedMsgServer) SubmitRuneEtching(ctx context.Context, req *MsgSubmitRuneEtching) (*MsgSubmitRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRuneEtching not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTrustedFeeProviders(ctx context.Context, req *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedFeeProviders not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawToBitcoin(ctx context.Context, req *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawToBitcoin not implemented")
+}
+func (*UnimplementedMsgServer) MultiWithdrawToBitcoin(ctx context.Context, req *MsgMultiWithdrawToBitcoin) (*MsgMultiWithdrawToBitcoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiWithdrawToBitcoin not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+}
+func (*UnimplementedMsgServer) ConsolidateVaults(ctx context.Context, req *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateVaults not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+}
+func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+}
+func (*UnimplementedMsgServer) Refresh(ctx context.Context, req *MsgRefresh) (*MsgRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (*UnimplementedMsgServer) CompleteRefreshing(ctx context.Context, req *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRefreshing not implemented")
+}
+func (*UnimplementedMsgServer) TransferVault(ctx context.Context, req *MsgTransferVault) (*MsgTransferVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
+}
+func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (*UnimplementedMsgServer) ExtendPause(ctx context.Context, req *MsgExtendPause) (*MsgExtendPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendPause not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDenylist(ctx context.Context, req *MsgUpdateDenylist) (*MsgUpdateDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenylist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRuneEtching(ctx context.Context, req *MsgUpdateRuneEtching) (*MsgUpdateRuneEtchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuneEtching not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDepositTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSubmitDepositTransaction) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitDepositTransaction",
 	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransaction))
 	}
-	l = len(m.PrevTxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDepositTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDepositTransactions)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDepositTransactions(ctx, in)
 	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitDepositTransactions",
 	}
-	return n
-}
-
-func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDepositTransactions(ctx, req.(*MsgSubmitDepositTransactions))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitWithdrawTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitWithdrawTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitWithdrawTransaction",
 	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransaction))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSubmitFeeRate) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFeeRate(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitFeeRate",
 	}
-	if m.FeeRate != 0 {
-		n += 1 + sovTx(uint64(m.FeeRate))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFeeRate(ctx, req.(*MsgSubmitFeeRate))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitFeeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BondRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateTrustedNonBtcRelayers) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).BondRelayer(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/BondRelayer",
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondRelayer(ctx, req.(*MsgBondRelayer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnbondRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateTrustedFeeProviders) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondRelayer(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/UnbondRelayer",
 	}
-	if len(m.FeeProviders) > 0 {
-		for _, s := range m.FeeProviders {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondRelayer(ctx, req.(*MsgUnbondRelayer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SlashRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawToBitcoin) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SlashRelayer(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SlashRelayer",
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashRelayer(ctx, req.(*MsgSlashRelayer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawToBitcoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRuneEtching)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitRuneEtching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitRuneEtching",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitRuneEtching(ctx, req.(*MsgSubmitRuneEtching))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSignatures) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTrustedFeeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustedFeeProviders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, in)
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/UpdateTrustedFeeProviders",
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, req.(*MsgUpdateTrustedFeeProviders))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSignaturesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawToBitcoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawToBitcoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/WithdrawToBitcoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawToBitcoin(ctx, req.(*MsgWithdrawToBitcoin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConsolidateVaults) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MultiWithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiWithdrawToBitcoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VaultVersion != 0 {
-		n += 1 + sovTx(uint64(m.VaultVersion))
+	if interceptor == nil {
+		return srv.(MsgServer).MultiWithdrawToBitcoin(ctx, in)
 	}
-	if m.BtcConsolidation != nil {
-		l = m.BtcConsolidation.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/MultiWithdrawToBitcoin",
 	}
-	if len(m.RunesConsolidations) > 0 {
-		for _, e := range m.RunesConsolidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiWithdrawToBitcoin(ctx, req.(*MsgMultiWithdrawToBitcoin))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConsolidateVaultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/SubmitSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateDKG) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConsolidateVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConsolidateVaults)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConsolidateVaults(ctx, in)
 	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/ConsolidateVaults",
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConsolidateVaults(ctx, req.(*MsgConsolidateVaults))
 	}
-	if len(m.VaultTypes) > 0 {
-		l = 0
-		for _, e := range m.VaultTypes {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDKG)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EnableTransfer {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDKG(ctx, in)
 	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/InitiateDKG",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteDKG)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCompleteDKG) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteDKG(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/CompleteDKG",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
 	}
-	if len(m.Vaults) > 0 {
-		for _, s := range m.Vaults {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefresh)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Refresh(ctx, in)
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/Refresh",
 	}
-	return n
-}
-
-func (m *MsgCompleteDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refresh(ctx, req.(*MsgRefresh))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefresh) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteRefreshing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteRefreshing)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteRefreshing(ctx, in)
 	}
-	if len(m.DkgIds) > 0 {
-		l = 0
-		for _, e := range m.DkgIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/CompleteRefreshing",
 	}
-	if len(m.RemovedParticipants) > 0 {
-		for _, s := range m.RemovedParticipants {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteRefreshing(ctx, req.(*MsgCompleteRefreshing))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefreshResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVault)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/TransferVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVault(ctx, req.(*MsgTransferVault))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteRefreshing) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/Pause",
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteRefreshingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgTransferVault) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/Unpause",
 	}
-	if m.SourceVersion != 0 {
-		n += 1 + sovTx(uint64(m.SourceVersion))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
 	}
-	if m.DestVersion != 0 {
-		n += 1 + sovTx(uint64(m.DestVersion))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AssetType != 0 {
-		n += 1 + sovTx(uint64(m.AssetType))
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendPause(ctx, in)
 	}
-	if len(m.Psbts) > 0 {
-		for _, s := range m.Psbts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/ExtendPause",
 	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendPause(ctx, req.(*MsgExtendPause))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/UpdateDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenylist(ctx, req.(*MsgUpdateDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRuneEtching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRuneEtching)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRuneEtching(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/UpdateRuneEtching",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRuneEtching(ctx, req.(*MsgUpdateRuneEtching))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.btcbridge.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitway.btcbridge.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitDepositTransaction",
+			Handler:    _Msg_SubmitDepositTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitDepositTransactions",
+			Handler:    _Msg_SubmitDepositTransactions_Handler,
+		},
+		{
+			MethodName: "SubmitWithdrawTransaction",
+			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitFeeRate",
+			Handler:    _Msg_SubmitFeeRate_Handler,
+		},
+		{
+			MethodName: "BondRelayer",
+			Handler:    _Msg_BondRelayer_Handler,
+		},
+		{
+			MethodName: "UnbondRelayer",
+			Handler:    _Msg_UnbondRelayer_Handler,
+		},
+		{
+			MethodName: "SlashRelayer",
+			Handler:    _Msg_SlashRelayer_Handler,
+		},
+		{
+			MethodName: "SubmitRuneEtching",
+			Handler:    _Msg_SubmitRuneEtching_Handler,
+		},
+		{
+			MethodName: "UpdateTrustedFeeProviders",
+			Handler:    _Msg_UpdateTrustedFeeProviders_Handler,
+		},
+		{
+			MethodName: "WithdrawToBitcoin",
+			Handler:    _Msg_WithdrawToBitcoin_Handler,
+		},
+		{
+			MethodName: "MultiWithdrawToBitcoin",
+			Handler:    _Msg_MultiWithdrawToBitcoin_Handler,
+		},
+		{
+			MethodName: "SubmitSignatures",
+			Handler:    _Msg_SubmitSignatures_Handler,
+		},
+		{
+			MethodName: "ConsolidateVaults",
+			Handler:    _Msg_ConsolidateVaults_Handler,
+		},
+		{
+			MethodName: "InitiateDKG",
+			Handler:    _Msg_InitiateDKG_Handler,
+		},
+		{
+			MethodName: "CompleteDKG",
+			Handler:    _Msg_CompleteDKG_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Msg_Refresh_Handler,
+		},
+		{
+			MethodName: "CompleteRefreshing",
+			Handler:    _Msg_CompleteRefreshing_Handler,
+		},
+		{
+			MethodName: "TransferVault",
+			Handler:    _Msg_TransferVault_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "ExtendPause",
+			Handler:    _Msg_ExtendPause_Handler,
+		},
+		{
+			MethodName: "UpdateDenylist",
+			Handler:    _Msg_UpdateDenylist_Handler,
+		},
+		{
+			MethodName: "UpdateRuneEtching",
+			Handler:    _Msg_UpdateRuneEtching_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitway/btcbridge/tx.proto",
+}
+
+func (m *MsgSubmitDepositTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleBlock) > 0 {
+		i -= len(m.MerkleBlock)
+		copy(dAtA[i:], m.MerkleBlock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleBlock)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrevTxBytes) > 0 {
+		i -= len(m.PrevTxBytes)
+		copy(dAtA[i:], m.PrevTxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PrevTxs) > 0 {
+		for iNdEx := len(m.PrevTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrevTxs[iNdEx])
+			copy(dAtA[i:], m.PrevTxs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleBlock) > 0 {
+		i -= len(m.MerkleBlock)
+		copy(dAtA[i:], m.MerkleBlock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleBlock)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrevTxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrevTxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleBlock) > 0 {
+		i -= len(m.MerkleBlock)
+		copy(dAtA[i:], m.MerkleBlock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleBlock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Misbehaviour != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Misbehaviour))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRuneEtching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRuneEtching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRuneEtching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Etching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRuneEtchingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRuneEtchingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRuneEtchingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedFeeProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedFeeProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedFeeProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeProviders) > 0 {
+		for iNdEx := len(m.FeeProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeProviders[iNdEx])
+			copy(dAtA[i:], m.FeeProviders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawToBitcoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawToBitcoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawToBitcoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawToBitcoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawToBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawToBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiWithdrawToBitcoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiWithdrawToBitcoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiWithdrawToBitcoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiWithdrawToBitcoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiWithdrawToBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiWithdrawToBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Group != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsolidateVaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateVaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateVaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunesConsolidations) > 0 {
+		for iNdEx := len(m.RunesConsolidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunesConsolidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BtcConsolidation != nil {
+		{
+			size, err := m.BtcConsolidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VaultVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VaultVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsolidateVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableTransfer {
+		i--
+		if m.EnableTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VaultTypes) > 0 {
+		dAtA5 := make([]byte, len(m.VaultTypes)*10)
+		var j4 int
+		for _, num := range m.VaultTypes {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.InternalKeys) > 0 {
+		for iNdEx := len(m.InternalKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InternalKeys[iNdEx])
+			copy(dAtA[i:], m.InternalKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.InternalKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vaults[iNdEx])
+			copy(dAtA[i:], m.Vaults[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Vaults[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RemovedParticipants) > 0 {
+		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedParticipants[iNdEx])
+			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DkgIds) > 0 {
+		dAtA8 := make([]byte, len(m.DkgIds)*10)
+		var j7 int
+		for _, num := range m.DkgIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteRefreshing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteRefreshing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteRefreshing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteRefreshingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteRefreshingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteRefreshingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Psbts) > 0 {
+		for iNdEx := len(m.Psbts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Psbts[iNdEx])
+			copy(dAtA[i:], m.Psbts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Psbts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DestVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.AssetType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.AssetType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenylist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenylist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenylist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removals[iNdEx])
+			copy(dAtA[i:], m.Removals[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Removals[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Additions) > 0 {
+		for iNdEx := len(m.Additions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Additions[iNdEx])
+			copy(dAtA[i:], m.Additions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Additions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenylistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenylistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenylistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRuneEtching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRuneEtching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRuneEtching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Etching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRuneEtchingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRuneEtchingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRuneEtchingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitDepositTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrevTxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MerkleBlock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDepositTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PrevTxs) > 0 {
+		for _, s := range m.PrevTxs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleBlock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockIndex != 0 {
+		n += 1 + sovTx(uint64(m.BlockIndex))
+	}
+	if m.PrevTxIndex != 0 {
+		n += 1 + sovTx(uint64(m.PrevTxIndex))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDepositTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MerkleBlock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *MsgSubmitFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBondRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSlashRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Misbehaviour != 0 {
+		n += 1 + sovTx(uint64(m.Misbehaviour))
+	}
+	return n
+}
+
+func (m *MsgSlashRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitRuneEtching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Etching.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitRuneEtchingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTrustedFeeProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeProviders) > 0 {
+		for _, s := range m.FeeProviders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawToBitcoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawToBitcoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiWithdrawToBitcoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiWithdrawToBitcoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTx(uint64(m.Group))
+	}
+	return n
+}
+
+func (m *MsgSubmitSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConsolidateVaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VaultVersion != 0 {
+		n += 1 + sovTx(uint64(m.VaultVersion))
+	}
+	if m.BtcConsolidation != nil {
+		l = m.BtcConsolidation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RunesConsolidations) > 0 {
+		for _, e := range m.RunesConsolidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConsolidateVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if len(m.VaultTypes) > 0 {
+		l = 0
+		for _, e := range m.VaultTypes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.EnableTransfer {
+		n += 2
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	}
+	return n
+}
+
+func (m *MsgInitiateDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Vaults) > 0 {
+		for _, s := range m.Vaults {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InternalKeys) > 0 {
+		for _, s := range m.InternalKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DkgIds) > 0 {
+		l = 0
+		for _, e := range m.DkgIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.RemovedParticipants) > 0 {
+		for _, s := range m.RemovedParticipants {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefreshResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteRefreshing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteRefreshingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceVersion != 0 {
+		n += 1 + sovTx(uint64(m.SourceVersion))
+	}
+	if m.DestVersion != 0 {
+		n += 1 + sovTx(uint64(m.DestVersion))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovTx(uint64(m.AssetType))
+	}
+	if len(m.Psbts) > 0 {
+		for _, s := range m.Psbts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	}
+	return n
+}
+
+func (m *MsgTransferVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 1 + sovTx(uint64(m.Target))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovTx(uint64(m.AssetType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 1 + sovTx(uint64(m.Target))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovTx(uint64(m.AssetType))
+	}
+	return n
+}
+
+func (m *MsgUnpauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 1 + sovTx(uint64(m.Target))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovTx(uint64(m.AssetType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExtendPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDenylist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Additions) > 0 {
+		for _, s := range m.Additions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removals) > 0 {
+		for _, s := range m.Removals {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDenylistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRuneEtching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Etching.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRuneEtchingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, DepositBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevTxs = append(m.PrevTxs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositTransaction{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIndex", wireType)
+			}
+			m.BlockIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxIndex", wireType)
+			}
+			m.PrevTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevTxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, DepositResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			}
+			m.Misbehaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misbehaviour |= RelayerMisbehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRuneEtching) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRuneEtching: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRuneEtching: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Etching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Etching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRuneEtchingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRuneEtchingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRuneEtchingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeProviders = append(m.FeeProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiWithdrawToBitcoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiWithdrawToBitcoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, WithdrawOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiWithdrawToBitcoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiWithdrawToBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3781,15 +9820,15 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsolidateVaults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsolidateVaults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3817,13 +9856,13 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultVersion", wireType)
 			}
-			var stringLen uint64
+			m.VaultVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3833,29 +9872,16 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VaultVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConsolidation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3865,61 +9891,33 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if m.BtcConsolidation == nil {
+				m.BtcConsolidation = &BtcConsolidation{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BtcConsolidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunesConsolidations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3929,23 +9927,25 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			m.RunesConsolidations = append(m.RunesConsolidations, &RunesConsolidation{})
+			if err := m.RunesConsolidations[len(m.RunesConsolidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3968,7 +9968,7 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3991,10 +9991,10 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4018,7 +10018,7 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4041,15 +10041,15 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4077,13 +10077,13 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4093,29 +10093,31 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, &DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4125,29 +10127,85 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			if wireType == 0 {
+				var v AssetType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VaultTypes = append(m.VaultTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.VaultTypes) == 0 {
+					m.VaultTypes = make([]AssetType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VaultTypes = append(m.VaultTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4157,24 +10215,31 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.EnableTransfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TargetUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4196,7 +10261,7 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4219,10 +10284,10 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4246,7 +10311,7 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,10 +10334,10 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFeeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4309,9 +10374,9 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.FeeRate = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4321,114 +10386,14 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4456,11 +10421,11 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4488,111 +10453,43 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4620,11 +10517,11 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.InternalKeys = append(m.InternalKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4652,7 +10549,7 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeProviders = append(m.FeeProviders, string(dAtA[iNdEx:postIndex]))
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4675,7 +10572,7 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4698,10 +10595,10 @@ func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4725,7 +10622,7 @@ func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
+func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4748,15 +10645,15 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefresh: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4784,11 +10681,87 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DkgIds = append(m.DkgIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DkgIds) == 0 {
+					m.DkgIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DkgIds = append(m.DkgIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4816,7 +10789,40 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4839,7 +10845,7 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefreshResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4862,10 +10868,10 @@ func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefreshResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4889,7 +10895,7 @@ func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4912,10 +10918,10 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteRefreshing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteRefreshing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4951,8 +10957,27 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4980,11 +11005,11 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5012,7 +11037,7 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5035,7 +11060,7 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteRefreshingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5058,10 +11083,10 @@ func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteRefreshingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteRefreshingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5085,7 +11110,7 @@ func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5108,10 +11133,10 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsolidateVaults: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsolidateVaults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5148,9 +11173,9 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
 			}
-			m.VaultVersion = 0
+			m.SourceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5160,16 +11185,16 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VaultVersion |= uint64(b&0x7F) << shift
+				m.SourceVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcConsolidation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
 			}
-			var msglen int
+			m.DestVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5179,33 +11204,35 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DestVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BtcConsolidation == nil {
-				m.BtcConsolidation = &BtcConsolidation{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			if err := m.BtcConsolidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunesConsolidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5215,26 +11242,43 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunesConsolidations = append(m.RunesConsolidations, &RunesConsolidation{})
-			if err := m.RunesConsolidations[len(m.RunesConsolidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Psbts = append(m.Psbts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			}
+			m.TargetUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5256,7 +11300,7 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5279,10 +11323,10 @@ func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5306,7 +11350,7 @@ func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5329,15 +11373,15 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5365,13 +11409,13 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			m.Target = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5381,31 +11425,16 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Target |= PauseTarget(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participants = append(m.Participants, &DKGParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			m.Threshold = 0
+			m.AssetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5415,85 +11444,16 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				m.AssetType |= AssetType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v AssetType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= AssetType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.VaultTypes = append(m.VaultTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.VaultTypes) == 0 {
-					m.VaultTypes = make([]AssetType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.VaultTypes = append(m.VaultTypes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5503,17 +11463,30 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableTransfer = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.TargetUtxoNum = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5523,11 +11496,24 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5549,7 +11535,7 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5572,12 +11558,31 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5599,7 +11604,7 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5622,10 +11627,10 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5662,9 +11667,9 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			m.Id = 0
+			m.Target = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5674,80 +11679,16 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Target |= PauseTarget(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			var stringLen uint64
+			m.AssetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5757,24 +11698,11 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetType |= AssetType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5796,7 +11724,7 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5819,10 +11747,10 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5846,7 +11774,7 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5869,10 +11797,10 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefresh: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5908,86 +11836,29 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DkgIds = append(m.DkgIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DkgIds) == 0 {
-					m.DkgIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DkgIds = append(m.DkgIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= PauseTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgIds", wireType)
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			var stringLen uint64
+			m.AssetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5997,27 +11868,14 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetType |= AssetType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6044,7 +11902,7 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6069,7 +11927,7 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefreshResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6092,10 +11950,10 @@ func (m *MsgRefreshResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefreshResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6119,7 +11977,7 @@ func (m *MsgRefreshResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDenylist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6142,15 +12000,15 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteRefreshing: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDenylist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteRefreshing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDenylist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6178,13 +12036,13 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Additions", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6194,14 +12052,27 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Additions = append(m.Additions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6229,11 +12100,11 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			m.Removals = append(m.Removals, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6261,7 +12132,7 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6284,7 +12155,7 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteRefreshingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDenylistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6307,10 +12178,10 @@ func (m *MsgCompleteRefreshingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteRefreshingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDenylistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteRefreshingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDenylistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6334,7 +12205,7 @@ func (m *MsgCompleteRefreshingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRuneEtching) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6357,10 +12228,10 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRuneEtching: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRuneEtching: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6396,67 +12267,10 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
-			}
-			m.SourceVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
-			}
-			m.DestVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
-			}
-			m.AssetType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetType |= AssetType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Etching", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6466,43 +12280,25 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbts = append(m.Psbts, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
-			}
-			m.TargetUtxoNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Etching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6524,7 +12320,7 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRuneEtchingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6547,10 +12343,10 @@ func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRuneEtchingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRuneEtchingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -52,6 +52,13 @@ func NewKeeper(
 	// register signing request completed handler
 	tssKeeper.RegisterSigningRequestCompletedHandler(types.ModuleName, k.SigningCompletedHandler)
 
+	// register signing request timeout handler
+	tssKeeper.RegisterSigningRequestTimeoutHandler(types.ModuleName, k.SigningTimeoutHandler)
+
+	// register key rotation handlers
+	tssKeeper.RegisterKeyRotationHandler(types.ModuleName, k.KeyRotationHandler)
+	tssKeeper.RegisterKeyRetirementHandler(types.ModuleName, k.KeyRetirementHandler)
+
 	return k
 }
 
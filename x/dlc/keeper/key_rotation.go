@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/dlc/types"
+)
+
+// KeyRotationHandler initiates the DKG for the new keys of the given type on the scheduled key rotation
+func (k Keeper) KeyRotationHandler(ctx sdk.Context, ty string) error {
+	switch ty {
+	case types.KEY_TYPE_DCM:
+		dcms := k.GetDCMs(ctx, types.DCMStatus_DCM_status_Enable)
+		if len(dcms) == 0 {
+			return types.ErrDCMDoesNotExist
+		}
+
+		// inherit participants and threshold from the latest DCM
+		dkgReq := k.tssKeeper.GetDKGRequest(ctx, dcms[len(dcms)-1].DkgId)
+
+		req := k.tssKeeper.InitiateDKG(ctx, types.ModuleName, types.DKG_TYPE_DCM, int32(types.DKGIntent_DKG_INTENT_DEFAULT), dkgReq.Participants, dkgReq.Threshold, 1, types.DefaultDKGTimeoutDuration)
+		k.SetKeyRotationDKGId(ctx, ty, req.Id)
+
+	case types.KEY_TYPE_ORACLE:
+		// check if there are sufficient oracle participants
+		if len(k.GetOracleParticipantBaseSet(ctx)) < int(k.OracleParticipantNum(ctx)) {
+			return errorsmod.Wrap(types.ErrInvalidParticipants, "insufficient oracle participants")
+		}
+
+		req := k.tssKeeper.InitiateDKG(ctx, types.ModuleName, types.DKG_TYPE_NONCE, int32(types.DKGIntent_DKG_INTENT_LENDING_EVENT_NONCE), k.GetOracleParticipants(ctx), k.OracleParticipantThreshold(ctx), k.NonceGenerationBatchSize(ctx), k.NonceGenerationTimeoutDuration(ctx))
+		k.SetKeyRotationDKGId(ctx, ty, req.Id)
+
+	default:
+		return errorsmod.Wrapf(types.ErrUnsupportedKeyType, "key type %s", ty)
+	}
+
+	return nil
+}
+
+// KeyRetirementHandler disables the keys of the given type generated before the key rotation
+func (k Keeper) KeyRetirementHandler(ctx sdk.Context, ty string) error {
+	if !k.HasKeyRotationDKGId(ctx, ty) {
+		return nil
+	}
+
+	dkgId := k.GetKeyRotationDKGId(ctx, ty)
+
+	switch ty {
+	case types.KEY_TYPE_DCM:
+		for _, dcm := range k.GetDCMs(ctx, types.DCMStatus_DCM_status_Enable) {
+			if dcm.DkgId >= dkgId {
+				continue
+			}
+
+			dcm.Status = types.DCMStatus_DCM_status_Disable
+			k.SetDCM(ctx, dcm)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDisableDCM,
+					sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", dcm.Id)),
+					sdk.NewAttribute(types.AttributeKeyPubKey, dcm.Pubkey),
+				),
+			)
+		}
+
+	case types.KEY_TYPE_ORACLE:
+		retiredOracles := make(map[string]bool)
+
+		for _, oracle := range k.GetOracles(ctx, types.DLCOracleStatus_Oracle_status_Enable) {
+			if oracle.DkgId >= dkgId {
+				continue
+			}
+
+			oracle.Status = types.DLCOracleStatus_Oracle_status_Disable
+			k.SetOracle(ctx, oracle)
+
+			retiredOracles[oracle.Pubkey] = true
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDisableOracle,
+					sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", oracle.Id)),
+					sdk.NewAttribute(types.AttributeKeyPubKey, oracle.Pubkey),
+				),
+			)
+		}
+
+		// the pending lending events of the retired oracles will not be bound to new loans
+		retiredEvents := []*types.DLCEvent{}
+		k.IteratePendingLendingEvents(ctx, func(event *types.DLCEvent) (stop bool) {
+			if retiredOracles[event.Pubkey] {
+				retiredEvents = append(retiredEvents, event)
+			}
+
+			return false
+		})
+
+		for _, event := range retiredEvents {
+			k.RemoveLendingEventFromPendingQueue(ctx, event)
+		}
+
+	default:
+		return errorsmod.Wrapf(types.ErrUnsupportedKeyType, "key type %s", ty)
+	}
+
+	k.RemoveKeyRotationDKGId(ctx, ty)
+
+	return nil
+}
+
+// HandleKeyRotationDKGCompleted completes the key rotation of the given type if the DKG is initiated for it
+func (k Keeper) HandleKeyRotationDKGCompleted(ctx sdk.Context, ty string, dkgId uint64) {
+	if !k.HasKeyRotationDKGId(ctx, ty) || k.GetKeyRotationDKGId(ctx, ty) != dkgId {
+		return
+	}
+
+	if k.tssKeeper.IsKeyRotating(ctx, types.ModuleName, ty) {
+		_ = k.tssKeeper.CompleteKeyRotation(ctx, types.ModuleName, ty)
+	}
+}
+
+// HandleKeyRotationDKGTimeout aborts the key rotation of the given type if the DKG is initiated for it
+func (k Keeper) HandleKeyRotationDKGTimeout(ctx sdk.Context, ty string, dkgId uint64) {
+	if !k.HasKeyRotationDKGId(ctx, ty) || k.GetKeyRotationDKGId(ctx, ty) != dkgId {
+		return
+	}
+
+	k.RemoveKeyRotationDKGId(ctx, ty)
+
+	if k.tssKeeper.IsKeyRotating(ctx, types.ModuleName, ty) {
+		_ = k.tssKeeper.AbortKeyRotation(ctx, types.ModuleName, ty)
+	}
+}
+
+// SetKeyRotationDKGId sets the DKG id of the key rotation for the given key type
+func (k Keeper) SetKeyRotationDKGId(ctx sdk.Context, ty string, dkgId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.KeyRotationDKGKey(ty), sdk.Uint64ToBigEndian(dkgId))
+}
+
+// HasKeyRotationDKGId returns true if the DKG of the key rotation exists for the given key type, false otherwise
+func (k Keeper) HasKeyRotationDKGId(ctx sdk.Context, ty string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.KeyRotationDKGKey(ty))
+}
+
+// GetKeyRotationDKGId gets the DKG id of the key rotation for the given key type
+func (k Keeper) GetKeyRotationDKGId(ctx sdk.Context, ty string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyRotationDKGKey(ty))
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// RemoveKeyRotationDKGId removes the DKG id of the key rotation for the given key type
+func (k Keeper) RemoveKeyRotationDKGId(ctx sdk.Context, ty string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeyRotationDKGKey(ty))
+}
@@ -150,6 +150,7 @@ func (k Keeper) IterateOracles(ctx sdk.Context, cb func(oracle *types.DLCOracle)
 }
 
 // GetOracleParticipantBaseSet gets the oracle participant base set
+// The TSS participant set is used if the allowed oracle participants are empty and the TSS participant set is maintained automatically
 func (k Keeper) GetOracleParticipantBaseSet(ctx sdk.Context) []string {
 	allowedOracleParticipants := k.AllowedOracleParticipants(ctx)
 
@@ -158,6 +159,10 @@ func (k Keeper) GetOracleParticipantBaseSet(ctx sdk.Context) []string {
 		return allowedOracleParticipants
 	}
 
+	if len(allowedOracleParticipants) == 0 && k.tssKeeper.ParticipantSetEnabled(ctx) {
+		return baseParticipants
+	}
+
 	oracleParticipantBaseSet := []string{}
 	for _, participant := range allowedOracleParticipants {
 		if slices.Contains(baseParticipants, participant) {
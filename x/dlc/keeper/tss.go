@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/x/dlc/types"
@@ -29,7 +32,11 @@ func (k Keeper) DKGCompletionReceivedHandler(ctx sdk.Context, id uint64, ty stri
 func (k Keeper) DKGCompletedHandler(ctx sdk.Context, id uint64, ty string, intent int32, pubKeys []string) error {
 	switch ty {
 	case types.DKG_TYPE_DCM:
-		return k.CreateDCM(ctx, id, pubKeys[0])
+		if err := k.CreateDCM(ctx, id, pubKeys[0]); err != nil {
+			return err
+		}
+
+		k.HandleKeyRotationDKGCompleted(ctx, types.KEY_TYPE_DCM, id)
 
 	case types.DKG_TYPE_NONCE:
 		// the first pub key is oracle and the remaining are nonces
@@ -38,7 +45,11 @@ func (k Keeper) DKGCompletedHandler(ctx sdk.Context, id uint64, ty string, inten
 			return err
 		}
 
-		return k.HandleNonces(ctx, pubKeys[0], pubKeys[1:], intent)
+		if err := k.HandleNonces(ctx, pubKeys[0], pubKeys[1:], intent); err != nil {
+			return err
+		}
+
+		k.HandleKeyRotationDKGCompleted(ctx, types.KEY_TYPE_ORACLE, id)
 	}
 
 	return nil
@@ -46,6 +57,14 @@ func (k Keeper) DKGCompletedHandler(ctx sdk.Context, id uint64, ty string, inten
 
 // DKGTimeoutHandler is callback handler when the DKG timed out in TSS
 func (k Keeper) DKGTimeoutHandler(ctx sdk.Context, id uint64, ty string, intent int32, absentParticipants []string) error {
+	switch ty {
+	case types.DKG_TYPE_DCM:
+		k.HandleKeyRotationDKGTimeout(ctx, types.KEY_TYPE_DCM, id)
+
+	case types.DKG_TYPE_NONCE:
+		k.HandleKeyRotationDKGTimeout(ctx, types.KEY_TYPE_ORACLE, id)
+	}
+
 	switch ty {
 	case types.DKG_TYPE_NONCE, types.DKG_TYPE_LIVENESS_CHECK:
 		if len(absentParticipants) == len(k.tssKeeper.GetDKGRequest(ctx, id).Participants) {
@@ -75,3 +94,37 @@ func (k Keeper) DKGTimeoutHandler(ctx sdk.Context, id uint64, ty string, intent
 func (k Keeper) SigningCompletedHandler(ctx sdk.Context, sender string, id uint64, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, signatures []string) error {
 	return k.HandleAttestation(ctx, sender, types.FromScopedId(scopedId), signatures[0])
 }
+
+// SigningTimeoutHandler is callback handler when the signing request timed out in TSS
+// The signing request is re-initiated and the absent participants are reported
+// The signing request remains timed out once the max signing retries of TSS reached
+func (k Keeper) SigningTimeoutHandler(ctx sdk.Context, id uint64, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, absentParticipants []string) error {
+	if len(absentParticipants) != 0 && len(absentParticipants) < len(k.tssKeeper.GetSigningParticipants(ctx, k.tssKeeper.GetSigningRequest(ctx, id))) {
+		// set the absent oracle participants to non-alive unless all participants are absent
+		for _, participant := range absentParticipants {
+			if k.HasOracleParticipantLiveness(ctx, participant) {
+				liveness := k.GetOracleParticipantLiveness(ctx, participant)
+
+				liveness.IsAlive = false
+				k.SetOracleParticipantLiveness(ctx, liveness)
+			}
+		}
+	}
+
+	req, err := k.tssKeeper.ReinitiateSigningRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReinitiateSigning,
+			sdk.NewAttribute(types.AttributeKeySigningId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyPreviousSigningId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyScopedId, scopedId),
+			sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+		),
+	)
+
+	return nil
+}
@@ -23,6 +23,12 @@ const (
 	// DKG type for oracle participants liveness check
 	DKG_TYPE_LIVENESS_CHECK = "liveness_check"
 
+	// key type of the DCMs for the scheduled key rotation
+	KEY_TYPE_DCM = "dcm"
+
+	// key type of the oracles for the scheduled key rotation
+	KEY_TYPE_ORACLE = "oracle"
+
 	// default DKG timeout duration
 	DefaultDKGTimeoutDuration = 300 * time.Second // 300s
 
@@ -18,6 +18,7 @@ var (
 	ErrInvalidParticipants      = errorsmod.Register(ModuleName, 1108, "invalid participants")
 	ErrInvalidThreshold         = errorsmod.Register(ModuleName, 1109, "invalid threshold")
 	ErrInvalidTimeoutDuration   = errorsmod.Register(ModuleName, 1110, "invalid timeout duration")
+	ErrUnsupportedKeyType       = errorsmod.Register(ModuleName, 1111, "unsupported key type")
 
 	ErrInvalidParams = errorsmod.Register(ModuleName, 2100, "invalid params")
 )
@@ -2,15 +2,23 @@ package types
 
 // DLC module event types
 const (
-	EventTypeCreateOracle    = "create_oracle"
-	EventTypeCreateDCM       = "create_dcm"
-	EventTypeTriggerDLCEvent = "trigger_dlc_event"
+	EventTypeCreateOracle      = "create_oracle"
+	EventTypeCreateDCM         = "create_dcm"
+	EventTypeTriggerDLCEvent   = "trigger_dlc_event"
+	EventTypeReinitiateSigning = "reinitiate_signing"
+	EventTypeDisableDCM        = "disable_dcm"
+	EventTypeDisableOracle     = "disable_oracle"
 
 	AttributeKeyId           = "id"
 	AttributeKeyDKGId        = "dkg_id"
 	AttributeKeyPubKey       = "pub_key"
 	AttributeKeyDLCEventType = "dlc_event_type"
 	AttributeKeyOutcome      = "outcome"
+
+	AttributeKeySigningId          = "signing_id"
+	AttributeKeyPreviousSigningId  = "previous_signing_id"
+	AttributeKeyScopedId           = "scoped_id"
+	AttributeKeyAbsentParticipants = "absent_participants"
 )
 
 const (
@@ -27,15 +27,28 @@ type StakingKeeper interface {
 // TSSKeeper defines the expected TSS keeper interface
 type TSSKeeper interface {
 	AllowedDKGParticipants(ctx sdk.Context) []string
+	ParticipantSetEnabled(ctx sdk.Context) bool
 
 	HasDKGRequest(ctx sdk.Context, id uint64) bool
 	GetDKGRequest(ctx sdk.Context, id uint64) *tsstypes.DKGRequest
 
 	InitiateDKG(ctx sdk.Context, module string, ty string, intent int32, participants []string, threshold uint32, batchSize uint32, timeoutDuration time.Duration) *tsstypes.DKGRequest
+	GetSigningRequest(ctx sdk.Context, id uint64) *tsstypes.SigningRequest
+	GetSigningParticipants(ctx sdk.Context, req *tsstypes.SigningRequest) []string
+
 	InitiateSigningRequest(ctx sdk.Context, module string, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, sigHashes []string, options *tsstypes.SigningOptions) *tsstypes.SigningRequest
+	ReinitiateSigningRequest(ctx sdk.Context, id uint64) (*tsstypes.SigningRequest, error)
 
 	RegisterDKGCompletionReceivedHandler(module string, handler tsstypes.DKGCompletionReceivedHandler)
 	RegisterDKGRequestCompletedHandler(module string, handler tsstypes.DKGRequestCompletedHandler)
 	RegisterDKGRequestTimeoutHandler(module string, handler tsstypes.DKGRequestTimeoutHandler)
 	RegisterSigningRequestCompletedHandler(module string, handler tsstypes.SigningRequestCompletedHandler)
+	RegisterSigningRequestTimeoutHandler(module string, handler tsstypes.SigningRequestTimeoutHandler)
+
+	IsKeyRotating(ctx sdk.Context, module string, ty string) bool
+	CompleteKeyRotation(ctx sdk.Context, module string, ty string) error
+	AbortKeyRotation(ctx sdk.Context, module string, ty string) error
+
+	RegisterKeyRotationHandler(module string, handler tsstypes.KeyRotationHandler)
+	RegisterKeyRetirementHandler(module string, handler tsstypes.KeyRetirementHandler)
 }
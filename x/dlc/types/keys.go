@@ -38,6 +38,7 @@ var (
 	PendingLendingEventKeyPrefix = []byte{0x19} // key prefix for the pending lending event
 	AttestationKeyPrefix         = []byte{0x20} // prefix for each key to an attestation
 	AttestationByEventKeyPrefix  = []byte{0x21} // prefix for each key to an attestation by event
+	KeyRotationDKGKeyPrefix      = []byte{0x22} // key prefix for the DKG of the scheduled key rotation
 
 	OracleParticipantLivenessKeyPrefix = []byte{0x30} // key prefix for oracle participant liveness
 )
@@ -95,3 +96,7 @@ func AttestationByEventKey(eventId uint64) []byte {
 func OracleParticipantLivenessKey(consPubKey string) []byte {
 	return append(OracleParticipantLivenessKeyPrefix, []byte(consPubKey)...)
 }
+
+func KeyRotationDKGKey(keyType string) []byte {
+	return append(KeyRotationDKGKeyPrefix, []byte(keyType)...)
+}
@@ -73,6 +73,9 @@ func NewKeeper(
 	// register signing request completed handler
 	tssKeeper.RegisterSigningRequestCompletedHandler(types.ModuleName, k.SigningCompletedHandler)
 
+	// register signing request timeout handler
+	tssKeeper.RegisterSigningRequestTimeoutHandler(types.ModuleName, k.SigningTimeoutHandler)
+
 	return k
 }
 
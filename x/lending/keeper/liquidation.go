@@ -7,7 +7,6 @@ import (
 
 	"github.com/bitwaylabs/bitway/x/lending/types"
 	liquidationtypes "github.com/bitwaylabs/bitway/x/liquidation/types"
-	tsstypes "github.com/bitwaylabs/bitway/x/tss/types"
 )
 
 // HandleLiquidation performs possible liquidation for the given loan
@@ -106,15 +105,13 @@ func (k Keeper) HandleLiquidation(ctx sdk.Context, loan *types.Loan) {
 		k.DLCKeeper().TriggerDLCEvent(ctx, loan.DlcEventId, outcomeIndex)
 
 		// initiate signing request
-		k.TSSKeeper().InitiateSigningRequest(
+		k.TSSKeeper().InitiateSigningRequestWithCommittedNonces(
 			ctx,
 			types.ModuleName,
 			loan.VaultAddress,
-			tsstypes.SigningType_SIGNING_TYPE_SCHNORR,
 			signingIntent,
 			loan.DCM,
 			sigHashes,
-			nil,
 		)
 	}
 }
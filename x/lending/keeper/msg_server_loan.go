@@ -16,8 +16,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/bitwaylabs/bitway/bitcoin/crypto/schnorr"
+	btcbridgetypes "github.com/bitwaylabs/bitway/x/btcbridge/types"
+	dlctypes "github.com/bitwaylabs/bitway/x/dlc/types"
 	"github.com/bitwaylabs/bitway/x/lending/types"
-	tsstypes "github.com/bitwaylabs/bitway/x/tss/types"
 )
 
 // Apply implements types.MsgServer.
@@ -28,6 +29,10 @@ func (m msgServer) Apply(goCtx context.Context, msg *types.MsgApply) (*types.Msg
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if m.btcbridgeKeeper.IsPaused(ctx, btcbridgetypes.PauseTarget_PAUSE_TARGET_LENDING_APPLY, btcbridgetypes.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, types.ErrApplyPaused
+	}
+
 	if !m.HasPool(ctx, msg.PoolId) {
 		return nil, types.ErrPoolDoesNotExist
 	}
@@ -69,6 +74,9 @@ func (m msgServer) Apply(goCtx context.Context, msg *types.MsgApply) (*types.Msg
 	}
 
 	dcm := m.dlcKeeper.GetDCM(ctx, msg.DCMId)
+	if dcm.Status != dlctypes.DCMStatus_DCM_status_Enable {
+		return nil, errorsmod.Wrap(types.ErrInvalidDCM, "dcm disabled")
+	}
 
 	maturityTime := ctx.BlockTime().Add(time.Duration(trancheConfig.Maturity) * time.Second).Unix()
 	finalTimeout := maturityTime + m.FinalTimeoutDuration(ctx)
@@ -255,7 +263,7 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 	}
 
 	// validate deposit tx
-	tx, _, err := m.btcbridgeKeeper.ValidateTransaction(ctx, msg.DepositTx, "", msg.BlockHash, msg.Proof, m.btcbridgeKeeper.DepositConfirmationDepth(ctx))
+	tx, _, err := m.btcbridgeKeeper.ValidateTransaction(ctx, msg.DepositTx, "", msg.BlockHash, msg.Proof, "", m.btcbridgeKeeper.DepositConfirmationDepth(ctx))
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.ErrInvalidDepositTx, "failed to validate tx: %v", err)
 	}
@@ -384,15 +392,13 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 	}
 	m.SetRedemption(ctx, redemption)
 
-	m.tssKeeper.InitiateSigningRequest(
+	m.tssKeeper.InitiateSigningRequestWithCommittedNonces(
 		ctx,
 		types.ModuleName,
 		types.ToScopedId(redemption.Id),
-		tsstypes.SigningType_SIGNING_TYPE_SCHNORR,
 		int32(types.SigningIntent_SIGNING_INTENT_REDEMPTION),
 		loan.DCM,
 		sigHashes,
-		nil,
 	)
 
 	// update loan status to cancelled if the current status is requested
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/x/lending/types"
@@ -26,3 +29,25 @@ func (k Keeper) SigningCompletedHandler(ctx sdk.Context, sender string, id uint6
 		return types.ErrInvalidSigningIntent
 	}
 }
+
+// SigningTimeoutHandler is callback handler when the signing request timed out in TSS
+// The signing request is re-initiated and the absent participants are reported
+// The signing request remains timed out once the max signing retries of TSS reached
+func (k Keeper) SigningTimeoutHandler(ctx sdk.Context, id uint64, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, absentParticipants []string) error {
+	req, err := k.tssKeeper.ReinitiateSigningRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReinitiateSigning,
+			sdk.NewAttribute(types.AttributeKeySigningId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyPreviousSigningId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyScopedId, scopedId),
+			sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+		),
+	)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/bitcoin/crypto/adaptor"
+	btcbridgetypes "github.com/bitwaylabs/bitway/x/btcbridge/types"
 	"github.com/bitwaylabs/bitway/x/lending/keeper"
 	"github.com/bitwaylabs/bitway/x/lending/types"
 )
@@ -60,6 +61,11 @@ func handleApprovals(ctx sdk.Context, k keeper.Keeper) error {
 
 // handleLiquidations performs possible liquidations for active loans
 func handleLiquidations(ctx sdk.Context, k keeper.Keeper) {
+	// check if liquidation is paused
+	if k.BtcBridgeKeeper().IsPaused(ctx, btcbridgetypes.PauseTarget_PAUSE_TARGET_LIQUIDATION, btcbridgetypes.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return
+	}
+
 	k.IterateLoansByStatus(ctx, types.LoanStatus_Open, func(loan *types.Loan) (stop bool) {
 		k.HandleLiquidation(ctx, loan)
 		return false
@@ -37,6 +37,7 @@ var (
 	ErrInsufficientUTXOs            = errorsmod.Register(ModuleName, 3015, "insufficient utxos")
 	ErrDustOutput                   = errorsmod.Register(ModuleName, 3016, "dust output")
 	ErrMaxTransactionWeightExceeded = errorsmod.Register(ModuleName, 3017, "maximum transaction weight exceeded")
+	ErrApplyPaused                  = errorsmod.Register(ModuleName, 3018, "loan application paused")
 
 	ErrInvalidVault          = errorsmod.Register(ModuleName, 4001, "invalid vault")
 	ErrInvalidBlockHash      = errorsmod.Register(ModuleName, 4002, "invalid block hash")
@@ -15,6 +15,7 @@ const (
 	EventTypeRegisterReferrer                    = "register_referrer"
 	EventTypeUpdateReferrer                      = "update_referrer"
 	EventTypeReferral                            = "referral"
+	EventTypeReinitiateSigning                   = "reinitiate_signing"
 
 	AttributeKeyVault              = "vault"
 	AttributeKeyBorrower           = "borrower"
@@ -49,6 +50,11 @@ const (
 	AttributeKeyReferrerAddress   = "referrer_address"
 	AttributeKeyReferralFeeFactor = "referral_fee_factor"
 	AttributeKeyReferralFee       = "referral_fee"
+
+	AttributeKeySigningId          = "signing_id"
+	AttributeKeyPreviousSigningId  = "previous_signing_id"
+	AttributeKeyScopedId           = "scoped_id"
+	AttributeKeyAbsentParticipants = "absent_participants"
 )
 
 const (
@@ -73,15 +73,20 @@ type DLCKeeper interface {
 // BtcBridgeKeeper defines the expected BtcBridge keeper interface
 type BtcBridgeKeeper interface {
 	DepositConfirmationDepth(ctx sdk.Context) int32
-	ValidateTransaction(ctx sdk.Context, tx string, prevTx string, blockHash string, proof []string, confirmationDepth int32) (*btcutil.Tx, *btcutil.Tx, error)
+	ValidateTransaction(ctx sdk.Context, tx string, prevTx string, blockHash string, proof []string, merkleBlock string, confirmationDepth int32) (*btcutil.Tx, *btcutil.Tx, error)
 
 	GetFeeRate(ctx sdk.Context) *btcbridgetypes.FeeRate
 	CheckFeeRate(ctx sdk.Context, feeRate *btcbridgetypes.FeeRate) error
+
+	IsPaused(ctx sdk.Context, target btcbridgetypes.PauseTarget, assetType btcbridgetypes.AssetType) bool
 }
 
 // TSSKeeper defines the expected TSS keeper interface
 type TSSKeeper interface {
 	InitiateSigningRequest(ctx sdk.Context, module string, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, sigHashes []string, options *tsstypes.SigningOptions) *tsstypes.SigningRequest
+	InitiateSigningRequestWithCommittedNonces(ctx sdk.Context, module string, scopedId string, intent int32, pubKey string, sigHashes []string) *tsstypes.SigningRequest
+	ReinitiateSigningRequest(ctx sdk.Context, id uint64) (*tsstypes.SigningRequest, error)
 
 	RegisterSigningRequestCompletedHandler(module string, handler tsstypes.SigningRequestCompletedHandler)
+	RegisterSigningRequestTimeoutHandler(module string, handler tsstypes.SigningRequestTimeoutHandler)
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	btcbridgetypes "github.com/bitwaylabs/bitway/x/btcbridge/types"
 	"github.com/bitwaylabs/bitway/x/liquidation/types"
 )
 
@@ -23,6 +24,10 @@ func (m msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if m.btcbridgeKeeper.IsPaused(ctx, btcbridgetypes.PauseTarget_PAUSE_TARGET_LIQUIDATION, btcbridgetypes.AssetType_ASSET_TYPE_UNSPECIFIED) {
+		return nil, types.ErrLiquidationPaused
+	}
+
 	record, err := m.Keeper.HandleLiquidation(ctx, msg.Liquidator, msg.LiquidationId, msg.DebtAmount)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/x/liquidation/types"
@@ -11,3 +14,61 @@ import (
 func (k Keeper) SigningCompletedHandler(ctx sdk.Context, sender string, id uint64, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, signatures []string) error {
 	return k.HandleSettlementSignatures(ctx, sender, types.FromScopedId(scopedId), signatures)
 }
+
+// SigningTimeoutHandler is callback handler when the signing request timed out in TSS
+// The signing request is re-initiated and the absent participants are reported
+// The signing request remains timed out once the max signing retries of TSS reached
+func (k Keeper) SigningTimeoutHandler(ctx sdk.Context, id uint64, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, absentParticipants []string) error {
+	req, err := k.tssKeeper.ReinitiateSigningRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReinitiateSigning,
+			sdk.NewAttribute(types.AttributeKeySigningId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyPreviousSigningId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyScopedId, scopedId),
+			sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+		),
+	)
+
+	return nil
+}
+
+// SigningBatchCompletedHandler is callback handler when the signing batch of the settlement transactions completed by TSS
+// Each signing request of the batch is scoped by the corresponding liquidation
+func (k Keeper) SigningBatchCompletedHandler(ctx sdk.Context, sender string, id uint64, scopedId string, intent int32, signatures []*tsstypes.BatchSignatures) error {
+	for _, batchSignatures := range signatures {
+		req := k.tssKeeper.GetSigningRequest(ctx, batchSignatures.SigningId)
+
+		if err := k.HandleSettlementSignatures(ctx, sender, types.FromScopedId(req.ScopedId), batchSignatures.Signatures); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SigningBatchTimeoutHandler is callback handler when the signing batch timed out in TSS
+// The signing batch is re-initiated and the absent participants are reported
+// The signing batch remains timed out once the max signing retries of TSS reached
+func (k Keeper) SigningBatchTimeoutHandler(ctx sdk.Context, id uint64, scopedId string, intent int32, absentParticipants []string) error {
+	batch, err := k.tssKeeper.ReinitiateSigningBatch(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReinitiateSigningBatch,
+			sdk.NewAttribute(types.AttributeKeySigningBatchId, fmt.Sprintf("%d", batch.Id)),
+			sdk.NewAttribute(types.AttributeKeyPreviousBatchId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyScopedId, scopedId),
+			sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+		),
+	)
+
+	return nil
+}
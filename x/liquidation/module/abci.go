@@ -1,6 +1,8 @@
 package liquidation
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitwaylabs/bitway/x/liquidation/keeper"
@@ -100,6 +102,9 @@ func handleCompletedLiquidations(ctx sdk.Context, k keeper.Keeper) {
 		k.Logger(ctx).Warn("Failed to get valid fee rate to handle liquidation", "err", err)
 	}
 
+	// the settlement txs of the liquidations are signed in a batch
+	signingItems := []*tsstypes.SigningBatchItem{}
+
 	for _, liquidation := range liquidations {
 		// build settlement tx
 		settlementTx, txHash, sigHashes, changeAmount, err := types.BuildSettlementTransaction(liquidation, k.GetLiquidationRecords(ctx, liquidation.Id), k.ProtocolLiquidationFeeCollector(ctx), feeRate.Value, types.LiquidationNetworkFeeReserve)
@@ -116,9 +121,21 @@ func handleCompletedLiquidations(ctx sdk.Context, k keeper.Keeper) {
 		// update liquidation
 		k.SetLiquidation(ctx, liquidation)
 
-		// initiate signing request via TSS
-		k.TSSKeeper().InitiateSigningRequest(ctx, types.ModuleName, types.ToScopedId(liquidation.Id), tsstypes.SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK, int32(types.SigningIntent_SIGNING_INTENT_DEFAULT), liquidation.DCM, sigHashes, &tsstypes.SigningOptions{Tweak: ""})
+		signingItems = append(signingItems, &tsstypes.SigningBatchItem{
+			Type:      tsstypes.SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK,
+			PubKey:    liquidation.DCM,
+			SigHashes: sigHashes,
+			Options:   &tsstypes.SigningOptions{Tweak: ""},
+			ScopedId:  types.ToScopedId(liquidation.Id),
+		})
+	}
+
+	if len(signingItems) == 0 {
+		return
 	}
+
+	// initiate signing batch via TSS; the batch is scoped by the block height
+	k.TSSKeeper().InitiateSigningBatch(ctx, types.ModuleName, fmt.Sprintf("%d", ctx.BlockHeight()), int32(types.SigningIntent_SIGNING_INTENT_DEFAULT), signingItems)
 }
 
 // calculateAccruedInterest calculates the accrued interest during liquidations
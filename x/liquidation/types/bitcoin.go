@@ -66,7 +66,7 @@ func BuildSettlementTransaction(liquidation *Liquidation, records []*Liquidation
 	sigHashes := make([]string, 0)
 
 	for i := range settlementTxPsbt.Inputs {
-		sigHash, err := CalcTaprootSigHash(settlementTxPsbt, i, DefaultSigHashType)
+		sigHash, err := bitcoin.CalcTaprootSigHash(settlementTxPsbt, i, DefaultSigHashType)
 		if err != nil {
 			return "", nil, nil, 0, err
 		}
@@ -284,22 +284,6 @@ func IsValidBtcAddress(address string) bool {
 	return err == nil
 }
 
-// CalcTaprootSigHash computes the sig hash of the given input
-// Assume that the psbt is valid
-func CalcTaprootSigHash(p *psbt.Packet, idx int, sigHashType txscript.SigHashType) ([]byte, error) {
-	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
-	for i, txIn := range p.UnsignedTx.TxIn {
-		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
-	}
-
-	sigHash, err := txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(p.UnsignedTx, prevOutFetcher), sigHashType, p.UnsignedTx, idx, prevOutFetcher)
-	if err != nil {
-		return nil, err
-	}
-
-	return sigHash, nil
-}
-
 // GetTxVirtualSize gets the virtual size of the given tx.
 func GetTxVirtualSize(tx *wire.MsgTx, witnessSize int) int64 {
 	newTx := PopulateTxWithDummyWitness(tx, witnessSize)
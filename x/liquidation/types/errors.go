@@ -12,6 +12,7 @@ var (
 	ErrLiquidationDoesNotExist  = errorsmod.Register(ModuleName, 1102, "liquidation does not exist")
 	ErrInvalidLiquidationStatus = errorsmod.Register(ModuleName, 1103, "invalid liquidation status")
 	ErrInvalidPrice             = errorsmod.Register(ModuleName, 1104, "invalid price")
+	ErrLiquidationPaused        = errorsmod.Register(ModuleName, 1105, "liquidation paused")
 
 	ErrInvalidParams = errorsmod.Register(ModuleName, 2100, "invalid params")
 
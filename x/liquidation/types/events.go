@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeLiquidate                           = "liquidate"
 	EventTypeGenerateSignedSettlementTransaction = "generate_signed_settlement_transaction"
+	EventTypeReinitiateSigning                   = "reinitiate_signing"
+	EventTypeReinitiateSigningBatch              = "reinitiate_signing_batch"
 
 	AttributeKeyLiquidator          = "liquidator"
 	AttributeKeyLiquidationId       = "liquidation_id"
@@ -13,6 +15,13 @@ const (
 	AttributeKeyBonusAmount         = "bonus_amount"
 
 	AttributeKeyTxHash = "tx_hash"
+
+	AttributeKeySigningId          = "signing_id"
+	AttributeKeyPreviousSigningId  = "previous_signing_id"
+	AttributeKeySigningBatchId     = "signing_batch_id"
+	AttributeKeyPreviousBatchId    = "previous_signing_batch_id"
+	AttributeKeyScopedId           = "scoped_id"
+	AttributeKeyAbsentParticipants = "absent_participants"
 )
 
 const (
@@ -46,14 +46,22 @@ type OracleKeeper interface {
 // TSSKeeper defines the expected TSS keeper interface
 type TSSKeeper interface {
 	InitiateSigningRequest(ctx sdk.Context, module string, scopedId string, ty tsstypes.SigningType, intent int32, pubKey string, sigHashes []string, options *tsstypes.SigningOptions) *tsstypes.SigningRequest
+	ReinitiateSigningRequest(ctx sdk.Context, id uint64) (*tsstypes.SigningRequest, error)
+	GetSigningRequest(ctx sdk.Context, id uint64) *tsstypes.SigningRequest
+
+	InitiateSigningBatch(ctx sdk.Context, module string, scopedId string, intent int32, items []*tsstypes.SigningBatchItem) *tsstypes.SigningBatch
+	ReinitiateSigningBatch(ctx sdk.Context, id uint64) (*tsstypes.SigningBatch, error)
 
 	RegisterSigningRequestCompletedHandler(module string, handler tsstypes.SigningRequestCompletedHandler)
+	RegisterSigningRequestTimeoutHandler(module string, handler tsstypes.SigningRequestTimeoutHandler)
 }
 
 // BtcBridgeKeeper defines the expected BtcBridge keeper interface
 type BtcBridgeKeeper interface {
 	GetFeeRate(ctx sdk.Context) *btcbridgetypes.FeeRate
 	CheckFeeRate(ctx sdk.Context, feeRate *btcbridgetypes.FeeRate) error
+
+	IsPaused(ctx sdk.Context, target btcbridgetypes.PauseTarget, assetType btcbridgetypes.AssetType) bool
 }
 
 // LendingKeeper defines the expected lending keeper interface
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/rpcclient"
@@ -31,8 +32,14 @@ type PriceOracleVoteExtHandler struct {
 
 	Keeper keeper.Keeper // keeper of our oracle module
 	config *types.OracleConfig
+
+	screeningKeeper types.ScreeningKeeper // optional keeper for the address screening
+	screener        types.AddressScreener // optional off-chain address screener
 }
 
+// maximum number of the screened addresses per vote extension
+const maxScreenedAddresses = 100
+
 func NewPriceOracleVoteExtHandler(logger log.Logger, valStore baseapp.ValidatorStore, oracleKeeper keeper.Keeper, config *types.OracleConfig) PriceOracleVoteExtHandler {
 	client, err := rpcclient.New(&rpcclient.ConnConfig{
 		Host:         config.BitcoinRpc,
@@ -55,6 +62,12 @@ func NewPriceOracleVoteExtHandler(logger log.Logger, valStore baseapp.ValidatorS
 	}
 }
 
+// SetAddressScreening sets the screening keeper and the optional off-chain address screener
+func (h *PriceOracleVoteExtHandler) SetAddressScreening(screeningKeeper types.ScreeningKeeper, screener types.AddressScreener) {
+	h.screeningKeeper = screeningKeeper
+	h.screener = screener
+}
+
 func (h *PriceOracleVoteExtHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
 
@@ -72,10 +85,11 @@ func (h *PriceOracleVoteExtHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			h.logger.Error("failed to fetch bitcoin headers", "error", err)
 		}
 		voteExt := types.OracleVoteExtension{
-			Height:   req.Height,
-			Prices:   prices,
-			Blocks:   headers,
-			HasError: err != nil,
+			Height:            req.Height,
+			Prices:            prices,
+			Blocks:            headers,
+			HasError:          err != nil,
+			ScreenedAddresses: h.screenAddresses(ctx),
 		}
 
 		bz, err := voteExt.Marshal()
@@ -119,10 +133,48 @@ func (h *PriceOracleVoteExtHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteE
 			}
 		}
 
+		if len(voteExt.ScreenedAddresses) > maxScreenedAddresses {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
 		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
 	}
 }
 
+// screenAddresses screens the candidate addresses by the off-chain screener if plugged in
+func (h *PriceOracleVoteExtHandler) screenAddresses(ctx sdk.Context) []string {
+	if h.screeningKeeper == nil || h.screener == nil {
+		return nil
+	}
+
+	candidates := h.screeningKeeper.GetScreeningCandidates(ctx)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	flagged, err := h.screener.Screen(candidates)
+	if err != nil {
+		h.logger.Error("failed to screen addresses", "error", err)
+		return nil
+	}
+
+	isCandidate := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		isCandidate[candidate] = true
+	}
+
+	// only the candidates are allowed to be reported
+	screened := []string{}
+	for _, addr := range flagged {
+		if isCandidate[addr] && len(screened) < maxScreenedAddresses {
+			screened = append(screened, addr)
+			delete(isCandidate, addr)
+		}
+	}
+
+	return screened
+}
+
 func (h *PriceOracleVoteExtHandler) getBitcoinHeaders(ctx sdk.Context, bitwayHeight int64) ([]*types.BlockHeader, error) {
 	// skip
 	if bitwayHeight%2 == 0 {
@@ -321,6 +373,14 @@ func (h *PriceOracleVoteExtHandler) PreBlocker(ctx sdk.Context, req *abci.Reques
 
 	h.logger.Info("Oracle Final States", "price", prices, "headers", headers)
 
+	if h.screeningKeeper != nil {
+		if screened := h.extractScreenedAddresses(injectedVoteExtTx); len(screened) > 0 {
+			h.screeningKeeper.AddScreenedAddresses(ctx, screened)
+
+			h.logger.Info("Screened addresses", "addresses", screened)
+		}
+	}
+
 	return res, nil
 }
 
@@ -409,6 +469,48 @@ func (h *PriceOracleVoteExtHandler) extractPricesAndBlockHeaders(_ sdk.Context,
 	return finalPrices, headers, nil
 }
 
+// extractScreenedAddresses extracts the addresses flagged by at least 2/3 of the total voting power
+func (h *PriceOracleVoteExtHandler) extractScreenedAddresses(commit abci.ExtendedCommitInfo) []string {
+	var totalStake int64
+
+	screenedStakes := make(map[string]int64)
+
+	for _, v := range commit.Votes {
+		if v.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		var voteExt types.OracleVoteExtension
+		if err := voteExt.Unmarshal(v.VoteExtension); err != nil {
+			continue
+		}
+
+		totalStake += v.Validator.Power
+
+		seen := make(map[string]bool)
+		for _, addr := range voteExt.ScreenedAddresses {
+			if seen[addr] {
+				continue
+			}
+
+			screenedStakes[addr] += v.Validator.Power
+			seen[addr] = true
+		}
+	}
+
+	screened := []string{}
+	for addr, power := range screenedStakes {
+		if power*3 >= totalStake*2 {
+			screened = append(screened, addr)
+		}
+	}
+
+	// sort for determinism
+	sort.Strings(screened)
+
+	return screened
+}
+
 func voteExtensionEnabled(ctx sdk.Context, height int64) bool {
 	consParams := ctx.ConsensusParams()
 
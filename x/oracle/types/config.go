@@ -13,6 +13,8 @@ type OracleConfig struct {
 	BitcoinRpcPass string `toml:"bitcoin_rpc_password"`
 	HTTPPostMode   bool   `toml:"http_post_mode"`
 	DisableTLS     bool   `toml:"disable_tls"`
+	// optional denylist file used to screen the withdrawal addresses; empty means disabled
+	ScreenerDenylist string `toml:"screener_denylist"`
 }
 
 func DefaultOracleConfig() OracleConfig {
@@ -62,6 +64,11 @@ func ReadOracleConfig(opts servertypes.AppOptions) (OracleConfig, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagOracleScreenerDenylist); v != nil {
+		if cfg.ScreenerDenylist, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
 	StartProviders = cfg.Enable
 	return cfg, validate(&cfg)
 }
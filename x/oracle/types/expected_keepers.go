@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScreeningKeeper defines the expected keeper interface for the address screening
+type ScreeningKeeper interface {
+	GetScreeningCandidates(ctx sdk.Context) []string
+	AddScreenedAddresses(ctx sdk.Context, addresses []string)
+}
+
+// AddressScreener defines the off-chain address screening interface which can be optionally plugged in by validators
+type AddressScreener interface {
+	// Screen returns the flagged addresses among the given addresses
+	Screen(addresses []string) ([]string, error)
+}
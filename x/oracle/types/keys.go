@@ -28,6 +28,8 @@ const (
 	flagOracleBitcoinRpcPass = "oracle.bitcoin_rpc_password"
 	flagOracleBitcoinRpcPost = "oracle.http_post_mode"
 	flagOracleBitcoinRpcSSL  = "oracle.disable_tls"
+
+	flagOracleScreenerDenylist = "oracle.screener_denylist"
 )
 
 var (
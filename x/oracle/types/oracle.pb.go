@@ -185,6 +185,8 @@ type OracleVoteExtension struct {
 	Prices   map[string]string `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Blocks   []*BlockHeader    `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
 	HasError bool              `protobuf:"varint,4,opt,name=has_error,json=hasError,proto3" json:"has_error,omitempty"`
+	// addresses flagged by the optional off-chain screener
+	ScreenedAddresses []string `protobuf:"bytes,5,rep,name=screened_addresses,json=screenedAddresses,proto3" json:"screened_addresses,omitempty"`
 }
 
 func (m *OracleVoteExtension) Reset()         { *m = OracleVoteExtension{} }
@@ -248,6 +250,13 @@ func (m *OracleVoteExtension) GetHasError() bool {
 	return false
 }
 
+func (m *OracleVoteExtension) GetScreenedAddresses() []string {
+	if m != nil {
+		return m.ScreenedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OraclePrice)(nil), "bitway.oracle.OraclePrice")
 	proto.RegisterType((*BlockHeader)(nil), "bitway.oracle.BlockHeader")
@@ -258,38 +267,39 @@ func init() {
 func init() { proto.RegisterFile("bitway/oracle/oracle.proto", fileDescriptor_998ea42f731d3edb) }
 
 var fileDescriptor_998ea42f731d3edb = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x8b, 0x13, 0x31,
-	0x14, 0x6f, 0xfa, 0x67, 0xb6, 0xcd, 0x20, 0x68, 0x76, 0x91, 0xd0, 0x85, 0x69, 0xa9, 0x97, 0x82,
-	0x90, 0x81, 0xf5, 0xe2, 0x7a, 0x2c, 0x16, 0x3c, 0x2c, 0x28, 0x39, 0x78, 0xf0, 0x52, 0x33, 0xb3,
-	0x61, 0x66, 0xe8, 0xcc, 0xbc, 0x92, 0xa4, 0xb5, 0xf3, 0x09, 0xbc, 0xfa, 0xb1, 0xf6, 0xb8, 0x47,
-	0xf1, 0xb0, 0x48, 0xfb, 0x15, 0xfc, 0x00, 0x92, 0x64, 0x8a, 0xab, 0x78, 0xca, 0xef, 0x97, 0xf7,
-	0xde, 0xef, 0xfd, 0xc5, 0xe3, 0xa4, 0x30, 0x5f, 0x44, 0x13, 0x83, 0x12, 0x69, 0x29, 0xdb, 0x87,
-	0x6d, 0x14, 0x18, 0x20, 0x4f, 0xbc, 0x8d, 0xf9, 0xcf, 0xf1, 0x45, 0x06, 0x19, 0x38, 0x4b, 0x6c,
-	0x91, 0x77, 0x9a, 0x7d, 0xc6, 0xe1, 0x7b, 0x67, 0xff, 0xa0, 0x8a, 0x54, 0x92, 0xe7, 0x38, 0xd0,
-	0x4d, 0x95, 0x40, 0x49, 0xd1, 0x14, 0xcd, 0x47, 0xbc, 0x65, 0xe4, 0x1a, 0x0f, 0x36, 0xd6, 0x81,
-	0x76, 0xed, 0xf7, 0xe2, 0xc5, 0xdd, 0xc3, 0xa4, 0xf3, 0xe3, 0x61, 0x72, 0x99, 0x82, 0xae, 0x40,
-	0xeb, 0xdb, 0x35, 0x2b, 0x20, 0xae, 0x84, 0xc9, 0xd9, 0x8d, 0xcc, 0x44, 0xda, 0xbc, 0x95, 0x29,
-	0xf7, 0x11, 0xb3, 0x5f, 0x08, 0x87, 0x8b, 0x12, 0xd2, 0xf5, 0x3b, 0x29, 0x6e, 0xa5, 0x22, 0x14,
-	0x9f, 0xed, 0xa4, 0xd2, 0x05, 0xd4, 0x2e, 0xc7, 0x80, 0x9f, 0x28, 0x21, 0xb8, 0x9f, 0x0b, 0x9d,
-	0xfb, 0x1c, 0xdc, 0x61, 0x5b, 0x50, 0x2e, 0x8b, 0x2c, 0x37, 0xb4, 0xe7, 0x9c, 0x5b, 0x46, 0x18,
-	0x3e, 0xdf, 0x28, 0xb9, 0x2b, 0x60, 0xab, 0x57, 0x89, 0x55, 0x5f, 0xb9, 0xd0, 0xbe, 0x0b, 0x7d,
-	0x76, 0x32, 0xf9, 0xbc, 0x56, 0x67, 0x82, 0xc3, 0x4a, 0xaa, 0x75, 0x29, 0x57, 0x0a, 0xc0, 0xd0,
-	0x81, 0xf3, 0xc3, 0xfe, 0x8b, 0x03, 0x18, 0x72, 0x81, 0x07, 0x35, 0xd4, 0xa9, 0xa4, 0xc1, 0x14,
-	0xcd, 0xfb, 0xdc, 0x13, 0x5b, 0x52, 0x52, 0x18, 0x4d, 0xcf, 0x7c, 0x49, 0x16, 0xdb, 0x3f, 0x53,
-	0x54, 0x92, 0x0e, 0xa7, 0x68, 0xde, 0xe3, 0x0e, 0x93, 0xa7, 0xb8, 0x57, 0x9b, 0x3d, 0x1d, 0xb9,
-	0x1a, 0x2d, 0x9c, 0x7d, 0xed, 0xe2, 0x73, 0x3f, 0xd9, 0x8f, 0x60, 0xe4, 0x72, 0x6f, 0x64, 0xed,
-	0x9a, 0xfc, 0xd3, 0x10, 0x72, 0xf1, 0xa7, 0x86, 0x6e, 0x70, 0xe0, 0xe6, 0xa5, 0x69, 0x77, 0xda,
-	0x9b, 0x87, 0x57, 0x8c, 0xfd, 0xb5, 0x3e, 0xf6, 0x1f, 0x2d, 0xe6, 0x76, 0xa6, 0x97, 0xb5, 0x51,
-	0xcd, 0xa2, 0x6f, 0x57, 0xc2, 0x5b, 0x0d, 0x72, 0x85, 0x03, 0x37, 0x15, 0x4d, 0x7b, 0x4e, 0x6d,
-	0xfc, 0x8f, 0xda, 0xa3, 0x85, 0xf0, 0xd6, 0x93, 0x5c, 0xe2, 0x51, 0x2e, 0xf4, 0x4a, 0x2a, 0x05,
-	0xca, 0x0d, 0x72, 0xc8, 0x87, 0xb9, 0xd0, 0x4b, 0xcb, 0xc7, 0xd7, 0x38, 0x7c, 0x94, 0xcd, 0xf6,
-	0xbb, 0x96, 0x4d, 0x7b, 0x24, 0x16, 0xda, 0xf9, 0xed, 0x44, 0xb9, 0x6d, 0x2f, 0x84, 0x7b, 0xf2,
-	0xa6, 0xfb, 0x1a, 0x2d, 0x96, 0x77, 0x87, 0x08, 0xdd, 0x1f, 0x22, 0xf4, 0xf3, 0x10, 0xa1, 0x6f,
-	0xc7, 0xa8, 0x73, 0x7f, 0x8c, 0x3a, 0xdf, 0x8f, 0x51, 0xe7, 0xd3, 0xcb, 0xac, 0x30, 0xf9, 0x36,
-	0x61, 0x29, 0x54, 0xb1, 0xaf, 0xaf, 0x14, 0x89, 0x6e, 0x61, 0xbc, 0x3f, 0x5d, 0xb5, 0x69, 0x36,
-	0x52, 0x27, 0x81, 0x3b, 0xd8, 0x57, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0x97, 0xb8, 0xc8,
-	0xf3, 0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0x6f, 0x9a, 0xb6, 0xdb, 0x4e, 0x11, 0x74, 0x76, 0x91, 0xa1, 0x0b, 0x69, 0xa9, 0x97, 0x82,
+	0x98, 0xc2, 0x7a, 0x71, 0xbd, 0x59, 0x2c, 0x78, 0x58, 0x50, 0xe6, 0xe0, 0xc1, 0x4b, 0x9d, 0xa4,
+	0x8f, 0x24, 0x34, 0xc9, 0x2b, 0x33, 0xd3, 0xda, 0x7c, 0x0b, 0x3f, 0x8d, 0x9f, 0x61, 0x8f, 0x7b,
+	0x14, 0x0f, 0x8b, 0xb4, 0x5f, 0xc1, 0x0f, 0x20, 0x33, 0x93, 0xe0, 0x2a, 0x9e, 0xf2, 0x7b, 0xff,
+	0x7e, 0xf3, 0xde, 0xef, 0xbd, 0x90, 0x51, 0x94, 0xe9, 0x2f, 0xa2, 0x9a, 0xa3, 0x14, 0x71, 0x0e,
+	0xf5, 0x27, 0xdc, 0x4a, 0xd4, 0x48, 0x1f, 0xb9, 0x58, 0xe8, 0x9c, 0xa3, 0x8b, 0x04, 0x13, 0xb4,
+	0x91, 0xb9, 0x41, 0x2e, 0x69, 0xfa, 0x99, 0x0c, 0xdf, 0xdb, 0xf8, 0x07, 0x99, 0xc5, 0x40, 0x9f,
+	0x92, 0x9e, 0xaa, 0x8a, 0x08, 0x73, 0xe6, 0x4d, 0xbc, 0xd9, 0x80, 0xd7, 0x16, 0xbd, 0x26, 0xdd,
+	0xad, 0x49, 0x60, 0x6d, 0xe3, 0x5e, 0x3c, 0xbb, 0xbd, 0x1f, 0xb7, 0x7e, 0xdc, 0x8f, 0x2f, 0x63,
+	0x54, 0x05, 0x2a, 0xb5, 0xde, 0x84, 0x19, 0xce, 0x0b, 0xa1, 0xd3, 0xf0, 0x06, 0x12, 0x11, 0x57,
+	0x6f, 0x21, 0xe6, 0xae, 0x62, 0xfa, 0xcb, 0x23, 0xc3, 0x45, 0x8e, 0xf1, 0xe6, 0x1d, 0x88, 0x35,
+	0x48, 0xca, 0xc8, 0xd9, 0x1e, 0xa4, 0xca, 0xb0, 0xb4, 0x6f, 0x74, 0x79, 0x63, 0x52, 0x4a, 0x3a,
+	0xa9, 0x50, 0xa9, 0x7b, 0x83, 0x5b, 0x6c, 0x1a, 0x4a, 0x21, 0x4b, 0x52, 0xcd, 0x7c, 0x9b, 0x5c,
+	0x5b, 0x34, 0x24, 0xe7, 0x5b, 0x09, 0xfb, 0x0c, 0x77, 0x6a, 0x15, 0x19, 0xf6, 0x95, 0x2d, 0xed,
+	0xd8, 0xd2, 0x27, 0x4d, 0xc8, 0xbd, 0x6b, 0x78, 0xc6, 0x64, 0x58, 0x80, 0xdc, 0xe4, 0xb0, 0x92,
+	0x88, 0x9a, 0x75, 0x6d, 0x1e, 0x71, 0x2e, 0x8e, 0xa8, 0xe9, 0x05, 0xe9, 0x96, 0x58, 0xc6, 0xc0,
+	0x7a, 0x13, 0x6f, 0xd6, 0xe1, 0xce, 0x30, 0x2d, 0x45, 0x99, 0x56, 0xec, 0xcc, 0xb5, 0x64, 0xb0,
+	0xf1, 0xe9, 0xac, 0x00, 0xd6, 0x9f, 0x78, 0x33, 0x9f, 0x5b, 0x4c, 0x1f, 0x13, 0xbf, 0xd4, 0x07,
+	0x36, 0xb0, 0x3d, 0x1a, 0x38, 0xfd, 0xd6, 0x26, 0xe7, 0x4e, 0xd9, 0x8f, 0xa8, 0x61, 0x79, 0xd0,
+	0x50, 0xda, 0x21, 0xff, 0x0c, 0xe4, 0xd9, 0xfa, 0x66, 0xa0, 0x1b, 0xd2, 0xb3, 0x7a, 0x29, 0xd6,
+	0x9e, 0xf8, 0xb3, 0xe1, 0x55, 0x18, 0xfe, 0xb5, 0xbe, 0xf0, 0x3f, 0x5c, 0xa1, 0xdd, 0x99, 0x5a,
+	0x96, 0x5a, 0x56, 0x8b, 0x8e, 0x59, 0x09, 0xaf, 0x39, 0xe8, 0x15, 0xe9, 0x59, 0x55, 0x14, 0xf3,
+	0x2d, 0xdb, 0xe8, 0x1f, 0xb6, 0x07, 0x0b, 0xe1, 0x75, 0x26, 0xbd, 0x24, 0x83, 0x54, 0xa8, 0x15,
+	0x48, 0x89, 0xd2, 0x0a, 0xd9, 0xe7, 0xfd, 0x54, 0xa8, 0xa5, 0xb1, 0xe9, 0x0b, 0x42, 0x55, 0x2c,
+	0x01, 0x4a, 0x58, 0xaf, 0xc4, 0x7a, 0x2d, 0x41, 0x29, 0x50, 0xac, 0x3b, 0xf1, 0x8d, 0xdc, 0x4d,
+	0xe4, 0x4d, 0x13, 0x18, 0x5d, 0x93, 0xe1, 0x83, 0xe6, 0x8c, 0x3c, 0x1b, 0xa8, 0xea, 0x9b, 0x32,
+	0xd0, 0xc8, 0xbd, 0x17, 0xf9, 0xae, 0x3e, 0x28, 0xee, 0x8c, 0xd7, 0xed, 0x57, 0xde, 0x62, 0x79,
+	0x7b, 0x0c, 0xbc, 0xbb, 0x63, 0xe0, 0xfd, 0x3c, 0x06, 0xde, 0xd7, 0x53, 0xd0, 0xba, 0x3b, 0x05,
+	0xad, 0xef, 0xa7, 0xa0, 0xf5, 0xe9, 0x79, 0x92, 0xe9, 0x74, 0x17, 0x85, 0x31, 0x16, 0x73, 0x37,
+	0x4e, 0x2e, 0x22, 0x55, 0xc3, 0xf9, 0xa1, 0xf9, 0x09, 0x74, 0xb5, 0x05, 0x15, 0xf5, 0xec, 0x7d,
+	0xbf, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x89, 0x35, 0xf2, 0x22, 0x03, 0x00, 0x00,
 }
 
 func (m *OraclePrice) Marshal() (dAtA []byte, err error) {
@@ -428,6 +438,15 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScreenedAddresses) > 0 {
+		for iNdEx := len(m.ScreenedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScreenedAddresses[iNdEx])
+			copy(dAtA[i:], m.ScreenedAddresses[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.ScreenedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.HasError {
 		i--
 		if m.HasError {
@@ -571,6 +590,12 @@ func (m *OracleVoteExtension) Size() (n int) {
 	if m.HasError {
 		n += 2
 	}
+	if len(m.ScreenedAddresses) > 0 {
+		for _, s := range m.ScreenedAddresses {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1198,6 +1223,38 @@ func (m *OracleVoteExtension) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasError = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreenedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreenedAddresses = append(m.ScreenedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
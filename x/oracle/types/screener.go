@@ -0,0 +1,49 @@
+package types
+
+import (
+	"os"
+	"strings"
+)
+
+var _ AddressScreener = &DenylistScreener{}
+
+// DenylistScreener screens the addresses against the local denylist file maintained by the validator
+// The file contains one address per line; empty lines and lines starting with # are ignored
+// The file is reloaded on every screening so that the updates take effect without restarting the node
+type DenylistScreener struct {
+	path string
+}
+
+// NewDenylistScreener creates a new denylist screener with the given file path
+func NewDenylistScreener(path string) *DenylistScreener {
+	return &DenylistScreener{path: path}
+}
+
+// Screen implements AddressScreener
+func (s *DenylistScreener) Screen(addresses []string) ([]string, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	denylist := make(map[string]bool)
+
+	for _, line := range strings.Split(string(bz), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		denylist[line] = true
+	}
+
+	flagged := []string{}
+
+	for _, addr := range addresses {
+		if denylist[addr] {
+			flagged = append(flagged, addr)
+		}
+	}
+
+	return flagged, nil
+}
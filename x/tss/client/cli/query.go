@@ -31,9 +31,26 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryDKGCompletions())
 	cmd.AddCommand(CmdQuerySigningRequest())
 	cmd.AddCommand(CmdQuerySigningRequests())
+	cmd.AddCommand(CmdQuerySigningBatch())
+	cmd.AddCommand(CmdQuerySigningBatches())
+	cmd.AddCommand(CmdQuerySigningAcknowledgements())
 	cmd.AddCommand(CmdQueryRefreshingRequest())
 	cmd.AddCommand(CmdQueryRefreshingRequests())
 	cmd.AddCommand(CmdQueryRefreshingCompletions())
+	cmd.AddCommand(CmdQueryParticipantReliability())
+	cmd.AddCommand(CmdQueryParticipantReliabilities())
+	cmd.AddCommand(CmdQueryParticipantHealth())
+	cmd.AddCommand(CmdQuerySigningStats())
+	cmd.AddCommand(CmdQueryParticipantSet())
+	cmd.AddCommand(CmdQueryCommittedNonces())
+	cmd.AddCommand(CmdQueryPartialSignatures())
+	cmd.AddCommand(CmdQuerySignerSet())
+	cmd.AddCommand(CmdQuerySigningFee())
+	cmd.AddCommand(CmdQueryParticipantRewards())
+	cmd.AddCommand(CmdQueryAllParticipantRewards())
+	cmd.AddCommand(CmdQueryKeyRotations())
+	cmd.AddCommand(CmdQueryGovSignatureRequest())
+	cmd.AddCommand(CmdQueryGovSignatureRequests())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -226,6 +243,70 @@ func CmdQuerySigningRequests() *cobra.Command {
 	return cmd
 }
 
+func CmdQuerySigningBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-batch [id]",
+		Short: "Query the signing batch by the given id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningBatch(cmd.Context(), &types.QuerySigningBatchRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySigningBatches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-batches [status]",
+		Short: "Query signing batches by the given status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningBatches(cmd.Context(), &types.QuerySigningBatchesRequest{Status: types.SigningStatus(status)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryRefreshingRequest() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "refreshing-request [id]",
@@ -321,3 +402,448 @@ func CmdQueryRefreshingCompletions() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQuerySigningAcknowledgements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-acknowledgements [id]",
+		Short: "Query signing acknowledgements by the given signing request id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningAcknowledgements(cmd.Context(), &types.QuerySigningAcknowledgementsRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParticipantReliability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-reliability [consensus pub key]",
+		Short: "Query the reliability of the given participant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipantReliability(cmd.Context(), &types.QueryParticipantReliabilityRequest{ConsensusPubkey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParticipantReliabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-reliabilities",
+		Short: "Query reliabilities of all participants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipantReliabilities(cmd.Context(), &types.QueryParticipantReliabilitiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParticipantHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-health [consensus pub key]",
+		Short: "Query the key inventory and signing performance of the given participant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipantHealth(cmd.Context(), &types.QueryParticipantHealthRequest{ConsensusPubkey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySigningStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-stats [module]",
+		Short: "Query the signing statistics by module and signing type",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			module := ""
+			if len(args) > 0 {
+				module = args[0]
+			}
+
+			res, err := queryClient.SigningStats(cmd.Context(), &types.QuerySigningStatsRequest{Module: module})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParticipantSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-set",
+		Short: "Query the automatically maintained participant set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipantSet(cmd.Context(), &types.QueryParticipantSetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCommittedNonces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "committed-nonces [dkg id]",
+		Short: "Query the committed nonces in the queue of the given dkg",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			dkgId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommittedNonces(cmd.Context(), &types.QueryCommittedNoncesRequest{DkgId: dkgId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPartialSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-signatures [id]",
+		Short: "Query partial signatures by the given signing request id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PartialSignatures(cmd.Context(), &types.QueryPartialSignaturesRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySignerSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set [id]",
+		Short: "Query the signer set of the given signing request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSet(cmd.Context(), &types.QuerySignerSetRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySigningFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-fee [id]",
+		Short: "Query the fee escrowed for the given signing request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningFee(cmd.Context(), &types.QuerySigningFeeRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParticipantRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-rewards [consensus pub key]",
+		Short: "Query the rewards of the given participant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipantRewards(cmd.Context(), &types.QueryParticipantRewardsRequest{ConsensusPubkey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllParticipantRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-participant-rewards",
+		Short: "Query rewards of all participants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllParticipantRewards(cmd.Context(), &types.QueryAllParticipantRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryKeyRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-rotations [module]",
+		Short: "Query the scheduled key rotations of the given module or all modules if not specified",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			module := ""
+			if len(args) > 0 {
+				module = args[0]
+			}
+
+			res, err := queryClient.KeyRotations(cmd.Context(), &types.QueryKeyRotationsRequest{Module: module})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGovSignatureRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-signature-request [id]",
+		Short: "Query the gov signature request and the corresponding signing request by the given id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovSignatureRequest(cmd.Context(), &types.QueryGovSignatureRequestRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGovSignatureRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-signature-requests",
+		Short: "Query all gov signature requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GovSignatureRequests(cmd.Context(), &types.QueryGovSignatureRequestsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
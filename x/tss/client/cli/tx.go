@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +21,9 @@ var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Min
 const (
 	// flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	listSeparator = ","
+
+	// separator between the signing request id and signatures
+	batchSignaturesSeparator = ":"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -34,7 +38,11 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCompleteDKG())
 	cmd.AddCommand(CmdSubmitSignatures())
+	cmd.AddCommand(CmdSubmitBatchSignatures())
 	cmd.AddCommand(CmdCompleteRefreshing())
+	cmd.AddCommand(CmdAcknowledgeSigning())
+	cmd.AddCommand(CmdSubmitPartialSignatures())
+	cmd.AddCommand(CmdClaimRewards())
 
 	return cmd
 }
@@ -42,9 +50,9 @@ func GetTxCmd() *cobra.Command {
 // Complete DKG
 func CmdCompleteDKG() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "complete-dkg [id] [pub keys] [consensus pub key] [signature]",
-		Short: "Complete DKG with the generated pub keys and signature",
-		Args:  cobra.ExactArgs(4),
+		Use:   "complete-dkg [id] [pub keys] [consensus pub key] [signature] [proofs] [verification shares]",
+		Short: "Complete DKG with the generated pub keys, signature, proofs of possession and optional verification shares",
+		Args:  cobra.RangeArgs(5, 6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -62,6 +70,8 @@ func CmdCompleteDKG() *cobra.Command {
 				strings.Split(args[1], listSeparator),
 				args[2],
 				args[3],
+				strings.Split(args[4], listSeparator),
+				getOptionalList(args, 5),
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -112,12 +122,66 @@ func CmdSubmitSignatures() *cobra.Command {
 	return cmd
 }
 
+func CmdSubmitBatchSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-batch-signatures [id] [signing id:signatures]...",
+		Short: "Submit signatures for all pending signing requests of the given batch",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			batchSignatures := []*types.BatchSignatures{}
+
+			for _, arg := range args[1:] {
+				signingId, signatures, found := strings.Cut(arg, batchSignaturesSeparator)
+				if !found {
+					return fmt.Errorf("invalid batch signatures %s", arg)
+				}
+
+				id, err := strconv.ParseUint(signingId, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				batchSignatures = append(batchSignatures, &types.BatchSignatures{
+					SigningId:  id,
+					Signatures: strings.Split(signatures, listSeparator),
+				})
+			}
+
+			msg := types.NewMsgSubmitBatchSignatures(
+				clientCtx.GetFromAddress().String(),
+				id,
+				batchSignatures,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Complete refreshing
 func CmdCompleteRefreshing() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "complete-refreshing [id] [consensus pub key] [signature]",
-		Short: "Complete refreshing with the corresponding signature",
-		Args:  cobra.ExactArgs(3),
+		Use:   "complete-refreshing [id] [consensus pub key] [signature] [verification shares]",
+		Short: "Complete refreshing with the corresponding signature and optional verification shares",
+		Args:  cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -134,6 +198,7 @@ func CmdCompleteRefreshing() *cobra.Command {
 				id,
 				args[1],
 				args[2],
+				getOptionalList(args, 3),
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -148,3 +213,121 @@ func CmdCompleteRefreshing() *cobra.Command {
 
 	return cmd
 }
+
+// Acknowledge signing
+func CmdAcknowledgeSigning() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge-signing [id] [consensus pub key] [signature]",
+		Short: "Acknowledge the participation in the given signing request with the corresponding signature",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcknowledgeSigning(
+				clientCtx.GetFromAddress().String(),
+				id,
+				args[1],
+				args[2],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Submit partial signatures
+func CmdSubmitPartialSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-partial-signatures [id] [consensus pub key] [signers] [aggregated nonces] [nonces] [partial signatures] [signature]",
+		Short: "Submit the partial signatures of the participant in the signing session for the given signing request with the corresponding signature",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPartialSignatures(
+				clientCtx.GetFromAddress().String(),
+				id,
+				args[1],
+				strings.Split(args[2], listSeparator),
+				strings.Split(args[3], listSeparator),
+				strings.Split(args[4], listSeparator),
+				strings.Split(args[5], listSeparator),
+				args[6],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Claim rewards
+func CmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [consensus pub key]",
+		Short: "Claim the rewards of the given participant by the corresponding validator operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimRewards(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getOptionalList gets the list from the optional argument at the given position
+// Nil returned if the argument is not provided
+func getOptionalList(args []string, i int) []string {
+	if len(args) <= i || len(args[i]) == 0 {
+		return nil
+	}
+
+	return strings.Split(args[i], listSeparator)
+}
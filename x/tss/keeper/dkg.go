@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"strings"
@@ -279,6 +280,11 @@ func (k Keeper) GetDKGPubKeys(ctx sdk.Context, id uint64) []string {
 
 // InitiateDKG initiates the DKG request by the specified params
 func (k Keeper) InitiateDKG(ctx sdk.Context, module string, ty string, intent int32, participants []string, threshold uint32, batchSize uint32, timeoutDuration time.Duration) *types.DKGRequest {
+	return k.InitiateDKGWithKeyType(ctx, module, ty, intent, participants, threshold, batchSize, timeoutDuration, types.KeyType_KEY_TYPE_SCHNORR)
+}
+
+// InitiateDKGWithKeyType initiates the DKG request to generate keys of the given key type
+func (k Keeper) InitiateDKGWithKeyType(ctx sdk.Context, module string, ty string, intent int32, participants []string, threshold uint32, batchSize uint32, timeoutDuration time.Duration, keyType types.KeyType) *types.DKGRequest {
 	if timeoutDuration == 0 {
 		timeoutDuration = k.DKGTimeoutDuration(ctx)
 	}
@@ -293,6 +299,7 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, module string, ty string, intent in
 		BatchSize:      batchSize,
 		ExpirationTime: types.GetExpirationTime(ctx.BlockTime(), timeoutDuration),
 		Status:         types.DKGStatus_DKG_STATUS_PENDING,
+		KeyType:        keyType,
 	}
 
 	k.SetDKGRequest(ctx, req)
@@ -309,6 +316,7 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, module string, ty string, intent in
 			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", threshold)),
 			sdk.NewAttribute(types.AttributeKeyBatchSize, fmt.Sprintf("%d", batchSize)),
 			sdk.NewAttribute(types.AttributeKeyExpirationTime, req.ExpirationTime.String()),
+			sdk.NewAttribute(types.AttributeKeyKeyType, keyType.String()),
 		),
 	)
 
@@ -317,7 +325,9 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, module string, ty string, intent in
 
 // CompleteDKG completes the DKG request by the DKG participant
 // The DKG request will be completed when all participants submit valid completions before timeout
-func (k Keeper) CompleteDKG(ctx sdk.Context, sender string, id uint64, pubKeys []string, consensusPubKey string, signature string) error {
+// Each pub key must be accompanied by a valid proof of possession to ensure that the key is usable
+// The optional verification shares enable the on chain aggregation of the partial signatures
+func (k Keeper) CompleteDKG(ctx sdk.Context, sender string, id uint64, pubKeys []string, consensusPubKey string, signature string, proofs []string, verificationShares []string) error {
 	if !k.HasDKGRequest(ctx, id) {
 		return types.ErrDKGRequestDoesNotExist
 	}
@@ -343,19 +353,73 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, sender string, id uint64, pubKeys [
 		return errorsmod.Wrap(types.ErrInvalidDKGCompletion, "mismatched public key count")
 	}
 
-	if !types.VerifySignature(signature, consensusPubKey, types.GetDKGCompletionSigMsg(id, pubKeys)) {
+	if len(proofs) != len(pubKeys) {
+		return errorsmod.Wrap(types.ErrInvalidPossessionProof, "mismatched proof count")
+	}
+
+	for i, pubKey := range pubKeys {
+		pubKeyBytes, _ := hex.DecodeString(pubKey)
+		if err := types.CheckPubKey(dkgRequest.KeyType, pubKeyBytes); err != nil {
+			return err
+		}
+
+		if !types.VerifyPossessionProof(dkgRequest.KeyType, id, pubKey, proofs[i]) {
+			return errorsmod.Wrapf(types.ErrInvalidPossessionProof, "pub key %s", pubKey)
+		}
+	}
+
+	if len(verificationShares) != 0 {
+		if dkgRequest.KeyType != types.KeyType_KEY_TYPE_SCHNORR {
+			return errorsmod.Wrap(types.ErrInvalidVerificationShares, "verification shares only supported for schnorr keys")
+		}
+
+		if err := types.CheckVerificationShares(pubKeys, len(dkgRequest.Participants), dkgRequest.Threshold, verificationShares); err != nil {
+			return err
+		}
+	}
+
+	if !types.VerifySignature(signature, consensusPubKey, types.GetDKGCompletionSigMsg(id, pubKeys, verificationShares)) {
 		return types.ErrInvalidSignature
 	}
 
 	completion := &types.DKGCompletion{
-		Id:              id,
-		Sender:          sender,
-		PubKeys:         pubKeys,
-		ConsensusPubkey: consensusPubKey,
-		Signature:       signature,
+		Id:                 id,
+		Sender:             sender,
+		PubKeys:            pubKeys,
+		ConsensusPubkey:    consensusPubKey,
+		Signature:          signature,
+		Proofs:             proofs,
+		VerificationShares: verificationShares,
 	}
 
 	k.SetDKGCompletion(ctx, completion)
 
 	return nil
 }
+
+// SetDKGRequestByPubKeys indexes the given completed DKG request by the generated pub keys
+// The pub key already indexed is skipped, i.e. the first DKG request which generated the pub key is retained
+func (k Keeper) SetDKGRequestByPubKeys(ctx sdk.Context, id uint64, pubKeys []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, pubKey := range pubKeys {
+		if store.Has(types.DKGRequestByPubKeyKey(pubKey)) {
+			continue
+		}
+
+		store.Set(types.DKGRequestByPubKeyKey(pubKey), sdk.Uint64ToBigEndian(id))
+	}
+}
+
+// GetDKGRequestByPubKey gets the completed DKG request which generated the given pub key
+// Nil is returned if not found
+func (k Keeper) GetDKGRequestByPubKey(ctx sdk.Context, pubKey string) *types.DKGRequest {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DKGRequestByPubKeyKey(pubKey))
+	if bz == nil {
+		return nil
+	}
+
+	return k.GetDKGRequest(ctx, sdk.BigEndianToUint64(bz))
+}
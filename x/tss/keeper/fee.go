@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// AttachSigningFee attaches the fee paid by the given payer to the specified signing request
+// The fee is escrowed in the module account and distributed to the participants when the signing request is completed
+// The fee is refunded to the payer if the signing request times out without being re-initiated
+func (k Keeper) AttachSigningFee(ctx sdk.Context, id uint64, payer sdk.AccAddress, fee sdk.Coins) error {
+	if !fee.IsValid() || fee.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidSigningFee, "invalid fee %s", fee)
+	}
+
+	if !k.HasSigningRequest(ctx, id) {
+		return types.ErrSigningRequestDoesNotExist
+	}
+
+	req := k.GetSigningRequest(ctx, id)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing request non pending")
+	}
+
+	if k.HasSigningFee(ctx, id) {
+		return types.ErrSigningFeeAlreadyExists
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee); err != nil {
+		return err
+	}
+
+	k.SetSigningFee(ctx, &types.SigningFee{
+		Id:    id,
+		Payer: payer.String(),
+		Fee:   fee,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSigningFeeEscrowed,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// attachModuleSigningFee attaches the signing fee configured for the module of the given signing request if any
+// The fee is paid from the payer module account and the signing request is left without fee if the payment failed
+func (k Keeper) attachModuleSigningFee(ctx sdk.Context, req *types.SigningRequest) {
+	moduleFee, found := k.GetModuleSigningFee(ctx, req.Module)
+	if !found {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.AttachSigningFee(cacheCtx, req.Id, authtypes.NewModuleAddress(moduleFee.PayerModule), moduleFee.Fee); err != nil {
+		k.Logger(ctx).Info("Failed to attach the module signing fee", "id", req.Id, "module", req.Module, "payer module", moduleFee.PayerModule, "err", err)
+		return
+	}
+
+	write()
+}
+
+// RefundSigningFee refunds the escrowed fee of the given signing request to the payer
+// No-op if no fee is escrowed
+func (k Keeper) RefundSigningFee(ctx sdk.Context, id uint64) {
+	if !k.HasSigningFee(ctx, id) {
+		return
+	}
+
+	signingFee := k.GetSigningFee(ctx, id)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(signingFee.Payer), signingFee.Fee); err != nil {
+		// keep the fee escrowed
+		k.Logger(ctx).Error("Failed to refund the signing fee", "id", id, "payer", signingFee.Payer, "err", err)
+		return
+	}
+
+	k.RemoveSigningFee(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSigningFeeRefunded,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, signingFee.Payer),
+			sdk.NewAttribute(types.AttributeKeyFee, signingFee.Fee.String()),
+		),
+	)
+}
+
+// DistributeSigningFee distributes the escrowed fee of the given signing request to the specified signers equally
+// Fall back to the participants of the DKG if no signers are given
+// The remainder of the division is allocated to the first recipient
+func (k Keeper) DistributeSigningFee(ctx sdk.Context, req *types.SigningRequest, signers []string) {
+	if !k.HasSigningFee(ctx, req.Id) {
+		return
+	}
+
+	recipients := signers
+	if len(recipients) == 0 {
+		recipients = k.GetSigningParticipants(ctx, req)
+	}
+
+	if len(recipients) == 0 {
+		// keep the fee escrowed
+		return
+	}
+
+	signingFee := k.GetSigningFee(ctx, req.Id)
+
+	share, remainder := splitCoins(signingFee.Fee, len(recipients))
+
+	for i, recipient := range recipients {
+		rewards := share
+		if i == 0 {
+			rewards = rewards.Add(remainder...)
+		}
+
+		if rewards.IsZero() {
+			continue
+		}
+
+		participantRewards := k.GetParticipantRewards(ctx, recipient)
+		participantRewards.Rewards = participantRewards.Rewards.Add(rewards...)
+
+		k.SetParticipantRewards(ctx, participantRewards)
+	}
+
+	k.RemoveSigningFee(ctx, req.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSigningFeeDistributed,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyFee, signingFee.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipients, strings.Join(recipients, types.AttributeValueSeparator)),
+		),
+	)
+}
+
+// TransferSigningFee transfers the escrowed fee of the given signing request to the new one
+// No-op if no fee is escrowed
+func (k Keeper) TransferSigningFee(ctx sdk.Context, id uint64, newId uint64) {
+	if !k.HasSigningFee(ctx, id) {
+		return
+	}
+
+	signingFee := k.GetSigningFee(ctx, id)
+	k.RemoveSigningFee(ctx, id)
+
+	signingFee.Id = newId
+	k.SetSigningFee(ctx, signingFee)
+}
+
+// ClaimRewards claims the rewards of the given participant by the account of the corresponding validator operator
+func (k Keeper) ClaimRewards(ctx sdk.Context, sender string, consensusPubKey string) (sdk.Coins, error) {
+	validator := k.GetParticipantValidator(ctx, consensusPubKey)
+	if len(validator) == 0 {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedRewardsClaimer, "validator does not exist")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if sdk.AccAddress(valAddr).String() != sender {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedRewardsClaimer, "sender is not the validator operator")
+	}
+
+	participantRewards := k.GetParticipantRewards(ctx, consensusPubKey)
+	if participantRewards.Rewards.IsZero() {
+		return nil, types.ErrNoRewards
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(valAddr), participantRewards.Rewards); err != nil {
+		return nil, err
+	}
+
+	k.RemoveParticipantRewards(ctx, consensusPubKey)
+
+	return participantRewards.Rewards, nil
+}
+
+// SetSigningFee sets the given signing fee
+func (k Keeper) SetSigningFee(ctx sdk.Context, signingFee *types.SigningFee) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(signingFee)
+	store.Set(types.SigningFeeKey(signingFee.Id), bz)
+}
+
+// HasSigningFee returns true if the fee of the given signing request exists, false otherwise
+func (k Keeper) HasSigningFee(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.SigningFeeKey(id))
+}
+
+// GetSigningFee gets the fee of the given signing request
+// Nil is returned if the signing fee does not exist
+func (k Keeper) GetSigningFee(ctx sdk.Context, id uint64) *types.SigningFee {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SigningFeeKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var signingFee types.SigningFee
+	k.cdc.MustUnmarshal(bz, &signingFee)
+
+	return &signingFee
+}
+
+// RemoveSigningFee removes the fee of the given signing request
+func (k Keeper) RemoveSigningFee(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.SigningFeeKey(id))
+}
+
+// GetAllSigningFees gets all escrowed signing fees
+func (k Keeper) GetAllSigningFees(ctx sdk.Context) []*types.SigningFee {
+	signingFees := make([]*types.SigningFee, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SigningFeeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var signingFee types.SigningFee
+		k.cdc.MustUnmarshal(iterator.Value(), &signingFee)
+
+		signingFees = append(signingFees, &signingFee)
+	}
+
+	return signingFees
+}
+
+// SetParticipantRewards sets the given participant rewards
+func (k Keeper) SetParticipantRewards(ctx sdk.Context, rewards *types.ParticipantRewards) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(rewards)
+	store.Set(types.ParticipantRewardsKey(rewards.ConsensusPubkey), bz)
+}
+
+// GetParticipantRewards gets the rewards of the given participant
+// The empty rewards are returned if not exist
+func (k Keeper) GetParticipantRewards(ctx sdk.Context, consPubKey string) *types.ParticipantRewards {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParticipantRewardsKey(consPubKey))
+	if bz == nil {
+		return &types.ParticipantRewards{ConsensusPubkey: consPubKey, Rewards: sdk.Coins{}}
+	}
+
+	var rewards types.ParticipantRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+
+	return &rewards
+}
+
+// RemoveParticipantRewards removes the rewards of the given participant
+func (k Keeper) RemoveParticipantRewards(ctx sdk.Context, consPubKey string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ParticipantRewardsKey(consPubKey))
+}
+
+// GetAllParticipantRewards gets rewards of all participants
+func (k Keeper) GetAllParticipantRewards(ctx sdk.Context) []*types.ParticipantRewards {
+	allRewards := make([]*types.ParticipantRewards, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ParticipantRewardsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.ParticipantRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+
+		allRewards = append(allRewards, &rewards)
+	}
+
+	return allRewards
+}
+
+// GetParticipantRewardsWithPagination gets rewards of all participants with pagination
+func (k Keeper) GetParticipantRewardsWithPagination(ctx sdk.Context, pagination *query.PageRequest) ([]*types.ParticipantRewards, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	rewardsStore := prefix.NewStore(store, types.ParticipantRewardsKeyPrefix)
+
+	var allRewards []*types.ParticipantRewards
+
+	pageRes, err := query.Paginate(rewardsStore, pagination, func(key []byte, value []byte) error {
+		var rewards types.ParticipantRewards
+		k.cdc.MustUnmarshal(value, &rewards)
+
+		allRewards = append(allRewards, &rewards)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return allRewards, pageRes, nil
+}
+
+// splitCoins splits the given coins into the specified number of equal shares
+// The share and remainder are returned
+func splitCoins(coins sdk.Coins, num int) (sdk.Coins, sdk.Coins) {
+	share := sdk.NewCoins()
+	remainder := sdk.NewCoins()
+
+	for _, coin := range coins {
+		amount := coin.Amount.Quo(sdkmath.NewInt(int64(num)))
+
+		share = share.Add(sdk.NewCoin(coin.Denom, amount))
+		remainder = remainder.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(amount.MulRaw(int64(num)))))
+	}
+
+	return share, remainder
+}
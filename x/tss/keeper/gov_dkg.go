@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GovDKGCompletionReceivedHandler is callback handler when the completion of the DKG initiated by the governance is received
+func (k Keeper) GovDKGCompletionReceivedHandler(ctx sdk.Context, id uint64, ty string, intent int32, participant string) error {
+	return nil
+}
+
+// GovDKGCompletedHandler is callback handler when the DKG initiated by the governance completed
+// The generated keys are usable by the governance signing requests
+func (k Keeper) GovDKGCompletedHandler(ctx sdk.Context, id uint64, ty string, intent int32, pubKeys []string) error {
+	return nil
+}
+
+// GovDKGTimeoutHandler is callback handler when the DKG initiated by the governance timed out
+func (k Keeper) GovDKGTimeoutHandler(ctx sdk.Context, id uint64, ty string, intent int32, absentParticipants []string) error {
+	return nil
+}
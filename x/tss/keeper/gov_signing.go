@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/bitcoin"
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// RequestSignature initiates the signing request by the given DKG on behalf of the governance
+// The sig hashes are derived from the psbt if given
+func (k Keeper) RequestSignature(ctx sdk.Context, dkgId uint64, pubKey string, ty types.SigningType, sigHashes []string, psbt string, options *types.SigningOptions) (*types.GovSignatureRequest, error) {
+	if !k.HasDKGRequest(ctx, dkgId) {
+		return nil, types.ErrDKGRequestDoesNotExist
+	}
+
+	dkgRequest := k.GetDKGRequest(ctx, dkgId)
+	if dkgRequest.Status != types.DKGStatus_DKG_STATUS_COMPLETED {
+		return nil, errorsmod.Wrap(types.ErrInvalidDKGStatus, "dkg not completed")
+	}
+
+	if dkgRequest.KeyType != types.GetKeyType(ty) {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigningRequest, "signing type mismatched with the dkg key type")
+	}
+
+	pubKeys := k.GetDKGPubKeys(ctx, dkgId)
+	if len(pubKey) == 0 && len(pubKeys) != 0 {
+		pubKey = pubKeys[0]
+	}
+
+	if !slices.Contains(pubKeys, pubKey) {
+		return nil, errorsmod.Wrap(types.ErrInvalidPubKey, "pub key does not belong to the dkg")
+	}
+
+	if len(psbt) != 0 {
+		var err error
+		if sigHashes, err = getPsbtSigHashes(psbt); err != nil {
+			return nil, err
+		}
+	}
+
+	if options == nil {
+		options = &types.SigningOptions{}
+	}
+
+	req := &types.GovSignatureRequest{
+		Id:    k.IncrementGovSignatureRequestId(ctx),
+		DkgId: dkgId,
+		Psbt:  psbt,
+	}
+
+	signingRequest := k.InitiateSigningRequest(ctx, types.GovModuleScope, fmt.Sprintf("%d", req.Id), ty, 0, pubKey, sigHashes, options)
+	req.SigningId = signingRequest.Id
+
+	k.SetGovSignatureRequest(ctx, req)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRequestSignature,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyDKGId, fmt.Sprintf("%d", dkgId)),
+			sdk.NewAttribute(types.AttributeKeySigningId, fmt.Sprintf("%d", signingRequest.Id)),
+		),
+	)
+
+	return req, nil
+}
+
+// GovSigningCompletedHandler is callback handler when the signing request initiated by the governance completed
+func (k Keeper) GovSigningCompletedHandler(ctx sdk.Context, sender string, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, signatures []string) error {
+	req, err := k.getGovSignatureRequestByScopedId(ctx, scopedId)
+	if err != nil {
+		return err
+	}
+
+	req.SigningId = id
+	req.Signatures = signatures
+
+	k.SetGovSignatureRequest(ctx, req)
+
+	return nil
+}
+
+// GovSigningTimeoutHandler is callback handler when the signing request initiated by the governance timed out
+// The signing request is re-initiated until the max signing retries reached
+func (k Keeper) GovSigningTimeoutHandler(ctx sdk.Context, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, absentParticipants []string) error {
+	req, err := k.getGovSignatureRequestByScopedId(ctx, scopedId)
+	if err != nil {
+		return err
+	}
+
+	signingRequest, err := k.ReinitiateSigningRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	req.SigningId = signingRequest.Id
+	k.SetGovSignatureRequest(ctx, req)
+
+	return nil
+}
+
+// getGovSignatureRequestByScopedId gets the gov signature request by the given scoped id
+func (k Keeper) getGovSignatureRequestByScopedId(ctx sdk.Context, scopedId string) (*types.GovSignatureRequest, error) {
+	id, err := strconv.ParseUint(scopedId, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasGovSignatureRequest(ctx, id) {
+		return nil, types.ErrGovSignatureRequestDoesNotExist
+	}
+
+	return k.GetGovSignatureRequest(ctx, id), nil
+}
+
+// GetGovSignatureRequestId gets the current gov signature request id
+func (k Keeper) GetGovSignatureRequestId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GovSignatureRequestIdKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementGovSignatureRequestId increments the gov signature request id and returns the new id
+func (k Keeper) IncrementGovSignatureRequestId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := k.GetGovSignatureRequestId(ctx) + 1
+	store.Set(types.GovSignatureRequestIdKey, sdk.Uint64ToBigEndian(id))
+
+	return id
+}
+
+// SetGovSignatureRequest sets the given gov signature request
+func (k Keeper) SetGovSignatureRequest(ctx sdk.Context, req *types.GovSignatureRequest) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(req)
+	store.Set(types.GovSignatureRequestKey(req.Id), bz)
+}
+
+// HasGovSignatureRequest returns true if the given gov signature request exists, false otherwise
+func (k Keeper) HasGovSignatureRequest(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GovSignatureRequestKey(id))
+}
+
+// GetGovSignatureRequest gets the gov signature request by the given id
+// Nil is returned if the request does not exist
+func (k Keeper) GetGovSignatureRequest(ctx sdk.Context, id uint64) *types.GovSignatureRequest {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GovSignatureRequestKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var req types.GovSignatureRequest
+	k.cdc.MustUnmarshal(bz, &req)
+
+	return &req
+}
+
+// GetGovSignatureRequestsWithPagination gets gov signature requests with pagination
+func (k Keeper) GetGovSignatureRequestsWithPagination(ctx sdk.Context, pagination *query.PageRequest) ([]*types.GovSignatureRequest, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	reqStore := prefix.NewStore(store, types.GovSignatureRequestKeyPrefix)
+
+	var requests []*types.GovSignatureRequest
+
+	pageRes, err := query.Paginate(reqStore, pagination, func(key []byte, value []byte) error {
+		var req types.GovSignatureRequest
+		k.cdc.MustUnmarshal(value, &req)
+
+		requests = append(requests, &req)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return requests, pageRes, nil
+}
+
+// getPsbtSigHashes gets the base64 encoded sig hashes of all inputs from the given psbt
+// Only taproot and segwit v0 inputs with witness utxos are supported
+func getPsbtSigHashes(psbtB64 string) ([]string, error) {
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbtB64)), true)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigningRequest, "invalid psbt")
+	}
+
+	sigHashes := []string{}
+
+	for i, input := range p.Inputs {
+		if input.WitnessUtxo == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSigningRequest, "witness utxo required for input %d", i)
+		}
+
+		sigHash, err := bitcoin.CalcSigHash(p, i, input.SighashType)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSigningRequest, "failed to calculate sig hash for input %d: %v", i, err)
+		}
+
+		sigHashes = append(sigHashes, base64.StdEncoding.EncodeToString(sigHash))
+	}
+
+	return sigHashes, nil
+}
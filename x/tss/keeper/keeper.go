@@ -14,12 +14,19 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	memKey   storetypes.StoreKey
 
-	stakingKeeper types.StakingKeeper
+	bankKeeper     types.BankKeeper
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
 
 	dkgCompletionReceivedHandlers   map[string]types.DKGCompletionReceivedHandler
 	dkgRequestCompletedHandlers     map[string]types.DKGRequestCompletedHandler
 	dkgRequestTimeoutHandlers       map[string]types.DKGRequestTimeoutHandler
 	signingRequestCompletedHandlers map[string]types.SigningRequestCompletedHandler
+	signingRequestTimeoutHandlers   map[string]types.SigningRequestTimeoutHandler
+	signingBatchCompletedHandlers   map[string]types.SigningBatchCompletedHandler
+	signingBatchTimeoutHandlers     map[string]types.SigningBatchTimeoutHandler
+	keyRotationHandlers             map[string]types.KeyRotationHandler
+	keyRetirementHandlers           map[string]types.KeyRetirementHandler
 
 	authority string
 }
@@ -28,20 +35,45 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
 	authority string,
 ) *Keeper {
-	return &Keeper{
+	k := &Keeper{
 		cdc:                             cdc,
 		storeKey:                        storeKey,
 		memKey:                          memKey,
+		bankKeeper:                      bankKeeper,
 		stakingKeeper:                   stakingKeeper,
+		slashingKeeper:                  slashingKeeper,
 		dkgCompletionReceivedHandlers:   make(map[string]types.DKGCompletionReceivedHandler),
 		dkgRequestCompletedHandlers:     make(map[string]types.DKGRequestCompletedHandler),
 		dkgRequestTimeoutHandlers:       make(map[string]types.DKGRequestTimeoutHandler),
 		signingRequestCompletedHandlers: make(map[string]types.SigningRequestCompletedHandler),
+		signingRequestTimeoutHandlers:   make(map[string]types.SigningRequestTimeoutHandler),
+		signingBatchCompletedHandlers:   make(map[string]types.SigningBatchCompletedHandler),
+		signingBatchTimeoutHandlers:     make(map[string]types.SigningBatchTimeoutHandler),
+		keyRotationHandlers:             make(map[string]types.KeyRotationHandler),
+		keyRetirementHandlers:           make(map[string]types.KeyRetirementHandler),
 		authority:                       authority,
 	}
+
+	// register handlers for the nonce generation
+	k.RegisterDKGCompletionReceivedHandler(types.ModuleName, k.NonceGenerationCompletionReceivedHandler)
+	k.RegisterDKGRequestCompletedHandler(types.ModuleName, k.NonceGenerationCompletedHandler)
+	k.RegisterDKGRequestTimeoutHandler(types.ModuleName, k.NonceGenerationTimeoutHandler)
+
+	// register handlers for the DKG requests initiated by the governance
+	k.RegisterDKGCompletionReceivedHandler(types.GovModuleScope, k.GovDKGCompletionReceivedHandler)
+	k.RegisterDKGRequestCompletedHandler(types.GovModuleScope, k.GovDKGCompletedHandler)
+	k.RegisterDKGRequestTimeoutHandler(types.GovModuleScope, k.GovDKGTimeoutHandler)
+
+	// register handlers for the signing requests initiated by the governance
+	k.RegisterSigningRequestCompletedHandler(types.GovModuleScope, k.GovSigningCompletedHandler)
+	k.RegisterSigningRequestTimeoutHandler(types.GovModuleScope, k.GovSigningTimeoutHandler)
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -83,6 +115,26 @@ func (k *Keeper) RegisterSigningRequestCompletedHandler(module string, handler t
 	k.signingRequestCompletedHandlers[module] = handler
 }
 
+func (k *Keeper) RegisterSigningRequestTimeoutHandler(module string, handler types.SigningRequestTimeoutHandler) {
+	k.signingRequestTimeoutHandlers[module] = handler
+}
+
+func (k *Keeper) RegisterSigningBatchCompletedHandler(module string, handler types.SigningBatchCompletedHandler) {
+	k.signingBatchCompletedHandlers[module] = handler
+}
+
+func (k *Keeper) RegisterSigningBatchTimeoutHandler(module string, handler types.SigningBatchTimeoutHandler) {
+	k.signingBatchTimeoutHandlers[module] = handler
+}
+
+func (k *Keeper) RegisterKeyRotationHandler(module string, handler types.KeyRotationHandler) {
+	k.keyRotationHandlers[module] = handler
+}
+
+func (k *Keeper) RegisterKeyRetirementHandler(module string, handler types.KeyRetirementHandler) {
+	k.keyRetirementHandlers[module] = handler
+}
+
 func (k Keeper) GetDKGCompletionReceivedHandler(module string) types.DKGCompletionReceivedHandler {
 	return k.dkgCompletionReceivedHandlers[module]
 }
@@ -98,3 +150,23 @@ func (k Keeper) GetDKGRequestTimeoutHandler(module string) types.DKGRequestTimeo
 func (k Keeper) GetSigningRequestCompletedHandler(module string) types.SigningRequestCompletedHandler {
 	return k.signingRequestCompletedHandlers[module]
 }
+
+func (k Keeper) GetSigningRequestTimeoutHandler(module string) types.SigningRequestTimeoutHandler {
+	return k.signingRequestTimeoutHandlers[module]
+}
+
+func (k Keeper) GetSigningBatchCompletedHandler(module string) types.SigningBatchCompletedHandler {
+	return k.signingBatchCompletedHandlers[module]
+}
+
+func (k Keeper) GetSigningBatchTimeoutHandler(module string) types.SigningBatchTimeoutHandler {
+	return k.signingBatchTimeoutHandlers[module]
+}
+
+func (k Keeper) GetKeyRotationHandler(module string) types.KeyRotationHandler {
+	return k.keyRotationHandlers[module]
+}
+
+func (k Keeper) GetKeyRetirementHandler(module string) types.KeyRetirementHandler {
+	return k.keyRetirementHandlers[module]
+}
@@ -1 +1,1085 @@
 package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	simapp "github.com/bitwaylabs/bitway/app"
+	keepertest "github.com/bitwaylabs/bitway/testutil/keeper"
+	"github.com/bitwaylabs/bitway/x/tss/keeper"
+	tss "github.com/bitwaylabs/bitway/x/tss/module"
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+func TestHandleParticipation(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	// disable penalty
+	params := k.GetParams(ctx)
+	params.ParticipantPenaltyParams.MaxFaults = 0
+	k.SetParams(ctx, params)
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	// all participants absent
+	k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_DKG, participants, participants)
+	require.False(t, k.HasParticipantReliability(ctx, participants[0]), "no fault should be recorded if all participants absent")
+
+	k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_DKG, participants, participants[2:])
+
+	reliability := k.GetParticipantReliability(ctx, participants[2])
+	require.Equal(t, uint64(1), reliability.MissedDkgs)
+	require.Equal(t, uint64(1), reliability.PendingFaults)
+	require.Equal(t, uint64(1), k.GetParticipantReliability(ctx, participants[0]).CompletedDkgs)
+
+	k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_SIGNING, participants, participants[2:])
+
+	reliability = k.GetParticipantReliability(ctx, participants[2])
+	require.Equal(t, uint64(1), reliability.MissedSignings)
+	require.Equal(t, uint64(2), reliability.PendingFaults)
+	require.Equal(t, uint64(0), reliability.PenaltyCount)
+	require.Equal(t, "0.000000000000000000", reliability.Score().String())
+
+	require.Equal(t, "1.000000000000000000", k.GetParticipantReliability(ctx, participants[0]).Score().String())
+}
+
+func TestRefreshingParticipants(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	participants := []string{}
+	for i := 0; i < 4; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	dkgRequest := &types.DKGRequest{
+		Id:           1,
+		Participants: participants[:3],
+		Threshold:    2,
+		Status:       types.DKGStatus_DKG_STATUS_COMPLETED,
+	}
+	k.SetDKGRequest(ctx, dkgRequest)
+
+	refreshingRequest := k.InitiateRefreshingRequest(ctx, dkgRequest.Id, participants[:1], participants[3:], 2, 0)
+	require.Equal(t, participants[1:], k.GetRefreshingParticipants(ctx, refreshingRequest))
+	require.True(t, k.IsResharing(ctx, refreshingRequest), "resharing expected when participants added")
+
+	refreshingRequest = k.InitiateRefreshingRequest(ctx, dkgRequest.Id, participants[:1], []string{}, 2, 0)
+	require.Equal(t, participants[1:3], k.GetRefreshingParticipants(ctx, refreshingRequest))
+	require.False(t, k.IsResharing(ctx, refreshingRequest), "resharing not expected when participants removed only")
+
+	refreshingRequest = k.InitiateRefreshingRequest(ctx, dkgRequest.Id, []string{}, []string{}, 3, 0)
+	require.True(t, k.IsResharing(ctx, refreshingRequest), "resharing expected when threshold changed")
+}
+
+func TestDKGPossessionProof(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	consPrivKey := ed25519.GenPrivKey()
+	participant := base64.StdEncoding.EncodeToString(consPrivKey.PubKey().Bytes())
+	participants := []string{participant, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes())}
+
+	for _, keyType := range []types.KeyType{types.KeyType_KEY_TYPE_SCHNORR, types.KeyType_KEY_TYPE_ECDSA} {
+		dkgRequest := k.InitiateDKGWithKeyType(ctx, "test", "signing", 0, participants, 2, 1, time.Hour, keyType)
+
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		pubKey := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+		if keyType == types.KeyType_KEY_TYPE_SCHNORR {
+			pubKey = hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+		}
+
+		sig, err := consPrivKey.Sign(types.GetDKGCompletionSigMsg(dkgRequest.Id, []string{pubKey}, nil))
+		require.NoError(t, err)
+
+		// the proof signed by an unrelated key is rejected
+		otherPrivKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		invalidProof, err := schnorr.Sign(otherPrivKey, types.GetPossessionProofSigMsg(dkgRequest.Id, pubKey))
+		require.NoError(t, err)
+
+		err = k.CompleteDKG(ctx, "", dkgRequest.Id, []string{pubKey}, participant, hex.EncodeToString(sig), []string{hex.EncodeToString(invalidProof.Serialize())}, nil)
+		require.ErrorIs(t, err, types.ErrInvalidPossessionProof)
+
+		// the proof over a different dkg id is rejected
+		replayedProof, err := schnorr.Sign(privKey, types.GetPossessionProofSigMsg(dkgRequest.Id+1, pubKey))
+		require.NoError(t, err)
+
+		err = k.CompleteDKG(ctx, "", dkgRequest.Id, []string{pubKey}, participant, hex.EncodeToString(sig), []string{hex.EncodeToString(replayedProof.Serialize())}, nil)
+		require.ErrorIs(t, err, types.ErrInvalidPossessionProof)
+
+		err = k.CompleteDKG(ctx, "", dkgRequest.Id, []string{pubKey}, participant, hex.EncodeToString(sig), []string{}, nil)
+		require.ErrorIs(t, err, types.ErrInvalidPossessionProof)
+
+		proof, err := schnorr.Sign(privKey, types.GetPossessionProofSigMsg(dkgRequest.Id, pubKey))
+		require.NoError(t, err)
+
+		err = k.CompleteDKG(ctx, "", dkgRequest.Id, []string{pubKey}, participant, hex.EncodeToString(sig), []string{hex.EncodeToString(proof.Serialize())}, nil)
+		require.NoError(t, err)
+		require.True(t, k.HasDKGCompletion(ctx, dkgRequest.Id, participant))
+	}
+}
+
+func TestNonceQueue(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.NonceQueueParams.QueueSize = 2
+	params.NonceQueueParams.GenerationBatchSize = 3
+	params.NonceQueueParams.GenerationInterval = 1
+	params.NonceQueueParams.Dkgs = []types.ManagedDKG{{Module: "test", Type: "signing"}}
+	k.SetParams(ctx, params)
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKey := randomSchnorrPubKey(t)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	k.HandleNonceQueues(ctx)
+
+	generationId := k.GetNonceGeneration(ctx, dkgRequest.Id)
+	require.NotZero(t, generationId, "nonce generation should be initiated")
+	require.True(t, k.HasPendingNonceGeneration(ctx, dkgRequest.Id))
+	require.Equal(t, participants, k.GetDKGRequest(ctx, generationId).Participants)
+
+	nonces := []string{randomSchnorrPubKey(t), randomSchnorrPubKey(t), randomSchnorrPubKey(t)}
+	require.NoError(t, k.NonceGenerationCompletedHandler(ctx, generationId, types.DKGTypeNonce, 0, nonces))
+	require.Equal(t, uint64(3), k.GetCommittedNonceCount(ctx, dkgRequest.Id))
+	require.Zero(t, k.GetNonceGeneration(ctx, dkgRequest.Id))
+
+	// the queue is full
+	k.HandleNonceQueues(ctx)
+	require.Zero(t, k.GetNonceGeneration(ctx, dkgRequest.Id), "no nonce generation expected when the queue is full")
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(make([]byte, 32)), base64.StdEncoding.EncodeToString(make([]byte, 32))}
+
+	req := k.InitiateSigningRequestWithCommittedNonces(ctx, "test", "1", 0, pubKey, sigHashes)
+	require.Equal(t, types.SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT, req.Type)
+	require.Equal(t, nonces[:2], req.Options.Nonces)
+	require.Equal(t, uint64(1), k.GetCommittedNonceCount(ctx, dkgRequest.Id))
+
+	// fall back to the common schnorr signing due to insufficient nonces
+	req = k.InitiateSigningRequestWithCommittedNonces(ctx, "test", "2", 0, pubKey, sigHashes)
+	require.Equal(t, types.SigningType_SIGNING_TYPE_SCHNORR, req.Type)
+	require.Equal(t, uint64(1), k.GetCommittedNonceCount(ctx, dkgRequest.Id))
+
+	// the consumed nonces can not be committed again
+	k.HandleNonceQueues(ctx)
+	generationId = k.GetNonceGeneration(ctx, dkgRequest.Id)
+	require.ErrorIs(t, k.NonceGenerationCompletedHandler(ctx, generationId, types.DKGTypeNonce, 0, nonces[:1]), types.ErrInvalidNonce)
+
+	k.ResetNonceQueue(ctx, dkgRequest.Id)
+	require.Zero(t, k.GetCommittedNonceCount(ctx, dkgRequest.Id))
+}
+
+func TestPartialSignatures(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	k.RegisterDKGRequestCompletedHandler("test", func(ctx sdk.Context, id uint64, ty string, intent int32, pubKeys []string) error {
+		return nil
+	})
+
+	completedSignatures := []string{}
+	k.RegisterSigningRequestCompletedHandler("test", func(ctx sdk.Context, sender string, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, signatures []string) error {
+		completedSignatures = signatures
+		return nil
+	})
+
+	consPrivKeys := []*ed25519.PrivKey{}
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		consPrivKey := ed25519.GenPrivKey()
+		consPrivKeys = append(consPrivKeys, consPrivKey)
+		participants = append(participants, base64.StdEncoding.EncodeToString(consPrivKey.PubKey().Bytes()))
+	}
+
+	// shamir shares of the secret key with the threshold 2, i.e. f(x) = a_0 + a_1 * x
+	secret, coefficient := randomScalar(t), randomScalar(t)
+
+	shares := []*btcec.ModNScalar{}
+	verificationShares := []string{}
+	for i := 1; i <= len(participants); i++ {
+		var index btcec.ModNScalar
+		index.SetInt(uint32(i))
+
+		share := new(btcec.ModNScalar).Mul2(coefficient, &index).Add(secret)
+		shares = append(shares, share)
+		verificationShares = append(verificationShares, hex.EncodeToString(btcec.PrivKeyFromScalar(share).PubKey().SerializeCompressed()))
+	}
+
+	privKey := btcec.PrivKeyFromScalar(secret)
+	pubKey := schnorr.SerializePubKey(privKey.PubKey())
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+
+	proof, err := schnorr.Sign(privKey, types.GetPossessionProofSigMsg(dkgRequest.Id, hex.EncodeToString(pubKey)))
+	require.NoError(t, err)
+
+	completeDKG := func(i int, verificationShares []string) error {
+		signature, err := consPrivKeys[i].Sign(types.GetDKGCompletionSigMsg(dkgRequest.Id, []string{hex.EncodeToString(pubKey)}, verificationShares))
+		require.NoError(t, err)
+
+		return k.CompleteDKG(ctx, "", dkgRequest.Id, []string{hex.EncodeToString(pubKey)}, participants[i], hex.EncodeToString(signature), []string{hex.EncodeToString(proof.Serialize())}, verificationShares)
+	}
+
+	// the verification shares which do not lie on the same polynomial are rejected
+	require.ErrorIs(t, completeDKG(0, []string{verificationShares[0], verificationShares[1], verificationShares[0]}), types.ErrInvalidVerificationShares)
+	require.ErrorIs(t, completeDKG(0, verificationShares[:2]), types.ErrInvalidVerificationShares)
+
+	for i := range participants {
+		require.NoError(t, completeDKG(i, verificationShares))
+	}
+
+	require.NoError(t, tss.EndBlocker(ctx, k))
+	require.Equal(t, types.DKGStatus_DKG_STATUS_COMPLETED, k.GetDKGRequest(ctx, dkgRequest.Id).Status)
+	require.Equal(t, verificationShares, k.GetVerificationShares(ctx, dkgRequest.Id).Shares)
+	require.Equal(t, dkgRequest.Id, k.GetDKGRequestByPubKey(ctx, hex.EncodeToString(pubKey)).Id)
+
+	sigHash := chainhash.HashB([]byte("partial signatures"))
+	req := k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, hex.EncodeToString(pubKey), []string{base64.StdEncoding.EncodeToString(sigHash)}, nil)
+
+	// the first two participants sign in the session
+	signers := participants[:2]
+	indexes := []uint32{1, 2}
+
+	nonces := []*btcec.ModNScalar{randomScalar(t), randomScalar(t)}
+
+	var aggregatedNoncePoint btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(new(btcec.ModNScalar).Add2(nonces[0], nonces[1]), &aggregatedNoncePoint)
+	aggregatedNoncePoint.ToAffine()
+
+	aggregatedNonce := btcec.NewPublicKey(&aggregatedNoncePoint.X, &aggregatedNoncePoint.Y)
+
+	challengeHash := chainhash.TaggedHash(chainhash.TagBIP0340Challenge, schnorr.SerializePubKey(aggregatedNonce), pubKey, sigHash)
+
+	var challenge btcec.ModNScalar
+	challenge.SetByteSlice(challengeHash[:])
+
+	// z_i = s_R * k_i + e * λ_i * s_P * x_i
+	partialSigs := []*btcec.ModNScalar{}
+	for i := range signers {
+		nonce := new(btcec.ModNScalar).Set(nonces[i])
+		if aggregatedNoncePoint.Y.IsOdd() {
+			nonce.Negate()
+		}
+
+		factor := new(btcec.ModNScalar).Mul2(&challenge, types.ComputeLagrangeCoefficient(indexes[i], indexes, 0))
+		if privKey.PubKey().Y().Bit(0) == 1 {
+			factor.Negate()
+		}
+
+		partialSigs = append(partialSigs, factor.Mul(shares[i]).Add(nonce))
+	}
+
+	submit := func(i int, signers []string, nonce *btcec.ModNScalar, partialSig *btcec.ModNScalar) error {
+		aggregatedNoncesHex := []string{hex.EncodeToString(aggregatedNonce.SerializeCompressed())}
+		noncesHex := []string{hex.EncodeToString(btcec.PrivKeyFromScalar(nonce).PubKey().SerializeCompressed())}
+
+		partialSigBytes := partialSig.Bytes()
+		partialSigsHex := []string{hex.EncodeToString(partialSigBytes[:])}
+
+		signature, err := consPrivKeys[i].Sign(types.GetPartialSignatureSigMsg(req.Id, signers, aggregatedNoncesHex, noncesHex, partialSigsHex))
+		require.NoError(t, err)
+
+		return k.SubmitPartialSignatures(ctx, "", req.Id, participants[i], signers, aggregatedNoncesHex, noncesHex, partialSigsHex, hex.EncodeToString(signature))
+	}
+
+	require.ErrorIs(t, submit(0, participants[:1], nonces[0], partialSigs[0]), types.ErrInvalidSignatures)
+	require.ErrorIs(t, submit(2, signers, nonces[0], partialSigs[0]), types.ErrUnauthorizedParticipant)
+
+	require.NoError(t, submit(0, signers, nonces[0], partialSigs[0]))
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_PENDING, k.GetSigningRequest(ctx, req.Id).Status)
+	require.ErrorIs(t, submit(0, signers, nonces[0], partialSigs[0]), types.ErrPartialSignatureAlreadyExists)
+
+	// the nonce can not be reused in another session
+	require.ErrorIs(t, submit(0, []string{participants[0], participants[2]}, nonces[0], partialSigs[0]), types.ErrInvalidNonce)
+
+	// the invalid partial signature is rejected and attributed to the submitter
+	err = submit(1, signers, nonces[1], new(btcec.ModNScalar).Add2(partialSigs[1], new(btcec.ModNScalar).SetInt(1)))
+	require.ErrorIs(t, err, types.ErrInvalidPartialSignature)
+	require.ErrorContains(t, err, participants[1])
+	require.Len(t, k.GetPartialSignatures(ctx, req.Id), 1)
+
+	require.NoError(t, submit(1, signers, nonces[1], partialSigs[1]))
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, k.GetSigningRequest(ctx, req.Id).Status)
+	require.Len(t, completedSignatures, 1)
+
+	signature, err := hex.DecodeString(completedSignatures[0])
+	require.NoError(t, err)
+	parsedSignature, err := schnorr.ParseSignature(signature)
+	require.NoError(t, err)
+	parsedPubKey, err := schnorr.ParsePubKey(pubKey)
+	require.NoError(t, err)
+	require.True(t, parsedSignature.Verify(sigHash, parsedPubKey))
+
+	signerSet := k.GetSignerSet(ctx, req.Id)
+	require.True(t, signerSet.Aggregated)
+	require.ElementsMatch(t, signers, signerSet.Signers)
+	require.Equal(t, participants[2:], k.GetAbsentSigningParticipants(ctx, req))
+}
+
+func TestSigningBatch(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	completions := 0
+	var completedSignatures []*types.BatchSignatures
+
+	k.RegisterSigningBatchCompletedHandler("test", func(ctx sdk.Context, sender string, id uint64, scopedId string, intent int32, signatures []*types.BatchSignatures) error {
+		completions++
+		completedSignatures = signatures
+
+		return nil
+	})
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	items := []*types.SigningBatchItem{}
+	for _, msg := range []string{"liquidation cet", "default liquidation cet", "repayment cet"} {
+		items = append(items, &types.SigningBatchItem{
+			Type:      types.SigningType_SIGNING_TYPE_SCHNORR,
+			PubKey:    pubKey,
+			SigHashes: []string{base64.StdEncoding.EncodeToString(chainhash.HashB([]byte(msg)))},
+		})
+	}
+
+	// the item scoped id overrides the batch scoped id
+	items[0].ScopedId = "10"
+
+	batch := k.InitiateSigningBatch(ctx, "test", "1", 0, items)
+	require.Len(t, batch.SigningIds, 3)
+	require.Equal(t, "10", k.GetSigningRequest(ctx, batch.SigningIds[0]).ScopedId)
+	require.Equal(t, "1", k.GetSigningRequest(ctx, batch.SigningIds[1]).ScopedId)
+
+	batchSignatures := []*types.BatchSignatures{}
+	for i := len(batch.SigningIds) - 1; i >= 0; i-- {
+		req := k.GetSigningRequest(ctx, batch.SigningIds[i])
+		require.Equal(t, batch.Id, req.BatchId)
+		require.Equal(t, batch.ExpirationTime, req.ExpirationTime)
+
+		sigHash, err := base64.StdEncoding.DecodeString(req.SigHashes[0])
+		require.NoError(t, err)
+
+		signature, err := schnorr.Sign(privKey, sigHash)
+		require.NoError(t, err)
+
+		batchSignatures = append(batchSignatures, &types.BatchSignatures{SigningId: req.Id, Signatures: []string{hex.EncodeToString(signature.Serialize())}})
+	}
+
+	// all pending signing requests must be covered
+	err = k.SubmitBatchSignatures(ctx, "", batch.Id, batchSignatures[1:])
+	require.ErrorIs(t, err, types.ErrInvalidSignatures)
+
+	// the signatures are verified before any signing request is completed
+	invalidSignatures := []*types.BatchSignatures{batchSignatures[0], batchSignatures[1], {SigningId: batchSignatures[2].SigningId, Signatures: batchSignatures[0].Signatures}}
+	err = k.SubmitBatchSignatures(ctx, "", batch.Id, invalidSignatures)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_PENDING, k.GetSigningRequest(ctx, batchSignatures[0].SigningId).Status)
+
+	require.NoError(t, k.SubmitBatchSignatures(ctx, "", batch.Id, batchSignatures))
+	require.Equal(t, 1, completions)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, k.GetSigningBatch(ctx, batch.Id).Status)
+
+	// the signatures are ordered by the signing requests of the batch
+	for i, signatures := range completedSignatures {
+		require.Equal(t, batch.SigningIds[i], signatures.SigningId)
+	}
+
+	// the timed out batch is re-initiated by the module handler
+	var newBatch *types.SigningBatch
+	k.RegisterSigningBatchTimeoutHandler("test", func(ctx sdk.Context, id uint64, scopedId string, intent int32, absentParticipants []string) error {
+		newBatch, err = k.ReinitiateSigningBatch(ctx, id)
+		return err
+	})
+
+	batch = k.InitiateSigningBatch(ctx, "test", "2", 0, items[:2])
+
+	// the signed requests are inherited on re-initiation
+	req := k.GetSigningRequest(ctx, batch.SigningIds[0])
+	require.NoError(t, k.CompleteSigningRequest(ctx, "", req, batchSignatures[2].Signatures, nil, false))
+
+	ctx = ctx.WithBlockTime(batch.ExpirationTime)
+	require.NoError(t, tss.EndBlocker(ctx, k))
+
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_TIMEDOUT, k.GetSigningBatch(ctx, batch.Id).Status)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, k.GetSigningRequest(ctx, batch.SigningIds[0]).Status)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_TIMEDOUT, k.GetSigningRequest(ctx, batch.SigningIds[1]).Status)
+
+	require.NotNil(t, newBatch)
+	require.Equal(t, batch.Id, newBatch.PreviousId)
+	require.Equal(t, batch.SigningIds[0], newBatch.SigningIds[0])
+	require.NotEqual(t, batch.SigningIds[1], newBatch.SigningIds[1])
+	require.Equal(t, batch.SigningIds[1], k.GetSigningRequest(ctx, newBatch.SigningIds[1]).PreviousId)
+	require.Len(t, newBatch.Signatures, 1)
+	require.Len(t, k.GetSigningBatchesByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING), 1)
+}
+
+func TestReinitiateSigningRequest(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxSigningRetries = 2
+	k.SetParams(ctx, params)
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKey := randomSchnorrPubKey(t)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	require.Nil(t, k.GetDKGRequestByPubKey(ctx, randomSchnorrPubKey(t)))
+	require.Equal(t, participants, k.GetDKGRequestByPubKey(ctx, pubKey).Participants)
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(chainhash.HashB([]byte("reinitiation")))}
+	req := k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, sigHashes, nil)
+
+	_, err := k.ReinitiateSigningRequest(ctx, req.Id)
+	require.ErrorIs(t, err, types.ErrInvalidSigningStatus, "pending signing request should not be re-initiated")
+
+	for i := 1; i <= int(params.MaxSigningRetries); i++ {
+		ctx = ctx.WithBlockTime(req.ExpirationTime)
+		require.NoError(t, tss.EndBlocker(ctx, k))
+		require.Equal(t, types.SigningStatus_SIGNING_STATUS_TIMEDOUT, k.GetSigningRequest(ctx, req.Id).Status)
+
+		newReq, err := k.ReinitiateSigningRequest(ctx, req.Id)
+		require.NoError(t, err)
+		require.Equal(t, req.Id, newReq.PreviousId)
+		require.Equal(t, uint32(i), newReq.Retries)
+		require.Equal(t, sigHashes, newReq.SigHashes)
+		require.Equal(t, types.SigningStatus_SIGNING_STATUS_PENDING, newReq.Status)
+		require.Equal(t, ctx.BlockTime().Add(params.SigningTimeoutDuration), newReq.ExpirationTime)
+
+		req = newReq
+	}
+
+	// max signing retries reached
+	ctx = ctx.WithBlockTime(req.ExpirationTime)
+	require.NoError(t, tss.EndBlocker(ctx, k))
+
+	_, err = k.ReinitiateSigningRequest(ctx, req.Id)
+	require.ErrorIs(t, err, types.ErrMaxSigningRetriesExceeded)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_TIMEDOUT, k.GetSigningRequest(ctx, req.Id).Status)
+}
+
+func TestSigningFee(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	k.RegisterSigningRequestCompletedHandler("test", func(ctx sdk.Context, sender string, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, signatures []string) error {
+		return nil
+	})
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKey := randomSchnorrPubKey(t)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(make([]byte, 32))}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ubtw", 100), sdk.NewInt64Coin("sat", 1))
+
+	req := k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, sigHashes, nil)
+	k.SetSigningFee(ctx, &types.SigningFee{Id: req.Id, Fee: fee})
+
+	// the fee is inherited by the re-initiated signing request
+	req.Status = types.SigningStatus_SIGNING_STATUS_TIMEDOUT
+	k.SetSigningRequest(ctx, req)
+
+	newReq, err := k.ReinitiateSigningRequest(ctx, req.Id)
+	require.NoError(t, err)
+	require.False(t, k.HasSigningFee(ctx, req.Id))
+	require.Equal(t, fee, k.GetSigningFee(ctx, newReq.Id).Fee)
+
+	// distributed to the signers equally and the remainder is allocated to the first signer
+	require.NoError(t, k.CompleteSigningRequest(ctx, "", newReq, []string{}, participants[:2], false))
+	require.False(t, k.HasSigningFee(ctx, newReq.Id))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubtw", 50), sdk.NewInt64Coin("sat", 1)), k.GetParticipantRewards(ctx, participants[0]).Rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubtw", 50)), k.GetParticipantRewards(ctx, participants[1]).Rewards)
+	require.True(t, k.GetParticipantRewards(ctx, participants[2]).Rewards.IsZero())
+
+	// distributed to the DKG participants if no signers
+	req = k.InitiateSigningRequest(ctx, "test", "2", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, sigHashes, nil)
+	k.SetSigningFee(ctx, &types.SigningFee{Id: req.Id, Fee: sdk.NewCoins(sdk.NewInt64Coin("ubtw", 30))})
+
+	require.NoError(t, k.CompleteSigningRequest(ctx, "", req, []string{}, []string{}, false))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubtw", 10)), k.GetParticipantRewards(ctx, participants[2]).Rewards)
+}
+
+func TestModuleSigningFee(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	k := *app.TSSKeeper
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ubtw", 100))
+	payer := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := k.GetParams(ctx)
+	params.MaxSigningRetries = 1
+	params.ModuleSigningFees = []types.ModuleSigningFee{{Module: "test", PayerModule: govtypes.ModuleName, Fee: fee}}
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+
+	k.RegisterSigningRequestTimeoutHandler("test", func(ctx sdk.Context, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, absentParticipants []string) error {
+		_, err := k.ReinitiateSigningRequest(ctx, id)
+		return err
+	})
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKey := randomSchnorrPubKey(t)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(make([]byte, 32))}
+
+	// no fee attached if the payer can not afford it
+	req := k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, sigHashes, nil)
+	require.False(t, k.HasSigningFee(ctx, req.Id))
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, payer, fee))
+
+	req = k.InitiateSigningRequest(ctx, "test", "2", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, sigHashes, nil)
+	require.Equal(t, payer.String(), k.GetSigningFee(ctx, req.Id).Payer)
+	require.Equal(t, fee, k.GetSigningFee(ctx, req.Id).Fee)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, payer).IsZero())
+
+	// the fee is inherited by the re-initiated signing request
+	ctx = ctx.WithBlockTime(req.ExpirationTime)
+	require.NoError(t, tss.EndBlocker(ctx, k))
+
+	var newReq *types.SigningRequest
+	for _, pendingReq := range k.GetSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING) {
+		if pendingReq.PreviousId == req.Id {
+			newReq = pendingReq
+		}
+	}
+
+	require.NotNil(t, newReq)
+	require.False(t, k.HasSigningFee(ctx, req.Id))
+	require.Equal(t, fee, k.GetSigningFee(ctx, newReq.Id).Fee)
+
+	// the fee is refunded once the max signing retries reached
+	ctx = ctx.WithBlockTime(newReq.ExpirationTime)
+	require.NoError(t, tss.EndBlocker(ctx, k))
+
+	require.Empty(t, k.GetSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING))
+	require.False(t, k.HasSigningFee(ctx, newReq.Id))
+	require.Equal(t, fee, app.BankKeeper.GetAllBalances(ctx, payer))
+}
+
+func TestGovSignatureRequest(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxSigningRetries = 1
+	k.SetParams(ctx, params)
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKey := randomSchnorrPubKey(t)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+
+	sigHashes := []string{base64.StdEncoding.EncodeToString(make([]byte, 32))}
+
+	_, err := k.RequestSignature(ctx, dkgRequest.Id, "", types.SigningType_SIGNING_TYPE_SCHNORR, sigHashes, "", nil)
+	require.ErrorIs(t, err, types.ErrInvalidDKGStatus)
+
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	_, err = k.RequestSignature(ctx, dkgRequest.Id, "", types.SigningType_SIGNING_TYPE_ECDSA, sigHashes, "", nil)
+	require.ErrorIs(t, err, types.ErrInvalidSigningRequest, "signing type should match the key type")
+
+	_, err = k.RequestSignature(ctx, dkgRequest.Id, randomSchnorrPubKey(t), types.SigningType_SIGNING_TYPE_SCHNORR, sigHashes, "", nil)
+	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+
+	req, err := k.RequestSignature(ctx, dkgRequest.Id, "", types.SigningType_SIGNING_TYPE_SCHNORR, sigHashes, "", nil)
+	require.NoError(t, err)
+
+	signingRequest := k.GetSigningRequest(ctx, req.SigningId)
+	require.Equal(t, types.GovModuleScope, signingRequest.Module)
+	require.Equal(t, "1", signingRequest.ScopedId)
+	require.Equal(t, pubKey, signingRequest.PubKey)
+
+	// re-initiated on timeout
+	signingRequest.Status = types.SigningStatus_SIGNING_STATUS_TIMEDOUT
+	k.SetSigningRequest(ctx, signingRequest)
+
+	require.NoError(t, k.GetSigningRequestTimeoutHandler(types.GovModuleScope)(ctx, signingRequest.Id, signingRequest.ScopedId, signingRequest.Type, 0, pubKey, []string{}))
+
+	req = k.GetGovSignatureRequest(ctx, req.Id)
+	require.NotEqual(t, signingRequest.Id, req.SigningId)
+
+	// not re-initiated once the max signing retries reached
+	retriedRequest := k.GetSigningRequest(ctx, req.SigningId)
+	retriedRequest.Status = types.SigningStatus_SIGNING_STATUS_TIMEDOUT
+	k.SetSigningRequest(ctx, retriedRequest)
+
+	err = k.GetSigningRequestTimeoutHandler(types.GovModuleScope)(ctx, retriedRequest.Id, retriedRequest.ScopedId, retriedRequest.Type, 0, pubKey, []string{})
+	require.ErrorIs(t, err, types.ErrMaxSigningRetriesExceeded)
+	require.Equal(t, retriedRequest.Id, k.GetGovSignatureRequest(ctx, req.Id).SigningId)
+
+	retriedRequest.Status = types.SigningStatus_SIGNING_STATUS_PENDING
+	k.SetSigningRequest(ctx, retriedRequest)
+
+	// the signatures are recorded on completion
+	signatures := []string{hex.EncodeToString(make([]byte, 64))}
+	require.NoError(t, k.CompleteSigningRequest(ctx, "", k.GetSigningRequest(ctx, req.SigningId), signatures, participants[:2], false))
+	require.Equal(t, signatures, k.GetGovSignatureRequest(ctx, req.Id).Signatures)
+}
+
+func TestGovDKG(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	consPrivKeys := []ed25519.PrivKey{}
+	participants := []string{}
+	for i := 0; i < 2; i++ {
+		consPrivKey := ed25519.GenPrivKey()
+		consPrivKeys = append(consPrivKeys, *consPrivKey)
+		participants = append(participants, base64.StdEncoding.EncodeToString(consPrivKey.PubKey().Bytes()))
+	}
+
+	_, err := msgServer.InitiateDKG(ctx, &types.MsgInitiateDKG{Authority: participants[0], Participants: participants, Threshold: 2, BatchSize: 1, KeyType: types.KeyType_KEY_TYPE_ECDSA})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.InitiateDKG(ctx, &types.MsgInitiateDKG{Authority: authority, Participants: participants, Threshold: 2, KeyType: types.KeyType_KEY_TYPE_ECDSA})
+	require.ErrorIs(t, err, types.ErrInvalidDKGs, "batch size should be required")
+
+	res, err := msgServer.InitiateDKG(ctx, &types.MsgInitiateDKG{Authority: authority, Participants: participants, Threshold: 2, BatchSize: 1, KeyType: types.KeyType_KEY_TYPE_ECDSA})
+	require.NoError(t, err)
+
+	dkgRequest := k.GetDKGRequest(ctx, res.Id)
+	require.Equal(t, types.GovModuleScope, dkgRequest.Module)
+	require.Equal(t, types.KeyType_KEY_TYPE_ECDSA, dkgRequest.KeyType)
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+
+	proof, err := schnorr.Sign(privKey, types.GetPossessionProofSigMsg(dkgRequest.Id, pubKey))
+	require.NoError(t, err)
+
+	for i, participant := range participants {
+		sig, err := consPrivKeys[i].Sign(types.GetDKGCompletionSigMsg(dkgRequest.Id, []string{pubKey}, nil))
+		require.NoError(t, err)
+
+		msg := types.NewMsgCompleteDKG(authority, dkgRequest.Id, []string{pubKey}, participant, hex.EncodeToString(sig), []string{hex.EncodeToString(proof.Serialize())}, nil)
+
+		_, err = msgServer.CompleteDKG(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	tss.EndBlocker(ctx, k)
+	require.Equal(t, types.DKGStatus_DKG_STATUS_COMPLETED, k.GetDKGRequest(ctx, dkgRequest.Id).Status)
+
+	// the generated key is usable by the governance signing requests
+	sigHashes := []string{base64.StdEncoding.EncodeToString(make([]byte, 32))}
+
+	_, err = k.RequestSignature(ctx, dkgRequest.Id, "", types.SigningType_SIGNING_TYPE_SCHNORR, sigHashes, "", nil)
+	require.ErrorIs(t, err, types.ErrInvalidSigningRequest)
+
+	req, err := k.RequestSignature(ctx, dkgRequest.Id, "", types.SigningType_SIGNING_TYPE_ECDSA, sigHashes, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, k.GetSigningRequest(ctx, req.SigningId).PubKey)
+}
+
+func TestKeyRotation(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+
+	rotations, retirements := 0, 0
+
+	k.RegisterKeyRotationHandler("test", func(ctx sdk.Context, ty string) error {
+		rotations++
+		return nil
+	})
+
+	k.RegisterKeyRetirementHandler("test", func(ctx sdk.Context, ty string) error {
+		retirements++
+		return nil
+	})
+
+	params := k.GetParams(ctx)
+	params.KeyRotationPolicies = []types.KeyRotationPolicy{
+		{Module: "test", Type: "redkg", Method: types.KeyRotationMethod_KEY_ROTATION_METHOD_REDKG, MaxAge: time.Hour, OverlapWindow: 10 * time.Minute},
+		{Module: "test", Type: "refresh", Method: types.KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH, MaxAge: time.Hour},
+	}
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "refresh", 0, participants, 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+
+	startTime := ctx.BlockTime()
+
+	// start tracking the key age
+	k.HandleKeyRotations(ctx)
+	require.Len(t, k.GetKeyRotations(ctx, "test"), 2)
+
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	k.HandleKeyRotations(ctx)
+	require.Equal(t, 0, rotations)
+	require.Equal(t, types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE, k.GetKeyRotation(ctx, "test", "refresh").Status)
+
+	// the maximum age reached
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	k.HandleKeyRotations(ctx)
+	k.HandleKeyRotations(ctx)
+	require.Equal(t, 1, rotations)
+	require.True(t, k.IsKeyRotating(ctx, "test", "redkg"))
+
+	refreshRotation := k.GetKeyRotation(ctx, "test", "refresh")
+	require.Equal(t, types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING, refreshRotation.Status)
+	require.Len(t, refreshRotation.RefreshingIds, 1)
+	require.Equal(t, dkgRequest.Id, k.GetRefreshingRequest(ctx, refreshRotation.RefreshingIds[0]).DkgId)
+
+	// the new keys are ready
+	require.NoError(t, k.CompleteKeyRotation(ctx, "test", "redkg"))
+	require.Error(t, k.CompleteKeyRotation(ctx, "test", "redkg"))
+	require.Equal(t, startTime.Add(70*time.Minute), k.GetKeyRotation(ctx, "test", "redkg").RetirementTime)
+
+	refreshingReq := k.GetRefreshingRequest(ctx, refreshRotation.RefreshingIds[0])
+	refreshingReq.Status = types.RefreshingStatus_REFRESHING_STATUS_COMPLETED
+	k.SetRefreshingRequest(ctx, refreshingReq)
+
+	ctx = ctx.WithBlockTime(startTime.Add(65 * time.Minute))
+	k.HandleKeyRotations(ctx)
+	require.Equal(t, 0, retirements)
+	require.Equal(t, types.KeyRotationStatus_KEY_ROTATION_STATUS_OVERLAPPING, k.GetKeyRotation(ctx, "test", "redkg").Status)
+
+	refreshRotation = k.GetKeyRotation(ctx, "test", "refresh")
+	require.Equal(t, types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE, refreshRotation.Status)
+	require.Equal(t, startTime.Add(65*time.Minute), refreshRotation.LastRotationTime)
+
+	// the old keys are retired after the overlap window
+	ctx = ctx.WithBlockTime(startTime.Add(70 * time.Minute))
+	k.HandleKeyRotations(ctx)
+	require.Equal(t, 1, retirements)
+	require.Equal(t, types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE, k.GetKeyRotation(ctx, "test", "redkg").Status)
+	require.Error(t, k.AbortKeyRotation(ctx, "test", "redkg"))
+}
+
+func randomScalar(t *testing.T) *btcec.ModNScalar {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return &privKey.Key
+}
+
+func randomSchnorrPubKey(t *testing.T) string {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+}
+
+func TestParticipantHealth(t *testing.T) {
+	k, ctx := keepertest.TSSKeeper(t)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	k.RegisterSigningRequestCompletedHandler("test", func(ctx sdk.Context, sender string, id uint64, scopedId string, ty types.SigningType, intent int32, pubKey string, signatures []string) error {
+		return nil
+	})
+
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants[:2], 2, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: []string{pubKey}, ConsensusPubkey: participants[0]})
+	k.SetDKGRequestByPubKeys(ctx, dkgRequest.Id, []string{pubKey})
+
+	sigHash := chainhash.HashB([]byte("sig hash"))
+
+	req := k.InitiateSigningRequest(ctx, "test", "1", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, []string{base64.StdEncoding.EncodeToString(sigHash)}, nil)
+	k.InitiateSigningRequest(ctx, "test", "2", types.SigningType_SIGNING_TYPE_SCHNORR, 0, pubKey, []string{base64.StdEncoding.EncodeToString(sigHash)}, nil)
+
+	require.Equal(t, []uint64{1, 2}, k.GetParticipantPendingSigningIds(ctx, participants[0]))
+	require.Empty(t, k.GetParticipantPendingSigningIds(ctx, participants[2]))
+
+	keys := k.GetParticipantKeys(ctx, participants[1])
+	require.Len(t, keys, 1)
+	require.Equal(t, []string{pubKey}, keys[0].PubKeys)
+	require.Empty(t, k.GetParticipantKeys(ctx, participants[2]))
+
+	signature, err := schnorr.Sign(privKey, sigHash)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	require.NoError(t, k.CompleteSigningRequest(ctx, "", req, []string{hex.EncodeToString(signature.Serialize())}, participants[:1], true))
+
+	reliability := k.GetParticipantReliability(ctx, participants[0])
+	require.Equal(t, uint64(1), reliability.MeasuredSignings)
+	require.Equal(t, 10*time.Second, reliability.AverageSigningLatency())
+	require.Zero(t, k.GetParticipantReliability(ctx, participants[1]).MeasuredSignings)
+
+	stats := k.GetSigningStats(ctx, "test")
+	require.Len(t, stats, 1)
+	require.Equal(t, uint64(2), stats[0].Requested)
+	require.Equal(t, uint64(1), stats[0].Signed)
+	require.Equal(t, 10*time.Second, stats[0].AverageLatency())
+	require.Empty(t, k.GetSigningStats(ctx, "tes"))
+}
+
+func TestParticipantSet(t *testing.T) {
+	validators := []*cmttypes.Validator{}
+	for i := 0; i < 5; i++ {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+
+		validators = append(validators, cmttypes.NewValidator(pubKey, 1))
+	}
+
+	delegatorPrivKey := secp256k1.GenPrivKey()
+	delegator := authtypes.NewBaseAccount(delegatorPrivKey.PubKey().Address().Bytes(), delegatorPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: delegator.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+
+	app := simapp.SetupWithGenesisValSet(t, cmttypes.NewValidatorSet(validators), []authtypes.GenesisAccount{delegator}, "testing", balance)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+	k := *app.TSSKeeper
+
+	// the hooks are no-op if the participant set is not maintained automatically
+	require.NoError(t, k.Hooks().AfterValidatorBonded(ctx, nil, nil))
+	require.True(t, k.GetParticipantSetUpdateTime(ctx).IsZero())
+
+	params := k.GetParams(ctx)
+	params.ParticipantSetParams = types.ParticipantSetParams{
+		Enabled:         true,
+		MaxParticipants: 4,
+		DriftThreshold:  1,
+		UpdateDelay:     time.Hour,
+		ManagedDkgs:     []types.ManagedDKG{{Module: "test", Type: "signing"}},
+	}
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+
+	// the participant set is initialized immediately
+	k.HandleParticipantSetUpdate(ctx)
+
+	participants := k.GetParticipantSet(ctx).ConsensusPubKeys()
+	require.Len(t, participants, 4)
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 3, 1, 0)
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+
+	validatorByParticipant := func(participant string) stakingtypes.Validator {
+		pubKey, err := base64.StdEncoding.DecodeString(participant)
+		require.NoError(t, err)
+
+		validator, err := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress((&ed25519.PubKey{Key: pubKey}).Address()))
+		require.NoError(t, err)
+
+		return validator
+	}
+
+	// applies the validator set changes and checks if the participant set update is scheduled after the delay
+	applyValidatorSetUpdates := func() {
+		require.True(t, k.GetParticipantSetUpdateTime(ctx).IsZero())
+
+		_, err := app.StakingKeeper.EndBlocker(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, ctx.BlockTime().Add(time.Hour), k.GetParticipantSetUpdateTime(ctx))
+
+		// not updated before the delay
+		k.HandleParticipantSetUpdate(ctx)
+		require.Equal(t, participants, k.GetParticipantSet(ctx).ConsensusPubKeys())
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		k.HandleParticipantSetUpdate(ctx)
+		require.True(t, k.GetParticipantSetUpdateTime(ctx).IsZero())
+
+		participants = k.GetParticipantSet(ctx).ConsensusPubKeys()
+	}
+
+	// jailing
+	jailedParticipant := participants[0]
+	jailedValidator := validatorByParticipant(jailedParticipant)
+
+	consAddr, err := jailedValidator.GetConsAddr()
+	require.NoError(t, err)
+	require.NoError(t, app.StakingKeeper.Jail(ctx, consAddr))
+
+	applyValidatorSetUpdates()
+	require.Len(t, participants, 4, "the standby validator should join the participant set")
+	require.NotContains(t, participants, jailedParticipant)
+
+	// the managed DKG is reshared to remove the jailed participant and add the new participant
+	refreshingRequests := k.GetPendingRefreshingRequests(ctx)
+	require.Len(t, refreshingRequests, 1)
+	require.Equal(t, dkgRequest.Id, refreshingRequests[0].DkgId)
+	require.Equal(t, []string{jailedParticipant}, refreshingRequests[0].RemovedParticipants)
+	require.Len(t, refreshingRequests[0].AddedParticipants, 1)
+	require.Contains(t, participants, refreshingRequests[0].AddedParticipants[0])
+
+	// unbonding
+	unbondedParticipant := participants[0]
+	unbondedValidator := validatorByParticipant(unbondedParticipant)
+
+	valAddr, err := sdk.ValAddressFromBech32(unbondedValidator.OperatorAddress)
+	require.NoError(t, err)
+
+	_, _, err = app.StakingKeeper.Undelegate(ctx, delegator.GetAddress(), valAddr, unbondedValidator.DelegatorShares)
+	require.NoError(t, err)
+
+	applyValidatorSetUpdates()
+	require.Len(t, participants, 3)
+	require.NotContains(t, participants, unbondedParticipant)
+
+	// bonding
+	require.NoError(t, app.StakingKeeper.Unjail(ctx, consAddr))
+
+	applyValidatorSetUpdates()
+	require.Len(t, participants, 4)
+	require.Contains(t, participants, jailedParticipant)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	k := *app.TSSKeeper
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// version 1 params without the params introduced in version 2
+	params := types.Params{
+		AllowedDkgParticipants: []types.DKGParticipant{},
+		DkgTimeoutDuration:     types.DefaultDKGTimeoutDuration,
+	}
+
+	store.Set(types.ParamsKey, app.AppCodec().MustMarshal(&params))
+
+	// DKG request completed before version 2, which is not indexed by the pub keys
+	participants := []string{}
+	for i := 0; i < 3; i++ {
+		participants = append(participants, base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	}
+
+	pubKeys := []string{randomSchnorrPubKey(t), randomSchnorrPubKey(t)}
+
+	dkgRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	for _, participant := range participants {
+		k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: dkgRequest.Id, PubKeys: pubKeys, ConsensusPubkey: participant})
+	}
+
+	dkgRequest.Status = types.DKGStatus_DKG_STATUS_COMPLETED
+	k.SetDKGRequest(ctx, dkgRequest)
+
+	// pending DKG request which is not indexed
+	pendingDKGRequest := k.InitiateDKG(ctx, "test", "signing", 0, participants, 2, 1, 0)
+	k.SetDKGCompletion(ctx, &types.DKGCompletion{Id: pendingDKGRequest.Id, PubKeys: []string{randomSchnorrPubKey(t)}, ConsensusPubkey: participants[0]})
+
+	require.Nil(t, k.GetDKGRequestByPubKey(ctx, pubKeys[0]))
+	require.Empty(t, k.GetSigningParticipants(ctx, &types.SigningRequest{PubKey: pubKeys[0]}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params = k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultSigningTimeoutDuration, params.SigningTimeoutDuration)
+	require.Equal(t, types.DefaultParams().ParticipantPenaltyParams, params.ParticipantPenaltyParams)
+	require.Equal(t, types.DefaultParticipantSetUpdateDelay, params.ParticipantSetParams.UpdateDelay)
+	require.Equal(t, types.DefaultNonceQueueSize, params.NonceQueueParams.QueueSize)
+	require.Equal(t, types.DefaultMaxSigningRetries, params.MaxSigningRetries)
+
+	for _, pubKey := range pubKeys {
+		require.Equal(t, dkgRequest.Id, k.GetDKGRequestByPubKey(ctx, pubKey).Id)
+		require.ElementsMatch(t, participants, k.GetSigningParticipants(ctx, &types.SigningRequest{PubKey: pubKey}))
+	}
+
+	require.Nil(t, k.GetDKGRequestByPubKey(ctx, k.GetDKGCompletions(ctx, pendingDKGRequest.Id)[0].PubKeys[0]))
+}
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// HandleKeyRotations performs the scheduled key rotations according to the key rotation policies
+func (k Keeper) HandleKeyRotations(ctx sdk.Context) {
+	for _, policy := range k.KeyRotationPolicies(ctx) {
+		rotation := k.GetKeyRotation(ctx, policy.Module, policy.Type)
+		if rotation == nil {
+			// start tracking the key age from now on
+			k.SetKeyRotation(ctx, &types.KeyRotation{
+				Module:           policy.Module,
+				Type:             policy.Type,
+				Status:           types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE,
+				LastRotationTime: ctx.BlockTime(),
+			})
+
+			continue
+		}
+
+		switch policy.Method {
+		case types.KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH:
+			k.handleKeyRefreshing(ctx, policy, rotation)
+
+		case types.KeyRotationMethod_KEY_ROTATION_METHOD_REDKG:
+			k.handleKeyReDKG(ctx, policy, rotation)
+		}
+	}
+}
+
+// handleKeyRefreshing rotates the key shares of the DKGs matching the given policy by refreshing
+func (k Keeper) handleKeyRefreshing(ctx sdk.Context, policy types.KeyRotationPolicy, rotation *types.KeyRotation) {
+	if rotation.Status == types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING {
+		completed := true
+
+		for _, id := range rotation.RefreshingIds {
+			switch k.GetRefreshingRequest(ctx, id).Status {
+			case types.RefreshingStatus_REFRESHING_STATUS_PENDING:
+				return
+
+			case types.RefreshingStatus_REFRESHING_STATUS_COMPLETED:
+
+			default:
+				completed = false
+			}
+		}
+
+		rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE
+		rotation.RefreshingIds = nil
+
+		if !completed {
+			// the rotation will be retried since the last rotation time is not updated
+			k.SetKeyRotation(ctx, rotation)
+			k.emitKeyRotationEvent(ctx, types.EventTypeKeyRotationAborted, rotation)
+
+			return
+		}
+
+		rotation.LastRotationTime = ctx.BlockTime()
+		k.SetKeyRotation(ctx, rotation)
+		k.emitKeyRotationEvent(ctx, types.EventTypeKeyRotationCompleted, rotation)
+
+		return
+	}
+
+	if ctx.BlockTime().Before(rotation.LastRotationTime.Add(policy.MaxAge)) {
+		return
+	}
+
+	dkgIdsInRefreshing := make(map[uint64]bool)
+	for _, req := range k.GetPendingRefreshingRequests(ctx) {
+		dkgIdsInRefreshing[req.DkgId] = true
+	}
+
+	refreshingIds := []uint64{}
+
+	k.IterateDKGRequestsByStatus(ctx, types.DKGStatus_DKG_STATUS_COMPLETED, func(req *types.DKGRequest) (stop bool) {
+		if req.Module != policy.Module || req.Type != policy.Type || dkgIdsInRefreshing[req.Id] {
+			return false
+		}
+
+		refreshingReq := k.InitiateRefreshingRequest(ctx, req.Id, []string{}, []string{}, req.Threshold, k.DKGTimeoutDuration(ctx))
+		refreshingIds = append(refreshingIds, refreshingReq.Id)
+
+		return false
+	})
+
+	if len(refreshingIds) == 0 {
+		// no keys to be refreshed
+		rotation.LastRotationTime = ctx.BlockTime()
+		k.SetKeyRotation(ctx, rotation)
+
+		return
+	}
+
+	rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING
+	rotation.RefreshingIds = refreshingIds
+
+	k.SetKeyRotation(ctx, rotation)
+	k.emitKeyRotationEvent(ctx, types.EventTypeKeyRotationInitiated, rotation)
+}
+
+// handleKeyReDKG rotates the keys matching the given policy by the re-DKG performed by the owner module
+func (k Keeper) handleKeyReDKG(ctx sdk.Context, policy types.KeyRotationPolicy, rotation *types.KeyRotation) {
+	switch rotation.Status {
+	case types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE:
+		if ctx.BlockTime().Before(rotation.LastRotationTime.Add(policy.MaxAge)) {
+			return
+		}
+
+		handler := k.GetKeyRotationHandler(policy.Module)
+		if handler == nil {
+			return
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+
+		// mark the rotation in progress before the callback so that the module can complete it immediately
+		rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING
+		k.SetKeyRotation(cacheCtx, rotation)
+
+		k.emitKeyRotationEvent(cacheCtx, types.EventTypeKeyRotationInitiated, rotation)
+
+		// callback the corresponding module handler
+		if err := handler(cacheCtx, policy.Type); err != nil {
+			k.Logger(ctx).Warn("Failed to call KeyRotationHandler", "module", policy.Module, "type", policy.Type, "err", err)
+			return
+		}
+
+		write()
+
+	case types.KeyRotationStatus_KEY_ROTATION_STATUS_OVERLAPPING:
+		if ctx.BlockTime().Before(rotation.RetirementTime) {
+			return
+		}
+
+		if handler := k.GetKeyRetirementHandler(policy.Module); handler != nil {
+			// callback the corresponding module handler
+			cacheCtx, write := ctx.CacheContext()
+			if err := handler(cacheCtx, policy.Type); err != nil {
+				k.Logger(ctx).Warn("Failed to call KeyRetirementHandler", "module", policy.Module, "type", policy.Type, "err", err)
+				return
+			}
+
+			write()
+		}
+
+		rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE
+		k.SetKeyRotation(ctx, rotation)
+
+		k.emitKeyRotationEvent(ctx, types.EventTypeKeyRetired, rotation)
+	}
+}
+
+// IsKeyRotating returns true if the keys of the given module and type are being rotated, false otherwise
+func (k Keeper) IsKeyRotating(ctx sdk.Context, module string, ty string) bool {
+	rotation := k.GetKeyRotation(ctx, module, ty)
+
+	return rotation != nil && rotation.Status == types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING
+}
+
+// CompleteKeyRotation is called by the owner module when the new keys of the given type are ready
+// The old keys are retired after the overlap window of the policy
+func (k Keeper) CompleteKeyRotation(ctx sdk.Context, module string, ty string) error {
+	rotation := k.GetKeyRotation(ctx, module, ty)
+	if rotation == nil {
+		return types.ErrKeyRotationDoesNotExist
+	}
+
+	if rotation.Status != types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING {
+		return errorsmod.Wrap(types.ErrInvalidKeyRotationStatus, "key rotation not in progress")
+	}
+
+	policy, _ := k.GetKeyRotationPolicy(ctx, module, ty)
+
+	rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_OVERLAPPING
+	rotation.LastRotationTime = ctx.BlockTime()
+	rotation.RetirementTime = ctx.BlockTime().Add(policy.OverlapWindow)
+
+	k.SetKeyRotation(ctx, rotation)
+	k.emitKeyRotationEvent(ctx, types.EventTypeKeyRotationCompleted, rotation)
+
+	return nil
+}
+
+// AbortKeyRotation is called by the owner module when the new keys of the given type failed to be generated
+// The rotation will be retried since the last rotation time is not updated
+func (k Keeper) AbortKeyRotation(ctx sdk.Context, module string, ty string) error {
+	rotation := k.GetKeyRotation(ctx, module, ty)
+	if rotation == nil {
+		return types.ErrKeyRotationDoesNotExist
+	}
+
+	if rotation.Status != types.KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING {
+		return errorsmod.Wrap(types.ErrInvalidKeyRotationStatus, "key rotation not in progress")
+	}
+
+	rotation.Status = types.KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE
+
+	k.SetKeyRotation(ctx, rotation)
+	k.emitKeyRotationEvent(ctx, types.EventTypeKeyRotationAborted, rotation)
+
+	return nil
+}
+
+// emitKeyRotationEvent emits the event of the given type for the specified key rotation
+func (k Keeper) emitKeyRotationEvent(ctx sdk.Context, eventType string, rotation *types.KeyRotation) {
+	refreshingIds := []string{}
+	for _, id := range rotation.RefreshingIds {
+		refreshingIds = append(refreshingIds, fmt.Sprintf("%d", id))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyModule, rotation.Module),
+			sdk.NewAttribute(types.AttributeKeyType, rotation.Type),
+			sdk.NewAttribute(types.AttributeKeyRefreshingIds, strings.Join(refreshingIds, types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyRetirementTime, rotation.RetirementTime.String()),
+		),
+	)
+}
+
+// SetKeyRotation sets the given key rotation
+func (k Keeper) SetKeyRotation(ctx sdk.Context, rotation *types.KeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(rotation)
+	store.Set(types.KeyRotationKey(rotation.Module, rotation.Type), bz)
+}
+
+// GetKeyRotation gets the key rotation of the given module and type
+// Nil is returned if the key rotation does not exist
+func (k Keeper) GetKeyRotation(ctx sdk.Context, module string, ty string) *types.KeyRotation {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyRotationKey(module, ty))
+	if bz == nil {
+		return nil
+	}
+
+	var rotation types.KeyRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+
+	return &rotation
+}
+
+// GetKeyRotations gets the key rotations of the given module
+// All key rotations are returned if the module is empty
+func (k Keeper) GetKeyRotations(ctx sdk.Context, module string) []*types.KeyRotation {
+	rotations := make([]*types.KeyRotation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyRotationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.KeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+
+		if len(module) == 0 || rotation.Module == module {
+			rotations = append(rotations, &rotation)
+		}
+	}
+
+	return rotations
+}
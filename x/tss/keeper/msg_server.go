@@ -24,7 +24,7 @@ func (m msgServer) CompleteDKG(goCtx context.Context, msg *types.MsgCompleteDKG)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := m.Keeper.CompleteDKG(ctx, msg.Sender, msg.Id, msg.PubKeys, msg.ConsensusPubkey, msg.Signature); err != nil {
+	if err := m.Keeper.CompleteDKG(ctx, msg.Sender, msg.Id, msg.PubKeys, msg.ConsensusPubkey, msg.Signature, msg.Proofs, msg.VerificationShares); err != nil {
 		return nil, err
 	}
 
@@ -70,23 +70,125 @@ func (m msgServer) SubmitSignatures(goCtx context.Context, msg *types.MsgSubmitS
 		return nil, err
 	}
 
-	// callback to the module handler
-	if err := m.GetSigningRequestCompletedHandler(req.Module)(ctx, msg.Sender, req.Id, req.ScopedId, req.Type, req.Intent, req.PubKey, msg.Signatures); err != nil {
+	// the participants which acknowledged the signing request are regarded as the signers
+	if err := m.CompleteSigningRequest(ctx, msg.Sender, req, msg.Signatures, m.GetAcknowledgedSigningParticipants(ctx, req.Id), false); err != nil {
 		return nil, err
 	}
 
-	req.Status = types.SigningStatus_SIGNING_STATUS_SIGNED
-	m.SetSigningRequest(ctx, req)
+	return &types.MsgSubmitSignaturesResponse{}, nil
+}
+
+// SubmitBatchSignatures submits the signatures for all pending signing requests of the specified batch
+func (m msgServer) SubmitBatchSignatures(goCtx context.Context, msg *types.MsgSubmitBatchSignatures) (*types.MsgSubmitBatchSignaturesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SubmitBatchSignatures(ctx, msg.Sender, msg.Id, msg.Signatures); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBatchSignaturesResponse{}, nil
+}
+
+// SubmitPartialSignatures submits the partial signatures for the specified signing request by the participant
+func (m msgServer) SubmitPartialSignatures(goCtx context.Context, msg *types.MsgSubmitPartialSignatures) (*types.MsgSubmitPartialSignaturesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SubmitPartialSignatures(ctx, msg.Sender, msg.Id, msg.ConsensusPubkey, msg.Signers, msg.AggregatedNonces, msg.Nonces, msg.PartialSignatures, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	// Emit events
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeCompleteSigning,
+			types.EventTypeSubmitPartialSignatures,
 			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.ConsensusPubkey),
 		),
 	)
 
-	return &types.MsgSubmitSignaturesResponse{}, nil
+	return &types.MsgSubmitPartialSignaturesResponse{}, nil
+}
+
+// ClaimRewards claims the rewards of the participant by the corresponding validator operator
+func (m msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, err := m.Keeper.ClaimRewards(ctx, msg.Sender, msg.ConsensusPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.ConsensusPubkey),
+			sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+		),
+	)
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+// RequestSignature requests the signatures on behalf of the governance
+func (m msgServer) RequestSignature(goCtx context.Context, msg *types.MsgRequestSignature) (*types.MsgRequestSignatureResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	req, err := m.Keeper.RequestSignature(ctx, msg.DkgId, msg.PubKey, msg.Type, msg.SigHashes, msg.Psbt, msg.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestSignatureResponse{Id: req.Id}, nil
+}
+
+// InitiateDKG initiates the DKG request on behalf of the governance
+func (m msgServer) InitiateDKG(goCtx context.Context, msg *types.MsgInitiateDKG) (*types.MsgInitiateDKGResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowedParticipants := m.AllowedDKGParticipants(ctx)
+
+	if len(allowedParticipants) != 0 {
+		for _, p := range msg.Participants {
+			if !slices.Contains(allowedParticipants, p) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "participant %s not allowed", p)
+			}
+		}
+	}
+
+	req := m.InitiateDKGWithKeyType(ctx, types.GovModuleScope, types.GovDKGType, 0, msg.Participants, msg.Threshold, msg.BatchSize, msg.TimeoutDuration, msg.KeyType)
+
+	return &types.MsgInitiateDKGResponse{Id: req.Id}, nil
 }
 
 // Refresh refreshes the key shares
@@ -101,6 +203,8 @@ func (m msgServer) Refresh(goCtx context.Context, msg *types.MsgRefresh) (*types
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	allowedParticipants := m.AllowedDKGParticipants(ctx)
+
 	for _, dkgId := range msg.DkgIds {
 		if !m.HasDKGRequest(ctx, dkgId) {
 			return nil, errorsmod.Wrapf(types.ErrDKGRequestDoesNotExist, "dkg %d", dkgId)
@@ -111,22 +215,37 @@ func (m msgServer) Refresh(goCtx context.Context, msg *types.MsgRefresh) (*types
 			return nil, errorsmod.Wrapf(types.ErrInvalidDKGStatus, "dkg %d not completed", dkgId)
 		}
 
-		remainingParticipantNum := len(dkgRequest.Participants) - len(msg.RemovedParticipants)
-		if remainingParticipantNum < types.MinDKGParticipantNum {
-			return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "remaining participants %d cannot be less than min participants %d for dkg %d", remainingParticipantNum, types.MinDKGParticipantNum, dkgId)
-		}
-
-		if uint32(remainingParticipantNum) < dkgRequest.Threshold {
-			return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "remaining participants %d cannot be less than threshold %d for dkg %d", remainingParticipantNum, dkgRequest.Threshold, dkgId)
-		}
-
 		for _, p := range msg.RemovedParticipants {
 			if !slices.Contains(dkgRequest.Participants, p) {
 				return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "participant %s does not exist for dkg %d", p, dkgId)
 			}
 		}
 
-		m.InitiateRefreshingRequest(ctx, dkgId, msg.RemovedParticipants, msg.TimeoutDuration)
+		for _, p := range msg.AddedParticipants {
+			if slices.Contains(dkgRequest.Participants, p) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "participant %s already exists for dkg %d", p, dkgId)
+			}
+
+			if len(allowedParticipants) != 0 && !slices.Contains(allowedParticipants, p) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "participant %s not allowed", p)
+			}
+		}
+
+		participantNum := len(dkgRequest.Participants) - len(msg.RemovedParticipants) + len(msg.AddedParticipants)
+		if participantNum < types.MinDKGParticipantNum {
+			return nil, errorsmod.Wrapf(types.ErrInvalidParticipants, "participants %d cannot be less than min participants %d for dkg %d", participantNum, types.MinDKGParticipantNum, dkgId)
+		}
+
+		threshold := dkgRequest.Threshold
+		if msg.Threshold != 0 {
+			threshold = msg.Threshold
+		}
+
+		if err := types.CheckDKGThreshold(participantNum, int(threshold)); err != nil {
+			return nil, errorsmod.Wrapf(err, "dkg %d", dkgId)
+		}
+
+		m.InitiateRefreshingRequest(ctx, dkgId, msg.RemovedParticipants, msg.AddedParticipants, threshold, msg.TimeoutDuration)
 	}
 
 	return &types.MsgRefreshResponse{}, nil
@@ -140,7 +259,7 @@ func (m msgServer) CompleteRefreshing(goCtx context.Context, msg *types.MsgCompl
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := m.Keeper.CompleteRefreshing(ctx, msg.Sender, msg.Id, msg.ConsensusPubkey, msg.Signature); err != nil {
+	if err := m.Keeper.CompleteRefreshing(ctx, msg.Sender, msg.Id, msg.ConsensusPubkey, msg.Signature, msg.VerificationShares); err != nil {
 		return nil, err
 	}
 
@@ -157,6 +276,31 @@ func (m msgServer) CompleteRefreshing(goCtx context.Context, msg *types.MsgCompl
 	return &types.MsgCompleteRefreshingResponse{}, nil
 }
 
+// AcknowledgeSigning acknowledges the signing request by the participant
+func (m msgServer) AcknowledgeSigning(goCtx context.Context, msg *types.MsgAcknowledgeSigning) (*types.MsgAcknowledgeSigningResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.AcknowledgeSigning(ctx, msg.Sender, msg.Id, msg.ConsensusPubkey, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcknowledgeSigning,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.ConsensusPubkey),
+		),
+	)
+
+	return &types.MsgAcknowledgeSigningResponse{}, nil
+}
+
 // UpdateParams updates the module params
 func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if m.authority != msg.Authority {
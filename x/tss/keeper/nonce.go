@@ -0,0 +1,331 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// HandleNonceQueues replenishes the nonce queues of the managed DKGs at the configured block interval
+// The nonce generation is initiated for the DKG if the queue size falls below the target and no generation is pending
+func (k Keeper) HandleNonceQueues(ctx sdk.Context) {
+	params := k.NonceQueueParams(ctx)
+	if params.QueueSize == 0 || len(params.Dkgs) == 0 {
+		return
+	}
+
+	if ctx.BlockHeight()%params.GenerationInterval != 0 {
+		return
+	}
+
+	dkgRequests := []*types.DKGRequest{}
+
+	k.IterateDKGRequestsByStatus(ctx, types.DKGStatus_DKG_STATUS_COMPLETED, func(req *types.DKGRequest) (stop bool) {
+		if params.IsManagedDKG(req.Module, req.Type) && req.KeyType == types.KeyType_KEY_TYPE_SCHNORR {
+			dkgRequests = append(dkgRequests, req)
+		}
+
+		return false
+	})
+
+	for _, req := range dkgRequests {
+		if k.HasPendingNonceGeneration(ctx, req.Id) {
+			continue
+		}
+
+		if k.GetCommittedNonceCount(ctx, req.Id) >= uint64(params.QueueSize) {
+			continue
+		}
+
+		generation := k.InitiateDKG(ctx, types.ModuleName, types.DKGTypeNonce, 0, req.Participants, req.Threshold, params.GenerationBatchSize, params.GenerationTimeoutDuration)
+
+		k.SetNonceGeneration(ctx, req.Id, generation.Id)
+	}
+}
+
+// HasPendingNonceGeneration returns true if the nonce generation of the given DKG is pending, false otherwise
+// The stale nonce generation which failed or timed out is removed
+func (k Keeper) HasPendingNonceGeneration(ctx sdk.Context, dkgId uint64) bool {
+	generationId := k.GetNonceGeneration(ctx, dkgId)
+	if generationId == 0 {
+		return false
+	}
+
+	if k.GetDKGRequest(ctx, generationId).Status == types.DKGStatus_DKG_STATUS_PENDING {
+		return true
+	}
+
+	k.RemoveNonceGeneration(ctx, dkgId, generationId)
+
+	return false
+}
+
+// NonceGenerationCompletionReceivedHandler is callback handler when the completion of the nonce generation received
+func (k Keeper) NonceGenerationCompletionReceivedHandler(ctx sdk.Context, id uint64, ty string, intent int32, participant string) error {
+	return nil
+}
+
+// NonceGenerationCompletedHandler is callback handler when the nonce generation completed
+// The generated nonces are committed to the nonce queue of the corresponding DKG
+func (k Keeper) NonceGenerationCompletedHandler(ctx sdk.Context, id uint64, ty string, intent int32, pubKeys []string) error {
+	if ty != types.DKGTypeNonce {
+		return errorsmod.Wrapf(types.ErrInvalidDKGs, "unexpected dkg type %s", ty)
+	}
+
+	dkgId := k.GetNonceGenerationDKG(ctx, id)
+	if dkgId == 0 {
+		return errorsmod.Wrap(types.ErrDKGRequestDoesNotExist, "nonce generation not found")
+	}
+
+	k.RemoveNonceGeneration(ctx, dkgId, id)
+
+	if k.GetDKGRequest(ctx, dkgId).Status != types.DKGStatus_DKG_STATUS_COMPLETED {
+		return errorsmod.Wrap(types.ErrInvalidDKGStatus, "dkg not completed")
+	}
+
+	for _, pubKey := range pubKeys {
+		nonceBytes, _ := hex.DecodeString(pubKey)
+		if k.HasNonce(ctx, nonceBytes) {
+			return errorsmod.Wrap(types.ErrInvalidNonce, "nonce already exists")
+		}
+	}
+
+	for _, pubKey := range pubKeys {
+		nonceBytes, _ := hex.DecodeString(pubKey)
+
+		k.SetCommittedNonce(ctx, &types.CommittedNonce{
+			DkgId:        dkgId,
+			Index:        k.IncrementNonceQueueIndex(ctx, dkgId),
+			Nonce:        pubKey,
+			GenerationId: id,
+		})
+
+		k.SetNonceByValue(ctx, nonceBytes)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNoncesCommitted,
+			sdk.NewAttribute(types.AttributeKeyDKGId, fmt.Sprintf("%d", dkgId)),
+			sdk.NewAttribute(types.AttributeKeyGenerationId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyNonces, strings.Join(pubKeys, types.AttributeValueSeparator)),
+		),
+	)
+
+	return nil
+}
+
+// NonceGenerationTimeoutHandler is callback handler when the nonce generation timed out
+func (k Keeper) NonceGenerationTimeoutHandler(ctx sdk.Context, id uint64, ty string, intent int32, absentParticipants []string) error {
+	if dkgId := k.GetNonceGenerationDKG(ctx, id); dkgId != 0 {
+		k.RemoveNonceGeneration(ctx, dkgId, id)
+	}
+
+	return nil
+}
+
+// ConsumeNonces pops the given number of committed nonces from the nonce queue of the specified DKG
+// The consumed nonces can never be committed again
+func (k Keeper) ConsumeNonces(ctx sdk.Context, dkgId uint64, num int) ([]string, error) {
+	committedNonces := []*types.CommittedNonce{}
+
+	k.IterateCommittedNonces(ctx, dkgId, func(nonce *types.CommittedNonce) (stop bool) {
+		if len(committedNonces) >= num {
+			return true
+		}
+
+		committedNonces = append(committedNonces, nonce)
+
+		return false
+	})
+
+	if len(committedNonces) < num {
+		return nil, types.ErrInsufficientNonces
+	}
+
+	nonces := []string{}
+
+	for _, nonce := range committedNonces {
+		k.RemoveCommittedNonce(ctx, dkgId, nonce.Index)
+
+		nonces = append(nonces, nonce.Nonce)
+	}
+
+	return nonces, nil
+}
+
+// ResetNonceQueue removes all committed nonces and the pending nonce generation of the given DKG
+// The nonces generated by the previous key shares are discarded once the DKG is refreshed
+func (k Keeper) ResetNonceQueue(ctx sdk.Context, dkgId uint64) {
+	for _, nonce := range k.GetCommittedNonces(ctx, dkgId) {
+		k.RemoveCommittedNonce(ctx, dkgId, nonce.Index)
+	}
+
+	if generationId := k.GetNonceGeneration(ctx, dkgId); generationId != 0 {
+		k.RemoveNonceGeneration(ctx, dkgId, generationId)
+	}
+}
+
+// GetNonceQueueIndex gets the current nonce queue index of the given DKG
+func (k Keeper) GetNonceQueueIndex(ctx sdk.Context, dkgId uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NonceQueueIndexKey(dkgId))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementNonceQueueIndex increments the nonce queue index of the given DKG and returns the new index
+func (k Keeper) IncrementNonceQueueIndex(ctx sdk.Context, dkgId uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	index := k.GetNonceQueueIndex(ctx, dkgId) + 1
+	store.Set(types.NonceQueueIndexKey(dkgId), sdk.Uint64ToBigEndian(index))
+
+	return index
+}
+
+// HasNonce returns true if the given nonce has ever been committed, false otherwise
+func (k Keeper) HasNonce(ctx sdk.Context, nonce []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.NonceByValueKey(nonce))
+}
+
+// SetNonceByValue sets the given nonce value
+func (k Keeper) SetNonceByValue(ctx sdk.Context, nonce []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.NonceByValueKey(nonce), []byte{})
+}
+
+// SetCommittedNonce sets the given committed nonce
+func (k Keeper) SetCommittedNonce(ctx sdk.Context, nonce *types.CommittedNonce) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(nonce)
+	store.Set(types.CommittedNonceKey(nonce.DkgId, nonce.Index), bz)
+}
+
+// RemoveCommittedNonce removes the committed nonce by the given DKG id and index
+func (k Keeper) RemoveCommittedNonce(ctx sdk.Context, dkgId uint64, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.CommittedNonceKey(dkgId, index))
+}
+
+// GetCommittedNonceCount gets the number of committed nonces in the queue of the given DKG
+func (k Keeper) GetCommittedNonceCount(ctx sdk.Context, dkgId uint64) uint64 {
+	count := uint64(0)
+
+	k.IterateCommittedNonces(ctx, dkgId, func(nonce *types.CommittedNonce) (stop bool) {
+		count++
+		return false
+	})
+
+	return count
+}
+
+// GetCommittedNonces gets committed nonces in the queue of the given DKG
+func (k Keeper) GetCommittedNonces(ctx sdk.Context, dkgId uint64) []*types.CommittedNonce {
+	nonces := make([]*types.CommittedNonce, 0)
+
+	k.IterateCommittedNonces(ctx, dkgId, func(nonce *types.CommittedNonce) (stop bool) {
+		nonces = append(nonces, nonce)
+		return false
+	})
+
+	return nonces
+}
+
+// GetCommittedNoncesWithPagination gets committed nonces in the queue of the given DKG with pagination
+func (k Keeper) GetCommittedNoncesWithPagination(ctx sdk.Context, dkgId uint64, pagination *query.PageRequest) ([]*types.CommittedNonce, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	nonceStore := prefix.NewStore(store, append(types.CommittedNonceKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...))
+
+	var nonces []*types.CommittedNonce
+
+	pageRes, err := query.Paginate(nonceStore, pagination, func(key []byte, value []byte) error {
+		var nonce types.CommittedNonce
+		k.cdc.MustUnmarshal(value, &nonce)
+
+		nonces = append(nonces, &nonce)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nonces, pageRes, nil
+}
+
+// IterateCommittedNonces iterates through committed nonces in the queue of the given DKG in the queue order
+func (k Keeper) IterateCommittedNonces(ctx sdk.Context, dkgId uint64, cb func(nonce *types.CommittedNonce) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.CommittedNonceKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.CommittedNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+
+		if cb(&nonce) {
+			break
+		}
+	}
+}
+
+// SetNonceGeneration sets the pending nonce generation of the given DKG
+func (k Keeper) SetNonceGeneration(ctx sdk.Context, dkgId uint64, generationId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.NonceGenerationKey(dkgId), sdk.Uint64ToBigEndian(generationId))
+	store.Set(types.NonceGenerationDKGKey(generationId), sdk.Uint64ToBigEndian(dkgId))
+}
+
+// GetNonceGeneration gets the id of the pending nonce generation of the given DKG
+// 0 is returned if not exist
+func (k Keeper) GetNonceGeneration(ctx sdk.Context, dkgId uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NonceGenerationKey(dkgId))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetNonceGenerationDKG gets the id of the DKG for which the given nonce generation is initiated
+// 0 is returned if not exist
+func (k Keeper) GetNonceGenerationDKG(ctx sdk.Context, generationId uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NonceGenerationDKGKey(generationId))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// RemoveNonceGeneration removes the pending nonce generation of the given DKG
+func (k Keeper) RemoveNonceGeneration(ctx sdk.Context, dkgId uint64, generationId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.NonceGenerationKey(dkgId))
+	store.Delete(types.NonceGenerationDKGKey(generationId))
+}
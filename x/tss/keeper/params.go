@@ -4,10 +4,19 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
 )
 
 // AllowedDKGParticipants gets the allowed DKG participants
+// The automatically maintained participant set is returned if enabled
 func (k Keeper) AllowedDKGParticipants(ctx sdk.Context) []string {
+	if k.ParticipantSetEnabled(ctx) {
+		if participantSet := k.GetParticipantSet(ctx); participantSet != nil {
+			return participantSet.ConsensusPubKeys()
+		}
+	}
+
 	participants := []string{}
 
 	for _, p := range k.GetParams(ctx).AllowedDkgParticipants {
@@ -21,3 +30,67 @@ func (k Keeper) AllowedDKGParticipants(ctx sdk.Context) []string {
 func (k Keeper) DKGTimeoutDuration(ctx sdk.Context) time.Duration {
 	return k.GetParams(ctx).DkgTimeoutDuration
 }
+
+// SigningTimeoutDuration gets the signing timeout duration for the given module
+// The default signing timeout duration is returned if not specified for the module
+func (k Keeper) SigningTimeoutDuration(ctx sdk.Context, module string) time.Duration {
+	params := k.GetParams(ctx)
+
+	for _, t := range params.ModuleSigningTimeouts {
+		if t.Module == module {
+			return t.TimeoutDuration
+		}
+	}
+
+	return params.SigningTimeoutDuration
+}
+
+// MaxSigningRetries gets the maximum number of times a timed out signing request can be re-initiated
+// 0 means unlimited
+func (k Keeper) MaxSigningRetries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxSigningRetries
+}
+
+// GetModuleSigningFee gets the signing fee for the given module
+// False returned if no signing fee configured for the module
+func (k Keeper) GetModuleSigningFee(ctx sdk.Context, module string) (types.ModuleSigningFee, bool) {
+	for _, fee := range k.GetParams(ctx).ModuleSigningFees {
+		if fee.Module == module {
+			return fee, true
+		}
+	}
+
+	return types.ModuleSigningFee{}, false
+}
+
+// ParticipantSetParams gets the participant set params
+func (k Keeper) ParticipantSetParams(ctx sdk.Context) types.ParticipantSetParams {
+	return k.GetParams(ctx).ParticipantSetParams
+}
+
+// ParticipantSetEnabled returns true if the participant set is maintained automatically, false otherwise
+func (k Keeper) ParticipantSetEnabled(ctx sdk.Context) bool {
+	return k.ParticipantSetParams(ctx).Enabled
+}
+
+// NonceQueueParams gets the nonce queue params
+func (k Keeper) NonceQueueParams(ctx sdk.Context) types.NonceQueueParams {
+	return k.GetParams(ctx).NonceQueueParams
+}
+
+// KeyRotationPolicies gets the key rotation policies
+func (k Keeper) KeyRotationPolicies(ctx sdk.Context) []types.KeyRotationPolicy {
+	return k.GetParams(ctx).KeyRotationPolicies
+}
+
+// GetKeyRotationPolicy gets the key rotation policy of the given module and type
+// False is returned if the policy does not exist
+func (k Keeper) GetKeyRotationPolicy(ctx sdk.Context, module string, ty string) (types.KeyRotationPolicy, bool) {
+	for _, policy := range k.KeyRotationPolicies(ctx) {
+		if policy.Module == module && policy.Type == ty {
+			return policy, true
+		}
+	}
+
+	return types.KeyRotationPolicy{}, false
+}
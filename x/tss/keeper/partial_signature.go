@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// SubmitPartialSignatures submits the partial signatures of the given signing request by the participant
+// Each partial signature is verified against the verification share of the participant so that the invalid submission is rejected
+// The partial signatures are aggregated once all signers of the same session submit, i.e. with the same signers and aggregated nonces
+// The signing request is completed with the signers as the signer set if the aggregated signatures are valid
+func (k Keeper) SubmitPartialSignatures(ctx sdk.Context, sender string, id uint64, consensusPubKey string, signers []string, aggregatedNonces []string, nonces []string, partialSigs []string, signature string) error {
+	if !k.HasSigningRequest(ctx, id) {
+		return types.ErrSigningRequestDoesNotExist
+	}
+
+	req := k.GetSigningRequest(ctx, id)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing request non pending")
+	}
+
+	if !req.ExpirationTime.IsZero() && !ctx.BlockTime().Before(req.ExpirationTime) {
+		return types.ErrSigningRequestExpired
+	}
+
+	if !types.SupportsPartialSignatures(req.Type) {
+		return errorsmod.Wrapf(types.ErrPartialSignaturesNotSupported, "signing type %s", req.Type)
+	}
+
+	dkgRequest := k.GetDKGRequestByPubKey(ctx, req.PubKey)
+	if dkgRequest == nil || !types.ParticipantExists(dkgRequest.Participants, consensusPubKey) {
+		return types.ErrUnauthorizedParticipant
+	}
+
+	verificationShares := k.GetVerificationShares(ctx, dkgRequest.Id)
+	if verificationShares == nil {
+		return errorsmod.Wrap(types.ErrPartialSignaturesNotSupported, "no verification shares")
+	}
+
+	if err := types.CheckSigners(dkgRequest.Participants, dkgRequest.Threshold, signers); err != nil {
+		return err
+	}
+
+	if !slices.Contains(signers, consensusPubKey) {
+		return errorsmod.Wrap(types.ErrUnauthorizedParticipant, "participant not included in the signers")
+	}
+
+	if len(nonces) != len(req.SigHashes) {
+		return errorsmod.Wrap(types.ErrInvalidSignatures, "partial signatures do not match sig hashes")
+	}
+
+	if err := types.CheckPartialSignatures(aggregatedNonces, nonces, partialSigs); err != nil {
+		return err
+	}
+
+	if k.HasPartialSignature(ctx, id, consensusPubKey) {
+		// only one submission is allowed for each session
+		// the public nonces can not be reused in another session, otherwise the key share would be leaked
+		existingPartialSig := k.GetPartialSignature(ctx, id, consensusPubKey)
+		if slices.Equal(existingPartialSig.Signers, signers) && slices.Equal(existingPartialSig.AggregatedNonces, aggregatedNonces) {
+			return types.ErrPartialSignatureAlreadyExists
+		}
+
+		for _, nonce := range nonces {
+			if slices.Contains(existingPartialSig.Nonces, nonce) {
+				return errorsmod.Wrap(types.ErrInvalidNonce, "nonce reused")
+			}
+		}
+	}
+
+	if !types.VerifySignature(signature, consensusPubKey, types.GetPartialSignatureSigMsg(id, signers, aggregatedNonces, nonces, partialSigs)) {
+		return types.ErrInvalidSignature
+	}
+
+	if err := k.VerifyPartialSignatures(ctx, req, dkgRequest, verificationShares, consensusPubKey, signers, aggregatedNonces, nonces, partialSigs); err != nil {
+		return err
+	}
+
+	k.SetPartialSignature(ctx, &types.PartialSignature{
+		Id:                id,
+		Sender:            sender,
+		ConsensusPubkey:   consensusPubKey,
+		Nonces:            nonces,
+		PartialSignatures: partialSigs,
+		Signature:         signature,
+		Signers:           signers,
+		AggregatedNonces:  aggregatedNonces,
+	})
+
+	sessionPartialSigs := make([]*types.PartialSignature, 0, len(signers))
+	for _, partialSig := range k.GetPartialSignatures(ctx, id) {
+		if slices.Equal(partialSig.Signers, signers) && slices.Equal(partialSig.AggregatedNonces, aggregatedNonces) {
+			sessionPartialSigs = append(sessionPartialSigs, partialSig)
+		}
+	}
+
+	if len(sessionPartialSigs) < len(signers) {
+		return nil
+	}
+
+	// the aggregated nonces may not be the sum of the individual nonces
+	// thus the partial signatures of the session are discarded on the failed aggregation so that the signers can start a new session
+	signatures := types.AggregatePartialSignatures(aggregatedNonces, sessionPartialSigs)
+
+	var err error
+	if !types.CheckAggregatedNonces(aggregatedNonces, sessionPartialSigs) {
+		err = errorsmod.Wrap(types.ErrInvalidNonce, "aggregated nonces mismatched")
+	} else {
+		err = k.VerifySignatures(ctx, req, signatures)
+	}
+
+	if err != nil {
+		for _, partialSig := range sessionPartialSigs {
+			k.RemovePartialSignature(ctx, id, partialSig.ConsensusPubkey)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAggregationFailed,
+				sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+
+		return nil
+	}
+
+	return k.CompleteSigningRequest(ctx, sender, req, signatures, signers, true)
+}
+
+// VerifyPartialSignatures verifies the partial signatures of the participant against its verification share
+// The participant at position i of the DKG participants holds the key share at index i + 1
+func (k Keeper) VerifyPartialSignatures(ctx sdk.Context, req *types.SigningRequest, dkgRequest *types.DKGRequest, verificationShares *types.VerificationShares, consensusPubKey string, signers []string, aggregatedNonces []string, nonces []string, partialSigs []string) error {
+	keyShares, err := types.ParseVerificationShares(types.GetVerificationShares(k.GetDKGPubKeys(ctx, dkgRequest.Id), len(dkgRequest.Participants), verificationShares.Shares, req.PubKey))
+	if err != nil || len(keyShares) == 0 {
+		return errorsmod.Wrap(types.ErrPartialSignaturesNotSupported, "no verification shares for the pub key")
+	}
+
+	indexes := make([]uint32, len(signers))
+	signerShares := make([]*btcec.PublicKey, len(signers))
+
+	for i, signer := range signers {
+		position := slices.Index(dkgRequest.Participants, signer)
+
+		indexes[i] = uint32(position + 1)
+		signerShares[i] = keyShares[position]
+	}
+
+	index := uint32(slices.Index(dkgRequest.Participants, consensusPubKey) + 1)
+	share := keyShares[index-1]
+	lambda := types.ComputeLagrangeCoefficient(index, indexes, 0)
+
+	// the group pub key with the actual parity
+	groupPubKey := types.InterpolateVerificationShares(indexes, signerShares, 0)
+
+	for i, sigHash := range req.SigHashes {
+		aggregatedNonce, _ := types.ParsePartialNonce(aggregatedNonces[i])
+		nonce, _ := types.ParsePartialNonce(nonces[i])
+		partialSig, _ := types.ParsePartialSignature(partialSigs[i])
+
+		if req.Type == types.SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT {
+			committedNonce, _ := hex.DecodeString(req.Options.Nonce)
+			if len(req.Options.Nonces) != 0 {
+				committedNonce, _ = hex.DecodeString(req.Options.Nonces[i])
+			}
+
+			if !bytes.Equal(schnorr.SerializePubKey(aggregatedNonce), committedNonce) {
+				return errorsmod.Wrapf(types.ErrInvalidNonce, "aggregated nonce %d does not match the committed nonce", i)
+			}
+		}
+
+		sigHashBytes, _ := base64.StdEncoding.DecodeString(sigHash)
+
+		if !types.VerifyPartialSignature(groupPubKey, share, lambda, aggregatedNonce, nonce, partialSig, sigHashBytes) {
+			return errorsmod.Wrapf(types.ErrInvalidPartialSignature, "partial signature %d of participant %s", i, consensusPubKey)
+		}
+	}
+
+	return nil
+}
+
+// SetPartialSignature sets the given partial signature
+func (k Keeper) SetPartialSignature(ctx sdk.Context, partialSig *types.PartialSignature) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(partialSig)
+	store.Set(types.PartialSignatureKey(partialSig.Id, partialSig.ConsensusPubkey), bz)
+}
+
+// HasPartialSignature returns true if the partial signature of the given participant exists, false otherwise
+func (k Keeper) HasPartialSignature(ctx sdk.Context, id uint64, consPubKey string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.PartialSignatureKey(id, consPubKey))
+}
+
+// GetPartialSignature gets the partial signature of the given participant
+func (k Keeper) GetPartialSignature(ctx sdk.Context, id uint64, consPubKey string) *types.PartialSignature {
+	store := ctx.KVStore(k.storeKey)
+
+	var partialSig types.PartialSignature
+	bz := store.Get(types.PartialSignatureKey(id, consPubKey))
+	k.cdc.MustUnmarshal(bz, &partialSig)
+
+	return &partialSig
+}
+
+// RemovePartialSignature removes the partial signature of the given participant
+func (k Keeper) RemovePartialSignature(ctx sdk.Context, id uint64, consPubKey string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.PartialSignatureKey(id, consPubKey))
+}
+
+// RemovePartialSignatures removes partial signatures by the given signing request id
+func (k Keeper) RemovePartialSignatures(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, partialSig := range k.GetPartialSignatures(ctx, id) {
+		store.Delete(types.PartialSignatureKey(id, partialSig.ConsensusPubkey))
+	}
+}
+
+// GetPartialSignatures gets partial signatures by the given signing request id
+func (k Keeper) GetPartialSignatures(ctx sdk.Context, id uint64) []*types.PartialSignature {
+	partialSigs := make([]*types.PartialSignature, 0)
+
+	k.IteratePartialSignatures(ctx, id, func(partialSig *types.PartialSignature) (stop bool) {
+		partialSigs = append(partialSigs, partialSig)
+		return false
+	})
+
+	return partialSigs
+}
+
+// GetPartialSignaturesWithPagination gets partial signatures by the given signing request id with pagination
+func (k Keeper) GetPartialSignaturesWithPagination(ctx sdk.Context, id uint64, pagination *query.PageRequest) ([]*types.PartialSignature, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	partialSigStore := prefix.NewStore(store, append(types.PartialSignatureKeyPrefix, sdk.Uint64ToBigEndian(id)...))
+
+	var partialSigs []*types.PartialSignature
+
+	pageRes, err := query.Paginate(partialSigStore, pagination, func(key []byte, value []byte) error {
+		var partialSig types.PartialSignature
+		k.cdc.MustUnmarshal(value, &partialSig)
+
+		partialSigs = append(partialSigs, &partialSig)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return partialSigs, pageRes, nil
+}
+
+// IteratePartialSignatures iterates through partial signatures by the given signing request id
+func (k Keeper) IteratePartialSignatures(ctx sdk.Context, id uint64, cb func(partialSig *types.PartialSignature) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.PartialSignatureKeyPrefix, sdk.Uint64ToBigEndian(id)...))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var partialSig types.PartialSignature
+		k.cdc.MustUnmarshal(iterator.Value(), &partialSig)
+
+		if cb(&partialSig) {
+			break
+		}
+	}
+}
+
+// SetSignerSet sets the given signer set
+func (k Keeper) SetSignerSet(ctx sdk.Context, signerSet *types.SignerSet) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(signerSet)
+	store.Set(types.SignerSetKey(signerSet.Id), bz)
+}
+
+// HasSignerSet returns true if the signer set of the given signing request exists, false otherwise
+func (k Keeper) HasSignerSet(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.SignerSetKey(id))
+}
+
+// GetSignerSet gets the signer set of the given signing request
+// Nil is returned if the signer set does not exist
+func (k Keeper) GetSignerSet(ctx sdk.Context, id uint64) *types.SignerSet {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SignerSetKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var signerSet types.SignerSet
+	k.cdc.MustUnmarshal(bz, &signerSet)
+
+	return &signerSet
+}
+
+// SetVerificationShares sets the given verification shares
+func (k Keeper) SetVerificationShares(ctx sdk.Context, verificationShares *types.VerificationShares) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(verificationShares)
+	store.Set(types.VerificationSharesKey(verificationShares.DkgId), bz)
+}
+
+// GetVerificationShares gets the verification shares of the given DKG
+// Nil is returned if the verification shares do not exist
+func (k Keeper) GetVerificationShares(ctx sdk.Context, dkgId uint64) *types.VerificationShares {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.VerificationSharesKey(dkgId))
+	if bz == nil {
+		return nil
+	}
+
+	var verificationShares types.VerificationShares
+	k.cdc.MustUnmarshal(bz, &verificationShares)
+
+	return &verificationShares
+}
+
+// RemoveVerificationShares removes the verification shares of the given DKG
+func (k Keeper) RemoveVerificationShares(ctx sdk.Context, dkgId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.VerificationSharesKey(dkgId))
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// ScheduleParticipantSetUpdate schedules the participant set update after the configured delay
+// No-op if the participant set is not maintained automatically or the update is already scheduled
+func (k Keeper) ScheduleParticipantSetUpdate(ctx sdk.Context) {
+	params := k.ParticipantSetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	if !k.GetParticipantSetUpdateTime(ctx).IsZero() {
+		return
+	}
+
+	k.SetParticipantSetUpdateTime(ctx, ctx.BlockTime().Add(params.UpdateDelay))
+}
+
+// HandleParticipantSetUpdate updates the participant set if the scheduled update time is reached
+func (k Keeper) HandleParticipantSetUpdate(ctx sdk.Context) {
+	if !k.ParticipantSetParams(ctx).Enabled {
+		return
+	}
+
+	updateTime := k.GetParticipantSetUpdateTime(ctx)
+	if !k.HasParticipantSet(ctx) {
+		// initialize the participant set immediately
+		updateTime = ctx.BlockTime()
+	}
+
+	if updateTime.IsZero() || ctx.BlockTime().Before(updateTime) {
+		return
+	}
+
+	k.RemoveParticipantSetUpdateTime(ctx)
+
+	if err := k.UpdateParticipantSet(ctx); err != nil {
+		k.Logger(ctx).Warn("Failed to update participant set", "err", err)
+	}
+}
+
+// UpdateParticipantSet updates the participant set with the top bonded validators by power
+// The managed DKGs are refreshed to remove the departed participants or reshared if the drift threshold is reached
+func (k Keeper) UpdateParticipantSet(ctx sdk.Context) error {
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := k.ParticipantSetParams(ctx)
+
+	participants := []types.DKGParticipant{}
+	for _, validator := range validators {
+		if len(participants) >= int(params.MaxParticipants) {
+			break
+		}
+
+		pubKey, err := validator.ConsPubKey()
+		if err != nil {
+			continue
+		}
+
+		// only the ed25519 consensus pub key is supported
+		if _, ok := pubKey.(*ed25519.PubKey); !ok {
+			continue
+		}
+
+		participants = append(participants, types.DKGParticipant{
+			Moniker:         validator.Description.Moniker,
+			ConsensusPubkey: base64.StdEncoding.EncodeToString(pubKey.Bytes()),
+		})
+	}
+
+	participantSet := &types.ParticipantSet{
+		Participants: participants,
+		Height:       ctx.BlockHeight(),
+	}
+
+	k.SetParticipantSet(ctx, participantSet)
+
+	consensusPubKeys := participantSet.ConsensusPubKeys()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantSetUpdated,
+			sdk.NewAttribute(types.AttributeKeyParticipants, strings.Join(consensusPubKeys, types.AttributeValueSeparator)),
+		),
+	)
+
+	k.refreshManagedDKGs(ctx, consensusPubKeys, params)
+
+	return nil
+}
+
+// refreshManagedDKGs initiates the refreshing requests for the managed DKGs according to the given participant set
+func (k Keeper) refreshManagedDKGs(ctx sdk.Context, participants []string, params types.ParticipantSetParams) {
+	if len(params.ManagedDkgs) == 0 {
+		return
+	}
+
+	dkgIdsInRefreshing := make(map[uint64]bool)
+	for _, req := range k.GetPendingRefreshingRequests(ctx) {
+		dkgIdsInRefreshing[req.DkgId] = true
+	}
+
+	k.IterateDKGRequestsByStatus(ctx, types.DKGStatus_DKG_STATUS_COMPLETED, func(req *types.DKGRequest) (stop bool) {
+		if !params.IsManagedDKG(req.Module, req.Type) || dkgIdsInRefreshing[req.Id] {
+			return false
+		}
+
+		removedParticipants := []string{}
+		for _, p := range req.Participants {
+			if !slices.Contains(participants, p) {
+				removedParticipants = append(removedParticipants, p)
+			}
+		}
+
+		addedParticipants := []string{}
+		if params.DriftThreshold > 0 {
+			for _, p := range participants {
+				if !slices.Contains(req.Participants, p) {
+					addedParticipants = append(addedParticipants, p)
+				}
+			}
+
+			if len(addedParticipants) < int(params.DriftThreshold) {
+				addedParticipants = []string{}
+			}
+		}
+
+		if len(removedParticipants) == 0 && len(addedParticipants) == 0 {
+			return false
+		}
+
+		// the remaining participants must be sufficient to reconstruct the key shares
+		remainingParticipantNum := len(req.Participants) - len(removedParticipants)
+		if remainingParticipantNum < int(req.Threshold) {
+			k.Logger(ctx).Warn("Insufficient remaining participants to refresh dkg", "id", req.Id, "remaining", remainingParticipantNum, "threshold", req.Threshold)
+			return false
+		}
+
+		participantNum := remainingParticipantNum + len(addedParticipants)
+		if types.CheckDKGParticipantNum(participantNum) != nil {
+			return false
+		}
+
+		k.InitiateRefreshingRequest(ctx, req.Id, removedParticipants, addedParticipants, types.GetDKGThreshold(participantNum, req.Threshold), k.DKGTimeoutDuration(ctx))
+
+		return false
+	})
+}
+
+// SetParticipantSet sets the given participant set
+func (k Keeper) SetParticipantSet(ctx sdk.Context, participantSet *types.ParticipantSet) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(participantSet)
+	store.Set(types.ParticipantSetKey, bz)
+}
+
+// HasParticipantSet returns true if the participant set exists, false otherwise
+func (k Keeper) HasParticipantSet(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.ParticipantSetKey)
+}
+
+// GetParticipantSet gets the participant set
+// Nil is returned if the participant set does not exist
+func (k Keeper) GetParticipantSet(ctx sdk.Context) *types.ParticipantSet {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParticipantSetKey)
+	if bz == nil {
+		return nil
+	}
+
+	var participantSet types.ParticipantSet
+	k.cdc.MustUnmarshal(bz, &participantSet)
+
+	return &participantSet
+}
+
+// SetParticipantSetUpdateTime sets the scheduled participant set update time
+func (k Keeper) SetParticipantSetUpdateTime(ctx sdk.Context, updateTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ParticipantSetUpdateTimeKey, sdk.FormatTimeBytes(updateTime))
+}
+
+// GetParticipantSetUpdateTime gets the scheduled participant set update time
+// Zero time is returned if not scheduled
+func (k Keeper) GetParticipantSetUpdateTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParticipantSetUpdateTimeKey)
+	if bz == nil {
+		return time.Time{}
+	}
+
+	updateTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse participant set update time: %v", err))
+	}
+
+	return updateTime
+}
+
+// RemoveParticipantSetUpdateTime removes the scheduled participant set update time
+func (k Keeper) RemoveParticipantSetUpdateTime(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ParticipantSetUpdateTimeKey)
+}
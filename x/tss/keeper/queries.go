@@ -72,6 +72,21 @@ func (k Keeper) DKGCompletions(goCtx context.Context, req *types.QueryDKGComplet
 	return &types.QueryDKGCompletionsResponse{Completions: k.GetDKGCompletions(ctx, req.Id)}, nil
 }
 
+func (k Keeper) SigningAcknowledgements(goCtx context.Context, req *types.QuerySigningAcknowledgementsRequest) (*types.QuerySigningAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acknowledgements, pagination, err := k.GetSigningAcknowledgementsWithPagination(ctx, req.Id, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningAcknowledgementsResponse{Acknowledgements: acknowledgements, Pagination: pagination}, nil
+}
+
 func (k Keeper) SigningRequest(goCtx context.Context, req *types.QuerySigningRequestRequest) (*types.QuerySigningRequestResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -110,6 +125,42 @@ func (k Keeper) SigningRequests(goCtx context.Context, req *types.QuerySigningRe
 	return &types.QuerySigningRequestsResponse{Requests: signingRequests, Pagination: pagination}, nil
 }
 
+func (k Keeper) SigningBatch(goCtx context.Context, req *types.QuerySigningBatchRequest) (*types.QuerySigningBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSigningBatch(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "signing batch does not exist")
+	}
+
+	batch := k.GetSigningBatch(ctx, req.Id)
+
+	requests := []*types.SigningRequest{}
+	for _, id := range batch.SigningIds {
+		requests = append(requests, k.GetSigningRequest(ctx, id))
+	}
+
+	return &types.QuerySigningBatchResponse{Batch: batch, Requests: requests}, nil
+}
+
+func (k Keeper) SigningBatches(goCtx context.Context, req *types.QuerySigningBatchesRequest) (*types.QuerySigningBatchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	batches, pagination, err := k.GetSigningBatchesWithPagination(ctx, req.Status, req.Module, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningBatchesResponse{Batches: batches, Pagination: pagination}, nil
+}
+
 func (k Keeper) RefreshingRequest(goCtx context.Context, req *types.QueryRefreshingRequestRequest) (*types.QueryRefreshingRequestResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -143,3 +194,216 @@ func (k Keeper) RefreshingCompletions(goCtx context.Context, req *types.QueryRef
 
 	return &types.QueryRefreshingCompletionsResponse{Completions: k.GetRefreshingCompletions(ctx, req.Id)}, nil
 }
+
+func (k Keeper) ParticipantReliability(goCtx context.Context, req *types.QueryParticipantReliabilityRequest) (*types.QueryParticipantReliabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasParticipantReliability(ctx, req.ConsensusPubkey) {
+		return nil, status.Error(codes.NotFound, "participant reliability does not exist")
+	}
+
+	reliability := k.GetParticipantReliability(ctx, req.ConsensusPubkey)
+
+	return &types.QueryParticipantReliabilityResponse{
+		Reliability: reliability,
+		Validator:   k.GetParticipantValidator(ctx, req.ConsensusPubkey),
+		Score:       reliability.Score().String(),
+	}, nil
+}
+
+func (k Keeper) ParticipantReliabilities(goCtx context.Context, req *types.QueryParticipantReliabilitiesRequest) (*types.QueryParticipantReliabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reliabilities, pagination, err := k.GetParticipantReliabilitiesWithPagination(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParticipantReliabilitiesResponse{Reliabilities: reliabilities, Pagination: pagination}, nil
+}
+
+func (k Keeper) ParticipantHealth(goCtx context.Context, req *types.QueryParticipantHealthRequest) (*types.QueryParticipantHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := types.GetConsAddress(req.ConsensusPubkey); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consensus pub key")
+	}
+
+	reliability := k.GetParticipantReliability(ctx, req.ConsensusPubkey)
+
+	return &types.QueryParticipantHealthResponse{
+		Reliability:           reliability,
+		Validator:             k.GetParticipantValidator(ctx, req.ConsensusPubkey),
+		CompletionRate:        reliability.Score().String(),
+		AverageSigningLatency: reliability.AverageSigningLatency(),
+		ActiveKeys:            k.GetParticipantKeys(ctx, req.ConsensusPubkey),
+		PendingSigningIds:     k.GetParticipantPendingSigningIds(ctx, req.ConsensusPubkey),
+	}, nil
+}
+
+func (k Keeper) SigningStats(goCtx context.Context, req *types.QuerySigningStatsRequest) (*types.QuerySigningStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySigningStatsResponse{Stats: k.GetSigningStats(ctx, req.Module)}, nil
+}
+
+func (k Keeper) ParticipantSet(goCtx context.Context, req *types.QueryParticipantSetRequest) (*types.QueryParticipantSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParticipantSetResponse{
+		ParticipantSet: k.GetParticipantSet(ctx),
+		UpdateTime:     k.GetParticipantSetUpdateTime(ctx),
+	}, nil
+}
+
+func (k Keeper) CommittedNonces(goCtx context.Context, req *types.QueryCommittedNoncesRequest) (*types.QueryCommittedNoncesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nonces, pagination, err := k.GetCommittedNoncesWithPagination(ctx, req.DkgId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pendingGenerationId := k.GetNonceGeneration(ctx, req.DkgId)
+	if pendingGenerationId != 0 && k.GetDKGRequest(ctx, pendingGenerationId).Status != types.DKGStatus_DKG_STATUS_PENDING {
+		pendingGenerationId = 0
+	}
+
+	return &types.QueryCommittedNoncesResponse{Nonces: nonces, PendingGenerationId: pendingGenerationId, Pagination: pagination}, nil
+}
+
+func (k Keeper) PartialSignatures(goCtx context.Context, req *types.QueryPartialSignaturesRequest) (*types.QueryPartialSignaturesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	partialSigs, pagination, err := k.GetPartialSignaturesWithPagination(ctx, req.Id, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPartialSignaturesResponse{PartialSignatures: partialSigs, Pagination: pagination}, nil
+}
+
+func (k Keeper) SignerSet(goCtx context.Context, req *types.QuerySignerSetRequest) (*types.QuerySignerSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSignerSet(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "signer set does not exist")
+	}
+
+	return &types.QuerySignerSetResponse{SignerSet: k.GetSignerSet(ctx, req.Id)}, nil
+}
+
+func (k Keeper) SigningFee(goCtx context.Context, req *types.QuerySigningFeeRequest) (*types.QuerySigningFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSigningFee(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "signing fee does not exist")
+	}
+
+	return &types.QuerySigningFeeResponse{Fee: k.GetSigningFee(ctx, req.Id)}, nil
+}
+
+func (k Keeper) ParticipantRewards(goCtx context.Context, req *types.QueryParticipantRewardsRequest) (*types.QueryParticipantRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParticipantRewardsResponse{
+		Rewards:   k.GetParticipantRewards(ctx, req.ConsensusPubkey),
+		Validator: k.GetParticipantValidator(ctx, req.ConsensusPubkey),
+	}, nil
+}
+
+func (k Keeper) AllParticipantRewards(goCtx context.Context, req *types.QueryAllParticipantRewardsRequest) (*types.QueryAllParticipantRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, pagination, err := k.GetParticipantRewardsWithPagination(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllParticipantRewardsResponse{Rewards: rewards, Pagination: pagination}, nil
+}
+
+func (k Keeper) KeyRotations(goCtx context.Context, req *types.QueryKeyRotationsRequest) (*types.QueryKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryKeyRotationsResponse{Rotations: k.GetKeyRotations(ctx, req.Module)}, nil
+}
+
+func (k Keeper) GovSignatureRequest(goCtx context.Context, req *types.QueryGovSignatureRequestRequest) (*types.QueryGovSignatureRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasGovSignatureRequest(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "gov signature request does not exist")
+	}
+
+	request := k.GetGovSignatureRequest(ctx, req.Id)
+
+	return &types.QueryGovSignatureRequestResponse{Request: request, SigningRequest: k.GetSigningRequest(ctx, request.SigningId)}, nil
+}
+
+func (k Keeper) GovSignatureRequests(goCtx context.Context, req *types.QueryGovSignatureRequestsRequest) (*types.QueryGovSignatureRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	requests, pagination, err := k.GetGovSignatureRequestsWithPagination(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGovSignatureRequestsResponse{Requests: requests, Pagination: pagination}, nil
+}
@@ -60,6 +60,7 @@ func (k Keeper) GetRefreshingRequest(ctx sdk.Context, id uint64) *types.Refreshi
 }
 
 // GetRefreshingParticipants gets all participants of the given refreshing request
+// i.e. the remaining participants of the DKG followed by the added participants
 func (k Keeper) GetRefreshingParticipants(ctx sdk.Context, refreshingRequest *types.RefreshingRequest) []string {
 	dkgReq := k.GetDKGRequest(ctx, refreshingRequest.DkgId)
 
@@ -70,9 +71,77 @@ func (k Keeper) GetRefreshingParticipants(ctx sdk.Context, refreshingRequest *ty
 		}
 	}
 
+	for _, p := range refreshingRequest.AddedParticipants {
+		if !slices.Contains(participants, p) {
+			participants = append(participants, p)
+		}
+	}
+
 	return participants
 }
 
+// GetRefreshingCompletionSigMsg gets the msg to be signed for the completion of the given refreshing request
+// The new participant set and threshold are committed to in case of resharing
+func (k Keeper) GetRefreshingCompletionSigMsg(ctx sdk.Context, refreshingRequest *types.RefreshingRequest, verificationShares []string) []byte {
+	pubKeys := k.GetDKGPubKeys(ctx, refreshingRequest.DkgId)
+
+	if !k.IsResharing(ctx, refreshingRequest) {
+		return types.GetRefreshingCompletionSigMsg(refreshingRequest.Id, pubKeys, verificationShares)
+	}
+
+	return types.GetResharingCompletionSigMsg(refreshingRequest.Id, pubKeys, k.GetRefreshingParticipants(ctx, refreshingRequest), refreshingRequest.Threshold, verificationShares)
+}
+
+// GetRefreshingThreshold gets the threshold of the DKG after the given refreshing request is completed
+func (k Keeper) GetRefreshingThreshold(ctx sdk.Context, refreshingRequest *types.RefreshingRequest) uint32 {
+	if refreshingRequest.Threshold != 0 {
+		return refreshingRequest.Threshold
+	}
+
+	return k.GetDKGRequest(ctx, refreshingRequest.DkgId).Threshold
+}
+
+// GetRefreshingVerificationShares gets the verification shares of the refreshed key shares
+// Nil returned if the completions do not agree on the verification shares
+func (k Keeper) GetRefreshingVerificationShares(ctx sdk.Context, refreshingRequest *types.RefreshingRequest) []string {
+	completions := k.GetRefreshingCompletions(ctx, refreshingRequest.Id)
+	if len(completions) == 0 {
+		return nil
+	}
+
+	verificationShares := completions[0].VerificationShares
+
+	for _, completion := range completions[1:] {
+		if !slices.Equal(completion.VerificationShares, verificationShares) {
+			return nil
+		}
+	}
+
+	return verificationShares
+}
+
+// IsResharing returns true if the given refreshing request adds participants or changes the threshold, false otherwise
+func (k Keeper) IsResharing(ctx sdk.Context, refreshingRequest *types.RefreshingRequest) bool {
+	if len(refreshingRequest.AddedParticipants) != 0 {
+		return true
+	}
+
+	return refreshingRequest.Threshold != 0 && refreshingRequest.Threshold != k.GetDKGRequest(ctx, refreshingRequest.DkgId).Threshold
+}
+
+// GetAbsentRefreshingParticipants gets the participants which did not complete the given refreshing request
+func (k Keeper) GetAbsentRefreshingParticipants(ctx sdk.Context, refreshingRequest *types.RefreshingRequest) []string {
+	absentParticipants := []string{}
+
+	for _, participant := range k.GetRefreshingParticipants(ctx, refreshingRequest) {
+		if !k.HasRefreshingCompletion(ctx, refreshingRequest.Id, participant) {
+			absentParticipants = append(absentParticipants, participant)
+		}
+	}
+
+	return absentParticipants
+}
+
 // GetRefreshingRequests gets the refreshing requests by the given status
 func (k Keeper) GetRefreshingRequests(ctx sdk.Context, status types.RefreshingStatus) []*types.RefreshingRequest {
 	requests := make([]*types.RefreshingRequest, 0)
@@ -165,13 +234,16 @@ func (k Keeper) IterateRefreshingCompletions(ctx sdk.Context, id uint64, cb func
 }
 
 // InitiateRefreshingRequest initiates the refreshing request with the specified params
-func (k Keeper) InitiateRefreshingRequest(ctx sdk.Context, dkgId uint64, removedParticipants []string, timeoutDuration time.Duration) *types.RefreshingRequest {
+// The participants are removed and added and the threshold is changed while the group public key remains the same
+func (k Keeper) InitiateRefreshingRequest(ctx sdk.Context, dkgId uint64, removedParticipants []string, addedParticipants []string, threshold uint32, timeoutDuration time.Duration) *types.RefreshingRequest {
 	req := &types.RefreshingRequest{
 		Id:                  k.IncrementRefreshingRequestId(ctx),
 		DkgId:               dkgId,
 		RemovedParticipants: removedParticipants,
 		ExpirationTime:      types.GetExpirationTime(ctx.BlockTime(), timeoutDuration),
 		Status:              types.RefreshingStatus_REFRESHING_STATUS_PENDING,
+		AddedParticipants:   addedParticipants,
+		Threshold:           threshold,
 	}
 
 	k.SetRefreshingRequest(ctx, req)
@@ -182,6 +254,8 @@ func (k Keeper) InitiateRefreshingRequest(ctx sdk.Context, dkgId uint64, removed
 			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
 			sdk.NewAttribute(types.AttributeKeyDKGId, fmt.Sprintf("%d", dkgId)),
 			sdk.NewAttribute(types.AttributeKeyRemovedParticipants, strings.Join(removedParticipants, types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyAddedParticipants, strings.Join(addedParticipants, types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", threshold)),
 			sdk.NewAttribute(types.AttributeKeyExpirationTime, req.ExpirationTime.String()),
 		),
 	)
@@ -191,7 +265,8 @@ func (k Keeper) InitiateRefreshingRequest(ctx sdk.Context, dkgId uint64, removed
 
 // CompleteRefreshing completes the refreshing request by the participant
 // The refreshing request will be finalized when all participants submit valid completions before timeout
-func (k Keeper) CompleteRefreshing(ctx sdk.Context, sender string, id uint64, consensusPubKey string, signature string) error {
+// The optional verification shares of the refreshed key shares enable the on chain aggregation of the partial signatures
+func (k Keeper) CompleteRefreshing(ctx sdk.Context, sender string, id uint64, consensusPubKey string, signature string, verificationShares []string) error {
 	if !k.HasRefreshingRequest(ctx, id) {
 		return types.ErrRefreshingRequestDoesNotExist
 	}
@@ -213,15 +288,30 @@ func (k Keeper) CompleteRefreshing(ctx sdk.Context, sender string, id uint64, co
 		return types.ErrRefreshingCompletionAlreadyExists
 	}
 
-	if !types.VerifySignature(signature, consensusPubKey, types.GetRefreshingCompletionSigMsg(id, k.GetDKGPubKeys(ctx, refreshingRequest.DkgId))) {
+	if len(verificationShares) != 0 {
+		dkgRequest := k.GetDKGRequest(ctx, refreshingRequest.DkgId)
+		if dkgRequest.KeyType != types.KeyType_KEY_TYPE_SCHNORR {
+			return errorsmod.Wrap(types.ErrInvalidVerificationShares, "verification shares only supported for schnorr keys")
+		}
+
+		participantNum := len(k.GetRefreshingParticipants(ctx, refreshingRequest))
+		threshold := k.GetRefreshingThreshold(ctx, refreshingRequest)
+
+		if err := types.CheckVerificationShares(k.GetDKGPubKeys(ctx, refreshingRequest.DkgId), participantNum, threshold, verificationShares); err != nil {
+			return err
+		}
+	}
+
+	if !types.VerifySignature(signature, consensusPubKey, k.GetRefreshingCompletionSigMsg(ctx, refreshingRequest, verificationShares)) {
 		return types.ErrInvalidSignature
 	}
 
 	completion := &types.RefreshingCompletion{
-		Id:              id,
-		Sender:          sender,
-		ConsensusPubkey: consensusPubKey,
-		Signature:       signature,
+		Id:                 id,
+		Sender:             sender,
+		ConsensusPubkey:    consensusPubKey,
+		Signature:          signature,
+		VerificationShares: verificationShares,
 	}
 
 	k.SetRefreshingCompletion(ctx, completion)
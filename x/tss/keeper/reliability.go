@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// HandleParticipation records the completions and faults of the given participants for the specified round type
+// Faults are not recorded if all participants are absent, which is likely caused by the network wide issue
+func (k Keeper) HandleParticipation(ctx sdk.Context, faultType types.FaultType, participants []string, absentParticipants []string) {
+	allAbsent := len(absentParticipants) == len(participants)
+
+	for _, participant := range participants {
+		reliability := k.GetParticipantReliability(ctx, participant)
+
+		if !slices.Contains(absentParticipants, participant) {
+			reliability.RecordCompletion(faultType, ctx.BlockHeight())
+			k.SetParticipantReliability(ctx, reliability)
+
+			continue
+		}
+
+		if allAbsent {
+			continue
+		}
+
+		reliability.RecordFault(faultType, ctx.BlockHeight())
+		k.SetParticipantReliability(ctx, reliability)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParticipantFault,
+				sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+				sdk.NewAttribute(types.AttributeKeyFaultType, faultType.String()),
+				sdk.NewAttribute(types.AttributeKeyPendingFaults, fmt.Sprintf("%d", reliability.PendingFaults)),
+			),
+		)
+
+		maxFaults := k.GetParams(ctx).ParticipantPenaltyParams.MaxFaults
+		if maxFaults == 0 || reliability.PendingFaults < uint64(maxFaults) {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.penalizeParticipant(cacheCtx, reliability); err != nil {
+			k.Logger(ctx).Warn("Failed to penalize participant", "participant", participant, "err", err)
+			continue
+		}
+
+		write()
+	}
+}
+
+// penalizeParticipant slashes and jails the validator corresponding to the given participant
+// The pending faults are reset no matter whether the participant is an active validator or not
+func (k Keeper) penalizeParticipant(ctx sdk.Context, reliability *types.ParticipantReliability) error {
+	reliability.PendingFaults = 0
+	reliability.PenaltyCount++
+	reliability.LastPenaltyHeight = ctx.BlockHeight()
+
+	k.SetParticipantReliability(ctx, reliability)
+
+	consAddr, err := types.GetConsAddress(reliability.ConsensusPubkey)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		// not a validator
+		return nil
+	}
+
+	if validator.IsJailed() || k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return nil
+	}
+
+	penaltyParams := k.GetParams(ctx).ParticipantPenaltyParams
+
+	slashFraction := penaltyParams.SlashFraction
+	if !slashFraction.IsNil() && slashFraction.IsPositive() {
+		power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+		distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+		if err := k.slashingKeeper.Slash(ctx, consAddr, slashFraction, power, distributionHeight); err != nil {
+			return err
+		}
+	}
+
+	jailUntil := ctx.BlockTime()
+	if penaltyParams.JailDuration > 0 {
+		if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+
+		jailUntil = ctx.BlockTime().Add(penaltyParams.JailDuration)
+		if err := k.slashingKeeper.JailUntil(ctx, consAddr, jailUntil); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantPenalty,
+			sdk.NewAttribute(types.AttributeKeyParticipant, reliability.ConsensusPubkey),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+			sdk.NewAttribute(types.AttributeKeyJailUntil, jailUntil.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetParticipantValidator gets the operator address of the validator corresponding to the given participant
+// Empty string is returned if the validator does not exist
+func (k Keeper) GetParticipantValidator(ctx sdk.Context, consensusPubKey string) string {
+	consAddr, err := types.GetConsAddress(consensusPubKey)
+	if err != nil {
+		return ""
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return ""
+	}
+
+	return validator.OperatorAddress
+}
+
+// SetParticipantReliability sets the given participant reliability
+func (k Keeper) SetParticipantReliability(ctx sdk.Context, reliability *types.ParticipantReliability) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(reliability)
+	store.Set(types.ParticipantReliabilityKey(reliability.ConsensusPubkey), bz)
+}
+
+// HasParticipantReliability returns true if the reliability of the given participant exists, false otherwise
+func (k Keeper) HasParticipantReliability(ctx sdk.Context, consPubKey string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.ParticipantReliabilityKey(consPubKey))
+}
+
+// GetParticipantReliability gets the reliability of the given participant
+// The empty reliability is returned if not exist
+func (k Keeper) GetParticipantReliability(ctx sdk.Context, consPubKey string) *types.ParticipantReliability {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParticipantReliabilityKey(consPubKey))
+	if bz == nil {
+		return types.NewParticipantReliability(consPubKey)
+	}
+
+	var reliability types.ParticipantReliability
+	k.cdc.MustUnmarshal(bz, &reliability)
+
+	return &reliability
+}
+
+// GetAllParticipantReliabilities gets reliabilities of all participants
+func (k Keeper) GetAllParticipantReliabilities(ctx sdk.Context) []*types.ParticipantReliability {
+	reliabilities := make([]*types.ParticipantReliability, 0)
+
+	k.IterateParticipantReliabilities(ctx, func(reliability *types.ParticipantReliability) (stop bool) {
+		reliabilities = append(reliabilities, reliability)
+		return false
+	})
+
+	return reliabilities
+}
+
+// GetParticipantReliabilitiesWithPagination gets reliabilities of all participants with pagination
+func (k Keeper) GetParticipantReliabilitiesWithPagination(ctx sdk.Context, pagination *query.PageRequest) ([]*types.ParticipantReliability, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	reliabilityStore := prefix.NewStore(store, types.ParticipantReliabilityKeyPrefix)
+
+	var reliabilities []*types.ParticipantReliability
+
+	pageRes, err := query.Paginate(reliabilityStore, pagination, func(key []byte, value []byte) error {
+		var reliability types.ParticipantReliability
+		k.cdc.MustUnmarshal(value, &reliability)
+
+		reliabilities = append(reliabilities, &reliability)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reliabilities, pageRes, nil
+}
+
+// IterateParticipantReliabilities iterates through reliabilities of all participants
+func (k Keeper) IterateParticipantReliabilities(ctx sdk.Context, cb func(reliability *types.ParticipantReliability) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ParticipantReliabilityKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reliability types.ParticipantReliability
+		k.cdc.MustUnmarshal(iterator.Value(), &reliability)
+
+		if cb(&reliability) {
+			break
+		}
+	}
+}
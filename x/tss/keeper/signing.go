@@ -201,20 +201,87 @@ func (k Keeper) IterateSigningRequestsByStatus(ctx sdk.Context, status types.Sig
 
 // InitiateSigningRequest initiates the signing request with the specified params
 func (k Keeper) InitiateSigningRequest(ctx sdk.Context, module string, scopedId string, ty types.SigningType, intent int32, pubKey string, sigHashes []string, options *types.SigningOptions) *types.SigningRequest {
+	return k.initiateSigningRequest(ctx, module, scopedId, ty, intent, pubKey, sigHashes, options, 0)
+}
+
+// ReinitiateSigningRequest re-initiates the given timed out signing request with a new request id
+// The new signing request inherits the params of the given one and restarts the timeout
+// The signing request can not be re-initiated any more once the max signing retries reached
+func (k Keeper) ReinitiateSigningRequest(ctx sdk.Context, id uint64) (*types.SigningRequest, error) {
+	if !k.HasSigningRequest(ctx, id) {
+		return nil, types.ErrSigningRequestDoesNotExist
+	}
+
+	req := k.GetSigningRequest(ctx, id)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_TIMEDOUT {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing request not timed out")
+	}
+
+	if maxRetries := k.MaxSigningRetries(ctx); maxRetries > 0 && req.Retries >= maxRetries {
+		return nil, errorsmod.Wrapf(types.ErrMaxSigningRetriesExceeded, "signing request re-initiated %d times", req.Retries)
+	}
+
+	var newReq *types.SigningRequest
+	if req.Options != nil && len(req.Options.Nonces) != 0 {
+		// the committed nonces cannot be reused
+		newReq = k.initiateSigningRequestWithCommittedNonces(ctx, req.Module, req.ScopedId, req.Intent, req.PubKey, req.SigHashes, req.Id)
+	} else {
+		newReq = k.initiateSigningRequest(ctx, req.Module, req.ScopedId, req.Type, req.Intent, req.PubKey, req.SigHashes, req.Options, req.Id)
+	}
+
+	// the escrowed fee is inherited by the new signing request
+	k.TransferSigningFee(ctx, req.Id, newReq.Id)
+
+	return newReq, nil
+}
+
+// InitiateSigningRequestWithCommittedNonces initiates the schnorr signing request with the committed nonces
+// One nonce is consumed from the nonce queue of the DKG for each sig hash, so that the interactive nonce round is not needed
+// Fall back to the common schnorr signing if the committed nonces are insufficient
+func (k Keeper) InitiateSigningRequestWithCommittedNonces(ctx sdk.Context, module string, scopedId string, intent int32, pubKey string, sigHashes []string) *types.SigningRequest {
+	return k.initiateSigningRequestWithCommittedNonces(ctx, module, scopedId, intent, pubKey, sigHashes, 0)
+}
+
+// initiateSigningRequestWithCommittedNonces initiates the schnorr signing request with the committed nonces
+func (k Keeper) initiateSigningRequestWithCommittedNonces(ctx sdk.Context, module string, scopedId string, intent int32, pubKey string, sigHashes []string, previousId uint64) *types.SigningRequest {
+	if dkgRequest := k.GetDKGRequestByPubKey(ctx, pubKey); dkgRequest != nil {
+		nonces, err := k.ConsumeNonces(ctx, dkgRequest.Id, len(sigHashes))
+		if err == nil {
+			return k.initiateSigningRequest(ctx, module, scopedId, types.SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT, intent, pubKey, sigHashes, &types.SigningOptions{Nonces: nonces}, previousId)
+		}
+	}
+
+	return k.initiateSigningRequest(ctx, module, scopedId, types.SigningType_SIGNING_TYPE_SCHNORR, intent, pubKey, sigHashes, nil, previousId)
+}
+
+// initiateSigningRequest initiates the signing request with the specified params
+func (k Keeper) initiateSigningRequest(ctx sdk.Context, module string, scopedId string, ty types.SigningType, intent int32, pubKey string, sigHashes []string, options *types.SigningOptions, previousId uint64) *types.SigningRequest {
 	req := &types.SigningRequest{
-		Id:           k.IncrementSigningRequestId(ctx),
-		Module:       module,
-		ScopedId:     scopedId,
-		Type:         ty,
-		Intent:       intent,
-		PubKey:       pubKey,
-		SigHashes:    sigHashes,
-		Options:      options,
-		CreationTime: ctx.BlockTime(),
-		Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+		Id:             k.IncrementSigningRequestId(ctx),
+		Module:         module,
+		ScopedId:       scopedId,
+		Type:           ty,
+		Intent:         intent,
+		PubKey:         pubKey,
+		SigHashes:      sigHashes,
+		Options:        options,
+		CreationTime:   ctx.BlockTime(),
+		Status:         types.SigningStatus_SIGNING_STATUS_PENDING,
+		ExpirationTime: types.GetExpirationTime(ctx.BlockTime(), k.SigningTimeoutDuration(ctx, module)),
+		PreviousId:     previousId,
+	}
+
+	if previousId != 0 {
+		req.Retries = k.GetSigningRequest(ctx, previousId).Retries + 1
 	}
 
 	k.SetSigningRequest(ctx, req)
+	k.UpdateSigningStats(ctx, req)
+
+	// the re-initiated signing request inherits the escrowed fee instead
+	if previousId == 0 {
+		k.attachModuleSigningFee(ctx, req)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -227,12 +294,201 @@ func (k Keeper) InitiateSigningRequest(ctx sdk.Context, module string, scopedId
 			sdk.NewAttribute(types.AttributeKeyPubKey, pubKey),
 			sdk.NewAttribute(types.AttributeKeySigHashes, strings.Join(sigHashes, types.AttributeValueSeparator)),
 			sdk.NewAttribute(types.AttributeKeyOption, types.GetSigningOption(ty, options)),
+			sdk.NewAttribute(types.AttributeKeyExpirationTime, req.ExpirationTime.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousId, fmt.Sprintf("%d", previousId)),
 		),
 	)
 
 	return req
 }
 
+// CompleteSigningRequest completes the given signing request with the verified signatures
+// The given signers are recorded as the signer set of the signing request
+// The module handler is not called for the batched signing request until the whole batch is signed
+func (k Keeper) CompleteSigningRequest(ctx sdk.Context, sender string, req *types.SigningRequest, signatures []string, signers []string, aggregated bool) error {
+	if req.BatchId != 0 {
+		if err := k.completeBatchedSigningRequest(ctx, sender, req, signatures); err != nil {
+			return err
+		}
+	} else {
+		// callback to the module handler
+		if err := k.GetSigningRequestCompletedHandler(req.Module)(ctx, sender, req.Id, req.ScopedId, req.Type, req.Intent, req.PubKey, signatures); err != nil {
+			return err
+		}
+	}
+
+	req.Status = types.SigningStatus_SIGNING_STATUS_SIGNED
+	k.SetSigningRequest(ctx, req)
+
+	k.SetSignerSet(ctx, &types.SignerSet{
+		Id:         req.Id,
+		Signers:    signers,
+		Aggregated: aggregated,
+	})
+
+	// record completions of the signers
+	k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_SIGNING, signers, []string{})
+
+	// record the signing latency
+	k.UpdateSigningStats(ctx, req)
+	k.RecordSigningLatency(ctx, req, signers)
+
+	// distribute the escrowed fee if any
+	k.DistributeSigningFee(ctx, req, signers)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteSigning,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeySigners, strings.Join(signers, types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyAggregated, fmt.Sprintf("%t", aggregated)),
+		),
+	)
+
+	return nil
+}
+
+// AcknowledgeSigning acknowledges the participation in the given signing request by the participant
+func (k Keeper) AcknowledgeSigning(ctx sdk.Context, sender string, id uint64, consensusPubKey string, signature string) error {
+	if !k.HasSigningRequest(ctx, id) {
+		return types.ErrSigningRequestDoesNotExist
+	}
+
+	req := k.GetSigningRequest(ctx, id)
+	if req.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing request non pending")
+	}
+
+	if !req.ExpirationTime.IsZero() && !ctx.BlockTime().Before(req.ExpirationTime) {
+		return types.ErrSigningRequestExpired
+	}
+
+	if !types.ParticipantExists(k.GetSigningParticipants(ctx, req), consensusPubKey) {
+		return types.ErrUnauthorizedParticipant
+	}
+
+	if k.HasSigningAcknowledgement(ctx, id, consensusPubKey) {
+		return types.ErrSigningAcknowledgementAlreadyExists
+	}
+
+	if !types.VerifySignature(signature, consensusPubKey, types.GetSigningAcknowledgementSigMsg(id, req.SigHashes)) {
+		return types.ErrInvalidSignature
+	}
+
+	acknowledgement := &types.SigningAcknowledgement{
+		Id:              id,
+		Sender:          sender,
+		ConsensusPubkey: consensusPubKey,
+		Signature:       signature,
+	}
+
+	k.SetSigningAcknowledgement(ctx, acknowledgement)
+
+	return nil
+}
+
+// GetSigningParticipants gets the participants of the DKG which generated the pub key of the given signing request
+func (k Keeper) GetSigningParticipants(ctx sdk.Context, req *types.SigningRequest) []string {
+	dkgRequest := k.GetDKGRequestByPubKey(ctx, req.PubKey)
+	if dkgRequest == nil {
+		return []string{}
+	}
+
+	return dkgRequest.Participants
+}
+
+// GetAbsentSigningParticipants gets the participants which neither acknowledged the given signing request nor submitted the partial signatures
+func (k Keeper) GetAbsentSigningParticipants(ctx sdk.Context, req *types.SigningRequest) []string {
+	absentParticipants := []string{}
+
+	for _, participant := range k.GetSigningParticipants(ctx, req) {
+		if !k.HasSigningAcknowledgement(ctx, req.Id, participant) && !k.HasPartialSignature(ctx, req.Id, participant) {
+			absentParticipants = append(absentParticipants, participant)
+		}
+	}
+
+	return absentParticipants
+}
+
+// GetAcknowledgedSigningParticipants gets the participants which acknowledged the given signing request
+func (k Keeper) GetAcknowledgedSigningParticipants(ctx sdk.Context, id uint64) []string {
+	participants := []string{}
+
+	k.IterateSigningAcknowledgements(ctx, id, func(acknowledgement *types.SigningAcknowledgement) (stop bool) {
+		participants = append(participants, acknowledgement.ConsensusPubkey)
+		return false
+	})
+
+	return participants
+}
+
+// SetSigningAcknowledgement sets the given signing acknowledgement
+func (k Keeper) SetSigningAcknowledgement(ctx sdk.Context, acknowledgement *types.SigningAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(acknowledgement)
+	store.Set(types.SigningAcknowledgementKey(acknowledgement.Id, acknowledgement.ConsensusPubkey), bz)
+}
+
+// HasSigningAcknowledgement returns true if the given signing acknowledgement exists, false otherwise
+func (k Keeper) HasSigningAcknowledgement(ctx sdk.Context, id uint64, consPubKey string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.SigningAcknowledgementKey(id, consPubKey))
+}
+
+// GetSigningAcknowledgements gets signing acknowledgements by the given signing request id
+func (k Keeper) GetSigningAcknowledgements(ctx sdk.Context, id uint64) []*types.SigningAcknowledgement {
+	acknowledgements := make([]*types.SigningAcknowledgement, 0)
+
+	k.IterateSigningAcknowledgements(ctx, id, func(acknowledgement *types.SigningAcknowledgement) (stop bool) {
+		acknowledgements = append(acknowledgements, acknowledgement)
+		return false
+	})
+
+	return acknowledgements
+}
+
+// GetSigningAcknowledgementsWithPagination gets signing acknowledgements by the given signing request id with pagination
+func (k Keeper) GetSigningAcknowledgementsWithPagination(ctx sdk.Context, id uint64, pagination *query.PageRequest) ([]*types.SigningAcknowledgement, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	acknowledgementStore := prefix.NewStore(store, append(types.SigningAcknowledgementKeyPrefix, sdk.Uint64ToBigEndian(id)...))
+
+	var acknowledgements []*types.SigningAcknowledgement
+
+	pageRes, err := query.Paginate(acknowledgementStore, pagination, func(key []byte, value []byte) error {
+		var acknowledgement types.SigningAcknowledgement
+		k.cdc.MustUnmarshal(value, &acknowledgement)
+
+		acknowledgements = append(acknowledgements, &acknowledgement)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return acknowledgements, pageRes, nil
+}
+
+// IterateSigningAcknowledgements iterates through signing acknowledgements by the given signing request id
+func (k Keeper) IterateSigningAcknowledgements(ctx sdk.Context, id uint64, cb func(acknowledgement *types.SigningAcknowledgement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.SigningAcknowledgementKeyPrefix, sdk.Uint64ToBigEndian(id)...))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var acknowledgement types.SigningAcknowledgement
+		k.cdc.MustUnmarshal(iterator.Value(), &acknowledgement)
+
+		if cb(&acknowledgement) {
+			break
+		}
+	}
+}
+
 // VerifySignatures verifies the given signatures against the specified signing request
 // Assume that the signing request is valid and signatures are hex encoded
 func (k Keeper) VerifySignatures(ctx sdk.Context, signingRequest *types.SigningRequest, signatures []string) error {
@@ -276,6 +532,10 @@ func (k Keeper) VerifySignatures(ctx sdk.Context, signingRequest *types.SigningR
 			}
 
 			nonce, _ := hex.DecodeString(options.Nonce)
+			if len(options.Nonces) != 0 {
+				nonce, _ = hex.DecodeString(options.Nonces[i])
+			}
+
 			if !bytes.Equal(sigBytes[:32], nonce) {
 				return errorsmod.Wrap(types.ErrInvalidSignature, "invalid signature r")
 			}
@@ -293,6 +553,15 @@ func (k Keeper) VerifySignatures(ctx sdk.Context, signingRequest *types.SigningR
 			if !adaptor.Verify(sigBytes, sigHash, pubKey, adaptorPoint) {
 				return errorsmod.Wrap(types.ErrInvalidSignature, "invalid schnorr adaptor signature")
 			}
+
+		case types.SigningType_SIGNING_TYPE_ECDSA:
+			if len(sigBytes) < types.MinECDSASignatureSize || len(sigBytes) > types.MaxECDSASignatureSize {
+				return errorsmod.Wrap(types.ErrInvalidSignature, "invalid ecdsa signature size")
+			}
+
+			if !types.VerifyECDSASignature(sigBytes, sigHash, pubKey) {
+				return errorsmod.Wrap(types.ErrInvalidSignature, "invalid ecdsa signature")
+			}
 		}
 	}
 
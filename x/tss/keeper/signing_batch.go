@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// InitiateSigningBatch initiates the signing batch which groups the signing requests of the given items with a shared deadline
+// The batch completed handler of the module is called once when all signing requests of the batch are signed
+// The batch is an opt-in alternative for the modules which initiate several signing requests at once
+// Each signing request is scoped by the item scoped id if any, or by the batch scoped id otherwise
+func (k Keeper) InitiateSigningBatch(ctx sdk.Context, module string, scopedId string, intent int32, items []*types.SigningBatchItem) *types.SigningBatch {
+	batch := k.newSigningBatch(ctx, module, scopedId, intent, 0)
+
+	for _, item := range items {
+		itemScopedId := scopedId
+		if len(item.ScopedId) != 0 {
+			itemScopedId = item.ScopedId
+		}
+
+		req := k.initiateSigningRequest(ctx, module, itemScopedId, item.Type, intent, item.PubKey, item.SigHashes, item.Options, 0)
+		k.addToSigningBatch(ctx, batch, req)
+	}
+
+	k.SetSigningBatch(ctx, batch)
+	k.emitInitiateSigningBatchEvent(ctx, batch)
+
+	return batch
+}
+
+// ReinitiateSigningBatch re-initiates the given timed out signing batch with a new batch id
+// The unsigned requests are re-initiated and the signatures of the signed ones are inherited by the new batch
+func (k Keeper) ReinitiateSigningBatch(ctx sdk.Context, id uint64) (*types.SigningBatch, error) {
+	if !k.HasSigningBatch(ctx, id) {
+		return nil, types.ErrSigningBatchDoesNotExist
+	}
+
+	batch := k.GetSigningBatch(ctx, id)
+	if batch.Status != types.SigningStatus_SIGNING_STATUS_TIMEDOUT {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing batch not timed out")
+	}
+
+	newBatch := k.newSigningBatch(ctx, batch.Module, batch.ScopedId, batch.Intent, batch.Id)
+	newBatch.Signatures = batch.Signatures
+
+	for _, signingId := range batch.SigningIds {
+		if k.GetSigningRequest(ctx, signingId).Status == types.SigningStatus_SIGNING_STATUS_SIGNED {
+			newBatch.SigningIds = append(newBatch.SigningIds, signingId)
+			continue
+		}
+
+		req, err := k.ReinitiateSigningRequest(ctx, signingId)
+		if err != nil {
+			return nil, err
+		}
+
+		k.addToSigningBatch(ctx, newBatch, req)
+	}
+
+	k.SetSigningBatch(ctx, newBatch)
+	k.emitInitiateSigningBatchEvent(ctx, newBatch)
+
+	return newBatch, nil
+}
+
+// SubmitBatchSignatures submits the signatures for all pending signing requests of the given batch
+func (k Keeper) SubmitBatchSignatures(ctx sdk.Context, sender string, id uint64, batchSignatures []*types.BatchSignatures) error {
+	if !k.HasSigningBatch(ctx, id) {
+		return types.ErrSigningBatchDoesNotExist
+	}
+
+	batch := k.GetSigningBatch(ctx, id)
+	if batch.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing batch non pending")
+	}
+
+	pendingRequests := make(map[uint64]*types.SigningRequest)
+	for _, signingId := range batch.SigningIds {
+		req := k.GetSigningRequest(ctx, signingId)
+		if req.Status == types.SigningStatus_SIGNING_STATUS_PENDING {
+			pendingRequests[signingId] = req
+		}
+	}
+
+	if len(batchSignatures) != len(pendingRequests) {
+		return errorsmod.Wrap(types.ErrInvalidSignatures, "signatures do not match pending signing requests")
+	}
+
+	// verify all signatures before completing any signing request
+	for _, signatures := range batchSignatures {
+		req, ok := pendingRequests[signatures.SigningId]
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidSignatures, "signing request %d not pending in the batch", signatures.SigningId)
+		}
+
+		if err := k.VerifySignatures(ctx, req, signatures.Signatures); err != nil {
+			return err
+		}
+	}
+
+	for _, signatures := range batchSignatures {
+		req := pendingRequests[signatures.SigningId]
+
+		// the participants which acknowledged the signing request are regarded as the signers
+		if err := k.CompleteSigningRequest(ctx, sender, req, signatures.Signatures, k.GetAcknowledgedSigningParticipants(ctx, req.Id), false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// completeBatchedSigningRequest records the signatures of the given signing request to the corresponding batch
+// The batch is completed and the module handler is called when all signing requests of the batch are signed
+func (k Keeper) completeBatchedSigningRequest(ctx sdk.Context, sender string, req *types.SigningRequest, signatures []string) error {
+	if !k.HasSigningBatch(ctx, req.BatchId) {
+		return types.ErrSigningBatchDoesNotExist
+	}
+
+	batch := k.GetSigningBatch(ctx, req.BatchId)
+	if batch.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return errorsmod.Wrap(types.ErrInvalidSigningStatus, "signing batch non pending")
+	}
+
+	batch.Signatures = append(batch.Signatures, &types.BatchSignatures{
+		SigningId:  req.Id,
+		Signatures: signatures,
+	})
+
+	if len(batch.Signatures) < len(batch.SigningIds) {
+		k.SetSigningBatch(ctx, batch)
+		return nil
+	}
+
+	// order the signatures by the signing requests of the batch
+	slices.SortFunc(batch.Signatures, func(a, b *types.BatchSignatures) int {
+		return slices.Index(batch.SigningIds, a.SigningId) - slices.Index(batch.SigningIds, b.SigningId)
+	})
+
+	if handler := k.GetSigningBatchCompletedHandler(batch.Module); handler != nil {
+		// callback to the module handler
+		if err := handler(ctx, sender, batch.Id, batch.ScopedId, batch.Intent, batch.Signatures); err != nil {
+			return err
+		}
+	}
+
+	batch.Status = types.SigningStatus_SIGNING_STATUS_SIGNED
+	k.SetSigningBatch(ctx, batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteSigningBatch,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", batch.Id)),
+			sdk.NewAttribute(types.AttributeKeyModule, batch.Module),
+			sdk.NewAttribute(types.AttributeKeyScopedId, batch.ScopedId),
+		),
+	)
+
+	return nil
+}
+
+// GetAbsentSigningBatchParticipants gets the participants which are absent from any unsigned request of the given batch
+func (k Keeper) GetAbsentSigningBatchParticipants(ctx sdk.Context, batch *types.SigningBatch) []string {
+	absentParticipants := []string{}
+
+	for _, signingId := range batch.SigningIds {
+		req := k.GetSigningRequest(ctx, signingId)
+		if req.Status == types.SigningStatus_SIGNING_STATUS_SIGNED {
+			continue
+		}
+
+		for _, participant := range k.GetAbsentSigningParticipants(ctx, req) {
+			if !slices.Contains(absentParticipants, participant) {
+				absentParticipants = append(absentParticipants, participant)
+			}
+		}
+	}
+
+	return absentParticipants
+}
+
+// newSigningBatch creates a new pending signing batch with the specified params
+func (k Keeper) newSigningBatch(ctx sdk.Context, module string, scopedId string, intent int32, previousId uint64) *types.SigningBatch {
+	return &types.SigningBatch{
+		Id:             k.IncrementSigningBatchId(ctx),
+		Module:         module,
+		ScopedId:       scopedId,
+		Intent:         intent,
+		CreationTime:   ctx.BlockTime(),
+		Status:         types.SigningStatus_SIGNING_STATUS_PENDING,
+		ExpirationTime: types.GetExpirationTime(ctx.BlockTime(), k.SigningTimeoutDuration(ctx, module)),
+		PreviousId:     previousId,
+	}
+}
+
+// addToSigningBatch adds the given signing request to the specified batch with the shared deadline
+func (k Keeper) addToSigningBatch(ctx sdk.Context, batch *types.SigningBatch, req *types.SigningRequest) {
+	req.BatchId = batch.Id
+	req.ExpirationTime = batch.ExpirationTime
+	k.SetSigningRequest(ctx, req)
+
+	batch.SigningIds = append(batch.SigningIds, req.Id)
+}
+
+// emitInitiateSigningBatchEvent emits the event for the given signing batch initiated
+func (k Keeper) emitInitiateSigningBatchEvent(ctx sdk.Context, batch *types.SigningBatch) {
+	signingIds := []string{}
+	for _, id := range batch.SigningIds {
+		signingIds = append(signingIds, fmt.Sprintf("%d", id))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInitiateSigningBatch,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", batch.Id)),
+			sdk.NewAttribute(types.AttributeKeyModule, batch.Module),
+			sdk.NewAttribute(types.AttributeKeyScopedId, batch.ScopedId),
+			sdk.NewAttribute(types.AttributeKeyIntent, fmt.Sprintf("%d", batch.Intent)),
+			sdk.NewAttribute(types.AttributeKeySigningIds, strings.Join(signingIds, types.AttributeValueSeparator)),
+			sdk.NewAttribute(types.AttributeKeyExpirationTime, batch.ExpirationTime.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousId, fmt.Sprintf("%d", batch.PreviousId)),
+		),
+	)
+}
+
+// GetSigningBatchId gets the current signing batch id
+func (k Keeper) GetSigningBatchId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SigningBatchIdKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementSigningBatchId increments the signing batch id and returns the new id
+func (k Keeper) IncrementSigningBatchId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := k.GetSigningBatchId(ctx) + 1
+	store.Set(types.SigningBatchIdKey, sdk.Uint64ToBigEndian(id))
+
+	return id
+}
+
+// SetSigningBatch sets the given signing batch
+func (k Keeper) SetSigningBatch(ctx sdk.Context, batch *types.SigningBatch) {
+	store := ctx.KVStore(k.storeKey)
+
+	if k.HasSigningBatch(ctx, batch.Id) {
+		store.Delete(types.SigningBatchByStatusKey(k.GetSigningBatch(ctx, batch.Id).Status, batch.Id))
+	}
+
+	bz := k.cdc.MustMarshal(batch)
+
+	store.Set(types.SigningBatchKey(batch.Id), bz)
+	store.Set(types.SigningBatchByStatusKey(batch.Status, batch.Id), []byte{})
+}
+
+// HasSigningBatch returns true if the given signing batch exists, false otherwise
+func (k Keeper) HasSigningBatch(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.SigningBatchKey(id))
+}
+
+// GetSigningBatch gets the signing batch by the given id
+// Nil is returned if the batch does not exist
+func (k Keeper) GetSigningBatch(ctx sdk.Context, id uint64) *types.SigningBatch {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SigningBatchKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var batch types.SigningBatch
+	k.cdc.MustUnmarshal(bz, &batch)
+
+	return &batch
+}
+
+// GetSigningBatchesByStatus gets the signing batches by the given status
+func (k Keeper) GetSigningBatchesByStatus(ctx sdk.Context, status types.SigningStatus) []*types.SigningBatch {
+	batches := make([]*types.SigningBatch, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := append(types.SigningBatchByStatusKeyPrefix, sdk.Uint64ToBigEndian(uint64(status))...)
+
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(keyPrefix):])
+		batches = append(batches, k.GetSigningBatch(ctx, id))
+	}
+
+	return batches
+}
+
+// GetAllSigningBatches gets all signing batches
+func (k Keeper) GetAllSigningBatches(ctx sdk.Context) []*types.SigningBatch {
+	batches := make([]*types.SigningBatch, 0)
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SigningBatchKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var batch types.SigningBatch
+		k.cdc.MustUnmarshal(iterator.Value(), &batch)
+
+		batches = append(batches, &batch)
+	}
+
+	return batches
+}
+
+// GetSigningBatchesWithPagination gets the signing batches by the given status and module with pagination
+// All statuses are included if the status is unspecified
+func (k Keeper) GetSigningBatchesWithPagination(ctx sdk.Context, status types.SigningStatus, module string, pagination *query.PageRequest) ([]*types.SigningBatch, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var batchStore prefix.Store
+	if status == types.SigningStatus_SIGNING_STATUS_UNSPECIFIED {
+		batchStore = prefix.NewStore(store, types.SigningBatchKeyPrefix)
+	} else {
+		batchStore = prefix.NewStore(store, append(types.SigningBatchByStatusKeyPrefix, sdk.Uint64ToBigEndian(uint64(status))...))
+	}
+
+	var batches []*types.SigningBatch
+
+	pageRes, err := query.Paginate(batchStore, pagination, func(key []byte, value []byte) error {
+		batch := k.GetSigningBatch(ctx, sdk.BigEndianToUint64(key))
+
+		if len(module) == 0 || batch.Module == module {
+			batches = append(batches, batch)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return batches, pageRes, nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// UpdateSigningStats updates the signing stats of the module and signing type by the current status of the given signing request
+func (k Keeper) UpdateSigningStats(ctx sdk.Context, req *types.SigningRequest) {
+	stats := k.GetSigningStatsByType(ctx, req.Module, req.Type)
+
+	switch req.Status {
+	case types.SigningStatus_SIGNING_STATUS_PENDING:
+		stats.Requested++
+
+	case types.SigningStatus_SIGNING_STATUS_SIGNED:
+		stats.Signed++
+		stats.TotalLatency += ctx.BlockTime().Sub(req.CreationTime)
+
+	case types.SigningStatus_SIGNING_STATUS_TIMEDOUT:
+		stats.TimedOut++
+
+	default:
+		return
+	}
+
+	k.SetSigningStats(ctx, stats)
+}
+
+// RecordSigningLatency records the latency of the given signed request for the specified signers
+func (k Keeper) RecordSigningLatency(ctx sdk.Context, req *types.SigningRequest, signers []string) {
+	latency := ctx.BlockTime().Sub(req.CreationTime)
+
+	for _, signer := range signers {
+		reliability := k.GetParticipantReliability(ctx, signer)
+		reliability.RecordSigningLatency(latency)
+
+		k.SetParticipantReliability(ctx, reliability)
+	}
+}
+
+// GetParticipantKeys gets the keys of the completed DKGs which the given participant holds shares for
+func (k Keeper) GetParticipantKeys(ctx sdk.Context, consPubKey string) []types.ParticipantKey {
+	keys := []types.ParticipantKey{}
+
+	k.IterateDKGRequestsByStatus(ctx, types.DKGStatus_DKG_STATUS_COMPLETED, func(req *types.DKGRequest) (stop bool) {
+		if slices.Contains(req.Participants, consPubKey) {
+			keys = append(keys, types.ParticipantKey{
+				DkgId:   req.Id,
+				Module:  req.Module,
+				Type:    req.Type,
+				KeyType: req.KeyType,
+				PubKeys: k.GetDKGPubKeys(ctx, req.Id),
+			})
+		}
+
+		return false
+	})
+
+	return keys
+}
+
+// GetParticipantPendingSigningIds gets the ids of the pending signing requests which the given participant is involved in
+func (k Keeper) GetParticipantPendingSigningIds(ctx sdk.Context, consPubKey string) []uint64 {
+	ids := []uint64{}
+
+	k.IterateSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING, func(req *types.SigningRequest) (stop bool) {
+		if slices.Contains(k.GetSigningParticipants(ctx, req), consPubKey) {
+			ids = append(ids, req.Id)
+		}
+
+		return false
+	})
+
+	return ids
+}
+
+// SetSigningStats sets the given signing stats
+func (k Keeper) SetSigningStats(ctx sdk.Context, stats *types.SigningStats) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(stats)
+	store.Set(types.SigningStatsKey(stats.Module, stats.Type), bz)
+}
+
+// GetSigningStatsByType gets the signing stats of the given module and signing type
+// The empty stats is returned if not exist
+func (k Keeper) GetSigningStatsByType(ctx sdk.Context, module string, ty types.SigningType) *types.SigningStats {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SigningStatsKey(module, ty))
+	if bz == nil {
+		return types.NewSigningStats(module, ty)
+	}
+
+	var stats types.SigningStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	return &stats
+}
+
+// GetSigningStats gets the signing stats of the given module
+// All signing stats are returned if the module is empty
+func (k Keeper) GetSigningStats(ctx sdk.Context, module string) []*types.SigningStats {
+	allStats := make([]*types.SigningStats, 0)
+
+	k.IterateSigningStats(ctx, module, func(stats *types.SigningStats) (stop bool) {
+		allStats = append(allStats, stats)
+		return false
+	})
+
+	return allStats
+}
+
+// IterateSigningStats iterates through the signing stats of the given module
+// All signing stats are iterated if the module is empty
+func (k Keeper) IterateSigningStats(ctx sdk.Context, module string, cb func(stats *types.SigningStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := types.SigningStatsKeyPrefix
+	if len(module) != 0 {
+		keyPrefix = types.SigningStatsByModuleKeyPrefix(module)
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.SigningStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		if cb(&stats) {
+			break
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwaylabs/bitway/x/tss/types"
+)
+
+// MigrateStore migrates the x/tss module state from the consensus version 1 to
+// version 2
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateParams(store, cdc)
+	indexCompletedDKGRequests(store, cdc)
+
+	return nil
+}
+
+// migrateParams initializes the params introduced in version 2 with the default values
+// The version 1 params only contain the allowed DKG participants and the DKG timeout duration
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+
+	defaultParams := types.DefaultParams()
+
+	params.SigningTimeoutDuration = defaultParams.SigningTimeoutDuration
+	params.ModuleSigningTimeouts = defaultParams.ModuleSigningTimeouts
+	params.ParticipantPenaltyParams = defaultParams.ParticipantPenaltyParams
+	params.ParticipantSetParams = defaultParams.ParticipantSetParams
+	params.NonceQueueParams = defaultParams.NonceQueueParams
+	params.KeyRotationPolicies = defaultParams.KeyRotationPolicies
+	params.MaxSigningRetries = defaultParams.MaxSigningRetries
+	params.ModuleSigningFees = defaultParams.ModuleSigningFees
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
+
+// indexCompletedDKGRequests indexes the completed DKG requests by the pub keys generated before version 2
+// The DKG requests are iterated in ascending order of the id, i.e. the first DKG request which generated the pub key is retained
+func indexCompletedDKGRequests(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	keyPrefix := append(types.DKGRequestByStatusKeyPrefix, sdk.Uint64ToBigEndian(uint64(types.DKGStatus_DKG_STATUS_COMPLETED))...)
+
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(keyPrefix):])
+
+		for _, pubKey := range getDKGPubKeys(store, cdc, id) {
+			if store.Has(types.DKGRequestByPubKeyKey(pubKey)) {
+				continue
+			}
+
+			store.Set(types.DKGRequestByPubKeyKey(pubKey), sdk.Uint64ToBigEndian(id))
+		}
+	}
+}
+
+// getDKGPubKeys gets the pub keys generated by the given completed DKG request from the first completion
+// The completions of the completed DKG request are guaranteed to be consistent
+func getDKGPubKeys(store storetypes.KVStore, cdc codec.BinaryCodec, id uint64) []string {
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.DKGCompletionKeyPrefix, sdk.Uint64ToBigEndian(id)...))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	var completion types.DKGCompletion
+	cdc.MustUnmarshal(iterator.Value(), &completion)
+
+	return completion.PubKeys
+}
@@ -2,6 +2,7 @@ package tss
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -17,6 +18,21 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	// handle refreshing
 	handleRefreshingRequests(ctx, k)
 
+	// handle signing
+	handleSigningRequests(ctx, k)
+
+	// handle signing batches
+	handleSigningBatches(ctx, k)
+
+	// handle participant set update
+	k.HandleParticipantSetUpdate(ctx)
+
+	// handle nonce queues
+	k.HandleNonceQueues(ctx)
+
+	// handle scheduled key rotations
+	k.HandleKeyRotations(ctx)
+
 	return nil
 }
 
@@ -31,8 +47,13 @@ func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
 			req.Status = types.DKGStatus_DKG_STATUS_TIMEDOUT
 			k.SetDKGRequest(ctx, req)
 
+			absentParticipants := k.GetAbsentDKGParticipants(ctx, req)
+
+			// handle participant faults
+			k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_DKG, req.Participants, absentParticipants)
+
 			// callback the corresponding module handler
-			if err := k.GetDKGRequestTimeoutHandler(req.Module)(ctx, req.Id, req.Type, req.Intent, k.GetAbsentDKGParticipants(ctx, req)); err != nil {
+			if err := k.GetDKGRequestTimeoutHandler(req.Module)(ctx, req.Id, req.Type, req.Intent, absentParticipants); err != nil {
 				k.Logger(ctx).Warn("Failed to call DGKRequestTimeoutHandler", "module", req.Module, "type", req.Type, "intent", req.Intent)
 			}
 
@@ -64,6 +85,15 @@ func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
 		// update status
 		req.Status = types.DKGStatus_DKG_STATUS_COMPLETED
 		k.SetDKGRequest(ctx, req)
+		k.SetDKGRequestByPubKeys(ctx, req.Id, completions[0].PubKeys)
+
+		// store the verification shares for the partial signature verification if provided
+		if len(completions[0].VerificationShares) != 0 {
+			k.SetVerificationShares(ctx, &types.VerificationShares{DkgId: req.Id, Shares: completions[0].VerificationShares})
+		}
+
+		// record participant completions
+		k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_DKG, req.Participants, []string{})
 	}
 }
 
@@ -78,6 +108,9 @@ func handleRefreshingRequests(ctx sdk.Context, k keeper.Keeper) {
 			req.Status = types.RefreshingStatus_REFRESHING_STATUS_TIMEDOUT
 			k.SetRefreshingRequest(ctx, req)
 
+			// handle participant faults
+			k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_REFRESHING, k.GetRefreshingParticipants(ctx, req), k.GetAbsentRefreshingParticipants(ctx, req))
+
 			continue
 		}
 
@@ -91,18 +124,147 @@ func handleRefreshingRequests(ctx sdk.Context, k keeper.Keeper) {
 		req.Status = types.RefreshingStatus_REFRESHING_STATUS_COMPLETED
 		k.SetRefreshingRequest(ctx, req)
 
-		// update DKG participants
+		// update DKG participants and threshold
 		dkgRequest := k.GetDKGRequest(ctx, req.DkgId)
 		dkgRequest.Participants = k.GetRefreshingParticipants(ctx, req)
+		if req.Threshold != 0 {
+			dkgRequest.Threshold = req.Threshold
+		}
 		k.SetDKGRequest(ctx, dkgRequest)
 
+		// reset the nonce queue generated by the previous key shares
+		k.ResetNonceQueue(ctx, req.DkgId)
+
+		// replace the verification shares of the previous key shares
+		// the partial signatures are not supported for the DKG if the refreshed shares are not agreed
+		if verificationShares := k.GetRefreshingVerificationShares(ctx, req); len(verificationShares) != 0 {
+			k.SetVerificationShares(ctx, &types.VerificationShares{DkgId: req.DkgId, Shares: verificationShares})
+		} else {
+			k.RemoveVerificationShares(ctx, req.DkgId)
+		}
+
+		// record participant completions
+		k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_REFRESHING, dkgRequest.Participants, []string{})
+
 		// Emit events
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeRefreshingCompleted,
 				sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
 				sdk.NewAttribute(types.AttributeKeyDKGId, fmt.Sprintf("%d", req.DkgId)),
+				sdk.NewAttribute(types.AttributeKeyParticipants, strings.Join(dkgRequest.Participants, types.AttributeValueSeparator)),
+				sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", dkgRequest.Threshold)),
 			),
 		)
 	}
 }
+
+// handleSigningRequests performs the signing request timeout handling
+func handleSigningRequests(ctx sdk.Context, k keeper.Keeper) {
+	// get pending signing requests
+	requests := k.GetSigningRequestsByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING)
+
+	for _, req := range requests {
+		// the batched signing request is timed out along with the batch
+		if req.BatchId != 0 {
+			continue
+		}
+
+		// check if the signing request expired
+		if req.ExpirationTime.IsZero() || ctx.BlockTime().Before(req.ExpirationTime) {
+			continue
+		}
+
+		absentParticipants := timeoutSigningRequest(ctx, k, req)
+
+		// callback the corresponding module handler
+		if handler := k.GetSigningRequestTimeoutHandler(req.Module); handler != nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err := handler(cacheCtx, req.Id, req.ScopedId, req.Type, req.Intent, req.PubKey, absentParticipants); err != nil {
+				k.Logger(ctx).Warn("Failed to call SigningRequestTimeoutHandler", "module", req.Module, "id", req.Id, "scoped id", req.ScopedId, "err", err)
+			} else {
+				write()
+			}
+		}
+
+		// refund the escrowed fee if the signing request is not re-initiated
+		k.RefundSigningFee(ctx, req.Id)
+	}
+}
+
+// handleSigningBatches performs the signing batch timeout handling
+func handleSigningBatches(ctx sdk.Context, k keeper.Keeper) {
+	// get pending signing batches
+	batches := k.GetSigningBatchesByStatus(ctx, types.SigningStatus_SIGNING_STATUS_PENDING)
+
+	for _, batch := range batches {
+		// check if the signing batch expired
+		if batch.ExpirationTime.IsZero() || ctx.BlockTime().Before(batch.ExpirationTime) {
+			continue
+		}
+
+		batch.Status = types.SigningStatus_SIGNING_STATUS_TIMEDOUT
+		k.SetSigningBatch(ctx, batch)
+
+		absentParticipants := k.GetAbsentSigningBatchParticipants(ctx, batch)
+
+		// time out the pending signing requests of the batch so that they can be re-initiated
+		for _, signingId := range batch.SigningIds {
+			if req := k.GetSigningRequest(ctx, signingId); req.Status == types.SigningStatus_SIGNING_STATUS_PENDING {
+				timeoutSigningRequest(ctx, k, req)
+			}
+		}
+
+		// Emit events
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSigningBatchTimedOut,
+				sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", batch.Id)),
+				sdk.NewAttribute(types.AttributeKeyModule, batch.Module),
+				sdk.NewAttribute(types.AttributeKeyScopedId, batch.ScopedId),
+				sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+			),
+		)
+
+		// callback the corresponding module handler
+		if handler := k.GetSigningBatchTimeoutHandler(batch.Module); handler != nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err := handler(cacheCtx, batch.Id, batch.ScopedId, batch.Intent, absentParticipants); err != nil {
+				k.Logger(ctx).Warn("Failed to call SigningBatchTimeoutHandler", "module", batch.Module, "id", batch.Id, "scoped id", batch.ScopedId, "err", err)
+			} else {
+				write()
+			}
+		}
+
+		// refund the escrowed fees of the signing requests which are not re-initiated
+		for _, signingId := range batch.SigningIds {
+			k.RefundSigningFee(ctx, signingId)
+		}
+	}
+}
+
+// timeoutSigningRequest marks the given signing request as timed out and handles the participant faults
+// The absent participants are returned
+func timeoutSigningRequest(ctx sdk.Context, k keeper.Keeper, req *types.SigningRequest) []string {
+	req.Status = types.SigningStatus_SIGNING_STATUS_TIMEDOUT
+	k.SetSigningRequest(ctx, req)
+	k.UpdateSigningStats(ctx, req)
+
+	absentParticipants := k.GetAbsentSigningParticipants(ctx, req)
+
+	// handle participant faults
+	k.HandleParticipation(ctx, types.FaultType_FAULT_TYPE_SIGNING, k.GetSigningParticipants(ctx, req), absentParticipants)
+
+	// Emit events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSigningTimedOut,
+			sdk.NewAttribute(types.AttributeKeyId, fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute(types.AttributeKeyModule, req.Module),
+			sdk.NewAttribute(types.AttributeKeyScopedId, req.ScopedId),
+			sdk.NewAttribute(types.AttributeKeyAbsentParticipants, strings.Join(absentParticipants, types.AttributeValueSeparator)),
+		),
+	)
+
+	return absentParticipants
+}
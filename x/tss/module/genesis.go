@@ -21,6 +21,36 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, req := range genState.SigningRequests {
 		k.SetSigningRequest(ctx, req)
 	}
+
+	// set signing batches
+	for _, batch := range genState.SigningBatches {
+		k.SetSigningBatch(ctx, batch)
+	}
+
+	// set participant reliabilities
+	for _, reliability := range genState.ParticipantReliabilities {
+		k.SetParticipantReliability(ctx, reliability)
+	}
+
+	// set escrowed signing fees
+	for _, signingFee := range genState.SigningFees {
+		k.SetSigningFee(ctx, signingFee)
+	}
+
+	// set participant rewards
+	for _, rewards := range genState.ParticipantRewards {
+		k.SetParticipantRewards(ctx, rewards)
+	}
+
+	// set key rotations
+	for _, rotation := range genState.KeyRotations {
+		k.SetKeyRotation(ctx, rotation)
+	}
+
+	// set signing stats
+	for _, stats := range genState.SigningStats {
+		k.SetSigningStats(ctx, stats)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -30,6 +60,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.DkgRequests = k.GetAllDKGRequests(ctx)
 	genesis.SigningRequests = k.GetAllSigningRequests(ctx)
+	genesis.ParticipantReliabilities = k.GetAllParticipantReliabilities(ctx)
+	genesis.SigningFees = k.GetAllSigningFees(ctx)
+	genesis.ParticipantRewards = k.GetAllParticipantRewards(ctx)
+	genesis.KeyRotations = k.GetKeyRotations(ctx, "")
+	genesis.SigningBatches = k.GetAllSigningBatches(ctx)
+	genesis.SigningStats = k.GetSigningStats(ctx, "")
 
 	// this line is used by starport scaffolding # genesis/module/export
 
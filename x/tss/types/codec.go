@@ -10,6 +10,12 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCompleteDKG{}, "tss/MsgCompleteDKG", nil)
 	cdc.RegisterConcrete(&MsgSubmitSignatures{}, "tss/MsgSubmitSignatures", nil)
+	cdc.RegisterConcrete(&MsgSubmitBatchSignatures{}, "tss/MsgSubmitBatchSignatures", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeSigning{}, "tss/MsgAcknowledgeSigning", nil)
+	cdc.RegisterConcrete(&MsgSubmitPartialSignatures{}, "tss/MsgSubmitPartialSignatures", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "tss/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgRequestSignature{}, "tss/MsgRequestSignature", nil)
+	cdc.RegisterConcrete(&MsgInitiateDKG{}, "tss/MsgInitiateDKG", nil)
 	cdc.RegisterConcrete(&MsgRefresh{}, "tss/MsgRefresh", nil)
 	cdc.RegisterConcrete(&MsgCompleteRefreshing{}, "tss/MsgCompleteRefreshing", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "tss/MsgUpdateParams", nil)
@@ -19,6 +25,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCompleteDKG{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitSignatures{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitBatchSignatures{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcknowledgeSigning{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitPartialSignatures{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRequestSignature{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgInitiateDKG{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRefresh{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCompleteRefreshing{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
@@ -20,9 +20,23 @@ var (
 	ErrInvalidDKGCompletion       = errorsmod.Register(ModuleName, 2005, "invalid dkg completion")
 	ErrInvalidDKGParticipantNum   = errorsmod.Register(ModuleName, 2006, "invalid dkg participant number")
 	ErrInvalidDKGThreshold        = errorsmod.Register(ModuleName, 2007, "invalid dkg threshold")
+	ErrInvalidPossessionProof     = errorsmod.Register(ModuleName, 2008, "invalid proof of possession")
+	ErrInvalidVerificationShares  = errorsmod.Register(ModuleName, 2009, "invalid verification shares")
 
-	ErrSigningRequestDoesNotExist = errorsmod.Register(ModuleName, 3000, "signing request does not exist")
-	ErrInvalidSigningStatus       = errorsmod.Register(ModuleName, 3001, "invalid signing status")
+	ErrSigningRequestDoesNotExist          = errorsmod.Register(ModuleName, 3000, "signing request does not exist")
+	ErrInvalidSigningStatus                = errorsmod.Register(ModuleName, 3001, "invalid signing status")
+	ErrSigningRequestExpired               = errorsmod.Register(ModuleName, 3002, "signing request expired")
+	ErrSigningAcknowledgementAlreadyExists = errorsmod.Register(ModuleName, 3003, "signing acknowledgement already exists")
+	ErrInvalidNonce                        = errorsmod.Register(ModuleName, 3004, "invalid nonce")
+	ErrInsufficientNonces                  = errorsmod.Register(ModuleName, 3005, "insufficient committed nonces")
+	ErrPartialSignaturesNotSupported       = errorsmod.Register(ModuleName, 3006, "partial signatures not supported")
+	ErrPartialSignatureAlreadyExists       = errorsmod.Register(ModuleName, 3007, "partial signature already exists")
+	ErrInvalidSigningRequest               = errorsmod.Register(ModuleName, 3008, "invalid signing request")
+	ErrGovSignatureRequestDoesNotExist     = errorsmod.Register(ModuleName, 3009, "gov signature request does not exist")
+	ErrSigningBatchDoesNotExist            = errorsmod.Register(ModuleName, 3010, "signing batch does not exist")
+	ErrInvalidSigningBatch                 = errorsmod.Register(ModuleName, 3011, "invalid signing batch")
+	ErrInvalidPartialSignature             = errorsmod.Register(ModuleName, 3012, "invalid partial signature")
+	ErrMaxSigningRetriesExceeded           = errorsmod.Register(ModuleName, 3013, "max signing retries exceeded")
 
 	ErrInvalidDKGs                       = errorsmod.Register(ModuleName, 4000, "invalid dkgs")
 	ErrInvalidParticipants               = errorsmod.Register(ModuleName, 4001, "invalid participants")
@@ -33,4 +47,12 @@ var (
 	ErrRefreshingCompletionAlreadyExists = errorsmod.Register(ModuleName, 4006, "refreshing completion already exists")
 
 	ErrInvalidParams = errorsmod.Register(ModuleName, 5000, "invalid params")
+
+	ErrInvalidSigningFee          = errorsmod.Register(ModuleName, 6000, "invalid signing fee")
+	ErrSigningFeeAlreadyExists    = errorsmod.Register(ModuleName, 6001, "signing fee already exists")
+	ErrNoRewards                  = errorsmod.Register(ModuleName, 6002, "no rewards")
+	ErrUnauthorizedRewardsClaimer = errorsmod.Register(ModuleName, 6003, "unauthorized rewards claimer")
+
+	ErrKeyRotationDoesNotExist  = errorsmod.Register(ModuleName, 7000, "key rotation does not exist")
+	ErrInvalidKeyRotationStatus = errorsmod.Register(ModuleName, 7001, "invalid key rotation status")
 )
@@ -2,13 +2,38 @@ package types
 
 // TSS module event types and attribute keys
 const (
-	EventTypeInitiateDKG         = "initiate_dkg"
-	EventTypeCompleteDKG         = "complete_dkg"
-	EventTypeInitiateSigning     = "initiate_signing"
-	EventTypeCompleteSigning     = "complete_signing"
-	EventTypeInitiateRefreshing  = "initiate_refreshing"
-	EventTypeCompleteRefreshing  = "complete_refreshing"
-	EventTypeRefreshingCompleted = "refreshing_completed"
+	EventTypeInitiateDKG           = "initiate_dkg"
+	EventTypeCompleteDKG           = "complete_dkg"
+	EventTypeInitiateSigning       = "initiate_signing"
+	EventTypeCompleteSigning       = "complete_signing"
+	EventTypeAcknowledgeSigning    = "acknowledge_signing"
+	EventTypeSigningTimedOut       = "signing_timedout"
+	EventTypeInitiateRefreshing    = "initiate_refreshing"
+	EventTypeCompleteRefreshing    = "complete_refreshing"
+	EventTypeRefreshingCompleted   = "refreshing_completed"
+	EventTypeParticipantFault      = "participant_fault"
+	EventTypeParticipantPenalty    = "participant_penalty"
+	EventTypeParticipantSetUpdated = "participant_set_updated"
+	EventTypeNoncesCommitted       = "nonces_committed"
+
+	EventTypeSubmitPartialSignatures = "submit_partial_signatures"
+	EventTypeAggregationFailed       = "partial_signature_aggregation_failed"
+
+	EventTypeSigningFeeEscrowed    = "signing_fee_escrowed"
+	EventTypeSigningFeeDistributed = "signing_fee_distributed"
+	EventTypeSigningFeeRefunded    = "signing_fee_refunded"
+	EventTypeClaimRewards          = "claim_rewards"
+
+	EventTypeRequestSignature = "request_signature"
+
+	EventTypeInitiateSigningBatch = "initiate_signing_batch"
+	EventTypeCompleteSigningBatch = "complete_signing_batch"
+	EventTypeSigningBatchTimedOut = "signing_batch_timedout"
+
+	EventTypeKeyRotationInitiated = "key_rotation_initiated"
+	EventTypeKeyRotationCompleted = "key_rotation_completed"
+	EventTypeKeyRotationAborted   = "key_rotation_aborted"
+	EventTypeKeyRetired           = "key_retired"
 
 	AttributeKeySender = "sender"
 
@@ -22,6 +47,7 @@ const (
 	AttributeKeyThreshold      = "threshold"
 	AttributeKeyBatchSize      = "batch_size"
 	AttributeKeyExpirationTime = "expiration_time"
+	AttributeKeyKeyType        = "key_type"
 
 	AttributeKeyParticipant = "participant"
 
@@ -29,8 +55,38 @@ const (
 	AttributeKeySigHashes = "sig_hashes"
 	AttributeKeyOption    = "option"
 
+	AttributeKeyPreviousId         = "previous_id"
+	AttributeKeyAbsentParticipants = "absent_participants"
+
+	AttributeKeyFaultType     = "fault_type"
+	AttributeKeyPendingFaults = "pending_faults"
+	AttributeKeyValidator     = "validator"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyJailUntil     = "jail_until"
+
+	AttributeKeySigningId  = "signing_id"
+	AttributeKeySigningIds = "signing_ids"
+	AttributeKeyBatchId    = "batch_id"
+
 	AttributeKeyDKGId               = "dkg_id"
 	AttributeKeyRemovedParticipants = "removed_participants"
+	AttributeKeyAddedParticipants   = "added_participants"
+
+	AttributeKeyGenerationId = "generation_id"
+	AttributeKeyNonces       = "nonces"
+
+	AttributeKeySigners    = "signers"
+	AttributeKeyAggregated = "aggregated"
+	AttributeKeyError      = "error"
+
+	AttributeKeyPayer      = "payer"
+	AttributeKeyFee        = "fee"
+	AttributeKeyRecipients = "recipients"
+	AttributeKeyRewards    = "rewards"
+
+	AttributeKeyMethod         = "method"
+	AttributeKeyRefreshingIds  = "refreshing_ids"
+	AttributeKeyRetirementTime = "retirement_time"
 )
 
 const (
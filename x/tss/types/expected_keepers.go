@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -11,4 +13,22 @@ import (
 type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
 	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+
+	PowerReduction(ctx context.Context) sdkmath.Int
+}
+
+// BankKeeper defines the expected bank keeper used to escrow and distribute the signing fees (noalias)
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// SlashingKeeper defines the expected slashing keeper used to penalize the faulty participants (noalias)
+type SlashingKeeper interface {
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
 }
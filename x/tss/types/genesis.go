@@ -4,9 +4,15 @@ package types
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:          DefaultParams(),
-		DkgRequests:     []*DKGRequest{},
-		SigningRequests: []*SigningRequest{},
+		Params:                   DefaultParams(),
+		DkgRequests:              []*DKGRequest{},
+		SigningRequests:          []*SigningRequest{},
+		ParticipantReliabilities: []*ParticipantReliability{},
+		SigningFees:              []*SigningFee{},
+		ParticipantRewards:       []*ParticipantRewards{},
+		KeyRotations:             []*KeyRotation{},
+		SigningBatches:           []*SigningBatch{},
+		SigningStats:             []*SigningStats{},
 	}
 }
 
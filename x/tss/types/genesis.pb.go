@@ -25,9 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
-	Params          Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DkgRequests     []*DKGRequest     `protobuf:"bytes,2,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
-	SigningRequests []*SigningRequest `protobuf:"bytes,3,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	Params                   Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DkgRequests              []*DKGRequest             `protobuf:"bytes,2,rep,name=dkg_requests,json=dkgRequests,proto3" json:"dkg_requests,omitempty"`
+	SigningRequests          []*SigningRequest         `protobuf:"bytes,3,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	ParticipantReliabilities []*ParticipantReliability `protobuf:"bytes,4,rep,name=participant_reliabilities,json=participantReliabilities,proto3" json:"participant_reliabilities,omitempty"`
+	SigningFees              []*SigningFee             `protobuf:"bytes,5,rep,name=signing_fees,json=signingFees,proto3" json:"signing_fees,omitempty"`
+	ParticipantRewards       []*ParticipantRewards     `protobuf:"bytes,6,rep,name=participant_rewards,json=participantRewards,proto3" json:"participant_rewards,omitempty"`
+	KeyRotations             []*KeyRotation            `protobuf:"bytes,7,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	SigningBatches           []*SigningBatch           `protobuf:"bytes,8,rep,name=signing_batches,json=signingBatches,proto3" json:"signing_batches,omitempty"`
+	SigningStats             []*SigningStats           `protobuf:"bytes,9,rep,name=signing_stats,json=signingStats,proto3" json:"signing_stats,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +90,48 @@ func (m *GenesisState) GetSigningRequests() []*SigningRequest {
 	return nil
 }
 
+func (m *GenesisState) GetParticipantReliabilities() []*ParticipantReliability {
+	if m != nil {
+		return m.ParticipantReliabilities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningFees() []*SigningFee {
+	if m != nil {
+		return m.SigningFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantRewards() []*ParticipantRewards {
+	if m != nil {
+		return m.ParticipantRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyRotations() []*KeyRotation {
+	if m != nil {
+		return m.KeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningBatches() []*SigningBatch {
+	if m != nil {
+		return m.SigningBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningStats() []*SigningStats {
+	if m != nil {
+		return m.SigningStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "bitway.tss.GenesisState")
 }
@@ -91,24 +139,34 @@ func init() {
 func init() { proto.RegisterFile("bitway/tss/genesis.proto", fileDescriptor_1418a4cd6c93d9f3) }
 
 var fileDescriptor_1418a4cd6c93d9f3 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0xca, 0x2c, 0x29,
-	0x4f, 0xac, 0xd4, 0x2f, 0x29, 0x2e, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x82, 0xc8, 0xe8, 0x95, 0x14, 0x17, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x85, 0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x29, 0x71, 0x24, 0xbd, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0x50, 0xad, 0x52, 0x22, 0x48, 0x12, 0x25, 0xc5, 0x50, 0x51, 0xa5, 0x43, 0x8c, 0x5c,
-	0x3c, 0xee, 0x10, 0x2b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x0c, 0xb8, 0xd8, 0x20, 0xda, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x84, 0xf4, 0x10, 0x56, 0xea, 0x05, 0x80, 0x65, 0x9c, 0x58,
-	0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x13, 0xb2, 0xe4, 0xe2, 0x49, 0xc9, 0x4e, 0x8f, 0x2f,
-	0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x29, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x12, 0x43,
-	0xd6, 0xe7, 0xe2, 0xed, 0x1e, 0x04, 0x91, 0x0e, 0xe2, 0x4e, 0xc9, 0x4e, 0x87, 0xb2, 0x8b, 0x85,
-	0x5c, 0xb9, 0x04, 0x8a, 0x33, 0xd3, 0xf3, 0x32, 0xf3, 0x90, 0xb4, 0x33, 0x83, 0xb5, 0x4b, 0x21,
-	0x6b, 0x0f, 0x86, 0xa8, 0x81, 0x19, 0xc1, 0x5f, 0x8c, 0xc2, 0x2f, 0x76, 0x72, 0x3a, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x81, 0x39, 0x89, 0x49, 0xc5, 0x50, 0xa6, 0x7e, 0x05, 0x24, 0x30,
-	0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xe1, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe4,
-	0x67, 0xb5, 0xac, 0x7c, 0x01, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x69, 0x08, 0xb0, 0x4e, 0x01, 0x2d, 0x15, 0x5d, 0x7c, 0x30, 0x55, 0x4f, 0x39, 0xd9,
+	0xa8, 0x9c, 0x90, 0xe0, 0x80, 0x05, 0xf4, 0xd0, 0x03, 0x68, 0x7b, 0xe3, 0x62, 0xad, 0x93, 0xc1,
+	0x5d, 0x39, 0xb5, 0x8d, 0x67, 0xaa, 0xe2, 0xb7, 0xe0, 0xb1, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9,
+	0x03, 0xf0, 0x0a, 0x28, 0xbb, 0x76, 0xe3, 0x90, 0xe4, 0x36, 0x33, 0xdf, 0xcf, 0xce, 0xb7, 0x1a,
+	0x26, 0x52, 0x4d, 0xd7, 0xaa, 0x89, 0x08, 0x31, 0xca, 0xa0, 0x00, 0xd4, 0x18, 0x56, 0x75, 0x49,
+	0x25, 0x67, 0x16, 0x09, 0x09, 0xd1, 0x3f, 0xc8, 0xca, 0xac, 0x34, 0xe3, 0x68, 0x59, 0x59, 0x86,
+	0x7f, 0xd8, 0xd3, 0x56, 0xaa, 0x56, 0x97, 0xad, 0xd4, 0x3f, 0xe8, 0x01, 0x84, 0xed, 0xf4, 0xf8,
+	0xef, 0x80, 0x8d, 0x4e, 0xed, 0x13, 0xe7, 0xa4, 0x08, 0xf8, 0x2b, 0x36, 0xb4, 0x32, 0xe1, 0x1e,
+	0xb9, 0x63, 0xef, 0x84, 0x87, 0xab, 0x27, 0xc3, 0x2f, 0x06, 0x89, 0x07, 0x37, 0xbf, 0x5f, 0x3a,
+	0xb2, 0xe5, 0xf1, 0x37, 0x6c, 0x34, 0xcd, 0xb3, 0xa4, 0x86, 0xef, 0x57, 0x80, 0x84, 0xe2, 0xde,
+	0xd1, 0xde, 0xd8, 0x3b, 0x79, 0xde, 0xd7, 0x7d, 0x38, 0x3b, 0x95, 0x16, 0x96, 0xde, 0x34, 0xcf,
+	0xda, 0x1a, 0xf9, 0x47, 0xf6, 0x14, 0x75, 0x56, 0xe8, 0xa2, 0x27, 0xdf, 0x33, 0x72, 0xbf, 0x2f,
+	0x3f, 0xb7, 0x9c, 0xce, 0xe2, 0x09, 0xae, 0xf5, 0xc8, 0x13, 0xf6, 0xa2, 0x52, 0x35, 0xe9, 0x89,
+	0xae, 0x54, 0x41, 0x49, 0x0d, 0x33, 0xad, 0x52, 0x3d, 0xd3, 0xa4, 0x01, 0xc5, 0xc0, 0xf8, 0x1d,
+	0xff, 0x17, 0xa3, 0x23, 0xcb, 0x3b, 0x6e, 0x23, 0x45, 0xb5, 0x6d, 0xae, 0xc1, 0x44, 0xec, 0xf6,
+	0xfc, 0x06, 0x80, 0xe2, 0xfe, 0x66, 0xc4, 0x76, 0xc7, 0x4f, 0x00, 0xd2, 0xc3, 0xbb, 0x1a, 0xf9,
+	0x67, 0xf6, 0x6c, 0x7d, 0xb7, 0x6b, 0x55, 0x4f, 0x51, 0x0c, 0x8d, 0x43, 0xb0, 0x73, 0x2b, 0xc3,
+	0x92, 0xbc, 0xda, 0x98, 0xf1, 0xb7, 0x6c, 0x3f, 0x87, 0x26, 0xa9, 0x4b, 0x52, 0xa4, 0xcb, 0x02,
+	0xc5, 0x03, 0x63, 0x75, 0xd8, 0xb7, 0x3a, 0x83, 0x46, 0xb6, 0xb8, 0x1c, 0xe5, 0xab, 0x06, 0xf9,
+	0x7b, 0xd6, 0xfd, 0x5e, 0x92, 0x2a, 0x9a, 0x5c, 0x00, 0x8a, 0x87, 0x46, 0x2f, 0xb6, 0x84, 0x89,
+	0x97, 0x0c, 0xf9, 0x18, 0x7b, 0x1d, 0x20, 0x7f, 0xc7, 0xf6, 0x3b, 0x0b, 0x24, 0x45, 0x28, 0x1e,
+	0xed, 0x34, 0x58, 0x9e, 0x14, 0xca, 0xee, 0xef, 0x4c, 0x17, 0xc7, 0x37, 0xf3, 0xc0, 0xbd, 0x9d,
+	0x07, 0xee, 0x9f, 0x79, 0xe0, 0xfe, 0x5c, 0x04, 0xce, 0xed, 0x22, 0x70, 0x7e, 0x2d, 0x02, 0xe7,
+	0xeb, 0x38, 0xd3, 0x74, 0x71, 0x95, 0x86, 0x93, 0xf2, 0x32, 0xb2, 0x5e, 0x33, 0x95, 0x62, 0x5b,
+	0x46, 0x3f, 0xec, 0xe5, 0x36, 0x15, 0x60, 0x3a, 0x34, 0xc7, 0xfb, 0xfa, 0x5f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x71, 0x55, 0xac, 0x9b, 0x29, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +189,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigningStats) > 0 {
+		for iNdEx := len(m.SigningStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SigningBatches) > 0 {
+		for iNdEx := len(m.SigningBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ParticipantRewards) > 0 {
+		for iNdEx := len(m.ParticipantRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SigningFees) > 0 {
+		for iNdEx := len(m.SigningFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ParticipantReliabilities) > 0 {
+		for iNdEx := len(m.ParticipantReliabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantReliabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.SigningRequests) > 0 {
 		for iNdEx := len(m.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -203,6 +345,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ParticipantReliabilities) > 0 {
+		for _, e := range m.ParticipantReliabilities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningFees) > 0 {
+		for _, e := range m.SigningFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantRewards) > 0 {
+		for _, e := range m.ParticipantRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningBatches) > 0 {
+		for _, e := range m.SigningBatches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningStats) > 0 {
+		for _, e := range m.SigningStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -342,6 +520,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantReliabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantReliabilities = append(m.ParticipantReliabilities, &ParticipantReliability{})
+			if err := m.ParticipantReliabilities[len(m.ParticipantReliabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningFees = append(m.SigningFees, &SigningFee{})
+			if err := m.SigningFees[len(m.SigningFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantRewards = append(m.ParticipantRewards, &ParticipantRewards{})
+			if err := m.ParticipantRewards[len(m.ParticipantRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, &KeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningBatches = append(m.SigningBatches, &SigningBatch{})
+			if err := m.SigningBatches[len(m.SigningBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningStats = append(m.SigningStats, &SigningStats{})
+			if err := m.SigningStats[len(m.SigningStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
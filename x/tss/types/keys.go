@@ -16,13 +16,23 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_tss"
+
+	// GovModuleScope defines the module scope of the DKG and signing requests initiated by the governance
+	GovModuleScope = "gov"
+
+	// GovDKGType defines the type of the DKG requests initiated by the governance
+	GovDKGType = "gov"
 )
 
 var (
-	ParamsKey              = []byte{0x01} // key for params
-	DKGRequestIdKey        = []byte{0x02} // key for dkg request id
-	SigningRequestIdKey    = []byte{0x03} // key for signing request id
-	RefreshingRequestIdKey = []byte{0x04} // key for refreshing request id
+	ParamsKey                   = []byte{0x01} // key for params
+	DKGRequestIdKey             = []byte{0x02} // key for dkg request id
+	SigningRequestIdKey         = []byte{0x03} // key for signing request id
+	RefreshingRequestIdKey      = []byte{0x04} // key for refreshing request id
+	ParticipantSetKey           = []byte{0x05} // key for the participant set
+	ParticipantSetUpdateTimeKey = []byte{0x06} // key for the scheduled participant set update time
+	GovSignatureRequestIdKey    = []byte{0x07} // key for gov signature request id
+	SigningBatchIdKey           = []byte{0x08} // key for signing batch id
 
 	DKGRequestKeyPrefix             = []byte{0x10} // key prefix for the dkg request
 	DKGRequestByStatusKeyPrefix     = []byte{0x11} // key prefix for the dkg request by status
@@ -31,6 +41,24 @@ var (
 	SigningRequestByStatusKeyPrefix = []byte{0x14} // key prefix for the signing request by status
 	RefreshingRequestKeyPrefix      = []byte{0x15} // key prefix for the refreshing request
 	RefreshingCompletionKeyPrefix   = []byte{0x16} // key prefix for the refreshing completion
+	SigningAcknowledgementKeyPrefix = []byte{0x17} // key prefix for the signing acknowledgement
+	ParticipantReliabilityKeyPrefix = []byte{0x18} // key prefix for the participant reliability
+	NonceQueueIndexKeyPrefix        = []byte{0x19} // key prefix for the nonce queue index
+	CommittedNonceKeyPrefix         = []byte{0x1A} // key prefix for the committed nonce
+	NonceByValueKeyPrefix           = []byte{0x1B} // key prefix for the nonce value
+	NonceGenerationKeyPrefix        = []byte{0x1C} // key prefix for the pending nonce generation
+	NonceGenerationDKGKeyPrefix     = []byte{0x1D} // key prefix for the DKG of the nonce generation
+	PartialSignatureKeyPrefix       = []byte{0x1E} // key prefix for the partial signature
+	SignerSetKeyPrefix              = []byte{0x1F} // key prefix for the signer set
+	SigningFeeKeyPrefix             = []byte{0x20} // key prefix for the signing fee
+	ParticipantRewardsKeyPrefix     = []byte{0x21} // key prefix for the participant rewards
+	KeyRotationKeyPrefix            = []byte{0x22} // key prefix for the key rotation
+	GovSignatureRequestKeyPrefix    = []byte{0x23} // key prefix for the gov signature request
+	SigningBatchKeyPrefix           = []byte{0x24} // key prefix for the signing batch
+	SigningBatchByStatusKeyPrefix   = []byte{0x25} // key prefix for the signing batch by status
+	SigningStatsKeyPrefix           = []byte{0x26} // key prefix for the signing stats
+	VerificationSharesKeyPrefix     = []byte{0x27} // key prefix for the verification shares
+	DKGRequestByPubKeyKeyPrefix     = []byte{0x28} // key prefix for the completed DKG request by the generated pub key
 )
 
 func DKGRequestKey(id uint64) []byte {
@@ -43,6 +71,10 @@ func DKGRequestByStatusKey(status DKGStatus, id uint64) []byte {
 	return append(key, sdk.Uint64ToBigEndian(id)...)
 }
 
+func DKGRequestByPubKeyKey(pubKey string) []byte {
+	return append(DKGRequestByPubKeyKeyPrefix, []byte(pubKey)...)
+}
+
 func DKGCompletionKey(id uint64, consPubKey string) []byte {
 	return append(append(DKGCompletionKeyPrefix, sdk.Uint64ToBigEndian(id)...), []byte(consPubKey)...)
 }
@@ -64,3 +96,77 @@ func RefreshingRequestKey(id uint64) []byte {
 func RefreshingCompletionKey(id uint64, consPubKey string) []byte {
 	return append(append(RefreshingCompletionKeyPrefix, sdk.Uint64ToBigEndian(id)...), []byte(consPubKey)...)
 }
+
+func SigningAcknowledgementKey(id uint64, consPubKey string) []byte {
+	return append(append(SigningAcknowledgementKeyPrefix, sdk.Uint64ToBigEndian(id)...), []byte(consPubKey)...)
+}
+
+func ParticipantReliabilityKey(consPubKey string) []byte {
+	return append(ParticipantReliabilityKeyPrefix, []byte(consPubKey)...)
+}
+
+func NonceQueueIndexKey(dkgId uint64) []byte {
+	return append(NonceQueueIndexKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...)
+}
+
+func CommittedNonceKey(dkgId uint64, index uint64) []byte {
+	return append(append(CommittedNonceKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...), sdk.Uint64ToBigEndian(index)...)
+}
+
+func NonceByValueKey(nonce []byte) []byte {
+	return append(NonceByValueKeyPrefix, nonce...)
+}
+
+func NonceGenerationKey(dkgId uint64) []byte {
+	return append(NonceGenerationKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...)
+}
+
+func NonceGenerationDKGKey(generationId uint64) []byte {
+	return append(NonceGenerationDKGKeyPrefix, sdk.Uint64ToBigEndian(generationId)...)
+}
+
+func PartialSignatureKey(id uint64, consPubKey string) []byte {
+	return append(append(PartialSignatureKeyPrefix, sdk.Uint64ToBigEndian(id)...), []byte(consPubKey)...)
+}
+
+func SignerSetKey(id uint64) []byte {
+	return append(SignerSetKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func SigningFeeKey(id uint64) []byte {
+	return append(SigningFeeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func ParticipantRewardsKey(consPubKey string) []byte {
+	return append(ParticipantRewardsKeyPrefix, []byte(consPubKey)...)
+}
+
+func KeyRotationKey(module string, ty string) []byte {
+	return append(KeyRotationKeyPrefix, []byte(module+"/"+ty)...)
+}
+
+func GovSignatureRequestKey(id uint64) []byte {
+	return append(GovSignatureRequestKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func SigningBatchKey(id uint64) []byte {
+	return append(SigningBatchKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func SigningBatchByStatusKey(status SigningStatus, id uint64) []byte {
+	key := append(SigningBatchByStatusKeyPrefix, sdk.Uint64ToBigEndian(uint64(status))...)
+
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+func SigningStatsKey(module string, ty SigningType) []byte {
+	return append(SigningStatsByModuleKeyPrefix(module), sdk.Uint64ToBigEndian(uint64(ty))...)
+}
+
+func SigningStatsByModuleKeyPrefix(module string) []byte {
+	return append(SigningStatsKeyPrefix, []byte(module+"/")...)
+}
+
+func VerificationSharesKey(dkgId uint64) []byte {
+	return append(VerificationSharesKeyPrefix, sdk.Uint64ToBigEndian(dkgId)...)
+}
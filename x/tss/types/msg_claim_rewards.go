@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/base64"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func NewMsgClaimRewards(
+	sender string,
+	consensusPubKey string,
+) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Sender:          sender,
+		ConsensusPubkey: consensusPubKey,
+	}
+}
+
+// ValidateBasic performs basic message validation.
+func (m *MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	consensusPubKey, err := base64.StdEncoding.DecodeString(m.ConsensusPubkey)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubKey, "failed to decode the consensus pub key")
+	}
+
+	if len(consensusPubKey) != ed25519.PubKeySize {
+		return errorsmod.Wrap(ErrInvalidPubKey, "incorrect consensus pub key size")
+	}
+
+	return nil
+}
@@ -19,13 +19,17 @@ func NewMsgCompleteDKG(
 	pubKeys []string,
 	consensusPubKey string,
 	signature string,
+	proofs []string,
+	verificationShares []string,
 ) *MsgCompleteDKG {
 	return &MsgCompleteDKG{
-		Sender:          sender,
-		Id:              id,
-		PubKeys:         pubKeys,
-		ConsensusPubkey: consensusPubKey,
-		Signature:       signature,
+		Sender:             sender,
+		Id:                 id,
+		PubKeys:            pubKeys,
+		ConsensusPubkey:    consensusPubKey,
+		Signature:          signature,
+		Proofs:             proofs,
+		VerificationShares: verificationShares,
 	}
 }
 
@@ -45,11 +49,33 @@ func (m *MsgCompleteDKG) ValidateBasic() error {
 			return errorsmod.Wrap(ErrInvalidPubKey, "failed to decode the pub key")
 		}
 
-		if _, err := schnorr.ParsePubKey(pkBytes); err != nil {
+		// the key type is checked against the dkg request later
+		if CheckPubKey(KeyType_KEY_TYPE_SCHNORR, pkBytes) != nil && CheckPubKey(KeyType_KEY_TYPE_ECDSA, pkBytes) != nil {
 			return ErrInvalidPubKey
 		}
 	}
 
+	if len(m.Proofs) != len(m.PubKeys) {
+		return errorsmod.Wrap(ErrInvalidPossessionProof, "mismatched proof count")
+	}
+
+	for _, proof := range m.Proofs {
+		proofBytes, err := hex.DecodeString(proof)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidPossessionProof, "failed to decode the proof")
+		}
+
+		if len(proofBytes) != schnorr.SignatureSize {
+			return errorsmod.Wrap(ErrInvalidPossessionProof, "incorrect proof size")
+		}
+	}
+
+	if len(m.VerificationShares) != 0 {
+		if _, err := ParseVerificationShares(m.VerificationShares); err != nil {
+			return err
+		}
+	}
+
 	consensusPubKey, err := base64.StdEncoding.DecodeString(m.ConsensusPubkey)
 	if err != nil {
 		return errorsmod.Wrap(ErrInvalidPubKey, "failed to decode the consensus pub key")
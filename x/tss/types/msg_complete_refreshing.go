@@ -16,12 +16,14 @@ func NewMsgCompleteRefreshing(
 	id uint64,
 	consensusPubKey string,
 	signature string,
+	verificationShares []string,
 ) *MsgCompleteRefreshing {
 	return &MsgCompleteRefreshing{
-		Sender:          sender,
-		Id:              id,
-		ConsensusPubkey: consensusPubKey,
-		Signature:       signature,
+		Sender:             sender,
+		Id:                 id,
+		ConsensusPubkey:    consensusPubKey,
+		Signature:          signature,
+		VerificationShares: verificationShares,
 	}
 }
 
@@ -31,6 +33,12 @@ func (m *MsgCompleteRefreshing) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid sender address")
 	}
 
+	if len(m.VerificationShares) != 0 {
+		if _, err := ParseVerificationShares(m.VerificationShares); err != nil {
+			return err
+		}
+	}
+
 	consensusPubKey, err := base64.StdEncoding.DecodeString(m.ConsensusPubkey)
 	if err != nil {
 		return errorsmod.Wrap(ErrInvalidPubKey, "failed to decode the consensus pub key")
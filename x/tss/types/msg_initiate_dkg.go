@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/base64"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgInitiateDKG{}
+
+// ValidateBasic performs basic MsgInitiateDKG message validation.
+func (m *MsgInitiateDKG) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := CheckDKGParticipantNum(len(m.Participants)); err != nil {
+		return err
+	}
+
+	participants := make(map[string]bool)
+
+	for _, p := range m.Participants {
+		if pubKey, err := base64.StdEncoding.DecodeString(p); err != nil || len(pubKey) != ed25519.PubKeySize {
+			return errorsmod.Wrap(ErrInvalidParticipants, "invalid participant consensus pub key")
+		}
+
+		if participants[p] {
+			return errorsmod.Wrap(ErrInvalidParticipants, "duplicate participant")
+		}
+
+		participants[p] = true
+	}
+
+	if err := CheckDKGThreshold(len(m.Participants), int(m.Threshold)); err != nil {
+		return err
+	}
+
+	if m.BatchSize == 0 {
+		return errorsmod.Wrap(ErrInvalidDKGs, "batch size must be greater than 0")
+	}
+
+	if _, ok := KeyType_name[int32(m.KeyType)]; !ok {
+		return errorsmod.Wrap(ErrInvalidDKGs, "invalid key type")
+	}
+
+	if m.TimeoutDuration < 0 {
+		return ErrInvalidTimeoutDuration
+	}
+
+	return nil
+}
@@ -20,13 +20,13 @@ func (m *MsgRefresh) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidDKGs, "dkgs cannot be empty")
 	}
 
-	if len(m.RemovedParticipants) == 0 {
-		return errorsmod.Wrap(ErrInvalidParticipants, "removed participants cannot be empty")
+	if len(m.RemovedParticipants) == 0 && len(m.AddedParticipants) == 0 && m.Threshold == 0 {
+		return errorsmod.Wrap(ErrInvalidParticipants, "no participant or threshold change")
 	}
 
 	participants := make(map[string]bool)
 
-	for _, p := range m.RemovedParticipants {
+	for _, p := range append(m.RemovedParticipants, m.AddedParticipants...) {
 		if pubKey, err := base64.StdEncoding.DecodeString(p); err != nil || len(pubKey) != ed25519.PubKeySize {
 			return errorsmod.Wrap(ErrInvalidParticipants, "invalid participant consensus pub key")
 		}
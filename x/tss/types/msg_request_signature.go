@@ -0,0 +1,43 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRequestSignature{}
+
+// ValidateBasic performs basic MsgRequestSignature message validation.
+func (m *MsgRequestSignature) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, ok := SigningType_name[int32(m.Type)]; !ok {
+		return errorsmod.Wrap(ErrInvalidSigningRequest, "invalid signing type")
+	}
+
+	if len(m.SigHashes) == 0 && len(m.Psbt) == 0 {
+		return errorsmod.Wrap(ErrInvalidSigningRequest, "sig hashes or psbt required")
+	}
+
+	if len(m.SigHashes) != 0 && len(m.Psbt) != 0 {
+		return errorsmod.Wrap(ErrInvalidSigningRequest, "sig hashes and psbt are mutually exclusive")
+	}
+
+	if err := CheckSigHashes(m.SigHashes); err != nil {
+		return err
+	}
+
+	if len(m.Psbt) != 0 {
+		if _, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(m.Psbt)), true); err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "invalid psbt")
+		}
+	}
+
+	return CheckSigningOptions(m.Type, m.Options)
+}
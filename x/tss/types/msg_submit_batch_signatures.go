@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitBatchSignatures{}
+
+func NewMsgSubmitBatchSignatures(
+	sender string,
+	id uint64,
+	signatures []*BatchSignatures,
+) *MsgSubmitBatchSignatures {
+	return &MsgSubmitBatchSignatures{
+		Sender:     sender,
+		Id:         id,
+		Signatures: signatures,
+	}
+}
+
+// ValidateBasic performs basic message validation.
+func (m *MsgSubmitBatchSignatures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Signatures) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignatures, "signatures cannot be empty")
+	}
+
+	signingIds := make(map[uint64]bool)
+
+	for _, batchSignatures := range m.Signatures {
+		if batchSignatures == nil || len(batchSignatures.Signatures) == 0 {
+			return errorsmod.Wrap(ErrInvalidSignatures, "signatures cannot be empty")
+		}
+
+		if signingIds[batchSignatures.SigningId] {
+			return errorsmod.Wrap(ErrInvalidSignatures, "duplicate signing id")
+		}
+
+		signingIds[batchSignatures.SigningId] = true
+
+		for _, signature := range batchSignatures.Signatures {
+			sigBytes, err := hex.DecodeString(signature)
+			if err != nil {
+				return errorsmod.Wrap(ErrInvalidSignature, "failed to decode the signature")
+			}
+
+			if len(sigBytes) != SchnorrSignatureSize && len(sigBytes) != SchnorrAdaptorSignatureSize && (len(sigBytes) < MinECDSASignatureSize || len(sigBytes) > MaxECDSASignatureSize) {
+				return errorsmod.Wrap(ErrInvalidSignature, "invalid signature size")
+			}
+		}
+	}
+
+	return nil
+}
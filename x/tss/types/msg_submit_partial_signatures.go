@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitPartialSignatures{}
+
+func NewMsgSubmitPartialSignatures(
+	sender string,
+	id uint64,
+	consensusPubKey string,
+	signers []string,
+	aggregatedNonces []string,
+	nonces []string,
+	partialSignatures []string,
+	signature string,
+) *MsgSubmitPartialSignatures {
+	return &MsgSubmitPartialSignatures{
+		Sender:            sender,
+		Id:                id,
+		ConsensusPubkey:   consensusPubKey,
+		Nonces:            nonces,
+		PartialSignatures: partialSignatures,
+		Signature:         signature,
+		Signers:           signers,
+		AggregatedNonces:  aggregatedNonces,
+	}
+}
+
+// ValidateBasic performs basic message validation.
+func (m *MsgSubmitPartialSignatures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	consensusPubKey, err := base64.StdEncoding.DecodeString(m.ConsensusPubkey)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubKey, "failed to decode the consensus pub key")
+	}
+
+	if len(consensusPubKey) != ed25519.PubKeySize {
+		return errorsmod.Wrap(ErrInvalidPubKey, "incorrect consensus pub key size")
+	}
+
+	if len(m.Signers) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignatures, "signers cannot be empty")
+	}
+
+	for _, signer := range m.Signers {
+		signerBytes, err := base64.StdEncoding.DecodeString(signer)
+		if err != nil || len(signerBytes) != ed25519.PubKeySize {
+			return errorsmod.Wrap(ErrInvalidPubKey, "invalid signer")
+		}
+	}
+
+	if err := CheckPartialSignatures(m.AggregatedNonces, m.Nonces, m.PartialSignatures); err != nil {
+		return err
+	}
+
+	sigBytes, err := hex.DecodeString(m.Signature)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidSignature, "failed to decode the signature")
+	}
+
+	if len(sigBytes) != ed25519.SignatureSize {
+		return errorsmod.Wrap(ErrInvalidSignature, "incorrect signature size")
+	}
+
+	return nil
+}
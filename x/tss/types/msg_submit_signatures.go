@@ -37,7 +37,7 @@ func (m *MsgSubmitSignatures) ValidateBasic() error {
 			return errorsmod.Wrap(ErrInvalidSignature, "failed to decode the signature")
 		}
 
-		if len(sigBytes) != SchnorrSignatureSize && len(sigBytes) != SchnorrAdaptorSignatureSize {
+		if len(sigBytes) != SchnorrSignatureSize && len(sigBytes) != SchnorrAdaptorSignatureSize && (len(sigBytes) < MinECDSASignatureSize || len(sigBytes) > MaxECDSASignatureSize) {
 			return errorsmod.Wrap(ErrInvalidSignature, "invalid signature size")
 		}
 	}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// DKG type of the nonce generation
+	DKGTypeNonce = "nonce"
+)
+
+// IsManagedDKG returns true if the nonce queue is maintained for the DKG of the given module and type, false otherwise
+func (p NonceQueueParams) IsManagedDKG(module string, ty string) bool {
+	if p.QueueSize == 0 {
+		return false
+	}
+
+	for _, dkg := range p.Dkgs {
+		if dkg.Module == module && dkg.Type == ty {
+			return true
+		}
+	}
+
+	return false
+}
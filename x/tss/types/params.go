@@ -5,6 +5,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -15,6 +16,36 @@ var (
 
 	// default DKG timeout duration
 	DefaultDKGTimeoutDuration = time.Duration(86400) * time.Second // 1 day
+
+	// default signing timeout duration
+	DefaultSigningTimeoutDuration = time.Duration(86400) * time.Second // 1 day
+
+	// default number of faults which triggers the participant penalty
+	DefaultMaxFaults = uint32(10)
+
+	// default slash fraction for the participant penalty
+	DefaultSlashFraction = sdkmath.LegacyNewDecWithPrec(1, 3) // 0.1%
+
+	// default jail duration for the participant penalty
+	DefaultJailDuration = time.Duration(600) * time.Second // 10 minutes
+
+	// default delay before the participant set is updated
+	DefaultParticipantSetUpdateDelay = time.Duration(86400) * time.Second // 1 day
+
+	// default target number of committed nonces in each nonce queue
+	DefaultNonceQueueSize = uint32(100)
+
+	// default number of nonces generated in one round
+	DefaultNonceGenerationBatchSize = uint32(20)
+
+	// default block interval at which the nonce queues are replenished
+	DefaultNonceGenerationInterval = int64(50) // 50 blocks
+
+	// default nonce generation timeout duration
+	DefaultNonceGenerationTimeoutDuration = time.Duration(86400) * time.Second // 1 day
+
+	// default maximum number of times a timed out signing request can be re-initiated
+	DefaultMaxSigningRetries = uint32(10)
 )
 
 // NewParams creates a new Params instance
@@ -22,6 +53,28 @@ func NewParams() Params {
 	return Params{
 		AllowedDkgParticipants: []DKGParticipant{},
 		DkgTimeoutDuration:     DefaultDKGTimeoutDuration,
+		SigningTimeoutDuration: DefaultSigningTimeoutDuration,
+		ModuleSigningTimeouts:  []ModuleSigningTimeout{},
+		ParticipantPenaltyParams: ParticipantPenaltyParams{
+			MaxFaults:     DefaultMaxFaults,
+			SlashFraction: DefaultSlashFraction,
+			JailDuration:  DefaultJailDuration,
+		},
+		ParticipantSetParams: ParticipantSetParams{
+			Enabled:     false,
+			UpdateDelay: DefaultParticipantSetUpdateDelay,
+			ManagedDkgs: []ManagedDKG{},
+		},
+		NonceQueueParams: NonceQueueParams{
+			QueueSize:                 DefaultNonceQueueSize,
+			GenerationBatchSize:       DefaultNonceGenerationBatchSize,
+			GenerationInterval:        DefaultNonceGenerationInterval,
+			GenerationTimeoutDuration: DefaultNonceGenerationTimeoutDuration,
+			Dkgs:                      []ManagedDKG{},
+		},
+		KeyRotationPolicies: []KeyRotationPolicy{},
+		MaxSigningRetries:   DefaultMaxSigningRetries,
+		ModuleSigningFees:   []ModuleSigningFee{},
 	}
 }
 
@@ -40,6 +93,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSigningTimeouts(p.SigningTimeoutDuration, p.ModuleSigningTimeouts); err != nil {
+		return err
+	}
+
+	if err := validateParticipantPenaltyParams(&p.ParticipantPenaltyParams); err != nil {
+		return err
+	}
+
+	if err := validateParticipantSetParams(&p.ParticipantSetParams); err != nil {
+		return err
+	}
+
+	if err := validateNonceQueueParams(&p.NonceQueueParams); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationPolicies(p.KeyRotationPolicies); err != nil {
+		return err
+	}
+
+	if err := validateModuleSigningFees(p.ModuleSigningFees); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -88,3 +165,149 @@ func validateDKGTimeoutDuration(timeoutDuration time.Duration) error {
 
 	return nil
 }
+
+// validateSigningTimeouts validates the given signing timeouts
+func validateSigningTimeouts(timeoutDuration time.Duration, moduleTimeouts []ModuleSigningTimeout) error {
+	if timeoutDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid signing timeout duration")
+	}
+
+	modules := make(map[string]bool)
+
+	for _, t := range moduleTimeouts {
+		if len(t.Module) == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "module cannot be empty")
+		}
+
+		if modules[t.Module] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate module %s", t.Module)
+		}
+
+		modules[t.Module] = true
+
+		if t.TimeoutDuration < 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid signing timeout duration for module %s", t.Module)
+		}
+	}
+
+	return nil
+}
+
+// validateParticipantPenaltyParams validates the given participant penalty params
+func validateParticipantPenaltyParams(params *ParticipantPenaltyParams) error {
+	if params.SlashFraction.IsNil() || params.SlashFraction.IsNegative() || params.SlashFraction.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "slash fraction must be between 0 and 1")
+	}
+
+	if params.JailDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid jail duration")
+	}
+
+	return nil
+}
+
+// validateParticipantSetParams validates the given participant set params
+func validateParticipantSetParams(params *ParticipantSetParams) error {
+	if params.Enabled && int(params.MaxParticipants) < MinDKGParticipantNum {
+		return errorsmod.Wrapf(ErrInvalidParams, "maximum participants cannot be less than min participant number %d", MinDKGParticipantNum)
+	}
+
+	if params.UpdateDelay < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid participant set update delay")
+	}
+
+	return validateManagedDKGs(params.ManagedDkgs)
+}
+
+// validateNonceQueueParams validates the given nonce queue params
+func validateNonceQueueParams(params *NonceQueueParams) error {
+	if params.QueueSize > 0 {
+		if params.GenerationBatchSize == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "nonce generation batch size must be greater than 0")
+		}
+
+		if params.GenerationInterval <= 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "nonce generation interval must be greater than 0")
+		}
+
+		if params.GenerationTimeoutDuration <= 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid nonce generation timeout duration")
+		}
+	}
+
+	return validateManagedDKGs(params.Dkgs)
+}
+
+// validateManagedDKGs validates the given managed DKGs
+func validateManagedDKGs(dkgs []ManagedDKG) error {
+	managedDKGs := make(map[ManagedDKG]bool)
+
+	for _, dkg := range dkgs {
+		if len(dkg.Module) == 0 || len(dkg.Type) == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "module and type of the managed dkg cannot be empty")
+		}
+
+		if managedDKGs[dkg] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate managed dkg %s/%s", dkg.Module, dkg.Type)
+		}
+
+		managedDKGs[dkg] = true
+	}
+
+	return nil
+}
+
+// validateKeyRotationPolicies validates the given key rotation policies
+func validateKeyRotationPolicies(policies []KeyRotationPolicy) error {
+	keys := make(map[ManagedDKG]bool)
+
+	for _, policy := range policies {
+		if len(policy.Module) == 0 || len(policy.Type) == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "module and type of the key rotation policy cannot be empty")
+		}
+
+		key := ManagedDKG{Module: policy.Module, Type: policy.Type}
+		if keys[key] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate key rotation policy %s/%s", policy.Module, policy.Type)
+		}
+
+		keys[key] = true
+
+		if _, ok := KeyRotationMethod_name[int32(policy.Method)]; !ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid key rotation method for %s/%s", policy.Module, policy.Type)
+		}
+
+		if policy.MaxAge <= 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid maximum key age for %s/%s", policy.Module, policy.Type)
+		}
+
+		if policy.OverlapWindow < 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid overlap window for %s/%s", policy.Module, policy.Type)
+		}
+	}
+
+	return nil
+}
+
+// validateModuleSigningFees validates the given module signing fees
+func validateModuleSigningFees(fees []ModuleSigningFee) error {
+	modules := make(map[string]bool)
+
+	for _, f := range fees {
+		if len(f.Module) == 0 || len(f.PayerModule) == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "module and payer module of the signing fee cannot be empty")
+		}
+
+		if modules[f.Module] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate signing fee for module %s", f.Module)
+		}
+
+		modules[f.Module] = true
+
+		if !f.Fee.IsValid() || f.Fee.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid signing fee for module %s", f.Module)
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -27,10 +31,54 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Key Rotation Method
+type KeyRotationMethod int32
+
+const (
+	// refresh the key shares of the TSS keys while keeping the pub keys unchanged
+	KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH KeyRotationMethod = 0
+	// generate the new keys by the module and retire the old keys after the overlap window
+	KeyRotationMethod_KEY_ROTATION_METHOD_REDKG KeyRotationMethod = 1
+)
+
+var KeyRotationMethod_name = map[int32]string{
+	0: "KEY_ROTATION_METHOD_REFRESH",
+	1: "KEY_ROTATION_METHOD_REDKG",
+}
+
+var KeyRotationMethod_value = map[string]int32{
+	"KEY_ROTATION_METHOD_REFRESH": 0,
+	"KEY_ROTATION_METHOD_REDKG":   1,
+}
+
+func (x KeyRotationMethod) String() string {
+	return proto.EnumName(KeyRotationMethod_name, int32(x))
+}
+
+func (KeyRotationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	AllowedDkgParticipants []DKGParticipant `protobuf:"bytes,1,rep,name=allowed_dkg_participants,json=allowedDkgParticipants,proto3" json:"allowed_dkg_participants"`
 	DkgTimeoutDuration     time.Duration    `protobuf:"bytes,2,opt,name=dkg_timeout_duration,json=dkgTimeoutDuration,proto3,stdduration" json:"dkg_timeout_duration"`
+	// default signing timeout duration; 0 means no timeout
+	SigningTimeoutDuration time.Duration `protobuf:"bytes,3,opt,name=signing_timeout_duration,json=signingTimeoutDuration,proto3,stdduration" json:"signing_timeout_duration"`
+	// module specific signing timeout durations which override the default one
+	ModuleSigningTimeouts []ModuleSigningTimeout `protobuf:"bytes,4,rep,name=module_signing_timeouts,json=moduleSigningTimeouts,proto3" json:"module_signing_timeouts"`
+	// penalty params for the faulty participants
+	ParticipantPenaltyParams ParticipantPenaltyParams `protobuf:"bytes,5,opt,name=participant_penalty_params,json=participantPenaltyParams,proto3" json:"participant_penalty_params"`
+	// params for the automatically maintained participant set
+	ParticipantSetParams ParticipantSetParams `protobuf:"bytes,6,opt,name=participant_set_params,json=participantSetParams,proto3" json:"participant_set_params"`
+	// params for the pre-committed nonce queues
+	NonceQueueParams NonceQueueParams `protobuf:"bytes,7,opt,name=nonce_queue_params,json=nonceQueueParams,proto3" json:"nonce_queue_params"`
+	// policies for the scheduled key rotation
+	KeyRotationPolicies []KeyRotationPolicy `protobuf:"bytes,8,rep,name=key_rotation_policies,json=keyRotationPolicies,proto3" json:"key_rotation_policies"`
+	// maximum number of times a timed out signing request can be re-initiated; 0 means unlimited
+	MaxSigningRetries uint32 `protobuf:"varint,9,opt,name=max_signing_retries,json=maxSigningRetries,proto3" json:"max_signing_retries,omitempty"`
+	// module specific fees attached to the signing requests initiated by the modules
+	ModuleSigningFees []ModuleSigningFee `protobuf:"bytes,10,rep,name=module_signing_fees,json=moduleSigningFees,proto3" json:"module_signing_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +128,238 @@ func (m *Params) GetDkgTimeoutDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSigningTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.SigningTimeoutDuration
+	}
+	return 0
+}
+
+func (m *Params) GetModuleSigningTimeouts() []ModuleSigningTimeout {
+	if m != nil {
+		return m.ModuleSigningTimeouts
+	}
+	return nil
+}
+
+func (m *Params) GetParticipantPenaltyParams() ParticipantPenaltyParams {
+	if m != nil {
+		return m.ParticipantPenaltyParams
+	}
+	return ParticipantPenaltyParams{}
+}
+
+func (m *Params) GetParticipantSetParams() ParticipantSetParams {
+	if m != nil {
+		return m.ParticipantSetParams
+	}
+	return ParticipantSetParams{}
+}
+
+func (m *Params) GetNonceQueueParams() NonceQueueParams {
+	if m != nil {
+		return m.NonceQueueParams
+	}
+	return NonceQueueParams{}
+}
+
+func (m *Params) GetKeyRotationPolicies() []KeyRotationPolicy {
+	if m != nil {
+		return m.KeyRotationPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSigningRetries() uint32 {
+	if m != nil {
+		return m.MaxSigningRetries
+	}
+	return 0
+}
+
+func (m *Params) GetModuleSigningFees() []ModuleSigningFee {
+	if m != nil {
+		return m.ModuleSigningFees
+	}
+	return nil
+}
+
+// Participant Penalty Params
+type ParticipantPenaltyParams struct {
+	// number of faults which triggers the penalty; 0 means no penalty
+	MaxFaults uint32 `protobuf:"varint,1,opt,name=max_faults,json=maxFaults,proto3" json:"max_faults,omitempty"`
+	// fraction of the validator stake to be slashed
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail duration; 0 means no jailing
+	JailDuration time.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *ParticipantPenaltyParams) Reset()         { *m = ParticipantPenaltyParams{} }
+func (m *ParticipantPenaltyParams) String() string { return proto.CompactTextString(m) }
+func (*ParticipantPenaltyParams) ProtoMessage()    {}
+func (*ParticipantPenaltyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{1}
+}
+func (m *ParticipantPenaltyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantPenaltyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantPenaltyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantPenaltyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantPenaltyParams.Merge(m, src)
+}
+func (m *ParticipantPenaltyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantPenaltyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantPenaltyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantPenaltyParams proto.InternalMessageInfo
+
+func (m *ParticipantPenaltyParams) GetMaxFaults() uint32 {
+	if m != nil {
+		return m.MaxFaults
+	}
+	return 0
+}
+
+func (m *ParticipantPenaltyParams) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// Module Signing Fee
+type ModuleSigningFee struct {
+	// module name
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// name of the module account from which the fee is paid, e.g. gov for the default protocol fee collector
+	PayerModule string `protobuf:"bytes,2,opt,name=payer_module,json=payerModule,proto3" json:"payer_module,omitempty"`
+	// fee attached to each signing request initiated by the module
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *ModuleSigningFee) Reset()         { *m = ModuleSigningFee{} }
+func (m *ModuleSigningFee) String() string { return proto.CompactTextString(m) }
+func (*ModuleSigningFee) ProtoMessage()    {}
+func (*ModuleSigningFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{2}
+}
+func (m *ModuleSigningFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSigningFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSigningFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSigningFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSigningFee.Merge(m, src)
+}
+func (m *ModuleSigningFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSigningFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSigningFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSigningFee proto.InternalMessageInfo
+
+func (m *ModuleSigningFee) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleSigningFee) GetPayerModule() string {
+	if m != nil {
+		return m.PayerModule
+	}
+	return ""
+}
+
+func (m *ModuleSigningFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// Module Signing Timeout
+type ModuleSigningTimeout struct {
+	// module name
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// signing timeout duration; 0 means no timeout
+	TimeoutDuration time.Duration `protobuf:"bytes,2,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+}
+
+func (m *ModuleSigningTimeout) Reset()         { *m = ModuleSigningTimeout{} }
+func (m *ModuleSigningTimeout) String() string { return proto.CompactTextString(m) }
+func (*ModuleSigningTimeout) ProtoMessage()    {}
+func (*ModuleSigningTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{3}
+}
+func (m *ModuleSigningTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSigningTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSigningTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSigningTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSigningTimeout.Merge(m, src)
+}
+func (m *ModuleSigningTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSigningTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSigningTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSigningTimeout proto.InternalMessageInfo
+
+func (m *ModuleSigningTimeout) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleSigningTimeout) GetTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.TimeoutDuration
+	}
+	return 0
+}
+
 // DKG Participant
 type DKGParticipant struct {
 	// the optional moniker
@@ -92,7 +372,7 @@ func (m *DKGParticipant) Reset()         { *m = DKGParticipant{} }
 func (m *DKGParticipant) String() string { return proto.CompactTextString(m) }
 func (*DKGParticipant) ProtoMessage()    {}
 func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_092869df146f1ba1, []int{1}
+	return fileDescriptor_092869df146f1ba1, []int{4}
 }
 func (m *DKGParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,172 +415,2319 @@ func (m *DKGParticipant) GetConsensusPubkey() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "bitway.tss.Params")
-	proto.RegisterType((*DKGParticipant)(nil), "bitway.tss.DKGParticipant")
+// Participant Set Params
+type ParticipantSetParams struct {
+	// indicates if the participant set is maintained automatically by the top bonded validators
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// maximum number of participants, i.e. the top N bonded validators by power
+	MaxParticipants uint32 `protobuf:"varint,2,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// number of new participants which triggers the resharing of the managed DKGs; 0 means no resharing
+	DriftThreshold uint32 `protobuf:"varint,3,opt,name=drift_threshold,json=driftThreshold,proto3" json:"drift_threshold,omitempty"`
+	// delay after the validator set changes before the participant set is updated
+	UpdateDelay time.Duration `protobuf:"bytes,4,opt,name=update_delay,json=updateDelay,proto3,stdduration" json:"update_delay"`
+	// DKGs to be maintained automatically along with the participant set
+	ManagedDkgs []ManagedDKG `protobuf:"bytes,5,rep,name=managed_dkgs,json=managedDkgs,proto3" json:"managed_dkgs"`
 }
 
-func init() { proto.RegisterFile("bitway/tss/params.proto", fileDescriptor_092869df146f1ba1) }
+func (m *ParticipantSetParams) Reset()         { *m = ParticipantSetParams{} }
+func (m *ParticipantSetParams) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSetParams) ProtoMessage()    {}
+func (*ParticipantSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{5}
+}
+func (m *ParticipantSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSetParams.Merge(m, src)
+}
+func (m *ParticipantSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSetParams.DiscardUnknown(m)
+}
 
-var fileDescriptor_092869df146f1ba1 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xbd, 0x4e, 0xc3, 0x30,
-	0x18, 0x8c, 0x01, 0x15, 0xea, 0x4a, 0x80, 0xa2, 0x0a, 0x42, 0x07, 0xb7, 0xea, 0x14, 0x16, 0x5b,
-	0x2a, 0x6f, 0x10, 0x55, 0x62, 0x60, 0xa9, 0x22, 0xba, 0x74, 0x89, 0x9c, 0xc6, 0x18, 0x2b, 0x3f,
-	0x8e, 0x62, 0x47, 0xa5, 0x6f, 0xc1, 0xc8, 0xbb, 0xf0, 0x02, 0x1d, 0x3b, 0x32, 0x01, 0x6a, 0x5f,
-	0x04, 0xd5, 0x6e, 0x28, 0x6c, 0xdf, 0x77, 0x77, 0xbe, 0x3b, 0xdb, 0xf0, 0x3a, 0x16, 0x7a, 0x41,
-	0x97, 0x44, 0x2b, 0x45, 0x4a, 0x5a, 0xd1, 0x5c, 0xe1, 0xb2, 0x92, 0x5a, 0xba, 0xd0, 0x12, 0x58,
-	0x2b, 0xd5, 0xeb, 0x72, 0xc9, 0xa5, 0x81, 0xc9, 0x6e, 0xb2, 0x8a, 0x1e, 0xe2, 0x52, 0xf2, 0x8c,
-	0x11, 0xb3, 0xc5, 0xf5, 0x13, 0x49, 0xea, 0x8a, 0x6a, 0x21, 0x0b, 0xcb, 0x0f, 0xdf, 0x01, 0x6c,
-	0x4d, 0x8c, 0xa5, 0x3b, 0x83, 0x1e, 0xcd, 0x32, 0xb9, 0x60, 0x49, 0x94, 0xa4, 0x3c, 0x2a, 0x69,
-	0xa5, 0xc5, 0x5c, 0x94, 0xb4, 0xd0, 0xca, 0x03, 0x83, 0x63, 0xbf, 0x33, 0xea, 0xe1, 0x43, 0x1e,
-	0x1e, 0x3f, 0xdc, 0x4f, 0x0e, 0x92, 0xe0, 0x64, 0xf5, 0xd9, 0x77, 0xc2, 0xab, 0xbd, 0xc3, 0x38,
-	0xe5, 0x7f, 0x48, 0xe5, 0x4e, 0x61, 0x77, 0xe7, 0xa9, 0x45, 0xce, 0x64, 0xad, 0xa3, 0xa6, 0x84,
-	0x77, 0x34, 0x00, 0x7e, 0x67, 0x74, 0x83, 0x6d, 0x4b, 0xdc, 0xb4, 0xc4, 0xe3, 0xbd, 0x20, 0x38,
-	0xdb, 0xd9, 0xbe, 0x7d, 0xf5, 0x41, 0xe8, 0x26, 0x29, 0x7f, 0xb4, 0xe7, 0x1b, 0x76, 0x38, 0x85,
-	0xe7, 0xff, 0x6b, 0xb8, 0x1e, 0x3c, 0xcd, 0x65, 0x21, 0x52, 0x56, 0x79, 0x60, 0x00, 0xfc, 0x76,
-	0xd8, 0xac, 0xee, 0x2d, 0xbc, 0x9c, 0xcb, 0x42, 0xb1, 0x42, 0xd5, 0x2a, 0x2a, 0xeb, 0x38, 0x65,
-	0x4b, 0x13, 0xdf, 0x0e, 0x2f, 0x7e, 0xf1, 0x89, 0x81, 0x83, 0x60, 0xb5, 0x41, 0x60, 0xbd, 0x41,
-	0xe0, 0x7b, 0x83, 0xc0, 0xeb, 0x16, 0x39, 0xeb, 0x2d, 0x72, 0x3e, 0xb6, 0xc8, 0x99, 0xf9, 0x5c,
-	0xe8, 0xe7, 0x3a, 0xc6, 0x73, 0x99, 0x13, 0xfb, 0x16, 0x19, 0x8d, 0xd5, 0x7e, 0x24, 0x2f, 0xe6,
-	0x87, 0xf4, 0xb2, 0x64, 0x2a, 0x6e, 0x99, 0xbb, 0xdc, 0xfd, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9f,
-	0x29, 0xf2, 0x8a, 0xbc, 0x01, 0x00, 0x00,
+var xxx_messageInfo_ParticipantSetParams proto.InternalMessageInfo
+
+func (m *ParticipantSetParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParticipantSetParams) GetMaxParticipants() uint32 {
+	if m != nil {
+		return m.MaxParticipants
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParticipantSetParams) GetDriftThreshold() uint32 {
+	if m != nil {
+		return m.DriftThreshold
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutDuration):])
-	if err1 != nil {
-		return 0, err1
+func (m *ParticipantSetParams) GetUpdateDelay() time.Duration {
+	if m != nil {
+		return m.UpdateDelay
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.AllowedDkgParticipants) > 0 {
-		for iNdEx := len(m.AllowedDkgParticipants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedDkgParticipants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *ParticipantSetParams) GetManagedDkgs() []ManagedDKG {
+	if m != nil {
+		return m.ManagedDkgs
+	}
+	return nil
+}
+
+// Managed DKG
+type ManagedDKG struct {
+	// module which initiates the DKG
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// DKG type
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *ManagedDKG) Reset()         { *m = ManagedDKG{} }
+func (m *ManagedDKG) String() string { return proto.CompactTextString(m) }
+func (*ManagedDKG) ProtoMessage()    {}
+func (*ManagedDKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{6}
+}
+func (m *ManagedDKG) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedDKG.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManagedDKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedDKG.Merge(m, src)
+}
+func (m *ManagedDKG) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedDKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedDKG.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedDKG proto.InternalMessageInfo
+
+func (m *ManagedDKG) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ManagedDKG) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// Nonce Queue Params
+type NonceQueueParams struct {
+	// target number of committed nonces in the queue of each DKG; 0 means no nonce queue
+	QueueSize uint32 `protobuf:"varint,1,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// number of nonces generated in one round
+	GenerationBatchSize uint32 `protobuf:"varint,2,opt,name=generation_batch_size,json=generationBatchSize,proto3" json:"generation_batch_size,omitempty"`
+	// block interval at which the nonce queues are replenished
+	GenerationInterval int64 `protobuf:"varint,3,opt,name=generation_interval,json=generationInterval,proto3" json:"generation_interval,omitempty"`
+	// timeout duration of the nonce generation
+	GenerationTimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=generation_timeout_duration,json=generationTimeoutDuration,proto3,stdduration" json:"generation_timeout_duration"`
+	// DKGs for which the nonce queues are maintained
+	Dkgs []ManagedDKG `protobuf:"bytes,5,rep,name=dkgs,proto3" json:"dkgs"`
+}
+
+func (m *NonceQueueParams) Reset()         { *m = NonceQueueParams{} }
+func (m *NonceQueueParams) String() string { return proto.CompactTextString(m) }
+func (*NonceQueueParams) ProtoMessage()    {}
+func (*NonceQueueParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{7}
+}
+func (m *NonceQueueParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonceQueueParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonceQueueParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonceQueueParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonceQueueParams.Merge(m, src)
+}
+func (m *NonceQueueParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonceQueueParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonceQueueParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonceQueueParams proto.InternalMessageInfo
+
+func (m *NonceQueueParams) GetQueueSize() uint32 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
+
+func (m *NonceQueueParams) GetGenerationBatchSize() uint32 {
+	if m != nil {
+		return m.GenerationBatchSize
+	}
+	return 0
+}
+
+func (m *NonceQueueParams) GetGenerationInterval() int64 {
+	if m != nil {
+		return m.GenerationInterval
+	}
+	return 0
+}
+
+func (m *NonceQueueParams) GetGenerationTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.GenerationTimeoutDuration
+	}
+	return 0
+}
+
+func (m *NonceQueueParams) GetDkgs() []ManagedDKG {
+	if m != nil {
+		return m.Dkgs
+	}
+	return nil
+}
+
+// Key Rotation Policy
+type KeyRotationPolicy struct {
+	// module which owns the keys
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// key type defined by the module, i.e. the DKG type for the refreshing method
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// rotation method
+	Method KeyRotationMethod `protobuf:"varint,3,opt,name=method,proto3,enum=bitway.tss.KeyRotationMethod" json:"method,omitempty"`
+	// maximum age of the keys after which the rotation is initiated
+	MaxAge time.Duration `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+	// overlap window during which both the old and new keys are in use; only for the re-DKG method
+	OverlapWindow time.Duration `protobuf:"bytes,5,opt,name=overlap_window,json=overlapWindow,proto3,stdduration" json:"overlap_window"`
+}
+
+func (m *KeyRotationPolicy) Reset()         { *m = KeyRotationPolicy{} }
+func (m *KeyRotationPolicy) String() string { return proto.CompactTextString(m) }
+func (*KeyRotationPolicy) ProtoMessage()    {}
+func (*KeyRotationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092869df146f1ba1, []int{8}
+}
+func (m *KeyRotationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationPolicy.Merge(m, src)
+}
+func (m *KeyRotationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationPolicy proto.InternalMessageInfo
+
+func (m *KeyRotationPolicy) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *KeyRotationPolicy) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *KeyRotationPolicy) GetMethod() KeyRotationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return KeyRotationMethod_KEY_ROTATION_METHOD_REFRESH
+}
+
+func (m *KeyRotationPolicy) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *KeyRotationPolicy) GetOverlapWindow() time.Duration {
+	if m != nil {
+		return m.OverlapWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("bitway.tss.KeyRotationMethod", KeyRotationMethod_name, KeyRotationMethod_value)
+	proto.RegisterType((*Params)(nil), "bitway.tss.Params")
+	proto.RegisterType((*ParticipantPenaltyParams)(nil), "bitway.tss.ParticipantPenaltyParams")
+	proto.RegisterType((*ModuleSigningFee)(nil), "bitway.tss.ModuleSigningFee")
+	proto.RegisterType((*ModuleSigningTimeout)(nil), "bitway.tss.ModuleSigningTimeout")
+	proto.RegisterType((*DKGParticipant)(nil), "bitway.tss.DKGParticipant")
+	proto.RegisterType((*ParticipantSetParams)(nil), "bitway.tss.ParticipantSetParams")
+	proto.RegisterType((*ManagedDKG)(nil), "bitway.tss.ManagedDKG")
+	proto.RegisterType((*NonceQueueParams)(nil), "bitway.tss.NonceQueueParams")
+	proto.RegisterType((*KeyRotationPolicy)(nil), "bitway.tss.KeyRotationPolicy")
+}
+
+func init() { proto.RegisterFile("bitway/tss/params.proto", fileDescriptor_092869df146f1ba1) }
+
+var fileDescriptor_092869df146f1ba1 = []byte{
+	// 1131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0x8e, 0xe3, 0xd4, 0x6d, 0x4e, 0xfe, 0x39, 0x93, 0x34, 0xdd, 0xa4, 0xbf, 0x3a, 0xfe, 0x59,
+	0x48, 0x04, 0xa4, 0xee, 0xb6, 0x45, 0x48, 0x5c, 0x20, 0xa1, 0x1a, 0x27, 0x4d, 0x09, 0x49, 0xcc,
+	0x26, 0x55, 0xa1, 0xa2, 0xac, 0xc6, 0xbb, 0xe3, 0xf5, 0xe2, 0xdd, 0x9d, 0x65, 0x67, 0x9c, 0xd8,
+	0xbd, 0x40, 0xbc, 0x00, 0x12, 0x97, 0x3c, 0x03, 0x82, 0x3b, 0x1e, 0xa2, 0x97, 0x15, 0x57, 0x08,
+	0x89, 0x16, 0x25, 0x4f, 0xc0, 0x1b, 0xa0, 0xf9, 0xe3, 0x78, 0xed, 0x38, 0x52, 0x02, 0x57, 0xd9,
+	0x39, 0xdf, 0x39, 0xdf, 0x9c, 0xf3, 0xcd, 0x39, 0xc7, 0x81, 0x5b, 0x8d, 0x80, 0x1f, 0xe3, 0x9e,
+	0xc5, 0x19, 0xb3, 0x12, 0x9c, 0xe2, 0x88, 0x99, 0x49, 0x4a, 0x39, 0x45, 0xa0, 0x00, 0x93, 0x33,
+	0xb6, 0xb6, 0xec, 0x53, 0x9f, 0x4a, 0xb3, 0x25, 0xbe, 0x94, 0xc7, 0xda, 0xaa, 0x4b, 0x59, 0x44,
+	0x99, 0xa3, 0x00, 0x75, 0xd0, 0x50, 0xc9, 0xa7, 0xd4, 0x0f, 0x89, 0x25, 0x4f, 0x8d, 0x4e, 0xd3,
+	0xf2, 0x3a, 0x29, 0xe6, 0x01, 0x8d, 0xfb, 0xb8, 0xf2, 0xb6, 0x1a, 0x98, 0x11, 0xeb, 0xe8, 0x7e,
+	0x83, 0x70, 0x7c, 0xdf, 0x72, 0x69, 0xa0, 0xf1, 0xca, 0xdf, 0x05, 0x28, 0xd4, 0x65, 0x36, 0xe8,
+	0x19, 0x18, 0x38, 0x0c, 0xe9, 0x31, 0xf1, 0x1c, 0xaf, 0xed, 0x3b, 0x09, 0x4e, 0x79, 0xe0, 0x06,
+	0x09, 0x8e, 0x39, 0x33, 0x72, 0xe5, 0xfc, 0xc6, 0xcc, 0x83, 0x35, 0x73, 0x90, 0xaa, 0x59, 0xdb,
+	0x79, 0x54, 0x1f, 0xb8, 0x54, 0xa7, 0x5e, 0xbe, 0x5e, 0x9f, 0xb0, 0x57, 0x34, 0x43, 0xad, 0xed,
+	0x67, 0x40, 0x86, 0x9e, 0xc0, 0xb2, 0xe0, 0xe4, 0x41, 0x44, 0x68, 0x87, 0x3b, 0xfd, 0x24, 0x8d,
+	0xc9, 0x72, 0x6e, 0x63, 0xe6, 0xc1, 0xaa, 0xa9, 0xaa, 0x30, 0xfb, 0x55, 0x98, 0x35, 0xed, 0x50,
+	0xbd, 0x21, 0x68, 0x7f, 0x7c, 0xb3, 0x9e, 0xb3, 0x91, 0xd7, 0xf6, 0x0f, 0x55, 0x7c, 0x1f, 0x45,
+	0xcf, 0xc1, 0x60, 0x81, 0x1f, 0x07, 0xf1, 0x18, 0xea, 0xfc, 0xe5, 0xa9, 0x57, 0x34, 0xc9, 0x28,
+	0xfd, 0x57, 0x70, 0x2b, 0xa2, 0x5e, 0x27, 0x24, 0xce, 0xc8, 0x2d, 0xcc, 0x98, 0x92, 0x82, 0x94,
+	0xb3, 0x82, 0xec, 0x4a, 0xd7, 0x83, 0x21, 0x2a, 0x2d, 0xcb, 0xcd, 0x68, 0x0c, 0xc6, 0x50, 0x0b,
+	0xd6, 0x32, 0x2a, 0x3b, 0x09, 0x89, 0x71, 0xc8, 0x7b, 0x8e, 0xea, 0x0e, 0xe3, 0x9a, 0x2c, 0xe0,
+	0xad, 0xec, 0x15, 0x19, 0x4d, 0xeb, 0xca, 0x59, 0xbd, 0x9d, 0xbe, 0xc6, 0x48, 0x2e, 0xc0, 0xd1,
+	0x97, 0xb0, 0x92, 0xbd, 0x89, 0x11, 0xde, 0xbf, 0xa5, 0x50, 0xce, 0x8d, 0x16, 0x92, 0xb9, 0xe5,
+	0x80, 0xf0, 0xa1, 0x1b, 0x96, 0x93, 0x31, 0x18, 0xaa, 0x03, 0x8a, 0x69, 0xec, 0x12, 0xe7, 0x9b,
+	0x0e, 0xe9, 0x90, 0x3e, 0xf3, 0x75, 0xc9, 0xfc, 0xbf, 0x2c, 0xf3, 0x9e, 0xf0, 0xfa, 0x4c, 0x38,
+	0x0d, 0xb1, 0x16, 0xe3, 0x11, 0x3b, 0x7a, 0x0a, 0x37, 0xdb, 0xa4, 0xe7, 0xa4, 0x94, 0xcb, 0x97,
+	0x70, 0x12, 0x1a, 0x06, 0x6e, 0x40, 0x98, 0x71, 0x43, 0xea, 0x7e, 0x27, 0x4b, 0xba, 0x43, 0x7a,
+	0xb6, 0xf6, 0xab, 0x0b, 0xb7, 0x9e, 0x66, 0x5d, 0x6a, 0x8f, 0x00, 0x01, 0x61, 0xc8, 0x84, 0xa5,
+	0x08, 0x77, 0xcf, 0xde, 0x33, 0x25, 0x3c, 0x15, 0xb4, 0xd3, 0xe5, 0xdc, 0xc6, 0x9c, 0xbd, 0x18,
+	0xe1, 0xae, 0x7e, 0x23, 0x5b, 0x01, 0xc8, 0x86, 0xa5, 0x91, 0x16, 0x68, 0x12, 0xc2, 0x0c, 0x28,
+	0xe7, 0x47, 0x6b, 0x1b, 0x7a, 0xfe, 0x2d, 0x42, 0x74, 0x16, 0x8b, 0xd1, 0x88, 0x9d, 0x55, 0xfe,
+	0xcc, 0x81, 0x71, 0xd1, 0x4b, 0xa2, 0x3b, 0x00, 0x22, 0xc1, 0x26, 0xee, 0x84, 0x72, 0xee, 0x44,
+	0x5e, 0xd3, 0x11, 0xee, 0x6e, 0x49, 0x03, 0xfa, 0x1c, 0xe6, 0x59, 0x88, 0x59, 0xcb, 0x69, 0xa6,
+	0xd8, 0x3d, 0x1b, 0xa1, 0xe9, 0xea, 0x7d, 0x71, 0xd9, 0x1f, 0xaf, 0xd7, 0x6f, 0xab, 0x79, 0x67,
+	0x5e, 0xdb, 0x0c, 0xa8, 0x15, 0x61, 0xde, 0x32, 0x3f, 0x25, 0x3e, 0x76, 0x7b, 0x35, 0xe2, 0xfe,
+	0xf6, 0xeb, 0x5d, 0x50, 0xb0, 0x59, 0x23, 0xae, 0x3d, 0x27, 0x89, 0xb6, 0x34, 0x0f, 0xda, 0x86,
+	0xb9, 0xaf, 0x71, 0x10, 0xfe, 0xab, 0x01, 0x9a, 0x15, 0x91, 0x7d, 0x7b, 0xe5, 0xe7, 0x1c, 0x14,
+	0x47, 0xd5, 0x40, 0x2b, 0x50, 0x50, 0x4a, 0xc8, 0x9a, 0xa6, 0x6d, 0x7d, 0x42, 0xff, 0x87, 0xd9,
+	0x04, 0xf7, 0x48, 0xea, 0x68, 0x54, 0x96, 0x63, 0xcf, 0x48, 0x9b, 0x22, 0x41, 0xcf, 0x21, 0xdf,
+	0x24, 0xc4, 0xc8, 0x4b, 0xcd, 0x57, 0x4d, 0x5d, 0x82, 0xd8, 0x68, 0xa6, 0xde, 0x68, 0xe6, 0xc7,
+	0x34, 0x88, 0xab, 0xf7, 0x44, 0x3e, 0x3f, 0xbd, 0x59, 0xdf, 0xf0, 0x03, 0xde, 0xea, 0x34, 0x4c,
+	0x97, 0x46, 0x7a, 0x59, 0xea, 0x3f, 0x77, 0x99, 0xd7, 0xb6, 0x78, 0x2f, 0x21, 0x4c, 0x06, 0x30,
+	0x5b, 0xf0, 0x56, 0xbe, 0x85, 0xe5, 0x71, 0xa3, 0x7b, 0x61, 0xc6, 0x7b, 0x50, 0xfc, 0x2f, 0x7b,
+	0x6c, 0x81, 0x0f, 0x6f, 0x99, 0xca, 0x13, 0x98, 0x1f, 0xde, 0xa5, 0xc8, 0x80, 0xeb, 0x11, 0x8d,
+	0x83, 0x36, 0x49, 0xf5, 0xd5, 0xfd, 0x23, 0x7a, 0x07, 0x8a, 0x2e, 0x8d, 0x19, 0x89, 0x59, 0x87,
+	0x39, 0x49, 0xa7, 0xd1, 0x26, 0x3d, 0xad, 0xd8, 0xc2, 0x99, 0xbd, 0x2e, 0xcd, 0x95, 0xef, 0x27,
+	0x61, 0x79, 0xdc, 0x24, 0x0b, 0x76, 0x12, 0xe3, 0x46, 0x48, 0x3c, 0xc9, 0x7e, 0xc3, 0xee, 0x1f,
+	0x05, 0xbb, 0xe8, 0xbd, 0xa1, 0xcd, 0x3f, 0x29, 0x3b, 0x70, 0x21, 0xc2, 0xdd, 0xa1, 0x85, 0xfe,
+	0x36, 0x2c, 0x78, 0x69, 0xd0, 0xe4, 0x0e, 0x6f, 0xa5, 0x84, 0xb5, 0x68, 0xe8, 0xc9, 0x7e, 0x99,
+	0xb3, 0xe7, 0xa5, 0xf9, 0xb0, 0x6f, 0x45, 0x5b, 0x30, 0xdb, 0x49, 0x3c, 0xcc, 0x89, 0xe3, 0x91,
+	0x10, 0xf7, 0x8c, 0xa9, 0xcb, 0x2b, 0x35, 0xa3, 0x02, 0x6b, 0x22, 0x0e, 0x7d, 0x04, 0xb3, 0x11,
+	0x8e, 0xb1, 0xaf, 0x7e, 0x9d, 0xc4, 0x76, 0x14, 0xdd, 0xb0, 0x32, 0x34, 0x81, 0x0a, 0xaf, 0xed,
+	0x3c, 0xd2, 0xb3, 0x37, 0xa3, 0x23, 0x6a, 0x6d, 0x9f, 0x55, 0x3e, 0x00, 0x18, 0x38, 0x5c, 0xf8,
+	0xb8, 0x08, 0xa6, 0x44, 0x83, 0x68, 0x51, 0xe5, 0x77, 0xe5, 0x97, 0x49, 0x28, 0x8e, 0x6e, 0x2e,
+	0x31, 0xa7, 0x6a, 0xdb, 0xb1, 0xe0, 0x05, 0xe9, 0xcf, 0xa9, 0xb4, 0x1c, 0x04, 0x2f, 0x08, 0x7a,
+	0x00, 0x37, 0x7d, 0x12, 0x13, 0x55, 0x93, 0xd3, 0xc0, 0xdc, 0x6d, 0x29, 0x4f, 0xa5, 0xe7, 0xd2,
+	0x00, 0xac, 0x0a, 0x4c, 0xc6, 0x58, 0x90, 0x31, 0x3b, 0x41, 0xcc, 0x49, 0x7a, 0x84, 0x43, 0xa9,
+	0x6b, 0xde, 0x46, 0x03, 0xe8, 0xb1, 0x46, 0x90, 0x0b, 0xb7, 0x33, 0x01, 0xe7, 0x9a, 0xf2, 0x0a,
+	0x52, 0xaf, 0x0e, 0x78, 0x46, 0x7f, 0x04, 0xef, 0xc1, 0xd4, 0xa5, 0x05, 0x97, 0x9e, 0x95, 0xef,
+	0x26, 0x61, 0xf1, 0xdc, 0x52, 0xbe, 0x8a, 0xe2, 0xe8, 0x7d, 0x28, 0x44, 0x84, 0xb7, 0xa8, 0x6a,
+	0xaa, 0xf9, 0x0b, 0xf7, 0xfd, 0xae, 0x74, 0xb2, 0xb5, 0x33, 0xfa, 0x10, 0xae, 0x8b, 0xfe, 0xc5,
+	0x3e, 0xb9, 0x4a, 0xed, 0x85, 0x08, 0x77, 0x1f, 0xfa, 0x04, 0x7d, 0x02, 0xf3, 0xf4, 0x88, 0xa4,
+	0x21, 0x4e, 0x9c, 0xe3, 0x20, 0xf6, 0xe8, 0xb1, 0x71, 0xed, 0xf2, 0x24, 0x73, 0x3a, 0xf4, 0xa9,
+	0x8c, 0x7c, 0xf7, 0x00, 0x16, 0xcf, 0xa5, 0x89, 0xd6, 0xe1, 0xf6, 0xce, 0xe6, 0x17, 0x8e, 0xbd,
+	0x7f, 0xf8, 0xf0, 0xf0, 0xf1, 0xfe, 0x9e, 0xb3, 0xbb, 0x79, 0xb8, 0xbd, 0x5f, 0x73, 0xec, 0xcd,
+	0x2d, 0x7b, 0xf3, 0x60, 0xbb, 0x38, 0x81, 0xee, 0xc0, 0xea, 0x78, 0x87, 0xda, 0xce, 0xa3, 0x62,
+	0xae, 0x5a, 0x7d, 0x79, 0x52, 0xca, 0xbd, 0x3a, 0x29, 0xe5, 0xfe, 0x3a, 0x29, 0xe5, 0x7e, 0x38,
+	0x2d, 0x4d, 0xbc, 0x3a, 0x2d, 0x4d, 0xfc, 0x7e, 0x5a, 0x9a, 0x78, 0x96, 0xdd, 0x78, 0x4a, 0xa9,
+	0x10, 0x37, 0x98, 0xfe, 0xb4, 0xba, 0xf2, 0x7f, 0x4e, 0xb9, 0xf7, 0x1a, 0x05, 0x59, 0xc4, 0x7b,
+	0xff, 0x0c, 0x00, 0x73, 0x8b, 0x91, 0x9d, 0x8e, 0x0a, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleSigningFees) > 0 {
+		for iNdEx := len(m.ModuleSigningFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleSigningFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MaxSigningRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSigningRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.KeyRotationPolicies) > 0 {
+		for iNdEx := len(m.KeyRotationPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.NonceQueueParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ParticipantSetParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ParticipantPenaltyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ModuleSigningTimeouts) > 0 {
+		for iNdEx := len(m.ModuleSigningTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleSigningTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SigningTimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SigningTimeoutDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.AllowedDkgParticipants) > 0 {
+		for iNdEx := len(m.AllowedDkgParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedDkgParticipants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantPenaltyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantPenaltyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantPenaltyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxFaults != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFaults))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSigningFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSigningFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSigningFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PayerModule) > 0 {
+		i -= len(m.PayerModule)
+		copy(dAtA[i:], m.PayerModule)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PayerModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSigningTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSigningTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSigningTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagedDkgs) > 0 {
+		for iNdEx := len(m.ManagedDkgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedDkgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UpdateDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UpdateDelay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.DriftThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DriftThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxParticipants != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxParticipants))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonceQueueParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonceQueueParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonceQueueParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dkgs) > 0 {
+		for iNdEx := len(m.Dkgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dkgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GenerationTimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GenerationTimeoutDuration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.GenerationInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GenerationInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GenerationBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GenerationBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueueSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueueSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OverlapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OverlapWindow):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if m.Method != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDkgParticipants) > 0 {
+		for _, e := range m.AllowedDkgParticipants {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SigningTimeoutDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ModuleSigningTimeouts) > 0 {
+		for _, e := range m.ModuleSigningTimeouts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.ParticipantPenaltyParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ParticipantSetParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.NonceQueueParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.KeyRotationPolicies) > 0 {
+		for _, e := range m.KeyRotationPolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxSigningRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxSigningRetries))
+	}
+	if len(m.ModuleSigningFees) > 0 {
+		for _, e := range m.ModuleSigningFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantPenaltyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxFaults != 0 {
+		n += 1 + sovParams(uint64(m.MaxFaults))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ModuleSigningFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PayerModule)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleSigningTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DKGParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxParticipants != 0 {
+		n += 1 + sovParams(uint64(m.MaxParticipants))
+	}
+	if m.DriftThreshold != 0 {
+		n += 1 + sovParams(uint64(m.DriftThreshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UpdateDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ManagedDkgs) > 0 {
+		for _, e := range m.ManagedDkgs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ManagedDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *NonceQueueParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueueSize != 0 {
+		n += 1 + sovParams(uint64(m.QueueSize))
+	}
+	if m.GenerationBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.GenerationBatchSize))
+	}
+	if m.GenerationInterval != 0 {
+		n += 1 + sovParams(uint64(m.GenerationInterval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GenerationTimeoutDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Dkgs) > 0 {
+		for _, e := range m.Dkgs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRotationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Method != 0 {
+		n += 1 + sovParams(uint64(m.Method))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OverlapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDkgParticipants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDkgParticipants = append(m.AllowedDkgParticipants, DKGParticipant{})
+			if err := m.AllowedDkgParticipants[len(m.AllowedDkgParticipants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningTimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SigningTimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleSigningTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleSigningTimeouts = append(m.ModuleSigningTimeouts, ModuleSigningTimeout{})
+			if err := m.ModuleSigningTimeouts[len(m.ModuleSigningTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantPenaltyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipantPenaltyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantSetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipantSetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceQueueParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NonceQueueParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationPolicies = append(m.KeyRotationPolicies, KeyRotationPolicy{})
+			if err := m.KeyRotationPolicies[len(m.KeyRotationPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigningRetries", wireType)
+			}
+			m.MaxSigningRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigningRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleSigningFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleSigningFees = append(m.ModuleSigningFees, ModuleSigningFee{})
+			if err := m.ModuleSigningFees[len(m.ModuleSigningFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantPenaltyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantPenaltyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantPenaltyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFaults", wireType)
+			}
+			m.MaxFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFaults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSigningFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSigningFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSigningFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayerModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSigningTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSigningTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSigningTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantSetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantSetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParticipants", wireType)
+			}
+			m.MaxParticipants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxParticipants |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+			}
+			m.DriftThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DriftThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UpdateDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedDkgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedDkgs = append(m.ManagedDkgs, ManagedDKG{})
+			if err := m.ManagedDkgs[len(m.ManagedDkgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedDKG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedDKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllowedDkgParticipants) > 0 {
-		for _, e := range m.AllowedDkgParticipants {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutDuration)
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
 
-func (m *DKGParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *NonceQueueParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -323,15 +2750,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: NonceQueueParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NonceQueueParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerationBatchSize", wireType)
+			}
+			m.GenerationBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenerationBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerationInterval", wireType)
+			}
+			m.GenerationInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenerationInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDkgParticipants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerationTimeoutDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -358,14 +2842,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDkgParticipants = append(m.AllowedDkgParticipants, DKGParticipant{})
-			if err := m.AllowedDkgParticipants[len(m.AllowedDkgParticipants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GenerationTimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dkgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -392,7 +2875,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Dkgs = append(m.Dkgs, ManagedDKG{})
+			if err := m.Dkgs[len(m.Dkgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -417,7 +2901,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+func (m *KeyRotationPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -440,15 +2924,15 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotationPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -476,11 +2960,11 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -508,7 +2992,92 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= KeyRotationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OverlapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
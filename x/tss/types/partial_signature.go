@@ -0,0 +1,365 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"slices"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/bitwaylabs/bitway/bitcoin/crypto/hash"
+)
+
+const (
+	// size of the public nonce of the participant, i.e. the compressed point
+	PartialNonceSize = btcec.PubKeyBytesLenCompressed
+
+	// size of the partial signature of the participant, i.e. the scalar
+	PartialSignatureSize = 32
+
+	// size of the verification share of the participant, i.e. the compressed point
+	VerificationShareSize = btcec.PubKeyBytesLenCompressed
+)
+
+// SupportsPartialSignatures returns true if the partial signatures can be aggregated on chain for the given signing type, false otherwise
+// The partial signatures are combined by the lagrange coefficients, which is not applicable to the tweaked, adaptor and ECDSA signatures
+func SupportsPartialSignatures(signingType SigningType) bool {
+	return signingType == SigningType_SIGNING_TYPE_SCHNORR || signingType == SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT
+}
+
+// GetPartialSignatureSigMsg gets the msg to be signed from the given data for the partial signature submission
+// Assume that the given nonces and partial signatures are hex encoded and the signers are base64 encoded
+func GetPartialSignatureSigMsg(id uint64, signers []string, aggregatedNonces []string, nonces []string, partialSigs []string) []byte {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, id)
+
+	for _, signer := range signers {
+		signerBytes, _ := base64.StdEncoding.DecodeString(signer)
+		msg = append(msg, signerBytes...)
+	}
+
+	for _, nonce := range aggregatedNonces {
+		nonceBytes, _ := hex.DecodeString(nonce)
+		msg = append(msg, nonceBytes...)
+	}
+
+	for _, nonce := range nonces {
+		nonceBytes, _ := hex.DecodeString(nonce)
+		msg = append(msg, nonceBytes...)
+	}
+
+	for _, partialSig := range partialSigs {
+		partialSigBytes, _ := hex.DecodeString(partialSig)
+		msg = append(msg, partialSigBytes...)
+	}
+
+	return hash.Sha256(msg)
+}
+
+// CheckPartialSignatures checks if the given public nonces, aggregated nonces and partial signatures are valid
+func CheckPartialSignatures(aggregatedNonces []string, nonces []string, partialSigs []string) error {
+	if len(nonces) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignatures, "partial signatures cannot be empty")
+	}
+
+	if len(nonces) != len(partialSigs) || len(nonces) != len(aggregatedNonces) {
+		return errorsmod.Wrap(ErrInvalidSignatures, "nonces do not match partial signatures")
+	}
+
+	for i := range nonces {
+		if _, err := ParsePartialNonce(nonces[i]); err != nil {
+			return err
+		}
+
+		if _, err := ParsePartialNonce(aggregatedNonces[i]); err != nil {
+			return errorsmod.Wrap(err, "invalid aggregated nonce")
+		}
+
+		if _, err := ParsePartialSignature(partialSigs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckSigners checks if the given signers are valid for the participants and threshold
+// The signers must be distinct participants and the number of the signers must reach the threshold
+func CheckSigners(participants []string, threshold uint32, signers []string) error {
+	if len(signers) < int(threshold) {
+		return errorsmod.Wrap(ErrInvalidSignatures, "insufficient signers")
+	}
+
+	for i, signer := range signers {
+		if !ParticipantExists(participants, signer) {
+			return errorsmod.Wrapf(ErrUnauthorizedParticipant, "signer %s", signer)
+		}
+
+		if slices.Contains(signers[i+1:], signer) {
+			return errorsmod.Wrapf(ErrInvalidSignatures, "duplicate signer %s", signer)
+		}
+	}
+
+	return nil
+}
+
+// ParsePartialNonce parses the given hex encoded public nonce
+func ParsePartialNonce(nonce string) (*btcec.PublicKey, error) {
+	nonceBytes, err := hex.DecodeString(nonce)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidNonce, "failed to decode the nonce")
+	}
+
+	if len(nonceBytes) != PartialNonceSize {
+		return nil, errorsmod.Wrap(ErrInvalidNonce, "incorrect nonce size")
+	}
+
+	noncePoint, err := btcec.ParsePubKey(nonceBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidNonce, "invalid nonce")
+	}
+
+	return noncePoint, nil
+}
+
+// ParsePartialSignature parses the given hex encoded partial signature
+func ParsePartialSignature(partialSig string) (*btcec.ModNScalar, error) {
+	partialSigBytes, err := hex.DecodeString(partialSig)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidSignature, "failed to decode the partial signature")
+	}
+
+	if len(partialSigBytes) != PartialSignatureSize {
+		return nil, errorsmod.Wrap(ErrInvalidSignature, "incorrect partial signature size")
+	}
+
+	var s btcec.ModNScalar
+	if s.SetByteSlice(partialSigBytes) {
+		return nil, errorsmod.Wrap(ErrInvalidSignature, "partial signature overflows the curve order")
+	}
+
+	return &s, nil
+}
+
+// ParseVerificationShares parses the given hex encoded verification shares
+func ParseVerificationShares(shares []string) ([]*btcec.PublicKey, error) {
+	points := make([]*btcec.PublicKey, len(shares))
+
+	for i, share := range shares {
+		shareBytes, err := hex.DecodeString(share)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "failed to decode the verification share")
+		}
+
+		if len(shareBytes) != VerificationShareSize {
+			return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "incorrect verification share size")
+		}
+
+		point, err := btcec.ParsePubKey(shareBytes)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "invalid verification share")
+		}
+
+		points[i] = point
+	}
+
+	return points, nil
+}
+
+// CheckVerificationShares checks if the given verification shares are consistent with the pub keys
+// The shares are ordered by the participants for each pub key and the participant at position i holds the share at index i + 1
+// The shares of each pub key must lie on the polynomial of degree threshold - 1 which evaluates to the pub key at zero
+// Assume that the pub keys are valid x-only pub keys
+func CheckVerificationShares(pubKeys []string, participantNum int, threshold uint32, shares []string) error {
+	if len(shares) != len(pubKeys)*participantNum {
+		return errorsmod.Wrap(ErrInvalidVerificationShares, "mismatched verification share count")
+	}
+
+	points, err := ParseVerificationShares(shares)
+	if err != nil {
+		return err
+	}
+
+	// indexes of the shares which determine the polynomial
+	basis := make([]uint32, threshold)
+	for i := range basis {
+		basis[i] = uint32(i + 1)
+	}
+
+	for i, pubKey := range pubKeys {
+		keyShares := points[i*participantNum : (i+1)*participantNum]
+
+		groupPubKey := InterpolateVerificationShares(basis, keyShares[:threshold], 0)
+
+		pubKeyBytes, _ := hex.DecodeString(pubKey)
+		if !slices.Equal(schnorr.SerializePubKey(groupPubKey), pubKeyBytes) {
+			return errorsmod.Wrapf(ErrInvalidVerificationShares, "verification shares do not match the pub key %s", pubKey)
+		}
+
+		for j := int(threshold); j < participantNum; j++ {
+			if !InterpolateVerificationShares(basis, keyShares[:threshold], uint32(j+1)).IsEqual(keyShares[j]) {
+				return errorsmod.Wrapf(ErrInvalidVerificationShares, "verification share %d of the pub key %s is inconsistent", j, pubKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetVerificationShares gets the verification shares of the given pub key from all the verification shares
+// Assume that the shares are ordered by the participants for each pub key
+func GetVerificationShares(pubKeys []string, participantNum int, shares []string, pubKey string) []string {
+	i := slices.Index(pubKeys, pubKey)
+	if i < 0 || len(shares) != len(pubKeys)*participantNum {
+		return nil
+	}
+
+	return shares[i*participantNum : (i+1)*participantNum]
+}
+
+// ComputeLagrangeCoefficient computes the lagrange coefficient of the given index at x for the given indexes
+// λ_i(x) = Π_{j≠i} (x - x_j) / (x_i - x_j)
+func ComputeLagrangeCoefficient(index uint32, indexes []uint32, x uint32) *btcec.ModNScalar {
+	var xi, xs btcec.ModNScalar
+	xi.SetInt(index)
+	xs.SetInt(x)
+
+	num := new(btcec.ModNScalar).SetInt(1)
+	den := new(btcec.ModNScalar).SetInt(1)
+
+	for _, j := range indexes {
+		if j == index {
+			continue
+		}
+
+		var xj btcec.ModNScalar
+		xj.SetInt(j)
+		xj.Negate()
+
+		num.Mul(new(btcec.ModNScalar).Add2(&xs, &xj))
+		den.Mul(new(btcec.ModNScalar).Add2(&xi, &xj))
+	}
+
+	return num.Mul(den.InverseNonConst())
+}
+
+// InterpolateVerificationShares interpolates the given verification shares at x
+// i.e. Σ λ_i(x) * Y_i
+func InterpolateVerificationShares(indexes []uint32, shares []*btcec.PublicKey, x uint32) *btcec.PublicKey {
+	var result btcec.JacobianPoint
+
+	for i, index := range indexes {
+		var share, term btcec.JacobianPoint
+		shares[i].AsJacobian(&share)
+
+		btcec.ScalarMultNonConst(ComputeLagrangeCoefficient(index, indexes, x), &share, &term)
+		btcec.AddNonConst(&result, &term, &result)
+	}
+
+	result.ToAffine()
+
+	return btcec.NewPublicKey(&result.X, &result.Y)
+}
+
+// VerifyPartialSignature verifies the partial signature of the signer against the verification share
+// z_i * G == s_R * R_i + (e * λ_i * s_P) * Y_i
+// where s_R and s_P are -1 if the aggregated nonce and the group pub key have odd y respectively, otherwise 1
+// and e is the BIP340 challenge of the aggregated nonce, group pub key and sig hash
+func VerifyPartialSignature(groupPubKey *btcec.PublicKey, share *btcec.PublicKey, lambda *btcec.ModNScalar, aggregatedNonce *btcec.PublicKey, nonce *btcec.PublicKey, partialSig *btcec.ModNScalar, sigHash []byte) bool {
+	var groupPubKeyPoint, aggregatedNoncePoint, noncePoint, sharePoint btcec.JacobianPoint
+	groupPubKey.AsJacobian(&groupPubKeyPoint)
+	aggregatedNonce.AsJacobian(&aggregatedNoncePoint)
+	nonce.AsJacobian(&noncePoint)
+	share.AsJacobian(&sharePoint)
+
+	challengeHash := chainhash.TaggedHash(chainhash.TagBIP0340Challenge, schnorr.SerializePubKey(aggregatedNonce), schnorr.SerializePubKey(groupPubKey), sigHash)
+
+	var challenge btcec.ModNScalar
+	challenge.SetByteSlice(challengeHash[:])
+
+	// e * λ_i * s_P
+	factor := new(btcec.ModNScalar).Mul2(&challenge, lambda)
+	if groupPubKeyPoint.Y.IsOdd() {
+		factor.Negate()
+	}
+
+	// s_R * R_i
+	if aggregatedNoncePoint.Y.IsOdd() {
+		noncePoint.Y.Negate(1).Normalize()
+	}
+
+	var lhs, rhs, term btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(partialSig, &lhs)
+	btcec.ScalarMultNonConst(factor, &sharePoint, &term)
+	btcec.AddNonConst(&noncePoint, &term, &rhs)
+
+	lhs.ToAffine()
+	rhs.ToAffine()
+
+	return lhs.X.Equals(&rhs.X) && lhs.Y.Equals(&rhs.Y)
+}
+
+// CheckAggregatedNonces returns true if the aggregated nonces are the sum of the public nonces of the given partial signatures, false otherwise
+// Assume that the nonces are valid and match the aggregated nonces
+func CheckAggregatedNonces(aggregatedNonces []string, partialSigs []*PartialSignature) bool {
+	for i, aggregatedNonce := range aggregatedNonces {
+		var sum btcec.JacobianPoint
+
+		for _, partialSig := range partialSigs {
+			nonce, _ := ParsePartialNonce(partialSig.Nonces[i])
+
+			var noncePoint btcec.JacobianPoint
+			nonce.AsJacobian(&noncePoint)
+
+			btcec.AddNonConst(&sum, &noncePoint, &sum)
+		}
+
+		sum.ToAffine()
+
+		if !btcec.NewPublicKey(&sum.X, &sum.Y).IsEqual(mustParsePartialNonce(aggregatedNonce)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AggregatePartialSignatures aggregates the given partial signatures into the schnorr signatures
+// The aggregated signature is x(R) || Σz_i for each sig hash where R is the aggregated nonce
+// The lagrange coefficients and parities are already applied to the verified partial signatures
+// Assume that the partial signatures are valid and match the aggregated nonces
+func AggregatePartialSignatures(aggregatedNonces []string, partialSigs []*PartialSignature) []string {
+	signatures := make([]string, len(aggregatedNonces))
+
+	for i, aggregatedNonce := range aggregatedNonces {
+		var s btcec.ModNScalar
+
+		for _, partialSig := range partialSigs {
+			partialS, _ := ParsePartialSignature(partialSig.PartialSignatures[i])
+			s.Add(partialS)
+		}
+
+		sig := make([]byte, SchnorrSignatureSize)
+		copy(sig[:32], schnorr.SerializePubKey(mustParsePartialNonce(aggregatedNonce)))
+		s.PutBytesUnchecked(sig[32:])
+
+		signatures[i] = hex.EncodeToString(sig)
+	}
+
+	return signatures
+}
+
+// mustParsePartialNonce parses the given nonce which is assumed to be valid
+func mustParsePartialNonce(nonce string) *btcec.PublicKey {
+	noncePoint, err := ParsePartialNonce(nonce)
+	if err != nil {
+		panic(err)
+	}
+
+	return noncePoint
+}
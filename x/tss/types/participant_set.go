@@ -0,0 +1,23 @@
+package types
+
+// ConsensusPubKeys returns the consensus pub keys of the participant set
+func (s *ParticipantSet) ConsensusPubKeys() []string {
+	consensusPubKeys := []string{}
+
+	for _, p := range s.Participants {
+		consensusPubKeys = append(consensusPubKeys, p.ConsensusPubkey)
+	}
+
+	return consensusPubKeys
+}
+
+// IsManagedDKG returns true if the DKG of the given module and type is managed, false otherwise
+func (p ParticipantSetParams) IsManagedDKG(module string, ty string) bool {
+	for _, dkg := range p.ManagedDkgs {
+		if dkg.Module == module && dkg.Type == ty {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -534,22 +539,22 @@ func (m *QuerySigningRequestsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryRefreshingRequestRequest struct {
+type QuerySigningBatchRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryRefreshingRequestRequest) Reset()         { *m = QueryRefreshingRequestRequest{} }
-func (m *QueryRefreshingRequestRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingRequestRequest) ProtoMessage()    {}
-func (*QueryRefreshingRequestRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySigningBatchRequest) Reset()         { *m = QuerySigningBatchRequest{} }
+func (m *QuerySigningBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningBatchRequest) ProtoMessage()    {}
+func (*QuerySigningBatchRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{10}
 }
-func (m *QueryRefreshingRequestRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingRequestRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningBatchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,41 +564,42 @@ func (m *QueryRefreshingRequestRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingRequestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingRequestRequest.Merge(m, src)
+func (m *QuerySigningBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningBatchRequest.Merge(m, src)
 }
-func (m *QueryRefreshingRequestRequest) XXX_Size() int {
+func (m *QuerySigningBatchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingRequestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingRequestRequest.DiscardUnknown(m)
+func (m *QuerySigningBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningBatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingRequestRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningBatchRequest proto.InternalMessageInfo
 
-func (m *QueryRefreshingRequestRequest) GetId() uint64 {
+func (m *QuerySigningBatchRequest) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-type QueryRefreshingRequestResponse struct {
-	Request *RefreshingRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+type QuerySigningBatchResponse struct {
+	Batch    *SigningBatch     `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Requests []*SigningRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (m *QueryRefreshingRequestResponse) Reset()         { *m = QueryRefreshingRequestResponse{} }
-func (m *QueryRefreshingRequestResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingRequestResponse) ProtoMessage()    {}
-func (*QueryRefreshingRequestResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySigningBatchResponse) Reset()         { *m = QuerySigningBatchResponse{} }
+func (m *QuerySigningBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningBatchResponse) ProtoMessage()    {}
+func (*QuerySigningBatchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{11}
 }
-func (m *QueryRefreshingRequestResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingRequestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -603,42 +609,50 @@ func (m *QueryRefreshingRequestResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingRequestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingRequestResponse.Merge(m, src)
+func (m *QuerySigningBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningBatchResponse.Merge(m, src)
 }
-func (m *QueryRefreshingRequestResponse) XXX_Size() int {
+func (m *QuerySigningBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingRequestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingRequestResponse.DiscardUnknown(m)
+func (m *QuerySigningBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingRequestResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningBatchResponse proto.InternalMessageInfo
 
-func (m *QueryRefreshingRequestResponse) GetRequest() *RefreshingRequest {
+func (m *QuerySigningBatchResponse) GetBatch() *SigningBatch {
 	if m != nil {
-		return m.Request
+		return m.Batch
 	}
 	return nil
 }
 
-type QueryRefreshingRequestsRequest struct {
-	Status     RefreshingStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QuerySigningBatchResponse) GetRequests() []*SigningRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
 }
 
-func (m *QueryRefreshingRequestsRequest) Reset()         { *m = QueryRefreshingRequestsRequest{} }
-func (m *QueryRefreshingRequestsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingRequestsRequest) ProtoMessage()    {}
-func (*QueryRefreshingRequestsRequest) Descriptor() ([]byte, []int) {
+type QuerySigningBatchesRequest struct {
+	Status     SigningStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	Module     string             `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningBatchesRequest) Reset()         { *m = QuerySigningBatchesRequest{} }
+func (m *QuerySigningBatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningBatchesRequest) ProtoMessage()    {}
+func (*QuerySigningBatchesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{12}
 }
-func (m *QueryRefreshingRequestsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningBatchesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningBatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingRequestsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningBatchesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -648,49 +662,56 @@ func (m *QueryRefreshingRequestsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingRequestsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingRequestsRequest.Merge(m, src)
+func (m *QuerySigningBatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningBatchesRequest.Merge(m, src)
 }
-func (m *QueryRefreshingRequestsRequest) XXX_Size() int {
+func (m *QuerySigningBatchesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingRequestsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingRequestsRequest.DiscardUnknown(m)
+func (m *QuerySigningBatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningBatchesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingRequestsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningBatchesRequest proto.InternalMessageInfo
 
-func (m *QueryRefreshingRequestsRequest) GetStatus() RefreshingStatus {
+func (m *QuerySigningBatchesRequest) GetStatus() SigningStatus {
 	if m != nil {
 		return m.Status
 	}
-	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
 }
 
-func (m *QueryRefreshingRequestsRequest) GetPagination() *query.PageRequest {
+func (m *QuerySigningBatchesRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QuerySigningBatchesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryRefreshingRequestsResponse struct {
-	Requests   []*RefreshingRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
-	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QuerySigningBatchesResponse struct {
+	Batches    []*SigningBatch     `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRefreshingRequestsResponse) Reset()         { *m = QueryRefreshingRequestsResponse{} }
-func (m *QueryRefreshingRequestsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingRequestsResponse) ProtoMessage()    {}
-func (*QueryRefreshingRequestsResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySigningBatchesResponse) Reset()         { *m = QuerySigningBatchesResponse{} }
+func (m *QuerySigningBatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningBatchesResponse) ProtoMessage()    {}
+func (*QuerySigningBatchesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{13}
 }
-func (m *QueryRefreshingRequestsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningBatchesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningBatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingRequestsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningBatchesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -700,49 +721,49 @@ func (m *QueryRefreshingRequestsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingRequestsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingRequestsResponse.Merge(m, src)
+func (m *QuerySigningBatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningBatchesResponse.Merge(m, src)
 }
-func (m *QueryRefreshingRequestsResponse) XXX_Size() int {
+func (m *QuerySigningBatchesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingRequestsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingRequestsResponse.DiscardUnknown(m)
+func (m *QuerySigningBatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningBatchesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingRequestsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningBatchesResponse proto.InternalMessageInfo
 
-func (m *QueryRefreshingRequestsResponse) GetRequests() []*RefreshingRequest {
+func (m *QuerySigningBatchesResponse) GetBatches() []*SigningBatch {
 	if m != nil {
-		return m.Requests
+		return m.Batches
 	}
 	return nil
 }
 
-func (m *QueryRefreshingRequestsResponse) GetPagination() *query.PageResponse {
+func (m *QuerySigningBatchesResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryRefreshingCompletionsRequest struct {
+type QuerySigningAcknowledgementsRequest struct {
 	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRefreshingCompletionsRequest) Reset()         { *m = QueryRefreshingCompletionsRequest{} }
-func (m *QueryRefreshingCompletionsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingCompletionsRequest) ProtoMessage()    {}
-func (*QueryRefreshingCompletionsRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySigningAcknowledgementsRequest) Reset()         { *m = QuerySigningAcknowledgementsRequest{} }
+func (m *QuerySigningAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningAcknowledgementsRequest) ProtoMessage()    {}
+func (*QuerySigningAcknowledgementsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{14}
 }
-func (m *QueryRefreshingCompletionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingCompletionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningAcknowledgementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingCompletionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningAcknowledgementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -752,49 +773,49 @@ func (m *QueryRefreshingCompletionsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingCompletionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingCompletionsRequest.Merge(m, src)
+func (m *QuerySigningAcknowledgementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningAcknowledgementsRequest.Merge(m, src)
 }
-func (m *QueryRefreshingCompletionsRequest) XXX_Size() int {
+func (m *QuerySigningAcknowledgementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingCompletionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingCompletionsRequest.DiscardUnknown(m)
+func (m *QuerySigningAcknowledgementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningAcknowledgementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingCompletionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningAcknowledgementsRequest proto.InternalMessageInfo
 
-func (m *QueryRefreshingCompletionsRequest) GetId() uint64 {
+func (m *QuerySigningAcknowledgementsRequest) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *QueryRefreshingCompletionsRequest) GetPagination() *query.PageRequest {
+func (m *QuerySigningAcknowledgementsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryRefreshingCompletionsResponse struct {
-	Completions []*RefreshingCompletion `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
-	Pagination  *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QuerySigningAcknowledgementsResponse struct {
+	Acknowledgements []*SigningAcknowledgement `protobuf:"bytes,1,rep,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+	Pagination       *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRefreshingCompletionsResponse) Reset()         { *m = QueryRefreshingCompletionsResponse{} }
-func (m *QueryRefreshingCompletionsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRefreshingCompletionsResponse) ProtoMessage()    {}
-func (*QueryRefreshingCompletionsResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySigningAcknowledgementsResponse) Reset()         { *m = QuerySigningAcknowledgementsResponse{} }
+func (m *QuerySigningAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningAcknowledgementsResponse) ProtoMessage()    {}
+func (*QuerySigningAcknowledgementsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{15}
 }
-func (m *QueryRefreshingCompletionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySigningAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRefreshingCompletionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySigningAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRefreshingCompletionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySigningAcknowledgementsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -804,48 +825,49 @@ func (m *QueryRefreshingCompletionsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryRefreshingCompletionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRefreshingCompletionsResponse.Merge(m, src)
+func (m *QuerySigningAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningAcknowledgementsResponse.Merge(m, src)
 }
-func (m *QueryRefreshingCompletionsResponse) XXX_Size() int {
+func (m *QuerySigningAcknowledgementsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRefreshingCompletionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRefreshingCompletionsResponse.DiscardUnknown(m)
+func (m *QuerySigningAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningAcknowledgementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRefreshingCompletionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySigningAcknowledgementsResponse proto.InternalMessageInfo
 
-func (m *QueryRefreshingCompletionsResponse) GetCompletions() []*RefreshingCompletion {
+func (m *QuerySigningAcknowledgementsResponse) GetAcknowledgements() []*SigningAcknowledgement {
 	if m != nil {
-		return m.Completions
+		return m.Acknowledgements
 	}
 	return nil
 }
 
-func (m *QueryRefreshingCompletionsResponse) GetPagination() *query.PageResponse {
+func (m *QuerySigningAcknowledgementsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryPartialSignaturesRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPartialSignaturesRequest) Reset()         { *m = QueryPartialSignaturesRequest{} }
+func (m *QueryPartialSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPartialSignaturesRequest) ProtoMessage()    {}
+func (*QueryPartialSignaturesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{16}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPartialSignaturesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPartialSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPartialSignaturesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -855,35 +877,49 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryPartialSignaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartialSignaturesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryPartialSignaturesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryPartialSignaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartialSignaturesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPartialSignaturesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryPartialSignaturesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPartialSignaturesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPartialSignaturesResponse struct {
+	PartialSignatures []*PartialSignature `protobuf:"bytes,1,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPartialSignaturesResponse) Reset()         { *m = QueryPartialSignaturesResponse{} }
+func (m *QueryPartialSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPartialSignaturesResponse) ProtoMessage()    {}
+func (*QueryPartialSignaturesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c31a93ff2ede8af0, []int{17}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPartialSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPartialSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPartialSignaturesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -893,1496 +929,9348 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPartialSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartialSignaturesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPartialSignaturesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPartialSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartialSignaturesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPartialSignaturesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryPartialSignaturesResponse) GetPartialSignatures() []*PartialSignature {
 	if m != nil {
-		return m.Params
+		return m.PartialSignatures
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDKGRequestRequest)(nil), "bitway.tss.QueryDKGRequestRequest")
-	proto.RegisterType((*QueryDKGRequestResponse)(nil), "bitway.tss.QueryDKGRequestResponse")
-	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "bitway.tss.QueryDKGRequestsRequest")
-	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "bitway.tss.QueryDKGRequestsResponse")
-	proto.RegisterType((*QueryDKGCompletionsRequest)(nil), "bitway.tss.QueryDKGCompletionsRequest")
-	proto.RegisterType((*QueryDKGCompletionsResponse)(nil), "bitway.tss.QueryDKGCompletionsResponse")
-	proto.RegisterType((*QuerySigningRequestRequest)(nil), "bitway.tss.QuerySigningRequestRequest")
-	proto.RegisterType((*QuerySigningRequestResponse)(nil), "bitway.tss.QuerySigningRequestResponse")
-	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "bitway.tss.QuerySigningRequestsRequest")
-	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "bitway.tss.QuerySigningRequestsResponse")
-	proto.RegisterType((*QueryRefreshingRequestRequest)(nil), "bitway.tss.QueryRefreshingRequestRequest")
-	proto.RegisterType((*QueryRefreshingRequestResponse)(nil), "bitway.tss.QueryRefreshingRequestResponse")
-	proto.RegisterType((*QueryRefreshingRequestsRequest)(nil), "bitway.tss.QueryRefreshingRequestsRequest")
-	proto.RegisterType((*QueryRefreshingRequestsResponse)(nil), "bitway.tss.QueryRefreshingRequestsResponse")
-	proto.RegisterType((*QueryRefreshingCompletionsRequest)(nil), "bitway.tss.QueryRefreshingCompletionsRequest")
-	proto.RegisterType((*QueryRefreshingCompletionsResponse)(nil), "bitway.tss.QueryRefreshingCompletionsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "bitway.tss.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "bitway.tss.QueryParamsResponse")
+func (m *QueryPartialSignaturesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("bitway/tss/query.proto", fileDescriptor_c31a93ff2ede8af0) }
-
-var fileDescriptor_c31a93ff2ede8af0 = []byte{
-	// 901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x4e, 0x30, 0xe4, 0x45, 0x32, 0xe2, 0xe1, 0x38, 0xce, 0xe2, 0xac, 0x9d, 0x4d,
-	0x88, 0x9d, 0x90, 0xec, 0x12, 0x13, 0x81, 0x10, 0x37, 0x83, 0xc8, 0x21, 0x97, 0xe0, 0x9c, 0xe0,
-	0xb6, 0x8e, 0x97, 0xcd, 0x0a, 0xdb, 0xeb, 0x78, 0xd6, 0x40, 0x08, 0x5c, 0x38, 0x02, 0x07, 0x24,
-	0x24, 0xda, 0x53, 0x7b, 0xa8, 0xaa, 0xf6, 0xd8, 0xfe, 0x17, 0x39, 0x46, 0xea, 0xa5, 0xa7, 0xaa,
-	0x4a, 0xfa, 0x07, 0xf4, 0x4f, 0xa8, 0x3c, 0x3b, 0x5e, 0xaf, 0x67, 0x7f, 0x59, 0x55, 0x92, 0x53,
-	0xe2, 0xd9, 0xef, 0xbc, 0xf7, 0x79, 0xdf, 0xf7, 0x3c, 0xb3, 0x86, 0x7c, 0xd3, 0x72, 0x7e, 0xd1,
-	0x4f, 0x35, 0x87, 0x52, 0xed, 0x64, 0x60, 0xf4, 0x4f, 0xd5, 0x5e, 0xdf, 0x76, 0x6c, 0x04, 0x77,
-	0x5d, 0x75, 0x28, 0x95, 0x72, 0xa6, 0x6d, 0xda, 0x6c, 0x59, 0x1b, 0xfe, 0xe7, 0x2a, 0xa4, 0xa2,
-	0x69, 0xdb, 0x66, 0xdb, 0xd0, 0xf4, 0x9e, 0xa5, 0xe9, 0xdd, 0xae, 0xed, 0xe8, 0x8e, 0x65, 0x77,
-	0x29, 0x7f, 0xba, 0x79, 0x64, 0xd3, 0x8e, 0x4d, 0xb5, 0xa6, 0x4e, 0x0d, 0x37, 0xb0, 0xf6, 0xf3,
-	0x4e, 0xd3, 0x70, 0xf4, 0x1d, 0xad, 0xa7, 0x9b, 0x56, 0x97, 0x89, 0xb9, 0x76, 0xd1, 0xc7, 0xd0,
-	0xd3, 0xfb, 0x7a, 0x67, 0x14, 0x24, 0xe7, 0x7b, 0xe0, 0x50, 0xbe, 0xaa, 0x54, 0x21, 0xff, 0xdd,
-	0x30, 0xe0, 0x37, 0xfb, 0x7b, 0x0d, 0xe3, 0x64, 0x60, 0x50, 0x87, 0xff, 0xc1, 0x2c, 0xa4, 0xad,
-	0x56, 0x81, 0x94, 0x49, 0x75, 0xb6, 0x91, 0xb6, 0x5a, 0xca, 0x3e, 0x2c, 0x06, 0x94, 0xb4, 0x67,
-	0x77, 0xa9, 0x81, 0x9f, 0xc2, 0xbb, 0x7d, 0x77, 0x89, 0xe9, 0xe7, 0x6b, 0x79, 0x75, 0x5c, 0xb1,
-	0xea, 0xdb, 0x30, 0x92, 0x29, 0x8f, 0x49, 0x20, 0x1a, 0x1d, 0x25, 0xde, 0x86, 0x0c, 0x75, 0x74,
-	0x67, 0x40, 0x59, 0xb0, 0x6c, 0x6d, 0x41, 0x08, 0x76, 0xc8, 0x1e, 0x36, 0xb8, 0x08, 0xf3, 0x90,
-	0xe9, 0xd8, 0xad, 0x41, 0xdb, 0x28, 0xa4, 0xcb, 0xa4, 0x3a, 0xd7, 0xe0, 0x9f, 0xf0, 0x5b, 0x80,
-	0xb1, 0x39, 0x85, 0x19, 0xc6, 0xb5, 0xae, 0xba, 0x4e, 0xaa, 0x43, 0x27, 0x55, 0xb7, 0x45, 0xdc,
-	0x49, 0xf5, 0x40, 0x37, 0x8d, 0x11, 0xa7, 0x6f, 0xa7, 0x72, 0x87, 0x40, 0x21, 0x88, 0xca, 0x2b,
-	0xaf, 0xc1, 0x7b, 0xbc, 0xa4, 0x21, 0xed, 0x4c, 0x4c, 0xe9, 0x9e, 0x0e, 0xf7, 0x26, 0xc0, 0xd2,
-	0x0c, 0xac, 0x92, 0x08, 0xe6, 0x26, 0x9c, 0x20, 0x73, 0x40, 0x1a, 0x81, 0x7d, 0x6d, 0x77, 0x7a,
-	0x6d, 0x83, 0xcd, 0x4c, 0x44, 0xff, 0x04, 0x3f, 0xd2, 0x6f, 0xed, 0xc7, 0x03, 0x02, 0x1f, 0x85,
-	0xa6, 0xe5, 0x96, 0x7c, 0x05, 0xf3, 0x47, 0xe3, 0x65, 0xee, 0xca, 0x92, 0xe0, 0xca, 0x78, 0x63,
-	0xc3, 0xaf, 0xbe, 0x3e, 0x6f, 0xb6, 0xb8, 0x37, 0x87, 0x96, 0xd9, 0xb5, 0xba, 0x66, 0xc2, 0x6c,
-	0x1f, 0xf2, 0x92, 0x44, 0x35, 0x2f, 0x69, 0x57, 0x9c, 0x6f, 0xc9, 0x5f, 0x8e, 0xb0, 0xc9, 0x9b,
-	0xf1, 0x27, 0x24, 0x34, 0xaa, 0xd7, 0xa0, 0x1d, 0x61, 0xce, 0x97, 0x42, 0x82, 0xde, 0xf2, 0xac,
-	0xdf, 0x27, 0x50, 0x0c, 0x47, 0xe6, 0x4e, 0x7c, 0x1e, 0x98, 0xf7, 0x38, 0x2b, 0x6e, 0x60, 0xe6,
-	0x35, 0x58, 0x66, 0x80, 0x0d, 0xe3, 0xc7, 0xbe, 0x41, 0x8f, 0x93, 0x5b, 0xfb, 0x3d, 0xc8, 0x51,
-	0x1b, 0x78, 0x4d, 0x5f, 0x88, 0xdd, 0x5d, 0xf6, 0x97, 0x14, 0xdc, 0xe7, 0x35, 0xf8, 0x1e, 0x89,
-	0x8a, 0xed, 0xf5, 0x78, 0x57, 0xe8, 0x71, 0x31, 0x3c, 0xb4, 0xd0, 0xe6, 0xeb, 0xfa, 0xaa, 0x3e,
-	0x24, 0x50, 0x8a, 0x04, 0xe4, 0xd5, 0x7f, 0x19, 0xe8, 0x68, 0x42, 0xf9, 0x37, 0xd0, 0xd4, 0x33,
-	0x58, 0x11, 0x30, 0x6f, 0xf1, 0x3c, 0x7b, 0x4a, 0x40, 0x89, 0xcb, 0xce, 0x7d, 0xaa, 0x87, 0x1d,
-	0x6b, 0xe5, 0x70, 0xab, 0x6e, 0xfc, 0x74, 0xcb, 0x01, 0x32, 0xe4, 0x03, 0x76, 0xc1, 0xf3, 0xaa,
-	0x94, 0x3d, 0xf8, 0x70, 0x62, 0xd5, 0xbb, 0x9d, 0x33, 0xee, 0x8b, 0x00, 0x1f, 0x6f, 0xf4, 0x43,
-	0xbb, 0xda, 0xfa, 0xec, 0xf9, 0x8b, 0x52, 0xaa, 0xc1, 0x75, 0xb5, 0xd7, 0x73, 0xf0, 0x0e, 0x8b,
-	0x84, 0x06, 0x64, 0x5c, 0x05, 0xca, 0xfe, 0x5d, 0xc1, 0xe4, 0x52, 0x29, 0xf2, 0xb9, 0x8b, 0xa1,
-	0x48, 0x7f, 0x3e, 0x7b, 0xf5, 0x5f, 0x3a, 0x87, 0xa8, 0x05, 0xde, 0x50, 0xf0, 0x77, 0x80, 0xf1,
-	0x55, 0x89, 0x4a, 0x20, 0x54, 0xe0, 0xed, 0x44, 0x5a, 0x8d, 0xd5, 0xf0, 0x94, 0x1f, 0xb3, 0x94,
-	0x25, 0x5c, 0xf6, 0xa7, 0x6c, 0xfd, 0x64, 0x6a, 0xa3, 0x11, 0xd6, 0xce, 0xac, 0xd6, 0x1f, 0xf8,
-	0x1b, 0xcc, 0xfb, 0xee, 0x76, 0x8c, 0x0b, 0xed, 0x95, 0xbb, 0x16, 0x2f, 0xe2, 0x00, 0x65, 0x06,
-	0x20, 0x61, 0x21, 0x0a, 0x00, 0xff, 0x26, 0x90, 0x9d, 0xbc, 0x48, 0x71, 0x3d, 0x2c, 0x74, 0xf0,
-	0x0b, 0x21, 0x55, 0x12, 0x75, 0x9c, 0xa2, 0xca, 0x28, 0x14, 0x2c, 0x8b, 0x14, 0xbe, 0xd9, 0x74,
-	0x9d, 0xf8, 0x87, 0x40, 0x76, 0xf2, 0x0c, 0x0f, 0xa1, 0x09, 0xbd, 0x52, 0x43, 0x68, 0xc2, 0x2f,
-	0x53, 0x65, 0x83, 0xd1, 0xac, 0xe2, 0x8a, 0x9f, 0x86, 0xba, 0x5a, 0xa1, 0x31, 0x7f, 0x11, 0x78,
-	0x5f, 0xb8, 0x89, 0x30, 0x29, 0x8f, 0x67, 0x4f, 0x35, 0x59, 0xc8, 0x89, 0xd6, 0x18, 0x91, 0x8c,
-	0xc5, 0x38, 0x22, 0xbc, 0x4b, 0xe0, 0x83, 0xc0, 0x69, 0x88, 0x1b, 0x81, 0x2c, 0x51, 0x37, 0x93,
-	0xb4, 0x39, 0x8d, 0x94, 0x23, 0x6d, 0x31, 0xa4, 0x75, 0x5c, 0xf3, 0x23, 0xf5, 0x3d, 0xb9, 0xe0,
-	0xd3, 0xff, 0x04, 0x30, 0x78, 0xc4, 0xe3, 0x14, 0x09, 0x3d, 0xb7, 0x3e, 0x99, 0x4a, 0xcb, 0xe9,
-	0x2a, 0x8c, 0x6e, 0x05, 0x4b, 0x09, 0x74, 0xf8, 0x88, 0xc0, 0x42, 0xe8, 0xb1, 0x8a, 0xdb, 0x31,
-	0xf9, 0x42, 0x66, 0x5d, 0x9d, 0x56, 0xce, 0x09, 0x35, 0x46, 0xb8, 0x81, 0x95, 0x08, 0x42, 0x71,
-	0xf2, 0xeb, 0xf5, 0xf3, 0x4b, 0x99, 0x5c, 0x5c, 0xca, 0xe4, 0xe5, 0xa5, 0x4c, 0xfe, 0xbd, 0x92,
-	0x53, 0x17, 0x57, 0x72, 0xea, 0xf9, 0x95, 0x9c, 0xfa, 0xa1, 0x6a, 0x5a, 0xce, 0xf1, 0xa0, 0xa9,
-	0x1e, 0xd9, 0x1d, 0x1e, 0xac, 0xad, 0x37, 0xe9, 0x28, 0xee, 0xaf, 0xee, 0xef, 0xa9, 0xd3, 0x9e,
-	0x41, 0x9b, 0x19, 0xf6, 0x93, 0xea, 0xb3, 0x37, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72, 0xef, 0xd2,
-	0x12, 0x07, 0x0e, 0x00, 0x00,
+type QuerySignerSetRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QuerySignerSetRequest) Reset()         { *m = QuerySignerSetRequest{} }
+func (m *QuerySignerSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerSetRequest) ProtoMessage()    {}
+func (*QuerySignerSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{18}
+}
+func (m *QuerySignerSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignerSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerSetRequest.Merge(m, src)
+}
+func (m *QuerySignerSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerSetRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySignerSetRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DKGRequest queries the dkg request by the given id.
-	DKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
-	// DKGRequests queries dkg requests by the given params.
-	DKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
-	// DKGCompletions queries dkg completions by the given dkg request id.
-	DKGCompletions(ctx context.Context, in *QueryDKGCompletionsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionsResponse, error)
-	// SigningRequest queries the signing request by the given id.
-	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
-	// SigningRequests queries the signing requests by the given params.
-	SigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
-	// RefreshingRequest queries the refreshing request by the given id.
-	RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error)
-	// RefreshingRequests queries the refreshing requests by the given status.
-	RefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error)
-	// RefreshingCompletions queries refreshing completions by the given request id.
-	RefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error)
+func (m *QuerySignerSetRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QuerySignerSetResponse struct {
+	SignerSet *SignerSet `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySignerSetResponse) Reset()         { *m = QuerySignerSetResponse{} }
+func (m *QuerySignerSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerSetResponse) ProtoMessage()    {}
+func (*QuerySignerSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{19}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySignerSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySignerSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerSetResponse.Merge(m, src)
+}
+func (m *QuerySignerSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerSetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
-	out := new(QueryDKGRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignerSetResponse proto.InternalMessageInfo
+
+func (m *QuerySignerSetResponse) GetSignerSet() *SignerSet {
+	if m != nil {
+		return m.SignerSet
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
-	out := new(QueryDKGRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySigningFeeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) DKGCompletions(ctx context.Context, in *QueryDKGCompletionsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionsResponse, error) {
-	out := new(QueryDKGCompletionsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGCompletions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningFeeRequest) Reset()         { *m = QuerySigningFeeRequest{} }
+func (m *QuerySigningFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningFeeRequest) ProtoMessage()    {}
+func (*QuerySigningFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{20}
+}
+func (m *QuerySigningFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySigningFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningFeeRequest.Merge(m, src)
+}
+func (m *QuerySigningFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningFeeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
-	out := new(QuerySigningRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningFeeRequest proto.InternalMessageInfo
+
+func (m *QuerySigningFeeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
-	out := new(QuerySigningRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySigningFeeResponse struct {
+	Fee *SigningFee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (c *queryClient) RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error) {
-	out := new(QueryRefreshingRequestResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningFeeResponse) Reset()         { *m = QuerySigningFeeResponse{} }
+func (m *QuerySigningFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningFeeResponse) ProtoMessage()    {}
+func (*QuerySigningFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{21}
+}
+func (m *QuerySigningFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySigningFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningFeeResponse.Merge(m, src)
+}
+func (m *QuerySigningFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningFeeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error) {
-	out := new(QueryRefreshingRequestsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningFeeResponse proto.InternalMessageInfo
+
+func (m *QuerySigningFeeResponse) GetFee() *SigningFee {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error) {
-	out := new(QueryRefreshingCompletionsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingCompletions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryParticipantRewardsRequest struct {
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DKGRequest queries the dkg request by the given id.
-	DKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
-	// DKGRequests queries dkg requests by the given params.
-	DKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
-	// DKGCompletions queries dkg completions by the given dkg request id.
-	DKGCompletions(context.Context, *QueryDKGCompletionsRequest) (*QueryDKGCompletionsResponse, error)
-	// SigningRequest queries the signing request by the given id.
-	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
-	// SigningRequests queries the signing requests by the given params.
-	SigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
-	// RefreshingRequest queries the refreshing request by the given id.
-	RefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error)
-	// RefreshingRequests queries the refreshing requests by the given status.
-	RefreshingRequests(context.Context, *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error)
-	// RefreshingCompletions queries refreshing completions by the given request id.
-	RefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error)
+func (m *QueryParticipantRewardsRequest) Reset()         { *m = QueryParticipantRewardsRequest{} }
+func (m *QueryParticipantRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantRewardsRequest) ProtoMessage()    {}
+func (*QueryParticipantRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{22}
+}
+func (m *QueryParticipantRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantRewardsRequest.Merge(m, src)
+}
+func (m *QueryParticipantRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantRewardsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryParticipantRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantRewardsRequest) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+type QueryParticipantRewardsResponse struct {
+	Rewards *ParticipantRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// operator address of the corresponding validator if any
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
 }
-func (*UnimplementedQueryServer) DKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DKGRequest not implemented")
+
+func (m *QueryParticipantRewardsResponse) Reset()         { *m = QueryParticipantRewardsResponse{} }
+func (m *QueryParticipantRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantRewardsResponse) ProtoMessage()    {}
+func (*QueryParticipantRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{23}
 }
-func (*UnimplementedQueryServer) DKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DKGRequests not implemented")
+func (m *QueryParticipantRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DKGCompletions(ctx context.Context, req *QueryDKGCompletionsRequest) (*QueryDKGCompletionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DKGCompletions not implemented")
+func (m *QueryParticipantRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SigningRequest not implemented")
+func (m *QueryParticipantRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SigningRequests not implemented")
+func (m *QueryParticipantRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RefreshingRequest(ctx context.Context, req *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshingRequest not implemented")
+func (m *QueryParticipantRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantRewardsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RefreshingRequests(ctx context.Context, req *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshingRequests not implemented")
+
+var xxx_messageInfo_QueryParticipantRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantRewardsResponse) GetRewards() *ParticipantRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) RefreshingCompletions(ctx context.Context, req *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshingCompletions not implemented")
+
+func (m *QueryParticipantRewardsResponse) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryAllParticipantRewardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllParticipantRewardsRequest) Reset()         { *m = QueryAllParticipantRewardsRequest{} }
+func (m *QueryAllParticipantRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParticipantRewardsRequest) ProtoMessage()    {}
+func (*QueryAllParticipantRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{24}
+}
+func (m *QueryAllParticipantRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParticipantRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParticipantRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllParticipantRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParticipantRewardsRequest.Merge(m, src)
+}
+func (m *QueryAllParticipantRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParticipantRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParticipantRewardsRequest.DiscardUnknown(m)
 }
 
-func _Query_DKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DKGRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/DKGRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DKGRequest(ctx, req.(*QueryDKGRequestRequest))
+var xxx_messageInfo_QueryAllParticipantRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAllParticipantRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DKGRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/DKGRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DKGRequests(ctx, req.(*QueryDKGRequestsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllParticipantRewardsResponse struct {
+	Rewards    []*ParticipantRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DKGCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGCompletionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DKGCompletions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/DKGCompletions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DKGCompletions(ctx, req.(*QueryDKGCompletionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllParticipantRewardsResponse) Reset()         { *m = QueryAllParticipantRewardsResponse{} }
+func (m *QueryAllParticipantRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParticipantRewardsResponse) ProtoMessage()    {}
+func (*QueryAllParticipantRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{25}
 }
-
-func _Query_SigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SigningRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/SigningRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SigningRequest(ctx, req.(*QuerySigningRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllParticipantRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_SigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SigningRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/SigningRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SigningRequests(ctx, req.(*QuerySigningRequestsRequest))
+func (m *QueryAllParticipantRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParticipantRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_RefreshingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RefreshingRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/RefreshingRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RefreshingRequest(ctx, req.(*QueryRefreshingRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllParticipantRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParticipantRewardsResponse.Merge(m, src)
+}
+func (m *QueryAllParticipantRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParticipantRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParticipantRewardsResponse.DiscardUnknown(m)
 }
 
-func _Query_RefreshingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RefreshingRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/RefreshingRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RefreshingRequests(ctx, req.(*QueryRefreshingRequestsRequest))
+var xxx_messageInfo_QueryAllParticipantRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllParticipantRewardsResponse) GetRewards() []*ParticipantRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RefreshingCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRefreshingCompletionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RefreshingCompletions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Query/RefreshingCompletions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RefreshingCompletions(ctx, req.(*QueryRefreshingCompletionsRequest))
+func (m *QueryAllParticipantRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitway.tss.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DKGRequest",
-			Handler:    _Query_DKGRequest_Handler,
-		},
-		{
-			MethodName: "DKGRequests",
-			Handler:    _Query_DKGRequests_Handler,
-		},
-		{
-			MethodName: "DKGCompletions",
-			Handler:    _Query_DKGCompletions_Handler,
-		},
-		{
-			MethodName: "SigningRequest",
-			Handler:    _Query_SigningRequest_Handler,
-		},
-		{
-			MethodName: "SigningRequests",
-			Handler:    _Query_SigningRequests_Handler,
-		},
-		{
-			MethodName: "RefreshingRequest",
-			Handler:    _Query_RefreshingRequest_Handler,
-		},
-		{
-			MethodName: "RefreshingRequests",
-			Handler:    _Query_RefreshingRequests_Handler,
-		},
-		{
-			MethodName: "RefreshingCompletions",
-			Handler:    _Query_RefreshingCompletions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitway/tss/query.proto",
+type QueryKeyRotationsRequest struct {
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 }
 
-func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryKeyRotationsRequest) Reset()         { *m = QueryKeyRotationsRequest{} }
+func (m *QueryKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{26}
 }
-
-func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyRotationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryKeyRotationsRequest) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyRotationsResponse struct {
+	Rotations []*KeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
 }
 
-func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryKeyRotationsResponse) Reset()         { *m = QueryKeyRotationsResponse{} }
+func (m *QueryKeyRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyRotationsResponse) ProtoMessage()    {}
+func (*QueryKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{27}
+}
+func (m *QueryKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyRotationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryKeyRotationsResponse) GetRotations() []*KeyRotation {
+	if m != nil {
+		return m.Rotations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingRequestRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRefreshingRequestRequest) Reset()         { *m = QueryRefreshingRequestRequest{} }
+func (m *QueryRefreshingRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingRequestRequest) ProtoMessage()    {}
+func (*QueryRefreshingRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{28}
+}
+func (m *QueryRefreshingRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRefreshingRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingRequestRequest.Merge(m, src)
+}
+func (m *QueryRefreshingRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingRequestRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRefreshingRequestRequest proto.InternalMessageInfo
+
+func (m *QueryRefreshingRequestRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingRequestResponse struct {
+	Request *RefreshingRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRefreshingRequestResponse) Reset()         { *m = QueryRefreshingRequestResponse{} }
+func (m *QueryRefreshingRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingRequestResponse) ProtoMessage()    {}
+func (*QueryRefreshingRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{29}
+}
+func (m *QueryRefreshingRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRefreshingRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingRequestResponse.Merge(m, src)
+}
+func (m *QueryRefreshingRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDKGCompletionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRefreshingRequestResponse proto.InternalMessageInfo
+
+func (m *QueryRefreshingRequestResponse) GetRequest() *RefreshingRequest {
+	if m != nil {
+		return m.Request
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDKGCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingRequestsRequest struct {
+	Status     RefreshingStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDKGCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRefreshingRequestsRequest) Reset()         { *m = QueryRefreshingRequestsRequest{} }
+func (m *QueryRefreshingRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingRequestsRequest) ProtoMessage()    {}
+func (*QueryRefreshingRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{30}
+}
+func (m *QueryRefreshingRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDKGCompletionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRefreshingRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingRequestsRequest.Merge(m, src)
 }
-
-func (m *QueryDKGCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRefreshingRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingRequestsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDKGCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Completions) > 0 {
-		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryRefreshingRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryRefreshingRequestsRequest) GetStatus() RefreshingStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
 }
 
-func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRefreshingRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingRequestsResponse struct {
+	Requests   []*RefreshingRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRefreshingRequestsResponse) Reset()         { *m = QueryRefreshingRequestsResponse{} }
+func (m *QueryRefreshingRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingRequestsResponse) ProtoMessage()    {}
+func (*QueryRefreshingRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{31}
 }
-
-func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRefreshingRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRefreshingRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingRequestsResponse.Merge(m, src)
+}
+func (m *QueryRefreshingRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingRequestsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRefreshingRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryRefreshingRequestsResponse) GetRequests() []*RefreshingRequest {
+	if m != nil {
+		return m.Requests
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRefreshingRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingCompletionsRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRefreshingCompletionsRequest) Reset()         { *m = QueryRefreshingCompletionsRequest{} }
+func (m *QueryRefreshingCompletionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingCompletionsRequest) ProtoMessage()    {}
+func (*QueryRefreshingCompletionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{32}
+}
+func (m *QueryRefreshingCompletionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingCompletionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingCompletionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryRefreshingCompletionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingCompletionsRequest.Merge(m, src)
+}
+func (m *QueryRefreshingCompletionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingCompletionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingCompletionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefreshingCompletionsRequest proto.InternalMessageInfo
+
+func (m *QueryRefreshingCompletionsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRefreshingCompletionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRefreshingCompletionsResponse struct {
+	Completions []*RefreshingCompletion `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
+	Pagination  *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRefreshingCompletionsResponse) Reset()         { *m = QueryRefreshingCompletionsResponse{} }
+func (m *QueryRefreshingCompletionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefreshingCompletionsResponse) ProtoMessage()    {}
+func (*QueryRefreshingCompletionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{33}
+}
+func (m *QueryRefreshingCompletionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefreshingCompletionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefreshingCompletionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRefreshingCompletionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefreshingCompletionsResponse.Merge(m, src)
+}
+func (m *QueryRefreshingCompletionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefreshingCompletionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefreshingCompletionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRefreshingCompletionsResponse proto.InternalMessageInfo
+
+func (m *QueryRefreshingCompletionsResponse) GetCompletions() []*RefreshingCompletion {
+	if m != nil {
+		return m.Completions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRefreshingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRefreshingCompletionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRefreshingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type QueryParticipantReliabilityRequest struct {
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-func (m *QueryRefreshingRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParticipantReliabilityRequest) Reset()         { *m = QueryParticipantReliabilityRequest{} }
+func (m *QueryParticipantReliabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantReliabilityRequest) ProtoMessage()    {}
+func (*QueryParticipantReliabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{34}
 }
-
-func (m *QueryRefreshingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParticipantReliabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryRefreshingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParticipantReliabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantReliabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParticipantReliabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantReliabilityRequest.Merge(m, src)
+}
+func (m *QueryParticipantReliabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantReliabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantReliabilityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParticipantReliabilityRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantReliabilityRequest) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRefreshingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParticipantReliabilityResponse struct {
+	Reliability *ParticipantReliability `protobuf:"bytes,1,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	// operator address of the corresponding validator if any
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// ratio of the completed rounds to all rounds
+	Score string `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (m *QueryRefreshingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParticipantReliabilityResponse) Reset()         { *m = QueryParticipantReliabilityResponse{} }
+func (m *QueryParticipantReliabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantReliabilityResponse) ProtoMessage()    {}
+func (*QueryParticipantReliabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{35}
+}
+func (m *QueryParticipantReliabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantReliabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantReliabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRefreshingRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParticipantReliabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantReliabilityResponse.Merge(m, src)
 }
-
-func (m *QueryRefreshingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParticipantReliabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantReliabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantReliabilityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryParticipantReliabilityResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantReliabilityResponse) GetReliability() *ParticipantReliability {
+	if m != nil {
+		return m.Reliability
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *QueryParticipantReliabilityResponse) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRefreshingCompletionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParticipantReliabilityResponse) GetScore() string {
+	if m != nil {
+		return m.Score
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRefreshingCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParticipantReliabilitiesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRefreshingCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParticipantReliabilitiesRequest) Reset()         { *m = QueryParticipantReliabilitiesRequest{} }
+func (m *QueryParticipantReliabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantReliabilitiesRequest) ProtoMessage()    {}
+func (*QueryParticipantReliabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{36}
+}
+func (m *QueryParticipantReliabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantReliabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantReliabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParticipantReliabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantReliabilitiesRequest.Merge(m, src)
+}
+func (m *QueryParticipantReliabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantReliabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantReliabilitiesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingCompletionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParticipantReliabilitiesRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantReliabilitiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRefreshingCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParticipantReliabilitiesResponse struct {
+	Reliabilities []*ParticipantReliability `protobuf:"bytes,1,rep,name=reliabilities,proto3" json:"reliabilities,omitempty"`
+	Pagination    *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRefreshingCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParticipantReliabilitiesResponse) Reset()         { *m = QueryParticipantReliabilitiesResponse{} }
+func (m *QueryParticipantReliabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantReliabilitiesResponse) ProtoMessage()    {}
+func (*QueryParticipantReliabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{37}
+}
+func (m *QueryParticipantReliabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantReliabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantReliabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Completions) > 0 {
-		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryParticipantReliabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantReliabilitiesResponse.Merge(m, src)
+}
+func (m *QueryParticipantReliabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantReliabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantReliabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantReliabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantReliabilitiesResponse) GetReliabilities() []*ParticipantReliability {
+	if m != nil {
+		return m.Reliabilities
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParticipantReliabilitiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParticipantHealthRequest struct {
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParticipantHealthRequest) Reset()         { *m = QueryParticipantHealthRequest{} }
+func (m *QueryParticipantHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantHealthRequest) ProtoMessage()    {}
+func (*QueryParticipantHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{38}
+}
+func (m *QueryParticipantHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantHealthRequest.Merge(m, src)
+}
+func (m *QueryParticipantHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParticipantHealthRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantHealthRequest) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParticipantHealthResponse struct {
+	Reliability *ParticipantReliability `protobuf:"bytes,1,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	// operator address of the corresponding validator if any
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// ratio of the completed rounds to all rounds
+	CompletionRate string `protobuf:"bytes,3,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	// average latency of the measured signing rounds
+	AverageSigningLatency time.Duration `protobuf:"bytes,4,opt,name=average_signing_latency,json=averageSigningLatency,proto3,stdduration" json:"average_signing_latency"`
+	// keys of the completed DKGs which the participant holds shares for
+	ActiveKeys []ParticipantKey `protobuf:"bytes,5,rep,name=active_keys,json=activeKeys,proto3" json:"active_keys"`
+	// ids of the pending signing requests which the participant is involved in
+	PendingSigningIds []uint64 `protobuf:"varint,6,rep,packed,name=pending_signing_ids,json=pendingSigningIds,proto3" json:"pending_signing_ids,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParticipantHealthResponse) Reset()         { *m = QueryParticipantHealthResponse{} }
+func (m *QueryParticipantHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantHealthResponse) ProtoMessage()    {}
+func (*QueryParticipantHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{39}
+}
+func (m *QueryParticipantHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryParticipantHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantHealthResponse.Merge(m, src)
+}
+func (m *QueryParticipantHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantHealthResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryParticipantHealthResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantHealthResponse) GetReliability() *ParticipantReliability {
+	if m != nil {
+		return m.Reliability
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryDKGRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+
+func (m *QueryParticipantHealthResponse) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDKGRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParticipantHealthResponse) GetCompletionRate() string {
+	if m != nil {
+		return m.CompletionRate
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDKGRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParticipantHealthResponse) GetAverageSigningLatency() time.Duration {
+	if m != nil {
+		return m.AverageSigningLatency
 	}
-	return n
+	return 0
 }
 
-func (m *QueryDKGRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParticipantHealthResponse) GetActiveKeys() []ParticipantKey {
+	if m != nil {
+		return m.ActiveKeys
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDKGCompletionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParticipantHealthResponse) GetPendingSigningIds() []uint64 {
+	if m != nil {
+		return m.PendingSigningIds
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDKGCompletionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Completions) > 0 {
-		for _, e := range m.Completions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySigningStatsRequest struct {
+	// optional module filter
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 }
 
-func (m *QuerySigningRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QuerySigningStatsRequest) Reset()         { *m = QuerySigningStatsRequest{} }
+func (m *QuerySigningStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningStatsRequest) ProtoMessage()    {}
+func (*QuerySigningStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{40}
+}
+func (m *QuerySigningStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySigningStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningStatsRequest.Merge(m, src)
+}
+func (m *QuerySigningStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySigningStatsRequest proto.InternalMessageInfo
+
+func (m *QuerySigningStatsRequest) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return n
+	return ""
 }
 
-func (m *QuerySigningRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySigningStatsResponse struct {
+	Stats []*SigningStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (m *QuerySigningRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningStatsResponse) Reset()         { *m = QuerySigningStatsResponse{} }
+func (m *QuerySigningStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningStatsResponse) ProtoMessage()    {}
+func (*QuerySigningStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{41}
+}
+func (m *QuerySigningStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySigningStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningStatsResponse.Merge(m, src)
+}
+func (m *QuerySigningStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningStatsResponse proto.InternalMessageInfo
+
+func (m *QuerySigningStatsResponse) GetStats() []*SigningStats {
+	if m != nil {
+		return m.Stats
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRefreshingRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+type QueryParticipantSetRequest struct {
+}
+
+func (m *QueryParticipantSetRequest) Reset()         { *m = QueryParticipantSetRequest{} }
+func (m *QueryParticipantSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantSetRequest) ProtoMessage()    {}
+func (*QueryParticipantSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{42}
+}
+func (m *QueryParticipantSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryParticipantSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantSetRequest.Merge(m, src)
+}
+func (m *QueryParticipantSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantSetRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParticipantSetRequest proto.InternalMessageInfo
+
+type QueryParticipantSetResponse struct {
+	ParticipantSet *ParticipantSet `protobuf:"bytes,1,opt,name=participant_set,json=participantSet,proto3" json:"participant_set,omitempty"`
+	// time at which the participant set is scheduled to be updated
+	UpdateTime time.Time `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time"`
+}
+
+func (m *QueryParticipantSetResponse) Reset()         { *m = QueryParticipantSetResponse{} }
+func (m *QueryParticipantSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantSetResponse) ProtoMessage()    {}
+func (*QueryParticipantSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{43}
+}
+func (m *QueryParticipantSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryParticipantSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantSetResponse.Merge(m, src)
+}
+func (m *QueryParticipantSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantSetResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantSetResponse) GetParticipantSet() *ParticipantSet {
+	if m != nil {
+		return m.ParticipantSet
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRefreshingRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParticipantSetResponse) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return time.Time{}
+}
+
+type QueryCommittedNoncesRequest struct {
+	DkgId      uint64             `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommittedNoncesRequest) Reset()         { *m = QueryCommittedNoncesRequest{} }
+func (m *QueryCommittedNoncesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommittedNoncesRequest) ProtoMessage()    {}
+func (*QueryCommittedNoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{44}
+}
+func (m *QueryCommittedNoncesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommittedNoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommittedNoncesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCommittedNoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommittedNoncesRequest.Merge(m, src)
+}
+func (m *QueryCommittedNoncesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommittedNoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommittedNoncesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommittedNoncesRequest proto.InternalMessageInfo
+
+func (m *QueryCommittedNoncesRequest) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryRefreshingRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommittedNoncesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryCommittedNoncesResponse struct {
+	Nonces []*CommittedNonce `protobuf:"bytes,1,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// id of the pending nonce generation DKG if any
+	PendingGenerationId uint64              `protobuf:"varint,2,opt,name=pending_generation_id,json=pendingGenerationId,proto3" json:"pending_generation_id,omitempty"`
+	Pagination          *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommittedNoncesResponse) Reset()         { *m = QueryCommittedNoncesResponse{} }
+func (m *QueryCommittedNoncesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommittedNoncesResponse) ProtoMessage()    {}
+func (*QueryCommittedNoncesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{45}
+}
+func (m *QueryCommittedNoncesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommittedNoncesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommittedNoncesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryCommittedNoncesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommittedNoncesResponse.Merge(m, src)
+}
+func (m *QueryCommittedNoncesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommittedNoncesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommittedNoncesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRefreshingCompletionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryCommittedNoncesResponse proto.InternalMessageInfo
+
+func (m *QueryCommittedNoncesResponse) GetNonces() []*CommittedNonce {
+	if m != nil {
+		return m.Nonces
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryCommittedNoncesResponse) GetPendingGenerationId() uint64 {
+	if m != nil {
+		return m.PendingGenerationId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryRefreshingCompletionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommittedNoncesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Completions) > 0 {
-		for _, e := range m.Completions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{46}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{47}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return Params{}
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type QueryGovSignatureRequestRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryGovSignatureRequestRequest) Reset()         { *m = QueryGovSignatureRequestRequest{} }
+func (m *QueryGovSignatureRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGovSignatureRequestRequest) ProtoMessage()    {}
+func (*QueryGovSignatureRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{48}
+}
+func (m *QueryGovSignatureRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovSignatureRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovSignatureRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovSignatureRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovSignatureRequestRequest.Merge(m, src)
+}
+func (m *QueryGovSignatureRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovSignatureRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovSignatureRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovSignatureRequestRequest proto.InternalMessageInfo
+
+func (m *QueryGovSignatureRequestRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGovSignatureRequestResponse struct {
+	Request        *GovSignatureRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	SigningRequest *SigningRequest      `protobuf:"bytes,2,opt,name=signing_request,json=signingRequest,proto3" json:"signing_request,omitempty"`
+}
+
+func (m *QueryGovSignatureRequestResponse) Reset()         { *m = QueryGovSignatureRequestResponse{} }
+func (m *QueryGovSignatureRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGovSignatureRequestResponse) ProtoMessage()    {}
+func (*QueryGovSignatureRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{49}
+}
+func (m *QueryGovSignatureRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovSignatureRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovSignatureRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovSignatureRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovSignatureRequestResponse.Merge(m, src)
+}
+func (m *QueryGovSignatureRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovSignatureRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovSignatureRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovSignatureRequestResponse proto.InternalMessageInfo
+
+func (m *QueryGovSignatureRequestResponse) GetRequest() *GovSignatureRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QueryGovSignatureRequestResponse) GetSigningRequest() *SigningRequest {
+	if m != nil {
+		return m.SigningRequest
+	}
+	return nil
+}
+
+type QueryGovSignatureRequestsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGovSignatureRequestsRequest) Reset()         { *m = QueryGovSignatureRequestsRequest{} }
+func (m *QueryGovSignatureRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGovSignatureRequestsRequest) ProtoMessage()    {}
+func (*QueryGovSignatureRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{50}
+}
+func (m *QueryGovSignatureRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovSignatureRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovSignatureRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovSignatureRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovSignatureRequestsRequest.Merge(m, src)
+}
+func (m *QueryGovSignatureRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovSignatureRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovSignatureRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovSignatureRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryGovSignatureRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGovSignatureRequestsResponse struct {
+	Requests   []*GovSignatureRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGovSignatureRequestsResponse) Reset()         { *m = QueryGovSignatureRequestsResponse{} }
+func (m *QueryGovSignatureRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGovSignatureRequestsResponse) ProtoMessage()    {}
+func (*QueryGovSignatureRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c31a93ff2ede8af0, []int{51}
+}
+func (m *QueryGovSignatureRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovSignatureRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovSignatureRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovSignatureRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovSignatureRequestsResponse.Merge(m, src)
+}
+func (m *QueryGovSignatureRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovSignatureRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovSignatureRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovSignatureRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryGovSignatureRequestsResponse) GetRequests() []*GovSignatureRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *QueryGovSignatureRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDKGRequestRequest)(nil), "bitway.tss.QueryDKGRequestRequest")
+	proto.RegisterType((*QueryDKGRequestResponse)(nil), "bitway.tss.QueryDKGRequestResponse")
+	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "bitway.tss.QueryDKGRequestsRequest")
+	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "bitway.tss.QueryDKGRequestsResponse")
+	proto.RegisterType((*QueryDKGCompletionsRequest)(nil), "bitway.tss.QueryDKGCompletionsRequest")
+	proto.RegisterType((*QueryDKGCompletionsResponse)(nil), "bitway.tss.QueryDKGCompletionsResponse")
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "bitway.tss.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "bitway.tss.QuerySigningRequestResponse")
+	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "bitway.tss.QuerySigningRequestsRequest")
+	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "bitway.tss.QuerySigningRequestsResponse")
+	proto.RegisterType((*QuerySigningBatchRequest)(nil), "bitway.tss.QuerySigningBatchRequest")
+	proto.RegisterType((*QuerySigningBatchResponse)(nil), "bitway.tss.QuerySigningBatchResponse")
+	proto.RegisterType((*QuerySigningBatchesRequest)(nil), "bitway.tss.QuerySigningBatchesRequest")
+	proto.RegisterType((*QuerySigningBatchesResponse)(nil), "bitway.tss.QuerySigningBatchesResponse")
+	proto.RegisterType((*QuerySigningAcknowledgementsRequest)(nil), "bitway.tss.QuerySigningAcknowledgementsRequest")
+	proto.RegisterType((*QuerySigningAcknowledgementsResponse)(nil), "bitway.tss.QuerySigningAcknowledgementsResponse")
+	proto.RegisterType((*QueryPartialSignaturesRequest)(nil), "bitway.tss.QueryPartialSignaturesRequest")
+	proto.RegisterType((*QueryPartialSignaturesResponse)(nil), "bitway.tss.QueryPartialSignaturesResponse")
+	proto.RegisterType((*QuerySignerSetRequest)(nil), "bitway.tss.QuerySignerSetRequest")
+	proto.RegisterType((*QuerySignerSetResponse)(nil), "bitway.tss.QuerySignerSetResponse")
+	proto.RegisterType((*QuerySigningFeeRequest)(nil), "bitway.tss.QuerySigningFeeRequest")
+	proto.RegisterType((*QuerySigningFeeResponse)(nil), "bitway.tss.QuerySigningFeeResponse")
+	proto.RegisterType((*QueryParticipantRewardsRequest)(nil), "bitway.tss.QueryParticipantRewardsRequest")
+	proto.RegisterType((*QueryParticipantRewardsResponse)(nil), "bitway.tss.QueryParticipantRewardsResponse")
+	proto.RegisterType((*QueryAllParticipantRewardsRequest)(nil), "bitway.tss.QueryAllParticipantRewardsRequest")
+	proto.RegisterType((*QueryAllParticipantRewardsResponse)(nil), "bitway.tss.QueryAllParticipantRewardsResponse")
+	proto.RegisterType((*QueryKeyRotationsRequest)(nil), "bitway.tss.QueryKeyRotationsRequest")
+	proto.RegisterType((*QueryKeyRotationsResponse)(nil), "bitway.tss.QueryKeyRotationsResponse")
+	proto.RegisterType((*QueryRefreshingRequestRequest)(nil), "bitway.tss.QueryRefreshingRequestRequest")
+	proto.RegisterType((*QueryRefreshingRequestResponse)(nil), "bitway.tss.QueryRefreshingRequestResponse")
+	proto.RegisterType((*QueryRefreshingRequestsRequest)(nil), "bitway.tss.QueryRefreshingRequestsRequest")
+	proto.RegisterType((*QueryRefreshingRequestsResponse)(nil), "bitway.tss.QueryRefreshingRequestsResponse")
+	proto.RegisterType((*QueryRefreshingCompletionsRequest)(nil), "bitway.tss.QueryRefreshingCompletionsRequest")
+	proto.RegisterType((*QueryRefreshingCompletionsResponse)(nil), "bitway.tss.QueryRefreshingCompletionsResponse")
+	proto.RegisterType((*QueryParticipantReliabilityRequest)(nil), "bitway.tss.QueryParticipantReliabilityRequest")
+	proto.RegisterType((*QueryParticipantReliabilityResponse)(nil), "bitway.tss.QueryParticipantReliabilityResponse")
+	proto.RegisterType((*QueryParticipantReliabilitiesRequest)(nil), "bitway.tss.QueryParticipantReliabilitiesRequest")
+	proto.RegisterType((*QueryParticipantReliabilitiesResponse)(nil), "bitway.tss.QueryParticipantReliabilitiesResponse")
+	proto.RegisterType((*QueryParticipantHealthRequest)(nil), "bitway.tss.QueryParticipantHealthRequest")
+	proto.RegisterType((*QueryParticipantHealthResponse)(nil), "bitway.tss.QueryParticipantHealthResponse")
+	proto.RegisterType((*QuerySigningStatsRequest)(nil), "bitway.tss.QuerySigningStatsRequest")
+	proto.RegisterType((*QuerySigningStatsResponse)(nil), "bitway.tss.QuerySigningStatsResponse")
+	proto.RegisterType((*QueryParticipantSetRequest)(nil), "bitway.tss.QueryParticipantSetRequest")
+	proto.RegisterType((*QueryParticipantSetResponse)(nil), "bitway.tss.QueryParticipantSetResponse")
+	proto.RegisterType((*QueryCommittedNoncesRequest)(nil), "bitway.tss.QueryCommittedNoncesRequest")
+	proto.RegisterType((*QueryCommittedNoncesResponse)(nil), "bitway.tss.QueryCommittedNoncesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "bitway.tss.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "bitway.tss.QueryParamsResponse")
+	proto.RegisterType((*QueryGovSignatureRequestRequest)(nil), "bitway.tss.QueryGovSignatureRequestRequest")
+	proto.RegisterType((*QueryGovSignatureRequestResponse)(nil), "bitway.tss.QueryGovSignatureRequestResponse")
+	proto.RegisterType((*QueryGovSignatureRequestsRequest)(nil), "bitway.tss.QueryGovSignatureRequestsRequest")
+	proto.RegisterType((*QueryGovSignatureRequestsResponse)(nil), "bitway.tss.QueryGovSignatureRequestsResponse")
+}
+
+func init() { proto.RegisterFile("bitway/tss/query.proto", fileDescriptor_c31a93ff2ede8af0) }
+
+var fileDescriptor_c31a93ff2ede8af0 = []byte{
+	// 2254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x8d, 0x63, 0xef, 0xfa, 0x79, 0xb1, 0x37, 0xb5, 0xfe, 0x18, 0x77, 0xec, 0xb1, 0xdd,
+	0x76, 0xe2, 0x8f, 0x24, 0xd3, 0xb1, 0x77, 0xf9, 0x12, 0xa7, 0x38, 0x61, 0xbd, 0xc1, 0x28, 0x84,
+	0x0e, 0x17, 0xe0, 0x30, 0xea, 0x99, 0xa9, 0x8c, 0x1b, 0xcf, 0x4c, 0xcf, 0x4e, 0xf7, 0x38, 0x0c,
+	0x49, 0xf6, 0x00, 0x02, 0xc4, 0xc7, 0x61, 0x05, 0x12, 0xac, 0x38, 0x10, 0x3e, 0x04, 0xcb, 0x1e,
+	0xd0, 0xc2, 0x85, 0x03, 0x17, 0xae, 0x7b, 0x63, 0x25, 0x24, 0xc4, 0x09, 0x50, 0xc2, 0xbf, 0x81,
+	0x84, 0xba, 0xfa, 0x75, 0x77, 0x75, 0x77, 0x55, 0xcf, 0xac, 0x35, 0x8e, 0x38, 0xc5, 0x5d, 0xf5,
+	0xea, 0xbd, 0xdf, 0x7b, 0xf5, 0xaa, 0xde, 0xab, 0xdf, 0x04, 0xe6, 0xab, 0xb6, 0xf7, 0xc0, 0xea,
+	0x1b, 0x9e, 0xeb, 0x1a, 0x6f, 0xf6, 0x58, 0xb7, 0x5f, 0xee, 0x74, 0x1d, 0xcf, 0xa1, 0x10, 0x8c,
+	0x97, 0x3d, 0xd7, 0xd5, 0x66, 0x1b, 0x4e, 0xc3, 0xe1, 0xc3, 0x86, 0xff, 0x57, 0x20, 0xa1, 0x2d,
+	0x35, 0x1c, 0xa7, 0xd1, 0x64, 0x86, 0xd5, 0xb1, 0x0d, 0xab, 0xdd, 0x76, 0x3c, 0xcb, 0xb3, 0x9d,
+	0xb6, 0x8b, 0xb3, 0x25, 0x9c, 0xe5, 0x5f, 0xd5, 0xde, 0x7d, 0xa3, 0xde, 0xeb, 0x72, 0x01, 0x9c,
+	0x5f, 0x49, 0xcf, 0x7b, 0x76, 0x8b, 0xb9, 0x9e, 0xd5, 0xea, 0xa0, 0xc0, 0x4e, 0xcd, 0x71, 0x5b,
+	0x8e, 0x6b, 0x54, 0x2d, 0x97, 0x05, 0xc8, 0x8c, 0x93, 0xdd, 0x2a, 0xf3, 0xac, 0x5d, 0xa3, 0x63,
+	0x35, 0xec, 0xb6, 0xa8, 0x6c, 0x41, 0x70, 0xa2, 0x63, 0x75, 0xad, 0x56, 0x88, 0x62, 0x56, 0x98,
+	0xf0, 0x5c, 0x1c, 0xd5, 0xb7, 0x60, 0xfe, 0x8b, 0xbe, 0xc2, 0x5b, 0x87, 0x07, 0x26, 0x7b, 0xb3,
+	0xc7, 0x5c, 0x0f, 0xff, 0xa1, 0xd3, 0x50, 0xb0, 0xeb, 0x45, 0xb2, 0x4a, 0xb6, 0xce, 0x9b, 0x05,
+	0xbb, 0xae, 0x1f, 0xc2, 0x42, 0x46, 0xd2, 0xed, 0x38, 0x6d, 0x97, 0xd1, 0xeb, 0xf0, 0x42, 0x37,
+	0x18, 0xe2, 0xf2, 0x53, 0x7b, 0xf3, 0xe5, 0x38, 0x64, 0x65, 0x61, 0x41, 0x28, 0xa6, 0xff, 0x8e,
+	0x64, 0xb4, 0xb9, 0xa1, 0xe1, 0x6b, 0x30, 0xe1, 0x7a, 0x96, 0xd7, 0x73, 0xb9, 0xb2, 0xe9, 0xbd,
+	0xb9, 0x94, 0xb2, 0x7b, 0x7c, 0xd2, 0x44, 0x21, 0x3a, 0x0f, 0x13, 0x2d, 0xa7, 0xde, 0x6b, 0xb2,
+	0x62, 0x61, 0x95, 0x6c, 0x4d, 0x9a, 0xf8, 0x45, 0x5f, 0x07, 0x88, 0x83, 0x53, 0x1c, 0xe3, 0xb8,
+	0x2e, 0x97, 0x83, 0x48, 0x96, 0xfd, 0x48, 0x96, 0x83, 0x3d, 0xc6, 0x48, 0x96, 0xef, 0x5a, 0x0d,
+	0x16, 0xe2, 0x14, 0x56, 0xea, 0x3f, 0x25, 0x50, 0xcc, 0x42, 0x45, 0xcf, 0xf7, 0xe0, 0x45, 0x74,
+	0xc9, 0x47, 0x3b, 0x96, 0xe3, 0x7a, 0x24, 0x47, 0x0f, 0x12, 0xc0, 0x0a, 0x1c, 0xd8, 0xe6, 0x40,
+	0x60, 0x81, 0xc1, 0x04, 0x32, 0x0f, 0xb4, 0x10, 0xd8, 0x4d, 0xa7, 0xd5, 0x69, 0x32, 0x9e, 0x74,
+	0x8a, 0xfd, 0x4b, 0xc5, 0xa3, 0x70, 0xea, 0x78, 0xfc, 0x9a, 0xc0, 0x45, 0xa9, 0x59, 0x0c, 0xc9,
+	0x67, 0x60, 0xaa, 0x16, 0x0f, 0x63, 0x54, 0x16, 0x53, 0x51, 0x89, 0x17, 0x9a, 0xa2, 0xf4, 0xe8,
+	0x62, 0x73, 0x15, 0x63, 0x73, 0xcf, 0x6e, 0xb4, 0xed, 0x76, 0x63, 0x40, 0x6e, 0xdf, 0x43, 0x97,
+	0xd2, 0xd2, 0xe8, 0xd2, 0x6b, 0xe9, 0xfc, 0xd6, 0x44, 0x77, 0x52, 0x8b, 0xa2, 0x1c, 0xff, 0x03,
+	0x91, 0x6a, 0x8d, 0x36, 0x68, 0x37, 0x95, 0xe7, 0x8b, 0x12, 0xa5, 0xcf, 0x39, 0xd7, 0x9f, 0x10,
+	0x58, 0x92, 0x43, 0xc6, 0x48, 0x7c, 0x22, 0x93, 0xef, 0x79, 0xa1, 0x38, 0x83, 0x9c, 0xdf, 0xc1,
+	0xc3, 0x88, 0x96, 0xf6, 0x2d, 0xaf, 0x76, 0xa4, 0xda, 0xd5, 0x6f, 0x11, 0x58, 0x94, 0x08, 0xa3,
+	0x2b, 0x65, 0x18, 0xaf, 0xfa, 0x03, 0xb8, 0xa5, 0x45, 0x89, 0x1f, 0xc1, 0x82, 0x40, 0x2c, 0xe1,
+	0x7a, 0x61, 0x78, 0xd7, 0xf5, 0xf7, 0x49, 0x32, 0x15, 0xb9, 0x52, 0xf6, 0xff, 0x9c, 0x05, 0x3f,
+	0x4b, 0x25, 0x6e, 0x84, 0x38, 0xba, 0xf4, 0x5e, 0xa8, 0x06, 0x43, 0x98, 0x03, 0xea, 0xd8, 0x85,
+	0x82, 0xa3, 0x4b, 0x80, 0xc7, 0xb0, 0x2e, 0x62, 0xbb, 0x51, 0x3b, 0x6e, 0x3b, 0x0f, 0x9a, 0xac,
+	0xde, 0x60, 0x2d, 0xd6, 0xf6, 0xce, 0xfc, 0xf6, 0xfb, 0x0b, 0x81, 0x8d, 0x7c, 0xfb, 0x18, 0xa4,
+	0x3b, 0xf0, 0xb2, 0x95, 0x9a, 0xc3, 0x68, 0xe9, 0x92, 0x68, 0xa5, 0xd4, 0x98, 0x99, 0xb5, 0xa3,
+	0x0b, 0xe0, 0x03, 0x58, 0xe6, 0x0e, 0xdc, 0xb5, 0xba, 0x9e, 0x6d, 0x35, 0x7d, 0x00, 0x96, 0xd7,
+	0xeb, 0xb2, 0x33, 0x0f, 0xdd, 0x9f, 0x08, 0x94, 0x54, 0x96, 0x31, 0x68, 0x87, 0x40, 0x3b, 0xc1,
+	0x64, 0xc5, 0x8d, 0x66, 0x31, 0x6c, 0x4b, 0x62, 0xd8, 0xd2, 0x2a, 0xcc, 0x0b, 0x9d, 0xb4, 0xd2,
+	0xd1, 0x45, 0x6c, 0x13, 0xe6, 0xa2, 0x2d, 0x67, 0xdd, 0x7b, 0x4c, 0x59, 0x46, 0xee, 0x60, 0x33,
+	0x25, 0x08, 0x46, 0x15, 0x04, 0x5c, 0x3e, 0x58, 0x71, 0x59, 0x58, 0x44, 0xe6, 0xd2, 0x79, 0x10,
+	0x2c, 0x99, 0x74, 0xc3, 0x3f, 0xa3, 0xe6, 0x0c, 0x93, 0xe4, 0x75, 0xc6, 0x54, 0x96, 0x6f, 0x62,
+	0x3b, 0x25, 0x4a, 0xa2, 0xe9, 0x2d, 0x18, 0xbb, 0xcf, 0x98, 0xac, 0x31, 0x13, 0x84, 0x7d, 0x11,
+	0xfd, 0x50, 0xdc, 0x9f, 0x9a, 0xdd, 0xb1, 0xda, 0x9e, 0xc9, 0x1e, 0x58, 0xdd, 0x7a, 0x94, 0x1a,
+	0xdb, 0xf0, 0x72, 0xcd, 0x57, 0xda, 0x76, 0x7b, 0x6e, 0xa5, 0xd3, 0xab, 0x1e, 0xb3, 0x3e, 0x57,
+	0x3c, 0x69, 0xce, 0x44, 0xe3, 0x77, 0xf9, 0xb0, 0xde, 0x87, 0x15, 0xa5, 0x32, 0x44, 0xf6, 0x29,
+	0xbf, 0xac, 0xf2, 0x21, 0x44, 0x57, 0xca, 0x6c, 0x71, 0x72, 0x61, 0x28, 0x4e, 0x97, 0x60, 0xf2,
+	0xc4, 0x6a, 0xda, 0x75, 0xcb, 0x73, 0xba, 0x78, 0x09, 0xc6, 0x03, 0xfa, 0x31, 0xac, 0x71, 0xd3,
+	0x37, 0x9a, 0x4d, 0xb5, 0x2b, 0xc9, 0xac, 0x26, 0xa7, 0xce, 0xea, 0x77, 0x09, 0xe8, 0x79, 0xd6,
+	0x64, 0xbe, 0x8e, 0x7d, 0x14, 0x5f, 0x47, 0x96, 0xc6, 0x7b, 0x58, 0x3a, 0x0f, 0x59, 0xdf, 0x0c,
+	0x5f, 0x28, 0x61, 0x34, 0xe2, 0x92, 0x42, 0xc4, 0x92, 0xa2, 0x9b, 0x58, 0x41, 0x93, 0x6b, 0xd0,
+	0xa7, 0x8f, 0xc3, 0x64, 0x37, 0x1c, 0x44, 0xaf, 0x16, 0x44, 0xaf, 0x84, 0x45, 0x66, 0x2c, 0xa9,
+	0x1b, 0x78, 0x01, 0x99, 0xec, 0x7e, 0x97, 0xb9, 0x47, 0x83, 0xbb, 0xb3, 0x2f, 0x63, 0x5e, 0x4a,
+	0x16, 0x20, 0x92, 0x4f, 0xa6, 0x1b, 0xb4, 0x65, 0x11, 0x47, 0x76, 0x5d, 0xd4, 0xa3, 0xfd, 0x9c,
+	0xa8, 0x74, 0x47, 0xa1, 0x79, 0x2d, 0x55, 0xa0, 0x97, 0xe4, 0xaa, 0x53, 0x35, 0x7a, 0x54, 0x97,
+	0xe6, 0x6f, 0x08, 0x9e, 0x23, 0x19, 0x40, 0xf4, 0xfe, 0xd3, 0x99, 0xa6, 0x6c, 0x80, 0xfb, 0x67,
+	0xd0, 0x97, 0x3d, 0xc4, 0x33, 0x17, 0x1b, 0x7b, 0x8e, 0x4f, 0x92, 0x3f, 0x86, 0x67, 0x50, 0x61,
+	0x1d, 0xe3, 0xb4, 0x2f, 0x7b, 0x99, 0xac, 0xca, 0x43, 0x75, 0xe6, 0x0f, 0x94, 0x2f, 0x20, 0xe4,
+	0xc4, 0xd1, 0x6f, 0xda, 0x56, 0xd5, 0x6e, 0xda, 0x5e, 0xff, 0x14, 0x17, 0xee, 0x13, 0x82, 0x9d,
+	0x91, 0x4a, 0x23, 0x46, 0xe1, 0x16, 0x4c, 0x75, 0xe3, 0x61, 0x3c, 0x2f, 0xba, 0xf2, 0x36, 0x8a,
+	0x15, 0x88, 0xcb, 0xf2, 0x6f, 0x60, 0x3a, 0x0b, 0xe3, 0x6e, 0xcd, 0xe9, 0x32, 0xde, 0x84, 0x4e,
+	0x9a, 0xc1, 0x87, 0xde, 0xc6, 0xd6, 0x49, 0xaa, 0xdf, 0x66, 0x23, 0xbf, 0x9a, 0xff, 0x4c, 0xe0,
+	0xd2, 0x00, 0x83, 0x18, 0x93, 0x37, 0xe0, 0x63, 0x5d, 0x71, 0x42, 0xd6, 0xa9, 0x29, 0xa2, 0x92,
+	0x5c, 0x38, 0xba, 0xfc, 0xf8, 0x9c, 0xd8, 0xa6, 0x05, 0x66, 0xdf, 0x60, 0x56, 0xd3, 0x3b, 0x3a,
+	0x45, 0x6a, 0xfc, 0xb7, 0x90, 0xad, 0xec, 0xa1, 0xb2, 0xe7, 0x98, 0x15, 0x9b, 0x30, 0x13, 0x1f,
+	0xa5, 0x4a, 0xd7, 0xf2, 0xc2, 0xfc, 0x98, 0x8e, 0x87, 0x4d, 0xcb, 0x63, 0xf4, 0xab, 0xb0, 0x60,
+	0x9d, 0xb0, 0xae, 0xd5, 0x60, 0xbc, 0x0d, 0xb4, 0xdb, 0x8d, 0x4a, 0xd3, 0xf2, 0x58, 0xbb, 0xd6,
+	0x2f, 0x9e, 0xe7, 0xc0, 0x16, 0xcb, 0x01, 0x65, 0x56, 0x0e, 0x29, 0xb3, 0xf2, 0x2d, 0xa4, 0xd4,
+	0xf6, 0x5f, 0xfc, 0xe0, 0x9f, 0x2b, 0xe7, 0xde, 0xf9, 0xd7, 0x0a, 0x31, 0xe7, 0x50, 0x07, 0xb6,
+	0x39, 0x9f, 0x0f, 0x34, 0xd0, 0x1b, 0x30, 0x65, 0xd5, 0x3c, 0xfb, 0x84, 0x55, 0x8e, 0x59, 0xdf,
+	0x2d, 0x8e, 0x67, 0x9f, 0x72, 0x82, 0xa7, 0x87, 0xac, 0xbf, 0x7f, 0xde, 0xd7, 0x68, 0x42, 0xb0,
+	0xe8, 0x90, 0xf5, 0x5d, 0x5a, 0x86, 0x57, 0x3a, 0xac, 0x5d, 0xf7, 0x71, 0x85, 0xf8, 0xec, 0xba,
+	0x5b, 0x9c, 0x58, 0x1d, 0xdb, 0x3a, 0x6f, 0x5e, 0xc0, 0x29, 0x34, 0x7b, 0xbb, 0xee, 0x46, 0x95,
+	0x57, 0x78, 0xce, 0x0d, 0xac, 0xbc, 0x87, 0xc9, 0xb7, 0x2b, 0xae, 0x89, 0xdf, 0xae, 0x7e, 0x9d,
+	0xc9, 0x7b, 0x7f, 0x05, 0x0b, 0x02, 0x31, 0x7d, 0x09, 0x9f, 0xa0, 0x82, 0x67, 0x71, 0x1b, 0xab,
+	0xbf, 0x17, 0xbe, 0xf7, 0xd2, 0xd3, 0x68, 0xed, 0x26, 0xcc, 0x74, 0xe2, 0x19, 0xa1, 0x83, 0x55,
+	0x45, 0xcd, 0x5f, 0x3c, 0xdd, 0x49, 0x7c, 0xd3, 0xcf, 0xc2, 0x54, 0xaf, 0x53, 0xb7, 0x3c, 0x56,
+	0xf1, 0xec, 0x16, 0xc3, 0x93, 0xa1, 0x65, 0xf6, 0xf1, 0x4b, 0x21, 0xf5, 0x19, 0x6c, 0xe4, 0xdb,
+	0xfe, 0x46, 0x42, 0xb0, 0xd0, 0x9f, 0xd2, 0x1f, 0x21, 0xd4, 0x9b, 0x4e, 0xab, 0x65, 0x7b, 0x1e,
+	0xab, 0xdf, 0x71, 0xda, 0xb5, 0xf8, 0xea, 0x98, 0x83, 0x89, 0xfa, 0xb1, 0xbf, 0x1b, 0x58, 0x65,
+	0xc6, 0xeb, 0xc7, 0x8d, 0xdb, 0xa3, 0x2b, 0x34, 0x7f, 0x0d, 0xf9, 0x91, 0x8c, 0xf9, 0xe8, 0x69,
+	0x3c, 0xd1, 0xe6, 0x23, 0x32, 0x76, 0x24, 0xb9, 0xc8, 0x44, 0x49, 0xba, 0x07, 0x73, 0x61, 0x36,
+	0x35, 0x58, 0x9b, 0x05, 0x79, 0xec, 0xbb, 0x50, 0xe0, 0x2e, 0x84, 0xa9, 0x76, 0x10, 0xcd, 0xdd,
+	0xae, 0xa7, 0xae, 0x99, 0xb1, 0xd3, 0x5f, 0x33, 0xb3, 0x40, 0xc3, 0xad, 0xb7, 0x5a, 0x61, 0x18,
+	0xf5, 0x03, 0x78, 0x25, 0x31, 0x1a, 0xf1, 0xbc, 0x13, 0x01, 0xa5, 0x8c, 0xfb, 0x4f, 0x53, 0xfb,
+	0x6f, 0xb5, 0x5c, 0x3c, 0x2d, 0x28, 0xa7, 0xef, 0x62, 0xf7, 0x72, 0xe0, 0x9c, 0xc4, 0x6f, 0xb5,
+	0xfc, 0x6e, 0xef, 0x57, 0x04, 0x56, 0xd5, 0x6b, 0xa2, 0x96, 0x27, 0xd5, 0xf0, 0xad, 0x88, 0x50,
+	0x64, 0x2b, 0x43, 0x79, 0x3f, 0x9b, 0xc3, 0x43, 0x1b, 0xaa, 0x28, 0x0c, 0x24, 0xf5, 0xa6, 0xdd,
+	0xc4, 0xb7, 0xfe, 0x35, 0x35, 0xc6, 0x91, 0x97, 0xb1, 0xf7, 0x08, 0xf6, 0x56, 0x72, 0x63, 0x11,
+	0xed, 0x9a, 0x6e, 0x02, 0x07, 0x86, 0x64, 0xf4, 0x6d, 0xe0, 0xde, 0xdf, 0x97, 0x61, 0x9c, 0x63,
+	0xa5, 0x0c, 0x26, 0x82, 0x8c, 0xa0, 0x89, 0x97, 0x4e, 0x36, 0xd9, 0xb4, 0x15, 0xe5, 0x7c, 0x60,
+	0x40, 0xd7, 0xbe, 0xf9, 0xb7, 0xff, 0xfc, 0xb8, 0x30, 0x4b, 0xa9, 0x91, 0xf9, 0x6d, 0x83, 0x3e,
+	0x02, 0x88, 0x49, 0x76, 0xaa, 0x67, 0x54, 0x65, 0x7e, 0xd7, 0xd0, 0xd6, 0x73, 0x65, 0xd0, 0xe4,
+	0x25, 0x6e, 0x72, 0x85, 0x2e, 0x8b, 0x26, 0xeb, 0xc7, 0x0d, 0x23, 0x0c, 0x99, 0xf1, 0xd0, 0xae,
+	0x3f, 0xa6, 0xdf, 0x80, 0x29, 0xe1, 0x57, 0x01, 0x9a, 0xa7, 0x3a, 0x72, 0x77, 0x23, 0x5f, 0x08,
+	0x01, 0xac, 0x72, 0x00, 0x1a, 0x2d, 0xaa, 0x00, 0xd0, 0x1f, 0x10, 0x98, 0x4e, 0x52, 0xf0, 0xf4,
+	0xb2, 0x4c, 0x75, 0xb6, 0x0f, 0xd7, 0x36, 0x07, 0xca, 0x21, 0x8a, 0x2d, 0x8e, 0x42, 0xa7, 0xab,
+	0x69, 0x14, 0x42, 0x4b, 0x1c, 0x44, 0xe2, 0x87, 0x04, 0xa6, 0x93, 0x67, 0x46, 0x82, 0x46, 0x4a,
+	0xc6, 0x4b, 0xd0, 0xc8, 0x69, 0x78, 0x7d, 0x9b, 0xa3, 0x59, 0xa7, 0x6b, 0x22, 0x1a, 0x3c, 0x98,
+	0xa9, 0x8d, 0xf9, 0x3e, 0x81, 0x99, 0x14, 0x87, 0x4d, 0x07, 0xd9, 0x89, 0xc2, 0xb3, 0x35, 0x58,
+	0x10, 0x11, 0x6d, 0x70, 0x44, 0x25, 0xba, 0x94, 0x87, 0x88, 0x7e, 0x9b, 0xc0, 0x4b, 0x22, 0x2b,
+	0x4a, 0x37, 0x54, 0x06, 0x44, 0x3a, 0x5b, 0xbb, 0x34, 0x40, 0x2a, 0x6f, 0x8f, 0x42, 0x0c, 0x48,
+	0xbf, 0x06, 0x41, 0xf9, 0x4e, 0xbc, 0x47, 0x48, 0xe9, 0xaa, 0xf7, 0x28, 0xc9, 0x52, 0xab, 0xf7,
+	0x28, 0xc5, 0x0d, 0xeb, 0xeb, 0x1c, 0xcd, 0x32, 0xbd, 0x98, 0x83, 0x86, 0xbe, 0x4f, 0x60, 0x41,
+	0xc1, 0x9f, 0x52, 0x43, 0x65, 0x49, 0xc1, 0xf4, 0x6a, 0xd7, 0x87, 0x5f, 0x80, 0x18, 0x77, 0x39,
+	0xc6, 0x2b, 0x74, 0x5b, 0x86, 0x31, 0x4d, 0xbc, 0x06, 0xa1, 0x7b, 0x42, 0xe0, 0x42, 0x86, 0xb6,
+	0xa4, 0xdb, 0xb2, 0x9b, 0x4b, 0x4a, 0xaa, 0x6a, 0x3b, 0xc3, 0x88, 0x22, 0xbe, 0x57, 0x39, 0xbe,
+	0x6b, 0xf4, 0x8a, 0x0c, 0x5f, 0x96, 0x1f, 0x0d, 0x10, 0x3e, 0x82, 0xc9, 0x88, 0x43, 0xa4, 0x6b,
+	0xd2, 0x98, 0x88, 0xdc, 0xa5, 0xa6, 0xe7, 0x89, 0x0c, 0x93, 0x5a, 0x01, 0x4d, 0x89, 0xd6, 0x1f,
+	0x02, 0xc4, 0x6c, 0x22, 0xd5, 0x55, 0x5b, 0x12, 0x33, 0x98, 0x92, 0x6b, 0x38, 0xcb, 0x5d, 0xe6,
+	0x9f, 0xaf, 0xfb, 0x8c, 0x05, 0xc6, 0x7f, 0x42, 0x80, 0x66, 0x19, 0x34, 0xaa, 0x08, 0xb9, 0x8c,
+	0x0d, 0xd4, 0xae, 0x0c, 0x25, 0x8b, 0xa8, 0x36, 0x39, 0xaa, 0x35, 0xba, 0x92, 0xaa, 0x47, 0xa1,
+	0xbc, 0x11, 0x52, 0x77, 0xbf, 0x24, 0xf0, 0x8a, 0xa4, 0xf0, 0xd2, 0xac, 0x35, 0x75, 0x7f, 0xa4,
+	0x5d, 0x1d, 0x4e, 0x18, 0xb1, 0x19, 0x1c, 0xdb, 0x36, 0xdd, 0x14, 0xb1, 0x35, 0x9c, 0x13, 0x23,
+	0xca, 0x97, 0x4a, 0xf2, 0xa6, 0x7c, 0x42, 0x60, 0x56, 0xd6, 0x58, 0xd0, 0xa1, 0xec, 0x46, 0x01,
+	0xbc, 0x36, 0xa4, 0x34, 0xc2, 0xdc, 0xe1, 0x30, 0x37, 0xa8, 0x3e, 0x18, 0x26, 0x7d, 0x0b, 0x5e,
+	0x12, 0xe9, 0x47, 0xc9, 0xed, 0x29, 0x61, 0x34, 0x25, 0xb7, 0xa7, 0x8c, 0xc3, 0xd4, 0xd7, 0x38,
+	0x90, 0x8b, 0x74, 0x51, 0x04, 0x72, 0xcc, 0xfa, 0x95, 0x88, 0xaf, 0xa4, 0xbf, 0x20, 0x30, 0x27,
+	0x25, 0x77, 0x69, 0xd6, 0xe9, 0x3c, 0xca, 0x59, 0x2b, 0x0f, 0x2b, 0x9e, 0x77, 0xfc, 0x84, 0x3c,
+	0x73, 0xa3, 0x44, 0x7b, 0x87, 0xc0, 0x85, 0x0c, 0xcd, 0x27, 0xb9, 0x9e, 0x54, 0x94, 0xab, 0xe4,
+	0x7a, 0x52, 0x92, 0xad, 0xfa, 0x55, 0x0e, 0xeb, 0x32, 0xdd, 0x10, 0x61, 0x75, 0x23, 0xf1, 0x54,
+	0x25, 0xf6, 0x0f, 0x67, 0x96, 0xbb, 0xa4, 0x43, 0x18, 0xcc, 0x39, 0x9c, 0x6a, 0x32, 0x54, 0x7e,
+	0x38, 0x25, 0xe8, 0xe8, 0xbb, 0x04, 0xe6, 0xa4, 0x7c, 0xa1, 0x64, 0x5b, 0xf3, 0x58, 0x4d, 0xc9,
+	0xb6, 0xe6, 0xd2, 0x90, 0xf2, 0x23, 0x2a, 0x20, 0xcc, 0xf4, 0x56, 0xbf, 0x25, 0x30, 0x2f, 0x67,
+	0x5f, 0x68, 0x39, 0xff, 0xde, 0x4a, 0xf3, 0x89, 0x9a, 0x31, 0xb4, 0x3c, 0x82, 0xbd, 0xc2, 0xc1,
+	0x5e, 0xa2, 0xeb, 0xea, 0xbb, 0x2e, 0x46, 0xf3, 0x7b, 0x02, 0x45, 0x15, 0xd7, 0x46, 0xaf, 0x0f,
+	0x67, 0x3a, 0xe6, 0x01, 0xb5, 0xdd, 0x8f, 0xb0, 0x22, 0x2f, 0x37, 0x53, 0x47, 0x26, 0xc6, 0xfb,
+	0xa3, 0xb0, 0xaa, 0x8b, 0x94, 0x98, 0xaa, 0xaa, 0x4b, 0x38, 0x38, 0x6d, 0x67, 0x18, 0x51, 0x84,
+	0x76, 0x99, 0x43, 0x5b, 0xa5, 0x25, 0x55, 0x24, 0x8f, 0x02, 0xf3, 0x6f, 0x45, 0xcd, 0x22, 0xa7,
+	0x70, 0xd4, 0xcd, 0xa2, 0x48, 0x23, 0xa9, 0x9b, 0xc5, 0x04, 0x71, 0x24, 0xbf, 0xee, 0xa2, 0x8a,
+	0xce, 0xed, 0x7d, 0x97, 0xc0, 0x74, 0x92, 0xcb, 0x91, 0x74, 0x89, 0x52, 0x22, 0x49, 0xd2, 0x25,
+	0xca, 0x19, 0x25, 0x79, 0x5d, 0x4f, 0x6c, 0x93, 0xcb, 0x3c, 0xfa, 0x3d, 0x02, 0x33, 0x29, 0xa2,
+	0x45, 0xd2, 0xc4, 0xcb, 0x99, 0x20, 0x49, 0x13, 0xaf, 0xe0, 0x6c, 0xe4, 0x2d, 0x6b, 0xc0, 0xcd,
+	0x18, 0x0f, 0x03, 0x36, 0xe9, 0xf1, 0xfe, 0xfe, 0x07, 0x4f, 0x4b, 0xe4, 0xc3, 0xa7, 0x25, 0xf2,
+	0xef, 0xa7, 0x25, 0xf2, 0xf6, 0xb3, 0xd2, 0xb9, 0x0f, 0x9f, 0x95, 0xce, 0xfd, 0xe3, 0x59, 0xe9,
+	0xdc, 0x57, 0xb6, 0x1a, 0xb6, 0x77, 0xd4, 0xab, 0x96, 0x6b, 0x4e, 0x0b, 0x15, 0x34, 0xad, 0xaa,
+	0x1b, 0xea, 0xfa, 0x7a, 0xf0, 0xff, 0xed, 0xfa, 0x1d, 0xe6, 0x56, 0x27, 0x38, 0xcb, 0xf5, 0xea,
+	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xac, 0x6b, 0x3a, 0x4a, 0x68, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DKGRequest queries the dkg request by the given id.
+	DKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
+	// DKGRequests queries dkg requests by the given params.
+	DKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
+	// DKGCompletions queries dkg completions by the given dkg request id.
+	DKGCompletions(ctx context.Context, in *QueryDKGCompletionsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionsResponse, error)
+	// SigningRequest queries the signing request by the given id.
+	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+	// SigningRequests queries the signing requests by the given params.
+	SigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
+	// SigningBatch queries the signing batch by the given id.
+	SigningBatch(ctx context.Context, in *QuerySigningBatchRequest, opts ...grpc.CallOption) (*QuerySigningBatchResponse, error)
+	// SigningBatches queries the signing batches by the given params.
+	SigningBatches(ctx context.Context, in *QuerySigningBatchesRequest, opts ...grpc.CallOption) (*QuerySigningBatchesResponse, error)
+	// SigningAcknowledgements queries signing acknowledgements by the given signing request id.
+	SigningAcknowledgements(ctx context.Context, in *QuerySigningAcknowledgementsRequest, opts ...grpc.CallOption) (*QuerySigningAcknowledgementsResponse, error)
+	// PartialSignatures queries partial signatures by the given signing request id.
+	PartialSignatures(ctx context.Context, in *QueryPartialSignaturesRequest, opts ...grpc.CallOption) (*QueryPartialSignaturesResponse, error)
+	// SignerSet queries the signer set of the given signing request.
+	SignerSet(ctx context.Context, in *QuerySignerSetRequest, opts ...grpc.CallOption) (*QuerySignerSetResponse, error)
+	// SigningFee queries the fee escrowed for the given signing request.
+	SigningFee(ctx context.Context, in *QuerySigningFeeRequest, opts ...grpc.CallOption) (*QuerySigningFeeResponse, error)
+	// ParticipantRewards queries the rewards of the given participant.
+	ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error)
+	// GovSignatureRequest queries the gov signature request by the given id.
+	GovSignatureRequest(ctx context.Context, in *QueryGovSignatureRequestRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestResponse, error)
+	// GovSignatureRequests queries all gov signature requests.
+	GovSignatureRequests(ctx context.Context, in *QueryGovSignatureRequestsRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestsResponse, error)
+	// KeyRotations queries the scheduled key rotations.
+	KeyRotations(ctx context.Context, in *QueryKeyRotationsRequest, opts ...grpc.CallOption) (*QueryKeyRotationsResponse, error)
+	// AllParticipantRewards queries the rewards of all participants.
+	AllParticipantRewards(ctx context.Context, in *QueryAllParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryAllParticipantRewardsResponse, error)
+	// RefreshingRequest queries the refreshing request by the given id.
+	RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error)
+	// RefreshingRequests queries the refreshing requests by the given status.
+	RefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error)
+	// RefreshingCompletions queries refreshing completions by the given request id.
+	RefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error)
+	// ParticipantReliability queries the reliability of the given participant.
+	ParticipantReliability(ctx context.Context, in *QueryParticipantReliabilityRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilityResponse, error)
+	// ParticipantReliabilities queries the reliabilities of all participants.
+	ParticipantReliabilities(ctx context.Context, in *QueryParticipantReliabilitiesRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilitiesResponse, error)
+	// ParticipantHealth queries the key inventory and signing performance of the given participant.
+	ParticipantHealth(ctx context.Context, in *QueryParticipantHealthRequest, opts ...grpc.CallOption) (*QueryParticipantHealthResponse, error)
+	// SigningStats queries the signing statistics by module and signing type.
+	SigningStats(ctx context.Context, in *QuerySigningStatsRequest, opts ...grpc.CallOption) (*QuerySigningStatsResponse, error)
+	// ParticipantSet queries the automatically maintained participant set.
+	ParticipantSet(ctx context.Context, in *QueryParticipantSetRequest, opts ...grpc.CallOption) (*QueryParticipantSetResponse, error)
+	// CommittedNonces queries the committed nonces in the queue of the given DKG.
+	CommittedNonces(ctx context.Context, in *QueryCommittedNoncesRequest, opts ...grpc.CallOption) (*QueryCommittedNoncesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
+	out := new(QueryDKGRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
+	out := new(QueryDKGRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DKGCompletions(ctx context.Context, in *QueryDKGCompletionsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionsResponse, error) {
+	out := new(QueryDKGCompletionsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/DKGCompletions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
+	out := new(QuerySigningRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningBatch(ctx context.Context, in *QuerySigningBatchRequest, opts ...grpc.CallOption) (*QuerySigningBatchResponse, error) {
+	out := new(QuerySigningBatchResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningBatches(ctx context.Context, in *QuerySigningBatchesRequest, opts ...grpc.CallOption) (*QuerySigningBatchesResponse, error) {
+	out := new(QuerySigningBatchesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningAcknowledgements(ctx context.Context, in *QuerySigningAcknowledgementsRequest, opts ...grpc.CallOption) (*QuerySigningAcknowledgementsResponse, error) {
+	out := new(QuerySigningAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningAcknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartialSignatures(ctx context.Context, in *QueryPartialSignaturesRequest, opts ...grpc.CallOption) (*QueryPartialSignaturesResponse, error) {
+	out := new(QueryPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/PartialSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSet(ctx context.Context, in *QuerySignerSetRequest, opts ...grpc.CallOption) (*QuerySignerSetResponse, error) {
+	out := new(QuerySignerSetResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SignerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningFee(ctx context.Context, in *QuerySigningFeeRequest, opts ...grpc.CallOption) (*QuerySigningFeeResponse, error) {
+	out := new(QuerySigningFeeResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error) {
+	out := new(QueryParticipantRewardsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/ParticipantRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovSignatureRequest(ctx context.Context, in *QueryGovSignatureRequestRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestResponse, error) {
+	out := new(QueryGovSignatureRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/GovSignatureRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovSignatureRequests(ctx context.Context, in *QueryGovSignatureRequestsRequest, opts ...grpc.CallOption) (*QueryGovSignatureRequestsResponse, error) {
+	out := new(QueryGovSignatureRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/GovSignatureRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyRotations(ctx context.Context, in *QueryKeyRotationsRequest, opts ...grpc.CallOption) (*QueryKeyRotationsResponse, error) {
+	out := new(QueryKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/KeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllParticipantRewards(ctx context.Context, in *QueryAllParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryAllParticipantRewardsResponse, error) {
+	out := new(QueryAllParticipantRewardsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/AllParticipantRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefreshingRequest(ctx context.Context, in *QueryRefreshingRequestRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestResponse, error) {
+	out := new(QueryRefreshingRequestResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefreshingRequests(ctx context.Context, in *QueryRefreshingRequestsRequest, opts ...grpc.CallOption) (*QueryRefreshingRequestsResponse, error) {
+	out := new(QueryRefreshingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefreshingCompletions(ctx context.Context, in *QueryRefreshingCompletionsRequest, opts ...grpc.CallOption) (*QueryRefreshingCompletionsResponse, error) {
+	out := new(QueryRefreshingCompletionsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/RefreshingCompletions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantReliability(ctx context.Context, in *QueryParticipantReliabilityRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilityResponse, error) {
+	out := new(QueryParticipantReliabilityResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/ParticipantReliability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantReliabilities(ctx context.Context, in *QueryParticipantReliabilitiesRequest, opts ...grpc.CallOption) (*QueryParticipantReliabilitiesResponse, error) {
+	out := new(QueryParticipantReliabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/ParticipantReliabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantHealth(ctx context.Context, in *QueryParticipantHealthRequest, opts ...grpc.CallOption) (*QueryParticipantHealthResponse, error) {
+	out := new(QueryParticipantHealthResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/ParticipantHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningStats(ctx context.Context, in *QuerySigningStatsRequest, opts ...grpc.CallOption) (*QuerySigningStatsResponse, error) {
+	out := new(QuerySigningStatsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/SigningStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantSet(ctx context.Context, in *QueryParticipantSetRequest, opts ...grpc.CallOption) (*QueryParticipantSetResponse, error) {
+	out := new(QueryParticipantSetResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/ParticipantSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommittedNonces(ctx context.Context, in *QueryCommittedNoncesRequest, opts ...grpc.CallOption) (*QueryCommittedNoncesResponse, error) {
+	out := new(QueryCommittedNoncesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Query/CommittedNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DKGRequest queries the dkg request by the given id.
+	DKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
+	// DKGRequests queries dkg requests by the given params.
+	DKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
+	// DKGCompletions queries dkg completions by the given dkg request id.
+	DKGCompletions(context.Context, *QueryDKGCompletionsRequest) (*QueryDKGCompletionsResponse, error)
+	// SigningRequest queries the signing request by the given id.
+	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
+	// SigningRequests queries the signing requests by the given params.
+	SigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
+	// SigningBatch queries the signing batch by the given id.
+	SigningBatch(context.Context, *QuerySigningBatchRequest) (*QuerySigningBatchResponse, error)
+	// SigningBatches queries the signing batches by the given params.
+	SigningBatches(context.Context, *QuerySigningBatchesRequest) (*QuerySigningBatchesResponse, error)
+	// SigningAcknowledgements queries signing acknowledgements by the given signing request id.
+	SigningAcknowledgements(context.Context, *QuerySigningAcknowledgementsRequest) (*QuerySigningAcknowledgementsResponse, error)
+	// PartialSignatures queries partial signatures by the given signing request id.
+	PartialSignatures(context.Context, *QueryPartialSignaturesRequest) (*QueryPartialSignaturesResponse, error)
+	// SignerSet queries the signer set of the given signing request.
+	SignerSet(context.Context, *QuerySignerSetRequest) (*QuerySignerSetResponse, error)
+	// SigningFee queries the fee escrowed for the given signing request.
+	SigningFee(context.Context, *QuerySigningFeeRequest) (*QuerySigningFeeResponse, error)
+	// ParticipantRewards queries the rewards of the given participant.
+	ParticipantRewards(context.Context, *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error)
+	// GovSignatureRequest queries the gov signature request by the given id.
+	GovSignatureRequest(context.Context, *QueryGovSignatureRequestRequest) (*QueryGovSignatureRequestResponse, error)
+	// GovSignatureRequests queries all gov signature requests.
+	GovSignatureRequests(context.Context, *QueryGovSignatureRequestsRequest) (*QueryGovSignatureRequestsResponse, error)
+	// KeyRotations queries the scheduled key rotations.
+	KeyRotations(context.Context, *QueryKeyRotationsRequest) (*QueryKeyRotationsResponse, error)
+	// AllParticipantRewards queries the rewards of all participants.
+	AllParticipantRewards(context.Context, *QueryAllParticipantRewardsRequest) (*QueryAllParticipantRewardsResponse, error)
+	// RefreshingRequest queries the refreshing request by the given id.
+	RefreshingRequest(context.Context, *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error)
+	// RefreshingRequests queries the refreshing requests by the given status.
+	RefreshingRequests(context.Context, *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error)
+	// RefreshingCompletions queries refreshing completions by the given request id.
+	RefreshingCompletions(context.Context, *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error)
+	// ParticipantReliability queries the reliability of the given participant.
+	ParticipantReliability(context.Context, *QueryParticipantReliabilityRequest) (*QueryParticipantReliabilityResponse, error)
+	// ParticipantReliabilities queries the reliabilities of all participants.
+	ParticipantReliabilities(context.Context, *QueryParticipantReliabilitiesRequest) (*QueryParticipantReliabilitiesResponse, error)
+	// ParticipantHealth queries the key inventory and signing performance of the given participant.
+	ParticipantHealth(context.Context, *QueryParticipantHealthRequest) (*QueryParticipantHealthResponse, error)
+	// SigningStats queries the signing statistics by module and signing type.
+	SigningStats(context.Context, *QuerySigningStatsRequest) (*QuerySigningStatsResponse, error)
+	// ParticipantSet queries the automatically maintained participant set.
+	ParticipantSet(context.Context, *QueryParticipantSetRequest) (*QueryParticipantSetResponse, error)
+	// CommittedNonces queries the committed nonces in the queue of the given DKG.
+	CommittedNonces(context.Context, *QueryCommittedNoncesRequest) (*QueryCommittedNoncesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGRequest not implemented")
+}
+func (*UnimplementedQueryServer) DKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGRequests not implemented")
+}
+func (*UnimplementedQueryServer) DKGCompletions(ctx context.Context, req *QueryDKGCompletionsRequest) (*QueryDKGCompletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGCompletions not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequest not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequests not implemented")
+}
+func (*UnimplementedQueryServer) SigningBatch(ctx context.Context, req *QuerySigningBatchRequest) (*QuerySigningBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningBatch not implemented")
+}
+func (*UnimplementedQueryServer) SigningBatches(ctx context.Context, req *QuerySigningBatchesRequest) (*QuerySigningBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningBatches not implemented")
+}
+func (*UnimplementedQueryServer) SigningAcknowledgements(ctx context.Context, req *QuerySigningAcknowledgementsRequest) (*QuerySigningAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningAcknowledgements not implemented")
+}
+func (*UnimplementedQueryServer) PartialSignatures(ctx context.Context, req *QueryPartialSignaturesRequest) (*QueryPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialSignatures not implemented")
+}
+func (*UnimplementedQueryServer) SignerSet(ctx context.Context, req *QuerySignerSetRequest) (*QuerySignerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSet not implemented")
+}
+func (*UnimplementedQueryServer) SigningFee(ctx context.Context, req *QuerySigningFeeRequest) (*QuerySigningFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningFee not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantRewards(ctx context.Context, req *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantRewards not implemented")
+}
+func (*UnimplementedQueryServer) GovSignatureRequest(ctx context.Context, req *QueryGovSignatureRequestRequest) (*QueryGovSignatureRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSignatureRequest not implemented")
+}
+func (*UnimplementedQueryServer) GovSignatureRequests(ctx context.Context, req *QueryGovSignatureRequestsRequest) (*QueryGovSignatureRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSignatureRequests not implemented")
+}
+func (*UnimplementedQueryServer) KeyRotations(ctx context.Context, req *QueryKeyRotationsRequest) (*QueryKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyRotations not implemented")
+}
+func (*UnimplementedQueryServer) AllParticipantRewards(ctx context.Context, req *QueryAllParticipantRewardsRequest) (*QueryAllParticipantRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllParticipantRewards not implemented")
+}
+func (*UnimplementedQueryServer) RefreshingRequest(ctx context.Context, req *QueryRefreshingRequestRequest) (*QueryRefreshingRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshingRequest not implemented")
+}
+func (*UnimplementedQueryServer) RefreshingRequests(ctx context.Context, req *QueryRefreshingRequestsRequest) (*QueryRefreshingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshingRequests not implemented")
+}
+func (*UnimplementedQueryServer) RefreshingCompletions(ctx context.Context, req *QueryRefreshingCompletionsRequest) (*QueryRefreshingCompletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshingCompletions not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantReliability(ctx context.Context, req *QueryParticipantReliabilityRequest) (*QueryParticipantReliabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantReliability not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantReliabilities(ctx context.Context, req *QueryParticipantReliabilitiesRequest) (*QueryParticipantReliabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantReliabilities not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantHealth(ctx context.Context, req *QueryParticipantHealthRequest) (*QueryParticipantHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantHealth not implemented")
+}
+func (*UnimplementedQueryServer) SigningStats(ctx context.Context, req *QuerySigningStatsRequest) (*QuerySigningStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningStats not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantSet(ctx context.Context, req *QueryParticipantSetRequest) (*QueryParticipantSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantSet not implemented")
+}
+func (*UnimplementedQueryServer) CommittedNonces(ctx context.Context, req *QueryCommittedNoncesRequest) (*QueryCommittedNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommittedNonces not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DKGRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/DKGRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKGRequest(ctx, req.(*QueryDKGRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DKGRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/DKGRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKGRequests(ctx, req.(*QueryDKGRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DKGCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGCompletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DKGCompletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/DKGCompletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKGCompletions(ctx, req.(*QueryDKGCompletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequest(ctx, req.(*QuerySigningRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequests(ctx, req.(*QuerySigningRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningBatch(ctx, req.(*QuerySigningBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningBatches(ctx, req.(*QuerySigningBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningAcknowledgementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningAcknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningAcknowledgements(ctx, req.(*QuerySigningAcknowledgementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartialSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartialSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/PartialSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartialSignatures(ctx, req.(*QueryPartialSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SignerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSet(ctx, req.(*QuerySignerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningFee(ctx, req.(*QuerySigningFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/ParticipantRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantRewards(ctx, req.(*QueryParticipantRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovSignatureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovSignatureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovSignatureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/GovSignatureRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovSignatureRequest(ctx, req.(*QueryGovSignatureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovSignatureRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovSignatureRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovSignatureRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/GovSignatureRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovSignatureRequests(ctx, req.(*QueryGovSignatureRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/KeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyRotations(ctx, req.(*QueryKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllParticipantRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllParticipantRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllParticipantRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/AllParticipantRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllParticipantRewards(ctx, req.(*QueryAllParticipantRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefreshingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefreshingRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/RefreshingRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefreshingRequest(ctx, req.(*QueryRefreshingRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefreshingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefreshingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/RefreshingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefreshingRequests(ctx, req.(*QueryRefreshingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefreshingCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshingCompletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefreshingCompletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/RefreshingCompletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefreshingCompletions(ctx, req.(*QueryRefreshingCompletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/ParticipantReliability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantReliability(ctx, req.(*QueryParticipantReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantReliabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantReliabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantReliabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/ParticipantReliabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantReliabilities(ctx, req.(*QueryParticipantReliabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/ParticipantHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantHealth(ctx, req.(*QueryParticipantHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/SigningStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningStats(ctx, req.(*QuerySigningStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/ParticipantSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantSet(ctx, req.(*QueryParticipantSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommittedNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommittedNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommittedNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Query/CommittedNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommittedNonces(ctx, req.(*QueryCommittedNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitway.tss.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DKGRequest",
+			Handler:    _Query_DKGRequest_Handler,
+		},
+		{
+			MethodName: "DKGRequests",
+			Handler:    _Query_DKGRequests_Handler,
+		},
+		{
+			MethodName: "DKGCompletions",
+			Handler:    _Query_DKGCompletions_Handler,
+		},
+		{
+			MethodName: "SigningRequest",
+			Handler:    _Query_SigningRequest_Handler,
+		},
+		{
+			MethodName: "SigningRequests",
+			Handler:    _Query_SigningRequests_Handler,
+		},
+		{
+			MethodName: "SigningBatch",
+			Handler:    _Query_SigningBatch_Handler,
+		},
+		{
+			MethodName: "SigningBatches",
+			Handler:    _Query_SigningBatches_Handler,
+		},
+		{
+			MethodName: "SigningAcknowledgements",
+			Handler:    _Query_SigningAcknowledgements_Handler,
+		},
+		{
+			MethodName: "PartialSignatures",
+			Handler:    _Query_PartialSignatures_Handler,
+		},
+		{
+			MethodName: "SignerSet",
+			Handler:    _Query_SignerSet_Handler,
+		},
+		{
+			MethodName: "SigningFee",
+			Handler:    _Query_SigningFee_Handler,
+		},
+		{
+			MethodName: "ParticipantRewards",
+			Handler:    _Query_ParticipantRewards_Handler,
+		},
+		{
+			MethodName: "GovSignatureRequest",
+			Handler:    _Query_GovSignatureRequest_Handler,
+		},
+		{
+			MethodName: "GovSignatureRequests",
+			Handler:    _Query_GovSignatureRequests_Handler,
+		},
+		{
+			MethodName: "KeyRotations",
+			Handler:    _Query_KeyRotations_Handler,
+		},
+		{
+			MethodName: "AllParticipantRewards",
+			Handler:    _Query_AllParticipantRewards_Handler,
+		},
+		{
+			MethodName: "RefreshingRequest",
+			Handler:    _Query_RefreshingRequest_Handler,
+		},
+		{
+			MethodName: "RefreshingRequests",
+			Handler:    _Query_RefreshingRequests_Handler,
+		},
+		{
+			MethodName: "RefreshingCompletions",
+			Handler:    _Query_RefreshingCompletions_Handler,
+		},
+		{
+			MethodName: "ParticipantReliability",
+			Handler:    _Query_ParticipantReliability_Handler,
+		},
+		{
+			MethodName: "ParticipantReliabilities",
+			Handler:    _Query_ParticipantReliabilities_Handler,
+		},
+		{
+			MethodName: "ParticipantHealth",
+			Handler:    _Query_ParticipantHealth_Handler,
+		},
+		{
+			MethodName: "SigningStats",
+			Handler:    _Query_SigningStats_Handler,
+		},
+		{
+			MethodName: "ParticipantSet",
+			Handler:    _Query_ParticipantSet_Handler,
+		},
+		{
+			MethodName: "CommittedNonces",
+			Handler:    _Query_CommittedNonces_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitway/tss/query.proto",
+}
+
+func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Completions) > 0 {
+		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningBatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningBatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningBatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningBatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningBatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningBatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningAcknowledgementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningAcknowledgementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartialSignaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartialSignaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartialSignaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartialSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartialSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartialSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartialSignatures) > 0 {
+		for iNdEx := len(m.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartialSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Rewards != nil {
+		{
+			size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParticipantRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParticipantRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParticipantRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParticipantRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParticipantRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParticipantRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingCompletionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingCompletionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingCompletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefreshingCompletionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefreshingCompletionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefreshingCompletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Completions) > 0 {
+		for iNdEx := len(m.Completions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Completions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantReliabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantReliabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantReliabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantReliabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantReliabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantReliabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Score) > 0 {
+		i -= len(m.Score)
+		copy(dAtA[i:], m.Score)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Score)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reliability != nil {
+		{
+			size, err := m.Reliability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantReliabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantReliabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantReliabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantReliabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantReliabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantReliabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reliabilities) > 0 {
+		for iNdEx := len(m.Reliabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reliabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingSigningIds) > 0 {
+		dAtA30 := make([]byte, len(m.PendingSigningIds)*10)
+		var j29 int
+		for _, num := range m.PendingSigningIds {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintQuery(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveKeys) > 0 {
+		for iNdEx := len(m.ActiveKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n31, err31 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AverageSigningLatency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageSigningLatency):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintQuery(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x22
+	if len(m.CompletionRate) > 0 {
+		i -= len(m.CompletionRate)
+		copy(dAtA[i:], m.CompletionRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CompletionRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reliability != nil {
+		{
+			size, err := m.Reliability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintQuery(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x12
+	if m.ParticipantSet != nil {
+		{
+			size, err := m.ParticipantSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommittedNoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommittedNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommittedNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommittedNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommittedNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommittedNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PendingGenerationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingGenerationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovSignatureRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovSignatureRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovSignatureRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovSignatureRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovSignatureRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovSignatureRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningRequest != nil {
+		{
+			size, err := m.SigningRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovSignatureRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovSignatureRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovSignatureRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovSignatureRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovSignatureRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovSignatureRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDKGRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Completions) > 0 {
+		for _, e := range m.Completions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySigningBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySigningBatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningBatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningAcknowledgementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPartialSignaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPartialSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartialSignatures) > 0 {
+		for _, e := range m.PartialSignatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySignerSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySigningFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rewards != nil {
+		l = m.Rewards.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllParticipantRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllParticipantRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingCompletionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefreshingCompletionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Completions) > 0 {
+		for _, e := range m.Completions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantReliabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantReliabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reliability != nil {
+		l = m.Reliability.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Score)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantReliabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantReliabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reliabilities) > 0 {
+		for _, e := range m.Reliabilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reliability != nil {
+		l = m.Reliability.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CompletionRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageSigningLatency)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ActiveKeys) > 0 {
+		for _, e := range m.ActiveKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingSigningIds) > 0 {
+		l = 0
+		for _, e := range m.PendingSigningIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySigningStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParticipantSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParticipantSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParticipantSet != nil {
+		l = m.ParticipantSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommittedNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovQuery(uint64(m.DkgId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommittedNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PendingGenerationId != 0 {
+		n += 1 + sovQuery(uint64(m.PendingGenerationId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGovSignatureRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGovSignatureRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SigningRequest != nil {
+		l = m.SigningRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGovSignatureRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGovSignatureRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &DKGRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DKGStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &DKGRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGCompletionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGCompletionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGCompletionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGCompletionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Completions = append(m.Completions, &DKGCompletion{})
+			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SigningRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &SigningBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningBatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningBatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningBatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningBatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &SigningBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningAcknowledgementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningAcknowledgementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningAcknowledgementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningAcknowledgementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, &SigningAcknowledgement{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartialSignaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartialSignaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartialSignaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartialSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartialSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartialSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialSignatures = append(m.PartialSignatures, &PartialSignature{})
+			if err := m.PartialSignatures[len(m.PartialSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSet{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &SigningFee{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rewards == nil {
+				m.Rewards = &ParticipantRewards{}
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParticipantRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParticipantRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParticipantRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParticipantRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParticipantRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParticipantRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ParticipantRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, &KeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RefreshingRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RefreshingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &RefreshingRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Completions = append(m.Completions, &RefreshingCompletion{})
+			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantReliabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantReliabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantReliabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantReliabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,30 +10280,98 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantReliabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantReliabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reliability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reliability == nil {
+				m.Reliability = &ParticipantReliability{}
+			}
+			if err := m.Reliability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Id = 0
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2425,11 +10381,24 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Score = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2451,7 +10420,7 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantReliabilitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2474,15 +10443,15 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantReliabilitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantReliabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2509,10 +10478,10 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &DKGRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2537,7 +10506,7 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantReliabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,36 +10529,17 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantReliabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantReliabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= DKGStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reliabilities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2599,25 +10549,27 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.Reliabilities = append(m.Reliabilities, &ParticipantReliability{})
+			if err := m.Reliabilities[len(m.Reliabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2647,7 +10599,7 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2674,7 +10626,7 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2697,51 +10649,17 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &DKGRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2751,27 +10669,23 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2794,7 +10708,7 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGCompletionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2817,17 +10731,17 @@ func (m *QueryDKGCompletionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reliability", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2837,16 +10751,33 @@ func (m *QueryDKGCompletionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reliability == nil {
+				m.Reliability = &ParticipantReliability{}
+			}
+			if err := m.Reliability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2856,81 +10787,59 @@ func (m *QueryDKGCompletionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDKGCompletionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CompletionRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSigningLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2957,14 +10866,13 @@ func (m *QueryDKGCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Completions = append(m.Completions, &DKGCompletion{})
-			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AverageSigningLatency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2991,13 +10899,87 @@ func (m *QueryDKGCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveKeys = append(m.ActiveKeys, ParticipantKey{})
+			if err := m.ActiveKeys[len(m.ActiveKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingSigningIds = append(m.PendingSigningIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingSigningIds) == 0 {
+					m.PendingSigningIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingSigningIds = append(m.PendingSigningIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSigningIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3019,7 +11001,7 @@ func (m *QueryDKGCompletionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3042,17 +11024,17 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3062,11 +11044,24 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3088,7 +11083,7 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3111,15 +11106,15 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3146,10 +11141,8 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SigningRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, &SigningStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3174,7 +11167,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3197,36 +11190,67 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 2:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3236,27 +11260,31 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			if m.ParticipantSet == nil {
+				m.ParticipantSet = &ParticipantSet{}
+			}
+			if err := m.ParticipantSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3283,10 +11311,7 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3311,7 +11336,7 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommittedNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,17 +11359,17 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommittedNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommittedNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
 			}
-			var msglen int
+			m.DkgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3354,26 +11379,11 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DkgId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &SigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3404,7 +11414,7 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3431,7 +11441,7 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommittedNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3454,17 +11464,17 @@ func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommittedNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommittedNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3474,64 +11484,48 @@ func (m *QueryRefreshingRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Nonces = append(m.Nonces, &CommittedNonce{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingGenerationId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PendingGenerationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingGenerationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3558,10 +11552,10 @@ func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RefreshingRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3586,7 +11580,7 @@ func (m *QueryRefreshingRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,67 +11603,12 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RefreshingStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3691,7 +11630,7 @@ func (m *QueryRefreshingRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,49 +11653,15 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, &RefreshingRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3783,10 +11688,7 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3811,7 +11713,7 @@ func (m *QueryRefreshingRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGovSignatureRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,10 +11736,10 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovSignatureRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovSignatureRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3859,42 +11761,6 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3916,7 +11782,7 @@ func (m *QueryRefreshingCompletionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGovSignatureRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3939,15 +11805,15 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovSignatureRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRefreshingCompletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovSignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3974,14 +11840,16 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Completions = append(m.Completions, &RefreshingCompletion{})
-			if err := m.Completions[len(m.Completions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &GovSignatureRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4008,10 +11876,10 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.SigningRequest == nil {
+				m.SigningRequest = &SigningRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4036,7 +11904,7 @@ func (m *QueryRefreshingCompletionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGovSignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4059,12 +11927,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovSignatureRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovSignatureRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4086,7 +11990,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGovSignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4109,15 +12013,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovSignatureRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovSignatureRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4144,7 +12048,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &GovSignatureRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
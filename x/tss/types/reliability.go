@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/base64"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParticipantReliability creates a new ParticipantReliability instance for the given participant
+func NewParticipantReliability(consensusPubKey string) *ParticipantReliability {
+	return &ParticipantReliability{
+		ConsensusPubkey: consensusPubKey,
+	}
+}
+
+// CompletedRounds returns the total number of completed rounds
+func (r *ParticipantReliability) CompletedRounds() uint64 {
+	return r.CompletedDkgs + r.CompletedRefreshings + r.AcknowledgedSignings
+}
+
+// MissedRounds returns the total number of missed rounds
+func (r *ParticipantReliability) MissedRounds() uint64 {
+	return r.MissedDkgs + r.MissedRefreshings + r.MissedSignings
+}
+
+// Score returns the ratio of the completed rounds to all rounds
+// One is returned if no rounds involved
+func (r *ParticipantReliability) Score() sdkmath.LegacyDec {
+	total := r.CompletedRounds() + r.MissedRounds()
+	if total == 0 {
+		return sdkmath.LegacyOneDec()
+	}
+
+	return sdkmath.LegacyNewDec(int64(r.CompletedRounds())).QuoInt64(int64(total))
+}
+
+// AverageSigningLatency returns the average latency of the measured signing rounds
+// Zero is returned if no signing rounds measured
+func (r *ParticipantReliability) AverageSigningLatency() time.Duration {
+	if r.MeasuredSignings == 0 {
+		return 0
+	}
+
+	return r.TotalSigningLatency / time.Duration(r.MeasuredSignings)
+}
+
+// RecordCompletion records the completed round of the given fault type at the specified block height
+func (r *ParticipantReliability) RecordCompletion(faultType FaultType, height int64) {
+	switch faultType {
+	case FaultType_FAULT_TYPE_DKG:
+		r.CompletedDkgs++
+
+	case FaultType_FAULT_TYPE_REFRESHING:
+		r.CompletedRefreshings++
+		r.LastRefreshingHeight = height
+
+	case FaultType_FAULT_TYPE_SIGNING:
+		r.AcknowledgedSignings++
+	}
+}
+
+// RecordSigningLatency records the latency of the completed signing round
+func (r *ParticipantReliability) RecordSigningLatency(latency time.Duration) {
+	r.MeasuredSignings++
+	r.TotalSigningLatency += latency
+}
+
+// RecordFault records the missed round of the given fault type at the specified block height
+func (r *ParticipantReliability) RecordFault(faultType FaultType, height int64) {
+	switch faultType {
+	case FaultType_FAULT_TYPE_DKG:
+		r.MissedDkgs++
+
+	case FaultType_FAULT_TYPE_REFRESHING:
+		r.MissedRefreshings++
+
+	case FaultType_FAULT_TYPE_SIGNING:
+		r.MissedSignings++
+	}
+
+	r.PendingFaults++
+	r.LastFaultHeight = height
+}
+
+// GetConsAddress gets the consensus address from the given base64 encoded consensus pub key
+func GetConsAddress(consensusPubKey string) (sdk.ConsAddress, error) {
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(consensusPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pubKeyBytes) != ed25519.PubKeySize {
+		return nil, ErrInvalidPubKey
+	}
+
+	pubKey := &ed25519.PubKey{Key: pubKeyBytes}
+
+	return sdk.ConsAddress(pubKey.Address()), nil
+}
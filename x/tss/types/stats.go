@@ -0,0 +1,23 @@
+package types
+
+import (
+	"time"
+)
+
+// NewSigningStats creates a new SigningStats instance for the given module and signing type
+func NewSigningStats(module string, ty SigningType) *SigningStats {
+	return &SigningStats{
+		Module: module,
+		Type:   ty,
+	}
+}
+
+// AverageLatency returns the average latency of the signed requests
+// Zero is returned if no requests signed
+func (s *SigningStats) AverageLatency() time.Duration {
+	if s.Signed == 0 {
+		return 0
+	}
+
+	return s.TotalLatency / time.Duration(s.Signed)
+}
@@ -1,15 +1,20 @@
 package types
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 
 	errorsmod "cosmossdk.io/errors"
@@ -34,6 +39,12 @@ var (
 
 	// schnorr adaptor signature size
 	SchnorrAdaptorSignatureSize = 65
+
+	// minimum DER encoded ECDSA signature size
+	MinECDSASignatureSize = 8
+
+	// maximum DER encoded ECDSA signature size
+	MaxECDSASignatureSize = 72
 )
 
 // DKGCompletionReceivedHandler defines the callback handler on the DKG completion received
@@ -48,23 +59,45 @@ type DKGRequestTimeoutHandler func(ctx sdk.Context, id uint64, ty string, intent
 // SigningRequestCompletedHandler defines the callback handler on the signing request completed
 type SigningRequestCompletedHandler func(ctx sdk.Context, sender string, id uint64, scopedId string, ty SigningType, intent int32, pubKey string, signatures []string) error
 
+// SigningRequestTimeoutHandler defines the callback handler on the signing request timed out
+type SigningRequestTimeoutHandler func(ctx sdk.Context, id uint64, scopedId string, ty SigningType, intent int32, pubKey string, absentParticipants []string) error
+
+// SigningBatchCompletedHandler defines the callback handler on the signing batch completed
+// The signatures are ordered by the signing requests of the batch
+type SigningBatchCompletedHandler func(ctx sdk.Context, sender string, id uint64, scopedId string, intent int32, signatures []*BatchSignatures) error
+
+// SigningBatchTimeoutHandler defines the callback handler on the signing batch timed out
+type SigningBatchTimeoutHandler func(ctx sdk.Context, id uint64, scopedId string, intent int32, absentParticipants []string) error
+
+// KeyRotationHandler defines the callback handler to initiate the key rotation for the given key type
+// The module is expected to call CompleteKeyRotation once the new keys are ready
+type KeyRotationHandler func(ctx sdk.Context, ty string) error
+
+// KeyRetirementHandler defines the callback handler to retire the old keys of the given key type after the overlap window
+type KeyRetirementHandler func(ctx sdk.Context, ty string) error
+
 // ParticipantExists returns true if the given participant is included in the authorized participants, false otherwise
 func ParticipantExists(participants []string, participant string) bool {
 	return slices.Contains(participants, participant)
 }
 
-// CheckDKGCompletions checks if public keys of all DKG completions are same
+// CheckDKGCompletions checks if public keys and verification shares of all DKG completions are same
 func CheckDKGCompletions(completions []*DKGCompletion) bool {
 	if len(completions) == 0 {
 		return false
 	}
 
 	pubKeys := completions[0].PubKeys
+	verificationShares := completions[0].VerificationShares
 
 	for _, completion := range completions[1:] {
 		if !reflect.DeepEqual(completion.PubKeys, pubKeys) {
 			return false
 		}
+
+		if !slices.Equal(completion.VerificationShares, verificationShares) {
+			return false
+		}
 	}
 
 	return true
@@ -80,8 +113,8 @@ func VerifySignature(signature string, pubKey string, msg []byte) bool {
 }
 
 // GetDKGCompletionSigMsg gets the msg to be signed from the given data for the DKG completion
-// Assume that the given pub keys are hex encoded
-func GetDKGCompletionSigMsg(id uint64, pubKeys []string) []byte {
+// Assume that the given pub keys and verification shares are hex encoded
+func GetDKGCompletionSigMsg(id uint64, pubKeys []string, verificationShares []string) []byte {
 	msg := make([]byte, 8)
 	binary.BigEndian.PutUint64(msg, id)
 
@@ -90,12 +123,64 @@ func GetDKGCompletionSigMsg(id uint64, pubKeys []string) []byte {
 		msg = append(msg, pubKeyBytes...)
 	}
 
+	for _, share := range verificationShares {
+		shareBytes, _ := hex.DecodeString(share)
+		msg = append(msg, shareBytes...)
+	}
+
+	return hash.Sha256(msg)
+}
+
+// GetPossessionProofSigMsg gets the msg to be signed by the DKG key for the proof of possession
+// Assume that the given pub key is hex encoded
+func GetPossessionProofSigMsg(id uint64, pubKey string) []byte {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, id)
+
+	pubKeyBytes, _ := hex.DecodeString(pubKey)
+	msg = append(msg, pubKeyBytes...)
+
 	return hash.Sha256(msg)
 }
 
+// VerifyPossessionProof verifies the schnorr proof of possession for the given DKG pub key
+// The ECDSA pub key is verified against its x-only form, which the signer handles by negating the secret if needed
+// Assume that the proof and pub key are hex encoded
+func VerifyPossessionProof(keyType KeyType, id uint64, pubKey string, proof string) bool {
+	pubKeyBytes, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return false
+	}
+
+	if keyType == KeyType_KEY_TYPE_ECDSA {
+		if len(pubKeyBytes) != btcec.PubKeyBytesLenCompressed {
+			return false
+		}
+
+		pubKeyBytes = pubKeyBytes[1:]
+	}
+
+	key, err := schnorr.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return false
+	}
+
+	proofBytes, err := hex.DecodeString(proof)
+	if err != nil {
+		return false
+	}
+
+	sig, err := schnorr.ParseSignature(proofBytes)
+	if err != nil {
+		return false
+	}
+
+	return sig.Verify(GetPossessionProofSigMsg(id, pubKey), key)
+}
+
 // GetRefreshingCompletionSigMsg gets the msg to be signed from the given data for the refreshing completion
-// Assume that the given pub keys are hex encoded
-func GetRefreshingCompletionSigMsg(id uint64, pubKeys []string) []byte {
+// Assume that the given pub keys and verification shares are hex encoded
+func GetRefreshingCompletionSigMsg(id uint64, pubKeys []string, verificationShares []string) []byte {
 	msg := make([]byte, 8)
 	binary.BigEndian.PutUint64(msg, id)
 
@@ -104,6 +189,40 @@ func GetRefreshingCompletionSigMsg(id uint64, pubKeys []string) []byte {
 		msg = append(msg, pubKeyBytes...)
 	}
 
+	for _, share := range verificationShares {
+		shareBytes, _ := hex.DecodeString(share)
+		msg = append(msg, shareBytes...)
+	}
+
+	return hash.Sha256(msg)
+}
+
+// GetResharingCompletionSigMsg gets the msg to be signed from the given data for the resharing completion
+// Assume that the given pub keys and verification shares are hex encoded and the participants are base64 encoded
+func GetResharingCompletionSigMsg(id uint64, pubKeys []string, participants []string, threshold uint32, verificationShares []string) []byte {
+	msg := GetRefreshingCompletionSigMsg(id, pubKeys, verificationShares)
+
+	for _, participant := range participants {
+		participantBytes, _ := base64.StdEncoding.DecodeString(participant)
+		msg = append(msg, participantBytes...)
+	}
+
+	msg = binary.BigEndian.AppendUint32(msg, threshold)
+
+	return hash.Sha256(msg)
+}
+
+// GetSigningAcknowledgementSigMsg gets the msg to be signed from the given data for the signing acknowledgement
+// Assume that the given sig hashes are base64 encoded
+func GetSigningAcknowledgementSigMsg(id uint64, sigHashes []string) []byte {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, id)
+
+	for _, sigHash := range sigHashes {
+		sigHashBytes, _ := base64.StdEncoding.DecodeString(sigHash)
+		msg = append(msg, sigHashBytes...)
+	}
+
 	return hash.Sha256(msg)
 }
 
@@ -115,6 +234,10 @@ func GetSigningOption(signingType SigningType, options *SigningOptions) string {
 		return options.Tweak
 
 	case SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT:
+		if len(options.Nonces) != 0 {
+			return strings.Join(options.Nonces, AttributeValueSeparator)
+		}
+
 		return options.Nonce
 
 	case SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR:
@@ -125,6 +248,118 @@ func GetSigningOption(signingType SigningType, options *SigningOptions) string {
 	}
 }
 
+// CheckSigHashes checks if the given sig hashes are valid
+func CheckSigHashes(sigHashes []string) error {
+	for _, sigHash := range sigHashes {
+		sigHashBytes, err := base64.StdEncoding.DecodeString(sigHash)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "failed to decode sig hash")
+		}
+
+		if len(sigHashBytes) != chainhash.HashSize {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "incorrect sig hash size")
+		}
+	}
+
+	return nil
+}
+
+// CheckSigningOptions checks if the given options are valid for the specified signing type
+// The committed nonces are not allowed since they can only be consumed from the nonce queue
+func CheckSigningOptions(signingType SigningType, options *SigningOptions) error {
+	if options == nil {
+		options = &SigningOptions{}
+	}
+
+	if len(options.Nonces) != 0 {
+		return errorsmod.Wrap(ErrInvalidSigningRequest, "committed nonces not allowed")
+	}
+
+	switch signingType {
+	case SigningType_SIGNING_TYPE_SCHNORR_WITH_TWEAK:
+		if _, err := hex.DecodeString(options.Tweak); err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "failed to decode tweak")
+		}
+
+	case SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT:
+		nonce, err := hex.DecodeString(options.Nonce)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "failed to decode nonce")
+		}
+
+		if _, err := schnorr.ParsePubKey(nonce); err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "invalid nonce")
+		}
+
+	case SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR:
+		adaptorPoint, err := hex.DecodeString(options.AdaptorPoint)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "failed to decode adaptor point")
+		}
+
+		if _, err := btcec.ParsePubKey(adaptorPoint); err != nil {
+			return errorsmod.Wrap(ErrInvalidSigningRequest, "invalid adaptor point")
+		}
+	}
+
+	return nil
+}
+
+// CheckPubKey checks if the given pub key is valid for the specified key type
+// The schnorr pub key is x-only and the ECDSA pub key is compressed
+func CheckPubKey(keyType KeyType, pubKey []byte) error {
+	switch keyType {
+	case KeyType_KEY_TYPE_SCHNORR:
+		if _, err := schnorr.ParsePubKey(pubKey); err != nil {
+			return errorsmod.Wrap(ErrInvalidPubKey, "invalid schnorr pub key")
+		}
+
+	case KeyType_KEY_TYPE_ECDSA:
+		if len(pubKey) != btcec.PubKeyBytesLenCompressed {
+			return errorsmod.Wrap(ErrInvalidPubKey, "ecdsa pub key must be compressed")
+		}
+
+		if _, err := btcec.ParsePubKey(pubKey); err != nil {
+			return errorsmod.Wrap(ErrInvalidPubKey, "invalid ecdsa pub key")
+		}
+
+	default:
+		return errorsmod.Wrap(ErrInvalidPubKey, "unsupported key type")
+	}
+
+	return nil
+}
+
+// VerifyECDSASignature verifies the DER encoded ECDSA signature against the given sig hash and pub key
+// The signature must be strictly DER encoded in the low S form as required by the bitcoin standardness rules
+func VerifyECDSASignature(sigBytes []byte, sigHash []byte, pubKeyBytes []byte) bool {
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return false
+	}
+
+	sig, err := ecdsa.ParseDERSignature(sigBytes)
+	if err != nil {
+		return false
+	}
+
+	// the serialized signature is canonical with the low S
+	if !bytes.Equal(sig.Serialize(), sigBytes) {
+		return false
+	}
+
+	return sig.Verify(sigHash, pubKey)
+}
+
+// GetKeyType gets the key type according to the given signing type
+func GetKeyType(signingType SigningType) KeyType {
+	if signingType == SigningType_SIGNING_TYPE_ECDSA {
+		return KeyType_KEY_TYPE_ECDSA
+	}
+
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
 // GetTweakedPubKey gets the tweaked pub key by the given tweak
 // Assume that the given pub key is valid
 func GetTweakedPubKey(pubKeyBytes []byte, tweak []byte) []byte {
@@ -152,6 +387,21 @@ func CheckDKGParticipantNum(participantNum int) error {
 	return nil
 }
 
+// GetDKGThreshold gets the valid threshold for the given participant number
+// The given threshold is returned if valid, otherwise the minimum valid threshold
+func GetDKGThreshold(participantNum int, threshold uint32) uint32 {
+	if CheckDKGThreshold(participantNum, int(threshold)) == nil {
+		return threshold
+	}
+
+	minThreshold := int(MinDKGThresholdRatio.MulInt64(int64(participantNum)).Ceil().TruncateInt64())
+	if minThreshold < MinDKGThreshold {
+		minThreshold = MinDKGThreshold
+	}
+
+	return uint32(minThreshold)
+}
+
 // CheckDKGThreshold checks if the given threshold is valid
 func CheckDKGThreshold(participantNum int, threshold int) error {
 	if threshold < MinDKGThreshold {
@@ -5,9 +5,12 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -67,6 +70,34 @@ func (DKGStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_429ab65fe5c6256b, []int{0}
 }
 
+// Key Type
+type KeyType int32
+
+const (
+	// KEY_TYPE_SCHNORR defines the secp256k1 key for BIP-340 schnorr signing, i.e. the x-only pub key
+	KeyType_KEY_TYPE_SCHNORR KeyType = 0
+	// KEY_TYPE_ECDSA defines the secp256k1 key for ECDSA signing, i.e. the compressed pub key
+	KeyType_KEY_TYPE_ECDSA KeyType = 1
+)
+
+var KeyType_name = map[int32]string{
+	0: "KEY_TYPE_SCHNORR",
+	1: "KEY_TYPE_ECDSA",
+}
+
+var KeyType_value = map[string]int32{
+	"KEY_TYPE_SCHNORR": 0,
+	"KEY_TYPE_ECDSA":   1,
+}
+
+func (x KeyType) String() string {
+	return proto.EnumName(KeyType_name, int32(x))
+}
+
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{1}
+}
+
 // Signing Status
 type SigningStatus int32
 
@@ -79,6 +110,8 @@ const (
 	SigningStatus_SIGNING_STATUS_SIGNED SigningStatus = 2
 	// SIGNING_STATUS_FAILED defines the status of the signing request which failed due to unexpected reasons
 	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 3
+	// SIGNING_STATUS_TIMEDOUT defines the status of the signing request which timed out
+	SigningStatus_SIGNING_STATUS_TIMEDOUT SigningStatus = 4
 )
 
 var SigningStatus_name = map[int32]string{
@@ -86,6 +119,7 @@ var SigningStatus_name = map[int32]string{
 	1: "SIGNING_STATUS_PENDING",
 	2: "SIGNING_STATUS_SIGNED",
 	3: "SIGNING_STATUS_FAILED",
+	4: "SIGNING_STATUS_TIMEDOUT",
 }
 
 var SigningStatus_value = map[string]int32{
@@ -93,6 +127,7 @@ var SigningStatus_value = map[string]int32{
 	"SIGNING_STATUS_PENDING":     1,
 	"SIGNING_STATUS_SIGNED":      2,
 	"SIGNING_STATUS_FAILED":      3,
+	"SIGNING_STATUS_TIMEDOUT":    4,
 }
 
 func (x SigningStatus) String() string {
@@ -100,7 +135,7 @@ func (x SigningStatus) String() string {
 }
 
 func (SigningStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_429ab65fe5c6256b, []int{1}
+	return fileDescriptor_429ab65fe5c6256b, []int{2}
 }
 
 // Signing Type
@@ -115,6 +150,8 @@ const (
 	SigningType_SIGNING_TYPE_SCHNORR_WITH_COMMITMENT SigningType = 2
 	// SIGNING_TYPE_SCHNORR_ADAPTOR defines the schnorr adaptor signing
 	SigningType_SIGNING_TYPE_SCHNORR_ADAPTOR SigningType = 3
+	// SIGNING_TYPE_ECDSA defines the ECDSA signing, e.g. segwit v0
+	SigningType_SIGNING_TYPE_ECDSA SigningType = 4
 )
 
 var SigningType_name = map[int32]string{
@@ -122,6 +159,7 @@ var SigningType_name = map[int32]string{
 	1: "SIGNING_TYPE_SCHNORR_WITH_TWEAK",
 	2: "SIGNING_TYPE_SCHNORR_WITH_COMMITMENT",
 	3: "SIGNING_TYPE_SCHNORR_ADAPTOR",
+	4: "SIGNING_TYPE_ECDSA",
 }
 
 var SigningType_value = map[string]int32{
@@ -129,6 +167,7 @@ var SigningType_value = map[string]int32{
 	"SIGNING_TYPE_SCHNORR_WITH_TWEAK":      1,
 	"SIGNING_TYPE_SCHNORR_WITH_COMMITMENT": 2,
 	"SIGNING_TYPE_SCHNORR_ADAPTOR":         3,
+	"SIGNING_TYPE_ECDSA":                   4,
 }
 
 func (x SigningType) String() string {
@@ -136,7 +175,7 @@ func (x SigningType) String() string {
 }
 
 func (SigningType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_429ab65fe5c6256b, []int{2}
+	return fileDescriptor_429ab65fe5c6256b, []int{3}
 }
 
 // Refreshing Status
@@ -172,7 +211,75 @@ func (x RefreshingStatus) String() string {
 }
 
 func (RefreshingStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_429ab65fe5c6256b, []int{3}
+	return fileDescriptor_429ab65fe5c6256b, []int{4}
+}
+
+// Fault Type
+type FaultType int32
+
+const (
+	// FAULT_TYPE_UNSPECIFIED defines the unknown fault type
+	FaultType_FAULT_TYPE_UNSPECIFIED FaultType = 0
+	// FAULT_TYPE_DKG defines the fault of missing the DKG completion
+	FaultType_FAULT_TYPE_DKG FaultType = 1
+	// FAULT_TYPE_REFRESHING defines the fault of missing the refreshing completion
+	FaultType_FAULT_TYPE_REFRESHING FaultType = 2
+	// FAULT_TYPE_SIGNING defines the fault of missing the signing acknowledgement
+	FaultType_FAULT_TYPE_SIGNING FaultType = 3
+)
+
+var FaultType_name = map[int32]string{
+	0: "FAULT_TYPE_UNSPECIFIED",
+	1: "FAULT_TYPE_DKG",
+	2: "FAULT_TYPE_REFRESHING",
+	3: "FAULT_TYPE_SIGNING",
+}
+
+var FaultType_value = map[string]int32{
+	"FAULT_TYPE_UNSPECIFIED": 0,
+	"FAULT_TYPE_DKG":         1,
+	"FAULT_TYPE_REFRESHING":  2,
+	"FAULT_TYPE_SIGNING":     3,
+}
+
+func (x FaultType) String() string {
+	return proto.EnumName(FaultType_name, int32(x))
+}
+
+func (FaultType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{5}
+}
+
+// Key Rotation Status
+type KeyRotationStatus int32
+
+const (
+	// the current keys are active
+	KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE KeyRotationStatus = 0
+	// the rotation is in progress
+	KeyRotationStatus_KEY_ROTATION_STATUS_ROTATING KeyRotationStatus = 1
+	// the new keys are active and the old keys are to be retired
+	KeyRotationStatus_KEY_ROTATION_STATUS_OVERLAPPING KeyRotationStatus = 2
+)
+
+var KeyRotationStatus_name = map[int32]string{
+	0: "KEY_ROTATION_STATUS_ACTIVE",
+	1: "KEY_ROTATION_STATUS_ROTATING",
+	2: "KEY_ROTATION_STATUS_OVERLAPPING",
+}
+
+var KeyRotationStatus_value = map[string]int32{
+	"KEY_ROTATION_STATUS_ACTIVE":      0,
+	"KEY_ROTATION_STATUS_ROTATING":    1,
+	"KEY_ROTATION_STATUS_OVERLAPPING": 2,
+}
+
+func (x KeyRotationStatus) String() string {
+	return proto.EnumName(KeyRotationStatus_name, int32(x))
+}
+
+func (KeyRotationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{6}
 }
 
 // DKG Request
@@ -195,6 +302,8 @@ type DKGRequest struct {
 	ExpirationTime time.Time `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
 	// status
 	Status DKGStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bitway.tss.DKGStatus" json:"status,omitempty"`
+	// type of keys to be generated
+	KeyType KeyType `protobuf:"varint,10,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *DKGRequest) Reset()         { *m = DKGRequest{} }
@@ -293,6 +402,13 @@ func (m *DKGRequest) GetStatus() DKGStatus {
 	return DKGStatus_DKG_STATUS_UNSPECIFIED
 }
 
+func (m *DKGRequest) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
 // DKG Completion
 type DKGCompletion struct {
 	// request id
@@ -305,6 +421,11 @@ type DKGCompletion struct {
 	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 	// hex encoded participant signature
 	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded schnorr proofs of possession for the public keys
+	Proofs []string `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// hex encoded compressed verification shares of the participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
 func (m *DKGCompletion) Reset()         { *m = DKGCompletion{} }
@@ -375,6 +496,20 @@ func (m *DKGCompletion) GetSignature() string {
 	return ""
 }
 
+func (m *DKGCompletion) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *DKGCompletion) GetVerificationShares() []string {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
 // Signing Options
 type SigningOptions struct {
 	// optional tweak
@@ -383,6 +518,8 @@ type SigningOptions struct {
 	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// optional adaptor point
 	AdaptorPoint string `protobuf:"bytes,3,opt,name=adaptor_point,json=adaptorPoint,proto3" json:"adaptor_point,omitempty"`
+	// optional committed nonces consumed from the nonce queue, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
 }
 
 func (m *SigningOptions) Reset()         { *m = SigningOptions{} }
@@ -439,6 +576,13 @@ func (m *SigningOptions) GetAdaptorPoint() string {
 	return ""
 }
 
+func (m *SigningOptions) GetNonces() []string {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
 // Signing Request
 type SigningRequest struct {
 	// request id
@@ -461,6 +605,14 @@ type SigningRequest struct {
 	CreationTime time.Time `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time"`
 	// status
 	Status SigningStatus `protobuf:"varint,10,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	// expiration time; zero means no timeout
+	ExpirationTime time.Time `protobuf:"bytes,11,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+	// id of the timed out signing request which is re-initiated by this request; 0 if none
+	PreviousId uint64 `protobuf:"varint,12,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	// id of the signing batch which the request belongs to; 0 if none
+	BatchId uint64 `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// number of times the original signing request has been re-initiated
+	Retries uint32 `protobuf:"varint,14,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
 func (m *SigningRequest) Reset()         { *m = SigningRequest{} }
@@ -566,32 +718,60 @@ func (m *SigningRequest) GetStatus() SigningStatus {
 	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
 }
 
-// Refreshing Request
-type RefreshingRequest struct {
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// request id of the DKG corresponding to the key shares to be refreshed
-	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
-	// removed participant set
-	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
-	// expiration time
-	ExpirationTime time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
-	// status
-	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
+func (m *SigningRequest) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
 }
 
-func (m *RefreshingRequest) Reset()         { *m = RefreshingRequest{} }
-func (m *RefreshingRequest) String() string { return proto.CompactTextString(m) }
-func (*RefreshingRequest) ProtoMessage()    {}
-func (*RefreshingRequest) Descriptor() ([]byte, []int) {
+func (m *SigningRequest) GetPreviousId() uint64 {
+	if m != nil {
+		return m.PreviousId
+	}
+	return 0
+}
+
+func (m *SigningRequest) GetBatchId() uint64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *SigningRequest) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+// Signing Batch Item
+type SigningBatchItem struct {
+	// signing type
+	Type SigningType `protobuf:"varint,1,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// signing pub key
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// hashes to be signed
+	SigHashes []string `protobuf:"bytes,3,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	// signing options
+	Options *SigningOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// module specific id of the signing request; the scoped id of the batch is used if empty
+	ScopedId string `protobuf:"bytes,5,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
+}
+
+func (m *SigningBatchItem) Reset()         { *m = SigningBatchItem{} }
+func (m *SigningBatchItem) String() string { return proto.CompactTextString(m) }
+func (*SigningBatchItem) ProtoMessage()    {}
+func (*SigningBatchItem) Descriptor() ([]byte, []int) {
 	return fileDescriptor_429ab65fe5c6256b, []int{4}
 }
-func (m *RefreshingRequest) XXX_Unmarshal(b []byte) error {
+func (m *SigningBatchItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SigningBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SigningBatchItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -601,77 +781,73 @@ func (m *RefreshingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RefreshingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshingRequest.Merge(m, src)
+func (m *SigningBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningBatchItem.Merge(m, src)
 }
-func (m *RefreshingRequest) XXX_Size() int {
+func (m *SigningBatchItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshingRequest.DiscardUnknown(m)
+func (m *SigningBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningBatchItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshingRequest proto.InternalMessageInfo
+var xxx_messageInfo_SigningBatchItem proto.InternalMessageInfo
 
-func (m *RefreshingRequest) GetId() uint64 {
+func (m *SigningBatchItem) GetType() SigningType {
 	if m != nil {
-		return m.Id
+		return m.Type
 	}
-	return 0
+	return SigningType_SIGNING_TYPE_SCHNORR
 }
 
-func (m *RefreshingRequest) GetDkgId() uint64 {
+func (m *SigningBatchItem) GetPubKey() string {
 	if m != nil {
-		return m.DkgId
+		return m.PubKey
 	}
-	return 0
+	return ""
 }
 
-func (m *RefreshingRequest) GetRemovedParticipants() []string {
+func (m *SigningBatchItem) GetSigHashes() []string {
 	if m != nil {
-		return m.RemovedParticipants
+		return m.SigHashes
 	}
 	return nil
 }
 
-func (m *RefreshingRequest) GetExpirationTime() time.Time {
+func (m *SigningBatchItem) GetOptions() *SigningOptions {
 	if m != nil {
-		return m.ExpirationTime
+		return m.Options
 	}
-	return time.Time{}
+	return nil
 }
 
-func (m *RefreshingRequest) GetStatus() RefreshingStatus {
+func (m *SigningBatchItem) GetScopedId() string {
 	if m != nil {
-		return m.Status
+		return m.ScopedId
 	}
-	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+	return ""
 }
 
-// Refreshing Completion
-type RefreshingCompletion struct {
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// participant consensus pub key
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// hex encoded participant signature
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+// Batch Signatures
+type BatchSignatures struct {
+	// signing request id
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// hex encoded signatures
+	Signatures []string `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *RefreshingCompletion) Reset()         { *m = RefreshingCompletion{} }
-func (m *RefreshingCompletion) String() string { return proto.CompactTextString(m) }
-func (*RefreshingCompletion) ProtoMessage()    {}
-func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
+func (m *BatchSignatures) Reset()         { *m = BatchSignatures{} }
+func (m *BatchSignatures) String() string { return proto.CompactTextString(m) }
+func (*BatchSignatures) ProtoMessage()    {}
+func (*BatchSignatures) Descriptor() ([]byte, []int) {
 	return fileDescriptor_429ab65fe5c6256b, []int{5}
 }
-func (m *RefreshingCompletion) XXX_Unmarshal(b []byte) error {
+func (m *BatchSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshingCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshingCompletion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchSignatures.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -681,741 +857,6810 @@ func (m *RefreshingCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RefreshingCompletion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshingCompletion.Merge(m, src)
+func (m *BatchSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSignatures.Merge(m, src)
 }
-func (m *RefreshingCompletion) XXX_Size() int {
+func (m *BatchSignatures) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshingCompletion) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshingCompletion.DiscardUnknown(m)
+func (m *BatchSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSignatures.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshingCompletion proto.InternalMessageInfo
+var xxx_messageInfo_BatchSignatures proto.InternalMessageInfo
 
-func (m *RefreshingCompletion) GetId() uint64 {
+func (m *BatchSignatures) GetSigningId() uint64 {
+	if m != nil {
+		return m.SigningId
+	}
+	return 0
+}
+
+func (m *BatchSignatures) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// Signing Batch
+type SigningBatch struct {
+	// batch id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// module specific id
+	ScopedId string `protobuf:"bytes,3,opt,name=scoped_id,json=scopedId,proto3" json:"scoped_id,omitempty"`
+	// signing intent
+	Intent int32 `protobuf:"varint,4,opt,name=intent,proto3" json:"intent,omitempty"`
+	// ids of the signing requests in the batch
+	SigningIds []uint64 `protobuf:"varint,5,rep,packed,name=signing_ids,json=signingIds,proto3" json:"signing_ids,omitempty"`
+	// signatures of the signed requests in the batch
+	Signatures []*BatchSignatures `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// creation time
+	CreationTime time.Time `protobuf:"bytes,7,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time"`
+	// status
+	Status SigningStatus `protobuf:"varint,8,opt,name=status,proto3,enum=bitway.tss.SigningStatus" json:"status,omitempty"`
+	// shared expiration time of the signing requests; zero means no timeout
+	ExpirationTime time.Time `protobuf:"bytes,9,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+	// id of the timed out batch which is re-initiated by this batch; 0 if none
+	PreviousId uint64 `protobuf:"varint,10,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+}
+
+func (m *SigningBatch) Reset()         { *m = SigningBatch{} }
+func (m *SigningBatch) String() string { return proto.CompactTextString(m) }
+func (*SigningBatch) ProtoMessage()    {}
+func (*SigningBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{6}
+}
+func (m *SigningBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningBatch.Merge(m, src)
+}
+func (m *SigningBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningBatch proto.InternalMessageInfo
+
+func (m *SigningBatch) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *RefreshingCompletion) GetSender() string {
+func (m *SigningBatch) GetModule() string {
 	if m != nil {
-		return m.Sender
+		return m.Module
 	}
 	return ""
 }
 
-func (m *RefreshingCompletion) GetConsensusPubkey() string {
+func (m *SigningBatch) GetScopedId() string {
 	if m != nil {
-		return m.ConsensusPubkey
+		return m.ScopedId
 	}
 	return ""
 }
 
-func (m *RefreshingCompletion) GetSignature() string {
+func (m *SigningBatch) GetIntent() int32 {
 	if m != nil {
-		return m.Signature
+		return m.Intent
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("bitway.tss.DKGStatus", DKGStatus_name, DKGStatus_value)
-	proto.RegisterEnum("bitway.tss.SigningStatus", SigningStatus_name, SigningStatus_value)
-	proto.RegisterEnum("bitway.tss.SigningType", SigningType_name, SigningType_value)
-	proto.RegisterEnum("bitway.tss.RefreshingStatus", RefreshingStatus_name, RefreshingStatus_value)
-	proto.RegisterType((*DKGRequest)(nil), "bitway.tss.DKGRequest")
-	proto.RegisterType((*DKGCompletion)(nil), "bitway.tss.DKGCompletion")
-	proto.RegisterType((*SigningOptions)(nil), "bitway.tss.SigningOptions")
-	proto.RegisterType((*SigningRequest)(nil), "bitway.tss.SigningRequest")
-	proto.RegisterType((*RefreshingRequest)(nil), "bitway.tss.RefreshingRequest")
-	proto.RegisterType((*RefreshingCompletion)(nil), "bitway.tss.RefreshingCompletion")
+func (m *SigningBatch) GetSigningIds() []uint64 {
+	if m != nil {
+		return m.SigningIds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("bitway/tss/tss.proto", fileDescriptor_429ab65fe5c6256b) }
+func (m *SigningBatch) GetSignatures() []*BatchSignatures {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
 
-var fileDescriptor_429ab65fe5c6256b = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0xd5, 0xe8, 0x65, 0xf3, 0x26, 0x76, 0x98, 0x89, 0x6c, 0xd3, 0x8a, 0x2d, 0xab, 0x4e, 0x17,
-	0xaa, 0x8b, 0x4a, 0x48, 0x9a, 0x1f, 0xb0, 0x4d, 0x5a, 0x26, 0x14, 0x3d, 0x40, 0xd1, 0x08, 0xda,
-	0x0d, 0x41, 0x89, 0x13, 0x8a, 0x90, 0x44, 0xb2, 0x9a, 0x61, 0x13, 0x65, 0xd7, 0x4d, 0x8b, 0xee,
-	0xb2, 0x29, 0x50, 0xa0, 0x1f, 0xd3, 0x6d, 0x96, 0x59, 0x76, 0xd5, 0x16, 0xf6, 0x1f, 0xf4, 0x0b,
-	0x0a, 0x0e, 0x29, 0x89, 0x96, 0xe4, 0xa2, 0x69, 0x17, 0x06, 0x78, 0xcf, 0xb9, 0xe4, 0xcc, 0x3d,
-	0xf7, 0x1c, 0x43, 0x50, 0xe8, 0x39, 0xec, 0xb5, 0x39, 0xad, 0x31, 0x4a, 0xc3, 0xbf, 0xaa, 0x3f,
-	0xf1, 0x98, 0x87, 0x21, 0x42, 0xab, 0x8c, 0xd2, 0x62, 0xc1, 0xf6, 0x6c, 0x8f, 0xc3, 0xb5, 0xf0,
-	0x29, 0xea, 0x28, 0x1e, 0xd9, 0x9e, 0x67, 0x8f, 0x48, 0x8d, 0x57, 0xbd, 0xe0, 0x55, 0x8d, 0x39,
-	0x63, 0x42, 0x99, 0x39, 0xf6, 0xa3, 0x86, 0xe3, 0x5f, 0xd3, 0x00, 0x72, 0xa3, 0xae, 0x91, 0x6f,
-	0x02, 0x42, 0x19, 0xde, 0x86, 0xb4, 0x63, 0x49, 0xa8, 0x8c, 0x2a, 0x59, 0x2d, 0xed, 0x58, 0x78,
-	0x17, 0xf2, 0x63, 0xcf, 0x0a, 0x46, 0x44, 0x4a, 0x97, 0x51, 0x45, 0xd0, 0xe2, 0x0a, 0x63, 0xc8,
-	0xb2, 0xa9, 0x4f, 0xa4, 0x0c, 0x47, 0xf9, 0x73, 0xd8, 0xeb, 0xb8, 0x8c, 0xb8, 0x4c, 0xca, 0x96,
-	0x51, 0x25, 0xa7, 0xc5, 0x15, 0x3e, 0x86, 0xfb, 0xbe, 0x39, 0x61, 0x4e, 0xdf, 0xf1, 0x4d, 0x97,
-	0x51, 0x29, 0x57, 0xce, 0x54, 0x04, 0xed, 0x16, 0x86, 0x0f, 0x40, 0x60, 0x83, 0x09, 0xa1, 0x03,
-	0x6f, 0x64, 0x49, 0xf9, 0x32, 0xaa, 0x6c, 0x69, 0x0b, 0x00, 0x1f, 0x02, 0xf4, 0x4c, 0xd6, 0x1f,
-	0x18, 0xd4, 0x79, 0x4b, 0xa4, 0x8d, 0x88, 0xe6, 0x48, 0xd7, 0x79, 0x4b, 0x70, 0x13, 0x1e, 0x90,
-	0x37, 0xbe, 0x33, 0x31, 0x99, 0xe3, 0xb9, 0x46, 0x38, 0xa1, 0xb4, 0x59, 0x46, 0x95, 0x7b, 0xcf,
-	0x8a, 0xd5, 0x68, 0xfc, 0xea, 0x6c, 0xfc, 0xaa, 0x3e, 0x1b, 0xff, 0x6c, 0xf3, 0xfd, 0xef, 0x47,
-	0xa9, 0x77, 0x7f, 0x1c, 0x21, 0x6d, 0x7b, 0xf1, 0x72, 0x48, 0xe3, 0x2f, 0x20, 0x4f, 0x99, 0xc9,
-	0x02, 0x2a, 0x09, 0x65, 0x54, 0xd9, 0x7e, 0xb6, 0x53, 0x5d, 0xc8, 0x5c, 0x95, 0x1b, 0xf5, 0x2e,
-	0x27, 0xb5, 0xb8, 0xe9, 0xf8, 0x17, 0x04, 0x5b, 0x72, 0xa3, 0x7e, 0xee, 0x8d, 0xfd, 0x11, 0x09,
-	0x3f, 0xb2, 0x4e, 0x44, 0x4a, 0x5c, 0x8b, 0x4c, 0x66, 0x22, 0x46, 0x15, 0xde, 0x87, 0x4d, 0x3f,
-	0xe8, 0x19, 0x43, 0x32, 0xa5, 0x52, 0x86, 0x8b, 0xb2, 0xe1, 0x07, 0xbd, 0x06, 0x99, 0x52, 0xfc,
-	0x19, 0x88, 0x7d, 0xcf, 0xa5, 0xc4, 0xa5, 0x01, 0x35, 0xfc, 0xa0, 0x37, 0x24, 0x53, 0xae, 0xaa,
-	0xa0, 0x3d, 0x98, 0xe3, 0x1d, 0x0e, 0x87, 0xd2, 0x51, 0xc7, 0x76, 0x4d, 0x16, 0x4c, 0x88, 0x94,
-	0xe3, 0x3d, 0x0b, 0xe0, 0xd8, 0x84, 0xed, 0xae, 0x63, 0xbb, 0x8e, 0x6b, 0xb7, 0xfd, 0xf0, 0x72,
-	0x14, 0x17, 0x20, 0xc7, 0x5e, 0x13, 0x73, 0xc8, 0x2f, 0x28, 0x68, 0x51, 0x11, 0xa2, 0xae, 0xe7,
-	0xf6, 0x67, 0x7b, 0x8e, 0x0a, 0xfc, 0x04, 0xb6, 0x4c, 0xcb, 0xf4, 0x99, 0x37, 0x31, 0x7c, 0xcf,
-	0x71, 0x59, 0xbc, 0xef, 0xfb, 0x31, 0xd8, 0x09, 0xb1, 0xe3, 0xef, 0x33, 0xf3, 0x33, 0x3e, 0xd6,
-	0x46, 0x8f, 0x41, 0xa0, 0x7d, 0xcf, 0x27, 0x96, 0xe1, 0x58, 0xf1, 0xb7, 0x37, 0x23, 0x40, 0xb5,
-	0xf0, 0xe7, 0xb1, 0xc7, 0xb2, 0x7c, 0x0b, 0x7b, 0xc9, 0x2d, 0xc4, 0xc7, 0xe9, 0x53, 0x9f, 0xac,
-	0x98, 0x2f, 0x77, 0xcb, 0x7c, 0x7b, 0xb0, 0x11, 0x6b, 0xcc, 0x6d, 0x25, 0x68, 0xf9, 0x48, 0xe2,
-	0xd0, 0x53, 0xd4, 0xb1, 0x8d, 0x81, 0x49, 0x07, 0x84, 0x4a, 0x1b, 0x5c, 0xfe, 0x50, 0xb7, 0x4b,
-	0x0e, 0xe0, 0xe7, 0xb0, 0xe1, 0x45, 0x82, 0xcd, 0xbd, 0xb4, 0x7a, 0x7e, 0x2c, 0xa9, 0x36, 0x6b,
-	0xc5, 0x2a, 0x6c, 0xf5, 0x27, 0x24, 0xe1, 0x43, 0xe1, 0x23, 0x7c, 0x78, 0x7f, 0xf6, 0x2a, 0x77,
-	0xe1, 0xd3, 0xb9, 0x0b, 0x81, 0xcf, 0xbf, 0xbf, 0xe6, 0xfc, 0x25, 0x27, 0xfe, 0x85, 0xe0, 0xa1,
-	0x46, 0x5e, 0x85, 0xa9, 0xf9, 0x87, 0x5d, 0xec, 0x40, 0xde, 0x1a, 0xda, 0xa1, 0xe0, 0x69, 0x8e,
-	0xe5, 0xac, 0xa1, 0xad, 0x5a, 0xf8, 0x29, 0x14, 0x26, 0x64, 0xec, 0x7d, 0x4b, 0x2c, 0xe3, 0x56,
-	0x5a, 0x23, 0x63, 0x3e, 0x8a, 0xb9, 0x4e, 0x32, 0xb4, 0x6b, 0x72, 0x97, 0xfd, 0x1f, 0xb9, 0x7b,
-	0x3e, 0x9f, 0x38, 0xc7, 0x27, 0x3e, 0x48, 0x4e, 0xbc, 0x98, 0x6b, 0x69, 0xe8, 0x1f, 0x10, 0x14,
-	0x16, 0xe4, 0x7f, 0x48, 0xe1, 0xba, 0xa8, 0x65, 0xfe, 0x45, 0xd4, 0xb2, 0x4b, 0x51, 0x3b, 0xf9,
-	0x11, 0x81, 0x30, 0xff, 0xf7, 0x80, 0x8b, 0xb0, 0x2b, 0x37, 0xea, 0x46, 0x57, 0x3f, 0xd5, 0xaf,
-	0xba, 0xc6, 0x55, 0xab, 0xdb, 0x51, 0xce, 0xd5, 0x0b, 0x55, 0x91, 0xc5, 0x14, 0xde, 0x05, 0x9c,
-	0xe0, 0x3a, 0x4a, 0x4b, 0x56, 0x5b, 0x75, 0x11, 0x61, 0x09, 0x0a, 0x09, 0xfc, 0xbc, 0xdd, 0xec,
-	0xbc, 0x50, 0x74, 0x45, 0x16, 0xd3, 0x78, 0x07, 0x1e, 0x26, 0x98, 0x8b, 0x53, 0xf5, 0x85, 0x22,
-	0x8b, 0x19, 0xbc, 0x07, 0x8f, 0x12, 0xb0, 0xae, 0x36, 0x15, 0xb9, 0x7d, 0xa5, 0x8b, 0xd9, 0x93,
-	0xef, 0x10, 0x6c, 0xdd, 0x32, 0x09, 0x2e, 0x41, 0xb1, 0xab, 0xd6, 0x5b, 0x6a, 0xeb, 0x8e, 0x3b,
-	0x15, 0x61, 0x77, 0x89, 0x5f, 0xdc, 0x6b, 0x1f, 0x76, 0x96, 0xb8, 0xb0, 0xe4, 0x17, 0x5b, 0xa5,
-	0x66, 0x97, 0x3b, 0xf9, 0x19, 0xc1, 0xbd, 0x44, 0x50, 0xc3, 0xe9, 0x66, 0xad, 0xfa, 0x57, 0x1d,
-	0xc5, 0xe8, 0x9e, 0x5f, 0xb6, 0xda, 0x9a, 0x26, 0xa6, 0xf0, 0x13, 0x38, 0x5a, 0xc7, 0x18, 0x2f,
-	0x55, 0xfd, 0xd2, 0xd0, 0x5f, 0x2a, 0xa7, 0x0d, 0x11, 0xe1, 0x0a, 0x7c, 0x7a, 0x77, 0xd3, 0x79,
-	0xbb, 0xd9, 0x54, 0xf5, 0xa6, 0xd2, 0xd2, 0xc5, 0x34, 0x2e, 0xc3, 0xc1, 0xda, 0xce, 0x53, 0xf9,
-	0xb4, 0xa3, 0xb7, 0x35, 0x31, 0x73, 0xf2, 0x13, 0x02, 0x71, 0xd9, 0x51, 0xf8, 0x13, 0x38, 0xd4,
-	0x94, 0x0b, 0x4d, 0xe9, 0x5e, 0xde, 0x29, 0xd2, 0x21, 0xec, 0xaf, 0xb6, 0x2c, 0x74, 0x3a, 0x82,
-	0xc7, 0xab, 0x74, 0x72, 0x8d, 0x25, 0x28, 0xae, 0x36, 0xcc, 0xd7, 0x96, 0x39, 0x3b, 0x7b, 0x7f,
-	0x5d, 0x42, 0x1f, 0xae, 0x4b, 0xe8, 0xcf, 0xeb, 0x12, 0x7a, 0x77, 0x53, 0x4a, 0x7d, 0xb8, 0x29,
-	0xa5, 0x7e, 0xbb, 0x29, 0xa5, 0xbe, 0xae, 0xd8, 0x0e, 0x1b, 0x04, 0xbd, 0x6a, 0xdf, 0x1b, 0xd7,
-	0xa2, 0x58, 0x8c, 0xcc, 0x1e, 0x8d, 0x1f, 0x6b, 0x6f, 0xa2, 0x1f, 0x06, 0x53, 0x9f, 0xd0, 0x5e,
-	0x9e, 0x87, 0xee, 0xcb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x8f, 0x94, 0xe3, 0x33, 0x08,
-	0x00, 0x00,
+func (m *SigningBatch) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
+	}
+	return time.Time{}
 }
 
-func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SigningBatch) GetStatus() SigningStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
 }
 
-func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SigningBatch) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
 }
 
-func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x48
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTss(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	if m.BatchSize != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.BatchSize))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Threshold != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x30
+func (m *SigningBatch) GetPreviousId() uint64 {
+	if m != nil {
+		return m.PreviousId
 	}
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Participants[iNdEx])
-			copy(dAtA[i:], m.Participants[iNdEx])
-			i = encodeVarintTss(dAtA, i, uint64(len(m.Participants[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+	return 0
+}
+
+// Signing Acknowledgement
+type SigningAcknowledgement struct {
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SigningAcknowledgement) Reset()         { *m = SigningAcknowledgement{} }
+func (m *SigningAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*SigningAcknowledgement) ProtoMessage()    {}
+func (*SigningAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{7}
+}
+func (m *SigningAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Intent != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Intent))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *SigningAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningAcknowledgement.Merge(m, src)
+}
+func (m *SigningAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningAcknowledgement proto.InternalMessageInfo
+
+func (m *SigningAcknowledgement) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *SigningAcknowledgement) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.Id != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *SigningAcknowledgement) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DKGCompletion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SigningAcknowledgement) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DKGCompletion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Partial Signature
+type PartialSignature struct {
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded public nonces of the participant, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// hex encoded partial signatures, one for each sig hash
+	PartialSignatures []string `protobuf:"bytes,5,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// consensus pub keys of the participants which jointly sign in the same session
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+	// hex encoded aggregated public nonces of the signers, one for each sig hash
+	AggregatedNonces []string `protobuf:"bytes,8,rep,name=aggregated_nonces,json=aggregatedNonces,proto3" json:"aggregated_nonces,omitempty"`
 }
 
-func (m *DKGCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PubKeys) > 0 {
-		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PubKeys[iNdEx])
-			copy(dAtA[i:], m.PubKeys[iNdEx])
-			i = encodeVarintTss(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *PartialSignature) Reset()         { *m = PartialSignature{} }
+func (m *PartialSignature) String() string { return proto.CompactTextString(m) }
+func (*PartialSignature) ProtoMessage()    {}
+func (*PartialSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{8}
+}
+func (m *PartialSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *PartialSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSignature.Merge(m, src)
+}
+func (m *PartialSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSignature proto.InternalMessageInfo
+
+func (m *PartialSignature) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Id != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *PartialSignature) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SigningOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PartialSignature) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SigningOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PartialSignature) GetNonces() []string {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
 }
 
-func (m *SigningOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdaptorPoint) > 0 {
-		i -= len(m.AdaptorPoint)
-		copy(dAtA[i:], m.AdaptorPoint)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.AdaptorPoint)))
-		i--
-		dAtA[i] = 0x1a
+func (m *PartialSignature) GetPartialSignatures() []string {
+	if m != nil {
+		return m.PartialSignatures
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *PartialSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	if len(m.Tweak) > 0 {
-		i -= len(m.Tweak)
-		copy(dAtA[i:], m.Tweak)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Tweak)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PartialSignature) GetSigners() []string {
+	if m != nil {
+		return m.Signers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PartialSignature) GetAggregatedNonces() []string {
+	if m != nil {
+		return m.AggregatedNonces
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Verification Shares
+type VerificationShares struct {
+	// DKG request id
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// hex encoded compressed verification shares of the current participants
+	// ordered by the participants for each public key
+	Shares []string `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x50
-	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTss(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x4a
-	if m.Options != nil {
-		{
-			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTss(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SigHashes) > 0 {
-		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SigHashes[iNdEx])
-			copy(dAtA[i:], m.SigHashes[iNdEx])
-			i = encodeVarintTss(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+func (m *VerificationShares) Reset()         { *m = VerificationShares{} }
+func (m *VerificationShares) String() string { return proto.CompactTextString(m) }
+func (*VerificationShares) ProtoMessage()    {}
+func (*VerificationShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{9}
+}
+func (m *VerificationShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Intent != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Intent))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Type != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ScopedId) > 0 {
-		i -= len(m.ScopedId)
-		copy(dAtA[i:], m.ScopedId)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.ScopedId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *VerificationShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationShares.Merge(m, src)
+}
+func (m *VerificationShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationShares proto.InternalMessageInfo
+
+func (m *VerificationShares) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RefreshingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VerificationShares) GetShares() []string {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RefreshingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Signer Set
+type SignerSet struct {
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// participants which took part in the signing
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// indicates if the signatures are aggregated on chain from the partial signatures
+	Aggregated bool `protobuf:"varint,3,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
 }
 
-func (m *RefreshingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTss(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.RemovedParticipants) > 0 {
-		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedParticipants[iNdEx])
-			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
-			i = encodeVarintTss(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *SignerSet) Reset()         { *m = SignerSet{} }
+func (m *SignerSet) String() string { return proto.CompactTextString(m) }
+func (*SignerSet) ProtoMessage()    {}
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{10}
+}
+func (m *SignerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DkgId != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *SignerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSet.Merge(m, src)
+}
+func (m *SignerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSet proto.InternalMessageInfo
+
+func (m *SignerSet) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Id != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SignerSet) GetSigners() []string {
+	if m != nil {
+		return m.Signers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RefreshingCompletion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSet) GetAggregated() bool {
+	if m != nil {
+		return m.Aggregated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *RefreshingCompletion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Refreshing Request
+type RefreshingRequest struct {
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// request id of the DKG corresponding to the key shares to be refreshed
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// removed participant set
+	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
+	// expiration time
+	ExpirationTime time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+	// status
+	Status RefreshingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bitway.tss.RefreshingStatus" json:"status,omitempty"`
+	// added participant set
+	AddedParticipants []string `protobuf:"bytes,6,rep,name=added_participants,json=addedParticipants,proto3" json:"added_participants,omitempty"`
+	// threshold after refreshing
+	Threshold uint32 `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *RefreshingCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
+func (m *RefreshingRequest) Reset()         { *m = RefreshingRequest{} }
+func (m *RefreshingRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshingRequest) ProtoMessage()    {}
+func (*RefreshingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{11}
+}
+func (m *RefreshingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *RefreshingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshingRequest.Merge(m, src)
+}
+func (m *RefreshingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshingRequest proto.InternalMessageInfo
+
+func (m *RefreshingRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Id != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *RefreshingRequest) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTss(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTss(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RefreshingRequest) GetRemovedParticipants() []string {
+	if m != nil {
+		return m.RemovedParticipants
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *DKGRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RefreshingRequest) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTss(uint64(m.Id))
-	}
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return time.Time{}
+}
+
+func (m *RefreshingRequest) GetStatus() RefreshingStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return RefreshingStatus_REFRESHING_STATUS_UNSPECIFIED
+}
+
+func (m *RefreshingRequest) GetAddedParticipants() []string {
+	if m != nil {
+		return m.AddedParticipants
 	}
-	if m.Intent != 0 {
-		n += 1 + sovTss(uint64(m.Intent))
+	return nil
+}
+
+func (m *RefreshingRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	if len(m.Participants) > 0 {
-		for _, s := range m.Participants {
-			l = len(s)
-			n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+// Refreshing Completion
+type RefreshingCompletion struct {
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded compressed verification shares of the refreshed participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,5,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+}
+
+func (m *RefreshingCompletion) Reset()         { *m = RefreshingCompletion{} }
+func (m *RefreshingCompletion) String() string { return proto.CompactTextString(m) }
+func (*RefreshingCompletion) ProtoMessage()    {}
+func (*RefreshingCompletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{12}
+}
+func (m *RefreshingCompletion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshingCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshingCompletion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTss(uint64(m.Threshold))
-	}
-	if m.BatchSize != 0 {
-		n += 1 + sovTss(uint64(m.BatchSize))
+}
+func (m *RefreshingCompletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshingCompletion.Merge(m, src)
+}
+func (m *RefreshingCompletion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshingCompletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshingCompletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshingCompletion proto.InternalMessageInfo
+
+func (m *RefreshingCompletion) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
-	n += 1 + l + sovTss(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTss(uint64(m.Status))
+	return 0
+}
+
+func (m *RefreshingCompletion) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return n
+	return ""
 }
 
-func (m *DKGCompletion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshingCompletion) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTss(uint64(m.Id))
+	return ""
+}
+
+func (m *RefreshingCompletion) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return ""
+}
+
+func (m *RefreshingCompletion) GetVerificationShares() []string {
+	if m != nil {
+		return m.VerificationShares
 	}
-	if len(m.PubKeys) > 0 {
-		for _, s := range m.PubKeys {
-			l = len(s)
-			n += 1 + l + sovTss(uint64(l))
+	return nil
+}
+
+// Participant Reliability
+type ParticipantReliability struct {
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// number of completed DKG rounds
+	CompletedDkgs uint64 `protobuf:"varint,2,opt,name=completed_dkgs,json=completedDkgs,proto3" json:"completed_dkgs,omitempty"`
+	// number of missed DKG rounds
+	MissedDkgs uint64 `protobuf:"varint,3,opt,name=missed_dkgs,json=missedDkgs,proto3" json:"missed_dkgs,omitempty"`
+	// number of completed refreshing rounds
+	CompletedRefreshings uint64 `protobuf:"varint,4,opt,name=completed_refreshings,json=completedRefreshings,proto3" json:"completed_refreshings,omitempty"`
+	// number of missed refreshing rounds
+	MissedRefreshings uint64 `protobuf:"varint,5,opt,name=missed_refreshings,json=missedRefreshings,proto3" json:"missed_refreshings,omitempty"`
+	// number of acknowledged signing rounds
+	AcknowledgedSignings uint64 `protobuf:"varint,6,opt,name=acknowledged_signings,json=acknowledgedSignings,proto3" json:"acknowledged_signings,omitempty"`
+	// number of missed signing rounds
+	MissedSignings uint64 `protobuf:"varint,7,opt,name=missed_signings,json=missedSignings,proto3" json:"missed_signings,omitempty"`
+	// faults accumulated since the last penalty
+	PendingFaults uint64 `protobuf:"varint,8,opt,name=pending_faults,json=pendingFaults,proto3" json:"pending_faults,omitempty"`
+	// number of penalties
+	PenaltyCount uint64 `protobuf:"varint,9,opt,name=penalty_count,json=penaltyCount,proto3" json:"penalty_count,omitempty"`
+	// block height of the last fault
+	LastFaultHeight int64 `protobuf:"varint,10,opt,name=last_fault_height,json=lastFaultHeight,proto3" json:"last_fault_height,omitempty"`
+	// block height of the last penalty
+	LastPenaltyHeight int64 `protobuf:"varint,11,opt,name=last_penalty_height,json=lastPenaltyHeight,proto3" json:"last_penalty_height,omitempty"`
+	// block height of the last completed refreshing round
+	LastRefreshingHeight int64 `protobuf:"varint,12,opt,name=last_refreshing_height,json=lastRefreshingHeight,proto3" json:"last_refreshing_height,omitempty"`
+	// number of signing rounds of which the latency is measured
+	MeasuredSignings uint64 `protobuf:"varint,13,opt,name=measured_signings,json=measuredSignings,proto3" json:"measured_signings,omitempty"`
+	// accumulated latency of the measured signing rounds
+	TotalSigningLatency time.Duration `protobuf:"bytes,14,opt,name=total_signing_latency,json=totalSigningLatency,proto3,stdduration" json:"total_signing_latency"`
+}
+
+func (m *ParticipantReliability) Reset()         { *m = ParticipantReliability{} }
+func (m *ParticipantReliability) String() string { return proto.CompactTextString(m) }
+func (*ParticipantReliability) ProtoMessage()    {}
+func (*ParticipantReliability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{13}
+}
+func (m *ParticipantReliability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantReliability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantReliability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
-	}
-	return n
+}
+func (m *ParticipantReliability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantReliability.Merge(m, src)
+}
+func (m *ParticipantReliability) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantReliability) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantReliability.DiscardUnknown(m)
 }
 
-func (m *SigningOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tweak)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+var xxx_messageInfo_ParticipantReliability proto.InternalMessageInfo
+
+func (m *ParticipantReliability) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return ""
+}
+
+func (m *ParticipantReliability) GetCompletedDkgs() uint64 {
+	if m != nil {
+		return m.CompletedDkgs
 	}
-	l = len(m.AdaptorPoint)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+func (m *ParticipantReliability) GetMissedDkgs() uint64 {
+	if m != nil {
+		return m.MissedDkgs
 	}
-	return n
+	return 0
 }
 
-func (m *SigningRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParticipantReliability) GetCompletedRefreshings() uint64 {
+	if m != nil {
+		return m.CompletedRefreshings
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTss(uint64(m.Id))
+	return 0
+}
+
+func (m *ParticipantReliability) GetMissedRefreshings() uint64 {
+	if m != nil {
+		return m.MissedRefreshings
 	}
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+func (m *ParticipantReliability) GetAcknowledgedSignings() uint64 {
+	if m != nil {
+		return m.AcknowledgedSignings
 	}
-	l = len(m.ScopedId)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+func (m *ParticipantReliability) GetMissedSignings() uint64 {
+	if m != nil {
+		return m.MissedSignings
 	}
-	if m.Type != 0 {
-		n += 1 + sovTss(uint64(m.Type))
+	return 0
+}
+
+func (m *ParticipantReliability) GetPendingFaults() uint64 {
+	if m != nil {
+		return m.PendingFaults
 	}
-	if m.Intent != 0 {
-		n += 1 + sovTss(uint64(m.Intent))
+	return 0
+}
+
+func (m *ParticipantReliability) GetPenaltyCount() uint64 {
+	if m != nil {
+		return m.PenaltyCount
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+func (m *ParticipantReliability) GetLastFaultHeight() int64 {
+	if m != nil {
+		return m.LastFaultHeight
 	}
-	if len(m.SigHashes) > 0 {
-		for _, s := range m.SigHashes {
-			l = len(s)
-			n += 1 + l + sovTss(uint64(l))
-		}
+	return 0
+}
+
+func (m *ParticipantReliability) GetLastPenaltyHeight() int64 {
+	if m != nil {
+		return m.LastPenaltyHeight
 	}
-	if m.Options != nil {
-		l = m.Options.Size()
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+func (m *ParticipantReliability) GetLastRefreshingHeight() int64 {
+	if m != nil {
+		return m.LastRefreshingHeight
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
-	n += 1 + l + sovTss(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTss(uint64(m.Status))
+	return 0
+}
+
+func (m *ParticipantReliability) GetMeasuredSignings() uint64 {
+	if m != nil {
+		return m.MeasuredSignings
 	}
-	return n
+	return 0
 }
 
-func (m *RefreshingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParticipantReliability) GetTotalSigningLatency() time.Duration {
+	if m != nil {
+		return m.TotalSigningLatency
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTss(uint64(m.Id))
+	return 0
+}
+
+// Participant Set
+type ParticipantSet struct {
+	// participants
+	Participants []DKGParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants"`
+	// block height at which the participant set is updated
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ParticipantSet) Reset()         { *m = ParticipantSet{} }
+func (m *ParticipantSet) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSet) ProtoMessage()    {}
+func (*ParticipantSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{14}
+}
+func (m *ParticipantSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DkgId != 0 {
-		n += 1 + sovTss(uint64(m.DkgId))
+}
+func (m *ParticipantSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSet.Merge(m, src)
+}
+func (m *ParticipantSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantSet proto.InternalMessageInfo
+
+func (m *ParticipantSet) GetParticipants() []DKGParticipant {
+	if m != nil {
+		return m.Participants
 	}
-	if len(m.RemovedParticipants) > 0 {
-		for _, s := range m.RemovedParticipants {
-			l = len(s)
-			n += 1 + l + sovTss(uint64(l))
+	return nil
+}
+
+func (m *ParticipantSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Committed Nonce
+type CommittedNonce struct {
+	// id of the DKG for which the nonce is committed
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// index in the nonce queue
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded public nonce
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// id of the DKG which generated the nonce
+	GenerationId uint64 `protobuf:"varint,4,opt,name=generation_id,json=generationId,proto3" json:"generation_id,omitempty"`
+}
+
+func (m *CommittedNonce) Reset()         { *m = CommittedNonce{} }
+func (m *CommittedNonce) String() string { return proto.CompactTextString(m) }
+func (*CommittedNonce) ProtoMessage()    {}
+func (*CommittedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{15}
+}
+func (m *CommittedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommittedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommittedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
-	n += 1 + l + sovTss(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTss(uint64(m.Status))
+}
+func (m *CommittedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommittedNonce.Merge(m, src)
+}
+func (m *CommittedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommittedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommittedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommittedNonce proto.InternalMessageInfo
+
+func (m *CommittedNonce) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	return n
+	return 0
 }
 
-func (m *RefreshingCompletion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommittedNonce) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTss(uint64(m.Id))
+	return 0
+}
+
+func (m *CommittedNonce) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return ""
+}
+
+func (m *CommittedNonce) GetGenerationId() uint64 {
+	if m != nil {
+		return m.GenerationId
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+	return 0
+}
+
+// Signing Fee
+type SigningFee struct {
+	// signing request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address which paid the fee
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// fee escrowed in the module account
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *SigningFee) Reset()         { *m = SigningFee{} }
+func (m *SigningFee) String() string { return proto.CompactTextString(m) }
+func (*SigningFee) ProtoMessage()    {}
+func (*SigningFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{16}
+}
+func (m *SigningFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTss(uint64(l))
+}
+func (m *SigningFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningFee.Merge(m, src)
+}
+func (m *SigningFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningFee proto.InternalMessageInfo
+
+func (m *SigningFee) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func sovTss(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SigningFee) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
 }
-func sozTss(x uint64) (n int) {
-	return sovTss(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SigningFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
-func (m *DKGRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTss
+
+// Participant Rewards
+type ParticipantRewards struct {
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// accumulated rewards to be claimed
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ParticipantRewards) Reset()         { *m = ParticipantRewards{} }
+func (m *ParticipantRewards) String() string { return proto.CompactTextString(m) }
+func (*ParticipantRewards) ProtoMessage()    {}
+func (*ParticipantRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{17}
+}
+func (m *ParticipantRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantRewards.Merge(m, src)
+}
+func (m *ParticipantRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantRewards proto.InternalMessageInfo
+
+func (m *ParticipantRewards) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
+}
+
+func (m *ParticipantRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// Key Rotation
+type KeyRotation struct {
+	// module which owns the keys
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// key type defined by the module
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// status
+	Status KeyRotationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=bitway.tss.KeyRotationStatus" json:"status,omitempty"`
+	// time at which the current keys became active
+	LastRotationTime time.Time `protobuf:"bytes,4,opt,name=last_rotation_time,json=lastRotationTime,proto3,stdtime" json:"last_rotation_time"`
+	// time at which the old keys are retired when overlapping
+	RetirementTime time.Time `protobuf:"bytes,5,opt,name=retirement_time,json=retirementTime,proto3,stdtime" json:"retirement_time"`
+	// pending refreshing request ids for the refreshing method
+	RefreshingIds []uint64 `protobuf:"varint,6,rep,packed,name=refreshing_ids,json=refreshingIds,proto3" json:"refreshing_ids,omitempty"`
+}
+
+func (m *KeyRotation) Reset()         { *m = KeyRotation{} }
+func (m *KeyRotation) String() string { return proto.CompactTextString(m) }
+func (*KeyRotation) ProtoMessage()    {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{18}
+}
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
+func (m *KeyRotation) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetStatus() KeyRotationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return KeyRotationStatus_KEY_ROTATION_STATUS_ACTIVE
+}
+
+func (m *KeyRotation) GetLastRotationTime() time.Time {
+	if m != nil {
+		return m.LastRotationTime
+	}
+	return time.Time{}
+}
+
+func (m *KeyRotation) GetRetirementTime() time.Time {
+	if m != nil {
+		return m.RetirementTime
+	}
+	return time.Time{}
+}
+
+func (m *KeyRotation) GetRefreshingIds() []uint64 {
+	if m != nil {
+		return m.RefreshingIds
+	}
+	return nil
+}
+
+// Gov Signature Request
+type GovSignatureRequest struct {
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DKG id
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// optional base64 encoded psbt from which the sig hashes are derived
+	Psbt string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// id of the latest signing request
+	SigningId uint64 `protobuf:"varint,4,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// hex encoded signatures; empty until the signing request is completed
+	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *GovSignatureRequest) Reset()         { *m = GovSignatureRequest{} }
+func (m *GovSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*GovSignatureRequest) ProtoMessage()    {}
+func (*GovSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{19}
+}
+func (m *GovSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovSignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovSignatureRequest.Merge(m, src)
+}
+func (m *GovSignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovSignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovSignatureRequest proto.InternalMessageInfo
+
+func (m *GovSignatureRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GovSignatureRequest) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
+	}
+	return 0
+}
+
+func (m *GovSignatureRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *GovSignatureRequest) GetSigningId() uint64 {
+	if m != nil {
+		return m.SigningId
+	}
+	return 0
+}
+
+func (m *GovSignatureRequest) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// Signing Stats
+type SigningStats struct {
+	// initiator module
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// signing type
+	Type SigningType `protobuf:"varint,2,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// number of initiated signing requests
+	Requested uint64 `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	// number of signed requests
+	Signed uint64 `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	// number of timed out requests
+	TimedOut uint64 `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// accumulated latency of the signed requests
+	TotalLatency time.Duration `protobuf:"bytes,6,opt,name=total_latency,json=totalLatency,proto3,stdduration" json:"total_latency"`
+}
+
+func (m *SigningStats) Reset()         { *m = SigningStats{} }
+func (m *SigningStats) String() string { return proto.CompactTextString(m) }
+func (*SigningStats) ProtoMessage()    {}
+func (*SigningStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{20}
+}
+func (m *SigningStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningStats.Merge(m, src)
+}
+func (m *SigningStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningStats proto.InternalMessageInfo
+
+func (m *SigningStats) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *SigningStats) GetType() SigningType {
+	if m != nil {
+		return m.Type
+	}
+	return SigningType_SIGNING_TYPE_SCHNORR
+}
+
+func (m *SigningStats) GetRequested() uint64 {
+	if m != nil {
+		return m.Requested
+	}
+	return 0
+}
+
+func (m *SigningStats) GetSigned() uint64 {
+	if m != nil {
+		return m.Signed
+	}
+	return 0
+}
+
+func (m *SigningStats) GetTimedOut() uint64 {
+	if m != nil {
+		return m.TimedOut
+	}
+	return 0
+}
+
+func (m *SigningStats) GetTotalLatency() time.Duration {
+	if m != nil {
+		return m.TotalLatency
+	}
+	return 0
+}
+
+// Participant Key
+type ParticipantKey struct {
+	// DKG id
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// initiator module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// dkg type
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// key type
+	KeyType KeyType `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
+	// public keys generated by the DKG
+	PubKeys []string `protobuf:"bytes,5,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+}
+
+func (m *ParticipantKey) Reset()         { *m = ParticipantKey{} }
+func (m *ParticipantKey) String() string { return proto.CompactTextString(m) }
+func (*ParticipantKey) ProtoMessage()    {}
+func (*ParticipantKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429ab65fe5c6256b, []int{21}
+}
+func (m *ParticipantKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantKey.Merge(m, src)
+}
+func (m *ParticipantKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantKey proto.InternalMessageInfo
+
+func (m *ParticipantKey) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
+	}
+	return 0
+}
+
+func (m *ParticipantKey) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ParticipantKey) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ParticipantKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
+func (m *ParticipantKey) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("bitway.tss.DKGStatus", DKGStatus_name, DKGStatus_value)
+	proto.RegisterEnum("bitway.tss.KeyType", KeyType_name, KeyType_value)
+	proto.RegisterEnum("bitway.tss.SigningStatus", SigningStatus_name, SigningStatus_value)
+	proto.RegisterEnum("bitway.tss.SigningType", SigningType_name, SigningType_value)
+	proto.RegisterEnum("bitway.tss.RefreshingStatus", RefreshingStatus_name, RefreshingStatus_value)
+	proto.RegisterEnum("bitway.tss.FaultType", FaultType_name, FaultType_value)
+	proto.RegisterEnum("bitway.tss.KeyRotationStatus", KeyRotationStatus_name, KeyRotationStatus_value)
+	proto.RegisterType((*DKGRequest)(nil), "bitway.tss.DKGRequest")
+	proto.RegisterType((*DKGCompletion)(nil), "bitway.tss.DKGCompletion")
+	proto.RegisterType((*SigningOptions)(nil), "bitway.tss.SigningOptions")
+	proto.RegisterType((*SigningRequest)(nil), "bitway.tss.SigningRequest")
+	proto.RegisterType((*SigningBatchItem)(nil), "bitway.tss.SigningBatchItem")
+	proto.RegisterType((*BatchSignatures)(nil), "bitway.tss.BatchSignatures")
+	proto.RegisterType((*SigningBatch)(nil), "bitway.tss.SigningBatch")
+	proto.RegisterType((*SigningAcknowledgement)(nil), "bitway.tss.SigningAcknowledgement")
+	proto.RegisterType((*PartialSignature)(nil), "bitway.tss.PartialSignature")
+	proto.RegisterType((*VerificationShares)(nil), "bitway.tss.VerificationShares")
+	proto.RegisterType((*SignerSet)(nil), "bitway.tss.SignerSet")
+	proto.RegisterType((*RefreshingRequest)(nil), "bitway.tss.RefreshingRequest")
+	proto.RegisterType((*RefreshingCompletion)(nil), "bitway.tss.RefreshingCompletion")
+	proto.RegisterType((*ParticipantReliability)(nil), "bitway.tss.ParticipantReliability")
+	proto.RegisterType((*ParticipantSet)(nil), "bitway.tss.ParticipantSet")
+	proto.RegisterType((*CommittedNonce)(nil), "bitway.tss.CommittedNonce")
+	proto.RegisterType((*SigningFee)(nil), "bitway.tss.SigningFee")
+	proto.RegisterType((*ParticipantRewards)(nil), "bitway.tss.ParticipantRewards")
+	proto.RegisterType((*KeyRotation)(nil), "bitway.tss.KeyRotation")
+	proto.RegisterType((*GovSignatureRequest)(nil), "bitway.tss.GovSignatureRequest")
+	proto.RegisterType((*SigningStats)(nil), "bitway.tss.SigningStats")
+	proto.RegisterType((*ParticipantKey)(nil), "bitway.tss.ParticipantKey")
+}
+
+func init() { proto.RegisterFile("bitway/tss/tss.proto", fileDescriptor_429ab65fe5c6256b) }
+
+var fileDescriptor_429ab65fe5c6256b = []byte{
+	// 2203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcb, 0x73, 0x1a, 0xc9,
+	0x19, 0xd7, 0xc0, 0x20, 0xc4, 0x27, 0x09, 0xa3, 0x16, 0x92, 0xc7, 0xb2, 0x8d, 0x14, 0x1c, 0x57,
+	0x14, 0xb9, 0x0c, 0xf1, 0x23, 0xa7, 0x9c, 0x10, 0x20, 0x89, 0xc2, 0x92, 0xa8, 0x01, 0xdb, 0xb5,
+	0xa9, 0x4a, 0x4d, 0x0d, 0x4c, 0x0b, 0x4d, 0x01, 0x33, 0x93, 0xe9, 0xc1, 0x36, 0xfe, 0x0f, 0xf6,
+	0x94, 0xdd, 0x43, 0xaa, 0x72, 0xda, 0x63, 0x0e, 0xc9, 0x25, 0x39, 0x25, 0x95, 0x4b, 0xae, 0x5b,
+	0x39, 0xed, 0x31, 0xa7, 0x6c, 0xca, 0xce, 0x3d, 0xf9, 0x13, 0x52, 0xfd, 0x98, 0x07, 0x03, 0x78,
+	0xe5, 0xb5, 0xf6, 0xa0, 0xd2, 0x7c, 0x8f, 0xee, 0xfe, 0xfa, 0xf7, 0xf5, 0xf7, 0xe8, 0x06, 0xf2,
+	0x5d, 0xd3, 0x7b, 0xad, 0x4f, 0xca, 0x1e, 0x21, 0xf4, 0xaf, 0xe4, 0xb8, 0xb6, 0x67, 0x23, 0xe0,
+	0xdc, 0x92, 0x47, 0xc8, 0x4e, 0xbe, 0x6f, 0xf7, 0x6d, 0xc6, 0x2e, 0xd3, 0x2f, 0xae, 0xb1, 0x53,
+	0xe8, 0xdb, 0x76, 0x7f, 0x88, 0xcb, 0x8c, 0xea, 0x8e, 0x2f, 0xca, 0xc6, 0xd8, 0xd5, 0x3d, 0xd3,
+	0xb6, 0x84, 0x7c, 0x37, 0x2e, 0xf7, 0xcc, 0x11, 0x26, 0x9e, 0x3e, 0x72, 0xfc, 0x09, 0x7a, 0x36,
+	0x19, 0xd9, 0xa4, 0xdc, 0xd5, 0x09, 0x2e, 0xbf, 0x7a, 0xd4, 0xc5, 0x9e, 0xfe, 0xa8, 0xdc, 0xb3,
+	0x4d, 0x7f, 0x82, 0x9b, 0x11, 0xc3, 0x1c, 0xdd, 0xd5, 0x47, 0xc2, 0xb6, 0xe2, 0x7f, 0x13, 0x00,
+	0xb5, 0xe6, 0xb1, 0x8a, 0x7f, 0x3d, 0xc6, 0xc4, 0x43, 0x59, 0x48, 0x98, 0x86, 0x22, 0xed, 0x49,
+	0xfb, 0xb2, 0x9a, 0x30, 0x0d, 0xb4, 0x0d, 0xcb, 0x23, 0xdb, 0x18, 0x0f, 0xb1, 0x92, 0xd8, 0x93,
+	0xf6, 0x33, 0xaa, 0xa0, 0x10, 0x02, 0xd9, 0x9b, 0x38, 0x58, 0x49, 0x32, 0x2e, 0xfb, 0xa6, 0xba,
+	0xa6, 0xe5, 0x61, 0xcb, 0x53, 0xe4, 0x3d, 0x69, 0x3f, 0xa5, 0x0a, 0x0a, 0x15, 0x61, 0xcd, 0xd1,
+	0x5d, 0xcf, 0xec, 0x99, 0x8e, 0x6e, 0x79, 0x44, 0x49, 0xed, 0x25, 0xf7, 0x33, 0xea, 0x14, 0x0f,
+	0xdd, 0x81, 0x8c, 0x77, 0xe9, 0x62, 0x72, 0x69, 0x0f, 0x0d, 0x65, 0x79, 0x4f, 0xda, 0x5f, 0x57,
+	0x43, 0x06, 0xba, 0x0b, 0xd0, 0xd5, 0xbd, 0xde, 0xa5, 0x46, 0xcc, 0xb7, 0x58, 0x49, 0x73, 0x31,
+	0xe3, 0xb4, 0xcd, 0xb7, 0x18, 0x9d, 0xc2, 0x0d, 0xfc, 0xc6, 0x31, 0x39, 0x62, 0x1a, 0x85, 0x46,
+	0x59, 0xd9, 0x93, 0xf6, 0x57, 0x1f, 0xef, 0x94, 0x38, 0x6e, 0x25, 0x1f, 0xb7, 0x52, 0xc7, 0xc7,
+	0xed, 0x70, 0xe5, 0xeb, 0x7f, 0xed, 0x2e, 0x7d, 0xf1, 0xed, 0xae, 0xa4, 0x66, 0xc3, 0xc1, 0x54,
+	0x8c, 0x1e, 0xc2, 0x32, 0xf1, 0x74, 0x6f, 0x4c, 0x94, 0xcc, 0x9e, 0xb4, 0x9f, 0x7d, 0xbc, 0x55,
+	0x0a, 0xfd, 0x57, 0xaa, 0x35, 0x8f, 0xdb, 0x4c, 0xa8, 0x0a, 0x25, 0x54, 0x82, 0x95, 0x01, 0x9e,
+	0x68, 0x0c, 0x0e, 0x60, 0x03, 0x36, 0xa3, 0x03, 0x9a, 0x78, 0xd2, 0x99, 0x38, 0x58, 0x4d, 0x0f,
+	0xf8, 0x47, 0xf1, 0x3f, 0x12, 0xac, 0xd7, 0x9a, 0xc7, 0x55, 0x7b, 0xe4, 0x0c, 0x31, 0x5d, 0x74,
+	0x1e, 0xe8, 0x04, 0x5b, 0x06, 0x76, 0x7d, 0xd0, 0x39, 0x85, 0x6e, 0xc1, 0x8a, 0x33, 0xee, 0x6a,
+	0x03, 0x3c, 0x21, 0x4a, 0x92, 0x81, 0x98, 0x76, 0xc6, 0xdd, 0x26, 0x9e, 0x10, 0xf4, 0x53, 0xc8,
+	0xf5, 0x6c, 0x8b, 0x60, 0x8b, 0x8c, 0x89, 0xe6, 0x8c, 0xbb, 0x03, 0x3c, 0x61, 0x5e, 0xc8, 0xa8,
+	0x37, 0x02, 0x7e, 0x8b, 0xb1, 0x29, 0xd4, 0xc4, 0xec, 0x5b, 0xba, 0x37, 0x76, 0xb1, 0x92, 0x62,
+	0x3a, 0x21, 0x83, 0xae, 0xed, 0xb8, 0xb6, 0x7d, 0x41, 0x94, 0x65, 0xb6, 0x82, 0xa0, 0x50, 0x19,
+	0x36, 0x5f, 0x61, 0xd7, 0xbc, 0x30, 0x7b, 0x1c, 0x65, 0x72, 0xa9, 0xbb, 0x98, 0x28, 0x69, 0xa6,
+	0x84, 0xa2, 0xa2, 0x36, 0x93, 0x14, 0x27, 0x90, 0x6d, 0x9b, 0x7d, 0xcb, 0xb4, 0xfa, 0xe7, 0x0e,
+	0x65, 0x13, 0x94, 0x87, 0x94, 0xf7, 0x1a, 0xeb, 0x03, 0xb6, 0xd3, 0x8c, 0xca, 0x09, 0xca, 0xb5,
+	0x6c, 0xab, 0xe7, 0x1f, 0x30, 0x4e, 0xa0, 0x7b, 0xb0, 0xae, 0x1b, 0xba, 0xe3, 0xd9, 0xae, 0xe6,
+	0xd8, 0xa6, 0xe5, 0x89, 0x83, 0xb6, 0x26, 0x98, 0x2d, 0xca, 0xa3, 0xb6, 0x32, 0x6d, 0xa2, 0xc8,
+	0xdc, 0x56, 0x4e, 0x15, 0xff, 0x28, 0x07, 0x6b, 0x7f, 0xec, 0xb9, 0xbe, 0x0d, 0x19, 0xd2, 0xb3,
+	0x1d, 0x6c, 0x68, 0xa6, 0x21, 0xd6, 0x5c, 0xe1, 0x8c, 0x86, 0x81, 0x1e, 0x88, 0x43, 0x2f, 0x33,
+	0x2f, 0xdf, 0x8c, 0x7a, 0x59, 0x2c, 0xc7, 0x3c, 0x1d, 0x8f, 0x86, 0xd4, 0x54, 0x34, 0xdc, 0x84,
+	0xb4, 0x70, 0x22, 0x3b, 0xe7, 0x14, 0x61, 0xe6, 0x43, 0x7a, 0xc8, 0x89, 0xd9, 0xd7, 0x2e, 0x75,
+	0x72, 0x19, 0x00, 0x4b, 0x1d, 0x73, 0xc2, 0x18, 0xe8, 0x29, 0xa4, 0x6d, 0x0e, 0x64, 0x70, 0xb8,
+	0x67, 0xd7, 0x17, 0x50, 0xab, 0xbe, 0x2a, 0x6a, 0xc0, 0x7a, 0xcf, 0xc5, 0x91, 0xc0, 0xc8, 0x7c,
+	0x44, 0x60, 0xac, 0xf9, 0x43, 0xa9, 0x10, 0x3d, 0x0a, 0xc2, 0x82, 0x9f, 0xf2, 0x5b, 0x73, 0xd6,
+	0x8f, 0x85, 0xc6, 0x9c, 0xc0, 0x5c, 0xfd, 0x84, 0xc0, 0xdc, 0x85, 0x55, 0xc7, 0xc5, 0xaf, 0x4c,
+	0x7b, 0x4c, 0xa8, 0x7b, 0xd6, 0x98, 0x37, 0xc1, 0x67, 0x35, 0x0c, 0x1a, 0x20, 0x3c, 0x4f, 0x98,
+	0x86, 0xb2, 0xce, 0xa4, 0x69, 0x46, 0x37, 0x0c, 0xa4, 0x40, 0xda, 0xc5, 0x9e, 0x6b, 0x62, 0xa2,
+	0x64, 0x59, 0xfe, 0xf0, 0xc9, 0xe2, 0x3f, 0x24, 0xc8, 0x09, 0xf3, 0x0f, 0x99, 0xb2, 0x87, 0x47,
+	0x81, 0xab, 0xa5, 0xab, 0xb8, 0x3a, 0xe2, 0xd2, 0xc4, 0x07, 0x5c, 0x9a, 0xfc, 0x80, 0x4b, 0xe5,
+	0xab, 0xbb, 0x74, 0xea, 0x88, 0xa6, 0xa6, 0x8f, 0x68, 0xb1, 0x05, 0x37, 0x0e, 0x79, 0x5e, 0x14,
+	0x01, 0x4d, 0x84, 0x11, 0x74, 0x2a, 0x2d, 0x08, 0x81, 0x8c, 0xe0, 0x34, 0x0c, 0x54, 0xe0, 0x62,
+	0xae, 0xac, 0x24, 0x98, 0x8d, 0x11, 0x4e, 0xf1, 0x2f, 0x49, 0x58, 0x8b, 0xc2, 0x73, 0x3d, 0xa1,
+	0xb4, 0xa8, 0x56, 0xec, 0xc2, 0x6a, 0x68, 0x2c, 0x2f, 0x15, 0xb2, 0x0a, 0x81, 0xb5, 0x04, 0xfd,
+	0x62, 0xca, 0x5c, 0x9a, 0xa3, 0x56, 0x1f, 0xdf, 0x8e, 0xc2, 0x16, 0xdb, 0x7e, 0x74, 0x2f, 0xb3,
+	0xd1, 0x90, 0xbe, 0x86, 0x68, 0x58, 0xf9, 0x84, 0x68, 0xc8, 0x5c, 0x5f, 0x34, 0x40, 0x3c, 0x1a,
+	0x8a, 0x9f, 0x4b, 0xb0, 0x2d, 0x2c, 0xa9, 0xf4, 0x06, 0x96, 0xfd, 0x7a, 0x88, 0x8d, 0x3e, 0x1e,
+	0x51, 0x98, 0xaf, 0x5a, 0x71, 0xe6, 0x95, 0x95, 0xe4, 0x15, 0xca, 0x8a, 0x1c, 0x2b, 0x2b, 0xc5,
+	0x2f, 0x13, 0x90, 0x6b, 0xd1, 0x82, 0xaf, 0x0f, 0x03, 0xdf, 0xfc, 0x10, 0x56, 0x2c, 0x28, 0x09,
+	0xe8, 0x21, 0x20, 0x87, 0x2f, 0xaf, 0x45, 0x8e, 0x0f, 0xef, 0x44, 0x36, 0x9c, 0x98, 0x61, 0x64,
+	0x7a, 0x33, 0xcb, 0xf1, 0x1a, 0xa9, 0x40, 0x9a, 0x12, 0xd8, 0xf5, 0xd3, 0xb4, 0x4f, 0xa2, 0x07,
+	0xb0, 0xa1, 0xf7, 0xfb, 0x2e, 0xee, 0xeb, 0x1e, 0x36, 0x34, 0x61, 0xc9, 0x0a, 0xd3, 0xc9, 0x85,
+	0x82, 0x33, 0x5e, 0xa6, 0xaa, 0x80, 0x5e, 0xcc, 0xd4, 0x4d, 0xb4, 0x05, 0xcb, 0xc6, 0x20, 0x12,
+	0xaa, 0x29, 0x63, 0xd0, 0xe7, 0x01, 0x23, 0x4a, 0x2e, 0x0f, 0x51, 0x41, 0x15, 0x9f, 0x43, 0xa6,
+	0xcd, 0x16, 0x6f, 0xe3, 0x59, 0xb7, 0x46, 0x0c, 0x4d, 0x4c, 0x1b, 0x5a, 0x00, 0x08, 0xed, 0x61,
+	0x60, 0xae, 0xa8, 0x11, 0x4e, 0xf1, 0xef, 0x09, 0xd8, 0x50, 0xf1, 0x05, 0x6d, 0xc0, 0x3e, 0x50,
+	0x45, 0x43, 0x5b, 0x13, 0x51, 0x5b, 0x1f, 0x41, 0xde, 0xc5, 0x23, 0xfb, 0x15, 0x36, 0xb4, 0xa9,
+	0xc6, 0x8f, 0x27, 0xc0, 0x4d, 0x21, 0x6b, 0x45, 0x44, 0xf3, 0x62, 0x43, 0xfe, 0x84, 0xd8, 0x78,
+	0x1a, 0x44, 0x67, 0x8a, 0x45, 0xe7, 0x9d, 0x68, 0x74, 0x86, 0xfb, 0x8a, 0x05, 0xe8, 0x43, 0x40,
+	0xba, 0x61, 0xc4, 0xad, 0xe6, 0x7d, 0xd0, 0x06, 0x93, 0xb4, 0x16, 0xf6, 0xac, 0xe9, 0x58, 0xcf,
+	0x5a, 0xfc, 0xab, 0x04, 0xf9, 0x70, 0xa5, 0xef, 0xd1, 0xed, 0x5d, 0x57, 0xec, 0x2d, 0x6a, 0xdd,
+	0x52, 0x0b, 0x5b, 0xb7, 0x3f, 0xa7, 0x60, 0x3b, 0xb2, 0x53, 0x15, 0x0f, 0x4d, 0xbd, 0x6b, 0x0e,
+	0x4d, 0x6f, 0x32, 0xd7, 0x28, 0x69, 0xbe, 0x51, 0xf7, 0x21, 0xdb, 0xe3, 0xbb, 0xc6, 0x86, 0x66,
+	0x0c, 0xfa, 0x44, 0x1c, 0x92, 0xf5, 0x80, 0x5b, 0x1b, 0xf4, 0x09, 0x4d, 0x63, 0x23, 0x93, 0x10,
+	0x5f, 0x27, 0xc9, 0x74, 0x80, 0xb3, 0x98, 0xc2, 0x13, 0xd8, 0x0a, 0xe7, 0x71, 0x03, 0x44, 0x79,
+	0xcd, 0x94, 0xd5, 0x7c, 0x20, 0x0c, 0xd1, 0x66, 0xae, 0x14, 0xb3, 0x46, 0x47, 0xa4, 0xd8, 0x88,
+	0x0d, 0x2e, 0x89, 0xaa, 0x3f, 0x81, 0x2d, 0x3d, 0x4c, 0x91, 0x86, 0x26, 0x0a, 0x0e, 0x61, 0xb1,
+	0x2f, 0xab, 0xf9, 0xa8, 0x50, 0xa4, 0x54, 0x82, 0x7e, 0x02, 0x37, 0xc4, 0x1a, 0x81, 0x7a, 0x9a,
+	0xa9, 0x67, 0x39, 0x3b, 0x50, 0xbc, 0x0f, 0x59, 0x07, 0x5b, 0x06, 0x2d, 0x6a, 0x17, 0xfa, 0x78,
+	0xe8, 0xf1, 0x9a, 0x21, 0xab, 0xeb, 0x82, 0x7b, 0xc4, 0x98, 0xb4, 0xe7, 0x75, 0xb0, 0xa5, 0x0f,
+	0xbd, 0x89, 0xd6, 0xb3, 0xc7, 0x96, 0xc7, 0xaa, 0x83, 0xac, 0xae, 0x09, 0x66, 0x95, 0xf2, 0xd0,
+	0x01, 0x6c, 0x0c, 0x75, 0xe2, 0xf1, 0x89, 0xb4, 0x4b, 0x6c, 0xf6, 0x2f, 0x3d, 0x96, 0xfb, 0x93,
+	0xea, 0x0d, 0x2a, 0x60, 0x73, 0x9d, 0x30, 0x36, 0x2a, 0xc1, 0x26, 0xd3, 0xf5, 0x67, 0x15, 0xda,
+	0xab, 0x4c, 0x9b, 0x4d, 0xd3, 0xe2, 0x12, 0xa1, 0xff, 0x14, 0xb6, 0x99, 0x7e, 0x08, 0x99, 0x3f,
+	0x64, 0x8d, 0x0d, 0xc9, 0x53, 0x69, 0x08, 0x9b, 0x18, 0xf5, 0x00, 0x36, 0x46, 0x58, 0x27, 0x63,
+	0x37, 0x0a, 0x04, 0xef, 0xbe, 0x72, 0xbe, 0x20, 0x80, 0xe2, 0x25, 0x6c, 0x79, 0xb6, 0x27, 0xb2,
+	0x30, 0x5d, 0x60, 0xa8, 0x7b, 0xd8, 0xea, 0x4d, 0x58, 0x53, 0xb6, 0xfa, 0xf8, 0xd6, 0x4c, 0xb4,
+	0xd7, 0xc4, 0x45, 0x98, 0x07, 0xfb, 0xef, 0x68, 0xb0, 0x6f, 0xb2, 0x19, 0xc4, 0x94, 0xcf, 0xf8,
+	0xf8, 0xa2, 0x05, 0xd9, 0xc8, 0x91, 0xa5, 0xc9, 0xb0, 0x16, 0xbb, 0x76, 0x4a, 0x7b, 0xc9, 0x78,
+	0x8b, 0x55, 0x6b, 0x1e, 0x47, 0x06, 0x1d, 0xca, 0x74, 0x89, 0xd8, 0xc5, 0x74, 0x1b, 0x96, 0x05,
+	0x06, 0x09, 0x86, 0x81, 0xa0, 0x8a, 0x6f, 0x20, 0x5b, 0xb5, 0x47, 0x23, 0xd3, 0xf3, 0xf3, 0xf9,
+	0xa2, 0xc4, 0x9d, 0x87, 0x94, 0x69, 0x19, 0xf8, 0x8d, 0x9f, 0x22, 0x19, 0x11, 0xde, 0x7a, 0x92,
+	0xb1, 0x5b, 0x4f, 0x1f, 0x5b, 0x58, 0x64, 0x41, 0xd3, 0x10, 0x47, 0x7c, 0x2d, 0x64, 0x36, 0x8c,
+	0xe2, 0x97, 0x12, 0x80, 0xd8, 0xfc, 0x11, 0x9e, 0x2d, 0xa2, 0x79, 0x48, 0x39, 0xfa, 0x24, 0xc8,
+	0x26, 0x9c, 0x40, 0xbf, 0x82, 0xe4, 0x05, 0xc6, 0x2c, 0x03, 0x53, 0x94, 0xf9, 0x6b, 0x41, 0x89,
+	0xbe, 0x16, 0x94, 0xc4, 0x6b, 0x41, 0xa9, 0x6a, 0x9b, 0xd6, 0xe1, 0xcf, 0x28, 0x04, 0x7f, 0xf8,
+	0x76, 0x77, 0xbf, 0x6f, 0x7a, 0x97, 0xe3, 0x6e, 0xa9, 0x67, 0x8f, 0xca, 0xe2, 0x69, 0x81, 0xff,
+	0x7b, 0x48, 0x8c, 0x41, 0x99, 0x76, 0xbd, 0x84, 0x0d, 0x20, 0x2a, 0x9d, 0xb7, 0xf8, 0x7b, 0x09,
+	0xd0, 0x54, 0xc6, 0x78, 0xad, 0xbb, 0x06, 0xf9, 0x98, 0x6c, 0x81, 0x69, 0x7f, 0xce, 0x46, 0x29,
+	0x89, 0xeb, 0x37, 0xd2, 0x9f, 0xbb, 0xf8, 0xb7, 0x04, 0xac, 0x36, 0xf1, 0x44, 0xb5, 0x3d, 0x06,
+	0x67, 0xa4, 0x79, 0x95, 0xe6, 0xbe, 0x6f, 0x24, 0x22, 0xef, 0x1b, 0x3f, 0x0f, 0x8a, 0x4a, 0x92,
+	0x15, 0x95, 0xbb, 0xb1, 0x6b, 0xbe, 0x3f, 0x69, 0xac, 0xaa, 0xa8, 0x80, 0x78, 0x54, 0xd9, 0xde,
+	0xf7, 0xac, 0x6e, 0x39, 0x16, 0x77, 0x62, 0x38, 0x55, 0xa0, 0xe5, 0xd2, 0xc5, 0x9e, 0xe9, 0xb2,
+	0x6e, 0x8e, 0x4f, 0x98, 0xfa, 0x98, 0x72, 0x19, 0x0e, 0x66, 0xd3, 0xdd, 0x87, 0x6c, 0x24, 0xe6,
+	0x4d, 0x83, 0x17, 0x3d, 0x59, 0x5d, 0x0f, 0xb9, 0x0d, 0x83, 0x14, 0x7f, 0x23, 0xc1, 0xe6, 0xb1,
+	0xfd, 0x2a, 0xe8, 0x93, 0x3e, 0xb2, 0x2d, 0x40, 0x20, 0x3b, 0xa4, 0xeb, 0x5f, 0xe5, 0xd9, 0x77,
+	0xec, 0x72, 0x22, 0x7f, 0xf8, 0x72, 0x92, 0x9a, 0xb9, 0x9c, 0xfc, 0x4f, 0x0a, 0x2e, 0x27, 0x14,
+	0x75, 0xb2, 0xd0, 0x9f, 0x0f, 0x22, 0xfe, 0xfc, 0xce, 0xfb, 0xdc, 0x1d, 0xc8, 0xb8, 0x7c, 0x6b,
+	0xa2, 0x37, 0x92, 0xd5, 0x90, 0x41, 0x97, 0xa0, 0x16, 0x60, 0xdf, 0x5c, 0x41, 0xd1, 0xfb, 0x0e,
+	0x75, 0x84, 0xa1, 0xd9, 0x63, 0x4f, 0x54, 0x9a, 0x15, 0xc6, 0x38, 0x1f, 0x7b, 0xe8, 0x04, 0xd6,
+	0x79, 0xde, 0xf3, 0xf3, 0xdd, 0xf2, 0xd5, 0xf3, 0xdd, 0x1a, 0x1b, 0xe9, 0x27, 0xba, 0xaf, 0xa4,
+	0xa9, 0x4c, 0x47, 0xaf, 0x99, 0x8b, 0x5b, 0xc6, 0x2b, 0xbf, 0xdd, 0x45, 0x1f, 0xb1, 0xe4, 0xef,
+	0x7e, 0xc4, 0x9a, 0x7a, 0x8a, 0x4a, 0x4d, 0x3d, 0x45, 0x1d, 0x7c, 0x2e, 0x41, 0x26, 0x78, 0x25,
+	0x43, 0x3b, 0xb0, 0x5d, 0x6b, 0x1e, 0x6b, 0xed, 0x4e, 0xa5, 0xf3, 0xbc, 0xad, 0x3d, 0x3f, 0x6b,
+	0xb7, 0xea, 0xd5, 0xc6, 0x51, 0xa3, 0x5e, 0xcb, 0x2d, 0xa1, 0x6d, 0x40, 0x11, 0x59, 0xab, 0x7e,
+	0x56, 0x6b, 0x9c, 0x1d, 0xe7, 0x24, 0xa4, 0x40, 0x3e, 0xc2, 0xaf, 0x9e, 0x9f, 0xb6, 0x9e, 0xd5,
+	0x3b, 0xf5, 0x5a, 0x2e, 0x81, 0xb6, 0x60, 0x23, 0x22, 0x39, 0xaa, 0x34, 0x9e, 0xd5, 0x6b, 0xb9,
+	0x24, 0xba, 0x09, 0x9b, 0x11, 0x76, 0xa7, 0x71, 0x5a, 0xaf, 0x9d, 0x3f, 0xef, 0xe4, 0xe4, 0x83,
+	0x27, 0x90, 0x16, 0xa6, 0xa3, 0x3c, 0xe4, 0x9a, 0xf5, 0xcf, 0xb4, 0xce, 0x67, 0xad, 0xba, 0xd6,
+	0xae, 0x9e, 0x9c, 0x9d, 0xab, 0x6a, 0x6e, 0x09, 0x21, 0xc8, 0x06, 0xdc, 0x7a, 0xb5, 0xd6, 0xae,
+	0xe4, 0xa4, 0x83, 0xaf, 0x24, 0x58, 0x9f, 0xba, 0xc1, 0xa1, 0x02, 0xec, 0xb4, 0x1b, 0xc7, 0x67,
+	0x8d, 0xb3, 0x05, 0x1b, 0xd9, 0x81, 0xed, 0x98, 0x3c, 0xdc, 0xcc, 0x2d, 0xd8, 0x8a, 0xc9, 0x28,
+	0xc9, 0x76, 0x33, 0x2b, 0x0a, 0x76, 0x74, 0x1b, 0x6e, 0xc6, 0x44, 0x91, 0x5d, 0xfd, 0x49, 0x82,
+	0xd5, 0xc8, 0xa9, 0xa5, 0x78, 0xf9, 0xca, 0xb1, 0xed, 0xdd, 0x83, 0xdd, 0x79, 0x12, 0xed, 0x65,
+	0xa3, 0x73, 0xa2, 0x75, 0x5e, 0xd6, 0x2b, 0xcd, 0x9c, 0x84, 0xf6, 0xe1, 0xc7, 0x8b, 0x95, 0xaa,
+	0xe7, 0xa7, 0xa7, 0x8d, 0xce, 0x69, 0xfd, 0xac, 0x93, 0x4b, 0xa0, 0x3d, 0xb8, 0x33, 0x57, 0xb3,
+	0x52, 0xab, 0xb4, 0x3a, 0xe7, 0x6a, 0x2e, 0x49, 0x5d, 0x3a, 0xa5, 0xc1, 0x31, 0x95, 0x0f, 0x7e,
+	0x2b, 0x41, 0x2e, 0xde, 0x77, 0xa3, 0x1f, 0xc1, 0x5d, 0xb5, 0x7e, 0xa4, 0xd6, 0xdb, 0x27, 0x0b,
+	0x91, 0xbd, 0x0b, 0xb7, 0x66, 0x55, 0x42, 0x70, 0x77, 0xe1, 0xf6, 0xac, 0x38, 0x7a, 0x60, 0x0a,
+	0xb0, 0x33, 0xab, 0x10, 0x40, 0x99, 0x3c, 0xb0, 0x20, 0xc3, 0x3a, 0x26, 0x86, 0xe3, 0x0e, 0x6c,
+	0x1f, 0x55, 0x9e, 0x3f, 0xeb, 0x70, 0xd3, 0xa7, 0x0d, 0x41, 0x90, 0x8d, 0xc8, 0x6a, 0x4d, 0xe1,
+	0xda, 0x08, 0x2f, 0x5c, 0x27, 0x97, 0xa0, 0x38, 0x44, 0x44, 0x02, 0x92, 0x5c, 0xf2, 0xe0, 0x2d,
+	0x6c, 0xcc, 0x54, 0x0a, 0x6a, 0x24, 0x3d, 0x84, 0xea, 0x79, 0xa7, 0xd2, 0x69, 0x9c, 0x9f, 0xf9,
+	0x66, 0x56, 0xaa, 0x9d, 0xc6, 0x8b, 0x7a, 0x6e, 0x89, 0xc2, 0x3e, 0x4f, 0xce, 0x69, 0x86, 0xc3,
+	0x3d, 0xd8, 0x9d, 0xa7, 0x71, 0xfe, 0xa2, 0xae, 0x3e, 0xab, 0xb4, 0x5a, 0xcc, 0xa6, 0xc3, 0xc3,
+	0xaf, 0xdf, 0x15, 0xa4, 0x6f, 0xde, 0x15, 0xa4, 0x7f, 0xbf, 0x2b, 0x48, 0x5f, 0xbc, 0x2f, 0x2c,
+	0x7d, 0xf3, 0xbe, 0xb0, 0xf4, 0xcf, 0xf7, 0x85, 0xa5, 0x5f, 0x46, 0x0b, 0x29, 0x8f, 0xfa, 0xa1,
+	0xde, 0x25, 0xe2, 0xb3, 0xfc, 0x86, 0xff, 0x9c, 0x41, 0xcb, 0x69, 0x77, 0x99, 0x25, 0xaa, 0x27,
+	0xff, 0x1f, 0x00, 0x1f, 0xed, 0x99, 0xd1, 0xe9, 0x18, 0x00, 0x00,
+}
+
+func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTss(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.BatchSize != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Intent != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Intent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGCompletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGCompletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proofs[iNdEx])
+			copy(dAtA[i:], m.Proofs[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Proofs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nonces[iNdEx])
+			copy(dAtA[i:], m.Nonces[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Nonces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AdaptorPoint) > 0 {
+		i -= len(m.AdaptorPoint)
+		copy(dAtA[i:], m.AdaptorPoint)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.AdaptorPoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tweak) > 0 {
+		i -= len(m.Tweak)
+		copy(dAtA[i:], m.Tweak)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Tweak)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PreviousId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.PreviousId))
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTss(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTss(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTss(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SigHashes) > 0 {
+		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigHashes[iNdEx])
+			copy(dAtA[i:], m.SigHashes[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Intent != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Intent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Type != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ScopedId) > 0 {
+		i -= len(m.ScopedId)
+		copy(dAtA[i:], m.ScopedId)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ScopedId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopedId) > 0 {
+		i -= len(m.ScopedId)
+		copy(dAtA[i:], m.ScopedId)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ScopedId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTss(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SigHashes) > 0 {
+		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigHashes[iNdEx])
+			copy(dAtA[i:], m.SigHashes[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SigningId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.SigningId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.PreviousId))
+		i--
+		dAtA[i] = 0x50
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTss(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTss(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SigningIds) > 0 {
+		dAtA9 := make([]byte, len(m.SigningIds)*10)
+		var j8 int
+		for _, num := range m.SigningIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTss(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Intent != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Intent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ScopedId) > 0 {
+		i -= len(m.ScopedId)
+		copy(dAtA[i:], m.ScopedId)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ScopedId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartialSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedNonces) > 0 {
+		for iNdEx := len(m.AggregatedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AggregatedNonces[iNdEx])
+			copy(dAtA[i:], m.AggregatedNonces[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.AggregatedNonces[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PartialSignatures) > 0 {
+		for iNdEx := len(m.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartialSignatures[iNdEx])
+			copy(dAtA[i:], m.PartialSignatures[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.PartialSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nonces[iNdEx])
+			copy(dAtA[i:], m.Nonces[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Nonces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Aggregated {
+		i--
+		if m.Aggregated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AddedParticipants) > 0 {
+		for iNdEx := len(m.AddedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedParticipants[iNdEx])
+			copy(dAtA[i:], m.AddedParticipants[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.AddedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTss(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RemovedParticipants) > 0 {
+		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedParticipants[iNdEx])
+			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshingCompletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshingCompletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshingCompletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantReliability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantReliability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantReliability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TotalSigningLatency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalSigningLatency):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTss(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x72
+	if m.MeasuredSignings != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.MeasuredSignings))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LastRefreshingHeight != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.LastRefreshingHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastPenaltyHeight != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.LastPenaltyHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LastFaultHeight != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.LastFaultHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PenaltyCount != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.PenaltyCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingFaults != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.PendingFaults))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedSignings != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.MissedSignings))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AcknowledgedSignings != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.AcknowledgedSignings))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedRefreshings != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.MissedRefreshings))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedRefreshings != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.CompletedRefreshings))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedDkgs != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.MissedDkgs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompletedDkgs != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.CompletedDkgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommittedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommittedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommittedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GenerationId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.GenerationId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefreshingIds) > 0 {
+		dAtA13 := make([]byte, len(m.RefreshingIds)*10)
+		var j12 int
+		for _, num := range m.RefreshingIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTss(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x32
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RetirementTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RetirementTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTss(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastRotationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastRotationTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTss(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovSignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovSignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovSignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SigningId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.SigningId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TotalLatency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalLatency):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTss(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	if m.TimedOut != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.TimedOut))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Signed != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Signed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Requested != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Requested))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTss(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTss(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DKGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.Intent != 0 {
+		n += 1 + sovTss(uint64(m.Intent))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTss(uint64(m.Threshold))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovTss(uint64(m.BatchSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTss(uint64(m.Status))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTss(uint64(m.KeyType))
+	}
+	return n
+}
+
+func (m *DKGCompletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, s := range m.Proofs {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, s := range m.VerificationShares {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigningOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tweak)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.AdaptorPoint)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, s := range m.Nonces {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ScopedId)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTss(uint64(m.Type))
+	}
+	if m.Intent != 0 {
+		n += 1 + sovTss(uint64(m.Intent))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.SigHashes) > 0 {
+		for _, s := range m.SigHashes {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTss(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.PreviousId != 0 {
+		n += 1 + sovTss(uint64(m.PreviousId))
+	}
+	if m.BatchId != 0 {
+		n += 1 + sovTss(uint64(m.BatchId))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovTss(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *SigningBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTss(uint64(m.Type))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.SigHashes) > 0 {
+		for _, s := range m.SigHashes {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ScopedId)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigningId != 0 {
+		n += 1 + sovTss(uint64(m.SigningId))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigningBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ScopedId)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.Intent != 0 {
+		n += 1 + sovTss(uint64(m.Intent))
+	}
+	if len(m.SigningIds) > 0 {
+		l = 0
+		for _, e := range m.SigningIds {
+			l += sovTss(uint64(e))
+		}
+		n += 1 + sovTss(uint64(l)) + l
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTss(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.PreviousId != 0 {
+		n += 1 + sovTss(uint64(m.PreviousId))
+	}
+	return n
+}
+
+func (m *SigningAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	return n
+}
+
+func (m *PartialSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, s := range m.Nonces {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if len(m.PartialSignatures) > 0 {
+		for _, s := range m.PartialSignatures {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if len(m.AggregatedNonces) > 0 {
+		for _, s := range m.AggregatedNonces {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerificationShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovTss(uint64(m.DkgId))
+	}
+	if len(m.Shares) > 0 {
+		for _, s := range m.Shares {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Aggregated {
+		n += 2
+	}
+	return n
+}
+
+func (m *RefreshingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	if m.DkgId != 0 {
+		n += 1 + sovTss(uint64(m.DkgId))
+	}
+	if len(m.RemovedParticipants) > 0 {
+		for _, s := range m.RemovedParticipants {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovTss(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTss(uint64(m.Status))
+	}
+	if len(m.AddedParticipants) > 0 {
+		for _, s := range m.AddedParticipants {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTss(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *RefreshingCompletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, s := range m.VerificationShares {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantReliability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.CompletedDkgs != 0 {
+		n += 1 + sovTss(uint64(m.CompletedDkgs))
+	}
+	if m.MissedDkgs != 0 {
+		n += 1 + sovTss(uint64(m.MissedDkgs))
+	}
+	if m.CompletedRefreshings != 0 {
+		n += 1 + sovTss(uint64(m.CompletedRefreshings))
+	}
+	if m.MissedRefreshings != 0 {
+		n += 1 + sovTss(uint64(m.MissedRefreshings))
+	}
+	if m.AcknowledgedSignings != 0 {
+		n += 1 + sovTss(uint64(m.AcknowledgedSignings))
+	}
+	if m.MissedSignings != 0 {
+		n += 1 + sovTss(uint64(m.MissedSignings))
+	}
+	if m.PendingFaults != 0 {
+		n += 1 + sovTss(uint64(m.PendingFaults))
+	}
+	if m.PenaltyCount != 0 {
+		n += 1 + sovTss(uint64(m.PenaltyCount))
+	}
+	if m.LastFaultHeight != 0 {
+		n += 1 + sovTss(uint64(m.LastFaultHeight))
+	}
+	if m.LastPenaltyHeight != 0 {
+		n += 1 + sovTss(uint64(m.LastPenaltyHeight))
+	}
+	if m.LastRefreshingHeight != 0 {
+		n += 1 + sovTss(uint64(m.LastRefreshingHeight))
+	}
+	if m.MeasuredSignings != 0 {
+		n += 1 + sovTss(uint64(m.MeasuredSignings))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalSigningLatency)
+	n += 1 + l + sovTss(uint64(l))
+	return n
+}
+
+func (m *ParticipantSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTss(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CommittedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovTss(uint64(m.DkgId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTss(uint64(m.Index))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.GenerationId != 0 {
+		n += 1 + sovTss(uint64(m.GenerationId))
+	}
+	return n
+}
+
+func (m *SigningFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTss(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastRotationTime)
+	n += 1 + l + sovTss(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RetirementTime)
+	n += 1 + l + sovTss(uint64(l))
+	if len(m.RefreshingIds) > 0 {
+		l = 0
+		for _, e := range m.RefreshingIds {
+			l += sovTss(uint64(e))
+		}
+		n += 1 + sovTss(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GovSignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTss(uint64(m.Id))
+	}
+	if m.DkgId != 0 {
+		n += 1 + sovTss(uint64(m.DkgId))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.SigningId != 0 {
+		n += 1 + sovTss(uint64(m.SigningId))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigningStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTss(uint64(m.Type))
+	}
+	if m.Requested != 0 {
+		n += 1 + sovTss(uint64(m.Requested))
+	}
+	if m.Signed != 0 {
+		n += 1 + sovTss(uint64(m.Signed))
+	}
+	if m.TimedOut != 0 {
+		n += 1 + sovTss(uint64(m.TimedOut))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalLatency)
+	n += 1 + l + sovTss(uint64(l))
+	return n
+}
+
+func (m *ParticipantKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovTss(uint64(m.DkgId))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTss(uint64(m.KeyType))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTss(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTss(x uint64) (n int) {
+	return sovTss(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DKGRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			m.Intent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Intent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DKGStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGCompletion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tweak", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tweak = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptorPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SigningType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			m.Intent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Intent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &SigningOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+			}
+			m.PreviousId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningBatchItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningBatchItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SigningType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &SigningOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+			}
+			m.SigningId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			m.Intent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Intent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SigningIds = append(m.SigningIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTss
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTss
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SigningIds) == 0 {
+					m.SigningIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTss
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SigningIds = append(m.SigningIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningIds", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &BatchSignatures{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+			}
+			m.PreviousId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialSignatures = append(m.PartialSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedNonces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedNonces = append(m.AggregatedNonces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aggregated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RefreshingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedParticipants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedParticipants = append(m.AddedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantReliability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantReliability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantReliability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedDkgs", wireType)
+			}
+			m.CompletedDkgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedDkgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedDkgs", wireType)
+			}
+			m.MissedDkgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedDkgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRefreshings", wireType)
+			}
+			m.CompletedRefreshings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedRefreshings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRefreshings", wireType)
+			}
+			m.MissedRefreshings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRefreshings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedSignings", wireType)
+			}
+			m.AcknowledgedSignings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgedSignings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignings", wireType)
+			}
+			m.MissedSignings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSignings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFaults", wireType)
+			}
+			m.PendingFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingFaults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PenaltyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFaultHeight", wireType)
 			}
-			m.Id = 0
+			m.LastFaultHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1425,16 +7670,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LastFaultHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPenaltyHeight", wireType)
 			}
-			var stringLen uint64
+			m.LastPenaltyHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1444,29 +7689,54 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastPenaltyHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTss
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefreshingHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTss
+			m.LastRefreshingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRefreshingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasuredSignings", wireType)
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.MeasuredSignings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeasuredSignings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSigningLatency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1476,29 +7746,80 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TotalSigningLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Intent = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1508,16 +7829,31 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Intent |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTss
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1527,29 +7863,66 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTss
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTss
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommittedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommittedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommittedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
 			}
-			m.Threshold = 0
+			m.DkgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1559,16 +7932,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				m.DkgId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.BatchSize = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1578,16 +7951,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchSize |= uint32(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1597,30 +7970,29 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerationId", wireType)
 			}
-			m.Status = 0
+			m.GenerationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1630,7 +8002,7 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= DKGStatus(b&0x7F) << shift
+				m.GenerationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1656,7 +8028,7 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
+func (m *SigningFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1679,10 +8051,10 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGCompletion: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1706,7 +8078,7 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,13 +8106,13 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1750,25 +8122,77 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
@@ -1800,11 +8224,11 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -1814,23 +8238,25 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1853,7 +8279,7 @@ func (m *DKGCompletion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningOptions) Unmarshal(dAtA []byte) error {
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1876,15 +8302,15 @@ func (m *SigningOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tweak", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,11 +8338,11 @@ func (m *SigningOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tweak = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,95 +8370,13 @@ func (m *SigningOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorPoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTss
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTss
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTss
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdaptorPoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTss(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTss
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SigningRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTss
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Id = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2042,16 +8386,16 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Status |= KeyRotationStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRotationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2061,29 +8405,30 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastRotationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2093,48 +8438,156 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopedId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RetirementTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RefreshingIds = append(m.RefreshingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTss
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTss
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RefreshingIds) == 0 {
+					m.RefreshingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTss
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RefreshingIds = append(m.RefreshingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovSignatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTss
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= SigningType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovSignatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Intent = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2144,16 +8597,16 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Intent |= int32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
 			}
-			var stringLen uint64
+			m.DkgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2163,27 +8616,14 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DkgId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTss
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTss
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2211,13 +8651,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
+			m.Psbt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
 			}
-			var msglen int
+			m.SigningId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2227,33 +8667,16 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SigningId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTss
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTss
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Options == nil {
-				m.Options = &SigningOptions{}
-			}
-			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2263,44 +8686,24 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTss
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTss
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTss
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -2322,7 +8725,7 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
+func (m *SigningStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2345,17 +8748,17 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2365,16 +8768,29 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.DkgId = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2384,16 +8800,16 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DkgId |= uint64(b&0x7F) << shift
+				m.Type |= SigningType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParticipants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requested", wireType)
 			}
-			var stringLen uint64
+			m.Requested = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2403,27 +8819,52 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Requested |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTss
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTss
+			m.Signed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
 			}
-			m.RemovedParticipants = append(m.RemovedParticipants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			m.TimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2450,29 +8891,10 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TotalLatency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTss
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RefreshingStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -2494,7 +8916,7 @@ func (m *RefreshingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
+func (m *ParticipantKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2517,17 +8939,17 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshingCompletion: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshingCompletion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
 			}
-			m.Id = 0
+			m.DkgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTss
@@ -2537,14 +8959,14 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.DkgId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,11 +8994,11 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2604,11 +9026,30 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2636,7 +9077,7 @@ func (m *RefreshingCompletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -45,6 +47,11 @@ type MsgCompleteDKG struct {
 	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 	// hex encoded participant signature
 	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded schnorr proofs of possession for the public keys
+	Proofs []string `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// hex encoded compressed verification shares of the participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
 func (m *MsgCompleteDKG) Reset()         { *m = MsgCompleteDKG{} }
@@ -115,6 +122,20 @@ func (m *MsgCompleteDKG) GetSignature() string {
 	return ""
 }
 
+func (m *MsgCompleteDKG) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *MsgCompleteDKG) GetVerificationShares() []string {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
 // MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
 type MsgCompleteDKGResponse struct {
 }
@@ -250,30 +271,28 @@ func (m *MsgSubmitSignaturesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitSignaturesResponse proto.InternalMessageInfo
 
-// MsgRefresh defines the Msg/Refresh request type.
-type MsgRefresh struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// list of DKGs corresponding to key shares to be refreshed
-	DkgIds []uint64 `protobuf:"varint,2,rep,packed,name=dkg_ids,json=dkgIds,proto3" json:"dkg_ids,omitempty"`
-	// removed participant set
-	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
-	// timeout duration per DKG refreshing
-	TimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+// MsgSubmitBatchSignatures defines the Msg/SubmitBatchSignatures request type.
+type MsgSubmitBatchSignatures struct {
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// signing batch id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// signatures for each pending signing request of the batch
+	Signatures []*BatchSignatures `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *MsgRefresh) Reset()         { *m = MsgRefresh{} }
-func (m *MsgRefresh) String() string { return proto.CompactTextString(m) }
-func (*MsgRefresh) ProtoMessage()    {}
-func (*MsgRefresh) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitBatchSignatures) Reset()         { *m = MsgSubmitBatchSignatures{} }
+func (m *MsgSubmitBatchSignatures) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBatchSignatures) ProtoMessage()    {}
+func (*MsgSubmitBatchSignatures) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{4}
 }
-func (m *MsgRefresh) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitBatchSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitBatchSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefresh.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitBatchSignatures.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -283,62 +302,55 @@ func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgRefresh) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefresh.Merge(m, src)
+func (m *MsgSubmitBatchSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBatchSignatures.Merge(m, src)
 }
-func (m *MsgRefresh) XXX_Size() int {
+func (m *MsgSubmitBatchSignatures) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefresh) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefresh.DiscardUnknown(m)
+func (m *MsgSubmitBatchSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBatchSignatures.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefresh proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitBatchSignatures proto.InternalMessageInfo
 
-func (m *MsgRefresh) GetAuthority() string {
+func (m *MsgSubmitBatchSignatures) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRefresh) GetDkgIds() []uint64 {
+func (m *MsgSubmitBatchSignatures) GetId() uint64 {
 	if m != nil {
-		return m.DkgIds
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgRefresh) GetRemovedParticipants() []string {
+func (m *MsgSubmitBatchSignatures) GetSignatures() []*BatchSignatures {
 	if m != nil {
-		return m.RemovedParticipants
+		return m.Signatures
 	}
 	return nil
 }
 
-func (m *MsgRefresh) GetTimeoutDuration() time.Duration {
-	if m != nil {
-		return m.TimeoutDuration
-	}
-	return 0
-}
-
-// MsgRefreshResponse defines the Msg/Refresh response type.
-type MsgRefreshResponse struct {
+// MsgSubmitBatchSignaturesResponse defines the Msg/SubmitBatchSignatures response type.
+type MsgSubmitBatchSignaturesResponse struct {
 }
 
-func (m *MsgRefreshResponse) Reset()         { *m = MsgRefreshResponse{} }
-func (m *MsgRefreshResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRefreshResponse) ProtoMessage()    {}
-func (*MsgRefreshResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitBatchSignaturesResponse) Reset()         { *m = MsgSubmitBatchSignaturesResponse{} }
+func (m *MsgSubmitBatchSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBatchSignaturesResponse) ProtoMessage()    {}
+func (*MsgSubmitBatchSignaturesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{5}
 }
-func (m *MsgRefreshResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitBatchSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitBatchSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefreshResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitBatchSignaturesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -348,23 +360,23 @@ func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRefreshResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefreshResponse.Merge(m, src)
+func (m *MsgSubmitBatchSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBatchSignaturesResponse.Merge(m, src)
 }
-func (m *MsgRefreshResponse) XXX_Size() int {
+func (m *MsgSubmitBatchSignaturesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefreshResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefreshResponse.DiscardUnknown(m)
+func (m *MsgSubmitBatchSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBatchSignaturesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefreshResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitBatchSignaturesResponse proto.InternalMessageInfo
 
-// MsgCompleteRefreshing defines the Msg/CompleteRefreshing request type.
-type MsgCompleteRefreshing struct {
+// MsgAcknowledgeSigning defines the Msg/AcknowledgeSigning request type.
+type MsgAcknowledgeSigning struct {
 	// sender
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// request id
+	// signing request id
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// participant consensus pub key
 	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
@@ -372,18 +384,18 @@ type MsgCompleteRefreshing struct {
 	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgCompleteRefreshing) Reset()         { *m = MsgCompleteRefreshing{} }
-func (m *MsgCompleteRefreshing) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteRefreshing) ProtoMessage()    {}
-func (*MsgCompleteRefreshing) Descriptor() ([]byte, []int) {
+func (m *MsgAcknowledgeSigning) Reset()         { *m = MsgAcknowledgeSigning{} }
+func (m *MsgAcknowledgeSigning) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeSigning) ProtoMessage()    {}
+func (*MsgAcknowledgeSigning) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{6}
 }
-func (m *MsgCompleteRefreshing) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcknowledgeSigning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcknowledgeSigning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteRefreshing.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcknowledgeSigning.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -393,62 +405,62 @@ func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteRefreshing) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteRefreshing.Merge(m, src)
+func (m *MsgAcknowledgeSigning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeSigning.Merge(m, src)
 }
-func (m *MsgCompleteRefreshing) XXX_Size() int {
+func (m *MsgAcknowledgeSigning) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteRefreshing) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteRefreshing.DiscardUnknown(m)
+func (m *MsgAcknowledgeSigning) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeSigning.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteRefreshing proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcknowledgeSigning proto.InternalMessageInfo
 
-func (m *MsgCompleteRefreshing) GetSender() string {
+func (m *MsgAcknowledgeSigning) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgCompleteRefreshing) GetId() uint64 {
+func (m *MsgAcknowledgeSigning) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *MsgCompleteRefreshing) GetConsensusPubkey() string {
+func (m *MsgAcknowledgeSigning) GetConsensusPubkey() string {
 	if m != nil {
 		return m.ConsensusPubkey
 	}
 	return ""
 }
 
-func (m *MsgCompleteRefreshing) GetSignature() string {
+func (m *MsgAcknowledgeSigning) GetSignature() string {
 	if m != nil {
 		return m.Signature
 	}
 	return ""
 }
 
-// MsgCompleteRefreshingResponse defines the Msg/CompleteRefreshing response type.
-type MsgCompleteRefreshingResponse struct {
+// MsgAcknowledgeSigningResponse defines the Msg/AcknowledgeSigning response type.
+type MsgAcknowledgeSigningResponse struct {
 }
 
-func (m *MsgCompleteRefreshingResponse) Reset()         { *m = MsgCompleteRefreshingResponse{} }
-func (m *MsgCompleteRefreshingResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteRefreshingResponse) ProtoMessage()    {}
-func (*MsgCompleteRefreshingResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcknowledgeSigningResponse) Reset()         { *m = MsgAcknowledgeSigningResponse{} }
+func (m *MsgAcknowledgeSigningResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeSigningResponse) ProtoMessage()    {}
+func (*MsgAcknowledgeSigningResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{7}
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcknowledgeSigningResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcknowledgeSigningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteRefreshingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcknowledgeSigningResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,42 +470,50 @@ func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteRefreshingResponse.Merge(m, src)
+func (m *MsgAcknowledgeSigningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeSigningResponse.Merge(m, src)
 }
-func (m *MsgCompleteRefreshingResponse) XXX_Size() int {
+func (m *MsgAcknowledgeSigningResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteRefreshingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteRefreshingResponse.DiscardUnknown(m)
+func (m *MsgAcknowledgeSigningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeSigningResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteRefreshingResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcknowledgeSigningResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/tss parameters to be updated.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgSubmitPartialSignatures defines the Msg/SubmitPartialSignatures request type.
+type MsgSubmitPartialSignatures struct {
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// signing request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded public nonces of the participant, one for each sig hash
+	Nonces []string `protobuf:"bytes,4,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// hex encoded partial signatures, one for each sig hash
+	PartialSignatures []string `protobuf:"bytes,5,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// consensus pub keys of the participants which jointly sign in the same session
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+	// hex encoded aggregated public nonces of the signers, one for each sig hash
+	AggregatedNonces []string `protobuf:"bytes,8,rep,name=aggregated_nonces,json=aggregatedNonces,proto3" json:"aggregated_nonces,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitPartialSignatures) Reset()         { *m = MsgSubmitPartialSignatures{} }
+func (m *MsgSubmitPartialSignatures) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPartialSignatures) ProtoMessage()    {}
+func (*MsgSubmitPartialSignatures) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitPartialSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitPartialSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitPartialSignatures.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -503,50 +523,90 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgSubmitPartialSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPartialSignatures.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgSubmitPartialSignatures) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgSubmitPartialSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPartialSignatures.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitPartialSignatures proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgSubmitPartialSignatures) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgSubmitPartialSignatures) GetId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.Id
 	}
-	return Params{}
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgSubmitPartialSignatures) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitPartialSignatures) GetNonces() []string {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func (m *MsgSubmitPartialSignatures) GetPartialSignatures() []string {
+	if m != nil {
+		return m.PartialSignatures
+	}
+	return nil
+}
+
+func (m *MsgSubmitPartialSignatures) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *MsgSubmitPartialSignatures) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *MsgSubmitPartialSignatures) GetAggregatedNonces() []string {
+	if m != nil {
+		return m.AggregatedNonces
+	}
+	return nil
+}
+
+// MsgSubmitPartialSignaturesResponse defines the Msg/SubmitPartialSignatures response type.
+type MsgSubmitPartialSignaturesResponse struct {
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) Reset()         { *m = MsgSubmitPartialSignaturesResponse{} }
+func (m *MsgSubmitPartialSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPartialSignaturesResponse) ProtoMessage()    {}
+func (*MsgSubmitPartialSignaturesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8905f944056565d6, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitPartialSignaturesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -556,873 +616,4148 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPartialSignaturesResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSubmitPartialSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPartialSignaturesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitPartialSignaturesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCompleteDKG)(nil), "bitway.tss.MsgCompleteDKG")
-	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "bitway.tss.MsgCompleteDKGResponse")
-	proto.RegisterType((*MsgSubmitSignatures)(nil), "bitway.tss.MsgSubmitSignatures")
-	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "bitway.tss.MsgSubmitSignaturesResponse")
-	proto.RegisterType((*MsgRefresh)(nil), "bitway.tss.MsgRefresh")
-	proto.RegisterType((*MsgRefreshResponse)(nil), "bitway.tss.MsgRefreshResponse")
-	proto.RegisterType((*MsgCompleteRefreshing)(nil), "bitway.tss.MsgCompleteRefreshing")
-	proto.RegisterType((*MsgCompleteRefreshingResponse)(nil), "bitway.tss.MsgCompleteRefreshingResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "bitway.tss.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitway.tss.MsgUpdateParamsResponse")
+// MsgClaimRewards defines the Msg/ClaimRewards request type.
+type MsgClaimRewards struct {
+	// sender, i.e. the account of the validator operator corresponding to the participant
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-func init() { proto.RegisterFile("bitway/tss/tx.proto", fileDescriptor_8905f944056565d6) }
-
-var fileDescriptor_8905f944056565d6 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x4e, 0xdb, 0x40,
-	0x14, 0x8d, 0x93, 0x10, 0xe0, 0xa6, 0x02, 0x34, 0x50, 0x08, 0xa6, 0x38, 0x69, 0xba, 0x68, 0x60,
-	0x61, 0x17, 0xba, 0x63, 0xd7, 0x14, 0xa9, 0xaa, 0x50, 0xaa, 0xc8, 0xa8, 0x9b, 0xaa, 0x52, 0x64,
-	0xc7, 0xc3, 0xe0, 0x06, 0x7b, 0x5c, 0xdf, 0x31, 0x25, 0xbb, 0xaa, 0x5f, 0xd0, 0x4d, 0xa5, 0xfe,
-	0x42, 0x77, 0xa8, 0x5f, 0xc1, 0x92, 0x55, 0xd5, 0x55, 0x5b, 0xc1, 0x82, 0xdf, 0xa8, 0xe2, 0x57,
-	0x9c, 0x10, 0x1e, 0xab, 0x78, 0xce, 0xb9, 0x8f, 0x73, 0x67, 0xce, 0x0d, 0x2c, 0x9a, 0xb6, 0xf8,
-	0x64, 0xf4, 0x35, 0x81, 0xa8, 0x89, 0x13, 0xd5, 0xf3, 0xb9, 0xe0, 0x04, 0x22, 0x50, 0x15, 0x88,
-	0xf2, 0x12, 0xe3, 0x8c, 0x87, 0xb0, 0x36, 0xf8, 0x8a, 0x22, 0x64, 0x85, 0x71, 0xce, 0x8e, 0xa8,
-	0x16, 0x9e, 0xcc, 0xe0, 0x40, 0xb3, 0x02, 0xdf, 0x10, 0x36, 0x77, 0x63, 0x7e, 0xa5, 0xcb, 0xd1,
-	0xe1, 0xa8, 0x39, 0xc8, 0xb4, 0xe3, 0xad, 0xc1, 0x4f, 0x42, 0x64, 0xfa, 0x79, 0x86, 0x6f, 0x38,
-	0x18, 0x11, 0xf5, 0x1f, 0x12, 0xcc, 0xb5, 0x90, 0xbd, 0xe4, 0x8e, 0x77, 0x44, 0x05, 0xdd, 0xdd,
-	0x7b, 0x45, 0x96, 0xa1, 0x84, 0xd4, 0xb5, 0xa8, 0x5f, 0x91, 0x6a, 0x52, 0x63, 0x56, 0x8f, 0x4f,
-	0x64, 0x0e, 0xf2, 0xb6, 0x55, 0xc9, 0xd7, 0xa4, 0x46, 0x51, 0xcf, 0xdb, 0x16, 0x59, 0x85, 0x19,
-	0x2f, 0x30, 0x3b, 0x3d, 0xda, 0xc7, 0x4a, 0xa1, 0x56, 0x68, 0xcc, 0xea, 0xd3, 0x5e, 0x60, 0xee,
-	0xd1, 0x3e, 0x92, 0x0d, 0x58, 0xe8, 0x72, 0x17, 0xa9, 0x8b, 0x01, 0x76, 0xbc, 0xc0, 0xec, 0xd1,
-	0x7e, 0xa5, 0x18, 0x16, 0x9b, 0x4f, 0xf1, 0x76, 0x08, 0x93, 0x47, 0x30, 0x8b, 0x36, 0x73, 0x0d,
-	0x11, 0xf8, 0xb4, 0x32, 0x15, 0xc6, 0x0c, 0x81, 0x9d, 0xf2, 0x97, 0xab, 0xd3, 0xcd, 0x58, 0x40,
-	0xbd, 0x02, 0xcb, 0xa3, 0x52, 0x75, 0x8a, 0xde, 0xa0, 0x5c, 0xfd, 0x03, 0x2c, 0xb6, 0x90, 0xed,
-	0x07, 0xa6, 0x63, 0x8b, 0xfd, 0x24, 0x19, 0xef, 0x3d, 0x89, 0x02, 0x90, 0xb6, 0x4c, 0x66, 0xc9,
-	0x20, 0xa3, 0x2a, 0xd6, 0x61, 0x6d, 0x42, 0xaf, 0x54, 0xca, 0x2f, 0x09, 0xa0, 0x85, 0x4c, 0xa7,
-	0x07, 0x3e, 0xc5, 0xc3, 0xc1, 0x78, 0x46, 0x20, 0x0e, 0xb9, 0x6f, 0x8b, 0x7e, 0xac, 0x62, 0x08,
-	0x90, 0x15, 0x98, 0xb6, 0x7a, 0xac, 0x63, 0x5b, 0x58, 0xc9, 0xd7, 0x0a, 0x8d, 0xa2, 0x5e, 0xb2,
-	0x7a, 0xec, 0xb5, 0x85, 0x64, 0x0b, 0x96, 0x7c, 0xea, 0xf0, 0x63, 0x6a, 0x75, 0x3c, 0xc3, 0x17,
-	0x76, 0xd7, 0xf6, 0x0c, 0x57, 0x24, 0xda, 0x16, 0x63, 0xae, 0x9d, 0xa1, 0xc8, 0x1b, 0x58, 0x10,
-	0xb6, 0x43, 0x79, 0x20, 0x3a, 0x89, 0x2b, 0xc2, 0x3b, 0x2f, 0x6f, 0xaf, 0xaa, 0x91, 0x6d, 0xd4,
-	0xc4, 0x36, 0xea, 0x6e, 0x1c, 0xd0, 0x9c, 0x39, 0xfb, 0x53, 0xcd, 0x7d, 0xff, 0x5b, 0x95, 0xf4,
-	0xf9, 0x38, 0x39, 0xa1, 0x76, 0xe6, 0x06, 0x43, 0x0f, 0xb5, 0xd6, 0x97, 0x80, 0x0c, 0xe7, 0x4a,
-	0xc7, 0xfd, 0x26, 0xc1, 0xc3, 0xcc, 0xa3, 0xc4, 0xb4, 0xed, 0xb2, 0x7b, 0x5f, 0xfe, 0x24, 0xaf,
-	0x14, 0xee, 0xe1, 0x95, 0xe2, 0xad, 0x5e, 0xa9, 0xc2, 0xfa, 0x44, 0x59, 0xa9, 0xf0, 0x8f, 0x30,
-	0xdf, 0x42, 0xf6, 0xd6, 0xb3, 0x0c, 0x41, 0xdb, 0xe1, 0x46, 0xdc, 0xf1, 0x56, 0xcf, 0xa0, 0x14,
-	0x6d, 0x4e, 0xa8, 0xbd, 0xbc, 0x4d, 0xd4, 0xe1, 0xba, 0xaa, 0x51, 0x85, 0x66, 0x71, 0x70, 0x9d,
-	0x7a, 0x1c, 0x77, 0xed, 0x06, 0x57, 0x61, 0x65, 0xac, 0x65, 0xa2, 0x66, 0xfb, 0x67, 0x01, 0x0a,
-	0x2d, 0x64, 0xa4, 0x05, 0xe5, 0xec, 0x2a, 0xca, 0xd9, 0x1e, 0xa3, 0xde, 0x97, 0xeb, 0x37, 0x73,
-	0x49, 0x59, 0xf2, 0x1e, 0x16, 0xae, 0x2d, 0x45, 0x75, 0x2c, 0x6f, 0x3c, 0x40, 0x7e, 0x7a, 0x47,
-	0x40, 0x5a, 0xfd, 0x05, 0x4c, 0x27, 0x36, 0x5f, 0x1e, 0xcb, 0x89, 0x71, 0x59, 0x99, 0x8c, 0xa7,
-	0x25, 0x4c, 0x20, 0x13, 0xac, 0xf3, 0xf8, 0x86, 0xd1, 0x86, 0x21, 0xf2, 0xc6, 0x9d, 0x21, 0x69,
-	0x8f, 0x36, 0x3c, 0x18, 0x79, 0xe6, 0xb5, 0xb1, 0xd4, 0x2c, 0x29, 0x3f, 0xb9, 0x85, 0x4c, 0x2a,
-	0xca, 0x53, 0x9f, 0xaf, 0x4e, 0x37, 0xa5, 0x66, 0xf3, 0xec, 0x42, 0x91, 0xce, 0x2f, 0x14, 0xe9,
-	0xdf, 0x85, 0x22, 0x7d, 0xbd, 0x54, 0x72, 0xe7, 0x97, 0x4a, 0xee, 0xf7, 0xa5, 0x92, 0x7b, 0xd7,
-	0x60, 0xb6, 0x38, 0x0c, 0x4c, 0xb5, 0xcb, 0x1d, 0x2d, 0xaa, 0x77, 0x64, 0x98, 0x18, 0x7f, 0x6a,
-	0x27, 0xd1, 0xdf, 0x7e, 0xdf, 0xa3, 0x68, 0x96, 0xc2, 0x9d, 0x7c, 0xfe, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x6c, 0x45, 0x4a, 0xf6, 0x11, 0x06, 0x00, 0x00,
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{10}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgClaimRewards) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CompleteDKG completes the given DKG request by the participant.
-	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
-	// SubmitSignatures submits signatures.
-	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
-	// Refresh refreshes key shares.
-	Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error)
-	// CompleteRefreshing completes the given refreshing request by the participant.
-	CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error)
-	// UpdateParams defines a governance operation for updating the x/tss module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgClaimRewards) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgClaimRewardsResponse defines the Msg/ClaimRewards response type.
+type MsgClaimRewardsResponse struct {
+	// claimed rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{11}
 }
-
-func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
-	out := new(MsgCompleteDKGResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/CompleteDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
-	out := new(MsgSubmitSignaturesResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/SubmitSignatures", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error) {
-	out := new(MsgRefreshResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/Refresh", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
 }
-
-func (c *msgClient) CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error) {
-	out := new(MsgCompleteRefreshingResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/CompleteRefreshing", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CompleteDKG completes the given DKG request by the participant.
-	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
-	// SubmitSignatures submits signatures.
-	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
-	// Refresh refreshes key shares.
-	Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error)
-	// CompleteRefreshing completes the given refreshing request by the participant.
-	CompleteRefreshing(context.Context, *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error)
-	// UpdateParams defines a governance operation for updating the x/tss module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgRequestSignature defines the Msg/RequestSignature request type.
+type MsgRequestSignature struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// DKG id
+	DkgId uint64 `protobuf:"varint,2,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// hex encoded pub key to sign with; default to the first pub key of the DKG if empty
+	PubKey string `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signing type
+	Type SigningType `protobuf:"varint,4,opt,name=type,proto3,enum=bitway.tss.SigningType" json:"type,omitempty"`
+	// base64 encoded sig hashes; mutually exclusive with psbt
+	SigHashes []string `protobuf:"bytes,5,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	// base64 encoded psbt with witness utxos; mutually exclusive with sig hashes
+	Psbt string `protobuf:"bytes,6,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// signing options
+	Options *SigningOptions `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRequestSignature) Reset()         { *m = MsgRequestSignature{} }
+func (m *MsgRequestSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSignature) ProtoMessage()    {}
+func (*MsgRequestSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{12}
 }
-
-func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+func (m *MsgRequestSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+func (m *MsgRequestSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Refresh(ctx context.Context, req *MsgRefresh) (*MsgRefreshResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+func (m *MsgRequestSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSignature.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CompleteRefreshing(ctx context.Context, req *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteRefreshing not implemented")
+func (m *MsgRequestSignature) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRequestSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSignature.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgRequestSignature proto.InternalMessageInfo
 
-func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteDKG)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteDKG(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Msg/CompleteDKG",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
+func (m *MsgRequestSignature) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSignatures)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSignatures(ctx, in)
+func (m *MsgRequestSignature) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Msg/SubmitSignatures",
+	return 0
+}
+
+func (m *MsgRequestSignature) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
+	return ""
+}
+
+func (m *MsgRequestSignature) GetType() SigningType {
+	if m != nil {
+		return m.Type
 	}
-	return interceptor(ctx, in, info, handler)
+	return SigningType_SIGNING_TYPE_SCHNORR
 }
 
-func _Msg_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefresh)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetSigHashes() []string {
+	if m != nil {
+		return m.SigHashes
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Refresh(ctx, in)
+	return nil
+}
+
+func (m *MsgRequestSignature) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Msg/Refresh",
+	return ""
+}
+
+func (m *MsgRequestSignature) GetOptions() *SigningOptions {
+	if m != nil {
+		return m.Options
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Refresh(ctx, req.(*MsgRefresh))
+	return nil
+}
+
+// MsgRequestSignatureResponse defines the Msg/RequestSignature response type.
+type MsgRequestSignatureResponse struct {
+	// gov signature request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRequestSignatureResponse) Reset()         { *m = MsgRequestSignatureResponse{} }
+func (m *MsgRequestSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSignatureResponse) ProtoMessage()    {}
+func (*MsgRequestSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{13}
+}
+func (m *MsgRequestSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRequestSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSignatureResponse.Merge(m, src)
+}
+func (m *MsgRequestSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSignatureResponse.DiscardUnknown(m)
 }
 
-func _Msg_CompleteRefreshing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteRefreshing)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestSignatureResponse proto.InternalMessageInfo
+
+func (m *MsgRequestSignatureResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteRefreshing(ctx, in)
+	return 0
+}
+
+// MsgInitiateDKG defines the Msg/InitiateDKG request type.
+type MsgInitiateDKG struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// participant set
+	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// number of keys to be generated
+	BatchSize uint32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// key type
+	KeyType KeyType `protobuf:"varint,5,opt,name=key_type,json=keyType,proto3,enum=bitway.tss.KeyType" json:"key_type,omitempty"`
+	// timeout duration; default to the DKG timeout duration param if zero
+	TimeoutDuration time.Duration `protobuf:"bytes,6,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+}
+
+func (m *MsgInitiateDKG) Reset()         { *m = MsgInitiateDKG{} }
+func (m *MsgInitiateDKG) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDKG) ProtoMessage()    {}
+func (*MsgInitiateDKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{14}
+}
+func (m *MsgInitiateDKG) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateDKG.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Msg/CompleteRefreshing",
+}
+func (m *MsgInitiateDKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDKG.Merge(m, src)
+}
+func (m *MsgInitiateDKG) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateDKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDKG.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateDKG proto.InternalMessageInfo
+
+func (m *MsgInitiateDKG) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteRefreshing(ctx, req.(*MsgCompleteRefreshing))
+	return ""
+}
+
+func (m *MsgInitiateDKG) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgInitiateDKG) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return 0
+}
+
+func (m *MsgInitiateDKG) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitway.tss.Msg/UpdateParams",
+	return 0
+}
+
+func (m *MsgInitiateDKG) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return KeyType_KEY_TYPE_SCHNORR
+}
+
+func (m *MsgInitiateDKG) GetTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.TimeoutDuration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitway.tss.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CompleteDKG",
-			Handler:    _Msg_CompleteDKG_Handler,
-		},
-		{
-			MethodName: "SubmitSignatures",
-			Handler:    _Msg_SubmitSignatures_Handler,
-		},
-		{
-			MethodName: "Refresh",
-			Handler:    _Msg_Refresh_Handler,
-		},
-		{
-			MethodName: "CompleteRefreshing",
-			Handler:    _Msg_CompleteRefreshing_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitway/tss/tx.proto",
+// MsgInitiateDKGResponse defines the Msg/InitiateDKG response type.
+type MsgInitiateDKGResponse struct {
+	// DKG id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgInitiateDKGResponse) Reset()         { *m = MsgInitiateDKGResponse{} }
+func (m *MsgInitiateDKGResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDKGResponse) ProtoMessage()    {}
+func (*MsgInitiateDKGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{15}
 }
-
-func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgInitiateDKGResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PubKeys) > 0 {
-		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PubKeys[iNdEx])
-			copy(dAtA[i:], m.PubKeys[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgInitiateDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateDKGResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgInitiateDKGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDKGResponse.Merge(m, src)
 }
-
-func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgInitiateDKGResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgInitiateDKGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDKGResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInitiateDKGResponse proto.InternalMessageInfo
+
+func (m *MsgInitiateDKGResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRefresh defines the Msg/Refresh request type.
+type MsgRefresh struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// list of DKGs corresponding to key shares to be refreshed
+	DkgIds []uint64 `protobuf:"varint,2,rep,packed,name=dkg_ids,json=dkgIds,proto3" json:"dkg_ids,omitempty"`
+	// removed participant set
+	RemovedParticipants []string `protobuf:"bytes,3,rep,name=removed_participants,json=removedParticipants,proto3" json:"removed_participants,omitempty"`
+	// timeout duration per DKG refreshing
+	TimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+	// added participant set
+	AddedParticipants []string `protobuf:"bytes,5,rep,name=added_participants,json=addedParticipants,proto3" json:"added_participants,omitempty"`
+	// new threshold; 0 means unchanged
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRefresh) Reset()         { *m = MsgRefresh{} }
+func (m *MsgRefresh) String() string { return proto.CompactTextString(m) }
+func (*MsgRefresh) ProtoMessage()    {}
+func (*MsgRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{16}
+}
+func (m *MsgRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefresh.Merge(m, src)
+}
+func (m *MsgRefresh) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefresh.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefresh proto.InternalMessageInfo
+
+func (m *MsgRefresh) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRefresh) GetDkgIds() []uint64 {
+	if m != nil {
+		return m.DkgIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetRemovedParticipants() []string {
+	if m != nil {
+		return m.RemovedParticipants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefresh) GetTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.TimeoutDuration
+	}
+	return 0
 }
 
-func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRefresh) GetAddedParticipants() []string {
+	if m != nil {
+		return m.AddedParticipants
+	}
+	return nil
 }
 
-func (m *MsgRefresh) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefresh) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRefresh) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRefreshResponse defines the Msg/Refresh response type.
+type MsgRefreshResponse struct {
 }
 
-func (m *MsgRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
-	if err1 != nil {
-		return 0, err1
+func (m *MsgRefreshResponse) Reset()         { *m = MsgRefreshResponse{} }
+func (m *MsgRefreshResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshResponse) ProtoMessage()    {}
+func (*MsgRefreshResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{17}
+}
+func (m *MsgRefreshResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if len(m.RemovedParticipants) > 0 {
-		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedParticipants[iNdEx])
-			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DkgIds) > 0 {
-		dAtA3 := make([]byte, len(m.DkgIds)*10)
-		var j2 int
-		for _, num := range m.DkgIds {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRefreshResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRefreshResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshResponse.Merge(m, src)
 }
-
-func (m *MsgRefreshResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefreshResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRefreshResponse proto.InternalMessageInfo
+
+// MsgCompleteRefreshing defines the Msg/CompleteRefreshing request type.
+type MsgCompleteRefreshing struct {
+	// sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// participant consensus pub key
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// hex encoded participant signature
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded compressed verification shares of the refreshed participants, optional
+	// ordered by the participants for each public key
+	VerificationShares []string `protobuf:"bytes,5,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
-func (m *MsgCompleteRefreshing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCompleteRefreshing) Reset()         { *m = MsgCompleteRefreshing{} }
+func (m *MsgCompleteRefreshing) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteRefreshing) ProtoMessage()    {}
+func (*MsgCompleteRefreshing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{18}
+}
+func (m *MsgCompleteRefreshing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteRefreshing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteRefreshing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCompleteRefreshing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCompleteRefreshing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteRefreshing.Merge(m, src)
+}
+func (m *MsgCompleteRefreshing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteRefreshing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteRefreshing.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteRefreshing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCompleteRefreshing proto.InternalMessageInfo
+
+func (m *MsgCompleteRefreshing) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCompleteRefreshingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCompleteRefreshing) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCompleteRefreshingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCompleteRefreshing) GetConsensusPubkey() string {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return ""
 }
 
-func (m *MsgCompleteRefreshingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCompleteRefreshing) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCompleteRefreshing) GetVerificationShares() []string {
+	if m != nil {
+		return m.VerificationShares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCompleteRefreshingResponse defines the Msg/CompleteRefreshing response type.
+type MsgCompleteRefreshingResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCompleteRefreshingResponse) Reset()         { *m = MsgCompleteRefreshingResponse{} }
+func (m *MsgCompleteRefreshingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteRefreshingResponse) ProtoMessage()    {}
+func (*MsgCompleteRefreshingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{19}
+}
+func (m *MsgCompleteRefreshingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteRefreshingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteRefreshingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCompleteRefreshingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteRefreshingResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCompleteRefreshingResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCompleteRefreshingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteRefreshingResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_MsgCompleteRefreshingResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/tss parameters to be updated.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
-func (m *MsgCompleteDKG) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if len(m.PubKeys) > 0 {
-		for _, s := range m.PubKeys {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8905f944056565d6, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCompleteDKG)(nil), "bitway.tss.MsgCompleteDKG")
+	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "bitway.tss.MsgCompleteDKGResponse")
+	proto.RegisterType((*MsgSubmitSignatures)(nil), "bitway.tss.MsgSubmitSignatures")
+	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "bitway.tss.MsgSubmitSignaturesResponse")
+	proto.RegisterType((*MsgSubmitBatchSignatures)(nil), "bitway.tss.MsgSubmitBatchSignatures")
+	proto.RegisterType((*MsgSubmitBatchSignaturesResponse)(nil), "bitway.tss.MsgSubmitBatchSignaturesResponse")
+	proto.RegisterType((*MsgAcknowledgeSigning)(nil), "bitway.tss.MsgAcknowledgeSigning")
+	proto.RegisterType((*MsgAcknowledgeSigningResponse)(nil), "bitway.tss.MsgAcknowledgeSigningResponse")
+	proto.RegisterType((*MsgSubmitPartialSignatures)(nil), "bitway.tss.MsgSubmitPartialSignatures")
+	proto.RegisterType((*MsgSubmitPartialSignaturesResponse)(nil), "bitway.tss.MsgSubmitPartialSignaturesResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "bitway.tss.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "bitway.tss.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgRequestSignature)(nil), "bitway.tss.MsgRequestSignature")
+	proto.RegisterType((*MsgRequestSignatureResponse)(nil), "bitway.tss.MsgRequestSignatureResponse")
+	proto.RegisterType((*MsgInitiateDKG)(nil), "bitway.tss.MsgInitiateDKG")
+	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "bitway.tss.MsgInitiateDKGResponse")
+	proto.RegisterType((*MsgRefresh)(nil), "bitway.tss.MsgRefresh")
+	proto.RegisterType((*MsgRefreshResponse)(nil), "bitway.tss.MsgRefreshResponse")
+	proto.RegisterType((*MsgCompleteRefreshing)(nil), "bitway.tss.MsgCompleteRefreshing")
+	proto.RegisterType((*MsgCompleteRefreshingResponse)(nil), "bitway.tss.MsgCompleteRefreshingResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "bitway.tss.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitway.tss.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("bitway/tss/tx.proto", fileDescriptor_8905f944056565d6) }
+
+var fileDescriptor_8905f944056565d6 = []byte{
+	// 1289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x37, 0x65, 0xfd, 0xb0, 0x9f, 0x12, 0xc7, 0x39, 0x3b, 0x16, 0x43, 0x7f, 0x23, 0x3b, 0x4a,
+	0xf0, 0x8d, 0x92, 0x34, 0x64, 0xec, 0x76, 0x4a, 0xa7, 0x28, 0x01, 0xda, 0x20, 0x50, 0x2a, 0x30,
+	0xed, 0x52, 0x14, 0x10, 0x48, 0xf1, 0x4c, 0xb1, 0x92, 0x48, 0x9a, 0x77, 0xb4, 0xa3, 0x4c, 0x41,
+	0xb7, 0x02, 0x1d, 0xba, 0x14, 0xe8, 0xd6, 0xa1, 0x4b, 0xd1, 0x29, 0x7f, 0x45, 0x91, 0x31, 0x63,
+	0xa7, 0xa6, 0xb0, 0x87, 0xec, 0x05, 0xba, 0x17, 0xbc, 0x23, 0x29, 0x92, 0xa2, 0x2c, 0xd5, 0x4b,
+	0x27, 0x91, 0xef, 0x7d, 0x78, 0xf7, 0xb9, 0xcf, 0x7b, 0xf7, 0xde, 0x13, 0x6c, 0xe8, 0x16, 0x3d,
+	0xd6, 0xc6, 0x0a, 0x25, 0x44, 0xa1, 0x2f, 0x64, 0xd7, 0x73, 0xa8, 0x83, 0x80, 0x1b, 0x65, 0x4a,
+	0x88, 0xb4, 0x69, 0x3a, 0xa6, 0xc3, 0xcc, 0x4a, 0xf0, 0xc4, 0x11, 0x52, 0xdd, 0x74, 0x1c, 0x73,
+	0x88, 0x15, 0xf6, 0xa6, 0xfb, 0x07, 0x8a, 0xe1, 0x7b, 0x1a, 0xb5, 0x1c, 0x3b, 0xf4, 0xd7, 0x7a,
+	0x0e, 0x19, 0x39, 0x44, 0x19, 0x11, 0x53, 0x39, 0xda, 0x0b, 0x7e, 0xa2, 0x0f, 0x43, 0x87, 0xae,
+	0x11, 0xac, 0x1c, 0xed, 0xe9, 0x98, 0x6a, 0x7b, 0x4a, 0xcf, 0xb1, 0xe2, 0x0f, 0x13, 0x7c, 0x5c,
+	0xcd, 0xd3, 0x46, 0x24, 0x74, 0x6c, 0x26, 0x89, 0x92, 0xd0, 0xda, 0xf8, 0x4b, 0x80, 0xb5, 0x36,
+	0x31, 0x1f, 0x39, 0x23, 0x77, 0x88, 0x29, 0x7e, 0xfc, 0xf4, 0x13, 0xb4, 0x05, 0x65, 0x82, 0x6d,
+	0x03, 0x7b, 0xa2, 0xb0, 0x2b, 0x34, 0x57, 0xd5, 0xf0, 0x0d, 0xad, 0x41, 0xc1, 0x32, 0xc4, 0xc2,
+	0xae, 0xd0, 0x2c, 0xaa, 0x05, 0xcb, 0x40, 0x57, 0x61, 0xc5, 0xf5, 0xf5, 0xee, 0x00, 0x8f, 0x89,
+	0xb8, 0xbc, 0xbb, 0xdc, 0x5c, 0x55, 0x2b, 0xae, 0xaf, 0x3f, 0xc5, 0x63, 0x82, 0x6e, 0xc3, 0x7a,
+	0xcf, 0xb1, 0x09, 0xb6, 0x89, 0x4f, 0xba, 0xae, 0xaf, 0x0f, 0xf0, 0x58, 0x2c, 0xb2, 0xc5, 0x2e,
+	0xc5, 0xf6, 0x0e, 0x33, 0xa3, 0xff, 0xc1, 0x2a, 0xb1, 0x4c, 0x5b, 0xa3, 0xbe, 0x87, 0xc5, 0x12,
+	0xc3, 0x4c, 0x0c, 0x01, 0x17, 0xd7, 0x73, 0x9c, 0x03, 0x22, 0x96, 0xd9, 0x0e, 0xe1, 0x1b, 0x52,
+	0x60, 0xe3, 0x08, 0x7b, 0xd6, 0x81, 0xd5, 0x63, 0xa2, 0x75, 0x49, 0x5f, 0xf3, 0x30, 0x11, 0x2b,
+	0x0c, 0x84, 0x92, 0xae, 0xe7, 0xcc, 0xf3, 0xa0, 0xfa, 0xcd, 0xfb, 0xd7, 0x77, 0xc2, 0x93, 0x34,
+	0x44, 0xd8, 0x4a, 0x9f, 0x59, 0xc5, 0xc4, 0x0d, 0x78, 0x35, 0xbe, 0x86, 0x8d, 0x36, 0x31, 0x9f,
+	0xfb, 0xfa, 0xc8, 0xa2, 0xcf, 0x23, 0x16, 0x64, 0x61, 0x49, 0xea, 0x00, 0x31, 0xf7, 0x48, 0x94,
+	0x84, 0x25, 0xcd, 0xe2, 0x1a, 0x6c, 0xe7, 0xec, 0x15, 0x53, 0xf9, 0x4e, 0x00, 0x31, 0xf6, 0xb7,
+	0x34, 0xda, 0xeb, 0x9f, 0x83, 0xd0, 0xc7, 0x53, 0x84, 0xaa, 0xfb, 0xdb, 0xf2, 0x24, 0x3b, 0xe5,
+	0xcc, 0xc2, 0xb3, 0xd9, 0x36, 0x60, 0x77, 0x16, 0x9b, 0x98, 0xf2, 0x0f, 0x02, 0x5c, 0x69, 0x13,
+	0xf3, 0x61, 0x6f, 0x60, 0x3b, 0xc7, 0x43, 0x6c, 0x98, 0x38, 0x00, 0x59, 0xb6, 0xb9, 0x30, 0xdf,
+	0xbc, 0xc4, 0x59, 0x5e, 0x20, 0x71, 0x8a, 0x99, 0xc4, 0x49, 0x73, 0xdf, 0x81, 0x6b, 0xb9, 0xb4,
+	0x62, 0xe2, 0xbf, 0x14, 0x40, 0x8a, 0x4f, 0xd7, 0xd1, 0x3c, 0x6a, 0x69, 0xc3, 0x73, 0xa8, 0xfd,
+	0x2f, 0xd8, 0x6f, 0x41, 0xd9, 0x76, 0xec, 0x1e, 0x26, 0x62, 0x91, 0x27, 0x36, 0x7f, 0x43, 0xf7,
+	0x00, 0xb9, 0x7c, 0xff, 0x6e, 0x22, 0x70, 0x25, 0x86, 0xb9, 0xec, 0x4e, 0x31, 0x4b, 0x89, 0x50,
+	0xce, 0xde, 0x1e, 0x11, 0x2a, 0xc1, 0x0b, 0xf6, 0xa2, 0x9b, 0x11, 0xbd, 0xa2, 0xbb, 0x70, 0x59,
+	0x33, 0x4d, 0x0f, 0x9b, 0x1a, 0xc5, 0x46, 0x37, 0x64, 0xb2, 0xc2, 0x30, 0xeb, 0x13, 0xc7, 0x33,
+	0x66, 0x4f, 0x6b, 0x79, 0x13, 0x1a, 0xb3, 0x95, 0x8a, 0x05, 0xd5, 0xe0, 0x52, 0x70, 0xc3, 0x86,
+	0x9a, 0x35, 0x52, 0xf1, 0xb1, 0xe6, 0x19, 0xb3, 0x45, 0xcc, 0x13, 0xad, 0x90, 0x2b, 0x5a, 0x9a,
+	0xc8, 0x2b, 0x01, 0x6a, 0x99, 0x3d, 0xa2, 0xed, 0x11, 0x86, 0x8a, 0xc7, 0x4d, 0xa2, 0xc0, 0x72,
+	0xfe, 0xaa, 0xcc, 0xcb, 0xa6, 0x1c, 0x94, 0x4d, 0x39, 0x2c, 0x9b, 0xf2, 0x23, 0xc7, 0xb2, 0x5b,
+	0xf7, 0xdf, 0xfc, 0xb1, 0xb3, 0xf4, 0xeb, 0xbb, 0x9d, 0xa6, 0x69, 0xd1, 0xbe, 0xaf, 0xcb, 0x3d,
+	0x67, 0xa4, 0x84, 0x35, 0x96, 0xff, 0xdc, 0x23, 0xc6, 0x40, 0xa1, 0x63, 0x17, 0x13, 0xf6, 0x01,
+	0x51, 0xa3, 0xb5, 0x1b, 0xdf, 0x16, 0x58, 0xb9, 0x50, 0xf1, 0xa1, 0x8f, 0xc9, 0xe4, 0x0e, 0x07,
+	0x51, 0xd1, 0x7c, 0xda, 0x77, 0x3c, 0x8b, 0x8e, 0xc3, 0xd3, 0x4e, 0x0c, 0xe8, 0x0a, 0x94, 0x8d,
+	0x81, 0xd9, 0x8d, 0x33, 0xa7, 0x64, 0x0c, 0xcc, 0x27, 0x06, 0xaa, 0x41, 0x25, 0x2c, 0xa7, 0x61,
+	0xce, 0x94, 0x79, 0x35, 0x45, 0x77, 0xa1, 0x18, 0xec, 0xce, 0x72, 0x7c, 0x6d, 0xbf, 0x96, 0xbc,
+	0xbd, 0x61, 0x1e, 0x7f, 0x3e, 0x76, 0xb1, 0xca, 0x40, 0xe8, 0x1a, 0xbb, 0xf0, 0xdd, 0xbe, 0x46,
+	0xfa, 0x71, 0xde, 0x04, 0x19, 0xf1, 0x29, 0x33, 0x20, 0x04, 0x45, 0x97, 0xe8, 0x34, 0x4c, 0x15,
+	0xf6, 0x8c, 0x3e, 0x82, 0x8a, 0xe3, 0x06, 0xa5, 0x32, 0xc8, 0x12, 0xa1, 0x59, 0xdd, 0x97, 0x72,
+	0xb6, 0xf8, 0x8c, 0x23, 0xd4, 0x08, 0xfa, 0x60, 0x2d, 0x88, 0xc5, 0xe4, 0x54, 0x8d, 0x7b, 0xb0,
+	0x9d, 0x23, 0x45, 0x1c, 0x11, 0x7e, 0x55, 0x84, 0xe8, 0xaa, 0x34, 0x7e, 0x2a, 0xb0, 0xbe, 0xf3,
+	0xc4, 0xb6, 0xa8, 0xa5, 0xf1, 0xbe, 0x73, 0xb6, 0x6a, 0x0d, 0xb8, 0xc0, 0xd2, 0xbf, 0x67, 0xb9,
+	0x9a, 0x4d, 0x89, 0x58, 0x60, 0x47, 0x4b, 0xd9, 0x82, 0x15, 0x68, 0xdf, 0xc3, 0xa4, 0xef, 0x0c,
+	0x0d, 0x26, 0xe2, 0x45, 0x75, 0x62, 0x08, 0xa4, 0xd1, 0x83, 0xc2, 0xd5, 0x25, 0xd6, 0x4b, 0xae,
+	0xe6, 0x45, 0x75, 0x55, 0xe7, 0xa5, 0xec, 0x25, 0x46, 0x32, 0xac, 0x0c, 0xf0, 0xb8, 0xcb, 0xa4,
+	0x2e, 0x31, 0xa9, 0x37, 0x92, 0x3a, 0x3c, 0xc5, 0x63, 0x26, 0x73, 0x65, 0xc0, 0x1f, 0xd0, 0x33,
+	0x58, 0xa7, 0xd6, 0x08, 0x3b, 0x3e, 0xed, 0x46, 0xbd, 0x9b, 0xc9, 0x1a, 0x24, 0x1b, 0x6f, 0xee,
+	0x72, 0xd4, 0xdc, 0xe5, 0xc7, 0x21, 0xa0, 0xb5, 0x12, 0x24, 0xdb, 0x8f, 0xef, 0x76, 0x04, 0xf5,
+	0x52, 0xf8, 0x71, 0xe4, 0x9a, 0x12, 0xb4, 0x09, 0x5b, 0x69, 0x81, 0x66, 0x6a, 0xf9, 0x73, 0x01,
+	0x80, 0x69, 0x7f, 0x10, 0x1c, 0x75, 0x8e, 0x8e, 0x35, 0xa8, 0xf0, 0xec, 0xe3, 0x12, 0x16, 0xd5,
+	0x32, 0x4b, 0x3f, 0x82, 0xf6, 0x60, 0xd3, 0xc3, 0x23, 0xe7, 0x08, 0x1b, 0xdd, 0x94, 0xd0, 0xbc,
+	0x8b, 0x6d, 0x84, 0xbe, 0x4e, 0x52, 0xef, 0x3c, 0x09, 0x8a, 0xe7, 0x97, 0x20, 0x28, 0x7e, 0x9a,
+	0x61, 0x64, 0x09, 0x84, 0xc5, 0x8f, 0x79, 0x3a, 0x33, 0xc3, 0x5d, 0xce, 0x84, 0x7b, 0x4a, 0xcf,
+	0x4d, 0x40, 0x13, 0x91, 0xe2, 0x42, 0xf5, 0x1b, 0x6f, 0x59, 0xd1, 0x2c, 0x10, 0xba, 0xff, 0x8b,
+	0x96, 0x35, 0x6b, 0xa6, 0x29, 0x2d, 0x36, 0xd3, 0xf0, 0x1e, 0x37, 0x7d, 0x8e, 0xf8, 0xa4, 0x87,
+	0xac, 0x24, 0x7f, 0xe1, 0x1a, 0x1a, 0xc5, 0x1d, 0x36, 0x18, 0xce, 0xc9, 0x94, 0xfb, 0x50, 0xe6,
+	0x03, 0x24, 0x3b, 0x6c, 0x75, 0x1f, 0x25, 0xaf, 0x03, 0x5f, 0xa1, 0x55, 0x0c, 0x82, 0xa9, 0x86,
+	0xb8, 0x29, 0xc9, 0xaf, 0x42, 0x2d, 0xb3, 0x65, 0xc4, 0x66, 0xff, 0xef, 0x0a, 0x2c, 0xb7, 0x89,
+	0x89, 0xda, 0x50, 0x4d, 0xce, 0x9e, 0xa9, 0xd2, 0x93, 0x9e, 0xd1, 0xa4, 0xc6, 0x6c, 0x5f, 0x7c,
+	0x35, 0xbe, 0x82, 0xf5, 0xa9, 0xe1, 0x6d, 0x27, 0xf3, 0x5d, 0x16, 0x20, 0xdd, 0x9a, 0x03, 0x88,
+	0x57, 0x1f, 0xc0, 0x95, 0xfc, 0x71, 0xec, 0x66, 0xee, 0x0a, 0x19, 0x94, 0xf4, 0xc1, 0x22, 0xa8,
+	0x78, 0x33, 0x1d, 0x50, 0xce, 0x20, 0x75, 0x3d, 0xb3, 0xc6, 0x34, 0x44, 0xba, 0x3d, 0x17, 0x12,
+	0xef, 0x71, 0x08, 0xb5, 0x59, 0x33, 0xcf, 0xff, 0x73, 0xc9, 0x4e, 0xe1, 0x24, 0x79, 0x31, 0x5c,
+	0xbc, 0x65, 0x07, 0x2e, 0xa4, 0xc6, 0x82, 0xed, 0x6c, 0x54, 0x13, 0x4e, 0xe9, 0xc6, 0x19, 0xce,
+	0x64, 0xcc, 0xa7, 0x3a, 0x70, 0x36, 0xe6, 0x59, 0x80, 0x74, 0x6b, 0x0e, 0x20, 0x5e, 0xbd, 0x0d,
+	0xd5, 0x64, 0x93, 0xca, 0x26, 0x68, 0xc2, 0x27, 0x35, 0x66, 0xfb, 0xe2, 0xe5, 0x1e, 0x42, 0x25,
+	0xaa, 0xd3, 0x5b, 0x53, 0x14, 0x98, 0x5d, 0xaa, 0xe7, 0xdb, 0x93, 0x89, 0x91, 0x53, 0xae, 0xae,
+	0xcf, 0xb8, 0x1d, 0x13, 0x88, 0x74, 0x7b, 0x2e, 0x24, 0x19, 0xa5, 0x54, 0xa5, 0xc8, 0x46, 0x29,
+	0xe9, 0x94, 0x6e, 0x9c, 0xe1, 0x8c, 0x56, 0x94, 0x4a, 0xaf, 0xde, 0xbf, 0xbe, 0x23, 0xb4, 0x5a,
+	0x6f, 0x4e, 0xea, 0xc2, 0xdb, 0x93, 0xba, 0xf0, 0xe7, 0x49, 0x5d, 0xf8, 0xfe, 0xb4, 0xbe, 0xf4,
+	0xf6, 0xb4, 0xbe, 0xf4, 0xfb, 0x69, 0x7d, 0xe9, 0xcb, 0xe4, 0xfc, 0xc5, 0xd7, 0x1b, 0x6a, 0x3a,
+	0x09, 0x1f, 0x95, 0x17, 0xfc, 0x7f, 0x6b, 0x30, 0x85, 0xe9, 0x65, 0xd6, 0x54, 0x3e, 0xfc, 0x67,
+	0x00, 0x81, 0xbd, 0x97, 0x67, 0x7b, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CompleteDKG completes the given DKG request by the participant.
+	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
+	// SubmitSignatures submits signatures.
+	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
+	// SubmitBatchSignatures submits signatures for all pending signing requests of the given batch.
+	SubmitBatchSignatures(ctx context.Context, in *MsgSubmitBatchSignatures, opts ...grpc.CallOption) (*MsgSubmitBatchSignaturesResponse, error)
+	// AcknowledgeSigning acknowledges the participation in the given signing request by the participant.
+	AcknowledgeSigning(ctx context.Context, in *MsgAcknowledgeSigning, opts ...grpc.CallOption) (*MsgAcknowledgeSigningResponse, error)
+	// SubmitPartialSignatures submits the partial signatures of the given signing request by the participant.
+	SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error)
+	// ClaimRewards claims the signing rewards of the participant by the corresponding validator operator.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// RequestSignature requests the signatures by the given DKG on behalf of the governance.
+	RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error)
+	// InitiateDKG initiates the DKG request to generate keys of the given key type on behalf of the governance.
+	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
+	// Refresh refreshes key shares.
+	Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error)
+	// CompleteRefreshing completes the given refreshing request by the participant.
+	CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/tss module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
+	out := new(MsgCompleteDKGResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/CompleteDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
+	out := new(MsgSubmitSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/SubmitSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitBatchSignatures(ctx context.Context, in *MsgSubmitBatchSignatures, opts ...grpc.CallOption) (*MsgSubmitBatchSignaturesResponse, error) {
+	out := new(MsgSubmitBatchSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/SubmitBatchSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitSignatures) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AcknowledgeSigning(ctx context.Context, in *MsgAcknowledgeSigning, opts ...grpc.CallOption) (*MsgAcknowledgeSigningResponse, error) {
+	out := new(MsgAcknowledgeSigningResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/AcknowledgeSigning", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error) {
+	out := new(MsgSubmitPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/SubmitPartialSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error) {
+	out := new(MsgRequestSignatureResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/RequestSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
+	out := new(MsgInitiateDKGResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/InitiateDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Refresh(ctx context.Context, in *MsgRefresh, opts ...grpc.CallOption) (*MsgRefreshResponse, error) {
+	out := new(MsgRefreshResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CompleteRefreshing(ctx context.Context, in *MsgCompleteRefreshing, opts ...grpc.CallOption) (*MsgCompleteRefreshingResponse, error) {
+	out := new(MsgCompleteRefreshingResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/CompleteRefreshing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitway.tss.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CompleteDKG completes the given DKG request by the participant.
+	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
+	// SubmitSignatures submits signatures.
+	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
+	// SubmitBatchSignatures submits signatures for all pending signing requests of the given batch.
+	SubmitBatchSignatures(context.Context, *MsgSubmitBatchSignatures) (*MsgSubmitBatchSignaturesResponse, error)
+	// AcknowledgeSigning acknowledges the participation in the given signing request by the participant.
+	AcknowledgeSigning(context.Context, *MsgAcknowledgeSigning) (*MsgAcknowledgeSigningResponse, error)
+	// SubmitPartialSignatures submits the partial signatures of the given signing request by the participant.
+	SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error)
+	// ClaimRewards claims the signing rewards of the participant by the corresponding validator operator.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// RequestSignature requests the signatures by the given DKG on behalf of the governance.
+	RequestSignature(context.Context, *MsgRequestSignature) (*MsgRequestSignatureResponse, error)
+	// InitiateDKG initiates the DKG request to generate keys of the given key type on behalf of the governance.
+	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
+	// Refresh refreshes key shares.
+	Refresh(context.Context, *MsgRefresh) (*MsgRefreshResponse, error)
+	// CompleteRefreshing completes the given refreshing request by the participant.
+	CompleteRefreshing(context.Context, *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/tss module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBatchSignatures(ctx context.Context, req *MsgSubmitBatchSignatures) (*MsgSubmitBatchSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatchSignatures not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgeSigning(ctx context.Context, req *MsgAcknowledgeSigning) (*MsgAcknowledgeSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeSigning not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPartialSignatures(ctx context.Context, req *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPartialSignatures not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) RequestSignature(ctx context.Context, req *MsgRequestSignature) (*MsgRequestSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSignature not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+}
+func (*UnimplementedMsgServer) Refresh(ctx context.Context, req *MsgRefresh) (*MsgRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (*UnimplementedMsgServer) CompleteRefreshing(ctx context.Context, req *MsgCompleteRefreshing) (*MsgCompleteRefreshingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRefreshing not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteDKG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/CompleteDKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/SubmitSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBatchSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBatchSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBatchSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/SubmitBatchSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBatchSignatures(ctx, req.(*MsgSubmitBatchSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeSigning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/AcknowledgeSigning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeSigning(ctx, req.(*MsgAcknowledgeSigning))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPartialSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/SubmitPartialSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, req.(*MsgSubmitPartialSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/RequestSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSignature(ctx, req.(*MsgRequestSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDKG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/InitiateDKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefresh)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refresh(ctx, req.(*MsgRefresh))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CompleteRefreshing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteRefreshing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteRefreshing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/CompleteRefreshing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteRefreshing(ctx, req.(*MsgCompleteRefreshing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitway.tss.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitway.tss.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CompleteDKG",
+			Handler:    _Msg_CompleteDKG_Handler,
+		},
+		{
+			MethodName: "SubmitSignatures",
+			Handler:    _Msg_SubmitSignatures_Handler,
+		},
+		{
+			MethodName: "SubmitBatchSignatures",
+			Handler:    _Msg_SubmitBatchSignatures_Handler,
+		},
+		{
+			MethodName: "AcknowledgeSigning",
+			Handler:    _Msg_AcknowledgeSigning_Handler,
+		},
+		{
+			MethodName: "SubmitPartialSignatures",
+			Handler:    _Msg_SubmitPartialSignatures_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "RequestSignature",
+			Handler:    _Msg_RequestSignature_Handler,
+		},
+		{
+			MethodName: "InitiateDKG",
+			Handler:    _Msg_InitiateDKG_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Msg_Refresh_Handler,
+		},
+		{
+			MethodName: "CompleteRefreshing",
+			Handler:    _Msg_CompleteRefreshing_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitway/tss/tx.proto",
+}
+
+func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proofs[iNdEx])
+			copy(dAtA[i:], m.Proofs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proofs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBatchSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBatchSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBatchSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBatchSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBatchSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBatchSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeSigning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeSigning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeSigning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeSigningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeSigningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeSigningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPartialSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPartialSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPartialSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedNonces) > 0 {
+		for iNdEx := len(m.AggregatedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AggregatedNonces[iNdEx])
+			copy(dAtA[i:], m.AggregatedNonces[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AggregatedNonces[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PartialSignatures) > 0 {
+		for iNdEx := len(m.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartialSignatures[iNdEx])
+			copy(dAtA[i:], m.PartialSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PartialSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nonces[iNdEx])
+			copy(dAtA[i:], m.Nonces[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Nonces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SigHashes) > 0 {
+		for iNdEx := len(m.SigHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigHashes[iNdEx])
+			copy(dAtA[i:], m.SigHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SigHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AddedParticipants) > 0 {
+		for iNdEx := len(m.AddedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedParticipants[iNdEx])
+			copy(dAtA[i:], m.AddedParticipants[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RemovedParticipants) > 0 {
+		for iNdEx := len(m.RemovedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedParticipants[iNdEx])
+			copy(dAtA[i:], m.RemovedParticipants[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DkgIds) > 0 {
+		dAtA5 := make([]byte, len(m.DkgIds)*10)
+		var j4 int
+		for _, num := range m.DkgIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteRefreshing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteRefreshing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteRefreshing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteRefreshingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteRefreshingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteRefreshingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCompleteDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, s := range m.Proofs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, s := range m.VerificationShares {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBatchSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitBatchSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcknowledgeSigning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeSigningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPartialSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, s := range m.Nonces {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PartialSignatures) > 0 {
+		for _, s := range m.PartialSignatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AggregatedNonces) > 0 {
+		for _, s := range m.AggregatedNonces {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRequestSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DkgId != 0 {
+		n += 1 + sovTx(uint64(m.DkgId))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTx(uint64(m.Type))
+	}
+	if len(m.SigHashes) > 0 {
+		for _, s := range m.SigHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgInitiateDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovTx(uint64(m.BatchSize))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInitiateDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DkgIds) > 0 {
+		l = 0
+		for _, e := range m.DkgIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.RemovedParticipants) > 0 {
+		for _, s := range m.RemovedParticipants {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AddedParticipants) > 0 {
+		for _, s := range m.AddedParticipants {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgRefreshResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteRefreshing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, s := range m.VerificationShares {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteRefreshingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBatchSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBatchSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBatchSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &BatchSignatures{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBatchSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBatchSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBatchSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeSigning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeSigning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeSigning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeSigningResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeSigningResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeSigningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPartialSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPartialSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPartialSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialSignatures = append(m.PartialSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedNonces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedNonces = append(m.AggregatedNonces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPartialSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSubmitSignaturesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRefresh) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DkgIds) > 0 {
-		l = 0
-		for _, e := range m.DkgIds {
-			l += sovTx(uint64(e))
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.RemovedParticipants) > 0 {
-		for _, s := range m.RemovedParticipants {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgRefreshResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCompleteRefreshing) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgCompleteRefreshingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1441,19 +4776,70 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1481,13 +4867,13 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Id = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1497,14 +4883,14 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Type |= SigningType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1532,11 +4918,11 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			m.SigHashes = append(m.SigHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1564,13 +4950,13 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			m.Psbt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1580,23 +4966,27 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			if m.Options == nil {
+				m.Options = &SigningOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1619,7 +5009,7 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1642,12 +5032,31 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1669,7 +5078,7 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,15 +5101,15 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1728,13 +5137,13 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1744,16 +5153,86 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1763,23 +5242,24 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1802,7 +5282,7 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,12 +5305,31 @@ func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2054,6 +5553,57 @@ func (m *MsgRefresh) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedParticipants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedParticipants = append(m.AddedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2269,6 +5819,38 @@ func (m *MsgCompleteRefreshing) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])